@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"io"
 	"log/slog"
@@ -12,17 +13,67 @@ import (
 	"time"
 
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/buildinfo"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/doctor"
 	customLogger "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/logger"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/metrics"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+	"github.com/prometheus/client_golang/prometheus"
 	"gopkg.in/natefinch/lumberjack.v2"
 )
 
+// doctorTimeout bounds the whole self-check, so an unreachable backend cannot hang it.
+const doctorTimeout = 30 * time.Second
+
 func main() {
-	// Load config
-	cfg := config.Load()
+	validateOnly := flag.Bool("validate-config", false, "validate the configuration, print a report and exit")
+	doctorOnly := flag.Bool("doctor", false,
+		"check the configuration, PostgreSQL, Redis and the schema, print a pass/fail report and exit")
+	flag.Parse()
+
+	if flag.Arg(0) == "config" {
+		runConfigCommand(flag.Args()[1:])
+
+		return
+	}
+
+	// Load and validate config; report every problem at once rather than failing on the first
+	cfg, err := config.LoadAndValidate()
+
+	if *doctorOnly {
+		runDoctor(cfg, err)
+
+		return
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *validateOnly {
+		fmt.Fprintln(os.Stdout, "configuration is valid")
+
+		return
+	}
 
 	setupLogger()
+	setupEventLogger()
+	customLogger.SetLogSensitive(cfg.Logging.LogSensitiveData)
+	validation.SetProfileLimits(cfg.Profile.Limits())
+
+	if cfg.Logging.LogSensitiveData {
+		slog.Warn("sensitive data logging is on: personal data and secrets are logged unredacted")
+	}
+
+	err = metrics.Register(prometheus.DefaultRegisterer, cfg.Server.ServiceName)
+	if err != nil {
+		slog.Error("failed to register metrics", "error", err)
+		os.Exit(1)
+	}
 
 	// Create dependency container
 	container, err := app.NewContainer(app.ContainerConfig{
@@ -43,6 +94,47 @@ func main() {
 	runServerWithContainer(container)
 }
 
+// runDoctor prints the self-check report and exits non-zero if any check failed.
+func runDoctor(cfg *config.Config, cfgErr error) {
+	ctx, cancel := context.WithTimeout(context.Background(), doctorTimeout)
+	defer cancel()
+
+	report := doctor.Diagnose(ctx, cfg, cfgErr)
+
+	err := report.Write(os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+
+	if err != nil || !report.Passed() {
+		cancel()
+		os.Exit(1)
+	}
+}
+
+// runConfigCommand runs `config print-effective`, which writes the merged configuration with
+// secrets masked. It prints even an invalid configuration, followed by its problems, since that is
+// when the merged result is most worth seeing.
+func runConfigCommand(args []string) {
+	if len(args) != 1 || args[0] != "print-effective" {
+		fmt.Fprintln(os.Stderr, "usage: config print-effective")
+		os.Exit(2)
+	}
+
+	_, cfgErr := config.LoadAndValidate()
+
+	err := config.WriteEffective(os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if cfgErr != nil {
+		fmt.Fprintln(os.Stderr, cfgErr)
+		os.Exit(1)
+	}
+}
+
 func setupLogger() {
 	// Initialize structured logger
 	var handlers []slog.Handler
@@ -88,40 +180,158 @@ func setupLogger() {
 		handlers = append(handlers, slog.NewTextHandler(io.Discard, nil))
 	}
 
-	logger := slog.New(customLogger.NewFanoutHandler(handlers...))
+	// Every record names the build that wrote it
+	build := buildinfo.Get()
+	logger := slog.New(customLogger.NewFanoutHandler(handlers...)).
+		With("version", build.Version, "git_sha", build.GitSHA)
 	slog.SetDefault(logger)
 }
 
+// setupEventLogger sends domain events to their own NDJSON file, or to the service log under the
+// "event" group when no file is set.
+func setupEventLogger() {
+	eventsCfg := config.Instance.Events
+	if !eventsCfg.Enabled {
+		return
+	}
+
+	handler := slog.Default().Handler().WithGroup("event")
+
+	if eventsCfg.File != "" {
+		handler = slog.NewJSONHandler(&lumberjack.Logger{
+			Filename:   eventsCfg.File,
+			MaxSize:    config.Instance.Logging.MaxSize,
+			MaxBackups: config.Instance.Logging.MaxBackups,
+			MaxAge:     config.Instance.Logging.MaxAge,
+			Compress:   config.Instance.Logging.Compress,
+		}, nil)
+	}
+
+	customLogger.SetEvents(customLogger.NewEventLogger(handler, customLogger.EventOptions{
+		SampleRate:  eventsCfg.SampleRate,
+		SampleRates: eventsCfg.SampleRates,
+	}))
+}
+
 func runServerWithContainer(container *app.Container) {
 	srv := server.NewServerWithContainer(container)
 
+	var tlsCfg config.TLSConfig
+	if container.Config != nil {
+		tlsCfg = container.Config.Server.TLS
+	}
+
+	var reloader *server.CertReloader
+
+	if tlsCfg.Enabled {
+		var err error
+
+		reloader, err = server.ConfigureTLS(srv, tlsCfg)
+		if err != nil {
+			slog.Error("failed to configure TLS", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+
+	if reloader != nil {
+		go func() {
+			err := reloader.Watch(watchCtx)
+			if err != nil {
+				slog.Warn("TLS certificate reload disabled", "error", err)
+			}
+		}()
+	}
+
+	if container.IPFilter != nil && container.Config.IPFilter.File != "" {
+		ipFilterReloader := server.NewIPFilterReloader(container.Config.IPFilter.File, container.IPFilter)
+
+		go func() {
+			err := ipFilterReloader.Watch(watchCtx)
+			if err != nil {
+				slog.Warn("IP filter reload disabled", "error", err)
+			}
+		}()
+	}
+
 	// Server run context
 	done := make(chan bool, 1)
 
-	go func() {
-		slog.Info("Server listening", "addr", srv.Addr)
+	listeners, err := server.Listen(server.ListenerConfigs(container.Config, srv))
+	if err != nil {
+		panic(fmt.Sprintf("http server error: %s", err))
+	}
 
-		err := srv.ListenAndServe()
-		if err != nil && err != http.ErrServerClosed {
-			panic(fmt.Sprintf("http server error: %s", err))
-		}
+	for _, listener := range listeners {
+		slog.Info("Server listening", "network", listener.Addr().Network(), "addr", listener.Addr().String(),
+			"tls", tlsCfg.Enabled)
+	}
+
+	serveErrs := server.Serve(srv, listeners, tlsCfg.Enabled)
+
+	go func() {
+		// Any listener failing is fatal, as with a single listener
+		err := <-serveErrs
+		panic(fmt.Sprintf("http server error: %s", err))
 	}()
 
+	var redirectSrv *http.Server
+	if tlsCfg.Enabled && tlsCfg.RedirectPort != 0 {
+		redirectSrv = server.NewRedirectServer(tlsCfg.RedirectPort, container.Config.Server.Port)
+
+		go func() {
+			slog.Info("HTTP to HTTPS redirect listening", "addr", redirectSrv.Addr)
+
+			err := redirectSrv.ListenAndServe()
+			if err != nil && err != http.ErrServerClosed {
+				panic(fmt.Sprintf("redirect server error: %s", err))
+			}
+		}()
+	}
+
+	diagnosticsSrv := server.NewDiagnosticsServer(container)
+	if diagnosticsSrv != nil {
+		go func() {
+			slog.Info("Diagnostics listening", "addr", diagnosticsSrv.Addr)
+
+			err := diagnosticsSrv.ListenAndServe()
+			if err != nil && err != http.ErrServerClosed {
+				slog.Error("diagnostics server error", "error", err)
+			}
+		}()
+	}
+
 	// Graceful shutdown
 	wait := make(chan os.Signal, 1)
 	signal.Notify(wait, syscall.SIGINT, syscall.SIGTERM)
 
-	// Block until we receive our signal.
-	<-wait
+	// Block until we are signalled or an admin drains the instance.
+	waitForDrain(container.DrainService, wait)
 	slog.Info("Shutting down server...")
 
 	// Create a deadline to wait for.
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), container.Config.Server.ShutdownTimeout)
 	defer cancel()
 
-	// Doesn't block if no connections, but will otherwise wait
+	if redirectSrv != nil {
+		err := redirectSrv.Shutdown(ctx)
+		if err != nil {
+			slog.Error("Redirect server forced to shutdown", "error", err)
+		}
+	}
+
+	if diagnosticsSrv != nil {
+		err := diagnosticsSrv.Shutdown(ctx)
+		if err != nil {
+			slog.Error("Diagnostics server forced to shutdown", "error", err)
+		}
+	}
+
+	// Closes every listener, then waits for active connections
 	// until the timeout deadline.
-	err := srv.Shutdown(ctx)
+	err = srv.Shutdown(ctx)
 	if err != nil {
 		slog.Error("Server forced to shutdown", "error", err)
 	}
@@ -130,6 +340,24 @@ func runServerWithContainer(container *app.Container) {
 	close(done)
 }
 
+// waitForDrain returns once the instance has drained. A shutdown signal starts the drain, so
+// Kubernetes needs no preStop hook; a second signal cuts the drain short. A drain started by an
+// admin ends the same way.
+func waitForDrain(drain service.DrainService, signals <-chan os.Signal) {
+	select {
+	case <-signals:
+		drain.StartDrain(context.Background(), service.DrainTriggerSignal)
+	case <-drain.Drained():
+		return
+	}
+
+	select {
+	case <-drain.Drained():
+	case <-signals:
+		slog.Warn("signalled again while draining, shutting down now")
+	}
+}
+
 func parseLevel(level string) slog.Level {
 	switch level {
 	case "debug":
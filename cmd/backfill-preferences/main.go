@@ -0,0 +1,89 @@
+// Command backfill-preferences writes explicit default preference rows for every user of the
+// configured PostgreSQL database who never saved a category. It does the same work as the
+// preference-backfill job admins start with POST /admin/jobs/preference-backfill/run, for running
+// from a shell during a migration. Rows already saved are left alone, so it is safe to re-run.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/database"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func main() {
+	batchSize := flag.Int("batch", 0, "users per transaction (default PREFERENCES_BACKFILL_BATCH_SIZE)")
+	rate := flag.Int("rate", -1, "users per second, 0 for unlimited (default PREFERENCES_BACKFILL_RATE)")
+	flag.Parse()
+
+	err := run(*batchSize, *rate)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(batchSize, rate int) error {
+	cfg, err := config.LoadAndValidate()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Storage.Backend == config.StorageBackendMemory {
+		return errors.New("backfill writes to PostgreSQL; set STORAGE_BACKEND=postgres")
+	}
+
+	opts := service.PreferenceBackfillOptions{
+		BatchSize: cfg.Preferences.BackfillBatchSize,
+		Rate:      cfg.Preferences.BackfillRate,
+		Progress: func(result dto.PreferenceBackfillResult) {
+			fmt.Fprintf(os.Stderr, "%d users, %d rows inserted\n", result.Users, result.Rows)
+		},
+	}
+
+	if batchSize > 0 {
+		opts.BatchSize = batchSize
+	}
+
+	if rate >= 0 {
+		opts.Rate = rate
+	}
+
+	db, err := database.New(&cfg.Postgres)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	defer func() {
+		_ = db.Close()
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	tables := repository.NewPreferenceRepository(db.GetDB())
+	tables.SetPrivacyDefaultsVersion(cfg.Preferences.PrivacyDefaultsVersion)
+
+	var repo repository.PreferenceBackfillRepository = tables
+	if cfg.Preferences.Storage == config.PreferenceStorageJSONB {
+		repo = repository.NewDocumentPreferenceRepository(tables)
+	}
+
+	result, err := service.NewPreferenceBackfillService(repo, opts).Backfill(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "backfilled %d default preference rows for %d users\n", result.Rows, result.Users)
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Command import migrates users, preferences and follow edges from the JSON lines export of the
+// legacy Python user service into the configured PostgreSQL database. Imports are idempotent, so
+// a cutover can be rehearsed with -dry-run and the export re-applied until it comes through clean.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/database"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/legacyimport"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+func main() {
+	file := flag.String("file", "", "legacy export to import (JSON lines)")
+	dryRun := flag.Bool("dry-run", false, "validate the export and report what would be imported without writing")
+	errorsFile := flag.String("errors", "", "write rejected rows and follow edges to this file (JSON lines)")
+	progressEvery := flag.Int("progress", 1000, "report progress every N rows")
+	flag.Parse()
+
+	err := run(*file, *errorsFile, *dryRun, *progressEvery)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(file, errorsFile string, dryRun bool, progressEvery int) (err error) {
+	if file == "" {
+		return errors.New("-file is required")
+	}
+
+	cfg, err := config.LoadAndValidate()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Storage.Backend == config.StorageBackendMemory {
+		return errors.New("import writes to PostgreSQL; set STORAGE_BACKEND=postgres")
+	}
+
+	// Imported profiles are held to the same limits as profile updates
+	validation.SetProfileLimits(cfg.Profile.Limits())
+
+	export, err := os.Open(file) //nolint:gosec // path comes from the operator
+	if err != nil {
+		return fmt.Errorf("failed to open export: %w", err)
+	}
+
+	defer func() {
+		_ = export.Close()
+	}()
+
+	var rejects io.Writer
+
+	if errorsFile != "" {
+		report, err := os.Create(errorsFile) //nolint:gosec // path comes from the operator
+		if err != nil {
+			return fmt.Errorf("failed to create error report: %w", err)
+		}
+
+		defer func() {
+			err = errors.Join(err, report.Close())
+		}()
+
+		rejects = report
+	}
+
+	db, err := database.New(&cfg.Postgres)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	defer func() {
+		_ = db.Close()
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	result, err := legacyimport.New(db.GetDB()).Run(ctx, export, legacyimport.Options{
+		DryRun:        dryRun,
+		Rejects:       rejects,
+		ProgressEvery: progressEvery,
+		Progress: func(p legacyimport.Progress) {
+			fmt.Fprintf(os.Stderr, "%s: %d rows read, %d users, %d follows, %d rejected\n",
+				p.Phase, p.Rows, p.Users, p.Follows, p.Rejected)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	verb := "imported"
+	if dryRun {
+		verb = "would import"
+	}
+
+	fmt.Fprintf(os.Stdout, "%s %d users, %d preference categories and %d follows from %s; %d rejected\n",
+		verb, result.Users, result.Preferences, result.Follows, file, result.Rejected)
+
+	return nil
+}
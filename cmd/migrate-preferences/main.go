@@ -0,0 +1,90 @@
+// Command migrate-preferences copies saved preferences between the storage layouts of the
+// configured PostgreSQL database: -to jsonb copies the category tables into documents, -to tables
+// copies documents back. Privacy preferences stay in their table either way. The newer of a row
+// and a document wins, so it is safe to re-run, and running it again after switching
+// PREFERENCES_STORAGE picks up what was saved in the old layout in between.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/database"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func main() {
+	to := flag.String("to", "", "layout to copy preferences into: jsonb or tables")
+	batchSize := flag.Int("batch", 0, "users per transaction (default PREFERENCES_BACKFILL_BATCH_SIZE)")
+	flag.Parse()
+
+	err := run(*to, *batchSize)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(to string, batchSize int) error {
+	if to != config.PreferenceStorageJSONB && to != config.PreferenceStorageTables {
+		return fmt.Errorf("-to must be %s or %s, got %q", config.PreferenceStorageJSONB,
+			config.PreferenceStorageTables, to)
+	}
+
+	cfg, err := config.LoadAndValidate()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Storage.Backend == config.StorageBackendMemory {
+		return errors.New("migration copies PostgreSQL tables; set STORAGE_BACKEND=postgres")
+	}
+
+	opts := service.PreferenceMigrationOptions{
+		BatchSize: cfg.Preferences.BackfillBatchSize,
+		Progress: func(result dto.PreferenceMigrationResult) {
+			fmt.Fprintf(os.Stderr, "%d users, %d rows copied\n", result.Users, result.Rows)
+		},
+	}
+
+	if batchSize > 0 {
+		opts.BatchSize = batchSize
+	}
+
+	db, err := database.New(&cfg.Postgres)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	defer func() {
+		_ = db.Close()
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	repo := repository.NewDocumentPreferenceRepository(repository.NewPreferenceRepository(db.GetDB()))
+	svc := service.NewPreferenceMigrationService(repo, opts)
+
+	migrate := svc.MigrateToDocuments
+	if to == config.PreferenceStorageTables {
+		migrate = svc.MigrateToTables
+	}
+
+	result, err := migrate(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "copied %d preference rows for %d users into %s\n", result.Rows, result.Users, to)
+
+	return nil
+}
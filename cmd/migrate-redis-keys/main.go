@@ -0,0 +1,70 @@
+// Command migrate-redis-keys moves the service's Redis keys into the namespace configured with
+// REDIS_KEY_NAMESPACE, REDIS_TENANT, the service name and the environment. Run it once after
+// turning namespacing on, or after changing the tenant with -from set to the old namespace. TTLs
+// are kept, keys already in the new namespace are left alone, and it is safe to re-run.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+)
+
+func main() {
+	from := flag.String("from", "", `namespace the keys are in now, e.g. "ums:production:" (default none)`)
+	flag.Parse()
+
+	err := run(*from)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(from string) error {
+	cfg, err := config.LoadAndValidate()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Storage.Backend == config.StorageBackendMemory {
+		return errors.New("migration rewrites Redis keys; set STORAGE_BACKEND=postgres")
+	}
+
+	to := redis.ConfigKeyPrefix(cfg)
+	if to == from {
+		return fmt.Errorf("keys are already in namespace %q; set REDIS_KEY_NAMESPACE or -from", to)
+	}
+
+	cache, err := redis.New(&cfg.Redis)
+	if err != nil {
+		return fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	defer func() {
+		_ = cache.Close()
+	}()
+
+	cache.SetKeyPrefix(to)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(os.Stderr, "moving keys from %q to %q\n", from, to)
+
+	moved, skipped, err := cache.MigrateKeyPrefix(ctx, from)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "moved %d keys, skipped %d already in the new namespace\n", moved, skipped)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Command rebuild-public-profiles rebuilds the public profile projection in Redis from the
+// configured PostgreSQL database: every user's profile as anonymous viewers see it. It does the
+// same work as the public-profile-rebuild job admins start with
+// POST /admin/jobs/public-profile-rebuild/run, for filling the projection before turning it on.
+// Profiles are replaced as they are rebuilt, so it is safe to re-run.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/database"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func main() {
+	batchSize := flag.Int("batch", 0, "profiles per write (default PUBLIC_PROFILES_BATCH_SIZE)")
+	flag.Parse()
+
+	err := run(*batchSize)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(batchSize int) error {
+	cfg, err := config.LoadAndValidate()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Storage.Backend == config.StorageBackendMemory {
+		return errors.New("rebuild reads PostgreSQL and writes Redis; set STORAGE_BACKEND=postgres")
+	}
+
+	opts := service.PublicProfileOptions{
+		MaxStaleness: cfg.PublicProfiles.MaxStaleness,
+		BatchSize:    cfg.PublicProfiles.BatchSize,
+		Progress: func(rebuilt int) {
+			fmt.Fprintf(os.Stderr, "%d profiles rebuilt\n", rebuilt)
+		},
+	}
+
+	if batchSize > 0 {
+		opts.BatchSize = batchSize
+	}
+
+	db, err := database.New(&cfg.Postgres)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	defer func() {
+		_ = db.Close()
+	}()
+
+	cache, err := redis.New(&cfg.Redis)
+	if err != nil {
+		return fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	defer func() {
+		_ = cache.Close()
+	}()
+
+	cache.SetKeyPrefix(redis.ConfigKeyPrefix(cfg))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	userRepo := repository.NewUserRepository(db.GetDB())
+	userRepo.SetPrivacyDefaultsVersion(cfg.Preferences.PrivacyDefaultsVersion)
+
+	preferenceRepo := repository.NewPreferenceRepository(db.GetDB())
+	svc := service.NewPublicProfileService(
+		userRepo,
+		repository.NewSocialRepository(db.GetDB()),
+		repository.NewChangeLogRepository(db.GetDB()),
+		preferenceRepo,
+		cache,
+		opts,
+	)
+
+	rebuilt, err := svc.Rebuild(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "rebuilt %d public profiles\n", rebuilt)
+
+	return nil
+}
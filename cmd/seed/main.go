@@ -0,0 +1,78 @@
+// Command seed loads a YAML or JSON fixture file of users, follows and preferences into the
+// configured PostgreSQL database. Loading is idempotent, so the same file can be applied repeatedly
+// to keep staging environments and integration test databases reproducible.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/database"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/seed"
+)
+
+func main() {
+	file := flag.String("file", "", "fixture file to load (.yaml, .yml or .json)")
+	wipe := flag.Bool("wipe", false, "delete all users, follows, handles and preferences before loading")
+	flag.Parse()
+
+	err := run(*file, *wipe)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(file string, wipe bool) error {
+	if file == "" {
+		return errors.New("-file is required")
+	}
+
+	cfg, err := config.LoadAndValidate()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Storage.Backend == config.StorageBackendMemory {
+		return errors.New("seed writes to PostgreSQL; in-memory storage loads fixtures via STORAGE_FIXTURES_FILE")
+	}
+
+	if wipe && strings.HasPrefix(strings.ToLower(cfg.Environment), "prod") {
+		return fmt.Errorf("refusing to wipe the %s environment", cfg.Environment)
+	}
+
+	f, err := fixtures.Load(file)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.New(&cfg.Postgres)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	defer func() {
+		_ = db.Close()
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	result, err := seed.New(db.GetDB()).Load(ctx, f, seed.Options{Wipe: wipe})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "seeded %d users, %d follows and %d preference categories from %s\n",
+		result.Users, result.Follows, result.Preferences, file)
+
+	return nil
+}
@@ -2,17 +2,40 @@
 package app
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"log/slog"
+	"net/netip"
+	"time"
 
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/contentmod"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/crypto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/database"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dataloader"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/directory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/doctor"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/errortracking"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/lock"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/metrics"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/notification"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/oauth2"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/scheduler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/scheduler/cron"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/secrets"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/signedtoken"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/migrations"
 )
 
 // Container holds all application dependencies.
@@ -22,12 +45,47 @@ type Container struct {
 	Cache    repository.HealthChecker
 
 	// Services
-	HealthService     service.HealthServicer
-	UserService       service.UserService
-	SocialService     service.SocialService
-	MetricsService    service.MetricsService
-	AdminService      service.AdminService
-	PreferenceService service.PreferenceService
+	HealthService             service.HealthServicer
+	UserService               service.UserService
+	SocialService             service.SocialService
+	MetricsService            service.MetricsService
+	AdminService              service.AdminService
+	PreferenceService         service.PreferenceService
+	PreferenceTransferService service.PreferenceTransferService
+	ChangeFeedService         service.ChangeFeedService
+	EmailChangeService        service.EmailChangeService
+	AccountRecoveryService    service.AccountRecoveryService
+	PrivacyDefaultsService    service.PrivacyDefaultsService
+	HandleService             service.HandleService
+	ProfileShareService       service.ProfileShareService
+	UserDetailsService        service.UserDetailsService
+	StatsService              service.StatsService
+	TypeaheadService          service.TypeaheadService
+	PrivacyReportService      service.PrivacyReportService
+	ConsentService            service.ConsentService
+	PolicyService             service.PolicyService
+	AgeService                service.AgeService
+	RoleService               service.RoleService
+	OrganizationService       service.OrganizationService
+	InvitationService         service.InvitationService
+	PreferenceCenterService   service.PreferenceCenterService
+	ListUnsubscribeService    service.ListUnsubscribeService
+	AdminNoteService          service.AdminNoteService
+	ModerationService         service.ModerationService
+	DeviceTokenService        service.DeviceTokenService
+	MaintenanceService        service.MaintenanceService
+	DrainService              service.DrainService
+	JobService                service.JobService
+	PresenceService           service.PresenceService
+	ProfileViewService        service.ProfileViewService
+	RecentlyViewedService     service.RecentlyViewedService
+	BadgeService              service.BadgeService
+	MentionService            service.MentionService
+	EmbedService              service.EmbedService
+	DirectorySyncService      service.DirectorySyncService
+
+	// DataAccessRepo is the audit log of service accounts reading user data
+	DataAccessRepo repository.DataAccessRepository
 
 	// Handlers
 	HealthHandler  handler.HealthHandler
@@ -42,17 +100,57 @@ type Container struct {
 
 	// Notification
 	NotificationClient notification.Client
+
+	// ErrorReporter sends panics, 5xx responses and failed jobs to error tracking
+	ErrorReporter errortracking.ErrorReporter
+
+	// IPFilter restricts the admin and internal routes by client address; nil when disabled
+	IPFilter *middleware.IPFilter
+
+	// TrustedProxies are the proxies whose forwarding headers name the client, whether or not
+	// the IP filter is enabled
+	TrustedProxies []netip.Prefix
+
+	// Secrets
+	Secrets     *secrets.CachingProvider
+	stopSecrets context.CancelFunc
+
+	// jobLocker keeps each background job to one instance at a time
+	jobLocker *lock.Locker
+
+	// scheduler runs the periodic background jobs
+	scheduler *scheduler.Scheduler
+
+	// memory replaces PostgreSQL and Redis when storage.backend is "memory"
+	memory *memory.Store
 }
 
 // ContainerConfig holds options for building the container.
 type ContainerConfig struct {
-	Config         *config.Config
-	Database       repository.HealthChecker        // Optional override for testing
-	Cache          repository.HealthChecker        // Optional override for testing
-	UserRepo       repository.UserRepository       // Optional override for testing
-	SocialRepo     repository.SocialRepository     // Optional override for testing
-	TokenStore     repository.TokenStore           // Optional override for testing
-	PreferenceRepo repository.PreferenceRepository // Optional override for testing
+	Config           *config.Config
+	Database         repository.HealthChecker              // Optional override for testing
+	Cache            repository.HealthChecker              // Optional override for testing
+	UserRepo         repository.UserRepository             // Optional override for testing
+	SocialRepo       repository.SocialRepository           // Optional override for testing
+	TokenStore       repository.TokenStore                 // Optional override for testing
+	PreferenceRepo   repository.PreferenceRepository       // Optional override for testing
+	ChangeLogRepo    repository.ChangeLogRepository        // Optional override for testing
+	EmailChangeStore repository.EmailChangeStore           // Optional override for testing
+	HandleRepo       repository.HandleRepository           // Optional override for testing
+	StatsRepo        repository.StatsRepository            // Optional override for testing
+	DataAccessRepo   repository.DataAccessRepository       // Optional override for testing
+	ConsentRepo      repository.ConsentRepository          // Optional override for testing
+	PolicyRepo       repository.PolicyAcceptanceRepository // Optional override for testing
+	AgeRepo          repository.AgeRepository              // Optional override for testing
+	RoleRepo         repository.RoleRepository             // Optional override for testing
+	OrganizationRepo repository.OrganizationRepository     // Optional override for testing
+	InvitationRepo   repository.InvitationRepository       // Optional override for testing
+	PrefCenterRepo   repository.PreferenceCenterRepository // Optional override for testing
+	AdminNoteRepo    repository.AdminNoteRepository        // Optional override for testing
+	AuditRepo        repository.AuditRepository            // Optional override for testing
+	ModerationRepo   repository.ModerationRepository       // Optional override for testing
+	DeviceTokenRepo  repository.DeviceTokenRepository      // Optional override for testing
+	SecretProvider   secrets.Provider                      // Optional override for testing
 }
 
 // NewContainer creates a new dependency container.
@@ -61,40 +159,256 @@ func NewContainer(cfg ContainerConfig) (*Container, error) {
 		Config: cfg.Config,
 	}
 
+	// Resolve credentials from the secret store before connecting to anything
+	err := initSecrets(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = initMemoryStorage(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	initInfrastructure(c, cfg)
+	initJobLocker(c)
+
+	err = initErrorTracking(c)
+	if err != nil {
+		return nil, err
+	}
+
+	err = initIPFilter(c)
+	if err != nil {
+		return nil, err
+	}
+
+	c.scheduler = scheduler.New(c.jobLocker)
+	c.scheduler.SetErrorReporter(c.ErrorReporter)
+	c.JobService = c.scheduler
 
 	// Initialize OAuth2 and notification client early (needed by services)
 	initOAuth2(c, cfg)
 	initNotification(c, cfg)
 
 	// Initialize services
-	c.HealthService = service.NewHealthService(c.Database, c.Cache)
+	healthService := service.NewHealthService(c.Database, c.Cache)
+	healthService.SetServiceName(config.DefaultServiceName)
+
+	if c.Config != nil && c.Config.Server.ServiceName != "" {
+		healthService.SetServiceName(c.Config.Server.ServiceName)
+	}
+
+	var drainPeriod time.Duration
+	if c.Config != nil {
+		drainPeriod = c.Config.Server.DrainPeriod
+	}
+
+	c.DrainService = service.NewDrainService(drainPeriod)
+	healthService.SetDrainService(c.DrainService)
+
+	c.HealthService = healthService
+
+	initMaintenanceService(c)
 
 	// Initialize repositories and domain services
 	userRepo, socialRepo, tokenStore, preferenceRepo := initRepositories(c, cfg)
 
+	moderationRepo := initModerationRepository(c, cfg)
 	if userRepo != nil {
-		c.UserService = service.NewUserService(userRepo, tokenStore, c.NotificationClient)
+		hasher := codeHasher(c.Config)
+
+		var searchCfg config.SearchConfig
+		if c.Config != nil {
+			searchCfg = c.Config.Search
+		}
+
+		userService := service.NewUserService(userRepo, tokenStore, c.NotificationClient, moderationRepo)
+		userService.SetPersonalizedSearch(searchCfg.Personalized)
+		userService.SetTokenSigner(signedtoken.New(signingKey(c.Config, "confirmation-token")))
+		userService.SetCodeHasher(hasher)
+		userService.SetTxManager(txManager(c, cfg.UserRepo, cfg.ModerationRepo))
+
+		if moderator := contentModerator(c.Config); moderator != nil {
+			userService.SetContentModerator(moderator, contentmod.Action(c.Config.ContentModeration.Action))
+		}
+
+		c.UserService = userService
+		scheduleIdentifierRelease(c, userService)
+		initTypeaheadService(c, userRepo, searchCfg)
+		initMentionService(c, userRepo)
+
+		emailChangeService := service.NewEmailChangeService(
+			userRepo,
+			initEmailChangeStore(c, cfg),
+			c.NotificationClient,
+			moderationRepo,
+		)
+		emailChangeService.SetCodeHasher(hasher)
+		c.EmailChangeService = emailChangeService
+
+		if moderationRepo != nil {
+			c.ModerationService = service.NewModerationService(userRepo, moderationRepo, c.NotificationClient)
+		}
 	}
 
 	if userRepo != nil && socialRepo != nil {
-		c.SocialService = service.NewSocialService(userRepo, socialRepo, c.NotificationClient)
+		initSocialService(c, userRepo, socialRepo)
+		initPresenceService(c, userRepo, socialRepo)
+		initProfileViewService(c, userRepo)
+		initRecentlyViewedService(c, userRepo)
+		initEmbedService(c, userRepo, socialRepo)
 	}
 
-	if preferenceRepo != nil {
-		c.PreferenceService = service.NewPreferenceService(preferenceRepo)
+	initBadgeService(c, userRepo)
+	initDirectorySyncService(c, userRepo)
+
+	initPreferenceBackfill(c, preferenceRepo)
+	initPrivacyDefaultsService(c, preferenceRepo)
+
+	consentRepo, ageRepo := initConsentRepository(c, cfg), initAgeRepository(c, cfg)
+	if preferenceRepo != nil && consentRepo != nil {
+		preferenceService := service.NewPreferenceService(preferenceRepo, consentRepo, ageRepo)
+		if c.Config != nil {
+			preferenceService.SetDisabledCategories(disabledPreferenceCategories(c.Config.Preferences))
+		}
+
+		preferenceService.SetTxManager(txManager(c, cfg.PreferenceRepo, cfg.AgeRepo))
+
+		c.PreferenceService = preferenceService
+		c.PreferenceTransferService = service.NewPreferenceTransferService(c.PreferenceService,
+			signingKey(c.Config, "preference-bundle"))
+		c.ConsentService = service.NewConsentService(consentRepo, preferenceRepo, ageRepo)
+
+		if ageRepo != nil {
+			ageService := service.NewAgeService(ageRepo, preferenceRepo, consentRepo)
+			ageService.SetTxManager(txManager(c, cfg.AgeRepo, cfg.PreferenceRepo, cfg.ConsentRepo))
+			c.AgeService = ageService
+		}
 	}
 
+	initSearchCache(c)
+	initPublicProfileService(c, cfg, userRepo, socialRepo, preferenceRepo)
+	initReadinessInvariants(c)
+	initPolicyService(c, cfg)
+	initAdminNoteService(c, cfg, userRepo)
+	initRoleService(c, cfg)
+	initOrganizationService(c, cfg)
+	initInvitationService(c, cfg, socialRepo)
+	initDeviceTokenService(c, cfg, preferenceRepo)
+	initHandleService(c, cfg)
+	initProfileShareService(c, userRepo)
+	initPreferenceCenterService(c, cfg)
+	initAccountRecoveryService(c, userRepo)
+	initPrivacyReportService(c, cfg, userRepo, socialRepo)
+	initUserDetailsService(c)
+	initChangeFeedService(c, cfg)
 	initMetricsService(c)
 	initAdminService(c)
+	initStatsService(c, cfg)
+	initRedisPruneJob(c)
+
+	warnUnscheduledJobs(c)
+	c.scheduler.Start()
 
 	return c, nil
 }
 
+// secretTargets maps secret names in the store to the config fields they populate.
+func secretTargets(cfg *config.Config) map[string]*string {
+	return map[string]*string{
+		"postgres_password": &cfg.Postgres.Password,
+		"redis_password":    &cfg.Redis.Password,
+	}
+}
+
+func initSecrets(c *Container, cfg ContainerConfig) error {
+	if cfg.Config == nil {
+		return nil
+	}
+
+	provider := cfg.SecretProvider
+	if provider == nil {
+		if cfg.Config.Secrets.Provider != "vault" {
+			return nil
+		}
+
+		vaultCfg := cfg.Config.Secrets.Vault
+		provider = secrets.NewVaultProvider(secrets.VaultConfig{
+			Address: vaultCfg.Address,
+			Token:   vaultCfg.Token,
+			Mount:   vaultCfg.Mount,
+			Path:    vaultCfg.Path,
+			Timeout: vaultCfg.Timeout,
+		})
+	}
+
+	c.Secrets = secrets.NewCachingProvider(provider, cfg.Config.Secrets.CacheTTL)
+
+	ctx := context.Background()
+
+	for name, target := range secretTargets(cfg.Config) {
+		value, err := c.Secrets.GetSecret(ctx, name)
+		if err != nil {
+			if errors.Is(err, secrets.ErrSecretNotFound) {
+				// Not managed by the store; keep the value from config/env
+				continue
+			}
+
+			return fmt.Errorf("failed to resolve secret %q: %w", name, err)
+		}
+
+		*target = value
+
+		// Connection pools are built once from config, so a rotated value only applies after a restart
+		c.Secrets.OnRotate(name, func(name, _ string) {
+			slog.Warn("secret rotated; restart the service to apply it", "name", name)
+		})
+	}
+
+	if interval := cfg.Config.Secrets.RefreshInterval; interval > 0 {
+		watchCtx, cancel := context.WithCancel(ctx)
+		c.stopSecrets = cancel
+
+		go c.Secrets.Watch(watchCtx, interval)
+	}
+
+	return nil
+}
+
+func initMemoryStorage(c *Container, cfg ContainerConfig) error {
+	if cfg.Config == nil || cfg.Config.Storage.Backend != config.StorageBackendMemory {
+		return nil
+	}
+
+	c.memory = memory.New()
+	c.memory.SetPrivacyDefaultsVersion(cfg.Config.Preferences.PrivacyDefaultsVersion)
+
+	if path := cfg.Config.Storage.FixturesFile; path != "" {
+		seed, err := fixtures.Load(path)
+		if err != nil {
+			return fmt.Errorf("failed to load fixtures: %w", err)
+		}
+
+		err = c.memory.Seed(context.Background(), seed)
+		if err != nil {
+			return fmt.Errorf("failed to seed memory storage: %w", err)
+		}
+
+		slog.Info("seeded memory storage", "path", path, "users", len(seed.Users), "follows", len(seed.Follows))
+	}
+
+	slog.Warn("using in-memory storage; data is lost on restart")
+
+	return nil
+}
+
 func initInfrastructure(c *Container, cfg ContainerConfig) {
 	// Database
 	if cfg.Database != nil {
 		c.Database = cfg.Database
+	} else if c.memory != nil {
+		c.Database = c.memory
 	} else if cfg.Config != nil {
 		db, err := database.New(&cfg.Config.Postgres)
 		if err == nil {
@@ -105,9 +419,12 @@ func initInfrastructure(c *Container, cfg ContainerConfig) {
 	// Cache
 	if cfg.Cache != nil {
 		c.Cache = cfg.Cache
+	} else if c.memory != nil {
+		c.Cache = c.memory
 	} else if cfg.Config != nil {
 		cache, err := redis.New(&cfg.Config.Redis)
 		if err == nil {
+			cache.SetKeyPrefix(redis.ConfigKeyPrefix(cfg.Config))
 			c.Cache = cache
 		}
 	}
@@ -134,13 +451,19 @@ func initRepositories(c *Container, cfg ContainerConfig) (
 	// User Repo
 	if cfg.UserRepo != nil {
 		userRepo = cfg.UserRepo
+	} else if c.memory != nil {
+		userRepo = c.memory
 	} else if dbService != nil {
-		userRepo = repository.NewUserRepository(dbService.GetDB())
+		sqlUserRepo := repository.NewUserRepository(dbService.GetDB())
+		sqlUserRepo.SetPrivacyDefaultsVersion(privacyDefaultsVersion(c))
+		userRepo = dataloader.NewUserRepository(sqlUserRepo)
 	}
 
 	// Social Repo
 	if cfg.SocialRepo != nil {
 		socialRepo = cfg.SocialRepo
+	} else if c.memory != nil {
+		socialRepo = c.memory
 	} else if dbService != nil {
 		socialRepo = repository.NewSocialRepository(dbService.GetDB())
 	}
@@ -148,6 +471,8 @@ func initRepositories(c *Container, cfg ContainerConfig) (
 	// Token Store
 	if cfg.TokenStore != nil {
 		tokenStore = cfg.TokenStore
+	} else if c.memory != nil {
+		tokenStore = c.memory
 	} else if redisService, ok := c.Cache.(*redis.Service); ok {
 		tokenStore = redisService
 	}
@@ -155,82 +480,1430 @@ func initRepositories(c *Container, cfg ContainerConfig) (
 	// Preference Repo
 	if cfg.PreferenceRepo != nil {
 		preferenceRepo = cfg.PreferenceRepo
+	} else if c.memory != nil {
+		preferenceRepo = c.memory
 	} else if dbService != nil {
-		preferenceRepo = repository.NewPreferenceRepository(dbService.GetDB())
+		sqlPreferenceRepo := repository.NewPreferenceRepository(dbService.GetDB())
+		sqlPreferenceRepo.SetPrivacyDefaultsVersion(privacyDefaultsVersion(c))
+		preferenceRepo = sqlPreferenceRepo
+
+		if c.Config != nil && c.Config.Preferences.Storage == config.PreferenceStorageJSONB {
+			preferenceRepo = repository.NewDocumentPreferenceRepository(sqlPreferenceRepo)
+		}
 	}
 
 	return userRepo, socialRepo, tokenStore, preferenceRepo
 }
 
-func initMetricsService(c *Container) {
-	dbService, ok := c.Database.(*database.Service)
-	if !ok || dbService == nil {
+func initConsentRepository(c *Container, cfg ContainerConfig) repository.ConsentRepository {
+	if cfg.ConsentRepo != nil {
+		return cfg.ConsentRepo
+	}
+
+	if c.memory != nil {
+		return c.memory
+	}
+
+	if dbService, ok := c.Database.(*database.Service); ok {
+		return repository.NewConsentRepository(dbService.GetDB())
+	}
+
+	return nil
+}
+
+func initAgeRepository(c *Container, cfg ContainerConfig) repository.AgeRepository {
+	if cfg.AgeRepo != nil {
+		return cfg.AgeRepo
+	}
+
+	if c.memory != nil {
+		return c.memory
+	}
+
+	if dbService, ok := c.Database.(*database.Service); ok {
+		return repository.NewAgeRepository(dbService.GetDB())
+	}
+
+	return nil
+}
+
+func initModerationRepository(c *Container, cfg ContainerConfig) repository.ModerationRepository {
+	if cfg.ModerationRepo != nil {
+		return cfg.ModerationRepo
+	}
+
+	if c.memory != nil {
+		return c.memory
+	}
+
+	if dbService, ok := c.Database.(*database.Service); ok {
+		return repository.NewModerationRepository(dbService.GetDB())
+	}
+
+	return nil
+}
+
+// initAdminNoteService keeps internal notes on user accounts and the audit trail of changes to them.
+func initAdminNoteService(c *Container, cfg ContainerConfig, userRepo repository.UserRepository) {
+	notes, audit := cfg.AdminNoteRepo, cfg.AuditRepo
+
+	if c.memory != nil {
+		if notes == nil {
+			notes = c.memory
+		}
+
+		if audit == nil {
+			audit = c.memory
+		}
+	} else if dbService, ok := c.Database.(*database.Service); ok {
+		if notes == nil {
+			notes = repository.NewAdminNoteRepository(dbService.GetDB())
+		}
+
+		if audit == nil {
+			audit = repository.NewAuditRepository(dbService.GetDB())
+		}
+	}
+
+	if userRepo == nil || notes == nil || audit == nil {
 		return
 	}
 
-	var redisClient service.RedisClient
-	if rc, ok := c.Cache.(service.RedisClient); ok {
-		redisClient = rc
+	c.AdminNoteService = service.NewAdminNoteService(userRepo, notes, audit)
+}
+
+// initRoleService manages the roles stored on user accounts, which authorization checks consult.
+func initRoleService(c *Container, cfg ContainerConfig) {
+	roles := cfg.RoleRepo
+
+	if roles == nil {
+		if c.memory != nil {
+			roles = c.memory
+		} else if dbService, ok := c.Database.(*database.Service); ok {
+			roles = repository.NewRoleRepository(dbService.GetDB())
+		}
 	}
 
-	systemCollector := service.NewSystemCollector()
-	c.MetricsService = service.NewMetricsService(dbService, redisClient, systemCollector, c.Config)
+	if roles == nil {
+		return
+	}
+
+	c.RoleService = service.NewRoleService(roles)
 }
 
-// Close cleanly shuts down all dependencies.
-func (c *Container) Close() error {
-	var errs []error
+// initOrganizationService manages organizations and lets their members act as them.
+func initOrganizationService(c *Container, cfg ContainerConfig) {
+	orgs := cfg.OrganizationRepo
 
-	// Close TokenManager first (depends on OAuth2Client)
-	if c.TokenManager != nil {
-		c.TokenManager.Close()
+	if orgs == nil {
+		if c.memory != nil {
+			orgs = c.memory
+		} else if dbService, ok := c.Database.(*database.Service); ok {
+			orgs = repository.NewOrganizationRepository(dbService.GetDB())
+		}
 	}
 
-	if c.Database != nil {
-		err := c.Database.Close()
-		if err != nil {
-			errs = append(errs, fmt.Errorf("database close: %w", err))
+	if orgs == nil {
+		return
+	}
+
+	c.OrganizationService = service.NewOrganizationService(orgs)
+}
+
+// initInvitationService lets users invite others with a limited number of codes and, when
+// configured, makes inviters and invitees follow each other.
+func initInvitationService(c *Container, cfg ContainerConfig, socialRepo repository.SocialRepository) {
+	invitations := cfg.InvitationRepo
+
+	if invitations == nil {
+		if c.memory != nil {
+			invitations = c.memory
+		} else if dbService, ok := c.Database.(*database.Service); ok {
+			invitations = repository.NewInvitationRepository(dbService.GetDB())
 		}
 	}
 
-	if c.Cache != nil {
-		err := c.Cache.Close()
-		if err != nil {
-			errs = append(errs, fmt.Errorf("cache close: %w", err))
+	if invitations == nil {
+		return
+	}
+
+	var opts service.InvitationOptions
+	if c.Config != nil {
+		opts = service.InvitationOptions{
+			MaxPerUser:   c.Config.Invitations.MaxPerUser,
+			TTL:          c.Config.Invitations.TTL,
+			MutualFollow: c.Config.Invitations.MutualFollow,
 		}
 	}
 
-	return errors.Join(errs...)
+	svc := service.NewInvitationService(invitations, socialRepo, opts)
+	svc.SetTxManager(txManager(c, cfg.InvitationRepo, cfg.SocialRepo))
+	c.InvitationService = svc
 }
 
-func initAdminService(c *Container) {
-	var redisClient service.RedisCacheClient
-	if rc, ok := c.Cache.(service.RedisCacheClient); ok {
-		redisClient = rc
+// initSocialService serves the follow graph, capped at the configured follow limit, and digest
+// previews, and, when configured, periodically purges follow history older than the retention period.
+func initSocialService(c *Container, userRepo repository.UserRepository, socialRepo repository.SocialRepository) {
+	svc := service.NewSocialService(userRepo, socialRepo, c.NotificationClient)
+	c.SocialService = svc
+
+	var socialCfg config.SocialConfig
+	if c.Config != nil {
+		socialCfg = c.Config.Social
 	}
 
-	c.AdminService = service.NewAdminService(redisClient)
+	svc.SetMaxFollowing(socialCfg.MaxFollowing)
+
+	if socialCfg.DigestPeriod > 0 {
+		svc.SetDigestPeriod(socialCfg.DigestPeriod)
+	}
+
+	if socialCfg.FollowHistoryRetention <= 0 {
+		return
+	}
+
+	scheduleJob(c, scheduler.Job{
+		Name: jobFollowHistoryPurge,
+		Run: func(ctx context.Context) error {
+			deleted, err := svc.PurgeFollowHistory(ctx, socialCfg.FollowHistoryRetention)
+			if deleted > 0 {
+				slog.InfoContext(ctx, "purged follow history", "count", deleted)
+			}
+
+			return err
+		},
+	}, socialCfg.FollowHistoryPurgeInterval)
 }
 
-func initOAuth2(c *Container, cfg ContainerConfig) {
-	if cfg.Config == nil || !cfg.Config.OAuth2.Enabled {
+// scheduleIdentifierRelease frees the identifiers of users deactivated past the retention period.
+func scheduleIdentifierRelease(c *Container, svc service.UserService) {
+	scheduleJob(c, scheduler.Job{
+		Name: jobIdentifierRelease,
+		Run: func(ctx context.Context) error {
+			released, err := svc.ReleaseRetiredIdentifiers(ctx)
+			if released > 0 {
+				slog.InfoContext(ctx, "released retired identifiers", "users", released)
+			}
+
+			return err
+		},
+	}, identifierReleaseInterval)
+}
+
+// initMentionService resolves the @username mentions other services render.
+func initMentionService(c *Container, userRepo repository.UserRepository) {
+	var mentionsCfg config.MentionsConfig
+	if c.Config != nil {
+		mentionsCfg = c.Config.Mentions
+	}
+
+	c.MentionService = service.NewMentionService(userRepo, service.MentionOptions{
+		MaxUsernames: mentionsCfg.MaxUsernames,
+		CacheTTL:     mentionsCfg.CacheTTL,
+	})
+}
+
+// initEmbedService serves the link preview metadata of public profiles.
+func initEmbedService(c *Container, userRepo repository.UserRepository, socialRepo repository.SocialRepository) {
+	var embedCfg config.EmbedConfig
+	if c.Config != nil {
+		embedCfg = c.Config.Embed
+	}
+
+	c.EmbedService = service.NewEmbedService(userRepo, socialRepo, service.EmbedOptions{
+		ProfileURL: embedCfg.ProfileURL,
+		AvatarURL:  embedCfg.AvatarURL,
+		CacheTTL:   embedCfg.CacheTTL,
+	})
+}
+
+// initPublicProfileService keeps the public profile projection in the shared store for anonymous
+// profile views and embeds, synced from the user change log and rebuilt when an admin asks.
+func initPublicProfileService(
+	c *Container,
+	cfg ContainerConfig,
+	userRepo repository.UserRepository,
+	socialRepo repository.SocialRepository,
+	preferenceRepo repository.PreferenceRepository,
+) {
+	if c.Config == nil || !c.Config.PublicProfiles.Enabled || userRepo == nil || socialRepo == nil {
 		return
 	}
 
-	// Initialize OAuth2 client for token introspection
-	c.OAuth2Client = oauth2.NewOAuth2Client(&cfg.Config.OAuth2)
+	store := publicProfileStore(c)
+	if store == nil {
+		slog.Warn("no shared store available, public profile projection disabled")
 
-	// Initialize TokenManager for service-to-service authentication
-	// Include notification:admin scope for notification service calls
-	if cfg.Config.OAuth2.ServiceEnabled {
-		c.TokenManager = oauth2.NewTokenManager(c.OAuth2Client, []string{
-			"user:read",
-			"user:write",
-			"notification:admin",
+		return
+	}
+
+	changeLogRepo := initChangeLogRepository(c, cfg)
+	ids, _ := preferenceRepo.(repository.UserIDLister)
+	profilesCfg := c.Config.PublicProfiles
+
+	svc := service.NewPublicProfileService(userRepo, socialRepo, changeLogRepo, ids, store, service.PublicProfileOptions{
+		MaxStaleness: profilesCfg.MaxStaleness,
+		BatchSize:    profilesCfg.BatchSize,
+	})
+
+	if userService, ok := c.UserService.(*service.UserServiceImpl); ok {
+		userService.SetPublicProfiles(svc)
+	}
+
+	if embedService, ok := c.EmbedService.(*service.EmbedServiceImpl); ok {
+		embedService.SetPublicProfiles(svc)
+	}
+
+	if preferenceService, ok := c.PreferenceService.(*service.PreferenceServiceImpl); ok {
+		preferenceService.SetPublicProfiles(svc)
+	}
+
+	if ageService, ok := c.AgeService.(*service.AgeServiceImpl); ok {
+		ageService.SetPublicProfiles(svc)
+	}
+
+	if moderationService, ok := c.ModerationService.(*service.ModerationServiceImpl); ok {
+		moderationService.SetPublicProfiles(svc)
+	}
+
+	if changeLogRepo != nil {
+		scheduleJob(c, scheduler.Job{
+			Name: jobPublicProfileSync,
+			Run: func(ctx context.Context) error {
+				rebuilt, err := svc.Sync(ctx)
+				if rebuilt > 0 {
+					slog.InfoContext(ctx, "synced public profiles", "count", rebuilt)
+				}
+
+				return err
+			},
+		}, profilesCfg.SyncInterval)
+	}
+
+	if ids != nil {
+		registerOnDemandJob(c, scheduler.Job{
+			Name: jobPublicProfileBuild,
+			Run: func(ctx context.Context) error {
+				rebuilt, err := svc.Rebuild(ctx)
+				slog.InfoContext(ctx, "rebuilt public profiles", "count", rebuilt)
+
+				return err
+			},
 		})
 	}
 }
 
+// txManager returns the transaction manager for units of work spanning several repositories, or
+// nil without a store that has one. Overrides are the repositories the unit of work uses that were
+// passed in ContainerConfig; those don't join the store's transactions, so any of them leaves the
+// unit of work without one.
+func txManager(c *Container, overrides ...any) repository.TxManager {
+	for _, override := range overrides {
+		if override != nil {
+			return nil
+		}
+	}
+
+	if c.memory != nil {
+		return c.memory
+	}
+
+	if dbService, ok := c.Database.(*database.Service); ok {
+		return repository.NewTxManager(dbService.GetDB())
+	}
+
+	return nil
+}
+
+// publicProfileStore returns the shared store the public profile projection is kept in, or nil
+// without one.
+func publicProfileStore(c *Container) repository.PublicProfileStore {
+	if c.memory != nil {
+		return c.memory
+	}
+
+	if redisService, ok := c.Cache.(*redis.Service); ok {
+		return redisService
+	}
+
+	return nil
+}
+
+// initReadinessInvariants adds the schema and data invariants to readiness. They are read from
+// Postgres, so the in-memory store goes without them; the projection's checks need the projection.
+func initReadinessInvariants(c *Container) {
+	dbService, ok := c.Database.(*database.Service)
+	if c.Config == nil || c.memory != nil || !ok {
+		return
+	}
+
+	healthService, ok := c.HealthService.(*service.HealthService)
+	if !ok {
+		return
+	}
+
+	indexes, err := doctor.RequiredIndexes(migrations.FS)
+	if err != nil {
+		slog.Warn("failed to list required indexes, readiness will not check them", "error", err)
+	}
+
+	var projection repository.PublicProfileStore
+	if c.Config.PublicProfiles.Enabled {
+		projection = publicProfileStore(c)
+	}
+
+	readinessCfg := c.Config.Readiness
+	rule := func(cfg config.InvariantConfig) service.InvariantRule {
+		return service.InvariantRule{Severity: cfg.Severity, Max: cfg.Max, MaxAge: cfg.MaxAge, Sample: cfg.Sample}
+	}
+
+	healthService.SetInvariants(service.NewReadinessInvariants(
+		repository.NewInvariantRepository(dbService.GetDB()),
+		projection,
+		service.ReadinessInvariantOptions{
+			CheckInterval:   readinessCfg.CheckInterval,
+			RequiredIndexes: indexes,
+			Indexes:         rule(readinessCfg.Indexes),
+			CounterDrift:    rule(readinessCfg.CounterDrift),
+			ChangeBacklog:   rule(readinessCfg.ChangeBacklog),
+			ChangeAge:       rule(readinessCfg.ChangeAge),
+		},
+	))
+}
+
+// initSearchCache caches user search results in the shared store, and drops them from the
+// services whose changes alter search results.
+func initSearchCache(c *Container) {
+	if c.Config == nil || c.Config.Search.ResultsCacheTTL <= 0 {
+		return
+	}
+
+	var cache repository.SearchCache
+	if c.memory != nil {
+		cache = c.memory
+	} else if redisService, ok := c.Cache.(*redis.Service); ok {
+		cache = redisService
+	} else {
+		return
+	}
+
+	if userService, ok := c.UserService.(*service.UserServiceImpl); ok {
+		userService.SetSearchCache(cache, c.Config.Search.ResultsCacheTTL)
+	}
+
+	if preferenceService, ok := c.PreferenceService.(*service.PreferenceServiceImpl); ok {
+		preferenceService.SetSearchCache(cache)
+	}
+
+	if moderationService, ok := c.ModerationService.(*service.ModerationServiceImpl); ok {
+		moderationService.SetSearchCache(cache)
+	}
+}
+
+// initTypeaheadService serves username typeahead and, while the search index is read, rebuilds
+// it from PostgreSQL on a schedule.
+func initTypeaheadService(c *Container, userRepo repository.UserRepository, searchCfg config.SearchConfig) {
+	var index repository.UsernameIndex
+	if c.memory != nil {
+		index = c.memory
+	} else if redisService, ok := c.Cache.(*redis.Service); ok {
+		index = redisService
+	}
+
+	source := dto.TypeaheadSource(searchCfg.TypeaheadSource)
+	if index == nil && source != "" && source != dto.TypeaheadSourcePostgres {
+		slog.Warn("no search index available, typeahead reads postgres", "source", source)
+
+		source = dto.TypeaheadSourcePostgres
+	}
+
+	svc := service.NewTypeaheadService(userRepo, service.TypeaheadOptions{
+		Index:       index,
+		Source:      source,
+		CacheTTL:    searchCfg.TypeaheadCacheTTL,
+		ReindexRate: searchCfg.ReindexRate,
+	})
+	svc.SetLocker(c.jobLocker)
+	c.TypeaheadService = svc
+
+	if source == "" || source == dto.TypeaheadSourcePostgres {
+		return
+	}
+
+	// The index is rebuilt at startup too, so it is populated before it is read
+	scheduleJob(c, scheduler.Job{
+		Name:       jobSearchReindex,
+		RunOnStart: true,
+		Run: func(ctx context.Context) error {
+			job, err := svc.Reindex(ctx, service.ReindexTriggerSchedule)
+
+			switch {
+			case errors.Is(err, service.ErrReindexRunning):
+				return fmt.Errorf("%w: %w", scheduler.ErrSkipped, err)
+			case err != nil:
+				return err
+			case job.State == dto.SearchReindexFailed:
+				return fmt.Errorf("reindex %s failed: %s", job.JobID, job.Error)
+			}
+
+			return nil
+		},
+	}, searchCfg.ReindexInterval)
+}
+
+// initJobLocker takes job leases from the shared store, so scaling out does not run a purge or
+// reindex on every replica. Without a store the jobs run unguarded.
+func initJobLocker(c *Container) {
+	if c.Config == nil {
+		return
+	}
+
+	var store repository.LockStore
+	if c.memory != nil {
+		store = c.memory
+	} else if redisService, ok := c.Cache.(*redis.Service); ok {
+		store = redisService
+	}
+
+	if store == nil {
+		slog.Warn("no lock store available, background jobs may run on several instances at once")
+
+		return
+	}
+
+	c.jobLocker = lock.New(store, c.Config.Jobs.LockTTL)
+}
+
+// Scheduled job names, also the keys of jobs.schedules.
+const (
+	jobDeviceCleanup      = "device-cleanup"
+	jobFollowHistoryPurge = "follow-history-purge"
+	jobSearchReindex      = "search-reindex"
+	jobBadgeEvaluation    = "badge-evaluation"
+	jobBadgeSweep         = "badge-sweep"
+	jobDirectorySync      = "directory-sync"
+	jobPreferenceBackfill = "preference-backfill"
+	jobPublicProfileSync  = "public-profile-sync"
+	jobPublicProfileBuild = "public-profile-rebuild"
+	jobProfileViewFlush   = "profile-view-flush"
+	jobRedisPrune         = "redis-prune"
+	jobIdentifierRelease  = "identifier-release"
+)
+
+// identifierReleaseInterval is how often the usernames and emails of users deactivated past the
+// retention period are freed, so a retired identifier becomes available within this long.
+const identifierReleaseInterval = time.Hour
+
+// scheduleJob runs job on the schedule configured for it under jobs.schedules, or else every
+// interval. Without either the job is off.
+func scheduleJob(c *Container, job scheduler.Job, interval time.Duration) {
+	spec := "@every " + interval.String()
+
+	if c.Config != nil && c.Config.Jobs.Schedules[job.Name] != "" {
+		spec = c.Config.Jobs.Schedules[job.Name]
+	} else if interval <= 0 {
+		return
+	}
+
+	schedule, err := cron.Parse(spec)
+	if err == nil {
+		job.Schedule = schedule
+		err = c.scheduler.Register(job)
+	}
+
+	if err != nil {
+		slog.Warn("job not scheduled", "job", job.Name, "schedule", spec, "error", err)
+	}
+}
+
+// registerOnDemandJob registers a job that runs when an admin starts it, and also on the
+// schedule configured for it under jobs.schedules if there is one.
+func registerOnDemandJob(c *Container, job scheduler.Job) {
+	if c.Config != nil && c.Config.Jobs.Schedules[job.Name] != "" {
+		scheduleJob(c, job, 0)
+
+		return
+	}
+
+	err := c.scheduler.Register(job)
+	if err != nil {
+		slog.Warn("job not registered", "job", job.Name, "error", err)
+	}
+}
+
+// warnUnscheduledJobs flags schedules configured for jobs that are not running, whether the
+// name is misspelt or the job is off because what it works on is not configured.
+func warnUnscheduledJobs(c *Container) {
+	if c.Config == nil {
+		return
+	}
+
+	scheduled := make(map[string]bool)
+	for _, job := range c.scheduler.ListJobs(context.Background()) {
+		scheduled[job.Name] = true
+	}
+
+	for name := range c.Config.Jobs.Schedules {
+		if !scheduled[name] {
+			slog.Warn("schedule configured for a job that is not running", "job", name)
+		}
+	}
+}
+
+// initRedisPruneJob removes the Redis keys that TTLs will never clear, such as keys left under a
+// renamed prefix or written without an expiry. It is off without Redis or a prune interval.
+func initRedisPruneJob(c *Container) {
+	redisService, ok := c.Cache.(*redis.Service)
+	if !ok || c.Config == nil || c.Config.Redis.PruneInterval <= 0 {
+		return
+	}
+
+	redisCfg := c.Config.Redis
+
+	scheduleJob(c, scheduler.Job{
+		Name: jobRedisPrune,
+		Run: func(ctx context.Context) error {
+			results, err := redisService.PruneKeys(ctx, redisCfg.PrunePrefixes, redisCfg.PruneDryRun)
+
+			for _, result := range results {
+				metrics.RedisPruneKeysTotal.WithLabelValues(result.Prefix, "kept").
+					Add(float64(result.Scanned - result.Orphaned))
+				metrics.RedisPruneKeysTotal.WithLabelValues(result.Prefix, "deleted").Add(float64(result.Deleted))
+				metrics.RedisPruneKeysTotal.WithLabelValues(result.Prefix, "orphaned").
+					Add(float64(result.Orphaned - result.Deleted))
+
+				if result.Orphaned > 0 {
+					slog.InfoContext(ctx, "pruned orphaned redis keys", "prefix", result.Prefix,
+						"orphaned", result.Orphaned, "deleted", result.Deleted, "dry_run", redisCfg.PruneDryRun)
+				}
+			}
+
+			return err
+		},
+	}, redisCfg.PruneInterval)
+}
+
+// initMaintenanceService keeps the maintenance switch in the shared store, so turning it on
+// rejects writes on every instance. Without Redis it only applies to this instance.
+func initMaintenanceService(c *Container) {
+	var store repository.MaintenanceStore
+	if c.memory != nil {
+		store = c.memory
+	} else if redisService, ok := c.Cache.(*redis.Service); ok {
+		store = redisService
+	}
+
+	var maintenanceCfg config.MaintenanceConfig
+	if c.Config != nil {
+		maintenanceCfg = c.Config.Maintenance
+	}
+
+	c.MaintenanceService = service.NewMaintenanceService(store, service.MaintenanceOptions{
+		Forced:          maintenanceCfg.Enabled,
+		Message:         maintenanceCfg.Message,
+		RefreshInterval: maintenanceCfg.RefreshInterval,
+	})
+}
+
+// initBadgeService awards the badges defined in config. Reported events are queued in the shared
+// store and evaluated on a schedule, so a burst of events for a user is evaluated once; without
+// Redis each event is evaluated as it arrives. Membership badges, which no event reports, are
+// awarded by a periodic sweep.
+func initBadgeService(c *Container, userRepo repository.UserRepository) {
+	var repo repository.BadgeRepository
+	if c.memory != nil {
+		repo = c.memory
+	} else if dbService, ok := c.Database.(*database.Service); ok {
+		repo = repository.NewBadgeRepository(dbService.GetDB())
+	}
+
+	if userRepo == nil || repo == nil || c.Config == nil {
+		return
+	}
+
+	badgesCfg := c.Config.Badges
+
+	var queue repository.BadgeQueue
+	if badgesCfg.EvaluationInterval > 0 {
+		if c.memory != nil {
+			queue = c.memory
+		} else if redisService, ok := c.Cache.(*redis.Service); ok {
+			queue = redisService
+		}
+	}
+
+	definitions := make([]dto.BadgeDefinition, len(badgesCfg.Definitions))
+	for i, badge := range badgesCfg.Definitions {
+		definitions[i] = dto.BadgeDefinition{
+			BadgeID:     badge.ID,
+			Name:        badge.Name,
+			Description: badge.Description,
+			Metric:      dto.BadgeMetric(badge.Metric),
+			Threshold:   badge.Threshold,
+		}
+	}
+
+	svc := service.NewBadgeService(repo, userRepo, service.BadgeOptions{Definitions: definitions, Queue: queue})
+	c.BadgeService = svc
+
+	if userService, ok := c.UserService.(*service.UserServiceImpl); ok {
+		userService.SetBadgeService(svc)
+	}
+
+	if socialService, ok := c.SocialService.(*service.SocialServiceImpl); ok {
+		socialService.SetBadgeService(svc)
+	}
+
+	if queue != nil {
+		scheduleJob(c, scheduler.Job{
+			Name: jobBadgeEvaluation,
+			Run: func(ctx context.Context) error {
+				evaluated, err := svc.EvaluateQueued(ctx)
+				if evaluated > 0 {
+					slog.InfoContext(ctx, "evaluated badges", "count", evaluated)
+				}
+
+				return err
+			},
+		}, badgesCfg.EvaluationInterval)
+	}
+
+	scheduleJob(c, scheduler.Job{
+		Name: jobBadgeSweep,
+		Run: func(ctx context.Context) error {
+			awarded, err := svc.AwardMembershipBadges(ctx)
+			if awarded > 0 {
+				slog.InfoContext(ctx, "awarded membership badges", "count", awarded)
+			}
+
+			return err
+		},
+	}, badgesCfg.SweepInterval)
+}
+
+// initDirectorySyncService keeps the links between accounts and LDAP directory entries and, when
+// a directory is configured, syncs the linked accounts from it on a schedule. Links can be
+// managed without a directory, ahead of turning the sync on.
+func initDirectorySyncService(c *Container, userRepo repository.UserRepository) {
+	var repo repository.DirectoryLinkRepository
+	if c.memory != nil {
+		repo = c.memory
+	} else if dbService, ok := c.Database.(*database.Service); ok {
+		repo = repository.NewDirectoryLinkRepository(dbService.GetDB())
+	}
+
+	if userRepo == nil || repo == nil {
+		return
+	}
+
+	var directoryCfg config.DirectoryConfig
+	if c.Config != nil {
+		directoryCfg = c.Config.Directory
+	}
+
+	opts := service.DirectorySyncOptions{
+		Rules: map[dto.DirectoryAttribute]dto.DirectoryConflictRule{
+			dto.DirectoryAttributeFullName: dto.DirectoryConflictRule(directoryCfg.Rules.FullName),
+			dto.DirectoryAttributeEmail:    dto.DirectoryConflictRule(directoryCfg.Rules.Email),
+			dto.DirectoryAttributeIsActive: dto.DirectoryConflictRule(directoryCfg.Rules.IsActive),
+		},
+	}
+
+	if directoryCfg.Enabled {
+		client, err := directory.NewClient(directory.Config{
+			URL:               directoryCfg.URL,
+			StartTLS:          directoryCfg.StartTLS,
+			BindDN:            directoryCfg.BindDN,
+			BindPassword:      directoryCfg.BindPassword,
+			BaseDN:            directoryCfg.BaseDN,
+			LookupAttribute:   directoryCfg.LookupAttribute,
+			FullNameAttribute: directoryCfg.Attributes.FullName,
+			EmailAttribute:    directoryCfg.Attributes.Email,
+			ActiveAttribute:   directoryCfg.Attributes.Active,
+			InactiveValues:    directoryCfg.Attributes.InactiveValues,
+			Timeout:           directoryCfg.Timeout,
+		})
+		if err != nil {
+			slog.Warn("directory sync disabled", "error", err)
+		} else {
+			opts.Reader = client
+		}
+	}
+
+	svc := service.NewDirectorySyncService(repo, userRepo, opts)
+	c.DirectorySyncService = svc
+
+	if opts.Reader == nil {
+		return
+	}
+
+	scheduleJob(c, scheduler.Job{
+		Name: jobDirectorySync,
+		Run: func(ctx context.Context) error {
+			synced, err := svc.SyncAll(ctx)
+			if synced > 0 {
+				slog.InfoContext(ctx, "synced directory links", "count", synced)
+			}
+
+			return err
+		},
+	}, directoryCfg.SyncInterval)
+}
+
+// disabledPreferenceCategories converts the configured disabled categories, which validation has
+// already checked.
+func disabledPreferenceCategories(cfg config.PreferencesConfig) []dto.PreferenceCategory {
+	categories := make([]dto.PreferenceCategory, 0, len(cfg.DisabledCategories))
+	for _, category := range cfg.DisabledCategories {
+		categories = append(categories, dto.PreferenceCategory(category))
+	}
+
+	return categories
+}
+
+// initPreferenceBackfill registers the job that writes explicit default preference rows for
+// users who never saved a category. Admins start it with POST /admin/jobs/preference-backfill/run.
+func initPreferenceBackfill(c *Container, preferenceRepo repository.PreferenceRepository) {
+	repo, ok := preferenceRepo.(repository.PreferenceBackfillRepository)
+	if !ok {
+		return
+	}
+
+	var preferencesCfg config.PreferencesConfig
+	if c.Config != nil {
+		preferencesCfg = c.Config.Preferences
+	}
+
+	svc := service.NewPreferenceBackfillService(repo, service.PreferenceBackfillOptions{
+		BatchSize: preferencesCfg.BackfillBatchSize,
+		Rate:      preferencesCfg.BackfillRate,
+	})
+
+	registerOnDemandJob(c, scheduler.Job{
+		Name: jobPreferenceBackfill,
+		Run: func(ctx context.Context) error {
+			result, err := svc.Backfill(ctx)
+			slog.InfoContext(ctx, "backfilled default preferences", "users", result.Users, "rows", result.Rows)
+
+			return err
+		},
+	})
+}
+
+// initPresenceService keeps the last seen times in the shared store, so every instance reports
+// the same presence. Without Redis there is nowhere to keep them and presence is unavailable.
+func initPresenceService(c *Container, userRepo repository.UserRepository, socialRepo repository.SocialRepository) {
+	var store repository.PresenceStore
+	if c.memory != nil {
+		store = c.memory
+	} else if redisService, ok := c.Cache.(*redis.Service); ok {
+		store = redisService
+	} else {
+		return
+	}
+
+	var presenceCfg config.PresenceConfig
+	if c.Config != nil {
+		presenceCfg = c.Config.Presence
+	}
+
+	c.PresenceService = service.NewPresenceService(store, userRepo, socialRepo, service.PresenceOptions{
+		OnlineWindow: presenceCfg.OnlineWindow,
+		Retention:    presenceCfg.Retention,
+	})
+}
+
+// initProfileViewService counts profile views in the shared store, so a viewer is counted once
+// whichever instance serves them, and periodically adds them to the database. The counter is off
+// without Redis or a flush interval.
+func initProfileViewService(c *Container, userRepo repository.UserRepository) {
+	var (
+		store repository.ProfileViewStore
+		repo  repository.ProfileViewRepository
+	)
+
+	redisService, hasRedis := c.Cache.(*redis.Service)
+	dbService, hasDB := c.Database.(*database.Service)
+
+	switch {
+	case c.memory != nil:
+		store, repo = c.memory, c.memory
+	case hasRedis && hasDB:
+		store, repo = redisService, repository.NewProfileViewRepository(dbService.GetDB())
+	}
+
+	if store == nil || c.Config == nil || c.Config.ProfileViews.FlushInterval <= 0 {
+		return
+	}
+
+	svc := service.NewProfileViewService(store, repo, userRepo)
+	c.ProfileViewService = svc
+
+	scheduleJob(c, scheduler.Job{
+		Name: jobProfileViewFlush,
+		Run: func(ctx context.Context) error {
+			flushed, err := svc.FlushViews(ctx)
+			if flushed > 0 {
+				slog.DebugContext(ctx, "flushed profile views", "profiles", flushed)
+			}
+
+			return err
+		},
+	}, c.Config.ProfileViews.FlushInterval)
+}
+
+// initRecentlyViewedService keeps the recently viewed profiles in the shared store, so the list
+// is the same whichever instance served the views. It is off without Redis or a limit.
+func initRecentlyViewedService(c *Container, userRepo repository.UserRepository) {
+	var store repository.RecentlyViewedStore
+	if c.memory != nil {
+		store = c.memory
+	} else if redisService, ok := c.Cache.(*redis.Service); ok {
+		store = redisService
+	} else {
+		return
+	}
+
+	if c.Config == nil || c.Config.RecentlyViewed.Limit <= 0 {
+		return
+	}
+
+	c.RecentlyViewedService = service.NewRecentlyViewedService(store, userRepo, service.RecentlyViewedOptions{
+		Limit:     c.Config.RecentlyViewed.Limit,
+		Retention: c.Config.RecentlyViewed.Retention,
+	})
+}
+
+// initDeviceTokenService keeps the devices registered for push notifications and, when
+// configured, periodically removes the stale ones.
+func initDeviceTokenService(c *Container, cfg ContainerConfig, preferenceRepo repository.PreferenceRepository) {
+	var devices repository.DeviceTokenRepository
+
+	if cfg.DeviceTokenRepo != nil {
+		devices = cfg.DeviceTokenRepo
+	} else if c.memory != nil {
+		devices = c.memory
+	} else if dbService, ok := c.Database.(*database.Service); ok {
+		devices = repository.NewDeviceTokenRepository(dbService.GetDB())
+	}
+
+	if devices == nil || preferenceRepo == nil {
+		return
+	}
+
+	var pushCfg config.PushConfig
+	if c.Config != nil {
+		pushCfg = c.Config.Push
+	}
+
+	svc := service.NewDeviceTokenService(devices, preferenceRepo, pushCfg.MaxDevicesPerUser, pushCfg.StaleAfter)
+	c.DeviceTokenService = svc
+
+	if pushCfg.StaleAfter <= 0 {
+		return
+	}
+
+	scheduleJob(c, scheduler.Job{
+		Name: jobDeviceCleanup,
+		Run: func(ctx context.Context) error {
+			deleted, err := svc.PruneStaleDevices(ctx)
+			if deleted > 0 {
+				slog.InfoContext(ctx, "pruned stale devices", "count", deleted)
+			}
+
+			return err
+		},
+	}, pushCfg.CleanupInterval)
+}
+
+// initPolicyService tracks acceptance of the terms and privacy policy versions declared in config.
+func initPolicyService(c *Container, cfg ContainerConfig) {
+	var repo repository.PolicyAcceptanceRepository
+
+	if cfg.PolicyRepo != nil {
+		repo = cfg.PolicyRepo
+	} else if c.memory != nil {
+		repo = c.memory
+	} else if dbService, ok := c.Database.(*database.Service); ok {
+		repo = repository.NewPolicyAcceptanceRepository(dbService.GetDB())
+	}
+
+	if repo == nil || c.Config == nil {
+		return
+	}
+
+	c.PolicyService = service.NewPolicyService(repo, map[dto.PolicyDocument]string{
+		dto.PolicyDocumentTerms:   c.Config.Policies.TermsVersion,
+		dto.PolicyDocumentPrivacy: c.Config.Policies.PrivacyVersion,
+	})
+}
+
+func initEmailChangeStore(c *Container, cfg ContainerConfig) repository.EmailChangeStore {
+	if cfg.EmailChangeStore != nil {
+		return cfg.EmailChangeStore
+	}
+
+	if c.memory != nil {
+		return c.memory
+	}
+
+	if redisService, ok := c.Cache.(*redis.Service); ok {
+		return redisService
+	}
+
+	return nil
+}
+
+func initHandleService(c *Container, cfg ContainerConfig) {
+	if c.UserService == nil {
+		return
+	}
+
+	var handleRepo repository.HandleRepository
+
+	if cfg.HandleRepo != nil {
+		handleRepo = cfg.HandleRepo
+	} else if c.memory != nil {
+		handleRepo = c.memory
+	} else if dbService, ok := c.Database.(*database.Service); ok {
+		handleRepo = repository.NewHandleRepository(dbService.GetDB())
+	}
+
+	if handleRepo == nil {
+		return
+	}
+
+	var reservations repository.HandleReservationStore
+	if c.memory != nil {
+		reservations = c.memory
+	} else if redisService, ok := c.Cache.(*redis.Service); ok {
+		reservations = redisService
+	}
+
+	c.HandleService = service.NewHandleService(handleRepo, reservations, c.UserService)
+}
+
+func initProfileShareService(c *Container, userRepo repository.UserRepository) {
+	if userRepo == nil {
+		return
+	}
+
+	var store repository.ProfileShareStore
+	if c.memory != nil {
+		store = c.memory
+	} else if redisService, ok := c.Cache.(*redis.Service); ok {
+		store = redisService
+	}
+
+	c.ProfileShareService = service.NewProfileShareService(userRepo, store, signingKey(c.Config, "profile-share-token"))
+}
+
+// initPreferenceCenterService serves the preference center and unsubscribe links sent in emails,
+// through the preference service so consent rules apply. Unsubscribe links are only served when
+// a public base URL is configured for them.
+func initPreferenceCenterService(c *Container, cfg ContainerConfig) {
+	if c.PreferenceService == nil {
+		return
+	}
+
+	links := cfg.PrefCenterRepo
+
+	if links == nil {
+		if c.memory != nil {
+			links = c.memory
+		} else if dbService, ok := c.Database.(*database.Service); ok {
+			links = repository.NewPreferenceCenterRepository(dbService.GetDB())
+		}
+	}
+
+	if links == nil {
+		return
+	}
+
+	var ttl time.Duration
+	if c.Config != nil {
+		ttl = c.Config.PreferenceCenter.TokenTTL
+	}
+
+	c.PreferenceCenterService = service.NewPreferenceCenterService(links, c.PreferenceService,
+		signingKey(c.Config, "preference-center"), ttl)
+
+	if c.Config == nil || c.Config.ListUnsubscribe.BaseURL == "" {
+		return
+	}
+
+	c.ListUnsubscribeService = service.NewListUnsubscribeService(links, c.PreferenceService,
+		signingKey(c.Config, "list-unsubscribe"), service.ListUnsubscribeOptions{
+			BaseURL: c.Config.ListUnsubscribe.BaseURL,
+			Mailto:  c.Config.ListUnsubscribe.Mailto,
+			TTL:     c.Config.ListUnsubscribe.TokenTTL,
+		})
+}
+
+// privacyDefaultsVersion returns the privacy defaults version new users are provisioned under, or
+// 0 to keep the original defaults without recording a version.
+func privacyDefaultsVersion(c *Container) int {
+	if c.Config == nil {
+		return 0
+	}
+
+	return c.Config.Preferences.PrivacyDefaultsVersion
+}
+
+// initPrivacyDefaultsService reports and migrates the privacy defaults versions users were
+// provisioned under, when the preference repository records them.
+func initPrivacyDefaultsService(c *Container, preferenceRepo repository.PreferenceRepository) {
+	repo, ok := preferenceRepo.(repository.PrivacyDefaultsRepository)
+	if !ok {
+		return
+	}
+
+	c.PrivacyDefaultsService = service.NewPrivacyDefaultsService(repo, privacyDefaultsVersion(c),
+		c.NotificationClient)
+}
+
+// initAccountRecoveryService builds account recovery over the storage backend's lookup of
+// deactivated accounts by email.
+func initAccountRecoveryService(c *Container, userRepo repository.UserRepository) {
+	if userRepo == nil {
+		return
+	}
+
+	var recoveryRepo repository.AccountRecoveryRepository
+	if c.memory != nil {
+		recoveryRepo = c.memory
+	} else if dbService, ok := c.Database.(*database.Service); ok {
+		recoveryRepo = repository.NewUserRepository(dbService.GetDB())
+	} else {
+		return
+	}
+
+	var store repository.AccountRecoveryStore
+	if c.memory != nil {
+		store = c.memory
+	} else if redisService, ok := c.Cache.(*redis.Service); ok {
+		store = redisService
+	}
+
+	c.AccountRecoveryService = service.NewAccountRecoveryService(userRepo, recoveryRepo, store, c.NotificationClient)
+}
+
+// initPrivacyReportService builds the privacy report from the user, social and change log
+// repositories, with the data access log when one is available.
+func initPrivacyReportService(
+	c *Container,
+	cfg ContainerConfig,
+	userRepo repository.UserRepository,
+	socialRepo repository.SocialRepository,
+) {
+	if cfg.DataAccessRepo != nil {
+		c.DataAccessRepo = cfg.DataAccessRepo
+	} else if c.memory != nil {
+		c.DataAccessRepo = c.memory
+	} else if dbService, ok := c.Database.(*database.Service); ok {
+		c.DataAccessRepo = repository.NewDataAccessRepository(dbService.GetDB())
+	}
+
+	changeLogRepo := initChangeLogRepository(c, cfg)
+
+	if userRepo == nil || socialRepo == nil || changeLogRepo == nil {
+		return
+	}
+
+	c.PrivacyReportService = service.NewPrivacyReportService(userRepo, socialRepo, changeLogRepo, c.DataAccessRepo)
+}
+
+// initUserDetailsService composes the user details service from the user, social and preference
+// services. Without a preference service the privacy expansion is omitted.
+func initUserDetailsService(c *Container) {
+	if c.UserService == nil || c.SocialService == nil {
+		return
+	}
+
+	c.UserDetailsService = service.NewUserDetailsService(c.UserService, c.SocialService, c.PreferenceService)
+}
+
+// codeHasher returns the argon2id hasher for confirmation codes, with the recommended parameters
+// when none are configured.
+func codeHasher(cfg *config.Config) *crypto.Hasher {
+	if cfg == nil || cfg.Hashing == (config.HashingConfig{}) {
+		return crypto.NewHasher(crypto.DefaultArgon2Params())
+	}
+
+	return crypto.NewHasher(crypto.Argon2Params{
+		Time:      uint32(cfg.Hashing.Time),      //nolint:gosec // validated positive
+		MemoryKiB: uint32(cfg.Hashing.MemoryKiB), //nolint:gosec // validated positive
+		Threads:   uint8(cfg.Hashing.Threads),    //nolint:gosec // validated between 1 and 255
+	})
+}
+
+// contentModerator builds the moderator checking profile text, or returns nil if content
+// moderation is off or its word list cannot be read.
+func contentModerator(cfg *config.Config) contentmod.Moderator {
+	if cfg == nil || !cfg.ContentModeration.Enabled {
+		return nil
+	}
+
+	moderationCfg := cfg.ContentModeration
+
+	words := contentmod.NewWordList(nil)
+
+	if moderationCfg.WordListFile != "" {
+		loaded, err := contentmod.LoadWordList(moderationCfg.WordListFile)
+		if err != nil {
+			slog.Warn("content moderation disabled", "error", err)
+
+			return nil
+		}
+
+		words = loaded
+	}
+
+	if moderationCfg.Provider != "api" {
+		slog.Info("content moderation enabled", "provider", "wordlist", "words", words.Len(),
+			"action", moderationCfg.Action)
+
+		return words
+	}
+
+	slog.Info("content moderation enabled", "provider", "api", "fallback_words", words.Len(),
+		"action", moderationCfg.Action)
+
+	return contentmod.NewAPIModerator(contentmod.APIConfig{
+		URL:              moderationCfg.API.URL,
+		APIKey:           moderationCfg.API.APIKey,
+		Timeout:          moderationCfg.API.Timeout,
+		FailureThreshold: moderationCfg.API.FailureThreshold,
+		Cooldown:         moderationCfg.API.Cooldown,
+		Fallback:         words,
+	})
+}
+
+// signingKey derives the signing key for purpose from the JWT secret so what it signs stays valid
+// across replicas. Without a secret a per-process key is used and signatures do not survive restarts.
+func signingKey(cfg *config.Config, purpose string) []byte {
+	if cfg != nil && cfg.OAuth2.JWTSecret != "" {
+		return signedtoken.DeriveKey([]byte(cfg.OAuth2.JWTSecret), purpose)
+	}
+
+	slog.Warn("no jwt secret configured; signatures will not survive restarts", "purpose", purpose)
+
+	key := make([]byte, sha256.Size)
+	_, _ = rand.Read(key)
+
+	return key
+}
+
+func initChangeLogRepository(c *Container, cfg ContainerConfig) repository.ChangeLogRepository {
+	if cfg.ChangeLogRepo != nil {
+		return cfg.ChangeLogRepo
+	}
+
+	if c.memory != nil {
+		return c.memory
+	}
+
+	if dbService, ok := c.Database.(*database.Service); ok {
+		return repository.NewChangeLogRepository(dbService.GetDB())
+	}
+
+	return nil
+}
+
+func initChangeFeedService(c *Container, cfg ContainerConfig) {
+	if changeLogRepo := initChangeLogRepository(c, cfg); changeLogRepo != nil {
+		c.ChangeFeedService = service.NewChangeFeedService(changeLogRepo)
+	}
+}
+
+func initMetricsService(c *Container) {
+	dbService, ok := c.Database.(*database.Service)
+	if !ok || dbService == nil {
+		return
+	}
+
+	var redisClient service.RedisClient
+	if rc, ok := c.Cache.(service.RedisClient); ok {
+		redisClient = rc
+	}
+
+	systemCollector := service.NewSystemCollector()
+	c.MetricsService = service.NewMetricsService(dbService, redisClient, systemCollector, c.Config)
+}
+
+// Close cleanly shuts down all dependencies.
+func (c *Container) Close() error {
+	var errs []error
+
+	if c.stopSecrets != nil {
+		c.stopSecrets()
+	}
+
+	if c.scheduler != nil {
+		c.scheduler.Stop()
+	}
+
+	// Deliver the errors reported while shutting down
+	if sentry, ok := c.ErrorReporter.(*errortracking.SentryReporter); ok {
+		ctx, cancel := context.WithTimeout(context.Background(), c.Config.ErrorTracking.Timeout)
+		sentry.Flush(ctx)
+		cancel()
+	}
+
+	// Close TokenManager first (depends on OAuth2Client)
+	if c.TokenManager != nil {
+		c.TokenManager.Close()
+	}
+
+	if c.Database != nil {
+		err := c.Database.Close()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("database close: %w", err))
+		}
+	}
+
+	if c.Cache != nil {
+		err := c.Cache.Close()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cache close: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func initAdminService(c *Container) {
+	var redisClient service.RedisCacheClient
+	if rc, ok := c.Cache.(service.RedisCacheClient); ok {
+		redisClient = rc
+	}
+
+	c.AdminService = service.NewAdminService(redisClient)
+}
+
+func initStatsService(c *Container, cfg ContainerConfig) {
+	var statsRepo repository.StatsRepository
+
+	if cfg.StatsRepo != nil {
+		statsRepo = cfg.StatsRepo
+	} else if c.memory != nil {
+		statsRepo = c.memory
+	} else if dbService, ok := c.Database.(*database.Service); ok {
+		statsRepo = repository.NewStatsRepository(dbService.GetDB())
+	}
+
+	if statsRepo == nil {
+		return
+	}
+
+	var cacheTTL time.Duration
+	if c.Config != nil {
+		cacheTTL = c.Config.Admin.StatsCacheTTL
+	}
+
+	c.StatsService = service.NewStatsService(statsRepo, cacheTTL)
+}
+
+func initOAuth2(c *Container, cfg ContainerConfig) {
+	if cfg.Config == nil || !cfg.Config.OAuth2.Enabled {
+		return
+	}
+
+	// Initialize OAuth2 client for token introspection
+	c.OAuth2Client = oauth2.NewOAuth2Client(&cfg.Config.OAuth2)
+
+	// Initialize TokenManager for service-to-service authentication
+	// Include notification:admin scope for notification service calls
+	if cfg.Config.OAuth2.ServiceEnabled {
+		c.TokenManager = oauth2.NewTokenManager(c.OAuth2Client, []string{
+			"user:read",
+			"user:write",
+			"notification:admin",
+		})
+	}
+}
+
+// initErrorTracking sets up the Sentry reporter when error tracking is enabled, and one that drops
+// every event otherwise.
+func initErrorTracking(c *Container) error {
+	if c.Config == nil || !c.Config.ErrorTracking.Enabled {
+		c.ErrorReporter = errortracking.NoopReporter{}
+
+		return nil
+	}
+
+	reporter, err := errortracking.NewSentryReporter(errortracking.SentryConfig{
+		DSN:         c.Config.ErrorTracking.DSN,
+		Release:     c.Config.ErrorTracking.Release,
+		Environment: c.Config.Environment,
+		Timeout:     c.Config.ErrorTracking.Timeout,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set up error tracking: %w", err)
+	}
+
+	c.ErrorReporter = reporter
+
+	return nil
+}
+
+func initIPFilter(c *Container) error {
+	if c.Config == nil {
+		return nil
+	}
+
+	trusted, err := middleware.ParseIPPrefixes(c.Config.IPFilter.TrustedProxies)
+	if err != nil {
+		return fmt.Errorf("ipfilter.trusted_proxies: %w", err)
+	}
+
+	c.TrustedProxies = trusted
+
+	if !c.Config.IPFilter.Enabled {
+		return nil
+	}
+
+	rules, err := IPFilterRules(c.Config.IPFilter)
+	if err != nil {
+		return err
+	}
+
+	c.IPFilter = middleware.NewIPFilter(rules)
+
+	return nil
+}
+
+// IPFilterRules converts the configured IP filter lists into middleware rules.
+func IPFilterRules(cfg config.IPFilterConfig) (middleware.IPFilterRules, error) {
+	trusted, err := middleware.ParseIPPrefixes(cfg.TrustedProxies)
+	if err != nil {
+		return middleware.IPFilterRules{}, fmt.Errorf("ipfilter.trusted_proxies: %w", err)
+	}
+
+	rules := middleware.IPFilterRules{TrustedProxies: trusted, Groups: map[string]middleware.IPRuleSet{}}
+
+	for group, lists := range map[string]config.IPFilterRulesConfig{
+		middleware.IPFilterGroupAdmin:    cfg.Admin,
+		middleware.IPFilterGroupInternal: cfg.Internal,
+	} {
+		allow, err := middleware.ParseIPPrefixes(lists.Allow)
+		if err != nil {
+			return middleware.IPFilterRules{}, fmt.Errorf("ipfilter.%s.allow: %w", group, err)
+		}
+
+		deny, err := middleware.ParseIPPrefixes(lists.Deny)
+		if err != nil {
+			return middleware.IPFilterRules{}, fmt.Errorf("ipfilter.%s.deny: %w", group, err)
+		}
+
+		if len(allow) > 0 || len(deny) > 0 {
+			rules.Groups[group] = middleware.IPRuleSet{Allow: allow, Deny: deny}
+		}
+	}
+
+	return rules, nil
+}
+
 func initNotification(c *Container, cfg ContainerConfig) {
 	if cfg.Config == nil || !cfg.Config.DownstreamServices.Notification.Enabled {
 		c.NotificationClient = &notification.NoopClient{}
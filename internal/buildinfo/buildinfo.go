@@ -0,0 +1,89 @@
+// Package buildinfo identifies the running build, so logs, metrics and the version endpoint can be
+// matched to a deployed release. The release fields are set at link time:
+//
+//	go build -ldflags "-X <module>/internal/buildinfo.Version=1.4.0 \
+//	  -X <module>/internal/buildinfo.GitSHA=$(git rev-parse HEAD) \
+//	  -X <module>/internal/buildinfo.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// A build without them falls back to the revision and commit time the Go toolchain stamps into
+// binaries built from a git checkout.
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Set with -ldflags "-X ...". Left empty, Get reports what the toolchain knows instead.
+var (
+	Version   string
+	GitSHA    string
+	BuildDate string
+)
+
+// unknown is reported for a field that neither the linker nor the toolchain supplied.
+const unknown = "unknown"
+
+// Info describes the running build.
+type Info struct {
+	Version   string
+	GitSHA    string
+	BuildDate string
+	GoVersion string
+	OS        string
+	Arch      string
+}
+
+// Get returns the running build's info.
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		GitSHA:    GitSHA,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+	}
+
+	if build, ok := debug.ReadBuildInfo(); ok {
+		applyBuildSettings(&info, build)
+	}
+
+	if info.Version == "" {
+		info.Version = "dev"
+	}
+
+	if info.GitSHA == "" {
+		info.GitSHA = unknown
+	}
+
+	if info.BuildDate == "" {
+		info.BuildDate = unknown
+	}
+
+	return info
+}
+
+// applyBuildSettings fills the fields the linker left empty from the toolchain's VCS stamp. A
+// revision built with uncommitted changes is marked dirty.
+func applyBuildSettings(info *Info, build *debug.BuildInfo) {
+	settings := make(map[string]string, len(build.Settings))
+	for _, setting := range build.Settings {
+		settings[setting.Key] = setting.Value
+	}
+
+	if info.GitSHA == "" && settings["vcs.revision"] != "" {
+		info.GitSHA = settings["vcs.revision"]
+		if settings["vcs.modified"] == "true" {
+			info.GitSHA += "-dirty"
+		}
+	}
+
+	if info.BuildDate == "" {
+		info.BuildDate = settings["vcs.time"]
+	}
+
+	if info.Version == "" && build.Main.Version != "" && build.Main.Version != "(devel)" {
+		info.Version = build.Main.Version
+	}
+}
@@ -0,0 +1,50 @@
+package buildinfo
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyBuildSettings(t *testing.T) {
+	t.Parallel()
+
+	build := &debug.BuildInfo{
+		Main: debug.Module{Version: "(devel)"},
+		Settings: []debug.BuildSetting{
+			{Key: "vcs.revision", Value: "abc123"},
+			{Key: "vcs.time", Value: "2026-10-01T12:00:00Z"},
+			{Key: "vcs.modified", Value: "true"},
+		},
+	}
+
+	t.Run("fills what the linker left empty", func(t *testing.T) {
+		t.Parallel()
+
+		info := Info{}
+		applyBuildSettings(&info, build)
+
+		assert.Equal(t, Info{GitSHA: "abc123-dirty", BuildDate: "2026-10-01T12:00:00Z"}, info)
+	})
+
+	t.Run("keeps linker values", func(t *testing.T) {
+		t.Parallel()
+
+		info := Info{Version: "1.4.0", GitSHA: "def456", BuildDate: "2026-10-02T08:00:00Z"}
+		applyBuildSettings(&info, build)
+
+		assert.Equal(t, Info{Version: "1.4.0", GitSHA: "def456", BuildDate: "2026-10-02T08:00:00Z"}, info)
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	info := Get()
+
+	assert.NotEmpty(t, info.Version)
+	assert.NotEmpty(t, info.GitSHA)
+	assert.NotEmpty(t, info.BuildDate)
+	assert.NotEmpty(t, info.GoVersion)
+}
@@ -8,6 +8,9 @@ import (
 	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/crypto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
 )
 
 // Default timeout for downstream service HTTP clients.
@@ -22,14 +25,105 @@ type Config struct {
 	Redis              RedisConfig
 	OAuth2             OAuth2Config
 	DownstreamServices DownstreamServicesConfig
+	RateLimit          RateLimitConfig
+	Secrets            SecretsConfig
+	Diagnostics        DiagnosticsConfig
+	Shadow             ShadowConfig
+	Storage            StorageConfig
+	Admin              AdminConfig
+	Policies           PoliciesConfig
+	Push               PushConfig
+	Social             SocialConfig
+	Invitations        InvitationsConfig
+	PreferenceCenter   PreferenceCenterConfig
+	ListUnsubscribe    ListUnsubscribeConfig
+	Search             SearchConfig
+	Maintenance        MaintenanceConfig
+	Jobs               JobsConfig
+	Presence           PresenceConfig
+	ProfileViews       ProfileViewsConfig
+	RecentlyViewed     RecentlyViewedConfig
+	Badges             BadgesConfig
+	Mentions           MentionsConfig
+	Embed              EmbedConfig
+	Directory          DirectoryConfig
+	Preferences        PreferencesConfig
+	Events             EventsConfig
+	ErrorTracking      ErrorTrackingConfig
+	Hashing            HashingConfig
+	IPFilter           IPFilterConfig
+	RequestSigning     RequestSigningConfig
+	ContentModeration  ContentModerationConfig
+	Profile            ProfileConfig
+	PublicProfiles     PublicProfilesConfig
+	Readiness          ReadinessConfig
 }
 
 type ServerConfig struct {
-	Port         int
+	Port int
+	// BasePath prefixes every public API route, e.g. /api/v1/user-management.
+	BasePath string `mapstructure:"base_path"`
+	// ServiceName identifies this deployment in health responses and metrics.
+	ServiceName  string `mapstructure:"service_name"`
 	Timeout      time.Duration
 	IdleTimeout  time.Duration
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+	// DrainPeriod is how long readiness fails before shutdown while requests are still served, so
+	// load balancers stop routing to the instance first. Zero shuts down right away.
+	DrainPeriod time.Duration `mapstructure:"drain_period"`
+	// ShutdownTimeout bounds how long in-flight requests get to finish once draining is over.
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
+	TLS             TLSConfig     `mapstructure:"tls"`
+	// Listeners, when set, replaces the single TCP listener on Port.
+	Listeners       []ListenerConfig      `mapstructure:"listeners"`
+	SecurityHeaders SecurityHeadersConfig `mapstructure:"security_headers"`
+}
+
+// SecurityHeadersConfig controls the security headers sent with every response.
+type SecurityHeadersConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// ContentSecurityPolicy is sent with API responses, which are never meant to render.
+	ContentSecurityPolicy string `mapstructure:"content_security_policy"`
+	// HSTSMaxAge is the Strict-Transport-Security max-age, sent only when TLS is enabled.
+	HSTSMaxAge time.Duration `mapstructure:"hsts_max_age"`
+	// DocsPath and DocsContentSecurityPolicy relax the policy for the Swagger UI, which needs
+	// scripts, styles and images.
+	DocsPath                  string `mapstructure:"docs_path"`
+	DocsContentSecurityPolicy string `mapstructure:"docs_content_security_policy"`
+	// Overrides replace headers for routes under a path prefix; an empty value drops the header.
+	Overrides []SecurityHeaderOverrideConfig `mapstructure:"overrides"`
+}
+
+// SecurityHeaderOverrideConfig replaces security headers for one route prefix.
+type SecurityHeaderOverrideConfig struct {
+	PathPrefix string            `mapstructure:"path_prefix"`
+	Headers    map[string]string `mapstructure:"headers"`
+}
+
+// ListenerConfig describes one address the server accepts connections on.
+type ListenerConfig struct {
+	// Network is "tcp" or "unix".
+	Network string `mapstructure:"network"`
+	// Address is host:port for tcp or a socket path for unix.
+	Address string `mapstructure:"address"`
+	// SocketMode is the octal file mode applied to a unix socket, e.g. "0660". Empty keeps the umask default.
+	SocketMode string `mapstructure:"socket_mode"`
+}
+
+// TLSConfig configures HTTPS termination in the server.
+type TLSConfig struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
+	// MinVersion is "1.2" or "1.3".
+	MinVersion string `mapstructure:"min_version"`
+	// CipherSuites lists IANA suite names (e.g. TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256) allowed for
+	// TLS 1.2. Empty uses Go's defaults; TLS 1.3 suites are not configurable.
+	CipherSuites []string `mapstructure:"cipher_suites"`
+	HTTP2        bool     `mapstructure:"http2"`
+	// RedirectPort, when non-zero, serves a plain HTTP listener that redirects to HTTPS.
+	RedirectPort int `mapstructure:"redirect_port"`
 }
 
 type CorsConfig struct {
@@ -52,6 +146,8 @@ type LoggingConfig struct {
 	MaxBackups     int
 	MaxAge         int // days
 	Compress       bool
+	// LogSensitiveData logs personal data and secrets unredacted. Allowed in development only.
+	LogSensitiveData bool
 }
 
 type PostgresConfig struct {
@@ -77,6 +173,20 @@ type RedisConfig struct {
 	WriteTimeout time.Duration
 	PoolSize     int
 	MinIdleConns int
+	// KeyNamespace prefixes every key with the service name, environment and Tenant, so
+	// deployments can share a database. Keys written before it was turned on must be moved with
+	// the migrate-redis-keys command.
+	KeyNamespace bool
+	// Tenant is added to the key namespace when set.
+	Tenant string
+	// PruneInterval is how often the redis-prune job removes orphaned keys, those matching no live
+	// key schema, under PrunePrefixes. Zero turns the job off.
+	PruneInterval time.Duration
+	// PrunePrefixes are the key prefixes, within the namespace, the prune job scans. A prefix of
+	// no family the service still writes has all its keys removed.
+	PrunePrefixes []string
+	// PruneDryRun counts orphaned keys without removing them.
+	PruneDryRun bool
 }
 
 type OAuth2Config struct {
@@ -92,6 +202,477 @@ type OAuth2Config struct {
 	IntrospectionPath    string `mapstructure:"introspectionpath"`
 }
 
+// RateLimitConfig configures request throttling and anonymous access.
+type RateLimitConfig struct {
+	Enabled            bool          `mapstructure:"enabled"`
+	Window             time.Duration `mapstructure:"window"`
+	AuthenticatedLimit int           `mapstructure:"authenticated_limit"`
+	AnonymousLimit     int           `mapstructure:"anonymous_limit"`
+	// AnonymousDisabledGroups lists public route groups (e.g. "shared-profiles") that
+	// require authentication instead of allowing anonymous access.
+	AnonymousDisabledGroups []string `mapstructure:"anonymous_disabled_groups"`
+}
+
+// SecretsConfig selects an external secret store for database and cache credentials.
+type SecretsConfig struct {
+	// Provider is "" (credentials come from config/env only) or "vault".
+	Provider        string            `mapstructure:"provider"`
+	CacheTTL        time.Duration     `mapstructure:"cache_ttl"`
+	RefreshInterval time.Duration     `mapstructure:"refresh_interval"`
+	Vault           VaultSecretConfig `mapstructure:"vault"`
+}
+
+type VaultSecretConfig struct {
+	Address string        `mapstructure:"address"`
+	Token   string        `mapstructure:"token"`
+	Mount   string        `mapstructure:"mount"`
+	Path    string        `mapstructure:"path"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DiagnosticsConfig exposes pprof and runtime diagnostics endpoints.
+type DiagnosticsConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Port serves diagnostics on a separate internal listener without authentication.
+	// Zero mounts them on the main server under /debug, restricted to the admin scope.
+	Port int `mapstructure:"port"`
+	// Host is the address the internal listener binds to, loopback by default so profiles are
+	// only reachable from the host or through a port forward. Empty also means loopback.
+	Host string `mapstructure:"host"`
+	// SlowRequests writes a diagnostic bundle for requests over a threshold. It works whether or
+	// not the diagnostics endpoints are enabled.
+	SlowRequests SlowRequestsConfig `mapstructure:"slow_requests"`
+}
+
+// SlowRequestsConfig controls the bundles written for slow requests: the route, the SQL statements
+// run with their timings and, optionally, a goroutine dump.
+type SlowRequestsConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	Threshold     time.Duration `mapstructure:"threshold"`
+	Dir           string        `mapstructure:"dir"`
+	GoroutineDump bool          `mapstructure:"goroutine_dump"`
+	// MaxBundles and MaxAge bound what is kept in Dir; zero disables the limit.
+	MaxBundles int           `mapstructure:"max_bundles"`
+	MaxAge     time.Duration `mapstructure:"max_age"`
+	// MaxQueries caps the statements recorded per request.
+	MaxQueries int `mapstructure:"max_queries"`
+}
+
+// ShadowConfig mirrors a sample of read-only requests to a candidate implementation and logs
+// response differences, to verify refactors against production traffic.
+type ShadowConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	SamplePercent float64       `mapstructure:"sample_percent"`
+	Timeout       time.Duration `mapstructure:"timeout"`
+	MaxInFlight   int           `mapstructure:"max_in_flight"`
+	// IgnorePaths lists JSON paths (e.g. "$.timestamp") expected to differ between implementations.
+	IgnorePaths []string `mapstructure:"ignore_paths"`
+}
+
+// StorageConfig selects where users, follows and preferences are stored.
+type StorageConfig struct {
+	// Backend is "postgres" (PostgreSQL and Redis) or "memory" (in-process, no external dependencies).
+	Backend string `mapstructure:"backend"`
+	// FixturesFile optionally seeds the memory backend from a YAML or JSON fixture file.
+	FixturesFile string `mapstructure:"fixtures_file"`
+}
+
+// AdminConfig tunes the administrative endpoints.
+type AdminConfig struct {
+	// StatsCacheTTL is how long GET /admin/stats and follower insights results are reused. Zero
+	// disables caching.
+	StatsCacheTTL time.Duration `mapstructure:"stats_cache_ttl"`
+}
+
+// PoliciesConfig declares the current versions of the legal documents users accept.
+type PoliciesConfig struct {
+	// TermsVersion is the current terms of service version. Empty means none is published.
+	TermsVersion string `mapstructure:"terms_version"`
+	// PrivacyVersion is the current privacy policy version. Empty means none is published.
+	PrivacyVersion string `mapstructure:"privacy_version"`
+	// EnforceAcceptance rejects mutating requests from users who have not accepted the current
+	// versions with 428 Precondition Required.
+	EnforceAcceptance bool `mapstructure:"enforce_acceptance"`
+}
+
+// PushConfig limits the device tokens kept for push notifications.
+type PushConfig struct {
+	// MaxDevicesPerUser caps the registered devices per user; registering another removes the
+	// least recently seen one. Zero disables the limit.
+	MaxDevicesPerUser int `mapstructure:"max_devices_per_user"`
+	// StaleAfter is how long a device token is kept without being registered again. Zero keeps
+	// tokens until they are unregistered.
+	StaleAfter time.Duration `mapstructure:"stale_after"`
+	// CleanupInterval is how often stale device tokens are removed. Zero disables the cleanup.
+	CleanupInterval time.Duration `mapstructure:"cleanup_interval"`
+}
+
+// SocialConfig tunes the follow graph.
+type SocialConfig struct {
+	// FollowHistoryRetention is how long unfollowed edges are kept for the follow history. Zero keeps them forever.
+	FollowHistoryRetention time.Duration `mapstructure:"follow_history_retention"`
+	// FollowHistoryPurgeInterval is how often expired follow history is removed. Zero disables the purge.
+	FollowHistoryPurgeInterval time.Duration `mapstructure:"follow_history_purge_interval"`
+	// MaxFollowing is how many users an account may follow unless an admin overrides it. Zero is
+	// unlimited.
+	MaxFollowing int `mapstructure:"max_following"`
+	// DigestPeriod is how far back an activity digest looks, matching how often digests are sent.
+	DigestPeriod time.Duration `mapstructure:"digest_period"`
+}
+
+// InvitationsConfig controls the invitation codes users hand out.
+type InvitationsConfig struct {
+	// MaxPerUser caps the invitations each user may create. Zero is unlimited.
+	MaxPerUser int `mapstructure:"max_per_user"`
+	// TTL is how long an invitation stays redeemable.
+	TTL time.Duration `mapstructure:"ttl"`
+	// MutualFollow makes the inviter and the invitee follow each other when an invitation is
+	// redeemed.
+	MutualFollow bool `mapstructure:"mutual_follow"`
+}
+
+// PreferenceCenterConfig controls the links in emails that let recipients manage their emails
+// without signing in.
+type PreferenceCenterConfig struct {
+	// TokenTTL is how long a link stays valid after it is issued.
+	TokenTTL time.Duration `mapstructure:"token_ttl"`
+}
+
+// ListUnsubscribeConfig controls the List-Unsubscribe header payloads handed to the services
+// sending emails. Empty BaseURL turns the feature off.
+type ListUnsubscribeConfig struct {
+	// BaseURL is the public HTTPS URL of this service's API, e.g.
+	// https://recipes.example.com/api/v1/user-management, that one-click unsubscribes are posted to.
+	BaseURL string `mapstructure:"base_url"`
+	// Mailto is the address emailed unsubscribe requests go to. Empty leaves mailto out.
+	Mailto string
+	// TokenTTL is how long an unsubscribe link stays valid after it is issued.
+	TokenTTL time.Duration `mapstructure:"token_ttl"`
+}
+
+// SearchConfig tunes user search.
+type SearchConfig struct {
+	// Personalized ranks users the requester follows, then their second-degree connections, above
+	// strangers in search results.
+	Personalized bool `mapstructure:"personalized"`
+	// TypeaheadCacheTTL is how long username typeahead results are reused. Zero disables caching.
+	TypeaheadCacheTTL time.Duration `mapstructure:"typeahead_cache_ttl"`
+	// TypeaheadSource is "postgres" (the username prefix index), "index" (the Redis search index)
+	// or "verify" (serve postgres and compare every lookup with the search index).
+	TypeaheadSource string `mapstructure:"typeahead_source"`
+	// ReindexInterval is how often the search index is rebuilt from PostgreSQL while it is read.
+	// Zero disables scheduled rebuilds.
+	ReindexInterval time.Duration `mapstructure:"reindex_interval"`
+	// ReindexRate caps the users copied into the search index per second. Zero is unlimited.
+	ReindexRate int `mapstructure:"reindex_rate"`
+	// ResultsCacheTTL is how long the results of searches that are not personalized are reused.
+	// Zero disables caching.
+	ResultsCacheTTL time.Duration `mapstructure:"results_cache_ttl"`
+}
+
+// MaintenanceConfig controls maintenance mode, during which mutating endpoints return 503 while
+// reads stay available.
+type MaintenanceConfig struct {
+	// Enabled forces maintenance mode on; PUT /admin/maintenance switches it at runtime otherwise.
+	Enabled bool `mapstructure:"enabled"`
+	// Message is returned with rejected writes unless the runtime switch sets its own.
+	Message string `mapstructure:"message"`
+	// RetryAfter is sent as the Retry-After header of rejected writes. Zero omits it.
+	RetryAfter time.Duration `mapstructure:"retry_after"`
+	// RefreshInterval is how long an instance reuses the runtime switch before reading it from
+	// Redis again. Zero reads it on every write.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+// PresenceConfig controls the online status and last seen time kept from heartbeats.
+type PresenceConfig struct {
+	// OnlineWindow is how long after their last heartbeat a user is still shown as online.
+	OnlineWindow time.Duration `mapstructure:"online_window"`
+	// Retention is how long the last seen time is kept in Redis; users not seen within it are
+	// shown as offline without a last seen time.
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+// ProfileViewsConfig controls the profile view counter.
+type ProfileViewsConfig struct {
+	// FlushInterval is how often the views counted in Redis are added to the database. Zero turns
+	// the counter off.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+}
+
+// RecentlyViewedConfig controls the list of profiles each user recently viewed.
+type RecentlyViewedConfig struct {
+	// Limit is how many profiles the list keeps. Zero turns the list off.
+	Limit int `mapstructure:"limit"`
+	// Retention is how long the list is kept in Redis after the user last viewed a profile.
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+// BadgesConfig controls the badges users earn and when they are awarded.
+type BadgesConfig struct {
+	// Definitions are the badges users can earn. Removing one hides it from those who earned it.
+	Definitions []BadgeDefinitionConfig `mapstructure:"definitions"`
+	// EvaluationInterval is how often the users with new badge events are evaluated. Zero
+	// evaluates each event as it is reported.
+	EvaluationInterval time.Duration `mapstructure:"evaluation_interval"`
+	// SweepInterval is how often users are awarded the membership badges they reached. Zero
+	// disables the sweep.
+	SweepInterval time.Duration `mapstructure:"sweep_interval"`
+}
+
+// MentionsConfig controls how other services resolve @username mentions.
+type MentionsConfig struct {
+	// MaxUsernames caps the usernames resolved in one request.
+	MaxUsernames int `mapstructure:"max_usernames"`
+	// CacheTTL is how long a resolved username is reused, so renames, deactivations and privacy
+	// changes show up once it expires. Zero disables caching.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+// EmbedConfig controls the link preview metadata of public profiles.
+type EmbedConfig struct {
+	// ProfileURL and AvatarURL are templates for the profile page and avatar image links in embeds,
+	// with {userId} and {username} placeholders. Empty leaves the link out.
+	ProfileURL string `mapstructure:"profile_url"`
+	AvatarURL  string `mapstructure:"avatar_url"`
+	// CacheTTL is how long an embed is served from cache, both by this service and by the crawlers
+	// that read it. Zero disables caching.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+// DirectoryConfig controls the sync of full names, emails and active flags from an LDAP or
+// Active Directory server, for the accounts an admin linked to a directory entry.
+type DirectoryConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the server, as ldap://host[:port] or ldaps://host[:port].
+	URL string `mapstructure:"url"`
+	// StartTLS upgrades ldap:// connections to TLS before binding, which a bind password over
+	// ldap:// requires.
+	StartTLS bool `mapstructure:"start_tls"`
+	// BindDN and BindPassword authenticate the sync; an empty BindDN searches anonymously.
+	BindDN       string `mapstructure:"bind_dn"`
+	BindPassword string `mapstructure:"bind_password"`
+	// BaseDN is where entries are searched, by LookupAttribute equal to the linked external ID.
+	BaseDN          string                    `mapstructure:"base_dn"`
+	LookupAttribute string                    `mapstructure:"lookup_attribute"`
+	Attributes      DirectoryAttributesConfig `mapstructure:"attributes"`
+	Rules           DirectoryRulesConfig      `mapstructure:"rules"`
+	Timeout         time.Duration             `mapstructure:"timeout"`
+	// SyncInterval is how often every linked account is synced. Zero only syncs on demand.
+	SyncInterval time.Duration `mapstructure:"sync_interval"`
+}
+
+// DirectoryAttributesConfig names the directory attributes read for each synced value. An empty
+// name leaves the value alone.
+type DirectoryAttributesConfig struct {
+	FullName string `mapstructure:"full_name"`
+	Email    string `mapstructure:"email"`
+	// Active is the attribute telling whether the account is enabled. userAccountControl is read
+	// as Active Directory flags; any other attribute is inactive when it holds one of
+	// InactiveValues.
+	Active         string   `mapstructure:"active"`
+	InactiveValues []string `mapstructure:"inactive_values"`
+}
+
+// DirectoryRulesConfig sets, per attribute, whether the directory value always wins
+// ("directory") or a value changed locally since the last sync is kept ("local").
+type DirectoryRulesConfig struct {
+	FullName string `mapstructure:"full_name"`
+	Email    string `mapstructure:"email"`
+	IsActive string `mapstructure:"is_active"`
+}
+
+// BadgeDefinitionConfig is a badge earned once Metric ("recipes", "followers" or
+// "membership_days") reaches Threshold.
+type BadgeDefinitionConfig struct {
+	ID          string `mapstructure:"id"`
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	Metric      string `mapstructure:"metric"`
+	Threshold   int    `mapstructure:"threshold"`
+}
+
+// JobsConfig controls the background jobs (stale device cleanup, follow history purge, search
+// reindex, badge evaluation, directory sync), which take a lease in Redis so only one instance
+// runs each at a time.
+type JobsConfig struct {
+	// LockTTL is how long a lease outlives an instance that stops renewing it, e.g. after a crash.
+	LockTTL time.Duration `mapstructure:"lock_ttl"`
+	// Schedules sets when a job runs, by job name, as a cron expression ("0 3 * * *") or a
+	// descriptor ("@daily", "@every 15m"). Jobs without one run every interval set for them in
+	// their own section.
+	Schedules map[string]string `mapstructure:"schedules"`
+}
+
+// PreferencesConfig tunes the preference backfill, which writes explicit default rows for users
+// who never saved a category. It only runs when an admin starts it, unless
+// jobs.schedules.preference-backfill is set.
+type PreferencesConfig struct {
+	// BackfillBatchSize is how many users are backfilled per transaction.
+	BackfillBatchSize int `mapstructure:"backfill_batch_size"`
+	// BackfillRate caps the users backfilled per second. Zero is unlimited.
+	BackfillRate int `mapstructure:"backfill_rate"`
+	// PrivacyDefaultsVersion is the privacy defaults version users are provisioned under the first
+	// time their privacy preferences are read or written. Users already provisioned keep theirs.
+	PrivacyDefaultsVersion int `mapstructure:"privacy_defaults_version"`
+	// DisabledCategories lists preference categories this deployment does not use (e.g. "sound").
+	// Their routes return 404 and the bulk endpoints leave them out. Privacy cannot be disabled.
+	DisabledCategories []string `mapstructure:"disabled_categories"`
+	// Storage is how categories other than privacy are stored: "tables" keeps each in its own table,
+	// "jsonb" keeps them as documents in one table. make migrate-preferences copies between them.
+	Storage string `mapstructure:"storage"`
+}
+
+// EventsConfig controls the domain event log: business events such as profile updates, follows
+// and preference changes, recorded apart from the request log for downstream ingestion.
+type EventsConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// File is where events are written as NDJSON, rotated like the service log file. Empty writes
+	// them to the service log under the "event" group.
+	File string `mapstructure:"file"`
+	// SampleRate is the fraction of events recorded, from 0 to 1.
+	SampleRate float64 `mapstructure:"sample_rate"`
+	// SampleRates overrides SampleRate by event name.
+	SampleRates map[string]float64 `mapstructure:"sample_rates"`
+}
+
+// ErrorTrackingConfig sends panics, 5xx responses and failed jobs to Sentry. Events are tagged
+// with the release and the configured environment.
+type ErrorTrackingConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	DSN     string `mapstructure:"dsn"`
+	// Release identifies the deployed build, e.g. a version or commit SHA.
+	Release string        `mapstructure:"release"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// HashingConfig sets the argon2id cost of hashing confirmation codes and tokens before they are
+// stored. Stored hashes keep the parameters they were made with, so these can be raised at any time.
+type HashingConfig struct {
+	Time      int `mapstructure:"time"`
+	MemoryKiB int `mapstructure:"memory_kib"`
+	Threads   int `mapstructure:"threads"`
+}
+
+// IPFilterConfig restricts the admin and internal routes to client addresses. Entries are CIDRs
+// or bare IP addresses.
+type IPFilterConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// TrustedProxies are the proxies whose X-Forwarded-For entries are believed. Without any, the
+	// connection's peer address is the client.
+	TrustedProxies []string            `mapstructure:"trusted_proxies"`
+	Admin          IPFilterRulesConfig `mapstructure:"admin"`
+	Internal       IPFilterRulesConfig `mapstructure:"internal"`
+	// File is watched while serving and its rules replace the current ones when it changes.
+	// Empty disables reloading.
+	File string `mapstructure:"file"`
+}
+
+// IPFilterRulesConfig is the allow and deny lists of one route group. Deny wins, and an empty
+// allow list admits every address that is not denied.
+type IPFilterRulesConfig struct {
+	Allow []string `mapstructure:"allow"`
+	Deny  []string `mapstructure:"deny"`
+}
+
+// RequestSigningConfig requires sibling services calling the internal routes to sign requests
+// with a shared HMAC secret, for deployments without mutual TLS.
+type RequestSigningConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Secret  string `mapstructure:"secret"`
+	// PreviousSecret is still accepted while callers move to a rotated Secret.
+	PreviousSecret string `mapstructure:"previous_secret"`
+	// MaxSkew is how far a request's timestamp may be from the server's clock. Nonces are
+	// remembered for twice as long to reject replays.
+	MaxSkew time.Duration `mapstructure:"max_skew"`
+}
+
+// ContentModerationConfig checks the full names and bios users write for objectionable language.
+type ContentModerationConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Provider is "wordlist", checking text against WordListFile, or "api", calling an external
+	// moderation API and falling back to the word list while it fails.
+	Provider string `mapstructure:"provider"`
+	// Action is what happens to flagged text: "reject" refuses the update, "flag" saves it and
+	// queues it for admin review and "mask" replaces the flagged words with asterisks.
+	Action string `mapstructure:"action"`
+	// WordListFile has one word per line; lines starting with # are comments.
+	WordListFile string                     `mapstructure:"word_list_file"`
+	API          ContentModerationAPIConfig `mapstructure:"api"`
+}
+
+// ContentModerationAPIConfig configures the external moderation API and its circuit breaker.
+type ContentModerationAPIConfig struct {
+	URL     string        `mapstructure:"url"`
+	APIKey  string        `mapstructure:"api_key"`
+	Timeout time.Duration `mapstructure:"timeout"`
+	// FailureThreshold consecutive failures open the circuit breaker for Cooldown, during which
+	// the word list is used instead.
+	FailureThreshold int           `mapstructure:"failure_threshold"`
+	Cooldown         time.Duration `mapstructure:"cooldown"`
+}
+
+// ProfileConfig holds the length limits, in characters, of the free-text profile fields. They
+// apply to profile updates, change requests and imports, and are published to clients at
+// GET /users/profile/constraints. Existing values are not checked again when limits change.
+type ProfileConfig struct {
+	UsernameMinLength int `mapstructure:"username_min_length"`
+	UsernameMaxLength int `mapstructure:"username_max_length"`
+	FullNameMaxLength int `mapstructure:"full_name_max_length"`
+	BioMaxLength      int `mapstructure:"bio_max_length"`
+}
+
+// Limits returns the limits in the form the validator checks them.
+func (c ProfileConfig) Limits() validation.ProfileLimits {
+	return validation.ProfileLimits{
+		UsernameMin: c.UsernameMinLength,
+		UsernameMax: c.UsernameMaxLength,
+		FullNameMax: c.FullNameMaxLength,
+		BioMax:      c.BioMaxLength,
+	}
+}
+
+// PublicProfilesConfig controls the public profile projection: each user's profile as anonymous
+// viewers see it, kept in Redis so anonymous profile views and embeds read one record instead of
+// the user, their privacy preferences and follower count.
+type PublicProfilesConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// SyncInterval is how often profiles recorded as changed in the user change log are rebuilt.
+	// Zero disables syncing, leaving profiles to be rebuilt once they are stale.
+	SyncInterval time.Duration `mapstructure:"sync_interval"`
+	// MaxStaleness is the age past which a projected profile is rebuilt before it is served. It
+	// bounds how long changes the change log does not record, such as new followers, take to show.
+	MaxStaleness time.Duration `mapstructure:"max_staleness"`
+	// BatchSize is how many profiles are written at once while syncing or rebuilding.
+	BatchSize int `mapstructure:"batch_size"`
+}
+
+// ReadinessConfig configures the invariant checks /ready reports alongside its dependencies.
+// Results are reused for CheckInterval, so frequent probes do not load the database.
+type ReadinessConfig struct {
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+	// Indexes checks that every index the migrations create exists.
+	Indexes InvariantConfig `mapstructure:"indexes"`
+	// CounterDrift compares the follower counts of the Sample most recently followed public
+	// profiles with their follows; Max is the largest difference that passes.
+	CounterDrift InvariantConfig `mapstructure:"counter_drift"`
+	// ChangeBacklog counts the user changes the public profile projection has not applied yet;
+	// Max is the largest backlog that passes.
+	ChangeBacklog InvariantConfig `mapstructure:"change_backlog"`
+	// ChangeAge measures how long the oldest of those changes has waited; MaxAge is the longest
+	// wait that passes.
+	ChangeAge InvariantConfig `mapstructure:"change_age"`
+}
+
+// InvariantConfig configures one readiness invariant. Severity "off" skips it, "warn" reports a
+// violation in the readiness response, and "fail" also fails readiness.
+type InvariantConfig struct {
+	Severity string        `mapstructure:"severity"`
+	Max      int           `mapstructure:"max"`
+	MaxAge   time.Duration `mapstructure:"max_age"`
+	Sample   int           `mapstructure:"sample"`
+}
+
 type DownstreamServicesConfig struct {
 	Notification NotificationServiceConfig `mapstructure:"notification"`
 }
@@ -103,15 +684,135 @@ type NotificationServiceConfig struct {
 }
 
 const (
-	fatalConfigErr       = "fatal error config file: %w"
-	defaultPostgresPort  = 5432
-	defaultRedisPort     = 6379
-	defaultRedisDatabase = 0
+	fatalConfigErr                   = "fatal error config file: %w"
+	defaultPostgresPort              = 5432
+	defaultRedisPort                 = 6379
+	defaultRedisDatabase             = 0
+	defaultRedisPruneInterval        = 7 * 24 * time.Hour
+	defaultRateLimitWindow           = time.Minute
+	defaultRateLimitAuthenticatedMax = 300
+	defaultRateLimitAnonymousMax     = 30
+	defaultIPFilterFile              = "config/ipfilter.yaml"
+	defaultRequestSigningMaxSkew     = 5 * time.Minute
+	defaultModerationAPITimeout      = 2 * time.Second
+	defaultModerationAPIFailures     = 5
+	defaultModerationAPICooldown     = 30 * time.Second
+	defaultSecretsCacheTTL           = 5 * time.Minute
+	defaultSecretsRefreshInterval    = time.Minute
+	defaultShadowSamplePercent       = 1.0
+	defaultShadowTimeout             = 5 * time.Second
+	defaultShadowMaxInFlight         = 16
+	defaultAdminStatsCacheTTL        = 5 * time.Minute
+	defaultPushMaxDevicesPerUser     = 10
+	defaultPushStaleAfter            = 60 * 24 * time.Hour
+	defaultPushCleanupInterval       = time.Hour
+	defaultFollowHistoryRetention    = 365 * 24 * time.Hour
+	defaultFollowHistoryPurge        = time.Hour
+	defaultSocialMaxFollowing        = 7500
+	defaultSocialDigestPeriod        = 7 * 24 * time.Hour
+	defaultInvitationsMaxPerUser     = 10
+	defaultInvitationsTTL            = 30 * 24 * time.Hour
+	defaultPreferenceCenterTokenTTL  = 90 * 24 * time.Hour
+	defaultListUnsubscribeTokenTTL   = 90 * 24 * time.Hour
+	defaultTypeaheadCacheTTL         = 30 * time.Second
+	defaultSearchResultsCacheTTL     = 30 * time.Second
+	defaultReindexInterval           = 6 * time.Hour
+	defaultReindexRate               = 2000
+	defaultServerPort                = 8080
+	defaultMaintenanceRetryAfter     = time.Minute
+	defaultMaintenanceRefresh        = 5 * time.Second
+	defaultShutdownTimeout           = 5 * time.Second
+	defaultHSTSMaxAge                = 365 * 24 * time.Hour
+	defaultJobLockTTL                = 30 * time.Second
+	defaultPresenceOnlineWindow      = 5 * time.Minute
+	defaultPresenceRetention         = 30 * 24 * time.Hour
+	defaultProfileViewsFlushInterval = time.Minute
+	defaultRecentlyViewedLimit       = 20
+	defaultRecentlyViewedRetention   = 30 * 24 * time.Hour
+	defaultBadgeEvaluationInterval   = 30 * time.Second
+	defaultBadgeSweepInterval        = 24 * time.Hour
+	defaultMentionMaxUsernames       = 100
+	defaultMentionCacheTTL           = time.Minute
+	defaultEmbedCacheTTL             = 5 * time.Minute
+	defaultDirectoryTimeout          = 10 * time.Second
+	defaultDirectorySyncInterval     = time.Hour
+	defaultBackfillBatchSize         = 500
+	defaultBackfillRate              = 1000
+	defaultPrivacyDefaultsVersion    = 1
+	defaultErrorTrackingTimeout      = 5 * time.Second
+	defaultSlowRequestThreshold      = 5 * time.Second
+	defaultSlowRequestMaxBundles     = 100
+	defaultSlowRequestMaxAge         = 7 * 24 * time.Hour
+	defaultSlowRequestMaxQueries     = 500
+	defaultPublicProfileSync         = 30 * time.Second
+	defaultPublicProfileStaleness    = 15 * time.Minute
+	defaultPublicProfileBatchSize    = 500
+	defaultReadinessCheckInterval    = 30 * time.Second
+	defaultCounterDriftMax           = 10
+	defaultCounterDriftSample        = 50
+	defaultChangeBacklogMax          = 10000
+	defaultChangeAgeMax              = 15 * time.Minute
+)
+
+// Default content security policies: API responses never render, the Swagger UI needs its own
+// scripts, styles and images.
+const (
+	defaultContentSecurityPolicy     = "default-src 'none'; frame-ancestors 'none'"
+	defaultDocsContentSecurityPolicy = "default-src 'self'; script-src 'self' 'unsafe-inline'; " +
+		"style-src 'self' 'unsafe-inline'; img-src 'self' data:; frame-ancestors 'none'"
+)
+
+// Server identity defaults.
+const (
+	DefaultBasePath    = "/api/v1/user-management"
+	DefaultServiceName = "user-management-service"
+)
+
+// DefaultDiagnosticsHost keeps the unauthenticated diagnostics listener on loopback.
+const DefaultDiagnosticsHost = "127.0.0.1"
+
+// Storage backends.
+const (
+	StorageBackendPostgres = "postgres"
+	StorageBackendMemory   = "memory"
+)
+
+// Readiness invariant severities.
+const (
+	InvariantSeverityOff  = "off"
+	InvariantSeverityWarn = "warn"
+	InvariantSeverityFail = "fail"
+)
+
+// Preference storage layouts.
+const (
+	PreferenceStorageTables = "tables"
+	PreferenceStorageJSONB  = "jsonb"
 )
 
+// defaultRedisPrunePrefixes are the families of keys the service writes with a TTL, which the
+// redis-prune job checks unless redis.pruneprefixes says otherwise.
+var defaultRedisPrunePrefixes = []string{
+	"delete-request:", "email-change:", "handle-reservation:", "profile-share:", "presence:",
+	"profile-views:seen:", "recently-viewed:", "account-recovery:", "request-nonce:",
+}
+
 var Instance *Config
 
+// Load reads the configuration and panics if a required OAuth2 setting is missing.
+// Use LoadAndValidate for the full validation pass.
 func Load() *Config {
+	cfg := load()
+	validateConfig(cfg)
+
+	return cfg
+}
+
+// load merges the configuration layers, lowest precedence first: defaults, the YAML files in
+// ./config, their environment overlays, environment variables and <VAR>_FILE secrets. The result
+// is decoded strictly, so a setting no Config field reads (a typo in a file or overlay) fails the
+// load instead of being ignored.
+func load() *Config {
 	// Environment variables
 	// env variables will look like USERMGMT_SERVER_PORT, USERMGMT_LOGGING_LEVEL
 	viper.SetEnvPrefix("USERMGMT")
@@ -120,93 +821,127 @@ func Load() *Config {
 
 	// Read config files
 	viper.AddConfigPath("./config")
+	mergeConfigFiles(overlayEnvironment())
 
 	// Load config
 	loadServerConfig()
-	mergeDatabaseConfig()
-	mergeOauth2Config()
-	mergeDownstreamServicesConfig()
-	loadCorsConfig()
+	loadTLSConfig()
+	loadSecurityHeadersConfig()
 	loadLoggingConfig()
 	loadEnvironmentConfig()
 	loadPostgresConfig()
 	loadRedisConfig()
 	loadOauth2Config()
 	loadDownstreamServicesConfig()
+	loadRateLimitConfig()
+	loadSecretsConfig()
+	loadDiagnosticsConfig()
+	loadShadowConfig()
+	loadStorageConfig()
+	loadAdminConfig()
+	loadPoliciesConfig()
+	loadPushConfig()
+	loadSocialConfig()
+	loadInvitationsConfig()
+	loadPreferenceCenterConfig()
+	loadListUnsubscribeConfig()
+	loadSearchConfig()
+	loadMaintenanceConfig()
+	loadJobsConfig()
+	loadPresenceConfig()
+	loadProfileViewsConfig()
+	loadRecentlyViewedConfig()
+	loadBadgesConfig()
+	loadMentionsConfig()
+	loadEmbedConfig()
+	loadDirectoryConfig()
+	loadPreferencesConfig()
+	loadEventsConfig()
+	loadErrorTrackingConfig()
+	loadHashingConfig()
+	loadIPFilterConfig(viper.GetViper())
+	loadRequestSigningConfig()
+	loadContentModerationConfig()
+	loadProfileConfig()
+	loadPublicProfilesConfig()
+	loadReadinessConfig()
+	applySecretFiles()
 
 	var cfg Config
 
-	err := viper.Unmarshal(&cfg)
+	err := viper.UnmarshalExact(&cfg)
 	if err != nil {
-		panic(err)
+		panic(fmt.Errorf(fatalConfigErr, err))
 	}
 
 	Instance = &cfg
-	validateConfig(Instance)
 
 	return Instance
 }
 
-func validateConfig(cfg *Config) {
-	if cfg.OAuth2.Enabled {
-		if cfg.OAuth2.ClientID == "" {
-			panic("oauth2.client_id is required when oauth2 is enabled")
-		}
-
-		if cfg.OAuth2.ClientSecret == "" {
-			panic("oauth2.client_secret is required when oauth2 is enabled")
-		}
+// configFile is a YAML file in ./config. Required files must exist; settings of a missing
+// optional file keep their defaults.
+type configFile struct {
+	name     string
+	required bool
+}
 
-		if cfg.OAuth2.BaseAuthURL == "" {
-			panic("oauth2.base_auth_url is required when oauth2 is enabled")
-		}
+// configFiles are merged in this order, each followed by its overlay.
+var configFiles = []configFile{
+	{name: "server", required: true},
+	{name: "database", required: true},
+	{name: "oauth2"},
+	{name: "downstreamServices"},
+	{name: "ratelimit"},
+	{name: "ipfilter"},
+	{name: "badges"},
+	{name: "cors", required: true},
+	{name: "logging", required: true},
+}
 
-		if cfg.OAuth2.GetTokenPath == "" {
-			panic("oauth2.get_token_path is required when oauth2 is enabled")
-		}
+// overlayEnvironment is the environment whose overlays are merged: ENVIRONMENT, or development.
+func overlayEnvironment() string {
+	env := os.Getenv("ENVIRONMENT")
+	if env == "" {
+		env = "development"
 	}
-}
 
-func mergeDatabaseConfig() {
-	viper.SetConfigName("database")
-	viper.SetConfigType("yaml")
+	return env
+}
 
-	err := viper.MergeInConfig()
-	if err != nil {
-		var configFileNotFoundError viper.ConfigFileNotFoundError
-		if errors.As(err, &configFileNotFoundError) {
-			panic("database config file not found")
-		} else {
-			panic(fmt.Errorf(fatalConfigErr, err))
+// mergeConfigFiles merges every config file followed by its <name>.<env>.yaml overlay, which only
+// lists the settings that differ in that environment. Overlays are always optional.
+func mergeConfigFiles(env string) {
+	for _, file := range configFiles {
+		if !mergeConfigFile(file.name) && file.required {
+			panic(file.name + " config file not found")
 		}
+
+		mergeConfigFile(file.name + "." + env)
 	}
 }
 
-func mergeOauth2Config() {
-	viper.SetConfigName("oauth2")
+// mergeConfigFile merges the named YAML file into the configuration and reports whether it exists.
+func mergeConfigFile(name string) bool {
+	viper.SetConfigName(name)
 	viper.SetConfigType("yaml")
 
 	err := viper.MergeInConfig()
 	if err != nil {
 		var configFileNotFoundError viper.ConfigFileNotFoundError
 		if errors.As(err, &configFileNotFoundError) {
-			// Config file not found; ignore
-			return
-		} else {
-			panic(fmt.Errorf(fatalConfigErr, err))
+			return false
 		}
+
+		panic(fmt.Errorf(fatalConfigErr, err))
 	}
+
+	return true
 }
 
-func loadCorsConfig() {
-	viper.SetConfigName("cors")
-	viper.SetConfigType("yaml")
-	if err := viper.MergeInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
-			panic("cors config file not found")
-		} else {
-			panic(fmt.Errorf(fatalConfigErr, err))
-		}
+func validateConfig(cfg *Config) {
+	if problems := validateOAuth2(&cfg.OAuth2); len(problems) > 0 {
+		panic(problems[0])
 	}
 }
 
@@ -217,18 +952,9 @@ func loadEnvironmentConfig() {
 }
 
 func loadLoggingConfig() {
-	viper.SetConfigName("logging")
-	viper.SetConfigType("yaml")
+	viper.SetDefault("logging.logSensitiveData", false)
 
-	err := viper.MergeInConfig()
-	if err != nil {
-		var configFileNotFoundError viper.ConfigFileNotFoundError
-		if errors.As(err, &configFileNotFoundError) {
-			panic("logging config file not found")
-		} else {
-			panic(fmt.Errorf(fatalConfigErr, err))
-		}
-	}
+	_ = viper.BindEnv("logging.logSensitiveData", "LOG_SENSITIVE_DATA")
 }
 
 func loadOauth2Config() {
@@ -269,69 +995,66 @@ func loadRedisConfig() {
 	viper.SetDefault("redis.database", defaultRedisDatabase)
 	viper.SetDefault("redis.username", "")
 	viper.SetDefault("redis.password", "")
+	viper.SetDefault("redis.keynamespace", false)
+	viper.SetDefault("redis.tenant", "")
+	viper.SetDefault("redis.pruneinterval", defaultRedisPruneInterval)
+	viper.SetDefault("redis.pruneprefixes", defaultRedisPrunePrefixes)
+	viper.SetDefault("redis.prunedryrun", false)
 
 	_ = viper.BindEnv("redis.host", "REDIS_HOST")
 	_ = viper.BindEnv("redis.port", "REDIS_PORT")
 	_ = viper.BindEnv("redis.database", "REDIS_DB")
 	_ = viper.BindEnv("redis.username", "REDIS_USER")
 	_ = viper.BindEnv("redis.password", "REDIS_PASSWORD")
+	_ = viper.BindEnv("redis.keynamespace", "REDIS_KEY_NAMESPACE")
+	_ = viper.BindEnv("redis.tenant", "REDIS_TENANT")
+	_ = viper.BindEnv("redis.pruneinterval", "REDIS_PRUNE_INTERVAL")
+	_ = viper.BindEnv("redis.pruneprefixes", "REDIS_PRUNE_PREFIXES")
+	_ = viper.BindEnv("redis.prunedryrun", "REDIS_PRUNE_DRY_RUN")
 }
 
 func loadServerConfig() {
-	viper.SetConfigName("server")
-	viper.SetConfigType("yaml")
+	viper.SetDefault("server.port", defaultServerPort)
+	viper.SetDefault("server.base_path", DefaultBasePath)
+	viper.SetDefault("server.service_name", DefaultServiceName)
+	viper.SetDefault("server.drain_period", "0s")
+	viper.SetDefault("server.shutdown_timeout", defaultShutdownTimeout)
 
-	err := viper.ReadInConfig()
-	if err != nil {
-		var configFileNotFoundError viper.ConfigFileNotFoundError
-		if errors.As(err, &configFileNotFoundError) {
-			panic("server config file not found")
-		} else {
-			panic(fmt.Errorf(fatalConfigErr, err))
-		}
-	}
+	_ = viper.BindEnv("server.port", "SERVER_PORT")
+	_ = viper.BindEnv("server.base_path", "SERVER_BASE_PATH")
+	_ = viper.BindEnv("server.service_name", "SERVICE_NAME")
+	_ = viper.BindEnv("server.drain_period", "SERVER_DRAIN_PERIOD")
+	_ = viper.BindEnv("server.shutdown_timeout", "SERVER_SHUTDOWN_TIMEOUT")
 }
 
-func mergeDownstreamServicesConfig() {
-	viper.SetConfigName("downstreamServices")
-	viper.SetConfigType("yaml")
+func loadTLSConfig() {
+	viper.SetDefault("server.tls.enabled", false)
+	viper.SetDefault("server.tls.min_version", "1.2")
+	viper.SetDefault("server.tls.cipher_suites", []string{})
+	viper.SetDefault("server.tls.http2", true)
+	viper.SetDefault("server.tls.redirect_port", 0)
 
-	err := viper.MergeInConfig()
-	if err != nil {
-		var configFileNotFoundError viper.ConfigFileNotFoundError
-		if errors.As(err, &configFileNotFoundError) {
-			// Config file not found; ignore - downstream services are optional
-			return
-		}
-
-		panic(fmt.Errorf(fatalConfigErr, err))
-	}
-
-	// Load environment-specific overrides
-	mergeEnvSpecificConfig("downstreamServices")
+	_ = viper.BindEnv("server.tls.enabled", "SERVER_TLS_ENABLED")
+	_ = viper.BindEnv("server.tls.cert_file", "SERVER_TLS_CERT_FILE")
+	_ = viper.BindEnv("server.tls.key_file", "SERVER_TLS_KEY_FILE")
+	_ = viper.BindEnv("server.tls.min_version", "SERVER_TLS_MIN_VERSION")
+	_ = viper.BindEnv("server.tls.cipher_suites", "SERVER_TLS_CIPHER_SUITES")
+	_ = viper.BindEnv("server.tls.http2", "SERVER_TLS_HTTP2")
+	_ = viper.BindEnv("server.tls.redirect_port", "SERVER_TLS_REDIRECT_PORT")
 }
 
-// mergeEnvSpecificConfig loads environment-specific overrides for a config file.
-// For example, if baseName is "downstreamServices" and ENVIRONMENT is "production",
-// it will attempt to merge "downstreamServices.production.yaml".
-// Missing environment-specific files are silently ignored.
-func mergeEnvSpecificConfig(baseName string) {
-	env := os.Getenv("ENVIRONMENT")
-	if env == "" {
-		env = "development"
-	}
+func loadSecurityHeadersConfig() {
+	viper.SetDefault("server.security_headers.enabled", true)
+	viper.SetDefault("server.security_headers.content_security_policy", defaultContentSecurityPolicy)
+	viper.SetDefault("server.security_headers.hsts_max_age", defaultHSTSMaxAge)
+	viper.SetDefault("server.security_headers.docs_path", "/swagger")
+	viper.SetDefault("server.security_headers.docs_content_security_policy", defaultDocsContentSecurityPolicy)
 
-	viper.SetConfigName(baseName + "." + env)
-	viper.SetConfigType("yaml")
-
-	err := viper.MergeInConfig()
-	if err != nil {
-		var configFileNotFoundError viper.ConfigFileNotFoundError
-		if !errors.As(err, &configFileNotFoundError) {
-			panic(fmt.Errorf(fatalConfigErr, err))
-		}
-		// Environment-specific file not found is fine - it's optional
-	}
+	_ = viper.BindEnv("server.security_headers.enabled", "SECURITY_HEADERS_ENABLED")
+	_ = viper.BindEnv("server.security_headers.content_security_policy", "SECURITY_HEADERS_CSP")
+	_ = viper.BindEnv("server.security_headers.hsts_max_age", "SECURITY_HEADERS_HSTS_MAX_AGE")
+	_ = viper.BindEnv("server.security_headers.docs_path", "SECURITY_HEADERS_DOCS_PATH")
+	_ = viper.BindEnv("server.security_headers.docs_content_security_policy", "SECURITY_HEADERS_DOCS_CSP")
 }
 
 func loadDownstreamServicesConfig() {
@@ -342,3 +1065,491 @@ func loadDownstreamServicesConfig() {
 	_ = viper.BindEnv("downstreamservices.notification.base_url", "DOWNSTREAM_SERVICES_NOTIFICATION_BASE_URL")
 	_ = viper.BindEnv("downstreamservices.notification.timeout", "DOWNSTREAM_SERVICES_NOTIFICATION_TIMEOUT")
 }
+
+func loadRateLimitConfig() {
+	viper.SetDefault("ratelimit.enabled", true)
+	viper.SetDefault("ratelimit.window", defaultRateLimitWindow)
+	viper.SetDefault("ratelimit.authenticated_limit", defaultRateLimitAuthenticatedMax)
+	viper.SetDefault("ratelimit.anonymous_limit", defaultRateLimitAnonymousMax)
+	viper.SetDefault("ratelimit.anonymous_disabled_groups", []string{})
+
+	_ = viper.BindEnv("ratelimit.enabled", "RATE_LIMIT_ENABLED")
+	_ = viper.BindEnv("ratelimit.window", "RATE_LIMIT_WINDOW")
+	_ = viper.BindEnv("ratelimit.authenticated_limit", "RATE_LIMIT_AUTHENTICATED_LIMIT")
+	_ = viper.BindEnv("ratelimit.anonymous_limit", "RATE_LIMIT_ANONYMOUS_LIMIT")
+	_ = viper.BindEnv("ratelimit.anonymous_disabled_groups", "RATE_LIMIT_ANONYMOUS_DISABLED_GROUPS")
+}
+
+// loadIPFilterConfig sets the IP filter defaults and environment overrides on v, which is also
+// used to re-read the rules file while serving.
+func loadIPFilterConfig(v *viper.Viper) {
+	v.SetDefault("ipfilter.enabled", false)
+	v.SetDefault("ipfilter.trusted_proxies", []string{})
+	v.SetDefault("ipfilter.admin.allow", []string{})
+	v.SetDefault("ipfilter.admin.deny", []string{})
+	v.SetDefault("ipfilter.internal.allow", []string{})
+	v.SetDefault("ipfilter.internal.deny", []string{})
+	v.SetDefault("ipfilter.file", defaultIPFilterFile)
+
+	_ = v.BindEnv("ipfilter.enabled", "IP_FILTER_ENABLED")
+	_ = v.BindEnv("ipfilter.trusted_proxies", "IP_FILTER_TRUSTED_PROXIES")
+	_ = v.BindEnv("ipfilter.admin.allow", "IP_FILTER_ADMIN_ALLOW")
+	_ = v.BindEnv("ipfilter.admin.deny", "IP_FILTER_ADMIN_DENY")
+	_ = v.BindEnv("ipfilter.internal.allow", "IP_FILTER_INTERNAL_ALLOW")
+	_ = v.BindEnv("ipfilter.internal.deny", "IP_FILTER_INTERNAL_DENY")
+	_ = v.BindEnv("ipfilter.file", "IP_FILTER_FILE")
+}
+
+// ReadIPFilterFile reads the IP filter section of the YAML file at path, with the same defaults
+// and environment overrides as at startup, and validates it.
+func ReadIPFilterFile(path string) (IPFilterConfig, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+	loadIPFilterConfig(v)
+
+	// Unmarshal the whole tree; UnmarshalKey skips keys only set by the environment
+	var file struct {
+		IPFilter IPFilterConfig `mapstructure:"ipfilter"`
+	}
+
+	err := v.ReadInConfig()
+	if err != nil {
+		return file.IPFilter, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	err = v.Unmarshal(&file)
+	if err != nil {
+		return file.IPFilter, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if problems := validateIPFilter(&file.IPFilter); len(problems) > 0 {
+		return file.IPFilter, &ValidationError{Problems: problems}
+	}
+
+	return file.IPFilter, nil
+}
+
+func loadSecretsConfig() {
+	viper.SetDefault("secrets.provider", "")
+	viper.SetDefault("secrets.cache_ttl", defaultSecretsCacheTTL)
+	viper.SetDefault("secrets.refresh_interval", defaultSecretsRefreshInterval)
+	viper.SetDefault("secrets.vault.mount", "secret")
+
+	_ = viper.BindEnv("secrets.provider", "SECRETS_PROVIDER")
+	_ = viper.BindEnv("secrets.cache_ttl", "SECRETS_CACHE_TTL")
+	_ = viper.BindEnv("secrets.refresh_interval", "SECRETS_REFRESH_INTERVAL")
+	_ = viper.BindEnv("secrets.vault.address", "VAULT_ADDR")
+	_ = viper.BindEnv("secrets.vault.token", "VAULT_TOKEN")
+	_ = viper.BindEnv("secrets.vault.mount", "SECRETS_VAULT_MOUNT")
+	_ = viper.BindEnv("secrets.vault.path", "SECRETS_VAULT_PATH")
+	_ = viper.BindEnv("secrets.vault.timeout", "SECRETS_VAULT_TIMEOUT")
+}
+
+func loadDiagnosticsConfig() {
+	viper.SetDefault("diagnostics.enabled", false)
+	viper.SetDefault("diagnostics.port", 0)
+	viper.SetDefault("diagnostics.host", DefaultDiagnosticsHost)
+
+	_ = viper.BindEnv("diagnostics.enabled", "DIAGNOSTICS_ENABLED")
+	_ = viper.BindEnv("diagnostics.port", "DIAGNOSTICS_PORT")
+	_ = viper.BindEnv("diagnostics.host", "DIAGNOSTICS_HOST")
+
+	viper.SetDefault("diagnostics.slow_requests.enabled", false)
+	viper.SetDefault("diagnostics.slow_requests.threshold", defaultSlowRequestThreshold)
+	viper.SetDefault("diagnostics.slow_requests.dir", "./diagnostics/slow-requests")
+	viper.SetDefault("diagnostics.slow_requests.goroutine_dump", false)
+	viper.SetDefault("diagnostics.slow_requests.max_bundles", defaultSlowRequestMaxBundles)
+	viper.SetDefault("diagnostics.slow_requests.max_age", defaultSlowRequestMaxAge)
+	viper.SetDefault("diagnostics.slow_requests.max_queries", defaultSlowRequestMaxQueries)
+
+	_ = viper.BindEnv("diagnostics.slow_requests.enabled", "SLOW_REQUESTS_ENABLED")
+	_ = viper.BindEnv("diagnostics.slow_requests.threshold", "SLOW_REQUESTS_THRESHOLD")
+	_ = viper.BindEnv("diagnostics.slow_requests.dir", "SLOW_REQUESTS_DIR")
+	_ = viper.BindEnv("diagnostics.slow_requests.goroutine_dump", "SLOW_REQUESTS_GOROUTINE_DUMP")
+	_ = viper.BindEnv("diagnostics.slow_requests.max_bundles", "SLOW_REQUESTS_MAX_BUNDLES")
+	_ = viper.BindEnv("diagnostics.slow_requests.max_age", "SLOW_REQUESTS_MAX_AGE")
+	_ = viper.BindEnv("diagnostics.slow_requests.max_queries", "SLOW_REQUESTS_MAX_QUERIES")
+}
+
+func loadShadowConfig() {
+	viper.SetDefault("shadow.enabled", false)
+	viper.SetDefault("shadow.sample_percent", defaultShadowSamplePercent)
+	viper.SetDefault("shadow.timeout", defaultShadowTimeout)
+	viper.SetDefault("shadow.max_in_flight", defaultShadowMaxInFlight)
+	viper.SetDefault("shadow.ignore_paths", []string{})
+
+	_ = viper.BindEnv("shadow.enabled", "SHADOW_ENABLED")
+	_ = viper.BindEnv("shadow.sample_percent", "SHADOW_SAMPLE_PERCENT")
+	_ = viper.BindEnv("shadow.timeout", "SHADOW_TIMEOUT")
+	_ = viper.BindEnv("shadow.max_in_flight", "SHADOW_MAX_IN_FLIGHT")
+	_ = viper.BindEnv("shadow.ignore_paths", "SHADOW_IGNORE_PATHS")
+}
+
+func loadStorageConfig() {
+	viper.SetDefault("storage.backend", StorageBackendPostgres)
+	viper.SetDefault("storage.fixtures_file", "")
+
+	_ = viper.BindEnv("storage.backend", "STORAGE_BACKEND")
+	_ = viper.BindEnv("storage.fixtures_file", "STORAGE_FIXTURES_FILE")
+}
+
+func loadAdminConfig() {
+	viper.SetDefault("admin.stats_cache_ttl", defaultAdminStatsCacheTTL)
+
+	_ = viper.BindEnv("admin.stats_cache_ttl", "ADMIN_STATS_CACHE_TTL")
+}
+
+func loadPoliciesConfig() {
+	viper.SetDefault("policies.terms_version", "")
+	viper.SetDefault("policies.privacy_version", "")
+	viper.SetDefault("policies.enforce_acceptance", false)
+
+	_ = viper.BindEnv("policies.terms_version", "POLICIES_TERMS_VERSION")
+	_ = viper.BindEnv("policies.privacy_version", "POLICIES_PRIVACY_VERSION")
+	_ = viper.BindEnv("policies.enforce_acceptance", "POLICIES_ENFORCE_ACCEPTANCE")
+}
+
+func loadPushConfig() {
+	viper.SetDefault("push.max_devices_per_user", defaultPushMaxDevicesPerUser)
+	viper.SetDefault("push.stale_after", defaultPushStaleAfter)
+	viper.SetDefault("push.cleanup_interval", defaultPushCleanupInterval)
+
+	_ = viper.BindEnv("push.max_devices_per_user", "PUSH_MAX_DEVICES_PER_USER")
+	_ = viper.BindEnv("push.stale_after", "PUSH_STALE_AFTER")
+	_ = viper.BindEnv("push.cleanup_interval", "PUSH_CLEANUP_INTERVAL")
+}
+
+func loadSocialConfig() {
+	viper.SetDefault("social.follow_history_retention", defaultFollowHistoryRetention)
+	viper.SetDefault("social.follow_history_purge_interval", defaultFollowHistoryPurge)
+	viper.SetDefault("social.max_following", defaultSocialMaxFollowing)
+	viper.SetDefault("social.digest_period", defaultSocialDigestPeriod)
+
+	_ = viper.BindEnv("social.follow_history_retention", "SOCIAL_FOLLOW_HISTORY_RETENTION")
+	_ = viper.BindEnv("social.follow_history_purge_interval", "SOCIAL_FOLLOW_HISTORY_PURGE_INTERVAL")
+	_ = viper.BindEnv("social.max_following", "SOCIAL_MAX_FOLLOWING")
+	_ = viper.BindEnv("social.digest_period", "SOCIAL_DIGEST_PERIOD")
+}
+
+func loadInvitationsConfig() {
+	viper.SetDefault("invitations.max_per_user", defaultInvitationsMaxPerUser)
+	viper.SetDefault("invitations.ttl", defaultInvitationsTTL)
+	viper.SetDefault("invitations.mutual_follow", false)
+
+	_ = viper.BindEnv("invitations.max_per_user", "INVITATIONS_MAX_PER_USER")
+	_ = viper.BindEnv("invitations.ttl", "INVITATIONS_TTL")
+	_ = viper.BindEnv("invitations.mutual_follow", "INVITATIONS_MUTUAL_FOLLOW")
+}
+
+func loadPreferenceCenterConfig() {
+	viper.SetDefault("preferencecenter.token_ttl", defaultPreferenceCenterTokenTTL)
+
+	_ = viper.BindEnv("preferencecenter.token_ttl", "PREFERENCE_CENTER_TOKEN_TTL")
+}
+
+func loadListUnsubscribeConfig() {
+	viper.SetDefault("listunsubscribe.base_url", "")
+	viper.SetDefault("listunsubscribe.mailto", "")
+	viper.SetDefault("listunsubscribe.token_ttl", defaultListUnsubscribeTokenTTL)
+
+	_ = viper.BindEnv("listunsubscribe.base_url", "LIST_UNSUBSCRIBE_BASE_URL")
+	_ = viper.BindEnv("listunsubscribe.mailto", "LIST_UNSUBSCRIBE_MAILTO")
+	_ = viper.BindEnv("listunsubscribe.token_ttl", "LIST_UNSUBSCRIBE_TOKEN_TTL")
+}
+
+func loadSearchConfig() {
+	viper.SetDefault("search.personalized", false)
+	viper.SetDefault("search.typeahead_cache_ttl", defaultTypeaheadCacheTTL)
+	viper.SetDefault("search.typeahead_source", "postgres")
+	viper.SetDefault("search.reindex_interval", defaultReindexInterval)
+	viper.SetDefault("search.reindex_rate", defaultReindexRate)
+	viper.SetDefault("search.results_cache_ttl", defaultSearchResultsCacheTTL)
+
+	_ = viper.BindEnv("search.personalized", "SEARCH_PERSONALIZED")
+	_ = viper.BindEnv("search.typeahead_cache_ttl", "SEARCH_TYPEAHEAD_CACHE_TTL")
+	_ = viper.BindEnv("search.typeahead_source", "SEARCH_TYPEAHEAD_SOURCE")
+	_ = viper.BindEnv("search.reindex_interval", "SEARCH_REINDEX_INTERVAL")
+	_ = viper.BindEnv("search.reindex_rate", "SEARCH_REINDEX_RATE")
+	_ = viper.BindEnv("search.results_cache_ttl", "SEARCH_RESULTS_CACHE_TTL")
+}
+
+func loadMaintenanceConfig() {
+	viper.SetDefault("maintenance.enabled", false)
+	viper.SetDefault("maintenance.message", "")
+	viper.SetDefault("maintenance.retry_after", defaultMaintenanceRetryAfter)
+	viper.SetDefault("maintenance.refresh_interval", defaultMaintenanceRefresh)
+
+	_ = viper.BindEnv("maintenance.enabled", "MAINTENANCE_ENABLED")
+	_ = viper.BindEnv("maintenance.message", "MAINTENANCE_MESSAGE")
+	_ = viper.BindEnv("maintenance.retry_after", "MAINTENANCE_RETRY_AFTER")
+	_ = viper.BindEnv("maintenance.refresh_interval", "MAINTENANCE_REFRESH_INTERVAL")
+}
+
+func loadJobsConfig() {
+	viper.SetDefault("jobs.lock_ttl", defaultJobLockTTL)
+
+	_ = viper.BindEnv("jobs.lock_ttl", "JOBS_LOCK_TTL")
+	_ = viper.BindEnv("jobs.schedules.device-cleanup", "JOBS_DEVICE_CLEANUP_SCHEDULE")
+	_ = viper.BindEnv("jobs.schedules.follow-history-purge", "JOBS_FOLLOW_HISTORY_PURGE_SCHEDULE")
+	_ = viper.BindEnv("jobs.schedules.search-reindex", "JOBS_SEARCH_REINDEX_SCHEDULE")
+	_ = viper.BindEnv("jobs.schedules.preference-backfill", "JOBS_PREFERENCE_BACKFILL_SCHEDULE")
+	_ = viper.BindEnv("jobs.schedules.identifier-release", "JOBS_IDENTIFIER_RELEASE_SCHEDULE")
+}
+
+func loadPreferencesConfig() {
+	viper.SetDefault("preferences.backfill_batch_size", defaultBackfillBatchSize)
+	viper.SetDefault("preferences.backfill_rate", defaultBackfillRate)
+	viper.SetDefault("preferences.privacy_defaults_version", defaultPrivacyDefaultsVersion)
+	viper.SetDefault("preferences.disabled_categories", []string{})
+	viper.SetDefault("preferences.storage", PreferenceStorageTables)
+
+	_ = viper.BindEnv("preferences.backfill_batch_size", "PREFERENCES_BACKFILL_BATCH_SIZE")
+	_ = viper.BindEnv("preferences.backfill_rate", "PREFERENCES_BACKFILL_RATE")
+	_ = viper.BindEnv("preferences.privacy_defaults_version", "PREFERENCES_PRIVACY_DEFAULTS_VERSION")
+	_ = viper.BindEnv("preferences.disabled_categories", "PREFERENCES_DISABLED_CATEGORIES")
+	_ = viper.BindEnv("preferences.storage", "PREFERENCES_STORAGE")
+}
+
+func loadEventsConfig() {
+	viper.SetDefault("events.enabled", false)
+	viper.SetDefault("events.sample_rate", 1.0)
+
+	_ = viper.BindEnv("events.enabled", "EVENTS_ENABLED")
+	_ = viper.BindEnv("events.file", "EVENTS_FILE")
+	_ = viper.BindEnv("events.sample_rate", "EVENTS_SAMPLE_RATE")
+	_ = viper.BindEnv("events.sample_rates.profile_updated", "EVENTS_PROFILE_UPDATED_SAMPLE_RATE")
+	_ = viper.BindEnv("events.sample_rates.follow_created", "EVENTS_FOLLOW_CREATED_SAMPLE_RATE")
+	_ = viper.BindEnv("events.sample_rates.preference_changed", "EVENTS_PREFERENCE_CHANGED_SAMPLE_RATE")
+	_ = viper.BindEnv("events.sample_rates.invitation_redeemed", "EVENTS_INVITATION_REDEEMED_SAMPLE_RATE")
+}
+
+func loadErrorTrackingConfig() {
+	viper.SetDefault("errortracking.enabled", false)
+	viper.SetDefault("errortracking.timeout", defaultErrorTrackingTimeout)
+
+	_ = viper.BindEnv("errortracking.enabled", "ERROR_TRACKING_ENABLED")
+	_ = viper.BindEnv("errortracking.dsn", "SENTRY_DSN")
+	_ = viper.BindEnv("errortracking.release", "SENTRY_RELEASE")
+	_ = viper.BindEnv("errortracking.timeout", "ERROR_TRACKING_TIMEOUT")
+}
+
+func loadHashingConfig() {
+	viper.SetDefault("hashing.time", crypto.DefaultArgon2Time)
+	viper.SetDefault("hashing.memory_kib", crypto.DefaultArgon2MemoryKiB)
+	viper.SetDefault("hashing.threads", crypto.DefaultArgon2Threads)
+
+	_ = viper.BindEnv("hashing.time", "HASHING_ARGON2_TIME")
+	_ = viper.BindEnv("hashing.memory_kib", "HASHING_ARGON2_MEMORY_KIB")
+	_ = viper.BindEnv("hashing.threads", "HASHING_ARGON2_THREADS")
+}
+
+func loadRequestSigningConfig() {
+	viper.SetDefault("requestsigning.enabled", false)
+	viper.SetDefault("requestsigning.secret", "")
+	viper.SetDefault("requestsigning.previous_secret", "")
+	viper.SetDefault("requestsigning.max_skew", defaultRequestSigningMaxSkew)
+
+	_ = viper.BindEnv("requestsigning.enabled", "REQUEST_SIGNING_ENABLED")
+	_ = viper.BindEnv("requestsigning.secret", "REQUEST_SIGNING_SECRET")
+	_ = viper.BindEnv("requestsigning.previous_secret", "REQUEST_SIGNING_PREVIOUS_SECRET")
+	_ = viper.BindEnv("requestsigning.max_skew", "REQUEST_SIGNING_MAX_SKEW")
+}
+
+func loadContentModerationConfig() {
+	viper.SetDefault("contentmoderation.enabled", false)
+	viper.SetDefault("contentmoderation.provider", "wordlist")
+	viper.SetDefault("contentmoderation.action", "reject")
+	viper.SetDefault("contentmoderation.word_list_file", "")
+	viper.SetDefault("contentmoderation.api.url", "")
+	viper.SetDefault("contentmoderation.api.api_key", "")
+	viper.SetDefault("contentmoderation.api.timeout", defaultModerationAPITimeout)
+	viper.SetDefault("contentmoderation.api.failure_threshold", defaultModerationAPIFailures)
+	viper.SetDefault("contentmoderation.api.cooldown", defaultModerationAPICooldown)
+
+	_ = viper.BindEnv("contentmoderation.enabled", "CONTENT_MODERATION_ENABLED")
+	_ = viper.BindEnv("contentmoderation.provider", "CONTENT_MODERATION_PROVIDER")
+	_ = viper.BindEnv("contentmoderation.action", "CONTENT_MODERATION_ACTION")
+	_ = viper.BindEnv("contentmoderation.word_list_file", "CONTENT_MODERATION_WORD_LIST_FILE")
+	_ = viper.BindEnv("contentmoderation.api.url", "CONTENT_MODERATION_API_URL")
+	_ = viper.BindEnv("contentmoderation.api.api_key", "CONTENT_MODERATION_API_KEY")
+	_ = viper.BindEnv("contentmoderation.api.timeout", "CONTENT_MODERATION_API_TIMEOUT")
+	_ = viper.BindEnv("contentmoderation.api.failure_threshold", "CONTENT_MODERATION_API_FAILURE_THRESHOLD")
+	_ = viper.BindEnv("contentmoderation.api.cooldown", "CONTENT_MODERATION_API_COOLDOWN")
+}
+
+func loadProfileConfig() {
+	viper.SetDefault("profile.username_min_length", validation.DefaultUsernameMinLength)
+	viper.SetDefault("profile.username_max_length", validation.DefaultUsernameMaxLength)
+	viper.SetDefault("profile.full_name_max_length", validation.DefaultFullNameMaxLength)
+	viper.SetDefault("profile.bio_max_length", validation.DefaultBioMaxLength)
+
+	_ = viper.BindEnv("profile.username_min_length", "PROFILE_USERNAME_MIN_LENGTH")
+	_ = viper.BindEnv("profile.username_max_length", "PROFILE_USERNAME_MAX_LENGTH")
+	_ = viper.BindEnv("profile.full_name_max_length", "PROFILE_FULL_NAME_MAX_LENGTH")
+	_ = viper.BindEnv("profile.bio_max_length", "PROFILE_BIO_MAX_LENGTH")
+}
+
+func loadPublicProfilesConfig() {
+	viper.SetDefault("publicprofiles.enabled", false)
+	viper.SetDefault("publicprofiles.sync_interval", defaultPublicProfileSync)
+	viper.SetDefault("publicprofiles.max_staleness", defaultPublicProfileStaleness)
+	viper.SetDefault("publicprofiles.batch_size", defaultPublicProfileBatchSize)
+
+	_ = viper.BindEnv("publicprofiles.enabled", "PUBLIC_PROFILES_ENABLED")
+	_ = viper.BindEnv("publicprofiles.sync_interval", "PUBLIC_PROFILES_SYNC_INTERVAL")
+	_ = viper.BindEnv("publicprofiles.max_staleness", "PUBLIC_PROFILES_MAX_STALENESS")
+	_ = viper.BindEnv("publicprofiles.batch_size", "PUBLIC_PROFILES_BATCH_SIZE")
+}
+
+func loadReadinessConfig() {
+	viper.SetDefault("readiness.check_interval", defaultReadinessCheckInterval)
+	viper.SetDefault("readiness.indexes.severity", InvariantSeverityWarn)
+	viper.SetDefault("readiness.counter_drift.severity", InvariantSeverityWarn)
+	viper.SetDefault("readiness.counter_drift.max", defaultCounterDriftMax)
+	viper.SetDefault("readiness.counter_drift.sample", defaultCounterDriftSample)
+	viper.SetDefault("readiness.change_backlog.severity", InvariantSeverityWarn)
+	viper.SetDefault("readiness.change_backlog.max", defaultChangeBacklogMax)
+	viper.SetDefault("readiness.change_age.severity", InvariantSeverityWarn)
+	viper.SetDefault("readiness.change_age.max_age", defaultChangeAgeMax)
+
+	_ = viper.BindEnv("readiness.check_interval", "READINESS_CHECK_INTERVAL")
+	_ = viper.BindEnv("readiness.indexes.severity", "READINESS_INDEXES_SEVERITY")
+	_ = viper.BindEnv("readiness.counter_drift.severity", "READINESS_COUNTER_DRIFT_SEVERITY")
+	_ = viper.BindEnv("readiness.counter_drift.max", "READINESS_COUNTER_DRIFT_MAX")
+	_ = viper.BindEnv("readiness.counter_drift.sample", "READINESS_COUNTER_DRIFT_SAMPLE")
+	_ = viper.BindEnv("readiness.change_backlog.severity", "READINESS_CHANGE_BACKLOG_SEVERITY")
+	_ = viper.BindEnv("readiness.change_backlog.max", "READINESS_CHANGE_BACKLOG_MAX")
+	_ = viper.BindEnv("readiness.change_age.severity", "READINESS_CHANGE_AGE_SEVERITY")
+	_ = viper.BindEnv("readiness.change_age.max_age", "READINESS_CHANGE_AGE_MAX")
+}
+
+func loadPresenceConfig() {
+	viper.SetDefault("presence.online_window", defaultPresenceOnlineWindow)
+	viper.SetDefault("presence.retention", defaultPresenceRetention)
+
+	_ = viper.BindEnv("presence.online_window", "PRESENCE_ONLINE_WINDOW")
+	_ = viper.BindEnv("presence.retention", "PRESENCE_RETENTION")
+}
+
+func loadProfileViewsConfig() {
+	viper.SetDefault("profileviews.flush_interval", defaultProfileViewsFlushInterval)
+
+	_ = viper.BindEnv("profileviews.flush_interval", "PROFILE_VIEWS_FLUSH_INTERVAL")
+}
+
+func loadRecentlyViewedConfig() {
+	viper.SetDefault("recentlyviewed.limit", defaultRecentlyViewedLimit)
+	viper.SetDefault("recentlyviewed.retention", defaultRecentlyViewedRetention)
+
+	_ = viper.BindEnv("recentlyviewed.limit", "RECENTLY_VIEWED_LIMIT")
+	_ = viper.BindEnv("recentlyviewed.retention", "RECENTLY_VIEWED_RETENTION")
+}
+
+func loadMentionsConfig() {
+	viper.SetDefault("mentions.max_usernames", defaultMentionMaxUsernames)
+	viper.SetDefault("mentions.cache_ttl", defaultMentionCacheTTL)
+
+	_ = viper.BindEnv("mentions.max_usernames", "MENTIONS_MAX_USERNAMES")
+	_ = viper.BindEnv("mentions.cache_ttl", "MENTIONS_CACHE_TTL")
+}
+
+func loadEmbedConfig() {
+	viper.SetDefault("embed.profile_url", "")
+	viper.SetDefault("embed.avatar_url", "")
+	viper.SetDefault("embed.cache_ttl", defaultEmbedCacheTTL)
+
+	_ = viper.BindEnv("embed.profile_url", "EMBED_PROFILE_URL")
+	_ = viper.BindEnv("embed.avatar_url", "EMBED_AVATAR_URL")
+	_ = viper.BindEnv("embed.cache_ttl", "EMBED_CACHE_TTL")
+}
+
+func loadDirectoryConfig() {
+	viper.SetDefault("directory.enabled", false)
+	viper.SetDefault("directory.start_tls", false)
+	viper.SetDefault("directory.lookup_attribute", "uid")
+	viper.SetDefault("directory.attributes.full_name", "displayName")
+	viper.SetDefault("directory.attributes.email", "mail")
+	viper.SetDefault("directory.attributes.active", "")
+	viper.SetDefault("directory.attributes.inactive_values", []string{})
+	viper.SetDefault("directory.rules.full_name", "directory")
+	viper.SetDefault("directory.rules.email", "directory")
+	viper.SetDefault("directory.rules.is_active", "directory")
+	viper.SetDefault("directory.timeout", defaultDirectoryTimeout)
+	viper.SetDefault("directory.sync_interval", defaultDirectorySyncInterval)
+
+	_ = viper.BindEnv("directory.enabled", "DIRECTORY_ENABLED")
+	_ = viper.BindEnv("directory.url", "DIRECTORY_URL")
+	_ = viper.BindEnv("directory.start_tls", "DIRECTORY_START_TLS")
+	_ = viper.BindEnv("directory.bind_dn", "DIRECTORY_BIND_DN")
+	_ = viper.BindEnv("directory.bind_password", "DIRECTORY_BIND_PASSWORD")
+	_ = viper.BindEnv("directory.base_dn", "DIRECTORY_BASE_DN")
+	_ = viper.BindEnv("directory.lookup_attribute", "DIRECTORY_LOOKUP_ATTRIBUTE")
+	_ = viper.BindEnv("directory.attributes.full_name", "DIRECTORY_FULL_NAME_ATTRIBUTE")
+	_ = viper.BindEnv("directory.attributes.email", "DIRECTORY_EMAIL_ATTRIBUTE")
+	_ = viper.BindEnv("directory.attributes.active", "DIRECTORY_ACTIVE_ATTRIBUTE")
+	_ = viper.BindEnv("directory.attributes.inactive_values", "DIRECTORY_INACTIVE_VALUES")
+	_ = viper.BindEnv("directory.rules.full_name", "DIRECTORY_FULL_NAME_RULE")
+	_ = viper.BindEnv("directory.rules.email", "DIRECTORY_EMAIL_RULE")
+	_ = viper.BindEnv("directory.rules.is_active", "DIRECTORY_IS_ACTIVE_RULE")
+	_ = viper.BindEnv("directory.timeout", "DIRECTORY_TIMEOUT")
+	_ = viper.BindEnv("directory.sync_interval", "DIRECTORY_SYNC_INTERVAL")
+}
+
+func loadBadgesConfig() {
+	viper.SetDefault("badges.definitions", []map[string]any{
+		{
+			"id": "first-recipe", "name": "First Recipe", "description": "Shared a first recipe",
+			"metric": "recipes", "threshold": 1,
+		},
+		{
+			"id": "followers-100", "name": "Crowd Pleaser", "description": "Reached 100 followers",
+			"metric": "followers", "threshold": 100,
+		},
+		{
+			"id": "member-1-year", "name": "One Year Member", "description": "Member for a year",
+			"metric": "membership_days", "threshold": 365,
+		},
+	})
+	viper.SetDefault("badges.evaluation_interval", defaultBadgeEvaluationInterval)
+	viper.SetDefault("badges.sweep_interval", defaultBadgeSweepInterval)
+
+	_ = viper.BindEnv("badges.evaluation_interval", "BADGES_EVALUATION_INTERVAL")
+	_ = viper.BindEnv("badges.sweep_interval", "BADGES_SWEEP_INTERVAL")
+}
+
+// secretSettings are the settings holding secrets, with the <VAR>_FILE variable (Docker/Kubernetes
+// secrets mounted as files) that can supply each one.
+var secretSettings = []struct {
+	key     string
+	fileEnv string
+}{
+	{key: "postgres.password", fileEnv: "POSTGRES_PASSWORD_FILE"},
+	{key: "redis.password", fileEnv: "REDIS_PASSWORD_FILE"},
+	{key: "oauth2.client_secret", fileEnv: "OAUTH2_CLIENT_SECRET_FILE"},
+	{key: "oauth2.jwt_secret", fileEnv: "OAUTH2_JWT_SECRET_FILE"},
+	{key: "secrets.vault.token", fileEnv: "VAULT_TOKEN_FILE"},
+	{key: "directory.bind_password", fileEnv: "DIRECTORY_BIND_PASSWORD_FILE"},
+	{key: "requestsigning.secret", fileEnv: "REQUEST_SIGNING_SECRET_FILE"},
+	{key: "requestsigning.previous_secret", fileEnv: "REQUEST_SIGNING_PREVIOUS_SECRET_FILE"},
+	{key: "contentmoderation.api.api_key", fileEnv: "CONTENT_MODERATION_API_KEY_FILE"},
+}
+
+// applySecretFiles reads the <VAR>_FILE variables that are set and uses the file contents in place
+// of the corresponding secret. A _FILE variant takes precedence over the plain variable and config
+// files.
+func applySecretFiles() {
+	for _, secret := range secretSettings {
+		path := os.Getenv(secret.fileEnv)
+		if path == "" {
+			continue
+		}
+
+		content, err := os.ReadFile(path) //nolint:gosec // path is operator-supplied configuration
+		if err != nil {
+			panic(fmt.Sprintf("failed to read %s: %s", secret.fileEnv, err))
+		}
+
+		viper.Set(secret.key, strings.TrimRight(string(content), "\r\n"))
+	}
+}
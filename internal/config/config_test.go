@@ -351,6 +351,28 @@ func TestLoadEnvironmentVariableBinding(t *testing.T) {
 	assert.Equal(t, "staging", cfg.Environment)
 }
 
+func TestLoadJobSchedulesFromEnv(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "config")
+	err := os.Mkdir(configDir, 0750)
+	require.NoError(t, err)
+
+	createConfigFile(t, configDir, serverConfigFileName, serverConfigFileContents)
+	createConfigFile(t, configDir, corsConfigFileName, corsConfigFileContents)
+	createConfigFile(t, configDir, loggingConfigFileName, loggingConfigFileContents)
+	createConfigFile(t, configDir, databaseConfigFileName, databaseConfigFileContents)
+
+	t.Chdir(tmpDir)
+	t.Setenv("JOBS_SEARCH_REINDEX_SCHEDULE", "0 4 * * *")
+
+	cfg := Load()
+
+	// Jobs without a schedule keep running on their interval
+	assert.Equal(t, map[string]string{"search-reindex": "0 4 * * *"}, cfg.Jobs.Schedules)
+}
+
 func setPostgresEnv(t *testing.T) {
 	t.Helper()
 	t.Setenv("POSTGRES_HOST", "db.example.com")
@@ -428,3 +450,260 @@ func assertDownstreamServicesConfig(t *testing.T, cfg *Config) {
 	assert.Equal(t, "http://notification.example.com/api/v1", cfg.DownstreamServices.Notification.BaseURL)
 	assert.Equal(t, 45*time.Second, cfg.DownstreamServices.Notification.Timeout)
 }
+
+//nolint:paralleltest // t.Chdir modifies process-level working directory, cannot run in parallel
+func TestLoadRateLimitConfig(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "config")
+	err := os.Mkdir(configDir, 0750)
+	require.NoError(t, err)
+
+	createConfigFile(t, configDir, serverConfigFileName, serverConfigFileContents)
+	createConfigFile(t, configDir, corsConfigFileName, corsConfigFileContents)
+	createConfigFile(t, configDir, loggingConfigFileName, loggingConfigFileContents)
+	createConfigFile(t, configDir, databaseConfigFileName, databaseConfigFileContents)
+	createConfigFile(t, configDir, "ratelimit.yaml", `
+ratelimit:
+  anonymous_limit: 5
+  anonymous_disabled_groups: ["shared-profiles"]
+`)
+
+	t.Chdir(tmpDir)
+
+	t.Setenv("RATE_LIMIT_ENABLED", "")
+	t.Setenv("RATE_LIMIT_WINDOW", "")
+	t.Setenv("RATE_LIMIT_AUTHENTICATED_LIMIT", "")
+	t.Setenv("RATE_LIMIT_ANONYMOUS_LIMIT", "")
+	t.Setenv("RATE_LIMIT_ANONYMOUS_DISABLED_GROUPS", "")
+
+	cfg := Load()
+
+	assert.True(t, cfg.RateLimit.Enabled)
+	assert.Equal(t, time.Minute, cfg.RateLimit.Window)
+	assert.Equal(t, 300, cfg.RateLimit.AuthenticatedLimit)
+	assert.Equal(t, 5, cfg.RateLimit.AnonymousLimit)
+	assert.Equal(t, []string{"shared-profiles"}, cfg.RateLimit.AnonymousDisabledGroups)
+}
+
+//nolint:paralleltest // t.Chdir modifies process-level working directory, cannot run in parallel
+func TestLoadIPFilterConfig(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "config")
+	err := os.Mkdir(configDir, 0750)
+	require.NoError(t, err)
+
+	createConfigFile(t, configDir, serverConfigFileName, serverConfigFileContents)
+	createConfigFile(t, configDir, corsConfigFileName, corsConfigFileContents)
+	createConfigFile(t, configDir, loggingConfigFileName, loggingConfigFileContents)
+	createConfigFile(t, configDir, databaseConfigFileName, databaseConfigFileContents)
+	createConfigFile(t, configDir, "ipfilter.yaml", `
+ipfilter:
+  enabled: true
+  trusted_proxies: ["10.0.0.0/8"]
+  admin:
+    allow: ["192.168.1.0/24"]
+`)
+
+	t.Chdir(tmpDir)
+
+	t.Setenv("IP_FILTER_ENABLED", "")
+	t.Setenv("IP_FILTER_TRUSTED_PROXIES", "")
+	t.Setenv("IP_FILTER_ADMIN_ALLOW", "")
+	t.Setenv("IP_FILTER_ADMIN_DENY", "")
+	t.Setenv("IP_FILTER_INTERNAL_ALLOW", "")
+	t.Setenv("IP_FILTER_FILE", "")
+	t.Setenv("IP_FILTER_INTERNAL_DENY", "172.16.0.0/12,203.0.113.7")
+
+	cfg := Load()
+
+	assert.True(t, cfg.IPFilter.Enabled)
+	assert.Equal(t, []string{"10.0.0.0/8"}, cfg.IPFilter.TrustedProxies)
+	assert.Equal(t, []string{"192.168.1.0/24"}, cfg.IPFilter.Admin.Allow)
+	assert.Empty(t, cfg.IPFilter.Admin.Deny)
+	assert.Equal(t, []string{"172.16.0.0/12", "203.0.113.7"}, cfg.IPFilter.Internal.Deny)
+	assert.Equal(t, "config/ipfilter.yaml", cfg.IPFilter.File)
+
+	t.Run("reading the file again", func(t *testing.T) {
+		createConfigFile(t, configDir, "ipfilter.yaml",
+			"ipfilter:\n  enabled: true\n  admin:\n    allow: [\"192.168.2.1\"]\n")
+
+		reread, err := ReadIPFilterFile(filepath.Join(configDir, "ipfilter.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"192.168.2.1"}, reread.Admin.Allow)
+		assert.Equal(t, []string{"172.16.0.0/12", "203.0.113.7"}, reread.Internal.Deny, "env overrides still apply")
+
+		createConfigFile(t, configDir, "ipfilter.yaml", "ipfilter:\n  admin:\n    allow: [\"192.168.2.300\"]\n")
+
+		_, err = ReadIPFilterFile(filepath.Join(configDir, "ipfilter.yaml"))
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
+
+//nolint:paralleltest // t.Chdir modifies process-level working directory, cannot run in parallel
+func TestLoadSecretFiles(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "config")
+	err := os.Mkdir(configDir, 0750)
+	require.NoError(t, err)
+
+	createConfigFile(t, configDir, serverConfigFileName, serverConfigFileContents)
+	createConfigFile(t, configDir, corsConfigFileName, corsConfigFileContents)
+	createConfigFile(t, configDir, loggingConfigFileName, loggingConfigFileContents)
+	createConfigFile(t, configDir, databaseConfigFileName, databaseConfigFileContents)
+
+	passwordFile := filepath.Join(tmpDir, "postgres_password")
+	err = os.WriteFile(passwordFile, []byte("from-file\n"), 0600)
+	require.NoError(t, err)
+
+	t.Chdir(tmpDir)
+
+	t.Setenv("POSTGRES_PASSWORD", "from-env")
+	t.Setenv("POSTGRES_PASSWORD_FILE", passwordFile)
+	t.Setenv("REDIS_PASSWORD_FILE", "")
+
+	cfg := Load()
+
+	assert.Equal(t, "from-file", cfg.Postgres.Password)
+}
+
+//nolint:paralleltest // t.Chdir modifies process-level working directory, cannot run in parallel
+func TestLoadPanicOnUnreadableSecretFile(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "config")
+	err := os.Mkdir(configDir, 0750)
+	require.NoError(t, err)
+
+	createConfigFile(t, configDir, serverConfigFileName, serverConfigFileContents)
+	createConfigFile(t, configDir, corsConfigFileName, corsConfigFileContents)
+	createConfigFile(t, configDir, loggingConfigFileName, loggingConfigFileContents)
+	createConfigFile(t, configDir, databaseConfigFileName, databaseConfigFileContents)
+
+	t.Chdir(tmpDir)
+
+	t.Setenv("REDIS_PASSWORD_FILE", filepath.Join(tmpDir, "missing"))
+
+	assert.Panics(t, func() { Load() })
+}
+
+//nolint:paralleltest // t.Chdir modifies process-level working directory, cannot run in parallel
+func TestLoadTLSConfig(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "config")
+	err := os.Mkdir(configDir, 0750)
+	require.NoError(t, err)
+
+	createConfigFile(t, configDir, serverConfigFileName, `
+server:
+  port: 8443
+  tls:
+    enabled: true
+    cert_file: /etc/tls/tls.crt
+    key_file: /etc/tls/tls.key
+    redirect_port: 8080
+`)
+	createConfigFile(t, configDir, corsConfigFileName, corsConfigFileContents)
+	createConfigFile(t, configDir, loggingConfigFileName, loggingConfigFileContents)
+	createConfigFile(t, configDir, databaseConfigFileName, databaseConfigFileContents)
+
+	t.Chdir(tmpDir)
+
+	t.Setenv("SERVER_TLS_ENABLED", "")
+	t.Setenv("SERVER_TLS_MIN_VERSION", "1.3")
+	t.Setenv("SERVER_TLS_HTTP2", "")
+
+	cfg := Load()
+
+	assert.True(t, cfg.Server.TLS.Enabled)
+	assert.Equal(t, "/etc/tls/tls.crt", cfg.Server.TLS.CertFile)
+	assert.Equal(t, "/etc/tls/tls.key", cfg.Server.TLS.KeyFile)
+	assert.Equal(t, "1.3", cfg.Server.TLS.MinVersion)
+	assert.True(t, cfg.Server.TLS.HTTP2)
+	assert.Equal(t, 8080, cfg.Server.TLS.RedirectPort)
+}
+
+//nolint:paralleltest // t.Chdir modifies process-level working directory, cannot run in parallel
+func TestLoadEnvironmentOverlays(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "config")
+	err := os.Mkdir(configDir, 0750)
+	require.NoError(t, err)
+
+	createConfigFile(t, configDir, serverConfigFileName, `
+server:
+  port: 8080
+  timeout: 5s
+`)
+	createConfigFile(t, configDir, "server.staging.yaml", `
+server:
+  port: 9090
+  timeout: 10s
+`)
+	createConfigFile(t, configDir, "ratelimit.staging.yaml", `
+ratelimit:
+  anonymous_limit: 5
+`)
+	createConfigFile(t, configDir, "server.production.yaml", "server:\n  port: 443")
+	createConfigFile(t, configDir, corsConfigFileName, corsConfigFileContents)
+	createConfigFile(t, configDir, loggingConfigFileName, loggingConfigFileContents)
+	createConfigFile(t, configDir, databaseConfigFileName, databaseConfigFileContents)
+
+	t.Chdir(tmpDir)
+
+	t.Setenv("ENVIRONMENT", "staging")
+	t.Setenv("SERVER_PORT", "")
+	t.Setenv("RATE_LIMIT_ANONYMOUS_LIMIT", "")
+	t.Setenv("SERVER_TIMEOUT", "")
+
+	cfg := Load()
+
+	// The overlay of the current environment wins over its base file, even without one
+	assert.Equal(t, 9090, cfg.Server.Port)
+	assert.Equal(t, 5, cfg.RateLimit.AnonymousLimit)
+
+	// Environment variables win over overlays
+	viper.Reset()
+	t.Setenv("SERVER_PORT", "7070")
+
+	cfg = Load()
+
+	assert.Equal(t, 7070, cfg.Server.Port)
+	assert.Equal(t, 10*time.Second, cfg.Server.Timeout)
+}
+
+//nolint:paralleltest // t.Chdir modifies process-level working directory, cannot run in parallel
+func TestLoadPanicOnUnknownSetting(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "config")
+	err := os.Mkdir(configDir, 0750)
+	require.NoError(t, err)
+
+	createConfigFile(t, configDir, serverConfigFileName, serverConfigFileContents)
+	createConfigFile(t, configDir, "server.development.yaml", "server:\n  prot: 9090")
+	createConfigFile(t, configDir, corsConfigFileName, corsConfigFileContents)
+	createConfigFile(t, configDir, loggingConfigFileName, loggingConfigFileContents)
+	createConfigFile(t, configDir, databaseConfigFileName, databaseConfigFileContents)
+
+	t.Chdir(tmpDir)
+
+	t.Setenv("ENVIRONMENT", "")
+
+	_, err = LoadAndValidate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "prot")
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+)
+
+// maskedSecret replaces the value of every secret that is set in the effective configuration.
+const maskedSecret = "********"
+
+// EffectiveSettings returns the settings the last load merged from every layer, keyed as in the
+// config files. Secrets that are set read as a mask, so the result is safe to print or attach to a
+// ticket; an empty secret stays empty to show it is missing.
+func EffectiveSettings() map[string]any {
+	settings, _ := printable(viper.AllSettings()).(map[string]any)
+
+	for _, secret := range secretSettings {
+		if viper.GetString(secret.key) != "" {
+			maskSetting(settings, strings.Split(secret.key, "."))
+		}
+	}
+
+	return settings
+}
+
+// WriteEffective writes EffectiveSettings to w as YAML.
+func WriteEffective(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	err := encoder.Encode(EffectiveSettings())
+	if err != nil {
+		return fmt.Errorf("failed to write effective config: %w", err)
+	}
+
+	return encoder.Close()
+}
+
+// printable copies a settings value, writing durations the way config files spell them rather than
+// as nanoseconds.
+func printable(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[key] = printable(item)
+		}
+
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = printable(item)
+		}
+
+		return out
+	case []map[string]any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = printable(item)
+		}
+
+		return out
+	case time.Duration:
+		return v.String()
+	default:
+		return v
+	}
+}
+
+// maskSetting replaces the setting at path with the mask, creating the sections a secret that was
+// only set from the environment lacks.
+func maskSetting(settings map[string]any, path []string) {
+	for _, section := range path[:len(path)-1] {
+		next, ok := settings[section].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			settings[section] = next
+		}
+
+		settings = next
+	}
+
+	settings[path[len(path)-1]] = maskedSecret
+}
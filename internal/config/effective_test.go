@@ -0,0 +1,53 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:paralleltest // t.Chdir modifies process-level working directory, cannot run in parallel
+func TestWriteEffective(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "config")
+	err := os.Mkdir(configDir, 0750)
+	require.NoError(t, err)
+
+	createConfigFile(t, configDir, serverConfigFileName, serverConfigFileContents)
+	createConfigFile(t, configDir, corsConfigFileName, corsConfigFileContents)
+	createConfigFile(t, configDir, loggingConfigFileName, loggingConfigFileContents)
+	createConfigFile(t, configDir, databaseConfigFileName, databaseConfigFileContents)
+
+	secretFile := filepath.Join(tmpDir, "jwt_secret")
+	err = os.WriteFile(secretFile, []byte("from-file\n"), 0600)
+	require.NoError(t, err)
+
+	t.Chdir(tmpDir)
+
+	t.Setenv("POSTGRES_PASSWORD", "hunter2")
+	t.Setenv("OAUTH2_JWT_SECRET_FILE", secretFile)
+	t.Setenv("REDIS_PASSWORD", "")
+	t.Setenv("REDIS_PASSWORD_FILE", "")
+	t.Setenv("SHADOW_TIMEOUT", "")
+
+	Load()
+
+	var out strings.Builder
+
+	require.NoError(t, WriteEffective(&out))
+	assert.NotContains(t, out.String(), "hunter2")
+	assert.NotContains(t, out.String(), "from-file")
+
+	settings := EffectiveSettings()
+	assert.Equal(t, maskedSecret, settings["postgres"].(map[string]any)["password"])
+	assert.Equal(t, maskedSecret, settings["oauth2"].(map[string]any)["jwt_secret"])
+	assert.Empty(t, settings["redis"].(map[string]any)["password"], "missing secrets stay visible as empty")
+	assert.Equal(t, "5s", settings["shadow"].(map[string]any)["timeout"])
+}
@@ -0,0 +1,1076 @@
+package config
+
+import (
+	"crypto/tls"
+	"fmt"
+	"maps"
+	"math"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/errortracking"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/logger"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/scheduler/cron"
+)
+
+const maxPort = 65535
+
+// maxMaintenanceMessage matches the limit of messages set through PUT /admin/maintenance.
+const maxMaintenanceMessage = 500
+
+// maxMentionUsernames bounds a mention batch, which is looked up in one query.
+const maxMentionUsernames = 1000
+
+// maxRecentlyViewedLimit bounds the recently viewed list, which is resolved in one query.
+const maxRecentlyViewedLimit = 100
+
+var (
+	validLogLevels           = []string{"debug", "info", "warn", "error"}
+	validLogFormats          = []string{"json", "text"}
+	validSecretProviders     = []string{"vault"}
+	validTLSVersions         = []string{"1.2", "1.3"}
+	validListenerTypes       = []string{"tcp", "unix"}
+	validStorageBackends     = []string{StorageBackendPostgres, StorageBackendMemory}
+	validPreferenceStorages  = []string{PreferenceStorageTables, PreferenceStorageJSONB}
+	validTypeaheadSource     = []string{"postgres", "verify", "index"}
+	validBadgeMetrics        = []string{"recipes", "followers", "membership_days"}
+	validDirectoryRules      = []string{"directory", "local"}
+	validModerators          = []string{"wordlist", "api"}
+	validContentActions      = []string{"reject", "flag", "mask"}
+	validInvariantSeverities = []string{InvariantSeverityOff, InvariantSeverityWarn, InvariantSeverityFail}
+	devEnvironments          = []string{"development", "local"}
+)
+
+// ValidationError reports every problem found in a configuration at once.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "invalid configuration (%d problems):", len(e.Problems))
+
+	for _, problem := range e.Problems {
+		b.WriteString("\n  - ")
+		b.WriteString(problem)
+	}
+
+	return b.String()
+}
+
+// LoadAndValidate loads the configuration like Load but, instead of panicking on the first
+// problem, runs the full validation pass and returns a consolidated ValidationError.
+func LoadAndValidate() (cfg *Config, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			cfg = nil
+			err = &ValidationError{Problems: []string{fmt.Sprint(r)}}
+		}
+	}()
+
+	cfg = load()
+
+	err = Validate(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Validate checks required fields, value ranges, enum values and mutually exclusive options.
+// It returns a *ValidationError listing every problem, or nil if the configuration is valid.
+func Validate(cfg *Config) error {
+	var problems []string
+
+	problems = append(problems, validateServer(&cfg.Server)...)
+	problems = append(problems, validateLogging(&cfg.Logging, cfg.Environment)...)
+	problems = append(problems, validatePostgres(&cfg.Postgres)...)
+	problems = append(problems, validateRedis(&cfg.Redis)...)
+	problems = append(problems, validateOAuth2(&cfg.OAuth2)...)
+	problems = append(problems, validateOAuth2Modes(&cfg.OAuth2)...)
+	problems = append(problems, validateDownstreamServices(&cfg.DownstreamServices)...)
+	problems = append(problems, validateRateLimit(&cfg.RateLimit)...)
+	problems = append(problems, validateSecrets(&cfg.Secrets)...)
+	problems = append(problems, validateDiagnostics(&cfg.Diagnostics, cfg.Server.Port)...)
+	problems = append(problems, validateShadow(&cfg.Shadow)...)
+	problems = append(problems, validateStorage(&cfg.Storage)...)
+	problems = append(problems, validatePolicies(&cfg.Policies)...)
+	problems = append(problems, validatePush(&cfg.Push)...)
+	problems = append(problems, validateSocial(&cfg.Social)...)
+	problems = append(problems, validateInvitations(&cfg.Invitations)...)
+	problems = append(problems, validatePreferenceCenter(&cfg.PreferenceCenter)...)
+	problems = append(problems, validateListUnsubscribe(&cfg.ListUnsubscribe)...)
+	problems = append(problems, validateSearch(&cfg.Search)...)
+	problems = append(problems, validateMaintenance(&cfg.Maintenance)...)
+	problems = append(problems, validateJobs(&cfg.Jobs)...)
+	problems = append(problems, validatePresence(&cfg.Presence)...)
+	problems = append(problems, validateProfileViews(&cfg.ProfileViews)...)
+	problems = append(problems, validateRecentlyViewed(&cfg.RecentlyViewed)...)
+	problems = append(problems, validateBadges(&cfg.Badges)...)
+	problems = append(problems, validateMentions(&cfg.Mentions)...)
+	problems = append(problems, validateEmbed(&cfg.Embed)...)
+	problems = append(problems, validateDirectory(&cfg.Directory)...)
+	problems = append(problems, validatePreferences(&cfg.Preferences)...)
+	problems = append(problems, validateEvents(&cfg.Events)...)
+	problems = append(problems, validateErrorTracking(&cfg.ErrorTracking)...)
+	problems = append(problems, validateHashing(&cfg.Hashing)...)
+	problems = append(problems, validateIPFilter(&cfg.IPFilter)...)
+	problems = append(problems, validateRequestSigning(&cfg.RequestSigning)...)
+	problems = append(problems, validateContentModeration(&cfg.ContentModeration)...)
+	problems = append(problems, validateProfile(&cfg.Profile)...)
+	problems = append(problems, validatePublicProfiles(&cfg.PublicProfiles)...)
+	problems = append(problems, validateReadiness(&cfg.Readiness)...)
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+func validateServer(cfg *ServerConfig) []string {
+	var problems []string
+
+	problems = appendPortProblem(problems, "server.port", cfg.Port)
+
+	if cfg.Timeout < 0 || cfg.IdleTimeout < 0 || cfg.ReadTimeout < 0 || cfg.WriteTimeout < 0 {
+		problems = append(problems, "server timeouts must not be negative")
+	}
+
+	if cfg.DrainPeriod < 0 || cfg.ShutdownTimeout < 0 {
+		problems = append(problems, "server.drain_period and server.shutdown_timeout must not be negative")
+	}
+
+	if cfg.BasePath != "" && !isValidBasePath(cfg.BasePath) {
+		problems = append(problems, fmt.Sprintf(
+			"server.base_path must start with / and not end with / or contain spaces, got %q", cfg.BasePath))
+	}
+
+	problems = append(problems, validateTLS(&cfg.TLS, cfg.Port)...)
+	problems = append(problems, validateListeners(cfg.Listeners)...)
+	problems = append(problems, validateSecurityHeaders(&cfg.SecurityHeaders)...)
+
+	return problems
+}
+
+func validateSecurityHeaders(cfg *SecurityHeadersConfig) []string {
+	var problems []string
+
+	if cfg.HSTSMaxAge < 0 {
+		problems = append(problems, fmt.Sprintf("server.security_headers.hsts_max_age must not be negative, got %s",
+			cfg.HSTSMaxAge))
+	}
+
+	if cfg.DocsPath != "" && !strings.HasPrefix(cfg.DocsPath, "/") {
+		problems = append(problems, fmt.Sprintf("server.security_headers.docs_path must start with /, got %q", cfg.DocsPath))
+	}
+
+	for i, override := range cfg.Overrides {
+		if !strings.HasPrefix(override.PathPrefix, "/") {
+			problems = append(problems, fmt.Sprintf(
+				"server.security_headers.overrides[%d].path_prefix must start with /, got %q", i, override.PathPrefix))
+		}
+	}
+
+	return problems
+}
+
+// isValidBasePath reports whether path can prefix routes: rooted, without a trailing slash and
+// without characters that end or split a URL path.
+func isValidBasePath(path string) bool {
+	return len(path) > 1 && strings.HasPrefix(path, "/") && !strings.HasSuffix(path, "/") &&
+		!strings.ContainsAny(path, " ?#\t\n")
+}
+
+func validateTLS(cfg *TLSConfig, port int) []string {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var problems []string
+
+	problems = appendRequiredProblem(problems, "server.tls.cert_file", cfg.CertFile)
+	problems = appendRequiredProblem(problems, "server.tls.key_file", cfg.KeyFile)
+	problems = appendEnumProblem(problems, "server.tls.min_version", cfg.MinVersion, validTLSVersions)
+
+	for _, name := range cfg.CipherSuites {
+		if !isSecureCipherSuite(name) {
+			problems = append(problems, fmt.Sprintf("server.tls.cipher_suites contains unknown or insecure suite %q", name))
+		}
+	}
+
+	if cfg.RedirectPort != 0 {
+		problems = appendPortProblem(problems, "server.tls.redirect_port", cfg.RedirectPort)
+
+		if cfg.RedirectPort == port {
+			problems = append(problems, "server.tls.redirect_port must differ from server.port")
+		}
+	}
+
+	return problems
+}
+
+func validateListeners(listeners []ListenerConfig) []string {
+	var problems []string
+
+	seen := make(map[string]bool)
+
+	for i, listener := range listeners {
+		field := fmt.Sprintf("server.listeners[%d]", i)
+
+		if listener.Network == "" {
+			problems = append(problems, field+".network is required")
+		} else {
+			problems = appendEnumProblem(problems, field+".network", listener.Network, validListenerTypes)
+		}
+
+		problems = appendRequiredProblem(problems, field+".address", listener.Address)
+
+		if listener.SocketMode != "" {
+			if listener.Network != "unix" {
+				problems = append(problems, field+".socket_mode only applies to unix listeners")
+			} else if _, err := strconv.ParseUint(listener.SocketMode, 8, 32); err != nil {
+				problems = append(problems, fmt.Sprintf("%s.socket_mode must be an octal file mode, got %q",
+					field, listener.SocketMode))
+			}
+		}
+
+		key := listener.Network + "://" + listener.Address
+		if listener.Address != "" && seen[key] {
+			problems = append(problems, fmt.Sprintf("%s duplicates listener %s", field, key))
+		}
+
+		seen[key] = true
+	}
+
+	return problems
+}
+
+func isSecureCipherSuite(name string) bool {
+	for _, suite := range tls.CipherSuites() {
+		if suite.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateLogging(cfg *LoggingConfig, environment string) []string {
+	var problems []string
+
+	problems = appendEnumProblem(problems, "logging.consolelevel", cfg.ConsoleLevel, validLogLevels)
+	problems = appendEnumProblem(problems, "logging.filelevel", cfg.FileLevel, validLogLevels)
+	problems = appendEnumProblem(problems, "logging.format", cfg.Format, validLogFormats)
+
+	if cfg.FileEnabled && cfg.File == "" {
+		problems = append(problems, "logging.file is required when logging.fileenabled is true")
+	}
+
+	if cfg.MaxSize < 0 || cfg.MaxBackups < 0 || cfg.MaxAge < 0 {
+		problems = append(problems, "logging.maxsize, logging.maxbackups and logging.maxage must not be negative")
+	}
+
+	if cfg.LogSensitiveData && !slices.Contains(devEnvironments, strings.ToLower(environment)) {
+		problems = append(problems, fmt.Sprintf(
+			"logging.logsensitivedata is only allowed in development, got environment %q", environment))
+	}
+
+	return problems
+}
+
+func validatePostgres(cfg *PostgresConfig) []string {
+	var problems []string
+
+	problems = appendRequiredProblem(problems, "postgres.host", cfg.Host)
+	problems = appendRequiredProblem(problems, "postgres.database", cfg.Database)
+	problems = appendRequiredProblem(problems, "postgres.user", cfg.User)
+	problems = appendPortProblem(problems, "postgres.port", cfg.Port)
+
+	return problems
+}
+
+func validateRedis(cfg *RedisConfig) []string {
+	var problems []string
+
+	problems = appendRequiredProblem(problems, "redis.host", cfg.Host)
+	problems = appendPortProblem(problems, "redis.port", cfg.Port)
+
+	if cfg.Database < 0 {
+		problems = append(problems, fmt.Sprintf("redis.database must not be negative, got %d", cfg.Database))
+	}
+
+	// The tenant is one segment of the key namespace
+	if strings.ContainsAny(cfg.Tenant, ": \t") {
+		problems = append(problems, fmt.Sprintf("redis.tenant must not contain ':' or spaces, got %q", cfg.Tenant))
+	}
+
+	if cfg.PruneInterval < 0 {
+		problems = append(problems, fmt.Sprintf("redis.pruneinterval must not be negative, got %s", cfg.PruneInterval))
+	}
+
+	// An empty prefix would scan, and could prune, the whole database
+	for _, prefix := range cfg.PrunePrefixes {
+		if strings.TrimSpace(prefix) == "" {
+			problems = append(problems, "redis.pruneprefixes must not contain empty prefixes")
+
+			break
+		}
+	}
+
+	return problems
+}
+
+// validateOAuth2 checks the fields required when OAuth2 is enabled. Load panics with the first
+// of these problems, so the order and wording are part of its contract.
+func validateOAuth2(cfg *OAuth2Config) []string {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var problems []string
+
+	if cfg.ClientID == "" {
+		problems = append(problems, "oauth2.client_id is required when oauth2 is enabled")
+	}
+
+	if cfg.ClientSecret == "" {
+		problems = append(problems, "oauth2.client_secret is required when oauth2 is enabled")
+	}
+
+	if cfg.BaseAuthURL == "" {
+		problems = append(problems, "oauth2.base_auth_url is required when oauth2 is enabled")
+	}
+
+	if cfg.GetTokenPath == "" {
+		problems = append(problems, "oauth2.get_token_path is required when oauth2 is enabled")
+	}
+
+	return problems
+}
+
+// validateOAuth2Modes checks the token validation mode is fully and consistently configured.
+func validateOAuth2Modes(cfg *OAuth2Config) []string {
+	var problems []string
+
+	incomingAuth := cfg.Enabled || cfg.ServiceEnabled
+
+	switch {
+	case cfg.IntrospectionEnabled && !incomingAuth:
+		problems = append(problems,
+			"oauth2.introspection_enabled requires oauth2.enabled or oauth2.service_enabled")
+	case cfg.IntrospectionEnabled && cfg.IntrospectionPath == "":
+		problems = append(problems, "oauth2.introspectionpath is required when oauth2.introspection_enabled is true")
+	case incomingAuth && !cfg.IntrospectionEnabled && cfg.JWTSecret == "":
+		problems = append(problems,
+			"oauth2.jwt_secret is required for local JWT validation (set it or enable oauth2.introspection_enabled)")
+	}
+
+	return problems
+}
+
+func validateDownstreamServices(cfg *DownstreamServicesConfig) []string {
+	var problems []string
+
+	if cfg.Notification.Enabled && cfg.Notification.BaseURL == "" {
+		problems = append(problems,
+			"downstreamservices.notification.base_url is required when the notification service is enabled")
+	}
+
+	if cfg.Notification.Timeout < 0 {
+		problems = append(problems, "downstreamservices.notification.timeout must not be negative")
+	}
+
+	return problems
+}
+
+func validateRateLimit(cfg *RateLimitConfig) []string {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var problems []string
+
+	if cfg.Window <= 0 {
+		problems = append(problems, "ratelimit.window must be positive when rate limiting is enabled")
+	}
+
+	if cfg.AuthenticatedLimit < 0 || cfg.AnonymousLimit < 0 {
+		problems = append(problems, "ratelimit limits must not be negative")
+	}
+
+	if cfg.AuthenticatedLimit > 0 && cfg.AnonymousLimit > cfg.AuthenticatedLimit {
+		problems = append(problems, fmt.Sprintf(
+			"ratelimit.anonymous_limit (%d) must not exceed ratelimit.authenticated_limit (%d)",
+			cfg.AnonymousLimit, cfg.AuthenticatedLimit))
+	}
+
+	return problems
+}
+
+func validateIPFilter(cfg *IPFilterConfig) []string {
+	var problems []string
+
+	problems = appendIPEntryProblems(problems, "ipfilter.trusted_proxies", cfg.TrustedProxies)
+	problems = appendIPEntryProblems(problems, "ipfilter.admin.allow", cfg.Admin.Allow)
+	problems = appendIPEntryProblems(problems, "ipfilter.admin.deny", cfg.Admin.Deny)
+	problems = appendIPEntryProblems(problems, "ipfilter.internal.allow", cfg.Internal.Allow)
+	problems = appendIPEntryProblems(problems, "ipfilter.internal.deny", cfg.Internal.Deny)
+
+	return problems
+}
+
+// appendIPEntryProblems reports entries that are neither a CIDR nor an IP address.
+func appendIPEntryProblems(problems []string, key string, entries []string) []string {
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+
+		var err error
+		if strings.Contains(entry, "/") {
+			_, err = netip.ParsePrefix(entry)
+		} else {
+			_, err = netip.ParseAddr(entry)
+		}
+
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %q is not a CIDR or IP address", key, entry))
+		}
+	}
+
+	return problems
+}
+
+// minRequestSigningSecretLength is the shortest secret accepted, matching the HMAC-SHA256 key size.
+const minRequestSigningSecretLength = 32
+
+func validateRequestSigning(cfg *RequestSigningConfig) []string {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var problems []string
+
+	if len(cfg.Secret) < minRequestSigningSecretLength {
+		problems = append(problems, fmt.Sprintf(
+			"requestsigning.secret must be at least %d bytes when request signing is enabled", minRequestSigningSecretLength))
+	}
+
+	if cfg.PreviousSecret != "" && len(cfg.PreviousSecret) < minRequestSigningSecretLength {
+		problems = append(problems, fmt.Sprintf(
+			"requestsigning.previous_secret must be at least %d bytes", minRequestSigningSecretLength))
+	}
+
+	if cfg.MaxSkew <= 0 {
+		problems = append(problems, "requestsigning.max_skew must be positive when request signing is enabled")
+	}
+
+	return problems
+}
+
+func validateSecrets(cfg *SecretsConfig) []string {
+	var problems []string
+
+	problems = appendEnumProblem(problems, "secrets.provider", cfg.Provider, validSecretProviders)
+
+	if cfg.Provider == "vault" {
+		problems = appendRequiredProblem(problems, "secrets.vault.address", cfg.Vault.Address)
+		problems = appendRequiredProblem(problems, "secrets.vault.token", cfg.Vault.Token)
+		problems = appendRequiredProblem(problems, "secrets.vault.path", cfg.Vault.Path)
+	}
+
+	if cfg.CacheTTL < 0 || cfg.RefreshInterval < 0 {
+		problems = append(problems, "secrets.cache_ttl and secrets.refresh_interval must not be negative")
+	}
+
+	return problems
+}
+
+func validateDiagnostics(cfg *DiagnosticsConfig, serverPort int) []string {
+	problems := validateSlowRequests(&cfg.SlowRequests)
+
+	if !cfg.Enabled || cfg.Port == 0 {
+		return problems
+	}
+
+	problems = appendPortProblem(problems, "diagnostics.port", cfg.Port)
+
+	if cfg.Port == serverPort {
+		problems = append(problems, "diagnostics.port must differ from server.port")
+	}
+
+	if _, err := netip.ParseAddr(cfg.Host); cfg.Host != "" && err != nil {
+		problems = append(problems, fmt.Sprintf("diagnostics.host must be an IP address, got %q", cfg.Host))
+	}
+
+	return problems
+}
+
+func validateShadow(cfg *ShadowConfig) []string {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var problems []string
+
+	if cfg.SamplePercent < 0 || cfg.SamplePercent > 100 {
+		problems = append(problems, fmt.Sprintf("shadow.sample_percent must be between 0 and 100, got %g",
+			cfg.SamplePercent))
+	}
+
+	if cfg.Timeout < 0 || cfg.MaxInFlight < 0 {
+		problems = append(problems, "shadow.timeout and shadow.max_in_flight must not be negative")
+	}
+
+	return problems
+}
+
+func validateStorage(cfg *StorageConfig) []string {
+	var problems []string
+
+	problems = appendEnumProblem(problems, "storage.backend", cfg.Backend, validStorageBackends)
+
+	if cfg.FixturesFile != "" && cfg.Backend != StorageBackendMemory {
+		problems = append(problems, "storage.fixtures_file only applies to the memory backend")
+	}
+
+	return problems
+}
+
+func validatePolicies(cfg *PoliciesConfig) []string {
+	if cfg.EnforceAcceptance && cfg.TermsVersion == "" && cfg.PrivacyVersion == "" {
+		return []string{"policies.enforce_acceptance requires policies.terms_version or policies.privacy_version"}
+	}
+
+	return nil
+}
+
+func validatePush(cfg *PushConfig) []string {
+	if cfg.MaxDevicesPerUser < 0 || cfg.StaleAfter < 0 || cfg.CleanupInterval < 0 {
+		return []string{"push.max_devices_per_user, push.stale_after and push.cleanup_interval must not be negative"}
+	}
+
+	return nil
+}
+
+func validateSocial(cfg *SocialConfig) []string {
+	var problems []string
+
+	if cfg.FollowHistoryRetention < 0 || cfg.FollowHistoryPurgeInterval < 0 {
+		problems = append(problems,
+			"social.follow_history_retention and social.follow_history_purge_interval must not be negative")
+	}
+
+	if cfg.MaxFollowing < 0 {
+		problems = append(problems, "social.max_following must not be negative")
+	}
+
+	if cfg.DigestPeriod <= 0 {
+		problems = append(problems, fmt.Sprintf("social.digest_period must be positive, got %s", cfg.DigestPeriod))
+	}
+
+	return problems
+}
+
+func validateInvitations(cfg *InvitationsConfig) []string {
+	var problems []string
+
+	if cfg.MaxPerUser < 0 {
+		problems = append(problems, "invitations.max_per_user must not be negative")
+	}
+
+	if cfg.TTL <= 0 {
+		problems = append(problems, fmt.Sprintf("invitations.ttl must be positive, got %s", cfg.TTL))
+	}
+
+	return problems
+}
+
+func validatePreferenceCenter(cfg *PreferenceCenterConfig) []string {
+	if cfg.TokenTTL <= 0 {
+		return []string{fmt.Sprintf("preferencecenter.token_ttl must be positive, got %s", cfg.TokenTTL)}
+	}
+
+	return nil
+}
+
+func validateListUnsubscribe(cfg *ListUnsubscribeConfig) []string {
+	var problems []string
+
+	if cfg.BaseURL != "" {
+		// RFC 8058 only allows one-click unsubscribes over HTTPS
+		u, err := url.Parse(cfg.BaseURL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("listunsubscribe.base_url must be an absolute https URL, got %q",
+				cfg.BaseURL))
+		}
+	}
+
+	if cfg.Mailto != "" {
+		if _, err := mail.ParseAddress(cfg.Mailto); err != nil {
+			problems = append(problems, fmt.Sprintf("listunsubscribe.mailto must be an email address, got %q", cfg.Mailto))
+		}
+
+		if cfg.BaseURL == "" {
+			problems = append(problems, "listunsubscribe.mailto requires listunsubscribe.base_url")
+		}
+	}
+
+	if cfg.TokenTTL <= 0 {
+		problems = append(problems, fmt.Sprintf("listunsubscribe.token_ttl must be positive, got %s", cfg.TokenTTL))
+	}
+
+	return problems
+}
+
+func validateSearch(cfg *SearchConfig) []string {
+	var problems []string
+
+	if cfg.TypeaheadCacheTTL < 0 || cfg.ResultsCacheTTL < 0 {
+		problems = append(problems, "search.typeahead_cache_ttl and search.results_cache_ttl must not be negative")
+	}
+
+	problems = appendEnumProblem(problems, "search.typeahead_source", cfg.TypeaheadSource, validTypeaheadSource)
+
+	if cfg.ReindexInterval < 0 || cfg.ReindexRate < 0 {
+		problems = append(problems, "search.reindex_interval and search.reindex_rate must not be negative")
+	}
+
+	return problems
+}
+
+func validateMaintenance(cfg *MaintenanceConfig) []string {
+	var problems []string
+
+	if cfg.RetryAfter < 0 || cfg.RefreshInterval < 0 {
+		problems = append(problems, "maintenance.retry_after and maintenance.refresh_interval must not be negative")
+	}
+
+	if len(cfg.Message) > maxMaintenanceMessage {
+		problems = append(problems, fmt.Sprintf("maintenance.message must be at most %d characters", maxMaintenanceMessage))
+	}
+
+	return problems
+}
+
+func validatePresence(cfg *PresenceConfig) []string {
+	var problems []string
+
+	if cfg.OnlineWindow <= 0 {
+		problems = append(problems, fmt.Sprintf("presence.online_window must be positive, got %s", cfg.OnlineWindow))
+	}
+
+	if cfg.Retention < cfg.OnlineWindow {
+		problems = append(problems, fmt.Sprintf("presence.retention must be at least presence.online_window, got %s",
+			cfg.Retention))
+	}
+
+	return problems
+}
+
+func validateProfileViews(cfg *ProfileViewsConfig) []string {
+	if cfg.FlushInterval < 0 {
+		return []string{fmt.Sprintf("profileviews.flush_interval must not be negative, got %s", cfg.FlushInterval)}
+	}
+
+	return nil
+}
+
+func validateRecentlyViewed(cfg *RecentlyViewedConfig) []string {
+	var problems []string
+
+	if cfg.Limit < 0 || cfg.Limit > maxRecentlyViewedLimit {
+		problems = append(problems, fmt.Sprintf("recentlyviewed.limit must be between 0 and %d, got %d",
+			maxRecentlyViewedLimit, cfg.Limit))
+	}
+
+	if cfg.Limit > 0 && cfg.Retention <= 0 {
+		problems = append(problems, fmt.Sprintf("recentlyviewed.retention must be positive, got %s", cfg.Retention))
+	}
+
+	return problems
+}
+
+func validateBadges(cfg *BadgesConfig) []string {
+	var problems []string
+
+	seen := make(map[string]bool, len(cfg.Definitions))
+
+	for i, badge := range cfg.Definitions {
+		field := fmt.Sprintf("badges.definitions[%d]", i)
+
+		switch {
+		case badge.ID == "":
+			problems = append(problems, field+".id is required")
+		case seen[badge.ID]:
+			problems = append(problems, fmt.Sprintf("%s.id %q is used by another badge", field, badge.ID))
+		}
+
+		seen[badge.ID] = true
+
+		if badge.Name == "" {
+			problems = append(problems, field+".name is required")
+		}
+
+		if !slices.Contains(validBadgeMetrics, badge.Metric) {
+			problems = append(problems, fmt.Sprintf("%s.metric must be one of [%s], got %q", field,
+				strings.Join(validBadgeMetrics, ", "), badge.Metric))
+		}
+
+		if badge.Threshold < 1 {
+			problems = append(problems, fmt.Sprintf("%s.threshold must be at least 1, got %d", field, badge.Threshold))
+		}
+	}
+
+	if cfg.EvaluationInterval < 0 || cfg.SweepInterval < 0 {
+		problems = append(problems, "badges.evaluation_interval and badges.sweep_interval must not be negative")
+	}
+
+	return problems
+}
+
+func validateMentions(cfg *MentionsConfig) []string {
+	var problems []string
+
+	if cfg.MaxUsernames < 1 || cfg.MaxUsernames > maxMentionUsernames {
+		problems = append(problems, fmt.Sprintf("mentions.max_usernames must be between 1 and %d, got %d",
+			maxMentionUsernames, cfg.MaxUsernames))
+	}
+
+	if cfg.CacheTTL < 0 {
+		problems = append(problems, fmt.Sprintf("mentions.cache_ttl must not be negative, got %s", cfg.CacheTTL))
+	}
+
+	return problems
+}
+
+func validateEmbed(cfg *EmbedConfig) []string {
+	var problems []string
+
+	templates := []struct{ field, value string }{
+		{"embed.profile_url", cfg.ProfileURL},
+		{"embed.avatar_url", cfg.AvatarURL},
+	}
+
+	for _, template := range templates {
+		if template.value == "" {
+			continue
+		}
+
+		u, err := url.Parse(template.value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("%s must be an absolute http(s) URL, got %q",
+				template.field, template.value))
+		}
+	}
+
+	if cfg.CacheTTL < 0 {
+		problems = append(problems, fmt.Sprintf("embed.cache_ttl must not be negative, got %s", cfg.CacheTTL))
+	}
+
+	return problems
+}
+
+func validateDirectory(cfg *DirectoryConfig) []string {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var problems []string
+
+	problems = appendRequiredProblem(problems, "directory.url", cfg.URL)
+	problems = appendRequiredProblem(problems, "directory.base_dn", cfg.BaseDN)
+	problems = appendRequiredProblem(problems, "directory.lookup_attribute", cfg.LookupAttribute)
+
+	if cfg.URL != "" && !strings.HasPrefix(cfg.URL, "ldap://") && !strings.HasPrefix(cfg.URL, "ldaps://") {
+		problems = append(problems, fmt.Sprintf("directory.url must be an ldap:// or ldaps:// URL, got %q", cfg.URL))
+	}
+
+	if cfg.BindDN != "" {
+		problems = appendRequiredProblem(problems, "directory.bind_password", cfg.BindPassword)
+	}
+
+	if cfg.BindPassword != "" && strings.HasPrefix(cfg.URL, "ldap://") && !cfg.StartTLS {
+		problems = append(problems, "directory.bind_password over an ldap:// URL requires directory.start_tls")
+	}
+
+	problems = appendEnumProblem(problems, "directory.rules.full_name", cfg.Rules.FullName, validDirectoryRules)
+	problems = appendEnumProblem(problems, "directory.rules.email", cfg.Rules.Email, validDirectoryRules)
+	problems = appendEnumProblem(problems, "directory.rules.is_active", cfg.Rules.IsActive, validDirectoryRules)
+
+	if cfg.Timeout < 0 || cfg.SyncInterval < 0 {
+		problems = append(problems, "directory.timeout and directory.sync_interval must not be negative")
+	}
+
+	return problems
+}
+
+func validateContentModeration(cfg *ContentModerationConfig) []string {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var problems []string
+
+	problems = appendEnumProblem(problems, "contentmoderation.provider", cfg.Provider, validModerators)
+	problems = appendEnumProblem(problems, "contentmoderation.action", cfg.Action, validContentActions)
+
+	switch cfg.Provider {
+	case "api":
+		problems = appendRequiredProblem(problems, "contentmoderation.api.url", cfg.API.URL)
+
+		if cfg.API.URL != "" && !strings.HasPrefix(cfg.API.URL, "http://") && !strings.HasPrefix(cfg.API.URL, "https://") {
+			problems = append(problems,
+				fmt.Sprintf("contentmoderation.api.url must be an http:// or https:// URL, got %q", cfg.API.URL))
+		}
+	default:
+		problems = appendRequiredProblem(problems, "contentmoderation.word_list_file", cfg.WordListFile)
+	}
+
+	if cfg.API.Timeout < 0 || cfg.API.Cooldown < 0 || cfg.API.FailureThreshold < 0 {
+		problems = append(problems,
+			"contentmoderation.api.timeout, cooldown and failure_threshold must not be negative")
+	}
+
+	return problems
+}
+
+func validateProfile(cfg *ProfileConfig) []string {
+	var problems []string
+
+	for _, limit := range []struct {
+		key   string
+		value int
+	}{
+		{"profile.username_min_length", cfg.UsernameMinLength},
+		{"profile.username_max_length", cfg.UsernameMaxLength},
+		{"profile.full_name_max_length", cfg.FullNameMaxLength},
+		{"profile.bio_max_length", cfg.BioMaxLength},
+	} {
+		if limit.value < 1 {
+			problems = append(problems, fmt.Sprintf("%s must be at least 1, got %d", limit.key, limit.value))
+		}
+	}
+
+	if cfg.UsernameMinLength > cfg.UsernameMaxLength {
+		problems = append(problems, fmt.Sprintf(
+			"profile.username_min_length (%d) must not be greater than profile.username_max_length (%d)",
+			cfg.UsernameMinLength, cfg.UsernameMaxLength))
+	}
+
+	return problems
+}
+
+func validatePublicProfiles(cfg *PublicProfilesConfig) []string {
+	var problems []string
+
+	if cfg.SyncInterval < 0 {
+		problems = append(problems, "publicprofiles.sync_interval must not be negative")
+	}
+
+	if cfg.MaxStaleness <= 0 {
+		problems = append(problems, fmt.Sprintf("publicprofiles.max_staleness must be positive, got %s",
+			cfg.MaxStaleness))
+	}
+
+	if cfg.BatchSize < 1 {
+		problems = append(problems, fmt.Sprintf("publicprofiles.batch_size must be at least 1, got %d", cfg.BatchSize))
+	}
+
+	return problems
+}
+
+func validatePreferences(cfg *PreferencesConfig) []string {
+	var problems []string
+
+	if cfg.BackfillBatchSize < 1 {
+		problems = append(problems, fmt.Sprintf("preferences.backfill_batch_size must be at least 1, got %d",
+			cfg.BackfillBatchSize))
+	}
+
+	if cfg.BackfillRate < 0 {
+		problems = append(problems, "preferences.backfill_rate must not be negative")
+	}
+
+	if cfg.PrivacyDefaultsVersion < 1 || cfg.PrivacyDefaultsVersion > dto.LatestPrivacyDefaultsVersion {
+		problems = append(problems, fmt.Sprintf("preferences.privacy_defaults_version must be between 1 and %d, got %d",
+			dto.LatestPrivacyDefaultsVersion, cfg.PrivacyDefaultsVersion))
+	}
+
+	problems = appendEnumProblem(problems, "preferences.storage", cfg.Storage, validPreferenceStorages)
+
+	for _, category := range cfg.DisabledCategories {
+		switch {
+		case !dto.IsValidPreferenceCategory(category):
+			problems = append(problems, fmt.Sprintf("preferences.disabled_categories contains unknown category %q",
+				category))
+		case dto.PreferenceCategory(category) == dto.PreferenceCategoryPrivacy:
+			problems = append(problems, "preferences.disabled_categories must not contain privacy")
+		}
+	}
+
+	return problems
+}
+
+func validateEvents(cfg *EventsConfig) []string {
+	var problems []string
+
+	if cfg.SampleRate < 0 || cfg.SampleRate > 1 {
+		problems = append(problems, fmt.Sprintf("events.sample_rate must be between 0 and 1, got %g", cfg.SampleRate))
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(cfg.SampleRates)) {
+		switch rate := cfg.SampleRates[name]; {
+		case !slices.Contains(logger.EventNames, name):
+			problems = append(problems, fmt.Sprintf("events.sample_rates.%s: unknown event, must be one of %v",
+				name, logger.EventNames))
+		case rate < 0 || rate > 1:
+			problems = append(problems, fmt.Sprintf("events.sample_rates.%s must be between 0 and 1, got %g",
+				name, rate))
+		}
+	}
+
+	return problems
+}
+
+func validateSlowRequests(cfg *SlowRequestsConfig) []string {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var problems []string
+
+	if cfg.Threshold <= 0 {
+		problems = append(problems, fmt.Sprintf(
+			"diagnostics.slow_requests.threshold must be positive, got %s", cfg.Threshold))
+	}
+
+	problems = appendRequiredProblem(problems, "diagnostics.slow_requests.dir", cfg.Dir)
+
+	if cfg.MaxBundles < 0 || cfg.MaxAge < 0 || cfg.MaxQueries < 0 {
+		problems = append(problems, "diagnostics.slow_requests.max_bundles, max_age and max_queries must not be negative")
+	}
+
+	return problems
+}
+
+func validateErrorTracking(cfg *ErrorTrackingConfig) []string {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var problems []string
+
+	err := errortracking.ValidateDSN(cfg.DSN)
+	if err != nil {
+		problems = append(problems, "errortracking.dsn must be a Sentry DSN when error tracking is enabled")
+	}
+
+	if cfg.Timeout <= 0 {
+		problems = append(problems, fmt.Sprintf("errortracking.timeout must be positive, got %s", cfg.Timeout))
+	}
+
+	return problems
+}
+
+func validateHashing(cfg *HashingConfig) []string {
+	var problems []string
+
+	if cfg.Time < 1 || cfg.Threads < 1 || cfg.Threads > math.MaxUint8 {
+		problems = append(problems, fmt.Sprintf(
+			"hashing.time must be positive and hashing.threads between 1 and %d, got %d and %d",
+			math.MaxUint8, cfg.Time, cfg.Threads))
+	}
+
+	// argon2 needs at least 8 KiB per thread
+	if cfg.MemoryKiB < 8*max(cfg.Threads, 1) {
+		problems = append(problems, fmt.Sprintf("hashing.memory_kib must be at least 8 per thread, got %d", cfg.MemoryKiB))
+	}
+
+	return problems
+}
+
+func validateJobs(cfg *JobsConfig) []string {
+	var problems []string
+
+	// Leases are renewed every third of the TTL
+	if cfg.LockTTL < time.Second {
+		problems = append(problems, fmt.Sprintf("jobs.lock_ttl must be at least 1s, got %s", cfg.LockTTL))
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(cfg.Schedules)) {
+		_, err := cron.Parse(cfg.Schedules[name])
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("jobs.schedules.%s: %v", name, err))
+		}
+	}
+
+	return problems
+}
+
+func appendRequiredProblem(problems []string, field, value string) []string {
+	if value == "" {
+		return append(problems, field+" is required")
+	}
+
+	return problems
+}
+
+func appendPortProblem(problems []string, field string, port int) []string {
+	if port < 1 || port > maxPort {
+		return append(problems, fmt.Sprintf("%s must be between 1 and %d, got %d", field, maxPort, port))
+	}
+
+	return problems
+}
+
+// appendEnumProblem reports a value outside allowed. Empty values are accepted since a default applies.
+func appendEnumProblem(problems []string, field, value string, allowed []string) []string {
+	if value == "" || slices.Contains(allowed, value) {
+		return problems
+	}
+
+	return append(problems, fmt.Sprintf("%s must be one of [%s], got %q", field, strings.Join(allowed, ", "), value))
+}
+
+func validateReadiness(cfg *ReadinessConfig) []string {
+	var problems []string
+
+	if cfg.CheckInterval < 0 {
+		problems = append(problems, "readiness.check_interval must not be negative")
+	}
+
+	invariants := []struct {
+		field string
+		cfg   InvariantConfig
+	}{
+		{"readiness.indexes", cfg.Indexes},
+		{"readiness.counter_drift", cfg.CounterDrift},
+		{"readiness.change_backlog", cfg.ChangeBacklog},
+		{"readiness.change_age", cfg.ChangeAge},
+	}
+
+	for _, invariant := range invariants {
+		problems = appendEnumProblem(problems, invariant.field+".severity", invariant.cfg.Severity,
+			validInvariantSeverities)
+
+		if invariant.cfg.Max < 0 || invariant.cfg.MaxAge < 0 {
+			problems = append(problems, invariant.field+" thresholds must not be negative")
+		}
+	}
+
+	if cfg.CounterDrift.Severity != "" && cfg.CounterDrift.Severity != InvariantSeverityOff &&
+		cfg.CounterDrift.Sample < 1 {
+		problems = append(problems, fmt.Sprintf("readiness.counter_drift.sample must be at least 1, got %d",
+			cfg.CounterDrift.Sample))
+	}
+
+	return problems
+}
@@ -0,0 +1,677 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validConfig() *Config {
+	return &Config{
+		Server:  ServerConfig{Port: 8080, Timeout: time.Minute},
+		Logging: LoggingConfig{ConsoleLevel: "info", Format: "json"},
+		Postgres: PostgresConfig{
+			Host:     "localhost",
+			Port:     defaultPostgresPort,
+			Database: "postgres",
+			User:     "postgres",
+		},
+		Redis:   RedisConfig{Host: "localhost", Port: defaultRedisPort},
+		Hashing: HashingConfig{Time: 1, MemoryKiB: 64, Threads: 1},
+		RateLimit: RateLimitConfig{
+			Enabled:            true,
+			Window:             time.Minute,
+			AuthenticatedLimit: 100,
+			AnonymousLimit:     10,
+		},
+		Jobs:             JobsConfig{LockTTL: 30 * time.Second},
+		Presence:         PresenceConfig{OnlineWindow: 5 * time.Minute, Retention: 24 * time.Hour},
+		RecentlyViewed:   RecentlyViewedConfig{Limit: 20, Retention: 24 * time.Hour},
+		Social:           SocialConfig{DigestPeriod: 7 * 24 * time.Hour},
+		Invitations:      InvitationsConfig{TTL: 30 * 24 * time.Hour},
+		PreferenceCenter: PreferenceCenterConfig{TokenTTL: 90 * 24 * time.Hour},
+		ListUnsubscribe:  ListUnsubscribeConfig{TokenTTL: 90 * 24 * time.Hour},
+		Badges: BadgesConfig{Definitions: []BadgeDefinitionConfig{
+			{ID: "first-recipe", Name: "First Recipe", Metric: "recipes", Threshold: 1},
+		}},
+		Mentions:    MentionsConfig{MaxUsernames: 100, CacheTTL: time.Minute},
+		Embed:       EmbedConfig{ProfileURL: "https://recipes.example.com/u/{username}", CacheTTL: 5 * time.Minute},
+		Preferences: PreferencesConfig{BackfillBatchSize: 500, BackfillRate: 1000, PrivacyDefaultsVersion: 1},
+		Events:      EventsConfig{SampleRate: 1},
+		Profile: ProfileConfig{
+			UsernameMinLength: 3,
+			UsernameMaxLength: 50,
+			FullNameMaxLength: 255,
+			BioMaxLength:      1000,
+		},
+		PublicProfiles: PublicProfilesConfig{MaxStaleness: 15 * time.Minute, BatchSize: 500},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mutate   func(*Config)
+		problems []string
+	}{
+		{
+			name:   "valid",
+			mutate: func(_ *Config) {},
+		},
+		{
+			name:   "empty enums use defaults",
+			mutate: func(c *Config) { c.Logging = LoggingConfig{} },
+		},
+		{
+			name: "port ranges",
+			mutate: func(c *Config) {
+				c.Server.Port = 0
+				c.Redis.Port = 70000
+			},
+			problems: []string{
+				"server.port must be between 1 and 65535, got 0",
+				"redis.port must be between 1 and 65535, got 70000",
+			},
+		},
+		{
+			name: "security headers",
+			mutate: func(c *Config) {
+				c.Server.SecurityHeaders = SecurityHeadersConfig{
+					HSTSMaxAge: -time.Second,
+					DocsPath:   "swagger",
+					Overrides:  []SecurityHeaderOverrideConfig{{PathPrefix: "/ok"}, {PathPrefix: "embed"}},
+				}
+			},
+			problems: []string{
+				"server.security_headers.hsts_max_age must not be negative, got -1s",
+				`server.security_headers.docs_path must start with /, got "swagger"`,
+				`server.security_headers.overrides[1].path_prefix must start with /, got "embed"`,
+			},
+		},
+		{
+			name:   "hashing parameters",
+			mutate: func(c *Config) { c.Hashing = HashingConfig{Time: 0, MemoryKiB: 8, Threads: 2} },
+			problems: []string{
+				"hashing.time must be positive and hashing.threads between 1 and 255, got 0 and 2",
+				"hashing.memory_kib must be at least 8 per thread, got 8",
+			},
+		},
+		{
+			name: "ip filter entries",
+			mutate: func(c *Config) {
+				c.IPFilter = IPFilterConfig{
+					TrustedProxies: []string{"10.0.0.0/8", "10.1.2.3"},
+					Admin:          IPFilterRulesConfig{Allow: []string{"192.168.0.0/33"}},
+					Internal:       IPFilterRulesConfig{Deny: []string{"not-an-ip"}},
+				}
+			},
+			problems: []string{
+				`ipfilter.admin.allow: "192.168.0.0/33" is not a CIDR or IP address`,
+				`ipfilter.internal.deny: "not-an-ip" is not a CIDR or IP address`,
+			},
+		},
+		{
+			name: "request signing",
+			mutate: func(c *Config) {
+				c.RequestSigning = RequestSigningConfig{Enabled: true, Secret: "short", PreviousSecret: "old"}
+			},
+			problems: []string{
+				"requestsigning.secret must be at least 32 bytes when request signing is enabled",
+				"requestsigning.previous_secret must be at least 32 bytes",
+				"requestsigning.max_skew must be positive when request signing is enabled",
+			},
+		},
+		{
+			name:     "redis tenant",
+			mutate:   func(c *Config) { c.Redis.Tenant = "acme:eu" },
+			problems: []string{`redis.tenant must not contain ':' or spaces, got "acme:eu"`},
+		},
+		{
+			name: "redis prune",
+			mutate: func(c *Config) {
+				c.Redis.PruneInterval = -time.Hour
+				c.Redis.PrunePrefixes = []string{"delete-request:", " "}
+			},
+			problems: []string{
+				"redis.pruneinterval must not be negative, got -1h0m0s",
+				"redis.pruneprefixes must not contain empty prefixes",
+			},
+		},
+		{
+			name: "log enums",
+			mutate: func(c *Config) {
+				c.Logging.ConsoleLevel = "verbose"
+				c.Logging.Format = "xml"
+			},
+			problems: []string{
+				`logging.consolelevel must be one of [debug, info, warn, error], got "verbose"`,
+				`logging.format must be one of [json, text], got "xml"`,
+			},
+		},
+		{
+			name: "sensitive logging outside development",
+			mutate: func(c *Config) {
+				c.Environment = "production"
+				c.Logging.LogSensitiveData = true
+			},
+			problems: []string{`logging.logsensitivedata is only allowed in development, got environment "production"`},
+		},
+		{
+			name:     "file logging without a file",
+			mutate:   func(c *Config) { c.Logging.FileEnabled = true },
+			problems: []string{"logging.file is required when logging.fileenabled is true"},
+		},
+		{
+			name:     "required postgres fields",
+			mutate:   func(c *Config) { c.Postgres.Host = "" },
+			problems: []string{"postgres.host is required"},
+		},
+		{
+			name: "oauth2 enabled without credentials",
+			mutate: func(c *Config) {
+				c.OAuth2 = OAuth2Config{Enabled: true, BaseAuthURL: "http://auth", GetTokenPath: "/token", JWTSecret: "s"}
+			},
+			problems: []string{
+				"oauth2.client_id is required when oauth2 is enabled",
+				"oauth2.client_secret is required when oauth2 is enabled",
+			},
+		},
+		{
+			name:     "introspection without incoming auth",
+			mutate:   func(c *Config) { c.OAuth2 = OAuth2Config{IntrospectionEnabled: true} },
+			problems: []string{"oauth2.introspection_enabled requires oauth2.enabled or oauth2.service_enabled"},
+		},
+		{
+			name:   "local jwt validation without secret",
+			mutate: func(c *Config) { c.OAuth2 = OAuth2Config{ServiceEnabled: true} },
+			problems: []string{
+				"oauth2.jwt_secret is required for local JWT validation (set it or enable oauth2.introspection_enabled)",
+			},
+		},
+		{
+			name: "notification enabled without url",
+			mutate: func(c *Config) {
+				c.DownstreamServices.Notification.Enabled = true
+			},
+			problems: []string{
+				"downstreamservices.notification.base_url is required when the notification service is enabled",
+			},
+		},
+		{
+			name:     "anonymous limit looser than authenticated",
+			mutate:   func(c *Config) { c.RateLimit.AnonymousLimit = 500 },
+			problems: []string{"ratelimit.anonymous_limit (500) must not exceed ratelimit.authenticated_limit (100)"},
+		},
+		{
+			name: "tls enabled without files",
+			mutate: func(c *Config) {
+				c.Server.TLS = TLSConfig{Enabled: true, MinVersion: "1.1", RedirectPort: 8080}
+			},
+			problems: []string{
+				"server.tls.cert_file is required",
+				"server.tls.key_file is required",
+				`server.tls.min_version must be one of [1.2, 1.3], got "1.1"`,
+				"server.tls.redirect_port must differ from server.port",
+			},
+		},
+		{
+			name: "tls insecure cipher suite",
+			mutate: func(c *Config) {
+				c.Server.TLS = TLSConfig{
+					Enabled:      true,
+					CertFile:     "tls.crt",
+					KeyFile:      "tls.key",
+					CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_RSA_WITH_RC4_128_SHA"},
+				}
+			},
+			problems: []string{`server.tls.cipher_suites contains unknown or insecure suite "TLS_RSA_WITH_RC4_128_SHA"`},
+		},
+		{
+			name:     "negative maintenance retry after",
+			mutate:   func(c *Config) { c.Maintenance.RetryAfter = -time.Second },
+			problems: []string{"maintenance.retry_after and maintenance.refresh_interval must not be negative"},
+		},
+		{
+			name:     "invalid base path",
+			mutate:   func(c *Config) { c.Server.BasePath = "api/v1/" },
+			problems: []string{`server.base_path must start with / and not end with / or contain spaces, got "api/v1/"`},
+		},
+		{
+			name:     "presence retention shorter than the online window",
+			mutate:   func(c *Config) { c.Presence.Retention = time.Minute },
+			problems: []string{"presence.retention must be at least presence.online_window, got 1m0s"},
+		},
+		{
+			name:     "negative profile view flush interval",
+			mutate:   func(c *Config) { c.ProfileViews.FlushInterval = -time.Second },
+			problems: []string{"profileviews.flush_interval must not be negative, got -1s"},
+		},
+		{
+			name: "recently viewed limit out of range without retention",
+			mutate: func(c *Config) {
+				c.RecentlyViewed = RecentlyViewedConfig{Limit: 500}
+			},
+			problems: []string{
+				"recentlyviewed.limit must be between 0 and 100, got 500",
+				"recentlyviewed.retention must be positive, got 0s",
+			},
+		},
+		{
+			name: "invalid badge definitions",
+			mutate: func(c *Config) {
+				c.Badges.Definitions = append(c.Badges.Definitions,
+					BadgeDefinitionConfig{ID: "first-recipe", Name: "Again", Metric: "reviews", Threshold: 0})
+			},
+			problems: []string{
+				`badges.definitions[1].id "first-recipe" is used by another badge`,
+				`badges.definitions[1].metric must be one of [recipes, followers, membership_days], got "reviews"`,
+				"badges.definitions[1].threshold must be at least 1, got 0",
+			},
+		},
+		{
+			name:     "mention batch too large",
+			mutate:   func(c *Config) { c.Mentions.MaxUsernames = 5000 },
+			problems: []string{"mentions.max_usernames must be between 1 and 1000, got 5000"},
+		},
+		{
+			name: "relative embed links",
+			mutate: func(c *Config) {
+				c.Embed.ProfileURL = "/u/{username}"
+				c.Embed.AvatarURL = "ftp://media.example.com/{userId}.png"
+			},
+			problems: []string{
+				`embed.profile_url must be an absolute http(s) URL, got "/u/{username}"`,
+				`embed.avatar_url must be an absolute http(s) URL, got "ftp://media.example.com/{userId}.png"`,
+			},
+		},
+		{
+			name: "incomplete directory sync",
+			mutate: func(c *Config) {
+				c.Directory = DirectoryConfig{
+					Enabled:         true,
+					URL:             "https://dc.example.com",
+					BindDN:          "cn=sync,dc=example,dc=com",
+					LookupAttribute: "uid",
+					Rules:           DirectoryRulesConfig{FullName: "local", Email: "ldap"},
+				}
+			},
+			problems: []string{
+				"directory.base_dn is required",
+				`directory.url must be an ldap:// or ldaps:// URL, got "https://dc.example.com"`,
+				"directory.bind_password is required",
+				`directory.rules.email must be one of [directory, local], got "ldap"`,
+			},
+		},
+		{
+			name: "directory bind password in the clear",
+			mutate: func(c *Config) {
+				c.Directory = DirectoryConfig{
+					Enabled:         true,
+					URL:             "ldap://dc.example.com",
+					BindDN:          "cn=sync,dc=example,dc=com",
+					BindPassword:    "secret",
+					BaseDN:          "dc=example,dc=com",
+					LookupAttribute: "uid",
+				}
+			},
+			problems: []string{
+				"directory.bind_password over an ldap:// URL requires directory.start_tls",
+			},
+		},
+		{
+			name: "content moderation without a source",
+			mutate: func(c *Config) {
+				c.ContentModeration = ContentModerationConfig{
+					Enabled:  true,
+					Provider: "api",
+					Action:   "delete",
+					API:      ContentModerationAPIConfig{URL: "moderation.example.com", Cooldown: -time.Second},
+				}
+			},
+			problems: []string{
+				`contentmoderation.action must be one of [reject, flag, mask], got "delete"`,
+				`contentmoderation.api.url must be an http:// or https:// URL, got "moderation.example.com"`,
+				"contentmoderation.api.timeout, cooldown and failure_threshold must not be negative",
+			},
+		},
+		{
+			name:   "word list moderation without a file",
+			mutate: func(c *Config) { c.ContentModeration = ContentModerationConfig{Enabled: true, Provider: "wordlist"} },
+			problems: []string{
+				"contentmoderation.word_list_file is required",
+			},
+		},
+		{
+			name: "invalid profile limits",
+			mutate: func(c *Config) {
+				c.Profile = ProfileConfig{UsernameMinLength: 20, UsernameMaxLength: 10, BioMaxLength: 1000}
+			},
+			problems: []string{
+				"profile.full_name_max_length must be at least 1, got 0",
+				"profile.username_min_length (20) must not be greater than profile.username_max_length (10)",
+			},
+		},
+		{
+			name:   "invalid preference backfill",
+			mutate: func(c *Config) { c.Preferences = PreferencesConfig{BackfillRate: -1} },
+			problems: []string{
+				"preferences.backfill_batch_size must be at least 1, got 0",
+				"preferences.backfill_rate must not be negative",
+				"preferences.privacy_defaults_version must be between 1 and 2, got 0",
+			},
+		},
+		{
+			name: "slow request tracing without a directory",
+			mutate: func(c *Config) {
+				c.Diagnostics.SlowRequests = SlowRequestsConfig{Enabled: true, MaxBundles: -1}
+			},
+			problems: []string{
+				"diagnostics.slow_requests.threshold must be positive, got 0s",
+				"diagnostics.slow_requests.dir is required",
+				"diagnostics.slow_requests.max_bundles, max_age and max_queries must not be negative",
+			},
+		},
+		{
+			name: "error tracking without a DSN",
+			mutate: func(c *Config) {
+				c.ErrorTracking = ErrorTrackingConfig{Enabled: true, DSN: "https://o1.ingest.sentry.io/42"}
+			},
+			problems: []string{
+				"errortracking.dsn must be a Sentry DSN when error tracking is enabled",
+				"errortracking.timeout must be positive, got 0s",
+			},
+		},
+		{
+			name: "invalid event sampling",
+			mutate: func(c *Config) {
+				c.Events = EventsConfig{SampleRate: 1.5, SampleRates: map[string]float64{
+					"follow_created": -0.1,
+					"user_deleted":   1,
+				}}
+			},
+			problems: []string{
+				"events.sample_rate must be between 0 and 1, got 1.5",
+				"events.sample_rates.follow_created must be between 0 and 1, got -0.1",
+				"events.sample_rates.user_deleted: unknown event, must be one of " +
+					"[profile_updated follow_created preference_changed invitation_redeemed]",
+			},
+		},
+		{
+			name:     "unknown privacy defaults version",
+			mutate:   func(c *Config) { c.Preferences.PrivacyDefaultsVersion = 3 },
+			problems: []string{"preferences.privacy_defaults_version must be between 1 and 2, got 3"},
+		},
+		{
+			name: "bad preference storage and disabled categories",
+			mutate: func(c *Config) {
+				c.Preferences.Storage = "documents"
+				c.Preferences.DisabledCategories = []string{"sound", "privacy", "weather"}
+			},
+			problems: []string{
+				`preferences.storage must be one of [tables, jsonb], got "documents"`,
+				"preferences.disabled_categories must not contain privacy",
+				`preferences.disabled_categories contains unknown category "weather"`,
+			},
+		},
+		{
+			name:     "short job lock ttl",
+			mutate:   func(c *Config) { c.Jobs.LockTTL = 0 },
+			problems: []string{"jobs.lock_ttl must be at least 1s, got 0s"},
+		},
+		{
+			name: "invalid job schedule",
+			mutate: func(c *Config) {
+				c.Jobs.Schedules = map[string]string{"device-cleanup": "0 3 * * *", "search-reindex": "0 25 * * *"}
+			},
+			problems: []string{
+				`jobs.schedules.search-reindex: invalid cron schedule "0 25 * * *": end of range (25) above maximum (23): 25`,
+			},
+		},
+		{
+			name:     "negative drain period",
+			mutate:   func(c *Config) { c.Server.DrainPeriod = -time.Second },
+			problems: []string{"server.drain_period and server.shutdown_timeout must not be negative"},
+		},
+		{
+			name: "invalid listeners",
+			mutate: func(c *Config) {
+				c.Server.Listeners = []ListenerConfig{
+					{Network: "unix", Address: "/run/usermgmt.sock", SocketMode: "rw"},
+					{Network: "udp", Address: ":9000"},
+					{Network: "tcp", SocketMode: "0660"},
+					{Network: "unix", Address: "/run/usermgmt.sock"},
+				}
+			},
+			problems: []string{
+				`server.listeners[0].socket_mode must be an octal file mode, got "rw"`,
+				`server.listeners[1].network must be one of [tcp, unix], got "udp"`,
+				"server.listeners[2].address is required",
+				"server.listeners[2].socket_mode only applies to unix listeners",
+				"server.listeners[3] duplicates listener unix:///run/usermgmt.sock",
+			},
+		},
+		{
+			name:     "diagnostics on the server port",
+			mutate:   func(c *Config) { c.Diagnostics = DiagnosticsConfig{Enabled: true, Port: 8080} },
+			problems: []string{"diagnostics.port must differ from server.port"},
+		},
+		{
+			name: "diagnostics host not an address",
+			mutate: func(c *Config) {
+				c.Diagnostics = DiagnosticsConfig{Enabled: true, Port: 6060, Host: "debug.internal"}
+			},
+			problems: []string{`diagnostics.host must be an IP address, got "debug.internal"`},
+		},
+		{
+			name:     "shadow sample out of range",
+			mutate:   func(c *Config) { c.Shadow = ShadowConfig{Enabled: true, SamplePercent: 150} },
+			problems: []string{"shadow.sample_percent must be between 0 and 100, got 150"},
+		},
+		{
+			name:   "vault without connection details",
+			mutate: func(c *Config) { c.Secrets.Provider = "vault" },
+			problems: []string{
+				"secrets.vault.address is required",
+				"secrets.vault.token is required",
+				"secrets.vault.path is required",
+			},
+		},
+		{
+			name:     "unknown storage backend",
+			mutate:   func(c *Config) { c.Storage.Backend = "sqlite" },
+			problems: []string{`storage.backend must be one of [postgres, memory], got "sqlite"`},
+		},
+		{
+			name:     "fixtures without memory storage",
+			mutate:   func(c *Config) { c.Storage = StorageConfig{Backend: "postgres", FixturesFile: "seed.yaml"} },
+			problems: []string{"storage.fixtures_file only applies to the memory backend"},
+		},
+		{
+			name:     "policy enforcement without versions",
+			mutate:   func(c *Config) { c.Policies.EnforceAcceptance = true },
+			problems: []string{"policies.enforce_acceptance requires policies.terms_version or policies.privacy_version"},
+		},
+		{
+			name:     "negative push limits",
+			mutate:   func(c *Config) { c.Push.StaleAfter = -time.Hour },
+			problems: []string{"push.max_devices_per_user, push.stale_after and push.cleanup_interval must not be negative"},
+		},
+		{
+			name:   "negative follow history retention",
+			mutate: func(c *Config) { c.Social.FollowHistoryRetention = -time.Hour },
+			problems: []string{
+				"social.follow_history_retention and social.follow_history_purge_interval must not be negative",
+			},
+		},
+		{
+			name:     "negative follow limit",
+			mutate:   func(c *Config) { c.Social.MaxFollowing = -1 },
+			problems: []string{"social.max_following must not be negative"},
+		},
+		{
+			name:     "zero preference center token TTL",
+			mutate:   func(c *Config) { c.PreferenceCenter.TokenTTL = 0 },
+			problems: []string{"preferencecenter.token_ttl must be positive, got 0s"},
+		},
+		{
+			name: "invalid list unsubscribe settings",
+			mutate: func(c *Config) {
+				c.ListUnsubscribe.BaseURL = "http://recipes.example.com/api/v1/user-management"
+				c.ListUnsubscribe.Mailto = "not an address"
+				c.ListUnsubscribe.TokenTTL = 0
+			},
+			problems: []string{
+				`listunsubscribe.base_url must be an absolute https URL, got "http://recipes.example.com/api/v1/user-management"`,
+				`listunsubscribe.mailto must be an email address, got "not an address"`,
+				"listunsubscribe.token_ttl must be positive, got 0s",
+			},
+		},
+		{
+			name:     "list unsubscribe mailto without base URL",
+			mutate:   func(c *Config) { c.ListUnsubscribe.Mailto = "unsubscribe@recipes.example.com" },
+			problems: []string{"listunsubscribe.mailto requires listunsubscribe.base_url"},
+		},
+		{
+			name: "invalid invitation settings",
+			mutate: func(c *Config) {
+				c.Invitations.MaxPerUser = -1
+				c.Invitations.TTL = 0
+			},
+			problems: []string{
+				"invitations.max_per_user must not be negative",
+				"invitations.ttl must be positive, got 0s",
+			},
+		},
+		{
+			name:     "zero digest period",
+			mutate:   func(c *Config) { c.Social.DigestPeriod = 0 },
+			problems: []string{"social.digest_period must be positive, got 0s"},
+		},
+		{
+			name: "public profile projection without staleness bound",
+			mutate: func(c *Config) {
+				c.PublicProfiles.MaxStaleness = 0
+				c.PublicProfiles.BatchSize = 0
+			},
+			problems: []string{
+				"publicprofiles.max_staleness must be positive, got 0s",
+				"publicprofiles.batch_size must be at least 1, got 0",
+			},
+		},
+		{
+			name: "readiness invariants",
+			mutate: func(c *Config) {
+				c.Readiness.CheckInterval = -time.Second
+				c.Readiness.Indexes.Severity = "error"
+				c.Readiness.ChangeAge.MaxAge = -time.Minute
+				c.Readiness.CounterDrift.Severity = InvariantSeverityFail
+			},
+			problems: []string{
+				"readiness.check_interval must not be negative",
+				`readiness.indexes.severity must be one of [off, warn, fail], got "error"`,
+				"readiness.change_age thresholds must not be negative",
+				"readiness.counter_drift.sample must be at least 1, got 0",
+			},
+		},
+		{
+			name:     "negative typeahead cache TTL",
+			mutate:   func(c *Config) { c.Search.TypeaheadCacheTTL = -time.Second },
+			problems: []string{"search.typeahead_cache_ttl and search.results_cache_ttl must not be negative"},
+		},
+		{
+			name:     "negative search results cache TTL",
+			mutate:   func(c *Config) { c.Search.ResultsCacheTTL = -time.Second },
+			problems: []string{"search.typeahead_cache_ttl and search.results_cache_ttl must not be negative"},
+		},
+		{
+			name:     "unknown typeahead source",
+			mutate:   func(c *Config) { c.Search.TypeaheadSource = "elastic" },
+			problems: []string{`search.typeahead_source must be one of [postgres, verify, index], got "elastic"`},
+		},
+		{
+			name:     "unknown secret provider",
+			mutate:   func(c *Config) { c.Secrets.Provider = "aws" },
+			problems: []string{`secrets.provider must be one of [vault], got "aws"`},
+		},
+		{
+			name:   "rate limit disabled skips checks",
+			mutate: func(c *Config) { c.RateLimit = RateLimitConfig{Enabled: false, AnonymousLimit: 500} },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := validConfig()
+			tt.mutate(cfg)
+
+			err := Validate(cfg)
+			if len(tt.problems) == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.problems, validationErr.Problems)
+		})
+	}
+}
+
+func TestValidationErrorReport(t *testing.T) {
+	t.Parallel()
+
+	err := &ValidationError{Problems: []string{"first", "second"}}
+	assert.Equal(t, "invalid configuration (2 problems):\n  - first\n  - second", err.Error())
+}
+
+//nolint:paralleltest // t.Chdir modifies process-level working directory, cannot run in parallel
+func TestLoadAndValidate(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "config")
+	err := os.Mkdir(configDir, 0750)
+	require.NoError(t, err)
+
+	createConfigFile(t, configDir, serverConfigFileName, "server:\n  port: 99999")
+	createConfigFile(t, configDir, corsConfigFileName, corsConfigFileContents)
+	createConfigFile(t, configDir, loggingConfigFileName, "logging:\n  consolelevel: loud")
+	createConfigFile(t, configDir, databaseConfigFileName, databaseConfigFileContents)
+
+	t.Chdir(tmpDir)
+
+	t.Setenv("OAUTH2_ENABLED", "")
+	t.Setenv("OAUTH2_SERVICE_ENABLED", "")
+	t.Setenv("OAUTH2_INTROSPECTION_ENABLED", "")
+
+	cfg, err := LoadAndValidate()
+	assert.Nil(t, cfg)
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Len(t, validationErr.Problems, 2)
+	assert.Contains(t, err.Error(), "server.port")
+	assert.Contains(t, err.Error(), "logging.consolelevel")
+}
+
+//nolint:paralleltest // t.Chdir modifies process-level working directory, cannot run in parallel
+func TestLoadAndValidateMissingFile(t *testing.T) {
+	viper.Reset()
+
+	t.Chdir(t.TempDir())
+
+	cfg, err := LoadAndValidate()
+	assert.Nil(t, cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "server config file not found")
+}
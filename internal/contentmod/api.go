@@ -0,0 +1,180 @@
+package contentmod
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultAPITimeout          = 2 * time.Second
+	defaultAPIFailureThreshold = 5
+	defaultAPICooldown         = 30 * time.Second
+
+	// maxAPIResponseBytes bounds the verdicts read from the API.
+	maxAPIResponseBytes = 1 << 20
+)
+
+var (
+	// errAPIStatus is returned for responses other than 200 OK.
+	errAPIStatus = errors.New("unexpected moderation API status")
+	// errBreakerOpen is the reason calls skip the API while the breaker is open.
+	errBreakerOpen = errors.New("circuit breaker open")
+)
+
+// APIConfig configures an APIModerator.
+type APIConfig struct {
+	// URL receives a POST of {"text": "..."} and answers {"flagged": bool, "terms": ["..."]}.
+	URL string
+	// APIKey, when set, is sent as a bearer token.
+	APIKey string
+	// Timeout bounds each call. Defaults to two seconds.
+	Timeout time.Duration
+	// FailureThreshold consecutive failures open the circuit breaker for Cooldown. Defaults are
+	// five failures and thirty seconds.
+	FailureThreshold int
+	Cooldown         time.Duration
+	// Fallback moderates text while the API is failing or the breaker is open. Without it such
+	// calls fail with ErrUnavailable.
+	Fallback Moderator
+	// HTTPClient replaces the default client, e.g. in tests.
+	HTTPClient *http.Client
+}
+
+// APIModerator moderates text with an external moderation API.
+type APIModerator struct {
+	url      string
+	apiKey   string
+	client   *http.Client
+	breaker  *Breaker
+	fallback Moderator
+}
+
+type apiRequest struct {
+	Text string `json:"text"`
+}
+
+type apiResponse struct {
+	Flagged bool     `json:"flagged"`
+	Terms   []string `json:"terms"`
+}
+
+// NewAPIModerator creates an APIModerator from cfg.
+func NewAPIModerator(cfg APIConfig) *APIModerator {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultAPITimeout
+	}
+
+	threshold := cfg.FailureThreshold
+	if threshold <= 0 {
+		threshold = defaultAPIFailureThreshold
+	}
+
+	cooldown := cfg.Cooldown
+	if cooldown <= 0 {
+		cooldown = defaultAPICooldown
+	}
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: timeout}
+	}
+
+	return &APIModerator{
+		url:      cfg.URL,
+		apiKey:   cfg.APIKey,
+		client:   client,
+		breaker:  NewBreaker(threshold, cooldown),
+		fallback: cfg.Fallback,
+	}
+}
+
+// Moderate sends text to the API. Flagged terms the API reports are masked wherever they appear
+// in text. If the breaker is open or the call fails, text is moderated by the fallback instead.
+func (m *APIModerator) Moderate(ctx context.Context, text string) (Verdict, error) {
+	if !m.breaker.Allow() {
+		return m.moderateFallback(ctx, text, errBreakerOpen)
+	}
+
+	verdict, err := m.call(ctx, text)
+	if err != nil {
+		if ctx.Err() != nil {
+			// The caller gave up; that says nothing about the API's health
+			return Verdict{}, fmt.Errorf("%w: %w", ErrUnavailable, err)
+		}
+
+		if m.breaker.Failure() {
+			slog.WarnContext(ctx, "content moderation API circuit breaker opened", "error", err)
+		}
+
+		return m.moderateFallback(ctx, text, err)
+	}
+
+	m.breaker.Success()
+
+	return verdict, nil
+}
+
+func (m *APIModerator) moderateFallback(ctx context.Context, text string, cause error) (Verdict, error) {
+	if m.fallback == nil {
+		return Verdict{}, fmt.Errorf("%w: %w", ErrUnavailable, cause)
+	}
+
+	slog.DebugContext(ctx, "moderating with the fallback", "error", cause)
+
+	return m.fallback.Moderate(ctx, text)
+}
+
+func (m *APIModerator) call(ctx context.Context, text string) (Verdict, error) {
+	body, err := json.Marshal(apiRequest{Text: text})
+	if err != nil {
+		return Verdict{}, fmt.Errorf("failed to marshal moderation request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.url, bytes.NewReader(body))
+	if err != nil {
+		return Verdict{}, fmt.Errorf("failed to create moderation request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	if m.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("moderation request failed: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return Verdict{}, fmt.Errorf("%w: %d", errAPIStatus, resp.StatusCode)
+	}
+
+	var result apiResponse
+
+	err = json.NewDecoder(io.LimitReader(resp.Body, maxAPIResponseBytes)).Decode(&result)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("failed to decode moderation response: %w", err)
+	}
+
+	if !result.Flagged {
+		return Verdict{Masked: text}, nil
+	}
+
+	verdict := MaskTerms(text, result.Terms)
+	// The API may flag text for reasons other than single words; there is then nothing to mask
+	verdict.Flagged = true
+
+	return verdict, nil
+}
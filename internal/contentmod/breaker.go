@@ -0,0 +1,68 @@
+package contentmod
+
+import (
+	"sync"
+	"time"
+)
+
+// Breaker is a circuit breaker. It opens after a number of consecutive failures and then
+// refuses calls for a cooldown, after which one call at a time is let through to probe the
+// service until one succeeds.
+type Breaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+// NewBreaker creates a Breaker that opens after threshold consecutive failures, at least one,
+// for cooldown.
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{threshold: max(threshold, 1), cooldown: cooldown, now: time.Now}
+}
+
+// Allow reports whether a call may go ahead. Once the cooldown is over it lets one probing call
+// through and refuses the rest for another cooldown, unless the probe succeeds first.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+
+	now := b.now()
+	if now.Before(b.openUntil) {
+		return false
+	}
+
+	b.openUntil = now.Add(b.cooldown)
+
+	return true
+}
+
+// Success records a successful call, closing the breaker.
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+}
+
+// Failure records a failed call. It reports whether the breaker has just opened.
+func (b *Breaker) Failure() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.failures < b.threshold {
+		return false
+	}
+
+	b.openUntil = b.now().Add(b.cooldown)
+
+	return b.failures == b.threshold
+}
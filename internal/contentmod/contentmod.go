@@ -0,0 +1,125 @@
+// Package contentmod checks user-written profile text, such as full names and bios, for
+// objectionable language before it is saved. A Moderator is either a local word list or an
+// external moderation API guarded by a circuit breaker; what happens to flagged text is the
+// caller's Action.
+package contentmod
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// ErrUnavailable is returned when text could not be moderated, e.g. because the moderation API
+// is failing and there is no fallback.
+var ErrUnavailable = errors.New("content moderation unavailable")
+
+// Action is what happens to text a Moderator flags.
+type Action string
+
+const (
+	// ActionReject refuses the write.
+	ActionReject Action = "reject"
+	// ActionFlag saves the text as written and queues it for admin review.
+	ActionFlag Action = "flag"
+	// ActionMask saves the text with the flagged terms replaced by asterisks.
+	ActionMask Action = "mask"
+)
+
+// ValidActions lists all valid Action values.
+var ValidActions = []Action{ActionReject, ActionFlag, ActionMask}
+
+// IsValid reports whether a is one of the declared Action values.
+func (a Action) IsValid() bool {
+	return slices.Contains(ValidActions, a)
+}
+
+// Verdict is the outcome of moderating one piece of text.
+type Verdict struct {
+	Flagged bool
+	// Terms are the objectionable words found, as written.
+	Terms []string
+	// Masked is the text with every occurrence of Terms replaced by asterisks.
+	Masked string
+}
+
+// Moderator checks text for objectionable language.
+type Moderator interface {
+	Moderate(ctx context.Context, text string) (Verdict, error)
+}
+
+// leetspeak maps the digits and symbols commonly swapped for letters to those letters, so
+// "h3ll0" matches "hello".
+var leetspeak = strings.NewReplacer(
+	"0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "@", "a", "$", "s",
+)
+
+// normalize folds a word for comparison: lower case, with leetspeak undone.
+func normalize(word string) string {
+	return leetspeak.Replace(strings.ToLower(word))
+}
+
+// isWordRune reports whether r is part of a word, counting the leetspeak symbols.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '@' || r == '$'
+}
+
+// scan finds the words of text whose normalized form matches, and returns them with text masked.
+func scan(text string, matches func(normalized string) bool) Verdict {
+	verdict := Verdict{Masked: text}
+
+	var masked strings.Builder
+
+	runes := []rune(text)
+	start := -1
+
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && isWordRune(runes[i]) {
+			if start < 0 {
+				start = i
+			}
+
+			continue
+		}
+
+		if start >= 0 {
+			word := string(runes[start:i])
+			if matches(normalize(word)) {
+				verdict.Flagged = true
+				verdict.Terms = append(verdict.Terms, word)
+				word = strings.Repeat("*", i-start)
+			}
+
+			masked.WriteString(word)
+
+			start = -1
+		}
+
+		if i < len(runes) {
+			masked.WriteRune(runes[i])
+		}
+	}
+
+	if verdict.Flagged {
+		verdict.Masked = masked.String()
+	}
+
+	return verdict
+}
+
+// MaskTerms returns the verdict for text given the objectionable terms found in it, masking
+// every word of text that matches one of them.
+func MaskTerms(text string, terms []string) Verdict {
+	set := make(map[string]struct{}, len(terms))
+	for _, term := range terms {
+		set[normalize(term)] = struct{}{}
+	}
+
+	return scan(text, func(word string) bool {
+		_, ok := set[word]
+
+		return ok
+	})
+}
@@ -0,0 +1,158 @@
+package contentmod_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/contentmod"
+)
+
+func TestWordList(t *testing.T) {
+	t.Parallel()
+
+	list := contentmod.NewWordList([]string{"darn", " Heck ", ""})
+	assert.Equal(t, 2, list.Len())
+
+	tests := []struct {
+		name   string
+		text   string
+		terms  []string
+		masked string
+	}{
+		{"clean", "Home cook from Lyon", nil, "Home cook from Lyon"},
+		{"whole words only", "Darned good heckling", nil, "Darned good heckling"},
+		{"ignores case", "Oh DARN it", []string{"DARN"}, "Oh **** it"},
+		{"undoes leetspeak", "what the h3ck, d@rn!", []string{"h3ck", "d@rn"}, "what the ****, ****!"},
+		{"keeps other runes", "Crème brûlée, darn.", []string{"darn"}, "Crème brûlée, ****."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			verdict, err := list.Moderate(t.Context(), tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.terms) > 0, verdict.Flagged)
+			assert.Equal(t, tt.terms, verdict.Terms)
+			assert.Equal(t, tt.masked, verdict.Masked)
+		})
+	}
+}
+
+func TestLoadWordList(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "words.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# comments are skipped\ndarn\n\n  heck\n"), 0o600))
+
+	list, err := contentmod.LoadWordList(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, list.Len())
+
+	_, err = contentmod.LoadWordList(filepath.Join(t.TempDir(), "missing.txt"))
+	require.Error(t, err)
+}
+
+func TestBreaker(t *testing.T) {
+	t.Parallel()
+
+	breaker := contentmod.NewBreaker(2, 10*time.Millisecond)
+
+	assert.True(t, breaker.Allow())
+	assert.False(t, breaker.Failure())
+	assert.True(t, breaker.Allow(), "below the threshold")
+	assert.True(t, breaker.Failure(), "opens at the threshold")
+	assert.False(t, breaker.Allow())
+
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, breaker.Allow(), "one probe after the cooldown")
+	assert.False(t, breaker.Allow(), "while the probe is in flight")
+
+	breaker.Success()
+	assert.True(t, breaker.Allow())
+	assert.True(t, breaker.Allow())
+}
+
+func TestAPIModerator(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Equal(t, "Bearer api-key", r.Header.Get("Authorization"))
+
+		var req struct {
+			Text string `json:"text"`
+		}
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch req.Text {
+		case "fail":
+			w.WriteHeader(http.StatusBadGateway)
+		case "rude words":
+			_, _ = w.Write([]byte(`{"flagged":true,"terms":["RUDE"]}`))
+		default:
+			_, _ = w.Write([]byte(`{"flagged":false}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	moderator := contentmod.NewAPIModerator(contentmod.APIConfig{
+		URL:              server.URL,
+		APIKey:           "api-key",
+		FailureThreshold: 2,
+		Cooldown:         time.Hour,
+		Fallback:         contentmod.NewWordList([]string{"fail"}),
+	})
+
+	verdict, err := moderator.Moderate(t.Context(), "kind words")
+	require.NoError(t, err)
+	assert.False(t, verdict.Flagged)
+
+	verdict, err = moderator.Moderate(t.Context(), "rude words")
+	require.NoError(t, err)
+	assert.True(t, verdict.Flagged)
+	assert.Equal(t, "**** words", verdict.Masked)
+
+	t.Run("falls back while failing", func(t *testing.T) {
+		for range 2 {
+			verdict, err := moderator.Moderate(t.Context(), "fail")
+			require.NoError(t, err)
+			assert.True(t, verdict.Flagged, "moderated by the word list")
+		}
+
+		before := calls.Load()
+
+		verdict, err := moderator.Moderate(t.Context(), "rude words")
+		require.NoError(t, err)
+		assert.False(t, verdict.Flagged, "the open breaker skips the API")
+		assert.Equal(t, before, calls.Load())
+	})
+}
+
+func TestAPIModerator_WithoutFallback(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	moderator := contentmod.NewAPIModerator(contentmod.APIConfig{URL: server.URL})
+
+	_, err := moderator.Moderate(t.Context(), "anything")
+	require.ErrorIs(t, err, contentmod.ErrUnavailable)
+}
@@ -0,0 +1,71 @@
+package contentmod
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// WordList flags text containing any of a fixed set of words. Words match whole, ignoring case
+// and common leetspeak substitutions; entries with spaces never match.
+type WordList struct {
+	words map[string]struct{}
+}
+
+// NewWordList creates a WordList of words.
+func NewWordList(words []string) *WordList {
+	list := &WordList{words: make(map[string]struct{}, len(words))}
+
+	for _, word := range words {
+		word = strings.TrimSpace(word)
+		if word != "" {
+			list.words[normalize(word)] = struct{}{}
+		}
+	}
+
+	return list
+}
+
+// LoadWordList reads a WordList from a file with one word per line. Blank lines and lines
+// starting with # are ignored.
+func LoadWordList(path string) (*WordList, error) {
+	file, err := os.Open(path) //nolint:gosec // path is operator-supplied configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to open word list: %w", err)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	var words []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			words = append(words, line)
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read word list: %w", err)
+	}
+
+	return NewWordList(words), nil
+}
+
+// Len returns the number of words in the list.
+func (l *WordList) Len() int {
+	return len(l.words)
+}
+
+// Moderate flags text containing a listed word. It never fails.
+func (l *WordList) Moderate(_ context.Context, text string) (Verdict, error) {
+	return scan(text, func(word string) bool {
+		_, ok := l.words[word]
+
+		return ok
+	}), nil
+}
@@ -0,0 +1,126 @@
+// Package crypto hashes short-lived secrets such as confirmation codes and tokens, so the stores
+// holding them never see the codes themselves.
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2 parameters recommended by OWASP for argon2id: 19 MiB, 2 passes, 1 lane.
+const (
+	DefaultArgon2Time      = 2
+	DefaultArgon2MemoryKiB = 19 * 1024
+	DefaultArgon2Threads   = 1
+
+	saltLength = 16
+	keyLength  = 32
+	hashPrefix = "$argon2id$"
+)
+
+// ErrMalformedHash is returned when verifying against a value that is not an argon2id hash in
+// the encoding Hash produces.
+var ErrMalformedHash = errors.New("malformed argon2id hash")
+
+// Argon2Params are the argon2id cost parameters. Hashes record the parameters they were made
+// with, so changing them does not invalidate stored hashes.
+type Argon2Params struct {
+	Time      uint32
+	MemoryKiB uint32
+	Threads   uint8
+}
+
+// DefaultArgon2Params returns the recommended parameters.
+func DefaultArgon2Params() Argon2Params {
+	return Argon2Params{Time: DefaultArgon2Time, MemoryKiB: DefaultArgon2MemoryKiB, Threads: DefaultArgon2Threads}
+}
+
+// Hasher hashes codes with argon2id. It is safe for concurrent use.
+type Hasher struct {
+	params Argon2Params
+}
+
+// NewHasher creates a Hasher with params.
+func NewHasher(params Argon2Params) *Hasher {
+	return &Hasher{params: params}
+}
+
+// Hash returns code's argon2id hash with a random salt, in the PHC string format:
+// $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<hash>.
+func (h *Hasher) Hash(code string) (string, error) {
+	salt := make([]byte, saltLength)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(code), salt, h.params.Time, h.params.MemoryKiB, h.params.Threads, keyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", hashPrefix, argon2.Version,
+		h.params.MemoryKiB, h.params.Time, h.params.Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// IsHash reports whether s looks like a hash made by Hash, as opposed to a code stored before
+// codes were hashed.
+func IsHash(s string) bool {
+	return strings.HasPrefix(s, hashPrefix)
+}
+
+// Verify reports whether code matches encoded, a hash made by Hash with any parameters. The
+// comparison takes constant time.
+func Verify(code, encoded string) (bool, error) {
+	params, salt, key, err := decodeHash(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	candidate := argon2.IDKey([]byte(code), salt, params.Time, params.MemoryKiB, params.Threads,
+		uint32(len(key))) //nolint:gosec // decodeHash bounds the key length
+
+	return subtle.ConstantTimeCompare(candidate, key) == 1, nil
+}
+
+// maxDecodedMemoryKiB bounds the memory a stored hash can make Verify allocate.
+const maxDecodedMemoryKiB = 1 << 20
+
+func decodeHash(encoded string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+
+	// "", "argon2id", "v=19", "m=..,t=..,p=..", salt, key
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" { //nolint:mnd // PHC string fields
+		return params, nil, nil, ErrMalformedHash
+	}
+
+	var version int
+
+	_, err := fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil || version != argon2.Version {
+		return params, nil, nil, ErrMalformedHash
+	}
+
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.MemoryKiB, &params.Time, &params.Threads)
+	if err != nil || params.Time == 0 || params.Threads == 0 || params.MemoryKiB > maxDecodedMemoryKiB {
+		return params, nil, nil, ErrMalformedHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return params, nil, nil, ErrMalformedHash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 || len(key) > 1024 { //nolint:mnd // far above any real key length
+		return params, nil, nil, ErrMalformedHash
+	}
+
+	return params, salt, key, nil
+}
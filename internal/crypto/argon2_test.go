@@ -0,0 +1,86 @@
+package crypto_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/crypto"
+)
+
+// testParams keeps the tests fast; hashes record their own parameters.
+var testParams = crypto.Argon2Params{Time: 1, MemoryKiB: 64, Threads: 1}
+
+func TestHasher(t *testing.T) {
+	t.Parallel()
+
+	hasher := crypto.NewHasher(testParams)
+
+	hash, err := hasher.Hash("code-123")
+	require.NoError(t, err)
+	assert.True(t, crypto.IsHash(hash))
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$"), hash)
+	assert.NotContains(t, hash, "code-123")
+
+	other, err := hasher.Hash("code-123")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "hashes are salted")
+
+	ok, err := crypto.Verify("code-123", hash)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = crypto.Verify("code-124", hash)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	t.Run("parameters are read from the hash", func(t *testing.T) {
+		t.Parallel()
+
+		stronger, err := crypto.NewHasher(crypto.Argon2Params{Time: 2, MemoryKiB: 128, Threads: 2}).Hash("code-123")
+		require.NoError(t, err)
+
+		ok, err := crypto.Verify("code-123", stronger)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		t.Parallel()
+
+		parts := strings.Split(hash, "$")
+
+		for _, bad := range []string{
+			"",
+			"code-123",
+			"$argon2i$v=19$m=64,t=1,p=1$" + parts[4] + "$" + parts[5],
+			"$argon2id$v=16$m=64,t=1,p=1$" + parts[4] + "$" + parts[5],
+			"$argon2id$v=19$m=64,t=0,p=1$" + parts[4] + "$" + parts[5],
+			"$argon2id$v=19$m=99999999,t=1,p=1$" + parts[4] + "$" + parts[5],
+			"$argon2id$v=19$m=64,t=1,p=1$!!$" + parts[5],
+			"$argon2id$v=19$m=64,t=1,p=1$" + parts[4],
+		} {
+			_, err := crypto.Verify("code-123", bad)
+			require.ErrorIs(t, err, crypto.ErrMalformedHash, bad)
+		}
+	})
+}
+
+func BenchmarkHash(b *testing.B) {
+	hasher := crypto.NewHasher(crypto.DefaultArgon2Params())
+
+	for b.Loop() {
+		_, _ = hasher.Hash("3f1c2a9e-5b7d-4e8f-9a0b-1c2d3e4f5a6b")
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	hash, err := crypto.NewHasher(crypto.DefaultArgon2Params()).Hash("3f1c2a9e-5b7d-4e8f-9a0b-1c2d3e4f5a6b")
+	require.NoError(b, err)
+
+	for b.Loop() {
+		_, _ = crypto.Verify("3f1c2a9e-5b7d-4e8f-9a0b-1c2d3e4f5a6b", hash)
+	}
+}
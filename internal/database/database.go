@@ -9,8 +9,10 @@ import (
 	"strconv"
 	"time"
 
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/querytrace"
 )
 
 // Service represents a service that interacts with a database.
@@ -41,11 +43,16 @@ func New(cfg *config.PostgresConfig) (*Service, error) {
 		cfg.Schema,
 	)
 
-	db, err := sql.Open("pgx", dsn)
+	connConfig, err := pgx.ParseConfig(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	// Record statements for requests traced by the slow request middleware
+	connConfig.Tracer = querytrace.Tracer{}
+
+	db := stdlib.OpenDB(*connConfig)
+
 	db.SetMaxOpenConns(cfg.DefaultMaxOpenConns)
 	db.SetMaxIdleConns(cfg.DefaultMaxIdleConns)
 	db.SetConnMaxLifetime(cfg.DefaultConnMaxLifetime)
@@ -0,0 +1,203 @@
+// Package dataloader batches and memoizes lookups made while serving a single request. The same
+// user is often read several times per request, e.g. by each section of an expanded profile;
+// with a request scope installed by Middleware, those reads share one query.
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Loader batching defaults.
+const (
+	// DefaultWait is how long a loader collects keys before dispatching a batch.
+	DefaultWait = time.Millisecond
+	// DefaultMaxBatch dispatches a batch early once it holds this many keys.
+	DefaultMaxBatch = 100
+	// DefaultFetchTimeout bounds a batch fetch, which no single caller's deadline applies to.
+	DefaultFetchTimeout = 5 * time.Second
+)
+
+// Option configures the batching of a Loader.
+type Option func(*options)
+
+type options struct {
+	wait         time.Duration
+	maxBatch     int
+	fetchTimeout time.Duration
+}
+
+// WithWait sets how long a loader collects keys before dispatching a batch.
+func WithWait(wait time.Duration) Option {
+	return func(o *options) { o.wait = wait }
+}
+
+// WithMaxBatch sets the number of keys at which a batch is dispatched without waiting.
+func WithMaxBatch(maxBatch int) Option {
+	return func(o *options) { o.maxBatch = maxBatch }
+}
+
+// WithFetchTimeout sets how long a batch fetch may run.
+func WithFetchTimeout(timeout time.Duration) Option {
+	return func(o *options) { o.fetchTimeout = timeout }
+}
+
+// BatchFunc loads values for keys. Keys missing from the result resolve to the loader's
+// not-found error.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader coalesces Load calls for distinct keys into batches and memoizes the results for its
+// lifetime. Failed batches are not memoized, so a later Load retries. A Loader is meant to live
+// for one request.
+type Loader[K comparable, V any] struct {
+	fetch        BatchFunc[K, V]
+	notFound     error
+	wait         time.Duration
+	maxBatch     int
+	fetchTimeout time.Duration
+
+	mu      sync.Mutex
+	results map[K]*result[V]
+	pending *batch[K, V]
+}
+
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type batch[K comparable, V any] struct {
+	ctx     context.Context //nolint:containedctx // the batch carries its first caller's values
+	keys    []K
+	results []*result[V]
+	timer   *time.Timer
+}
+
+// NewLoader creates a loader that resolves keys absent from fetch's result to notFound.
+func NewLoader[K comparable, V any](fetch BatchFunc[K, V], notFound error, opts ...Option) *Loader[K, V] {
+	o := options{wait: DefaultWait, maxBatch: DefaultMaxBatch, fetchTimeout: DefaultFetchTimeout}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Loader[K, V]{
+		fetch:        fetch,
+		notFound:     notFound,
+		wait:         o.wait,
+		maxBatch:     o.maxBatch,
+		fetchTimeout: o.fetchTimeout,
+		results:      make(map[K]*result[V]),
+	}
+}
+
+// Load returns the value for key, joining an in-flight or completed lookup when there is one.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+
+	res, ok := l.results[key]
+	if !ok {
+		res = &result[V]{done: make(chan struct{})}
+		l.results[key] = res
+		l.enqueue(ctx, key, res)
+	}
+
+	l.mu.Unlock()
+
+	select {
+	case <-res.done:
+		return res.value, res.err
+	case <-ctx.Done():
+		var zero V
+
+		return zero, ctx.Err()
+	}
+}
+
+// Prime stores value for key, replacing any memoized result, e.g. after the key was written.
+func (l *Loader[K, V]) Prime(key K, value V) {
+	res := &result[V]{done: make(chan struct{}), value: value}
+	close(res.done)
+
+	l.mu.Lock()
+	l.results[key] = res
+	l.mu.Unlock()
+}
+
+// Clear forgets key so the next Load fetches it again.
+func (l *Loader[K, V]) Clear(key K) {
+	l.mu.Lock()
+	delete(l.results, key)
+	l.mu.Unlock()
+}
+
+// enqueue adds key to the pending batch, starting one if needed. l.mu must be held.
+func (l *Loader[K, V]) enqueue(ctx context.Context, key K, res *result[V]) {
+	if l.pending == nil {
+		b := &batch[K, V]{ctx: ctx}
+		b.timer = time.AfterFunc(l.wait, func() { l.dispatch(b) })
+		l.pending = b
+	}
+
+	b := l.pending
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, res)
+
+	if len(b.keys) >= l.maxBatch && b.timer.Stop() {
+		l.pending = nil
+
+		go l.run(b)
+	}
+}
+
+// dispatch runs b when its wait elapses, unless it was already dispatched for being full.
+func (l *Loader[K, V]) dispatch(b *batch[K, V]) {
+	l.mu.Lock()
+	if l.pending == b {
+		l.pending = nil
+	}
+	l.mu.Unlock()
+
+	l.run(b)
+}
+
+// run fetches b on behalf of all its callers. The fetch keeps the values of the caller that
+// started the batch but not its cancellation, so one caller giving up doesn't fail the others;
+// the fetch timeout bounds it instead.
+func (l *Loader[K, V]) run(b *batch[K, V]) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(b.ctx), l.fetchTimeout)
+	defer cancel()
+
+	values, err := l.fetch(ctx, b.keys)
+	if err != nil {
+		l.forget(b)
+	}
+
+	for i, key := range b.keys {
+		res := b.results[i]
+
+		switch value, ok := values[key]; {
+		case err != nil:
+			res.err = err
+		case !ok:
+			res.err = l.notFound
+		default:
+			res.value = value
+		}
+
+		close(res.done)
+	}
+}
+
+// forget removes the memoized results of a failed batch, unless they were replaced meanwhile.
+func (l *Loader[K, V]) forget(b *batch[K, V]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, key := range b.keys {
+		if l.results[key] == b.results[i] {
+			delete(l.results, key)
+		}
+	}
+}
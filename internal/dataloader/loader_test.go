@@ -0,0 +1,201 @@
+package dataloader_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dataloader"
+)
+
+var errNotFound = errors.New("not found")
+
+type recordingFetch struct {
+	mu      sync.Mutex
+	batches [][]int
+	err     error
+}
+
+func (f *recordingFetch) fetch(_ context.Context, keys []int) (map[int]string, error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, slices.Clone(keys))
+	f.mu.Unlock()
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	values := make(map[int]string, len(keys))
+
+	for _, key := range keys {
+		if key >= 0 {
+			values[key] = string(rune('a' + key))
+		}
+	}
+
+	return values, nil
+}
+
+func (f *recordingFetch) calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.batches)
+}
+
+func TestLoader_BatchesAndMemoizes(t *testing.T) {
+	t.Parallel()
+
+	f := &recordingFetch{}
+	// Only a full batch dispatches, so the test does not depend on goroutine scheduling.
+	loader := dataloader.NewLoader(f.fetch, errNotFound, dataloader.WithWait(time.Hour), dataloader.WithMaxBatch(3))
+
+	var wg sync.WaitGroup
+
+	for _, key := range []int{0, 1, 2, 1, 0} {
+		wg.Go(func() {
+			value, err := loader.Load(t.Context(), key)
+			assert.NoError(t, err)
+			assert.Equal(t, string(rune('a'+key)), value)
+		})
+	}
+
+	wg.Wait()
+
+	require.Equal(t, 1, f.calls(), "concurrent loads share one batch")
+	assert.ElementsMatch(t, []int{0, 1, 2}, f.batches[0], "keys are deduplicated")
+
+	value, err := loader.Load(t.Context(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, "c", value)
+	assert.Equal(t, 1, f.calls(), "completed lookups are memoized")
+}
+
+func TestLoader_MissingKeyResolvesToNotFound(t *testing.T) {
+	t.Parallel()
+
+	loader := dataloader.NewLoader((&recordingFetch{}).fetch, errNotFound)
+
+	_, err := loader.Load(t.Context(), -1)
+	require.ErrorIs(t, err, errNotFound)
+}
+
+func TestLoader_FailedBatchIsRetried(t *testing.T) {
+	t.Parallel()
+
+	errDB := errors.New("connection reset")
+	f := &recordingFetch{err: errDB}
+	loader := dataloader.NewLoader(f.fetch, errNotFound)
+
+	_, err := loader.Load(t.Context(), 1)
+	require.ErrorIs(t, err, errDB)
+
+	f.mu.Lock()
+	f.err = nil
+	f.mu.Unlock()
+
+	value, err := loader.Load(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "b", value)
+	assert.Equal(t, 2, f.calls())
+}
+
+func TestLoader_CallerCancellationDoesNotFailBatch(t *testing.T) {
+	t.Parallel()
+
+	started, release := make(chan struct{}), make(chan struct{})
+
+	var fetchErr error
+
+	fetch := func(ctx context.Context, keys []int) (map[int]string, error) {
+		close(started)
+		<-release
+
+		fetchErr = ctx.Err()
+
+		return map[int]string{keys[0]: "b"}, fetchErr
+	}
+	loader := dataloader.NewLoader(fetch, errNotFound)
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	go func() {
+		<-started
+		cancel()
+		close(release)
+	}()
+
+	_, err := loader.Load(ctx, 1)
+	require.ErrorIs(t, err, context.Canceled)
+
+	value, err := loader.Load(t.Context(), 1)
+	require.NoError(t, err, "callers joining the batch still get its result")
+	assert.Equal(t, "b", value)
+	assert.NoError(t, fetchErr)
+}
+
+func TestLoader_FetchTimeout(t *testing.T) {
+	t.Parallel()
+
+	fetch := func(ctx context.Context, _ []int) (map[int]string, error) {
+		<-ctx.Done()
+
+		return nil, ctx.Err()
+	}
+	loader := dataloader.NewLoader(fetch, errNotFound, dataloader.WithFetchTimeout(10*time.Millisecond))
+
+	_, err := loader.Load(t.Context(), 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestLoader_PrimeAndClear(t *testing.T) {
+	t.Parallel()
+
+	f := &recordingFetch{}
+	loader := dataloader.NewLoader(f.fetch, errNotFound)
+
+	loader.Prime(1, "primed")
+
+	value, err := loader.Load(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "primed", value)
+	assert.Zero(t, f.calls())
+
+	loader.Clear(1)
+
+	value, err = loader.Load(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "b", value)
+	assert.Equal(t, 1, f.calls())
+}
+
+func TestMiddleware_ScopesSafeRequests(t *testing.T) {
+	t.Parallel()
+
+	for method, scoped := range map[string]bool{
+		http.MethodGet:    true,
+		http.MethodHead:   true,
+		http.MethodPost:   false,
+		http.MethodPut:    false,
+		http.MethodDelete: false,
+	} {
+		var called atomic.Bool
+
+		handler := dataloader.Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			called.Store(true)
+			assert.Equal(t, scoped, dataloader.FromContext(r.Context()) != nil, method)
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(method, "/", nil))
+		assert.True(t, called.Load())
+	}
+}
@@ -0,0 +1,60 @@
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+type scopeKey struct{}
+
+// Scope holds the loaders of one request, created on first use.
+type Scope struct {
+	mu      sync.Mutex
+	loaders map[any]any
+}
+
+// NewContext returns ctx with a fresh request scope.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scopeKey{}, &Scope{loaders: make(map[any]any)})
+}
+
+// FromContext returns the request scope of ctx, or nil when none was installed.
+func FromContext(ctx context.Context) *Scope {
+	scope, _ := ctx.Value(scopeKey{}).(*Scope)
+
+	return scope
+}
+
+// Middleware installs a request scope for safe (GET and HEAD) requests. Requests that write are
+// left unscoped so they always read their own writes.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			r = r.WithContext(NewContext(r.Context()))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// scopedLoader returns the loader stored in the scope of ctx under key, creating it with
+// newLoader on first use. It returns nil when ctx has no scope.
+func scopedLoader[K comparable, V any](ctx context.Context, key any, newLoader func() *Loader[K, V]) *Loader[K, V] {
+	scope := FromContext(ctx)
+	if scope == nil {
+		return nil
+	}
+
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+
+	if loader, ok := scope.loaders[key].(*Loader[K, V]); ok {
+		return loader
+	}
+
+	loader := newLoader()
+	scope.loaders[key] = loader
+
+	return loader
+}
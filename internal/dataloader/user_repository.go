@@ -0,0 +1,120 @@
+package dataloader
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+type (
+	usersKey   struct{}
+	privacyKey struct{}
+)
+
+// UserRepository batches and memoizes user and privacy lookups within a request scope. Without a
+// scope in the context every call goes straight to the wrapped repository.
+type UserRepository struct {
+	repository.UserRepository
+
+	opts []Option
+}
+
+// NewUserRepository wraps repo with request-scoped loaders. Lookups are batched into one query
+// when repo implements repository.UserBatchReader and otherwise only deduplicated.
+func NewUserRepository(repo repository.UserRepository, opts ...Option) *UserRepository {
+	return &UserRepository{UserRepository: repo, opts: opts}
+}
+
+// FindUserByID retrieves a user by their ID through the request's user loader.
+func (r *UserRepository) FindUserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
+	loader := r.users(ctx)
+	if loader == nil {
+		return r.UserRepository.FindUserByID(ctx, userID) //nolint:wrapcheck // transparent decorator
+	}
+
+	return loader.Load(ctx, userID)
+}
+
+// FindPrivacyPreferencesByUserID retrieves privacy preferences through the request's privacy loader.
+func (r *UserRepository) FindPrivacyPreferencesByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.UserPrivacyPreferences, error) {
+	loader := r.privacy(ctx)
+	if loader == nil {
+		//nolint:wrapcheck // transparent decorator
+		return r.UserRepository.FindPrivacyPreferencesByUserID(ctx, userID)
+	}
+
+	return loader.Load(ctx, userID)
+}
+
+// UpdateUser updates the user and refreshes the memoized copy, if any.
+func (r *UserRepository) UpdateUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.UserProfileUpdateRequest,
+) (*dto.User, error) {
+	user, err := r.UserRepository.UpdateUser(ctx, userID, update)
+
+	if loader := r.users(ctx); loader != nil {
+		if err != nil {
+			loader.Clear(userID)
+		} else {
+			loader.Prime(userID, user)
+		}
+	}
+
+	return user, err //nolint:wrapcheck // transparent decorator
+}
+
+func (r *UserRepository) users(ctx context.Context) *Loader[uuid.UUID, *dto.User] {
+	return scopedLoader(ctx, usersKey{}, func() *Loader[uuid.UUID, *dto.User] {
+		if batch, ok := r.UserRepository.(repository.UserBatchReader); ok {
+			return NewLoader(batch.FindUsersByIDs, repository.ErrUserNotFound, r.opts...)
+		}
+
+		return NewLoader(eachKey(r.UserRepository.FindUserByID), repository.ErrUserNotFound, r.opts...)
+	})
+}
+
+func (r *UserRepository) privacy(ctx context.Context) *Loader[uuid.UUID, *dto.UserPrivacyPreferences] {
+	return scopedLoader(ctx, privacyKey{}, func() *Loader[uuid.UUID, *dto.UserPrivacyPreferences] {
+		if batch, ok := r.UserRepository.(repository.UserBatchReader); ok {
+			return NewLoader(batch.FindPrivacyPreferencesByUserIDs, repository.ErrUserNotFound, r.opts...)
+		}
+
+		return NewLoader(
+			eachKey(r.UserRepository.FindPrivacyPreferencesByUserID),
+			repository.ErrUserNotFound,
+			r.opts...,
+		)
+	})
+}
+
+// eachKey adapts a single-key lookup to a BatchFunc. Not-found keys are left out of the result;
+// any other error fails the batch.
+func eachKey[V any](find func(context.Context, uuid.UUID) (V, error)) BatchFunc[uuid.UUID, V] {
+	return func(ctx context.Context, keys []uuid.UUID) (map[uuid.UUID]V, error) {
+		values := make(map[uuid.UUID]V, len(keys))
+
+		for _, key := range keys {
+			value, err := find(ctx, key)
+			if errors.Is(err, repository.ErrUserNotFound) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			values[key] = value
+		}
+
+		return values, nil
+	}
+}
@@ -0,0 +1,127 @@
+package dataloader_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dataloader"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// batchUserRepository is a user repository that also supports batch reads.
+type batchUserRepository struct {
+	*mocks.UserRepository
+	*mocks.UserBatchReader
+}
+
+func TestUserRepository_PassesThroughWithoutScope(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	repo := mocks.NewUserRepository(t)
+	repo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil).Twice()
+
+	loader := dataloader.NewUserRepository(repo)
+
+	for range 2 {
+		user, err := loader.FindUserByID(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, userID.String(), user.UserID)
+	}
+}
+
+func TestUserRepository_BatchesWithinScope(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, missing := uuid.New(), uuid.New(), uuid.New()
+	repo := batchUserRepository{mocks.NewUserRepository(t), mocks.NewUserBatchReader(t)}
+	repo.UserBatchReader.
+		On("FindUsersByIDs", mock.Anything, mock.MatchedBy(func(ids []uuid.UUID) bool {
+			return assert.ElementsMatch(t, []uuid.UUID{alice, bob, missing}, ids)
+		})).
+		Return(map[uuid.UUID]*dto.User{
+			alice: {UserID: alice.String(), Username: "alice"},
+			bob:   {UserID: bob.String(), Username: "bob"},
+		}, nil).
+		Once()
+
+	loader := dataloader.NewUserRepository(repo, dataloader.WithWait(time.Hour), dataloader.WithMaxBatch(3))
+	ctx := dataloader.NewContext(t.Context())
+
+	var wg sync.WaitGroup
+
+	for _, id := range []uuid.UUID{alice, bob, alice, missing} {
+		wg.Go(func() {
+			user, err := loader.FindUserByID(ctx, id)
+			if id == missing {
+				assert.ErrorIs(t, err, repository.ErrUserNotFound)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, id.String(), user.UserID)
+		})
+	}
+
+	wg.Wait()
+
+	user, err := loader.FindUserByID(ctx, bob)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", user.Username, "served from the request's memo")
+}
+
+func TestUserRepository_DeduplicatesWithoutBatchReader(t *testing.T) {
+	t.Parallel()
+
+	userID, missing := uuid.New(), uuid.New()
+	repo := mocks.NewUserRepository(t)
+	repo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+		Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}, nil).
+		Once()
+	repo.On("FindUserByID", mock.Anything, missing).Return(nil, repository.ErrUserNotFound).Once()
+
+	loader := dataloader.NewUserRepository(repo)
+	ctx := dataloader.NewContext(t.Context())
+
+	for range 3 {
+		prefs, err := loader.FindPrivacyPreferencesByUserID(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, dto.ProfileVisibilityPrivate, prefs.ProfileVisibility)
+
+		_, err = loader.FindUserByID(ctx, missing)
+		require.ErrorIs(t, err, repository.ErrUserNotFound)
+	}
+}
+
+func TestUserRepository_UpdateRefreshesMemo(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	update := &dto.UserProfileUpdateRequest{}
+	repo := mocks.NewUserRepository(t)
+	repo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{Username: "before"}, nil).Once()
+	repo.On("UpdateUser", mock.Anything, userID, update).Return(&dto.User{Username: "after"}, nil).Once()
+
+	loader := dataloader.NewUserRepository(repo)
+	ctx := dataloader.NewContext(t.Context())
+
+	user, err := loader.FindUserByID(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "before", user.Username)
+
+	_, err = loader.UpdateUser(ctx, userID, update)
+	require.NoError(t, err)
+
+	user, err = loader.FindUserByID(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "after", user.Username)
+}
@@ -0,0 +1,304 @@
+// Package directory reads user entries from an LDAP directory such as OpenLDAP or Active
+// Directory, with go-ldap: a simple bind and equality searches, over TLS (ldaps://) or plain TCP
+// (ldap://) upgraded with StartTLS.
+package directory
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// searchSizeLimit is enough to tell an external ID matching several entries.
+const searchSizeLimit = 2
+
+// Active Directory keeps the disabled flag in the userAccountControl bit field.
+const (
+	adUserAccountControl = "userAccountControl"
+	adAccountDisable     = 0x2
+)
+
+var (
+	// ErrUnsupportedURL is returned for directory URLs other than ldap:// and ldaps://.
+	ErrUnsupportedURL = errors.New("directory URL must be ldap://host[:port] or ldaps://host[:port]")
+	// ErrInsecureBind is returned for a bind password over ldap:// without StartTLS, which would
+	// send it in the clear.
+	ErrInsecureBind = errors.New("directory bind password needs ldaps:// or StartTLS")
+)
+
+// Config configures a Client.
+type Config struct {
+	// URL is ldap://host[:389] or ldaps://host[:636].
+	URL string
+	// StartTLS upgrades ldap:// connections to TLS before binding. A bind password is only sent
+	// over ldaps:// or StartTLS.
+	StartTLS bool
+	// BindDN and BindPassword authenticate the client; an empty BindDN searches anonymously.
+	BindDN       string
+	BindPassword string
+	// BaseDN is the subtree searched for entries.
+	BaseDN string
+	// LookupAttribute holds the external IDs accounts are linked by, e.g. uid or sAMAccountName.
+	LookupAttribute string
+	// FullNameAttribute, EmailAttribute and ActiveAttribute name the attributes read into the
+	// full name, email and active flag of an entry. Empty ones are not read.
+	FullNameAttribute string
+	EmailAttribute    string
+	ActiveAttribute   string
+	// InactiveValues are the ActiveAttribute values of disabled accounts, compared ignoring case.
+	// Active Directory's userAccountControl is read as flags instead.
+	InactiveValues []string
+	// Timeout bounds connecting and each exchange with the server.
+	Timeout time.Duration
+	// TLSConfig is used for ldaps:// and StartTLS; nil verifies the server against the host's
+	// root CAs.
+	TLSConfig *tls.Config
+}
+
+// Client looks up user entries in an LDAP directory. Every lookup opens its own connection, so a
+// Client is safe for concurrent use.
+type Client struct {
+	cfg     Config
+	host    string
+	address string
+	useTLS  bool
+}
+
+// NewClient creates a new Client.
+func NewClient(cfg Config) (*Client, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || u.Hostname() == "" {
+		return nil, fmt.Errorf("%w, got %q", ErrUnsupportedURL, cfg.URL)
+	}
+
+	var port string
+
+	switch u.Scheme {
+	case "ldap":
+		port = ldap.DefaultLdapPort
+	case "ldaps":
+		port = ldap.DefaultLdapsPort
+	default:
+		return nil, fmt.Errorf("%w, got %q", ErrUnsupportedURL, cfg.URL)
+	}
+
+	if u.Scheme == "ldap" && !cfg.StartTLS && cfg.BindPassword != "" {
+		return nil, ErrInsecureBind
+	}
+
+	if u.Port() != "" {
+		port = u.Port()
+	}
+
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+
+	return &Client{
+		cfg:     cfg,
+		host:    u.Hostname(),
+		address: net.JoinHostPort(u.Hostname(), port),
+		useTLS:  u.Scheme == "ldaps",
+	}, nil
+}
+
+// LookupEntries returns the entries whose lookup attribute equals each external ID, keyed by
+// the ID, reading them over one connection. IDs matching no entry are left out, as are IDs
+// matching several, which cannot be told apart.
+func (c *Client) LookupEntries(ctx context.Context, externalIDs []string) (map[string]dto.DirectoryEntry, error) {
+	entries := make(map[string]dto.DirectoryEntry, len(externalIDs))
+	if len(externalIDs) == 0 {
+		return entries, nil
+	}
+
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to directory: %w", err)
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	// Unblock reads and writes as soon as the caller gives up
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	err = c.lookup(ctx, conn, externalIDs, entries)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		return nil, err
+	}
+
+	_ = conn.Unbind()
+
+	return entries, nil
+}
+
+func (c *Client) lookup(
+	ctx context.Context,
+	conn *ldap.Conn,
+	externalIDs []string,
+	entries map[string]dto.DirectoryEntry,
+) error {
+	if c.cfg.StartTLS && !c.useTLS {
+		err := conn.StartTLS(c.tlsConfig())
+		if err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if c.cfg.BindDN != "" {
+		err := conn.Bind(c.cfg.BindDN, c.cfg.BindPassword)
+		if err != nil {
+			return fmt.Errorf("failed to bind to directory: %w", err)
+		}
+	}
+
+	attributes := slices.DeleteFunc(
+		[]string{c.cfg.FullNameAttribute, c.cfg.EmailAttribute, c.cfg.ActiveAttribute},
+		func(name string) bool { return name == "" },
+	)
+
+	for _, externalID := range externalIDs {
+		found, err := c.search(conn, externalID, attributes)
+		if err != nil {
+			return fmt.Errorf("failed to search directory: %w", err)
+		}
+
+		switch len(found) {
+		case 0:
+		case 1:
+			entries[externalID] = c.toEntry(found[0])
+		default:
+			slog.WarnContext(ctx, "directory has several entries for one external ID", "external_id", externalID)
+		}
+	}
+
+	return nil
+}
+
+// search returns the entries under the base DN whose lookup attribute equals externalID, with the
+// requested attributes. At most searchSizeLimit entries are returned.
+func (c *Client) search(conn *ldap.Conn, externalID string, attributes []string) ([]*ldap.Entry, error) {
+	request := ldap.NewSearchRequest(
+		c.cfg.BaseDN,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		searchSizeLimit,
+		int(max(c.cfg.Timeout/time.Second, 1)),
+		false,
+		fmt.Sprintf("(%s=%s)", ldap.EscapeFilter(c.cfg.LookupAttribute), ldap.EscapeFilter(externalID)),
+		attributes,
+		nil,
+	)
+
+	result, err := conn.Search(request)
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, err //nolint:wrapcheck // wrapped by lookup
+	}
+
+	return result.Entries, nil
+}
+
+// toEntry reads the synced attributes out of a search result entry. LDAP compares attribute names
+// ignoring case.
+func (c *Client) toEntry(e *ldap.Entry) dto.DirectoryEntry {
+	entry := dto.DirectoryEntry{DN: e.DN}
+
+	if value, ok := first(e, c.cfg.FullNameAttribute); ok {
+		entry.FullName = &value
+	}
+
+	if value, ok := first(e, c.cfg.EmailAttribute); ok {
+		entry.Email = &value
+	}
+
+	if value, ok := first(e, c.cfg.ActiveAttribute); ok {
+		active := c.isActive(value)
+		entry.IsActive = &active
+	}
+
+	return entry
+}
+
+// first returns the first value of the named attribute.
+func first(e *ldap.Entry, name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+
+	values := e.GetEqualFoldAttributeValues(name)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	return values[0], true
+}
+
+func (c *Client) isActive(value string) bool {
+	if strings.EqualFold(c.cfg.ActiveAttribute, adUserAccountControl) {
+		flags, err := strconv.ParseInt(value, 10, 64)
+
+		return err != nil || flags&adAccountDisable == 0
+	}
+
+	return !slices.ContainsFunc(c.cfg.InactiveValues, func(inactive string) bool {
+		return strings.EqualFold(inactive, value)
+	})
+}
+
+// tlsConfig returns the TLS configuration of ldaps:// and StartTLS, naming the server so its
+// certificate is verified.
+func (c *Client) tlsConfig() *tls.Config {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.cfg.TLSConfig != nil {
+		tlsConfig = c.cfg.TLSConfig.Clone()
+	}
+
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = c.host
+	}
+
+	return tlsConfig
+}
+
+func (c *Client) dial(ctx context.Context) (*ldap.Conn, error) {
+	dialer := &net.Dialer{Timeout: c.cfg.Timeout}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	if c.useTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: c.tlsConfig()}).DialContext(ctx, "tcp", c.address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", c.address)
+	}
+
+	if err != nil {
+		return nil, err //nolint:wrapcheck // wrapped by LookupEntries
+	}
+
+	ldapConn := ldap.NewConn(conn, c.useTLS)
+	ldapConn.SetTimeout(c.cfg.Timeout)
+	ldapConn.Start()
+
+	return ldapConn, nil
+}
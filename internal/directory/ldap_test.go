@@ -0,0 +1,329 @@
+package directory
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+const (
+	testBindDN   = "cn=sync,dc=example,dc=com"
+	testPassword = "secret"
+
+	startTLSOID = "1.3.6.1.4.1.1466.20037"
+)
+
+// fakeEntry is a directory entry served by fakeServer.
+type fakeEntry struct {
+	dn         string
+	attributes map[string][]string
+}
+
+// fakeServer answers StartTLS, binds and uid equality searches from entries, keyed by uid. Binds
+// over a connection that has not started TLS are refused.
+type fakeServer struct {
+	entries map[string][]fakeEntry
+	tls     *tls.Config
+}
+
+// startFakeServer serves entries on a local port and returns its URL and a client TLS
+// configuration trusting it.
+func startFakeServer(t *testing.T, entries map[string][]fakeEntry) (string, *tls.Config) {
+	t.Helper()
+
+	serverTLS, clientTLS := testTLSConfigs(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	server := &fakeServer{entries: entries, tls: serverTLS}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go server.serve(conn)
+		}
+	}()
+
+	return "ldap://" + listener.Addr().String(), clientTLS
+}
+
+func (s *fakeServer) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	encrypted := false
+
+	for {
+		msg, err := ber.ReadPacket(conn)
+		if err != nil || len(msg.Children) < 2 {
+			return
+		}
+
+		id, _ := msg.Children[0].Value.(int64)
+		op := msg.Children[1]
+
+		reply := func(ops ...*ber.Packet) {
+			for _, op := range ops {
+				envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+				envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, ""))
+				envelope.AppendChild(op)
+				_, _ = conn.Write(envelope.Bytes())
+			}
+		}
+
+		switch op.Tag {
+		case ldap.ApplicationExtendedRequest:
+			if op.Children[0].Data.String() != startTLSOID {
+				reply(encodeResult(ldap.ApplicationExtendedResponse, ldap.LDAPResultProtocolError, "unsupported"))
+
+				continue
+			}
+
+			reply(encodeResult(ldap.ApplicationExtendedResponse, ldap.LDAPResultSuccess, ""))
+
+			conn = tls.Server(conn, s.tls)
+			encrypted = true
+		case ldap.ApplicationBindRequest:
+			code := int64(ldap.LDAPResultSuccess)
+
+			switch {
+			case !encrypted:
+				code = ldap.LDAPResultConfidentialityRequired
+			case op.Children[1].Data.String() != testBindDN || op.Children[2].Data.String() != testPassword:
+				code = ldap.LDAPResultInvalidCredentials
+			}
+
+			reply(encodeResult(ldap.ApplicationBindResponse, code, "bind"))
+		case ldap.ApplicationSearchRequest:
+			filter := op.Children[6]
+			if filter.Children[0].Data.String() != "uid" {
+				reply(encodeResult(ldap.ApplicationSearchResultDone, ldap.LDAPResultUnwillingToPerform, "unwilling"))
+
+				continue
+			}
+
+			var responses []*ber.Packet
+			for _, entry := range s.entries[filter.Children[1].Data.String()] {
+				responses = append(responses, encodeEntry(entry))
+			}
+
+			reply(append(responses, encodeResult(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess, ""))...)
+		case ldap.ApplicationUnbindRequest:
+			return
+		}
+	}
+}
+
+func encodeResult(tag ber.Tag, code int64, message string) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, ""))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, message, ""))
+
+	return result
+}
+
+func encodeEntry(entry fakeEntry) *ber.Packet {
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+
+	for name, values := range entry.attributes {
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, ""))
+		}
+
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, ""))
+		attribute.AppendChild(set)
+		attributes.AppendChild(attribute)
+	}
+
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "")
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.dn, ""))
+	result.AppendChild(attributes)
+
+	return result
+}
+
+// testTLSConfigs returns a server configuration with a self-signed certificate for 127.0.0.1 and
+// a client configuration trusting it.
+func testTLSConfigs(t *testing.T) (*tls.Config, *tls.Config) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+
+	server := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	}
+
+	return server, &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: roots}
+}
+
+func TestClient_LookupEntries(t *testing.T) {
+	t.Parallel()
+
+	// A long name takes the long form of BER lengths
+	longName := strings.Repeat("Ada Lovelace ", 20)
+
+	url, clientTLS := startFakeServer(t, map[string][]fakeEntry{
+		"ada": {{dn: "uid=ada,dc=example,dc=com", attributes: map[string][]string{
+			"displayName":   {longName},
+			"mail":          {"ada@example.com"},
+			"accountStatus": {"active"},
+		}}},
+		"bob": {{dn: "uid=bob,dc=example,dc=com", attributes: map[string][]string{
+			"displayname":   {"Bob"},
+			"accountStatus": {"Disabled"},
+		}}},
+		"twin": {
+			{dn: "uid=twin,ou=a,dc=example,dc=com"},
+			{dn: "uid=twin,ou=b,dc=example,dc=com"},
+		},
+	})
+
+	client, err := NewClient(Config{
+		URL:               url,
+		StartTLS:          true,
+		TLSConfig:         clientTLS,
+		BindDN:            testBindDN,
+		BindPassword:      testPassword,
+		BaseDN:            "dc=example,dc=com",
+		LookupAttribute:   "uid",
+		FullNameAttribute: "displayName",
+		EmailAttribute:    "mail",
+		ActiveAttribute:   "accountStatus",
+		InactiveValues:    []string{"disabled"},
+	})
+	require.NoError(t, err)
+
+	entries, err := client.LookupEntries(t.Context(), []string{"ada", "bob", "twin", "nobody"})
+	require.NoError(t, err)
+
+	active, inactive := true, false
+	email := "ada@example.com"
+	bob := "Bob"
+
+	// Unknown and ambiguous IDs are left out
+	assert.Equal(t, map[string]dto.DirectoryEntry{
+		"ada": {DN: "uid=ada,dc=example,dc=com", DirectoryAttributes: dto.DirectoryAttributes{
+			FullName: &longName, Email: &email, IsActive: &active,
+		}},
+		"bob": {DN: "uid=bob,dc=example,dc=com", DirectoryAttributes: dto.DirectoryAttributes{
+			FullName: &bob, IsActive: &inactive,
+		}},
+	}, entries)
+}
+
+func TestClient_LookupEntries_InvalidCredentials(t *testing.T) {
+	t.Parallel()
+
+	url, clientTLS := startFakeServer(t, nil)
+
+	client, err := NewClient(Config{
+		URL:             url,
+		StartTLS:        true,
+		TLSConfig:       clientTLS,
+		BindDN:          testBindDN,
+		BindPassword:    "wrong",
+		LookupAttribute: "uid",
+	})
+	require.NoError(t, err)
+
+	_, err = client.LookupEntries(t.Context(), []string{"ada"})
+	assert.True(t, ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials), err)
+}
+
+func TestClient_LookupEntries_UntrustedServer(t *testing.T) {
+	t.Parallel()
+
+	url, _ := startFakeServer(t, nil)
+
+	client, err := NewClient(Config{
+		URL:             url,
+		StartTLS:        true,
+		BindDN:          testBindDN,
+		BindPassword:    testPassword,
+		LookupAttribute: "uid",
+	})
+	require.NoError(t, err)
+
+	_, err = client.LookupEntries(t.Context(), []string{"ada"})
+	require.ErrorContains(t, err, "failed to start TLS")
+}
+
+func TestClient_IsActive_ActiveDirectory(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewClient(Config{URL: "ldaps://dc.example.com", ActiveAttribute: "userAccountControl"})
+	require.NoError(t, err)
+	assert.Equal(t, "dc.example.com:636", client.address)
+
+	// 512 is a normal account, 514 the same account disabled
+	assert.True(t, client.isActive("512"))
+	assert.False(t, client.isActive("514"))
+	assert.False(t, client.isActive("66050"))
+}
+
+func TestNewClient_UnsupportedURL(t *testing.T) {
+	t.Parallel()
+
+	for _, url := range []string{"", "http://dc.example.com", "ldap://"} {
+		_, err := NewClient(Config{URL: url})
+		require.ErrorIs(t, err, ErrUnsupportedURL, url)
+	}
+}
+
+func TestNewClient_InsecureBind(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(Config{URL: "ldap://dc.example.com", BindDN: testBindDN, BindPassword: testPassword})
+	require.ErrorIs(t, err, ErrInsecureBind)
+
+	for _, cfg := range []Config{
+		{URL: "ldaps://dc.example.com", BindDN: testBindDN, BindPassword: testPassword},
+		{URL: "ldap://dc.example.com", StartTLS: true, BindDN: testBindDN, BindPassword: testPassword},
+		{URL: "ldap://dc.example.com"},
+	} {
+		_, err := NewClient(cfg)
+		require.NoError(t, err, cfg.URL)
+	}
+}
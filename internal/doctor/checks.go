@@ -0,0 +1,259 @@
+package doctor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// baseTables predate the migrations directory; the repositories read them along with every
+// table the migrations create.
+var baseTables = []string{
+	"recipe_manager.users",
+	"recipe_manager.user_follows",
+	"recipe_manager.user_privacy_preferences",
+	"recipe_manager.user_notification_preferences",
+	"recipe_manager.user_display_preferences",
+	"recipe_manager.user_theme_preferences",
+	"recipe_manager.user_language_preferences",
+	"recipe_manager.user_accessibility_preferences",
+	"recipe_manager.user_security_preferences",
+	"recipe_manager.user_social_preferences",
+	"recipe_manager.user_sound_preferences",
+	"recipe_manager.recipes",
+	"recipe_manager.reviews",
+	"recipe_manager.recipe_favorites",
+}
+
+var (
+	createTablePattern = regexp.MustCompile(`(?i)CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?(\w+\.\w+)`)
+	createIndexPattern = regexp.MustCompile(
+		`(?i)CREATE\s+(?:UNIQUE\s+)?INDEX\s+(?:CONCURRENTLY\s+)?(?:IF\s+NOT\s+EXISTS\s+)?(\w+)\s+` +
+			`ON\s+(?:ONLY\s+)?(\w+)\.(\w+)`)
+	dropPattern = regexp.MustCompile(
+		`(?i)DROP\s+(TABLE|INDEX)\s+(?:CONCURRENTLY\s+)?(?:IF\s+EXISTS\s+)?(\w+\.\w+)`)
+)
+
+// schemaObjects is what the migrations expect to find in the database.
+type schemaObjects struct {
+	latest  uint64
+	tables  []string
+	indexes []string
+	// indexTables maps each index to its table, so dropping the table drops its indexes.
+	indexTables map[string]string
+}
+
+// parseMigrations reads the up migrations in fsys for the latest version and the tables and
+// indexes they create. Indexes are qualified with the schema of their table.
+func parseMigrations(fsys fs.FS) (*schemaObjects, error) {
+	names, err := fs.Glob(fsys, "*.up.sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	if len(names) == 0 {
+		return nil, errors.New("no migrations found")
+	}
+
+	objects := &schemaObjects{tables: slices.Clone(baseTables), indexTables: map[string]string{}}
+
+	for _, name := range names {
+		prefix, _, _ := strings.Cut(path.Base(name), "_")
+
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s has no numeric version: %w", name, err)
+		}
+
+		objects.latest = max(objects.latest, version)
+
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", name, err)
+		}
+
+		for _, match := range createTablePattern.FindAllStringSubmatch(string(content), -1) {
+			objects.tables = append(objects.tables, strings.ToLower(match[1]))
+		}
+
+		for _, match := range createIndexPattern.FindAllStringSubmatch(string(content), -1) {
+			index := strings.ToLower(match[2] + "." + match[1])
+			objects.indexes = append(objects.indexes, index)
+			objects.indexTables[index] = strings.ToLower(match[2] + "." + match[3])
+		}
+
+		objects.drop(dropPattern.FindAllStringSubmatch(string(content), -1))
+	}
+
+	slices.Sort(objects.tables)
+	slices.Sort(objects.indexes)
+	objects.tables = slices.Compact(objects.tables)
+	objects.indexes = slices.Compact(objects.indexes)
+
+	return objects, nil
+}
+
+// drop forgets the tables and indexes a later migration drops, with the indexes of dropped tables.
+func (o *schemaObjects) drop(matches [][]string) {
+	for _, match := range matches {
+		name := strings.ToLower(match[2])
+		if !strings.EqualFold(match[1], "TABLE") {
+			o.indexes = slices.DeleteFunc(o.indexes, func(index string) bool { return index == name })
+
+			continue
+		}
+
+		o.tables = slices.DeleteFunc(o.tables, func(table string) bool { return table == name })
+		o.indexes = slices.DeleteFunc(o.indexes, func(index string) bool { return o.indexTables[index] == name })
+	}
+}
+
+// RequiredIndexes lists the schema-qualified indexes the migrations in fsys create, so readiness
+// can check for them as well.
+func RequiredIndexes(fsys fs.FS) ([]string, error) {
+	objects, err := parseMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return objects.indexes, nil
+}
+
+func checkPostgresConnection(ctx context.Context, db *sql.DB) Check {
+	if db == nil {
+		return Check{Name: checkPostgres, Status: StatusFail, Detail: "no connection could be opened"}
+	}
+
+	err := db.PingContext(ctx)
+	if err != nil {
+		return Check{Name: checkPostgres, Status: StatusFail, Detail: err.Error()}
+	}
+
+	return Check{Name: checkPostgres, Status: StatusPass, Detail: "connected"}
+}
+
+// checkSchema compares the database with the migrations in fsys.
+func checkSchema(ctx context.Context, db *sql.DB, fsys fs.FS) []Check {
+	objects, err := parseMigrations(fsys)
+	if err != nil {
+		return markAll(StatusFail, err.Error(), schemaCheckNames...)
+	}
+
+	return []Check{
+		checkSchemaVersionOf(ctx, db, objects.latest),
+		checkExists(ctx, db, checkTables, "tables", objects.tables),
+		checkExists(ctx, db, checkIndexes, "indexes", objects.indexes),
+	}
+}
+
+// checkSchemaVersionOf reads the version recorded by the migration tool. Databases migrated by
+// hand have no schema_migrations table, so the check is skipped and the table and index checks
+// stand in for it.
+func checkSchemaVersionOf(ctx context.Context, db *sql.DB, latest uint64) Check {
+	fail := func(format string, args ...any) Check {
+		return Check{Name: checkSchemaVersion, Status: StatusFail, Detail: fmt.Sprintf(format, args...)}
+	}
+
+	var tracked bool
+
+	err := db.QueryRowContext(ctx, `SELECT to_regclass('schema_migrations') IS NOT NULL`).Scan(&tracked)
+	if err != nil {
+		return fail("failed to look up schema_migrations: %v", err)
+	}
+
+	if !tracked {
+		return Check{Name: checkSchemaVersion, Status: StatusSkip, Detail: "no schema_migrations table"}
+	}
+
+	var (
+		version uint64
+		dirty   bool
+	)
+
+	err = db.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fail("no migration applied, expected %d", latest)
+	}
+
+	if err != nil {
+		return fail("failed to read schema_migrations: %v", err)
+	}
+
+	switch {
+	case dirty:
+		return fail("migration %d did not finish (dirty)", version)
+	case version < latest:
+		return fail("database is at %d, expected %d", version, latest)
+	case version > latest:
+		return Check{
+			Name:   checkSchemaVersion,
+			Status: StatusPass,
+			Detail: fmt.Sprintf("database is at %d, ahead of %d", version, latest),
+		}
+	default:
+		return Check{Name: checkSchemaVersion, Status: StatusPass, Detail: fmt.Sprintf("database is at %d", version)}
+	}
+}
+
+// checkExists confirms every relation in names exists.
+func checkExists(ctx context.Context, db *sql.DB, name, kind string, names []string) Check {
+	rows, err := db.QueryContext(ctx,
+		`SELECT name FROM unnest(string_to_array($1, ',')) AS name WHERE to_regclass(name) IS NULL ORDER BY name`,
+		strings.Join(names, ","))
+	if err != nil {
+		return Check{Name: name, Status: StatusFail, Detail: fmt.Sprintf("failed to look up %s: %v", kind, err)}
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var missing []string
+
+	for rows.Next() {
+		var relation string
+
+		err = rows.Scan(&relation)
+		if err != nil {
+			return Check{Name: name, Status: StatusFail, Detail: fmt.Sprintf("failed to look up %s: %v", kind, err)}
+		}
+
+		missing = append(missing, relation)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return Check{Name: name, Status: StatusFail, Detail: fmt.Sprintf("failed to look up %s: %v", kind, err)}
+	}
+
+	if len(missing) > 0 {
+		return Check{Name: name, Status: StatusFail, Detail: "missing " + strings.Join(missing, ", ")}
+	}
+
+	return Check{Name: name, Status: StatusPass, Detail: fmt.Sprintf("%d %s present", len(names), kind)}
+}
+
+func checkRedis(ctx context.Context, cache repository.HealthChecker) Check {
+	if cache == nil {
+		return Check{Name: checkRedisName, Status: StatusFail, Detail: "no connection could be opened"}
+	}
+
+	health := cache.Health(ctx)
+	if health["status"] != "up" {
+		detail := health["error"]
+		if detail == "" {
+			detail = health["message"]
+		}
+
+		return Check{Name: checkRedisName, Status: StatusFail, Detail: detail}
+	}
+
+	return Check{Name: checkRedisName, Status: StatusPass, Detail: "connected"}
+}
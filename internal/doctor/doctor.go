@@ -0,0 +1,187 @@
+// Package doctor runs the startup self-check: it validates the configuration, connects to
+// PostgreSQL and Redis and confirms the schema this build expects is in place.
+package doctor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/database"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/migrations"
+)
+
+// Status is the outcome of a single check.
+type Status string
+
+// Check outcomes.
+const (
+	StatusPass Status = "PASS"
+	StatusFail Status = "FAIL"
+	StatusSkip Status = "SKIP"
+)
+
+// Check is the result of one self-check.
+type Check struct {
+	Name   string
+	Status Status
+	Detail string
+}
+
+// Report lists every check in the order it ran.
+type Report struct {
+	Checks []Check
+}
+
+// Passed reports whether no check failed. Skipped checks do not count as failures.
+func (r *Report) Passed() bool {
+	for _, check := range r.Checks {
+		if check.Status == StatusFail {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Write prints one line per check followed by a summary.
+func (r *Report) Write(w io.Writer) error {
+	counts := make(map[Status]int)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	for _, check := range r.Checks {
+		counts[check.Status]++
+
+		_, err := fmt.Fprintf(tw, "%s\t%s\t%s\n", check.Status, check.Name, check.Detail)
+		if err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	}
+
+	err := tw.Flush()
+	if err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	_, err = fmt.Fprintf(w, "\n%d passed, %d failed, %d skipped\n",
+		counts[StatusPass], counts[StatusFail], counts[StatusSkip])
+	if err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
+
+// Options holds what the checks run against.
+type Options struct {
+	// Config is the loaded configuration, nil if it could not be loaded.
+	Config *config.Config
+	// ConfigErr is why loading or validating the configuration failed.
+	ConfigErr error
+	// DB is the PostgreSQL connection, nil if it could not be opened.
+	DB *sql.DB
+	// Redis is the Redis connection, nil if it could not be opened.
+	Redis repository.HealthChecker
+	// Migrations holds the up migrations the schema is compared with.
+	Migrations fs.FS
+}
+
+// Diagnose connects to the backends cfg points at, runs every check and closes the connections.
+// The memory storage backend needs neither PostgreSQL nor Redis, so their checks are skipped.
+func Diagnose(ctx context.Context, cfg *config.Config, cfgErr error) *Report {
+	opts := Options{Config: cfg, ConfigErr: cfgErr, Migrations: migrations.FS}
+
+	if cfg != nil && cfg.Storage.Backend != config.StorageBackendMemory {
+		db, err := database.New(&cfg.Postgres)
+		if err == nil {
+			defer func() { _ = db.Close() }()
+
+			opts.DB = db.GetDB()
+		}
+
+		cache, err := redis.New(&cfg.Redis)
+		if err == nil {
+			defer func() { _ = cache.Close() }()
+
+			opts.Redis = cache
+		}
+	}
+
+	return Run(ctx, opts)
+}
+
+// Run runs every check against opts.
+func Run(ctx context.Context, opts Options) *Report {
+	report := &Report{}
+
+	if opts.ConfigErr != nil || opts.Config == nil {
+		detail := "configuration was not loaded"
+
+		var validationErr *config.ValidationError
+
+		switch {
+		case errors.As(opts.ConfigErr, &validationErr):
+			detail = strings.Join(validationErr.Problems, "; ")
+		case opts.ConfigErr != nil:
+			detail = opts.ConfigErr.Error()
+		}
+
+		report.Checks = append(report.Checks, Check{Name: checkConfig, Status: StatusFail, Detail: detail})
+		report.Checks = append(report.Checks, markAll(StatusSkip, "configuration is invalid", checkNames...)...)
+
+		return report
+	}
+
+	report.Checks = append(report.Checks, Check{Name: checkConfig, Status: StatusPass, Detail: "configuration is valid"})
+
+	if opts.Config.Storage.Backend == config.StorageBackendMemory {
+		report.Checks = append(report.Checks, markAll(StatusSkip, "storage backend is memory", checkNames...)...)
+
+		return report
+	}
+
+	report.Checks = append(report.Checks, checkPostgresConnection(ctx, opts.DB))
+
+	if report.Checks[len(report.Checks)-1].Status == StatusPass {
+		report.Checks = append(report.Checks, checkSchema(ctx, opts.DB, opts.Migrations)...)
+	} else {
+		report.Checks = append(report.Checks, markAll(StatusSkip, "postgres is unreachable", schemaCheckNames...)...)
+	}
+
+	report.Checks = append(report.Checks, checkRedis(ctx, opts.Redis))
+
+	return report
+}
+
+// Check names, in the order they run.
+const (
+	checkConfig        = "config"
+	checkPostgres      = "postgres"
+	checkSchemaVersion = "schema version"
+	checkTables        = "tables"
+	checkIndexes       = "indexes"
+	checkRedisName     = "redis"
+)
+
+var (
+	schemaCheckNames = []string{checkSchemaVersion, checkTables, checkIndexes}
+	checkNames       = []string{checkPostgres, checkSchemaVersion, checkTables, checkIndexes, checkRedisName}
+)
+
+// markAll gives every named check the same outcome.
+func markAll(status Status, detail string, names ...string) []Check {
+	checks := make([]Check, 0, len(names))
+	for _, name := range names {
+		checks = append(checks, Check{Name: name, Status: status, Detail: detail})
+	}
+
+	return checks
+}
@@ -0,0 +1,266 @@
+package doctor_test
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/doctor"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/migrations"
+)
+
+var testMigrations = fstest.MapFS{
+	"20260101000000_create_widgets.up.sql": {Data: []byte(
+		"CREATE TABLE IF NOT EXISTS recipe_manager.widgets (id UUID);\n" +
+			"CREATE INDEX IF NOT EXISTS idx_widgets_id ON recipe_manager.widgets (id);\n")},
+	"20260102000000_create_gadgets.up.sql": {Data: []byte(
+		"CREATE UNIQUE INDEX idx_gadgets_name\n    ON recipe_manager.gadgets (name);\n")},
+	"20260102000000_create_gadgets.down.sql": {Data: []byte("DROP INDEX recipe_manager.idx_gadgets_name;\n")},
+}
+
+// containsArg matches a comma-separated relation list that includes name.
+type containsArg string
+
+func (a containsArg) Match(v driver.Value) bool {
+	list, ok := v.(string)
+
+	return ok && strings.Contains(","+list+",", ","+string(a)+",")
+}
+
+// containsArgs matches a comma-separated relation list that includes every name.
+type containsArgs []string
+
+func (a containsArgs) Match(v driver.Value) bool {
+	for _, name := range a {
+		if !containsArg(name).Match(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newRedis(t *testing.T) (*redis.Service, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	port, _ := strconv.Atoi(mr.Port())
+
+	cache, err := redis.New(&config.RedisConfig{Host: mr.Host(), Port: port})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cache.Close() })
+
+	return cache, mr
+}
+
+func statuses(report *doctor.Report) map[string]doctor.Status {
+	result := make(map[string]doctor.Status)
+	for _, check := range report.Checks {
+		result[check.Name] = check.Status
+	}
+
+	return result
+}
+
+func TestRun_Healthy(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectPing()
+	mock.ExpectQuery("to_regclass\\('schema_migrations'\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(20260102000000, false))
+	mock.ExpectQuery("unnest").WithArgs(containsArg("recipe_manager.widgets")).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	mock.ExpectQuery("unnest").WithArgs(containsArg("recipe_manager.idx_gadgets_name")).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+	cache, _ := newRedis(t)
+
+	report := doctor.Run(t.Context(), doctor.Options{
+		Config:     &config.Config{},
+		DB:         db,
+		Redis:      cache,
+		Migrations: testMigrations,
+	})
+
+	assert.True(t, report.Passed())
+	assert.Equal(t, map[string]doctor.Status{
+		"config":         doctor.StatusPass,
+		"postgres":       doctor.StatusPass,
+		"schema version": doctor.StatusPass,
+		"tables":         doctor.StatusPass,
+		"indexes":        doctor.StatusPass,
+		"redis":          doctor.StatusPass,
+	}, statuses(report))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	var out bytes.Buffer
+	require.NoError(t, report.Write(&out))
+	assert.Contains(t, out.String(), "6 passed, 0 failed, 0 skipped")
+}
+
+func TestRun_Failures(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectPing()
+	mock.ExpectQuery("to_regclass\\('schema_migrations'\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(20260101000000, false))
+	mock.ExpectQuery("unnest").WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	mock.ExpectQuery("unnest").
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("recipe_manager.idx_gadgets_name"))
+
+	cache, mr := newRedis(t)
+	mr.Close()
+
+	report := doctor.Run(t.Context(), doctor.Options{
+		Config:     &config.Config{},
+		DB:         db,
+		Redis:      cache,
+		Migrations: testMigrations,
+	})
+
+	assert.False(t, report.Passed())
+	assert.Equal(t, map[string]doctor.Status{
+		"config":         doctor.StatusPass,
+		"postgres":       doctor.StatusPass,
+		"schema version": doctor.StatusFail,
+		"tables":         doctor.StatusPass,
+		"indexes":        doctor.StatusFail,
+		"redis":          doctor.StatusFail,
+	}, statuses(report))
+	assert.Equal(t, "database is at 20260101000000, expected 20260102000000", report.Checks[2].Detail)
+	assert.Equal(t, "missing recipe_manager.idx_gadgets_name", report.Checks[4].Detail)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRun_Skips(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		t.Parallel()
+
+		report := doctor.Run(t.Context(), doctor.Options{
+			ConfigErr: &config.ValidationError{Problems: []string{"server.port: must be between 1 and 65535"}},
+		})
+
+		assert.False(t, report.Passed())
+		assert.Equal(t, doctor.StatusFail, report.Checks[0].Status)
+		assert.Equal(t, "server.port: must be between 1 and 65535", report.Checks[0].Detail)
+
+		for _, check := range report.Checks[1:] {
+			assert.Equal(t, doctor.StatusSkip, check.Status, check.Name)
+		}
+	})
+
+	t.Run("memory backend", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{Storage: config.StorageConfig{Backend: config.StorageBackendMemory}}
+		report := doctor.Run(t.Context(), doctor.Options{Config: cfg})
+
+		assert.True(t, report.Passed())
+		assert.Len(t, report.Checks, 6)
+	})
+
+	t.Run("postgres unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+		require.NoError(t, err)
+
+		defer func() { _ = db.Close() }()
+
+		mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+
+		cache, _ := newRedis(t)
+
+		report := doctor.Run(t.Context(), doctor.Options{Config: &config.Config{}, DB: db, Redis: cache})
+
+		assert.Equal(t, map[string]doctor.Status{
+			"config":         doctor.StatusPass,
+			"postgres":       doctor.StatusFail,
+			"schema version": doctor.StatusSkip,
+			"tables":         doctor.StatusSkip,
+			"indexes":        doctor.StatusSkip,
+			"redis":          doctor.StatusPass,
+		}, statuses(report))
+	})
+}
+
+func TestRun_EmbeddedMigrations(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectPing()
+	mock.ExpectQuery("to_regclass\\('schema_migrations'\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("unnest").WithArgs(containsArgs{
+		"recipe_manager.user_follow_limits", "recipe_manager.user_change_log", "recipe_manager.user_handles",
+	}).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	mock.ExpectQuery("unnest").WithArgs(containsArgs{
+		"recipe_manager.users_username_prefix_idx", "recipe_manager.users_username_lower_idx",
+	}).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+	cache, _ := newRedis(t)
+
+	report := doctor.Run(t.Context(), doctor.Options{
+		Config:     &config.Config{},
+		DB:         db,
+		Redis:      cache,
+		Migrations: migrations.FS,
+	})
+
+	assert.True(t, report.Passed())
+	assert.Equal(t, doctor.StatusSkip, statuses(report)["schema version"], "hand-migrated databases are not tracked")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRequiredIndexes(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"20260101000000_create_widgets.up.sql": {Data: []byte(
+			"CREATE TABLE recipe_manager.widgets (id UUID, name TEXT);\n" +
+				"CREATE INDEX idx_widgets_id ON recipe_manager.widgets (id);\n" +
+				"CREATE INDEX idx_widgets_name ON ONLY recipe_manager.widgets (name);\n" +
+				"CREATE INDEX idx_gadgets_id ON recipe_manager.gadgets (id);\n")},
+		"20260102000000_drop_widgets.up.sql": {Data: []byte(
+			"DROP INDEX CONCURRENTLY IF EXISTS recipe_manager.idx_gadgets_id;\n" +
+				"DROP TABLE recipe_manager.widgets;\n" +
+				"CREATE INDEX idx_gadgets_name ON recipe_manager.gadgets (name);\n")},
+	}
+
+	indexes, err := doctor.RequiredIndexes(fsys)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"recipe_manager.idx_gadgets_name"}, indexes)
+}
@@ -1,11 +1,25 @@
 // Package dto contains Data Transfer Objects for API request/response handling.
 package dto
 
-// Error represents an API error response.
+// Error represents an API error response. Validation failures list each offending field in
+// Errors; Details maps the same fields to their messages for older clients.
 type Error struct {
 	Code    string            `json:"error"`
 	Message string            `json:"message"`
 	Details map[string]string `json:"details,omitempty"`
+	Errors  []FieldError      `json:"errors,omitempty"`
+}
+
+// FieldError describes one body, query or path field that failed validation. Code is the rule
+// it broke, e.g. "required", "max" or "uuid". RejectedValue echoes what was sent, shortened,
+// and is left out for sensitive fields.
+type FieldError struct {
+	Field         string `json:"field"`
+	Code          string `json:"code"`
+	Message       string `json:"message"`
+	RejectedValue any    `json:"rejectedValue,omitempty"`
+	// Param is the rule's parameter, kept to localize the message.
+	Param string `json:"-"`
 }
 
 // HealthResponse represents health check response.
@@ -13,6 +27,16 @@ type HealthResponse struct {
 	Status string `json:"status"`
 }
 
+// VersionResponse identifies the running build.
+type VersionResponse struct {
+	Version   string `json:"version"`
+	GitSHA    string `json:"gitSha"`
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+}
+
 // ReadyResponse represents readiness check response.
 type ReadyResponse struct {
 	Status   string            `json:"status"`
@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"log/slog"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/logger"
+)
+
+// Types holding personal data or secrets tag those fields `log:"redact"` and mask them when
+// logged. TestSensitiveFieldsAreRedacted fails for a new field that looks sensitive but is not
+// tagged, or for a tagged type without a LogValue method here.
+
+// LogValue implements slog.LogValuer.
+func (r UserProfileUpdateRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r UserAccountDeleteRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r EmailChangeRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r EmailChangeConfirmRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r AccountRecoveryRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r AccountRecoveryConfirmRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r DeviceTokenRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r UserProfileResponse) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r UserSearchResult) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r UserAccountDeleteRequestResponse) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r DeviceToken) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r EmailChangeRequestResponse) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r EmailChangeStatusResponse) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r PendingEmailChange) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r ProfileShareTokenResponse) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r PreferenceCenterTokenResponse) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r ListUnsubscribeResponse) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r User) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r MentionedUser) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r RecentlyViewedProfile) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r DirectoryAttributes) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r OrganizationCreateRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r Organization) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r CreateUserRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r UpdateUserRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r ChangePasswordRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r UserResponse) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r LoginRequest) LogValue() slog.Value { return logger.Redact(r) }
+
+// LogValue implements slog.LogValuer.
+func (r LoginResponse) LogValue() slog.Value { return logger.Redact(r) }
@@ -0,0 +1,155 @@
+package dto_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log/slog"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// sensitiveField matches the names of string fields holding personal data or secrets.
+var sensitiveField = regexp.MustCompile(`(Email|Token|Password|Secret|FullName)$`)
+
+func TestSensitiveFieldsAreRedacted(t *testing.T) {
+	t.Parallel()
+
+	files, err := parsePackageFiles()
+	require.NoError(t, err)
+
+	loggable := map[string]bool{}
+	redacted := map[string]string{}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Name.Name == "LogValue" && decl.Recv != nil {
+					loggable[receiverName(decl.Recv.List[0].Type)] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					typeSpec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+
+					structType, ok := typeSpec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+
+					for _, field := range structType.Fields.List {
+						tag := fieldTag(field)
+
+						for _, name := range field.Names {
+							switch {
+							case tag.Get("log") == "redact":
+								redacted[typeSpec.Name.Name] = name.Name
+							case isString(field.Type) && sensitiveField.MatchString(name.Name):
+								t.Errorf("%s.%s looks sensitive: tag it `log:\"redact\"`", typeSpec.Name.Name, name.Name)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	require.NotEmpty(t, redacted)
+
+	for typeName, fieldName := range redacted {
+		assert.True(t, loggable[typeName], "%s redacts %s but has no LogValue method", typeName, fieldName)
+	}
+}
+
+func TestLogValueRedactsSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	email, bio := "alice@example.com", "Cooks a lot"
+	log := slog.New(slog.NewJSONHandler(&buf, nil))
+	log.Info("update", "request", dto.UserProfileUpdateRequest{Email: &email, Bio: &bio})
+	log.Info("login", "request", &dto.LoginRequest{Email: email, Password: "hunter22"})
+
+	assert.NotContains(t, buf.String(), email)
+	assert.NotContains(t, buf.String(), "hunter22")
+	assert.Contains(t, buf.String(), `"email":"[REDACTED]"`)
+	assert.Contains(t, buf.String(), `"bio":"Cooks a lot"`)
+	assert.Contains(t, buf.String(), `"fullName":null`)
+}
+
+// parsePackageFiles parses the non-test source files of the dto package.
+func parsePackageFiles() ([]*ast.File, error) {
+	entries, err := os.ReadDir(".")
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+
+	var files []*ast.File
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+func fieldTag(field *ast.Field) reflect.StructTag {
+	if field.Tag == nil {
+		return ""
+	}
+
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return ""
+	}
+
+	return reflect.StructTag(tag)
+}
+
+func isString(expr ast.Expr) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.Name == "string"
+}
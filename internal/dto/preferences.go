@@ -1,6 +1,9 @@
 package dto
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // PreferenceCategory represents valid preference category names.
 type PreferenceCategory string
@@ -41,6 +44,26 @@ func IsValidPreferenceCategory(cat string) bool {
 	return false
 }
 
+// IsValid reports whether c is one of the declared PreferenceCategory values.
+func (c PreferenceCategory) IsValid() bool {
+	return IsValidPreferenceCategory(string(c))
+}
+
+// Values returns the valid PreferenceCategory values, for error messages.
+func (PreferenceCategory) Values() []string {
+	return enumStrings(ValidPreferenceCategories)
+}
+
+// enumStrings converts enum values to plain strings.
+func enumStrings[T ~string](values []T) []string {
+	out := make([]string, len(values))
+	for i, value := range values {
+		out[i] = string(value)
+	}
+
+	return out
+}
+
 // FontSize represents font size preference values.
 type FontSize string
 
@@ -51,6 +74,24 @@ const (
 	FontSizeExtraLarge FontSize = "EXTRA_LARGE"
 )
 
+// ValidFontSizes lists all valid FontSize values.
+var ValidFontSizes = []FontSize{
+	FontSizeSmall,
+	FontSizeMedium,
+	FontSizeLarge,
+	FontSizeExtraLarge,
+}
+
+// IsValid reports whether f is one of the declared FontSize values.
+func (f FontSize) IsValid() bool {
+	return slices.Contains(ValidFontSizes, f)
+}
+
+// Values returns the valid FontSize values, for error messages.
+func (FontSize) Values() []string {
+	return enumStrings(ValidFontSizes)
+}
+
 // ColorScheme represents color scheme preference values.
 type ColorScheme string
 
@@ -61,6 +102,24 @@ const (
 	ColorSchemeHighContrast ColorScheme = "HIGH_CONTRAST"
 )
 
+// ValidColorSchemes lists all valid ColorScheme values.
+var ValidColorSchemes = []ColorScheme{
+	ColorSchemeLight,
+	ColorSchemeDark,
+	ColorSchemeAuto,
+	ColorSchemeHighContrast,
+}
+
+// IsValid reports whether c is one of the declared ColorScheme values.
+func (c ColorScheme) IsValid() bool {
+	return slices.Contains(ValidColorSchemes, c)
+}
+
+// Values returns the valid ColorScheme values, for error messages.
+func (ColorScheme) Values() []string {
+	return enumStrings(ValidColorSchemes)
+}
+
 // LayoutDensity represents layout density preference values.
 type LayoutDensity string
 
@@ -70,6 +129,23 @@ const (
 	LayoutDensitySpacious    LayoutDensity = "SPACIOUS"
 )
 
+// ValidLayoutDensities lists all valid LayoutDensity values.
+var ValidLayoutDensities = []LayoutDensity{
+	LayoutDensityCompact,
+	LayoutDensityComfortable,
+	LayoutDensitySpacious,
+}
+
+// IsValid reports whether l is one of the declared LayoutDensity values.
+func (l LayoutDensity) IsValid() bool {
+	return slices.Contains(ValidLayoutDensities, l)
+}
+
+// Values returns the valid LayoutDensity values, for error messages.
+func (LayoutDensity) Values() []string {
+	return enumStrings(ValidLayoutDensities)
+}
+
 // ProfileVisibility represents profile visibility preference values.
 type ProfileVisibility string
 
@@ -79,6 +155,52 @@ const (
 	ProfileVisibilityPrivate     ProfileVisibility = "PRIVATE"
 )
 
+// ValidProfileVisibilities lists all valid ProfileVisibility values.
+var ValidProfileVisibilities = []ProfileVisibility{
+	ProfileVisibilityPublic,
+	ProfileVisibilityFriendsOnly,
+	ProfileVisibilityPrivate,
+}
+
+// IsValid reports whether p is one of the declared ProfileVisibility values.
+func (p ProfileVisibility) IsValid() bool {
+	return slices.Contains(ValidProfileVisibilities, p)
+}
+
+// Values returns the valid ProfileVisibility values, for error messages.
+func (ProfileVisibility) Values() []string {
+	return enumStrings(ValidProfileVisibilities)
+}
+
+// ActivityVisibility represents activity visibility preference values. It adds a close friends tier,
+// stricter than FRIENDS_ONLY, to the profile visibility levels.
+type ActivityVisibility string
+
+const (
+	ActivityVisibilityPublic       ActivityVisibility = "PUBLIC"
+	ActivityVisibilityFriendsOnly  ActivityVisibility = "FRIENDS_ONLY"
+	ActivityVisibilityCloseFriends ActivityVisibility = "CLOSE_FRIENDS"
+	ActivityVisibilityPrivate      ActivityVisibility = "PRIVATE"
+)
+
+// ValidActivityVisibilities lists all valid ActivityVisibility values, least restrictive first.
+var ValidActivityVisibilities = []ActivityVisibility{
+	ActivityVisibilityPublic,
+	ActivityVisibilityFriendsOnly,
+	ActivityVisibilityCloseFriends,
+	ActivityVisibilityPrivate,
+}
+
+// IsValid reports whether a is one of the declared ActivityVisibility values.
+func (a ActivityVisibility) IsValid() bool {
+	return slices.Contains(ValidActivityVisibilities, a)
+}
+
+// Values returns the valid ActivityVisibility values, for error messages.
+func (ActivityVisibility) Values() []string {
+	return enumStrings(ValidActivityVisibilities)
+}
+
 // Language represents language preference values.
 type Language string
 
@@ -95,6 +217,30 @@ const (
 	LanguageRU Language = "RU"
 )
 
+// ValidLanguages lists all valid Language values.
+var ValidLanguages = []Language{
+	LanguageEN,
+	LanguageES,
+	LanguageFR,
+	LanguageDE,
+	LanguageIT,
+	LanguagePT,
+	LanguageZH,
+	LanguageJA,
+	LanguageKO,
+	LanguageRU,
+}
+
+// IsValid reports whether l is one of the declared Language values.
+func (l Language) IsValid() bool {
+	return slices.Contains(ValidLanguages, l)
+}
+
+// Values returns the valid Language values, for error messages.
+func (Language) Values() []string {
+	return enumStrings(ValidLanguages)
+}
+
 // Theme represents theme preference values.
 type Theme string
 
@@ -105,6 +251,24 @@ const (
 	ThemeCustom Theme = "CUSTOM"
 )
 
+// ValidThemes lists all valid Theme values.
+var ValidThemes = []Theme{
+	ThemeLight,
+	ThemeDark,
+	ThemeAuto,
+	ThemeCustom,
+}
+
+// IsValid reports whether t is one of the declared Theme values.
+func (t Theme) IsValid() bool {
+	return slices.Contains(ValidThemes, t)
+}
+
+// Values returns the valid Theme values, for error messages.
+func (Theme) Values() []string {
+	return enumStrings(ValidThemes)
+}
+
 // VolumeLevel represents volume level preference values.
 type VolumeLevel string
 
@@ -115,6 +279,24 @@ const (
 	VolumeLevelHigh   VolumeLevel = "HIGH"
 )
 
+// ValidVolumeLevels lists all valid VolumeLevel values.
+var ValidVolumeLevels = []VolumeLevel{
+	VolumeLevelMuted,
+	VolumeLevelLow,
+	VolumeLevelMedium,
+	VolumeLevelHigh,
+}
+
+// IsValid reports whether v is one of the declared VolumeLevel values.
+func (v VolumeLevel) IsValid() bool {
+	return slices.Contains(ValidVolumeLevels, v)
+}
+
+// Values returns the valid VolumeLevel values, for error messages.
+func (VolumeLevel) Values() []string {
+	return enumStrings(ValidVolumeLevels)
+}
+
 // NotificationPreferences represents notification preference settings.
 type NotificationPreferences struct {
 	EmailNotifications    bool      `json:"emailNotifications"`
@@ -138,15 +320,70 @@ type DisplayPreferences struct {
 	UpdatedAt     time.Time     `json:"updatedAt"`
 }
 
-// UserPrivacyPreferences represents the full privacy preference settings from the database.
+// UserPrivacyPreferences is the privacy model used by the API, the repositories and the access
+// checks in the services. The legacy PrivacyPreferences shape is derived from it by Legacy.
 type UserPrivacyPreferences struct {
-	ProfileVisibility     ProfileVisibility `json:"profileVisibility"`
-	RecipeVisibility      ProfileVisibility `json:"recipeVisibility"`
-	ActivityVisibility    ProfileVisibility `json:"activityVisibility"`
-	ContactInfoVisibility ProfileVisibility `json:"contactInfoVisibility"`
-	DataSharing           bool              `json:"dataSharing"`
-	AnalyticsTracking     bool              `json:"analyticsTracking"`
-	UpdatedAt             time.Time         `json:"updatedAt"`
+	ProfileVisibility     ProfileVisibility  `json:"profileVisibility"`
+	RecipeVisibility      ProfileVisibility  `json:"recipeVisibility"`
+	ActivityVisibility    ActivityVisibility `json:"activityVisibility"`
+	ContactInfoVisibility ProfileVisibility  `json:"contactInfoVisibility"`
+	ShowFullName          bool               `json:"showFullName"`
+	AllowFollows          bool               `json:"allowFollows"`
+	AllowMessages         bool               `json:"allowMessages"`
+	DataSharing           bool               `json:"dataSharing"`
+	AnalyticsTracking     bool               `json:"analyticsTracking"`
+	// Discoverable users appear in search; others are only found by exact username by the people
+	// they follow.
+	Discoverable bool `json:"discoverable"`
+	// ShowLastSeen shares the user's online status and last seen time with the people who can see
+	// their activity.
+	ShowLastSeen bool `json:"showLastSeen"`
+	// ShowLocation shows the user's country and region on their profile, lets search filter them
+	// by location and counts them in the location breakdown of follower insights.
+	ShowLocation bool `json:"showLocation"`
+	// CountProfileViews counts the views of the user's profile, shown only to the user.
+	CountProfileViews bool `json:"countProfileViews"`
+	// KeepRecentlyViewed keeps the list of profiles the user recently viewed, shown only to them.
+	KeepRecentlyViewed bool      `json:"keepRecentlyViewed"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+}
+
+// ShowEmail reports whether the user's email is visible to other users.
+func (p *UserPrivacyPreferences) ShowEmail() bool {
+	return p.ContactInfoVisibility == ProfileVisibilityPublic
+}
+
+// EffectiveActivityVisibility returns who can see the user's activity: the stricter of the activity
+// and profile visibility, since activity is part of the profile.
+func (p *UserPrivacyPreferences) EffectiveActivityVisibility() ActivityVisibility {
+	profile := ActivityVisibility(p.ProfileVisibility)
+
+	if slices.Index(ValidActivityVisibilities, profile) > slices.Index(ValidActivityVisibilities, p.ActivityVisibility) {
+		return profile
+	}
+
+	return p.ActivityVisibility
+}
+
+// Legacy converts the preferences to the legacy access-control shape.
+func (p *UserPrivacyPreferences) Legacy() *PrivacyPreferences {
+	visibility := "public"
+
+	switch p.ProfileVisibility {
+	case ProfileVisibilityFriendsOnly:
+		visibility = "followers_only"
+	case ProfileVisibilityPrivate:
+		visibility = "private"
+	case ProfileVisibilityPublic:
+	}
+
+	return &PrivacyPreferences{
+		ProfileVisibility: visibility,
+		ShowEmail:         p.ShowEmail(),
+		ShowFullName:      p.ShowFullName,
+		AllowFollows:      p.AllowFollows,
+		AllowMessages:     p.AllowMessages,
+	}
 }
 
 // AccessibilityPreferences represents accessibility preference settings.
@@ -215,23 +452,65 @@ type NotificationPreferencesUpdate struct {
 	SocialInteractions    *bool `json:"socialInteractions,omitempty"`
 }
 
+// PreferenceCenterUpdateRequest changes the emails a user receives through a preference center
+// link. Only the optional mailings can be changed this way; everything else needs signing in.
+type PreferenceCenterUpdateRequest struct {
+	MarketingEmails       *bool `json:"marketingEmails,omitempty"`
+	ActivitySummaries     *bool `json:"activitySummaries,omitempty"`
+	RecipeRecommendations *bool `json:"recipeRecommendations,omitempty"`
+}
+
+// EmailCategory is a kind of optional email recipients can unsubscribe from with a single click.
+type EmailCategory string
+
+const (
+	EmailCategoryMarketing             EmailCategory = "marketing"
+	EmailCategoryActivitySummaries     EmailCategory = "activity_summaries"
+	EmailCategoryRecipeRecommendations EmailCategory = "recipe_recommendations"
+)
+
+// ValidEmailCategories lists all valid EmailCategory values.
+var ValidEmailCategories = []EmailCategory{
+	EmailCategoryMarketing,
+	EmailCategoryActivitySummaries,
+	EmailCategoryRecipeRecommendations,
+}
+
+// IsValid reports whether c is one of the declared EmailCategory values.
+func (c EmailCategory) IsValid() bool {
+	return slices.Contains(ValidEmailCategories, c)
+}
+
+// Values returns the valid EmailCategory values, for error messages.
+func (EmailCategory) Values() []string {
+	return enumStrings(ValidEmailCategories)
+}
+
 // DisplayPreferencesUpdate represents update request for display preferences.
 type DisplayPreferencesUpdate struct {
-	FontSize      *FontSize      `json:"fontSize,omitempty"`
-	ColorScheme   *ColorScheme   `json:"colorScheme,omitempty"`
-	LayoutDensity *LayoutDensity `json:"layoutDensity,omitempty"`
+	FontSize      *FontSize      `json:"fontSize,omitempty"      validate:"omitnil,enum"`
+	ColorScheme   *ColorScheme   `json:"colorScheme,omitempty"   validate:"omitnil,enum"`
+	LayoutDensity *LayoutDensity `json:"layoutDensity,omitempty" validate:"omitnil,enum"`
 	ShowImages    *bool          `json:"showImages,omitempty"`
 	CompactMode   *bool          `json:"compactMode,omitempty"`
 }
 
 // PrivacyPreferencesUpdate represents update request for privacy preferences.
 type PrivacyPreferencesUpdate struct {
-	ProfileVisibility     *ProfileVisibility `json:"profileVisibility,omitempty"`
-	RecipeVisibility      *ProfileVisibility `json:"recipeVisibility,omitempty"`
-	ActivityVisibility    *ProfileVisibility `json:"activityVisibility,omitempty"`
-	ContactInfoVisibility *ProfileVisibility `json:"contactInfoVisibility,omitempty"`
-	DataSharing           *bool              `json:"dataSharing,omitempty"`
-	AnalyticsTracking     *bool              `json:"analyticsTracking,omitempty"`
+	ProfileVisibility     *ProfileVisibility  `json:"profileVisibility,omitempty"     validate:"omitnil,enum"`
+	RecipeVisibility      *ProfileVisibility  `json:"recipeVisibility,omitempty"      validate:"omitnil,enum"`
+	ActivityVisibility    *ActivityVisibility `json:"activityVisibility,omitempty"    validate:"omitnil,enum"`
+	ContactInfoVisibility *ProfileVisibility  `json:"contactInfoVisibility,omitempty" validate:"omitnil,enum"`
+	ShowFullName          *bool               `json:"showFullName,omitempty"`
+	AllowFollows          *bool               `json:"allowFollows,omitempty"`
+	AllowMessages         *bool               `json:"allowMessages,omitempty"`
+	DataSharing           *bool               `json:"dataSharing,omitempty"`
+	AnalyticsTracking     *bool               `json:"analyticsTracking,omitempty"`
+	Discoverable          *bool               `json:"discoverable,omitempty"`
+	ShowLastSeen          *bool               `json:"showLastSeen,omitempty"`
+	ShowLocation          *bool               `json:"showLocation,omitempty"`
+	CountProfileViews     *bool               `json:"countProfileViews,omitempty"`
+	KeepRecentlyViewed    *bool               `json:"keepRecentlyViewed,omitempty"`
 }
 
 // AccessibilityPreferencesUpdate represents update request for accessibility preferences.
@@ -245,8 +524,8 @@ type AccessibilityPreferencesUpdate struct {
 
 // LanguagePreferencesUpdate represents update request for language preferences.
 type LanguagePreferencesUpdate struct {
-	PrimaryLanguage    *Language `json:"primaryLanguage,omitempty"`
-	SecondaryLanguage  *Language `json:"secondaryLanguage,omitempty"`
+	PrimaryLanguage    *Language `json:"primaryLanguage,omitempty"   validate:"omitnil,enum"`
+	SecondaryLanguage  *Language `json:"secondaryLanguage,omitempty" validate:"omitnil,enum"`
 	TranslationEnabled *bool     `json:"translationEnabled,omitempty"`
 }
 
@@ -270,7 +549,7 @@ type SocialPreferencesUpdate struct {
 type SoundPreferencesUpdate struct {
 	NotificationSounds *bool        `json:"notificationSounds,omitempty"`
 	SystemSounds       *bool        `json:"systemSounds,omitempty"`
-	VolumeLevel        *VolumeLevel `json:"volumeLevel,omitempty"`
+	VolumeLevel        *VolumeLevel `json:"volumeLevel,omitempty" validate:"omitnil,enum"`
 	MuteNotifications  *bool        `json:"muteNotifications,omitempty"`
 }
 
@@ -279,7 +558,7 @@ type ThemePreferencesUpdate struct {
 	DarkMode    *bool  `json:"darkMode,omitempty"`
 	LightMode   *bool  `json:"lightMode,omitempty"`
 	AutoTheme   *bool  `json:"autoTheme,omitempty"`
-	CustomTheme *Theme `json:"customTheme,omitempty"`
+	CustomTheme *Theme `json:"customTheme,omitempty" validate:"omitnil,enum"`
 }
 
 // UserPreferencesUpdateRequest represents a request to update multiple preference categories.
@@ -316,3 +595,85 @@ type PreferenceCategoryResponse struct {
 	Preferences any       `json:"preferences"`
 	UpdatedAt   time.Time `json:"updatedAt"`
 }
+
+// PreferenceBackfillResult counts the users a preference backfill went through and the default
+// rows it inserted for them.
+type PreferenceBackfillResult struct {
+	Users int `json:"users"`
+	Rows  int `json:"rows"`
+}
+
+// PreferenceMigrationResult counts the users a preference storage migration went through and the
+// rows or documents it wrote for them.
+type PreferenceMigrationResult struct {
+	Users int `json:"users"`
+	Rows  int `json:"rows"`
+}
+
+// PreferenceBundleSchemaVersion is the layout version of the preference bundles written by exports.
+// Imports reject bundles of any other version.
+const PreferenceBundleSchemaVersion = 1
+
+// PreferenceBundle is a user's exported preferences, signed by the service so the user can import
+// them into another account they own or after recreating their account.
+type PreferenceBundle struct {
+	SchemaVersion int                          `json:"schemaVersion"`
+	UserID        string                       `json:"userId"`
+	ExportedAt    time.Time                    `json:"exportedAt"`
+	Preferences   UserPreferencesUpdateRequest `json:"preferences"`
+	Signature     string                       `json:"signature,omitempty"`
+}
+
+// PreferenceImportRequest imports a preference bundle, limited to some of its categories when
+// categories are given.
+type PreferenceImportRequest struct {
+	Bundle     PreferenceBundle     `json:"bundle"`
+	Categories []PreferenceCategory `json:"categories,omitempty" validate:"omitempty,dive,enum"`
+}
+
+// Privacy defaults versions. Every user is provisioned under one version, and privacy preferences
+// they never saved take that version's defaults.
+const (
+	// PrivacyDefaultsVersionPublicProfile is the original defaults, with a public profile.
+	PrivacyDefaultsVersionPublicProfile = 1
+	// PrivacyDefaultsVersionPrivateProfile makes the profile private by default.
+	PrivacyDefaultsVersionPrivateProfile = 2
+	// LatestPrivacyDefaultsVersion is the most recent privacy defaults version.
+	LatestPrivacyDefaultsVersion = PrivacyDefaultsVersionPrivateProfile
+)
+
+// PrivacyDefaultsCohort counts the users provisioned under a privacy defaults version. Version 0
+// counts users not provisioned yet.
+type PrivacyDefaultsCohort struct {
+	Version int `json:"version"`
+	Users   int `json:"users"`
+}
+
+// PrivacyDefaultsStatusResponse reports the version new users are provisioned under and the size
+// of each version's cohort.
+type PrivacyDefaultsStatusResponse struct {
+	CurrentVersion int                     `json:"currentVersion"`
+	LatestVersion  int                     `json:"latestVersion"`
+	Cohorts        []PrivacyDefaultsCohort `json:"cohorts"`
+}
+
+// PrivacyDefaultsMigrationRequest moves up to Limit users from one privacy defaults version to
+// another.
+type PrivacyDefaultsMigrationRequest struct {
+	FromVersion       int  `json:"fromVersion"                 validate:"required,min=1"`
+	ToVersion         int  `json:"toVersion"                   validate:"required,min=1,nefield=FromVersion"`
+	Limit             int  `json:"limit"                       validate:"required,min=1,max=1000"`
+	SkipNotifications bool `json:"skipNotifications,omitempty"`
+}
+
+// PrivacyDefaultsMigrationResponse reports a privacy defaults migration batch. Changed users were
+// still on the old defaults and now have the new ones; Remaining users are still on the old
+// version.
+type PrivacyDefaultsMigrationResponse struct {
+	FromVersion int `json:"fromVersion"`
+	ToVersion   int `json:"toVersion"`
+	Moved       int `json:"moved"`
+	Changed     int `json:"changed"`
+	Notified    int `json:"notified"`
+	Remaining   int `json:"remaining"`
+}
@@ -0,0 +1,185 @@
+package dto_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+var enumType = reflect.TypeFor[validation.Enum]()
+
+// enumField is a preference update field holding an enum, addressed by its JSON path.
+type enumField struct {
+	path  []string
+	field reflect.Type
+}
+
+// enumFields walks t and returns every pointer field whose element type is an enum.
+func enumFields(t reflect.Type, path []string) []enumField {
+	var fields []enumField
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		elem := field.Type.Elem()
+
+		switch {
+		case elem.Implements(enumType):
+			fields = append(fields, enumField{path: append(slices.Clone(path), name), field: elem})
+		case elem.Kind() == reflect.Struct:
+			fields = append(fields, enumFields(elem, append(slices.Clone(path), name))...)
+		}
+	}
+
+	return fields
+}
+
+// body builds the JSON update request setting the field at path to value.
+func (f enumField) body(value string) []byte {
+	var doc any = value
+	for i := len(f.path) - 1; i >= 0; i-- {
+		doc = map[string]any{f.path[i]: doc}
+	}
+
+	data, _ := json.Marshal(doc)
+
+	return data
+}
+
+func TestPreferenceEnums_EveryUpdateFieldIsValidated(t *testing.T) {
+	t.Parallel()
+
+	fields := enumFields(reflect.TypeFor[dto.UserPreferencesUpdateRequest](), nil)
+	require.Len(t, fields, 11, "new enum fields must be covered by this walk")
+
+	v := validation.New()
+
+	for _, f := range fields {
+		t.Run(strings.Join(f.path, "."), func(t *testing.T) {
+			t.Parallel()
+
+			enum, ok := reflect.Zero(f.field).Interface().(validation.Enum)
+			require.True(t, ok)
+
+			values := enum.Values()
+			require.NotEmpty(t, values)
+
+			for _, value := range values {
+				var req dto.UserPreferencesUpdateRequest
+				require.NoError(t, json.Unmarshal(f.body(value), &req))
+				require.NoError(t, v.Validate(&req), value)
+			}
+
+			for _, value := range []string{"", "BOGUS", strings.ToLower(values[0]), " " + values[0]} {
+				var req dto.UserPreferencesUpdateRequest
+				require.NoError(t, json.Unmarshal(f.body(value), &req))
+
+				var validationErrs validation.ValidationErrors
+				require.ErrorAs(t, v.Validate(&req), &validationErrs, "%q", value)
+				assert.Equal(t, map[string]string{
+					f.path[len(f.path)-1]: "must be one of: " + strings.Join(values, ", "),
+				}, validationErrs.ToMap())
+			}
+		})
+	}
+}
+
+func TestPreferenceEnums_ValuesMatchValidity(t *testing.T) {
+	t.Parallel()
+
+	enums := []validation.Enum{
+		dto.FontSize(""),
+		dto.ColorScheme(""),
+		dto.LayoutDensity(""),
+		dto.ProfileVisibility(""),
+		dto.ActivityVisibility(""),
+		dto.Language(""),
+		dto.Theme(""),
+		dto.VolumeLevel(""),
+	}
+
+	for _, enum := range enums {
+		values := enum.Values()
+		assert.NotEmpty(t, values, "%T", enum)
+		assert.False(t, enum.IsValid(), "%T: the zero value is not a valid choice", enum)
+
+		for _, value := range values {
+			valid, ok := reflect.ValueOf(value).Convert(reflect.TypeOf(enum)).Interface().(validation.Enum)
+			require.True(t, ok)
+			assert.True(t, valid.IsValid(), "%T(%q)", enum, value)
+			assert.Equal(t, strings.ToUpper(value), value, "%T values are upper case", enum)
+		}
+	}
+}
+
+func TestUserPrivacyPreferences_LegacyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		unified dto.ProfileVisibility
+		legacy  string
+	}{
+		{dto.ProfileVisibilityPublic, "public"},
+		{dto.ProfileVisibilityFriendsOnly, "followers_only"},
+		{dto.ProfileVisibilityPrivate, "private"},
+	}
+
+	for _, tt := range tests {
+		for _, showEmail := range []bool{true, false} {
+			contact := dto.ProfileVisibilityPrivate
+			if showEmail {
+				contact = dto.ProfileVisibilityPublic
+			}
+
+			prefs := &dto.UserPrivacyPreferences{
+				ProfileVisibility:     tt.unified,
+				RecipeVisibility:      tt.unified,
+				ActivityVisibility:    dto.ActivityVisibility(tt.unified),
+				ContactInfoVisibility: contact,
+				ShowFullName:          true,
+				AllowFollows:          !showEmail,
+				AllowMessages:         showEmail,
+				Discoverable:          true,
+				ShowLastSeen:          true,
+				CountProfileViews:     true,
+				KeepRecentlyViewed:    true,
+			}
+
+			legacy := prefs.Legacy() //nolint:staticcheck // the adapter under test
+			assert.Equal(t, tt.legacy, legacy.ProfileVisibility)
+			assert.Equal(t, showEmail, legacy.ShowEmail)
+			assert.Equal(t, prefs.AllowFollows, legacy.AllowFollows)
+			assert.Equal(t, prefs, legacy.Unified())
+		}
+	}
+}
+
+func TestUserPrivacyPreferences_EffectiveActivityVisibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		profile  dto.ProfileVisibility
+		activity dto.ActivityVisibility
+		want     dto.ActivityVisibility
+	}{
+		{dto.ProfileVisibilityPublic, dto.ActivityVisibilityPublic, dto.ActivityVisibilityPublic},
+		{dto.ProfileVisibilityPublic, dto.ActivityVisibilityCloseFriends, dto.ActivityVisibilityCloseFriends},
+		{dto.ProfileVisibilityFriendsOnly, dto.ActivityVisibilityPublic, dto.ActivityVisibilityFriendsOnly},
+		{dto.ProfileVisibilityFriendsOnly, dto.ActivityVisibilityCloseFriends, dto.ActivityVisibilityCloseFriends},
+		{dto.ProfileVisibilityPrivate, dto.ActivityVisibilityCloseFriends, dto.ActivityVisibilityPrivate},
+		{dto.ProfileVisibilityFriendsOnly, "", dto.ActivityVisibilityFriendsOnly},
+	}
+
+	for _, tt := range tests {
+		prefs := &dto.UserPrivacyPreferences{ProfileVisibility: tt.profile, ActivityVisibility: tt.activity}
+		assert.Equal(t, tt.want, prefs.EffectiveActivityVisibility(), "profile %s, activity %s", tt.profile, tt.activity)
+	}
+}
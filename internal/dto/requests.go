@@ -4,18 +4,173 @@ package dto
 // User Management Requests
 // ============================================================================
 
-// UserProfileUpdateRequest represents a request to update user profile.
+// UserProfileUpdateRequest represents a request to update user profile. The lengths of the
+// username, full name and bio are checked against the configured profile limits.
 type UserProfileUpdateRequest struct {
-	Username *string `json:"username,omitempty" validate:"omitempty,min=3,max=50,username_pattern"`
-	Email    *string `json:"email,omitempty"    validate:"omitempty,email"`
-	FullName *string `json:"fullName,omitempty" validate:"omitempty,max=255"`
-	Bio      *string `json:"bio,omitempty"      validate:"omitempty,max=1000"`
+	Username *string `json:"username,omitempty" validate:"omitempty,profile_length=username,username_pattern,unreserved"`
+	Email    *string `json:"email,omitempty"    validate:"omitempty,email"                    log:"redact"`
+	FullName *string `json:"fullName,omitempty" validate:"omitempty,profile_length=full_name" log:"redact"`
+	Bio      *string `json:"bio,omitempty"      validate:"omitempty,profile_length=bio"`
+	// Country is an ISO 3166-1 alpha-2 code and Region an ISO 3166-2 subdivision code of the
+	// country. An empty string clears the field.
+	Country  *string `json:"country,omitempty"  validate:"omitempty,iso3166_1_alpha2"`
+	Region   *string `json:"region,omitempty"   validate:"omitempty,iso3166_2"`
 	IsActive *bool   `json:"-"` // Internal use only, not exposed in API
 }
 
+// LocationFilter narrows a user search to a country or an ISO 3166-2 region. Only users who show
+// their location match a filter; the zero value matches everyone.
+type LocationFilter struct {
+	Country string
+	Region  string
+}
+
+// IsZero reports whether the filter matches everyone.
+func (f LocationFilter) IsZero() bool {
+	return f.Country == "" && f.Region == ""
+}
+
 // UserAccountDeleteRequest represents a request to confirm account deletion.
 type UserAccountDeleteRequest struct {
-	ConfirmationToken string `json:"confirmationToken" validate:"required,min=1"`
+	ConfirmationToken string `json:"confirmationToken" validate:"required,min=1" log:"redact"`
+}
+
+// EmailChangeRequest represents a request to start an email address change.
+type EmailChangeRequest struct {
+	NewEmail string `json:"newEmail" validate:"required,email,max=255" log:"redact"`
+}
+
+// EmailChangeConfirmRequest represents a confirmation for one side of an email change.
+type EmailChangeConfirmRequest struct {
+	ConfirmationToken string `json:"confirmationToken" validate:"required,min=1" log:"redact"`
+}
+
+// AccountRecoveryRequest starts the recovery of a deactivated account by its email address.
+type AccountRecoveryRequest struct {
+	Email string `json:"email" validate:"required,email,max=255" log:"redact"`
+}
+
+// AccountRecoveryConfirmRequest redeems an account recovery token.
+type AccountRecoveryConfirmRequest struct {
+	RecoveryToken string `json:"recoveryToken" validate:"required,min=1" log:"redact"`
+}
+
+// ConsentRequest records a grant or withdrawal of consent for one purpose.
+type ConsentRequest struct {
+	Purpose       ConsentPurpose `json:"purpose"       validate:"required,enum"`
+	Granted       *bool          `json:"granted"       validate:"required"`
+	PolicyVersion string         `json:"policyVersion" validate:"required,max=32"`
+}
+
+// PolicyAcceptanceRequest accepts the current version of a policy document.
+type PolicyAcceptanceRequest struct {
+	Document PolicyDocument `json:"document" validate:"required,enum"`
+	Version  string         `json:"version"  validate:"required,max=32"`
+}
+
+// BirthdateRequest sets the user's birthdate.
+type BirthdateRequest struct {
+	Birthdate string `json:"birthdate" validate:"required,datetime=2006-01-02"`
+}
+
+// AgeOverrideRequest sets an admin decision on whether minor restrictions apply.
+type AgeOverrideRequest struct {
+	Override AgeOverride `json:"override" validate:"required,enum"`
+}
+
+// UserRoleRequest assigns a role to a user account.
+type UserRoleRequest struct {
+	Role UserRole `json:"role" validate:"required,enum"`
+}
+
+// OrganizationCreateRequest creates an organization and the account holding its profile.
+type OrganizationCreateRequest struct {
+	Username string  `json:"username"           validate:"required,profile_length=username,username_pattern,unreserved"`
+	Email    string  `json:"email"              validate:"required,email"                                   log:"redact"`
+	FullName *string `json:"fullName,omitempty" validate:"omitempty,profile_length=full_name"                log:"redact"`
+}
+
+// OrganizationMemberRequest adds a member to an organization or changes their role.
+type OrganizationMemberRequest struct {
+	Role OrganizationRole `json:"role" validate:"required,enum"`
+}
+
+// FollowLimitRequest overrides how many users an account may follow. Zero lifts the limit.
+type FollowLimitRequest struct {
+	MaxFollowing *int `json:"maxFollowing" validate:"required,gte=0"`
+}
+
+// MaintenanceRequest switches maintenance mode on or off.
+type MaintenanceRequest struct {
+	Enabled *bool `json:"enabled" validate:"required"`
+	// Message is shown to clients whose writes are rejected; empty uses the configured message.
+	Message string `json:"message" validate:"max=500"`
+}
+
+// HandleRequest represents a request to reserve or claim a profile handle.
+type HandleRequest struct {
+	Handle string `json:"handle" validate:"required,min=3,max=30,handle_pattern"`
+}
+
+// ChangeRequestSubmission asks for a username or email change on a moderated account.
+type ChangeRequestSubmission struct {
+	Field ChangeRequestField `json:"field" validate:"required,enum"`
+	Value string             `json:"value" validate:"required,max=255"`
+}
+
+// DeviceTokenRequest registers a device for push notifications, or refreshes its registration.
+type DeviceTokenRequest struct {
+	Platform   DevicePlatform `json:"platform"             validate:"required,enum"`
+	Token      string         `json:"token"                validate:"required,max=4096" log:"redact"`
+	AppVersion string         `json:"appVersion,omitempty" validate:"max=50"`
+}
+
+// BadgeEventRequest reports something a user did that may earn them a badge.
+type BadgeEventRequest struct {
+	Type BadgeEvent `json:"type" validate:"required,enum"`
+}
+
+// MentionResolveRequest lists the @usernames mentioned in some content, with or without the @.
+type MentionResolveRequest struct {
+	Usernames []string `json:"usernames" validate:"required"`
+}
+
+// ============================================================================
+// Social Feature Requests
+// ============================================================================
+
+// FollowSettingsUpdateRequest changes the notification settings of a follow; omitted fields are kept.
+type FollowSettingsUpdateRequest struct {
+	Muted            *bool `json:"muted,omitempty"`
+	NotifyNewRecipes *bool `json:"notifyNewRecipes,omitempty"`
+	NotifyReviews    *bool `json:"notifyReviews,omitempty"`
+}
+
+// ============================================================================
+// Admin Requests
+// ============================================================================
+
+// AdminNoteRequest adds a note to a user account.
+type AdminNoteRequest struct {
+	Text   string `json:"text"   validate:"required,max=5000"`
+	Pinned bool   `json:"pinned"`
+}
+
+// AdminNoteUpdateRequest changes the text or pinned flag of a note.
+type AdminNoteUpdateRequest struct {
+	Text   *string `json:"text,omitempty"   validate:"omitnil,min=1,max=5000"`
+	Pinned *bool   `json:"pinned,omitempty"`
+}
+
+// DirectoryLinkRequest maps a user account to its LDAP directory entry by the value of the
+// configured lookup attribute, e.g. the uid or sAMAccountName.
+type DirectoryLinkRequest struct {
+	ExternalID string `json:"externalId" validate:"required,max=255"`
+}
+
+// ChangeRequestRejection explains why a change request was rejected.
+type ChangeRequestRejection struct {
+	Reason string `json:"reason" validate:"max=500"`
 }
 
 // ============================================================================
@@ -1,6 +1,9 @@
 package dto
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // ============================================================================
 // User Management Responses
@@ -10,24 +13,59 @@ import "time"
 type UserProfileResponse struct {
 	UserID    string    `json:"userId"`
 	Username  string    `json:"username"`
-	Email     *string   `json:"email,omitempty"`
-	FullName  *string   `json:"fullName,omitempty"`
+	Email     *string   `json:"email,omitempty"    log:"redact"`
+	FullName  *string   `json:"fullName,omitempty" log:"redact"`
 	Bio       *string   `json:"bio,omitempty"`
+	Country   *string   `json:"country,omitempty"`
+	Region    *string   `json:"region,omitempty"`
 	IsActive  bool      `json:"isActive"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// Badges lists the badges the user earned, earliest first.
+	Badges []Badge `json:"badges,omitempty"`
+}
+
+// ProfileConstraintsResponse describes what PUT /users/profile accepts in the free-text profile
+// fields, so clients can check input with the limits the service is configured with.
+type ProfileConstraintsResponse struct {
+	Username FieldConstraints `json:"username"`
+	FullName FieldConstraints `json:"fullName"`
+	Bio      FieldConstraints `json:"bio"`
+}
+
+// FieldConstraints are the rules a string field must satisfy, named as in JSON Schema. Lengths
+// are in characters; a missing bound or pattern does not apply.
+type FieldConstraints struct {
+	MinLength int    `json:"minLength,omitempty"`
+	MaxLength int    `json:"maxLength"`
+	Pattern   string `json:"pattern,omitempty"`
 }
 
 // UserSearchResult represents a user in search results.
 type UserSearchResult struct {
 	UserID    string    `json:"userId"`
 	Username  string    `json:"username"`
-	FullName  *string   `json:"fullName,omitempty"`
+	FullName  *string   `json:"fullName,omitempty" log:"redact"`
 	IsActive  bool      `json:"isActive"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// Connection ranks personalized search results; it is not exposed so results do not reveal
+	// who the requester's connections follow.
+	Connection SearchConnection `json:"-"`
 }
 
+// SearchConnection is how close a search result is to the requester in the follow graph. Higher
+// values rank first.
+type SearchConnection int
+
+const (
+	SearchConnectionNone SearchConnection = iota
+	// SearchConnectionSecondDegree is a user followed by someone the requester follows.
+	SearchConnectionSecondDegree
+	// SearchConnectionFollowing is a user the requester follows.
+	SearchConnectionFollowing
+)
+
 // UserSearchResponse represents search results.
 type UserSearchResponse struct {
 	Results    []UserSearchResult `json:"results"`
@@ -36,10 +74,172 @@ type UserSearchResponse struct {
 	Offset     int                `json:"offset"`
 }
 
+// UserTypeaheadResult is a lightweight search result for username autocompletion.
+type UserTypeaheadResult struct {
+	UserID   string `json:"userId"`
+	Username string `json:"username"`
+}
+
+// UserTypeaheadResponse lists the users whose username starts with a prefix.
+type UserTypeaheadResponse struct {
+	Results []UserTypeaheadResult `json:"results"`
+}
+
+// MaintenanceStatus reports whether mutating endpoints are rejected for maintenance.
+type MaintenanceStatus struct {
+	Enabled bool   `json:"enabled"`
+	Message string `json:"message,omitempty"`
+	// Forced is set while maintenance is enabled in configuration, which the admin endpoint cannot
+	// turn off.
+	Forced    bool       `json:"forced"`
+	UpdatedBy string     `json:"updatedBy,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// DrainStatus reports whether the instance is draining before shutdown. While it drains,
+// readiness fails so no new traffic is routed to it, but requests are still served.
+type DrainStatus struct {
+	Draining bool `json:"draining"`
+	// Trigger is what started the drain: an admin or a shutdown signal.
+	Trigger    string     `json:"trigger,omitempty"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	ShutdownAt *time.Time `json:"shutdownAt,omitempty"`
+}
+
+// ScheduledJob is a background job, when it runs next and how its latest run went.
+type ScheduledJob struct {
+	Name string `json:"name"`
+	// Schedule is the cron expression or descriptor the job runs on, empty for jobs that only
+	// run when an admin starts them.
+	Schedule  string     `json:"schedule"`
+	Running   bool       `json:"running"`
+	NextRunAt *time.Time `json:"nextRunAt,omitempty"`
+	LastRun   *JobRun    `json:"lastRun,omitempty"`
+}
+
+// Job run outcomes. A run is skipped when the job finds nothing it can do, e.g. because the
+// same work is already in progress.
+const (
+	JobOutcomeSuccess = "success"
+	JobOutcomeFailure = "failure"
+	JobOutcomeSkipped = "skipped"
+)
+
+// JobRun is one run of a scheduled job. Outcome and FinishedAt are unset while it runs.
+type JobRun struct {
+	// Trigger is what started the run: its schedule, startup or an admin.
+	Trigger    string     `json:"trigger"`
+	Outcome    string     `json:"outcome,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// ScheduledJobsResponse lists the scheduled jobs by name.
+type ScheduledJobsResponse struct {
+	Jobs []ScheduledJob `json:"jobs"`
+}
+
+// TypeaheadSource selects where typeahead results are read from.
+type TypeaheadSource string
+
+const (
+	// TypeaheadSourcePostgres reads from the username prefix index in PostgreSQL.
+	TypeaheadSourcePostgres TypeaheadSource = "postgres"
+	// TypeaheadSourceVerify serves PostgreSQL results and compares them with the search index.
+	TypeaheadSourceVerify TypeaheadSource = "verify"
+	// TypeaheadSourceIndex reads from the search index.
+	TypeaheadSourceIndex TypeaheadSource = "index"
+)
+
+// SearchReindexState is the state of a search index rebuild.
+type SearchReindexState string
+
+const (
+	SearchReindexRunning   SearchReindexState = "RUNNING"
+	SearchReindexCompleted SearchReindexState = "COMPLETED"
+	SearchReindexFailed    SearchReindexState = "FAILED"
+)
+
+// SearchReindexJob reports the progress of a search index rebuild.
+type SearchReindexJob struct {
+	JobID string             `json:"jobId"`
+	State SearchReindexState `json:"state"`
+	// Trigger is "admin" or "schedule".
+	Trigger    string     `json:"trigger"`
+	Total      int        `json:"total"`
+	Indexed    int        `json:"indexed"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// TypeaheadVerification counts typeahead lookups compared against the search index.
+type TypeaheadVerification struct {
+	Reads      int64 `json:"reads"`
+	Mismatches int64 `json:"mismatches"`
+}
+
+// SearchIndexStatusResponse describes the search index and its last rebuild.
+type SearchIndexStatusResponse struct {
+	Source  TypeaheadSource   `json:"source"`
+	LastJob *SearchReindexJob `json:"lastJob,omitempty"`
+	// Verification is only reported while the source is TypeaheadSourceVerify.
+	Verification *TypeaheadVerification `json:"verification,omitempty"`
+}
+
+// UserExpansion names a related resource that GET /users/{user_id} can embed via ?expand=.
+type UserExpansion string
+
+const (
+	UserExpansionPrivacy        UserExpansion = "privacy"
+	UserExpansionStats          UserExpansion = "stats"
+	UserExpansionRecentActivity UserExpansion = "recentActivity"
+)
+
+// ValidUserExpansions lists all supported user expansions.
+var ValidUserExpansions = []UserExpansion{
+	UserExpansionPrivacy,
+	UserExpansionStats,
+	UserExpansionRecentActivity,
+}
+
+// Values returns the valid UserExpansion values, for error messages.
+func (UserExpansion) Values() []string {
+	return enumStrings(ValidUserExpansions)
+}
+
+// IsValidUserExpansion checks if an expansion string is valid.
+func IsValidUserExpansion(expansion string) bool {
+	for _, valid := range ValidUserExpansions {
+		if string(valid) == expansion {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UserSocialStats represents a user's follower and following counts.
+type UserSocialStats struct {
+	FollowerCount  int `json:"followerCount"`
+	FollowingCount int `json:"followingCount"`
+}
+
+// UserDetailsResponse represents a public user with the requested expansions. An expansion is
+// omitted when it was not requested or the requester may not see it.
+type UserDetailsResponse struct {
+	UserSearchResult
+
+	Privacy        *UserPrivacyPreferences `json:"privacy,omitempty"`
+	Stats          *UserSocialStats        `json:"stats,omitempty"`
+	RecentActivity *UserActivityResponse   `json:"recentActivity,omitempty"`
+}
+
 // UserAccountDeleteRequestResponse represents the response for account deletion request.
 type UserAccountDeleteRequestResponse struct {
 	UserID            string    `json:"userId"`
-	ConfirmationToken string    `json:"confirmationToken"`
+	ConfirmationToken string    `json:"confirmationToken" log:"redact"`
 	ExpiresAt         time.Time `json:"expiresAt"`
 }
 
@@ -49,6 +249,371 @@ type UserConfirmAccountDeleteResponse struct {
 	DeactivatedAt time.Time `json:"deactivatedAt"`
 }
 
+// AccountRecoveryResponse represents the response for a reactivated account.
+type AccountRecoveryResponse struct {
+	UserID        string    `json:"userId"`
+	Username      string    `json:"username"`
+	ReactivatedAt time.Time `json:"reactivatedAt"`
+}
+
+// DataCategory names a kind of user data covered by the privacy report and the data access log.
+type DataCategory string
+
+// Data categories.
+const (
+	DataCategoryProfile     DataCategory = "profile"
+	DataCategorySocialGraph DataCategory = "social_graph"
+	DataCategoryActivity    DataCategory = "activity"
+	DataCategoryPreferences DataCategory = "preferences"
+)
+
+// DataConsumer summarizes the reads of one data category by one service.
+type DataConsumer struct {
+	ClientID       string       `json:"clientId"`
+	Category       DataCategory `json:"category"`
+	AccessCount    int          `json:"accessCount"`
+	LastAccessedAt time.Time    `json:"lastAccessedAt"`
+}
+
+// PrivacyReportResponse tells a user what data is kept about them, who can see it and which
+// services have read it.
+type PrivacyReportResponse struct {
+	UserID          string                  `json:"userId"`
+	GeneratedAt     time.Time               `json:"generatedAt"`
+	Categories      []PrivacyReportCategory `json:"categories"`
+	PrivacySettings *UserPrivacyPreferences `json:"privacySettings"`
+}
+
+// PrivacyReportCategory describes one category of the privacy report in plain language.
+type PrivacyReportCategory struct {
+	Category    DataCategory `json:"category"`
+	Description string       `json:"description"`
+	// Items lists what is stored, e.g. "Email address". Empty when nothing is stored.
+	Items []string `json:"items"`
+	// Visibility is who can see the data; empty when it is never shown to other users. The activity
+	// category can also be CLOSE_FRIENDS.
+	Visibility    ProfileVisibility `json:"visibility,omitempty"`
+	LastUpdatedAt *time.Time        `json:"lastUpdatedAt,omitempty"`
+	Retention     string            `json:"retention"`
+	ConsumedBy    []DataConsumer    `json:"consumedBy"`
+}
+
+// ConsentPurpose is a use of personal data that needs the user's recorded consent.
+type ConsentPurpose string
+
+const (
+	ConsentPurposeMarketingEmails   ConsentPurpose = "marketing_emails"
+	ConsentPurposeAnalyticsTracking ConsentPurpose = "analytics_tracking"
+	ConsentPurposeDataSharing       ConsentPurpose = "data_sharing"
+)
+
+// ValidConsentPurposes lists all valid ConsentPurpose values.
+var ValidConsentPurposes = []ConsentPurpose{
+	ConsentPurposeMarketingEmails,
+	ConsentPurposeAnalyticsTracking,
+	ConsentPurposeDataSharing,
+}
+
+// IsValid reports whether p is one of the declared ConsentPurpose values.
+func (p ConsentPurpose) IsValid() bool {
+	return slices.Contains(ValidConsentPurposes, p)
+}
+
+// Values returns the valid ConsentPurpose values, for error messages.
+func (ConsentPurpose) Values() []string {
+	return enumStrings(ValidConsentPurposes)
+}
+
+// ConsentSource records where a consent decision was made.
+type ConsentSource string
+
+const (
+	// ConsentSourceUI is a decision made by the user.
+	ConsentSourceUI ConsentSource = "ui"
+	// ConsentSourceImport is a decision imported by a service account, e.g. from a signup form.
+	ConsentSourceImport ConsentSource = "import"
+	// ConsentSourceAdmin is a decision recorded by an administrator on the user's behalf.
+	ConsentSourceAdmin ConsentSource = "admin"
+	// ConsentSourcePolicy is a withdrawal applied automatically, e.g. when a minor's account is restricted.
+	ConsentSourcePolicy ConsentSource = "policy"
+)
+
+// ConsentRecord is one entry of the consent ledger: a grant or withdrawal of consent for a
+// purpose under a version of the privacy policy.
+type ConsentRecord struct {
+	Purpose       ConsentPurpose `json:"purpose"`
+	Granted       bool           `json:"granted"`
+	Source        ConsentSource  `json:"source"`
+	PolicyVersion string         `json:"policyVersion"`
+	RecordedAt    time.Time      `json:"recordedAt"`
+}
+
+// ConsentsResponse lists the latest consent decision of a user for each purpose. Purposes
+// without a decision are absent and count as not granted.
+type ConsentsResponse struct {
+	UserID   string          `json:"userId"`
+	Consents []ConsentRecord `json:"consents"`
+}
+
+// ConsentHistoryResponse lists every consent decision of a user, newest first.
+type ConsentHistoryResponse struct {
+	UserID  string          `json:"userId"`
+	Records []ConsentRecord `json:"records"`
+}
+
+// PolicyDocument is a legal document users accept, such as the terms of service.
+type PolicyDocument string
+
+const (
+	PolicyDocumentTerms   PolicyDocument = "terms_of_service"
+	PolicyDocumentPrivacy PolicyDocument = "privacy_policy"
+)
+
+// ValidPolicyDocuments lists all valid PolicyDocument values.
+var ValidPolicyDocuments = []PolicyDocument{
+	PolicyDocumentTerms,
+	PolicyDocumentPrivacy,
+}
+
+// IsValid reports whether d is one of the declared PolicyDocument values.
+func (d PolicyDocument) IsValid() bool {
+	return slices.Contains(ValidPolicyDocuments, d)
+}
+
+// Values returns the valid PolicyDocument values, for error messages.
+func (PolicyDocument) Values() []string {
+	return enumStrings(ValidPolicyDocuments)
+}
+
+// PolicyAcceptance records that a user accepted a version of a policy document.
+type PolicyAcceptance struct {
+	Document   PolicyDocument `json:"document"`
+	Version    string         `json:"version"`
+	AcceptedAt time.Time      `json:"acceptedAt"`
+}
+
+// PolicyStatus compares the versions of a policy document a user accepted with the current one.
+type PolicyStatus struct {
+	Document       PolicyDocument `json:"document"`
+	CurrentVersion string         `json:"currentVersion"`
+	// AcceptedVersion is the most recently accepted version; empty if the user never accepted one.
+	AcceptedVersion    string     `json:"acceptedVersion,omitempty"`
+	AcceptedAt         *time.Time `json:"acceptedAt,omitempty"`
+	AcceptanceRequired bool       `json:"acceptanceRequired"`
+}
+
+// PolicyStatusResponse lists the acceptance status of every published policy document.
+type PolicyStatusResponse struct {
+	UserID   string         `json:"userId"`
+	Policies []PolicyStatus `json:"policies"`
+}
+
+// AdultAge is the age from which minor restrictions no longer apply.
+const AdultAge = 18
+
+// AgeOverride is an admin decision on whether minor restrictions apply, regardless of birthdate.
+type AgeOverride string
+
+const (
+	AgeOverrideAdult AgeOverride = "adult"
+	AgeOverrideMinor AgeOverride = "minor"
+)
+
+// ValidAgeOverrides lists all valid AgeOverride values.
+var ValidAgeOverrides = []AgeOverride{
+	AgeOverrideAdult,
+	AgeOverrideMinor,
+}
+
+// IsValid reports whether o is one of the declared AgeOverride values.
+func (o AgeOverride) IsValid() bool {
+	return slices.Contains(ValidAgeOverrides, o)
+}
+
+// Values returns the valid AgeOverride values, for error messages.
+func (AgeOverride) Values() []string {
+	return enumStrings(ValidAgeOverrides)
+}
+
+// AgeRestriction is a restriction applied to the accounts of minors.
+type AgeRestriction string
+
+const (
+	// AgeRestrictionPrivateProfile keeps the profile visibility private.
+	AgeRestrictionPrivateProfile AgeRestriction = "private_profile"
+	// AgeRestrictionHiddenFromSearch leaves the user out of user search.
+	AgeRestrictionHiddenFromSearch AgeRestriction = "hidden_from_search"
+	// AgeRestrictionNoMarketing blocks consent to marketing emails.
+	AgeRestrictionNoMarketing AgeRestriction = "no_marketing"
+)
+
+// MinorRestrictions lists the restrictions applied to every minor.
+var MinorRestrictions = []AgeRestriction{
+	AgeRestrictionPrivateProfile,
+	AgeRestrictionHiddenFromSearch,
+	AgeRestrictionNoMarketing,
+}
+
+// AgeVerification holds a user's birthdate and any admin override. It is never part of a profile.
+type AgeVerification struct {
+	// Birthdate is nil until the user provides it.
+	Birthdate *time.Time
+	// Override is empty unless an admin decided whether the user is a minor.
+	Override AgeOverride
+}
+
+// IsMinor reports whether minor restrictions apply at now. An admin override wins over the
+// birthdate; users without a birthdate are not restricted.
+func (a *AgeVerification) IsMinor(now time.Time) bool {
+	switch {
+	case a == nil:
+		return false
+	case a.Override != "":
+		return a.Override == AgeOverrideMinor
+	case a.Birthdate == nil:
+		return false
+	default:
+		return a.Birthdate.AddDate(AdultAge, 0, 0).After(now)
+	}
+}
+
+// AgeStatusResponse describes a user's birthdate and the minor restrictions that apply to them.
+type AgeStatusResponse struct {
+	UserID string `json:"userId"`
+	// Birthdate is formatted as YYYY-MM-DD; empty if not provided.
+	Birthdate    string           `json:"birthdate,omitempty"`
+	Override     AgeOverride      `json:"override,omitempty"`
+	IsMinor      bool             `json:"isMinor"`
+	Restrictions []AgeRestriction `json:"restrictions"`
+}
+
+// DevicePlatform is the push service a device token is issued by.
+type DevicePlatform string
+
+const (
+	DevicePlatformIOS     DevicePlatform = "ios"
+	DevicePlatformAndroid DevicePlatform = "android"
+	DevicePlatformWeb     DevicePlatform = "web"
+)
+
+// ValidDevicePlatforms lists all valid DevicePlatform values.
+var ValidDevicePlatforms = []DevicePlatform{DevicePlatformIOS, DevicePlatformAndroid, DevicePlatformWeb}
+
+// IsValid reports whether p is one of the declared DevicePlatform values.
+func (p DevicePlatform) IsValid() bool {
+	return slices.Contains(ValidDevicePlatforms, p)
+}
+
+// Values returns the valid DevicePlatform values, for error messages.
+func (DevicePlatform) Values() []string {
+	return enumStrings(ValidDevicePlatforms)
+}
+
+// DeviceToken is a device registered to receive push notifications. LastSeenAt is refreshed
+// whenever the app registers the token again; tokens not seen for a while are removed.
+type DeviceToken struct {
+	DeviceID     int64          `json:"deviceId"`
+	Platform     DevicePlatform `json:"platform"`
+	Token        string         `json:"token" log:"redact"`
+	AppVersion   string         `json:"appVersion,omitempty"`
+	RegisteredAt time.Time      `json:"registeredAt"`
+	LastSeenAt   time.Time      `json:"lastSeenAt"`
+}
+
+// DeviceTokensResponse lists a user's registered devices, most recently seen first.
+type DeviceTokensResponse struct {
+	Devices []DeviceToken `json:"devices"`
+}
+
+// PushTargetsResponse lists the devices a push notification for a user should be sent to.
+// Devices is empty when the user has turned push notifications off.
+type PushTargetsResponse struct {
+	UserID      string        `json:"userId"`
+	PushEnabled bool          `json:"pushEnabled"`
+	Devices     []DeviceToken `json:"devices"`
+}
+
+// EmailChangeRequestResponse represents the response for a started email change.
+// Confirmation tokens are delivered to the old and new addresses, never returned here.
+type EmailChangeRequestResponse struct {
+	UserID    string    `json:"userId"`
+	NewEmail  string    `json:"newEmail" log:"redact"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// EmailChangeStatusResponse represents the state of a pending email change after a confirmation.
+type EmailChangeStatusResponse struct {
+	UserID            string    `json:"userId"`
+	OldEmailConfirmed bool      `json:"oldEmailConfirmed"`
+	NewEmailConfirmed bool      `json:"newEmailConfirmed"`
+	Completed         bool      `json:"completed"`
+	Email             *string   `json:"email,omitempty" log:"redact"`
+	ExpiresAt         time.Time `json:"expiresAt"`
+}
+
+// PendingEmailChange is the server-side state of an in-flight email change.
+// It is stored in the cache with a TTL and is never exposed directly in API responses.
+type PendingEmailChange struct {
+	OldEmail     string    `json:"oldEmail" log:"redact"`
+	NewEmail     string    `json:"newEmail" log:"redact"`
+	OldToken     string    `json:"oldToken" log:"redact"`
+	NewToken     string    `json:"newToken" log:"redact"`
+	OldConfirmed bool      `json:"oldConfirmed"`
+	NewConfirmed bool      `json:"newConfirmed"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+// HandleReservationResponse represents a temporary hold on a handle during selection.
+type HandleReservationResponse struct {
+	Handle    string    `json:"handle"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// HandleResponse represents a handle claimed by a user.
+type HandleResponse struct {
+	UserID    string    `json:"userId"`
+	Handle    string    `json:"handle"`
+	ClaimedAt time.Time `json:"claimedAt"`
+}
+
+// HandleResolutionResponse represents the public summary of a handle's owner.
+type HandleResolutionResponse struct {
+	Handle string           `json:"handle"`
+	User   UserSearchResult `json:"user"`
+}
+
+// ProfileShareTokenResponse represents a signed token granting anonymous access to a profile.
+type ProfileShareTokenResponse struct {
+	Token     string    `json:"token" log:"redact"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// PreferenceCenterTokenResponse is a signed token for emails, letting the recipient manage the
+// emails they receive without signing in.
+type PreferenceCenterTokenResponse struct {
+	Token     string    `json:"token" log:"redact"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// PreferenceCenterResponse is what a preference center link shows: the notification preferences
+// of the user it was sent to.
+type PreferenceCenterResponse struct {
+	Notification *NotificationPreferences `json:"notification"`
+	ExpiresAt    time.Time                `json:"expiresAt"`
+}
+
+// ListUnsubscribeResponse carries what an email needs for the List-Unsubscribe (RFC 2369) and
+// List-Unsubscribe-Post (RFC 8058) headers. ListUnsubscribe and ListUnsubscribePost are the
+// header values; URL and Mailto are the targets they list.
+type ListUnsubscribeResponse struct {
+	Category            EmailCategory `json:"category"`
+	URL                 string        `json:"url" log:"redact"`
+	Mailto              string        `json:"mailto,omitempty" log:"redact"`
+	ListUnsubscribe     string        `json:"listUnsubscribe" log:"redact"`
+	ListUnsubscribePost string        `json:"listUnsubscribePost"`
+	ExpiresAt           time.Time     `json:"expiresAt"`
+}
+
 // ============================================================================
 // Social Feature Responses
 // ============================================================================
@@ -57,12 +622,344 @@ type UserConfirmAccountDeleteResponse struct {
 type User struct {
 	UserID    string    `json:"userId"`
 	Username  string    `json:"username"`
-	Email     *string   `json:"email,omitempty"`
-	FullName  *string   `json:"fullName,omitempty"`
+	Email     *string   `json:"email,omitempty"    log:"redact"`
+	FullName  *string   `json:"fullName,omitempty" log:"redact"`
 	Bio       *string   `json:"bio,omitempty"`
+	Country   *string   `json:"country,omitempty"`
+	Region    *string   `json:"region,omitempty"`
 	IsActive  bool      `json:"isActive"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// DeactivatedAt is when an inactive user was deactivated; nil for active users.
+	DeactivatedAt *time.Time `json:"deactivatedAt,omitempty"`
+	// FollowSettings is only set in the requester's own following list.
+	FollowSettings *FollowSettings `json:"followSettings,omitempty"`
+	// Presence is only set in followers lists requested with includePresence.
+	Presence *Presence `json:"presence,omitempty"`
+}
+
+// PresenceStatus tells whether a user is online.
+type PresenceStatus string
+
+// PresenceStatus values. Hidden is returned when the user's privacy preferences keep their
+// presence from the requester.
+const (
+	PresenceOnline  PresenceStatus = "online"
+	PresenceOffline PresenceStatus = "offline"
+	PresenceHidden  PresenceStatus = "hidden"
+)
+
+// Presence is whether a user is online and when they were last seen. LastSeenAt is omitted when
+// the presence is hidden or the user was not seen within the retention period.
+type Presence struct {
+	Status     PresenceStatus `json:"status"`
+	LastSeenAt *time.Time     `json:"lastSeenAt,omitempty"`
+}
+
+// UserPresenceResponse is the presence of one user.
+type UserPresenceResponse struct {
+	UserID string `json:"userId"`
+	Presence
+}
+
+// ProfileViewsResponse is how many times a user's profile was viewed. Counting is false when the
+// user turned off the countProfileViews privacy preference; the count then stays where it was.
+type ProfileViewsResponse struct {
+	UserID    string `json:"userId"`
+	ViewCount int64  `json:"viewCount"`
+	Counting  bool   `json:"counting"`
+}
+
+// RecentlyViewedProfile is a profile the requester recently viewed. FullName is set when the user
+// shows it.
+type RecentlyViewedProfile struct {
+	UserID   string  `json:"userId"`
+	Username string  `json:"username"`
+	FullName *string `json:"fullName,omitempty" log:"redact"`
+}
+
+// RecentlyViewedResponse lists the profiles the requester recently viewed, most recent first,
+// leaving out those they can no longer see. Recording is false when the requester turned off the
+// keepRecentlyViewed privacy preference; the list is then empty.
+type RecentlyViewedResponse struct {
+	Profiles  []RecentlyViewedProfile `json:"profiles"`
+	Recording bool                    `json:"recording"`
+}
+
+// BadgeMetric is what a badge counts towards its threshold.
+type BadgeMetric string
+
+const (
+	// BadgeMetricRecipes counts the recipes the user created.
+	BadgeMetricRecipes BadgeMetric = "recipes"
+	// BadgeMetricFollowers counts the user's followers.
+	BadgeMetricFollowers BadgeMetric = "followers"
+	// BadgeMetricMembershipDays counts the days since the user signed up.
+	BadgeMetricMembershipDays BadgeMetric = "membership_days"
+)
+
+// ValidBadgeMetrics lists all valid BadgeMetric values.
+var ValidBadgeMetrics = []BadgeMetric{BadgeMetricRecipes, BadgeMetricFollowers, BadgeMetricMembershipDays}
+
+// IsValid reports whether m is one of the declared BadgeMetric values.
+func (m BadgeMetric) IsValid() bool {
+	return slices.Contains(ValidBadgeMetrics, m)
+}
+
+// BadgeDefinition is a badge users earn once a metric reaches a threshold.
+type BadgeDefinition struct {
+	BadgeID     string
+	Name        string
+	Description string
+	Metric      BadgeMetric
+	Threshold   int
+}
+
+// BadgeMetrics are the values of a user's badge metrics.
+type BadgeMetrics struct {
+	Recipes     int
+	Followers   int
+	MemberSince time.Time
+}
+
+// EarnedBadge is a badge a user earned, as stored.
+type EarnedBadge struct {
+	BadgeID  string
+	EarnedAt time.Time
+}
+
+// Badge is a badge a user earned.
+type Badge struct {
+	BadgeID     string    `json:"badgeId"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	EarnedAt    time.Time `json:"earnedAt"`
+}
+
+// UserBadgesResponse lists the badges a user earned, earliest first.
+type UserBadgesResponse struct {
+	UserID string  `json:"userId"`
+	Badges []Badge `json:"badges"`
+}
+
+// BadgeEvent is something a user did that may earn them a badge.
+type BadgeEvent string
+
+const (
+	BadgeEventRecipeCreated  BadgeEvent = "recipe_created"
+	BadgeEventFollowerGained BadgeEvent = "follower_gained"
+)
+
+// ValidBadgeEvents lists all valid BadgeEvent values.
+var ValidBadgeEvents = []BadgeEvent{BadgeEventRecipeCreated, BadgeEventFollowerGained}
+
+// IsValid reports whether e is one of the declared BadgeEvent values.
+func (e BadgeEvent) IsValid() bool {
+	return slices.Contains(ValidBadgeEvents, e)
+}
+
+// Values returns the valid BadgeEvent values, for error messages.
+func (BadgeEvent) Values() []string {
+	return enumStrings(ValidBadgeEvents)
+}
+
+// MentionedUser is the user an @username mention links to. FullName is set only when the user
+// shows it.
+type MentionedUser struct {
+	UserID   string  `json:"userId"`
+	Username string  `json:"username"`
+	FullName *string `json:"fullName,omitempty" log:"redact"`
+}
+
+// MentionResolveResponse lists the mentioned users, in the order they were requested. Unresolved
+// holds the usernames that link to nobody, whether the user does not exist or cannot be found.
+type MentionResolveResponse struct {
+	Users      []MentionedUser `json:"users"`
+	Unresolved []string        `json:"unresolved"`
+}
+
+// ProfileEmbedResponse is the Open Graph metadata of a public profile, for link previews. Title
+// is the full name with the username, or the username alone when the full name is hidden; URL and
+// AvatarURL are omitted when no template is configured for them.
+type ProfileEmbedResponse struct {
+	UserID        string `json:"userId"`
+	Username      string `json:"username"`
+	Title         string `json:"title"`
+	Description   string `json:"description,omitempty"`
+	URL           string `json:"url,omitempty"`
+	AvatarURL     string `json:"avatarUrl,omitempty"`
+	FollowerCount int    `json:"followerCount"`
+}
+
+// PublicProfile is a user's profile as anonymous viewers see it, precomputed so anonymous reads
+// need no joins. Profile and FollowerCount are only kept for public profiles.
+type PublicProfile struct {
+	UserID        string               `json:"userId"`
+	IsActive      bool                 `json:"isActive"`
+	Public        bool                 `json:"public"`
+	Profile       *UserProfileResponse `json:"profile,omitempty"`
+	FollowerCount int                  `json:"followerCount"`
+	// BuiltAt is when the profile was read from the database, to bound how stale it is.
+	BuiltAt time.Time `json:"builtAt"`
+}
+
+// DirectoryAttribute is a user attribute synced from the LDAP directory.
+type DirectoryAttribute string
+
+const (
+	DirectoryAttributeFullName DirectoryAttribute = "fullName"
+	DirectoryAttributeEmail    DirectoryAttribute = "email"
+	DirectoryAttributeIsActive DirectoryAttribute = "isActive"
+)
+
+// DirectoryConflictRule decides which value an attribute keeps when the local value and the
+// directory value differ.
+type DirectoryConflictRule string
+
+const (
+	// DirectoryWins always applies the directory value.
+	DirectoryWins DirectoryConflictRule = "directory"
+	// LocalWins applies the directory value only while the local value was not changed since the
+	// last sync, and otherwise keeps the local value as a conflict.
+	LocalWins DirectoryConflictRule = "local"
+)
+
+// ValidDirectoryConflictRules lists all valid DirectoryConflictRule values.
+var ValidDirectoryConflictRules = []DirectoryConflictRule{DirectoryWins, LocalWins}
+
+// IsValid reports whether r is one of the declared DirectoryConflictRule values.
+func (r DirectoryConflictRule) IsValid() bool {
+	return slices.Contains(ValidDirectoryConflictRules, r)
+}
+
+// DirectorySyncStatus is how the last directory sync of a linked account went.
+type DirectorySyncStatus string
+
+const (
+	// DirectorySyncPending means the account was linked and not synced yet.
+	DirectorySyncPending DirectorySyncStatus = "PENDING"
+	// DirectorySyncSynced means every attribute matches the directory.
+	DirectorySyncSynced DirectorySyncStatus = "SYNCED"
+	// DirectorySyncConflict means some attributes kept a local value that differs from the directory.
+	DirectorySyncConflict DirectorySyncStatus = "CONFLICT"
+	// DirectorySyncNotFound means the directory has no entry for the external ID.
+	DirectorySyncNotFound DirectorySyncStatus = "NOT_FOUND"
+	// DirectorySyncFailed means the directory values could not be applied; see the last error.
+	DirectorySyncFailed DirectorySyncStatus = "FAILED"
+)
+
+// DirectoryAttributes are values of the synced attributes. Nil means the value is unknown, or
+// the directory entry does not have it.
+type DirectoryAttributes struct {
+	FullName *string `json:"fullName,omitempty" log:"redact"`
+	Email    *string `json:"email,omitempty"    log:"redact"`
+	IsActive *bool   `json:"isActive,omitempty"`
+}
+
+// DirectoryEntry is the directory entry of a linked account.
+type DirectoryEntry struct {
+	DN string
+	DirectoryAttributes
+}
+
+// DirectoryLink maps a user account to its directory entry and records how the last sync went.
+type DirectoryLink struct {
+	UserID     string              `json:"userId"`
+	ExternalID string              `json:"externalId"`
+	Status     DirectorySyncStatus `json:"status"`
+	// Synced holds the directory values seen by the last sync. A local value that differs from
+	// it was changed locally since.
+	Synced DirectoryAttributes `json:"synced"`
+	// Conflicts lists the attributes whose local value was kept over a different directory value.
+	Conflicts    []DirectoryAttribute `json:"conflicts"`
+	LastError    string               `json:"lastError,omitempty"`
+	LastSyncedAt *time.Time           `json:"lastSyncedAt,omitempty"`
+	CreatedAt    time.Time            `json:"createdAt"`
+}
+
+// FollowSettings controls the activity notifications a follower gets about one followed user.
+// Muted silences all of them regardless of the other flags.
+type FollowSettings struct {
+	Muted            bool `json:"muted"`
+	NotifyNewRecipes bool `json:"notifyNewRecipes"`
+	NotifyReviews    bool `json:"notifyReviews"`
+}
+
+// DefaultFollowSettings returns the settings of a new follow: every notification on.
+func DefaultFollowSettings() FollowSettings {
+	return FollowSettings{NotifyNewRecipes: true, NotifyReviews: true}
+}
+
+// Notifies reports whether a follower with these settings is notified of event.
+func (s FollowSettings) Notifies(event FollowNotificationEvent) bool {
+	if s.Muted {
+		return false
+	}
+
+	switch event {
+	case FollowNotificationEventNewRecipe:
+		return s.NotifyNewRecipes
+	case FollowNotificationEventReview:
+		return s.NotifyReviews
+	default:
+		return false
+	}
+}
+
+// FollowNotificationEvent is an activity of a followed user that followers can be notified of.
+type FollowNotificationEvent string
+
+const (
+	FollowNotificationEventNewRecipe FollowNotificationEvent = "new_recipe"
+	FollowNotificationEventReview    FollowNotificationEvent = "review"
+)
+
+// ValidFollowNotificationEvents lists all valid FollowNotificationEvent values.
+var ValidFollowNotificationEvents = []FollowNotificationEvent{
+	FollowNotificationEventNewRecipe,
+	FollowNotificationEventReview,
+}
+
+// IsValid reports whether e is one of the declared FollowNotificationEvent values.
+func (e FollowNotificationEvent) IsValid() bool {
+	return slices.Contains(ValidFollowNotificationEvents, e)
+}
+
+// Values returns the valid FollowNotificationEvent values, for error messages.
+func (FollowNotificationEvent) Values() []string {
+	return enumStrings(ValidFollowNotificationEvents)
+}
+
+// FollowEvent is an entry of the follow history.
+type FollowEvent string
+
+const (
+	FollowEventFollow   FollowEvent = "FOLLOW"
+	FollowEventUnfollow FollowEvent = "UNFOLLOW"
+)
+
+// FollowHistoryEntry is a follow or unfollow of a user.
+type FollowHistoryEntry struct {
+	// UserID and Username identify the follower.
+	UserID     string      `json:"userId"`
+	Username   string      `json:"username"`
+	Event      FollowEvent `json:"event"`
+	OccurredAt time.Time   `json:"occurredAt"`
+}
+
+// FollowHistoryResponse is a page of a user's follow history, newest first.
+type FollowHistoryResponse struct {
+	TotalCount int                  `json:"totalCount"`
+	Events     []FollowHistoryEntry `json:"events"`
+	Limit      int                  `json:"limit"`
+	Offset     int                  `json:"offset"`
+}
+
+// NotificationRecipientsResponse lists the followers to notify of a user's activity.
+type NotificationRecipientsResponse struct {
+	UserID       string                  `json:"userId"`
+	Event        FollowNotificationEvent `json:"event"`
+	RecipientIDs []string                `json:"recipientIds"`
 }
 
 // GetFollowedUsersResponse represents the response for following/followers list.
@@ -79,6 +976,19 @@ type FollowResponse struct {
 	IsFollowing bool   `json:"isFollowing"`
 }
 
+// QuotaResponse reports how much of their quotas a user has used.
+type QuotaResponse struct {
+	Following QuotaUsage `json:"following"`
+}
+
+// QuotaUsage is the usage of one quota. Limit is omitted when there is none, and Overridden is
+// set when an admin chose the limit for this user instead of the configured default.
+type QuotaUsage struct {
+	Used       int  `json:"used"`
+	Limit      *int `json:"limit,omitempty"`
+	Overridden bool `json:"overridden"`
+}
+
 // FollowingCheckResponse represents the response for checking follow status.
 type FollowingCheckResponse struct {
 	IsFollowing bool       `json:"isFollowing"`
@@ -122,13 +1032,66 @@ type UserActivityResponse struct {
 	RecentFollows   []UserSummary     `json:"recentFollows"`
 	RecentReviews   []ReviewSummary   `json:"recentReviews"`
 	RecentFavorites []FavoriteSummary `json:"recentFavorites"`
+	// UnavailableSections names the sections that could not be loaded and are returned empty.
+	UnavailableSections []string `json:"unavailableSections,omitempty"`
+}
+
+// Activity section names, as used in UserActivityResponse.UnavailableSections.
+const (
+	ActivitySectionRecipes   = "recentRecipes"
+	ActivitySectionFollows   = "recentFollows"
+	ActivitySectionReviews   = "recentReviews"
+	ActivitySectionFavorites = "recentFavorites"
+)
+
+// ActivitySections lists the activity sections in response order.
+var ActivitySections = []string{
+	ActivitySectionRecipes,
+	ActivitySectionFollows,
+	ActivitySectionReviews,
+	ActivitySectionFavorites,
+}
+
+// DigestRecipe is a recipe by a followed user, as listed in an activity digest.
+type DigestRecipe struct {
+	RecipeSummary
+
+	AuthorID       string `json:"authorId"`
+	AuthorUsername string `json:"authorUsername"`
+}
+
+// DigestPreviewResponse is what the next activity digest of a user would contain: what happened
+// since Since, newest first.
+type DigestPreviewResponse struct {
+	Since           time.Time      `json:"since"`
+	NewFollowers    []UserSummary  `json:"newFollowers"`
+	FollowedRecipes []DigestRecipe `json:"followedRecipes"`
+	// UnavailableSections names the sections that could not be loaded and are returned empty.
+	UnavailableSections []string `json:"unavailableSections,omitempty"`
+}
+
+// Digest section names, as used in DigestPreviewResponse.UnavailableSections.
+const (
+	DigestSectionNewFollowers    = "newFollowers"
+	DigestSectionFollowedRecipes = "followedRecipes"
+)
+
+// DigestSections lists the digest sections in response order.
+var DigestSections = []string{
+	DigestSectionNewFollowers,
+	DigestSectionFollowedRecipes,
 }
 
 // ============================================================================
-// Privacy Preferences (used for access control)
+// Legacy Privacy Preferences
 // ============================================================================
 
-// PrivacyPreferences represents privacy settings used for access control.
+// PrivacyPreferences is the legacy access-control shape of the privacy preferences, where the
+// profile visibility is one of "public", "followers_only" or "private" and email visibility is a
+// flag. It is kept for consumers of that shape; use UserPrivacyPreferences.Legacy to build it and
+// PrivacyPreferences.Unified to convert it back.
+//
+// Deprecated: use UserPrivacyPreferences.
 type PrivacyPreferences struct {
 	ProfileVisibility string `json:"profileVisibility"`
 	ShowEmail         bool   `json:"showEmail"`
@@ -137,6 +1100,40 @@ type PrivacyPreferences struct {
 	AllowMessages     bool   `json:"allowMessages"`
 }
 
+// Unified converts legacy preferences to the unified model. Recipe and activity visibility, which
+// the legacy shape does not carry, follow the profile visibility, and the user stays discoverable,
+// shows when they were last seen, has their profile views counted and keeps the profiles they
+// recently viewed.
+func (p *PrivacyPreferences) Unified() *UserPrivacyPreferences {
+	visibility := ProfileVisibilityPublic
+
+	switch p.ProfileVisibility {
+	case "followers_only":
+		visibility = ProfileVisibilityFriendsOnly
+	case "private":
+		visibility = ProfileVisibilityPrivate
+	}
+
+	contact := ProfileVisibilityPrivate
+	if p.ShowEmail {
+		contact = ProfileVisibilityPublic
+	}
+
+	return &UserPrivacyPreferences{
+		ProfileVisibility:     visibility,
+		RecipeVisibility:      visibility,
+		ActivityVisibility:    ActivityVisibility(visibility),
+		ContactInfoVisibility: contact,
+		ShowFullName:          p.ShowFullName,
+		AllowFollows:          p.AllowFollows,
+		AllowMessages:         p.AllowMessages,
+		Discoverable:          true,
+		ShowLastSeen:          true,
+		CountProfileViews:     true,
+		KeepRecentlyViewed:    true,
+	}
+}
+
 // ============================================================================
 // Admin Responses
 // ============================================================================
@@ -159,6 +1156,132 @@ type UserStatsResponse struct {
 	NewUsersThisMonth int `json:"newUsersThisMonth"`
 }
 
+// StatsInterval is the bucket width of the admin statistics time series.
+type StatsInterval string
+
+// Admin statistics intervals. Weeks start on Monday; all buckets are in UTC.
+const (
+	StatsIntervalDay  StatsInterval = "day"
+	StatsIntervalWeek StatsInterval = "week"
+)
+
+// IsValid reports whether i is a supported interval.
+func (i StatsInterval) IsValid() bool {
+	return i == StatsIntervalDay || i == StatsIntervalWeek
+}
+
+// Values returns the valid StatsInterval values, for error messages.
+func (StatsInterval) Values() []string {
+	return enumStrings([]StatsInterval{StatsIntervalDay, StatsIntervalWeek})
+}
+
+// Truncate returns the start of the bucket containing t.
+func (i StatsInterval) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	if i == StatsIntervalWeek {
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7)) //nolint:mnd // days since Monday
+	}
+
+	return day
+}
+
+// Next returns the start of the bucket following the one starting at start.
+func (i StatsInterval) Next(start time.Time) time.Time {
+	if i == StatsIntervalWeek {
+		return start.AddDate(0, 0, 7) //nolint:mnd // days per week
+	}
+
+	return start.AddDate(0, 0, 1)
+}
+
+// AdminStatsQuery selects the range of the admin statistics. From and To are bucket boundaries;
+// To is exclusive.
+type AdminStatsQuery struct {
+	From     time.Time
+	To       time.Time
+	Interval StatsInterval
+}
+
+// AdminStatsResponse is the admin statistics dashboard for a time range.
+type AdminStatsResponse struct {
+	From     time.Time          `json:"from"`
+	To       time.Time          `json:"to"`
+	Interval StatsInterval      `json:"interval"`
+	Totals   AdminStatsTotals   `json:"totals"`
+	Series   []AdminStatsBucket `json:"series"`
+	// PreferenceAdoption is the share of users, from 0 to 1, who saved each preference category.
+	PreferenceAdoption map[PreferenceCategory]float64 `json:"preferenceAdoption"`
+	GeneratedAt        time.Time                      `json:"generatedAt"`
+}
+
+// AdminStatsTotals holds current counts, independent of the requested range.
+type AdminStatsTotals struct {
+	TotalUsers    int `json:"totalUsers"`
+	ActiveUsers   int `json:"activeUsers"`
+	InactiveUsers int `json:"inactiveUsers"`
+	FollowEdges   int `json:"followEdges"`
+}
+
+// AdminStatsBucket holds the counts of one interval of the admin statistics.
+type AdminStatsBucket struct {
+	Start time.Time `json:"start"`
+	// Signups counts accounts created in the interval.
+	Signups int `json:"signups"`
+	// ActiveUsers counts distinct users who changed their profile or preferences in the interval.
+	ActiveUsers int `json:"activeUsers"`
+	// Deactivations counts accounts deactivated in the interval.
+	Deactivations int `json:"deactivations"`
+	// NewFollows counts follow edges created in the interval.
+	NewFollows int `json:"newFollows"`
+}
+
+// FollowerInsightsQuery selects the range of follower insights. From and To are bucket boundaries;
+// To is exclusive.
+type FollowerInsightsQuery struct {
+	From     time.Time
+	To       time.Time
+	Interval StatsInterval
+}
+
+// FollowerInsightsResponse summarizes a creator's audience.
+type FollowerInsightsResponse struct {
+	UserID   string                   `json:"userId"`
+	From     time.Time                `json:"from"`
+	To       time.Time                `json:"to"`
+	Interval StatsInterval            `json:"interval"`
+	Totals   FollowerInsightsTotals   `json:"totals"`
+	Series   []FollowerInsightsBucket `json:"series"`
+	// PublicProfilePercent is the share of current followers, from 0 to 100, with a public profile.
+	PublicProfilePercent float64 `json:"publicProfilePercent"`
+	// RecentUnfollows counts unfollows within the range.
+	RecentUnfollows int `json:"recentUnfollows"`
+	// Locations counts current followers by country, most followers first. Only followers who
+	// show their location are counted.
+	Locations   []FollowerLocation `json:"locations"`
+	GeneratedAt time.Time          `json:"generatedAt"`
+}
+
+// FollowerInsightsTotals holds current follower counts, independent of the requested range.
+type FollowerInsightsTotals struct {
+	Followers              int `json:"followers"`
+	PublicProfileFollowers int `json:"publicProfileFollowers"`
+}
+
+// FollowerLocation counts the followers in one ISO 3166-1 alpha-2 country.
+type FollowerLocation struct {
+	Country   string `json:"country"`
+	Followers int    `json:"followers"`
+}
+
+// FollowerInsightsBucket holds the follower changes of one interval.
+type FollowerInsightsBucket struct {
+	Start        time.Time `json:"start"`
+	NewFollowers int       `json:"newFollowers"`
+	Unfollows    int       `json:"unfollows"`
+}
+
 // SystemHealthResponse represents system health status.
 type SystemHealthResponse struct {
 	Status         string `json:"status"`
@@ -182,6 +1305,310 @@ type ClearSessionsResponse struct {
 	SessionsCleared int    `json:"sessionsCleared"`
 }
 
+// AdminNote is an internal note left on a user account by an admin or support agent. Notes are
+// never returned by user-facing endpoints.
+type AdminNote struct {
+	NoteID    int64     `json:"noteId"`
+	UserID    string    `json:"userId"`
+	AuthorID  string    `json:"authorId"`
+	Text      string    `json:"text"`
+	Pinned    bool      `json:"pinned"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// AdminNotesResponse lists the notes on a user account, pinned notes first, then newest first.
+type AdminNotesResponse struct {
+	UserID string      `json:"userId"`
+	Notes  []AdminNote `json:"notes"`
+}
+
+// UserRole is the role stored on a user account, which decides what the account may do beyond
+// its own data.
+type UserRole string
+
+const (
+	// UserRoleUser is the default role, with access to the user's own data only.
+	UserRoleUser UserRole = "user"
+	// UserRoleModerator may review change requests and content flags and put accounts under moderation.
+	UserRoleModerator UserRole = "moderator"
+	// UserRoleAdmin may use every admin endpoint, like a token with the admin scope.
+	UserRoleAdmin UserRole = "admin"
+	// UserRoleService marks accounts run by other services rather than people.
+	UserRoleService UserRole = "service"
+)
+
+// ValidUserRoles lists all valid UserRole values.
+var ValidUserRoles = []UserRole{
+	UserRoleUser,
+	UserRoleModerator,
+	UserRoleAdmin,
+	UserRoleService,
+}
+
+// IsValid reports whether r is one of the declared UserRole values.
+func (r UserRole) IsValid() bool {
+	return slices.Contains(ValidUserRoles, r)
+}
+
+// Values returns the valid UserRole values, for error messages.
+func (UserRole) Values() []string {
+	return enumStrings(ValidUserRoles)
+}
+
+// UserRoleResponse is the role stored on a user account.
+type UserRoleResponse struct {
+	UserID string   `json:"userId"`
+	Role   UserRole `json:"role"`
+}
+
+// OrganizationRole is the role of a member in an organization.
+type OrganizationRole string
+
+const (
+	// OrganizationRoleOwner may manage the membership and act as the organization.
+	OrganizationRoleOwner OrganizationRole = "owner"
+	// OrganizationRoleEditor may act as the organization.
+	OrganizationRoleEditor OrganizationRole = "editor"
+)
+
+// ValidOrganizationRoles lists all valid OrganizationRole values.
+var ValidOrganizationRoles = []OrganizationRole{
+	OrganizationRoleOwner,
+	OrganizationRoleEditor,
+}
+
+// IsValid reports whether r is one of the declared OrganizationRole values.
+func (r OrganizationRole) IsValid() bool {
+	return slices.Contains(ValidOrganizationRoles, r)
+}
+
+// Values returns the valid OrganizationRole values, for error messages.
+func (OrganizationRole) Values() []string {
+	return enumStrings(ValidOrganizationRoles)
+}
+
+// Organization is a shared account managed by its members. OrgID is the ID of the user account
+// holding its profile and social graph.
+type Organization struct {
+	OrgID     string    `json:"orgId"`
+	Username  string    `json:"username"`
+	FullName  *string   `json:"fullName,omitempty" log:"redact"`
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// OrganizationMember is a user who may act as an organization.
+type OrganizationMember struct {
+	UserID   string           `json:"userId"`
+	Username string           `json:"username"`
+	Role     OrganizationRole `json:"role"`
+	AddedBy  string           `json:"addedBy"`
+	AddedAt  time.Time        `json:"addedAt"`
+}
+
+// OrganizationMembership is an organization a user belongs to, with their role in it.
+type OrganizationMembership struct {
+	Organization Organization     `json:"organization"`
+	Role         OrganizationRole `json:"role"`
+}
+
+// OrganizationsResponse lists the organizations a user belongs to.
+type OrganizationsResponse struct {
+	Organizations []OrganizationMembership `json:"organizations"`
+}
+
+// OrganizationMembersResponse lists the members of an organization, owners first.
+type OrganizationMembersResponse struct {
+	OrgID   string               `json:"orgId"`
+	Members []OrganizationMember `json:"members"`
+}
+
+// InvitationStatus is the state of an invitation code.
+type InvitationStatus string
+
+// Invitation statuses.
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusRedeemed InvitationStatus = "redeemed"
+	InvitationStatusExpired  InvitationStatus = "expired"
+)
+
+// Invitation is an invitation code a user created. RedeemedBy names the user who redeemed it.
+type Invitation struct {
+	Code            string           `json:"code"`
+	InviterID       string           `json:"inviterId"`
+	InviterUsername string           `json:"inviterUsername"`
+	Status          InvitationStatus `json:"status"`
+	CreatedAt       time.Time        `json:"createdAt"`
+	ExpiresAt       time.Time        `json:"expiresAt"`
+	RedeemedBy      *string          `json:"redeemedBy,omitempty"`
+	RedeemedAt      *time.Time       `json:"redeemedAt,omitempty"`
+}
+
+// InvitationsResponse lists the invitations a user created, newest first. Remaining is how many
+// more they may create, or omitted when there is no limit.
+type InvitationsResponse struct {
+	Invitations []Invitation `json:"invitations"`
+	Remaining   *int         `json:"remaining,omitempty"`
+}
+
+// InvitationRedemption reports a redeemed invitation. MutualFollow is set when the inviter and
+// the invitee now follow each other.
+type InvitationRedemption struct {
+	Code            string    `json:"code"`
+	InviterID       string    `json:"inviterId"`
+	InviterUsername string    `json:"inviterUsername"`
+	RedeemedAt      time.Time `json:"redeemedAt"`
+	MutualFollow    bool      `json:"mutualFollow"`
+}
+
+// InviterStats counts the invitations of one user that were redeemed.
+type InviterStats struct {
+	UserID   string `json:"userId"`
+	Username string `json:"username"`
+	Redeemed int64  `json:"redeemed"`
+}
+
+// InvitationStatsResponse summarizes how users grow the app by inviting others.
+type InvitationStatsResponse struct {
+	Created     int64          `json:"created"`
+	Redeemed    int64          `json:"redeemed"`
+	TopInviters []InviterStats `json:"topInviters"`
+}
+
+// AuditAction is an admin action recorded in the audit trail.
+type AuditAction string
+
+const (
+	AuditActionNoteCreated AuditAction = "note_created"
+	AuditActionNoteUpdated AuditAction = "note_updated"
+	AuditActionNoteDeleted AuditAction = "note_deleted"
+
+	AuditActionModerationEnabled     AuditAction = "moderation_enabled"
+	AuditActionModerationDisabled    AuditAction = "moderation_disabled"
+	AuditActionChangeRequestApproved AuditAction = "change_request_approved"
+	AuditActionChangeRequestRejected AuditAction = "change_request_rejected"
+	AuditActionContentFlagResolved   AuditAction = "content_flag_resolved"
+
+	AuditActionDirectoryLinked   AuditAction = "directory_linked"
+	AuditActionDirectoryUnlinked AuditAction = "directory_unlinked"
+
+	AuditActionRoleChanged AuditAction = "role_changed"
+
+	AuditActionOrganizationCreated       AuditAction = "organization_created"
+	AuditActionOrganizationMemberSet     AuditAction = "organization_member_set"
+	AuditActionOrganizationMemberRemoved AuditAction = "organization_member_removed"
+	AuditActionOrganizationActed         AuditAction = "organization_acted"
+)
+
+// AuditEntry records an admin action on a user account: who did what, to which resource, when.
+type AuditEntry struct {
+	AuditID    int64       `json:"auditId"`
+	ActorID    string      `json:"actorId"`
+	Action     AuditAction `json:"action"`
+	UserID     string      `json:"userId"`
+	ResourceID string      `json:"resourceId,omitempty"`
+	RecordedAt time.Time   `json:"recordedAt"`
+}
+
+// AuditTrailResponse lists the admin actions on a user account, newest first.
+type AuditTrailResponse struct {
+	UserID  string       `json:"userId"`
+	Entries []AuditEntry `json:"entries"`
+}
+
+// ModerationStatusResponse reports whether a user account is under moderation. Identity changes
+// on moderated accounts go through the change-request queue.
+type ModerationStatusResponse struct {
+	UserID          string `json:"userId"`
+	UnderModeration bool   `json:"underModeration"`
+}
+
+// ChangeRequestField is an identity field whose changes need admin approval on moderated accounts.
+type ChangeRequestField string
+
+const (
+	ChangeRequestFieldUsername ChangeRequestField = "username"
+	ChangeRequestFieldEmail    ChangeRequestField = "email"
+)
+
+// ValidChangeRequestFields lists all valid ChangeRequestField values.
+var ValidChangeRequestFields = []ChangeRequestField{ChangeRequestFieldUsername, ChangeRequestFieldEmail}
+
+// IsValid reports whether f is one of the declared ChangeRequestField values.
+func (f ChangeRequestField) IsValid() bool {
+	return slices.Contains(ValidChangeRequestFields, f)
+}
+
+// Values returns the valid ChangeRequestField values, for error messages.
+func (ChangeRequestField) Values() []string {
+	return enumStrings(ValidChangeRequestFields)
+}
+
+// ChangeRequestStatus is the state of a change request. Requests start pending and are decided once.
+type ChangeRequestStatus string
+
+const (
+	ChangeRequestStatusPending  ChangeRequestStatus = "PENDING"
+	ChangeRequestStatusApproved ChangeRequestStatus = "APPROVED"
+	ChangeRequestStatusRejected ChangeRequestStatus = "REJECTED"
+)
+
+// ValidChangeRequestStatuses lists all valid ChangeRequestStatus values.
+var ValidChangeRequestStatuses = []ChangeRequestStatus{
+	ChangeRequestStatusPending,
+	ChangeRequestStatusApproved,
+	ChangeRequestStatusRejected,
+}
+
+// IsValid reports whether s is one of the declared ChangeRequestStatus values.
+func (s ChangeRequestStatus) IsValid() bool {
+	return slices.Contains(ValidChangeRequestStatuses, s)
+}
+
+// Values returns the valid ChangeRequestStatus values, for error messages.
+func (ChangeRequestStatus) Values() []string {
+	return enumStrings(ValidChangeRequestStatuses)
+}
+
+// ChangeRequest is a username or email change waiting for, or given, an admin decision.
+type ChangeRequest struct {
+	RequestID   int64               `json:"requestId"`
+	UserID      string              `json:"userId"`
+	Field       ChangeRequestField  `json:"field"`
+	OldValue    string              `json:"oldValue"`
+	NewValue    string              `json:"newValue"`
+	Status      ChangeRequestStatus `json:"status"`
+	Reason      string              `json:"reason,omitempty"`
+	RequestedAt time.Time           `json:"requestedAt"`
+	DecidedAt   *time.Time          `json:"decidedAt,omitempty"`
+	DecidedBy   string              `json:"decidedBy,omitempty"`
+}
+
+// ChangeRequestsResponse lists change requests, oldest first.
+type ChangeRequestsResponse struct {
+	Requests []ChangeRequest `json:"requests"`
+}
+
+// ContentFlag is profile text that content moderation flagged for admin review. The text was
+// saved as written; Field is the JSON name of the profile field, e.g. "bio".
+type ContentFlag struct {
+	FlagID     int64      `json:"flagId"`
+	UserID     string     `json:"userId"`
+	Field      string     `json:"field"`
+	Content    string     `json:"content"`
+	Terms      []string   `json:"terms"`
+	FlaggedAt  time.Time  `json:"flaggedAt"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+	ResolvedBy string     `json:"resolvedBy,omitempty"`
+}
+
+// ContentFlagsResponse lists unresolved content flags, oldest first.
+type ContentFlagsResponse struct {
+	Flags []ContentFlag `json:"flags"`
+}
+
 // ============================================================================
 // Metrics Responses
 // ============================================================================
@@ -312,3 +1739,63 @@ type DetailedHealthMetricsResponse struct {
 	Services      ServicesHealth  `json:"services"`
 	Application   ApplicationInfo `json:"application"`
 }
+
+// ============================================================================
+// Internal Replication Responses
+// ============================================================================
+
+// UserChangeType represents the kind of change recorded in the user change log.
+type UserChangeType string
+
+const (
+	UserChangeTypeUpsert            UserChangeType = "USER_UPSERT"
+	UserChangeTypeDeactivated       UserChangeType = "USER_DEACTIVATED"
+	UserChangeTypePreferenceChanged UserChangeType = "PREFERENCE_CHANGED"
+)
+
+// UserChange represents a single entry in the user change feed.
+type UserChange struct {
+	Sequence   int64          `json:"sequence"`
+	UserID     string         `json:"userId"`
+	ChangeType UserChangeType `json:"changeType"`
+	Category   *string        `json:"category,omitempty"`
+	ChangedAt  time.Time      `json:"changedAt"`
+}
+
+// UserChangesResponse represents a page of the user change feed.
+type UserChangesResponse struct {
+	Changes    []UserChange `json:"changes"`
+	NextCursor string       `json:"nextCursor"`
+	HasMore    bool         `json:"hasMore"`
+}
+
+// RuntimeStatsResponse represents Go runtime diagnostics.
+type RuntimeStatsResponse struct {
+	Timestamp  time.Time `json:"timestamp"`
+	GoVersion  string    `json:"goVersion"`
+	NumCPU     int       `json:"numCpu"`
+	GoMaxProcs int       `json:"goMaxProcs"`
+	Goroutines int       `json:"goroutines"`
+	Heap       HeapStats `json:"heap"`
+	GC         GCStats   `json:"gc"`
+}
+
+// HeapStats represents heap memory usage in bytes.
+type HeapStats struct {
+	AllocBytes    uint64 `json:"allocBytes"`
+	InUseBytes    uint64 `json:"inUseBytes"`
+	IdleBytes     uint64 `json:"idleBytes"`
+	ReleasedBytes uint64 `json:"releasedBytes"`
+	SysBytes      uint64 `json:"sysBytes"`
+	Objects       uint64 `json:"objects"`
+}
+
+// GCStats represents garbage collector statistics.
+type GCStats struct {
+	NumGC           uint32     `json:"numGc"`
+	PauseTotalMs    float64    `json:"pauseTotalMs"`
+	LastPauseMs     float64    `json:"lastPauseMs"`
+	LastGC          *time.Time `json:"lastGc,omitempty"`
+	NextGCHeapBytes uint64     `json:"nextGcHeapBytes"`
+	CPUFraction     float64    `json:"cpuFraction"`
+}
@@ -4,42 +4,42 @@ import "time"
 
 // CreateUserRequest represents the request body for creating a user.
 type CreateUserRequest struct {
-	Email    string `json:"email"    validate:"required,email,max=255"`
-	Password string `json:"password" validate:"required,min=8,max=72"`
-	Name     string `json:"name"     validate:"required,min=1,max=100"`
+	Email    string `json:"email"    validate:"required,email,max=255" log:"redact"`
+	Password string `json:"password" validate:"required,min=8,max=72"  log:"redact"`
+	Name     string `json:"name"     validate:"required,min=1,max=100" log:"redact"`
 }
 
 // UpdateUserRequest represents the request body for updating a user.
 type UpdateUserRequest struct {
-	Email *string `json:"email,omitempty" validate:"omitempty,email,max=255"`
-	Name  *string `json:"name,omitempty"  validate:"omitempty,min=1,max=100"`
+	Email *string `json:"email,omitempty" validate:"omitempty,email,max=255" log:"redact"`
+	Name  *string `json:"name,omitempty"  validate:"omitempty,min=1,max=100" log:"redact"`
 }
 
 // ChangePasswordRequest represents the request body for changing password.
 type ChangePasswordRequest struct {
-	CurrentPassword string `json:"currentPassword" validate:"required"`
-	NewPassword     string `json:"newPassword"     validate:"required,min=8,max=72"`
+	CurrentPassword string `json:"currentPassword" validate:"required"              log:"redact"`
+	NewPassword     string `json:"newPassword"     validate:"required,min=8,max=72" log:"redact"`
 }
 
 // UserResponse represents a user in API responses.
 type UserResponse struct {
 	ID        string    `json:"id"`
-	Email     string    `json:"email"`
-	Name      string    `json:"name"`
+	Email     string    `json:"email" log:"redact"`
+	Name      string    `json:"name"  log:"redact"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 // LoginRequest represents the request body for user login.
 type LoginRequest struct {
-	Email    string `json:"email"    validate:"required,email"`
-	Password string `json:"password" validate:"required"`
+	Email    string `json:"email"    validate:"required,email" log:"redact"`
+	Password string `json:"password" validate:"required"       log:"redact"`
 }
 
 // LoginResponse represents the response for successful login.
 type LoginResponse struct {
-	AccessToken  string `json:"accessToken"`
-	RefreshToken string `json:"refreshToken,omitempty"`
+	AccessToken  string `json:"accessToken"            log:"redact"`
+	RefreshToken string `json:"refreshToken,omitempty" log:"redact"`
 	ExpiresIn    int64  `json:"expiresIn"`
 	TokenType    string `json:"tokenType"`
 }
@@ -0,0 +1,28 @@
+// Package errortracking reports panics, server errors and failed background jobs to an error
+// tracking service such as Sentry.
+package errortracking
+
+import "context"
+
+// Event is an error to report.
+type Event struct {
+	// Err is what went wrong.
+	Err error
+	// Panic marks errors recovered from a panic; Stack then holds the goroutine's stack.
+	Panic bool
+	Stack []byte
+	// Tags index the event, e.g. the route and request ID, or the job name.
+	Tags map[string]string
+}
+
+// ErrorReporter sends errors to an error tracking service.
+type ErrorReporter interface {
+	// Report sends event without blocking the caller. Delivery failures are logged, not returned.
+	Report(ctx context.Context, event Event)
+}
+
+// NoopReporter drops every event. It is used when error tracking is off.
+type NoopReporter struct{}
+
+// Report does nothing.
+func (NoopReporter) Report(context.Context, Event) {}
@@ -0,0 +1,33 @@
+package errortracking
+
+import (
+	"regexp"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/logger"
+)
+
+// scrubRules mask personal data and credentials that error messages and stacks may carry.
+var scrubRules = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// Email addresses
+	{regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`), logger.Redacted},
+	// Bearer tokens and JWTs
+	{regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9\-._~+/]+=*`), logger.Redacted},
+	{regexp.MustCompile(`eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]*`), logger.Redacted},
+	// Secrets in key=value form, e.g. from a query string
+	{
+		regexp.MustCompile(`(?i)((?:password|passwd|secret|token|api_key|apikey)[=:]\s*)[^\s&,;"']+`),
+		"${1}" + logger.Redacted,
+	},
+}
+
+// Scrub replaces personal data and credentials in s with logger.Redacted.
+func Scrub(s string) string {
+	for _, rule := range scrubRules {
+		s = rule.pattern.ReplaceAllString(s, rule.replacement)
+	}
+
+	return s
+}
@@ -0,0 +1,105 @@
+package errortracking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+const defaultTimeout = 5 * time.Second
+
+// ErrInvalidDSN is returned for a Sentry DSN that is not of the form
+// https://<public key>@<host>/<project ID>.
+var ErrInvalidDSN = errors.New("invalid sentry DSN")
+
+// SentryConfig configures a SentryReporter.
+type SentryConfig struct {
+	DSN string
+	// Release and Environment tag every event, so errors can be traced to a deploy.
+	Release     string
+	Environment string
+	// Timeout bounds each delivery; zero uses 5s.
+	Timeout time.Duration
+}
+
+// SentryReporter sends events to Sentry in the background through the Sentry SDK. Messages, tags
+// and stacks are scrubbed of personal data first, and no request bodies, users or IP addresses are
+// sent.
+type SentryReporter struct {
+	client *sentry.Client
+}
+
+// NewSentryReporter creates a SentryReporter for cfg.
+func NewSentryReporter(cfg SentryConfig) (*SentryReporter, error) {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	return NewSentryReporterWithHTTP(cfg, &http.Client{Timeout: timeout})
+}
+
+// NewSentryReporterWithHTTP creates a SentryReporter with a custom HTTP client (for testing).
+func NewSentryReporterWithHTTP(cfg SentryConfig, httpClient *http.Client) (*SentryReporter, error) {
+	err := ValidateDSN(cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := sentry.NewClient(sentry.ClientOptions{
+		Dsn:         cfg.DSN,
+		Release:     cfg.Release,
+		Environment: cfg.Environment,
+		HTTPClient:  httpClient,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sentry client: %w", err)
+	}
+
+	return &SentryReporter{client: client}, nil
+}
+
+// ValidateDSN reports whether dsn is a Sentry DSN the SDK can send to.
+func ValidateDSN(dsn string) error {
+	_, err := sentry.NewDsn(dsn)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidDSN, err)
+	}
+
+	return nil
+}
+
+// Report queues event for delivery and returns at once.
+func (r *SentryReporter) Report(_ context.Context, event Event) {
+	if event.Err == nil {
+		return
+	}
+
+	payload := sentry.NewEvent()
+	payload.Level = sentry.LevelError
+	payload.Exception = []sentry.Exception{{
+		Type:  fmt.Sprintf("%T", event.Err),
+		Value: Scrub(event.Err.Error()),
+	}}
+
+	if event.Panic {
+		payload.Level = sentry.LevelFatal
+		payload.Exception[0].Type = "panic"
+		payload.Contexts["panic"] = sentry.Context{"stack": Scrub(string(event.Stack))}
+	}
+
+	for key, value := range event.Tags {
+		payload.Tags[key] = Scrub(value)
+	}
+
+	r.client.CaptureEvent(payload, nil, nil)
+}
+
+// Flush waits for queued events to be delivered, or for ctx to end.
+func (r *SentryReporter) Flush(ctx context.Context) {
+	r.client.FlushWithContext(ctx)
+}
@@ -0,0 +1,118 @@
+package errortracking_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/errortracking"
+)
+
+func TestValidateDSN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		dsn     string
+		wantErr bool
+	}{
+		{name: "hosted", dsn: "https://abc123@o1.ingest.sentry.io/42"},
+		{name: "self-hosted under a path", dsn: "http://abc123@sentry.internal:9000/errors/7"},
+		{name: "no key", dsn: "https://o1.ingest.sentry.io/42", wantErr: true},
+		{name: "no project", dsn: "https://abc123@o1.ingest.sentry.io/", wantErr: true},
+		{name: "not a URL", dsn: "sentry", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := errortracking.ValidateDSN(tt.dsn)
+			if tt.wantErr {
+				require.ErrorIs(t, err, errortracking.ErrInvalidDSN)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSentryReporter(t *testing.T) {
+	t.Parallel()
+
+	envelopes := make(chan *http.Request, 1)
+	bodies := make(chan string, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		envelopes <- r
+		bodies <- string(body)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	dsn := strings.Replace(server.URL, "://", "://pubkey@", 1) + "/42"
+	reporter, err := errortracking.NewSentryReporterWithHTTP(errortracking.SentryConfig{
+		DSN:         dsn,
+		Release:     "1.4.0",
+		Environment: "production",
+	}, server.Client())
+	require.NoError(t, err)
+
+	reporter.Report(t.Context(), errortracking.Event{
+		Err:   errors.New("lookup failed for alice@example.com"),
+		Panic: true,
+		Stack: []byte("goroutine 1 [running]:\nmain.handle(token=s3cret)"),
+		Tags:  map[string]string{"route": "/users/{user_id}"},
+	})
+	reporter.Flush(t.Context())
+
+	req := <-envelopes
+	assert.Equal(t, "/api/42/envelope/", req.URL.Path)
+	assert.Contains(t, req.Header.Get("X-Sentry-Auth"), "sentry_key=pubkey")
+
+	lines := bufio.NewScanner(strings.NewReader(<-bodies))
+
+	var items []map[string]any
+
+	for lines.Scan() {
+		var item map[string]any
+		require.NoError(t, json.Unmarshal(lines.Bytes(), &item))
+
+		items = append(items, item)
+	}
+
+	require.Len(t, items, 3)
+	assert.Equal(t, "event", items[1]["type"])
+
+	event := items[2]
+	assert.Equal(t, "1.4.0", event["release"])
+	assert.Equal(t, "production", event["environment"])
+	assert.Equal(t, "fatal", event["level"])
+	assert.Equal(t, map[string]any{"route": "/users/{user_id}"}, event["tags"])
+
+	exception := event["exception"].([]any)[0].(map[string]any)
+	assert.Equal(t, "lookup failed for [REDACTED]", exception["value"])
+	stack := event["contexts"].(map[string]any)["panic"].(map[string]any)["stack"]
+	assert.Contains(t, stack, "main.handle")
+	assert.NotContains(t, stack, "s3cret")
+}
+
+func TestScrub(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		"user [REDACTED] sent [REDACTED] with password=[REDACTED]&page=2",
+		errortracking.Scrub("user bob@example.org sent Bearer abc.def-ghi with password=hunter2&page=2"))
+}
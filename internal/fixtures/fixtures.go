@@ -0,0 +1,157 @@
+// Package fixtures reads seed data files describing users, follow edges and preferences.
+package fixtures
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// ErrInvalidFixtures is returned when a fixture file is malformed or inconsistent.
+var ErrInvalidFixtures = errors.New("invalid fixtures")
+
+// Fixtures is the contents of a fixture file.
+type Fixtures struct {
+	Users   []User   `json:"users"`
+	Follows []Follow `json:"follows"`
+}
+
+// User is a seeded user. UserID is optional; a stable ID is derived from the username when omitted
+// so that reloading the same file produces the same users.
+type User struct {
+	UserID      string                            `json:"userId"`
+	Username    string                            `json:"username"`
+	Email       *string                           `json:"email,omitempty"`
+	FullName    *string                           `json:"fullName,omitempty"`
+	Bio         *string                           `json:"bio,omitempty"`
+	IsActive    *bool                             `json:"isActive,omitempty"`
+	Preferences *dto.UserPreferencesUpdateRequest `json:"preferences,omitempty"`
+}
+
+// Follow is a follow edge between two seeded users, referenced by username.
+type Follow struct {
+	Follower string `json:"follower"`
+	Followee string `json:"followee"`
+}
+
+// ID returns the user's ID.
+func (u *User) ID() uuid.UUID {
+	if id, err := uuid.Parse(u.UserID); err == nil {
+		return id
+	}
+
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte("user-management-fixture:"+strings.ToLower(u.Username)))
+}
+
+// Active reports whether the user is active. Users are active unless isActive is false.
+func (u *User) Active() bool {
+	return u.IsActive == nil || *u.IsActive
+}
+
+// Load reads a YAML or JSON fixture file and validates it.
+func Load(path string) (*Fixtures, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path comes from operator configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixtures: %w", err)
+	}
+
+	return Parse(data, filepath.Ext(path))
+}
+
+// Parse decodes fixture data. ext selects the format: ".json", or YAML for anything else.
+func Parse(data []byte, ext string) (*Fixtures, error) {
+	jsonData := data
+
+	if !strings.EqualFold(ext, ".json") {
+		// Decode YAML generically and re-encode as JSON so the DTO json tags and enum types apply
+		var doc any
+
+		err := yaml.Unmarshal(data, &doc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFixtures, err)
+		}
+
+		jsonData, err = json.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFixtures, err)
+		}
+	}
+
+	var f Fixtures
+
+	err := json.Unmarshal(jsonData, &f)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFixtures, err)
+	}
+
+	err = f.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// Validate checks that usernames and IDs are unique and follows reference seeded users.
+func (f *Fixtures) Validate() error {
+	usernames := make(map[string]bool, len(f.Users))
+	ids := make(map[uuid.UUID]bool, len(f.Users))
+
+	for i := range f.Users {
+		user := &f.Users[i]
+
+		if user.Username == "" {
+			return fmt.Errorf("%w: user %d has no username", ErrInvalidFixtures, i)
+		}
+
+		if user.UserID != "" {
+			if _, err := uuid.Parse(user.UserID); err != nil {
+				return fmt.Errorf("%w: user %q has invalid userId %q", ErrInvalidFixtures, user.Username, user.UserID)
+			}
+		}
+
+		name := strings.ToLower(user.Username)
+		if usernames[name] {
+			return fmt.Errorf("%w: duplicate username %q", ErrInvalidFixtures, user.Username)
+		}
+
+		if ids[user.ID()] {
+			return fmt.Errorf("%w: duplicate userId %q", ErrInvalidFixtures, user.ID())
+		}
+
+		usernames[name] = true
+		ids[user.ID()] = true
+	}
+
+	for _, follow := range f.Follows {
+		if !usernames[strings.ToLower(follow.Follower)] || !usernames[strings.ToLower(follow.Followee)] {
+			return fmt.Errorf("%w: follow %s -> %s references an unknown user",
+				ErrInvalidFixtures, follow.Follower, follow.Followee)
+		}
+
+		if strings.EqualFold(follow.Follower, follow.Followee) {
+			return fmt.Errorf("%w: %s cannot follow themselves", ErrInvalidFixtures, follow.Follower)
+		}
+	}
+
+	return nil
+}
+
+// UserID returns the ID of the seeded user with the given username.
+func (f *Fixtures) UserID(username string) (uuid.UUID, bool) {
+	for i := range f.Users {
+		if strings.EqualFold(f.Users[i].Username, username) {
+			return f.Users[i].ID(), true
+		}
+	}
+
+	return uuid.Nil, false
+}
@@ -0,0 +1,85 @@
+package fixtures_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("yaml with preferences", func(t *testing.T) {
+		t.Parallel()
+
+		f, err := fixtures.Parse([]byte(`
+users:
+  - username: alice
+    preferences:
+      display:
+        fontSize: LARGE
+  - username: bob
+    userId: 6f1c2a52-3f7e-4c1f-9d55-2b8f0f3e9a11
+    isActive: false
+follows:
+  - follower: Alice
+    followee: bob
+`), ".yaml")
+		require.NoError(t, err)
+		require.Len(t, f.Users, 2)
+
+		assert.True(t, f.Users[0].Active())
+		assert.False(t, f.Users[1].Active())
+		assert.Equal(t, "6f1c2a52-3f7e-4c1f-9d55-2b8f0f3e9a11", f.Users[1].ID().String())
+		assert.Equal(t, dto.FontSizeLarge, *f.Users[0].Preferences.Display.FontSize)
+
+		id, ok := f.UserID("ALICE")
+		assert.True(t, ok)
+		assert.Equal(t, f.Users[0].ID(), id, "derived IDs are stable")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		f, err := fixtures.Parse([]byte(`{"users":[{"username":"alice"}]}`), ".json")
+		require.NoError(t, err)
+		assert.Len(t, f.Users, 1)
+	})
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "missing username", data: "users:\n  - email: a@example.com\n"},
+		{name: "duplicate username", data: "users:\n  - username: a\n  - username: A\n"},
+		{name: "invalid user id", data: "users:\n  - username: a\n    userId: nope\n"},
+		{name: "unknown follow target", data: "users:\n  - username: a\nfollows:\n  - follower: a\n    followee: b\n"},
+		{name: "self follow", data: "users:\n  - username: a\nfollows:\n  - follower: a\n    followee: a\n"},
+		{name: "malformed", data: "users: [unterminated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := fixtures.Parse([]byte(tt.data), ".yml")
+			require.ErrorIs(t, err, fixtures.ErrInvalidFixtures)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	f, err := fixtures.Load("../../config/fixtures/demo.yaml")
+	require.NoError(t, err)
+	assert.NotEmpty(t, f.Users)
+	assert.NotEmpty(t, f.Follows)
+
+	_, err = fixtures.Load("does-not-exist.yaml")
+	require.Error(t, err)
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// AccountRecoveryHandler handles the recovery of deactivated accounts. Its endpoints do not
+// require authentication, since their callers can no longer sign in.
+type AccountRecoveryHandler struct {
+	accountRecoveryService service.AccountRecoveryService
+	binder                 *RequestBinder
+}
+
+// NewAccountRecoveryHandler creates a new account recovery handler.
+func NewAccountRecoveryHandler(accountRecoveryService service.AccountRecoveryService) *AccountRecoveryHandler {
+	return &AccountRecoveryHandler{
+		accountRecoveryService: accountRecoveryService,
+		binder:                 NewRequestBinder(),
+	}
+}
+
+// RequestRecovery handles POST /users/account/recovery. It answers 202 whether or not the email
+// belongs to a recoverable account.
+func (h *AccountRecoveryHandler) RequestRecovery(w http.ResponseWriter, r *http.Request) {
+	// 1. Bind and validate request body
+	var req dto.AccountRecoveryRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	// 2. Send a token if the account can be recovered
+	err := h.accountRecoveryService.RequestRecovery(r.Context(), req.Email)
+	if err != nil {
+		h.handleAccountRecoveryError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ConfirmRecovery handles POST /users/account/recovery/confirm.
+func (h *AccountRecoveryHandler) ConfirmRecovery(w http.ResponseWriter, r *http.Request) {
+	// 1. Bind and validate request body
+	var req dto.AccountRecoveryConfirmRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	// 2. Redeem the token
+	response, err := h.accountRecoveryService.ConfirmRecovery(r.Context(), req.RecoveryToken)
+	if err != nil {
+		h.handleAccountRecoveryError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+func (h *AccountRecoveryHandler) handleAccountRecoveryError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidToken), errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusBadRequest, "INVALID_TOKEN", "Invalid or expired recovery token")
+	case errors.Is(err, service.ErrRecoveryWindowExpired):
+		ErrorResponse(w, http.StatusGone, "RECOVERY_WINDOW_EXPIRED",
+			"Account was deactivated too long ago to be recovered")
+	case errors.Is(err, service.ErrCacheUnavailable):
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+	default:
+		slog.Error("failed to process account recovery", "error", err)
+		InternalErrorResponse(w)
+	}
+}
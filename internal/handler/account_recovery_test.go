@@ -0,0 +1,103 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestAccountRecoveryHandler(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		path           string
+		body           string
+		mockSetup      func(*mocks.AccountRecoveryService)
+		expectedStatus int
+	}{
+		{
+			name: "request accepted",
+			path: "/users/account/recovery",
+			body: `{"email": "gone@example.com"}`,
+			mockSetup: func(m *mocks.AccountRecoveryService) {
+				m.On("RequestRecovery", mock.Anything, "gone@example.com").Return(nil)
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "request with invalid email",
+			path:           "/users/account/recovery",
+			body:           `{"email": "not-an-email"}`,
+			mockSetup:      func(_ *mocks.AccountRecoveryService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "confirm reactivates",
+			path: "/users/account/recovery/confirm",
+			body: `{"recoveryToken": "abc"}`,
+			mockSetup: func(m *mocks.AccountRecoveryService) {
+				m.On("ConfirmRecovery", mock.Anything, "abc").
+					Return(&dto.AccountRecoveryResponse{UserID: userID.String()}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "confirm with invalid token",
+			path: "/users/account/recovery/confirm",
+			body: `{"recoveryToken": "abc"}`,
+			mockSetup: func(m *mocks.AccountRecoveryService) {
+				m.On("ConfirmRecovery", mock.Anything, "abc").Return(nil, service.ErrInvalidToken)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "confirm past the recovery window",
+			path: "/users/account/recovery/confirm",
+			body: `{"recoveryToken": "abc"}`,
+			mockSetup: func(m *mocks.AccountRecoveryService) {
+				m.On("ConfirmRecovery", mock.Anything, "abc").Return(nil, service.ErrRecoveryWindowExpired)
+			},
+			expectedStatus: http.StatusGone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.AccountRecoveryService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewAccountRecoveryHandler(mockSvc)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, tt.path,
+				strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			if strings.HasSuffix(tt.path, "/confirm") {
+				h.ConfirmRecovery(rr, req)
+			} else {
+				h.RequestRecovery(rr, req)
+			}
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
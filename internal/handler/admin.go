@@ -2,26 +2,53 @@
 package handler
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 )
 
+// Admin statistics range limits.
+const (
+	defaultAdminStatsDays = 30
+	maxAdminStatsRange    = 366 * 24 * time.Hour
+)
+
+// Admin statistics parameter validation errors.
+var (
+	ErrInvalidStatsInterval = errors.New("interval must be one of: day, week")
+	ErrInvalidStatsTime     = errors.New("must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+	ErrInvalidStatsRange    = errors.New("from must be before to and the range at most 366 days")
+)
+
 // AdminHandler handles admin HTTP endpoints.
 type AdminHandler struct {
 	userService  service.UserService
 	adminService service.AdminService
+	statsService service.StatsService
 	binder       *RequestBinder
 }
 
 // NewAdminHandler creates a new admin handler.
-func NewAdminHandler(userService service.UserService, adminService service.AdminService) *AdminHandler {
+func NewAdminHandler(
+	userService service.UserService,
+	adminService service.AdminService,
+	statsService service.StatsService,
+) *AdminHandler {
 	return &AdminHandler{
 		userService:  userService,
 		adminService: adminService,
+		statsService: statsService,
 		binder:       NewRequestBinder(),
 	}
 }
@@ -37,6 +64,101 @@ func (h *AdminHandler) GetUserStats(w http.ResponseWriter, r *http.Request) {
 	SuccessResponse(w, http.StatusOK, stats)
 }
 
+// GetAdminStats handles GET /admin/stats.
+func (h *AdminHandler) GetAdminStats(w http.ResponseWriter, r *http.Request) {
+	_, ok := adminRequester(w, r)
+	if !ok {
+		return
+	}
+
+	if h.statsService == nil {
+		ServiceUnavailableResponse(w, "Statistics are not available")
+
+		return
+	}
+
+	query, err := parseAdminStatsQuery(r, time.Now())
+	if err != nil {
+		queryErrorResponse(w, err)
+
+		return
+	}
+
+	stats, err := h.statsService.GetAdminStats(r.Context(), query)
+	if err != nil {
+		slog.Error("failed to get admin stats", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, stats)
+}
+
+// parseAdminStatsQuery reads the from, to and interval parameters. from and to accept RFC 3339
+// timestamps or dates; a date as to includes that whole day. The range defaults to the last
+// 30 days by day.
+func parseAdminStatsQuery(r *http.Request, now time.Time) (dto.AdminStatsQuery, error) {
+	params := r.URL.Query()
+
+	query := dto.AdminStatsQuery{
+		From:     now.AddDate(0, 0, -defaultAdminStatsDays),
+		To:       now,
+		Interval: dto.StatsIntervalDay,
+	}
+
+	if interval := params.Get("interval"); interval != "" {
+		query.Interval = dto.StatsInterval(interval)
+		if !query.Interval.IsValid() {
+			return query, invalidQuery("interval", "oneof", strings.Join(query.Interval.Values(), ", "), interval,
+				ErrInvalidStatsInterval)
+		}
+	}
+
+	if from := params.Get("from"); from != "" {
+		parsed, _, err := parseStatsTime(from)
+		if err != nil {
+			return query, invalidQuery("from", "timestamp", "", from, fmt.Errorf("from %w", ErrInvalidStatsTime))
+		}
+
+		query.From = parsed
+	}
+
+	if to := params.Get("to"); to != "" {
+		parsed, isDate, err := parseStatsTime(to)
+		if err != nil {
+			return query, invalidQuery("to", "timestamp", "", to, fmt.Errorf("to %w", ErrInvalidStatsTime))
+		}
+
+		if isDate {
+			parsed = parsed.AddDate(0, 0, 1)
+		}
+
+		query.To = parsed
+	}
+
+	if !query.From.Before(query.To) || query.To.Sub(query.From) > maxAdminStatsRange {
+		return query, ErrInvalidStatsRange
+	}
+
+	return query, nil
+}
+
+// parseStatsTime parses an RFC 3339 timestamp or a date, reporting whether value was a date.
+func parseStatsTime(value string) (time.Time, bool, error) {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return parsed, false, nil
+	}
+
+	parsed, err = time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to parse time: %w", err)
+	}
+
+	return parsed, true, nil
+}
+
 // ClearCache handles POST /admin/cache/clear.
 func (h *AdminHandler) ClearCache(w http.ResponseWriter, r *http.Request) {
 	var req dto.CacheClearRequest
@@ -69,7 +191,7 @@ func (h *AdminHandler) handleBindError(w http.ResponseWriter, err error) {
 	case errors.Is(err, ErrEmptyBody):
 		ErrorResponse(w, http.StatusBadRequest, "EMPTY_BODY", "Request body is required")
 	case errors.Is(err, ErrInvalidJSON), errors.Is(err, ErrInvalidFieldType):
-		ErrorResponse(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		FieldErrorResponse(w, http.StatusBadRequest, "INVALID_JSON", err.Error(), fieldsOf(err)...)
 	case errors.Is(err, ErrValidationFailed):
 		ValidationErrorResponse(w, err)
 	default:
@@ -77,3 +199,69 @@ func (h *AdminHandler) handleBindError(w http.ResponseWriter, err error) {
 		ErrorResponse(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
 	}
 }
+
+// adminRequester checks that the requester is an admin, by scope or stored role, and returns
+// their ID.
+func adminRequester(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	return authorizedRequester(w, r, middleware.IsAdmin, "Admin scope required")
+}
+
+// moderatorRequester checks that the requester is a moderator or an admin and returns their ID.
+func moderatorRequester(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	return authorizedRequester(w, r, middleware.IsModerator, "Moderator role required")
+}
+
+// authorizedRequester checks that the requester is authenticated and allowed, and returns their ID.
+func authorizedRequester(
+	w http.ResponseWriter,
+	r *http.Request,
+	allowed func(context.Context) bool,
+	message string,
+) (uuid.UUID, bool) {
+	authUser, ok := middleware.GetAuthenticatedUser(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "Authentication required")
+
+		return uuid.Nil, false
+	}
+
+	if !allowed(r.Context()) {
+		ForbiddenResponse(w, message)
+
+		return uuid.Nil, false
+	}
+
+	return authUser.UserID, true
+}
+
+// adminTarget checks that the requester is an admin and parses the user_id path parameter,
+// returning the requester and target user IDs.
+func adminTarget(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	return requesterTarget(w, r, adminRequester)
+}
+
+// moderatorTarget checks that the requester is a moderator or an admin and parses the user_id
+// path parameter, returning the requester and target user IDs.
+func moderatorTarget(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	return requesterTarget(w, r, moderatorRequester)
+}
+
+func requesterTarget(
+	w http.ResponseWriter,
+	r *http.Request,
+	requester func(http.ResponseWriter, *http.Request) (uuid.UUID, bool),
+) (uuid.UUID, uuid.UUID, bool) {
+	requesterID, ok := requester(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	targetUserID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return requesterID, targetUserID, true
+}
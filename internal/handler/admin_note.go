@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// AdminNoteHandler handles internal notes on user accounts and the admin audit trail.
+type AdminNoteHandler struct {
+	noteService service.AdminNoteService
+	binder      *RequestBinder
+}
+
+// NewAdminNoteHandler creates a new admin note handler.
+func NewAdminNoteHandler(noteService service.AdminNoteService) *AdminNoteHandler {
+	return &AdminNoteHandler{
+		noteService: noteService,
+		binder:      NewRequestBinder(),
+	}
+}
+
+// ListNotes handles GET /admin/users/{user_id}/notes.
+func (h *AdminNoteHandler) ListNotes(w http.ResponseWriter, r *http.Request) {
+	_, targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.noteService.ListNotes(r.Context(), targetUserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// CreateNote handles POST /admin/users/{user_id}/notes.
+func (h *AdminNoteHandler) CreateNote(w http.ResponseWriter, r *http.Request) {
+	actorID, targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.AdminNoteRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	note, err := h.noteService.CreateNote(r.Context(), actorID, targetUserID, &req)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusCreated, note)
+}
+
+// UpdateNote handles PUT /admin/users/{user_id}/notes/{note_id}.
+func (h *AdminNoteHandler) UpdateNote(w http.ResponseWriter, r *http.Request) {
+	actorID, targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	noteID, ok := parseNoteID(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.AdminNoteUpdateRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	note, err := h.noteService.UpdateNote(r.Context(), actorID, targetUserID, noteID, &req)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, note)
+}
+
+// DeleteNote handles DELETE /admin/users/{user_id}/notes/{note_id}.
+func (h *AdminNoteHandler) DeleteNote(w http.ResponseWriter, r *http.Request) {
+	actorID, targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	noteID, ok := parseNoteID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.noteService.DeleteNote(r.Context(), actorID, targetUserID, noteID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetAuditTrail handles GET /admin/users/{user_id}/audit.
+func (h *AdminNoteHandler) GetAuditTrail(w http.ResponseWriter, r *http.Request) {
+	_, targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.noteService.GetAuditTrail(r.Context(), targetUserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// prepare checks for the admin scope and service availability and returns the requester and
+// target user IDs.
+func (h *AdminNoteHandler) prepare(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	actorID, targetUserID, ok := adminTarget(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if h.noteService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return actorID, targetUserID, true
+}
+
+func parseNoteID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	noteID, err := strconv.ParseInt(chi.URLParam(r, "note_id"), 10, 64)
+	if err != nil || noteID <= 0 {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_NOTE_ID", "Invalid note ID format", "note_id", "integer")
+
+		return 0, false
+	}
+
+	return noteID, true
+}
+
+func (h *AdminNoteHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrNoteNotFound):
+		ErrorResponse(w, http.StatusNotFound, "NOTE_NOT_FOUND", "Note not found")
+	default:
+		slog.Error("admin note service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
@@ -0,0 +1,147 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func withAdmin(req *http.Request, adminID uuid.UUID) *http.Request {
+	ctx := middleware.SetAuthenticatedUser(req.Context(), &middleware.AuthenticatedUser{
+		UserID:   adminID,
+		ClientID: "support-console",
+		Scopes:   []string{"admin"},
+	})
+
+	return req.WithContext(ctx)
+}
+
+func TestAdminNoteHandler(t *testing.T) {
+	t.Parallel()
+
+	adminID, userID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.AdminNoteService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "admin creates note as author",
+			method: http.MethodPost,
+			path:   "/admin/users/" + userID.String() + "/notes",
+			body:   `{"text":"Refund issued","pinned":true}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup: func(m *mocks.AdminNoteService) {
+				m.On("CreateNote", mock.Anything, adminID, userID, &dto.AdminNoteRequest{Text: "Refund issued", Pinned: true}).
+					Return(&dto.AdminNote{NoteID: 1, Text: "Refund issued", Pinned: true}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"noteId":1`,
+		},
+		{
+			name:   "non-admin is forbidden",
+			method: http.MethodGet,
+			path:   "/admin/users/" + userID.String() + "/notes",
+			authorize: func(r *http.Request) *http.Request {
+				return setAuthenticatedUser(r, userID)
+			},
+			mockSetup:      func(*mocks.AdminNoteService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "anonymous is unauthorized",
+			method:         http.MethodGet,
+			path:           "/admin/users/" + userID.String() + "/audit",
+			authorize:      func(r *http.Request) *http.Request { return r },
+			mockSetup:      func(*mocks.AdminNoteService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "invalid note ID",
+			method: http.MethodDelete,
+			path:   "/admin/users/" + userID.String() + "/notes/abc",
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup:      func(*mocks.AdminNoteService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_NOTE_ID",
+		},
+		{
+			name:   "missing note",
+			method: http.MethodDelete,
+			path:   "/admin/users/" + userID.String() + "/notes/7",
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup: func(m *mocks.AdminNoteService) {
+				m.On("DeleteNote", mock.Anything, adminID, userID, int64(7)).Return(service.ErrNoteNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "NOTE_NOT_FOUND",
+		},
+		{
+			name:   "empty text is rejected",
+			method: http.MethodPut,
+			path:   "/admin/users/" + userID.String() + "/notes/7",
+			body:   `{"text":""}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup:      func(*mocks.AdminNoteService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.AdminNoteService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewAdminNoteHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/admin/users/{user_id}/notes", h.ListNotes)
+			r.Post("/admin/users/{user_id}/notes", h.CreateNote)
+			r.Put("/admin/users/{user_id}/notes/{note_id}", h.UpdateNote)
+			r.Delete("/admin/users/{user_id}/notes/{note_id}", h.DeleteNote)
+			r.Get("/admin/users/{user_id}/audit", h.GetAuditTrail)
+
+			req := httptest.NewRequestWithContext(context.Background(), tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// AgeHandler handles birthdates and the admin overrides of minor restrictions.
+type AgeHandler struct {
+	ageService service.AgeService
+	binder     *RequestBinder
+}
+
+// NewAgeHandler creates a new age handler.
+func NewAgeHandler(ageService service.AgeService) *AgeHandler {
+	return &AgeHandler{
+		ageService: ageService,
+		binder:     NewRequestBinder(),
+	}
+}
+
+// GetBirthdate handles GET /users/account/birthdate.
+func (h *AgeHandler) GetBirthdate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepareSelf(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.ageService.GetAgeStatus(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// SetBirthdate handles PUT /users/account/birthdate.
+func (h *AgeHandler) SetBirthdate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepareSelf(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.BirthdateRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	response, err := h.ageService.SetBirthdate(r.Context(), userID, &req)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// GetUserAge handles GET /admin/users/{user_id}/age.
+func (h *AgeHandler) GetUserAge(w http.ResponseWriter, r *http.Request) {
+	targetUserID, ok := h.prepareAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.ageService.GetAgeStatus(r.Context(), targetUserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// SetAgeOverride handles PUT /admin/users/{user_id}/age/override.
+func (h *AgeHandler) SetAgeOverride(w http.ResponseWriter, r *http.Request) {
+	targetUserID, ok := h.prepareAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.AgeOverrideRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	response, err := h.ageService.SetAgeOverride(r.Context(), targetUserID, req.Override)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// ClearAgeOverride handles DELETE /admin/users/{user_id}/age/override.
+func (h *AgeHandler) ClearAgeOverride(w http.ResponseWriter, r *http.Request) {
+	targetUserID, ok := h.prepareAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.ageService.SetAgeOverride(r.Context(), targetUserID, "")
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// prepareSelf checks authentication and service availability for the requester's own birthdate.
+func (h *AgeHandler) prepareSelf(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return uuid.Nil, false
+	}
+
+	if h.ageService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// prepareAdmin checks for the admin scope and service availability and returns the target user ID.
+func (h *AgeHandler) prepareAdmin(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	_, targetUserID, ok := adminTarget(w, r)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	if h.ageService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, false
+	}
+
+	return targetUserID, true
+}
+
+func (h *AgeHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrInvalidBirthdate):
+		ErrorResponse(w, http.StatusBadRequest, "INVALID_BIRTHDATE", "Birthdate must be a past date")
+	case errors.Is(err, service.ErrBirthdateAlreadySet):
+		ErrorResponse(w, http.StatusConflict, "BIRTHDATE_ALREADY_SET", "Birthdate can only be set once")
+	default:
+		slog.Error("age service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
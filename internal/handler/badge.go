@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// BadgeHandler handles the badges users earn.
+type BadgeHandler struct {
+	badgeService service.BadgeService
+	binder       *RequestBinder
+}
+
+// NewBadgeHandler creates a new badge handler.
+func NewBadgeHandler(badgeService service.BadgeService) *BadgeHandler {
+	return &BadgeHandler{
+		badgeService: badgeService,
+		binder:       NewRequestBinder(),
+	}
+}
+
+// GetBadges handles GET /users/{user_id}/badges.
+func (h *BadgeHandler) GetBadges(w http.ResponseWriter, r *http.Request) {
+	if h.badgeService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 1. Parse the user
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return
+	}
+
+	// 2. Identify requester (anonymous if not authenticated)
+	requesterID, _ := middleware.GetUserIDFromContext(r.Context())
+
+	// 3. Call service
+	response, err := h.badgeService.GetBadges(r.Context(), requesterID, userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// RecordEvent handles POST /internal/v1/users/{user_id}/events.
+func (h *BadgeHandler) RecordEvent(w http.ResponseWriter, r *http.Request) {
+	// 1. Only service accounts with write scope (or admins) may report events
+	if !canWriteUserData(r) {
+		ForbiddenResponse(w, "Service token with user:write scope required")
+
+		return
+	}
+
+	if h.badgeService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 2. Parse the user and the event
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return
+	}
+
+	var req dto.BadgeEventRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	// 3. Call service; the event is evaluated in the background
+	err = h.badgeService.RecordEvent(r.Context(), userID, req.Type)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *BadgeHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrProfilePrivate):
+		ErrorResponse(w, http.StatusForbidden, "PROFILE_PRIVATE", "Profile is private")
+	default:
+		slog.Error("badge service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
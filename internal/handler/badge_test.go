@@ -0,0 +1,161 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestBadgeHandlerRecordEvent(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	path := "/internal/v1/users/" + userID.String() + "/events"
+
+	tests := []struct {
+		name           string
+		path           string
+		body           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.BadgeService)
+		expectedStatus int
+	}{
+		{
+			name:      "service account reports a recipe",
+			path:      path,
+			body:      `{"type":"recipe_created"}`,
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:write") },
+			mockSetup: func(m *mocks.BadgeService) {
+				m.On("RecordEvent", mock.Anything, userID, dto.BadgeEventRecipeCreated).Return(nil)
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "unknown event",
+			path:           path,
+			body:           `{"type":"recipe_liked"}`,
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "user:write") },
+			mockSetup:      func(_ *mocks.BadgeService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "regular user is forbidden",
+			path:           path,
+			body:           `{"type":"recipe_created"}`,
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			mockSetup:      func(_ *mocks.BadgeService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "invalid user ID",
+			path:           "/internal/v1/users/not-a-uuid/events",
+			body:           `{"type":"recipe_created"}`,
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "user:write") },
+			mockSetup:      func(_ *mocks.BadgeService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewBadgeService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewBadgeHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Post("/internal/v1/users/{user_id}/events", h.RecordEvent)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, tt.path,
+				bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestBadgeHandlerGetBadges(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	earnedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("anonymous requester", func(t *testing.T) {
+		t.Parallel()
+
+		mockSvc := mocks.NewBadgeService(t)
+		mockSvc.On("GetBadges", mock.Anything, uuid.Nil, userID).Return(&dto.UserBadgesResponse{
+			UserID: userID.String(),
+			Badges: []dto.Badge{{BadgeID: "first-recipe", Name: "First Recipe", EarnedAt: earnedAt}},
+		}, nil)
+
+		rr := serveBadges(t, handler.NewBadgeHandler(mockSvc), userID.String())
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"userId":"`+userID.String()+`","badges":[`+
+			`{"badgeId":"first-recipe","name":"First Recipe","earnedAt":"2026-10-01T12:00:00Z"}]}`, rr.Body.String())
+	})
+
+	t.Run("private profile", func(t *testing.T) {
+		t.Parallel()
+
+		mockSvc := mocks.NewBadgeService(t)
+		mockSvc.On("GetBadges", mock.Anything, uuid.Nil, userID).Return(nil, service.ErrProfilePrivate)
+
+		rr := serveBadges(t, handler.NewBadgeHandler(mockSvc), userID.String())
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		t.Parallel()
+
+		mockSvc := mocks.NewBadgeService(t)
+		mockSvc.On("GetBadges", mock.Anything, uuid.Nil, userID).Return(nil, service.ErrUserNotFound)
+
+		rr := serveBadges(t, handler.NewBadgeHandler(mockSvc), userID.String())
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("badges unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		rr := serveBadges(t, handler.NewBadgeHandler(nil), userID.String())
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+}
+
+func serveBadges(t *testing.T, h *handler.BadgeHandler, userID string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	r := chi.NewRouter()
+	r.Get("/users/{user_id}/badges", h.GetBadges)
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/users/"+userID+"/badges", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	return rr
+}
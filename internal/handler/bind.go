@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/go-chi/chi/v5"
+
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
 )
 
@@ -60,8 +63,11 @@ func (b *RequestBinder) BindJSON(r *http.Request, target any) error {
 
 		var unmarshalErr *json.UnmarshalTypeError
 		if errors.As(err, &unmarshalErr) {
-			return fmt.Errorf("%w for field %s: expected %s",
-				ErrInvalidFieldType, unmarshalErr.Field, unmarshalErr.Type.String())
+			return &fieldError{
+				cause: fmt.Errorf("%w for field %s: expected %s",
+					ErrInvalidFieldType, unmarshalErr.Field, unmarshalErr.Type.String()),
+				field: validation.Invalid(unmarshalErr.Field, "type", unmarshalErr.Type.String(), nil),
+			}
 		}
 
 		if strings.HasPrefix(err.Error(), "json: unknown field") {
@@ -93,3 +99,66 @@ func (b *RequestBinder) BindAndValidate(r *http.Request, target any) error {
 
 	return b.Validate(target)
 }
+
+// fieldError is a body, query or path field that could not be read. It reads as its cause, so
+// the message stays the same, and carries the field details for the response.
+type fieldError struct {
+	cause error
+	field validation.ValidationError
+}
+
+func (e *fieldError) Error() string {
+	return e.cause.Error()
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.cause
+}
+
+// invalidQuery wraps cause with the details of the query parameter that broke rule.
+func invalidQuery(name, rule, param, value string, cause error) error {
+	return &fieldError{cause: cause, field: validation.Invalid(name, rule, param, value)}
+}
+
+// invalidRange wraps cause with the details of the query parameter n, outside low to high.
+func invalidRange(name, value string, n, low, high int, cause error) error {
+	if n < low {
+		return invalidQuery(name, "gte", strconv.Itoa(low), value, cause)
+	}
+
+	return invalidQuery(name, "lte", strconv.Itoa(high), value, cause)
+}
+
+// fieldsOf returns the field details carried by err, if any.
+func fieldsOf(err error) []validation.ValidationError {
+	var fieldErr *fieldError
+	if errors.As(err, &fieldErr) {
+		return []validation.ValidationError{fieldErr.field}
+	}
+
+	return nil
+}
+
+// queryErrorResponse writes the 400 response for query parameters that failed to parse.
+func queryErrorResponse(w http.ResponseWriter, err error) {
+	FieldErrorResponse(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), fieldsOf(err)...)
+}
+
+// pathErrorResponse writes the response for a path parameter that broke rule.
+func pathErrorResponse(w http.ResponseWriter, r *http.Request, status int, code, message, name, rule string) {
+	FieldErrorResponse(w, status, code, message, validation.Invalid(name, rule, "", chi.URLParam(r, name)))
+}
+
+// respondBindError writes the error response for a failed BindAndValidate call.
+func respondBindError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrEmptyBody):
+		ErrorResponse(w, http.StatusBadRequest, "EMPTY_BODY", "Request body is required")
+	case errors.Is(err, ErrInvalidJSON), errors.Is(err, ErrInvalidFieldType):
+		FieldErrorResponse(w, http.StatusBadRequest, "INVALID_JSON", err.Error(), fieldsOf(err)...)
+	case errors.Is(err, ErrValidationFailed):
+		ValidationErrorResponse(w, err)
+	default:
+		ErrorResponse(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+	}
+}
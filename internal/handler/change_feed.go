@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// Change feed pagination constants.
+const (
+	defaultChangeFeedLimit = 100
+	maxChangeFeedLimit     = 1000
+)
+
+// Change feed parameter validation errors.
+var (
+	ErrInvalidCursor          = errors.New("since must be a valid non-negative cursor")
+	ErrChangeFeedLimitInvalid = errors.New("limit must be between 1 and 1000")
+)
+
+// ChangeFeedHandler handles internal replication endpoints.
+type ChangeFeedHandler struct {
+	changeFeedService service.ChangeFeedService
+}
+
+// NewChangeFeedHandler creates a new change feed handler.
+func NewChangeFeedHandler(changeFeedService service.ChangeFeedService) *ChangeFeedHandler {
+	return &ChangeFeedHandler{
+		changeFeedService: changeFeedService,
+	}
+}
+
+// GetUserChanges handles GET /internal/v1/users/changes.
+func (h *ChangeFeedHandler) GetUserChanges(w http.ResponseWriter, r *http.Request) {
+	// 1. Only service accounts with read scope (or admins) may consume the feed
+	if !canReadUserData(r) {
+		ForbiddenResponse(w, "Service token with user:read scope required")
+
+		return
+	}
+
+	// 2. Parse query parameters
+	since, limit, err := h.parseChangeFeedParams(r)
+	if err != nil {
+		queryErrorResponse(w, err)
+
+		return
+	}
+
+	// 3. Call service
+	response, err := h.changeFeedService.GetUserChanges(r.Context(), since, limit)
+	if err != nil {
+		slog.Error("failed to get user changes", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// canReadUserData reports whether the requester is a service account with the user:read scope, or
+// an admin, as required by the internal service-to-service endpoints.
+func canReadUserData(r *http.Request) bool {
+	authUser, ok := middleware.GetAuthenticatedUser(r.Context())
+	if !ok {
+		return false
+	}
+
+	if middleware.IsAdmin(r.Context()) {
+		return true
+	}
+
+	return authUser.IsService && middleware.HasScope(r.Context(), scopeUserRead)
+}
+
+func (h *ChangeFeedHandler) parseChangeFeedParams(r *http.Request) (int64, int, error) {
+	var since int64
+
+	if sinceStr := r.URL.Query().Get("since"); sinceStr != "" {
+		parsed, err := strconv.ParseInt(sinceStr, 10, 64)
+		if err != nil || parsed < 0 {
+			return 0, 0, invalidQuery("since", "gte", "0", sinceStr, ErrInvalidCursor)
+		}
+
+		since = parsed
+	}
+
+	limit := defaultChangeFeedLimit
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return 0, 0, invalidQuery("limit", "integer", "", limitStr, ErrChangeFeedLimitInvalid)
+		}
+
+		if parsed < minLimit || parsed > maxChangeFeedLimit {
+			return 0, 0, invalidRange("limit", limitStr, parsed, minLimit, maxChangeFeedLimit, ErrChangeFeedLimitInvalid)
+		}
+
+		limit = parsed
+	}
+
+	return since, limit, nil
+}
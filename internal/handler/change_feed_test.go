@@ -0,0 +1,117 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+)
+
+func withServiceAccount(req *http.Request, scopes ...string) *http.Request {
+	ctx := middleware.SetAuthenticatedUser(req.Context(), &middleware.AuthenticatedUser{
+		UserID:    uuid.Nil,
+		ClientID:  "analytics-service",
+		Scopes:    scopes,
+		IsService: true,
+	})
+
+	return req.WithContext(ctx)
+}
+
+func TestChangeFeedHandlerGetUserChanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		query          string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.ChangeFeedService)
+		expectedStatus int
+	}{
+		{
+			name:      "service account reads feed with defaults",
+			query:     "",
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup: func(m *mocks.ChangeFeedService) {
+				m.On("GetUserChanges", mock.Anything, int64(0), 100).
+					Return(&dto.UserChangesResponse{Changes: []dto.UserChange{}, NextCursor: "0"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "custom cursor and limit",
+			query:     "?since=250&limit=50",
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup: func(m *mocks.ChangeFeedService) {
+				m.On("GetUserChanges", mock.Anything, int64(250), 50).
+					Return(&dto.UserChangesResponse{Changes: []dto.UserChange{}, NextCursor: "250"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "regular user is forbidden",
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, uuid.New()) },
+			mockSetup:      func(_ *mocks.ChangeFeedService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "service account without scope is forbidden",
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "notification:admin") },
+			mockSetup:      func(_ *mocks.ChangeFeedService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "invalid cursor",
+			query:          "?since=abc",
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup:      func(_ *mocks.ChangeFeedService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "limit out of range",
+			query:          "?limit=5000",
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup:      func(_ *mocks.ChangeFeedService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "service error",
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup: func(m *mocks.ChangeFeedService) {
+				m.On("GetUserChanges", mock.Anything, int64(0), 100).Return(nil, errDB)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.ChangeFeedService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewChangeFeedHandler(mockSvc)
+
+			req := httptest.NewRequestWithContext(
+				context.Background(), http.MethodGet, "/internal/v1/users/changes"+tt.query, nil,
+			)
+			req = tt.authorize(req)
+
+			rr := httptest.NewRecorder()
+			h.GetUserChanges(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
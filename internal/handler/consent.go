@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// ConsentHandler handles the consent ledger endpoints.
+type ConsentHandler struct {
+	consentService service.ConsentService
+	binder         *RequestBinder
+}
+
+// NewConsentHandler creates a new consent handler.
+func NewConsentHandler(consentService service.ConsentService) *ConsentHandler {
+	return &ConsentHandler{
+		consentService: consentService,
+		binder:         NewRequestBinder(),
+	}
+}
+
+// GetConsents handles GET /users/{user_id}/consents.
+func (h *ConsentHandler) GetConsents(w http.ResponseWriter, r *http.Request) {
+	targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	requesterID, isAdmin, hasServiceScope := requesterScopes(r)
+
+	response, err := h.consentService.GetConsents(r.Context(), requesterID, targetUserID, isAdmin, hasServiceScope)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// GetConsentHistory handles GET /users/{user_id}/consents/history.
+func (h *ConsentHandler) GetConsentHistory(w http.ResponseWriter, r *http.Request) {
+	targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	requesterID, isAdmin, hasServiceScope := requesterScopes(r)
+
+	response, err := h.consentService.GetConsentHistory(
+		r.Context(),
+		requesterID,
+		targetUserID,
+		isAdmin,
+		hasServiceScope,
+	)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// RecordConsent handles POST /users/{user_id}/consents.
+func (h *ConsentHandler) RecordConsent(w http.ResponseWriter, r *http.Request) {
+	targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.ConsentRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	requesterID, isAdmin, hasServiceScope := requesterScopes(r)
+
+	record, err := h.consentService.RecordConsent(
+		r.Context(),
+		requesterID,
+		targetUserID,
+		&req,
+		isAdmin,
+		hasServiceScope,
+	)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusCreated, record)
+}
+
+// prepare checks authentication and service availability and parses the target user ID.
+func (h *ConsentHandler) prepare(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	if _, ok := middleware.GetAuthenticatedUser(r.Context()); !ok {
+		UnauthorizedResponse(w, "Authentication required")
+
+		return uuid.Nil, false
+	}
+
+	if h.consentService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, false
+	}
+
+	targetUserID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return uuid.Nil, false
+	}
+
+	return targetUserID, true
+}
+
+func (h *ConsentHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrUnauthorizedAccess):
+		ForbiddenResponse(w, "Not authorized to access these consents")
+	case errors.Is(err, service.ErrAgeRestricted):
+		ErrorResponse(w, http.StatusForbidden, "AGE_RESTRICTED", "Minors cannot consent to marketing emails")
+	default:
+		slog.Error("consent service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
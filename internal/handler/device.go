@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// DeviceHandler handles the devices users register for push notifications.
+type DeviceHandler struct {
+	deviceService service.DeviceTokenService
+	binder        *RequestBinder
+}
+
+// NewDeviceHandler creates a new device handler.
+func NewDeviceHandler(deviceService service.DeviceTokenService) *DeviceHandler {
+	return &DeviceHandler{
+		deviceService: deviceService,
+		binder:        NewRequestBinder(),
+	}
+}
+
+// RegisterDevice handles POST /users/account/devices.
+func (h *DeviceHandler) RegisterDevice(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepareSelf(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.DeviceTokenRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	device, err := h.deviceService.RegisterDevice(r.Context(), userID, &req)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusCreated, device)
+}
+
+// ListDevices handles GET /users/account/devices.
+func (h *DeviceHandler) ListDevices(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepareSelf(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.deviceService.ListDevices(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// UnregisterDevice handles DELETE /users/account/devices/{device_id}.
+func (h *DeviceHandler) UnregisterDevice(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepareSelf(w, r)
+	if !ok {
+		return
+	}
+
+	deviceID, err := strconv.ParseInt(chi.URLParam(r, "device_id"), 10, 64)
+	if err != nil || deviceID <= 0 {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_DEVICE_ID", "Invalid device ID format",
+			"device_id", "integer")
+
+		return
+	}
+
+	err = h.deviceService.UnregisterDevice(r.Context(), userID, deviceID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetPushTargets handles GET /internal/v1/users/{user_id}/push-tokens.
+func (h *DeviceHandler) GetPushTargets(w http.ResponseWriter, r *http.Request) {
+	// 1. Only service accounts with read scope (or admins) may fetch tokens
+	if !canReadUserData(r) {
+		ForbiddenResponse(w, "Service token with user:read scope required")
+
+		return
+	}
+
+	if h.deviceService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 2. Parse the target user
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return
+	}
+
+	// 3. Call service
+	response, err := h.deviceService.GetPushTargets(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// prepareSelf checks authentication and service availability for the requester's own devices.
+func (h *DeviceHandler) prepareSelf(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return uuid.Nil, false
+	}
+
+	if h.deviceService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func (h *DeviceHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrDeviceNotFound):
+		ErrorResponse(w, http.StatusNotFound, "DEVICE_NOT_FOUND", "Device not found")
+	default:
+		slog.Error("device service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
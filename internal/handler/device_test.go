@@ -0,0 +1,90 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestDeviceHandlerGetPushTargets(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		path           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.DeviceTokenService)
+		expectedStatus int
+	}{
+		{
+			name:      "service account fetches tokens",
+			path:      "/internal/v1/users/" + userID.String() + "/push-tokens",
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup: func(m *mocks.DeviceTokenService) {
+				m.On("GetPushTargets", mock.Anything, userID).Return(&dto.PushTargetsResponse{
+					UserID:      userID.String(),
+					PushEnabled: true,
+					Devices:     []dto.DeviceToken{{DeviceID: 1, Platform: dto.DevicePlatformIOS, Token: "t"}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "regular user is forbidden",
+			path:           "/internal/v1/users/" + userID.String() + "/push-tokens",
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			mockSetup:      func(_ *mocks.DeviceTokenService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "invalid user ID",
+			path:           "/internal/v1/users/not-a-uuid/push-tokens",
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup:      func(_ *mocks.DeviceTokenService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "unknown user",
+			path:      "/internal/v1/users/" + userID.String() + "/push-tokens",
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup: func(m *mocks.DeviceTokenService) {
+				m.On("GetPushTargets", mock.Anything, userID).Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.DeviceTokenService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewDeviceHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/internal/v1/users/{user_id}/push-tokens", h.GetPushTargets)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"net/http"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// goroutineDumpDebug selects pprof's full stack format, matching a panic's goroutine dump.
+const goroutineDumpDebug = 2
+
+// DiagnosticsHandler handles runtime diagnostics HTTP endpoints.
+type DiagnosticsHandler struct{}
+
+// NewDiagnosticsHandler creates a new diagnostics handler.
+func NewDiagnosticsHandler() *DiagnosticsHandler {
+	return &DiagnosticsHandler{}
+}
+
+// GetRuntimeStats handles GET /debug/runtime.
+func (h *DiagnosticsHandler) GetRuntimeStats(w http.ResponseWriter, _ *http.Request) {
+	var mem runtime.MemStats
+
+	runtime.ReadMemStats(&mem)
+
+	gc := dto.GCStats{
+		NumGC:           mem.NumGC,
+		PauseTotalMs:    durationMs(time.Duration(mem.PauseTotalNs)), //nolint:gosec // fits in int64
+		NextGCHeapBytes: mem.NextGC,
+		CPUFraction:     mem.GCCPUFraction,
+	}
+
+	if mem.NumGC > 0 {
+		lastGC := time.Unix(0, int64(mem.LastGC)) //nolint:gosec // nanoseconds since epoch fit in int64
+		gc.LastGC = &lastGC
+		gc.LastPauseMs = durationMs(time.Duration(mem.PauseNs[(mem.NumGC+255)%256])) //nolint:gosec,mnd // ring buffer
+	}
+
+	SuccessResponse(w, http.StatusOK, dto.RuntimeStatsResponse{
+		Timestamp:  time.Now(),
+		GoVersion:  runtime.Version(),
+		NumCPU:     runtime.NumCPU(),
+		GoMaxProcs: runtime.GOMAXPROCS(0),
+		Goroutines: runtime.NumGoroutine(),
+		Heap: dto.HeapStats{
+			AllocBytes:    mem.HeapAlloc,
+			InUseBytes:    mem.HeapInuse,
+			IdleBytes:     mem.HeapIdle,
+			ReleasedBytes: mem.HeapReleased,
+			SysBytes:      mem.HeapSys,
+			Objects:       mem.HeapObjects,
+		},
+		GC: gc,
+	})
+}
+
+// GetGoroutineDump handles GET /debug/goroutines, writing every goroutine's stack as plain text.
+func (h *DiagnosticsHandler) GetGoroutineDump(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	_ = pprof.Lookup("goroutine").WriteTo(w, goroutineDumpDebug)
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
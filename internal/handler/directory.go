@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// DirectoryHandler handles the links between user accounts and LDAP directory entries.
+type DirectoryHandler struct {
+	directoryService service.DirectorySyncService
+	binder           *RequestBinder
+}
+
+// NewDirectoryHandler creates a new directory handler.
+func NewDirectoryHandler(directoryService service.DirectorySyncService) *DirectoryHandler {
+	return &DirectoryHandler{
+		directoryService: directoryService,
+		binder:           NewRequestBinder(),
+	}
+}
+
+// GetLink handles GET /admin/users/{user_id}/directory.
+func (h *DirectoryHandler) GetLink(w http.ResponseWriter, r *http.Request) {
+	_, targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	link, err := h.directoryService.GetLink(r.Context(), targetUserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, link)
+}
+
+// LinkUser handles PUT /admin/users/{user_id}/directory.
+func (h *DirectoryHandler) LinkUser(w http.ResponseWriter, r *http.Request) {
+	actorID, targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.DirectoryLinkRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	link, err := h.directoryService.LinkUser(r.Context(), actorID, targetUserID, req.ExternalID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, link)
+}
+
+// UnlinkUser handles DELETE /admin/users/{user_id}/directory.
+func (h *DirectoryHandler) UnlinkUser(w http.ResponseWriter, r *http.Request) {
+	actorID, targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.directoryService.UnlinkUser(r.Context(), actorID, targetUserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SyncUser handles POST /admin/users/{user_id}/directory/sync.
+func (h *DirectoryHandler) SyncUser(w http.ResponseWriter, r *http.Request) {
+	_, targetUserID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	link, err := h.directoryService.SyncUser(r.Context(), targetUserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, link)
+}
+
+// prepare checks for the admin scope and service availability and returns the requester and
+// target user IDs.
+func (h *DirectoryHandler) prepare(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	actorID, targetUserID, ok := adminTarget(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if h.directoryService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return actorID, targetUserID, true
+}
+
+func (h *DirectoryHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrDirectoryLinkNotFound):
+		ErrorResponse(w, http.StatusNotFound, "DIRECTORY_LINK_NOT_FOUND", "User is not linked to a directory entry")
+	case errors.Is(err, service.ErrExternalIDLinked):
+		ErrorResponse(w, http.StatusConflict, "EXTERNAL_ID_LINKED", "External ID is linked to another user")
+	case errors.Is(err, service.ErrDirectoryUnavailable):
+		ServiceUnavailableResponse(w, "Directory sync is not configured")
+	default:
+		slog.Error("directory sync service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
@@ -0,0 +1,144 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestDirectoryHandler(t *testing.T) {
+	t.Parallel()
+
+	adminID, userID := uuid.New(), uuid.New()
+	path := "/admin/users/" + userID.String() + "/directory"
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.DirectorySyncService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "admin links account",
+			method: http.MethodPut,
+			path:   path,
+			body:   `{"externalId":"ada"}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup: func(m *mocks.DirectorySyncService) {
+				m.On("LinkUser", mock.Anything, adminID, userID, "ada").Return(&dto.DirectoryLink{
+					UserID: userID.String(), ExternalID: "ada", Status: dto.DirectorySyncPending,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"status":"PENDING"`,
+		},
+		{
+			name:   "external ID taken",
+			method: http.MethodPut,
+			path:   path,
+			body:   `{"externalId":"ada"}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup: func(m *mocks.DirectorySyncService) {
+				m.On("LinkUser", mock.Anything, adminID, userID, "ada").Return(nil, service.ErrExternalIDLinked)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "EXTERNAL_ID_LINKED",
+		},
+		{
+			name:   "missing external ID",
+			method: http.MethodPut,
+			path:   path,
+			body:   `{}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup:      func(*mocks.DirectorySyncService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "unlinked account",
+			method: http.MethodGet,
+			path:   path,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup: func(m *mocks.DirectorySyncService) {
+				m.On("GetLink", mock.Anything, userID).Return(nil, service.ErrDirectoryLinkNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "DIRECTORY_LINK_NOT_FOUND",
+		},
+		{
+			name:   "sync without a directory",
+			method: http.MethodPost,
+			path:   path + "/sync",
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup: func(m *mocks.DirectorySyncService) {
+				m.On("SyncUser", mock.Anything, userID).Return(nil, service.ErrDirectoryUnavailable)
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "non-admin is forbidden",
+			method: http.MethodDelete,
+			path:   path,
+			authorize: func(r *http.Request) *http.Request {
+				return setAuthenticatedUser(r, userID)
+			},
+			mockSetup:      func(*mocks.DirectorySyncService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.DirectorySyncService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewDirectoryHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/admin/users/{user_id}/directory", h.GetLink)
+			r.Put("/admin/users/{user_id}/directory", h.LinkUser)
+			r.Delete("/admin/users/{user_id}/directory", h.UnlinkUser)
+			r.Post("/admin/users/{user_id}/directory/sync", h.SyncUser)
+
+			req := httptest.NewRequestWithContext(context.Background(), tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
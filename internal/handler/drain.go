@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// DrainHandler takes the instance out of rotation ahead of a shutdown.
+type DrainHandler struct {
+	drainService service.DrainService
+}
+
+// NewDrainHandler creates a new drain handler.
+func NewDrainHandler(drainService service.DrainService) *DrainHandler {
+	return &DrainHandler{drainService: drainService}
+}
+
+// GetDrain handles GET /admin/drain.
+func (h *DrainHandler) GetDrain(w http.ResponseWriter, r *http.Request) {
+	if _, ok := adminRequester(w, r); !ok || !h.available(w) {
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, h.drainService.GetDrainStatus(r.Context()))
+}
+
+// StartDrain handles POST /admin/drain. Readiness fails from now on and the instance shuts down
+// once the drain period has passed.
+func (h *DrainHandler) StartDrain(w http.ResponseWriter, r *http.Request) {
+	actorID, ok := adminRequester(w, r)
+	if !ok || !h.available(w) {
+		return
+	}
+
+	slog.InfoContext(r.Context(), "drain requested", "actor_id", actorID)
+
+	SuccessResponse(w, http.StatusAccepted, h.drainService.StartDrain(r.Context(), service.DrainTriggerAdmin))
+}
+
+func (h *DrainHandler) available(w http.ResponseWriter) bool {
+	if h.drainService == nil {
+		ServiceUnavailableResponse(w, "Draining is not available")
+
+		return false
+	}
+
+	return true
+}
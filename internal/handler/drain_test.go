@@ -0,0 +1,87 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestDrainHandler(t *testing.T) {
+	t.Parallel()
+
+	adminID, userID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name           string
+		method         string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.DrainService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:      "admin starts draining",
+			method:    http.MethodPost,
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.DrainService) {
+				m.On("StartDrain", mock.Anything, service.DrainTriggerAdmin).
+					Return(&dto.DrainStatus{Draining: true, Trigger: service.DrainTriggerAdmin})
+			},
+			expectedStatus: http.StatusAccepted,
+			expectedBody:   `"draining":true`,
+		},
+		{
+			name:      "admin reads the drain status",
+			method:    http.MethodGet,
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.DrainService) {
+				m.On("GetDrainStatus", mock.Anything).Return(&dto.DrainStatus{})
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"draining":false`,
+		},
+		{
+			name:           "non-admin is forbidden",
+			method:         http.MethodPost,
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			mockSetup:      func(*mocks.DrainService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewDrainService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewDrainHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/admin/drain", h.GetDrain)
+			r.Post("/admin/drain", h.StartDrain)
+
+			req := httptest.NewRequestWithContext(context.Background(), tt.method, "/admin/drain", nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
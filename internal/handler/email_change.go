@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// EmailChangeHandler handles dual-confirmation email change endpoints.
+type EmailChangeHandler struct {
+	emailChangeService service.EmailChangeService
+	binder             *RequestBinder
+}
+
+// NewEmailChangeHandler creates a new email change handler.
+func NewEmailChangeHandler(emailChangeService service.EmailChangeService) *EmailChangeHandler {
+	return &EmailChangeHandler{
+		emailChangeService: emailChangeService,
+		binder:             NewRequestBinder(),
+	}
+}
+
+// RequestEmailChange handles POST /users/account/email-change.
+func (h *EmailChangeHandler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	// 1. Require authentication
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	// 2. Bind and validate request body
+	var req dto.EmailChangeRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	// 3. Start the change
+	response, err := h.emailChangeService.RequestEmailChange(r.Context(), userID, req.NewEmail)
+	if err != nil {
+		h.handleEmailChangeError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusAccepted, response)
+}
+
+// ConfirmOldEmail handles POST /users/account/email-change/confirm-old.
+func (h *EmailChangeHandler) ConfirmOldEmail(w http.ResponseWriter, r *http.Request) {
+	h.confirm(w, r, service.EmailChangeSideOld)
+}
+
+// ConfirmNewEmail handles POST /users/account/email-change/confirm-new.
+func (h *EmailChangeHandler) ConfirmNewEmail(w http.ResponseWriter, r *http.Request) {
+	h.confirm(w, r, service.EmailChangeSideNew)
+}
+
+func (h *EmailChangeHandler) confirm(w http.ResponseWriter, r *http.Request, side service.EmailChangeSide) {
+	// 1. Require authentication
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	// 2. Bind and validate request body
+	var req dto.EmailChangeConfirmRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	// 3. Confirm this side of the change
+	response, err := h.emailChangeService.ConfirmEmailChange(r.Context(), userID, side, req.ConfirmationToken)
+	if err != nil {
+		h.handleEmailChangeError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+func (h *EmailChangeHandler) handleEmailChangeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidToken):
+		ErrorResponse(w, http.StatusBadRequest, "INVALID_TOKEN", "Invalid or expired confirmation token")
+	case errors.Is(err, service.ErrEmailUnchanged):
+		ErrorResponse(w, http.StatusBadRequest, "EMAIL_UNCHANGED", "New email matches the current email")
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrDuplicateEmail):
+		ErrorResponse(w, http.StatusConflict, "DUPLICATE_EMAIL", "Email already in use")
+	case errors.Is(err, service.ErrEmailRetired):
+		ErrorResponse(w, http.StatusConflict, "EMAIL_RETIRED",
+			"Email belongs to a recently deactivated account and is not yet available")
+	case errors.Is(err, service.ErrChangeApprovalRequired):
+		changeApprovalRequiredResponse(w)
+	case errors.Is(err, service.ErrCacheUnavailable):
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+	default:
+		slog.Error("failed to process email change", "error", err)
+		InternalErrorResponse(w)
+	}
+}
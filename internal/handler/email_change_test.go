@@ -0,0 +1,126 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestEmailChangeHandler(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		path           string
+		body           string
+		authenticated  bool
+		mockSetup      func(*mocks.EmailChangeService)
+		expectedStatus int
+	}{
+		{
+			name:          "request accepted",
+			path:          "/users/account/email-change",
+			body:          `{"newEmail": "new@example.com"}`,
+			authenticated: true,
+			mockSetup: func(m *mocks.EmailChangeService) {
+				m.On("RequestEmailChange", mock.Anything, userID, "new@example.com").
+					Return(&dto.EmailChangeRequestResponse{UserID: userID.String()}, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "request with invalid email",
+			path:           "/users/account/email-change",
+			body:           `{"newEmail": "not-an-email"}`,
+			authenticated:  true,
+			mockSetup:      func(_ *mocks.EmailChangeService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "request unauthenticated",
+			path:           "/users/account/email-change",
+			body:           `{"newEmail": "new@example.com"}`,
+			mockSetup:      func(_ *mocks.EmailChangeService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "confirm old side",
+			path:          "/users/account/email-change/confirm-old",
+			body:          `{"confirmationToken": "abc"}`,
+			authenticated: true,
+			mockSetup: func(m *mocks.EmailChangeService) {
+				m.On("ConfirmEmailChange", mock.Anything, userID, service.EmailChangeSideOld, "abc").
+					Return(&dto.EmailChangeStatusResponse{UserID: userID.String(), OldEmailConfirmed: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:          "confirm new side with expired token",
+			path:          "/users/account/email-change/confirm-new",
+			body:          `{"confirmationToken": "abc"}`,
+			authenticated: true,
+			mockSetup: func(m *mocks.EmailChangeService) {
+				m.On("ConfirmEmailChange", mock.Anything, userID, service.EmailChangeSideNew, "abc").
+					Return(nil, service.ErrInvalidToken)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:          "confirm new side with retired email",
+			path:          "/users/account/email-change/confirm-new",
+			body:          `{"confirmationToken": "abc"}`,
+			authenticated: true,
+			mockSetup: func(m *mocks.EmailChangeService) {
+				m.On("ConfirmEmailChange", mock.Anything, userID, service.EmailChangeSideNew, "abc").
+					Return(nil, service.ErrEmailRetired)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.EmailChangeService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewEmailChangeHandler(mockSvc)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, tt.path,
+				strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			if tt.authenticated {
+				req = setAuthenticatedUser(req, userID)
+			}
+
+			rr := httptest.NewRecorder()
+
+			switch {
+			case strings.HasSuffix(tt.path, "confirm-old"):
+				h.ConfirmOldEmail(rr, req)
+			case strings.HasSuffix(tt.path, "confirm-new"):
+				h.ConfirmNewEmail(rr, req)
+			default:
+				h.RequestEmailChange(rr, req)
+			}
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// EmbedHandler serves the link preview metadata of public profiles.
+type EmbedHandler struct {
+	embedService service.EmbedService
+	maxAge       time.Duration
+}
+
+// NewEmbedHandler creates a new embed handler. maxAge is how long crawlers may reuse an embed;
+// zero marks embeds uncacheable.
+func NewEmbedHandler(embedService service.EmbedService, maxAge time.Duration) *EmbedHandler {
+	return &EmbedHandler{
+		embedService: embedService,
+		maxAge:       maxAge,
+	}
+}
+
+// GetProfileEmbed handles GET /users/{user_id}/embed. Anyone may read the embed of a public
+// profile; every other profile looks missing.
+func (h *EmbedHandler) GetProfileEmbed(w http.ResponseWriter, r *http.Request) {
+	if h.embedService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 1. Parse the user
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return
+	}
+
+	// 2. Call service
+	response, err := h.embedService.GetProfileEmbed(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+
+			return
+		}
+
+		slog.Error("failed to build profile embed", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	// The embed is the same for every viewer, so shared caches may keep it too
+	if h.maxAge > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.maxAge.Seconds())))
+	} else {
+		w.Header().Set("Cache-Control", "no-store")
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
@@ -0,0 +1,92 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestEmbedHandlerGetProfileEmbed(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name                 string
+		userID               string
+		maxAge               time.Duration
+		mockSetup            func(*mocks.EmbedService)
+		expectedStatus       int
+		expectedCacheControl string
+	}{
+		{
+			name:   "public profile",
+			userID: userID.String(),
+			maxAge: 5 * time.Minute,
+			mockSetup: func(m *mocks.EmbedService) {
+				m.On("GetProfileEmbed", mock.Anything, userID).
+					Return(&dto.ProfileEmbedResponse{UserID: userID.String(), Username: "alice", Title: "@alice"}, nil)
+			},
+			expectedStatus:       http.StatusOK,
+			expectedCacheControl: "public, max-age=300",
+		},
+		{
+			name:   "caching disabled",
+			userID: userID.String(),
+			mockSetup: func(m *mocks.EmbedService) {
+				m.On("GetProfileEmbed", mock.Anything, userID).
+					Return(&dto.ProfileEmbedResponse{UserID: userID.String(), Username: "alice", Title: "@alice"}, nil)
+			},
+			expectedStatus:       http.StatusOK,
+			expectedCacheControl: "no-store",
+		},
+		{
+			name:   "non-public profile",
+			userID: userID.String(),
+			maxAge: 5 * time.Minute,
+			mockSetup: func(m *mocks.EmbedService) {
+				m.On("GetProfileEmbed", mock.Anything, userID).Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid user ID",
+			userID:         "not-a-uuid",
+			mockSetup:      func(_ *mocks.EmbedService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewEmbedService(t)
+			tt.mockSetup(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/users/{user_id}/embed", handler.NewEmbedHandler(mockSvc, tt.maxAge).GetProfileEmbed)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/users/"+tt.userID+"/embed", nil)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expectedCacheControl, rr.Header().Get("Cache-Control"))
+		})
+	}
+}
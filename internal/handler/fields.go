@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// fieldsParam is the query parameter selecting a sparse fieldset, e.g. ?fields=userId,username.
+const fieldsParam = "fields"
+
+// ErrUnknownField indicates a fields parameter naming a field the resource does not have.
+var ErrUnknownField = errors.New("unknown field")
+
+// parseFields returns the JSON field names requested by the fields query parameter, validated
+// against the resource type of response, or nil when no fieldset was requested. For collection
+// responses the names refer to the items, not the envelope.
+func parseFields(r *http.Request, response any) ([]string, error) {
+	raw := r.URL.Query().Get(fieldsParam)
+	if raw == "" {
+		return nil, nil
+	}
+
+	resource := resourceType(reflect.TypeOf(response))
+	known := jsonFields(resource)
+
+	var fields []string
+
+	for name := range strings.SplitSeq(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if !known[name] {
+			return nil, invalidQuery(fieldsParam, "oneof", strings.Join(slices.Sorted(maps.Keys(known)), ", "), name,
+				fmt.Errorf("%w: %s", ErrUnknownField, name))
+		}
+
+		fields = append(fields, name)
+	}
+
+	return fields, nil
+}
+
+// projectFields reduces data to the given fields before serialization. A collection response
+// keeps its envelope (counts and paging) and has each item projected. Field order and JSON
+// options such as omitempty are preserved. Empty fields returns data unchanged.
+func projectFields(data any, fields []string) any {
+	if len(fields) == 0 {
+		return data
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(data))
+	if v.Kind() != reflect.Struct {
+		return data
+	}
+
+	if i, ok := itemsField(v.Type()); ok {
+		return projectEnvelope(v, i, fields).Interface()
+	}
+
+	return newProjection(v.Type(), fields).apply(v).Interface()
+}
+
+// projection maps a struct type onto a generated type holding only the selected fields.
+type projection struct {
+	typ   reflect.Type
+	index []int
+}
+
+func newProjection(t reflect.Type, fields []string) projection {
+	selected := make(map[string]bool, len(fields))
+	for _, name := range fields {
+		selected[name] = true
+	}
+
+	var (
+		structFields []reflect.StructField
+		index        []int
+	)
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if name, ok := jsonName(f); ok && selected[name] {
+			structFields = append(structFields, reflect.StructField{Name: f.Name, Type: f.Type, Tag: f.Tag})
+			index = append(index, i)
+		}
+	}
+
+	return projection{typ: reflect.StructOf(structFields), index: index}
+}
+
+func (p projection) apply(v reflect.Value) reflect.Value {
+	out := reflect.New(p.typ).Elem()
+	for j, i := range p.index {
+		out.Field(j).Set(v.Field(i))
+	}
+
+	return out
+}
+
+// projectEnvelope copies the envelope fields of v and projects each element of its items field.
+func projectEnvelope(v reflect.Value, items int, fields []string) reflect.Value {
+	t := v.Type()
+	item := newProjection(t.Field(items).Type.Elem(), fields)
+
+	structFields := make([]reflect.StructField, 0, t.NumField())
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		field := reflect.StructField{Name: f.Name, Type: f.Type, Tag: f.Tag}
+		if i == items {
+			field.Type = reflect.SliceOf(item.typ)
+		}
+
+		structFields = append(structFields, field)
+	}
+
+	out := reflect.New(reflect.StructOf(structFields)).Elem()
+
+	for i, j := 0, 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+
+		src := v.Field(i)
+		if i != items {
+			out.Field(j).Set(src)
+		} else if !src.IsNil() {
+			projected := reflect.MakeSlice(out.Field(j).Type(), src.Len(), src.Len())
+			for k := range src.Len() {
+				projected.Index(k).Set(item.apply(src.Index(k)))
+			}
+
+			out.Field(j).Set(projected)
+		}
+
+		j++
+	}
+
+	return out
+}
+
+// resourceType returns the item type of a collection response, or the response's own struct type.
+func resourceType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if i, ok := itemsField(t); ok {
+		return t.Field(i).Type.Elem()
+	}
+
+	return t
+}
+
+// itemsField returns the index of the item slice of a collection response: a struct with a
+// totalCount and a single exported slice-of-struct field. Resources holding a list of nested
+// objects, such as a profile's badges, are not collections.
+func itemsField(t reflect.Type) (int, bool) {
+	if t.Kind() != reflect.Struct || !jsonFields(t)["totalCount"] {
+		return 0, false
+	}
+
+	found := -1
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() || f.Type.Kind() != reflect.Slice || f.Type.Elem().Kind() != reflect.Struct {
+			continue
+		}
+
+		if found >= 0 {
+			return 0, false
+		}
+
+		found = i
+	}
+
+	return found, found >= 0
+}
+
+// jsonFields returns the JSON names of a struct type's serialized fields.
+func jsonFields(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	if t.Kind() != reflect.Struct {
+		return names
+	}
+
+	for i := range t.NumField() {
+		if name, ok := jsonName(t.Field(i)); ok {
+			names[name] = true
+		}
+	}
+
+	return names
+}
+
+// jsonName returns the name a field is serialized under, or false when it is not serialized.
+func jsonName(f reflect.StructField) (string, bool) {
+	if !f.IsExported() || f.Anonymous {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return f.Name, true
+	default:
+		return name, true
+	}
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// FollowerInsightsHandler handles the follower insights of creators.
+type FollowerInsightsHandler struct {
+	statsService service.StatsService
+}
+
+// NewFollowerInsightsHandler creates a new follower insights handler.
+func NewFollowerInsightsHandler(statsService service.StatsService) *FollowerInsightsHandler {
+	return &FollowerInsightsHandler{
+		statsService: statsService,
+	}
+}
+
+// GetFollowerInsights handles GET /users/{user_id}/followers/insights. Insights are only shown to
+// their owner and accept the from, to and interval parameters of GET /admin/stats.
+func (h *FollowerInsightsHandler) GetFollowerInsights(w http.ResponseWriter, r *http.Request) {
+	// 1. Only the owner may see their audience
+	requesterID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
+
+		return
+	}
+
+	if userID != requesterID {
+		ForbiddenResponse(w, "Follower insights are only available to their owner")
+
+		return
+	}
+
+	if h.statsService == nil {
+		ServiceUnavailableResponse(w, "Follower insights are not available")
+
+		return
+	}
+
+	// 2. Parse the range
+	query, err := parseAdminStatsQuery(r, time.Now())
+	if err != nil {
+		queryErrorResponse(w, err)
+
+		return
+	}
+
+	// 3. Call service
+	insights, err := h.statsService.GetFollowerInsights(r.Context(), userID, dto.FollowerInsightsQuery(query))
+	if err != nil {
+		slog.Error("failed to get follower insights", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, insights)
+}
@@ -0,0 +1,97 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+)
+
+func TestFollowerInsightsHandlerGetFollowerInsights(t *testing.T) {
+	t.Parallel()
+
+	ownerID := uuid.New()
+	otherID := uuid.New()
+	path := "/users/" + ownerID.String() + "/followers/insights"
+
+	tests := []struct {
+		name           string
+		path           string
+		requesterID    uuid.UUID
+		mockSetup      func(*mocks.StatsService)
+		expectedStatus int
+	}{
+		{
+			name:        "owner sees their insights",
+			path:        path + "?interval=week&from=2026-09-01&to=2026-09-30",
+			requesterID: ownerID,
+			mockSetup: func(m *mocks.StatsService) {
+				m.On("GetFollowerInsights", mock.Anything, ownerID, mock.MatchedBy(func(q dto.FollowerInsightsQuery) bool {
+					return q.Interval == dto.StatsIntervalWeek
+				})).Return(&dto.FollowerInsightsResponse{UserID: ownerID.String()}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "other users are forbidden",
+			path:           path,
+			requesterID:    otherID,
+			mockSetup:      func(_ *mocks.StatsService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "invalid interval",
+			path:           path + "?interval=month",
+			requesterID:    ownerID,
+			mockSetup:      func(_ *mocks.StatsService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid user ID",
+			path:           "/users/not-a-uuid/followers/insights",
+			requesterID:    ownerID,
+			mockSetup:      func(_ *mocks.StatsService) {},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "unauthenticated",
+			path:           path,
+			mockSetup:      func(_ *mocks.StatsService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.StatsService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewFollowerInsightsHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/users/{user_id}/followers/insights", h.GetFollowerInsights)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, nil)
+			if tt.requesterID != uuid.Nil {
+				req = setAuthenticatedUser(req, tt.requesterID)
+			}
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// HandleHandler handles vanity profile handle endpoints.
+type HandleHandler struct {
+	handleService service.HandleService
+	binder        *RequestBinder
+}
+
+// NewHandleHandler creates a new handle handler.
+func NewHandleHandler(handleService service.HandleService) *HandleHandler {
+	return &HandleHandler{
+		handleService: handleService,
+		binder:        NewRequestBinder(),
+	}
+}
+
+// ReserveHandle handles POST /users/handle/reservation.
+func (h *HandleHandler) ReserveHandle(w http.ResponseWriter, r *http.Request) {
+	userID, req, ok := h.bindHandleRequest(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.handleService.ReserveHandle(r.Context(), userID, req.Handle)
+	if err != nil {
+		handleHandleError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// ClaimHandle handles PUT /users/handle.
+func (h *HandleHandler) ClaimHandle(w http.ResponseWriter, r *http.Request) {
+	userID, req, ok := h.bindHandleRequest(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.handleService.ClaimHandle(r.Context(), userID, req.Handle)
+	if err != nil {
+		handleHandleError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// ResolveHandle handles GET /handles/{handle}.
+func (h *HandleHandler) ResolveHandle(w http.ResponseWriter, r *http.Request) {
+	response, err := h.handleService.ResolveHandle(r.Context(), chi.URLParam(r, "handle"))
+	if err != nil {
+		handleHandleError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+func (h *HandleHandler) bindHandleRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+) (uuid.UUID, *dto.HandleRequest, bool) {
+	// 1. Require authentication
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return uuid.Nil, nil, false
+	}
+
+	// 2. Bind and validate request body
+	var req dto.HandleRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return uuid.Nil, nil, false
+	}
+
+	return userID, &req, true
+}
+
+func handleHandleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "HANDLE_NOT_FOUND", "Handle not found")
+	case errors.Is(err, service.ErrHandleInvalid):
+		ErrorResponse(w, http.StatusBadRequest, "INVALID_HANDLE",
+			"Handle must be 3-30 lowercase letters, digits or single hyphens and start with a letter")
+	case errors.Is(err, service.ErrHandleNotAllowed):
+		ErrorResponse(w, http.StatusBadRequest, "HANDLE_NOT_ALLOWED", "Handle is not allowed")
+	case errors.Is(err, service.ErrHandleTaken):
+		ErrorResponse(w, http.StatusConflict, "HANDLE_TAKEN", "Handle already taken")
+	case errors.Is(err, service.ErrHandleHeld):
+		ErrorResponse(w, http.StatusConflict, "HANDLE_RESERVED", "Handle is currently reserved by another user")
+	case errors.Is(err, service.ErrHandleNotReserved):
+		ErrorResponse(w, http.StatusConflict, "HANDLE_NOT_RESERVED", "Reserve the handle before claiming it")
+	case errors.Is(err, service.ErrCacheUnavailable):
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+	default:
+		slog.Error("failed to process handle request", "error", err)
+		InternalErrorResponse(w)
+	}
+}
@@ -0,0 +1,210 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestHandleHandlerReserveAndClaim(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		authenticated  bool
+		mockSetup      func(*mocks.HandleService)
+		expectedStatus int
+	}{
+		{
+			name:          "reserve success",
+			method:        http.MethodPost,
+			body:          `{"handle": "chef-jane"}`,
+			authenticated: true,
+			mockSetup: func(m *mocks.HandleService) {
+				m.On("ReserveHandle", mock.Anything, userID, "chef-jane").
+					Return(&dto.HandleReservationResponse{Handle: "chef-jane"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:          "reserve held by another user",
+			method:        http.MethodPost,
+			body:          `{"handle": "chef-jane"}`,
+			authenticated: true,
+			mockSetup: func(m *mocks.HandleService) {
+				m.On("ReserveHandle", mock.Anything, userID, "chef-jane").Return(nil, service.ErrHandleHeld)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "reserve with malformed handle",
+			method:         http.MethodPost,
+			body:           `{"handle": "-bad-"}`,
+			authenticated:  true,
+			mockSetup:      func(_ *mocks.HandleService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:          "reserve blocked handle",
+			method:        http.MethodPost,
+			body:          `{"handle": "admin"}`,
+			authenticated: true,
+			mockSetup: func(m *mocks.HandleService) {
+				m.On("ReserveHandle", mock.Anything, userID, "admin").Return(nil, service.ErrHandleNotAllowed)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "reserve unauthenticated",
+			method:         http.MethodPost,
+			body:           `{"handle": "chef-jane"}`,
+			mockSetup:      func(_ *mocks.HandleService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "claim success",
+			method:        http.MethodPut,
+			body:          `{"handle": "chef-jane"}`,
+			authenticated: true,
+			mockSetup: func(m *mocks.HandleService) {
+				m.On("ClaimHandle", mock.Anything, userID, "chef-jane").
+					Return(&dto.HandleResponse{UserID: userID.String(), Handle: "chef-jane"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:          "claim without reservation",
+			method:        http.MethodPut,
+			body:          `{"handle": "chef-jane"}`,
+			authenticated: true,
+			mockSetup: func(m *mocks.HandleService) {
+				m.On("ClaimHandle", mock.Anything, userID, "chef-jane").Return(nil, service.ErrHandleNotReserved)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:          "claim with cache down",
+			method:        http.MethodPut,
+			body:          `{"handle": "chef-jane"}`,
+			authenticated: true,
+			mockSetup: func(m *mocks.HandleService) {
+				m.On("ClaimHandle", mock.Anything, userID, "chef-jane").Return(nil, service.ErrCacheUnavailable)
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.HandleService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewHandleHandler(mockSvc)
+
+			req := httptest.NewRequestWithContext(context.Background(), tt.method, "/users/handle",
+				strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			if tt.authenticated {
+				req = setAuthenticatedUser(req, userID)
+			}
+
+			rr := httptest.NewRecorder()
+
+			if tt.method == http.MethodPut {
+				h.ClaimHandle(rr, req)
+			} else {
+				h.ReserveHandle(rr, req)
+			}
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleHandlerResolveHandle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		handle         string
+		mockSetup      func(*mocks.HandleService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "found",
+			handle: "chef-jane",
+			mockSetup: func(m *mocks.HandleService) {
+				m.On("ResolveHandle", mock.Anything, "chef-jane").Return(&dto.HandleResolutionResponse{
+					Handle: "chef-jane",
+					User:   dto.UserSearchResult{Username: "janedoe"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "janedoe",
+		},
+		{
+			name:   "not found",
+			handle: "ghost",
+			mockSetup: func(m *mocks.HandleService) {
+				m.On("ResolveHandle", mock.Anything, "ghost").Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "HANDLE_NOT_FOUND",
+		},
+		{
+			name:   "database error",
+			handle: "chef-jane",
+			mockSetup: func(m *mocks.HandleService) {
+				m.On("ResolveHandle", mock.Anything, "chef-jane").Return(nil, errDB)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.HandleService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewHandleHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/handles/{handle}", h.ResolveHandle)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/handles/"+tt.handle, nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -1,10 +1,10 @@
 package handler
 
 import (
-	"encoding/json"
-	"log/slog"
 	"net/http"
 
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/buildinfo"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 )
 
@@ -26,19 +26,34 @@ func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
 	h.writeJSON(w, http.StatusOK, status)
 }
 
-// Ready handles GET /ready (readiness probe).
+// Ready handles GET /ready (readiness probe). It fails with 503 while the instance drains or an
+// invariant of severity fail is violated, so it is taken out of rotation.
 func (h *HealthHandler) Ready(w http.ResponseWriter, r *http.Request) {
 	status := h.healthService.GetReadiness(r.Context())
+	if status.Status == "DRAINING" || status.Status == "NOT_READY" {
+		h.writeJSON(w, http.StatusServiceUnavailable, status)
+
+		return
+	}
+
 	h.writeJSON(w, http.StatusOK, status)
 }
 
+// Version handles GET /version, identifying the running build.
+func (h *HealthHandler) Version(w http.ResponseWriter, _ *http.Request) {
+	info := buildinfo.Get()
+
+	h.writeJSON(w, http.StatusOK, dto.VersionResponse{
+		Version:   info.Version,
+		GitSHA:    info.GitSHA,
+		BuildDate: info.BuildDate,
+		GoVersion: info.GoVersion,
+		OS:        info.OS,
+		Arch:      info.Arch,
+	})
+}
+
 // writeJSON writes a JSON response.
 func (h *HealthHandler) writeJSON(w http.ResponseWriter, statusCode int, data any) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-
-	err := json.NewEncoder(w).Encode(data)
-	if err != nil {
-		slog.Error("failed to write response", "error", err)
-	}
+	JSONResponse(w, statusCode, data)
 }
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"runtime"
 	"testing"
 
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 	"github.com/stretchr/testify/assert"
@@ -53,6 +55,28 @@ func TestHealthHandler(t *testing.T) {
 	assert.Equal(t, "UP", actual.Status, unexpectedStatusMsg)
 }
 
+func TestVersionHandler(t *testing.T) {
+	t.Parallel()
+
+	h := handler.NewHealthHandler(&mockHealthService{})
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/version", nil)
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	h.Version(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, wrongStatusCode)
+
+	var actual dto.VersionResponse
+
+	err = json.NewDecoder(rr.Body).Decode(&actual)
+	require.NoError(t, err)
+	assert.NotEmpty(t, actual.Version)
+	assert.NotEmpty(t, actual.GitSHA)
+	assert.Equal(t, runtime.Version(), actual.GoVersion)
+}
+
 func TestReadyHandler(t *testing.T) {
 	t.Parallel()
 
@@ -107,3 +131,42 @@ func TestReadyHandlerAllUp(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "READY", actual.Status, unexpectedStatusMsg)
 }
+
+func TestReadyHandlerDraining(t *testing.T) {
+	t.Parallel()
+
+	mockSvc := &mockHealthService{
+		readinessStatus: service.HealthStatus{Status: "DRAINING"},
+	}
+	h := handler.NewHealthHandler(mockSvc)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/ready", nil)
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	h.Ready(rr, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code, wrongStatusCode)
+	assert.Contains(t, rr.Body.String(), `"status":"DRAINING"`)
+}
+
+func TestReadyHandlerNotReady(t *testing.T) {
+	t.Parallel()
+
+	mockSvc := &mockHealthService{
+		readinessStatus: service.HealthStatus{
+			Status:     "NOT_READY",
+			Invariants: []service.InvariantResult{{Name: service.InvariantIndexes, Status: service.InvariantSeverityFail}},
+		},
+	}
+	h := handler.NewHealthHandler(mockSvc)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/ready", nil)
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	h.Ready(rr, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code, wrongStatusCode)
+	assert.Contains(t, rr.Body.String(), `"status":"NOT_READY"`)
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// InvitationHandler handles the invitation codes users hand out and redeem.
+type InvitationHandler struct {
+	invitationService service.InvitationService
+}
+
+// NewInvitationHandler creates a new invitation handler.
+func NewInvitationHandler(invitationService service.InvitationService) *InvitationHandler {
+	return &InvitationHandler{invitationService: invitationService}
+}
+
+// CreateInvitation handles POST /users/account/invitations.
+func (h *InvitationHandler) CreateInvitation(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	invitation, err := h.invitationService.CreateInvitation(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusCreated, invitation)
+}
+
+// ListInvitations handles GET /users/account/invitations.
+func (h *InvitationHandler) ListInvitations(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.invitationService.ListInvitations(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// GetInvitation handles GET /invitations/{code}.
+func (h *InvitationHandler) GetInvitation(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	invitation, err := h.invitationService.GetInvitation(r.Context(), userID, chi.URLParam(r, "code"))
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, invitation)
+}
+
+// RedeemInvitation handles POST /invitations/{code}/redeem.
+func (h *InvitationHandler) RedeemInvitation(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	redemption, err := h.invitationService.RedeemInvitation(r.Context(), userID, chi.URLParam(r, "code"))
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, redemption)
+}
+
+// GetInvitationStats handles GET /admin/invitations/stats.
+func (h *InvitationHandler) GetInvitationStats(w http.ResponseWriter, r *http.Request) {
+	_, ok := adminRequester(w, r)
+	if !ok {
+		return
+	}
+
+	if h.invitationService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	stats, err := h.invitationService.GetInvitationStats(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, stats)
+}
+
+// prepare checks authentication and service availability and returns the requester.
+func (h *InvitationHandler) prepare(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return uuid.Nil, false
+	}
+
+	if h.invitationService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func (h *InvitationHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrInvitationNotFound):
+		ErrorResponse(w, http.StatusNotFound, "INVITATION_NOT_FOUND", "Invitation not found")
+	case errors.Is(err, service.ErrInvitationLimitReached):
+		ErrorResponse(w, http.StatusConflict, "INVITATION_LIMIT_REACHED", "No invitations left")
+	case errors.Is(err, service.ErrInvitationRedeemed):
+		ErrorResponse(w, http.StatusConflict, "INVITATION_ALREADY_REDEEMED", "Invitation has already been redeemed")
+	case errors.Is(err, service.ErrInvitationExpired):
+		ErrorResponse(w, http.StatusGone, "INVITATION_EXPIRED", "Invitation has expired")
+	case errors.Is(err, service.ErrAlreadyInvited):
+		ErrorResponse(w, http.StatusConflict, "ALREADY_INVITED", "An invitation has already been redeemed")
+	case errors.Is(err, service.ErrCannotRedeemOwnInvitation):
+		ErrorResponse(w, http.StatusBadRequest, "CANNOT_REDEEM_OWN_INVITATION", "Cannot redeem your own invitation")
+	default:
+		slog.Error("invitation service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
@@ -0,0 +1,129 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestInvitationHandler(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		mockSetup      func(*mocks.InvitationService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "create invitation",
+			method: http.MethodPost,
+			path:   "/users/account/invitations",
+			mockSetup: func(m *mocks.InvitationService) {
+				m.On("CreateInvitation", mock.Anything, userID).
+					Return(&dto.Invitation{Code: "K5VDS4TFMFZWK3LF", Status: dto.InvitationStatusPending}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"code":"K5VDS4TFMFZWK3LF"`,
+		},
+		{
+			name:   "no invitations left",
+			method: http.MethodPost,
+			path:   "/users/account/invitations",
+			mockSetup: func(m *mocks.InvitationService) {
+				m.On("CreateInvitation", mock.Anything, userID).Return(nil, service.ErrInvitationLimitReached)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "INVITATION_LIMIT_REACHED",
+		},
+		{
+			name:   "unknown code",
+			method: http.MethodGet,
+			path:   "/invitations/NOPE",
+			mockSetup: func(m *mocks.InvitationService) {
+				m.On("GetInvitation", mock.Anything, userID, "NOPE").Return(nil, service.ErrInvitationNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "INVITATION_NOT_FOUND",
+		},
+		{
+			name:   "redeem invitation",
+			method: http.MethodPost,
+			path:   "/invitations/K5VDS4TFMFZWK3LF/redeem",
+			mockSetup: func(m *mocks.InvitationService) {
+				m.On("RedeemInvitation", mock.Anything, userID, "K5VDS4TFMFZWK3LF").
+					Return(&dto.InvitationRedemption{Code: "K5VDS4TFMFZWK3LF", MutualFollow: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"mutualFollow":true`,
+		},
+		{
+			name:   "expired invitation",
+			method: http.MethodPost,
+			path:   "/invitations/OLD/redeem",
+			mockSetup: func(m *mocks.InvitationService) {
+				m.On("RedeemInvitation", mock.Anything, userID, "OLD").Return(nil, service.ErrInvitationExpired)
+			},
+			expectedStatus: http.StatusGone,
+			expectedBody:   "INVITATION_EXPIRED",
+		},
+		{
+			name:   "own invitation",
+			method: http.MethodPost,
+			path:   "/invitations/MINE/redeem",
+			mockSetup: func(m *mocks.InvitationService) {
+				m.On("RedeemInvitation", mock.Anything, userID, "MINE").
+					Return(nil, service.ErrCannotRedeemOwnInvitation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "CANNOT_REDEEM_OWN_INVITATION",
+		},
+		{
+			name:           "stats require admin",
+			method:         http.MethodGet,
+			path:           "/admin/invitations/stats",
+			mockSetup:      func(*mocks.InvitationService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewInvitationService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewInvitationHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Post("/users/account/invitations", h.CreateInvitation)
+			r.Get("/invitations/{code}", h.GetInvitation)
+			r.Post("/invitations/{code}/redeem", h.RedeemInvitation)
+			r.Get("/admin/invitations/stats", h.GetInvitationStats)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req = setAuthenticatedUser(req, userID)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// JobHandler lists the scheduled background jobs and runs them on demand.
+type JobHandler struct {
+	jobService service.JobService
+}
+
+// NewJobHandler creates a new job handler.
+func NewJobHandler(jobService service.JobService) *JobHandler {
+	return &JobHandler{jobService: jobService}
+}
+
+// ListJobs handles GET /admin/jobs.
+func (h *JobHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	if _, ok := adminRequester(w, r); !ok || !h.available(w) {
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, dto.ScheduledJobsResponse{Jobs: h.jobService.ListJobs(r.Context())})
+}
+
+// RunJob handles POST /admin/jobs/{name}/run. The job runs in the background; GET /admin/jobs
+// reports how the run went.
+func (h *JobHandler) RunJob(w http.ResponseWriter, r *http.Request) {
+	actorID, ok := adminRequester(w, r)
+	if !ok || !h.available(w) {
+		return
+	}
+
+	name := chi.URLParam(r, "name")
+
+	slog.InfoContext(r.Context(), "job run requested", "job", name, "actor_id", actorID)
+
+	job, err := h.jobService.RunJob(r.Context(), name)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrJobNotFound):
+			NotFoundResponse(w, "Job")
+		case errors.Is(err, service.ErrJobRunning):
+			ErrorResponse(w, http.StatusConflict, "JOB_RUNNING", "The job is already running")
+		default:
+			slog.ErrorContext(r.Context(), "failed to run job", "job", name, "error", err)
+			InternalErrorResponse(w)
+		}
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusAccepted, job)
+}
+
+func (h *JobHandler) available(w http.ResponseWriter) bool {
+	if h.jobService == nil {
+		ServiceUnavailableResponse(w, "Jobs are not available")
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,113 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestJobHandler(t *testing.T) {
+	t.Parallel()
+
+	adminID, userID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.JobService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:      "admin lists jobs",
+			method:    http.MethodGet,
+			path:      "/admin/jobs",
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.JobService) {
+				m.On("ListJobs", mock.Anything).
+					Return([]dto.ScheduledJob{{Name: "device-cleanup", Schedule: "0 3 * * *"}})
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"schedule":"0 3 * * *"`,
+		},
+		{
+			name:      "admin runs a job",
+			method:    http.MethodPost,
+			path:      "/admin/jobs/device-cleanup/run",
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.JobService) {
+				m.On("RunJob", mock.Anything, "device-cleanup").
+					Return(&dto.ScheduledJob{Name: "device-cleanup", Running: true}, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+			expectedBody:   `"running":true`,
+		},
+		{
+			name:      "unknown job",
+			method:    http.MethodPost,
+			path:      "/admin/jobs/backup/run",
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.JobService) {
+				m.On("RunJob", mock.Anything, "backup").Return(nil, service.ErrJobNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:      "job already running",
+			method:    http.MethodPost,
+			path:      "/admin/jobs/device-cleanup/run",
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.JobService) {
+				m.On("RunJob", mock.Anything, "device-cleanup").Return(nil, service.ErrJobRunning)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "JOB_RUNNING",
+		},
+		{
+			name:           "non-admin is forbidden",
+			method:         http.MethodPost,
+			path:           "/admin/jobs/device-cleanup/run",
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			mockSetup:      func(*mocks.JobService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewJobService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewJobHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/admin/jobs", h.ListJobs)
+			r.Post("/admin/jobs/{name}/run", h.RunJob)
+
+			req := httptest.NewRequestWithContext(context.Background(), tt.method, tt.path, nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+// ListUnsubscribeHandler handles the List-Unsubscribe header payloads of emails and the one-click
+// unsubscribes mailbox providers post for them.
+type ListUnsubscribeHandler struct {
+	listUnsubscribeService service.ListUnsubscribeService
+}
+
+// NewListUnsubscribeHandler creates a new List-Unsubscribe handler.
+func NewListUnsubscribeHandler(listUnsubscribeService service.ListUnsubscribeService) *ListUnsubscribeHandler {
+	return &ListUnsubscribeHandler{listUnsubscribeService: listUnsubscribeService}
+}
+
+// GetListUnsubscribe handles GET /internal/v1/users/{user_id}/list-unsubscribe, called by the
+// services sending emails.
+func (h *ListUnsubscribeHandler) GetListUnsubscribe(w http.ResponseWriter, r *http.Request) {
+	// 1. Only service accounts with read scope (or admins) may fetch unsubscribe links
+	if !canReadUserData(r) {
+		ForbiddenResponse(w, "Service token with user:read scope required")
+
+		return
+	}
+
+	if h.listUnsubscribeService == nil {
+		ServiceUnavailableResponse(w, "List-Unsubscribe is not configured")
+
+		return
+	}
+
+	// 2. Parse the recipient and the email category
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return
+	}
+
+	category := dto.EmailCategory(r.URL.Query().Get("category"))
+	if !category.IsValid() {
+		values := strings.Join(category.Values(), ", ")
+		FieldErrorResponse(w, http.StatusBadRequest, "VALIDATION_ERROR", "category must be one of: "+values,
+			validation.Invalid("category", "oneof", values, string(category)))
+
+		return
+	}
+
+	// 3. Call service
+	response, err := h.listUnsubscribeService.GetListUnsubscribe(r.Context(), userID, category)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// Unsubscribe handles POST /unsubscribe/{token}, the one-click unsubscribe of RFC 8058. The token
+// authorizes the request, and the form body must be List-Unsubscribe=One-Click.
+func (h *ListUnsubscribeHandler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	if h.listUnsubscribeService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// Mailbox providers post either form encoding; link prefetchers post nothing
+	key, value, _ := strings.Cut(service.ListUnsubscribePost, "=")
+	if r.PostFormValue(key) != value {
+		ErrorResponse(w, http.StatusBadRequest, "INVALID_UNSUBSCRIBE_REQUEST",
+			"Request body must be "+service.ListUnsubscribePost)
+
+		return
+	}
+
+	err := h.listUnsubscribeService.Unsubscribe(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ListUnsubscribeHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUnsubscribeLinkInvalid):
+		ErrorResponse(w, http.StatusNotFound, "UNSUBSCRIBE_LINK_INVALID",
+			"Unsubscribe link is invalid or has been revoked")
+	case errors.Is(err, service.ErrUnsubscribeLinkExpired):
+		ErrorResponse(w, http.StatusGone, "UNSUBSCRIBE_LINK_EXPIRED", "Unsubscribe link has expired")
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrCategoryDisabled):
+		ErrorResponse(w, http.StatusNotFound, "CATEGORY_DISABLED", "Preference category is not available")
+	default:
+		slog.Error("list unsubscribe service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
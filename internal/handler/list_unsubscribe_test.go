@@ -0,0 +1,152 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestListUnsubscribeHandler(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	path := "/internal/v1/users/" + userID.String() + "/list-unsubscribe"
+	anonymous := func(r *http.Request) *http.Request { return r }
+	oneClick := "List-Unsubscribe=One-Click"
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.ListUnsubscribeService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:      "service account fetches the headers",
+			method:    http.MethodGet,
+			path:      path + "?category=marketing",
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup: func(m *mocks.ListUnsubscribeService) {
+				m.On("GetListUnsubscribe", mock.Anything, userID, dto.EmailCategoryMarketing).
+					Return(&dto.ListUnsubscribeResponse{ListUnsubscribePost: oneClick}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"listUnsubscribePost":"List-Unsubscribe=One-Click"`,
+		},
+		{
+			name:           "unknown category",
+			method:         http.MethodGet,
+			path:           path + "?category=security_alerts",
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup:      func(*mocks.ListUnsubscribeService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "regular user is forbidden",
+			method:         http.MethodGet,
+			path:           path + "?category=marketing",
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			mockSetup:      func(*mocks.ListUnsubscribeService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:      "one-click unsubscribe",
+			method:    http.MethodPost,
+			path:      "/unsubscribe/TOKEN",
+			body:      oneClick,
+			authorize: anonymous,
+			mockSetup: func(m *mocks.ListUnsubscribeService) {
+				m.On("Unsubscribe", mock.Anything, "TOKEN").Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "missing one-click body",
+			method:         http.MethodPost,
+			path:           "/unsubscribe/TOKEN",
+			authorize:      anonymous,
+			mockSetup:      func(*mocks.ListUnsubscribeService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_UNSUBSCRIBE_REQUEST",
+		},
+		{
+			name:      "revoked link",
+			method:    http.MethodPost,
+			path:      "/unsubscribe/OLD",
+			body:      oneClick,
+			authorize: anonymous,
+			mockSetup: func(m *mocks.ListUnsubscribeService) {
+				m.On("Unsubscribe", mock.Anything, "OLD").Return(service.ErrUnsubscribeLinkInvalid)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "UNSUBSCRIBE_LINK_INVALID",
+		},
+		{
+			name:      "expired link",
+			method:    http.MethodPost,
+			path:      "/unsubscribe/OLD",
+			body:      oneClick,
+			authorize: anonymous,
+			mockSetup: func(m *mocks.ListUnsubscribeService) {
+				m.On("Unsubscribe", mock.Anything, "OLD").Return(service.ErrUnsubscribeLinkExpired)
+			},
+			expectedStatus: http.StatusGone,
+			expectedBody:   "UNSUBSCRIBE_LINK_EXPIRED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewListUnsubscribeService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewListUnsubscribeHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/internal/v1/users/{user_id}/list-unsubscribe", h.GetListUnsubscribe)
+			r.Post("/unsubscribe/{token}", h.Unsubscribe)
+
+			req := httptest.NewRequestWithContext(context.Background(), tt.method, tt.path,
+				strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestListUnsubscribeHandler_NotConfigured(t *testing.T) {
+	t.Parallel()
+
+	h := handler.NewListUnsubscribeHandler(nil)
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet,
+		"/internal/v1/users/"+uuid.NewString()+"/list-unsubscribe?category=marketing", nil)
+
+	rr := httptest.NewRecorder()
+	h.GetListUnsubscribe(rr, withServiceAccount(req, "user:read"))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// MaintenanceHandler handles the maintenance mode switch.
+type MaintenanceHandler struct {
+	maintenanceService service.MaintenanceService
+	binder             *RequestBinder
+}
+
+// NewMaintenanceHandler creates a new maintenance handler.
+func NewMaintenanceHandler(maintenanceService service.MaintenanceService) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		maintenanceService: maintenanceService,
+		binder:             NewRequestBinder(),
+	}
+}
+
+// GetMaintenance handles GET /admin/maintenance.
+func (h *MaintenanceHandler) GetMaintenance(w http.ResponseWriter, r *http.Request) {
+	if _, ok := adminRequester(w, r); !ok || !h.available(w) {
+		return
+	}
+
+	status, err := h.maintenanceService.GetMaintenance(r.Context())
+	if err != nil {
+		slog.Error("failed to get maintenance mode", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, status)
+}
+
+// SetMaintenance handles PUT /admin/maintenance. It stays reachable during maintenance so the
+// mode can be turned off again.
+func (h *MaintenanceHandler) SetMaintenance(w http.ResponseWriter, r *http.Request) {
+	actorID, ok := adminRequester(w, r)
+	if !ok || !h.available(w) {
+		return
+	}
+
+	var req dto.MaintenanceRequest
+
+	err := h.binder.BindAndValidate(r, &req)
+	if err != nil {
+		respondBindError(w, err)
+
+		return
+	}
+
+	status, err := h.maintenanceService.SetMaintenance(r.Context(), actorID, &req)
+	if err != nil {
+		slog.Error("failed to set maintenance mode", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, status)
+}
+
+func (h *MaintenanceHandler) available(w http.ResponseWriter) bool {
+	if h.maintenanceService == nil {
+		ServiceUnavailableResponse(w, "Maintenance mode is not available")
+
+		return false
+	}
+
+	return true
+}
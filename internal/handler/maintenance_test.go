@@ -0,0 +1,101 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+)
+
+func TestMaintenanceHandler(t *testing.T) {
+	t.Parallel()
+
+	adminID, userID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.MaintenanceService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:      "admin turns maintenance on",
+			method:    http.MethodPut,
+			body:      `{"enabled":true,"message":"Migrating"}`,
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.MaintenanceService) {
+				m.On("SetMaintenance", mock.Anything, adminID, mock.MatchedBy(func(req *dto.MaintenanceRequest) bool {
+					return *req.Enabled && req.Message == "Migrating"
+				})).Return(&dto.MaintenanceStatus{Enabled: true, Message: "Migrating"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"enabled":true`,
+		},
+		{
+			name:      "admin reads the switch",
+			method:    http.MethodGet,
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.MaintenanceService) {
+				m.On("GetMaintenance", mock.Anything).Return(&dto.MaintenanceStatus{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"enabled":false`,
+		},
+		{
+			name:           "enabled is required",
+			method:         http.MethodPut,
+			body:           `{"message":"Migrating"}`,
+			authorize:      func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup:      func(*mocks.MaintenanceService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "non-admin is forbidden",
+			method:         http.MethodPut,
+			body:           `{"enabled":true}`,
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			mockSetup:      func(*mocks.MaintenanceService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewMaintenanceService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewMaintenanceHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/admin/maintenance", h.GetMaintenance)
+			r.Put("/admin/maintenance", h.SetMaintenance)
+
+			req := httptest.NewRequestWithContext(context.Background(), tt.method, "/admin/maintenance",
+				strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
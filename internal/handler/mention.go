@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// MentionHandler resolves the @username mentions other services render.
+type MentionHandler struct {
+	mentionService service.MentionService
+	binder         *RequestBinder
+}
+
+// NewMentionHandler creates a new mention handler.
+func NewMentionHandler(mentionService service.MentionService) *MentionHandler {
+	return &MentionHandler{
+		mentionService: mentionService,
+		binder:         NewRequestBinder(),
+	}
+}
+
+// ResolveMentions handles POST /internal/v1/users/resolve-mentions.
+func (h *MentionHandler) ResolveMentions(w http.ResponseWriter, r *http.Request) {
+	// 1. Only service accounts with read scope (or admins) may resolve mentions
+	if !canReadUserData(r) {
+		ForbiddenResponse(w, "Service token with user:read scope required")
+
+		return
+	}
+
+	if h.mentionService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 2. Parse the usernames
+	var req dto.MentionResolveRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	// 3. Call service
+	response, err := h.mentionService.ResolveMentions(r.Context(), req.Usernames)
+	if err != nil {
+		if errors.Is(err, service.ErrTooManyMentions) {
+			ErrorResponse(w, http.StatusBadRequest, "TOO_MANY_USERNAMES", err.Error())
+
+			return
+		}
+
+		slog.Error("failed to resolve mentions", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
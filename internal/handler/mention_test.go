@@ -0,0 +1,95 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestMentionHandlerResolveMentions(t *testing.T) {
+	t.Parallel()
+
+	resolved := &dto.MentionResolveResponse{
+		Users:      []dto.MentionedUser{{UserID: uuid.NewString(), Username: "alice"}},
+		Unresolved: []string{"nobody"},
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.MentionService)
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:      "service account resolves mentions",
+			body:      `{"usernames":["alice","nobody"]}`,
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup: func(m *mocks.MentionService) {
+				m.On("ResolveMentions", mock.Anything, []string{"alice", "nobody"}).Return(resolved, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "too many usernames",
+			body:      `{"usernames":["alice","bob"]}`,
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup: func(m *mocks.MentionService) {
+				m.On("ResolveMentions", mock.Anything, []string{"alice", "bob"}).
+					Return(nil, fmt.Errorf("%w: at most 1", service.ErrTooManyMentions))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "TOO_MANY_USERNAMES",
+		},
+		{
+			name:           "usernames missing",
+			body:           `{}`,
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup:      func(_ *mocks.MentionService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "VALIDATION_ERROR",
+		},
+		{
+			name:           "regular user is forbidden",
+			body:           `{"usernames":["alice"]}`,
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, uuid.New()) },
+			mockSetup:      func(_ *mocks.MentionService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewMentionService(t)
+			tt.mockSetup(mockSvc)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost,
+				"/internal/v1/users/resolve-mentions", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			handler.NewMentionHandler(mockSvc).ResolveMentions(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedError != "" {
+				assert.Contains(t, rr.Body.String(), `"error":"`+tt.expectedError+`"`)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package handler_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/i18n"
+)
+
+// messageArgs maps the error response helpers to the position of their message argument.
+var messageArgs = map[string]int{
+	"ErrorResponse":              3,
+	"FieldErrorResponse":         3,
+	"pathErrorResponse":          4,
+	"ForbiddenResponse":          1,
+	"UnauthorizedResponse":       1,
+	"ConflictResponse":           1,
+	"ServiceUnavailableResponse": 1,
+}
+
+// TestErrorMessagesAreTranslated checks that every literal error message of the profile,
+// preference, social and presence handlers, and of the query parameters they parse, has a
+// translation in each supported language.
+func TestErrorMessagesAreTranslated(t *testing.T) {
+	t.Parallel()
+
+	messages := map[string]bool{
+		"Unauthorized": true,
+		"Forbidden":    true,
+	}
+
+	for _, err := range []error{
+		handler.ErrInvalidLimit, handler.ErrLimitOutOfRange, handler.ErrInvalidOffset,
+		handler.ErrNegativeOffset, handler.ErrInvalidCountOnly, handler.ErrInvalidCountry, handler.ErrInvalidRegion,
+		handler.ErrInvalidPerTypeLimit, handler.ErrPerTypeLimitOutOfRange, handler.ErrInvalidIncludePresence,
+	} {
+		messages[err.Error()] = true
+	}
+
+	fset := token.NewFileSet()
+
+	for _, name := range []string{
+		"user.go", "preference.go", "social.go", "response.go", "bind.go", "presence.go", "badge.go", "embed.go",
+	} {
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		require.NoError(t, err)
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			fn, ok := call.Fun.(*ast.Ident)
+			if !ok {
+				return true
+			}
+
+			i, ok := messageArgs[fn.Name]
+			if !ok || len(call.Args) <= i {
+				return true
+			}
+
+			if lit, ok := call.Args[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				message, err := strconv.Unquote(lit.Value)
+				require.NoError(t, err)
+
+				messages[message] = true
+			}
+
+			return true
+		})
+	}
+
+	require.Greater(t, len(messages), 30, "the handlers' messages were found")
+
+	for _, lang := range i18n.Languages()[1:] {
+		for message := range messages {
+			assert.NotEqual(t, message, i18n.Translate(lang, message), "no %s translation for %q", lang, message)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// ModerationHandler handles account moderation and the approval queue for identity changes.
+type ModerationHandler struct {
+	moderationService service.ModerationService
+	binder            *RequestBinder
+}
+
+// NewModerationHandler creates a new moderation handler.
+func NewModerationHandler(moderationService service.ModerationService) *ModerationHandler {
+	return &ModerationHandler{
+		moderationService: moderationService,
+		binder:            NewRequestBinder(),
+	}
+}
+
+// SubmitChangeRequest handles POST /users/account/change-requests.
+func (h *ModerationHandler) SubmitChangeRequest(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepareSelf(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.ChangeRequestSubmission
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	// The new value follows the same rules as a direct profile update
+	update := dto.UserProfileUpdateRequest{}
+	if req.Field == dto.ChangeRequestFieldUsername {
+		update.Username = &req.Value
+	} else {
+		update.Email = &req.Value
+	}
+
+	bindErr = h.binder.Validate(&update)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	request, err := h.moderationService.SubmitChangeRequest(r.Context(), userID, &req)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusCreated, request)
+}
+
+// ListMyChangeRequests handles GET /users/account/change-requests.
+func (h *ModerationHandler) ListMyChangeRequests(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepareSelf(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.moderationService.ListUserChangeRequests(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// ListChangeRequests handles GET /admin/change-requests. The status parameter defaults to PENDING.
+func (h *ModerationHandler) ListChangeRequests(w http.ResponseWriter, r *http.Request) {
+	if _, ok := h.prepareModerator(w, r); !ok {
+		return
+	}
+
+	status := dto.ChangeRequestStatusPending
+	if value := r.URL.Query().Get("status"); value != "" {
+		status = dto.ChangeRequestStatus(value)
+	}
+
+	if !status.IsValid() {
+		ErrorResponse(w, http.StatusBadRequest, "INVALID_STATUS", "Status must be PENDING, APPROVED or REJECTED")
+
+		return
+	}
+
+	response, err := h.moderationService.ListChangeRequests(r.Context(), status)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// ApproveChangeRequest handles POST /admin/change-requests/{request_id}/approve.
+func (h *ModerationHandler) ApproveChangeRequest(w http.ResponseWriter, r *http.Request) {
+	actorID, ok := h.prepareModerator(w, r)
+	if !ok {
+		return
+	}
+
+	requestID, ok := parseChangeRequestID(w, r)
+	if !ok {
+		return
+	}
+
+	request, err := h.moderationService.ApproveChangeRequest(r.Context(), actorID, requestID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, request)
+}
+
+// RejectChangeRequest handles POST /admin/change-requests/{request_id}/reject.
+func (h *ModerationHandler) RejectChangeRequest(w http.ResponseWriter, r *http.Request) {
+	actorID, ok := h.prepareModerator(w, r)
+	if !ok {
+		return
+	}
+
+	requestID, ok := parseChangeRequestID(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.ChangeRequestRejection
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	request, err := h.moderationService.RejectChangeRequest(r.Context(), actorID, requestID, req.Reason)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, request)
+}
+
+// ListContentFlags handles GET /admin/content-flags.
+func (h *ModerationHandler) ListContentFlags(w http.ResponseWriter, r *http.Request) {
+	if _, ok := h.prepareModerator(w, r); !ok {
+		return
+	}
+
+	response, err := h.moderationService.ListContentFlags(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// ResolveContentFlag handles POST /admin/content-flags/{flag_id}/resolve.
+func (h *ModerationHandler) ResolveContentFlag(w http.ResponseWriter, r *http.Request) {
+	actorID, ok := h.prepareModerator(w, r)
+	if !ok {
+		return
+	}
+
+	flagID, err := strconv.ParseInt(chi.URLParam(r, "flag_id"), 10, 64)
+	if err != nil || flagID <= 0 {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_FLAG_ID", "Invalid content flag ID format",
+			"flag_id", "integer")
+
+		return
+	}
+
+	flag, err := h.moderationService.ResolveContentFlag(r.Context(), actorID, flagID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, flag)
+}
+
+// SetModeration handles PUT /admin/users/{user_id}/moderation.
+func (h *ModerationHandler) SetModeration(w http.ResponseWriter, r *http.Request) {
+	h.setModeration(w, r, true)
+}
+
+// ClearModeration handles DELETE /admin/users/{user_id}/moderation.
+func (h *ModerationHandler) ClearModeration(w http.ResponseWriter, r *http.Request) {
+	h.setModeration(w, r, false)
+}
+
+func (h *ModerationHandler) setModeration(w http.ResponseWriter, r *http.Request, flagged bool) {
+	actorID, targetUserID, ok := moderatorTarget(w, r)
+	if !ok {
+		return
+	}
+
+	if h.moderationService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	response, err := h.moderationService.SetUnderModeration(r.Context(), actorID, targetUserID, flagged)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// prepareSelf checks authentication and service availability for the requester's own requests.
+func (h *ModerationHandler) prepareSelf(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return uuid.Nil, false
+	}
+
+	if h.moderationService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// prepareModerator checks that the requester is a moderator or an admin and the service is
+// available, and returns the requester ID.
+func (h *ModerationHandler) prepareModerator(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	actorID, ok := moderatorRequester(w, r)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	if h.moderationService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, false
+	}
+
+	return actorID, true
+}
+
+func parseChangeRequestID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	requestID, err := strconv.ParseInt(chi.URLParam(r, "request_id"), 10, 64)
+	if err != nil || requestID <= 0 {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_REQUEST_ID", "Invalid change request ID format",
+			"request_id", "integer")
+
+		return 0, false
+	}
+
+	return requestID, true
+}
+
+func (h *ModerationHandler) handleServiceError(w http.ResponseWriter, err error) {
+	if respondIdentifierConflict(w, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrChangeRequestNotFound):
+		ErrorResponse(w, http.StatusNotFound, "CHANGE_REQUEST_NOT_FOUND", "Change request not found")
+	case errors.Is(err, service.ErrChangeRequestDecided):
+		ErrorResponse(w, http.StatusConflict, "CHANGE_REQUEST_DECIDED", "Change request was already decided")
+	case errors.Is(err, service.ErrChangeRequestPending):
+		ErrorResponse(w, http.StatusConflict, "CHANGE_REQUEST_PENDING",
+			"A change of this field is already waiting for approval")
+	case errors.Is(err, service.ErrNotUnderModeration):
+		ErrorResponse(w, http.StatusConflict, "NOT_UNDER_MODERATION",
+			"This account can change its username and email directly")
+	case errors.Is(err, service.ErrContentFlagNotFound):
+		ErrorResponse(w, http.StatusNotFound, "CONTENT_FLAG_NOT_FOUND", "Content flag not found or already resolved")
+	case errors.Is(err, service.ErrValueUnchanged):
+		ErrorResponse(w, http.StatusBadRequest, "VALUE_UNCHANGED", "New value matches the current value")
+	default:
+		slog.Error("moderation service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
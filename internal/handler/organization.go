@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// OrganizationHandler handles organizations and their membership. Members manage the
+// organization's profile and social graph through the user routes while acting as it.
+type OrganizationHandler struct {
+	orgService service.OrganizationService
+	binder     *RequestBinder
+}
+
+// NewOrganizationHandler creates a new organization handler.
+func NewOrganizationHandler(orgService service.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{
+		orgService: orgService,
+		binder:     NewRequestBinder(),
+	}
+}
+
+// CreateOrganization handles POST /organizations.
+func (h *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	actorID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.OrganizationCreateRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	org, err := h.orgService.CreateOrganization(r.Context(), actorID, &req)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusCreated, org)
+}
+
+// ListOrganizations handles GET /organizations.
+func (h *OrganizationHandler) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+	actorID, ok := h.prepare(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.orgService.ListUserOrganizations(r.Context(), actorID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// ListMembers handles GET /organizations/{org_id}/members.
+func (h *OrganizationHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	actorID, orgID, ok := h.prepareOrganization(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.orgService.ListMembers(r.Context(), actorID, orgID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// SetMember handles PUT /organizations/{org_id}/members/{user_id}.
+func (h *OrganizationHandler) SetMember(w http.ResponseWriter, r *http.Request) {
+	actorID, orgID, userID, ok := h.prepareMember(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.OrganizationMemberRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	response, err := h.orgService.SetMember(r.Context(), actorID, orgID, userID, req.Role)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// RemoveMember handles DELETE /organizations/{org_id}/members/{user_id}.
+func (h *OrganizationHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	actorID, orgID, userID, ok := h.prepareMember(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.orgService.RemoveMember(r.Context(), actorID, orgID, userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// prepare checks authentication and service availability and returns the person making the
+// request, who manages organizations even while acting as one.
+func (h *OrganizationHandler) prepare(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	actorID, ok := middleware.ActorID(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return uuid.Nil, false
+	}
+
+	if h.orgService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, false
+	}
+
+	return actorID, true
+}
+
+// prepareOrganization additionally parses the org_id path parameter.
+func (h *OrganizationHandler) prepareOrganization(
+	w http.ResponseWriter,
+	r *http.Request,
+) (uuid.UUID, uuid.UUID, bool) {
+	actorID, ok := h.prepare(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	orgID, err := uuid.Parse(chi.URLParam(r, "org_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_ORGANIZATION_ID", "Invalid organization ID format",
+			"org_id", "uuid")
+
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return actorID, orgID, true
+}
+
+// prepareMember additionally parses the user_id path parameter.
+func (h *OrganizationHandler) prepareMember(
+	w http.ResponseWriter,
+	r *http.Request,
+) (uuid.UUID, uuid.UUID, uuid.UUID, bool) {
+	actorID, orgID, ok := h.prepareOrganization(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+
+	return actorID, orgID, userID, true
+}
+
+func (h *OrganizationHandler) handleServiceError(w http.ResponseWriter, err error) {
+	if respondIdentifierConflict(w, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrOrganizationNotFound):
+		ErrorResponse(w, http.StatusNotFound, "ORGANIZATION_NOT_FOUND", "Organization not found")
+	case errors.Is(err, service.ErrNotOrganizationMember):
+		ErrorResponse(w, http.StatusForbidden, "NOT_ORGANIZATION_MEMBER", "Not a member of this organization")
+	case errors.Is(err, service.ErrOrganizationMemberNotFound):
+		ErrorResponse(w, http.StatusNotFound, "MEMBER_NOT_FOUND", "User is not a member of this organization")
+	case errors.Is(err, service.ErrOrganizationOwnerRequired):
+		ErrorResponse(w, http.StatusForbidden, "ORGANIZATION_OWNER_REQUIRED", "Only owners can manage members")
+	case errors.Is(err, service.ErrLastOrganizationOwner):
+		ErrorResponse(w, http.StatusConflict, "LAST_ORGANIZATION_OWNER", "An organization must keep at least one owner")
+	default:
+		slog.Error("organization service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
@@ -0,0 +1,158 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestOrganizationHandler(t *testing.T) {
+	t.Parallel()
+
+	ownerID, editorID, orgID := uuid.New(), uuid.New(), uuid.New()
+	members := &dto.OrganizationMembersResponse{
+		OrgID:   orgID.String(),
+		Members: []dto.OrganizationMember{{UserID: editorID.String(), Role: dto.OrganizationRoleEditor}},
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		mockSetup      func(*mocks.OrganizationService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "create organization",
+			method: http.MethodPost,
+			path:   "/organizations",
+			body:   `{"username":"tasty_kitchen","email":"hello@tasty.example"}`,
+			mockSetup: func(m *mocks.OrganizationService) {
+				m.On("CreateOrganization", mock.Anything, ownerID, mock.Anything).
+					Return(&dto.Organization{OrgID: orgID.String(), Username: "tasty_kitchen"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"username":"tasty_kitchen"`,
+		},
+		{
+			name:           "create organization requires an email",
+			method:         http.MethodPost,
+			path:           "/organizations",
+			body:           `{"username":"tasty_kitchen"}`,
+			mockSetup:      func(*mocks.OrganizationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "username taken",
+			method: http.MethodPost,
+			path:   "/organizations",
+			body:   `{"username":"tasty_kitchen","email":"hello@tasty.example"}`,
+			mockSetup: func(m *mocks.OrganizationService) {
+				m.On("CreateOrganization", mock.Anything, ownerID, mock.Anything).
+					Return(nil, service.ErrDuplicateUsername)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "DUPLICATE_USERNAME",
+		},
+		{
+			name:   "owner sets member",
+			method: http.MethodPut,
+			path:   "/organizations/" + orgID.String() + "/members/" + editorID.String(),
+			body:   `{"role":"editor"}`,
+			mockSetup: func(m *mocks.OrganizationService) {
+				m.On("SetMember", mock.Anything, ownerID, orgID, editorID, dto.OrganizationRoleEditor).
+					Return(members, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"role":"editor"`,
+		},
+		{
+			name:           "unknown member role",
+			method:         http.MethodPut,
+			path:           "/organizations/" + orgID.String() + "/members/" + editorID.String(),
+			body:           `{"role":"admin"}`,
+			mockSetup:      func(*mocks.OrganizationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "editors cannot manage members",
+			method: http.MethodPut,
+			path:   "/organizations/" + orgID.String() + "/members/" + editorID.String(),
+			body:   `{"role":"owner"}`,
+			mockSetup: func(m *mocks.OrganizationService) {
+				m.On("SetMember", mock.Anything, ownerID, orgID, editorID, dto.OrganizationRoleOwner).
+					Return(nil, service.ErrOrganizationOwnerRequired)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "ORGANIZATION_OWNER_REQUIRED",
+		},
+		{
+			name:   "last owner cannot leave",
+			method: http.MethodDelete,
+			path:   "/organizations/" + orgID.String() + "/members/" + ownerID.String(),
+			mockSetup: func(m *mocks.OrganizationService) {
+				m.On("RemoveMember", mock.Anything, ownerID, orgID, ownerID).Return(service.ErrLastOrganizationOwner)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "LAST_ORGANIZATION_OWNER",
+		},
+		{
+			name:           "invalid organization ID",
+			method:         http.MethodGet,
+			path:           "/organizations/tasty/members",
+			mockSetup:      func(*mocks.OrganizationService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_ORGANIZATION_ID",
+		},
+		{
+			name:   "non-members cannot list members",
+			method: http.MethodGet,
+			path:   "/organizations/" + orgID.String() + "/members",
+			mockSetup: func(m *mocks.OrganizationService) {
+				m.On("ListMembers", mock.Anything, ownerID, orgID).Return(nil, service.ErrNotOrganizationMember)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "NOT_ORGANIZATION_MEMBER",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewOrganizationService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewOrganizationHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Post("/organizations", h.CreateOrganization)
+			r.Get("/organizations/{org_id}/members", h.ListMembers)
+			r.Put("/organizations/{org_id}/members/{user_id}", h.SetMember)
+			r.Delete("/organizations/{org_id}/members/{user_id}", h.RemoveMember)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = setAuthenticatedUser(req, ownerID)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// PolicyHandler handles terms of service and privacy policy acceptance.
+type PolicyHandler struct {
+	policyService service.PolicyService
+	binder        *RequestBinder
+}
+
+// NewPolicyHandler creates a new policy handler.
+func NewPolicyHandler(policyService service.PolicyService) *PolicyHandler {
+	return &PolicyHandler{
+		policyService: policyService,
+		binder:        NewRequestBinder(),
+	}
+}
+
+// GetPolicyStatus handles GET /users/account/policies.
+func (h *PolicyHandler) GetPolicyStatus(w http.ResponseWriter, r *http.Request) {
+	// 1. Require authentication
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	if h.policyService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 2. Compare accepted and current versions
+	response, err := h.policyService.GetPolicyStatus(r.Context(), userID)
+	if err != nil {
+		h.handlePolicyError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// AcceptPolicy handles POST /users/account/policies.
+func (h *PolicyHandler) AcceptPolicy(w http.ResponseWriter, r *http.Request) {
+	// 1. Require authentication
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	if h.policyService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 2. Bind and validate request body
+	var req dto.PolicyAcceptanceRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	// 3. Record the acceptance
+	acceptance, err := h.policyService.AcceptPolicy(r.Context(), userID, &req)
+	if err != nil {
+		h.handlePolicyError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusCreated, acceptance)
+}
+
+func (h *PolicyHandler) handlePolicyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrPolicyNotPublished):
+		ErrorResponse(w, http.StatusBadRequest, "POLICY_NOT_PUBLISHED", "Policy document is not published")
+	case errors.Is(err, service.ErrPolicyVersionNotCurrent):
+		ErrorResponse(w, http.StatusConflict, "POLICY_VERSION_NOT_CURRENT", err.Error())
+	default:
+		slog.Error("policy service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
@@ -14,13 +14,13 @@ import (
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
 )
 
 // Scope constants for preference access.
 const (
 	scopeUserRead  = "user:read"
 	scopeUserWrite = "user:write"
-	scopeAdmin     = "admin"
 )
 
 // ErrInvalidPreferenceCategory is returned when an invalid preference category is provided.
@@ -29,13 +29,19 @@ var ErrInvalidPreferenceCategory = errors.New("invalid preference category")
 // PreferenceHandler handles preference-related HTTP endpoints.
 type PreferenceHandler struct {
 	preferenceService service.PreferenceService
+	transferService   service.PreferenceTransferService
 	binder            *RequestBinder
 }
 
-// NewPreferenceHandler creates a new preference handler.
-func NewPreferenceHandler(preferenceService service.PreferenceService) *PreferenceHandler {
+// NewPreferenceHandler creates a new preference handler. A nil transferService makes preference
+// export and import unavailable.
+func NewPreferenceHandler(
+	preferenceService service.PreferenceService,
+	transferService service.PreferenceTransferService,
+) *PreferenceHandler {
 	return &PreferenceHandler{
 		preferenceService: preferenceService,
+		transferService:   transferService,
 		binder:            NewRequestBinder(),
 	}
 }
@@ -57,7 +63,7 @@ func (h *PreferenceHandler) GetAllPreferences(w http.ResponseWriter, r *http.Req
 	// 3. Parse optional categories filter
 	categories, err := h.parseCategoriesParam(r)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "INVALID_CATEGORY", err.Error())
+		FieldErrorResponse(w, http.StatusBadRequest, "INVALID_CATEGORY", err.Error(), fieldsOf(err)...)
 
 		return
 	}
@@ -91,7 +97,8 @@ func (h *PreferenceHandler) GetCategoryPreferences(w http.ResponseWriter, r *htt
 	// 2. Extract and validate category from path
 	category := chi.URLParam(r, "category")
 	if !dto.IsValidPreferenceCategory(category) {
-		ErrorResponse(w, http.StatusBadRequest, "INVALID_CATEGORY", "Invalid preference category")
+		FieldErrorResponse(w, http.StatusBadRequest, "INVALID_CATEGORY", "Invalid preference category",
+			invalidCategory("category", category))
 
 		return
 	}
@@ -173,7 +180,8 @@ func (h *PreferenceHandler) UpdateCategoryPreferences(w http.ResponseWriter, r *
 	// 2. Extract and validate category from path
 	category := chi.URLParam(r, "category")
 	if !dto.IsValidPreferenceCategory(category) {
-		ErrorResponse(w, http.StatusBadRequest, "INVALID_CATEGORY", "Invalid preference category")
+		FieldErrorResponse(w, http.StatusBadRequest, "INVALID_CATEGORY", "Invalid preference category",
+			invalidCategory("category", category))
 
 		return
 	}
@@ -184,8 +192,12 @@ func (h *PreferenceHandler) UpdateCategoryPreferences(w http.ResponseWriter, r *
 		return
 	}
 
-	// 4. Parse request body based on category
+	// 4. Parse and validate request body based on category
 	update, err := h.parseUpdateRequest(r, category)
+	if err == nil {
+		err = h.binder.Validate(update)
+	}
+
 	if err != nil {
 		h.handleBindError(w, err)
 
@@ -211,12 +223,165 @@ func (h *PreferenceHandler) UpdateCategoryPreferences(w http.ResponseWriter, r *
 	SuccessResponse(w, http.StatusOK, response)
 }
 
+// ResetAllPreferences handles DELETE /users/{user_id}/preferences.
+func (h *PreferenceHandler) ResetAllPreferences(w http.ResponseWriter, r *http.Request) {
+	// 1. Extract target user ID from path
+	targetUserID, ok := h.parseUserID(w, r)
+	if !ok {
+		return
+	}
+
+	// 2. Get authenticated user and authorization info
+	requesterID, isAdmin, hasServiceScope, ok := h.extractAuthInfo(w, r)
+	if !ok {
+		return
+	}
+
+	// 3. Parse optional categories filter
+	categories, err := h.parseCategoriesParam(r)
+	if err != nil {
+		FieldErrorResponse(w, http.StatusBadRequest, "INVALID_CATEGORY", err.Error(), fieldsOf(err)...)
+
+		return
+	}
+
+	// 4. Call service
+	response, err := h.preferenceService.ResetAllPreferences(
+		r.Context(),
+		requesterID,
+		targetUserID,
+		categories,
+		isAdmin,
+		hasServiceScope,
+	)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// ResetCategoryPreferences handles DELETE /users/{user_id}/preferences/{category}.
+func (h *PreferenceHandler) ResetCategoryPreferences(w http.ResponseWriter, r *http.Request) {
+	// 1. Extract target user ID from path
+	targetUserID, ok := h.parseUserID(w, r)
+	if !ok {
+		return
+	}
+
+	// 2. Extract and validate category from path
+	category := chi.URLParam(r, "category")
+	if !dto.IsValidPreferenceCategory(category) {
+		FieldErrorResponse(w, http.StatusBadRequest, "INVALID_CATEGORY", "Invalid preference category",
+			invalidCategory("category", category))
+
+		return
+	}
+
+	// 3. Get authenticated user and authorization info
+	requesterID, isAdmin, hasServiceScope, ok := h.extractAuthInfo(w, r)
+	if !ok {
+		return
+	}
+
+	// 4. Call service
+	response, err := h.preferenceService.ResetCategoryPreferences(
+		r.Context(),
+		requesterID,
+		targetUserID,
+		dto.PreferenceCategory(category),
+		isAdmin,
+		hasServiceScope,
+	)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// ExportPreferences handles GET /users/account/preferences/export.
+func (h *PreferenceHandler) ExportPreferences(w http.ResponseWriter, r *http.Request) {
+	// 1. Require authentication; users export their own preferences
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	if h.transferService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 2. Parse optional categories filter
+	categories, err := h.parseCategoriesParam(r)
+	if err != nil {
+		FieldErrorResponse(w, http.StatusBadRequest, "INVALID_CATEGORY", err.Error(), fieldsOf(err)...)
+
+		return
+	}
+
+	// 3. Call service
+	bundle, err := h.transferService.ExportPreferences(r.Context(), userID, categories)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, bundle)
+}
+
+// ImportPreferences handles POST /users/account/preferences/import.
+func (h *PreferenceHandler) ImportPreferences(w http.ResponseWriter, r *http.Request) {
+	// 1. Require authentication; users import into their own account
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	if h.transferService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 2. Bind and validate request body, bundle included
+	var req dto.PreferenceImportRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		h.handleBindError(w, bindErr)
+
+		return
+	}
+
+	// 3. Call service
+	response, err := h.transferService.ImportPreferences(r.Context(), userID, &req)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
 func (h *PreferenceHandler) parseUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
 	userIDStr := chi.URLParam(r, "user_id")
 
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format")
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
 
 		return uuid.Nil, false
 	}
@@ -228,24 +393,36 @@ func (h *PreferenceHandler) extractAuthInfo(
 	w http.ResponseWriter,
 	r *http.Request,
 ) (uuid.UUID, bool, bool, bool) {
-	authUser, ok := middleware.GetAuthenticatedUser(r.Context())
-	if !ok {
+	if _, ok := middleware.GetAuthenticatedUser(r.Context()); !ok {
 		UnauthorizedResponse(w, "Authentication required")
 
 		return uuid.Nil, false, false, false
 	}
 
+	requesterID, isAdmin, hasServiceScope := requesterScopes(r)
+
+	return requesterID, isAdmin, hasServiceScope, true
+}
+
+// requesterScopes returns the requester's ID and whether it is an admin, by scope or stored role,
+// or a service account with user scopes. Anonymous requests get uuid.Nil and no scopes.
+func requesterScopes(r *http.Request) (uuid.UUID, bool, bool) {
+	authUser, ok := middleware.GetAuthenticatedUser(r.Context())
+	if !ok {
+		return uuid.Nil, false, false
+	}
+
 	// For service accounts, use a nil UUID as requester ID
 	requesterID := authUser.UserID
 
-	// Check admin scope
-	isAdmin := middleware.HasScope(r.Context(), scopeAdmin)
+	// Check admin scope or role
+	isAdmin := middleware.IsAdmin(r.Context())
 
 	// Check if service account with proper scopes
 	hasServiceScope := authUser.IsService &&
 		(middleware.HasScope(r.Context(), scopeUserRead) || middleware.HasScope(r.Context(), scopeUserWrite))
 
-	return requesterID, isAdmin, hasServiceScope, true
+	return requesterID, isAdmin, hasServiceScope
 }
 
 func (h *PreferenceHandler) parseCategoriesParam(r *http.Request) ([]dto.PreferenceCategory, error) {
@@ -266,7 +443,10 @@ func (h *PreferenceHandler) parseCategoriesParam(r *http.Request) ([]dto.Prefere
 			}
 
 			if !dto.IsValidPreferenceCategory(cat) {
-				return nil, fmt.Errorf("%w: %s", ErrInvalidPreferenceCategory, cat)
+				return nil, &fieldError{
+					cause: fmt.Errorf("%w: %s", ErrInvalidPreferenceCategory, cat),
+					field: invalidCategory("categories", cat),
+				}
 			}
 
 			categories = append(categories, dto.PreferenceCategory(cat))
@@ -276,6 +456,11 @@ func (h *PreferenceHandler) parseCategoriesParam(r *http.Request) ([]dto.Prefere
 	return categories, nil
 }
 
+// invalidCategory describes a field naming an unknown preference category.
+func invalidCategory(name, value string) validation.ValidationError {
+	return validation.Invalid(name, "oneof", strings.Join(dto.PreferenceCategory(value).Values(), ", "), value)
+}
+
 //nolint:cyclop,funlen // Switch over 9 categories is inherent to domain design.
 func (h *PreferenceHandler) parseUpdateRequest(r *http.Request, category string) (any, error) {
 	switch dto.PreferenceCategory(category) {
@@ -373,6 +558,17 @@ func (h *PreferenceHandler) handleServiceError(w http.ResponseWriter, err error)
 		ForbiddenResponse(w, "Not authorized to access these preferences")
 	case errors.Is(err, service.ErrInvalidCategory):
 		ErrorResponse(w, http.StatusBadRequest, "INVALID_CATEGORY", "Invalid preference category")
+	case errors.Is(err, service.ErrCategoryDisabled):
+		ErrorResponse(w, http.StatusNotFound, "CATEGORY_DISABLED", "Preference category is not available")
+	case errors.Is(err, service.ErrConsentRequired):
+		ErrorResponse(w, http.StatusConflict, "CONSENT_REQUIRED", err.Error())
+	case errors.Is(err, service.ErrAgeRestricted):
+		ErrorResponse(w, http.StatusForbidden, "AGE_RESTRICTED", "Profiles of minors must stay private")
+	case errors.Is(err, service.ErrPreferenceBundleVersion):
+		ErrorResponse(w, http.StatusBadRequest, "UNSUPPORTED_BUNDLE_VERSION", err.Error())
+	case errors.Is(err, service.ErrPreferenceBundleInvalid):
+		ErrorResponse(w, http.StatusBadRequest, "INVALID_PREFERENCE_BUNDLE",
+			"Preference bundle was modified or not exported by this service")
 	default:
 		slog.Error("preference service error", "error", err)
 		InternalErrorResponse(w)
@@ -384,7 +580,7 @@ func (h *PreferenceHandler) handleBindError(w http.ResponseWriter, err error) {
 	case errors.Is(err, ErrEmptyBody):
 		ErrorResponse(w, http.StatusBadRequest, "EMPTY_BODY", "Request body is required")
 	case errors.Is(err, ErrInvalidJSON), errors.Is(err, ErrInvalidFieldType):
-		ErrorResponse(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		FieldErrorResponse(w, http.StatusBadRequest, "INVALID_JSON", err.Error(), fieldsOf(err)...)
 	case errors.Is(err, ErrValidationFailed):
 		ValidationErrorResponse(w, err)
 	default:
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// PreferenceCenterHandler handles the preference center links in emails, which let recipients
+// manage the emails they receive without signing in.
+type PreferenceCenterHandler struct {
+	preferenceCenterService service.PreferenceCenterService
+	binder                  *RequestBinder
+}
+
+// NewPreferenceCenterHandler creates a new preference center handler.
+func NewPreferenceCenterHandler(preferenceCenterService service.PreferenceCenterService) *PreferenceCenterHandler {
+	return &PreferenceCenterHandler{
+		preferenceCenterService: preferenceCenterService,
+		binder:                  NewRequestBinder(),
+	}
+}
+
+// GetPreferences handles GET /preference-center/{token}. The token authorizes the request, so it
+// does not require authentication.
+func (h *PreferenceCenterHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	if h.preferenceCenterService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	response, err := h.preferenceCenterService.GetPreferences(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// UpdatePreferences handles POST /preference-center/{token}.
+func (h *PreferenceCenterHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	if h.preferenceCenterService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	var req dto.PreferenceCenterUpdateRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	response, err := h.preferenceCenterService.UpdatePreferences(r.Context(), chi.URLParam(r, "token"), &req)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// RevokeLinks handles DELETE /users/account/preference-center-links.
+func (h *PreferenceCenterHandler) RevokeLinks(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	if h.preferenceCenterService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	err := h.preferenceCenterService.RevokeTokens(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// IssueToken handles POST /internal/v1/users/{user_id}/preference-center-token, called by the
+// services sending emails.
+func (h *PreferenceCenterHandler) IssueToken(w http.ResponseWriter, r *http.Request) {
+	// 1. Only service accounts with write scope (or admins) may issue links
+	if !canWriteUserData(r) {
+		ForbiddenResponse(w, "Service token with user:write scope required")
+
+		return
+	}
+
+	if h.preferenceCenterService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 2. Parse the target user
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return
+	}
+
+	response, err := h.preferenceCenterService.IssueToken(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusCreated, response)
+}
+
+func (h *PreferenceCenterHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrPreferenceCenterLinkInvalid):
+		ErrorResponse(w, http.StatusNotFound, "PREFERENCE_CENTER_LINK_INVALID",
+			"Preference center link is invalid or has been revoked")
+	case errors.Is(err, service.ErrPreferenceCenterLinkExpired):
+		ErrorResponse(w, http.StatusGone, "PREFERENCE_CENTER_LINK_EXPIRED", "Preference center link has expired")
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrCategoryDisabled):
+		ErrorResponse(w, http.StatusNotFound, "CATEGORY_DISABLED", "Preference category is not available")
+	case errors.Is(err, service.ErrConsentRequired):
+		ErrorResponse(w, http.StatusConflict, "CONSENT_REQUIRED", err.Error())
+	default:
+		slog.Error("preference center service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
@@ -0,0 +1,153 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPreferenceCenterHandler(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	anonymous := func(r *http.Request) *http.Request { return r }
+	off := false
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.PreferenceCenterService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:      "anonymous reads preferences",
+			method:    http.MethodGet,
+			path:      "/preference-center/TOKEN",
+			authorize: anonymous,
+			mockSetup: func(m *mocks.PreferenceCenterService) {
+				m.On("GetPreferences", mock.Anything, "TOKEN").Return(&dto.PreferenceCenterResponse{
+					Notification: &dto.NotificationPreferences{MarketingEmails: true},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"marketingEmails":true`,
+		},
+		{
+			name:      "revoked link",
+			method:    http.MethodGet,
+			path:      "/preference-center/OLD",
+			authorize: anonymous,
+			mockSetup: func(m *mocks.PreferenceCenterService) {
+				m.On("GetPreferences", mock.Anything, "OLD").Return(nil, service.ErrPreferenceCenterLinkInvalid)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "PREFERENCE_CENTER_LINK_INVALID",
+		},
+		{
+			name:      "expired link",
+			method:    http.MethodGet,
+			path:      "/preference-center/OLD",
+			authorize: anonymous,
+			mockSetup: func(m *mocks.PreferenceCenterService) {
+				m.On("GetPreferences", mock.Anything, "OLD").Return(nil, service.ErrPreferenceCenterLinkExpired)
+			},
+			expectedStatus: http.StatusGone,
+			expectedBody:   "PREFERENCE_CENTER_LINK_EXPIRED",
+		},
+		{
+			name:      "anonymous unsubscribes",
+			method:    http.MethodPost,
+			path:      "/preference-center/TOKEN",
+			body:      `{"marketingEmails":false}`,
+			authorize: anonymous,
+			mockSetup: func(m *mocks.PreferenceCenterService) {
+				m.On("UpdatePreferences", mock.Anything, "TOKEN",
+					&dto.PreferenceCenterUpdateRequest{MarketingEmails: &off}).
+					Return(&dto.PreferenceCenterResponse{Notification: &dto.NotificationPreferences{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"marketingEmails":false`,
+		},
+		{
+			name:      "user revokes links",
+			method:    http.MethodDelete,
+			path:      "/users/account/preference-center-links",
+			authorize: func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			mockSetup: func(m *mocks.PreferenceCenterService) {
+				m.On("RevokeTokens", mock.Anything, userID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "revoking requires authentication",
+			method:         http.MethodDelete,
+			path:           "/users/account/preference-center-links",
+			authorize:      anonymous,
+			mockSetup:      func(*mocks.PreferenceCenterService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:      "service account issues a link",
+			method:    http.MethodPost,
+			path:      "/internal/v1/users/" + userID.String() + "/preference-center-token",
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:write") },
+			mockSetup: func(m *mocks.PreferenceCenterService) {
+				m.On("IssueToken", mock.Anything, userID).
+					Return(&dto.PreferenceCenterTokenResponse{Token: "TOKEN"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"token":"TOKEN"`,
+		},
+		{
+			name:           "regular user cannot issue links",
+			method:         http.MethodPost,
+			path:           "/internal/v1/users/" + userID.String() + "/preference-center-token",
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			mockSetup:      func(*mocks.PreferenceCenterService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewPreferenceCenterService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewPreferenceCenterHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/preference-center/{token}", h.GetPreferences)
+			r.Post("/preference-center/{token}", h.UpdatePreferences)
+			r.Delete("/users/account/preference-center-links", h.RevokeLinks)
+			r.Post("/internal/v1/users/{user_id}/preference-center-token", h.IssueToken)
+
+			req := httptest.NewRequestWithContext(context.Background(), tt.method, tt.path,
+				bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedBody)
+		})
+	}
+}
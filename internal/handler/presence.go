@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// PresenceHandler handles the online status and last seen time of users.
+type PresenceHandler struct {
+	presenceService service.PresenceService
+}
+
+// NewPresenceHandler creates a new presence handler.
+func NewPresenceHandler(presenceService service.PresenceService) *PresenceHandler {
+	return &PresenceHandler{presenceService: presenceService}
+}
+
+// Heartbeat handles POST /internal/v1/users/{user_id}/heartbeat.
+func (h *PresenceHandler) Heartbeat(w http.ResponseWriter, r *http.Request) {
+	// 1. Only service accounts with write scope (or admins) may report heartbeats
+	if !canWriteUserData(r) {
+		ForbiddenResponse(w, "Service token with user:write scope required")
+
+		return
+	}
+
+	if h.presenceService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 2. Parse the user
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return
+	}
+
+	// 3. Call service
+	err = h.presenceService.Heartbeat(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetPresence handles GET /users/{user_id}/presence.
+func (h *PresenceHandler) GetPresence(w http.ResponseWriter, r *http.Request) {
+	if h.presenceService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 1. Extract optional requester ID (anonymous access allowed)
+	var requesterID *uuid.UUID
+	if id, ok := middleware.GetUserIDFromContext(r.Context()); ok && id != uuid.Nil {
+		requesterID = &id
+	}
+
+	// 2. Parse the user
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
+
+		return
+	}
+
+	// 3. Call service
+	response, err := h.presenceService.GetPresence(r.Context(), requesterID, userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+func (h *PresenceHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	default:
+		slog.Error("presence service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
+
+// canWriteUserData reports whether the requester is a service account with the user:write
+// scope, or an admin.
+func canWriteUserData(r *http.Request) bool {
+	authUser, ok := middleware.GetAuthenticatedUser(r.Context())
+	if !ok {
+		return false
+	}
+
+	if middleware.IsAdmin(r.Context()) {
+		return true
+	}
+
+	return authUser.IsService && middleware.HasScope(r.Context(), scopeUserWrite)
+}
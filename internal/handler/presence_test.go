@@ -0,0 +1,216 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPresenceHandlerHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		path           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.PresenceService)
+		expectedStatus int
+	}{
+		{
+			name:      "service account reports a heartbeat",
+			path:      "/internal/v1/users/" + userID.String() + "/heartbeat",
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:write") },
+			mockSetup: func(m *mocks.PresenceService) {
+				m.On("Heartbeat", mock.Anything, userID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "read scope is forbidden",
+			path:           "/internal/v1/users/" + userID.String() + "/heartbeat",
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockSetup:      func(_ *mocks.PresenceService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "regular user is forbidden",
+			path:           "/internal/v1/users/" + userID.String() + "/heartbeat",
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			mockSetup:      func(_ *mocks.PresenceService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "invalid user ID",
+			path:           "/internal/v1/users/not-a-uuid/heartbeat",
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "user:write") },
+			mockSetup:      func(_ *mocks.PresenceService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewPresenceService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewPresenceHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Post("/internal/v1/users/{user_id}/heartbeat", h.Heartbeat)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, tt.path, nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestPresenceHandlerGetPresence(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("anonymous requester", func(t *testing.T) {
+		t.Parallel()
+
+		mockSvc := mocks.NewPresenceService(t)
+		mockSvc.On("GetPresence", mock.Anything, (*uuid.UUID)(nil), userID).Return(&dto.UserPresenceResponse{
+			UserID:   userID.String(),
+			Presence: dto.Presence{Status: dto.PresenceOffline},
+		}, nil)
+
+		rr := servePresence(t, handler.NewPresenceHandler(mockSvc), userID.String())
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"userId":"`+userID.String()+`","status":"offline"}`, rr.Body.String())
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		t.Parallel()
+
+		mockSvc := mocks.NewPresenceService(t)
+		mockSvc.On("GetPresence", mock.Anything, (*uuid.UUID)(nil), userID).Return(nil, service.ErrUserNotFound)
+
+		rr := servePresence(t, handler.NewPresenceHandler(mockSvc), userID.String())
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		rr := servePresence(t, handler.NewPresenceHandler(mocks.NewPresenceService(t)), "not-a-uuid")
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("presence unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		rr := servePresence(t, handler.NewPresenceHandler(nil), userID.String())
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+}
+
+func servePresence(t *testing.T, h *handler.PresenceHandler, userID string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	r := chi.NewRouter()
+	r.Get("/users/{user_id}/presence", h.GetPresence)
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/users/"+userID+"/presence", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	return rr
+}
+
+func TestSocialHandlerGetFollowers_IncludePresence(t *testing.T) {
+	t.Parallel()
+
+	requesterID, followerID := uuid.New(), uuid.New()
+	newFollowers := func() *dto.GetFollowedUsersResponse {
+		return &dto.GetFollowedUsersResponse{
+			TotalCount:    1,
+			FollowedUsers: []dto.User{{UserID: followerID.String(), Username: "bob"}},
+		}
+	}
+
+	serve := func(h *handler.SocialHandler, query string) *httptest.ResponseRecorder {
+		r := chi.NewRouter()
+		r.Get("/users/{user_id}/followers", h.GetFollowers)
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+			"/users/"+requesterID.String()+"/followers"+query, nil)
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, setAuthenticatedUser(req, requesterID))
+
+		return rr
+	}
+
+	t.Run("attaches presence", func(t *testing.T) {
+		t.Parallel()
+
+		socialSvc := mocks.NewSocialService(t)
+		presenceSvc := mocks.NewPresenceService(t)
+		followers := newFollowers()
+
+		socialSvc.On("GetFollowers", mock.Anything, requesterID, requesterID, 20, 0, false).Return(followers, nil)
+		presenceSvc.On("AttachPresence", mock.Anything, requesterID, followers.FollowedUsers).
+			Run(func(args mock.Arguments) {
+				args.Get(2).([]dto.User)[0].Presence = &dto.Presence{Status: dto.PresenceHidden}
+			}).Return(nil)
+
+		rr := serve(handler.NewSocialHandler(socialSvc, presenceSvc), "?includePresence=true")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response dto.GetFollowedUsersResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, &dto.Presence{Status: dto.PresenceHidden}, response.FollowedUsers[0].Presence)
+	})
+
+	t.Run("invalid includePresence", func(t *testing.T) {
+		t.Parallel()
+
+		rr := serve(handler.NewSocialHandler(mocks.NewSocialService(t), nil), "?includePresence=maybe")
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, []dto.FieldError{{
+			Field:         "includePresence",
+			Code:          "boolean",
+			Message:       "must be true or false",
+			RejectedValue: "maybe",
+		}}, fieldErrors(t, rr.Body.String()))
+	})
+
+	t.Run("presence unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		socialSvc := mocks.NewSocialService(t)
+		socialSvc.On("GetFollowers", mock.Anything, requesterID, requesterID, 20, 0, false).Return(newFollowers(), nil)
+
+		rr := serve(handler.NewSocialHandler(socialSvc, nil), "?includePresence=true")
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// PrivacyDefaultsHandler reports the privacy defaults versions users are on and migrates cohorts
+// between them.
+type PrivacyDefaultsHandler struct {
+	privacyDefaultsService service.PrivacyDefaultsService
+	binder                 *RequestBinder
+}
+
+// NewPrivacyDefaultsHandler creates a new privacy defaults handler.
+func NewPrivacyDefaultsHandler(privacyDefaultsService service.PrivacyDefaultsService) *PrivacyDefaultsHandler {
+	return &PrivacyDefaultsHandler{
+		privacyDefaultsService: privacyDefaultsService,
+		binder:                 NewRequestBinder(),
+	}
+}
+
+// GetPrivacyDefaults handles GET /admin/preferences/privacy-defaults.
+func (h *PrivacyDefaultsHandler) GetPrivacyDefaults(w http.ResponseWriter, r *http.Request) {
+	if _, ok := adminRequester(w, r); !ok || !h.available(w) {
+		return
+	}
+
+	response, err := h.privacyDefaultsService.GetPrivacyDefaults(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to get privacy defaults", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// MigratePrivacyDefaults handles POST /admin/preferences/privacy-defaults/migrate.
+func (h *PrivacyDefaultsHandler) MigratePrivacyDefaults(w http.ResponseWriter, r *http.Request) {
+	actorID, ok := adminRequester(w, r)
+	if !ok || !h.available(w) {
+		return
+	}
+
+	var req dto.PrivacyDefaultsMigrationRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	response, err := h.privacyDefaultsService.MigratePrivacyDefaults(r.Context(), actorID, &req)
+	if err != nil {
+		if errors.Is(err, service.ErrUnknownPrivacyDefaultsVersion) {
+			ErrorResponse(w, http.StatusBadRequest, "UNKNOWN_PRIVACY_DEFAULTS_VERSION",
+				"The privacy defaults version does not exist")
+
+			return
+		}
+
+		slog.ErrorContext(r.Context(), "failed to migrate privacy defaults", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+func (h *PrivacyDefaultsHandler) available(w http.ResponseWriter) bool {
+	if h.privacyDefaultsService == nil {
+		ServiceUnavailableResponse(w, "Privacy defaults are not available")
+
+		return false
+	}
+
+	return true
+}
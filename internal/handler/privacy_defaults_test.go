@@ -0,0 +1,123 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPrivacyDefaultsHandler(t *testing.T) {
+	t.Parallel()
+
+	adminID, userID := uuid.New(), uuid.New()
+	migration := &dto.PrivacyDefaultsMigrationRequest{FromVersion: 1, ToVersion: 2, Limit: 100}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.PrivacyDefaultsService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:      "admin reads the cohorts",
+			method:    http.MethodGet,
+			path:      "/admin/preferences/privacy-defaults",
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.PrivacyDefaultsService) {
+				m.On("GetPrivacyDefaults", mock.Anything).Return(&dto.PrivacyDefaultsStatusResponse{
+					CurrentVersion: 2,
+					LatestVersion:  2,
+					Cohorts:        []dto.PrivacyDefaultsCohort{{Version: 1, Users: 40}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"cohorts":[{"version":1,"users":40}]`,
+		},
+		{
+			name:      "admin migrates a batch",
+			method:    http.MethodPost,
+			path:      "/admin/preferences/privacy-defaults/migrate",
+			body:      `{"fromVersion":1,"toVersion":2,"limit":100}`,
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.PrivacyDefaultsService) {
+				m.On("MigratePrivacyDefaults", mock.Anything, adminID, migration).
+					Return(&dto.PrivacyDefaultsMigrationResponse{FromVersion: 1, ToVersion: 2, Moved: 100}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"moved":100`,
+		},
+		{
+			name:           "same version is invalid",
+			method:         http.MethodPost,
+			path:           "/admin/preferences/privacy-defaults/migrate",
+			body:           `{"fromVersion":1,"toVersion":1,"limit":100}`,
+			authorize:      func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup:      func(*mocks.PrivacyDefaultsService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "unknown version",
+			method:    http.MethodPost,
+			path:      "/admin/preferences/privacy-defaults/migrate",
+			body:      `{"fromVersion":1,"toVersion":9,"limit":100}`,
+			authorize: func(r *http.Request) *http.Request { return withAdmin(r, adminID) },
+			mockSetup: func(m *mocks.PrivacyDefaultsService) {
+				m.On("MigratePrivacyDefaults", mock.Anything, adminID, mock.Anything).
+					Return(nil, service.ErrUnknownPrivacyDefaultsVersion)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "UNKNOWN_PRIVACY_DEFAULTS_VERSION",
+		},
+		{
+			name:           "non-admin is forbidden",
+			method:         http.MethodGet,
+			path:           "/admin/preferences/privacy-defaults",
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			mockSetup:      func(*mocks.PrivacyDefaultsService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewPrivacyDefaultsService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewPrivacyDefaultsHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/admin/preferences/privacy-defaults", h.GetPrivacyDefaults)
+			r.Post("/admin/preferences/privacy-defaults/migrate", h.MigratePrivacyDefaults)
+
+			req := httptest.NewRequestWithContext(context.Background(), tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
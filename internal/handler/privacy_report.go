@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// PrivacyReportHandler handles the data transparency report endpoint.
+type PrivacyReportHandler struct {
+	privacyReportService service.PrivacyReportService
+}
+
+// NewPrivacyReportHandler creates a new privacy report handler.
+func NewPrivacyReportHandler(privacyReportService service.PrivacyReportService) *PrivacyReportHandler {
+	return &PrivacyReportHandler{
+		privacyReportService: privacyReportService,
+	}
+}
+
+// GetPrivacyReport handles GET /users/account/privacy-report.
+func (h *PrivacyReportHandler) GetPrivacyReport(w http.ResponseWriter, r *http.Request) {
+	// 1. Require authentication; the report is only available about yourself
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	if h.privacyReportService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return
+	}
+
+	// 2. Build the report
+	report, err := h.privacyReportService.GetPrivacyReport(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+
+			return
+		}
+
+		slog.Error("failed to build privacy report", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, report)
+}
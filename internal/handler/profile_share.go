@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// ProfileShareHandler handles profile share token endpoints.
+type ProfileShareHandler struct {
+	profileShareService service.ProfileShareService
+	profileViewService  service.ProfileViewService
+}
+
+// NewProfileShareHandler creates a new profile share handler. Views of shared profiles are not
+// counted when profileViewService is nil.
+func NewProfileShareHandler(
+	profileShareService service.ProfileShareService,
+	profileViewService service.ProfileViewService,
+) *ProfileShareHandler {
+	return &ProfileShareHandler{
+		profileShareService: profileShareService,
+		profileViewService:  profileViewService,
+	}
+}
+
+// GetShareToken handles GET /users/profile/share-token.
+func (h *ProfileShareHandler) GetShareToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	response, err := h.profileShareService.CreateShareToken(r.Context(), userID)
+	if err != nil {
+		handleProfileShareError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// RevokeShareToken handles DELETE /users/profile/share-token.
+func (h *ProfileShareHandler) RevokeShareToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	err := h.profileShareService.RevokeShareToken(r.Context(), userID)
+	if err != nil {
+		handleProfileShareError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetSharedProfile handles GET /shared-profiles/{token}. It does not require authentication, so
+// views are counted as anonymous.
+func (h *ProfileShareHandler) GetSharedProfile(w http.ResponseWriter, r *http.Request) {
+	response, err := h.profileShareService.GetSharedProfile(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		handleProfileShareError(w, err)
+
+		return
+	}
+
+	if userID, err := uuid.Parse(response.UserID); err == nil {
+		recordProfileView(r, h.profileViewService, userID, uuid.Nil)
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+func handleProfileShareError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrShareTokenInvalid):
+		ErrorResponse(w, http.StatusNotFound, "SHARED_PROFILE_NOT_FOUND", "Share link is invalid or has expired")
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrProfilePrivate):
+		ErrorResponse(w, http.StatusForbidden, "PROFILE_PRIVATE", "Private profiles cannot be shared")
+	case errors.Is(err, service.ErrCacheUnavailable):
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+	default:
+		slog.Error("failed to process profile share request", "error", err)
+		InternalErrorResponse(w)
+	}
+}
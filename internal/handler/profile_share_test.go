@@ -0,0 +1,170 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestProfileShareHandlerShareToken(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		method         string
+		authenticated  bool
+		mockSetup      func(*mocks.ProfileShareService)
+		expectedStatus int
+	}{
+		{
+			name:          "issue token",
+			method:        http.MethodGet,
+			authenticated: true,
+			mockSetup: func(m *mocks.ProfileShareService) {
+				m.On("CreateShareToken", mock.Anything, userID).Return(&dto.ProfileShareTokenResponse{
+					Token:     "payload.sig",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:          "issue token for private profile",
+			method:        http.MethodGet,
+			authenticated: true,
+			mockSetup: func(m *mocks.ProfileShareService) {
+				m.On("CreateShareToken", mock.Anything, userID).Return(nil, service.ErrProfilePrivate)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "issue token unauthenticated",
+			method:         http.MethodGet,
+			mockSetup:      func(_ *mocks.ProfileShareService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "revoke token",
+			method:        http.MethodDelete,
+			authenticated: true,
+			mockSetup: func(m *mocks.ProfileShareService) {
+				m.On("RevokeShareToken", mock.Anything, userID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:          "revoke token with cache down",
+			method:        http.MethodDelete,
+			authenticated: true,
+			mockSetup: func(m *mocks.ProfileShareService) {
+				m.On("RevokeShareToken", mock.Anything, userID).Return(service.ErrCacheUnavailable)
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.ProfileShareService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewProfileShareHandler(mockSvc, nil)
+
+			req := httptest.NewRequestWithContext(context.Background(), tt.method, "/users/profile/share-token", nil)
+			if tt.authenticated {
+				req = setAuthenticatedUser(req, userID)
+			}
+
+			rr := httptest.NewRecorder()
+
+			if tt.method == http.MethodDelete {
+				h.RevokeShareToken(rr, req)
+			} else {
+				h.GetShareToken(rr, req)
+			}
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProfileShareHandlerGetSharedProfile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		mockSetup      func(*mocks.ProfileShareService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "valid token",
+			mockSetup: func(m *mocks.ProfileShareService) {
+				m.On("GetSharedProfile", mock.Anything, "payload.sig").
+					Return(&dto.UserProfileResponse{Username: "janedoe"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "janedoe",
+		},
+		{
+			name: "revoked token",
+			mockSetup: func(m *mocks.ProfileShareService) {
+				m.On("GetSharedProfile", mock.Anything, "payload.sig").Return(nil, service.ErrShareTokenInvalid)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "SHARED_PROFILE_NOT_FOUND",
+		},
+		{
+			name: "database error",
+			mockSetup: func(m *mocks.ProfileShareService) {
+				m.On("GetSharedProfile", mock.Anything, "payload.sig").Return(nil, errDB)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.ProfileShareService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewProfileShareHandler(mockSvc, nil)
+
+			r := chi.NewRouter()
+			r.Get("/shared-profiles/{token}", h.GetSharedProfile)
+
+			// No authenticated user: shared profiles are viewable anonymously
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/shared-profiles/payload.sig", nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
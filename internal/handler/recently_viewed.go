@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// RecentlyViewedHandler handles the profiles users recently viewed.
+type RecentlyViewedHandler struct {
+	recentlyViewedService service.RecentlyViewedService
+}
+
+// NewRecentlyViewedHandler creates a new recently viewed handler.
+func NewRecentlyViewedHandler(recentlyViewedService service.RecentlyViewedService) *RecentlyViewedHandler {
+	return &RecentlyViewedHandler{recentlyViewedService: recentlyViewedService}
+}
+
+// GetRecentlyViewed handles GET /users/me/recently-viewed.
+func (h *RecentlyViewedHandler) GetRecentlyViewed(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepareSelf(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.recentlyViewedService.GetRecentlyViewed(r.Context(), userID)
+	if err != nil {
+		slog.Error("failed to get recently viewed profiles", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// ClearRecentlyViewed handles DELETE /users/me/recently-viewed.
+func (h *RecentlyViewedHandler) ClearRecentlyViewed(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.prepareSelf(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.recentlyViewedService.ClearRecentlyViewed(r.Context(), userID)
+	if err != nil {
+		slog.Error("failed to clear recently viewed profiles", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// prepareSelf checks authentication and service availability for the requester's own list.
+func (h *RecentlyViewedHandler) prepareSelf(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return uuid.Nil, false
+	}
+
+	if h.recentlyViewedService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// recordRecentlyViewed adds the profile of userID to the recently viewed profiles of requesterID,
+// unless anonymous. Like view counting it is best effort.
+func recordRecentlyViewed(
+	r *http.Request,
+	recentlyViewed service.RecentlyViewedService,
+	userID, requesterID uuid.UUID,
+) {
+	if recentlyViewed == nil || requesterID == uuid.Nil {
+		return
+	}
+
+	err := recentlyViewed.RecordView(r.Context(), requesterID, userID)
+	if err != nil {
+		slog.WarnContext(r.Context(), "failed to record recently viewed profile", "error", err)
+	}
+}
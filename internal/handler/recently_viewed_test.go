@@ -0,0 +1,109 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestRecentlyViewedHandlerGetRecentlyViewed(t *testing.T) {
+	t.Parallel()
+
+	userID, viewedID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name           string
+		authenticated  bool
+		withService    bool
+		mockSetup      func(*mocks.RecentlyViewedService)
+		expectedStatus int
+	}{
+		{
+			name:          "success",
+			authenticated: true,
+			withService:   true,
+			mockSetup: func(m *mocks.RecentlyViewedService) {
+				m.On("GetRecentlyViewed", mock.Anything, userID).Return(&dto.RecentlyViewedResponse{
+					Profiles:  []dto.RecentlyViewedProfile{{UserID: viewedID.String(), Username: "chef"}},
+					Recording: true,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unauthenticated",
+			withService:    true,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "not available",
+			authenticated:  true,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:          "service error",
+			authenticated: true,
+			withService:   true,
+			mockSetup: func(m *mocks.RecentlyViewedService) {
+				m.On("GetRecentlyViewed", mock.Anything, userID).Return(nil, errDB)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var svc service.RecentlyViewedService
+
+			if tt.withService {
+				mockSvc := mocks.NewRecentlyViewedService(t)
+				if tt.mockSetup != nil {
+					tt.mockSetup(mockSvc)
+				}
+
+				svc = mockSvc
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/users/me/recently-viewed", nil)
+			if tt.authenticated {
+				req = setAuthenticatedUser(req, userID)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.NewRecentlyViewedHandler(svc).GetRecentlyViewed(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.JSONEq(t, `{"profiles": [{"userId": "`+viewedID.String()+`", "username": "chef"}],
+					"recording": true}`, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestRecentlyViewedHandlerClearRecentlyViewed(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	mockSvc := mocks.NewRecentlyViewedService(t)
+	mockSvc.On("ClearRecentlyViewed", mock.Anything, userID).Return(nil)
+
+	req := setAuthenticatedUser(httptest.NewRequest(http.MethodDelete, "/users/me/recently-viewed", nil), userID)
+	rr := httptest.NewRecorder()
+	handler.NewRecentlyViewedHandler(mockSvc).ClearRecentlyViewed(rr, req)
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
@@ -1,25 +1,17 @@
 package handler
 
 import (
-	"encoding/json"
 	"errors"
 	"net/http"
 
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/respond"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
 )
 
 // JSONResponse writes a JSON response with the given status code.
 func JSONResponse(w http.ResponseWriter, status int, data any) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-
-	if data != nil {
-		err := json.NewEncoder(w).Encode(data)
-		if err != nil {
-			http.Error(w, "failed to encode response", http.StatusInternalServerError)
-		}
-	}
+	respond.JSON(w, status, data)
 }
 
 func SuccessResponse(w http.ResponseWriter, status int, data any) {
@@ -27,25 +19,32 @@ func SuccessResponse(w http.ResponseWriter, status int, data any) {
 }
 
 func ErrorResponse(w http.ResponseWriter, status int, code, message string) {
-	JSONResponse(w, status, dto.Error{
-		Code:    code,
-		Message: message,
-	})
+	respond.Error(w, status, code, message, nil)
 }
 
 // ValidationErrorResponse writes a validation error response.
 func ValidationErrorResponse(w http.ResponseWriter, err error) {
-	response := dto.Error{
-		Code:    "VALIDATION_ERROR",
-		Message: "Request validation failed",
-	}
-
 	var validationErrs validation.ValidationErrors
-	if errors.As(err, &validationErrs) {
-		response.Details = validationErrs.ToMap()
+
+	errors.As(err, &validationErrs)
+
+	FieldErrorResponse(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", validationErrs...)
+}
+
+// FieldErrorResponse writes an error response listing the fields that failed validation.
+func FieldErrorResponse(w http.ResponseWriter, status int, code, message string, fields ...validation.ValidationError) {
+	response := dto.Error{Code: code, Message: message}
+
+	if len(fields) > 0 {
+		response.Details = validation.ValidationErrors(fields).ToMap()
+		response.Errors = make([]dto.FieldError, len(fields))
+
+		for i, field := range fields {
+			response.Errors[i] = dto.FieldError(field)
+		}
 	}
 
-	JSONResponse(w, http.StatusBadRequest, response)
+	respond.Problem(w, status, response)
 }
 
 // NotFoundResponse writes a 404 not found response.
@@ -0,0 +1,59 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+)
+
+func followerPage(size int) *dto.GetFollowedUsersResponse {
+	users := make([]dto.User, size)
+	now := time.Now()
+
+	for i := range users {
+		email := fmt.Sprintf("follower%d@example.com", i)
+		fullName := fmt.Sprintf("Follower Number %d", i)
+		users[i] = dto.User{
+			UserID:    uuid.NewString(),
+			Username:  fmt.Sprintf("follower_%d", i),
+			Email:     &email,
+			FullName:  &fullName,
+			IsActive:  true,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+
+	limit, offset := size, 0
+
+	return &dto.GetFollowedUsersResponse{
+		TotalCount:    size * 10, //nolint:mnd // more followers than one page
+		FollowedUsers: users,
+		Limit:         &limit,
+		Offset:        &offset,
+	}
+}
+
+// BenchmarkJSONResponse_FollowerPage measures encoding large follower pages. Compare codecs with
+// `go test -bench JSONResponse -tags jsonv2 ./internal/handler/`.
+func BenchmarkJSONResponse_FollowerPage(b *testing.B) {
+	for _, size := range []int{20, 100, 1000} {
+		page := followerPage(size)
+
+		b.Run(fmt.Sprintf("users=%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for b.Loop() {
+				rr := httptest.NewRecorder()
+				handler.JSONResponse(rr, http.StatusOK, page)
+			}
+		})
+	}
+}
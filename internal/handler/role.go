@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// RoleHandler handles the admin endpoints for the roles stored on user accounts.
+type RoleHandler struct {
+	roleService service.RoleService
+	binder      *RequestBinder
+}
+
+// NewRoleHandler creates a new role handler.
+func NewRoleHandler(roleService service.RoleService) *RoleHandler {
+	return &RoleHandler{
+		roleService: roleService,
+		binder:      NewRequestBinder(),
+	}
+}
+
+// GetUserRole handles GET /admin/users/{user_id}/role.
+func (h *RoleHandler) GetUserRole(w http.ResponseWriter, r *http.Request) {
+	_, targetUserID, ok := h.prepareAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := h.roleService.GetUserRole(r.Context(), targetUserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// SetUserRole handles PUT /admin/users/{user_id}/role.
+func (h *RoleHandler) SetUserRole(w http.ResponseWriter, r *http.Request) {
+	actorID, targetUserID, ok := h.prepareAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.UserRoleRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	response, err := h.roleService.SetUserRole(r.Context(), actorID, targetUserID, req.Role)
+	if err != nil {
+		h.handleServiceError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// prepareAdmin checks for an admin and service availability and returns the requester and target
+// user IDs.
+func (h *RoleHandler) prepareAdmin(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	actorID, targetUserID, ok := adminTarget(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if h.roleService == nil {
+		ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return actorID, targetUserID, true
+}
+
+func (h *RoleHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case errors.Is(err, service.ErrCannotChangeOwnRole):
+		ErrorResponse(w, http.StatusConflict, "CANNOT_CHANGE_OWN_ROLE", "Admins cannot change their own role")
+	default:
+		slog.Error("role service error", "error", err)
+		InternalErrorResponse(w)
+	}
+}
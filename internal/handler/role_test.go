@@ -0,0 +1,160 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// withRole authenticates the request as a user holding the stored role.
+func withRole(req *http.Request, userID uuid.UUID, role dto.UserRole) *http.Request {
+	req = setAuthenticatedUser(req, userID)
+
+	return req.WithContext(middleware.WithRole(req.Context(), role))
+}
+
+func TestRoleHandler(t *testing.T) {
+	t.Parallel()
+
+	adminID, userID := uuid.New(), uuid.New()
+	moderator := &dto.UserRoleResponse{UserID: userID.String(), Role: dto.UserRoleModerator}
+
+	tests := []struct {
+		name           string
+		method         string
+		userID         uuid.UUID
+		body           string
+		authorize      func(*http.Request) *http.Request
+		mockSetup      func(*mocks.RoleService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "admin role assigns role",
+			method: http.MethodPut,
+			userID: userID,
+			body:   `{"role":"moderator"}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withRole(r, adminID, dto.UserRoleAdmin)
+			},
+			mockSetup: func(m *mocks.RoleService) {
+				m.On("SetUserRole", mock.Anything, adminID, userID, dto.UserRoleModerator).Return(moderator, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"role":"moderator"`,
+		},
+		{
+			name:   "admin scope reads role",
+			method: http.MethodGet,
+			userID: userID,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup: func(m *mocks.RoleService) {
+				m.On("GetUserRole", mock.Anything, userID).Return(moderator, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"role":"moderator"`,
+		},
+		{
+			name:   "moderator is forbidden",
+			method: http.MethodPut,
+			userID: userID,
+			body:   `{"role":"admin"}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withRole(r, adminID, dto.UserRoleModerator)
+			},
+			mockSetup:      func(*mocks.RoleService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "role header is not trusted",
+			method: http.MethodPut,
+			userID: userID,
+			body:   `{"role":"admin"}`,
+			authorize: func(r *http.Request) *http.Request {
+				r.Header.Set("X-User-Role", "admin")
+
+				return setAuthenticatedUser(r, adminID)
+			},
+			mockSetup:      func(*mocks.RoleService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "unknown role is rejected",
+			method: http.MethodPut,
+			userID: userID,
+			body:   `{"role":"owner"}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup:      func(*mocks.RoleService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "own role cannot be changed",
+			method: http.MethodPut,
+			userID: adminID,
+			body:   `{"role":"user"}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup: func(m *mocks.RoleService) {
+				m.On("SetUserRole", mock.Anything, adminID, adminID, dto.UserRoleUser).
+					Return(nil, service.ErrCannotChangeOwnRole)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "CANNOT_CHANGE_OWN_ROLE",
+		},
+		{
+			name:   "unknown user",
+			method: http.MethodGet,
+			userID: userID,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockSetup: func(m *mocks.RoleService) {
+				m.On("GetUserRole", mock.Anything, userID).Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "USER_NOT_FOUND",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewRoleService(t)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewRoleHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/admin/users/{user_id}/role", h.GetUserRole)
+			r.Put("/admin/users/{user_id}/role", h.SetUserRole)
+
+			req := httptest.NewRequest(tt.method, "/admin/users/"+tt.userID.String()+"/role", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = tt.authorize(req)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedBody)
+		})
+	}
+}
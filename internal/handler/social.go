@@ -5,23 +5,31 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
 )
 
 // SocialHandler handles social feature HTTP endpoints.
 type SocialHandler struct {
-	socialService service.SocialService
+	socialService   service.SocialService
+	presenceService service.PresenceService
+	binder          *RequestBinder
 }
 
-// NewSocialHandler creates a new social handler.
-func NewSocialHandler(socialService service.SocialService) *SocialHandler {
+// NewSocialHandler creates a new social handler. presenceService may be nil, in which case
+// followers lists cannot include presence.
+func NewSocialHandler(socialService service.SocialService, presenceService service.PresenceService) *SocialHandler {
 	return &SocialHandler{
-		socialService: socialService,
+		socialService:   socialService,
+		presenceService: presenceService,
+		binder:          NewRequestBinder(),
 	}
 }
 
@@ -38,7 +46,8 @@ func (h *SocialHandler) GetFollowing(w http.ResponseWriter, r *http.Request) {
 
 	targetUserID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format")
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
 
 		return
 	}
@@ -46,7 +55,7 @@ func (h *SocialHandler) GetFollowing(w http.ResponseWriter, r *http.Request) {
 	// 3. Parse query parameters
 	params, err := h.parseFollowingParams(r)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+		queryErrorResponse(w, err)
 
 		return
 	}
@@ -66,7 +75,7 @@ func (h *SocialHandler) GetFollowing(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	SuccessResponse(w, http.StatusOK, response)
+	SuccessResponse(w, http.StatusOK, projectFields(response, params.fields))
 }
 
 // GetFollowers handles GET /users/{user_id}/followers.
@@ -82,7 +91,8 @@ func (h *SocialHandler) GetFollowers(w http.ResponseWriter, r *http.Request) {
 
 	targetUserID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format")
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
 
 		return
 	}
@@ -90,7 +100,7 @@ func (h *SocialHandler) GetFollowers(w http.ResponseWriter, r *http.Request) {
 	// 3. Parse query parameters
 	params, err := h.parseFollowingParams(r)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+		queryErrorResponse(w, err)
 
 		return
 	}
@@ -110,7 +120,24 @@ func (h *SocialHandler) GetFollowers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	SuccessResponse(w, http.StatusOK, response)
+	// 5. Attach the presence of each follower the requester may see
+	if params.includePresence && len(response.FollowedUsers) > 0 {
+		if h.presenceService == nil {
+			ServiceUnavailableResponse(w, "Service temporarily unavailable")
+
+			return
+		}
+
+		err = h.presenceService.AttachPresence(r.Context(), requesterID, response.FollowedUsers)
+		if err != nil {
+			slog.Error("failed to attach follower presence", "error", err)
+			InternalErrorResponse(w)
+
+			return
+		}
+	}
+
+	SuccessResponse(w, http.StatusOK, projectFields(response, params.fields))
 }
 
 // FollowUser handles POST /users/{user_id}/follow/{target_user_id}.
@@ -126,14 +153,14 @@ func (h *SocialHandler) FollowUser(w http.ResponseWriter, r *http.Request) {
 
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format")
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
 
 		return
 	}
 
 	// 3. Authorization check: path user_id must match authenticated user OR user is admin
-	isAdmin := h.isAdminUser(r)
-	if userID != requesterID && !isAdmin {
+	if userID != requesterID && !middleware.IsAdmin(r.Context()) {
 		ForbiddenResponse(w, "Cannot perform follow action for another user")
 
 		return
@@ -144,7 +171,8 @@ func (h *SocialHandler) FollowUser(w http.ResponseWriter, r *http.Request) {
 
 	targetUserID, err := uuid.Parse(targetUserIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid target user ID format")
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid target user ID format",
+			"target_user_id", "uuid")
 
 		return
 	}
@@ -175,14 +203,14 @@ func (h *SocialHandler) UnfollowUser(w http.ResponseWriter, r *http.Request) {
 
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format")
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
 
 		return
 	}
 
 	// 3. Authorization check: path user_id must match authenticated user OR user is admin
-	isAdmin := h.isAdminUser(r)
-	if userID != requesterID && !isAdmin {
+	if userID != requesterID && !middleware.IsAdmin(r.Context()) {
 		ForbiddenResponse(w, "Cannot perform unfollow action for another user")
 
 		return
@@ -193,7 +221,8 @@ func (h *SocialHandler) UnfollowUser(w http.ResponseWriter, r *http.Request) {
 
 	targetUserID, err := uuid.Parse(targetUserIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid target user ID format")
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid target user ID format",
+			"target_user_id", "uuid")
 
 		return
 	}
@@ -223,7 +252,8 @@ func (h *SocialHandler) CheckFollowing(w http.ResponseWriter, r *http.Request) {
 
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format")
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
 
 		return
 	}
@@ -233,7 +263,8 @@ func (h *SocialHandler) CheckFollowing(w http.ResponseWriter, r *http.Request) {
 
 	targetUserID, err := uuid.Parse(targetUserIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid target user ID format")
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid target user ID format",
+			"target_user_id", "uuid")
 
 		return
 	}
@@ -249,6 +280,321 @@ func (h *SocialHandler) CheckFollowing(w http.ResponseWriter, r *http.Request) {
 	SuccessResponse(w, http.StatusOK, response)
 }
 
+// UpdateFollowSettings handles PUT /users/{user_id}/following/{target_user_id}/settings.
+// Changes the notification settings of user_id's follow of target_user_id.
+func (h *SocialHandler) UpdateFollowSettings(w http.ResponseWriter, r *http.Request) {
+	// 1. Extract and validate requester ID from header (authenticated user)
+	requesterID, ok := h.extractAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	// 2. Extract and validate user_id from path (the follower)
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
+
+		return
+	}
+
+	// 3. Authorization check: path user_id must match authenticated user OR user is admin
+	if userID != requesterID && !middleware.IsAdmin(r.Context()) {
+		ForbiddenResponse(w, "Cannot change follow settings for another user")
+
+		return
+	}
+
+	// 4. Extract and validate target_user_id from path
+	targetUserID, err := uuid.Parse(chi.URLParam(r, "target_user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid target user ID format",
+			"target_user_id", "uuid")
+
+		return
+	}
+
+	// 5. Bind request body
+	var req dto.FollowSettingsUpdateRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	// 6. Call service
+	settings, err := h.socialService.UpdateFollowSettings(r.Context(), userID, targetUserID, &req)
+	if err != nil {
+		h.handleUpdateFollowSettingsError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, settings)
+}
+
+// GetNotificationRecipients handles GET /internal/v1/users/{user_id}/notification-recipients.
+// Lists the followers the notification service should notify of the user's activity.
+func (h *SocialHandler) GetNotificationRecipients(w http.ResponseWriter, r *http.Request) {
+	// 1. Only service accounts with read scope (or admins) may list recipients
+	if !canReadUserData(r) {
+		ForbiddenResponse(w, "Service token with user:read scope required")
+
+		return
+	}
+
+	// 2. Parse the followed user and the event
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+
+		return
+	}
+
+	event := dto.FollowNotificationEvent(r.URL.Query().Get("event"))
+	if !event.IsValid() {
+		values := strings.Join(event.Values(), ", ")
+		FieldErrorResponse(w, http.StatusBadRequest, "VALIDATION_ERROR", "event must be one of: "+values,
+			validation.Invalid("event", "oneof", values, string(event)))
+
+		return
+	}
+
+	// 3. Call service
+	response, err := h.socialService.GetNotificationRecipients(r.Context(), userID, event)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+
+			return
+		}
+
+		slog.Error("failed to get notification recipients", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// AddCloseFriend handles PUT /users/{user_id}/close-friends/{target_user_id}.
+func (h *SocialHandler) AddCloseFriend(w http.ResponseWriter, r *http.Request) {
+	h.setCloseFriend(w, r, true)
+}
+
+// RemoveCloseFriend handles DELETE /users/{user_id}/close-friends/{target_user_id}.
+func (h *SocialHandler) RemoveCloseFriend(w http.ResponseWriter, r *http.Request) {
+	h.setCloseFriend(w, r, false)
+}
+
+func (h *SocialHandler) setCloseFriend(w http.ResponseWriter, r *http.Request, closeFriend bool) {
+	// 1. Only the owner manages their close friends
+	userID, ok := h.extractCloseFriendsOwner(w, r)
+	if !ok {
+		return
+	}
+
+	// 2. Extract and validate target_user_id from path
+	targetUserID, err := uuid.Parse(chi.URLParam(r, "target_user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid target user ID format",
+			"target_user_id", "uuid")
+
+		return
+	}
+
+	// 3. Call service
+	err = h.socialService.SetCloseFriend(r.Context(), userID, targetUserID, closeFriend)
+	if err != nil {
+		if errors.Is(err, service.ErrNotAFollower) {
+			ErrorResponse(w, http.StatusConflict, "NOT_A_FOLLOWER", "Only followers can be close friends")
+
+			return
+		}
+
+		slog.Error("failed to update close friend", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetCloseFriends handles GET /users/{user_id}/close-friends.
+func (h *SocialHandler) GetCloseFriends(w http.ResponseWriter, r *http.Request) {
+	// 1. Only the owner can see their close friends
+	userID, ok := h.extractCloseFriendsOwner(w, r)
+	if !ok {
+		return
+	}
+
+	// 2. Parse query parameters
+	params, err := h.parseFollowingParams(r)
+	if err != nil {
+		queryErrorResponse(w, err)
+
+		return
+	}
+
+	// 3. Call service
+	response, err := h.socialService.GetCloseFriends(r.Context(), userID, params.limit, params.offset, params.countOnly)
+	if err != nil {
+		slog.Error("failed to get close friends", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, projectFields(response, params.fields))
+}
+
+// GetFollowHistory handles GET /users/{user_id}/followers/history.
+func (h *SocialHandler) GetFollowHistory(w http.ResponseWriter, r *http.Request) {
+	// 1. Only the owner can see who followed and unfollowed them
+	userID, ok := h.extractOwner(w, r, "Follow history is only available to its owner")
+	if !ok {
+		return
+	}
+
+	// 2. Parse query parameters
+	params, err := h.parseFollowingParams(r)
+	if err != nil {
+		queryErrorResponse(w, err)
+
+		return
+	}
+
+	// 3. Call service
+	response, err := h.socialService.GetFollowHistory(r.Context(), userID, params.limit, params.offset)
+	if err != nil {
+		slog.Error("failed to get follow history", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// GetQuota handles GET /users/{user_id}/quota.
+func (h *SocialHandler) GetQuota(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.extractOwner(w, r, "Quotas are only available to their owner")
+	if !ok {
+		return
+	}
+
+	h.respondQuota(w, r, userID)
+}
+
+// GetUserQuota handles GET /admin/users/{user_id}/quota.
+func (h *SocialHandler) GetUserQuota(w http.ResponseWriter, r *http.Request) {
+	_, targetUserID, ok := adminTarget(w, r)
+	if !ok {
+		return
+	}
+
+	h.respondQuota(w, r, targetUserID)
+}
+
+// SetFollowLimit handles PUT /admin/users/{user_id}/quota/following.
+func (h *SocialHandler) SetFollowLimit(w http.ResponseWriter, r *http.Request) {
+	_, targetUserID, ok := adminTarget(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.FollowLimitRequest
+
+	bindErr := h.binder.BindAndValidate(r, &req)
+	if bindErr != nil {
+		respondBindError(w, bindErr)
+
+		return
+	}
+
+	h.setFollowLimit(w, r, targetUserID, req.MaxFollowing)
+}
+
+// ClearFollowLimit handles DELETE /admin/users/{user_id}/quota/following, restoring the
+// configured limit.
+func (h *SocialHandler) ClearFollowLimit(w http.ResponseWriter, r *http.Request) {
+	_, targetUserID, ok := adminTarget(w, r)
+	if !ok {
+		return
+	}
+
+	h.setFollowLimit(w, r, targetUserID, nil)
+}
+
+func (h *SocialHandler) setFollowLimit(w http.ResponseWriter, r *http.Request, userID uuid.UUID, limit *int) {
+	response, err := h.socialService.SetFollowLimit(r.Context(), userID, limit)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+
+			return
+		}
+
+		slog.Error("failed to set follow limit", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+func (h *SocialHandler) respondQuota(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	response, err := h.socialService.GetQuota(r.Context(), userID)
+	if err != nil {
+		slog.Error("failed to get quota", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// extractCloseFriendsOwner returns the path user_id if it is the authenticated user. Close friends
+// are private to their owner, so not even admins can act on another user's list.
+func (h *SocialHandler) extractCloseFriendsOwner(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	return h.extractOwner(w, r, "Close friends can only be managed by their owner")
+}
+
+// extractOwner returns the path user_id if it is the authenticated user, responding with
+// forbiddenMessage otherwise.
+func (h *SocialHandler) extractOwner(
+	w http.ResponseWriter,
+	r *http.Request,
+	forbiddenMessage string,
+) (uuid.UUID, bool) {
+	requesterID, ok := h.extractAuthenticatedUserID(w, r)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
+
+		return uuid.Nil, false
+	}
+
+	if userID != requesterID {
+		ForbiddenResponse(w, forbiddenMessage)
+
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
 // Activity parameter constants.
 const (
 	defaultPerTypeLimit = 15
@@ -256,6 +602,9 @@ const (
 	maxPerTypeLimit     = 100
 )
 
+// ErrInvalidIncludePresence is returned when the includePresence query parameter is not a boolean.
+var ErrInvalidIncludePresence = errors.New("includePresence must be a valid boolean")
+
 // Activity parameter validation errors.
 var (
 	ErrInvalidPerTypeLimit    = errors.New("per_type_limit must be a valid integer")
@@ -272,7 +621,8 @@ func (h *SocialHandler) GetUserActivity(w http.ResponseWriter, r *http.Request)
 
 	targetUserID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format")
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
 
 		return
 	}
@@ -280,7 +630,7 @@ func (h *SocialHandler) GetUserActivity(w http.ResponseWriter, r *http.Request)
 	// 3. Parse query parameters
 	perTypeLimit, err := h.parseActivityParams(r)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+		queryErrorResponse(w, err)
 
 		return
 	}
@@ -301,6 +651,31 @@ func (h *SocialHandler) GetUserActivity(w http.ResponseWriter, r *http.Request)
 	SuccessResponse(w, http.StatusOK, response)
 }
 
+// GetDigestPreview handles GET /users/preferences/notifications/digest-preview.
+func (h *SocialHandler) GetDigestPreview(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.extractAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	perTypeLimit, err := h.parseActivityParams(r)
+	if err != nil {
+		queryErrorResponse(w, err)
+
+		return
+	}
+
+	response, err := h.socialService.GetDigestPreview(r.Context(), userID, perTypeLimit)
+	if err != nil {
+		slog.Error("failed to preview digest", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, response)
+}
+
 // extractOptionalUserID extracts user ID from context (nil if not authenticated).
 func (h *SocialHandler) extractOptionalUserID(r *http.Request) *uuid.UUID {
 	userID, ok := middleware.GetUserIDFromContext(r.Context())
@@ -317,11 +692,12 @@ func (h *SocialHandler) parseActivityParams(r *http.Request) (int, error) {
 	if limitStr := r.URL.Query().Get("per_type_limit"); limitStr != "" {
 		limit, err := strconv.Atoi(limitStr)
 		if err != nil {
-			return 0, ErrInvalidPerTypeLimit
+			return 0, invalidQuery("per_type_limit", "integer", "", limitStr, ErrInvalidPerTypeLimit)
 		}
 
 		if limit < minPerTypeLimit || limit > maxPerTypeLimit {
-			return 0, ErrPerTypeLimitOutOfRange
+			return 0, invalidRange("per_type_limit", limitStr, limit, minPerTypeLimit, maxPerTypeLimit,
+				ErrPerTypeLimitOutOfRange)
 		}
 
 		perTypeLimit = limit
@@ -345,9 +721,11 @@ func (h *SocialHandler) handleGetUserActivityError(w http.ResponseWriter, err er
 // Private helper types and methods below.
 
 type followingParams struct {
-	limit     int
-	offset    int
-	countOnly bool
+	limit           int
+	offset          int
+	countOnly       bool
+	includePresence bool
+	fields          []string
 }
 
 func (h *SocialHandler) parseFollowingParams(r *http.Request) (*followingParams, error) {
@@ -361,11 +739,11 @@ func (h *SocialHandler) parseFollowingParams(r *http.Request) (*followingParams,
 	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
 		limit, err := strconv.Atoi(limitStr)
 		if err != nil {
-			return nil, ErrInvalidLimit
+			return nil, invalidQuery("limit", "integer", "", limitStr, ErrInvalidLimit)
 		}
 
 		if limit < minLimit || limit > maxLimit {
-			return nil, ErrLimitOutOfRange
+			return nil, invalidRange("limit", limitStr, limit, minLimit, maxLimit, ErrLimitOutOfRange)
 		}
 
 		params.limit = limit
@@ -375,11 +753,11 @@ func (h *SocialHandler) parseFollowingParams(r *http.Request) (*followingParams,
 	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
 		offset, err := strconv.Atoi(offsetStr)
 		if err != nil {
-			return nil, ErrInvalidOffset
+			return nil, invalidQuery("offset", "integer", "", offsetStr, ErrInvalidOffset)
 		}
 
 		if offset < 0 {
-			return nil, ErrNegativeOffset
+			return nil, invalidQuery("offset", "gte", "0", offsetStr, ErrNegativeOffset)
 		}
 
 		params.offset = offset
@@ -389,12 +767,29 @@ func (h *SocialHandler) parseFollowingParams(r *http.Request) (*followingParams,
 	if countOnlyStr := r.URL.Query().Get("countOnly"); countOnlyStr != "" {
 		countOnly, err := strconv.ParseBool(countOnlyStr)
 		if err != nil {
-			return nil, ErrInvalidCountOnly
+			return nil, invalidQuery("countOnly", "boolean", "", countOnlyStr, ErrInvalidCountOnly)
 		}
 
 		params.countOnly = countOnly
 	}
 
+	// Parse includePresence
+	if includeStr := r.URL.Query().Get("includePresence"); includeStr != "" {
+		include, err := strconv.ParseBool(includeStr)
+		if err != nil {
+			return nil, invalidQuery("includePresence", "boolean", "", includeStr, ErrInvalidIncludePresence)
+		}
+
+		params.includePresence = include
+	}
+
+	fields, err := parseFields(r, dto.GetFollowedUsersResponse{})
+	if err != nil {
+		return nil, err
+	}
+
+	params.fields = fields
+
 	return params, nil
 }
 
@@ -433,12 +828,6 @@ func (h *SocialHandler) extractAuthenticatedUserID(w http.ResponseWriter, r *htt
 	return userID, true
 }
 
-func (h *SocialHandler) isAdminUser(r *http.Request) bool {
-	role := r.Header.Get("X-User-Role")
-
-	return role == "admin"
-}
-
 func (h *SocialHandler) handleFollowUserError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, service.ErrCannotFollowSelf):
@@ -447,6 +836,8 @@ func (h *SocialHandler) handleFollowUserError(w http.ResponseWriter, err error)
 		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
 	case errors.Is(err, service.ErrFollowNotAllowed):
 		ForbiddenResponse(w, "This user does not allow follows")
+	case errors.Is(err, service.ErrFollowLimitReached):
+		ErrorResponse(w, http.StatusForbidden, "FOLLOW_LIMIT_REACHED", "You already follow as many users as allowed")
 	default:
 		slog.Error("failed to follow user", "error", err)
 		InternalErrorResponse(w)
@@ -476,3 +867,13 @@ func (h *SocialHandler) handleCheckFollowingError(w http.ResponseWriter, err err
 		InternalErrorResponse(w)
 	}
 }
+
+func (h *SocialHandler) handleUpdateFollowSettingsError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFollowing):
+		ErrorResponse(w, http.StatusNotFound, "NOT_FOLLOWING", "Not following this user")
+	default:
+		slog.Error("failed to update follow settings", "error", err)
+		InternalErrorResponse(w)
+	}
+}
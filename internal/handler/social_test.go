@@ -1,175 +1,35 @@
 package handler_test
 
 import (
-	"context"
 	"errors"
-	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
 )
 
 var (
-	errMockSocialArgs         = errors.New("mock: missing args")
-	errFollowedUsersRespType  = errors.New("invalid type assertion for GetFollowedUsersResponse")
-	errFollowRespType         = errors.New("invalid type assertion for FollowResponse")
-	errUserActivityRespType   = errors.New("invalid type assertion for UserActivityResponse")
-	errFollowingCheckRespType = errors.New("invalid type assertion for FollowingCheckResponse")
-	errUnexpectedService      = errors.New("unexpected service error")
+	errUnexpectedService = errors.New("unexpected service error")
 )
 
-// MockSocialService is a mock implementation of service.SocialService.
-type MockSocialService struct {
-	mock.Mock
-}
-
-func (m *MockSocialService) GetFollowing(
-	ctx context.Context,
-	requesterID, targetUserID uuid.UUID,
-	limit, offset int,
-	countOnly bool,
-) (*dto.GetFollowedUsersResponse, error) {
-	args := m.Called(ctx, requesterID, targetUserID, limit, offset, countOnly)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockSocialArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.GetFollowedUsersResponse); ok {
-		return val, nil
-	}
-
-	return nil, errFollowedUsersRespType
-}
-
-func (m *MockSocialService) GetFollowers(
-	ctx context.Context,
-	requesterID, targetUserID uuid.UUID,
-	limit, offset int,
-	countOnly bool,
-) (*dto.GetFollowedUsersResponse, error) {
-	args := m.Called(ctx, requesterID, targetUserID, limit, offset, countOnly)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockSocialArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.GetFollowedUsersResponse); ok {
-		return val, nil
-	}
-
-	return nil, errFollowedUsersRespType
-}
-
-func (m *MockSocialService) FollowUser(
-	ctx context.Context,
-	followerID, targetUserID uuid.UUID,
-) (*dto.FollowResponse, error) {
-	args := m.Called(ctx, followerID, targetUserID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockSocialArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.FollowResponse); ok {
-		return val, nil
-	}
-
-	return nil, errFollowRespType
-}
-
-func (m *MockSocialService) UnfollowUser(
-	ctx context.Context,
-	followerID, targetUserID uuid.UUID,
-) (*dto.FollowResponse, error) {
-	args := m.Called(ctx, followerID, targetUserID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockSocialArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.FollowResponse); ok {
-		return val, nil
-	}
-
-	return nil, errFollowRespType
-}
-
-func (m *MockSocialService) GetUserActivity(
-	ctx context.Context,
-	requesterID *uuid.UUID,
-	targetUserID uuid.UUID,
-	perTypeLimit int,
-) (*dto.UserActivityResponse, error) {
-	args := m.Called(ctx, requesterID, targetUserID, perTypeLimit)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockSocialArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.UserActivityResponse); ok {
-		return val, nil
-	}
-
-	return nil, errUserActivityRespType
-}
-
-func (m *MockSocialService) CheckFollowing(
-	ctx context.Context,
-	requesterID, userID, targetUserID uuid.UUID,
-) (*dto.FollowingCheckResponse, error) {
-	args := m.Called(ctx, requesterID, userID, targetUserID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockSocialArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.FollowingCheckResponse); ok {
-		return val, nil
-	}
-
-	return nil, errFollowingCheckRespType
-}
-
 type socialHandlerTestCase struct {
 	name           string
 	targetIDPath   string
 	requesterIDHdr string
 	queryParams    string
-	mockRun        func(*MockSocialService)
+	mockRun        func(*mocks.SocialService)
 	expectedStatus int
 	validateBody   func(*testing.T, string)
 }
@@ -219,7 +79,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowing", mock.Anything, requesterID, targetID, 20, 0, false).Return(baseResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -234,7 +94,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "countOnly=true",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowing", mock.Anything, requesterID, targetID, 20, 0, true).Return(countOnlyResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -248,7 +108,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowing", mock.Anything, requesterID, targetID, 20, 0, false).Return(emptyResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -264,7 +124,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "limit=50&offset=10",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowing", mock.Anything, requesterID, targetID, 50, 10, false).Return(baseResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -277,7 +137,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   requesterID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowing", mock.Anything, requesterID, requesterID, 20, 0, false).Return(baseResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -290,7 +150,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "",
 			queryParams:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnauthorized,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -303,7 +163,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "invalid-uuid",
 			queryParams:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnauthorized,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -316,7 +176,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   "invalid-uuid",
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnprocessableEntity,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -329,7 +189,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "limit=abc",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -342,7 +202,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "limit=0",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -355,7 +215,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "limit=101",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -368,7 +228,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "offset=abc",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -381,7 +241,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "offset=-1",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -394,7 +254,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "countOnly=maybe",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -407,7 +267,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowing", mock.Anything, requesterID, targetID, 20, 0, false).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -422,7 +282,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowing", mock.Anything, requesterID, targetID, 20, 0, false).Return(nil, service.ErrAccessDenied)
 			},
 			expectedStatus: http.StatusForbidden,
@@ -437,7 +297,7 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowing", mock.Anything, requesterID, targetID, 20, 0, false).Return(nil, errUnexpectedService)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -452,12 +312,12 @@ func TestSocialHandlerGetFollowing(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockSocialService)
+			mockSvc := new(mocks.SocialService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewSocialHandler(mockSvc)
+			h := handler.NewSocialHandler(mockSvc, nil)
 
 			r := chi.NewRouter()
 			r.Get("/users/{user_id}/following", h.GetFollowing)
@@ -527,7 +387,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowers", mock.Anything, requesterID, targetID, 20, 0, false).Return(baseResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -542,7 +402,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "countOnly=true",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowers", mock.Anything, requesterID, targetID, 20, 0, true).Return(countOnlyResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -556,7 +416,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowers", mock.Anything, requesterID, targetID, 20, 0, false).Return(emptyResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -572,7 +432,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "limit=50&offset=10",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowers", mock.Anything, requesterID, targetID, 50, 10, false).Return(baseResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -585,7 +445,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   requesterID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowers", mock.Anything, requesterID, requesterID, 20, 0, false).Return(baseResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -598,7 +458,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "",
 			queryParams:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnauthorized,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -611,7 +471,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "invalid-uuid",
 			queryParams:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnauthorized,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -624,7 +484,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   "invalid-uuid",
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnprocessableEntity,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -637,7 +497,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "limit=abc",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -650,7 +510,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "limit=0",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -663,7 +523,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "limit=101",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -676,7 +536,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "offset=abc",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -689,7 +549,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "offset=-1",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -702,7 +562,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "countOnly=maybe",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -715,7 +575,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowers", mock.Anything, requesterID, targetID, 20, 0, false).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -730,7 +590,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowers", mock.Anything, requesterID, targetID, 20, 0, false).Return(nil, service.ErrAccessDenied)
 			},
 			expectedStatus: http.StatusForbidden,
@@ -745,7 +605,7 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetFollowers", mock.Anything, requesterID, targetID, 20, 0, false).Return(nil, errUnexpectedService)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -760,12 +620,12 @@ func TestSocialHandlerGetFollowers(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockSocialService)
+			mockSvc := new(mocks.SocialService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewSocialHandler(mockSvc)
+			h := handler.NewSocialHandler(mockSvc, nil)
 
 			r := chi.NewRouter()
 			r.Get("/users/{user_id}/followers", h.GetFollowers)
@@ -795,8 +655,8 @@ type followUserTestCase struct {
 	userIDPath     string
 	targetIDPath   string
 	requesterIDHdr string
-	userRoleHdr    string
-	mockRun        func(*MockSocialService)
+	userRole       dto.UserRole
+	mockRun        func(*mocks.SocialService)
 	expectedStatus int
 	validateBody   func(*testing.T, string)
 }
@@ -820,8 +680,7 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("FollowUser", mock.Anything, userID, targetID).Return(successResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -836,8 +695,8 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			userIDPath:     differentUserID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "admin",
-			mockRun: func(m *MockSocialService) {
+			userRole:       dto.UserRoleAdmin,
+			mockRun: func(m *mocks.SocialService) {
 				m.On("FollowUser", mock.Anything, differentUserID, targetID).Return(successResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -851,8 +710,7 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "",
-			userRoleHdr:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnauthorized,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -864,8 +722,7 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "invalid-uuid",
-			userRoleHdr:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnauthorized,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -877,8 +734,7 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			userIDPath:     "invalid-uuid",
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnprocessableEntity,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -891,8 +747,7 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   "invalid-uuid",
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnprocessableEntity,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -905,8 +760,7 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			userIDPath:     differentUserID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusForbidden,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -919,8 +773,7 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   userID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("FollowUser", mock.Anything, userID, userID).Return(nil, service.ErrCannotFollowSelf)
 			},
 			expectedStatus: http.StatusBadRequest,
@@ -935,8 +788,7 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("FollowUser", mock.Anything, userID, targetID).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -950,8 +802,7 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("FollowUser", mock.Anything, userID, targetID).Return(nil, service.ErrFollowNotAllowed)
 			},
 			expectedStatus: http.StatusForbidden,
@@ -961,13 +812,26 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 				assert.Contains(t, body, "does not allow follows")
 			},
 		},
+		{
+			name:           "Forbidden - follow limit reached",
+			userIDPath:     userID.String(),
+			targetIDPath:   targetID.String(),
+			requesterIDHdr: userID.String(),
+			mockRun: func(m *mocks.SocialService) {
+				m.On("FollowUser", mock.Anything, userID, targetID).Return(nil, service.ErrFollowLimitReached)
+			},
+			expectedStatus: http.StatusForbidden,
+			validateBody: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, "FOLLOW_LIMIT_REACHED")
+			},
+		},
 		{
 			name:           "Internal Error - service error",
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("FollowUser", mock.Anything, userID, targetID).Return(nil, errUnexpectedService)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -982,12 +846,12 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockSocialService)
+			mockSvc := new(mocks.SocialService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewSocialHandler(mockSvc)
+			h := handler.NewSocialHandler(mockSvc, nil)
 
 			r := chi.NewRouter()
 			r.Post("/users/{user_id}/follow/{target_user_id}", h.FollowUser)
@@ -997,8 +861,8 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 			req := httptest.NewRequest(http.MethodPost, url, nil)
 			req = setAuthenticatedUserFromString(req, tt.requesterIDHdr)
 
-			if tt.userRoleHdr != "" {
-				req.Header.Set("X-User-Role", tt.userRoleHdr)
+			if tt.userRole != "" {
+				req = req.WithContext(middleware.WithRole(req.Context(), tt.userRole))
 			}
 
 			rr := httptest.NewRecorder()
@@ -1013,6 +877,26 @@ func TestSocialHandlerFollowUser(t *testing.T) {
 	}
 }
 
+func TestSocialHandlerFollowUser_IgnoresRoleHeader(t *testing.T) {
+	t.Parallel()
+
+	userID, otherID, targetID := uuid.New(), uuid.New(), uuid.New()
+
+	h := handler.NewSocialHandler(mocks.NewSocialService(t), nil)
+
+	r := chi.NewRouter()
+	r.Post("/users/{user_id}/follow/{target_user_id}", h.FollowUser)
+
+	req := httptest.NewRequest(http.MethodPost, "/users/"+otherID.String()+"/follow/"+targetID.String(), nil)
+	req.Header.Set("X-User-Role", "admin")
+	req = setAuthenticatedUser(req, userID)
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code, "only the stored role or the admin scope make an admin")
+}
+
 //nolint:funlen,dupl // table-driven test with many test cases, mirrors FollowUser pattern
 func TestSocialHandlerUnfollowUser(t *testing.T) {
 	t.Parallel()
@@ -1032,8 +916,7 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("UnfollowUser", mock.Anything, userID, targetID).Return(successResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -1048,8 +931,8 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			userIDPath:     differentUserID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "admin",
-			mockRun: func(m *MockSocialService) {
+			userRole:       dto.UserRoleAdmin,
+			mockRun: func(m *mocks.SocialService) {
 				m.On("UnfollowUser", mock.Anything, differentUserID, targetID).Return(successResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -1063,8 +946,7 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "",
-			userRoleHdr:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnauthorized,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1076,8 +958,7 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "invalid-uuid",
-			userRoleHdr:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnauthorized,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1089,8 +970,7 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			userIDPath:     "invalid-uuid",
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnprocessableEntity,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1103,8 +983,7 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   "invalid-uuid",
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnprocessableEntity,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1117,8 +996,7 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			userIDPath:     differentUserID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusForbidden,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1131,8 +1009,7 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   userID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("UnfollowUser", mock.Anything, userID, userID).Return(nil, service.ErrCannotUnfollowSelf)
 			},
 			expectedStatus: http.StatusBadRequest,
@@ -1147,8 +1024,7 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("UnfollowUser", mock.Anything, userID, targetID).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -1162,8 +1038,7 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: userID.String(),
-			userRoleHdr:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("UnfollowUser", mock.Anything, userID, targetID).Return(nil, errUnexpectedService)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -1178,12 +1053,12 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockSocialService)
+			mockSvc := new(mocks.SocialService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewSocialHandler(mockSvc)
+			h := handler.NewSocialHandler(mockSvc, nil)
 
 			r := chi.NewRouter()
 			r.Delete("/users/{user_id}/follow/{target_user_id}", h.UnfollowUser)
@@ -1193,8 +1068,8 @@ func TestSocialHandlerUnfollowUser(t *testing.T) {
 			req := httptest.NewRequest(http.MethodDelete, url, nil)
 			req = setAuthenticatedUserFromString(req, tt.requesterIDHdr)
 
-			if tt.userRoleHdr != "" {
-				req.Header.Set("X-User-Role", tt.userRoleHdr)
+			if tt.userRole != "" {
+				req = req.WithContext(middleware.WithRole(req.Context(), tt.userRole))
 			}
 
 			rr := httptest.NewRecorder()
@@ -1244,7 +1119,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetUserActivity", mock.Anything, mock.AnythingOfType("*uuid.UUID"), targetID, 15).
 					Return(baseResponse, nil)
 			},
@@ -1261,7 +1136,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "",
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetUserActivity", mock.Anything, (*uuid.UUID)(nil), targetID, 15).
 					Return(baseResponse, nil)
 			},
@@ -1276,7 +1151,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "per_type_limit=50",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetUserActivity", mock.Anything, mock.AnythingOfType("*uuid.UUID"), targetID, 50).
 					Return(baseResponse, nil)
 			},
@@ -1291,7 +1166,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "per_type_limit=1",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetUserActivity", mock.Anything, mock.AnythingOfType("*uuid.UUID"), targetID, 1).
 					Return(emptyResponse, nil)
 			},
@@ -1302,7 +1177,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "per_type_limit=100",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetUserActivity", mock.Anything, mock.AnythingOfType("*uuid.UUID"), targetID, 100).
 					Return(emptyResponse, nil)
 			},
@@ -1313,7 +1188,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetUserActivity", mock.Anything, mock.AnythingOfType("*uuid.UUID"), targetID, 15).
 					Return(emptyResponse, nil)
 			},
@@ -1328,7 +1203,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   "invalid-uuid",
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnprocessableEntity,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1341,7 +1216,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "per_type_limit=abc",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1354,7 +1229,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "per_type_limit=0",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1367,7 +1242,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "per_type_limit=101",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1380,7 +1255,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetUserActivity", mock.Anything, mock.AnythingOfType("*uuid.UUID"), targetID, 15).
 					Return(nil, service.ErrUserNotFound)
 			},
@@ -1396,7 +1271,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetUserActivity", mock.Anything, mock.AnythingOfType("*uuid.UUID"), targetID, 15).
 					Return(nil, service.ErrAccessDenied)
 			},
@@ -1412,7 +1287,7 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("GetUserActivity", mock.Anything, mock.AnythingOfType("*uuid.UUID"), targetID, 15).
 					Return(nil, errUnexpectedService)
 			},
@@ -1428,12 +1303,12 @@ func TestSocialHandlerGetUserActivity(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockSocialService)
+			mockSvc := new(mocks.SocialService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewSocialHandler(mockSvc)
+			h := handler.NewSocialHandler(mockSvc, nil)
 
 			r := chi.NewRouter()
 			r.Get("/users/{user_id}/activity", h.GetUserActivity)
@@ -1463,7 +1338,7 @@ type checkFollowingTestCase struct {
 	userIDPath     string
 	targetIDPath   string
 	requesterIDHdr string
-	mockRun        func(*MockSocialService)
+	mockRun        func(*mocks.SocialService)
 	expectedStatus int
 	validateBody   func(*testing.T, string)
 }
@@ -1494,7 +1369,7 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("CheckFollowing", mock.Anything, requesterID, userID, targetID).Return(followingResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -1509,7 +1384,7 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("CheckFollowing", mock.Anything, requesterID, userID, targetID).Return(notFollowingResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -1525,7 +1400,7 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 			userIDPath:     requesterID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("CheckFollowing", mock.Anything, requesterID, requesterID, targetID).Return(followingResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -1539,7 +1414,7 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnauthorized,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1552,7 +1427,7 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: "invalid-uuid",
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnauthorized,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1565,7 +1440,7 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 			userIDPath:     "invalid-uuid",
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnprocessableEntity,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1578,7 +1453,7 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   "invalid-uuid",
 			requesterIDHdr: requesterID.String(),
-			mockRun:        func(_ *MockSocialService) {},
+			mockRun:        func(_ *mocks.SocialService) {},
 			expectedStatus: http.StatusUnprocessableEntity,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
@@ -1591,7 +1466,7 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("CheckFollowing", mock.Anything, requesterID, userID, targetID).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -1606,7 +1481,7 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("CheckFollowing", mock.Anything, requesterID, userID, targetID).Return(nil, service.ErrAccessDenied)
 			},
 			expectedStatus: http.StatusForbidden,
@@ -1621,7 +1496,7 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 			userIDPath:     userID.String(),
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
-			mockRun: func(m *MockSocialService) {
+			mockRun: func(m *mocks.SocialService) {
 				m.On("CheckFollowing", mock.Anything, requesterID, userID, targetID).Return(nil, errUnexpectedService)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -1636,12 +1511,12 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockSocialService)
+			mockSvc := new(mocks.SocialService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewSocialHandler(mockSvc)
+			h := handler.NewSocialHandler(mockSvc, nil)
 
 			r := chi.NewRouter()
 			r.Get("/users/{user_id}/following/{target_user_id}", h.CheckFollowing)
@@ -1662,3 +1537,563 @@ func TestSocialHandlerCheckFollowing(t *testing.T) {
 		})
 	}
 }
+
+func TestSocialHandlerUpdateFollowSettings(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	targetID := uuid.New()
+	otherID := uuid.New()
+
+	tests := []struct {
+		name           string
+		requesterID    uuid.UUID
+		userRole       dto.UserRole
+		body           string
+		mockRun        func(*mocks.SocialService)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:        "Success - mutes the follow",
+			requesterID: userID,
+			body:        `{"muted":true}`,
+			mockRun: func(m *mocks.SocialService) {
+				m.On("UpdateFollowSettings", mock.Anything, userID, targetID,
+					mock.MatchedBy(func(req *dto.FollowSettingsUpdateRequest) bool {
+						return req.Muted != nil && *req.Muted && req.NotifyReviews == nil
+					})).
+					Return(&dto.FollowSettings{Muted: true, NotifyNewRecipes: true, NotifyReviews: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Forbidden - another user's follow",
+			requesterID:    otherID,
+			body:           `{"muted":true}`,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:        "Success - admin changes another user's follow",
+			requesterID: otherID,
+			userRole:    dto.UserRoleAdmin,
+			body:        `{"notifyReviews":false}`,
+			mockRun: func(m *mocks.SocialService) {
+				m.On("UpdateFollowSettings", mock.Anything, userID, targetID, mock.Anything).
+					Return(&dto.FollowSettings{NotifyNewRecipes: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Not Found - not following",
+			requesterID: userID,
+			body:        `{"muted":true}`,
+			mockRun: func(m *mocks.SocialService) {
+				m.On("UpdateFollowSettings", mock.Anything, userID, targetID, mock.Anything).
+					Return(nil, service.ErrNotFollowing)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "NOT_FOLLOWING",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewSocialService(t)
+			if tt.mockRun != nil {
+				tt.mockRun(mockSvc)
+			}
+
+			h := handler.NewSocialHandler(mockSvc, nil)
+
+			r := chi.NewRouter()
+			r.Put("/users/{user_id}/following/{target_user_id}/settings", h.UpdateFollowSettings)
+
+			url := "/users/" + userID.String() + "/following/" + targetID.String() + "/settings"
+
+			req := httptest.NewRequest(http.MethodPut, url, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = setAuthenticatedUser(req, tt.requesterID)
+
+			if tt.userRole != "" {
+				req = req.WithContext(middleware.WithRole(req.Context(), tt.userRole))
+			}
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedCode)
+			}
+		})
+	}
+}
+
+func TestSocialHandlerGetNotificationRecipients(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	path := "/internal/v1/users/" + userID.String() + "/notification-recipients"
+
+	tests := []struct {
+		name           string
+		query          string
+		authorize      func(*http.Request) *http.Request
+		mockRun        func(*mocks.SocialService)
+		expectedStatus int
+	}{
+		{
+			name:      "service account lists recipients",
+			query:     "?event=new_recipe",
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockRun: func(m *mocks.SocialService) {
+				m.On("GetNotificationRecipients", mock.Anything, userID, dto.FollowNotificationEventNewRecipe).
+					Return(&dto.NotificationRecipientsResponse{UserID: userID.String(), RecipientIDs: []string{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "regular user is forbidden",
+			query:          "?event=new_recipe",
+			authorize:      func(r *http.Request) *http.Request { return setAuthenticatedUser(r, userID) },
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "unknown event",
+			query:          "?event=comment",
+			authorize:      func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "unknown user",
+			query:     "?event=review",
+			authorize: func(r *http.Request) *http.Request { return withServiceAccount(r, "user:read") },
+			mockRun: func(m *mocks.SocialService) {
+				m.On("GetNotificationRecipients", mock.Anything, userID, dto.FollowNotificationEventReview).
+					Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewSocialService(t)
+			if tt.mockRun != nil {
+				tt.mockRun(mockSvc)
+			}
+
+			h := handler.NewSocialHandler(mockSvc, nil)
+
+			r := chi.NewRouter()
+			r.Get("/internal/v1/users/{user_id}/notification-recipients", h.GetNotificationRecipients)
+
+			req := httptest.NewRequest(http.MethodGet, path+tt.query, nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, tt.authorize(req))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestSocialHandlerCloseFriends(t *testing.T) {
+	t.Parallel()
+
+	ownerID := uuid.New()
+	friendID := uuid.New()
+	friendPath := "/users/" + ownerID.String() + "/close-friends/" + friendID.String()
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		requesterID    uuid.UUID
+		userRole       dto.UserRole
+		mockRun        func(*mocks.SocialService)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:        "owner adds a close friend",
+			method:      http.MethodPut,
+			path:        friendPath,
+			requesterID: ownerID,
+			mockRun: func(m *mocks.SocialService) {
+				m.On("SetCloseFriend", mock.Anything, ownerID, friendID, true).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:        "adding a non-follower conflicts",
+			method:      http.MethodPut,
+			path:        friendPath,
+			requesterID: ownerID,
+			mockRun: func(m *mocks.SocialService) {
+				m.On("SetCloseFriend", mock.Anything, ownerID, friendID, true).Return(service.ErrNotAFollower)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "NOT_A_FOLLOWER",
+		},
+		{
+			name:        "owner removes a close friend",
+			method:      http.MethodDelete,
+			path:        friendPath,
+			requesterID: ownerID,
+			mockRun: func(m *mocks.SocialService) {
+				m.On("SetCloseFriend", mock.Anything, ownerID, friendID, false).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:        "owner lists close friends",
+			method:      http.MethodGet,
+			path:        "/users/" + ownerID.String() + "/close-friends?limit=5",
+			requesterID: ownerID,
+			mockRun: func(m *mocks.SocialService) {
+				m.On("GetCloseFriends", mock.Anything, ownerID, 5, 0, false).
+					Return(&dto.GetFollowedUsersResponse{TotalCount: 0}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "another user cannot list them",
+			method:         http.MethodGet,
+			path:           "/users/" + ownerID.String() + "/close-friends",
+			requesterID:    friendID,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "not even an admin can change them",
+			method:         http.MethodPut,
+			path:           friendPath,
+			requesterID:    friendID,
+			userRole:       dto.UserRoleAdmin,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewSocialService(t)
+			if tt.mockRun != nil {
+				tt.mockRun(mockSvc)
+			}
+
+			h := handler.NewSocialHandler(mockSvc, nil)
+
+			r := chi.NewRouter()
+			r.Get("/users/{user_id}/close-friends", h.GetCloseFriends)
+			r.Put("/users/{user_id}/close-friends/{target_user_id}", h.AddCloseFriend)
+			r.Delete("/users/{user_id}/close-friends/{target_user_id}", h.RemoveCloseFriend)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req = setAuthenticatedUser(req, tt.requesterID)
+
+			if tt.userRole != "" {
+				req = req.WithContext(middleware.WithRole(req.Context(), tt.userRole))
+			}
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedCode)
+			}
+		})
+	}
+}
+
+func TestSocialHandlerGetFollowHistory(t *testing.T) {
+	t.Parallel()
+
+	ownerID := uuid.New()
+	otherID := uuid.New()
+	path := "/users/" + ownerID.String() + "/followers/history"
+
+	tests := []struct {
+		name           string
+		path           string
+		requesterID    uuid.UUID
+		userRole       dto.UserRole
+		mockRun        func(*mocks.SocialService)
+		expectedStatus int
+	}{
+		{
+			name:        "owner reads their history",
+			path:        path + "?limit=5&offset=10",
+			requesterID: ownerID,
+			mockRun: func(m *mocks.SocialService) {
+				m.On("GetFollowHistory", mock.Anything, ownerID, 5, 10).
+					Return(&dto.FollowHistoryResponse{TotalCount: 0, Limit: 5, Offset: 10}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid limit",
+			path:           path + "?limit=0",
+			requesterID:    ownerID,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "admins cannot read another user's history",
+			path:           path,
+			requesterID:    otherID,
+			userRole:       dto.UserRoleAdmin,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewSocialService(t)
+			if tt.mockRun != nil {
+				tt.mockRun(mockSvc)
+			}
+
+			h := handler.NewSocialHandler(mockSvc, nil)
+
+			r := chi.NewRouter()
+			r.Get("/users/{user_id}/followers/history", h.GetFollowHistory)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req = setAuthenticatedUser(req, tt.requesterID)
+
+			if tt.userRole != "" {
+				req = req.WithContext(middleware.WithRole(req.Context(), tt.userRole))
+			}
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestSocialHandlerQuota(t *testing.T) {
+	t.Parallel()
+
+	adminID, userID := uuid.New(), uuid.New()
+	limit := 50
+	quota := &dto.QuotaResponse{Following: dto.QuotaUsage{Used: 3, Limit: &limit, Overridden: true}}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		authorize      func(*http.Request) *http.Request
+		mockRun        func(*mocks.SocialService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "owner reads their quota",
+			method: http.MethodGet,
+			path:   "/users/" + userID.String() + "/quota",
+			authorize: func(r *http.Request) *http.Request {
+				return setAuthenticatedUser(r, userID)
+			},
+			mockRun: func(m *mocks.SocialService) {
+				m.On("GetQuota", mock.Anything, userID).Return(quota, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"used":3`,
+		},
+		{
+			name:   "other users cannot read a quota",
+			method: http.MethodGet,
+			path:   "/users/" + userID.String() + "/quota",
+			authorize: func(r *http.Request) *http.Request {
+				return setAuthenticatedUser(r, adminID)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "admin reads a user's quota",
+			method: http.MethodGet,
+			path:   "/admin/users/" + userID.String() + "/quota",
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockRun: func(m *mocks.SocialService) {
+				m.On("GetQuota", mock.Anything, userID).Return(quota, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"overridden":true`,
+		},
+		{
+			name:   "admin overrides the follow limit",
+			method: http.MethodPut,
+			path:   "/admin/users/" + userID.String() + "/quota/following",
+			body:   `{"maxFollowing":50}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockRun: func(m *mocks.SocialService) {
+				m.On("SetFollowLimit", mock.Anything, userID, &limit).Return(quota, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"limit":50`,
+		},
+		{
+			name:   "negative follow limit",
+			method: http.MethodPut,
+			path:   "/admin/users/" + userID.String() + "/quota/following",
+			body:   `{"maxFollowing":-1}`,
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "admin clears the override of an unknown user",
+			method: http.MethodDelete,
+			path:   "/admin/users/" + userID.String() + "/quota/following",
+			authorize: func(r *http.Request) *http.Request {
+				return withAdmin(r, adminID)
+			},
+			mockRun: func(m *mocks.SocialService) {
+				m.On("SetFollowLimit", mock.Anything, userID, (*int)(nil)).Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "USER_NOT_FOUND",
+		},
+		{
+			name:   "non-admin cannot override the follow limit",
+			method: http.MethodPut,
+			path:   "/admin/users/" + userID.String() + "/quota/following",
+			body:   `{"maxFollowing":50}`,
+			authorize: func(r *http.Request) *http.Request {
+				return setAuthenticatedUser(r, userID)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewSocialService(t)
+			if tt.mockRun != nil {
+				tt.mockRun(mockSvc)
+			}
+
+			h := handler.NewSocialHandler(mockSvc, nil)
+
+			r := chi.NewRouter()
+			r.Get("/users/{user_id}/quota", h.GetQuota)
+			r.Get("/admin/users/{user_id}/quota", h.GetUserQuota)
+			r.Put("/admin/users/{user_id}/quota/following", h.SetFollowLimit)
+			r.Delete("/admin/users/{user_id}/quota/following", h.ClearFollowLimit)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = tt.authorize(req)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestSocialHandlerGetDigestPreview(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	path := "/users/preferences/notifications/digest-preview"
+
+	tests := []struct {
+		name           string
+		path           string
+		authenticated  bool
+		mockRun        func(*mocks.SocialService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:          "previews the digest of the requester",
+			path:          path + "?per_type_limit=5",
+			authenticated: true,
+			mockRun: func(m *mocks.SocialService) {
+				m.On("GetDigestPreview", mock.Anything, userID, 5).Return(&dto.DigestPreviewResponse{
+					NewFollowers:        []dto.UserSummary{{UserID: uuid.NewString(), Username: "remy"}},
+					FollowedRecipes:     []dto.DigestRecipe{},
+					UnavailableSections: []string{dto.DigestSectionFollowedRecipes},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"unavailableSections":["followedRecipes"]`,
+		},
+		{
+			name:           "invalid per-type limit",
+			path:           path + "?per_type_limit=0",
+			authenticated:  true,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "requires authentication",
+			path:           path,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "service error",
+			path:          path,
+			authenticated: true,
+			mockRun: func(m *mocks.SocialService) {
+				m.On("GetDigestPreview", mock.Anything, userID, 15).Return(nil, errUnexpectedService)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := mocks.NewSocialService(t)
+			if tt.mockRun != nil {
+				tt.mockRun(mockSvc)
+			}
+
+			h := handler.NewSocialHandler(mockSvc, nil)
+
+			r := chi.NewRouter()
+			r.Get(path, h.GetDigestPreview)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.authenticated {
+				req = setAuthenticatedUser(req, userID)
+			}
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
@@ -1,10 +1,14 @@
 package handler_test
 
 import (
+	"encoding/json"
 	"net/http"
+	"testing"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
 
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
 )
 
@@ -37,3 +41,13 @@ func setAuthenticatedUserFromString(req *http.Request, userIDStr string) *http.R
 
 	return setAuthenticatedUser(req, userID)
 }
+
+// fieldErrors decodes the field errors of an error response body.
+func fieldErrors(t *testing.T, body string) []dto.FieldError {
+	t.Helper()
+
+	var response dto.Error
+	require.NoError(t, json.Unmarshal([]byte(body), &response))
+
+	return response.Errors
+}
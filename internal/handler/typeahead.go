@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+// typeaheadMaxAge lets clients reuse typeahead results while the user keeps typing.
+const typeaheadMaxAge = 30 * time.Second
+
+// TypeaheadHandler handles username autocompletion.
+type TypeaheadHandler struct {
+	typeaheadService service.TypeaheadService
+}
+
+// NewTypeaheadHandler creates a new typeahead handler.
+func NewTypeaheadHandler(typeaheadService service.TypeaheadService) *TypeaheadHandler {
+	return &TypeaheadHandler{
+		typeaheadService: typeaheadService,
+	}
+}
+
+// Typeahead handles GET /users/search/typeahead?prefix=. It returns at most
+// service.MaxTypeaheadResults users whose username starts with prefix.
+func (h *TypeaheadHandler) Typeahead(w http.ResponseWriter, r *http.Request) {
+	// 1. Require authentication
+	if _, ok := middleware.GetUserIDFromContext(r.Context()); !ok {
+		UnauthorizedResponse(w, "User authentication required")
+
+		return
+	}
+
+	if h.typeaheadService == nil {
+		ServiceUnavailableResponse(w, "Typeahead is not available")
+
+		return
+	}
+
+	// 2. Validate the prefix, which is at most as long as the longest username
+	prefix := r.URL.Query().Get("prefix")
+	maxPrefix := validation.CurrentProfileLimits().UsernameMax
+
+	if length := utf8.RuneCountInString(prefix); length == 0 || length > maxPrefix {
+		ErrorResponse(w, http.StatusBadRequest, "VALIDATION_ERROR",
+			fmt.Sprintf("prefix must be between 1 and %d characters", maxPrefix))
+
+		return
+	}
+
+	// 3. Call service
+	response, err := h.typeaheadService.Typeahead(r.Context(), prefix)
+	if err != nil {
+		slog.Error("failed to autocomplete usernames", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	w.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", int(typeaheadMaxAge.Seconds())))
+	SuccessResponse(w, http.StatusOK, response)
+}
+
+// StartReindex handles POST /admin/search/reindex. The search index is rebuilt in the background;
+// the response is the new job, whose progress GET /admin/search/index reports.
+func (h *TypeaheadHandler) StartReindex(w http.ResponseWriter, r *http.Request) {
+	_, ok := adminRequester(w, r)
+	if !ok {
+		return
+	}
+
+	if h.typeaheadService == nil {
+		ServiceUnavailableResponse(w, "Search index is not available")
+
+		return
+	}
+
+	job, err := h.typeaheadService.StartReindex(r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrSearchIndexUnavailable):
+			ServiceUnavailableResponse(w, "Search index is not available")
+		case errors.Is(err, service.ErrReindexRunning):
+			ErrorResponse(w, http.StatusConflict, "REINDEX_RUNNING", "A reindex is already running")
+		default:
+			slog.Error("failed to start reindex", "error", err)
+			InternalErrorResponse(w)
+		}
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusAccepted, job)
+}
+
+// GetSearchIndexStatus handles GET /admin/search/index.
+func (h *TypeaheadHandler) GetSearchIndexStatus(w http.ResponseWriter, r *http.Request) {
+	_, ok := adminRequester(w, r)
+	if !ok {
+		return
+	}
+
+	if h.typeaheadService == nil {
+		ServiceUnavailableResponse(w, "Search index is not available")
+
+		return
+	}
+
+	status, err := h.typeaheadService.GetSearchIndexStatus(r.Context())
+	if err != nil {
+		slog.Error("failed to get search index status", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, status)
+}
@@ -0,0 +1,194 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestTypeaheadHandlerTypeahead(t *testing.T) {
+	t.Parallel()
+
+	requesterID := uuid.New()
+
+	tests := []struct {
+		name           string
+		query          string
+		requesterID    uuid.UUID
+		mockSetup      func(*mocks.TypeaheadService)
+		expectedStatus int
+	}{
+		{
+			name:        "returns matches",
+			query:       "?prefix=ch",
+			requesterID: requesterID,
+			mockSetup: func(m *mocks.TypeaheadService) {
+				m.On("Typeahead", mock.Anything, "ch").Return(&dto.UserTypeaheadResponse{
+					Results: []dto.UserTypeaheadResult{{UserID: uuid.NewString(), Username: "chef"}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing prefix",
+			requesterID:    requesterID,
+			mockSetup:      func(_ *mocks.TypeaheadService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "prefix too long",
+			query:          "?prefix=" + strings.Repeat("a", 51),
+			requesterID:    requesterID,
+			mockSetup:      func(_ *mocks.TypeaheadService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "service error",
+			query:       "?prefix=ch",
+			requesterID: requesterID,
+			mockSetup: func(m *mocks.TypeaheadService) {
+				m.On("Typeahead", mock.Anything, "ch").Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "unauthenticated",
+			query:          "?prefix=ch",
+			mockSetup:      func(_ *mocks.TypeaheadService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.TypeaheadService)
+			tt.mockSetup(mockSvc)
+
+			h := handler.NewTypeaheadHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Get("/users/search/typeahead", h.Typeahead)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/users/search/typeahead"+tt.query, nil)
+			if tt.requesterID != uuid.Nil {
+				req = setAuthenticatedUser(req, tt.requesterID)
+			}
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if rr.Code == http.StatusOK {
+				assert.Equal(t, "private, max-age=30", rr.Header().Get("Cache-Control"))
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTypeaheadHandlerStartReindex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{name: "accepted", expectedStatus: http.StatusAccepted},
+		{name: "already running", err: service.ErrReindexRunning, expectedStatus: http.StatusConflict},
+		{name: "no index", err: service.ErrSearchIndexUnavailable, expectedStatus: http.StatusServiceUnavailable},
+		{name: "failure", err: errors.New("boom"), expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.TypeaheadService)
+			if tt.err != nil {
+				mockSvc.On("StartReindex", mock.Anything).Return(nil, tt.err)
+			} else {
+				mockSvc.On("StartReindex", mock.Anything).
+					Return(&dto.SearchReindexJob{JobID: "job", State: dto.SearchReindexRunning}, nil)
+			}
+
+			h := handler.NewTypeaheadHandler(mockSvc)
+
+			r := chi.NewRouter()
+			r.Post("/admin/search/reindex", h.StartReindex)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/admin/search/reindex", nil)
+			req = withRole(req, uuid.New(), dto.UserRoleAdmin)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTypeaheadHandlerGetSearchIndexStatus(t *testing.T) {
+	t.Parallel()
+
+	mockSvc := new(mocks.TypeaheadService)
+	mockSvc.On("GetSearchIndexStatus", mock.Anything).Return(&dto.SearchIndexStatusResponse{
+		Source:       dto.TypeaheadSourceVerify,
+		Verification: &dto.TypeaheadVerification{Reads: 4, Mismatches: 1},
+	}, nil)
+
+	h := handler.NewTypeaheadHandler(mockSvc)
+
+	r := chi.NewRouter()
+	r.Get("/admin/search/index", h.GetSearchIndexStatus)
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/admin/search/index", nil)
+	req = withRole(req, uuid.New(), dto.UserRoleAdmin)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"mismatches":1`)
+}
+
+func TestTypeaheadHandlerSearchIndexRequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	h := handler.NewTypeaheadHandler(new(mocks.TypeaheadService))
+
+	r := chi.NewRouter()
+	r.Post("/admin/search/reindex", h.StartReindex)
+	r.Get("/admin/search/index", h.GetSearchIndexStatus)
+
+	for _, method := range []string{http.MethodPost, http.MethodGet} {
+		path := "/admin/search/index"
+		if method == http.MethodPost {
+			path = "/admin/search/reindex"
+		}
+
+		req := httptest.NewRequestWithContext(context.Background(), method, path, nil)
+		req = withRole(req, uuid.New(), dto.UserRoleUser)
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code, path)
+	}
+}
@@ -2,9 +2,11 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -12,6 +14,7 @@ import (
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
 )
 
 // Pagination constants.
@@ -28,19 +31,35 @@ var (
 	ErrInvalidOffset    = errors.New("offset must be a valid integer")
 	ErrNegativeOffset   = errors.New("offset must be non-negative")
 	ErrInvalidCountOnly = errors.New("countOnly must be a valid boolean")
+	ErrInvalidCountry   = errors.New("country must be an ISO 3166-1 alpha-2 code")
+	ErrInvalidRegion    = errors.New("region must be an ISO 3166-2 subdivision code")
+	ErrUnknownExpansion = errors.New("unknown expansion")
 )
 
 // UserHandler handles user-related HTTP endpoints.
 type UserHandler struct {
-	userService service.UserService
-	binder      *RequestBinder
+	userService           service.UserService
+	userDetailsService    service.UserDetailsService
+	profileViewService    service.ProfileViewService
+	recentlyViewedService service.RecentlyViewedService
+	binder                *RequestBinder
 }
 
-// NewUserHandler creates a new user handler.
-func NewUserHandler(userService service.UserService) *UserHandler {
+// NewUserHandler creates a new user handler. Profile views are not counted when
+// profileViewService is nil, nor kept in the viewer's recently viewed profiles when
+// recentlyViewedService is.
+func NewUserHandler(
+	userService service.UserService,
+	userDetailsService service.UserDetailsService,
+	profileViewService service.ProfileViewService,
+	recentlyViewedService service.RecentlyViewedService,
+) *UserHandler {
 	return &UserHandler{
-		userService: userService,
-		binder:      NewRequestBinder(),
+		userService:           userService,
+		userDetailsService:    userDetailsService,
+		profileViewService:    profileViewService,
+		recentlyViewedService: recentlyViewedService,
+		binder:                NewRequestBinder(),
 	}
 }
 
@@ -51,7 +70,13 @@ func (h *UserHandler) GetUserProfile(w http.ResponseWriter, r *http.Request) {
 
 	targetUserID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format")
+		pathErrorResponse(w, r, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format", "user_id", "uuid")
+		return
+	}
+
+	fields, err := parseFields(r, dto.UserProfileResponse{})
+	if err != nil {
+		queryErrorResponse(w, err)
 		return
 	}
 
@@ -77,7 +102,114 @@ func (h *UserHandler) GetUserProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	SuccessResponse(w, http.StatusOK, profile)
+	h.recordView(r, targetUserID, requesterID)
+	SuccessResponse(w, http.StatusOK, projectFields(profile, fields))
+}
+
+// GetUserProfileByUsername handles GET /users/by-username/{username}.
+// Private and missing profiles both return 404 so usernames cannot be enumerated.
+func (h *UserHandler) GetUserProfileByUsername(w http.ResponseWriter, r *http.Request) {
+	// 1. Extract username from path
+	username := chi.URLParam(r, "username")
+	if username == "" {
+		ErrorResponse(w, http.StatusBadRequest, "INVALID_USERNAME", "Username is required")
+		return
+	}
+
+	fields, err := parseFields(r, dto.UserProfileResponse{})
+	if err != nil {
+		queryErrorResponse(w, err)
+		return
+	}
+
+	// 2. Identify Requester from context (anonymous if not authenticated)
+	requesterID, _ := middleware.GetUserIDFromContext(r.Context())
+
+	// 3. Call Service
+	profile, err := h.userService.GetUserProfileByUsername(r.Context(), requesterID, username)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+
+		slog.Error("failed to retrieve profile by username", "error", err)
+		ErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve profile")
+
+		return
+	}
+
+	if targetUserID, err := uuid.Parse(profile.UserID); err == nil {
+		h.recordView(r, targetUserID, requesterID)
+	}
+
+	SuccessResponse(w, http.StatusOK, projectFields(profile, fields))
+}
+
+// GetProfileViews handles GET /users/{user_id}/profile/views.
+func (h *UserHandler) GetProfileViews(w http.ResponseWriter, r *http.Request) {
+	// 1. Only the owner may see how often their profile was viewed
+	requesterID, ok := h.extractAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
+
+		return
+	}
+
+	if userID != requesterID {
+		ForbiddenResponse(w, "Profile views are only available to their owner")
+
+		return
+	}
+
+	if h.profileViewService == nil {
+		ServiceUnavailableResponse(w, "Profile views are not available")
+
+		return
+	}
+
+	// 2. Call service
+	views, err := h.profileViewService.GetProfileViews(r.Context(), userID)
+	if err != nil {
+		slog.Error("failed to get profile views", "error", err)
+		InternalErrorResponse(w)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, views)
+}
+
+// recordView records that requesterID, uuid.Nil when anonymous, viewed the profile of userID.
+func (h *UserHandler) recordView(r *http.Request, userID, requesterID uuid.UUID) {
+	recordProfileView(r, h.profileViewService, userID, requesterID)
+	recordRecentlyViewed(r, h.recentlyViewedService, userID, requesterID)
+}
+
+// recordProfileView counts a view of the profile of userID by requesterID, anonymous when nil.
+// Anonymous viewers are told apart by the client IP RealIP resolved through the trusted proxies,
+// so forged forwarding headers can't inflate the count. Counting is best effort: a failure is
+// logged and the profile is still served.
+func recordProfileView(r *http.Request, profileViews service.ProfileViewService, userID, requesterID uuid.UUID) {
+	if profileViews == nil {
+		return
+	}
+
+	var viewerID *uuid.UUID
+	if requesterID != uuid.Nil {
+		viewerID = &requesterID
+	}
+
+	err := profileViews.RecordView(r.Context(), userID, viewerID, middleware.ClientIP(r))
+	if err != nil {
+		slog.WarnContext(r.Context(), "failed to record profile view", "error", err)
+	}
 }
 
 // UpdateUserProfile handles PUT /users/profile.
@@ -106,6 +238,21 @@ func (h *UserHandler) UpdateUserProfile(w http.ResponseWriter, r *http.Request)
 	SuccessResponse(w, http.StatusOK, profile)
 }
 
+// GetProfileConstraints handles GET /users/profile/constraints.
+func (h *UserHandler) GetProfileConstraints(w http.ResponseWriter, _ *http.Request) {
+	limits := validation.CurrentProfileLimits()
+
+	SuccessResponse(w, http.StatusOK, dto.ProfileConstraintsResponse{
+		Username: dto.FieldConstraints{
+			MinLength: limits.UsernameMin,
+			MaxLength: limits.UsernameMax,
+			Pattern:   validation.UsernamePattern,
+		},
+		FullName: dto.FieldConstraints{MaxLength: limits.FullNameMax},
+		Bio:      dto.FieldConstraints{MaxLength: limits.BioMax},
+	})
+}
+
 // RequestAccountDeletion handles POST /users/account/delete-request.
 func (h *UserHandler) RequestAccountDeletion(w http.ResponseWriter, r *http.Request) {
 	requesterID, ok := h.extractAuthenticatedUserID(w, r)
@@ -152,7 +299,7 @@ func (h *UserHandler) ConfirmAccountDeletion(w http.ResponseWriter, r *http.Requ
 // SearchUsers handles GET /users/search.
 func (h *UserHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
 	// 1. Require authentication
-	_, ok := h.extractAuthenticatedUserID(w, r)
+	requesterID, ok := h.extractAuthenticatedUserID(w, r)
 	if !ok {
 		return
 	}
@@ -160,30 +307,54 @@ func (h *UserHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
 	// 2. Parse query parameters
 	params, err := h.parseSearchParams(r)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+		queryErrorResponse(w, err)
 
 		return
 	}
 
 	// 3. Call service
-	response, err := h.userService.SearchUsers(r.Context(), params.query, params.limit, params.offset, params.countOnly)
+	response, err := h.userService.SearchUsers(
+		r.Context(),
+		requesterID,
+		params.query,
+		params.location,
+		params.limit,
+		params.offset,
+		params.countOnly,
+	)
 	if err != nil {
 		h.handleSearchError(w, err)
 
 		return
 	}
 
-	SuccessResponse(w, http.StatusOK, response)
+	SuccessResponse(w, http.StatusOK, projectFields(response, params.fields))
 }
 
 // GetUserByID handles GET /users/{user_id}.
+// ?expand= embeds related resources (privacy, stats, recentActivity), each gated by its own
+// privacy rules.
 func (h *UserHandler) GetUserByID(w http.ResponseWriter, r *http.Request) {
 	// 1. Extract UserID from path
 	userIDStr := chi.URLParam(r, "user_id")
 
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		ErrorResponse(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format")
+		pathErrorResponse(w, r, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid user ID format",
+			"user_id", "uuid")
+
+		return
+	}
+
+	expand, err := parseExpand(r)
+	if err != nil {
+		queryErrorResponse(w, err)
+
+		return
+	}
+
+	if len(expand) > 0 {
+		h.getUserDetails(w, r, userID, expand)
 
 		return
 	}
@@ -199,11 +370,64 @@ func (h *UserHandler) GetUserByID(w http.ResponseWriter, r *http.Request) {
 	SuccessResponse(w, http.StatusOK, result)
 }
 
+func (h *UserHandler) getUserDetails(
+	w http.ResponseWriter,
+	r *http.Request,
+	userID uuid.UUID,
+	expand []dto.UserExpansion,
+) {
+	requesterID, isAdmin, hasServiceScope := requesterScopes(r)
+
+	result, err := h.userDetailsService.GetUserDetails(
+		r.Context(),
+		requesterID,
+		userID,
+		expand,
+		isAdmin,
+		hasServiceScope,
+	)
+	if err != nil {
+		h.handleGetUserByIDError(w, err)
+
+		return
+	}
+
+	SuccessResponse(w, http.StatusOK, result)
+}
+
+// parseExpand returns the expansions requested by the comma-separated expand query parameter.
+func parseExpand(r *http.Request) ([]dto.UserExpansion, error) {
+	raw := r.URL.Query().Get("expand")
+	if raw == "" {
+		return nil, nil
+	}
+
+	var expand []dto.UserExpansion
+
+	for name := range strings.SplitSeq(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if !dto.IsValidUserExpansion(name) {
+			return nil, invalidQuery("expand", "oneof", strings.Join(dto.UserExpansion(name).Values(), ", "), name,
+				fmt.Errorf("%w: %s", ErrUnknownExpansion, name))
+		}
+
+		expand = append(expand, dto.UserExpansion(name))
+	}
+
+	return expand, nil
+}
+
 type searchParams struct {
 	query     string
+	location  dto.LocationFilter
 	limit     int
 	offset    int
 	countOnly bool
+	fields    []string
 }
 
 func (h *UserHandler) parseSearchParams(r *http.Request) (*searchParams, error) {
@@ -218,11 +442,11 @@ func (h *UserHandler) parseSearchParams(r *http.Request) (*searchParams, error)
 	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
 		limit, err := strconv.Atoi(limitStr)
 		if err != nil {
-			return nil, ErrInvalidLimit
+			return nil, invalidQuery("limit", "integer", "", limitStr, ErrInvalidLimit)
 		}
 
 		if limit < minLimit || limit > maxLimit {
-			return nil, ErrLimitOutOfRange
+			return nil, invalidRange("limit", limitStr, limit, minLimit, maxLimit, ErrLimitOutOfRange)
 		}
 
 		params.limit = limit
@@ -232,11 +456,11 @@ func (h *UserHandler) parseSearchParams(r *http.Request) (*searchParams, error)
 	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
 		offset, err := strconv.Atoi(offsetStr)
 		if err != nil {
-			return nil, ErrInvalidOffset
+			return nil, invalidQuery("offset", "integer", "", offsetStr, ErrInvalidOffset)
 		}
 
 		if offset < 0 {
-			return nil, ErrNegativeOffset
+			return nil, invalidQuery("offset", "gte", "0", offsetStr, ErrNegativeOffset)
 		}
 
 		params.offset = offset
@@ -246,12 +470,36 @@ func (h *UserHandler) parseSearchParams(r *http.Request) (*searchParams, error)
 	if countOnlyStr := r.URL.Query().Get("countOnly"); countOnlyStr != "" {
 		countOnly, err := strconv.ParseBool(countOnlyStr)
 		if err != nil {
-			return nil, ErrInvalidCountOnly
+			return nil, invalidQuery("countOnly", "boolean", "", countOnlyStr, ErrInvalidCountOnly)
 		}
 
 		params.countOnly = countOnly
 	}
 
+	// Parse the location filter
+	if country := r.URL.Query().Get("country"); country != "" {
+		if !validation.IsCountryCode(country) {
+			return nil, invalidQuery("country", "iso3166_1_alpha2", "", country, ErrInvalidCountry)
+		}
+
+		params.location.Country = country
+	}
+
+	if region := r.URL.Query().Get("region"); region != "" {
+		if !validation.IsRegionCode(region) {
+			return nil, invalidQuery("region", "iso3166_2", "", region, ErrInvalidRegion)
+		}
+
+		params.location.Region = region
+	}
+
+	fields, err := parseFields(r, dto.UserSearchResponse{})
+	if err != nil {
+		return nil, err
+	}
+
+	params.fields = fields
+
 	return params, nil
 }
 
@@ -286,7 +534,7 @@ func (h *UserHandler) handleBindError(w http.ResponseWriter, err error) {
 	case errors.Is(err, ErrEmptyBody):
 		ErrorResponse(w, http.StatusBadRequest, "EMPTY_BODY", "Request body is required")
 	case errors.Is(err, ErrInvalidJSON), errors.Is(err, ErrInvalidFieldType):
-		ErrorResponse(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		FieldErrorResponse(w, http.StatusBadRequest, "INVALID_JSON", err.Error(), fieldsOf(err)...)
 	case errors.Is(err, ErrValidationFailed):
 		ValidationErrorResponse(w, err)
 	default:
@@ -296,17 +544,60 @@ func (h *UserHandler) handleBindError(w http.ResponseWriter, err error) {
 }
 
 func (h *UserHandler) handleUpdateProfileError(w http.ResponseWriter, err error) {
+	if respondIdentifierConflict(w, err) {
+		return
+	}
+
 	switch {
 	case errors.Is(err, service.ErrUserNotFound):
 		ErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
-	case errors.Is(err, service.ErrDuplicateUsername):
-		ConflictResponse(w, "Username already taken")
+	case errors.Is(err, service.ErrChangeApprovalRequired):
+		changeApprovalRequiredResponse(w)
+	case errors.Is(err, service.ErrRegionOutsideCountry):
+		ErrorResponse(w, http.StatusBadRequest, "REGION_OUTSIDE_COUNTRY",
+			"Region must be a subdivision of the profile's country")
+	case errors.Is(err, service.ErrEmailChangeRequiresConfirmation):
+		ErrorResponse(w, http.StatusBadRequest, "EMAIL_CHANGE_REQUIRES_CONFIRMATION",
+			"Email cannot be changed with a profile update; use POST /users/account/email-change")
+	case errors.Is(err, service.ErrObjectionableContent):
+		var rejected validation.ValidationErrors
+
+		errors.As(err, &rejected)
+		FieldErrorResponse(w, http.StatusUnprocessableEntity, "OBJECTIONABLE_CONTENT",
+			"Profile text was rejected by content moderation", rejected...)
 	default:
 		slog.Error("failed to update user profile", "error", err)
 		InternalErrorResponse(w)
 	}
 }
 
+// respondIdentifierConflict writes a 409 response if err reports a username or email that is
+// taken or retired, and reports whether it did.
+func respondIdentifierConflict(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, service.ErrDuplicateUsername):
+		ErrorResponse(w, http.StatusConflict, "DUPLICATE_USERNAME", "Username already taken")
+	case errors.Is(err, service.ErrDuplicateEmail):
+		ErrorResponse(w, http.StatusConflict, "DUPLICATE_EMAIL", "Email already in use")
+	case errors.Is(err, service.ErrUsernameRetired):
+		ErrorResponse(w, http.StatusConflict, "USERNAME_RETIRED",
+			"Username belongs to a recently deactivated account and is not yet available")
+	case errors.Is(err, service.ErrEmailRetired):
+		ErrorResponse(w, http.StatusConflict, "EMAIL_RETIRED",
+			"Email belongs to a recently deactivated account and is not yet available")
+	default:
+		return false
+	}
+
+	return true
+}
+
+// changeApprovalRequiredResponse points moderated accounts at the change-request queue.
+func changeApprovalRequiredResponse(w http.ResponseWriter) {
+	ErrorResponse(w, http.StatusForbidden, "CHANGE_APPROVAL_REQUIRED",
+		"Username and email changes on this account need admin approval; submit a change request")
+}
+
 func (h *UserHandler) handleDeleteRequestError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, service.ErrUserNotFound):
@@ -1,7 +1,6 @@
 package handler_test
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -12,184 +11,28 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
 )
 
 var (
-	errDB                  = errors.New("db error")
-	errMockArgs            = errors.New("mock: missing args")
-	errStartType           = errors.New("invalid type assertion for UserProfileResponse")
-	errDeleteRequestType   = errors.New("invalid type assertion for UserAccountDeleteRequestResponse")
-	errConfirmDeletionType = errors.New("invalid type assertion for UserConfirmAccountDeleteResponse")
-	errSearchResponseType  = errors.New("invalid type assertion for UserSearchResponse")
-	errSearchResultType    = errors.New("invalid type assertion for UserSearchResult")
-	errUserStatsType       = errors.New("invalid type assertion for UserStatsResponse")
-	internalErrorStr       = "Internal Error"
-	userNotFoundStr        = "Not Found - User does not exist"
+	errDB            = errors.New("db error")
+	internalErrorStr = "Internal Error"
+	userNotFoundStr  = "Not Found - User does not exist"
 )
 
-// MockUserService is a mock implementation of service.UserService.
-type MockUserService struct {
-	mock.Mock
-}
-
-func (m *MockUserService) GetUserProfile(
-	ctx context.Context,
-	requesterID, targetUserID uuid.UUID,
-) (*dto.UserProfileResponse, error) {
-	args := m.Called(ctx, requesterID, targetUserID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockArgs // Fix nilnil
-	}
-
-	if val, ok := args.Get(0).(*dto.UserProfileResponse); ok {
-		return val, nil
-	}
-
-	return nil, errStartType // Fix err113
-}
-
-func (m *MockUserService) UpdateUserProfile(
-	ctx context.Context,
-	userID uuid.UUID,
-	update *dto.UserProfileUpdateRequest,
-) (*dto.UserProfileResponse, error) {
-	args := m.Called(ctx, userID, update)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.UserProfileResponse); ok {
-		return val, nil
-	}
-
-	return nil, errStartType
-}
-
-func (m *MockUserService) RequestAccountDeletion(
-	ctx context.Context,
-	userID uuid.UUID,
-) (*dto.UserAccountDeleteRequestResponse, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.UserAccountDeleteRequestResponse); ok {
-		return val, nil
-	}
-
-	return nil, errDeleteRequestType
-}
-
-func (m *MockUserService) ConfirmAccountDeletion(
-	ctx context.Context,
-	userID uuid.UUID,
-	token string,
-) (*dto.UserConfirmAccountDeleteResponse, error) {
-	args := m.Called(ctx, userID, token)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.UserConfirmAccountDeleteResponse); ok {
-		return val, nil
-	}
-
-	return nil, errConfirmDeletionType
-}
-
-func (m *MockUserService) SearchUsers(
-	ctx context.Context,
-	query string,
-	limit, offset int,
-	countOnly bool,
-) (*dto.UserSearchResponse, error) {
-	args := m.Called(ctx, query, limit, offset, countOnly)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.UserSearchResponse); ok {
-		return val, nil
-	}
-
-	return nil, errSearchResponseType
-}
-
-func (m *MockUserService) GetUserByID(
-	ctx context.Context,
-	userID uuid.UUID,
-) (*dto.UserSearchResult, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.UserSearchResult); ok {
-		return val, nil
-	}
-
-	return nil, errSearchResultType
-}
-
-func (m *MockUserService) GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error) {
-	args := m.Called(ctx)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.UserStatsResponse); ok {
-		return val, nil
-	}
-
-	return nil, errUserStatsType
-}
-
 type userHandlerTestCase struct {
 	name           string
 	targetIDPath   string
 	requesterIDHdr string
-	mockRun        func(*MockUserService)
+	mockRun        func(*mocks.UserService)
 	expectedStatus int
 	validateBody   func(*testing.T, string)
 }
@@ -222,7 +65,7 @@ func getHandlerTestCases(
 			name:           "Success",
 			targetIDPath:   targetID.String(),
 			requesterIDHdr: requesterID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("GetUserProfile", mock.Anything, requesterID, targetID).Return(baseProfile, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -235,7 +78,7 @@ func getHandlerTestCases(
 		{
 			name:         "User Not Found",
 			targetIDPath: targetID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("GetUserProfile", mock.Anything, uuid.Nil, targetID).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -243,7 +86,7 @@ func getHandlerTestCases(
 		{
 			name:         "Profile Private",
 			targetIDPath: targetID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("GetUserProfile", mock.Anything, uuid.Nil, targetID).Return(nil, service.ErrProfilePrivate)
 			},
 			expectedStatus: http.StatusForbidden,
@@ -251,7 +94,7 @@ func getHandlerTestCases(
 		{
 			name:         internalErrorStr,
 			targetIDPath: targetID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("GetUserProfile", mock.Anything, uuid.Nil, targetID).Return(nil, errDB)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -259,7 +102,7 @@ func getHandlerTestCases(
 		{
 			name:         "Invalid ID Format",
 			targetIDPath: "invalid-uuid",
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				// Service is not called because ID validation fails first.
 			},
 			expectedStatus: http.StatusBadRequest,
@@ -274,12 +117,12 @@ func runUserHandlerTest(t *testing.T, tests []userHandlerTestCase) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockUserService)
+			mockSvc := new(mocks.UserService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewUserHandler(mockSvc)
+			h := handler.NewUserHandler(mockSvc, nil, nil, nil)
 
 			r := chi.NewRouter()
 			r.Get("/users/{user_id}/profile", h.GetUserProfile)
@@ -304,7 +147,7 @@ type updateProfileTestCase struct {
 	requesterIDHdr string
 	requestBody    string
 	contentType    string
-	mockRun        func(*MockUserService)
+	mockRun        func(*mocks.UserService)
 	expectedStatus int
 	validateBody   func(*testing.T, string)
 }
@@ -321,7 +164,7 @@ func TestUserHandlerUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 			requesterIDHdr: userID.String(),
 			requestBody:    `{"username": "newusername"}`,
 			contentType:    "application/json",
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("UpdateUserProfile", mock.Anything, userID, mock.Anything).Return(&dto.UserProfileResponse{
 					UserID:    userID.String(),
 					Username:  "newusername",
@@ -381,7 +224,28 @@ func TestUserHandlerUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
 				assert.Contains(t, body, "VALIDATION_ERROR")
-				assert.Contains(t, body, "username")
+				assert.Equal(t, []dto.FieldError{{
+					Field:         "username",
+					Code:          "min",
+					Message:       "must be at least 3 characters",
+					RejectedValue: "ab",
+				}}, fieldErrors(t, body))
+			},
+		},
+		{
+			name:           "Bad Request - Wrong field type",
+			requesterIDHdr: userID.String(),
+			requestBody:    `{"username": 42}`,
+			contentType:    "application/json",
+			expectedStatus: http.StatusBadRequest,
+			validateBody: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, "INVALID_JSON")
+
+				errs := fieldErrors(t, body)
+				require.Len(t, errs, 1)
+				assert.Equal(t, "username", errs[0].Field)
+				assert.Equal(t, "type", errs[0].Code)
 			},
 		},
 		{
@@ -395,12 +259,28 @@ func TestUserHandlerUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 				assert.Contains(t, body, "VALIDATION_ERROR")
 			},
 		},
+		{
+			name:           "Bad Request - Validation Error (released username prefix)",
+			requesterIDHdr: userID.String(),
+			requestBody:    `{"username": "Released_0f3c9a"}`,
+			contentType:    "application/json",
+			expectedStatus: http.StatusBadRequest,
+			validateBody: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, "VALIDATION_ERROR")
+
+				errs := fieldErrors(t, body)
+				require.Len(t, errs, 1)
+				assert.Equal(t, "username", errs[0].Field)
+				assert.Equal(t, "unreserved", errs[0].Code)
+			},
+		},
 		{
 			name:           "Not Found",
 			requesterIDHdr: userID.String(),
 			requestBody:    `{"username": "newusername"}`,
 			contentType:    "application/json",
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("UpdateUserProfile", mock.Anything, userID, mock.Anything).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -410,17 +290,46 @@ func TestUserHandlerUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 			requesterIDHdr: userID.String(),
 			requestBody:    `{"username": "existinguser"}`,
 			contentType:    "application/json",
-			mockRun: func(m *MockUserService) {
-				m.On("UpdateUserProfile", mock.Anything, userID, mock.Anything).Return(nil, service.ErrDuplicateUsername)
+			mockRun: func(m *mocks.UserService) {
+				m.On("UpdateUserProfile", mock.Anything, userID, mock.Anything).
+					Return(nil, service.ErrDuplicateUsername)
 			},
 			expectedStatus: http.StatusConflict,
 		},
+		{
+			name:           "Email Change Requires Confirmation",
+			requesterIDHdr: userID.String(),
+			requestBody:    `{"email": "new@example.com"}`,
+			contentType:    "application/json",
+			mockRun: func(m *mocks.UserService) {
+				m.On("UpdateUserProfile", mock.Anything, userID, mock.Anything).
+					Return(nil, service.ErrEmailChangeRequiresConfirmation)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Objectionable Content",
+			requesterIDHdr: userID.String(),
+			requestBody:    `{"bio": "darn it"}`,
+			contentType:    "application/json",
+			mockRun: func(m *mocks.UserService) {
+				rejected := validation.ValidationErrors{validation.Invalid("bio", "moderation", "", nil)}
+				m.On("UpdateUserProfile", mock.Anything, userID, mock.Anything).
+					Return(nil, fmt.Errorf("%w: %w", service.ErrObjectionableContent, rejected))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			validateBody: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, "OBJECTIONABLE_CONTENT")
+				assert.Contains(t, body, `"field":"bio"`)
+			},
+		},
 		{
 			name:           internalErrorStr,
 			requesterIDHdr: userID.String(),
 			requestBody:    `{"username": "newusername"}`,
 			contentType:    "application/json",
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("UpdateUserProfile", mock.Anything, userID, mock.Anything).Return(nil, errDB)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -431,12 +340,12 @@ func TestUserHandlerUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockUserService)
+			mockSvc := new(mocks.UserService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewUserHandler(mockSvc)
+			h := handler.NewUserHandler(mockSvc, nil, nil, nil)
 
 			r := chi.NewRouter()
 			r.Put("/users/profile", h.UpdateUserProfile)
@@ -463,7 +372,7 @@ func TestUserHandlerUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 type requestAccountDeletionTestCase struct {
 	name           string
 	requesterIDHdr string
-	mockRun        func(*MockUserService)
+	mockRun        func(*mocks.UserService)
 	expectedStatus int
 	validateBody   func(*testing.T, string)
 }
@@ -478,7 +387,7 @@ func TestUserHandlerRequestAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		{
 			name:           "Success",
 			requesterIDHdr: userID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("RequestAccountDeletion", mock.Anything, userID).Return(&dto.UserAccountDeleteRequestResponse{
 					UserID:            userID.String(),
 					ConfirmationToken: uuid.New().String(),
@@ -506,7 +415,7 @@ func TestUserHandlerRequestAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		{
 			name:           userNotFoundStr,
 			requesterIDHdr: userID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("RequestAccountDeletion", mock.Anything, userID).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -518,7 +427,7 @@ func TestUserHandlerRequestAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		{
 			name:           "Service Unavailable - Cache unavailable",
 			requesterIDHdr: userID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("RequestAccountDeletion", mock.Anything, userID).Return(nil, service.ErrCacheUnavailable)
 			},
 			expectedStatus: http.StatusServiceUnavailable,
@@ -530,7 +439,7 @@ func TestUserHandlerRequestAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		{
 			name:           internalErrorStr,
 			requesterIDHdr: userID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("RequestAccountDeletion", mock.Anything, userID).Return(nil, errDB)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -541,12 +450,12 @@ func TestUserHandlerRequestAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockUserService)
+			mockSvc := new(mocks.UserService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewUserHandler(mockSvc)
+			h := handler.NewUserHandler(mockSvc, nil, nil, nil)
 
 			r := chi.NewRouter()
 			r.Post("/users/account/delete-request", h.RequestAccountDeletion)
@@ -571,7 +480,7 @@ type confirmAccountDeletionTestCase struct {
 	requesterIDHdr string
 	requestBody    string
 	contentType    string
-	mockRun        func(*MockUserService)
+	mockRun        func(*mocks.UserService)
 	expectedStatus int
 	validateBody   func(*testing.T, string)
 }
@@ -589,11 +498,12 @@ func TestUserHandlerConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 			requesterIDHdr: userID.String(),
 			requestBody:    fmt.Sprintf(`{"confirmationToken": "%s"}`, token),
 			contentType:    "application/json",
-			mockRun: func(m *MockUserService) {
-				m.On("ConfirmAccountDeletion", mock.Anything, userID, token).Return(&dto.UserConfirmAccountDeleteResponse{
-					UserID:        userID.String(),
-					DeactivatedAt: now,
-				}, nil)
+			mockRun: func(m *mocks.UserService) {
+				m.On("ConfirmAccountDeletion", mock.Anything, userID, token).
+					Return(&dto.UserConfirmAccountDeleteResponse{
+						UserID:        userID.String(),
+						DeactivatedAt: now,
+					}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			validateBody: func(t *testing.T, body string) {
@@ -643,8 +553,9 @@ func TestUserHandlerConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 			requesterIDHdr: userID.String(),
 			requestBody:    `{"confirmationToken": "wrong-token"}`,
 			contentType:    "application/json",
-			mockRun: func(m *MockUserService) {
-				m.On("ConfirmAccountDeletion", mock.Anything, userID, "wrong-token").Return(nil, service.ErrInvalidToken)
+			mockRun: func(m *mocks.UserService) {
+				m.On("ConfirmAccountDeletion", mock.Anything, userID, "wrong-token").
+					Return(nil, service.ErrInvalidToken)
 			},
 			expectedStatus: http.StatusBadRequest,
 			validateBody: func(t *testing.T, body string) {
@@ -657,7 +568,7 @@ func TestUserHandlerConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 			requesterIDHdr: userID.String(),
 			requestBody:    fmt.Sprintf(`{"confirmationToken": "%s"}`, token),
 			contentType:    "application/json",
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("ConfirmAccountDeletion", mock.Anything, userID, token).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -671,7 +582,7 @@ func TestUserHandlerConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 			requesterIDHdr: userID.String(),
 			requestBody:    fmt.Sprintf(`{"confirmationToken": "%s"}`, token),
 			contentType:    "application/json",
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("ConfirmAccountDeletion", mock.Anything, userID, token).Return(nil, service.ErrCacheUnavailable)
 			},
 			expectedStatus: http.StatusServiceUnavailable,
@@ -685,7 +596,7 @@ func TestUserHandlerConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 			requesterIDHdr: userID.String(),
 			requestBody:    fmt.Sprintf(`{"confirmationToken": "%s"}`, token),
 			contentType:    "application/json",
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("ConfirmAccountDeletion", mock.Anything, userID, token).Return(nil, errDB)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -696,12 +607,12 @@ func TestUserHandlerConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockUserService)
+			mockSvc := new(mocks.UserService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewUserHandler(mockSvc)
+			h := handler.NewUserHandler(mockSvc, nil, nil, nil)
 
 			r := chi.NewRouter()
 			r.Delete("/users/account", h.ConfirmAccountDeletion)
@@ -729,7 +640,7 @@ type searchUsersTestCase struct {
 	name           string
 	requesterIDHdr string
 	queryParams    string
-	mockRun        func(*MockUserService)
+	mockRun        func(*mocks.UserService)
 	expectedStatus int
 	validateBody   func(*testing.T, string)
 }
@@ -746,22 +657,23 @@ func TestUserHandlerSearchUsers(t *testing.T) { //nolint:funlen // table-driven
 			name:           "Success - Returns search results",
 			requesterIDHdr: userID.String(),
 			queryParams:    "?query=test&limit=10&offset=0",
-			mockRun: func(m *MockUserService) {
-				m.On("SearchUsers", mock.Anything, "test", 10, 0, false).Return(&dto.UserSearchResponse{
-					Results: []dto.UserSearchResult{
-						{
-							UserID:    uuid.New().String(),
-							Username:  "testuser",
-							FullName:  &fullName,
-							IsActive:  true,
-							CreatedAt: now,
-							UpdatedAt: now,
+			mockRun: func(m *mocks.UserService) {
+				m.On("SearchUsers", mock.Anything, userID, "test", dto.LocationFilter{}, 10, 0, false).
+					Return(&dto.UserSearchResponse{
+						Results: []dto.UserSearchResult{
+							{
+								UserID:    uuid.New().String(),
+								Username:  "testuser",
+								FullName:  &fullName,
+								IsActive:  true,
+								CreatedAt: now,
+								UpdatedAt: now,
+							},
 						},
-					},
-					TotalCount: 1,
-					Limit:      10,
-					Offset:     0,
-				}, nil)
+						TotalCount: 1,
+						Limit:      10,
+						Offset:     0,
+					}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			validateBody: func(t *testing.T, body string) {
@@ -774,13 +686,14 @@ func TestUserHandlerSearchUsers(t *testing.T) { //nolint:funlen // table-driven
 			name:           "Success - countOnly returns only count",
 			requesterIDHdr: userID.String(),
 			queryParams:    "?query=test&countOnly=true",
-			mockRun: func(m *MockUserService) {
-				m.On("SearchUsers", mock.Anything, "test", 20, 0, true).Return(&dto.UserSearchResponse{
-					Results:    []dto.UserSearchResult{},
-					TotalCount: 5,
-					Limit:      20,
-					Offset:     0,
-				}, nil)
+			mockRun: func(m *mocks.UserService) {
+				m.On("SearchUsers", mock.Anything, userID, "test", dto.LocationFilter{}, 20, 0, true).
+					Return(&dto.UserSearchResponse{
+						Results:    []dto.UserSearchResult{},
+						TotalCount: 5,
+						Limit:      20,
+						Offset:     0,
+					}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			validateBody: func(t *testing.T, body string) {
@@ -793,13 +706,14 @@ func TestUserHandlerSearchUsers(t *testing.T) { //nolint:funlen // table-driven
 			name:           "Success - Empty query returns all users",
 			requesterIDHdr: userID.String(),
 			queryParams:    "",
-			mockRun: func(m *MockUserService) {
-				m.On("SearchUsers", mock.Anything, "", 20, 0, false).Return(&dto.UserSearchResponse{
-					Results:    []dto.UserSearchResult{},
-					TotalCount: 0,
-					Limit:      20,
-					Offset:     0,
-				}, nil)
+			mockRun: func(m *mocks.UserService) {
+				m.On("SearchUsers", mock.Anything, userID, "", dto.LocationFilter{}, 20, 0, false).
+					Return(&dto.UserSearchResponse{
+						Results:    []dto.UserSearchResult{},
+						TotalCount: 0,
+						Limit:      20,
+						Offset:     0,
+					}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			validateBody: func(t *testing.T, body string) {
@@ -811,13 +725,14 @@ func TestUserHandlerSearchUsers(t *testing.T) { //nolint:funlen // table-driven
 			name:           "Success - No results found (empty array)",
 			requesterIDHdr: userID.String(),
 			queryParams:    "?query=nonexistent",
-			mockRun: func(m *MockUserService) {
-				m.On("SearchUsers", mock.Anything, "nonexistent", 20, 0, false).Return(&dto.UserSearchResponse{
-					Results:    []dto.UserSearchResult{},
-					TotalCount: 0,
-					Limit:      20,
-					Offset:     0,
-				}, nil)
+			mockRun: func(m *mocks.UserService) {
+				m.On("SearchUsers", mock.Anything, userID, "nonexistent", dto.LocationFilter{}, 20, 0, false).
+					Return(&dto.UserSearchResponse{
+						Results:    []dto.UserSearchResult{},
+						TotalCount: 0,
+						Limit:      20,
+						Offset:     0,
+					}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			validateBody: func(t *testing.T, body string) {
@@ -857,7 +772,12 @@ func TestUserHandlerSearchUsers(t *testing.T) { //nolint:funlen // table-driven
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
 				assert.Contains(t, body, "VALIDATION_ERROR")
-				assert.Contains(t, body, "limit")
+				assert.Equal(t, []dto.FieldError{{
+					Field:         "limit",
+					Code:          "lte",
+					Message:       "must be less than or equal to 100",
+					RejectedValue: "101",
+				}}, fieldErrors(t, body))
 			},
 		},
 		{
@@ -893,12 +813,45 @@ func TestUserHandlerSearchUsers(t *testing.T) { //nolint:funlen // table-driven
 				assert.Contains(t, body, "countOnly")
 			},
 		},
+		{
+			name:           "Success - Location filter",
+			requesterIDHdr: userID.String(),
+			queryParams:    "?query=test&country=FR&region=FR-IDF",
+			mockRun: func(m *mocks.UserService) {
+				location := dto.LocationFilter{Country: "FR", Region: "FR-IDF"}
+				m.On("SearchUsers", mock.Anything, userID, "test", location, 20, 0, false).
+					Return(&dto.UserSearchResponse{Results: []dto.UserSearchResult{}, Limit: 20}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Bad Request - Invalid country",
+			requesterIDHdr: userID.String(),
+			queryParams:    "?query=test&country=fr",
+			expectedStatus: http.StatusBadRequest,
+			validateBody: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, "VALIDATION_ERROR")
+				assert.Contains(t, body, `"code":"iso3166_1_alpha2"`)
+			},
+		},
+		{
+			name:           "Bad Request - Invalid region",
+			requesterIDHdr: userID.String(),
+			queryParams:    "?query=test&region=FR-XX",
+			expectedStatus: http.StatusBadRequest,
+			validateBody: func(t *testing.T, body string) {
+				t.Helper()
+				assert.Contains(t, body, `"code":"iso3166_2"`)
+			},
+		},
 		{
 			name:           "Internal Error - Database failure",
 			requesterIDHdr: userID.String(),
 			queryParams:    "?query=test",
-			mockRun: func(m *MockUserService) {
-				m.On("SearchUsers", mock.Anything, "test", 20, 0, false).Return(nil, errDB)
+			mockRun: func(m *mocks.UserService) {
+				m.On("SearchUsers", mock.Anything, userID, "test", dto.LocationFilter{}, 20, 0, false).
+					Return(nil, errDB)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -908,12 +861,12 @@ func TestUserHandlerSearchUsers(t *testing.T) { //nolint:funlen // table-driven
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockUserService)
+			mockSvc := new(mocks.UserService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewUserHandler(mockSvc)
+			h := handler.NewUserHandler(mockSvc, nil, nil, nil)
 
 			r := chi.NewRouter()
 			r.Get("/users/search", h.SearchUsers)
@@ -936,7 +889,7 @@ func TestUserHandlerSearchUsers(t *testing.T) { //nolint:funlen // table-driven
 type getUserByIDTestCase struct {
 	name           string
 	targetIDPath   string
-	mockRun        func(*MockUserService)
+	mockRun        func(*mocks.UserService)
 	expectedStatus int
 	validateBody   func(*testing.T, string)
 }
@@ -961,7 +914,7 @@ func TestUserHandlerGetUserByID(t *testing.T) { //nolint:funlen // table-driven
 		{
 			name:         "Success - Public profile",
 			targetIDPath: targetID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("GetUserByID", mock.Anything, targetID).Return(baseResult, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -974,7 +927,7 @@ func TestUserHandlerGetUserByID(t *testing.T) { //nolint:funlen // table-driven
 		{
 			name:         userNotFoundStr,
 			targetIDPath: targetID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("GetUserByID", mock.Anything, targetID).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -986,7 +939,7 @@ func TestUserHandlerGetUserByID(t *testing.T) { //nolint:funlen // table-driven
 		{
 			name:         "Not Found - Private profile returns 404",
 			targetIDPath: uuid.New().String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("GetUserByID", mock.Anything, mock.Anything).Return(nil, service.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
@@ -998,17 +951,23 @@ func TestUserHandlerGetUserByID(t *testing.T) { //nolint:funlen // table-driven
 		{
 			name:           "Validation Error - Invalid UUID format",
 			targetIDPath:   "invalid-uuid",
-			mockRun:        func(_ *MockUserService) {},
+			mockRun:        func(_ *mocks.UserService) {},
 			expectedStatus: http.StatusUnprocessableEntity,
 			validateBody: func(t *testing.T, body string) {
 				t.Helper()
 				assert.Contains(t, body, "VALIDATION_ERROR")
+				assert.Equal(t, []dto.FieldError{{
+					Field:         "user_id",
+					Code:          "uuid",
+					Message:       "must be a valid UUID",
+					RejectedValue: "invalid-uuid",
+				}}, fieldErrors(t, body))
 			},
 		},
 		{
 			name:         "Internal Error - Database failure",
 			targetIDPath: targetID.String(),
-			mockRun: func(m *MockUserService) {
+			mockRun: func(m *mocks.UserService) {
 				m.On("GetUserByID", mock.Anything, targetID).Return(nil, errDB)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -1019,12 +978,12 @@ func TestUserHandlerGetUserByID(t *testing.T) { //nolint:funlen // table-driven
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockSvc := new(MockUserService)
+			mockSvc := new(mocks.UserService)
 			if tt.mockRun != nil {
 				tt.mockRun(mockSvc)
 			}
 
-			h := handler.NewUserHandler(mockSvc)
+			h := handler.NewUserHandler(mockSvc, nil, nil, nil)
 
 			r := chi.NewRouter()
 			r.Get("/users/{user_id}", h.GetUserByID)
@@ -1042,3 +1001,300 @@ func TestUserHandlerGetUserByID(t *testing.T) { //nolint:funlen // table-driven
 		})
 	}
 }
+
+func TestUserHandlerGetUserByID_Expand(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.New()
+	requesterID := uuid.New()
+
+	details := &dto.UserDetailsResponse{
+		UserSearchResult: dto.UserSearchResult{UserID: targetID.String(), Username: "testuser", IsActive: true},
+		Stats:            &dto.UserSocialStats{FollowerCount: 3, FollowingCount: 1},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		mockRun        func(*mocks.UserDetailsService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "Success - Expansions passed to the details service",
+			query: "?expand=stats,%20recentActivity",
+			mockRun: func(m *mocks.UserDetailsService) {
+				m.On("GetUserDetails", mock.Anything, requesterID, targetID,
+					[]dto.UserExpansion{dto.UserExpansionStats, dto.UserExpansionRecentActivity}, false, false).
+					Return(details, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"stats":{"followerCount":3,"followingCount":1}`,
+		},
+		{
+			name:           "Bad Request - Unknown expansion",
+			query:          "?expand=stats,password",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "unknown expansion: password",
+		},
+		{
+			name:  userNotFoundStr,
+			query: "?expand=privacy",
+			mockRun: func(m *mocks.UserDetailsService) {
+				m.On("GetUserDetails", mock.Anything, requesterID, targetID,
+					[]dto.UserExpansion{dto.UserExpansionPrivacy}, false, false).
+					Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "USER_NOT_FOUND",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			detailsSvc := mocks.NewUserDetailsService(t)
+			if tt.mockRun != nil {
+				tt.mockRun(detailsSvc)
+			}
+
+			h := handler.NewUserHandler(mocks.NewUserService(t), detailsSvc, nil, nil)
+
+			r := chi.NewRouter()
+			r.Get("/users/{user_id}", h.GetUserByID)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/"+targetID.String()+tt.query, nil)
+			req = setAuthenticatedUserFromString(req, requesterID.String())
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestUserHandlerGetUserProfileByUsername(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.New()
+
+	profile := &dto.UserProfileResponse{
+		UserID:    targetID.String(),
+		Username:  "ChefJane",
+		IsActive:  true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name           string
+		username       string
+		mockRun        func(*mocks.UserService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:     "Success",
+			username: "chefjane",
+			mockRun: func(m *mocks.UserService) {
+				m.On("GetUserProfileByUsername", mock.Anything, uuid.Nil, "chefjane").Return(profile, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "ChefJane",
+		},
+		{
+			name:     "Not Found - Missing or private profile",
+			username: "ghost",
+			mockRun: func(m *mocks.UserService) {
+				m.On("GetUserProfileByUsername", mock.Anything, uuid.Nil, "ghost").Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "USER_NOT_FOUND",
+		},
+		{
+			name:     internalErrorStr,
+			username: "chefjane",
+			mockRun: func(m *mocks.UserService) {
+				m.On("GetUserProfileByUsername", mock.Anything, uuid.Nil, "chefjane").Return(nil, errDB)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSvc := new(mocks.UserService)
+			tt.mockRun(mockSvc)
+
+			h := handler.NewUserHandler(mockSvc, nil, nil, nil)
+
+			r := chi.NewRouter()
+			r.Get("/users/by-username/{username}", h.GetUserProfileByUsername)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/by-username/"+tt.username, nil)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedBody != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserHandlerGetProfileConstraints(t *testing.T) {
+	t.Parallel()
+
+	h := handler.NewUserHandler(new(mocks.UserService), nil, nil, nil)
+
+	rr := httptest.NewRecorder()
+	h.GetProfileConstraints(rr, httptest.NewRequest(http.MethodGet, "/users/profile/constraints", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{
+		"username": {"minLength": 3, "maxLength": 50, "pattern": "^[a-zA-Z0-9_]+$"},
+		"fullName": {"maxLength": 255},
+		"bio": {"maxLength": 1000}
+	}`, rr.Body.String())
+}
+
+func TestUserHandlerGetProfileViews(t *testing.T) { //nolint:funlen // table-driven test
+	t.Parallel()
+
+	ownerID := uuid.New()
+	otherID := uuid.New()
+
+	tests := []struct {
+		name           string
+		path           string
+		requester      string
+		withService    bool
+		mockRun        func(*mocks.ProfileViewService)
+		expectedStatus int
+	}{
+		{
+			name:        "Success",
+			path:        ownerID.String(),
+			requester:   ownerID.String(),
+			withService: true,
+			mockRun: func(m *mocks.ProfileViewService) {
+				m.On("GetProfileViews", mock.Anything, ownerID).Return(&dto.ProfileViewsResponse{
+					UserID: ownerID.String(), ViewCount: 3, Counting: true,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unauthenticated",
+			path:           ownerID.String(),
+			withService:    true,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Invalid ID Format",
+			path:           "invalid-uuid",
+			requester:      ownerID.String(),
+			withService:    true,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Not Owner",
+			path:           ownerID.String(),
+			requester:      otherID.String(),
+			withService:    true,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Not Available",
+			path:           ownerID.String(),
+			requester:      ownerID.String(),
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:        internalErrorStr,
+			path:        ownerID.String(),
+			requester:   ownerID.String(),
+			withService: true,
+			mockRun: func(m *mocks.ProfileViewService) {
+				m.On("GetProfileViews", mock.Anything, ownerID).Return(nil, errDB)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var profileViews service.ProfileViewService
+
+			if tt.withService {
+				mockSvc := mocks.NewProfileViewService(t)
+				if tt.mockRun != nil {
+					tt.mockRun(mockSvc)
+				}
+
+				profileViews = mockSvc
+			}
+
+			h := handler.NewUserHandler(new(mocks.UserService), nil, profileViews, nil)
+
+			r := chi.NewRouter()
+			r.Get("/users/{user_id}/profile/views", h.GetProfileViews)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tt.path+"/profile/views", nil)
+			req = setAuthenticatedUserFromString(req, tt.requester)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.JSONEq(t, fmt.Sprintf(`{"userId": %q, "viewCount": 3, "counting": true}`, ownerID),
+					rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestUserHandlerGetUserProfile_RecordsView(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.New()
+	requesterID := uuid.New()
+
+	userSvc := new(mocks.UserService)
+	userSvc.On("GetUserProfile", mock.Anything, requesterID, targetID).
+		Return(&dto.UserProfileResponse{UserID: targetID.String(), Username: "targetuser"}, nil)
+
+	// A failure to count the view does not fail the request
+	viewSvc := mocks.NewProfileViewService(t)
+	viewSvc.On("RecordView", mock.Anything, targetID, &requesterID, mock.Anything).Return(errDB)
+
+	recentSvc := mocks.NewRecentlyViewedService(t)
+	recentSvc.On("RecordView", mock.Anything, requesterID, targetID).Return(nil)
+
+	h := handler.NewUserHandler(userSvc, nil, viewSvc, recentSvc)
+
+	r := chi.NewRouter()
+	r.Get("/users/{user_id}/profile", h.GetUserProfile)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/"+targetID.String()+"/profile", nil)
+	req = setAuthenticatedUserFromString(req, requesterID.String())
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
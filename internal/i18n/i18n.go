@@ -0,0 +1,161 @@
+// Package i18n localizes the messages of error responses. The language is negotiated from the
+// request's Accept-Language header; messages are written in English and translated through the
+// catalogs in locales/, keyed by their English text. Anything without a translation stays in
+// English.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// catalog holds the translations of one language: messages keyed by their English text and
+// validation rule messages keyed by rule, with %s standing for the rule's parameter.
+type catalog struct {
+	Messages map[string]string `json:"messages"`
+	Rules    map[string]string `json:"rules"`
+}
+
+// English is the language messages are written in and the fallback for all others.
+var English = language.English
+
+var (
+	catalogs = mustLoadCatalogs()
+	// supported lists English first, so it wins when nothing matches
+	supported = append([]language.Tag{English}, sortedTags(catalogs)...)
+	matcher   = language.NewMatcher(supported)
+)
+
+func mustLoadCatalogs() map[language.Tag]catalog {
+	files, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: failed to read locales: %v", err))
+	}
+
+	catalogs := make(map[language.Tag]catalog, len(files))
+
+	for _, file := range files {
+		name := strings.TrimSuffix(file.Name(), path.Ext(file.Name()))
+
+		tag, err := language.Parse(name)
+		if err != nil {
+			panic(fmt.Sprintf("i18n: invalid locale file name %s: %v", file.Name(), err))
+		}
+
+		data, err := localeFiles.ReadFile(path.Join("locales", file.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: failed to read %s: %v", file.Name(), err))
+		}
+
+		var c catalog
+		if err := json.Unmarshal(data, &c); err != nil {
+			panic(fmt.Sprintf("i18n: invalid locale file %s: %v", file.Name(), err))
+		}
+
+		catalogs[tag] = c
+	}
+
+	return catalogs
+}
+
+func sortedTags(catalogs map[language.Tag]catalog) []language.Tag {
+	tags := make([]language.Tag, 0, len(catalogs))
+	for tag := range catalogs {
+		tags = append(tags, tag)
+	}
+
+	slices.SortFunc(tags, func(a, b language.Tag) int { return strings.Compare(a.String(), b.String()) })
+
+	return tags
+}
+
+// Languages returns the supported languages, English first.
+func Languages() []language.Tag {
+	return slices.Clone(supported)
+}
+
+// Negotiate returns the supported language that best matches an Accept-Language header, or
+// English if none does.
+func Negotiate(acceptLanguage string) language.Tag {
+	if acceptLanguage == "" {
+		return English
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return English
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return English
+	}
+
+	return supported[index]
+}
+
+// Translate returns message in lang, or message itself if it has no translation.
+func Translate(lang language.Tag, message string) string {
+	if translated, ok := catalogs[lang].Messages[message]; ok {
+		return translated
+	}
+
+	return message
+}
+
+// Rule returns the message for a broken validation rule in lang, filled in with param, and
+// whether lang has one.
+func Rule(lang language.Tag, rule, param string) (string, bool) {
+	format, ok := catalogs[lang].Rules[rule]
+	if !ok {
+		return "", false
+	}
+
+	if strings.Contains(format, "%s") {
+		return fmt.Sprintf(format, param), true
+	}
+
+	return format, true
+}
+
+// languageWriter carries the language negotiated for a request to the code writing its response.
+type languageWriter struct {
+	http.ResponseWriter
+	lang language.Tag
+}
+
+// Unwrap returns the wrapped writer, for http.ResponseController and the middleware in between.
+func (w *languageWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// WithLanguage returns w carrying lang.
+func WithLanguage(w http.ResponseWriter, lang language.Tag) http.ResponseWriter {
+	return &languageWriter{ResponseWriter: w, lang: lang}
+}
+
+// Language returns the language carried by w or a writer it wraps, and whether there is one.
+func Language(w http.ResponseWriter) (language.Tag, bool) {
+	for w != nil {
+		switch lw := w.(type) {
+		case *languageWriter:
+			return lw.lang, true
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = lw.Unwrap()
+		default:
+			return English, false
+		}
+	}
+
+	return English, false
+}
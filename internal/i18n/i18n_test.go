@@ -0,0 +1,86 @@
+package i18n_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/i18n"
+)
+
+func TestNegotiate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		header string
+		want   language.Tag
+	}{
+		{"", language.English},
+		{"es", language.Spanish},
+		{"es-MX,es;q=0.9", language.Spanish},
+		{"fr-CA", language.French},
+		{"de, fr;q=0.5", language.French},
+		{"en;q=0.9, fr", language.French},
+		{"de", language.English},
+		{"*", language.English},
+		{"not a language", language.English},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, i18n.Negotiate(tt.header), tt.header)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Usuario no encontrado", i18n.Translate(language.Spanish, "User not found"))
+	assert.Equal(t, "Utilisateur introuvable", i18n.Translate(language.French, "User not found"))
+	assert.Equal(t, "User not found", i18n.Translate(language.English, "User not found"))
+	assert.Equal(t, "unknown field: bio", i18n.Translate(language.Spanish, "unknown field: bio"),
+		"messages without a translation stay in English")
+}
+
+func TestRule(t *testing.T) {
+	t.Parallel()
+
+	message, ok := i18n.Rule(language.Spanish, "min", "3")
+	assert.True(t, ok)
+	assert.Equal(t, "debe tener al menos 3 caracteres", message)
+
+	message, ok = i18n.Rule(language.French, "required", "")
+	assert.True(t, ok)
+	assert.Equal(t, "est obligatoire", message)
+
+	_, ok = i18n.Rule(language.English, "min", "3")
+	assert.False(t, ok, "English messages come from the validator")
+}
+
+func TestCatalogsCoverTheSameMessages(t *testing.T) {
+	t.Parallel()
+
+	languages := i18n.Languages()
+	assert.Equal(t, language.English, languages[0])
+
+	for _, message := range []string{"User not found", "Request validation failed", "Invalid preference category"} {
+		for _, lang := range languages[1:] {
+			assert.NotEqual(t, message, i18n.Translate(lang, message), "%s: %s", lang, message)
+		}
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	t.Parallel()
+
+	_, ok := i18n.Language(httptest.NewRecorder())
+	assert.False(t, ok)
+
+	w := i18n.WithLanguage(httptest.NewRecorder(), language.French)
+
+	lang, ok := i18n.Language(middleware.NewWrapResponseWriter(w, 1))
+	assert.True(t, ok, "the language is found through writers wrapping it")
+	assert.Equal(t, language.French, lang)
+}
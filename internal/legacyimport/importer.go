@@ -0,0 +1,433 @@
+package legacyimport
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+const (
+	// maxLineSize bounds one export row. Rows are a user with their settings and follow list.
+	maxLineSize = 4 << 20
+	// defaultProgressEvery is how many rows are read between progress reports.
+	defaultProgressEvery = 1000
+
+	sqlStateUniqueViolation = "23505"
+)
+
+// Options controls an import run.
+type Options struct {
+	// DryRun maps and validates every row and checks follow edges without writing anything.
+	DryRun bool
+	// Rejects receives one JSON line per rejected row or follow edge. Nil discards them.
+	Rejects io.Writer
+	// Progress is called every ProgressEvery rows and once more when each phase ends.
+	Progress func(Progress)
+	// ProgressEvery defaults to 1000 rows.
+	ProgressEvery int
+}
+
+// Phase is the part of the import a progress report belongs to.
+type Phase string
+
+// Import phases. Users and their preferences are imported first so that follow edges can point at
+// users later in the file.
+const (
+	PhaseUsers   Phase = "users"
+	PhaseFollows Phase = "follows"
+)
+
+// Progress is a snapshot of an import run.
+type Progress struct {
+	Phase Phase
+	Result
+}
+
+// Result summarizes an import run. In a dry run the counts are what would have been imported.
+type Result struct {
+	Rows        int
+	Users       int
+	Preferences int
+	Follows     int
+	Rejected    int
+}
+
+// Rejection is a line of the error report.
+type Rejection struct {
+	Line     int             `json:"line"`
+	LegacyID LegacyID        `json:"legacyId,omitempty"`
+	Followee LegacyID        `json:"followee,omitempty"`
+	Reason   string          `json:"reason"`
+	Row      json.RawMessage `json:"row,omitempty"`
+}
+
+// Importer writes legacy export rows to the database.
+type Importer struct {
+	db          *sql.DB
+	preferences repository.PreferenceRepository
+	validator   *validation.Validator
+}
+
+// New creates an Importer for db.
+func New(db *sql.DB) *Importer {
+	return &Importer{
+		db:          db,
+		preferences: repository.NewPreferenceRepository(db),
+		validator:   validation.New(),
+	}
+}
+
+// pendingFollow is a follow edge of an imported user, applied once all users are in.
+type pendingFollow struct {
+	line     int
+	follower LegacyID
+	followee LegacyID
+}
+
+// run holds the state of one Run call.
+type run struct {
+	*Importer
+
+	opts     Options
+	result   Result
+	imported map[LegacyID]uuid.UUID
+	names    map[string]LegacyID
+	follows  []pendingFollow
+	rejects  *json.Encoder
+}
+
+// Run imports the export read from r. Users are upserted by ID, preferences are upserted per
+// category and follows are only added, so importing the same export again is safe. Rows that
+// cannot be imported are rejected and reported without stopping the run; database failures other
+// than a taken username or email stop it.
+func (i *Importer) Run(ctx context.Context, r io.Reader, opts Options) (*Result, error) {
+	if opts.ProgressEvery <= 0 {
+		opts.ProgressEvery = defaultProgressEvery
+	}
+
+	state := &run{
+		Importer: i,
+		opts:     opts,
+		imported: map[LegacyID]uuid.UUID{},
+		names:    map[string]LegacyID{},
+	}
+
+	if opts.Rejects != nil {
+		state.rejects = json.NewEncoder(opts.Rejects)
+	}
+
+	err := state.importUsers(ctx, r)
+	if err != nil {
+		return &state.result, err
+	}
+
+	err = state.importFollows(ctx)
+	if err != nil {
+		return &state.result, err
+	}
+
+	return &state.result, nil
+}
+
+func (s *run) importUsers(ctx context.Context, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		raw := scanner.Bytes()
+		if len(strings.TrimSpace(string(raw))) == 0 {
+			continue
+		}
+
+		s.result.Rows++
+
+		err := s.importRow(ctx, line, raw)
+		if err != nil {
+			return err
+		}
+
+		if s.result.Rows%s.opts.ProgressEvery == 0 {
+			s.progress(PhaseUsers)
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return fmt.Errorf("failed to read line %d: %w", line+1, err)
+	}
+
+	s.progress(PhaseUsers)
+
+	return nil
+}
+
+func (s *run) importRow(ctx context.Context, line int, raw []byte) error {
+	var rec Record
+
+	err := json.Unmarshal(raw, &rec)
+	if err != nil {
+		return s.reject(Rejection{Line: line, Reason: "malformed row: " + err.Error(), Row: validJSON(raw)})
+	}
+
+	user, err := Map(&rec, s.validator)
+	if err != nil {
+		return s.reject(Rejection{Line: line, LegacyID: rec.ID, Reason: err.Error(), Row: raw})
+	}
+
+	if _, ok := s.imported[user.LegacyID]; ok {
+		return s.reject(Rejection{Line: line, LegacyID: rec.ID, Reason: "duplicate id", Row: raw})
+	}
+
+	name := strings.ToLower(user.Username)
+	if _, ok := s.names[name]; ok {
+		return s.reject(Rejection{Line: line, LegacyID: rec.ID, Reason: "duplicate username", Row: raw})
+	}
+
+	if !s.opts.DryRun {
+		err = s.saveUser(ctx, user)
+		if err != nil {
+			if !isUniqueViolation(err) {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+
+			return s.reject(Rejection{
+				Line: line, LegacyID: rec.ID, Reason: "username or email is taken by another user", Row: raw,
+			})
+		}
+	}
+
+	s.imported[user.LegacyID] = user.UserID
+	s.names[name] = user.LegacyID
+	s.result.Users++
+
+	if user.Preferences != nil {
+		s.result.Preferences += countCategories(user)
+	}
+
+	for _, followee := range user.Following {
+		s.follows = append(s.follows, pendingFollow{line: line, follower: user.LegacyID, followee: followee})
+	}
+
+	return nil
+}
+
+func (s *run) importFollows(ctx context.Context) error {
+	for n, follow := range s.follows {
+		followerID := s.imported[follow.follower]
+
+		followeeID, ok := s.imported[follow.followee]
+		if !ok {
+			// The followee may have been imported by an earlier run
+			followeeID = follow.followee.UserID()
+
+			exists, err := s.userExists(ctx, followeeID)
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				err = s.reject(Rejection{
+					Line: follow.line, LegacyID: follow.follower, Followee: follow.followee, Reason: "unknown followee",
+				})
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+		}
+
+		if followerID == followeeID {
+			err := s.reject(Rejection{
+				Line: follow.line, LegacyID: follow.follower, Followee: follow.followee, Reason: "self follow",
+			})
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !s.opts.DryRun {
+			err := s.addFollow(ctx, followerID, followeeID)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", follow.line, err)
+			}
+		}
+
+		s.result.Follows++
+
+		if (n+1)%s.opts.ProgressEvery == 0 {
+			s.progress(PhaseFollows)
+		}
+	}
+
+	s.progress(PhaseFollows)
+
+	return nil
+}
+
+func (s *run) reject(rejection Rejection) error {
+	s.result.Rejected++
+
+	if s.rejects == nil {
+		return nil
+	}
+
+	err := s.rejects.Encode(rejection)
+	if err != nil {
+		return fmt.Errorf("failed to write error report: %w", err)
+	}
+
+	return nil
+}
+
+func (s *run) progress(phase Phase) {
+	if s.opts.Progress != nil {
+		s.opts.Progress(Progress{Phase: phase, Result: s.result})
+	}
+}
+
+// saveUser upserts the user, keeping the legacy creation time, then their preferences.
+func (i *Importer) saveUser(ctx context.Context, user *User) error {
+	query := `
+		INSERT INTO recipe_manager.users (user_id, username, email, full_name, bio, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, NOW()), NOW())
+		ON CONFLICT (user_id) DO UPDATE SET
+			username = EXCLUDED.username,
+			email = EXCLUDED.email,
+			full_name = EXCLUDED.full_name,
+			bio = EXCLUDED.bio,
+			is_active = EXCLUDED.is_active,
+			updated_at = NOW()
+	`
+
+	_, err := i.db.ExecContext(ctx, query,
+		user.UserID, user.Username, user.Email, user.FullName, user.Bio, user.IsActive, user.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to import user %s: %w", user.Username, err)
+	}
+
+	if user.Preferences == nil {
+		return nil
+	}
+
+	err = i.savePreferences(ctx, user)
+	if err != nil {
+		return fmt.Errorf("failed to import preferences for %s: %w", user.Username, err)
+	}
+
+	return nil
+}
+
+// savePreferences upserts the categories the legacy settings map onto.
+func (i *Importer) savePreferences(ctx context.Context, user *User) error {
+	prefs := user.Preferences
+
+	if prefs.Notification != nil {
+		_, err := i.preferences.UpdateNotificationPreferences(ctx, user.UserID, prefs.Notification)
+		if err != nil {
+			return err
+		}
+	}
+
+	if prefs.Privacy != nil {
+		_, err := i.preferences.UpdatePrivacyPreferencesData(ctx, user.UserID, prefs.Privacy)
+		if err != nil {
+			return err
+		}
+	}
+
+	if prefs.Language != nil {
+		_, err := i.preferences.UpdateLanguagePreferences(ctx, user.UserID, prefs.Language)
+		if err != nil {
+			return err
+		}
+	}
+
+	if prefs.Theme != nil {
+		_, err := i.preferences.UpdateThemePreferences(ctx, user.UserID, prefs.Theme)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *Importer) userExists(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var exists bool
+
+	err := i.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM recipe_manager.users WHERE user_id = $1)`, userID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up user %s: %w", userID, err)
+	}
+
+	return exists, nil
+}
+
+func (i *Importer) addFollow(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	// NOT EXISTS rather than ON CONFLICT so the "already following" trigger never fires
+	_, err := i.db.ExecContext(ctx, `
+		INSERT INTO recipe_manager.user_follows (follower_id, followee_id, followed_at)
+		SELECT $1, $2, NOW()
+		WHERE NOT EXISTS (
+			SELECT 1 FROM recipe_manager.user_follows
+			WHERE follower_id = $1 AND followee_id = $2 AND deleted_at IS NULL
+		)
+	`, followerID, followeeID)
+	if err != nil {
+		return fmt.Errorf("failed to import follow %s -> %s: %w", followerID, followeeID, err)
+	}
+
+	return nil
+}
+
+// countCategories returns how many preference categories the user's settings set.
+func countCategories(user *User) int {
+	prefs := user.Preferences
+	count := 0
+
+	for _, set := range []bool{
+		prefs.Notification != nil, prefs.Privacy != nil, prefs.Language != nil, prefs.Theme != nil,
+	} {
+		if set {
+			count++
+		}
+	}
+
+	return count
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == sqlStateUniqueViolation
+}
+
+// validJSON returns raw for embedding in the error report, quoted as a string when it is not JSON.
+func validJSON(raw []byte) json.RawMessage {
+	if json.Valid(raw) {
+		return raw
+	}
+
+	quoted, _ := json.Marshal(string(raw))
+
+	return quoted
+}
@@ -0,0 +1,183 @@
+package legacyimport_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/legacyimport"
+)
+
+const (
+	upsertUserQuery     = `INSERT INTO recipe_manager\.users .* ON CONFLICT \(user_id\) DO UPDATE`
+	insertFollowQuery   = `INSERT INTO recipe_manager\.user_follows .* WHERE NOT EXISTS`
+	userExistsQuery     = `SELECT EXISTS \(SELECT 1 FROM recipe_manager\.users`
+	upsertLanguageQuery = `INSERT INTO recipe_manager\.user_language_preferences`
+)
+
+// export has a follow edge pointing forward in the file, a malformed row, a follow of a user
+// missing from the export and a duplicate username.
+const export = `{"id": 1, "username": "alice", "settings": {"language": "en"}, "following": [2, 99]}
+{"id": 2, "username": "bob", "created_at": "2019-04-01T10:00:00Z"}
+
+{"id": 3, "username":
+{"id": 4, "username": "Alice"}
+`
+
+func decodeRejections(t *testing.T, report *bytes.Buffer) []legacyimport.Rejection {
+	t.Helper()
+
+	var rejections []legacyimport.Rejection
+
+	for line := range strings.Lines(report.String()) {
+		var rejection legacyimport.Rejection
+		require.NoError(t, json.Unmarshal([]byte(line), &rejection))
+
+		rejections = append(rejections, rejection)
+	}
+
+	return rejections
+}
+
+func TestImporter_Run(t *testing.T) {
+	t.Parallel()
+
+	alice := legacyimport.LegacyID("1").UserID()
+	bob := legacyimport.LegacyID("2").UserID()
+	unknown := legacyimport.LegacyID("99").UserID()
+	created := time.Date(2019, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Success - imports users, preferences and follows", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectExec(upsertUserQuery).
+			WithArgs(alice, "alice", nil, nil, nil, true, nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectBegin()
+		mock.ExpectQuery(upsertLanguageQuery).
+			WillReturnRows(sqlmock.NewRows(
+				[]string{"primary_language", "secondary_language", "translation_enabled", "updated_at"},
+			).AddRow("EN", nil, false, time.Now()))
+		mock.ExpectCommit()
+		mock.ExpectExec(upsertUserQuery).
+			WithArgs(bob, "bob", nil, nil, nil, true, created).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(insertFollowQuery).
+			WithArgs(alice, bob).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(userExistsQuery).
+			WithArgs(unknown).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		mock.ExpectClose()
+
+		var (
+			report   bytes.Buffer
+			progress []legacyimport.Progress
+		)
+
+		result, err := legacyimport.New(db).Run(t.Context(), strings.NewReader(export), legacyimport.Options{
+			Rejects:       &report,
+			Progress:      func(p legacyimport.Progress) { progress = append(progress, p) },
+			ProgressEvery: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &legacyimport.Result{Rows: 4, Users: 2, Preferences: 1, Follows: 1, Rejected: 3}, result)
+
+		rejections := decodeRejections(t, &report)
+		require.Len(t, rejections, 3)
+		assert.Equal(t, 4, rejections[0].Line)
+		assert.Contains(t, rejections[0].Reason, "malformed row")
+		assert.JSONEq(t, `"{\"id\": 3, \"username\":"`, string(rejections[0].Row))
+		assert.Equal(t, "duplicate username", rejections[1].Reason)
+		assert.Equal(t, legacyimport.LegacyID("4"), rejections[1].LegacyID)
+		assert.Equal(t, legacyimport.Rejection{Line: 1, LegacyID: "1", Followee: "99", Reason: "unknown followee"},
+			rejections[2])
+
+		require.Len(t, progress, 4)
+		assert.Equal(t, legacyimport.PhaseUsers, progress[0].Phase)
+		assert.Equal(t, 2, progress[0].Rows)
+		assert.Equal(t, legacyimport.PhaseFollows, progress[3].Phase)
+		assert.Equal(t, result, &progress[3].Result)
+	})
+
+	t.Run("Success - dry run only checks followees outside the export", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(userExistsQuery).
+			WithArgs(unknown).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectClose()
+
+		result, err := legacyimport.New(db).Run(t.Context(), strings.NewReader(export), legacyimport.Options{
+			DryRun: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &legacyimport.Result{Rows: 4, Users: 2, Preferences: 1, Follows: 2, Rejected: 2}, result)
+	})
+
+	t.Run("Rejected - username taken by another user", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectExec(upsertUserQuery).
+			WithArgs(bob, "bob", nil, nil, nil, true, nil).
+			WillReturnError(&pgconn.PgError{Code: "23505"})
+		mock.ExpectClose()
+
+		var report bytes.Buffer
+
+		result, err := legacyimport.New(db).Run(t.Context(), strings.NewReader(`{"id": 2, "username": "bob"}`),
+			legacyimport.Options{Rejects: &report})
+		require.NoError(t, err)
+		assert.Equal(t, &legacyimport.Result{Rows: 1, Rejected: 1}, result)
+
+		rejections := decodeRejections(t, &report)
+		require.Len(t, rejections, 1)
+		assert.JSONEq(t, `{"id": 2, "username": "bob"}`, string(rejections[0].Row))
+	})
+
+	t.Run("Error - database failure stops the run", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectExec(upsertUserQuery).WillReturnError(assert.AnError)
+		mock.ExpectClose()
+
+		_, err = legacyimport.New(db).Run(t.Context(), strings.NewReader(`{"id": 2, "username": "bob"}`),
+			legacyimport.Options{})
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,205 @@
+// Package legacyimport migrates users, preferences and follow edges from the JSON lines export of
+// the legacy Python user service into the PostgreSQL database.
+package legacyimport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+// ErrInvalidRecord is returned when an export row cannot be mapped to a user.
+var ErrInvalidRecord = errors.New("invalid record")
+
+// LegacyID is a user ID of the legacy service. The export writes integer IDs as numbers and
+// UUIDs as strings; both are kept as their string form.
+type LegacyID string
+
+// UnmarshalJSON accepts a JSON string or number.
+func (id *LegacyID) UnmarshalJSON(data []byte) error {
+	var s string
+
+	err := json.Unmarshal(data, &s)
+	if err == nil {
+		*id = LegacyID(s)
+
+		return nil
+	}
+
+	var n json.Number
+
+	err = json.Unmarshal(data, &n)
+	if err != nil {
+		return errors.New("id must be a string or a number")
+	}
+
+	*id = LegacyID(n.String())
+
+	return nil
+}
+
+// UserID returns the ID the user gets in this service. UUIDs are kept; other IDs map to a stable
+// UUID so that importing the same export again updates the same users.
+func (id LegacyID) UserID() uuid.UUID {
+	if parsed, err := uuid.Parse(string(id)); err == nil {
+		return parsed
+	}
+
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte("user-management-legacy:"+string(id)))
+}
+
+// Record is one line of the legacy export. Fields the export carries that have no counterpart
+// here, like password hashes, are ignored.
+type Record struct {
+	ID          LegacyID   `json:"id"`
+	Username    string     `json:"username"`
+	Email       *string    `json:"email"`
+	DisplayName *string    `json:"display_name"`
+	Bio         *string    `json:"bio"`
+	IsActive    *bool      `json:"is_active"`
+	CreatedAt   *time.Time `json:"created_at"`
+	Settings    *Settings  `json:"settings"`
+	Following   []LegacyID `json:"following"`
+}
+
+// Settings are the legacy per-user settings. Each maps onto one of our preference categories.
+type Settings struct {
+	// Theme is "light", "dark" or "system".
+	Theme *string `json:"theme"`
+	// Language is a language tag such as "en" or "pt-BR"; only the primary subtag is kept.
+	Language           *string `json:"language"`
+	EmailNotifications *bool   `json:"email_notifications"`
+	PushNotifications  *bool   `json:"push_notifications"`
+	MarketingOptIn     *bool   `json:"marketing_opt_in"`
+	PrivateProfile     *bool   `json:"private_profile"`
+}
+
+// User is a record mapped to this service.
+type User struct {
+	LegacyID    LegacyID
+	UserID      uuid.UUID
+	Username    string  `validate:"required,profile_length=username,username_pattern,unreserved"`
+	Email       *string `validate:"omitnil,email,max=255"`
+	FullName    *string `validate:"omitnil,profile_length=full_name"`
+	Bio         *string `validate:"omitnil,profile_length=bio"`
+	IsActive    bool
+	CreatedAt   *time.Time
+	Preferences *dto.UserPreferencesUpdateRequest
+	Following   []LegacyID
+}
+
+// Map converts a record to a user and validates it against the rules of this service.
+func Map(rec *Record, v *validation.Validator) (*User, error) {
+	if rec.ID == "" {
+		return nil, fmt.Errorf("%w: missing id", ErrInvalidRecord)
+	}
+
+	user := &User{
+		LegacyID:  rec.ID,
+		UserID:    rec.ID.UserID(),
+		Username:  strings.TrimSpace(rec.Username),
+		Email:     trimmed(rec.Email),
+		FullName:  trimmed(rec.DisplayName),
+		Bio:       trimmed(rec.Bio),
+		IsActive:  rec.IsActive == nil || *rec.IsActive,
+		CreatedAt: rec.CreatedAt,
+		Following: rec.Following,
+	}
+
+	err := v.Validate(user)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRecord, err)
+	}
+
+	if rec.Settings != nil {
+		user.Preferences, err = mapSettings(rec.Settings)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRecord, err)
+		}
+	}
+
+	return user, nil
+}
+
+// mapSettings converts the legacy settings to a preferences update holding only the categories
+// the export sets.
+func mapSettings(s *Settings) (*dto.UserPreferencesUpdateRequest, error) {
+	prefs := &dto.UserPreferencesUpdateRequest{}
+
+	if s.Theme != nil {
+		theme, err := mapTheme(*s.Theme)
+		if err != nil {
+			return nil, err
+		}
+
+		prefs.Theme = theme
+	}
+
+	if s.Language != nil {
+		primary, _, _ := strings.Cut(strings.ReplaceAll(*s.Language, "_", "-"), "-")
+
+		language := dto.Language(strings.ToUpper(strings.TrimSpace(primary)))
+		if !language.IsValid() {
+			return nil, fmt.Errorf("unsupported language %q", *s.Language)
+		}
+
+		prefs.Language = &dto.LanguagePreferencesUpdate{PrimaryLanguage: &language}
+	}
+
+	if s.EmailNotifications != nil || s.PushNotifications != nil || s.MarketingOptIn != nil {
+		prefs.Notification = &dto.NotificationPreferencesUpdate{
+			EmailNotifications: s.EmailNotifications,
+			PushNotifications:  s.PushNotifications,
+			MarketingEmails:    s.MarketingOptIn,
+		}
+	}
+
+	if s.PrivateProfile != nil {
+		visibility := dto.ProfileVisibilityPublic
+		if *s.PrivateProfile {
+			visibility = dto.ProfileVisibilityPrivate
+		}
+
+		prefs.Privacy = &dto.PrivacyPreferencesUpdate{ProfileVisibility: &visibility}
+	}
+
+	return prefs, nil
+}
+
+func mapTheme(theme string) (*dto.ThemePreferencesUpdate, error) {
+	dark, light, auto := false, false, false
+
+	switch strings.ToLower(strings.TrimSpace(theme)) {
+	case "dark":
+		dark = true
+	case "light":
+		light = true
+	case "system":
+		auto = true
+	default:
+		return nil, fmt.Errorf("unsupported theme %q", theme)
+	}
+
+	return &dto.ThemePreferencesUpdate{DarkMode: &dark, LightMode: &light, AutoTheme: &auto}, nil
+}
+
+// trimmed returns s without surrounding whitespace, or nil when nothing is left.
+func trimmed(s *string) *string {
+	if s == nil {
+		return nil
+	}
+
+	value := strings.TrimSpace(*s)
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
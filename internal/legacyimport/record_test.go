@@ -0,0 +1,94 @@
+package legacyimport_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/legacyimport"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+func decodeRecord(t *testing.T, row string) *legacyimport.Record {
+	t.Helper()
+
+	var rec legacyimport.Record
+	require.NoError(t, json.Unmarshal([]byte(row), &rec))
+
+	return &rec
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	v := validation.New()
+
+	t.Run("Success - maps fields and settings", func(t *testing.T) {
+		t.Parallel()
+
+		rec := decodeRecord(t, `{"id": 42, "username": "alice", "email": " alice@example.com ",
+			"display_name": "", "is_active": false, "created_at": "2019-04-01T10:00:00Z",
+			"settings": {"theme": "Dark", "language": "pt_BR", "marketing_opt_in": false, "private_profile": true},
+			"following": [7, "c0ffee00-0000-4000-8000-000000000000"]}`)
+
+		user, err := legacyimport.Map(rec, v)
+		require.NoError(t, err)
+
+		assert.Equal(t, legacyimport.LegacyID("42"), user.LegacyID)
+		assert.Equal(t, legacyimport.LegacyID("42").UserID(), user.UserID)
+		assert.Equal(t, "alice@example.com", *user.Email)
+		assert.Nil(t, user.FullName, "blank values are dropped")
+		assert.False(t, user.IsActive)
+		assert.Equal(t, 2019, user.CreatedAt.Year())
+		assert.Equal(t, []legacyimport.LegacyID{"7", "c0ffee00-0000-4000-8000-000000000000"}, user.Following)
+
+		prefs := user.Preferences
+		assert.True(t, *prefs.Theme.DarkMode)
+		assert.False(t, *prefs.Theme.LightMode)
+		assert.Equal(t, dto.LanguagePT, *prefs.Language.PrimaryLanguage)
+		assert.False(t, *prefs.Notification.MarketingEmails)
+		assert.Nil(t, prefs.Notification.EmailNotifications)
+		assert.Equal(t, dto.ProfileVisibilityPrivate, *prefs.Privacy.ProfileVisibility)
+		assert.Nil(t, prefs.Display)
+	})
+
+	t.Run("Success - users without settings have no preferences", func(t *testing.T) {
+		t.Parallel()
+
+		user, err := legacyimport.Map(decodeRecord(t, `{"id": "1", "username": "bob"}`), v)
+		require.NoError(t, err)
+		assert.True(t, user.IsActive)
+		assert.Nil(t, user.Preferences)
+	})
+
+	for name, row := range map[string]string{
+		"missing id":           `{"username": "bob"}`,
+		"invalid username":     `{"id": 1, "username": "bob smith"}`,
+		"invalid email":        `{"id": 1, "username": "bob", "email": "not-an-email"}`,
+		"unsupported theme":    `{"id": 1, "username": "bob", "settings": {"theme": "sepia"}}`,
+		"unsupported language": `{"id": 1, "username": "bob", "settings": {"language": "xx"}}`,
+	} {
+		t.Run("Error - "+name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := legacyimport.Map(decodeRecord(t, row), v)
+			require.ErrorIs(t, err, legacyimport.ErrInvalidRecord)
+		})
+	}
+}
+
+func TestLegacyID_UserID(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	assert.Equal(t, id, legacyimport.LegacyID(id.String()).UserID(), "UUIDs are kept")
+	assert.Equal(t, legacyimport.LegacyID("42").UserID(), legacyimport.LegacyID("42").UserID())
+	assert.NotEqual(t, legacyimport.LegacyID("42").UserID(), legacyimport.LegacyID("43").UserID())
+
+	var rec legacyimport.Record
+	require.Error(t, json.Unmarshal([]byte(`{"id": true}`), &rec))
+}
@@ -0,0 +1,166 @@
+// Package lock keeps background jobs from running on several replicas at once. A job runs under
+// a named lease held in a shared store; the lease expires after its TTL unless it is renewed, so
+// a replica that dies mid-job does not block the others for longer than that.
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+var (
+	// ErrNotAcquired is returned when another replica holds the lease.
+	ErrNotAcquired = errors.New("lock is held by another instance")
+	// ErrLeaseLost is the cause of a lease context cancelled because the lease could not be renewed.
+	ErrLeaseLost = errors.New("lock lease lost")
+)
+
+// NameSearchReindex is the lock a search index rebuild runs under, however it was started.
+const NameSearchReindex = "jobs:search-reindex"
+
+// Locker hands out leases from a store. A nil *Locker runs every job unguarded, which suits a
+// single instance.
+type Locker struct {
+	store repository.LockStore
+	owner string
+	ttl   time.Duration
+}
+
+// New creates a Locker whose leases last ttl and are renewed every third of it.
+func New(store repository.LockStore, ttl time.Duration) *Locker {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+
+	return &Locker{
+		store: store,
+		owner: host + "/" + uuid.NewString(),
+		ttl:   ttl,
+	}
+}
+
+// Lease is a held lock. Its context is cancelled with ErrLeaseLost if a renewal fails, so the
+// job stops before another replica may take over.
+type Lease struct {
+	name  string
+	token int64
+
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	done   chan struct{}
+
+	release func()
+	once    sync.Once
+}
+
+// Context returns the context the job should run with.
+func (l *Lease) Context() context.Context {
+	return l.ctx
+}
+
+// Token returns the fencing token of the lease. Tokens increase with every lease taken on a name,
+// so a write carrying an older token than the last one seen comes from a stale holder.
+func (l *Lease) Token() int64 {
+	return l.token
+}
+
+// Release stops renewing the lease and gives it up. It is safe to call more than once.
+func (l *Lease) Release() {
+	l.once.Do(func() {
+		l.cancel(context.Canceled)
+		<-l.done
+		l.release()
+	})
+}
+
+// Acquire takes the lease on name or returns ErrNotAcquired. On a nil Locker it always succeeds
+// with a zero token.
+func (l *Locker) Acquire(ctx context.Context, name string) (*Lease, error) {
+	leaseCtx, cancel := context.WithCancelCause(ctx)
+	lease := &Lease{
+		name:    name,
+		ctx:     leaseCtx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		release: func() {},
+	}
+
+	if l == nil {
+		close(lease.done)
+
+		return lease, nil
+	}
+
+	token, err := l.store.AcquireLock(ctx, name, l.owner, l.ttl)
+	if err != nil {
+		cancel(err)
+
+		return nil, fmt.Errorf("failed to acquire lock %s: %w", name, err)
+	}
+
+	if token == 0 {
+		cancel(ErrNotAcquired)
+
+		return nil, ErrNotAcquired
+	}
+
+	lease.token = token
+	lease.release = func() {
+		err := l.store.ReleaseLock(context.WithoutCancel(ctx), name, l.owner)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to release lock", "lock", name, "error", err)
+		}
+	}
+
+	go l.renew(lease)
+
+	return lease, nil
+}
+
+// Do runs fn under the lease on name, or returns ErrNotAcquired without running it.
+func (l *Locker) Do(ctx context.Context, name string, fn func(ctx context.Context, token int64) error) error {
+	lease, err := l.Acquire(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer lease.Release()
+
+	return fn(lease.Context(), lease.Token())
+}
+
+// renew extends the lease every third of its TTL until it is released or lost.
+func (l *Locker) renew(lease *Lease) {
+	defer close(lease.done)
+
+	ticker := time.NewTicker(l.ttl / 3) //nolint:mnd // renew well before the lease expires
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-lease.ctx.Done():
+			return
+		case <-ticker.C:
+			held, err := l.store.RenewLock(lease.ctx, lease.name, l.owner, l.ttl)
+			if lease.ctx.Err() != nil {
+				return
+			}
+
+			if err != nil || !held {
+				slog.WarnContext(lease.ctx, "lock lease lost", "lock", lease.name, "token", lease.token, "error", err)
+				lease.cancel(ErrLeaseLost)
+
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package lock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/lock"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+)
+
+const (
+	namePurge   = "jobs:purge"
+	nameCleanup = "jobs:cleanup"
+)
+
+func TestLocker_Exclusive(t *testing.T) {
+	t.Parallel()
+
+	store := memory.New()
+	replicaA, replicaB := lock.New(store, time.Minute), lock.New(store, time.Minute)
+
+	lease, err := replicaA.Acquire(t.Context(), namePurge)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), lease.Token())
+
+	ran := false
+	err = replicaB.Do(t.Context(), namePurge, func(context.Context, int64) error {
+		ran = true
+
+		return nil
+	})
+	require.ErrorIs(t, err, lock.ErrNotAcquired)
+	assert.False(t, ran, "the job runs on one replica at a time")
+
+	// Other jobs are not blocked
+	require.NoError(t, replicaB.Do(t.Context(), nameCleanup, func(context.Context, int64) error {
+		return nil
+	}))
+
+	lease.Release()
+	lease.Release()
+	require.ErrorIs(t, lease.Context().Err(), context.Canceled)
+
+	err = replicaB.Do(t.Context(), namePurge, func(_ context.Context, token int64) error {
+		assert.Equal(t, int64(2), token, "fencing tokens increase with every lease")
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestLocker_LeaseLost(t *testing.T) {
+	t.Parallel()
+
+	store := mocks.NewLockStore(t)
+	store.On("AcquireLock", mock.Anything, lock.NameSearchReindex, mock.Anything, 30*time.Millisecond).
+		Return(int64(7), nil)
+	store.On("RenewLock", mock.Anything, lock.NameSearchReindex, mock.Anything, 30*time.Millisecond).
+		Return(false, nil)
+	store.On("ReleaseLock", mock.Anything, lock.NameSearchReindex, mock.Anything).Return(nil)
+
+	err := lock.New(store, 30*time.Millisecond).Do(t.Context(), lock.NameSearchReindex,
+		func(ctx context.Context, token int64) error {
+			assert.Equal(t, int64(7), token)
+
+			<-ctx.Done()
+
+			return context.Cause(ctx)
+		})
+	require.ErrorIs(t, err, lock.ErrLeaseLost, "the job is stopped once another replica may take over")
+}
+
+func TestLocker_StoreError(t *testing.T) {
+	t.Parallel()
+
+	errStore := errors.New("redis unavailable")
+	store := mocks.NewLockStore(t)
+	store.On("AcquireLock", mock.Anything, nameCleanup, mock.Anything, time.Minute).Return(int64(0), errStore)
+
+	_, err := lock.New(store, time.Minute).Acquire(t.Context(), nameCleanup)
+	require.ErrorIs(t, err, errStore)
+}
+
+func TestLocker_Nil(t *testing.T) {
+	t.Parallel()
+
+	var locker *lock.Locker
+
+	ran := false
+	err := locker.Do(t.Context(), nameCleanup, func(context.Context, int64) error {
+		ran = true
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, ran, "a nil locker runs jobs unguarded")
+}
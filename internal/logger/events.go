@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Domain event names.
+const (
+	EventProfileUpdated     = "profile_updated"
+	EventFollowCreated      = "follow_created"
+	EventPreferenceChanged  = "preference_changed"
+	EventInvitationRedeemed = "invitation_redeemed"
+)
+
+// EventNames lists the domain events, for validating per-event sample rates.
+var EventNames = []string{EventProfileUpdated, EventFollowCreated, EventPreferenceChanged, EventInvitationRedeemed}
+
+// EventOptions configures an EventLogger.
+type EventOptions struct {
+	// SampleRate is the fraction of events recorded, from 0 to 1.
+	SampleRate float64
+	// SampleRates overrides SampleRate by event name.
+	SampleRates map[string]float64
+}
+
+// EventLogger records business events, such as profile updates and follows, apart from the
+// request log. Each record carries the event name and the sample rate it was recorded at, so
+// downstream counts can be scaled back up.
+type EventLogger struct {
+	handler slog.Handler
+	opts    EventOptions
+}
+
+// NewEventLogger creates an EventLogger writing to handler.
+func NewEventLogger(handler slog.Handler, opts EventOptions) *EventLogger {
+	return &EventLogger{handler: handler, opts: opts}
+}
+
+var events atomic.Pointer[EventLogger]
+
+// SetEvents makes l the logger returned by Events. A nil l turns event logging off.
+func SetEvents(l *EventLogger) {
+	events.Store(l)
+}
+
+// Events returns the event logger set with SetEvents. Until one is set, events are dropped.
+func Events() *EventLogger {
+	return events.Load()
+}
+
+// ProfileUpdated records that a user changed the given profile fields.
+func (l *EventLogger) ProfileUpdated(ctx context.Context, userID uuid.UUID, fields []string) {
+	l.log(ctx, EventProfileUpdated, slog.String("user_id", userID.String()), slog.Any("fields", fields))
+}
+
+// FollowCreated records that a user followed another.
+func (l *EventLogger) FollowCreated(ctx context.Context, followerID, followeeID uuid.UUID) {
+	l.log(ctx, EventFollowCreated,
+		slog.String("follower_id", followerID.String()),
+		slog.String("followee_id", followeeID.String()),
+	)
+}
+
+// PreferenceChanged records that a user's preferences of the given categories changed.
+func (l *EventLogger) PreferenceChanged(ctx context.Context, userID uuid.UUID, categories []string) {
+	l.log(ctx, EventPreferenceChanged, slog.String("user_id", userID.String()), slog.Any("categories", categories))
+}
+
+// InvitationRedeemed records that a user joined through another user's invitation.
+func (l *EventLogger) InvitationRedeemed(ctx context.Context, inviterID, inviteeID uuid.UUID) {
+	l.log(ctx, EventInvitationRedeemed,
+		slog.String("inviter_id", inviterID.String()),
+		slog.String("invitee_id", inviteeID.String()),
+	)
+}
+
+// log writes a sampled event record. A nil logger drops every event.
+func (l *EventLogger) log(ctx context.Context, event string, attrs ...slog.Attr) {
+	if l == nil {
+		return
+	}
+
+	rate := l.opts.SampleRate
+	if override, ok := l.opts.SampleRates[event]; ok {
+		rate = override
+	}
+
+	if rate <= 0 || (rate < 1 && rand.Float64() >= rate) { //nolint:gosec // sampling does not need a secure source
+		return
+	}
+
+	if !l.handler.Enabled(ctx, slog.LevelInfo) {
+		return
+	}
+
+	record := slog.NewRecord(time.Now(), slog.LevelInfo, "domain event", 0)
+	record.AddAttrs(slog.String("name", event), slog.Float64("sample_rate", rate))
+	record.AddAttrs(attrs...)
+
+	_ = l.handler.Handle(ctx, record)
+}
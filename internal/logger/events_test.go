@@ -0,0 +1,86 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventLogger(t *testing.T) {
+	t.Parallel()
+
+	userID, followeeID := uuid.New(), uuid.New()
+
+	t.Run("writes one JSON object per event", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		l := NewEventLogger(slog.NewJSONHandler(&buf, nil), EventOptions{SampleRate: 1})
+		l.ProfileUpdated(t.Context(), userID, []string{"bio"})
+		l.FollowCreated(t.Context(), userID, followeeID)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 2)
+
+		var first map[string]any
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+		assert.Equal(t, EventProfileUpdated, first["name"])
+		assert.Equal(t, userID.String(), first["user_id"])
+		assert.Equal(t, []any{"bio"}, first["fields"])
+		assert.InDelta(t, 1.0, first["sample_rate"], 0)
+
+		var second map[string]any
+		require.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+		assert.Equal(t, EventFollowCreated, second["name"])
+		assert.Equal(t, followeeID.String(), second["followee_id"])
+	})
+
+	t.Run("per-event sample rates override the default", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		l := NewEventLogger(slog.NewJSONHandler(&buf, nil), EventOptions{
+			SampleRate:  0,
+			SampleRates: map[string]float64{EventPreferenceChanged: 1},
+		})
+
+		for range 10 {
+			l.FollowCreated(t.Context(), userID, followeeID)
+		}
+
+		l.PreferenceChanged(t.Context(), userID, []string{"display"})
+
+		assert.Equal(t, 1, strings.Count(buf.String(), "\n"))
+		assert.Contains(t, buf.String(), `"name":"preference_changed"`)
+	})
+
+	t.Run("sampling keeps roughly the configured fraction", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		l := NewEventLogger(slog.NewJSONHandler(&buf, nil), EventOptions{SampleRate: 0.5})
+
+		for range 1000 {
+			l.FollowCreated(t.Context(), userID, followeeID)
+		}
+
+		assert.InDelta(t, 500, strings.Count(buf.String(), "\n"), 150)
+	})
+
+	t.Run("nil logger drops events", func(t *testing.T) {
+		t.Parallel()
+
+		var l *EventLogger
+
+		assert.NotPanics(t, func() { l.FollowCreated(t.Context(), userID, followeeID) })
+	})
+}
@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync/atomic"
+)
+
+// Redacted replaces the value of a sensitive field in log output.
+const Redacted = "[REDACTED]"
+
+// RedactTag is the struct tag value, as in `log:"redact"`, marking a field Redact masks.
+const RedactTag = "redact"
+
+var logSensitive atomic.Bool
+
+// SetLogSensitive turns redaction off when enabled is true, so sensitive fields are logged as is.
+// It is meant for local development only.
+func SetLogSensitive(enabled bool) {
+	logSensitive.Store(enabled)
+}
+
+// Redact returns v, a struct or a pointer to one, as a group of its exported fields named after
+// their JSON keys, with the non-empty fields tagged `log:"redact"` replaced by Redacted. Types
+// holding personal data or secrets return it from their LogValue method.
+func Redact(v any) slog.Value {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return slog.AnyValue(nil)
+		}
+
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return slog.AnyValue(v)
+	}
+
+	attrs := make([]slog.Attr, 0, rv.NumField())
+
+	for i := range rv.NumField() {
+		field := rv.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := fieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		value := rv.Field(i)
+
+		if field.Tag.Get("log") == RedactTag && !value.IsZero() && !logSensitive.Load() {
+			attrs = append(attrs, slog.String(name, Redacted))
+
+			continue
+		}
+
+		if value.Kind() == reflect.Pointer && !value.IsNil() {
+			value = value.Elem()
+		}
+
+		attrs = append(attrs, slog.Any(name, value.Interface()))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// fieldName returns the JSON key of field, or its Go name when it has none.
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
@@ -0,0 +1,35 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type redactTestRequest struct {
+	Email    *string `json:"email,omitempty" log:"redact"`
+	Token    string  `json:"token"           log:"redact"`
+	Bio      *string `json:"bio,omitempty"`
+	Internal bool    `json:"-"`
+	hidden   string
+}
+
+func TestRedact(t *testing.T) {
+	email, bio := "alice@example.com", "Cooks a lot"
+	req := redactTestRequest{Email: &email, Bio: &bio, hidden: "x"}
+
+	t.Run("masks tagged fields that are set", func(t *testing.T) {
+		assert.Equal(t, "[email=[REDACTED] token= bio=Cooks a lot]", Redact(&req).String())
+	})
+
+	t.Run("logs everything when sensitive logging is on", func(t *testing.T) {
+		SetLogSensitive(true)
+		t.Cleanup(func() { SetLogSensitive(false) })
+
+		assert.Equal(t, "[email=alice@example.com token= bio=Cooks a lot]", Redact(req).String())
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		assert.Equal(t, "<nil>", Redact((*redactTestRequest)(nil)).String())
+	})
+}
@@ -2,18 +2,24 @@
 package metrics
 
 import (
+	"fmt"
+
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/buildinfo"
 )
 
 const (
-	namespace = "user_management"
-	subsystem = "http"
+	namespace       = "user_management"
+	subsystem       = "http"
+	jobsSubsystem   = "jobs"
+	searchSubsystem = "search"
+	redisSubsystem  = "redis"
 )
 
 var (
 	// RequestsTotal counts HTTP requests by method, path, and status.
-	RequestsTotal = promauto.NewCounterVec(
+	RequestsTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
@@ -24,7 +30,7 @@ var (
 	)
 
 	// RequestDuration measures request latency in seconds.
-	RequestDuration = promauto.NewHistogramVec(
+	RequestDuration = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
@@ -36,7 +42,7 @@ var (
 	)
 
 	// RequestsInFlight tracks concurrent requests.
-	RequestsInFlight = promauto.NewGauge(
+	RequestsInFlight = prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
@@ -44,4 +50,120 @@ var (
 			Help:      "Current number of HTTP requests being processed",
 		},
 	)
+
+	// JobRunsTotal counts scheduled job runs by job and outcome.
+	JobRunsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: jobsSubsystem,
+			Name:      "runs_total",
+			Help:      "Total number of scheduled job runs",
+		},
+		[]string{"job", "outcome"},
+	)
+
+	// JobDuration measures how long scheduled jobs run in seconds.
+	JobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: jobsSubsystem,
+			Name:      "duration_seconds",
+			Help:      "Scheduled job run duration in seconds",
+			Buckets:   []float64{.1, .5, 1, 5, 15, 30, 60, 300, 900, 1800, 3600},
+		},
+		[]string{"job"},
+	)
+
+	// JobLastSuccess is the Unix time each scheduled job last succeeded, for alerting on jobs
+	// that stopped running.
+	JobLastSuccess = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: jobsSubsystem,
+			Name:      "last_success_timestamp_seconds",
+			Help:      "Unix time of the last successful scheduled job run",
+		},
+		[]string{"job"},
+	)
+
+	// IPFilterRejectionsTotal counts requests to the admin and internal routes rejected by the IP
+	// filter, by route group and reason.
+	IPFilterRejectionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "ip_filter_rejections_total",
+			Help:      "Total number of requests rejected by the IP filter",
+		},
+		[]string{"group", "reason"},
+	)
+
+	// ContentModerationTotal counts profile text checked by content moderation, by field and
+	// outcome: clean, rejected, flagged, masked or error.
+	ContentModerationTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "content_moderation_total",
+			Help:      "Total number of profile texts checked by content moderation",
+		},
+		[]string{"field", "outcome"},
+	)
+
+	// SearchCacheLookupsTotal counts user search result cache lookups by result: hit, miss or
+	// error. The hit rate is hits over hits and misses.
+	SearchCacheLookupsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: searchSubsystem,
+			Name:      "cache_lookups_total",
+			Help:      "Total number of user search result cache lookups",
+		},
+		[]string{"result"},
+	)
+
+	// RedisPruneKeysTotal counts the keys checked by the redis-prune job by configured prefix and
+	// outcome: kept, deleted, or orphaned for orphans a dry run left in place.
+	RedisPruneKeysTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: redisSubsystem,
+			Name:      "prune_keys_total",
+			Help:      "Total number of Redis keys checked by the prune job",
+		},
+		[]string{"prefix", "outcome"},
+	)
+
+	// BuildInfo is always 1, labelled with the running build, so series can be joined with the
+	// release that produced them.
+	BuildInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "build_info",
+			Help:      "Build information of the running service, always 1",
+		},
+		[]string{"version", "git_sha", "build_date", "go_version"},
+	)
 )
+
+// Register registers the metrics with reg, labelling every series with the service name so
+// deployments sharing a Prometheus can be told apart. It must be called once per registry.
+func Register(reg prometheus.Registerer, service string) error {
+	labelled := prometheus.WrapRegistererWith(prometheus.Labels{"service": service}, reg)
+
+	for _, collector := range []prometheus.Collector{
+		RequestsTotal, RequestDuration, RequestsInFlight, JobRunsTotal, JobDuration, JobLastSuccess,
+		IPFilterRejectionsTotal, ContentModerationTotal, SearchCacheLookupsTotal, RedisPruneKeysTotal,
+		BuildInfo,
+	} {
+		err := labelled.Register(collector)
+		if err != nil {
+			return fmt.Errorf("failed to register metrics: %w", err)
+		}
+	}
+
+	info := buildinfo.Get()
+	BuildInfo.WithLabelValues(info.Version, info.GitSHA, info.BuildDate, info.GoVersion).Set(1)
+
+	return nil
+}
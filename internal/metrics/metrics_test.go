@@ -0,0 +1,74 @@
+package metrics_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/metrics"
+)
+
+func TestRegister_LabelsSeriesWithService(t *testing.T) {
+	t.Parallel()
+
+	reg := prometheus.NewRegistry()
+	require.NoError(t, metrics.Register(reg, "user-management-eu"))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() == "user_management_http_requests_in_flight" {
+			require.Len(t, family.GetMetric(), 1)
+
+			labels := family.GetMetric()[0].GetLabel()
+			require.Len(t, labels, 1)
+			assert.Equal(t, "service", labels[0].GetName())
+			assert.Equal(t, "user-management-eu", labels[0].GetValue())
+
+			return
+		}
+	}
+
+	t.Fatal("requests_in_flight is not registered")
+}
+
+func TestRegister_Twice(t *testing.T) {
+	t.Parallel()
+
+	reg := prometheus.NewRegistry()
+	require.NoError(t, metrics.Register(reg, "user-management-service"))
+	require.Error(t, metrics.Register(reg, "user-management-service"))
+}
+
+func TestRegister_ExportsBuildInfo(t *testing.T) {
+	t.Parallel()
+
+	reg := prometheus.NewRegistry()
+	require.NoError(t, metrics.Register(reg, "user-management-service"))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() == "user_management_build_info" {
+			require.Len(t, family.GetMetric(), 1)
+			assert.InDelta(t, 1, family.GetMetric()[0].GetGauge().GetValue(), 0)
+
+			labels := map[string]string{}
+			for _, label := range family.GetMetric()[0].GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			assert.Equal(t, runtime.Version(), labels["go_version"])
+			assert.NotEmpty(t, labels["version"])
+
+			return
+		}
+	}
+
+	t.Fatal("build_info is not registered")
+}
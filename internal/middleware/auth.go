@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/oauth2"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/respond"
 )
 
 const (
@@ -16,6 +17,9 @@ const (
 	xUserIDHeader       = "X-User-Id"
 )
 
+// ScopeAdmin grants access to administrative endpoints such as runtime diagnostics.
+const ScopeAdmin = "admin"
+
 // AuthConfig holds the configuration for the Auth middleware.
 type AuthConfig struct {
 	// OAuth2Enabled indicates whether OAuth2 validation is enabled.
@@ -82,6 +86,22 @@ func Auth(cfg AuthConfig) func(http.Handler) http.Handler {
 	}
 }
 
+// RequireScope rejects requests whose authenticated user lacks scope with 403 Forbidden.
+// It must run after Auth.
+func RequireScope(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !HasScope(r.Context(), scope) {
+				forbiddenResponse(w, "Insufficient scope")
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 // extractFromHeader extracts the user ID from the X-User-Id header.
 // This mode is used when OAuth2 is disabled (local development/testing).
 func extractFromHeader(r *http.Request) (*AuthenticatedUser, error) {
@@ -204,8 +224,11 @@ func buildAuthUserFromIntrospection(resp *oauth2.IntrospectResponse) (*Authentic
 
 // unauthorizedResponse sends a 401 Unauthorized response.
 func unauthorizedResponse(w http.ResponseWriter, message string) {
-	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("WWW-Authenticate", "Bearer")
-	w.WriteHeader(http.StatusUnauthorized)
-	_, _ = w.Write([]byte(`{"error":"UNAUTHORIZED","message":"` + message + `"}`))
+	respond.Error(w, http.StatusUnauthorized, "UNAUTHORIZED", message, nil)
+}
+
+// forbiddenResponse writes a 403 Forbidden JSON response.
+func forbiddenResponse(w http.ResponseWriter, message string) {
+	respond.Error(w, http.StatusForbidden, "FORBIDDEN", message, nil)
 }
@@ -465,3 +465,46 @@ func TestAuth_IntrospectionMode(t *testing.T) {
 		mockClient.AssertExpectations(t)
 	})
 }
+
+func TestRequireScope(t *testing.T) {
+	t.Parallel()
+
+	handler := middleware.RequireScope(middleware.ScopeAdmin)(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+	tests := []struct {
+		name     string
+		user     *middleware.AuthenticatedUser
+		expected int
+	}{
+		{name: "no user", user: nil, expected: http.StatusForbidden},
+		{
+			name:     "missing scope",
+			user:     &middleware.AuthenticatedUser{Scopes: []string{"read"}},
+			expected: http.StatusForbidden,
+		},
+		{name: "admin scope", user: &middleware.AuthenticatedUser{Scopes: []string{"admin"}}, expected: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/debug/runtime", nil)
+			if tt.user != nil {
+				req = req.WithContext(middleware.SetAuthenticatedUser(req.Context(), tt.user))
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expected, rr.Code)
+
+			if tt.expected == http.StatusForbidden {
+				assert.Contains(t, rr.Body.String(), "FORBIDDEN")
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// DataAccessRecorder stores reads of user data by service accounts.
+type DataAccessRecorder interface {
+	RecordDataAccess(
+		ctx context.Context,
+		userID uuid.UUID,
+		clientID string,
+		category dto.DataCategory,
+		accessedAt time.Time,
+	) error
+}
+
+// userSubjectPattern prefixes the route patterns of reads about one user.
+const userSubjectPattern = "/users/{user_id}"
+
+// dataAccessRoutes maps the routes below userSubjectPattern to the data category they expose.
+var dataAccessRoutes = map[string]dto.DataCategory{
+	"/":                           dto.DataCategoryProfile,
+	"/profile":                    dto.DataCategoryProfile,
+	"/followers":                  dto.DataCategorySocialGraph,
+	"/following":                  dto.DataCategorySocialGraph,
+	"/following/{target_user_id}": dto.DataCategorySocialGraph,
+	"/activity":                   dto.DataCategoryActivity,
+	"/preferences/":               dto.DataCategoryPreferences,
+	"/preferences/{category}":     dto.DataCategoryPreferences,
+}
+
+// DataAccessAudit records successful reads of a user's data by service accounts, so users can see
+// which services consumed their data in the privacy report. Reads by users are not recorded.
+// A nil recorder disables the audit.
+func DataAccessAudit(recorder DataAccessRecorder) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if recorder == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authUser, ok := GetAuthenticatedUser(r.Context())
+			if !ok || !authUser.IsService || r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			ww := chimw.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			next.ServeHTTP(ww, r)
+
+			if ww.Status() < http.StatusOK || ww.Status() >= http.StatusMultipleChoices {
+				return
+			}
+
+			category, ok := dataAccessCategory(chi.RouteContext(r.Context()).RoutePattern())
+			if !ok {
+				return
+			}
+
+			userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+			if err != nil {
+				return
+			}
+
+			err = recorder.RecordDataAccess(r.Context(), userID, authUser.ClientID, category, time.Now())
+			if err != nil {
+				slog.Warn("failed to record data access", "error", err, "category", category)
+			}
+		})
+	}
+}
+
+// dataAccessCategory returns the data category exposed by a matched route pattern.
+func dataAccessCategory(pattern string) (dto.DataCategory, bool) {
+	_, route, found := strings.Cut(pattern, userSubjectPattern)
+	if !found {
+		return "", false
+	}
+
+	category, ok := dataAccessRoutes[route]
+
+	return category, ok
+}
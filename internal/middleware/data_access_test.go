@@ -0,0 +1,119 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+type recordedAccess struct {
+	userID   uuid.UUID
+	clientID string
+	category dto.DataCategory
+}
+
+type fakeDataAccessRecorder struct {
+	mu       sync.Mutex
+	accesses []recordedAccess
+}
+
+func (f *fakeDataAccessRecorder) RecordDataAccess(
+	_ context.Context,
+	userID uuid.UUID,
+	clientID string,
+	category dto.DataCategory,
+	_ time.Time,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.accesses = append(f.accesses, recordedAccess{userID: userID, clientID: clientID, category: category})
+
+	return nil
+}
+
+func TestDataAccessAudit(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	service := &middleware.AuthenticatedUser{ClientID: "meal-planner", IsService: true}
+	user := &middleware.AuthenticatedUser{UserID: uuid.New(), ClientID: "web"}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		caller *middleware.AuthenticatedUser
+		want   []recordedAccess
+	}{
+		{
+			name:   "service read of profile is recorded",
+			method: http.MethodGet,
+			path:   "/users/" + userID.String() + "/profile",
+			caller: service,
+			want:   []recordedAccess{{userID: userID, clientID: "meal-planner", category: dto.DataCategoryProfile}},
+		},
+		{
+			name:   "service read of a preference category is recorded",
+			method: http.MethodGet,
+			path:   "/users/" + userID.String() + "/preferences/theme",
+			caller: service,
+			want:   []recordedAccess{{userID: userID, clientID: "meal-planner", category: dto.DataCategoryPreferences}},
+		},
+		{
+			name:   "failed service read is not recorded",
+			method: http.MethodGet,
+			path:   "/users/" + userID.String() + "/followers",
+			caller: service,
+		},
+		{
+			name:   "user read is not recorded",
+			method: http.MethodGet,
+			path:   "/users/" + userID.String() + "/profile",
+			caller: user,
+		},
+		{
+			name:   "service write is not recorded",
+			method: http.MethodPut,
+			path:   "/users/" + userID.String() + "/preferences/theme",
+			caller: service,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := &fakeDataAccessRecorder{}
+			ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+
+			r := chi.NewRouter()
+			r.Use(func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+					next.ServeHTTP(w, req.WithContext(middleware.SetAuthenticatedUser(req.Context(), tt.caller)))
+				})
+			})
+			r.Use(middleware.DataAccessAudit(recorder))
+			r.Route("/users/{user_id}", func(r chi.Router) {
+				r.Get("/profile", ok)
+				r.Get("/followers", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusForbidden) })
+				r.Get("/preferences/{category}", ok)
+				r.Put("/preferences/{category}", ok)
+			})
+
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.want, recorder.accesses)
+		})
+	}
+}
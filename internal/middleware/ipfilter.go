@@ -0,0 +1,270 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/metrics"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/respond"
+)
+
+// IP filter route groups.
+const (
+	IPFilterGroupAdmin    = "admin"
+	IPFilterGroupInternal = "internal"
+)
+
+// Reasons a request is rejected by the IP filter, used as the rejection metric's reason label.
+const (
+	ipFilterReasonDenied     = "denied"
+	ipFilterReasonNotAllowed = "not_allowed"
+	ipFilterReasonUnknown    = "unknown_address"
+)
+
+// ErrInvalidIPRule is returned when an IP filter entry is neither a CIDR nor an IP address.
+var ErrInvalidIPRule = errors.New("invalid IP filter entry")
+
+// IPRuleSet is the allow and deny lists of one route group. Deny wins over allow, and an empty
+// allow list admits every address that is not denied.
+type IPRuleSet struct {
+	Allow []netip.Prefix
+	Deny  []netip.Prefix
+}
+
+// IPFilterRules is the full IP filter configuration.
+type IPFilterRules struct {
+	// TrustedProxies are the proxies whose X-Forwarded-For entries are believed. Without any,
+	// the connection's peer address is the client.
+	TrustedProxies []netip.Prefix
+
+	// Groups maps a route group (IPFilterGroupAdmin, IPFilterGroupInternal) to its rules. Groups
+	// without an entry are not filtered.
+	Groups map[string]IPRuleSet
+}
+
+// ParseIPPrefixes parses CIDRs and bare IP addresses, which match only themselves.
+func ParseIPPrefixes(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("%w %q", ErrInvalidIPRule, entry)
+			}
+
+			prefixes = append(prefixes, prefix.Masked())
+
+			continue
+		}
+
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q", ErrInvalidIPRule, entry)
+		}
+
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+
+	return prefixes, nil
+}
+
+// IPFilter restricts route groups to client addresses by CIDR. Its rules can be replaced while
+// serving, so it is safe for concurrent use.
+type IPFilter struct {
+	rules atomic.Pointer[IPFilterRules]
+}
+
+// NewIPFilter creates an IPFilter enforcing rules.
+func NewIPFilter(rules IPFilterRules) *IPFilter {
+	f := &IPFilter{}
+	f.SetRules(rules)
+
+	return f
+}
+
+// SetRules replaces the rules. Requests already past the filter are not affected.
+func (f *IPFilter) SetRules(rules IPFilterRules) {
+	f.rules.Store(&rules)
+}
+
+// TrustedProxies returns the trusted proxies of the current rules; none for a nil filter.
+func (f *IPFilter) TrustedProxies() []netip.Prefix {
+	if f == nil {
+		return nil
+	}
+
+	return f.rules.Load().TrustedProxies
+}
+
+// Middleware rejects requests to group from addresses its rules do not admit with 403 Forbidden.
+// With pathPrefixes, only requests under one of them are checked, so the filter can run ahead of
+// authentication on a router the group shares. A nil filter is a pass-through. PeerAddr must run
+// before RealIP so the connection's own address is known.
+func (f *IPFilter) Middleware(group string, pathPrefixes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if f == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(pathPrefixes) > 0 && !slices.ContainsFunc(pathPrefixes, func(prefix string) bool {
+				return matchesPathPrefix(r.URL.Path, prefix)
+			}) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			rules := f.rules.Load()
+
+			set, ok := rules.Groups[group]
+			if !ok {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			client, ok := clientAddr(r, rules.TrustedProxies)
+
+			reason := ""
+
+			switch {
+			case !ok:
+				reason = ipFilterReasonUnknown
+			case matchesAny(set.Deny, client):
+				reason = ipFilterReasonDenied
+			case len(set.Allow) > 0 && !matchesAny(set.Allow, client):
+				reason = ipFilterReasonNotAllowed
+			}
+
+			if reason != "" {
+				metrics.IPFilterRejectionsTotal.WithLabelValues(group, reason).Inc()
+				slog.WarnContext(r.Context(), "request rejected by IP filter",
+					"group", group, "client_ip", client.String(), "reason", reason, "path", r.URL.Path)
+				respond.Error(w, http.StatusForbidden, "IP_NOT_ALLOWED",
+					"Requests from this address are not allowed on this route", nil)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientAddr returns the client's address: the connection's peer, or, when the peer is one of
+// trusted, the rightmost X-Forwarded-For entry that is not itself a trusted proxy. Entries left of
+// that are set by the client and are ignored.
+func clientAddr(r *http.Request, trusted []netip.Prefix) (netip.Addr, bool) {
+	client, ok := parseHostAddr(peerAddr(r))
+	if !ok || !matchesAny(trusted, client) {
+		return client, ok
+	}
+
+	hops := forwardedFor(r)
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			// A malformed entry can't be traced further; the proxy that appended it is the client
+			break
+		}
+
+		client = hop.Unmap()
+		if !matchesAny(trusted, client) {
+			break
+		}
+	}
+
+	return client, true
+}
+
+// forwardedFor returns the X-Forwarded-For entries across every header line, leftmost first.
+func forwardedFor(r *http.Request) []string {
+	var hops []string
+
+	for _, value := range r.Header.Values("X-Forwarded-For") {
+		for hop := range strings.SplitSeq(value, ",") {
+			hop = strings.TrimSpace(hop)
+			if hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+
+	return hops
+}
+
+func parseHostAddr(hostPort string) (netip.Addr, bool) {
+	host, _, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		host = hostPort
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+
+	return addr.Unmap(), true
+}
+
+func matchesAny(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type peerAddrKey struct{}
+
+// PeerAddr records the connection's remote address before RealIP replaces it with the address
+// the forwarding headers name.
+func PeerAddr(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), peerAddrKey{}, r.RemoteAddr)))
+	})
+}
+
+// peerAddr returns the address recorded by PeerAddr, or RemoteAddr when it did not run.
+func peerAddr(r *http.Request) string {
+	if addr, ok := r.Context().Value(peerAddrKey{}).(string); ok {
+		return addr
+	}
+
+	return r.RemoteAddr
+}
+
+// RealIP replaces RemoteAddr with the client's address as the IP filter resolves it, believing
+// X-Forwarded-For only when it comes through the proxies trusted returns, so rate limiting and
+// logging can't be steered by a forged header. PeerAddr must run first.
+func RealIP(trusted func() []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var proxies []netip.Prefix
+			if trusted != nil {
+				proxies = trusted()
+			}
+
+			if client, ok := clientAddr(r, proxies); ok {
+				r.RemoteAddr = client.String()
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/metrics"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+func mustParseIPPrefixes(t *testing.T, entries ...string) []netip.Prefix {
+	t.Helper()
+
+	prefixes, err := middleware.ParseIPPrefixes(entries)
+	require.NoError(t, err)
+
+	return prefixes
+}
+
+// ipFilterStatus serves a request from remoteAddr through PeerAddr, RealIP and the filter,
+// as the router does, and returns the status.
+func ipFilterStatus(t *testing.T, filter *middleware.IPFilter, group, path, remoteAddr, forwardedFor string,
+	pathPrefixes ...string,
+) int {
+	t.Helper()
+
+	r := chi.NewRouter()
+	r.Use(middleware.PeerAddr)
+	r.Use(middleware.RealIP(filter.TrustedProxies))
+	r.Use(filter.Middleware(group, pathPrefixes...))
+	r.Get("/*", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, path, nil)
+	req.RemoteAddr = remoteAddr
+
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	return rr.Code
+}
+
+func TestParseIPPrefixes(t *testing.T) {
+	t.Parallel()
+
+	prefixes, err := middleware.ParseIPPrefixes([]string{
+		"10.1.2.3/8", " 192.168.0.1 ", "2001:db8::/32", "::ffff:10.0.0.1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.168.0.1/32"),
+		netip.MustParsePrefix("2001:db8::/32"),
+		netip.MustParsePrefix("10.0.0.1/32"),
+	}, prefixes)
+
+	for _, bad := range []string{"", "10.0.0.0/33", "example.com", "10.0.0.1:80"} {
+		_, err := middleware.ParseIPPrefixes([]string{bad})
+		require.ErrorIs(t, err, middleware.ErrInvalidIPRule, bad)
+	}
+}
+
+func TestIPFilter(t *testing.T) {
+	t.Parallel()
+
+	filter := middleware.NewIPFilter(middleware.IPFilterRules{
+		TrustedProxies: mustParseIPPrefixes(t, "10.0.0.0/8"),
+		Groups: map[string]middleware.IPRuleSet{
+			middleware.IPFilterGroupAdmin: {
+				Allow: mustParseIPPrefixes(t, "192.168.1.0/24"),
+				Deny:  mustParseIPPrefixes(t, "192.168.1.66"),
+			},
+			middleware.IPFilterGroupInternal: {Deny: mustParseIPPrefixes(t, "203.0.113.0/24")},
+		},
+	})
+
+	tests := []struct {
+		name         string
+		group        string
+		remoteAddr   string
+		forwardedFor string
+		want         int
+	}{
+		{"allowed peer", middleware.IPFilterGroupAdmin, "192.168.1.10:4321", "", http.StatusOK},
+		{"peer outside the allowlist", middleware.IPFilterGroupAdmin, "192.168.2.10:4321", "", http.StatusForbidden},
+		{"denied peer inside the allowlist", middleware.IPFilterGroupAdmin, "192.168.1.66:4321", "", http.StatusForbidden},
+		{
+			"allowed client behind a trusted proxy", middleware.IPFilterGroupAdmin, "10.0.0.5:80",
+			"192.168.1.10", http.StatusOK,
+		},
+		{
+			"client behind a chain of trusted proxies", middleware.IPFilterGroupAdmin, "10.0.0.5:80",
+			"192.168.1.10, 10.2.0.1", http.StatusOK,
+		},
+		{
+			"spoofed entry left of the real client", middleware.IPFilterGroupAdmin, "10.0.0.5:80",
+			"192.168.1.10, 198.51.100.7", http.StatusForbidden,
+		},
+		{
+			"forwarded header from an untrusted peer", middleware.IPFilterGroupAdmin, "198.51.100.7:80",
+			"192.168.1.10", http.StatusForbidden,
+		},
+		{"denylist only admits others", middleware.IPFilterGroupInternal, "198.51.100.7:80", "", http.StatusOK},
+		{"denylisted peer", middleware.IPFilterGroupInternal, "203.0.113.9:80", "", http.StatusForbidden},
+		{"unfiltered group", "other", "203.0.113.9:80", "", http.StatusOK},
+		{"unparseable peer", middleware.IPFilterGroupInternal, "@", "", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ipFilterStatus(t, filter, tt.group, "/admin/stats", tt.remoteAddr, tt.forwardedFor)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIPFilter_PathPrefixes(t *testing.T) {
+	t.Parallel()
+
+	filter := middleware.NewIPFilter(middleware.IPFilterRules{
+		Groups: map[string]middleware.IPRuleSet{
+			middleware.IPFilterGroupAdmin: {Allow: mustParseIPPrefixes(t, "192.168.1.0/24")},
+		},
+	})
+
+	assert.Equal(t, http.StatusForbidden,
+		ipFilterStatus(t, filter, middleware.IPFilterGroupAdmin, "/api/admin/stats", "198.51.100.7:80", "", "/api/admin"))
+	assert.Equal(t, http.StatusOK,
+		ipFilterStatus(t, filter, middleware.IPFilterGroupAdmin, "/api/users/me", "198.51.100.7:80", "", "/api/admin"))
+	assert.Equal(t, http.StatusOK,
+		ipFilterStatus(t, filter, middleware.IPFilterGroupAdmin, "/api/administrators", "198.51.100.7:80", "", "/api/admin"))
+}
+
+//nolint:paralleltest // reads a process-wide counter
+func TestIPFilter_RejectionResponseAndMetrics(t *testing.T) {
+	filter := middleware.NewIPFilter(middleware.IPFilterRules{
+		Groups: map[string]middleware.IPRuleSet{
+			middleware.IPFilterGroupInternal: {Allow: mustParseIPPrefixes(t, "10.0.0.0/8")},
+		},
+	})
+
+	counter := metrics.IPFilterRejectionsTotal.WithLabelValues(middleware.IPFilterGroupInternal, "not_allowed")
+	before := testutil.ToFloat64(counter)
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	h := filter.Middleware(middleware.IPFilterGroupInternal)(ok)
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/internal/v1/users", nil)
+	req.RemoteAddr = "198.51.100.7:80"
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"error":"IP_NOT_ALLOWED"`)
+	assert.InDelta(t, before+1, testutil.ToFloat64(counter), 0)
+
+	t.Run("rules can be replaced", func(t *testing.T) {
+		filter.SetRules(middleware.IPFilterRules{
+			Groups: map[string]middleware.IPRuleSet{
+				middleware.IPFilterGroupInternal: {Allow: mustParseIPPrefixes(t, "198.51.100.0/24")},
+			},
+		})
+
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestIPFilter_Nil(t *testing.T) {
+	t.Parallel()
+
+	var filter *middleware.IPFilter
+
+	assert.Equal(t, http.StatusOK,
+		ipFilterStatus(t, filter, middleware.IPFilterGroupAdmin, "/admin/stats", "198.51.100.7:80", ""))
+}
+
+func TestRealIP(t *testing.T) {
+	t.Parallel()
+
+	trusted := mustParseIPPrefixes(t, "10.0.0.0/8")
+
+	remoteAddr := func(peer, forwardedFor string) string {
+		var got string
+
+		h := middleware.PeerAddr(middleware.RealIP(func() []netip.Prefix { return trusted })(
+			http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) { got = r.RemoteAddr })))
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+		req.RemoteAddr = peer
+
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+
+		req.Header.Set("X-Real-IP", "192.0.2.1")
+		h.ServeHTTP(httptest.NewRecorder(), req)
+
+		return got
+	}
+
+	// A trusted proxy's X-Forwarded-For names the client; entries the client added are ignored
+	assert.Equal(t, "198.51.100.7", remoteAddr("10.0.0.2:443", "192.0.2.9, 198.51.100.7, 10.0.0.3"))
+
+	// Forwarding headers from anyone else are ignored
+	assert.Equal(t, "203.0.113.5", remoteAddr("203.0.113.5:5000", "198.51.100.7"))
+	assert.Equal(t, "203.0.113.5", remoteAddr("203.0.113.5:5000", ""))
+}
@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/i18n"
+)
+
+// Language negotiates the language of error messages from the Accept-Language header. Error
+// responses written further down are translated into it and carry a Content-Language header;
+// requests without the header, or asking only for unsupported languages, get English.
+func Language(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lang := i18n.Negotiate(r.Header.Get("Accept-Language"))
+
+		next.ServeHTTP(i18n.WithLanguage(w, lang), r)
+	})
+}
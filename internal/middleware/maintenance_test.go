@@ -0,0 +1,64 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+type fakeMaintenanceChecker bool
+
+func (f fakeMaintenanceChecker) MaintenanceMode(context.Context) (bool, string) {
+	return bool(f), "Migrating, back soon"
+}
+
+func TestRejectDuringMaintenance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		method  string
+		checker middleware.MaintenanceChecker
+		want    int
+	}{
+		{
+			name:    "write is rejected",
+			method:  http.MethodPost,
+			checker: fakeMaintenanceChecker(true),
+			want:    http.StatusServiceUnavailable,
+		},
+		{name: "read passes", method: http.MethodGet, checker: fakeMaintenanceChecker(true), want: http.StatusOK},
+		{
+			name:    "write passes when off",
+			method:  http.MethodDelete,
+			checker: fakeMaintenanceChecker(false),
+			want:    http.StatusOK,
+		},
+		{name: "no checker passes", method: http.MethodPut, want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+			handler := middleware.RejectDuringMaintenance(tt.checker, 2*time.Minute)(next)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(tt.method, "/users/profile", nil))
+
+			assert.Equal(t, tt.want, rr.Code)
+
+			if tt.want == http.StatusServiceUnavailable {
+				assert.Equal(t, "120", rr.Header().Get("Retry-After"))
+				assert.JSONEq(t, `{"error": "RETRY_LATER", "message": "Migrating, back soon"}`, rr.Body.String())
+			}
+		})
+	}
+}
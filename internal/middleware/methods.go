@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/respond"
+)
+
+// routableMethods are the methods probed against the route tree when advertising a path's methods.
+var routableMethods = []string{
+	http.MethodGet,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// AllowedMethods returns the methods routes serves for path, including HEAD for GET routes and
+// OPTIONS, or nil when no route matches the path.
+func AllowedMethods(routes chi.Routes, path string) []string {
+	var methods []string
+
+	for _, method := range routableMethods {
+		if routeMatches(routes, method, path) {
+			methods = append(methods, method)
+		}
+	}
+
+	if len(methods) == 0 {
+		return nil
+	}
+
+	if slices.Contains(methods, http.MethodGet) {
+		methods = append(methods, http.MethodHead)
+	}
+
+	return append(methods, http.MethodOptions)
+}
+
+// routeMatches reports whether routes serves method on path. chi registers a subrouter's bare mount
+// path as a stub accepting every method, so a match on the stub is resolved against the subrouter's
+// root route instead.
+func routeMatches(routes chi.Routes, method, path string) bool {
+	pattern := routes.Find(chi.NewRouteContext(), method, path)
+	if pattern == "" {
+		return false
+	}
+
+	mount := strings.TrimSuffix(pattern, "/") + "/*"
+	for _, route := range routes.Routes() {
+		if route.Pattern == mount && route.SubRoutes != nil && pattern != mount {
+			return routeMatches(route.SubRoutes, method, "/")
+		}
+	}
+
+	return true
+}
+
+// Head serves HEAD requests with the route's GET handler and discards the body, so HEAD returns
+// the same status and headers, including Content-Length, as GET without transferring the payload.
+// It must be mounted on the root router, ahead of middleware that rewrites the body.
+func Head(next http.Handler) http.Handler {
+	getHead := chiMiddleware.GetHead(next)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		getHead.ServeHTTP(headResponseWriter{ResponseWriter: w}, r)
+	})
+}
+
+// headResponseWriter drops the body written by a GET handler serving a HEAD request.
+type headResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (w headResponseWriter) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+// Unwrap returns the wrapped writer.
+func (w headResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Options answers OPTIONS requests with 204 and an Allow header listing the methods the route tree
+// serves for the path. CORS preflight requests are answered by the CORS middleware before this
+// runs; paths without routes fall through to the 404 handler.
+func Options(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rctx := chi.RouteContext(r.Context())
+		if r.Method != http.MethodOptions || rctx == nil {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		methods := AllowedMethods(rctx.Routes, r.URL.Path)
+		if methods == nil {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		w.Header().Set("Allow", strings.Join(methods, ", "))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// NotFound is the router's 404 handler for paths no route matches.
+func NotFound(w http.ResponseWriter, _ *http.Request) {
+	respond.Error(w, http.StatusNotFound, "NOT_FOUND", "Route not found", nil)
+}
+
+// MethodNotAllowed is the router's 405 handler. It lists the path's methods in the Allow header,
+// as required by RFC 9110, and writes the standard error body.
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		if methods := AllowedMethods(rctx.Routes, r.URL.Path); methods != nil {
+			w.Header().Set("Allow", strings.Join(methods, ", "))
+		}
+	}
+
+	respond.Error(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Method not allowed", nil)
+}
@@ -0,0 +1,105 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+func newMethodsRouter() chi.Router {
+	r := chi.NewRouter()
+	r.Use(middleware.Head)
+	r.Use(middleware.Options)
+	r.MethodNotAllowed(middleware.MethodNotAllowed)
+
+	r.Route("/items", func(r chi.Router) {
+		r.Get("/{id}", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Length", "5")
+			w.Header().Set("X-Item", "1")
+			_, _ = w.Write([]byte("hello"))
+		})
+		r.Put("/{id}", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		r.Post("/", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+		})
+	})
+
+	return r
+}
+
+func TestAllowedMethods(t *testing.T) {
+	t.Parallel()
+
+	r := newMethodsRouter()
+
+	assert.Equal(t, []string{"GET", "PUT", "HEAD", "OPTIONS"}, middleware.AllowedMethods(r, "/items/42"))
+	assert.Equal(t, []string{"POST", "OPTIONS"}, middleware.AllowedMethods(r, "/items/"))
+	assert.Nil(t, middleware.AllowedMethods(r, "/missing"))
+}
+
+func TestHead(t *testing.T) {
+	t.Parallel()
+
+	r := newMethodsRouter()
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodHead, "/items/42", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "5", rr.Header().Get("Content-Length"))
+	assert.Equal(t, "1", rr.Header().Get("X-Item"))
+	assert.Empty(t, rr.Body.String())
+
+	rr = httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/items/42", nil))
+	assert.Equal(t, "hello", rr.Body.String())
+}
+
+func TestOptions(t *testing.T) {
+	t.Parallel()
+
+	r := newMethodsRouter()
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantAllow  string
+	}{
+		{name: "item", path: "/items/42", wantStatus: http.StatusNoContent, wantAllow: "GET, PUT, HEAD, OPTIONS"},
+		{name: "collection", path: "/items/", wantStatus: http.StatusNoContent, wantAllow: "POST, OPTIONS"},
+		{name: "unknown path", path: "/missing", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodOptions, tt.path, nil))
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			assert.Equal(t, tt.wantAllow, rr.Header().Get("Allow"))
+		})
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	r := newMethodsRouter()
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/items/42", nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	assert.Equal(t, "GET, PUT, HEAD, OPTIONS", rr.Header().Get("Allow"))
+	assert.Contains(t, rr.Body.String(), "METHOD_NOT_ALLOWED")
+}
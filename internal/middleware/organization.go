@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/chi/v5"
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/respond"
+)
+
+// ActAsHeader names the organization a member acts as.
+const ActAsHeader = "X-Act-As"
+
+// actorKey is the context key for the member acting as an organization.
+const actorKey contextKey = "acting_member"
+
+// OrganizationActor checks organization membership and records what members do as organizations.
+type OrganizationActor interface {
+	// CanActAs reports whether userID may act as orgID; destructive requests take an owner.
+	CanActAs(ctx context.Context, orgID, userID uuid.UUID, destructive bool) (bool, error)
+	RecordAction(ctx context.Context, actorID, orgID uuid.UUID, action string) error
+}
+
+// ActAsOrganization lets members of an organization act as it by sending its ID in the X-Act-As
+// header: the organization becomes the authenticated user, so its profile and social graph are
+// managed through the usual routes; routes about the account itself refuse it through
+// RejectOrganizationActors. Deletes take an owner of the organization. Successful writes are
+// recorded in the organization's audit trail, attributed to the member. Acting as an organization
+// never carries the admin scope. It must run after Auth. Requests naming an organization the user
+// may not act as are rejected with 403, and so are all requests naming one when actor is nil.
+func ActAsOrganization(actor OrganizationActor) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get(ActAsHeader)
+			if header == "" {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			authUser, _ := GetAuthenticatedUser(r.Context())
+
+			memberID, ok := GetUserIDFromContext(r.Context())
+			if !ok {
+				forbiddenResponse(w, "Only users can act as an organization")
+
+				return
+			}
+
+			orgID, err := uuid.Parse(header)
+			if err != nil {
+				respond.Error(w, http.StatusBadRequest, "INVALID_ORGANIZATION_ID", "Invalid organization ID format",
+					nil)
+
+				return
+			}
+
+			if actor == nil {
+				forbiddenResponse(w, "Not a member of this organization")
+
+				return
+			}
+
+			allowed, err := actor.CanActAs(r.Context(), orgID, memberID, r.Method == http.MethodDelete)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "failed to check organization membership", "error", err)
+				respond.Error(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE",
+					"Service temporarily unavailable", nil)
+
+				return
+			}
+
+			if !allowed {
+				forbiddenResponse(w, "Not allowed to act as this organization")
+
+				return
+			}
+
+			acting := *authUser
+			acting.UserID = orgID
+			acting.Scopes = slices.DeleteFunc(slices.Clone(authUser.Scopes), func(scope string) bool {
+				return scope == ScopeAdmin
+			})
+
+			ctx := SetAuthenticatedUser(r.Context(), &acting)
+			ctx = context.WithValue(ctx, actorKey, memberID)
+			r = r.WithContext(ctx)
+
+			if isSafeMethod(r.Method) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			ww := chimw.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			next.ServeHTTP(ww, r)
+
+			// Handlers that never write leave the status at 0, which net/http sends as 200
+			if status := ww.Status(); status != 0 && (status < http.StatusOK || status >= http.StatusMultipleChoices) {
+				return
+			}
+
+			action := r.Method + " " + chi.RouteContext(ctx).RoutePattern()
+
+			err = actor.RecordAction(ctx, memberID, orgID, action)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to record organization action", "error", err, "action", action)
+			}
+		})
+	}
+}
+
+// RejectOrganizationActors refuses requests made while acting as an organization with 403. It
+// guards the routes about the account rather than its profile and social graph, like deletion,
+// email changes, handles, policies and organizations, which a member must not reach as the
+// organization.
+func RejectOrganizationActors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ActingAsOrganization(r.Context()) {
+			forbiddenResponse(w, "Not available while acting as an organization")
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ActorID returns the person behind the request: the member acting as an organization, or else
+// the authenticated user.
+func ActorID(ctx context.Context) (uuid.UUID, bool) {
+	if memberID, ok := ctx.Value(actorKey).(uuid.UUID); ok {
+		return memberID, true
+	}
+
+	return GetUserIDFromContext(ctx)
+}
+
+// ActingAsOrganization reports whether the request is made by a member acting as an organization.
+func ActingAsOrganization(ctx context.Context) bool {
+	_, ok := ctx.Value(actorKey).(uuid.UUID)
+
+	return ok
+}
@@ -0,0 +1,173 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+type fakeOrganizationActor struct {
+	members map[uuid.UUID]bool
+	owners  map[uuid.UUID]bool
+	err     error
+
+	mu      sync.Mutex
+	actions []string
+}
+
+func (f *fakeOrganizationActor) CanActAs(_ context.Context, _, userID uuid.UUID, destructive bool) (bool, error) {
+	return f.members[userID] && (!destructive || f.owners[userID]), f.err
+}
+
+func (f *fakeOrganizationActor) RecordAction(_ context.Context, actorID, orgID uuid.UUID, action string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.actions = append(f.actions, actorID.String()+" "+orgID.String()+" "+action)
+
+	return nil
+}
+
+func TestActAsOrganization(t *testing.T) {
+	t.Parallel()
+
+	orgID, memberID, ownerID, strangerID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	orgHeader := orgID.String()
+
+	tests := []struct {
+		name        string
+		method      string
+		header      string
+		caller      *middleware.AuthenticatedUser
+		actorErr    error
+		nilActor    bool
+		status      int
+		wantUser    uuid.UUID
+		wantActor   uuid.UUID
+		wantScopes  []string
+		wantActions int
+	}{
+		{name: "no header", method: http.MethodPost, caller: &middleware.AuthenticatedUser{UserID: strangerID},
+			status: http.StatusOK, wantUser: strangerID, wantActor: strangerID},
+		{name: "member write is recorded", method: http.MethodPost, header: orgHeader,
+			caller: &middleware.AuthenticatedUser{UserID: memberID, Scopes: []string{"user:read", "admin"}},
+			status: http.StatusOK, wantUser: orgID, wantActor: memberID, wantScopes: []string{"user:read"},
+			wantActions: 1},
+		{name: "member read is not recorded", method: http.MethodGet, header: orgHeader,
+			caller: &middleware.AuthenticatedUser{UserID: memberID}, status: http.StatusOK,
+			wantUser: orgID, wantActor: memberID},
+		{name: "editor delete", method: http.MethodDelete, header: orgHeader,
+			caller: &middleware.AuthenticatedUser{UserID: memberID}, status: http.StatusForbidden},
+		{name: "owner delete is recorded", method: http.MethodDelete, header: orgHeader,
+			caller: &middleware.AuthenticatedUser{UserID: ownerID}, status: http.StatusOK,
+			wantUser: orgID, wantActor: ownerID, wantActions: 1},
+		{name: "non-member", method: http.MethodPost, header: orgHeader,
+			caller: &middleware.AuthenticatedUser{UserID: strangerID}, status: http.StatusForbidden},
+		{name: "service token", method: http.MethodPost, header: orgHeader,
+			caller: &middleware.AuthenticatedUser{ClientID: "meal-planner", IsService: true},
+			status: http.StatusForbidden},
+		{name: "invalid organization ID", method: http.MethodPost, header: "brand",
+			caller: &middleware.AuthenticatedUser{UserID: memberID}, status: http.StatusBadRequest},
+		{name: "membership check fails", method: http.MethodPost, header: orgHeader,
+			caller: &middleware.AuthenticatedUser{UserID: memberID}, actorErr: errors.New("database down"),
+			status: http.StatusServiceUnavailable},
+		{name: "no actor", method: http.MethodPost, header: orgHeader, nilActor: true,
+			caller: &middleware.AuthenticatedUser{UserID: memberID}, status: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actor := &fakeOrganizationActor{
+				members: map[uuid.UUID]bool{memberID: true, ownerID: true},
+				owners:  map[uuid.UUID]bool{ownerID: true},
+				err:     tt.actorErr,
+			}
+
+			var mw func(http.Handler) http.Handler
+			if tt.nilActor {
+				mw = middleware.ActAsOrganization(nil)
+			} else {
+				mw = middleware.ActAsOrganization(actor)
+			}
+
+			var userID, actorID uuid.UUID
+
+			var scopes []string
+
+			r := chi.NewRouter()
+			r.Use(mw)
+			r.HandleFunc("/users/{user_id}/follow", func(_ http.ResponseWriter, r *http.Request) {
+				user, _ := middleware.GetAuthenticatedUser(r.Context())
+				userID = user.UserID
+				scopes = user.Scopes
+				actorID, _ = middleware.ActorID(r.Context())
+			})
+
+			req := httptest.NewRequest(tt.method, "/users/"+orgHeader+"/follow", nil)
+			req = req.WithContext(middleware.SetAuthenticatedUser(req.Context(), tt.caller))
+
+			if tt.header != "" {
+				req.Header.Set(middleware.ActAsHeader, tt.header)
+			}
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+			assert.Equal(t, tt.wantUser, userID)
+			assert.Equal(t, tt.wantActor, actorID)
+			assert.Len(t, actor.actions, tt.wantActions)
+
+			if tt.wantScopes != nil {
+				assert.Equal(t, tt.wantScopes, scopes, "the admin scope is dropped")
+			}
+
+			if tt.wantActions > 0 {
+				assert.Equal(t, tt.wantActor.String()+" "+orgHeader+" "+tt.method+" /users/{user_id}/follow",
+					actor.actions[0])
+			}
+		})
+	}
+}
+
+func TestRejectOrganizationActors(t *testing.T) {
+	t.Parallel()
+
+	orgID, memberID := uuid.New(), uuid.New()
+	actor := &fakeOrganizationActor{members: map[uuid.UUID]bool{memberID: true}}
+
+	r := chi.NewRouter()
+	r.Use(middleware.ActAsOrganization(actor))
+	r.With(middleware.RejectOrganizationActors).
+		Post("/users/account/delete-request", func(http.ResponseWriter, *http.Request) {})
+
+	request := func(actAs bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/users/account/delete-request", nil)
+		req = req.WithContext(middleware.SetAuthenticatedUser(req.Context(),
+			&middleware.AuthenticatedUser{UserID: memberID}))
+
+		if actAs {
+			req.Header.Set(middleware.ActAsHeader, orgID.String())
+		}
+
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	assert.Equal(t, http.StatusOK, request(false).Code)
+	assert.Equal(t, http.StatusForbidden, request(true).Code)
+	assert.Empty(t, actor.actions, "refused requests are not recorded")
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/respond"
+)
+
+// PolicyChecker reports the published policy documents a user has not accepted in their
+// current version.
+type PolicyChecker interface {
+	PendingPolicies(ctx context.Context, userID uuid.UUID) ([]dto.PolicyDocument, error)
+}
+
+// RequirePolicyAcceptance rejects mutating requests from users who have not accepted the current
+// version of every published policy with 428 Precondition Required, so clients can prompt for
+// re-acceptance after a policy change. Reads and service accounts are not gated, and requests
+// pass through if the check fails. A nil checker disables the gate.
+func RequirePolicyAcceptance(checker PolicyChecker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if checker == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authUser, ok := GetAuthenticatedUser(r.Context())
+			if !ok || authUser.IsService || isSafeMethod(r.Method) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			pending, err := checker.PendingPolicies(r.Context(), authUser.UserID)
+			if err != nil {
+				slog.Warn("failed to check policy acceptance", "error", err)
+			}
+
+			if len(pending) > 0 {
+				policyAcceptanceRequiredResponse(w, pending)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// policyAcceptanceRequiredResponse writes a 428 Precondition Required JSON response naming the
+// documents to accept.
+func policyAcceptanceRequiredResponse(w http.ResponseWriter, pending []dto.PolicyDocument) {
+	documents := make([]string, len(pending))
+	for i, document := range pending {
+		documents[i] = string(document)
+	}
+
+	respond.Error(w, http.StatusPreconditionRequired, "POLICY_ACCEPTANCE_REQUIRED",
+		"Accept the current policies to continue", map[string]string{"documents": strings.Join(documents, ",")})
+}
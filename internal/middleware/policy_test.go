@@ -0,0 +1,90 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+type fakePolicyChecker struct {
+	pending []dto.PolicyDocument
+	err     error
+}
+
+func (f fakePolicyChecker) PendingPolicies(context.Context, uuid.UUID) ([]dto.PolicyDocument, error) {
+	return f.pending, f.err
+}
+
+func TestRequirePolicyAcceptance(t *testing.T) {
+	t.Parallel()
+
+	pending := fakePolicyChecker{pending: []dto.PolicyDocument{dto.PolicyDocumentTerms}}
+	user := &middleware.AuthenticatedUser{UserID: uuid.New(), ClientID: "web"}
+	service := &middleware.AuthenticatedUser{ClientID: "meal-planner", IsService: true}
+
+	tests := []struct {
+		name    string
+		method  string
+		caller  *middleware.AuthenticatedUser
+		checker middleware.PolicyChecker
+		want    int
+	}{
+		{
+			name:    "pending write is rejected",
+			method:  http.MethodPut,
+			caller:  user,
+			checker: pending,
+			want:    http.StatusPreconditionRequired,
+		},
+		{name: "pending read passes", method: http.MethodGet, caller: user, checker: pending, want: http.StatusOK},
+		{
+			name:    "accepted write passes",
+			method:  http.MethodPost,
+			caller:  user,
+			checker: fakePolicyChecker{},
+			want:    http.StatusOK,
+		},
+		{name: "service write passes", method: http.MethodDelete, caller: service, checker: pending, want: http.StatusOK},
+		{
+			name:    "failed check passes",
+			method:  http.MethodPut,
+			caller:  user,
+			checker: fakePolicyChecker{err: errors.New("database down")},
+			want:    http.StatusOK,
+		},
+		{name: "no checker passes", method: http.MethodPut, caller: user, want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+			handler := middleware.RequirePolicyAcceptance(tt.checker)(next)
+
+			req := httptest.NewRequest(tt.method, "/users/profile", nil)
+			req = req.WithContext(middleware.SetAuthenticatedUser(req.Context(), tt.caller))
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+
+			if tt.want == http.StatusPreconditionRequired {
+				assert.JSONEq(t, `{
+					"error": "POLICY_ACCEPTANCE_REQUIRED",
+					"message": "Accept the current policies to continue",
+					"details": {"documents": "terms_of_service"}
+				}`, rr.Body.String())
+			}
+		})
+	}
+}
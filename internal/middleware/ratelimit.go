@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/respond"
+)
+
+const defaultRateLimitWindow = time.Minute
+
+// RateLimitConfig holds the configuration for the RateLimit middleware.
+type RateLimitConfig struct {
+	// Enabled turns rate limiting on. When false the middleware is a pass-through.
+	Enabled bool
+
+	// Window is the length of each fixed counting window. Defaults to one minute.
+	Window time.Duration
+
+	// AuthenticatedLimit is the number of requests an authenticated caller may make per window,
+	// keyed by user ID (or client ID for service tokens). Zero means unlimited.
+	AuthenticatedLimit int
+
+	// AnonymousLimit is the number of requests an anonymous caller may make per window,
+	// keyed by client IP. It should be stricter than AuthenticatedLimit. Zero means unlimited.
+	AnonymousLimit int
+}
+
+// RateLimit creates a fixed-window rate limiting middleware with separate authenticated and
+// anonymous tiers. It must run after Auth on protected routes so the caller can be identified;
+// requests without an authenticated user fall into the anonymous tier.
+//
+// The returned middleware shares one set of counters, so reuse it across route groups rather
+// than calling RateLimit once per group.
+func RateLimit(cfg RateLimitConfig) func(http.Handler) http.Handler {
+	if !cfg.Enabled {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	window := cfg.Window
+	if window <= 0 {
+		window = defaultRateLimitWindow
+	}
+
+	limiter := newRateLimiter(window, time.Now)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key, limit := rateLimitKey(r, cfg)
+			if limit <= 0 {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			remaining, reset, allowed := limiter.allow(key, limit)
+
+			w.Header().Set("X-RateLimit-Limit", strconv.Itoa(limit))
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+
+			if !allowed {
+				slog.Debug("rate limit exceeded", "key", key, "path", r.URL.Path)
+				tooManyRequestsResponse(w, reset)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// rateLimitKey returns the counter key and limit that apply to the request.
+func rateLimitKey(r *http.Request, cfg RateLimitConfig) (string, int) {
+	if user, ok := GetAuthenticatedUser(r.Context()); ok {
+		if user.IsService {
+			return "client:" + user.ClientID, cfg.AuthenticatedLimit
+		}
+
+		return "user:" + user.UserID.String(), cfg.AuthenticatedLimit
+	}
+
+	return "ip:" + ClientIP(r), cfg.AnonymousLimit
+}
+
+// ClientIP returns the caller's IP. RemoteAddr has already been rewritten by RealIP when the
+// request came through a trusted proxy.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// tooManyRequestsResponse sends a 429 Too Many Requests response.
+func tooManyRequestsResponse(w http.ResponseWriter, reset time.Time) {
+	retryAfter := max(int(time.Until(reset).Seconds()+1), 1)
+
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	respond.Error(w, http.StatusTooManyRequests, "RATE_LIMITED", "Too many requests", nil)
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// rateLimiter counts requests per key in fixed windows.
+type rateLimiter struct {
+	mu        sync.Mutex
+	window    time.Duration
+	now       func() time.Time
+	counters  map[string]*rateWindow
+	lastSweep time.Time
+}
+
+func newRateLimiter(window time.Duration, now func() time.Time) *rateLimiter {
+	return &rateLimiter{
+		window:    window,
+		now:       now,
+		counters:  make(map[string]*rateWindow),
+		lastSweep: now(),
+	}
+}
+
+// allow records a request for key and reports whether it is within limit, along with the
+// remaining allowance and when the current window resets.
+func (l *rateLimiter) allow(key string, limit int) (int, time.Time, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	counter, ok := l.counters[key]
+	if !ok || now.Sub(counter.start) >= l.window {
+		counter = &rateWindow{start: now}
+		l.counters[key] = counter
+	}
+
+	reset := counter.start.Add(l.window)
+
+	if counter.count >= limit {
+		return 0, reset, false
+	}
+
+	counter.count++
+
+	return limit - counter.count, reset, true
+}
+
+// sweep drops expired counters once per window so idle keys do not accumulate.
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.window {
+		return
+	}
+
+	for key, counter := range l.counters {
+		if now.Sub(counter.start) >= l.window {
+			delete(l.counters, key)
+		}
+	}
+
+	l.lastSweep = now
+}
@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func rateLimitedHandler(cfg RateLimitConfig) http.Handler {
+	return RateLimit(cfg)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func anonymousRequest(remoteAddr string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/shared-profiles/token", nil)
+	req.RemoteAddr = remoteAddr
+
+	return req
+}
+
+func authenticatedRequest(userID uuid.UUID, remoteAddr string) *http.Request {
+	req := anonymousRequest(remoteAddr)
+
+	return req.WithContext(SetAuthenticatedUser(req.Context(), &AuthenticatedUser{UserID: userID}))
+}
+
+func TestRateLimit_AnonymousTier(t *testing.T) {
+	t.Parallel()
+
+	h := rateLimitedHandler(RateLimitConfig{Enabled: true, AuthenticatedLimit: 10, AnonymousLimit: 2})
+
+	for range 2 {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, anonymousRequest("203.0.113.7:5000"))
+		assert.Equal(t, http.StatusOK, rr.Code)
+	}
+
+	// Third anonymous request from the same IP (different port) is rejected
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, anonymousRequest("203.0.113.7:6000"))
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Equal(t, "0", rr.Header().Get("X-RateLimit-Remaining"))
+	assert.NotEmpty(t, rr.Header().Get("Retry-After"))
+	assert.Contains(t, rr.Body.String(), "RATE_LIMITED")
+
+	// Another IP has its own allowance
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, anonymousRequest("198.51.100.1:5000"))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	// An authenticated user from the throttled IP uses the authenticated tier
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, authenticatedRequest(uuid.New(), "203.0.113.7:5000"))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "10", rr.Header().Get("X-RateLimit-Limit"))
+}
+
+func TestRateLimit_ForwardedFor(t *testing.T) {
+	t.Parallel()
+
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	h := PeerAddr(RealIP(func() []netip.Prefix { return trusted })(
+		rateLimitedHandler(RateLimitConfig{Enabled: true, AuthenticatedLimit: 10, AnonymousLimit: 1})))
+
+	request := func(remoteAddr, forwardedFor string) int {
+		req := anonymousRequest(remoteAddr)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+
+		return rr.Code
+	}
+
+	// A client can't escape its limit by forging a different X-Forwarded-For each time
+	assert.Equal(t, http.StatusOK, request("203.0.113.7:5000", "192.0.2.1"))
+	assert.Equal(t, http.StatusTooManyRequests, request("203.0.113.7:5000", "192.0.2.2"))
+
+	// Clients behind a trusted proxy are limited separately
+	assert.Equal(t, http.StatusOK, request("10.0.0.2:443", "198.51.100.1"))
+	assert.Equal(t, http.StatusOK, request("10.0.0.2:443", "198.51.100.2"))
+	assert.Equal(t, http.StatusTooManyRequests, request("10.0.0.2:443", "192.0.2.9, 198.51.100.2"))
+}
+
+func TestRateLimit_AuthenticatedTier(t *testing.T) {
+	t.Parallel()
+
+	h := rateLimitedHandler(RateLimitConfig{Enabled: true, AuthenticatedLimit: 1, AnonymousLimit: 1})
+	userID := uuid.New()
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, authenticatedRequest(userID, "203.0.113.7:5000"))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	// Same user from a different IP shares the allowance
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, authenticatedRequest(userID, "198.51.100.1:5000"))
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+}
+
+func TestRateLimit_Disabled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  RateLimitConfig
+	}{
+		{name: "disabled", cfg: RateLimitConfig{Enabled: false, AnonymousLimit: 1}},
+		{name: "unlimited tier", cfg: RateLimitConfig{Enabled: true, AnonymousLimit: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := rateLimitedHandler(tt.cfg)
+
+			for range 5 {
+				rr := httptest.NewRecorder()
+				h.ServeHTTP(rr, anonymousRequest("203.0.113.7:5000"))
+				assert.Equal(t, http.StatusOK, rr.Code)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_WindowReset(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(time.Minute, func() time.Time { return now })
+
+	_, _, allowed := limiter.allow("ip:1", 1)
+	assert.True(t, allowed)
+
+	_, reset, allowed := limiter.allow("ip:1", 1)
+	assert.False(t, allowed)
+	assert.Equal(t, now.Add(time.Minute), reset)
+
+	// Next window starts a fresh count and sweeps stale keys
+	now = now.Add(time.Minute)
+
+	remaining, _, allowed := limiter.allow("ip:2", 1)
+	assert.True(t, allowed)
+	assert.Equal(t, 0, remaining)
+	assert.NotContains(t, limiter.counters, "ip:1")
+
+	_, _, allowed = limiter.allow("ip:1", 1)
+	assert.True(t, allowed)
+}
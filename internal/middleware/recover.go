@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/errortracking"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/respond"
+)
+
+// Recoverer turns a panic in a handler into a 500 with the standard error body and logs it with
+// its stack. http.ErrAbortHandler is re-raised so the server aborts the response as intended.
+func Recoverer(next http.Handler) http.Handler {
+	return ReportErrors(errortracking.NoopReporter{})(next)
+}
+
+// errServerError is reported for 5xx responses, whose cause the handler has already logged.
+var errServerError = errors.New("server error")
+
+// ReportErrors recovers panics like Recoverer, and sends them and every other 5xx response to
+// reporter, tagged with the method, route pattern, status and request ID.
+func ReportErrors(reporter errortracking.ErrorReporter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ww := chiMiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					if ww.Status() >= http.StatusInternalServerError {
+						reporter.Report(r.Context(), errortracking.Event{
+							Err:  fmt.Errorf("%w: %d", errServerError, ww.Status()),
+							Tags: requestTags(r, ww.Status()),
+						})
+					}
+
+					return
+				}
+
+				if err, ok := rec.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+					panic(rec)
+				}
+
+				stack := debug.Stack()
+
+				slog.ErrorContext(r.Context(), "panic serving request", "panic", rec, "stack", string(stack))
+				reporter.Report(r.Context(), errortracking.Event{
+					Err:   fmt.Errorf("panic: %v", rec),
+					Panic: true,
+					Stack: stack,
+					Tags:  requestTags(r, http.StatusInternalServerError),
+				})
+				respond.Error(ww, http.StatusInternalServerError, "INTERNAL_ERROR", "An internal error occurred", nil)
+			}()
+
+			next.ServeHTTP(ww, r)
+		})
+	}
+}
+
+// requestTags indexes an error report by request. The route pattern is used over the path so
+// user IDs stay out of the report.
+func requestTags(r *http.Request, status int) map[string]string {
+	tags := map[string]string{
+		"method":     r.Method,
+		"status":     strconv.Itoa(status),
+		"request_id": chiMiddleware.GetReqID(r.Context()),
+	}
+
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+		tags["route"] = rctx.RoutePattern()
+	}
+
+	return tags
+}
+
+// Timeout cancels the request context after timeout. A handler that gives up without writing a
+// response gets a 504 with the standard error body.
+func Timeout(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			ww := chiMiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) && ww.Status() == 0 {
+				respond.Error(w, http.StatusGatewayTimeout, "TIMEOUT", "The request took too long", nil)
+			}
+		})
+	}
+}
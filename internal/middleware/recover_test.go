@@ -0,0 +1,135 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/errortracking"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+func decodeError(t *testing.T, rr *httptest.ResponseRecorder) dto.Error {
+	t.Helper()
+
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+	var body dto.Error
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+
+	return body
+}
+
+func TestRecoverer(t *testing.T) {
+	t.Parallel()
+
+	h := middleware.Recoverer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("nil map")
+	}))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/items/42", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Equal(t, "INTERNAL_ERROR", decodeError(t, rr).Code)
+
+	abort := middleware.Recoverer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		abort.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items/42", nil))
+	})
+}
+
+type fakeErrorReporter struct {
+	mu     sync.Mutex
+	events []errortracking.Event
+}
+
+func (f *fakeErrorReporter) Report(_ context.Context, event errortracking.Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.events = append(f.events, event)
+}
+
+func TestReportErrors(t *testing.T) {
+	t.Parallel()
+
+	reporter := &fakeErrorReporter{}
+
+	r := chi.NewRouter()
+	r.Use(middleware.ReportErrors(reporter))
+	r.Get("/items/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	r.Get("/panics/{id}", func(http.ResponseWriter, *http.Request) {
+		panic("nil map")
+	})
+	r.Get("/ok", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	for _, path := range []string{"/items/42", "/panics/42", "/ok"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	require.Len(t, reporter.events, 2)
+
+	assert.False(t, reporter.events[0].Panic)
+	assert.Equal(t, "/items/{id}", reporter.events[0].Tags["route"])
+	assert.Equal(t, "502", reporter.events[0].Tags["status"])
+
+	assert.True(t, reporter.events[1].Panic)
+	assert.EqualError(t, reporter.events[1].Err, "panic: nil map")
+	assert.NotEmpty(t, reporter.events[1].Stack)
+	assert.Equal(t, "/panics/{id}", reporter.events[1].Tags["route"])
+}
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	slow := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	rr := httptest.NewRecorder()
+	middleware.Timeout(10*time.Millisecond)(slow).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/items/42", nil))
+
+	assert.Equal(t, http.StatusGatewayTimeout, rr.Code)
+	assert.Equal(t, "TIMEOUT", decodeError(t, rr).Code)
+
+	// A response already under way is left alone
+	partial := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		<-r.Context().Done()
+	})
+
+	rr = httptest.NewRecorder()
+	middleware.Timeout(10*time.Millisecond)(partial).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/items/42", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, rr.Body.String())
+}
+
+func TestNotFound(t *testing.T) {
+	t.Parallel()
+
+	r := newMethodsRouter()
+	r.NotFound(middleware.NotFound)
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/widgets", nil))
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Equal(t, "NOT_FOUND", decodeError(t, rr).Code)
+}
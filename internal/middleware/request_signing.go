@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/requestsign"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/respond"
+)
+
+const (
+	defaultRequestSigningMaxSkew = 5 * time.Minute
+	maxSignedBodyBytes           = 10 << 20
+)
+
+// NonceStore remembers the nonces of signed requests, so each signed request is accepted once.
+type NonceStore interface {
+	// ReserveNonce records nonce for ttl. It returns false if the nonce is already recorded.
+	ReserveNonce(ctx context.Context, nonce string, ttl time.Duration) (bool, error)
+}
+
+// RequestSigningConfig holds the configuration for the RequireSignature middleware.
+type RequestSigningConfig struct {
+	// Enabled turns signature checks on. When false the middleware is a pass-through.
+	Enabled bool
+
+	// Secrets are the shared secrets a signature may be made with: the current one first, then
+	// any being rotated out.
+	Secrets [][]byte
+
+	// MaxSkew is how far a request's timestamp may be from the server's clock. Defaults to five
+	// minutes.
+	MaxSkew time.Duration
+
+	// Nonces rejects replayed requests. Without it signatures are checked but a captured request
+	// can be resent until its timestamp expires.
+	Nonces NonceStore
+}
+
+// RequireSignature creates a middleware that rejects requests without a valid HMAC signature
+// (see package requestsign) with 401 Unauthorized, and requests whose nonce was already used
+// within MaxSkew. The body is read to check the signature and replaced for the handler.
+func RequireSignature(cfg RequestSigningConfig) func(http.Handler) http.Handler {
+	if !cfg.Enabled {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	maxSkew := cfg.MaxSkew
+	if maxSkew <= 0 {
+		maxSkew = defaultRequestSigningMaxSkew
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSignedBodyBytes))
+			if err != nil {
+				invalidSignatureResponse(w, "Request body could not be read for signature verification")
+
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			nonce, err := requestsign.Verify(r, body, cfg.Secrets, time.Now(), maxSkew)
+			if err != nil {
+				slog.WarnContext(r.Context(), "rejected request signature", "path", r.URL.Path, "error", err)
+
+				switch {
+				case errors.Is(err, requestsign.ErrMissing):
+					invalidSignatureResponse(w, "Request signature is required")
+				case errors.Is(err, requestsign.ErrExpired):
+					invalidSignatureResponse(w, "Request signature has expired")
+				default:
+					invalidSignatureResponse(w, "Request signature is invalid")
+				}
+
+				return
+			}
+
+			if cfg.Nonces != nil {
+				// Timestamps are accepted up to maxSkew either side of now
+				fresh, err := cfg.Nonces.ReserveNonce(r.Context(), nonce, 2*maxSkew)
+				if err != nil {
+					slog.ErrorContext(r.Context(), "failed to record request nonce", "error", err)
+					respond.Error(w, http.StatusServiceUnavailable, "RETRY_LATER",
+						"Request signature could not be verified; retry later", nil)
+
+					return
+				}
+
+				if !fresh {
+					slog.WarnContext(r.Context(), "rejected replayed signed request", "path", r.URL.Path)
+					invalidSignatureResponse(w, "Request has already been received")
+
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// invalidSignatureResponse writes a 401 Unauthorized JSON response for a failed signature check.
+func invalidSignatureResponse(w http.ResponseWriter, message string) {
+	respond.Error(w, http.StatusUnauthorized, "INVALID_SIGNATURE", message, nil)
+}
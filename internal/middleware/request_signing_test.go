@@ -0,0 +1,146 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/requestsign"
+)
+
+var signingSecret = []byte("0123456789abcdef0123456789abcdef")
+
+type failingNonceStore struct{}
+
+func (failingNonceStore) ReserveNonce(context.Context, string, time.Duration) (bool, error) {
+	return false, errors.New("redis unavailable")
+}
+
+func signedTestRequest(t *testing.T, body string, at time.Time) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/internal/v1/users/resolve-mentions",
+		strings.NewReader(body))
+	require.NoError(t, requestsign.Sign(req, signingSecret, []byte(body), at))
+
+	return req
+}
+
+func serveSigned(cfg middleware.RequestSigningConfig, req *http.Request) *httptest.ResponseRecorder {
+	h := middleware.RequireSignature(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	return rr
+}
+
+func TestRequireSignature(t *testing.T) {
+	t.Parallel()
+
+	cfg := middleware.RequestSigningConfig{
+		Enabled: true,
+		Secrets: [][]byte{signingSecret},
+		Nonces:  memory.New(),
+	}
+
+	t.Run("valid signature", func(t *testing.T) {
+		t.Parallel()
+
+		rr := serveSigned(cfg, signedTestRequest(t, `{"usernames":["chef"]}`, time.Now()))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"usernames":["chef"]}`, rr.Body.String(), "the body is passed on")
+	})
+
+	t.Run("replayed", func(t *testing.T) {
+		t.Parallel()
+
+		req := signedTestRequest(t, `{}`, time.Now())
+		replay := req.Clone(t.Context())
+		replay.Body = io.NopCloser(strings.NewReader(`{}`))
+
+		assert.Equal(t, http.StatusOK, serveSigned(cfg, req).Code)
+
+		rr := serveSigned(cfg, replay)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Contains(t, rr.Body.String(), "already been received")
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		t.Parallel()
+
+		tampered := signedTestRequest(t, `{"usernames":["chef"]}`, time.Now())
+		tampered.Body = io.NopCloser(strings.NewReader(`{"usernames":["admin"]}`))
+
+		expired := signedTestRequest(t, `{}`, time.Now().Add(-time.Hour))
+
+		unsigned := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/internal/v1/users/changes", nil)
+
+		for name, tt := range map[string]struct {
+			req     *http.Request
+			message string
+		}{
+			"tampered": {tampered, "Request signature is invalid"},
+			"expired":  {expired, "Request signature has expired"},
+			"unsigned": {unsigned, "Request signature is required"},
+		} {
+			rr := serveSigned(cfg, tt.req)
+			assert.Equal(t, http.StatusUnauthorized, rr.Code, name)
+			assert.Contains(t, rr.Body.String(), `"error":"INVALID_SIGNATURE"`, name)
+			assert.Contains(t, rr.Body.String(), tt.message, name)
+		}
+	})
+
+	t.Run("previous secret", func(t *testing.T) {
+		t.Parallel()
+
+		rotated := cfg
+		rotated.Secrets = [][]byte{[]byte("fedcba9876543210fedcba9876543210"), signingSecret}
+
+		assert.Equal(t, http.StatusOK, serveSigned(rotated, signedTestRequest(t, `{}`, time.Now())).Code)
+	})
+
+	t.Run("custom skew", func(t *testing.T) {
+		t.Parallel()
+
+		strict := cfg
+		strict.MaxSkew = 10 * time.Second
+
+		rr := serveSigned(strict, signedTestRequest(t, `{}`, time.Now().Add(-time.Minute)))
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("nonce store unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		failing := cfg
+		failing.Nonces = failingNonceStore{}
+
+		rr := serveSigned(failing, signedTestRequest(t, `{}`, time.Now()))
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/internal/v1/users/resolve-mentions",
+			strings.NewReader(`{}`))
+		req.Header.Set(requestsign.HeaderTimestamp, strconv.Itoa(0))
+
+		assert.Equal(t, http.StatusOK, serveSigned(middleware.RequestSigningConfig{}, req).Code)
+	})
+}
@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// roleKey is the context key for the role lookup of the authenticated user.
+const roleKey contextKey = "user_role"
+
+// RoleResolver returns the role stored on a user account.
+type RoleResolver interface {
+	ResolveRole(ctx context.Context, userID uuid.UUID) (dto.UserRole, error)
+}
+
+// ResolveRoles makes the role stored on the authenticated user's account available to Role,
+// IsAdmin and IsModerator. The role is looked up the first time it is checked, so requests that
+// never check it cost nothing; a failed lookup leaves the user with the user role. It must run
+// after Auth. A nil resolver leaves every user with the user role.
+func ResolveRoles(resolver RoleResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if resolver == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authUser, ok := GetAuthenticatedUser(r.Context())
+			if !ok || authUser.IsService || authUser.UserID == uuid.Nil {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			ctx := r.Context()
+			lookup := sync.OnceValue(func() dto.UserRole {
+				role, err := resolver.ResolveRole(ctx, authUser.UserID)
+				if err != nil {
+					slog.WarnContext(ctx, "failed to resolve user role", "error", err)
+
+					return dto.UserRoleUser
+				}
+
+				return role
+			})
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, roleKey, lookup)))
+		})
+	}
+}
+
+// WithRole stores the role of the authenticated user in ctx, in place of looking it up.
+func WithRole(ctx context.Context, role dto.UserRole) context.Context {
+	return context.WithValue(ctx, roleKey, func() dto.UserRole { return role })
+}
+
+// Role returns the role the authenticated user acts with: the service role for service tokens,
+// otherwise the role stored on their account. Users are plain users when no role was resolved,
+// and anonymous requests have no role.
+func Role(ctx context.Context) dto.UserRole {
+	user, ok := GetAuthenticatedUser(ctx)
+	if !ok {
+		return ""
+	}
+
+	if user.IsService {
+		return dto.UserRoleService
+	}
+
+	if lookup, ok := ctx.Value(roleKey).(func() dto.UserRole); ok {
+		return lookup()
+	}
+
+	return dto.UserRoleUser
+}
+
+// IsAdmin reports whether the authenticated user may act as an admin, either through the admin
+// scope on their token or the admin role on their account.
+func IsAdmin(ctx context.Context) bool {
+	return HasScope(ctx, ScopeAdmin) || Role(ctx) == dto.UserRoleAdmin
+}
+
+// IsModerator reports whether the authenticated user may moderate accounts, which admins may too.
+func IsModerator(ctx context.Context) bool {
+	return IsAdmin(ctx) || Role(ctx) == dto.UserRoleModerator
+}
+
+// RequireAdmin refuses requests from anyone but admins, with 401 when there is no authenticated
+// user and 403 otherwise. It must run after ResolveRoles.
+func RequireAdmin(next http.Handler) http.Handler {
+	return requireRole(next, IsAdmin, "Admin scope required")
+}
+
+// RequireModerator refuses requests from anyone but moderators and admins, like RequireAdmin.
+func RequireModerator(next http.Handler) http.Handler {
+	return requireRole(next, IsModerator, "Moderator role required")
+}
+
+func requireRole(next http.Handler, allowed func(context.Context) bool, message string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := GetAuthenticatedUser(r.Context()); !ok {
+			unauthorizedResponse(w, "Authentication required")
+
+			return
+		}
+
+		if !allowed(r.Context()) {
+			forbiddenResponse(w, message)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,169 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+type fakeRoleResolver struct {
+	role    dto.UserRole
+	err     error
+	lookups *atomic.Int32
+}
+
+func (f fakeRoleResolver) ResolveRole(context.Context, uuid.UUID) (dto.UserRole, error) {
+	if f.lookups != nil {
+		f.lookups.Add(1)
+	}
+
+	return f.role, f.err
+}
+
+func TestResolveRoles(t *testing.T) {
+	t.Parallel()
+
+	user := &middleware.AuthenticatedUser{UserID: uuid.New(), ClientID: "web"}
+	scoped := &middleware.AuthenticatedUser{UserID: uuid.New(), ClientID: "console", Scopes: []string{"admin"}}
+	service := &middleware.AuthenticatedUser{ClientID: "meal-planner", IsService: true}
+
+	tests := []struct {
+		name          string
+		caller        *middleware.AuthenticatedUser
+		resolver      middleware.RoleResolver
+		wantRole      dto.UserRole
+		wantAdmin     bool
+		wantModerator bool
+	}{
+		{name: "stored admin", caller: user, resolver: fakeRoleResolver{role: dto.UserRoleAdmin},
+			wantRole: dto.UserRoleAdmin, wantAdmin: true, wantModerator: true},
+		{name: "stored moderator", caller: user, resolver: fakeRoleResolver{role: dto.UserRoleModerator},
+			wantRole: dto.UserRoleModerator, wantModerator: true},
+		{name: "plain user", caller: user, resolver: fakeRoleResolver{role: dto.UserRoleUser},
+			wantRole: dto.UserRoleUser},
+		{name: "failed lookup is a plain user", caller: user,
+			resolver: fakeRoleResolver{err: errors.New("database down")}, wantRole: dto.UserRoleUser},
+		{name: "no resolver", caller: user, wantRole: dto.UserRoleUser},
+		{name: "admin scope", caller: scoped, resolver: fakeRoleResolver{role: dto.UserRoleUser},
+			wantRole: dto.UserRoleUser, wantAdmin: true, wantModerator: true},
+		{name: "service token", caller: service, resolver: fakeRoleResolver{role: dto.UserRoleAdmin},
+			wantRole: dto.UserRoleService},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var role dto.UserRole
+
+			var admin, moderator bool
+
+			next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				role = middleware.Role(r.Context())
+				admin = middleware.IsAdmin(r.Context())
+				moderator = middleware.IsModerator(r.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/users/profile", nil)
+			req = req.WithContext(middleware.SetAuthenticatedUser(req.Context(), tt.caller))
+
+			middleware.ResolveRoles(tt.resolver)(next).ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.wantRole, role)
+			assert.Equal(t, tt.wantAdmin, admin)
+			assert.Equal(t, tt.wantModerator, moderator)
+		})
+	}
+}
+
+func TestResolveRoles_LooksUpOnceWhenChecked(t *testing.T) {
+	t.Parallel()
+
+	var lookups atomic.Int32
+
+	resolver := fakeRoleResolver{role: dto.UserRoleAdmin, lookups: &lookups}
+	caller := &middleware.AuthenticatedUser{UserID: uuid.New(), ClientID: "web"}
+
+	checks := 0
+	next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		for range checks {
+			middleware.IsModerator(r.Context())
+		}
+	})
+
+	for _, n := range []int{0, 3} {
+		checks = n
+		req := httptest.NewRequest(http.MethodGet, "/users/profile", nil)
+		req = req.WithContext(middleware.SetAuthenticatedUser(req.Context(), caller))
+		middleware.ResolveRoles(resolver)(next).ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	assert.Equal(t, int32(1), lookups.Load(), "unchecked requests skip the lookup and checks share one")
+}
+
+func TestRole_Anonymous(t *testing.T) {
+	t.Parallel()
+
+	ctx := middleware.WithRole(t.Context(), dto.UserRoleAdmin)
+
+	assert.Empty(t, middleware.Role(ctx))
+	assert.False(t, middleware.IsAdmin(ctx))
+}
+
+func TestRequireAdmin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		role          dto.UserRole
+		anonymous     bool
+		wantAdmin     int
+		wantModerator int
+	}{
+		{name: "admin", role: dto.UserRoleAdmin, wantAdmin: http.StatusOK, wantModerator: http.StatusOK},
+		{name: "moderator", role: dto.UserRoleModerator,
+			wantAdmin: http.StatusForbidden, wantModerator: http.StatusOK},
+		{name: "plain user", role: dto.UserRoleUser,
+			wantAdmin: http.StatusForbidden, wantModerator: http.StatusForbidden},
+		{name: "anonymous", anonymous: true,
+			wantAdmin: http.StatusUnauthorized, wantModerator: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			for guard, want := range map[string]int{"admin": tt.wantAdmin, "moderator": tt.wantModerator} {
+				req := httptest.NewRequest(http.MethodGet, "/admin/stats", nil)
+				if !tt.anonymous {
+					caller := &middleware.AuthenticatedUser{UserID: uuid.New(), ClientID: "web"}
+					ctx := middleware.SetAuthenticatedUser(req.Context(), caller)
+					req = req.WithContext(middleware.WithRole(ctx, tt.role))
+				}
+
+				handler := middleware.RequireAdmin(next)
+				if guard == "moderator" {
+					handler = middleware.RequireModerator(next)
+				}
+
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, req)
+
+				assert.Equal(t, want, rr.Code, guard)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+const testCSP = "default-src 'none'; frame-ancestors 'none'"
+
+func securityHeaders(t *testing.T, cfg middleware.SecurityHeadersConfig, path string) http.Header {
+	t.Helper()
+
+	h := middleware.SecurityHeaders(cfg)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequestWithContext(t.Context(), http.MethodGet, path, nil))
+
+	return rr.Header()
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	t.Parallel()
+
+	cfg := middleware.SecurityHeadersConfig{
+		Enabled:               true,
+		ContentSecurityPolicy: testCSP,
+		Overrides: []middleware.SecurityHeaderOverride{
+			{PathPrefix: "/swagger", Headers: map[string]string{"Content-Security-Policy": "default-src 'self'"}},
+			{PathPrefix: "/swagger/embed", Headers: map[string]string{"x-frame-options": ""}},
+		},
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		headers := securityHeaders(t, cfg, "/api/v1/user-management/users/me")
+		assert.Equal(t, "nosniff", headers.Get("X-Content-Type-Options"))
+		assert.Equal(t, "DENY", headers.Get("X-Frame-Options"))
+		assert.Equal(t, "no-referrer", headers.Get("Referrer-Policy"))
+		assert.Equal(t, testCSP, headers.Get("Content-Security-Policy"))
+		assert.Empty(t, headers.Get("Strict-Transport-Security"), "no HSTS without TLS")
+	})
+
+	t.Run("route overrides", func(t *testing.T) {
+		t.Parallel()
+
+		headers := securityHeaders(t, cfg, "/swagger/index.html")
+		assert.Equal(t, "default-src 'self'", headers.Get("Content-Security-Policy"))
+		assert.Equal(t, "DENY", headers.Get("X-Frame-Options"))
+
+		headers = securityHeaders(t, cfg, "/swagger/embed/widget")
+		assert.Equal(t, testCSP, headers.Get("Content-Security-Policy"), "the longest prefix wins")
+		assert.NotContains(t, headers, "X-Frame-Options", "an empty value drops the header")
+		assert.Equal(t, "nosniff", headers.Get("X-Content-Type-Options"))
+
+		headers = securityHeaders(t, cfg, "/swaggerish")
+		assert.Equal(t, testCSP, headers.Get("Content-Security-Policy"), "prefixes match whole segments")
+	})
+
+	t.Run("hsts", func(t *testing.T) {
+		t.Parallel()
+
+		tlsCfg := cfg
+		tlsCfg.HSTSMaxAge = 365 * 24 * time.Hour
+
+		headers := securityHeaders(t, tlsCfg, "/health")
+		assert.Equal(t, "max-age=31536000; includeSubDomains", headers.Get("Strict-Transport-Security"))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		headers := securityHeaders(t, middleware.SecurityHeadersConfig{ContentSecurityPolicy: testCSP}, "/health")
+		assert.Empty(t, headers)
+	})
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// SelfPathSegment is the path segment that stands for the authenticated user in user routes,
+// e.g. /users/me/profile.
+const SelfPathSegment = "me"
+
+// ResolveSelf sets the param URL parameter to the authenticated user's ID, so routes mounted under
+// /users/me share the handlers of /users/{user_id} without trusting a client-supplied ID. It must
+// run after Auth. Requests without a user, such as service tokens, are rejected with 401.
+func ResolveSelf(param string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID, ok := GetUserIDFromContext(r.Context())
+			if !ok {
+				unauthorizedResponse(w, "User authentication required")
+
+				return
+			}
+
+			rctx := chi.RouteContext(r.Context())
+			if rctx == nil {
+				rctx = chi.NewRouteContext()
+				r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			}
+
+			// chi resolves URL parameters last-added first, so this shadows any earlier value.
+			rctx.URLParams.Add(param, userID.String())
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
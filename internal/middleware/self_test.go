@@ -0,0 +1,117 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+func TestResolveSelf(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if id := req.Header.Get("X-User-Id"); id != "" {
+				user := &middleware.AuthenticatedUser{UserID: uuid.MustParse(id)}
+				req = req.WithContext(middleware.SetAuthenticatedUser(req.Context(), user))
+			}
+
+			if req.Header.Get("X-Service") != "" {
+				user := &middleware.AuthenticatedUser{ClientID: "svc", IsService: true}
+				req = req.WithContext(middleware.SetAuthenticatedUser(req.Context(), user))
+			}
+
+			next.ServeHTTP(w, req)
+		})
+	})
+
+	echo := func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(chi.URLParam(req, "user_id")))
+	}
+
+	r.Route("/users/me", func(r chi.Router) {
+		r.Use(middleware.ResolveSelf("user_id"))
+		r.Get("/profile", echo)
+	})
+	r.Get("/users/{user_id}/profile", echo)
+
+	tests := []struct {
+		name       string
+		path       string
+		headers    map[string]string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "me resolves to the authenticated user",
+			path:       "/users/me/profile",
+			headers:    map[string]string{"X-User-Id": userID.String()},
+			wantStatus: http.StatusOK,
+			wantBody:   userID.String(),
+		},
+		{
+			name:       "explicit id is untouched",
+			path:       "/users/" + uuid.Nil.String() + "/profile",
+			headers:    map[string]string{"X-User-Id": userID.String()},
+			wantStatus: http.StatusOK,
+			wantBody:   uuid.Nil.String(),
+		},
+		{
+			name:       "anonymous request is rejected",
+			path:       "/users/me/profile",
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   "UNAUTHORIZED",
+		},
+		{
+			name:       "service token has no self",
+			path:       "/users/me/profile",
+			headers:    map[string]string{"X-Service": "1"},
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   "UNAUTHORIZED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.wantBody)
+		})
+	}
+}
+
+func TestResolveSelf_WithoutRouter(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	handler := middleware.ResolveSelf("user_id")(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(chi.URLParam(req, "user_id")))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req = req.WithContext(middleware.SetAuthenticatedUser(req.Context(), &middleware.AuthenticatedUser{UserID: userID}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, userID.String(), rr.Body.String())
+}
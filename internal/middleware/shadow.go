@@ -0,0 +1,217 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	defaultShadowTimeout     = 5 * time.Second
+	defaultShadowMaxInFlight = 16
+	maxShadowDiffPaths       = 20
+)
+
+// ShadowConfig holds the configuration for the Shadow middleware.
+type ShadowConfig struct {
+	// Enabled turns shadowing on. When false, or when Candidate is nil, the middleware is a pass-through.
+	Enabled bool
+
+	// SamplePercent is the percentage (0-100) of read-only requests mirrored to Candidate.
+	SamplePercent float64
+
+	// Candidate is the alternative implementation under evaluation. It receives a copy of each
+	// sampled request; its response is compared with the primary's and then discarded.
+	Candidate http.Handler
+
+	// Timeout bounds each shadow request. Defaults to five seconds.
+	Timeout time.Duration
+
+	// MaxInFlight caps concurrent shadow requests; samples beyond it are skipped so shadowing
+	// cannot pile up under load. Defaults to 16.
+	MaxInFlight int
+
+	// IgnorePaths lists JSON paths (e.g. "$.timestamp") expected to differ between runs.
+	IgnorePaths []string
+}
+
+// Shadow creates a middleware that mirrors a sample of GET and HEAD requests to a candidate
+// handler after the primary response has been written, and logs any difference in status code
+// or JSON body. The client always receives the primary response; the candidate runs in the
+// background and never affects it.
+func Shadow(cfg ShadowConfig) func(http.Handler) http.Handler {
+	if !cfg.Enabled || cfg.Candidate == nil || cfg.SamplePercent <= 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultShadowTimeout
+	}
+
+	maxInFlight := cfg.MaxInFlight
+	if maxInFlight <= 0 {
+		maxInFlight = defaultShadowMaxInFlight
+	}
+
+	inFlight := make(chan struct{}, maxInFlight)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isReadOnly(r.Method) || rand.Float64()*100 >= cfg.SamplePercent { //nolint:gosec // sampling only
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			var primaryBody bytes.Buffer
+
+			ww := chiMiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&primaryBody)
+
+			next.ServeHTTP(ww, r)
+
+			select {
+			case inFlight <- struct{}{}:
+			default:
+				slog.Debug("shadow request skipped: too many in flight", "path", r.URL.Path)
+
+				return
+			}
+
+			// Detach from the client's request so the shadow outlives it, keeping auth context values.
+			// The candidate routes the request itself, so drop the primary router's chi context.
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), timeout)
+			ctx = context.WithValue(ctx, chi.RouteCtxKey, nil)
+			shadowReq := r.Clone(ctx)
+			primaryStatus := ww.Status()
+
+			go func() {
+				defer func() { <-inFlight }()
+				defer cancel()
+
+				runShadow(cfg, shadowReq, primaryStatus, primaryBody.Bytes())
+			}()
+		})
+	}
+}
+
+func isReadOnly(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+func runShadow(cfg ShadowConfig, r *http.Request, primaryStatus int, primaryBody []byte) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			slog.Warn("shadow handler panicked", "path", r.URL.Path, "panic", rec)
+		}
+	}()
+
+	rec := httptest.NewRecorder()
+	cfg.Candidate.ServeHTTP(rec, r)
+
+	diffs := diffResponses(primaryStatus, primaryBody, rec.Code, rec.Body.Bytes(), cfg.IgnorePaths)
+	if len(diffs) == 0 {
+		slog.Debug("shadow response matched", "method", r.Method, "path", r.URL.Path)
+
+		return
+	}
+
+	slog.Warn("shadow response mismatch",
+		"method", r.Method,
+		"path", r.URL.Path,
+		"primary_status", primaryStatus,
+		"shadow_status", rec.Code,
+		"diff", diffs,
+	)
+}
+
+// diffResponses lists the differences between two responses: "status" if the codes differ,
+// then the JSON paths whose values differ. Non-JSON bodies are compared byte for byte ("body").
+// Paths in ignore are skipped. Only paths are reported, never values, so diffs are safe to log.
+func diffResponses(primaryStatus int, primaryBody []byte, shadowStatus int, shadowBody []byte,
+	ignore []string,
+) []string {
+	var diffs []string
+
+	if primaryStatus != shadowStatus {
+		diffs = append(diffs, "status")
+	}
+
+	var primary, shadow any
+
+	if json.Unmarshal(primaryBody, &primary) != nil || json.Unmarshal(shadowBody, &shadow) != nil {
+		if !bytes.Equal(primaryBody, shadowBody) {
+			diffs = append(diffs, "body")
+		}
+
+		return diffs
+	}
+
+	diffs = appendJSONDiffs(diffs, "$", primary, shadow)
+	diffs = slices.DeleteFunc(diffs, func(path string) bool { return slices.Contains(ignore, path) })
+
+	if len(diffs) > maxShadowDiffPaths {
+		diffs = append(diffs[:maxShadowDiffPaths], "...")
+	}
+
+	return diffs
+}
+
+func appendJSONDiffs(diffs []string, path string, a, b any) []string {
+	switch aTyped := a.(type) {
+	case map[string]any:
+		bTyped, ok := b.(map[string]any)
+		if !ok {
+			return append(diffs, path)
+		}
+
+		keys := make([]string, 0, len(aTyped)+len(bTyped))
+		for key := range aTyped {
+			keys = append(keys, key)
+		}
+
+		for key := range bTyped {
+			if _, ok := aTyped[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			diffs = appendJSONDiffs(diffs, path+"."+key, aTyped[key], bTyped[key])
+		}
+
+		return diffs
+	case []any:
+		bTyped, ok := b.([]any)
+		if !ok || len(aTyped) != len(bTyped) {
+			return append(diffs, path)
+		}
+
+		for i := range aTyped {
+			diffs = appendJSONDiffs(diffs, fmt.Sprintf("%s[%d]", path, i), aTyped[i], bTyped[i])
+		}
+
+		return diffs
+	default:
+		if !reflect.DeepEqual(a, b) {
+			return append(diffs, path)
+		}
+
+		return diffs
+	}
+}
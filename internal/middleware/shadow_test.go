@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShadow_MirrorsReadsToCandidate(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	mirrored := make(chan string, 1)
+
+	// The candidate is its own chi router, as a real candidate implementation would be
+	candidate := chi.NewRouter()
+	candidate.Get("/users/{user_id}", func(w http.ResponseWriter, r *http.Request) {
+		authUser, _ := GetUserIDFromContext(r.Context())
+		mirrored <- chi.URLParam(r, "user_id") + " as " + authUser.String()
+
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	primary := chi.NewRouter()
+	primary.Use(Shadow(ShadowConfig{Enabled: true, SamplePercent: 100, Candidate: candidate}))
+	primary.Get("/users/{user_id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/users/abc", nil)
+	req = req.WithContext(SetAuthenticatedUser(req.Context(), &AuthenticatedUser{UserID: userID}))
+
+	rr := httptest.NewRecorder()
+	primary.ServeHTTP(rr, req)
+
+	// The client gets the primary response regardless of the candidate's
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"ok":true}`, rr.Body.String())
+
+	select {
+	case got := <-mirrored:
+		assert.Equal(t, "abc as "+userID.String(), got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not mirrored to the candidate")
+	}
+}
+
+func TestShadow_PassThrough(t *testing.T) {
+	t.Parallel()
+
+	candidate := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("candidate must not be called")
+	})
+
+	tests := []struct {
+		name   string
+		cfg    ShadowConfig
+		method string
+	}{
+		{name: "writes are never mirrored", cfg: ShadowConfig{Enabled: true, SamplePercent: 100, Candidate: candidate},
+			method: http.MethodPost},
+		{name: "disabled", cfg: ShadowConfig{Enabled: false, SamplePercent: 100, Candidate: candidate},
+			method: http.MethodGet},
+		{name: "zero sample", cfg: ShadowConfig{Enabled: true, SamplePercent: 0, Candidate: candidate},
+			method: http.MethodGet},
+		{name: "no candidate", cfg: ShadowConfig{Enabled: true, SamplePercent: 100}, method: http.MethodGet},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := Shadow(tt.cfg)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}))
+
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, httptest.NewRequest(tt.method, "/users", nil))
+			assert.Equal(t, http.StatusNoContent, rr.Code)
+		})
+	}
+
+	// Give any wrongly started shadow goroutine a chance to run
+	time.Sleep(50 * time.Millisecond)
+}
+
+func TestDiffResponses(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		primaryStatus int
+		primary       string
+		shadowStatus  int
+		shadow        string
+		ignore        []string
+		expected      []string
+	}{
+		{
+			name:          "identical JSON in a different key order",
+			primaryStatus: 200, primary: `{"a":1,"b":{"c":[1,2]}}`,
+			shadowStatus: 200, shadow: `{"b":{"c":[1,2]},"a":1}`,
+		},
+		{
+			name:          "status and nested fields",
+			primaryStatus: 200, primary: `{"a":1,"b":{"c":[1,2]},"d":"x"}`,
+			shadowStatus: 404, shadow: `{"a":2,"b":{"c":[1,3]},"e":"x"}`,
+			expected: []string{"status", "$.a", "$.b.c[1]", "$.d", "$.e"},
+		},
+		{
+			name:          "ignored paths",
+			primaryStatus: 200, primary: `{"a":1,"timestamp":"t1"}`,
+			shadowStatus: 200, shadow: `{"a":1,"timestamp":"t2"}`,
+			ignore: []string{"$.timestamp"},
+		},
+		{
+			name:          "non-JSON bodies",
+			primaryStatus: 200, primary: "plain",
+			shadowStatus: 200, shadow: "other",
+			expected: []string{"body"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diffs := diffResponses(tt.primaryStatus, []byte(tt.primary), tt.shadowStatus, []byte(tt.shadow), tt.ignore)
+			if len(tt.expected) == 0 {
+				assert.Empty(t, diffs)
+
+				return
+			}
+
+			assert.Equal(t, tt.expected, diffs)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/querytrace"
+)
+
+const (
+	defaultSlowRequestMaxQueries = 500
+	slowRequestBundlePrefix      = "slow-request-"
+)
+
+// SlowRequestConfig holds the configuration for the SlowRequests middleware.
+type SlowRequestConfig struct {
+	// Enabled turns tracing on. When false, or when Threshold is not positive, the middleware is a
+	// pass-through.
+	Enabled bool
+
+	// Threshold is how long a request may take before a bundle is written for it.
+	Threshold time.Duration
+
+	// Dir is the directory bundles are written to. It is created if missing.
+	Dir string
+
+	// GoroutineDump adds a dump of every goroutine, taken when the request crosses Threshold while
+	// it is still running. Only one dump is taken at a time.
+	GoroutineDump bool
+
+	// MaxBundles keeps the newest bundles only; zero keeps them all.
+	MaxBundles int
+
+	// MaxAge removes bundles older than it; zero keeps them regardless of age.
+	MaxAge time.Duration
+
+	// MaxQueries caps the statements recorded per request; later ones are only counted. Defaults
+	// to 500.
+	MaxQueries int
+}
+
+// slowRequestBundle is the diagnostic bundle written for a slow request.
+type slowRequestBundle struct {
+	RequestID      string             `json:"requestId,omitempty"`
+	Method         string             `json:"method"`
+	Route          string             `json:"route,omitempty"`
+	Path           string             `json:"path"`
+	Status         int                `json:"status"`
+	StartedAt      time.Time          `json:"startedAt"`
+	DurationMs     float64            `json:"durationMs"`
+	Queries        []querytrace.Query `json:"queries"`
+	QueriesDropped int                `json:"queriesDropped,omitempty"`
+	Goroutines     string             `json:"goroutines,omitempty"`
+}
+
+// SlowRequests creates a middleware that writes a diagnostic bundle for every request taking
+// longer than the threshold: its route, status and timing, the SQL statements it ran with their
+// timings, and optionally a goroutine dump. Bundles older than MaxAge or beyond the newest
+// MaxBundles are removed after each write.
+func SlowRequests(cfg SlowRequestConfig) func(http.Handler) http.Handler {
+	if !cfg.Enabled || cfg.Threshold <= 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	maxQueries := cfg.MaxQueries
+	if maxQueries <= 0 {
+		maxQueries = defaultSlowRequestMaxQueries
+	}
+
+	var (
+		dumping atomic.Bool
+		writeMu sync.Mutex
+	)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, queries := querytrace.WithLog(r.Context(), maxQueries)
+			ww := chiMiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			started := time.Now()
+
+			// Dump the goroutines while the request is still stalled, not once it has recovered
+			var goroutines bytes.Buffer
+
+			dumped := make(chan struct{})
+			timer := time.AfterFunc(cfg.Threshold, func() {
+				defer close(dumped)
+
+				if cfg.GoroutineDump && dumping.CompareAndSwap(false, true) {
+					_ = pprof.Lookup("goroutine").WriteTo(&goroutines, 2) //nolint:mnd // 2 prints full stacks
+					dumping.Store(false)
+				}
+			})
+
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			elapsed := time.Since(started)
+
+			// Stopped before it fired: the request finished in time
+			if timer.Stop() {
+				return
+			}
+
+			<-dumped
+
+			bundle := slowRequestBundle{
+				RequestID:  chiMiddleware.GetReqID(r.Context()),
+				Method:     r.Method,
+				Path:       r.URL.Path,
+				Status:     ww.Status(),
+				StartedAt:  started,
+				DurationMs: float64(elapsed) / float64(time.Millisecond),
+				Goroutines: goroutines.String(),
+			}
+			bundle.Queries, bundle.QueriesDropped = queries.Queries()
+
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				bundle.Route = rctx.RoutePattern()
+			}
+
+			writeMu.Lock()
+			defer writeMu.Unlock()
+
+			path, err := writeSlowRequestBundle(cfg, &bundle)
+			if err != nil {
+				slog.WarnContext(r.Context(), "failed to write slow request bundle", "error", err)
+
+				return
+			}
+
+			slog.WarnContext(r.Context(), "slow request",
+				"method", bundle.Method, "route", bundle.Route, "duration", elapsed,
+				"queries", len(bundle.Queries)+bundle.QueriesDropped, "bundle", path)
+		})
+	}
+}
+
+// writeSlowRequestBundle writes bundle to the bundle directory and applies the retention limits.
+func writeSlowRequestBundle(cfg SlowRequestConfig, bundle *slowRequestBundle) (string, error) {
+	err := os.MkdirAll(cfg.Dir, 0o750) //nolint:mnd // owner and group only
+	if err != nil {
+		return "", fmt.Errorf("failed to create bundle directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode bundle: %w", err)
+	}
+
+	// Timestamps first, so names sort oldest first
+	name := slowRequestBundlePrefix + bundle.StartedAt.UTC().Format("20060102T150405.000000000Z")
+	if bundle.RequestID != "" {
+		name += "-" + strings.ReplaceAll(bundle.RequestID, "/", "_")
+	}
+
+	path := filepath.Join(cfg.Dir, name+".json")
+
+	err = os.WriteFile(path, data, 0o600) //nolint:mnd // owner only: bundles hold SQL and stacks
+	if err != nil {
+		return "", fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	pruneSlowRequestBundles(cfg)
+
+	return path, nil
+}
+
+// pruneSlowRequestBundles removes bundles older than MaxAge, then the oldest beyond MaxBundles.
+func pruneSlowRequestBundles(cfg SlowRequestConfig) {
+	entries, err := os.ReadDir(cfg.Dir)
+	if err != nil {
+		slog.Warn("failed to list slow request bundles", "error", err)
+
+		return
+	}
+
+	var bundles []string
+
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasPrefix(entry.Name(), slowRequestBundlePrefix) {
+			bundles = append(bundles, entry.Name())
+		}
+	}
+
+	slices.Sort(bundles)
+
+	var expired []string
+
+	if cfg.MaxAge > 0 {
+		cutoff := time.Now().Add(-cfg.MaxAge)
+
+		for _, name := range bundles {
+			info, err := os.Stat(filepath.Join(cfg.Dir, name))
+			if err == nil && info.ModTime().Before(cutoff) {
+				expired = append(expired, name)
+			}
+		}
+	}
+
+	kept := slices.DeleteFunc(slices.Clone(bundles), func(name string) bool { return slices.Contains(expired, name) })
+	if cfg.MaxBundles > 0 && len(kept) > cfg.MaxBundles {
+		expired = append(expired, kept[:len(kept)-cfg.MaxBundles]...)
+	}
+
+	for _, name := range expired {
+		err := os.Remove(filepath.Join(cfg.Dir, name))
+		if err != nil && !os.IsNotExist(err) {
+			slog.Warn("failed to remove slow request bundle", "bundle", name, "error", err)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/querytrace"
+)
+
+func slowRouter(cfg middleware.SlowRequestConfig) http.Handler {
+	r := chi.NewRouter()
+	r.Use(chiMiddleware.RequestID)
+	r.Use(middleware.SlowRequests(cfg))
+	r.Get("/users/{user_id}/followers", func(w http.ResponseWriter, r *http.Request) {
+		var tracer querytrace.Tracer
+
+		ctx := tracer.TraceQueryStart(r.Context(), nil, pgx.TraceQueryStartData{SQL: "SELECT follower_id FROM follows"})
+		time.Sleep(30 * time.Millisecond)
+		tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+
+		w.WriteHeader(http.StatusOK)
+	})
+	r.Get("/fast", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return r
+}
+
+func bundles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	matches, err := filepath.Glob(filepath.Join(dir, "slow-request-*.json"))
+	require.NoError(t, err)
+
+	return matches
+}
+
+func TestSlowRequests(t *testing.T) {
+	t.Parallel()
+
+	t.Run("writes a bundle for slow requests only", func(t *testing.T) {
+		t.Parallel()
+
+		dir := filepath.Join(t.TempDir(), "slow")
+		h := slowRouter(middleware.SlowRequestConfig{
+			Enabled:       true,
+			Threshold:     10 * time.Millisecond,
+			Dir:           dir,
+			GoroutineDump: true,
+		})
+
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/fast", nil))
+		assert.NoDirExists(t, dir)
+
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/42/followers", nil))
+
+		files := bundles(t, dir)
+		require.Len(t, files, 1)
+
+		data, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+
+		var bundle map[string]any
+		require.NoError(t, json.Unmarshal(data, &bundle))
+
+		assert.Equal(t, "/users/{user_id}/followers", bundle["route"])
+		assert.InDelta(t, float64(http.StatusOK), bundle["status"], 0)
+		assert.NotEmpty(t, bundle["requestId"])
+		assert.Contains(t, bundle["goroutines"], "goroutine")
+
+		queries := bundle["queries"].([]any)
+		require.Len(t, queries, 1)
+		assert.Equal(t, "SELECT follower_id FROM follows", queries[0].(map[string]any)["sql"])
+		assert.Greater(t, queries[0].(map[string]any)["durationMs"], 0.0)
+	})
+
+	t.Run("keeps the newest bundles", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		h := slowRouter(middleware.SlowRequestConfig{
+			Enabled:    true,
+			Threshold:  10 * time.Millisecond,
+			Dir:        dir,
+			MaxBundles: 2,
+		})
+
+		for range 3 {
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/42/followers", nil))
+		}
+
+		assert.Len(t, bundles(t, dir), 2)
+	})
+
+	t.Run("removes expired bundles", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		old := filepath.Join(dir, "slow-request-20200101T000000.000000000Z.json")
+		require.NoError(t, os.WriteFile(old, []byte("{}"), 0o600))
+		require.NoError(t, os.Chtimes(old, time.Now().Add(-48*time.Hour), time.Now().Add(-48*time.Hour)))
+
+		h := slowRouter(middleware.SlowRequestConfig{
+			Enabled:   true,
+			Threshold: 10 * time.Millisecond,
+			Dir:       dir,
+			MaxAge:    24 * time.Hour,
+		})
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/42/followers", nil))
+
+		files := bundles(t, dir)
+		require.Len(t, files, 1)
+		assert.NotEqual(t, old, files[0])
+	})
+}
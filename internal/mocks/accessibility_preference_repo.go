@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// AccessibilityPreferenceRepo is an autogenerated mock type for the AccessibilityPreferenceRepo type
+type AccessibilityPreferenceRepo struct {
+	mock.Mock
+}
+
+type AccessibilityPreferenceRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccessibilityPreferenceRepo) EXPECT() *AccessibilityPreferenceRepo_Expecter {
+	return &AccessibilityPreferenceRepo_Expecter{mock: &_m.Mock}
+}
+
+// GetAccessibilityPreferences provides a mock function with given fields: ctx, userID
+func (_m *AccessibilityPreferenceRepo) GetAccessibilityPreferences(ctx context.Context, userID uuid.UUID) (*dto.AccessibilityPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccessibilityPreferences")
+	}
+
+	var r0 *dto.AccessibilityPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.AccessibilityPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.AccessibilityPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AccessibilityPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessibilityPreferenceRepo_GetAccessibilityPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccessibilityPreferences'
+type AccessibilityPreferenceRepo_GetAccessibilityPreferences_Call struct {
+	*mock.Call
+}
+
+// GetAccessibilityPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AccessibilityPreferenceRepo_Expecter) GetAccessibilityPreferences(ctx interface{}, userID interface{}) *AccessibilityPreferenceRepo_GetAccessibilityPreferences_Call {
+	return &AccessibilityPreferenceRepo_GetAccessibilityPreferences_Call{Call: _e.mock.On("GetAccessibilityPreferences", ctx, userID)}
+}
+
+func (_c *AccessibilityPreferenceRepo_GetAccessibilityPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AccessibilityPreferenceRepo_GetAccessibilityPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccessibilityPreferenceRepo_GetAccessibilityPreferences_Call) Return(_a0 *dto.AccessibilityPreferences, _a1 error) *AccessibilityPreferenceRepo_GetAccessibilityPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessibilityPreferenceRepo_GetAccessibilityPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.AccessibilityPreferences, error)) *AccessibilityPreferenceRepo_GetAccessibilityPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAccessibilityPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *AccessibilityPreferenceRepo) UpdateAccessibilityPreferences(ctx context.Context, userID uuid.UUID, u *dto.AccessibilityPreferencesUpdate) (*dto.AccessibilityPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccessibilityPreferences")
+	}
+
+	var r0 *dto.AccessibilityPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.AccessibilityPreferencesUpdate) (*dto.AccessibilityPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.AccessibilityPreferencesUpdate) *dto.AccessibilityPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AccessibilityPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.AccessibilityPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessibilityPreferenceRepo_UpdateAccessibilityPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccessibilityPreferences'
+type AccessibilityPreferenceRepo_UpdateAccessibilityPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateAccessibilityPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.AccessibilityPreferencesUpdate
+func (_e *AccessibilityPreferenceRepo_Expecter) UpdateAccessibilityPreferences(ctx interface{}, userID interface{}, u interface{}) *AccessibilityPreferenceRepo_UpdateAccessibilityPreferences_Call {
+	return &AccessibilityPreferenceRepo_UpdateAccessibilityPreferences_Call{Call: _e.mock.On("UpdateAccessibilityPreferences", ctx, userID, u)}
+}
+
+func (_c *AccessibilityPreferenceRepo_UpdateAccessibilityPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.AccessibilityPreferencesUpdate)) *AccessibilityPreferenceRepo_UpdateAccessibilityPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.AccessibilityPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *AccessibilityPreferenceRepo_UpdateAccessibilityPreferences_Call) Return(_a0 *dto.AccessibilityPreferences, _a1 error) *AccessibilityPreferenceRepo_UpdateAccessibilityPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessibilityPreferenceRepo_UpdateAccessibilityPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.AccessibilityPreferencesUpdate) (*dto.AccessibilityPreferences, error)) *AccessibilityPreferenceRepo_UpdateAccessibilityPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccessibilityPreferenceRepo creates a new instance of AccessibilityPreferenceRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccessibilityPreferenceRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccessibilityPreferenceRepo {
+	mock := &AccessibilityPreferenceRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
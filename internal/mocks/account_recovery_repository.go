@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AccountRecoveryRepository is an autogenerated mock type for the AccountRecoveryRepository type
+type AccountRecoveryRepository struct {
+	mock.Mock
+}
+
+type AccountRecoveryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccountRecoveryRepository) EXPECT() *AccountRecoveryRepository_Expecter {
+	return &AccountRecoveryRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindDeactivatedUserByEmail provides a mock function with given fields: ctx, email
+func (_m *AccountRecoveryRepository) FindDeactivatedUserByEmail(ctx context.Context, email string) (*dto.User, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDeactivatedUserByEmail")
+	}
+
+	var r0 *dto.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountRecoveryRepository_FindDeactivatedUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDeactivatedUserByEmail'
+type AccountRecoveryRepository_FindDeactivatedUserByEmail_Call struct {
+	*mock.Call
+}
+
+// FindDeactivatedUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AccountRecoveryRepository_Expecter) FindDeactivatedUserByEmail(ctx interface{}, email interface{}) *AccountRecoveryRepository_FindDeactivatedUserByEmail_Call {
+	return &AccountRecoveryRepository_FindDeactivatedUserByEmail_Call{Call: _e.mock.On("FindDeactivatedUserByEmail", ctx, email)}
+}
+
+func (_c *AccountRecoveryRepository_FindDeactivatedUserByEmail_Call) Run(run func(ctx context.Context, email string)) *AccountRecoveryRepository_FindDeactivatedUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AccountRecoveryRepository_FindDeactivatedUserByEmail_Call) Return(_a0 *dto.User, _a1 error) *AccountRecoveryRepository_FindDeactivatedUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountRecoveryRepository_FindDeactivatedUserByEmail_Call) RunAndReturn(run func(context.Context, string) (*dto.User, error)) *AccountRecoveryRepository_FindDeactivatedUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccountRecoveryRepository creates a new instance of AccountRecoveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccountRecoveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccountRecoveryRepository {
+	mock := &AccountRecoveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
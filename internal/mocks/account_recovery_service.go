@@ -0,0 +1,143 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AccountRecoveryService is an autogenerated mock type for the AccountRecoveryService type
+type AccountRecoveryService struct {
+	mock.Mock
+}
+
+type AccountRecoveryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccountRecoveryService) EXPECT() *AccountRecoveryService_Expecter {
+	return &AccountRecoveryService_Expecter{mock: &_m.Mock}
+}
+
+// ConfirmRecovery provides a mock function with given fields: ctx, token
+func (_m *AccountRecoveryService) ConfirmRecovery(ctx context.Context, token string) (*dto.AccountRecoveryResponse, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmRecovery")
+	}
+
+	var r0 *dto.AccountRecoveryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.AccountRecoveryResponse, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.AccountRecoveryResponse); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AccountRecoveryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountRecoveryService_ConfirmRecovery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmRecovery'
+type AccountRecoveryService_ConfirmRecovery_Call struct {
+	*mock.Call
+}
+
+// ConfirmRecovery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *AccountRecoveryService_Expecter) ConfirmRecovery(ctx interface{}, token interface{}) *AccountRecoveryService_ConfirmRecovery_Call {
+	return &AccountRecoveryService_ConfirmRecovery_Call{Call: _e.mock.On("ConfirmRecovery", ctx, token)}
+}
+
+func (_c *AccountRecoveryService_ConfirmRecovery_Call) Run(run func(ctx context.Context, token string)) *AccountRecoveryService_ConfirmRecovery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AccountRecoveryService_ConfirmRecovery_Call) Return(_a0 *dto.AccountRecoveryResponse, _a1 error) *AccountRecoveryService_ConfirmRecovery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountRecoveryService_ConfirmRecovery_Call) RunAndReturn(run func(context.Context, string) (*dto.AccountRecoveryResponse, error)) *AccountRecoveryService_ConfirmRecovery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestRecovery provides a mock function with given fields: ctx, email
+func (_m *AccountRecoveryService) RequestRecovery(ctx context.Context, email string) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestRecovery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountRecoveryService_RequestRecovery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestRecovery'
+type AccountRecoveryService_RequestRecovery_Call struct {
+	*mock.Call
+}
+
+// RequestRecovery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AccountRecoveryService_Expecter) RequestRecovery(ctx interface{}, email interface{}) *AccountRecoveryService_RequestRecovery_Call {
+	return &AccountRecoveryService_RequestRecovery_Call{Call: _e.mock.On("RequestRecovery", ctx, email)}
+}
+
+func (_c *AccountRecoveryService_RequestRecovery_Call) Run(run func(ctx context.Context, email string)) *AccountRecoveryService_RequestRecovery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AccountRecoveryService_RequestRecovery_Call) Return(_a0 error) *AccountRecoveryService_RequestRecovery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountRecoveryService_RequestRecovery_Call) RunAndReturn(run func(context.Context, string) error) *AccountRecoveryService_RequestRecovery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccountRecoveryService creates a new instance of AccountRecoveryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccountRecoveryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccountRecoveryService {
+	mock := &AccountRecoveryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
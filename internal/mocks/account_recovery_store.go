@@ -0,0 +1,148 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// AccountRecoveryStore is an autogenerated mock type for the AccountRecoveryStore type
+type AccountRecoveryStore struct {
+	mock.Mock
+}
+
+type AccountRecoveryStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccountRecoveryStore) EXPECT() *AccountRecoveryStore_Expecter {
+	return &AccountRecoveryStore_Expecter{mock: &_m.Mock}
+}
+
+// StoreRecoveryToken provides a mock function with given fields: ctx, tokenHash, userID, ttl
+func (_m *AccountRecoveryStore) StoreRecoveryToken(ctx context.Context, tokenHash string, userID uuid.UUID, ttl time.Duration) error {
+	ret := _m.Called(ctx, tokenHash, userID, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreRecoveryToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Duration) error); ok {
+		r0 = rf(ctx, tokenHash, userID, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountRecoveryStore_StoreRecoveryToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreRecoveryToken'
+type AccountRecoveryStore_StoreRecoveryToken_Call struct {
+	*mock.Call
+}
+
+// StoreRecoveryToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+//   - userID uuid.UUID
+//   - ttl time.Duration
+func (_e *AccountRecoveryStore_Expecter) StoreRecoveryToken(ctx interface{}, tokenHash interface{}, userID interface{}, ttl interface{}) *AccountRecoveryStore_StoreRecoveryToken_Call {
+	return &AccountRecoveryStore_StoreRecoveryToken_Call{Call: _e.mock.On("StoreRecoveryToken", ctx, tokenHash, userID, ttl)}
+}
+
+func (_c *AccountRecoveryStore_StoreRecoveryToken_Call) Run(run func(ctx context.Context, tokenHash string, userID uuid.UUID, ttl time.Duration)) *AccountRecoveryStore_StoreRecoveryToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *AccountRecoveryStore_StoreRecoveryToken_Call) Return(_a0 error) *AccountRecoveryStore_StoreRecoveryToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountRecoveryStore_StoreRecoveryToken_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Duration) error) *AccountRecoveryStore_StoreRecoveryToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TakeRecoveryToken provides a mock function with given fields: ctx, tokenHash
+func (_m *AccountRecoveryStore) TakeRecoveryToken(ctx context.Context, tokenHash string) (uuid.UUID, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeRecoveryToken")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountRecoveryStore_TakeRecoveryToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeRecoveryToken'
+type AccountRecoveryStore_TakeRecoveryToken_Call struct {
+	*mock.Call
+}
+
+// TakeRecoveryToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *AccountRecoveryStore_Expecter) TakeRecoveryToken(ctx interface{}, tokenHash interface{}) *AccountRecoveryStore_TakeRecoveryToken_Call {
+	return &AccountRecoveryStore_TakeRecoveryToken_Call{Call: _e.mock.On("TakeRecoveryToken", ctx, tokenHash)}
+}
+
+func (_c *AccountRecoveryStore_TakeRecoveryToken_Call) Run(run func(ctx context.Context, tokenHash string)) *AccountRecoveryStore_TakeRecoveryToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AccountRecoveryStore_TakeRecoveryToken_Call) Return(_a0 uuid.UUID, _a1 error) *AccountRecoveryStore_TakeRecoveryToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountRecoveryStore_TakeRecoveryToken_Call) RunAndReturn(run func(context.Context, string) (uuid.UUID, error)) *AccountRecoveryStore_TakeRecoveryToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccountRecoveryStore creates a new instance of AccountRecoveryStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccountRecoveryStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccountRecoveryStore {
+	mock := &AccountRecoveryStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,257 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// AdminNoteRepository is an autogenerated mock type for the AdminNoteRepository type
+type AdminNoteRepository struct {
+	mock.Mock
+}
+
+type AdminNoteRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AdminNoteRepository) EXPECT() *AdminNoteRepository_Expecter {
+	return &AdminNoteRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateNote provides a mock function with given fields: ctx, userID, note
+func (_m *AdminNoteRepository) CreateNote(ctx context.Context, userID uuid.UUID, note *dto.AdminNote) error {
+	ret := _m.Called(ctx, userID, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.AdminNote) error); ok {
+		r0 = rf(ctx, userID, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdminNoteRepository_CreateNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNote'
+type AdminNoteRepository_CreateNote_Call struct {
+	*mock.Call
+}
+
+// CreateNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - note *dto.AdminNote
+func (_e *AdminNoteRepository_Expecter) CreateNote(ctx interface{}, userID interface{}, note interface{}) *AdminNoteRepository_CreateNote_Call {
+	return &AdminNoteRepository_CreateNote_Call{Call: _e.mock.On("CreateNote", ctx, userID, note)}
+}
+
+func (_c *AdminNoteRepository_CreateNote_Call) Run(run func(ctx context.Context, userID uuid.UUID, note *dto.AdminNote)) *AdminNoteRepository_CreateNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.AdminNote))
+	})
+	return _c
+}
+
+func (_c *AdminNoteRepository_CreateNote_Call) Return(_a0 error) *AdminNoteRepository_CreateNote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AdminNoteRepository_CreateNote_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.AdminNote) error) *AdminNoteRepository_CreateNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNote provides a mock function with given fields: ctx, actorID, userID, noteID
+func (_m *AdminNoteRepository) DeleteNote(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, noteID int64) error {
+	ret := _m.Called(ctx, actorID, userID, noteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int64) error); ok {
+		r0 = rf(ctx, actorID, userID, noteID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdminNoteRepository_DeleteNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNote'
+type AdminNoteRepository_DeleteNote_Call struct {
+	*mock.Call
+}
+
+// DeleteNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - noteID int64
+func (_e *AdminNoteRepository_Expecter) DeleteNote(ctx interface{}, actorID interface{}, userID interface{}, noteID interface{}) *AdminNoteRepository_DeleteNote_Call {
+	return &AdminNoteRepository_DeleteNote_Call{Call: _e.mock.On("DeleteNote", ctx, actorID, userID, noteID)}
+}
+
+func (_c *AdminNoteRepository_DeleteNote_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, noteID int64)) *AdminNoteRepository_DeleteNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *AdminNoteRepository_DeleteNote_Call) Return(_a0 error) *AdminNoteRepository_DeleteNote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AdminNoteRepository_DeleteNote_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int64) error) *AdminNoteRepository_DeleteNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNotes provides a mock function with given fields: ctx, userID
+func (_m *AdminNoteRepository) ListNotes(ctx context.Context, userID uuid.UUID) ([]dto.AdminNote, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotes")
+	}
+
+	var r0 []dto.AdminNote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.AdminNote, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.AdminNote); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.AdminNote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminNoteRepository_ListNotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNotes'
+type AdminNoteRepository_ListNotes_Call struct {
+	*mock.Call
+}
+
+// ListNotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AdminNoteRepository_Expecter) ListNotes(ctx interface{}, userID interface{}) *AdminNoteRepository_ListNotes_Call {
+	return &AdminNoteRepository_ListNotes_Call{Call: _e.mock.On("ListNotes", ctx, userID)}
+}
+
+func (_c *AdminNoteRepository_ListNotes_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AdminNoteRepository_ListNotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AdminNoteRepository_ListNotes_Call) Return(_a0 []dto.AdminNote, _a1 error) *AdminNoteRepository_ListNotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminNoteRepository_ListNotes_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.AdminNote, error)) *AdminNoteRepository_ListNotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNote provides a mock function with given fields: ctx, actorID, userID, noteID, update
+func (_m *AdminNoteRepository) UpdateNote(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, noteID int64, update *dto.AdminNoteUpdateRequest) (*dto.AdminNote, error) {
+	ret := _m.Called(ctx, actorID, userID, noteID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNote")
+	}
+
+	var r0 *dto.AdminNote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int64, *dto.AdminNoteUpdateRequest) (*dto.AdminNote, error)); ok {
+		return rf(ctx, actorID, userID, noteID, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int64, *dto.AdminNoteUpdateRequest) *dto.AdminNote); ok {
+		r0 = rf(ctx, actorID, userID, noteID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AdminNote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, int64, *dto.AdminNoteUpdateRequest) error); ok {
+		r1 = rf(ctx, actorID, userID, noteID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminNoteRepository_UpdateNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNote'
+type AdminNoteRepository_UpdateNote_Call struct {
+	*mock.Call
+}
+
+// UpdateNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - noteID int64
+//   - update *dto.AdminNoteUpdateRequest
+func (_e *AdminNoteRepository_Expecter) UpdateNote(ctx interface{}, actorID interface{}, userID interface{}, noteID interface{}, update interface{}) *AdminNoteRepository_UpdateNote_Call {
+	return &AdminNoteRepository_UpdateNote_Call{Call: _e.mock.On("UpdateNote", ctx, actorID, userID, noteID, update)}
+}
+
+func (_c *AdminNoteRepository_UpdateNote_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, noteID int64, update *dto.AdminNoteUpdateRequest)) *AdminNoteRepository_UpdateNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int64), args[4].(*dto.AdminNoteUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *AdminNoteRepository_UpdateNote_Call) Return(_a0 *dto.AdminNote, _a1 error) *AdminNoteRepository_UpdateNote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminNoteRepository_UpdateNote_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int64, *dto.AdminNoteUpdateRequest) (*dto.AdminNote, error)) *AdminNoteRepository_UpdateNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAdminNoteRepository creates a new instance of AdminNoteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAdminNoteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AdminNoteRepository {
+	mock := &AdminNoteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,329 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// AdminNoteService is an autogenerated mock type for the AdminNoteService type
+type AdminNoteService struct {
+	mock.Mock
+}
+
+type AdminNoteService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AdminNoteService) EXPECT() *AdminNoteService_Expecter {
+	return &AdminNoteService_Expecter{mock: &_m.Mock}
+}
+
+// CreateNote provides a mock function with given fields: ctx, actorID, userID, req
+func (_m *AdminNoteService) CreateNote(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, req *dto.AdminNoteRequest) (*dto.AdminNote, error) {
+	ret := _m.Called(ctx, actorID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNote")
+	}
+
+	var r0 *dto.AdminNote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dto.AdminNoteRequest) (*dto.AdminNote, error)); ok {
+		return rf(ctx, actorID, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dto.AdminNoteRequest) *dto.AdminNote); ok {
+		r0 = rf(ctx, actorID, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AdminNote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *dto.AdminNoteRequest) error); ok {
+		r1 = rf(ctx, actorID, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminNoteService_CreateNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNote'
+type AdminNoteService_CreateNote_Call struct {
+	*mock.Call
+}
+
+// CreateNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - req *dto.AdminNoteRequest
+func (_e *AdminNoteService_Expecter) CreateNote(ctx interface{}, actorID interface{}, userID interface{}, req interface{}) *AdminNoteService_CreateNote_Call {
+	return &AdminNoteService_CreateNote_Call{Call: _e.mock.On("CreateNote", ctx, actorID, userID, req)}
+}
+
+func (_c *AdminNoteService_CreateNote_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, req *dto.AdminNoteRequest)) *AdminNoteService_CreateNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*dto.AdminNoteRequest))
+	})
+	return _c
+}
+
+func (_c *AdminNoteService_CreateNote_Call) Return(_a0 *dto.AdminNote, _a1 error) *AdminNoteService_CreateNote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminNoteService_CreateNote_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, *dto.AdminNoteRequest) (*dto.AdminNote, error)) *AdminNoteService_CreateNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNote provides a mock function with given fields: ctx, actorID, userID, noteID
+func (_m *AdminNoteService) DeleteNote(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, noteID int64) error {
+	ret := _m.Called(ctx, actorID, userID, noteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int64) error); ok {
+		r0 = rf(ctx, actorID, userID, noteID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdminNoteService_DeleteNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNote'
+type AdminNoteService_DeleteNote_Call struct {
+	*mock.Call
+}
+
+// DeleteNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - noteID int64
+func (_e *AdminNoteService_Expecter) DeleteNote(ctx interface{}, actorID interface{}, userID interface{}, noteID interface{}) *AdminNoteService_DeleteNote_Call {
+	return &AdminNoteService_DeleteNote_Call{Call: _e.mock.On("DeleteNote", ctx, actorID, userID, noteID)}
+}
+
+func (_c *AdminNoteService_DeleteNote_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, noteID int64)) *AdminNoteService_DeleteNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *AdminNoteService_DeleteNote_Call) Return(_a0 error) *AdminNoteService_DeleteNote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AdminNoteService_DeleteNote_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int64) error) *AdminNoteService_DeleteNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuditTrail provides a mock function with given fields: ctx, userID
+func (_m *AdminNoteService) GetAuditTrail(ctx context.Context, userID uuid.UUID) (*dto.AuditTrailResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditTrail")
+	}
+
+	var r0 *dto.AuditTrailResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.AuditTrailResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.AuditTrailResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AuditTrailResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminNoteService_GetAuditTrail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditTrail'
+type AdminNoteService_GetAuditTrail_Call struct {
+	*mock.Call
+}
+
+// GetAuditTrail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AdminNoteService_Expecter) GetAuditTrail(ctx interface{}, userID interface{}) *AdminNoteService_GetAuditTrail_Call {
+	return &AdminNoteService_GetAuditTrail_Call{Call: _e.mock.On("GetAuditTrail", ctx, userID)}
+}
+
+func (_c *AdminNoteService_GetAuditTrail_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AdminNoteService_GetAuditTrail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AdminNoteService_GetAuditTrail_Call) Return(_a0 *dto.AuditTrailResponse, _a1 error) *AdminNoteService_GetAuditTrail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminNoteService_GetAuditTrail_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.AuditTrailResponse, error)) *AdminNoteService_GetAuditTrail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNotes provides a mock function with given fields: ctx, userID
+func (_m *AdminNoteService) ListNotes(ctx context.Context, userID uuid.UUID) (*dto.AdminNotesResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotes")
+	}
+
+	var r0 *dto.AdminNotesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.AdminNotesResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.AdminNotesResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AdminNotesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminNoteService_ListNotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNotes'
+type AdminNoteService_ListNotes_Call struct {
+	*mock.Call
+}
+
+// ListNotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AdminNoteService_Expecter) ListNotes(ctx interface{}, userID interface{}) *AdminNoteService_ListNotes_Call {
+	return &AdminNoteService_ListNotes_Call{Call: _e.mock.On("ListNotes", ctx, userID)}
+}
+
+func (_c *AdminNoteService_ListNotes_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AdminNoteService_ListNotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AdminNoteService_ListNotes_Call) Return(_a0 *dto.AdminNotesResponse, _a1 error) *AdminNoteService_ListNotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminNoteService_ListNotes_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.AdminNotesResponse, error)) *AdminNoteService_ListNotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNote provides a mock function with given fields: ctx, actorID, userID, noteID, req
+func (_m *AdminNoteService) UpdateNote(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, noteID int64, req *dto.AdminNoteUpdateRequest) (*dto.AdminNote, error) {
+	ret := _m.Called(ctx, actorID, userID, noteID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNote")
+	}
+
+	var r0 *dto.AdminNote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int64, *dto.AdminNoteUpdateRequest) (*dto.AdminNote, error)); ok {
+		return rf(ctx, actorID, userID, noteID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int64, *dto.AdminNoteUpdateRequest) *dto.AdminNote); ok {
+		r0 = rf(ctx, actorID, userID, noteID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AdminNote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, int64, *dto.AdminNoteUpdateRequest) error); ok {
+		r1 = rf(ctx, actorID, userID, noteID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminNoteService_UpdateNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNote'
+type AdminNoteService_UpdateNote_Call struct {
+	*mock.Call
+}
+
+// UpdateNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - noteID int64
+//   - req *dto.AdminNoteUpdateRequest
+func (_e *AdminNoteService_Expecter) UpdateNote(ctx interface{}, actorID interface{}, userID interface{}, noteID interface{}, req interface{}) *AdminNoteService_UpdateNote_Call {
+	return &AdminNoteService_UpdateNote_Call{Call: _e.mock.On("UpdateNote", ctx, actorID, userID, noteID, req)}
+}
+
+func (_c *AdminNoteService_UpdateNote_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, noteID int64, req *dto.AdminNoteUpdateRequest)) *AdminNoteService_UpdateNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int64), args[4].(*dto.AdminNoteUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *AdminNoteService_UpdateNote_Call) Return(_a0 *dto.AdminNote, _a1 error) *AdminNoteService_UpdateNote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminNoteService_UpdateNote_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int64, *dto.AdminNoteUpdateRequest) (*dto.AdminNote, error)) *AdminNoteService_UpdateNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAdminNoteService creates a new instance of AdminNoteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAdminNoteService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AdminNoteService {
+	mock := &AdminNoteService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AdminService is an autogenerated mock type for the AdminService type
+type AdminService struct {
+	mock.Mock
+}
+
+type AdminService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AdminService) EXPECT() *AdminService_Expecter {
+	return &AdminService_Expecter{mock: &_m.Mock}
+}
+
+// ClearCache provides a mock function with given fields: ctx, keyPattern
+func (_m *AdminService) ClearCache(ctx context.Context, keyPattern string) (*dto.CacheClearResponse, error) {
+	ret := _m.Called(ctx, keyPattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearCache")
+	}
+
+	var r0 *dto.CacheClearResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.CacheClearResponse, error)); ok {
+		return rf(ctx, keyPattern)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.CacheClearResponse); ok {
+		r0 = rf(ctx, keyPattern)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CacheClearResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, keyPattern)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminService_ClearCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearCache'
+type AdminService_ClearCache_Call struct {
+	*mock.Call
+}
+
+// ClearCache is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyPattern string
+func (_e *AdminService_Expecter) ClearCache(ctx interface{}, keyPattern interface{}) *AdminService_ClearCache_Call {
+	return &AdminService_ClearCache_Call{Call: _e.mock.On("ClearCache", ctx, keyPattern)}
+}
+
+func (_c *AdminService_ClearCache_Call) Run(run func(ctx context.Context, keyPattern string)) *AdminService_ClearCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AdminService_ClearCache_Call) Return(_a0 *dto.CacheClearResponse, _a1 error) *AdminService_ClearCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminService_ClearCache_Call) RunAndReturn(run func(context.Context, string) (*dto.CacheClearResponse, error)) *AdminService_ClearCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAdminService creates a new instance of AdminService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAdminService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AdminService {
+	mock := &AdminService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
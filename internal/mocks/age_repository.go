@@ -0,0 +1,196 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// AgeRepository is an autogenerated mock type for the AgeRepository type
+type AgeRepository struct {
+	mock.Mock
+}
+
+type AgeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AgeRepository) EXPECT() *AgeRepository_Expecter {
+	return &AgeRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAgeVerification provides a mock function with given fields: ctx, userID
+func (_m *AgeRepository) GetAgeVerification(ctx context.Context, userID uuid.UUID) (*dto.AgeVerification, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAgeVerification")
+	}
+
+	var r0 *dto.AgeVerification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.AgeVerification, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.AgeVerification); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AgeVerification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AgeRepository_GetAgeVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAgeVerification'
+type AgeRepository_GetAgeVerification_Call struct {
+	*mock.Call
+}
+
+// GetAgeVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AgeRepository_Expecter) GetAgeVerification(ctx interface{}, userID interface{}) *AgeRepository_GetAgeVerification_Call {
+	return &AgeRepository_GetAgeVerification_Call{Call: _e.mock.On("GetAgeVerification", ctx, userID)}
+}
+
+func (_c *AgeRepository_GetAgeVerification_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AgeRepository_GetAgeVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AgeRepository_GetAgeVerification_Call) Return(_a0 *dto.AgeVerification, _a1 error) *AgeRepository_GetAgeVerification_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AgeRepository_GetAgeVerification_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.AgeVerification, error)) *AgeRepository_GetAgeVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAgeOverride provides a mock function with given fields: ctx, userID, override
+func (_m *AgeRepository) SetAgeOverride(ctx context.Context, userID uuid.UUID, override dto.AgeOverride) error {
+	ret := _m.Called(ctx, userID, override)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAgeOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.AgeOverride) error); ok {
+		r0 = rf(ctx, userID, override)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AgeRepository_SetAgeOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAgeOverride'
+type AgeRepository_SetAgeOverride_Call struct {
+	*mock.Call
+}
+
+// SetAgeOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - override dto.AgeOverride
+func (_e *AgeRepository_Expecter) SetAgeOverride(ctx interface{}, userID interface{}, override interface{}) *AgeRepository_SetAgeOverride_Call {
+	return &AgeRepository_SetAgeOverride_Call{Call: _e.mock.On("SetAgeOverride", ctx, userID, override)}
+}
+
+func (_c *AgeRepository_SetAgeOverride_Call) Run(run func(ctx context.Context, userID uuid.UUID, override dto.AgeOverride)) *AgeRepository_SetAgeOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.AgeOverride))
+	})
+	return _c
+}
+
+func (_c *AgeRepository_SetAgeOverride_Call) Return(_a0 error) *AgeRepository_SetAgeOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AgeRepository_SetAgeOverride_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.AgeOverride) error) *AgeRepository_SetAgeOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetBirthdate provides a mock function with given fields: ctx, userID, birthdate
+func (_m *AgeRepository) SetBirthdate(ctx context.Context, userID uuid.UUID, birthdate time.Time) error {
+	ret := _m.Called(ctx, userID, birthdate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBirthdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, userID, birthdate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AgeRepository_SetBirthdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBirthdate'
+type AgeRepository_SetBirthdate_Call struct {
+	*mock.Call
+}
+
+// SetBirthdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - birthdate time.Time
+func (_e *AgeRepository_Expecter) SetBirthdate(ctx interface{}, userID interface{}, birthdate interface{}) *AgeRepository_SetBirthdate_Call {
+	return &AgeRepository_SetBirthdate_Call{Call: _e.mock.On("SetBirthdate", ctx, userID, birthdate)}
+}
+
+func (_c *AgeRepository_SetBirthdate_Call) Run(run func(ctx context.Context, userID uuid.UUID, birthdate time.Time)) *AgeRepository_SetBirthdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AgeRepository_SetBirthdate_Call) Return(_a0 error) *AgeRepository_SetBirthdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AgeRepository_SetBirthdate_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *AgeRepository_SetBirthdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAgeRepository creates a new instance of AgeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAgeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AgeRepository {
+	mock := &AgeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
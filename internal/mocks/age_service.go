@@ -0,0 +1,218 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// AgeService is an autogenerated mock type for the AgeService type
+type AgeService struct {
+	mock.Mock
+}
+
+type AgeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AgeService) EXPECT() *AgeService_Expecter {
+	return &AgeService_Expecter{mock: &_m.Mock}
+}
+
+// GetAgeStatus provides a mock function with given fields: ctx, userID
+func (_m *AgeService) GetAgeStatus(ctx context.Context, userID uuid.UUID) (*dto.AgeStatusResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAgeStatus")
+	}
+
+	var r0 *dto.AgeStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.AgeStatusResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.AgeStatusResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AgeStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AgeService_GetAgeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAgeStatus'
+type AgeService_GetAgeStatus_Call struct {
+	*mock.Call
+}
+
+// GetAgeStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AgeService_Expecter) GetAgeStatus(ctx interface{}, userID interface{}) *AgeService_GetAgeStatus_Call {
+	return &AgeService_GetAgeStatus_Call{Call: _e.mock.On("GetAgeStatus", ctx, userID)}
+}
+
+func (_c *AgeService_GetAgeStatus_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AgeService_GetAgeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AgeService_GetAgeStatus_Call) Return(_a0 *dto.AgeStatusResponse, _a1 error) *AgeService_GetAgeStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AgeService_GetAgeStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.AgeStatusResponse, error)) *AgeService_GetAgeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAgeOverride provides a mock function with given fields: ctx, userID, override
+func (_m *AgeService) SetAgeOverride(ctx context.Context, userID uuid.UUID, override dto.AgeOverride) (*dto.AgeStatusResponse, error) {
+	ret := _m.Called(ctx, userID, override)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAgeOverride")
+	}
+
+	var r0 *dto.AgeStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.AgeOverride) (*dto.AgeStatusResponse, error)); ok {
+		return rf(ctx, userID, override)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.AgeOverride) *dto.AgeStatusResponse); ok {
+		r0 = rf(ctx, userID, override)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AgeStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.AgeOverride) error); ok {
+		r1 = rf(ctx, userID, override)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AgeService_SetAgeOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAgeOverride'
+type AgeService_SetAgeOverride_Call struct {
+	*mock.Call
+}
+
+// SetAgeOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - override dto.AgeOverride
+func (_e *AgeService_Expecter) SetAgeOverride(ctx interface{}, userID interface{}, override interface{}) *AgeService_SetAgeOverride_Call {
+	return &AgeService_SetAgeOverride_Call{Call: _e.mock.On("SetAgeOverride", ctx, userID, override)}
+}
+
+func (_c *AgeService_SetAgeOverride_Call) Run(run func(ctx context.Context, userID uuid.UUID, override dto.AgeOverride)) *AgeService_SetAgeOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.AgeOverride))
+	})
+	return _c
+}
+
+func (_c *AgeService_SetAgeOverride_Call) Return(_a0 *dto.AgeStatusResponse, _a1 error) *AgeService_SetAgeOverride_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AgeService_SetAgeOverride_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.AgeOverride) (*dto.AgeStatusResponse, error)) *AgeService_SetAgeOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetBirthdate provides a mock function with given fields: ctx, userID, req
+func (_m *AgeService) SetBirthdate(ctx context.Context, userID uuid.UUID, req *dto.BirthdateRequest) (*dto.AgeStatusResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBirthdate")
+	}
+
+	var r0 *dto.AgeStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.BirthdateRequest) (*dto.AgeStatusResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.BirthdateRequest) *dto.AgeStatusResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AgeStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.BirthdateRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AgeService_SetBirthdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBirthdate'
+type AgeService_SetBirthdate_Call struct {
+	*mock.Call
+}
+
+// SetBirthdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - req *dto.BirthdateRequest
+func (_e *AgeService_Expecter) SetBirthdate(ctx interface{}, userID interface{}, req interface{}) *AgeService_SetBirthdate_Call {
+	return &AgeService_SetBirthdate_Call{Call: _e.mock.On("SetBirthdate", ctx, userID, req)}
+}
+
+func (_c *AgeService_SetBirthdate_Call) Run(run func(ctx context.Context, userID uuid.UUID, req *dto.BirthdateRequest)) *AgeService_SetBirthdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.BirthdateRequest))
+	})
+	return _c
+}
+
+func (_c *AgeService_SetBirthdate_Call) Return(_a0 *dto.AgeStatusResponse, _a1 error) *AgeService_SetBirthdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AgeService_SetBirthdate_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.BirthdateRequest) (*dto.AgeStatusResponse, error)) *AgeService_SetBirthdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAgeService creates a new instance of AgeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAgeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AgeService {
+	mock := &AgeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
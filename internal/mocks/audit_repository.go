@@ -0,0 +1,99 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// AuditRepository is an autogenerated mock type for the AuditRepository type
+type AuditRepository struct {
+	mock.Mock
+}
+
+type AuditRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditRepository) EXPECT() *AuditRepository_Expecter {
+	return &AuditRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAuditTrail provides a mock function with given fields: ctx, userID, limit
+func (_m *AuditRepository) GetAuditTrail(ctx context.Context, userID uuid.UUID, limit int) ([]dto.AuditEntry, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditTrail")
+	}
+
+	var r0 []dto.AuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]dto.AuditEntry, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []dto.AuditEntry); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.AuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditRepository_GetAuditTrail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditTrail'
+type AuditRepository_GetAuditTrail_Call struct {
+	*mock.Call
+}
+
+// GetAuditTrail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+func (_e *AuditRepository_Expecter) GetAuditTrail(ctx interface{}, userID interface{}, limit interface{}) *AuditRepository_GetAuditTrail_Call {
+	return &AuditRepository_GetAuditTrail_Call{Call: _e.mock.On("GetAuditTrail", ctx, userID, limit)}
+}
+
+func (_c *AuditRepository_GetAuditTrail_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int)) *AuditRepository_GetAuditTrail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *AuditRepository_GetAuditTrail_Call) Return(_a0 []dto.AuditEntry, _a1 error) *AuditRepository_GetAuditTrail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditRepository_GetAuditTrail_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]dto.AuditEntry, error)) *AuditRepository_GetAuditTrail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditRepository creates a new instance of AuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditRepository {
+	mock := &AuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,144 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// BadgeQueue is an autogenerated mock type for the BadgeQueue type
+type BadgeQueue struct {
+	mock.Mock
+}
+
+type BadgeQueue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BadgeQueue) EXPECT() *BadgeQueue_Expecter {
+	return &BadgeQueue_Expecter{mock: &_m.Mock}
+}
+
+// DequeueBadgeEvaluations provides a mock function with given fields: ctx, limit
+func (_m *BadgeQueue) DequeueBadgeEvaluations(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DequeueBadgeEvaluations")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]uuid.UUID, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []uuid.UUID); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeQueue_DequeueBadgeEvaluations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DequeueBadgeEvaluations'
+type BadgeQueue_DequeueBadgeEvaluations_Call struct {
+	*mock.Call
+}
+
+// DequeueBadgeEvaluations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *BadgeQueue_Expecter) DequeueBadgeEvaluations(ctx interface{}, limit interface{}) *BadgeQueue_DequeueBadgeEvaluations_Call {
+	return &BadgeQueue_DequeueBadgeEvaluations_Call{Call: _e.mock.On("DequeueBadgeEvaluations", ctx, limit)}
+}
+
+func (_c *BadgeQueue_DequeueBadgeEvaluations_Call) Run(run func(ctx context.Context, limit int)) *BadgeQueue_DequeueBadgeEvaluations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *BadgeQueue_DequeueBadgeEvaluations_Call) Return(_a0 []uuid.UUID, _a1 error) *BadgeQueue_DequeueBadgeEvaluations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeQueue_DequeueBadgeEvaluations_Call) RunAndReturn(run func(context.Context, int) ([]uuid.UUID, error)) *BadgeQueue_DequeueBadgeEvaluations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueBadgeEvaluation provides a mock function with given fields: ctx, userID
+func (_m *BadgeQueue) EnqueueBadgeEvaluation(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueBadgeEvaluation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BadgeQueue_EnqueueBadgeEvaluation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueBadgeEvaluation'
+type BadgeQueue_EnqueueBadgeEvaluation_Call struct {
+	*mock.Call
+}
+
+// EnqueueBadgeEvaluation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *BadgeQueue_Expecter) EnqueueBadgeEvaluation(ctx interface{}, userID interface{}) *BadgeQueue_EnqueueBadgeEvaluation_Call {
+	return &BadgeQueue_EnqueueBadgeEvaluation_Call{Call: _e.mock.On("EnqueueBadgeEvaluation", ctx, userID)}
+}
+
+func (_c *BadgeQueue_EnqueueBadgeEvaluation_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *BadgeQueue_EnqueueBadgeEvaluation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *BadgeQueue_EnqueueBadgeEvaluation_Call) Return(_a0 error) *BadgeQueue_EnqueueBadgeEvaluation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BadgeQueue_EnqueueBadgeEvaluation_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *BadgeQueue_EnqueueBadgeEvaluation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBadgeQueue creates a new instance of BadgeQueue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBadgeQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BadgeQueue {
+	mock := &BadgeQueue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
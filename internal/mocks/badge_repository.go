@@ -0,0 +1,280 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// BadgeRepository is an autogenerated mock type for the BadgeRepository type
+type BadgeRepository struct {
+	mock.Mock
+}
+
+type BadgeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BadgeRepository) EXPECT() *BadgeRepository_Expecter {
+	return &BadgeRepository_Expecter{mock: &_m.Mock}
+}
+
+// AwardBadges provides a mock function with given fields: ctx, userID, badgeIDs
+func (_m *BadgeRepository) AwardBadges(ctx context.Context, userID uuid.UUID, badgeIDs []string) ([]string, error) {
+	ret := _m.Called(ctx, userID, badgeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AwardBadges")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) ([]string, error)); ok {
+		return rf(ctx, userID, badgeIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) []string); ok {
+		r0 = rf(ctx, userID, badgeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []string) error); ok {
+		r1 = rf(ctx, userID, badgeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeRepository_AwardBadges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AwardBadges'
+type BadgeRepository_AwardBadges_Call struct {
+	*mock.Call
+}
+
+// AwardBadges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - badgeIDs []string
+func (_e *BadgeRepository_Expecter) AwardBadges(ctx interface{}, userID interface{}, badgeIDs interface{}) *BadgeRepository_AwardBadges_Call {
+	return &BadgeRepository_AwardBadges_Call{Call: _e.mock.On("AwardBadges", ctx, userID, badgeIDs)}
+}
+
+func (_c *BadgeRepository_AwardBadges_Call) Run(run func(ctx context.Context, userID uuid.UUID, badgeIDs []string)) *BadgeRepository_AwardBadges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *BadgeRepository_AwardBadges_Call) Return(_a0 []string, _a1 error) *BadgeRepository_AwardBadges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeRepository_AwardBadges_Call) RunAndReturn(run func(context.Context, uuid.UUID, []string) ([]string, error)) *BadgeRepository_AwardBadges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBadgeMetrics provides a mock function with given fields: ctx, userID
+func (_m *BadgeRepository) GetBadgeMetrics(ctx context.Context, userID uuid.UUID) (*dto.BadgeMetrics, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBadgeMetrics")
+	}
+
+	var r0 *dto.BadgeMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.BadgeMetrics, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.BadgeMetrics); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BadgeMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeRepository_GetBadgeMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBadgeMetrics'
+type BadgeRepository_GetBadgeMetrics_Call struct {
+	*mock.Call
+}
+
+// GetBadgeMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *BadgeRepository_Expecter) GetBadgeMetrics(ctx interface{}, userID interface{}) *BadgeRepository_GetBadgeMetrics_Call {
+	return &BadgeRepository_GetBadgeMetrics_Call{Call: _e.mock.On("GetBadgeMetrics", ctx, userID)}
+}
+
+func (_c *BadgeRepository_GetBadgeMetrics_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *BadgeRepository_GetBadgeMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *BadgeRepository_GetBadgeMetrics_Call) Return(_a0 *dto.BadgeMetrics, _a1 error) *BadgeRepository_GetBadgeMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeRepository_GetBadgeMetrics_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.BadgeMetrics, error)) *BadgeRepository_GetBadgeMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserBadges provides a mock function with given fields: ctx, userID
+func (_m *BadgeRepository) ListUserBadges(ctx context.Context, userID uuid.UUID) ([]dto.EarnedBadge, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserBadges")
+	}
+
+	var r0 []dto.EarnedBadge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.EarnedBadge, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.EarnedBadge); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.EarnedBadge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeRepository_ListUserBadges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserBadges'
+type BadgeRepository_ListUserBadges_Call struct {
+	*mock.Call
+}
+
+// ListUserBadges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *BadgeRepository_Expecter) ListUserBadges(ctx interface{}, userID interface{}) *BadgeRepository_ListUserBadges_Call {
+	return &BadgeRepository_ListUserBadges_Call{Call: _e.mock.On("ListUserBadges", ctx, userID)}
+}
+
+func (_c *BadgeRepository_ListUserBadges_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *BadgeRepository_ListUserBadges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *BadgeRepository_ListUserBadges_Call) Return(_a0 []dto.EarnedBadge, _a1 error) *BadgeRepository_ListUserBadges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeRepository_ListUserBadges_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.EarnedBadge, error)) *BadgeRepository_ListUserBadges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsersMissingBadge provides a mock function with given fields: ctx, badgeID, joinedBefore, limit
+func (_m *BadgeRepository) ListUsersMissingBadge(ctx context.Context, badgeID string, joinedBefore time.Time, limit int) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, badgeID, joinedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsersMissingBadge")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, int) ([]uuid.UUID, error)); ok {
+		return rf(ctx, badgeID, joinedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, int) []uuid.UUID); ok {
+		r0 = rf(ctx, badgeID, joinedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, int) error); ok {
+		r1 = rf(ctx, badgeID, joinedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeRepository_ListUsersMissingBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersMissingBadge'
+type BadgeRepository_ListUsersMissingBadge_Call struct {
+	*mock.Call
+}
+
+// ListUsersMissingBadge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - badgeID string
+//   - joinedBefore time.Time
+//   - limit int
+func (_e *BadgeRepository_Expecter) ListUsersMissingBadge(ctx interface{}, badgeID interface{}, joinedBefore interface{}, limit interface{}) *BadgeRepository_ListUsersMissingBadge_Call {
+	return &BadgeRepository_ListUsersMissingBadge_Call{Call: _e.mock.On("ListUsersMissingBadge", ctx, badgeID, joinedBefore, limit)}
+}
+
+func (_c *BadgeRepository_ListUsersMissingBadge_Call) Run(run func(ctx context.Context, badgeID string, joinedBefore time.Time, limit int)) *BadgeRepository_ListUsersMissingBadge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *BadgeRepository_ListUsersMissingBadge_Call) Return(_a0 []uuid.UUID, _a1 error) *BadgeRepository_ListUsersMissingBadge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeRepository_ListUsersMissingBadge_Call) RunAndReturn(run func(context.Context, string, time.Time, int) ([]uuid.UUID, error)) *BadgeRepository_ListUsersMissingBadge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBadgeRepository creates a new instance of BadgeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBadgeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BadgeRepository {
+	mock := &BadgeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
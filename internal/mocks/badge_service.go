@@ -0,0 +1,206 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// BadgeService is an autogenerated mock type for the BadgeService type
+type BadgeService struct {
+	mock.Mock
+}
+
+type BadgeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BadgeService) EXPECT() *BadgeService_Expecter {
+	return &BadgeService_Expecter{mock: &_m.Mock}
+}
+
+// GetBadges provides a mock function with given fields: ctx, requesterID, userID
+func (_m *BadgeService) GetBadges(ctx context.Context, requesterID uuid.UUID, userID uuid.UUID) (*dto.UserBadgesResponse, error) {
+	ret := _m.Called(ctx, requesterID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBadges")
+	}
+
+	var r0 *dto.UserBadgesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*dto.UserBadgesResponse, error)); ok {
+		return rf(ctx, requesterID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *dto.UserBadgesResponse); ok {
+		r0 = rf(ctx, requesterID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserBadgesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, requesterID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeService_GetBadges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBadges'
+type BadgeService_GetBadges_Call struct {
+	*mock.Call
+}
+
+// GetBadges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - userID uuid.UUID
+func (_e *BadgeService_Expecter) GetBadges(ctx interface{}, requesterID interface{}, userID interface{}) *BadgeService_GetBadges_Call {
+	return &BadgeService_GetBadges_Call{Call: _e.mock.On("GetBadges", ctx, requesterID, userID)}
+}
+
+func (_c *BadgeService_GetBadges_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, userID uuid.UUID)) *BadgeService_GetBadges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *BadgeService_GetBadges_Call) Return(_a0 *dto.UserBadgesResponse, _a1 error) *BadgeService_GetBadges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeService_GetBadges_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*dto.UserBadgesResponse, error)) *BadgeService_GetBadges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEarnedBadges provides a mock function with given fields: ctx, userID
+func (_m *BadgeService) ListEarnedBadges(ctx context.Context, userID uuid.UUID) ([]dto.Badge, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEarnedBadges")
+	}
+
+	var r0 []dto.Badge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.Badge, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.Badge); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Badge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeService_ListEarnedBadges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEarnedBadges'
+type BadgeService_ListEarnedBadges_Call struct {
+	*mock.Call
+}
+
+// ListEarnedBadges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *BadgeService_Expecter) ListEarnedBadges(ctx interface{}, userID interface{}) *BadgeService_ListEarnedBadges_Call {
+	return &BadgeService_ListEarnedBadges_Call{Call: _e.mock.On("ListEarnedBadges", ctx, userID)}
+}
+
+func (_c *BadgeService_ListEarnedBadges_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *BadgeService_ListEarnedBadges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *BadgeService_ListEarnedBadges_Call) Return(_a0 []dto.Badge, _a1 error) *BadgeService_ListEarnedBadges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeService_ListEarnedBadges_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.Badge, error)) *BadgeService_ListEarnedBadges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordEvent provides a mock function with given fields: ctx, userID, event
+func (_m *BadgeService) RecordEvent(ctx context.Context, userID uuid.UUID, event dto.BadgeEvent) error {
+	ret := _m.Called(ctx, userID, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.BadgeEvent) error); ok {
+		r0 = rf(ctx, userID, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BadgeService_RecordEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordEvent'
+type BadgeService_RecordEvent_Call struct {
+	*mock.Call
+}
+
+// RecordEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - event dto.BadgeEvent
+func (_e *BadgeService_Expecter) RecordEvent(ctx interface{}, userID interface{}, event interface{}) *BadgeService_RecordEvent_Call {
+	return &BadgeService_RecordEvent_Call{Call: _e.mock.On("RecordEvent", ctx, userID, event)}
+}
+
+func (_c *BadgeService_RecordEvent_Call) Run(run func(ctx context.Context, userID uuid.UUID, event dto.BadgeEvent)) *BadgeService_RecordEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.BadgeEvent))
+	})
+	return _c
+}
+
+func (_c *BadgeService_RecordEvent_Call) Return(_a0 error) *BadgeService_RecordEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BadgeService_RecordEvent_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.BadgeEvent) error) *BadgeService_RecordEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBadgeService creates a new instance of BadgeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBadgeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BadgeService {
+	mock := &BadgeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChangeFeedService is an autogenerated mock type for the ChangeFeedService type
+type ChangeFeedService struct {
+	mock.Mock
+}
+
+type ChangeFeedService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ChangeFeedService) EXPECT() *ChangeFeedService_Expecter {
+	return &ChangeFeedService_Expecter{mock: &_m.Mock}
+}
+
+// GetUserChanges provides a mock function with given fields: ctx, since, limit
+func (_m *ChangeFeedService) GetUserChanges(ctx context.Context, since int64, limit int) (*dto.UserChangesResponse, error) {
+	ret := _m.Called(ctx, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserChanges")
+	}
+
+	var r0 *dto.UserChangesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) (*dto.UserChangesResponse, error)); ok {
+		return rf(ctx, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) *dto.UserChangesResponse); ok {
+		r0 = rf(ctx, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserChangesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangeFeedService_GetUserChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserChanges'
+type ChangeFeedService_GetUserChanges_Call struct {
+	*mock.Call
+}
+
+// GetUserChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since int64
+//   - limit int
+func (_e *ChangeFeedService_Expecter) GetUserChanges(ctx interface{}, since interface{}, limit interface{}) *ChangeFeedService_GetUserChanges_Call {
+	return &ChangeFeedService_GetUserChanges_Call{Call: _e.mock.On("GetUserChanges", ctx, since, limit)}
+}
+
+func (_c *ChangeFeedService_GetUserChanges_Call) Run(run func(ctx context.Context, since int64, limit int)) *ChangeFeedService_GetUserChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ChangeFeedService_GetUserChanges_Call) Return(_a0 *dto.UserChangesResponse, _a1 error) *ChangeFeedService_GetUserChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChangeFeedService_GetUserChanges_Call) RunAndReturn(run func(context.Context, int64, int) (*dto.UserChangesResponse, error)) *ChangeFeedService_GetUserChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewChangeFeedService creates a new instance of ChangeFeedService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChangeFeedService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChangeFeedService {
+	mock := &ChangeFeedService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
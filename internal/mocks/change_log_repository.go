@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ChangeLogRepository is an autogenerated mock type for the ChangeLogRepository type
+type ChangeLogRepository struct {
+	mock.Mock
+}
+
+type ChangeLogRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ChangeLogRepository) EXPECT() *ChangeLogRepository_Expecter {
+	return &ChangeLogRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetChangesSince provides a mock function with given fields: ctx, cursor, limit
+func (_m *ChangeLogRepository) GetChangesSince(ctx context.Context, cursor int64, limit int) ([]dto.UserChange, error) {
+	ret := _m.Called(ctx, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChangesSince")
+	}
+
+	var r0 []dto.UserChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) ([]dto.UserChange, error)); ok {
+		return rf(ctx, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []dto.UserChange); ok {
+		r0 = rf(ctx, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangeLogRepository_GetChangesSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChangesSince'
+type ChangeLogRepository_GetChangesSince_Call struct {
+	*mock.Call
+}
+
+// GetChangesSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cursor int64
+//   - limit int
+func (_e *ChangeLogRepository_Expecter) GetChangesSince(ctx interface{}, cursor interface{}, limit interface{}) *ChangeLogRepository_GetChangesSince_Call {
+	return &ChangeLogRepository_GetChangesSince_Call{Call: _e.mock.On("GetChangesSince", ctx, cursor, limit)}
+}
+
+func (_c *ChangeLogRepository_GetChangesSince_Call) Run(run func(ctx context.Context, cursor int64, limit int)) *ChangeLogRepository_GetChangesSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ChangeLogRepository_GetChangesSince_Call) Return(_a0 []dto.UserChange, _a1 error) *ChangeLogRepository_GetChangesSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChangeLogRepository_GetChangesSince_Call) RunAndReturn(run func(context.Context, int64, int) ([]dto.UserChange, error)) *ChangeLogRepository_GetChangesSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestChangesForUser provides a mock function with given fields: ctx, userID
+func (_m *ChangeLogRepository) GetLatestChangesForUser(ctx context.Context, userID uuid.UUID) ([]dto.UserChange, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestChangesForUser")
+	}
+
+	var r0 []dto.UserChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.UserChange, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.UserChange); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangeLogRepository_GetLatestChangesForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestChangesForUser'
+type ChangeLogRepository_GetLatestChangesForUser_Call struct {
+	*mock.Call
+}
+
+// GetLatestChangesForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ChangeLogRepository_Expecter) GetLatestChangesForUser(ctx interface{}, userID interface{}) *ChangeLogRepository_GetLatestChangesForUser_Call {
+	return &ChangeLogRepository_GetLatestChangesForUser_Call{Call: _e.mock.On("GetLatestChangesForUser", ctx, userID)}
+}
+
+func (_c *ChangeLogRepository_GetLatestChangesForUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ChangeLogRepository_GetLatestChangesForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ChangeLogRepository_GetLatestChangesForUser_Call) Return(_a0 []dto.UserChange, _a1 error) *ChangeLogRepository_GetLatestChangesForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChangeLogRepository_GetLatestChangesForUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.UserChange, error)) *ChangeLogRepository_GetLatestChangesForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewChangeLogRepository creates a new instance of ChangeLogRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChangeLogRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChangeLogRepository {
+	mock := &ChangeLogRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
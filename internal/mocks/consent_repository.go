@@ -0,0 +1,205 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ConsentRepository is an autogenerated mock type for the ConsentRepository type
+type ConsentRepository struct {
+	mock.Mock
+}
+
+type ConsentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ConsentRepository) EXPECT() *ConsentRepository_Expecter {
+	return &ConsentRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetConsentHistory provides a mock function with given fields: ctx, userID
+func (_m *ConsentRepository) GetConsentHistory(ctx context.Context, userID uuid.UUID) ([]dto.ConsentRecord, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsentHistory")
+	}
+
+	var r0 []dto.ConsentRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.ConsentRecord, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.ConsentRecord); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ConsentRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentRepository_GetConsentHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConsentHistory'
+type ConsentRepository_GetConsentHistory_Call struct {
+	*mock.Call
+}
+
+// GetConsentHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ConsentRepository_Expecter) GetConsentHistory(ctx interface{}, userID interface{}) *ConsentRepository_GetConsentHistory_Call {
+	return &ConsentRepository_GetConsentHistory_Call{Call: _e.mock.On("GetConsentHistory", ctx, userID)}
+}
+
+func (_c *ConsentRepository_GetConsentHistory_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ConsentRepository_GetConsentHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ConsentRepository_GetConsentHistory_Call) Return(_a0 []dto.ConsentRecord, _a1 error) *ConsentRepository_GetConsentHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentRepository_GetConsentHistory_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.ConsentRecord, error)) *ConsentRepository_GetConsentHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCurrentConsents provides a mock function with given fields: ctx, userID
+func (_m *ConsentRepository) GetCurrentConsents(ctx context.Context, userID uuid.UUID) ([]dto.ConsentRecord, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrentConsents")
+	}
+
+	var r0 []dto.ConsentRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.ConsentRecord, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.ConsentRecord); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ConsentRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentRepository_GetCurrentConsents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentConsents'
+type ConsentRepository_GetCurrentConsents_Call struct {
+	*mock.Call
+}
+
+// GetCurrentConsents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ConsentRepository_Expecter) GetCurrentConsents(ctx interface{}, userID interface{}) *ConsentRepository_GetCurrentConsents_Call {
+	return &ConsentRepository_GetCurrentConsents_Call{Call: _e.mock.On("GetCurrentConsents", ctx, userID)}
+}
+
+func (_c *ConsentRepository_GetCurrentConsents_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ConsentRepository_GetCurrentConsents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ConsentRepository_GetCurrentConsents_Call) Return(_a0 []dto.ConsentRecord, _a1 error) *ConsentRepository_GetCurrentConsents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentRepository_GetCurrentConsents_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.ConsentRecord, error)) *ConsentRepository_GetCurrentConsents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordConsent provides a mock function with given fields: ctx, userID, record
+func (_m *ConsentRepository) RecordConsent(ctx context.Context, userID uuid.UUID, record *dto.ConsentRecord) error {
+	ret := _m.Called(ctx, userID, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordConsent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.ConsentRecord) error); ok {
+		r0 = rf(ctx, userID, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsentRepository_RecordConsent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordConsent'
+type ConsentRepository_RecordConsent_Call struct {
+	*mock.Call
+}
+
+// RecordConsent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - record *dto.ConsentRecord
+func (_e *ConsentRepository_Expecter) RecordConsent(ctx interface{}, userID interface{}, record interface{}) *ConsentRepository_RecordConsent_Call {
+	return &ConsentRepository_RecordConsent_Call{Call: _e.mock.On("RecordConsent", ctx, userID, record)}
+}
+
+func (_c *ConsentRepository_RecordConsent_Call) Run(run func(ctx context.Context, userID uuid.UUID, record *dto.ConsentRecord)) *ConsentRepository_RecordConsent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.ConsentRecord))
+	})
+	return _c
+}
+
+func (_c *ConsentRepository_RecordConsent_Call) Return(_a0 error) *ConsentRepository_RecordConsent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsentRepository_RecordConsent_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.ConsentRecord) error) *ConsentRepository_RecordConsent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConsentRepository creates a new instance of ConsentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConsentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConsentRepository {
+	mock := &ConsentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,226 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ConsentService is an autogenerated mock type for the ConsentService type
+type ConsentService struct {
+	mock.Mock
+}
+
+type ConsentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ConsentService) EXPECT() *ConsentService_Expecter {
+	return &ConsentService_Expecter{mock: &_m.Mock}
+}
+
+// GetConsentHistory provides a mock function with given fields: ctx, requesterID, targetUserID, isAdmin, hasServiceScope
+func (_m *ConsentService) GetConsentHistory(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, isAdmin bool, hasServiceScope bool) (*dto.ConsentHistoryResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsentHistory")
+	}
+
+	var r0 *dto.ConsentHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool, bool) (*dto.ConsentHistoryResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool, bool) *dto.ConsentHistoryResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ConsentHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, bool, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentService_GetConsentHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConsentHistory'
+type ConsentService_GetConsentHistory_Call struct {
+	*mock.Call
+}
+
+// GetConsentHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - isAdmin bool
+//   - hasServiceScope bool
+func (_e *ConsentService_Expecter) GetConsentHistory(ctx interface{}, requesterID interface{}, targetUserID interface{}, isAdmin interface{}, hasServiceScope interface{}) *ConsentService_GetConsentHistory_Call {
+	return &ConsentService_GetConsentHistory_Call{Call: _e.mock.On("GetConsentHistory", ctx, requesterID, targetUserID, isAdmin, hasServiceScope)}
+}
+
+func (_c *ConsentService_GetConsentHistory_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, isAdmin bool, hasServiceScope bool)) *ConsentService_GetConsentHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(bool), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *ConsentService_GetConsentHistory_Call) Return(_a0 *dto.ConsentHistoryResponse, _a1 error) *ConsentService_GetConsentHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentService_GetConsentHistory_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, bool, bool) (*dto.ConsentHistoryResponse, error)) *ConsentService_GetConsentHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConsents provides a mock function with given fields: ctx, requesterID, targetUserID, isAdmin, hasServiceScope
+func (_m *ConsentService) GetConsents(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, isAdmin bool, hasServiceScope bool) (*dto.ConsentsResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsents")
+	}
+
+	var r0 *dto.ConsentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool, bool) (*dto.ConsentsResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool, bool) *dto.ConsentsResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ConsentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, bool, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentService_GetConsents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConsents'
+type ConsentService_GetConsents_Call struct {
+	*mock.Call
+}
+
+// GetConsents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - isAdmin bool
+//   - hasServiceScope bool
+func (_e *ConsentService_Expecter) GetConsents(ctx interface{}, requesterID interface{}, targetUserID interface{}, isAdmin interface{}, hasServiceScope interface{}) *ConsentService_GetConsents_Call {
+	return &ConsentService_GetConsents_Call{Call: _e.mock.On("GetConsents", ctx, requesterID, targetUserID, isAdmin, hasServiceScope)}
+}
+
+func (_c *ConsentService_GetConsents_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, isAdmin bool, hasServiceScope bool)) *ConsentService_GetConsents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(bool), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *ConsentService_GetConsents_Call) Return(_a0 *dto.ConsentsResponse, _a1 error) *ConsentService_GetConsents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentService_GetConsents_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, bool, bool) (*dto.ConsentsResponse, error)) *ConsentService_GetConsents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordConsent provides a mock function with given fields: ctx, requesterID, targetUserID, req, isAdmin, hasServiceScope
+func (_m *ConsentService) RecordConsent(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, req *dto.ConsentRequest, isAdmin bool, hasServiceScope bool) (*dto.ConsentRecord, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, req, isAdmin, hasServiceScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordConsent")
+	}
+
+	var r0 *dto.ConsentRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dto.ConsentRequest, bool, bool) (*dto.ConsentRecord, error)); ok {
+		return rf(ctx, requesterID, targetUserID, req, isAdmin, hasServiceScope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dto.ConsentRequest, bool, bool) *dto.ConsentRecord); ok {
+		r0 = rf(ctx, requesterID, targetUserID, req, isAdmin, hasServiceScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ConsentRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *dto.ConsentRequest, bool, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, req, isAdmin, hasServiceScope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentService_RecordConsent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordConsent'
+type ConsentService_RecordConsent_Call struct {
+	*mock.Call
+}
+
+// RecordConsent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - req *dto.ConsentRequest
+//   - isAdmin bool
+//   - hasServiceScope bool
+func (_e *ConsentService_Expecter) RecordConsent(ctx interface{}, requesterID interface{}, targetUserID interface{}, req interface{}, isAdmin interface{}, hasServiceScope interface{}) *ConsentService_RecordConsent_Call {
+	return &ConsentService_RecordConsent_Call{Call: _e.mock.On("RecordConsent", ctx, requesterID, targetUserID, req, isAdmin, hasServiceScope)}
+}
+
+func (_c *ConsentService_RecordConsent_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, req *dto.ConsentRequest, isAdmin bool, hasServiceScope bool)) *ConsentService_RecordConsent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*dto.ConsentRequest), args[4].(bool), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *ConsentService_RecordConsent_Call) Return(_a0 *dto.ConsentRecord, _a1 error) *ConsentService_RecordConsent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentService_RecordConsent_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, *dto.ConsentRequest, bool, bool) (*dto.ConsentRecord, error)) *ConsentService_RecordConsent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConsentService creates a new instance of ConsentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConsentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConsentService {
+	mock := &ConsentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,150 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// DataAccessRepository is an autogenerated mock type for the DataAccessRepository type
+type DataAccessRepository struct {
+	mock.Mock
+}
+
+type DataAccessRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DataAccessRepository) EXPECT() *DataAccessRepository_Expecter {
+	return &DataAccessRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetDataConsumers provides a mock function with given fields: ctx, userID
+func (_m *DataAccessRepository) GetDataConsumers(ctx context.Context, userID uuid.UUID) ([]dto.DataConsumer, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataConsumers")
+	}
+
+	var r0 []dto.DataConsumer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.DataConsumer, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.DataConsumer); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DataConsumer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataAccessRepository_GetDataConsumers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDataConsumers'
+type DataAccessRepository_GetDataConsumers_Call struct {
+	*mock.Call
+}
+
+// GetDataConsumers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *DataAccessRepository_Expecter) GetDataConsumers(ctx interface{}, userID interface{}) *DataAccessRepository_GetDataConsumers_Call {
+	return &DataAccessRepository_GetDataConsumers_Call{Call: _e.mock.On("GetDataConsumers", ctx, userID)}
+}
+
+func (_c *DataAccessRepository_GetDataConsumers_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *DataAccessRepository_GetDataConsumers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DataAccessRepository_GetDataConsumers_Call) Return(_a0 []dto.DataConsumer, _a1 error) *DataAccessRepository_GetDataConsumers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataAccessRepository_GetDataConsumers_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.DataConsumer, error)) *DataAccessRepository_GetDataConsumers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordDataAccess provides a mock function with given fields: ctx, userID, clientID, category, accessedAt
+func (_m *DataAccessRepository) RecordDataAccess(ctx context.Context, userID uuid.UUID, clientID string, category dto.DataCategory, accessedAt time.Time) error {
+	ret := _m.Called(ctx, userID, clientID, category, accessedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDataAccess")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, dto.DataCategory, time.Time) error); ok {
+		r0 = rf(ctx, userID, clientID, category, accessedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataAccessRepository_RecordDataAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordDataAccess'
+type DataAccessRepository_RecordDataAccess_Call struct {
+	*mock.Call
+}
+
+// RecordDataAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - clientID string
+//   - category dto.DataCategory
+//   - accessedAt time.Time
+func (_e *DataAccessRepository_Expecter) RecordDataAccess(ctx interface{}, userID interface{}, clientID interface{}, category interface{}, accessedAt interface{}) *DataAccessRepository_RecordDataAccess_Call {
+	return &DataAccessRepository_RecordDataAccess_Call{Call: _e.mock.On("RecordDataAccess", ctx, userID, clientID, category, accessedAt)}
+}
+
+func (_c *DataAccessRepository_RecordDataAccess_Call) Run(run func(ctx context.Context, userID uuid.UUID, clientID string, category dto.DataCategory, accessedAt time.Time)) *DataAccessRepository_RecordDataAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(dto.DataCategory), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DataAccessRepository_RecordDataAccess_Call) Return(_a0 error) *DataAccessRepository_RecordDataAccess_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataAccessRepository_RecordDataAccess_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, dto.DataCategory, time.Time) error) *DataAccessRepository_RecordDataAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDataAccessRepository creates a new instance of DataAccessRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDataAccessRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DataAccessRepository {
+	mock := &DataAccessRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,254 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// DeviceTokenRepository is an autogenerated mock type for the DeviceTokenRepository type
+type DeviceTokenRepository struct {
+	mock.Mock
+}
+
+type DeviceTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeviceTokenRepository) EXPECT() *DeviceTokenRepository_Expecter {
+	return &DeviceTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteDeviceToken provides a mock function with given fields: ctx, userID, deviceID
+func (_m *DeviceTokenRepository) DeleteDeviceToken(ctx context.Context, userID uuid.UUID, deviceID int64) error {
+	ret := _m.Called(ctx, userID, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeviceToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) error); ok {
+		r0 = rf(ctx, userID, deviceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeviceTokenRepository_DeleteDeviceToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDeviceToken'
+type DeviceTokenRepository_DeleteDeviceToken_Call struct {
+	*mock.Call
+}
+
+// DeleteDeviceToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - deviceID int64
+func (_e *DeviceTokenRepository_Expecter) DeleteDeviceToken(ctx interface{}, userID interface{}, deviceID interface{}) *DeviceTokenRepository_DeleteDeviceToken_Call {
+	return &DeviceTokenRepository_DeleteDeviceToken_Call{Call: _e.mock.On("DeleteDeviceToken", ctx, userID, deviceID)}
+}
+
+func (_c *DeviceTokenRepository_DeleteDeviceToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, deviceID int64)) *DeviceTokenRepository_DeleteDeviceToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DeviceTokenRepository_DeleteDeviceToken_Call) Return(_a0 error) *DeviceTokenRepository_DeleteDeviceToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeviceTokenRepository_DeleteDeviceToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64) error) *DeviceTokenRepository_DeleteDeviceToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStaleDeviceTokens provides a mock function with given fields: ctx, cutoff
+func (_m *DeviceTokenRepository) DeleteStaleDeviceTokens(ctx context.Context, cutoff time.Time) (int64, error) {
+	ret := _m.Called(ctx, cutoff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStaleDeviceTokens")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, cutoff)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, cutoff)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, cutoff)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceTokenRepository_DeleteStaleDeviceTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStaleDeviceTokens'
+type DeviceTokenRepository_DeleteStaleDeviceTokens_Call struct {
+	*mock.Call
+}
+
+// DeleteStaleDeviceTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cutoff time.Time
+func (_e *DeviceTokenRepository_Expecter) DeleteStaleDeviceTokens(ctx interface{}, cutoff interface{}) *DeviceTokenRepository_DeleteStaleDeviceTokens_Call {
+	return &DeviceTokenRepository_DeleteStaleDeviceTokens_Call{Call: _e.mock.On("DeleteStaleDeviceTokens", ctx, cutoff)}
+}
+
+func (_c *DeviceTokenRepository_DeleteStaleDeviceTokens_Call) Run(run func(ctx context.Context, cutoff time.Time)) *DeviceTokenRepository_DeleteStaleDeviceTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DeviceTokenRepository_DeleteStaleDeviceTokens_Call) Return(_a0 int64, _a1 error) *DeviceTokenRepository_DeleteStaleDeviceTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceTokenRepository_DeleteStaleDeviceTokens_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *DeviceTokenRepository_DeleteStaleDeviceTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeviceTokens provides a mock function with given fields: ctx, userID
+func (_m *DeviceTokenRepository) ListDeviceTokens(ctx context.Context, userID uuid.UUID) ([]dto.DeviceToken, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeviceTokens")
+	}
+
+	var r0 []dto.DeviceToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.DeviceToken, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.DeviceToken); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DeviceToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceTokenRepository_ListDeviceTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeviceTokens'
+type DeviceTokenRepository_ListDeviceTokens_Call struct {
+	*mock.Call
+}
+
+// ListDeviceTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *DeviceTokenRepository_Expecter) ListDeviceTokens(ctx interface{}, userID interface{}) *DeviceTokenRepository_ListDeviceTokens_Call {
+	return &DeviceTokenRepository_ListDeviceTokens_Call{Call: _e.mock.On("ListDeviceTokens", ctx, userID)}
+}
+
+func (_c *DeviceTokenRepository_ListDeviceTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *DeviceTokenRepository_ListDeviceTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DeviceTokenRepository_ListDeviceTokens_Call) Return(_a0 []dto.DeviceToken, _a1 error) *DeviceTokenRepository_ListDeviceTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceTokenRepository_ListDeviceTokens_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.DeviceToken, error)) *DeviceTokenRepository_ListDeviceTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterDeviceToken provides a mock function with given fields: ctx, userID, device, limit
+func (_m *DeviceTokenRepository) RegisterDeviceToken(ctx context.Context, userID uuid.UUID, device *dto.DeviceToken, limit int) error {
+	ret := _m.Called(ctx, userID, device, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterDeviceToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.DeviceToken, int) error); ok {
+		r0 = rf(ctx, userID, device, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeviceTokenRepository_RegisterDeviceToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterDeviceToken'
+type DeviceTokenRepository_RegisterDeviceToken_Call struct {
+	*mock.Call
+}
+
+// RegisterDeviceToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - device *dto.DeviceToken
+//   - limit int
+func (_e *DeviceTokenRepository_Expecter) RegisterDeviceToken(ctx interface{}, userID interface{}, device interface{}, limit interface{}) *DeviceTokenRepository_RegisterDeviceToken_Call {
+	return &DeviceTokenRepository_RegisterDeviceToken_Call{Call: _e.mock.On("RegisterDeviceToken", ctx, userID, device, limit)}
+}
+
+func (_c *DeviceTokenRepository_RegisterDeviceToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, device *dto.DeviceToken, limit int)) *DeviceTokenRepository_RegisterDeviceToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.DeviceToken), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *DeviceTokenRepository_RegisterDeviceToken_Call) Return(_a0 error) *DeviceTokenRepository_RegisterDeviceToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeviceTokenRepository_RegisterDeviceToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.DeviceToken, int) error) *DeviceTokenRepository_RegisterDeviceToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDeviceTokenRepository creates a new instance of DeviceTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeviceTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeviceTokenRepository {
+	mock := &DeviceTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,321 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// DeviceTokenService is an autogenerated mock type for the DeviceTokenService type
+type DeviceTokenService struct {
+	mock.Mock
+}
+
+type DeviceTokenService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeviceTokenService) EXPECT() *DeviceTokenService_Expecter {
+	return &DeviceTokenService_Expecter{mock: &_m.Mock}
+}
+
+// GetPushTargets provides a mock function with given fields: ctx, userID
+func (_m *DeviceTokenService) GetPushTargets(ctx context.Context, userID uuid.UUID) (*dto.PushTargetsResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPushTargets")
+	}
+
+	var r0 *dto.PushTargetsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PushTargetsResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PushTargetsResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PushTargetsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceTokenService_GetPushTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPushTargets'
+type DeviceTokenService_GetPushTargets_Call struct {
+	*mock.Call
+}
+
+// GetPushTargets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *DeviceTokenService_Expecter) GetPushTargets(ctx interface{}, userID interface{}) *DeviceTokenService_GetPushTargets_Call {
+	return &DeviceTokenService_GetPushTargets_Call{Call: _e.mock.On("GetPushTargets", ctx, userID)}
+}
+
+func (_c *DeviceTokenService_GetPushTargets_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *DeviceTokenService_GetPushTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DeviceTokenService_GetPushTargets_Call) Return(_a0 *dto.PushTargetsResponse, _a1 error) *DeviceTokenService_GetPushTargets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceTokenService_GetPushTargets_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.PushTargetsResponse, error)) *DeviceTokenService_GetPushTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDevices provides a mock function with given fields: ctx, userID
+func (_m *DeviceTokenService) ListDevices(ctx context.Context, userID uuid.UUID) (*dto.DeviceTokensResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDevices")
+	}
+
+	var r0 *dto.DeviceTokensResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.DeviceTokensResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.DeviceTokensResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DeviceTokensResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceTokenService_ListDevices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDevices'
+type DeviceTokenService_ListDevices_Call struct {
+	*mock.Call
+}
+
+// ListDevices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *DeviceTokenService_Expecter) ListDevices(ctx interface{}, userID interface{}) *DeviceTokenService_ListDevices_Call {
+	return &DeviceTokenService_ListDevices_Call{Call: _e.mock.On("ListDevices", ctx, userID)}
+}
+
+func (_c *DeviceTokenService_ListDevices_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *DeviceTokenService_ListDevices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DeviceTokenService_ListDevices_Call) Return(_a0 *dto.DeviceTokensResponse, _a1 error) *DeviceTokenService_ListDevices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceTokenService_ListDevices_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.DeviceTokensResponse, error)) *DeviceTokenService_ListDevices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PruneStaleDevices provides a mock function with given fields: ctx
+func (_m *DeviceTokenService) PruneStaleDevices(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneStaleDevices")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceTokenService_PruneStaleDevices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneStaleDevices'
+type DeviceTokenService_PruneStaleDevices_Call struct {
+	*mock.Call
+}
+
+// PruneStaleDevices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DeviceTokenService_Expecter) PruneStaleDevices(ctx interface{}) *DeviceTokenService_PruneStaleDevices_Call {
+	return &DeviceTokenService_PruneStaleDevices_Call{Call: _e.mock.On("PruneStaleDevices", ctx)}
+}
+
+func (_c *DeviceTokenService_PruneStaleDevices_Call) Run(run func(ctx context.Context)) *DeviceTokenService_PruneStaleDevices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DeviceTokenService_PruneStaleDevices_Call) Return(_a0 int64, _a1 error) *DeviceTokenService_PruneStaleDevices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceTokenService_PruneStaleDevices_Call) RunAndReturn(run func(context.Context) (int64, error)) *DeviceTokenService_PruneStaleDevices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterDevice provides a mock function with given fields: ctx, userID, req
+func (_m *DeviceTokenService) RegisterDevice(ctx context.Context, userID uuid.UUID, req *dto.DeviceTokenRequest) (*dto.DeviceToken, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterDevice")
+	}
+
+	var r0 *dto.DeviceToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.DeviceTokenRequest) (*dto.DeviceToken, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.DeviceTokenRequest) *dto.DeviceToken); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DeviceToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.DeviceTokenRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceTokenService_RegisterDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterDevice'
+type DeviceTokenService_RegisterDevice_Call struct {
+	*mock.Call
+}
+
+// RegisterDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - req *dto.DeviceTokenRequest
+func (_e *DeviceTokenService_Expecter) RegisterDevice(ctx interface{}, userID interface{}, req interface{}) *DeviceTokenService_RegisterDevice_Call {
+	return &DeviceTokenService_RegisterDevice_Call{Call: _e.mock.On("RegisterDevice", ctx, userID, req)}
+}
+
+func (_c *DeviceTokenService_RegisterDevice_Call) Run(run func(ctx context.Context, userID uuid.UUID, req *dto.DeviceTokenRequest)) *DeviceTokenService_RegisterDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.DeviceTokenRequest))
+	})
+	return _c
+}
+
+func (_c *DeviceTokenService_RegisterDevice_Call) Return(_a0 *dto.DeviceToken, _a1 error) *DeviceTokenService_RegisterDevice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceTokenService_RegisterDevice_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.DeviceTokenRequest) (*dto.DeviceToken, error)) *DeviceTokenService_RegisterDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnregisterDevice provides a mock function with given fields: ctx, userID, deviceID
+func (_m *DeviceTokenService) UnregisterDevice(ctx context.Context, userID uuid.UUID, deviceID int64) error {
+	ret := _m.Called(ctx, userID, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnregisterDevice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) error); ok {
+		r0 = rf(ctx, userID, deviceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeviceTokenService_UnregisterDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnregisterDevice'
+type DeviceTokenService_UnregisterDevice_Call struct {
+	*mock.Call
+}
+
+// UnregisterDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - deviceID int64
+func (_e *DeviceTokenService_Expecter) UnregisterDevice(ctx interface{}, userID interface{}, deviceID interface{}) *DeviceTokenService_UnregisterDevice_Call {
+	return &DeviceTokenService_UnregisterDevice_Call{Call: _e.mock.On("UnregisterDevice", ctx, userID, deviceID)}
+}
+
+func (_c *DeviceTokenService_UnregisterDevice_Call) Run(run func(ctx context.Context, userID uuid.UUID, deviceID int64)) *DeviceTokenService_UnregisterDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DeviceTokenService_UnregisterDevice_Call) Return(_a0 error) *DeviceTokenService_UnregisterDevice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeviceTokenService_UnregisterDevice_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64) error) *DeviceTokenService_UnregisterDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDeviceTokenService creates a new instance of DeviceTokenService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeviceTokenService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeviceTokenService {
+	mock := &DeviceTokenService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
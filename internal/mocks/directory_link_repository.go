@@ -0,0 +1,314 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// DirectoryLinkRepository is an autogenerated mock type for the DirectoryLinkRepository type
+type DirectoryLinkRepository struct {
+	mock.Mock
+}
+
+type DirectoryLinkRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DirectoryLinkRepository) EXPECT() *DirectoryLinkRepository_Expecter {
+	return &DirectoryLinkRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetDirectoryLink provides a mock function with given fields: ctx, userID
+func (_m *DirectoryLinkRepository) GetDirectoryLink(ctx context.Context, userID uuid.UUID) (*dto.DirectoryLink, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDirectoryLink")
+	}
+
+	var r0 *dto.DirectoryLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.DirectoryLink, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.DirectoryLink); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DirectoryLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DirectoryLinkRepository_GetDirectoryLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDirectoryLink'
+type DirectoryLinkRepository_GetDirectoryLink_Call struct {
+	*mock.Call
+}
+
+// GetDirectoryLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *DirectoryLinkRepository_Expecter) GetDirectoryLink(ctx interface{}, userID interface{}) *DirectoryLinkRepository_GetDirectoryLink_Call {
+	return &DirectoryLinkRepository_GetDirectoryLink_Call{Call: _e.mock.On("GetDirectoryLink", ctx, userID)}
+}
+
+func (_c *DirectoryLinkRepository_GetDirectoryLink_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *DirectoryLinkRepository_GetDirectoryLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DirectoryLinkRepository_GetDirectoryLink_Call) Return(_a0 *dto.DirectoryLink, _a1 error) *DirectoryLinkRepository_GetDirectoryLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DirectoryLinkRepository_GetDirectoryLink_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.DirectoryLink, error)) *DirectoryLinkRepository_GetDirectoryLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkDirectoryEntry provides a mock function with given fields: ctx, actorID, userID, externalID
+func (_m *DirectoryLinkRepository) LinkDirectoryEntry(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, externalID string) (*dto.DirectoryLink, error) {
+	ret := _m.Called(ctx, actorID, userID, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkDirectoryEntry")
+	}
+
+	var r0 *dto.DirectoryLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (*dto.DirectoryLink, error)); ok {
+		return rf(ctx, actorID, userID, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) *dto.DirectoryLink); ok {
+		r0 = rf(ctx, actorID, userID, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DirectoryLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, actorID, userID, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DirectoryLinkRepository_LinkDirectoryEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkDirectoryEntry'
+type DirectoryLinkRepository_LinkDirectoryEntry_Call struct {
+	*mock.Call
+}
+
+// LinkDirectoryEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - externalID string
+func (_e *DirectoryLinkRepository_Expecter) LinkDirectoryEntry(ctx interface{}, actorID interface{}, userID interface{}, externalID interface{}) *DirectoryLinkRepository_LinkDirectoryEntry_Call {
+	return &DirectoryLinkRepository_LinkDirectoryEntry_Call{Call: _e.mock.On("LinkDirectoryEntry", ctx, actorID, userID, externalID)}
+}
+
+func (_c *DirectoryLinkRepository_LinkDirectoryEntry_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, externalID string)) *DirectoryLinkRepository_LinkDirectoryEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *DirectoryLinkRepository_LinkDirectoryEntry_Call) Return(_a0 *dto.DirectoryLink, _a1 error) *DirectoryLinkRepository_LinkDirectoryEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DirectoryLinkRepository_LinkDirectoryEntry_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) (*dto.DirectoryLink, error)) *DirectoryLinkRepository_LinkDirectoryEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDirectoryLinks provides a mock function with given fields: ctx, after, limit
+func (_m *DirectoryLinkRepository) ListDirectoryLinks(ctx context.Context, after uuid.UUID, limit int) ([]dto.DirectoryLink, error) {
+	ret := _m.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDirectoryLinks")
+	}
+
+	var r0 []dto.DirectoryLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]dto.DirectoryLink, error)); ok {
+		return rf(ctx, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []dto.DirectoryLink); ok {
+		r0 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DirectoryLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DirectoryLinkRepository_ListDirectoryLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDirectoryLinks'
+type DirectoryLinkRepository_ListDirectoryLinks_Call struct {
+	*mock.Call
+}
+
+// ListDirectoryLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after uuid.UUID
+//   - limit int
+func (_e *DirectoryLinkRepository_Expecter) ListDirectoryLinks(ctx interface{}, after interface{}, limit interface{}) *DirectoryLinkRepository_ListDirectoryLinks_Call {
+	return &DirectoryLinkRepository_ListDirectoryLinks_Call{Call: _e.mock.On("ListDirectoryLinks", ctx, after, limit)}
+}
+
+func (_c *DirectoryLinkRepository_ListDirectoryLinks_Call) Run(run func(ctx context.Context, after uuid.UUID, limit int)) *DirectoryLinkRepository_ListDirectoryLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *DirectoryLinkRepository_ListDirectoryLinks_Call) Return(_a0 []dto.DirectoryLink, _a1 error) *DirectoryLinkRepository_ListDirectoryLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DirectoryLinkRepository_ListDirectoryLinks_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]dto.DirectoryLink, error)) *DirectoryLinkRepository_ListDirectoryLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDirectorySync provides a mock function with given fields: ctx, link
+func (_m *DirectoryLinkRepository) SaveDirectorySync(ctx context.Context, link *dto.DirectoryLink) error {
+	ret := _m.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDirectorySync")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.DirectoryLink) error); ok {
+		r0 = rf(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DirectoryLinkRepository_SaveDirectorySync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDirectorySync'
+type DirectoryLinkRepository_SaveDirectorySync_Call struct {
+	*mock.Call
+}
+
+// SaveDirectorySync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *dto.DirectoryLink
+func (_e *DirectoryLinkRepository_Expecter) SaveDirectorySync(ctx interface{}, link interface{}) *DirectoryLinkRepository_SaveDirectorySync_Call {
+	return &DirectoryLinkRepository_SaveDirectorySync_Call{Call: _e.mock.On("SaveDirectorySync", ctx, link)}
+}
+
+func (_c *DirectoryLinkRepository_SaveDirectorySync_Call) Run(run func(ctx context.Context, link *dto.DirectoryLink)) *DirectoryLinkRepository_SaveDirectorySync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dto.DirectoryLink))
+	})
+	return _c
+}
+
+func (_c *DirectoryLinkRepository_SaveDirectorySync_Call) Return(_a0 error) *DirectoryLinkRepository_SaveDirectorySync_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DirectoryLinkRepository_SaveDirectorySync_Call) RunAndReturn(run func(context.Context, *dto.DirectoryLink) error) *DirectoryLinkRepository_SaveDirectorySync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlinkDirectoryEntry provides a mock function with given fields: ctx, actorID, userID
+func (_m *DirectoryLinkRepository) UnlinkDirectoryEntry(ctx context.Context, actorID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, actorID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlinkDirectoryEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, actorID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DirectoryLinkRepository_UnlinkDirectoryEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlinkDirectoryEntry'
+type DirectoryLinkRepository_UnlinkDirectoryEntry_Call struct {
+	*mock.Call
+}
+
+// UnlinkDirectoryEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+func (_e *DirectoryLinkRepository_Expecter) UnlinkDirectoryEntry(ctx interface{}, actorID interface{}, userID interface{}) *DirectoryLinkRepository_UnlinkDirectoryEntry_Call {
+	return &DirectoryLinkRepository_UnlinkDirectoryEntry_Call{Call: _e.mock.On("UnlinkDirectoryEntry", ctx, actorID, userID)}
+}
+
+func (_c *DirectoryLinkRepository_UnlinkDirectoryEntry_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID)) *DirectoryLinkRepository_UnlinkDirectoryEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DirectoryLinkRepository_UnlinkDirectoryEntry_Call) Return(_a0 error) *DirectoryLinkRepository_UnlinkDirectoryEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DirectoryLinkRepository_UnlinkDirectoryEntry_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *DirectoryLinkRepository_UnlinkDirectoryEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDirectoryLinkRepository creates a new instance of DirectoryLinkRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDirectoryLinkRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DirectoryLinkRepository {
+	mock := &DirectoryLinkRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DirectoryReader is an autogenerated mock type for the DirectoryReader type
+type DirectoryReader struct {
+	mock.Mock
+}
+
+type DirectoryReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DirectoryReader) EXPECT() *DirectoryReader_Expecter {
+	return &DirectoryReader_Expecter{mock: &_m.Mock}
+}
+
+// LookupEntries provides a mock function with given fields: ctx, externalIDs
+func (_m *DirectoryReader) LookupEntries(ctx context.Context, externalIDs []string) (map[string]dto.DirectoryEntry, error) {
+	ret := _m.Called(ctx, externalIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LookupEntries")
+	}
+
+	var r0 map[string]dto.DirectoryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]dto.DirectoryEntry, error)); ok {
+		return rf(ctx, externalIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]dto.DirectoryEntry); ok {
+		r0 = rf(ctx, externalIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]dto.DirectoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, externalIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DirectoryReader_LookupEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LookupEntries'
+type DirectoryReader_LookupEntries_Call struct {
+	*mock.Call
+}
+
+// LookupEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalIDs []string
+func (_e *DirectoryReader_Expecter) LookupEntries(ctx interface{}, externalIDs interface{}) *DirectoryReader_LookupEntries_Call {
+	return &DirectoryReader_LookupEntries_Call{Call: _e.mock.On("LookupEntries", ctx, externalIDs)}
+}
+
+func (_c *DirectoryReader_LookupEntries_Call) Run(run func(ctx context.Context, externalIDs []string)) *DirectoryReader_LookupEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *DirectoryReader_LookupEntries_Call) Return(_a0 map[string]dto.DirectoryEntry, _a1 error) *DirectoryReader_LookupEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DirectoryReader_LookupEntries_Call) RunAndReturn(run func(context.Context, []string) (map[string]dto.DirectoryEntry, error)) *DirectoryReader_LookupEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDirectoryReader creates a new instance of DirectoryReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDirectoryReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DirectoryReader {
+	mock := &DirectoryReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
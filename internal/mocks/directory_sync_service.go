@@ -0,0 +1,266 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// DirectorySyncService is an autogenerated mock type for the DirectorySyncService type
+type DirectorySyncService struct {
+	mock.Mock
+}
+
+type DirectorySyncService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DirectorySyncService) EXPECT() *DirectorySyncService_Expecter {
+	return &DirectorySyncService_Expecter{mock: &_m.Mock}
+}
+
+// GetLink provides a mock function with given fields: ctx, userID
+func (_m *DirectorySyncService) GetLink(ctx context.Context, userID uuid.UUID) (*dto.DirectoryLink, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLink")
+	}
+
+	var r0 *dto.DirectoryLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.DirectoryLink, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.DirectoryLink); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DirectoryLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DirectorySyncService_GetLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLink'
+type DirectorySyncService_GetLink_Call struct {
+	*mock.Call
+}
+
+// GetLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *DirectorySyncService_Expecter) GetLink(ctx interface{}, userID interface{}) *DirectorySyncService_GetLink_Call {
+	return &DirectorySyncService_GetLink_Call{Call: _e.mock.On("GetLink", ctx, userID)}
+}
+
+func (_c *DirectorySyncService_GetLink_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *DirectorySyncService_GetLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DirectorySyncService_GetLink_Call) Return(_a0 *dto.DirectoryLink, _a1 error) *DirectorySyncService_GetLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DirectorySyncService_GetLink_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.DirectoryLink, error)) *DirectorySyncService_GetLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkUser provides a mock function with given fields: ctx, actorID, userID, externalID
+func (_m *DirectorySyncService) LinkUser(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, externalID string) (*dto.DirectoryLink, error) {
+	ret := _m.Called(ctx, actorID, userID, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkUser")
+	}
+
+	var r0 *dto.DirectoryLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (*dto.DirectoryLink, error)); ok {
+		return rf(ctx, actorID, userID, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) *dto.DirectoryLink); ok {
+		r0 = rf(ctx, actorID, userID, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DirectoryLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, actorID, userID, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DirectorySyncService_LinkUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkUser'
+type DirectorySyncService_LinkUser_Call struct {
+	*mock.Call
+}
+
+// LinkUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - externalID string
+func (_e *DirectorySyncService_Expecter) LinkUser(ctx interface{}, actorID interface{}, userID interface{}, externalID interface{}) *DirectorySyncService_LinkUser_Call {
+	return &DirectorySyncService_LinkUser_Call{Call: _e.mock.On("LinkUser", ctx, actorID, userID, externalID)}
+}
+
+func (_c *DirectorySyncService_LinkUser_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, externalID string)) *DirectorySyncService_LinkUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *DirectorySyncService_LinkUser_Call) Return(_a0 *dto.DirectoryLink, _a1 error) *DirectorySyncService_LinkUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DirectorySyncService_LinkUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) (*dto.DirectoryLink, error)) *DirectorySyncService_LinkUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncUser provides a mock function with given fields: ctx, userID
+func (_m *DirectorySyncService) SyncUser(ctx context.Context, userID uuid.UUID) (*dto.DirectoryLink, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncUser")
+	}
+
+	var r0 *dto.DirectoryLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.DirectoryLink, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.DirectoryLink); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DirectoryLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DirectorySyncService_SyncUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncUser'
+type DirectorySyncService_SyncUser_Call struct {
+	*mock.Call
+}
+
+// SyncUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *DirectorySyncService_Expecter) SyncUser(ctx interface{}, userID interface{}) *DirectorySyncService_SyncUser_Call {
+	return &DirectorySyncService_SyncUser_Call{Call: _e.mock.On("SyncUser", ctx, userID)}
+}
+
+func (_c *DirectorySyncService_SyncUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *DirectorySyncService_SyncUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DirectorySyncService_SyncUser_Call) Return(_a0 *dto.DirectoryLink, _a1 error) *DirectorySyncService_SyncUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DirectorySyncService_SyncUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.DirectoryLink, error)) *DirectorySyncService_SyncUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlinkUser provides a mock function with given fields: ctx, actorID, userID
+func (_m *DirectorySyncService) UnlinkUser(ctx context.Context, actorID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, actorID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlinkUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, actorID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DirectorySyncService_UnlinkUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlinkUser'
+type DirectorySyncService_UnlinkUser_Call struct {
+	*mock.Call
+}
+
+// UnlinkUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+func (_e *DirectorySyncService_Expecter) UnlinkUser(ctx interface{}, actorID interface{}, userID interface{}) *DirectorySyncService_UnlinkUser_Call {
+	return &DirectorySyncService_UnlinkUser_Call{Call: _e.mock.On("UnlinkUser", ctx, actorID, userID)}
+}
+
+func (_c *DirectorySyncService_UnlinkUser_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID)) *DirectorySyncService_UnlinkUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DirectorySyncService_UnlinkUser_Call) Return(_a0 error) *DirectorySyncService_UnlinkUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DirectorySyncService_UnlinkUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *DirectorySyncService_UnlinkUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDirectorySyncService creates a new instance of DirectorySyncService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDirectorySyncService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DirectorySyncService {
+	mock := &DirectorySyncService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
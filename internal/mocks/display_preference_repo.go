@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// DisplayPreferenceRepo is an autogenerated mock type for the DisplayPreferenceRepo type
+type DisplayPreferenceRepo struct {
+	mock.Mock
+}
+
+type DisplayPreferenceRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DisplayPreferenceRepo) EXPECT() *DisplayPreferenceRepo_Expecter {
+	return &DisplayPreferenceRepo_Expecter{mock: &_m.Mock}
+}
+
+// GetDisplayPreferences provides a mock function with given fields: ctx, userID
+func (_m *DisplayPreferenceRepo) GetDisplayPreferences(ctx context.Context, userID uuid.UUID) (*dto.DisplayPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDisplayPreferences")
+	}
+
+	var r0 *dto.DisplayPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.DisplayPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.DisplayPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DisplayPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisplayPreferenceRepo_GetDisplayPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDisplayPreferences'
+type DisplayPreferenceRepo_GetDisplayPreferences_Call struct {
+	*mock.Call
+}
+
+// GetDisplayPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *DisplayPreferenceRepo_Expecter) GetDisplayPreferences(ctx interface{}, userID interface{}) *DisplayPreferenceRepo_GetDisplayPreferences_Call {
+	return &DisplayPreferenceRepo_GetDisplayPreferences_Call{Call: _e.mock.On("GetDisplayPreferences", ctx, userID)}
+}
+
+func (_c *DisplayPreferenceRepo_GetDisplayPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *DisplayPreferenceRepo_GetDisplayPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DisplayPreferenceRepo_GetDisplayPreferences_Call) Return(_a0 *dto.DisplayPreferences, _a1 error) *DisplayPreferenceRepo_GetDisplayPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DisplayPreferenceRepo_GetDisplayPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.DisplayPreferences, error)) *DisplayPreferenceRepo_GetDisplayPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDisplayPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *DisplayPreferenceRepo) UpdateDisplayPreferences(ctx context.Context, userID uuid.UUID, u *dto.DisplayPreferencesUpdate) (*dto.DisplayPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDisplayPreferences")
+	}
+
+	var r0 *dto.DisplayPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.DisplayPreferencesUpdate) (*dto.DisplayPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.DisplayPreferencesUpdate) *dto.DisplayPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DisplayPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.DisplayPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisplayPreferenceRepo_UpdateDisplayPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDisplayPreferences'
+type DisplayPreferenceRepo_UpdateDisplayPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateDisplayPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.DisplayPreferencesUpdate
+func (_e *DisplayPreferenceRepo_Expecter) UpdateDisplayPreferences(ctx interface{}, userID interface{}, u interface{}) *DisplayPreferenceRepo_UpdateDisplayPreferences_Call {
+	return &DisplayPreferenceRepo_UpdateDisplayPreferences_Call{Call: _e.mock.On("UpdateDisplayPreferences", ctx, userID, u)}
+}
+
+func (_c *DisplayPreferenceRepo_UpdateDisplayPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.DisplayPreferencesUpdate)) *DisplayPreferenceRepo_UpdateDisplayPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.DisplayPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *DisplayPreferenceRepo_UpdateDisplayPreferences_Call) Return(_a0 *dto.DisplayPreferences, _a1 error) *DisplayPreferenceRepo_UpdateDisplayPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DisplayPreferenceRepo_UpdateDisplayPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.DisplayPreferencesUpdate) (*dto.DisplayPreferences, error)) *DisplayPreferenceRepo_UpdateDisplayPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDisplayPreferenceRepo creates a new instance of DisplayPreferenceRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDisplayPreferenceRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DisplayPreferenceRepo {
+	mock := &DisplayPreferenceRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,4 @@
+// Package mocks holds testify mocks of the repository and service interfaces, generated by
+// mockery from .mockery.yaml. Regenerate with `make mocks` after changing an interface; do not
+// edit the files by hand.
+package mocks
@@ -0,0 +1,226 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DrainService is an autogenerated mock type for the DrainService type
+type DrainService struct {
+	mock.Mock
+}
+
+type DrainService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DrainService) EXPECT() *DrainService_Expecter {
+	return &DrainService_Expecter{mock: &_m.Mock}
+}
+
+// Drained provides a mock function with no fields
+func (_m *DrainService) Drained() <-chan struct{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drained")
+	}
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// DrainService_Drained_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drained'
+type DrainService_Drained_Call struct {
+	*mock.Call
+}
+
+// Drained is a helper method to define mock.On call
+func (_e *DrainService_Expecter) Drained() *DrainService_Drained_Call {
+	return &DrainService_Drained_Call{Call: _e.mock.On("Drained")}
+}
+
+func (_c *DrainService_Drained_Call) Run(run func()) *DrainService_Drained_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DrainService_Drained_Call) Return(_a0 <-chan struct{}) *DrainService_Drained_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DrainService_Drained_Call) RunAndReturn(run func() <-chan struct{}) *DrainService_Drained_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Draining provides a mock function with no fields
+func (_m *DrainService) Draining() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Draining")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// DrainService_Draining_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Draining'
+type DrainService_Draining_Call struct {
+	*mock.Call
+}
+
+// Draining is a helper method to define mock.On call
+func (_e *DrainService_Expecter) Draining() *DrainService_Draining_Call {
+	return &DrainService_Draining_Call{Call: _e.mock.On("Draining")}
+}
+
+func (_c *DrainService_Draining_Call) Run(run func()) *DrainService_Draining_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DrainService_Draining_Call) Return(_a0 bool) *DrainService_Draining_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DrainService_Draining_Call) RunAndReturn(run func() bool) *DrainService_Draining_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDrainStatus provides a mock function with given fields: ctx
+func (_m *DrainService) GetDrainStatus(ctx context.Context) *dto.DrainStatus {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDrainStatus")
+	}
+
+	var r0 *dto.DrainStatus
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.DrainStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DrainStatus)
+		}
+	}
+
+	return r0
+}
+
+// DrainService_GetDrainStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDrainStatus'
+type DrainService_GetDrainStatus_Call struct {
+	*mock.Call
+}
+
+// GetDrainStatus is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DrainService_Expecter) GetDrainStatus(ctx interface{}) *DrainService_GetDrainStatus_Call {
+	return &DrainService_GetDrainStatus_Call{Call: _e.mock.On("GetDrainStatus", ctx)}
+}
+
+func (_c *DrainService_GetDrainStatus_Call) Run(run func(ctx context.Context)) *DrainService_GetDrainStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DrainService_GetDrainStatus_Call) Return(_a0 *dto.DrainStatus) *DrainService_GetDrainStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DrainService_GetDrainStatus_Call) RunAndReturn(run func(context.Context) *dto.DrainStatus) *DrainService_GetDrainStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartDrain provides a mock function with given fields: ctx, trigger
+func (_m *DrainService) StartDrain(ctx context.Context, trigger string) *dto.DrainStatus {
+	ret := _m.Called(ctx, trigger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartDrain")
+	}
+
+	var r0 *dto.DrainStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.DrainStatus); ok {
+		r0 = rf(ctx, trigger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DrainStatus)
+		}
+	}
+
+	return r0
+}
+
+// DrainService_StartDrain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartDrain'
+type DrainService_StartDrain_Call struct {
+	*mock.Call
+}
+
+// StartDrain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - trigger string
+func (_e *DrainService_Expecter) StartDrain(ctx interface{}, trigger interface{}) *DrainService_StartDrain_Call {
+	return &DrainService_StartDrain_Call{Call: _e.mock.On("StartDrain", ctx, trigger)}
+}
+
+func (_c *DrainService_StartDrain_Call) Run(run func(ctx context.Context, trigger string)) *DrainService_StartDrain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DrainService_StartDrain_Call) Return(_a0 *dto.DrainStatus) *DrainService_StartDrain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DrainService_StartDrain_Call) RunAndReturn(run func(context.Context, string) *dto.DrainStatus) *DrainService_StartDrain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDrainService creates a new instance of DrainService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDrainService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DrainService {
+	mock := &DrainService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,162 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+
+	uuid "github.com/google/uuid"
+)
+
+// EmailChangeService is an autogenerated mock type for the EmailChangeService type
+type EmailChangeService struct {
+	mock.Mock
+}
+
+type EmailChangeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EmailChangeService) EXPECT() *EmailChangeService_Expecter {
+	return &EmailChangeService_Expecter{mock: &_m.Mock}
+}
+
+// ConfirmEmailChange provides a mock function with given fields: ctx, userID, side, token
+func (_m *EmailChangeService) ConfirmEmailChange(ctx context.Context, userID uuid.UUID, side service.EmailChangeSide, token string) (*dto.EmailChangeStatusResponse, error) {
+	ret := _m.Called(ctx, userID, side, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmEmailChange")
+	}
+
+	var r0 *dto.EmailChangeStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, service.EmailChangeSide, string) (*dto.EmailChangeStatusResponse, error)); ok {
+		return rf(ctx, userID, side, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, service.EmailChangeSide, string) *dto.EmailChangeStatusResponse); ok {
+		r0 = rf(ctx, userID, side, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.EmailChangeStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, service.EmailChangeSide, string) error); ok {
+		r1 = rf(ctx, userID, side, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EmailChangeService_ConfirmEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmEmailChange'
+type EmailChangeService_ConfirmEmailChange_Call struct {
+	*mock.Call
+}
+
+// ConfirmEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - side service.EmailChangeSide
+//   - token string
+func (_e *EmailChangeService_Expecter) ConfirmEmailChange(ctx interface{}, userID interface{}, side interface{}, token interface{}) *EmailChangeService_ConfirmEmailChange_Call {
+	return &EmailChangeService_ConfirmEmailChange_Call{Call: _e.mock.On("ConfirmEmailChange", ctx, userID, side, token)}
+}
+
+func (_c *EmailChangeService_ConfirmEmailChange_Call) Run(run func(ctx context.Context, userID uuid.UUID, side service.EmailChangeSide, token string)) *EmailChangeService_ConfirmEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(service.EmailChangeSide), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *EmailChangeService_ConfirmEmailChange_Call) Return(_a0 *dto.EmailChangeStatusResponse, _a1 error) *EmailChangeService_ConfirmEmailChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EmailChangeService_ConfirmEmailChange_Call) RunAndReturn(run func(context.Context, uuid.UUID, service.EmailChangeSide, string) (*dto.EmailChangeStatusResponse, error)) *EmailChangeService_ConfirmEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestEmailChange provides a mock function with given fields: ctx, userID, newEmail
+func (_m *EmailChangeService) RequestEmailChange(ctx context.Context, userID uuid.UUID, newEmail string) (*dto.EmailChangeRequestResponse, error) {
+	ret := _m.Called(ctx, userID, newEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestEmailChange")
+	}
+
+	var r0 *dto.EmailChangeRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dto.EmailChangeRequestResponse, error)); ok {
+		return rf(ctx, userID, newEmail)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dto.EmailChangeRequestResponse); ok {
+		r0 = rf(ctx, userID, newEmail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.EmailChangeRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, newEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EmailChangeService_RequestEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestEmailChange'
+type EmailChangeService_RequestEmailChange_Call struct {
+	*mock.Call
+}
+
+// RequestEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - newEmail string
+func (_e *EmailChangeService_Expecter) RequestEmailChange(ctx interface{}, userID interface{}, newEmail interface{}) *EmailChangeService_RequestEmailChange_Call {
+	return &EmailChangeService_RequestEmailChange_Call{Call: _e.mock.On("RequestEmailChange", ctx, userID, newEmail)}
+}
+
+func (_c *EmailChangeService_RequestEmailChange_Call) Run(run func(ctx context.Context, userID uuid.UUID, newEmail string)) *EmailChangeService_RequestEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *EmailChangeService_RequestEmailChange_Call) Return(_a0 *dto.EmailChangeRequestResponse, _a1 error) *EmailChangeService_RequestEmailChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EmailChangeService_RequestEmailChange_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dto.EmailChangeRequestResponse, error)) *EmailChangeService_RequestEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEmailChangeService creates a new instance of EmailChangeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailChangeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailChangeService {
+	mock := &EmailChangeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
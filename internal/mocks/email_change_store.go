@@ -0,0 +1,196 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// EmailChangeStore is an autogenerated mock type for the EmailChangeStore type
+type EmailChangeStore struct {
+	mock.Mock
+}
+
+type EmailChangeStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EmailChangeStore) EXPECT() *EmailChangeStore_Expecter {
+	return &EmailChangeStore_Expecter{mock: &_m.Mock}
+}
+
+// DeleteEmailChange provides a mock function with given fields: ctx, userID
+func (_m *EmailChangeStore) DeleteEmailChange(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmailChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EmailChangeStore_DeleteEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEmailChange'
+type EmailChangeStore_DeleteEmailChange_Call struct {
+	*mock.Call
+}
+
+// DeleteEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *EmailChangeStore_Expecter) DeleteEmailChange(ctx interface{}, userID interface{}) *EmailChangeStore_DeleteEmailChange_Call {
+	return &EmailChangeStore_DeleteEmailChange_Call{Call: _e.mock.On("DeleteEmailChange", ctx, userID)}
+}
+
+func (_c *EmailChangeStore_DeleteEmailChange_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *EmailChangeStore_DeleteEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *EmailChangeStore_DeleteEmailChange_Call) Return(_a0 error) *EmailChangeStore_DeleteEmailChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EmailChangeStore_DeleteEmailChange_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *EmailChangeStore_DeleteEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEmailChange provides a mock function with given fields: ctx, userID
+func (_m *EmailChangeStore) GetEmailChange(ctx context.Context, userID uuid.UUID) (*dto.PendingEmailChange, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmailChange")
+	}
+
+	var r0 *dto.PendingEmailChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PendingEmailChange, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PendingEmailChange); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PendingEmailChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EmailChangeStore_GetEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmailChange'
+type EmailChangeStore_GetEmailChange_Call struct {
+	*mock.Call
+}
+
+// GetEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *EmailChangeStore_Expecter) GetEmailChange(ctx interface{}, userID interface{}) *EmailChangeStore_GetEmailChange_Call {
+	return &EmailChangeStore_GetEmailChange_Call{Call: _e.mock.On("GetEmailChange", ctx, userID)}
+}
+
+func (_c *EmailChangeStore_GetEmailChange_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *EmailChangeStore_GetEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *EmailChangeStore_GetEmailChange_Call) Return(_a0 *dto.PendingEmailChange, _a1 error) *EmailChangeStore_GetEmailChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EmailChangeStore_GetEmailChange_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.PendingEmailChange, error)) *EmailChangeStore_GetEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreEmailChange provides a mock function with given fields: ctx, userID, change, ttl
+func (_m *EmailChangeStore) StoreEmailChange(ctx context.Context, userID uuid.UUID, change *dto.PendingEmailChange, ttl time.Duration) error {
+	ret := _m.Called(ctx, userID, change, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreEmailChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PendingEmailChange, time.Duration) error); ok {
+		r0 = rf(ctx, userID, change, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EmailChangeStore_StoreEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreEmailChange'
+type EmailChangeStore_StoreEmailChange_Call struct {
+	*mock.Call
+}
+
+// StoreEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - change *dto.PendingEmailChange
+//   - ttl time.Duration
+func (_e *EmailChangeStore_Expecter) StoreEmailChange(ctx interface{}, userID interface{}, change interface{}, ttl interface{}) *EmailChangeStore_StoreEmailChange_Call {
+	return &EmailChangeStore_StoreEmailChange_Call{Call: _e.mock.On("StoreEmailChange", ctx, userID, change, ttl)}
+}
+
+func (_c *EmailChangeStore_StoreEmailChange_Call) Run(run func(ctx context.Context, userID uuid.UUID, change *dto.PendingEmailChange, ttl time.Duration)) *EmailChangeStore_StoreEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.PendingEmailChange), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *EmailChangeStore_StoreEmailChange_Call) Return(_a0 error) *EmailChangeStore_StoreEmailChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EmailChangeStore_StoreEmailChange_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.PendingEmailChange, time.Duration) error) *EmailChangeStore_StoreEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEmailChangeStore creates a new instance of EmailChangeStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailChangeStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailChangeStore {
+	mock := &EmailChangeStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// EmbedService is an autogenerated mock type for the EmbedService type
+type EmbedService struct {
+	mock.Mock
+}
+
+type EmbedService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EmbedService) EXPECT() *EmbedService_Expecter {
+	return &EmbedService_Expecter{mock: &_m.Mock}
+}
+
+// GetProfileEmbed provides a mock function with given fields: ctx, userID
+func (_m *EmbedService) GetProfileEmbed(ctx context.Context, userID uuid.UUID) (*dto.ProfileEmbedResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfileEmbed")
+	}
+
+	var r0 *dto.ProfileEmbedResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.ProfileEmbedResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.ProfileEmbedResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProfileEmbedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EmbedService_GetProfileEmbed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfileEmbed'
+type EmbedService_GetProfileEmbed_Call struct {
+	*mock.Call
+}
+
+// GetProfileEmbed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *EmbedService_Expecter) GetProfileEmbed(ctx interface{}, userID interface{}) *EmbedService_GetProfileEmbed_Call {
+	return &EmbedService_GetProfileEmbed_Call{Call: _e.mock.On("GetProfileEmbed", ctx, userID)}
+}
+
+func (_c *EmbedService_GetProfileEmbed_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *EmbedService_GetProfileEmbed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *EmbedService_GetProfileEmbed_Call) Return(_a0 *dto.ProfileEmbedResponse, _a1 error) *EmbedService_GetProfileEmbed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EmbedService_GetProfileEmbed_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.ProfileEmbedResponse, error)) *EmbedService_GetProfileEmbed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEmbedService creates a new instance of EmbedService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmbedService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmbedService {
+	mock := &EmbedService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// HandleRepository is an autogenerated mock type for the HandleRepository type
+type HandleRepository struct {
+	mock.Mock
+}
+
+type HandleRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HandleRepository) EXPECT() *HandleRepository_Expecter {
+	return &HandleRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimHandle provides a mock function with given fields: ctx, userID, handle
+func (_m *HandleRepository) ClaimHandle(ctx context.Context, userID uuid.UUID, handle string) (time.Time, error) {
+	ret := _m.Called(ctx, userID, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimHandle")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (time.Time, error)); ok {
+		return rf(ctx, userID, handle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) time.Time); ok {
+		r0 = rf(ctx, userID, handle)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandleRepository_ClaimHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimHandle'
+type HandleRepository_ClaimHandle_Call struct {
+	*mock.Call
+}
+
+// ClaimHandle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - handle string
+func (_e *HandleRepository_Expecter) ClaimHandle(ctx interface{}, userID interface{}, handle interface{}) *HandleRepository_ClaimHandle_Call {
+	return &HandleRepository_ClaimHandle_Call{Call: _e.mock.On("ClaimHandle", ctx, userID, handle)}
+}
+
+func (_c *HandleRepository_ClaimHandle_Call) Run(run func(ctx context.Context, userID uuid.UUID, handle string)) *HandleRepository_ClaimHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *HandleRepository_ClaimHandle_Call) Return(_a0 time.Time, _a1 error) *HandleRepository_ClaimHandle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HandleRepository_ClaimHandle_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (time.Time, error)) *HandleRepository_ClaimHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserIDByHandle provides a mock function with given fields: ctx, handle
+func (_m *HandleRepository) FindUserIDByHandle(ctx context.Context, handle string) (uuid.UUID, error) {
+	ret := _m.Called(ctx, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserIDByHandle")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return rf(ctx, handle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = rf(ctx, handle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandleRepository_FindUserIDByHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserIDByHandle'
+type HandleRepository_FindUserIDByHandle_Call struct {
+	*mock.Call
+}
+
+// FindUserIDByHandle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - handle string
+func (_e *HandleRepository_Expecter) FindUserIDByHandle(ctx interface{}, handle interface{}) *HandleRepository_FindUserIDByHandle_Call {
+	return &HandleRepository_FindUserIDByHandle_Call{Call: _e.mock.On("FindUserIDByHandle", ctx, handle)}
+}
+
+func (_c *HandleRepository_FindUserIDByHandle_Call) Run(run func(ctx context.Context, handle string)) *HandleRepository_FindUserIDByHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *HandleRepository_FindUserIDByHandle_Call) Return(_a0 uuid.UUID, _a1 error) *HandleRepository_FindUserIDByHandle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HandleRepository_FindUserIDByHandle_Call) RunAndReturn(run func(context.Context, string) (uuid.UUID, error)) *HandleRepository_FindUserIDByHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHandleRepository creates a new instance of HandleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHandleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HandleRepository {
+	mock := &HandleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
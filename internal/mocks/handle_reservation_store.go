@@ -0,0 +1,206 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// HandleReservationStore is an autogenerated mock type for the HandleReservationStore type
+type HandleReservationStore struct {
+	mock.Mock
+}
+
+type HandleReservationStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HandleReservationStore) EXPECT() *HandleReservationStore_Expecter {
+	return &HandleReservationStore_Expecter{mock: &_m.Mock}
+}
+
+// GetHandleReservation provides a mock function with given fields: ctx, handle
+func (_m *HandleReservationStore) GetHandleReservation(ctx context.Context, handle string) (uuid.UUID, error) {
+	ret := _m.Called(ctx, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHandleReservation")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return rf(ctx, handle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = rf(ctx, handle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandleReservationStore_GetHandleReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHandleReservation'
+type HandleReservationStore_GetHandleReservation_Call struct {
+	*mock.Call
+}
+
+// GetHandleReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - handle string
+func (_e *HandleReservationStore_Expecter) GetHandleReservation(ctx interface{}, handle interface{}) *HandleReservationStore_GetHandleReservation_Call {
+	return &HandleReservationStore_GetHandleReservation_Call{Call: _e.mock.On("GetHandleReservation", ctx, handle)}
+}
+
+func (_c *HandleReservationStore_GetHandleReservation_Call) Run(run func(ctx context.Context, handle string)) *HandleReservationStore_GetHandleReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *HandleReservationStore_GetHandleReservation_Call) Return(_a0 uuid.UUID, _a1 error) *HandleReservationStore_GetHandleReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HandleReservationStore_GetHandleReservation_Call) RunAndReturn(run func(context.Context, string) (uuid.UUID, error)) *HandleReservationStore_GetHandleReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseHandleReservation provides a mock function with given fields: ctx, handle, userID
+func (_m *HandleReservationStore) ReleaseHandleReservation(ctx context.Context, handle string, userID uuid.UUID) error {
+	ret := _m.Called(ctx, handle, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseHandleReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, handle, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HandleReservationStore_ReleaseHandleReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseHandleReservation'
+type HandleReservationStore_ReleaseHandleReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseHandleReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - handle string
+//   - userID uuid.UUID
+func (_e *HandleReservationStore_Expecter) ReleaseHandleReservation(ctx interface{}, handle interface{}, userID interface{}) *HandleReservationStore_ReleaseHandleReservation_Call {
+	return &HandleReservationStore_ReleaseHandleReservation_Call{Call: _e.mock.On("ReleaseHandleReservation", ctx, handle, userID)}
+}
+
+func (_c *HandleReservationStore_ReleaseHandleReservation_Call) Run(run func(ctx context.Context, handle string, userID uuid.UUID)) *HandleReservationStore_ReleaseHandleReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *HandleReservationStore_ReleaseHandleReservation_Call) Return(_a0 error) *HandleReservationStore_ReleaseHandleReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HandleReservationStore_ReleaseHandleReservation_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) error) *HandleReservationStore_ReleaseHandleReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveHandle provides a mock function with given fields: ctx, handle, userID, ttl
+func (_m *HandleReservationStore) ReserveHandle(ctx context.Context, handle string, userID uuid.UUID, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, handle, userID, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveHandle")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Duration) (bool, error)); ok {
+		return rf(ctx, handle, userID, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Duration) bool); ok {
+		r0 = rf(ctx, handle, userID, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, time.Duration) error); ok {
+		r1 = rf(ctx, handle, userID, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandleReservationStore_ReserveHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveHandle'
+type HandleReservationStore_ReserveHandle_Call struct {
+	*mock.Call
+}
+
+// ReserveHandle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - handle string
+//   - userID uuid.UUID
+//   - ttl time.Duration
+func (_e *HandleReservationStore_Expecter) ReserveHandle(ctx interface{}, handle interface{}, userID interface{}, ttl interface{}) *HandleReservationStore_ReserveHandle_Call {
+	return &HandleReservationStore_ReserveHandle_Call{Call: _e.mock.On("ReserveHandle", ctx, handle, userID, ttl)}
+}
+
+func (_c *HandleReservationStore_ReserveHandle_Call) Run(run func(ctx context.Context, handle string, userID uuid.UUID, ttl time.Duration)) *HandleReservationStore_ReserveHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *HandleReservationStore_ReserveHandle_Call) Return(_a0 bool, _a1 error) *HandleReservationStore_ReserveHandle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HandleReservationStore_ReserveHandle_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Duration) (bool, error)) *HandleReservationStore_ReserveHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHandleReservationStore creates a new instance of HandleReservationStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHandleReservationStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HandleReservationStore {
+	mock := &HandleReservationStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,218 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// HandleService is an autogenerated mock type for the HandleService type
+type HandleService struct {
+	mock.Mock
+}
+
+type HandleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HandleService) EXPECT() *HandleService_Expecter {
+	return &HandleService_Expecter{mock: &_m.Mock}
+}
+
+// ClaimHandle provides a mock function with given fields: ctx, userID, handle
+func (_m *HandleService) ClaimHandle(ctx context.Context, userID uuid.UUID, handle string) (*dto.HandleResponse, error) {
+	ret := _m.Called(ctx, userID, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimHandle")
+	}
+
+	var r0 *dto.HandleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dto.HandleResponse, error)); ok {
+		return rf(ctx, userID, handle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dto.HandleResponse); ok {
+		r0 = rf(ctx, userID, handle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.HandleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandleService_ClaimHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimHandle'
+type HandleService_ClaimHandle_Call struct {
+	*mock.Call
+}
+
+// ClaimHandle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - handle string
+func (_e *HandleService_Expecter) ClaimHandle(ctx interface{}, userID interface{}, handle interface{}) *HandleService_ClaimHandle_Call {
+	return &HandleService_ClaimHandle_Call{Call: _e.mock.On("ClaimHandle", ctx, userID, handle)}
+}
+
+func (_c *HandleService_ClaimHandle_Call) Run(run func(ctx context.Context, userID uuid.UUID, handle string)) *HandleService_ClaimHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *HandleService_ClaimHandle_Call) Return(_a0 *dto.HandleResponse, _a1 error) *HandleService_ClaimHandle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HandleService_ClaimHandle_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dto.HandleResponse, error)) *HandleService_ClaimHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveHandle provides a mock function with given fields: ctx, userID, handle
+func (_m *HandleService) ReserveHandle(ctx context.Context, userID uuid.UUID, handle string) (*dto.HandleReservationResponse, error) {
+	ret := _m.Called(ctx, userID, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveHandle")
+	}
+
+	var r0 *dto.HandleReservationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dto.HandleReservationResponse, error)); ok {
+		return rf(ctx, userID, handle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dto.HandleReservationResponse); ok {
+		r0 = rf(ctx, userID, handle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.HandleReservationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandleService_ReserveHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveHandle'
+type HandleService_ReserveHandle_Call struct {
+	*mock.Call
+}
+
+// ReserveHandle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - handle string
+func (_e *HandleService_Expecter) ReserveHandle(ctx interface{}, userID interface{}, handle interface{}) *HandleService_ReserveHandle_Call {
+	return &HandleService_ReserveHandle_Call{Call: _e.mock.On("ReserveHandle", ctx, userID, handle)}
+}
+
+func (_c *HandleService_ReserveHandle_Call) Run(run func(ctx context.Context, userID uuid.UUID, handle string)) *HandleService_ReserveHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *HandleService_ReserveHandle_Call) Return(_a0 *dto.HandleReservationResponse, _a1 error) *HandleService_ReserveHandle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HandleService_ReserveHandle_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dto.HandleReservationResponse, error)) *HandleService_ReserveHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveHandle provides a mock function with given fields: ctx, handle
+func (_m *HandleService) ResolveHandle(ctx context.Context, handle string) (*dto.HandleResolutionResponse, error) {
+	ret := _m.Called(ctx, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveHandle")
+	}
+
+	var r0 *dto.HandleResolutionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.HandleResolutionResponse, error)); ok {
+		return rf(ctx, handle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.HandleResolutionResponse); ok {
+		r0 = rf(ctx, handle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.HandleResolutionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandleService_ResolveHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveHandle'
+type HandleService_ResolveHandle_Call struct {
+	*mock.Call
+}
+
+// ResolveHandle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - handle string
+func (_e *HandleService_Expecter) ResolveHandle(ctx interface{}, handle interface{}) *HandleService_ResolveHandle_Call {
+	return &HandleService_ResolveHandle_Call{Call: _e.mock.On("ResolveHandle", ctx, handle)}
+}
+
+func (_c *HandleService_ResolveHandle_Call) Run(run func(ctx context.Context, handle string)) *HandleService_ResolveHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *HandleService_ResolveHandle_Call) Return(_a0 *dto.HandleResolutionResponse, _a1 error) *HandleService_ResolveHandle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HandleService_ResolveHandle_Call) RunAndReturn(run func(context.Context, string) (*dto.HandleResolutionResponse, error)) *HandleService_ResolveHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHandleService creates a new instance of HandleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHandleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HandleService {
+	mock := &HandleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
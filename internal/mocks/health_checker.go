@@ -0,0 +1,129 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HealthChecker is an autogenerated mock type for the HealthChecker type
+type HealthChecker struct {
+	mock.Mock
+}
+
+type HealthChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HealthChecker) EXPECT() *HealthChecker_Expecter {
+	return &HealthChecker_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *HealthChecker) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthChecker_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type HealthChecker_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *HealthChecker_Expecter) Close() *HealthChecker_Close_Call {
+	return &HealthChecker_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *HealthChecker_Close_Call) Run(run func()) *HealthChecker_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthChecker_Close_Call) Return(_a0 error) *HealthChecker_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthChecker_Close_Call) RunAndReturn(run func() error) *HealthChecker_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Health provides a mock function with given fields: ctx
+func (_m *HealthChecker) Health(ctx context.Context) map[string]string {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Health")
+	}
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// HealthChecker_Health_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Health'
+type HealthChecker_Health_Call struct {
+	*mock.Call
+}
+
+// Health is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HealthChecker_Expecter) Health(ctx interface{}) *HealthChecker_Health_Call {
+	return &HealthChecker_Health_Call{Call: _e.mock.On("Health", ctx)}
+}
+
+func (_c *HealthChecker_Health_Call) Run(run func(ctx context.Context)) *HealthChecker_Health_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *HealthChecker_Health_Call) Return(_a0 map[string]string) *HealthChecker_Health_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthChecker_Health_Call) RunAndReturn(run func(context.Context) map[string]string) *HealthChecker_Health_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHealthChecker creates a new instance of HealthChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealthChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HealthChecker {
+	mock := &HealthChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,129 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	service "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HealthServicer is an autogenerated mock type for the HealthServicer type
+type HealthServicer struct {
+	mock.Mock
+}
+
+type HealthServicer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HealthServicer) EXPECT() *HealthServicer_Expecter {
+	return &HealthServicer_Expecter{mock: &_m.Mock}
+}
+
+// GetHealth provides a mock function with given fields: ctx
+func (_m *HealthServicer) GetHealth(ctx context.Context) service.HealthStatus {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHealth")
+	}
+
+	var r0 service.HealthStatus
+	if rf, ok := ret.Get(0).(func(context.Context) service.HealthStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(service.HealthStatus)
+	}
+
+	return r0
+}
+
+// HealthServicer_GetHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHealth'
+type HealthServicer_GetHealth_Call struct {
+	*mock.Call
+}
+
+// GetHealth is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HealthServicer_Expecter) GetHealth(ctx interface{}) *HealthServicer_GetHealth_Call {
+	return &HealthServicer_GetHealth_Call{Call: _e.mock.On("GetHealth", ctx)}
+}
+
+func (_c *HealthServicer_GetHealth_Call) Run(run func(ctx context.Context)) *HealthServicer_GetHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *HealthServicer_GetHealth_Call) Return(_a0 service.HealthStatus) *HealthServicer_GetHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthServicer_GetHealth_Call) RunAndReturn(run func(context.Context) service.HealthStatus) *HealthServicer_GetHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadiness provides a mock function with given fields: ctx
+func (_m *HealthServicer) GetReadiness(ctx context.Context) service.HealthStatus {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadiness")
+	}
+
+	var r0 service.HealthStatus
+	if rf, ok := ret.Get(0).(func(context.Context) service.HealthStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(service.HealthStatus)
+	}
+
+	return r0
+}
+
+// HealthServicer_GetReadiness_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadiness'
+type HealthServicer_GetReadiness_Call struct {
+	*mock.Call
+}
+
+// GetReadiness is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HealthServicer_Expecter) GetReadiness(ctx interface{}) *HealthServicer_GetReadiness_Call {
+	return &HealthServicer_GetReadiness_Call{Call: _e.mock.On("GetReadiness", ctx)}
+}
+
+func (_c *HealthServicer_GetReadiness_Call) Run(run func(ctx context.Context)) *HealthServicer_GetReadiness_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *HealthServicer_GetReadiness_Call) Return(_a0 service.HealthStatus) *HealthServicer_GetReadiness_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthServicer_GetReadiness_Call) RunAndReturn(run func(context.Context) service.HealthStatus) *HealthServicer_GetReadiness_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHealthServicer creates a new instance of HealthServicer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealthServicer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HealthServicer {
+	mock := &HealthServicer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	service "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InvariantChecker is an autogenerated mock type for the InvariantChecker type
+type InvariantChecker struct {
+	mock.Mock
+}
+
+type InvariantChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InvariantChecker) EXPECT() *InvariantChecker_Expecter {
+	return &InvariantChecker_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx
+func (_m *InvariantChecker) Check(ctx context.Context) []service.InvariantResult {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 []service.InvariantResult
+	if rf, ok := ret.Get(0).(func(context.Context) []service.InvariantResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.InvariantResult)
+		}
+	}
+
+	return r0
+}
+
+// InvariantChecker_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type InvariantChecker_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InvariantChecker_Expecter) Check(ctx interface{}) *InvariantChecker_Check_Call {
+	return &InvariantChecker_Check_Call{Call: _e.mock.On("Check", ctx)}
+}
+
+func (_c *InvariantChecker_Check_Call) Run(run func(ctx context.Context)) *InvariantChecker_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InvariantChecker_Check_Call) Return(_a0 []service.InvariantResult) *InvariantChecker_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvariantChecker_Check_Call) RunAndReturn(run func(context.Context) []service.InvariantResult) *InvariantChecker_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInvariantChecker creates a new instance of InvariantChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvariantChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvariantChecker {
+	mock := &InvariantChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
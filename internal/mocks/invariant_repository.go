@@ -0,0 +1,224 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// InvariantRepository is an autogenerated mock type for the InvariantRepository type
+type InvariantRepository struct {
+	mock.Mock
+}
+
+type InvariantRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InvariantRepository) EXPECT() *InvariantRepository_Expecter {
+	return &InvariantRepository_Expecter{mock: &_m.Mock}
+}
+
+// ChangeBacklog provides a mock function with given fields: ctx, cursor
+func (_m *InvariantRepository) ChangeBacklog(ctx context.Context, cursor int64) (int64, *time.Time, error) {
+	ret := _m.Called(ctx, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeBacklog")
+	}
+
+	var r0 int64
+	var r1 *time.Time
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, *time.Time, error)); ok {
+		return rf(ctx, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, cursor)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) *time.Time); ok {
+		r1 = rf(ctx, cursor)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64) error); ok {
+		r2 = rf(ctx, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// InvariantRepository_ChangeBacklog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeBacklog'
+type InvariantRepository_ChangeBacklog_Call struct {
+	*mock.Call
+}
+
+// ChangeBacklog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cursor int64
+func (_e *InvariantRepository_Expecter) ChangeBacklog(ctx interface{}, cursor interface{}) *InvariantRepository_ChangeBacklog_Call {
+	return &InvariantRepository_ChangeBacklog_Call{Call: _e.mock.On("ChangeBacklog", ctx, cursor)}
+}
+
+func (_c *InvariantRepository_ChangeBacklog_Call) Run(run func(ctx context.Context, cursor int64)) *InvariantRepository_ChangeBacklog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *InvariantRepository_ChangeBacklog_Call) Return(_a0 int64, _a1 *time.Time, _a2 error) *InvariantRepository_ChangeBacklog_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *InvariantRepository_ChangeBacklog_Call) RunAndReturn(run func(context.Context, int64) (int64, *time.Time, error)) *InvariantRepository_ChangeBacklog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MissingRelations provides a mock function with given fields: ctx, relations
+func (_m *InvariantRepository) MissingRelations(ctx context.Context, relations []string) ([]string, error) {
+	ret := _m.Called(ctx, relations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MissingRelations")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, relations)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, relations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, relations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvariantRepository_MissingRelations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MissingRelations'
+type InvariantRepository_MissingRelations_Call struct {
+	*mock.Call
+}
+
+// MissingRelations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - relations []string
+func (_e *InvariantRepository_Expecter) MissingRelations(ctx interface{}, relations interface{}) *InvariantRepository_MissingRelations_Call {
+	return &InvariantRepository_MissingRelations_Call{Call: _e.mock.On("MissingRelations", ctx, relations)}
+}
+
+func (_c *InvariantRepository_MissingRelations_Call) Run(run func(ctx context.Context, relations []string)) *InvariantRepository_MissingRelations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *InvariantRepository_MissingRelations_Call) Return(_a0 []string, _a1 error) *InvariantRepository_MissingRelations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvariantRepository_MissingRelations_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *InvariantRepository_MissingRelations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecentFolloweeCounts provides a mock function with given fields: ctx, limit
+func (_m *InvariantRepository) RecentFolloweeCounts(ctx context.Context, limit int) (map[uuid.UUID]int, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecentFolloweeCounts")
+	}
+
+	var r0 map[uuid.UUID]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (map[uuid.UUID]int, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) map[uuid.UUID]int); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvariantRepository_RecentFolloweeCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecentFolloweeCounts'
+type InvariantRepository_RecentFolloweeCounts_Call struct {
+	*mock.Call
+}
+
+// RecentFolloweeCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *InvariantRepository_Expecter) RecentFolloweeCounts(ctx interface{}, limit interface{}) *InvariantRepository_RecentFolloweeCounts_Call {
+	return &InvariantRepository_RecentFolloweeCounts_Call{Call: _e.mock.On("RecentFolloweeCounts", ctx, limit)}
+}
+
+func (_c *InvariantRepository_RecentFolloweeCounts_Call) Run(run func(ctx context.Context, limit int)) *InvariantRepository_RecentFolloweeCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *InvariantRepository_RecentFolloweeCounts_Call) Return(_a0 map[uuid.UUID]int, _a1 error) *InvariantRepository_RecentFolloweeCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvariantRepository_RecentFolloweeCounts_Call) RunAndReturn(run func(context.Context, int) (map[uuid.UUID]int, error)) *InvariantRepository_RecentFolloweeCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInvariantRepository creates a new instance of InvariantRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvariantRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvariantRepository {
+	mock := &InvariantRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,372 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// InvitationRepository is an autogenerated mock type for the InvitationRepository type
+type InvitationRepository struct {
+	mock.Mock
+}
+
+type InvitationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InvitationRepository) EXPECT() *InvitationRepository_Expecter {
+	return &InvitationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountInvitations provides a mock function with given fields: ctx, inviterID
+func (_m *InvitationRepository) CountInvitations(ctx context.Context, inviterID uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, inviterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountInvitations")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return rf(ctx, inviterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = rf(ctx, inviterID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, inviterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationRepository_CountInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountInvitations'
+type InvitationRepository_CountInvitations_Call struct {
+	*mock.Call
+}
+
+// CountInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inviterID uuid.UUID
+func (_e *InvitationRepository_Expecter) CountInvitations(ctx interface{}, inviterID interface{}) *InvitationRepository_CountInvitations_Call {
+	return &InvitationRepository_CountInvitations_Call{Call: _e.mock.On("CountInvitations", ctx, inviterID)}
+}
+
+func (_c *InvitationRepository_CountInvitations_Call) Run(run func(ctx context.Context, inviterID uuid.UUID)) *InvitationRepository_CountInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InvitationRepository_CountInvitations_Call) Return(_a0 int, _a1 error) *InvitationRepository_CountInvitations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationRepository_CountInvitations_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int, error)) *InvitationRepository_CountInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInvitation provides a mock function with given fields: ctx, invitation, limit
+func (_m *InvitationRepository) CreateInvitation(ctx context.Context, invitation *dto.Invitation, limit int) error {
+	ret := _m.Called(ctx, invitation, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Invitation, int) error); ok {
+		r0 = rf(ctx, invitation, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InvitationRepository_CreateInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInvitation'
+type InvitationRepository_CreateInvitation_Call struct {
+	*mock.Call
+}
+
+// CreateInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitation *dto.Invitation
+//   - limit int
+func (_e *InvitationRepository_Expecter) CreateInvitation(ctx interface{}, invitation interface{}, limit interface{}) *InvitationRepository_CreateInvitation_Call {
+	return &InvitationRepository_CreateInvitation_Call{Call: _e.mock.On("CreateInvitation", ctx, invitation, limit)}
+}
+
+func (_c *InvitationRepository_CreateInvitation_Call) Run(run func(ctx context.Context, invitation *dto.Invitation, limit int)) *InvitationRepository_CreateInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dto.Invitation), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *InvitationRepository_CreateInvitation_Call) Return(_a0 error) *InvitationRepository_CreateInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvitationRepository_CreateInvitation_Call) RunAndReturn(run func(context.Context, *dto.Invitation, int) error) *InvitationRepository_CreateInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInvitation provides a mock function with given fields: ctx, code
+func (_m *InvitationRepository) GetInvitation(ctx context.Context, code string) (*dto.Invitation, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitation")
+	}
+
+	var r0 *dto.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Invitation, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Invitation); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationRepository_GetInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvitation'
+type InvitationRepository_GetInvitation_Call struct {
+	*mock.Call
+}
+
+// GetInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *InvitationRepository_Expecter) GetInvitation(ctx interface{}, code interface{}) *InvitationRepository_GetInvitation_Call {
+	return &InvitationRepository_GetInvitation_Call{Call: _e.mock.On("GetInvitation", ctx, code)}
+}
+
+func (_c *InvitationRepository_GetInvitation_Call) Run(run func(ctx context.Context, code string)) *InvitationRepository_GetInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InvitationRepository_GetInvitation_Call) Return(_a0 *dto.Invitation, _a1 error) *InvitationRepository_GetInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationRepository_GetInvitation_Call) RunAndReturn(run func(context.Context, string) (*dto.Invitation, error)) *InvitationRepository_GetInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInvitationStats provides a mock function with given fields: ctx, limit
+func (_m *InvitationRepository) GetInvitationStats(ctx context.Context, limit int) (*dto.InvitationStatsResponse, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitationStats")
+	}
+
+	var r0 *dto.InvitationStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*dto.InvitationStatsResponse, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *dto.InvitationStatsResponse); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.InvitationStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationRepository_GetInvitationStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvitationStats'
+type InvitationRepository_GetInvitationStats_Call struct {
+	*mock.Call
+}
+
+// GetInvitationStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *InvitationRepository_Expecter) GetInvitationStats(ctx interface{}, limit interface{}) *InvitationRepository_GetInvitationStats_Call {
+	return &InvitationRepository_GetInvitationStats_Call{Call: _e.mock.On("GetInvitationStats", ctx, limit)}
+}
+
+func (_c *InvitationRepository_GetInvitationStats_Call) Run(run func(ctx context.Context, limit int)) *InvitationRepository_GetInvitationStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *InvitationRepository_GetInvitationStats_Call) Return(_a0 *dto.InvitationStatsResponse, _a1 error) *InvitationRepository_GetInvitationStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationRepository_GetInvitationStats_Call) RunAndReturn(run func(context.Context, int) (*dto.InvitationStatsResponse, error)) *InvitationRepository_GetInvitationStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInvitations provides a mock function with given fields: ctx, inviterID
+func (_m *InvitationRepository) ListInvitations(ctx context.Context, inviterID uuid.UUID) ([]dto.Invitation, error) {
+	ret := _m.Called(ctx, inviterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitations")
+	}
+
+	var r0 []dto.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.Invitation, error)); ok {
+		return rf(ctx, inviterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.Invitation); ok {
+		r0 = rf(ctx, inviterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, inviterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationRepository_ListInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInvitations'
+type InvitationRepository_ListInvitations_Call struct {
+	*mock.Call
+}
+
+// ListInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inviterID uuid.UUID
+func (_e *InvitationRepository_Expecter) ListInvitations(ctx interface{}, inviterID interface{}) *InvitationRepository_ListInvitations_Call {
+	return &InvitationRepository_ListInvitations_Call{Call: _e.mock.On("ListInvitations", ctx, inviterID)}
+}
+
+func (_c *InvitationRepository_ListInvitations_Call) Run(run func(ctx context.Context, inviterID uuid.UUID)) *InvitationRepository_ListInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InvitationRepository_ListInvitations_Call) Return(_a0 []dto.Invitation, _a1 error) *InvitationRepository_ListInvitations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationRepository_ListInvitations_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.Invitation, error)) *InvitationRepository_ListInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeemInvitation provides a mock function with given fields: ctx, code, inviteeID, redeemedAt
+func (_m *InvitationRepository) RedeemInvitation(ctx context.Context, code string, inviteeID uuid.UUID, redeemedAt time.Time) error {
+	ret := _m.Called(ctx, code, inviteeID, redeemedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeemInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, code, inviteeID, redeemedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InvitationRepository_RedeemInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeemInvitation'
+type InvitationRepository_RedeemInvitation_Call struct {
+	*mock.Call
+}
+
+// RedeemInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - inviteeID uuid.UUID
+//   - redeemedAt time.Time
+func (_e *InvitationRepository_Expecter) RedeemInvitation(ctx interface{}, code interface{}, inviteeID interface{}, redeemedAt interface{}) *InvitationRepository_RedeemInvitation_Call {
+	return &InvitationRepository_RedeemInvitation_Call{Call: _e.mock.On("RedeemInvitation", ctx, code, inviteeID, redeemedAt)}
+}
+
+func (_c *InvitationRepository_RedeemInvitation_Call) Run(run func(ctx context.Context, code string, inviteeID uuid.UUID, redeemedAt time.Time)) *InvitationRepository_RedeemInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InvitationRepository_RedeemInvitation_Call) Return(_a0 error) *InvitationRepository_RedeemInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvitationRepository_RedeemInvitation_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Time) error) *InvitationRepository_RedeemInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInvitationRepository creates a new instance of InvitationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvitationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvitationRepository {
+	mock := &InvitationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,335 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// InvitationService is an autogenerated mock type for the InvitationService type
+type InvitationService struct {
+	mock.Mock
+}
+
+type InvitationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InvitationService) EXPECT() *InvitationService_Expecter {
+	return &InvitationService_Expecter{mock: &_m.Mock}
+}
+
+// CreateInvitation provides a mock function with given fields: ctx, inviterID
+func (_m *InvitationService) CreateInvitation(ctx context.Context, inviterID uuid.UUID) (*dto.Invitation, error) {
+	ret := _m.Called(ctx, inviterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInvitation")
+	}
+
+	var r0 *dto.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.Invitation, error)); ok {
+		return rf(ctx, inviterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.Invitation); ok {
+		r0 = rf(ctx, inviterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, inviterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_CreateInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInvitation'
+type InvitationService_CreateInvitation_Call struct {
+	*mock.Call
+}
+
+// CreateInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inviterID uuid.UUID
+func (_e *InvitationService_Expecter) CreateInvitation(ctx interface{}, inviterID interface{}) *InvitationService_CreateInvitation_Call {
+	return &InvitationService_CreateInvitation_Call{Call: _e.mock.On("CreateInvitation", ctx, inviterID)}
+}
+
+func (_c *InvitationService_CreateInvitation_Call) Run(run func(ctx context.Context, inviterID uuid.UUID)) *InvitationService_CreateInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InvitationService_CreateInvitation_Call) Return(_a0 *dto.Invitation, _a1 error) *InvitationService_CreateInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_CreateInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.Invitation, error)) *InvitationService_CreateInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInvitation provides a mock function with given fields: ctx, requesterID, code
+func (_m *InvitationService) GetInvitation(ctx context.Context, requesterID uuid.UUID, code string) (*dto.Invitation, error) {
+	ret := _m.Called(ctx, requesterID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitation")
+	}
+
+	var r0 *dto.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dto.Invitation, error)); ok {
+		return rf(ctx, requesterID, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dto.Invitation); ok {
+		r0 = rf(ctx, requesterID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, requesterID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_GetInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvitation'
+type InvitationService_GetInvitation_Call struct {
+	*mock.Call
+}
+
+// GetInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - code string
+func (_e *InvitationService_Expecter) GetInvitation(ctx interface{}, requesterID interface{}, code interface{}) *InvitationService_GetInvitation_Call {
+	return &InvitationService_GetInvitation_Call{Call: _e.mock.On("GetInvitation", ctx, requesterID, code)}
+}
+
+func (_c *InvitationService_GetInvitation_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, code string)) *InvitationService_GetInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *InvitationService_GetInvitation_Call) Return(_a0 *dto.Invitation, _a1 error) *InvitationService_GetInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_GetInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dto.Invitation, error)) *InvitationService_GetInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInvitationStats provides a mock function with given fields: ctx
+func (_m *InvitationService) GetInvitationStats(ctx context.Context) (*dto.InvitationStatsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitationStats")
+	}
+
+	var r0 *dto.InvitationStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.InvitationStatsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.InvitationStatsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.InvitationStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_GetInvitationStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvitationStats'
+type InvitationService_GetInvitationStats_Call struct {
+	*mock.Call
+}
+
+// GetInvitationStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InvitationService_Expecter) GetInvitationStats(ctx interface{}) *InvitationService_GetInvitationStats_Call {
+	return &InvitationService_GetInvitationStats_Call{Call: _e.mock.On("GetInvitationStats", ctx)}
+}
+
+func (_c *InvitationService_GetInvitationStats_Call) Run(run func(ctx context.Context)) *InvitationService_GetInvitationStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InvitationService_GetInvitationStats_Call) Return(_a0 *dto.InvitationStatsResponse, _a1 error) *InvitationService_GetInvitationStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_GetInvitationStats_Call) RunAndReturn(run func(context.Context) (*dto.InvitationStatsResponse, error)) *InvitationService_GetInvitationStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInvitations provides a mock function with given fields: ctx, inviterID
+func (_m *InvitationService) ListInvitations(ctx context.Context, inviterID uuid.UUID) (*dto.InvitationsResponse, error) {
+	ret := _m.Called(ctx, inviterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitations")
+	}
+
+	var r0 *dto.InvitationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.InvitationsResponse, error)); ok {
+		return rf(ctx, inviterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.InvitationsResponse); ok {
+		r0 = rf(ctx, inviterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.InvitationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, inviterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_ListInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInvitations'
+type InvitationService_ListInvitations_Call struct {
+	*mock.Call
+}
+
+// ListInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inviterID uuid.UUID
+func (_e *InvitationService_Expecter) ListInvitations(ctx interface{}, inviterID interface{}) *InvitationService_ListInvitations_Call {
+	return &InvitationService_ListInvitations_Call{Call: _e.mock.On("ListInvitations", ctx, inviterID)}
+}
+
+func (_c *InvitationService_ListInvitations_Call) Run(run func(ctx context.Context, inviterID uuid.UUID)) *InvitationService_ListInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InvitationService_ListInvitations_Call) Return(_a0 *dto.InvitationsResponse, _a1 error) *InvitationService_ListInvitations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_ListInvitations_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.InvitationsResponse, error)) *InvitationService_ListInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeemInvitation provides a mock function with given fields: ctx, inviteeID, code
+func (_m *InvitationService) RedeemInvitation(ctx context.Context, inviteeID uuid.UUID, code string) (*dto.InvitationRedemption, error) {
+	ret := _m.Called(ctx, inviteeID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeemInvitation")
+	}
+
+	var r0 *dto.InvitationRedemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dto.InvitationRedemption, error)); ok {
+		return rf(ctx, inviteeID, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dto.InvitationRedemption); ok {
+		r0 = rf(ctx, inviteeID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.InvitationRedemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, inviteeID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_RedeemInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeemInvitation'
+type InvitationService_RedeemInvitation_Call struct {
+	*mock.Call
+}
+
+// RedeemInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - inviteeID uuid.UUID
+//   - code string
+func (_e *InvitationService_Expecter) RedeemInvitation(ctx interface{}, inviteeID interface{}, code interface{}) *InvitationService_RedeemInvitation_Call {
+	return &InvitationService_RedeemInvitation_Call{Call: _e.mock.On("RedeemInvitation", ctx, inviteeID, code)}
+}
+
+func (_c *InvitationService_RedeemInvitation_Call) Run(run func(ctx context.Context, inviteeID uuid.UUID, code string)) *InvitationService_RedeemInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *InvitationService_RedeemInvitation_Call) Return(_a0 *dto.InvitationRedemption, _a1 error) *InvitationService_RedeemInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_RedeemInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dto.InvitationRedemption, error)) *InvitationService_RedeemInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInvitationService creates a new instance of InvitationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvitationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvitationService {
+	mock := &InvitationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
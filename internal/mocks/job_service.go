@@ -0,0 +1,144 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobService is an autogenerated mock type for the JobService type
+type JobService struct {
+	mock.Mock
+}
+
+type JobService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobService) EXPECT() *JobService_Expecter {
+	return &JobService_Expecter{mock: &_m.Mock}
+}
+
+// ListJobs provides a mock function with given fields: ctx
+func (_m *JobService) ListJobs(ctx context.Context) []dto.ScheduledJob {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobs")
+	}
+
+	var r0 []dto.ScheduledJob
+	if rf, ok := ret.Get(0).(func(context.Context) []dto.ScheduledJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ScheduledJob)
+		}
+	}
+
+	return r0
+}
+
+// JobService_ListJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListJobs'
+type JobService_ListJobs_Call struct {
+	*mock.Call
+}
+
+// ListJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *JobService_Expecter) ListJobs(ctx interface{}) *JobService_ListJobs_Call {
+	return &JobService_ListJobs_Call{Call: _e.mock.On("ListJobs", ctx)}
+}
+
+func (_c *JobService_ListJobs_Call) Run(run func(ctx context.Context)) *JobService_ListJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *JobService_ListJobs_Call) Return(_a0 []dto.ScheduledJob) *JobService_ListJobs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobService_ListJobs_Call) RunAndReturn(run func(context.Context) []dto.ScheduledJob) *JobService_ListJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunJob provides a mock function with given fields: ctx, name
+func (_m *JobService) RunJob(ctx context.Context, name string) (*dto.ScheduledJob, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunJob")
+	}
+
+	var r0 *dto.ScheduledJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.ScheduledJob, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.ScheduledJob); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ScheduledJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_RunJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunJob'
+type JobService_RunJob_Call struct {
+	*mock.Call
+}
+
+// RunJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *JobService_Expecter) RunJob(ctx interface{}, name interface{}) *JobService_RunJob_Call {
+	return &JobService_RunJob_Call{Call: _e.mock.On("RunJob", ctx, name)}
+}
+
+func (_c *JobService_RunJob_Call) Run(run func(ctx context.Context, name string)) *JobService_RunJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *JobService_RunJob_Call) Return(_a0 *dto.ScheduledJob, _a1 error) *JobService_RunJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_RunJob_Call) RunAndReturn(run func(context.Context, string) (*dto.ScheduledJob, error)) *JobService_RunJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobService creates a new instance of JobService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobService {
+	mock := &JobService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
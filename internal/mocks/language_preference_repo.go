@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// LanguagePreferenceRepo is an autogenerated mock type for the LanguagePreferenceRepo type
+type LanguagePreferenceRepo struct {
+	mock.Mock
+}
+
+type LanguagePreferenceRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LanguagePreferenceRepo) EXPECT() *LanguagePreferenceRepo_Expecter {
+	return &LanguagePreferenceRepo_Expecter{mock: &_m.Mock}
+}
+
+// GetLanguagePreferences provides a mock function with given fields: ctx, userID
+func (_m *LanguagePreferenceRepo) GetLanguagePreferences(ctx context.Context, userID uuid.UUID) (*dto.LanguagePreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLanguagePreferences")
+	}
+
+	var r0 *dto.LanguagePreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.LanguagePreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.LanguagePreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LanguagePreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LanguagePreferenceRepo_GetLanguagePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLanguagePreferences'
+type LanguagePreferenceRepo_GetLanguagePreferences_Call struct {
+	*mock.Call
+}
+
+// GetLanguagePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *LanguagePreferenceRepo_Expecter) GetLanguagePreferences(ctx interface{}, userID interface{}) *LanguagePreferenceRepo_GetLanguagePreferences_Call {
+	return &LanguagePreferenceRepo_GetLanguagePreferences_Call{Call: _e.mock.On("GetLanguagePreferences", ctx, userID)}
+}
+
+func (_c *LanguagePreferenceRepo_GetLanguagePreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *LanguagePreferenceRepo_GetLanguagePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *LanguagePreferenceRepo_GetLanguagePreferences_Call) Return(_a0 *dto.LanguagePreferences, _a1 error) *LanguagePreferenceRepo_GetLanguagePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LanguagePreferenceRepo_GetLanguagePreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.LanguagePreferences, error)) *LanguagePreferenceRepo_GetLanguagePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLanguagePreferences provides a mock function with given fields: ctx, userID, u
+func (_m *LanguagePreferenceRepo) UpdateLanguagePreferences(ctx context.Context, userID uuid.UUID, u *dto.LanguagePreferencesUpdate) (*dto.LanguagePreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLanguagePreferences")
+	}
+
+	var r0 *dto.LanguagePreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.LanguagePreferencesUpdate) (*dto.LanguagePreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.LanguagePreferencesUpdate) *dto.LanguagePreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LanguagePreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.LanguagePreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LanguagePreferenceRepo_UpdateLanguagePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLanguagePreferences'
+type LanguagePreferenceRepo_UpdateLanguagePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateLanguagePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.LanguagePreferencesUpdate
+func (_e *LanguagePreferenceRepo_Expecter) UpdateLanguagePreferences(ctx interface{}, userID interface{}, u interface{}) *LanguagePreferenceRepo_UpdateLanguagePreferences_Call {
+	return &LanguagePreferenceRepo_UpdateLanguagePreferences_Call{Call: _e.mock.On("UpdateLanguagePreferences", ctx, userID, u)}
+}
+
+func (_c *LanguagePreferenceRepo_UpdateLanguagePreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.LanguagePreferencesUpdate)) *LanguagePreferenceRepo_UpdateLanguagePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.LanguagePreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *LanguagePreferenceRepo_UpdateLanguagePreferences_Call) Return(_a0 *dto.LanguagePreferences, _a1 error) *LanguagePreferenceRepo_UpdateLanguagePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LanguagePreferenceRepo_UpdateLanguagePreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.LanguagePreferencesUpdate) (*dto.LanguagePreferences, error)) *LanguagePreferenceRepo_UpdateLanguagePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLanguagePreferenceRepo creates a new instance of LanguagePreferenceRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLanguagePreferenceRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LanguagePreferenceRepo {
+	mock := &LanguagePreferenceRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,146 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ListUnsubscribeService is an autogenerated mock type for the ListUnsubscribeService type
+type ListUnsubscribeService struct {
+	mock.Mock
+}
+
+type ListUnsubscribeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListUnsubscribeService) EXPECT() *ListUnsubscribeService_Expecter {
+	return &ListUnsubscribeService_Expecter{mock: &_m.Mock}
+}
+
+// GetListUnsubscribe provides a mock function with given fields: ctx, userID, category
+func (_m *ListUnsubscribeService) GetListUnsubscribe(ctx context.Context, userID uuid.UUID, category dto.EmailCategory) (*dto.ListUnsubscribeResponse, error) {
+	ret := _m.Called(ctx, userID, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListUnsubscribe")
+	}
+
+	var r0 *dto.ListUnsubscribeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.EmailCategory) (*dto.ListUnsubscribeResponse, error)); ok {
+		return rf(ctx, userID, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.EmailCategory) *dto.ListUnsubscribeResponse); ok {
+		r0 = rf(ctx, userID, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ListUnsubscribeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.EmailCategory) error); ok {
+		r1 = rf(ctx, userID, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUnsubscribeService_GetListUnsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListUnsubscribe'
+type ListUnsubscribeService_GetListUnsubscribe_Call struct {
+	*mock.Call
+}
+
+// GetListUnsubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - category dto.EmailCategory
+func (_e *ListUnsubscribeService_Expecter) GetListUnsubscribe(ctx interface{}, userID interface{}, category interface{}) *ListUnsubscribeService_GetListUnsubscribe_Call {
+	return &ListUnsubscribeService_GetListUnsubscribe_Call{Call: _e.mock.On("GetListUnsubscribe", ctx, userID, category)}
+}
+
+func (_c *ListUnsubscribeService_GetListUnsubscribe_Call) Run(run func(ctx context.Context, userID uuid.UUID, category dto.EmailCategory)) *ListUnsubscribeService_GetListUnsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.EmailCategory))
+	})
+	return _c
+}
+
+func (_c *ListUnsubscribeService_GetListUnsubscribe_Call) Return(_a0 *dto.ListUnsubscribeResponse, _a1 error) *ListUnsubscribeService_GetListUnsubscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListUnsubscribeService_GetListUnsubscribe_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.EmailCategory) (*dto.ListUnsubscribeResponse, error)) *ListUnsubscribeService_GetListUnsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unsubscribe provides a mock function with given fields: ctx, token
+func (_m *ListUnsubscribeService) Unsubscribe(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unsubscribe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListUnsubscribeService_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type ListUnsubscribeService_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *ListUnsubscribeService_Expecter) Unsubscribe(ctx interface{}, token interface{}) *ListUnsubscribeService_Unsubscribe_Call {
+	return &ListUnsubscribeService_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", ctx, token)}
+}
+
+func (_c *ListUnsubscribeService_Unsubscribe_Call) Run(run func(ctx context.Context, token string)) *ListUnsubscribeService_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ListUnsubscribeService_Unsubscribe_Call) Return(_a0 error) *ListUnsubscribeService_Unsubscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ListUnsubscribeService_Unsubscribe_Call) RunAndReturn(run func(context.Context, string) error) *ListUnsubscribeService_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListUnsubscribeService creates a new instance of ListUnsubscribeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListUnsubscribeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListUnsubscribeService {
+	mock := &ListUnsubscribeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
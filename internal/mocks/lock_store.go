@@ -0,0 +1,204 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// LockStore is an autogenerated mock type for the LockStore type
+type LockStore struct {
+	mock.Mock
+}
+
+type LockStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LockStore) EXPECT() *LockStore_Expecter {
+	return &LockStore_Expecter{mock: &_m.Mock}
+}
+
+// AcquireLock provides a mock function with given fields: ctx, name, owner, ttl
+func (_m *LockStore) AcquireLock(ctx context.Context, name string, owner string, ttl time.Duration) (int64, error) {
+	ret := _m.Called(ctx, name, owner, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireLock")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (int64, error)); ok {
+		return rf(ctx, name, owner, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) int64); ok {
+		r0 = rf(ctx, name, owner, ttl)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, name, owner, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockStore_AcquireLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireLock'
+type LockStore_AcquireLock_Call struct {
+	*mock.Call
+}
+
+// AcquireLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - owner string
+//   - ttl time.Duration
+func (_e *LockStore_Expecter) AcquireLock(ctx interface{}, name interface{}, owner interface{}, ttl interface{}) *LockStore_AcquireLock_Call {
+	return &LockStore_AcquireLock_Call{Call: _e.mock.On("AcquireLock", ctx, name, owner, ttl)}
+}
+
+func (_c *LockStore_AcquireLock_Call) Run(run func(ctx context.Context, name string, owner string, ttl time.Duration)) *LockStore_AcquireLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *LockStore_AcquireLock_Call) Return(_a0 int64, _a1 error) *LockStore_AcquireLock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LockStore_AcquireLock_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) (int64, error)) *LockStore_AcquireLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseLock provides a mock function with given fields: ctx, name, owner
+func (_m *LockStore) ReleaseLock(ctx context.Context, name string, owner string) error {
+	ret := _m.Called(ctx, name, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseLock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, name, owner)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockStore_ReleaseLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseLock'
+type LockStore_ReleaseLock_Call struct {
+	*mock.Call
+}
+
+// ReleaseLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - owner string
+func (_e *LockStore_Expecter) ReleaseLock(ctx interface{}, name interface{}, owner interface{}) *LockStore_ReleaseLock_Call {
+	return &LockStore_ReleaseLock_Call{Call: _e.mock.On("ReleaseLock", ctx, name, owner)}
+}
+
+func (_c *LockStore_ReleaseLock_Call) Run(run func(ctx context.Context, name string, owner string)) *LockStore_ReleaseLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LockStore_ReleaseLock_Call) Return(_a0 error) *LockStore_ReleaseLock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LockStore_ReleaseLock_Call) RunAndReturn(run func(context.Context, string, string) error) *LockStore_ReleaseLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewLock provides a mock function with given fields: ctx, name, owner, ttl
+func (_m *LockStore) RenewLock(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, name, owner, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewLock")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (bool, error)); ok {
+		return rf(ctx, name, owner, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) bool); ok {
+		r0 = rf(ctx, name, owner, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, name, owner, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockStore_RenewLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewLock'
+type LockStore_RenewLock_Call struct {
+	*mock.Call
+}
+
+// RenewLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - owner string
+//   - ttl time.Duration
+func (_e *LockStore_Expecter) RenewLock(ctx interface{}, name interface{}, owner interface{}, ttl interface{}) *LockStore_RenewLock_Call {
+	return &LockStore_RenewLock_Call{Call: _e.mock.On("RenewLock", ctx, name, owner, ttl)}
+}
+
+func (_c *LockStore_RenewLock_Call) Run(run func(ctx context.Context, name string, owner string, ttl time.Duration)) *LockStore_RenewLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *LockStore_RenewLock_Call) Return(_a0 bool, _a1 error) *LockStore_RenewLock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LockStore_RenewLock_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) (bool, error)) *LockStore_RenewLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLockStore creates a new instance of LockStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLockStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LockStore {
+	mock := &LockStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
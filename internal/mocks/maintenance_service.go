@@ -0,0 +1,213 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MaintenanceService is an autogenerated mock type for the MaintenanceService type
+type MaintenanceService struct {
+	mock.Mock
+}
+
+type MaintenanceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MaintenanceService) EXPECT() *MaintenanceService_Expecter {
+	return &MaintenanceService_Expecter{mock: &_m.Mock}
+}
+
+// GetMaintenance provides a mock function with given fields: ctx
+func (_m *MaintenanceService) GetMaintenance(ctx context.Context) (*dto.MaintenanceStatus, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMaintenance")
+	}
+
+	var r0 *dto.MaintenanceStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.MaintenanceStatus, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.MaintenanceStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MaintenanceStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaintenanceService_GetMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMaintenance'
+type MaintenanceService_GetMaintenance_Call struct {
+	*mock.Call
+}
+
+// GetMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MaintenanceService_Expecter) GetMaintenance(ctx interface{}) *MaintenanceService_GetMaintenance_Call {
+	return &MaintenanceService_GetMaintenance_Call{Call: _e.mock.On("GetMaintenance", ctx)}
+}
+
+func (_c *MaintenanceService_GetMaintenance_Call) Run(run func(ctx context.Context)) *MaintenanceService_GetMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MaintenanceService_GetMaintenance_Call) Return(_a0 *dto.MaintenanceStatus, _a1 error) *MaintenanceService_GetMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MaintenanceService_GetMaintenance_Call) RunAndReturn(run func(context.Context) (*dto.MaintenanceStatus, error)) *MaintenanceService_GetMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaintenanceMode provides a mock function with given fields: ctx
+func (_m *MaintenanceService) MaintenanceMode(ctx context.Context) (bool, string) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaintenanceMode")
+	}
+
+	var r0 bool
+	var r1 string
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, string)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) string); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	return r0, r1
+}
+
+// MaintenanceService_MaintenanceMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaintenanceMode'
+type MaintenanceService_MaintenanceMode_Call struct {
+	*mock.Call
+}
+
+// MaintenanceMode is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MaintenanceService_Expecter) MaintenanceMode(ctx interface{}) *MaintenanceService_MaintenanceMode_Call {
+	return &MaintenanceService_MaintenanceMode_Call{Call: _e.mock.On("MaintenanceMode", ctx)}
+}
+
+func (_c *MaintenanceService_MaintenanceMode_Call) Run(run func(ctx context.Context)) *MaintenanceService_MaintenanceMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MaintenanceService_MaintenanceMode_Call) Return(_a0 bool, _a1 string) *MaintenanceService_MaintenanceMode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MaintenanceService_MaintenanceMode_Call) RunAndReturn(run func(context.Context) (bool, string)) *MaintenanceService_MaintenanceMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMaintenance provides a mock function with given fields: ctx, actorID, req
+func (_m *MaintenanceService) SetMaintenance(ctx context.Context, actorID uuid.UUID, req *dto.MaintenanceRequest) (*dto.MaintenanceStatus, error) {
+	ret := _m.Called(ctx, actorID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMaintenance")
+	}
+
+	var r0 *dto.MaintenanceStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.MaintenanceRequest) (*dto.MaintenanceStatus, error)); ok {
+		return rf(ctx, actorID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.MaintenanceRequest) *dto.MaintenanceStatus); ok {
+		r0 = rf(ctx, actorID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MaintenanceStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.MaintenanceRequest) error); ok {
+		r1 = rf(ctx, actorID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaintenanceService_SetMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMaintenance'
+type MaintenanceService_SetMaintenance_Call struct {
+	*mock.Call
+}
+
+// SetMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - req *dto.MaintenanceRequest
+func (_e *MaintenanceService_Expecter) SetMaintenance(ctx interface{}, actorID interface{}, req interface{}) *MaintenanceService_SetMaintenance_Call {
+	return &MaintenanceService_SetMaintenance_Call{Call: _e.mock.On("SetMaintenance", ctx, actorID, req)}
+}
+
+func (_c *MaintenanceService_SetMaintenance_Call) Run(run func(ctx context.Context, actorID uuid.UUID, req *dto.MaintenanceRequest)) *MaintenanceService_SetMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.MaintenanceRequest))
+	})
+	return _c
+}
+
+func (_c *MaintenanceService_SetMaintenance_Call) Return(_a0 *dto.MaintenanceStatus, _a1 error) *MaintenanceService_SetMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MaintenanceService_SetMaintenance_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.MaintenanceRequest) (*dto.MaintenanceStatus, error)) *MaintenanceService_SetMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMaintenanceService creates a new instance of MaintenanceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMaintenanceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MaintenanceService {
+	mock := &MaintenanceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
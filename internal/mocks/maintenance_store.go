@@ -0,0 +1,142 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MaintenanceStore is an autogenerated mock type for the MaintenanceStore type
+type MaintenanceStore struct {
+	mock.Mock
+}
+
+type MaintenanceStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MaintenanceStore) EXPECT() *MaintenanceStore_Expecter {
+	return &MaintenanceStore_Expecter{mock: &_m.Mock}
+}
+
+// GetMaintenance provides a mock function with given fields: ctx
+func (_m *MaintenanceStore) GetMaintenance(ctx context.Context) (*dto.MaintenanceStatus, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMaintenance")
+	}
+
+	var r0 *dto.MaintenanceStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.MaintenanceStatus, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.MaintenanceStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MaintenanceStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaintenanceStore_GetMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMaintenance'
+type MaintenanceStore_GetMaintenance_Call struct {
+	*mock.Call
+}
+
+// GetMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MaintenanceStore_Expecter) GetMaintenance(ctx interface{}) *MaintenanceStore_GetMaintenance_Call {
+	return &MaintenanceStore_GetMaintenance_Call{Call: _e.mock.On("GetMaintenance", ctx)}
+}
+
+func (_c *MaintenanceStore_GetMaintenance_Call) Run(run func(ctx context.Context)) *MaintenanceStore_GetMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MaintenanceStore_GetMaintenance_Call) Return(_a0 *dto.MaintenanceStatus, _a1 error) *MaintenanceStore_GetMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MaintenanceStore_GetMaintenance_Call) RunAndReturn(run func(context.Context) (*dto.MaintenanceStatus, error)) *MaintenanceStore_GetMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMaintenance provides a mock function with given fields: ctx, status
+func (_m *MaintenanceStore) SetMaintenance(ctx context.Context, status *dto.MaintenanceStatus) error {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMaintenance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.MaintenanceStatus) error); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MaintenanceStore_SetMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMaintenance'
+type MaintenanceStore_SetMaintenance_Call struct {
+	*mock.Call
+}
+
+// SetMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status *dto.MaintenanceStatus
+func (_e *MaintenanceStore_Expecter) SetMaintenance(ctx interface{}, status interface{}) *MaintenanceStore_SetMaintenance_Call {
+	return &MaintenanceStore_SetMaintenance_Call{Call: _e.mock.On("SetMaintenance", ctx, status)}
+}
+
+func (_c *MaintenanceStore_SetMaintenance_Call) Run(run func(ctx context.Context, status *dto.MaintenanceStatus)) *MaintenanceStore_SetMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dto.MaintenanceStatus))
+	})
+	return _c
+}
+
+func (_c *MaintenanceStore_SetMaintenance_Call) Return(_a0 error) *MaintenanceStore_SetMaintenance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MaintenanceStore_SetMaintenance_Call) RunAndReturn(run func(context.Context, *dto.MaintenanceStatus) error) *MaintenanceStore_SetMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMaintenanceStore creates a new instance of MaintenanceStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMaintenanceStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MaintenanceStore {
+	mock := &MaintenanceStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
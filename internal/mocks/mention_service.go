@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MentionService is an autogenerated mock type for the MentionService type
+type MentionService struct {
+	mock.Mock
+}
+
+type MentionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MentionService) EXPECT() *MentionService_Expecter {
+	return &MentionService_Expecter{mock: &_m.Mock}
+}
+
+// ResolveMentions provides a mock function with given fields: ctx, usernames
+func (_m *MentionService) ResolveMentions(ctx context.Context, usernames []string) (*dto.MentionResolveResponse, error) {
+	ret := _m.Called(ctx, usernames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveMentions")
+	}
+
+	var r0 *dto.MentionResolveResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (*dto.MentionResolveResponse, error)); ok {
+		return rf(ctx, usernames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *dto.MentionResolveResponse); ok {
+		r0 = rf(ctx, usernames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MentionResolveResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, usernames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MentionService_ResolveMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveMentions'
+type MentionService_ResolveMentions_Call struct {
+	*mock.Call
+}
+
+// ResolveMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usernames []string
+func (_e *MentionService_Expecter) ResolveMentions(ctx interface{}, usernames interface{}) *MentionService_ResolveMentions_Call {
+	return &MentionService_ResolveMentions_Call{Call: _e.mock.On("ResolveMentions", ctx, usernames)}
+}
+
+func (_c *MentionService_ResolveMentions_Call) Run(run func(ctx context.Context, usernames []string)) *MentionService_ResolveMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MentionService_ResolveMentions_Call) Return(_a0 *dto.MentionResolveResponse, _a1 error) *MentionService_ResolveMentions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MentionService_ResolveMentions_Call) RunAndReturn(run func(context.Context, []string) (*dto.MentionResolveResponse, error)) *MentionService_ResolveMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMentionService creates a new instance of MentionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMentionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MentionService {
+	mock := &MentionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
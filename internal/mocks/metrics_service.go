@@ -0,0 +1,269 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MetricsService is an autogenerated mock type for the MetricsService type
+type MetricsService struct {
+	mock.Mock
+}
+
+type MetricsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MetricsService) EXPECT() *MetricsService_Expecter {
+	return &MetricsService_Expecter{mock: &_m.Mock}
+}
+
+// GetCacheMetrics provides a mock function with given fields: ctx
+func (_m *MetricsService) GetCacheMetrics(ctx context.Context) (*dto.CacheMetricsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCacheMetrics")
+	}
+
+	var r0 *dto.CacheMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.CacheMetricsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.CacheMetricsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CacheMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsService_GetCacheMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheMetrics'
+type MetricsService_GetCacheMetrics_Call struct {
+	*mock.Call
+}
+
+// GetCacheMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MetricsService_Expecter) GetCacheMetrics(ctx interface{}) *MetricsService_GetCacheMetrics_Call {
+	return &MetricsService_GetCacheMetrics_Call{Call: _e.mock.On("GetCacheMetrics", ctx)}
+}
+
+func (_c *MetricsService_GetCacheMetrics_Call) Run(run func(ctx context.Context)) *MetricsService_GetCacheMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MetricsService_GetCacheMetrics_Call) Return(_a0 *dto.CacheMetricsResponse, _a1 error) *MetricsService_GetCacheMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsService_GetCacheMetrics_Call) RunAndReturn(run func(context.Context) (*dto.CacheMetricsResponse, error)) *MetricsService_GetCacheMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDetailedHealthMetrics provides a mock function with given fields: ctx
+func (_m *MetricsService) GetDetailedHealthMetrics(ctx context.Context) (*dto.DetailedHealthMetricsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetailedHealthMetrics")
+	}
+
+	var r0 *dto.DetailedHealthMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.DetailedHealthMetricsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.DetailedHealthMetricsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DetailedHealthMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsService_GetDetailedHealthMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetailedHealthMetrics'
+type MetricsService_GetDetailedHealthMetrics_Call struct {
+	*mock.Call
+}
+
+// GetDetailedHealthMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MetricsService_Expecter) GetDetailedHealthMetrics(ctx interface{}) *MetricsService_GetDetailedHealthMetrics_Call {
+	return &MetricsService_GetDetailedHealthMetrics_Call{Call: _e.mock.On("GetDetailedHealthMetrics", ctx)}
+}
+
+func (_c *MetricsService_GetDetailedHealthMetrics_Call) Run(run func(ctx context.Context)) *MetricsService_GetDetailedHealthMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MetricsService_GetDetailedHealthMetrics_Call) Return(_a0 *dto.DetailedHealthMetricsResponse, _a1 error) *MetricsService_GetDetailedHealthMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsService_GetDetailedHealthMetrics_Call) RunAndReturn(run func(context.Context) (*dto.DetailedHealthMetricsResponse, error)) *MetricsService_GetDetailedHealthMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPerformanceMetrics provides a mock function with given fields: ctx
+func (_m *MetricsService) GetPerformanceMetrics(ctx context.Context) (*dto.PerformanceMetricsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPerformanceMetrics")
+	}
+
+	var r0 *dto.PerformanceMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.PerformanceMetricsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.PerformanceMetricsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PerformanceMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsService_GetPerformanceMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPerformanceMetrics'
+type MetricsService_GetPerformanceMetrics_Call struct {
+	*mock.Call
+}
+
+// GetPerformanceMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MetricsService_Expecter) GetPerformanceMetrics(ctx interface{}) *MetricsService_GetPerformanceMetrics_Call {
+	return &MetricsService_GetPerformanceMetrics_Call{Call: _e.mock.On("GetPerformanceMetrics", ctx)}
+}
+
+func (_c *MetricsService_GetPerformanceMetrics_Call) Run(run func(ctx context.Context)) *MetricsService_GetPerformanceMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MetricsService_GetPerformanceMetrics_Call) Return(_a0 *dto.PerformanceMetricsResponse, _a1 error) *MetricsService_GetPerformanceMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsService_GetPerformanceMetrics_Call) RunAndReturn(run func(context.Context) (*dto.PerformanceMetricsResponse, error)) *MetricsService_GetPerformanceMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSystemMetrics provides a mock function with given fields: ctx
+func (_m *MetricsService) GetSystemMetrics(ctx context.Context) (*dto.SystemMetricsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystemMetrics")
+	}
+
+	var r0 *dto.SystemMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.SystemMetricsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.SystemMetricsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SystemMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsService_GetSystemMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSystemMetrics'
+type MetricsService_GetSystemMetrics_Call struct {
+	*mock.Call
+}
+
+// GetSystemMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MetricsService_Expecter) GetSystemMetrics(ctx interface{}) *MetricsService_GetSystemMetrics_Call {
+	return &MetricsService_GetSystemMetrics_Call{Call: _e.mock.On("GetSystemMetrics", ctx)}
+}
+
+func (_c *MetricsService_GetSystemMetrics_Call) Run(run func(ctx context.Context)) *MetricsService_GetSystemMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MetricsService_GetSystemMetrics_Call) Return(_a0 *dto.SystemMetricsResponse, _a1 error) *MetricsService_GetSystemMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsService_GetSystemMetrics_Call) RunAndReturn(run func(context.Context) (*dto.SystemMetricsResponse, error)) *MetricsService_GetSystemMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMetricsService creates a new instance of MetricsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetricsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MetricsService {
+	mock := &MetricsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
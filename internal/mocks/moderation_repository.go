@@ -0,0 +1,540 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ModerationRepository is an autogenerated mock type for the ModerationRepository type
+type ModerationRepository struct {
+	mock.Mock
+}
+
+type ModerationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModerationRepository) EXPECT() *ModerationRepository_Expecter {
+	return &ModerationRepository_Expecter{mock: &_m.Mock}
+}
+
+// ApproveChangeRequest provides a mock function with given fields: ctx, actorID, requestID
+func (_m *ModerationRepository) ApproveChangeRequest(ctx context.Context, actorID uuid.UUID, requestID int64) (*dto.ChangeRequest, error) {
+	ret := _m.Called(ctx, actorID, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveChangeRequest")
+	}
+
+	var r0 *dto.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) (*dto.ChangeRequest, error)); ok {
+		return rf(ctx, actorID, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) *dto.ChangeRequest); ok {
+		r0 = rf(ctx, actorID, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, actorID, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_ApproveChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveChangeRequest'
+type ModerationRepository_ApproveChangeRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveChangeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - requestID int64
+func (_e *ModerationRepository_Expecter) ApproveChangeRequest(ctx interface{}, actorID interface{}, requestID interface{}) *ModerationRepository_ApproveChangeRequest_Call {
+	return &ModerationRepository_ApproveChangeRequest_Call{Call: _e.mock.On("ApproveChangeRequest", ctx, actorID, requestID)}
+}
+
+func (_c *ModerationRepository_ApproveChangeRequest_Call) Run(run func(ctx context.Context, actorID uuid.UUID, requestID int64)) *ModerationRepository_ApproveChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_ApproveChangeRequest_Call) Return(_a0 *dto.ChangeRequest, _a1 error) *ModerationRepository_ApproveChangeRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_ApproveChangeRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64) (*dto.ChangeRequest, error)) *ModerationRepository_ApproveChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChangeRequest provides a mock function with given fields: ctx, request
+func (_m *ModerationRepository) CreateChangeRequest(ctx context.Context, request *dto.ChangeRequest) error {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChangeRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ChangeRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ModerationRepository_CreateChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChangeRequest'
+type ModerationRepository_CreateChangeRequest_Call struct {
+	*mock.Call
+}
+
+// CreateChangeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.ChangeRequest
+func (_e *ModerationRepository_Expecter) CreateChangeRequest(ctx interface{}, request interface{}) *ModerationRepository_CreateChangeRequest_Call {
+	return &ModerationRepository_CreateChangeRequest_Call{Call: _e.mock.On("CreateChangeRequest", ctx, request)}
+}
+
+func (_c *ModerationRepository_CreateChangeRequest_Call) Run(run func(ctx context.Context, request *dto.ChangeRequest)) *ModerationRepository_CreateChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dto.ChangeRequest))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_CreateChangeRequest_Call) Return(_a0 error) *ModerationRepository_CreateChangeRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModerationRepository_CreateChangeRequest_Call) RunAndReturn(run func(context.Context, *dto.ChangeRequest) error) *ModerationRepository_CreateChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlagContent provides a mock function with given fields: ctx, flag
+func (_m *ModerationRepository) FlagContent(ctx context.Context, flag *dto.ContentFlag) error {
+	ret := _m.Called(ctx, flag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlagContent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ContentFlag) error); ok {
+		r0 = rf(ctx, flag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ModerationRepository_FlagContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlagContent'
+type ModerationRepository_FlagContent_Call struct {
+	*mock.Call
+}
+
+// FlagContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flag *dto.ContentFlag
+func (_e *ModerationRepository_Expecter) FlagContent(ctx interface{}, flag interface{}) *ModerationRepository_FlagContent_Call {
+	return &ModerationRepository_FlagContent_Call{Call: _e.mock.On("FlagContent", ctx, flag)}
+}
+
+func (_c *ModerationRepository_FlagContent_Call) Run(run func(ctx context.Context, flag *dto.ContentFlag)) *ModerationRepository_FlagContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dto.ContentFlag))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_FlagContent_Call) Return(_a0 error) *ModerationRepository_FlagContent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModerationRepository_FlagContent_Call) RunAndReturn(run func(context.Context, *dto.ContentFlag) error) *ModerationRepository_FlagContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUnderModeration provides a mock function with given fields: ctx, userID
+func (_m *ModerationRepository) IsUnderModeration(ctx context.Context, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUnderModeration")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_IsUnderModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUnderModeration'
+type ModerationRepository_IsUnderModeration_Call struct {
+	*mock.Call
+}
+
+// IsUnderModeration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ModerationRepository_Expecter) IsUnderModeration(ctx interface{}, userID interface{}) *ModerationRepository_IsUnderModeration_Call {
+	return &ModerationRepository_IsUnderModeration_Call{Call: _e.mock.On("IsUnderModeration", ctx, userID)}
+}
+
+func (_c *ModerationRepository_IsUnderModeration_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ModerationRepository_IsUnderModeration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_IsUnderModeration_Call) Return(_a0 bool, _a1 error) *ModerationRepository_IsUnderModeration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_IsUnderModeration_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *ModerationRepository_IsUnderModeration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListChangeRequests provides a mock function with given fields: ctx, userID, status, limit
+func (_m *ModerationRepository) ListChangeRequests(ctx context.Context, userID uuid.UUID, status dto.ChangeRequestStatus, limit int) ([]dto.ChangeRequest, error) {
+	ret := _m.Called(ctx, userID, status, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChangeRequests")
+	}
+
+	var r0 []dto.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.ChangeRequestStatus, int) ([]dto.ChangeRequest, error)); ok {
+		return rf(ctx, userID, status, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.ChangeRequestStatus, int) []dto.ChangeRequest); ok {
+		r0 = rf(ctx, userID, status, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.ChangeRequestStatus, int) error); ok {
+		r1 = rf(ctx, userID, status, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_ListChangeRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChangeRequests'
+type ModerationRepository_ListChangeRequests_Call struct {
+	*mock.Call
+}
+
+// ListChangeRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - status dto.ChangeRequestStatus
+//   - limit int
+func (_e *ModerationRepository_Expecter) ListChangeRequests(ctx interface{}, userID interface{}, status interface{}, limit interface{}) *ModerationRepository_ListChangeRequests_Call {
+	return &ModerationRepository_ListChangeRequests_Call{Call: _e.mock.On("ListChangeRequests", ctx, userID, status, limit)}
+}
+
+func (_c *ModerationRepository_ListChangeRequests_Call) Run(run func(ctx context.Context, userID uuid.UUID, status dto.ChangeRequestStatus, limit int)) *ModerationRepository_ListChangeRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.ChangeRequestStatus), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_ListChangeRequests_Call) Return(_a0 []dto.ChangeRequest, _a1 error) *ModerationRepository_ListChangeRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_ListChangeRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.ChangeRequestStatus, int) ([]dto.ChangeRequest, error)) *ModerationRepository_ListChangeRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListContentFlags provides a mock function with given fields: ctx, limit
+func (_m *ModerationRepository) ListContentFlags(ctx context.Context, limit int) ([]dto.ContentFlag, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListContentFlags")
+	}
+
+	var r0 []dto.ContentFlag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]dto.ContentFlag, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []dto.ContentFlag); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ContentFlag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_ListContentFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListContentFlags'
+type ModerationRepository_ListContentFlags_Call struct {
+	*mock.Call
+}
+
+// ListContentFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *ModerationRepository_Expecter) ListContentFlags(ctx interface{}, limit interface{}) *ModerationRepository_ListContentFlags_Call {
+	return &ModerationRepository_ListContentFlags_Call{Call: _e.mock.On("ListContentFlags", ctx, limit)}
+}
+
+func (_c *ModerationRepository_ListContentFlags_Call) Run(run func(ctx context.Context, limit int)) *ModerationRepository_ListContentFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_ListContentFlags_Call) Return(_a0 []dto.ContentFlag, _a1 error) *ModerationRepository_ListContentFlags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_ListContentFlags_Call) RunAndReturn(run func(context.Context, int) ([]dto.ContentFlag, error)) *ModerationRepository_ListContentFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectChangeRequest provides a mock function with given fields: ctx, actorID, requestID, reason
+func (_m *ModerationRepository) RejectChangeRequest(ctx context.Context, actorID uuid.UUID, requestID int64, reason string) (*dto.ChangeRequest, error) {
+	ret := _m.Called(ctx, actorID, requestID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectChangeRequest")
+	}
+
+	var r0 *dto.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64, string) (*dto.ChangeRequest, error)); ok {
+		return rf(ctx, actorID, requestID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64, string) *dto.ChangeRequest); ok {
+		r0 = rf(ctx, actorID, requestID, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64, string) error); ok {
+		r1 = rf(ctx, actorID, requestID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_RejectChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectChangeRequest'
+type ModerationRepository_RejectChangeRequest_Call struct {
+	*mock.Call
+}
+
+// RejectChangeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - requestID int64
+//   - reason string
+func (_e *ModerationRepository_Expecter) RejectChangeRequest(ctx interface{}, actorID interface{}, requestID interface{}, reason interface{}) *ModerationRepository_RejectChangeRequest_Call {
+	return &ModerationRepository_RejectChangeRequest_Call{Call: _e.mock.On("RejectChangeRequest", ctx, actorID, requestID, reason)}
+}
+
+func (_c *ModerationRepository_RejectChangeRequest_Call) Run(run func(ctx context.Context, actorID uuid.UUID, requestID int64, reason string)) *ModerationRepository_RejectChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_RejectChangeRequest_Call) Return(_a0 *dto.ChangeRequest, _a1 error) *ModerationRepository_RejectChangeRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_RejectChangeRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64, string) (*dto.ChangeRequest, error)) *ModerationRepository_RejectChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveContentFlag provides a mock function with given fields: ctx, actorID, flagID
+func (_m *ModerationRepository) ResolveContentFlag(ctx context.Context, actorID uuid.UUID, flagID int64) (*dto.ContentFlag, error) {
+	ret := _m.Called(ctx, actorID, flagID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveContentFlag")
+	}
+
+	var r0 *dto.ContentFlag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) (*dto.ContentFlag, error)); ok {
+		return rf(ctx, actorID, flagID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) *dto.ContentFlag); ok {
+		r0 = rf(ctx, actorID, flagID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ContentFlag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, actorID, flagID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_ResolveContentFlag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveContentFlag'
+type ModerationRepository_ResolveContentFlag_Call struct {
+	*mock.Call
+}
+
+// ResolveContentFlag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - flagID int64
+func (_e *ModerationRepository_Expecter) ResolveContentFlag(ctx interface{}, actorID interface{}, flagID interface{}) *ModerationRepository_ResolveContentFlag_Call {
+	return &ModerationRepository_ResolveContentFlag_Call{Call: _e.mock.On("ResolveContentFlag", ctx, actorID, flagID)}
+}
+
+func (_c *ModerationRepository_ResolveContentFlag_Call) Run(run func(ctx context.Context, actorID uuid.UUID, flagID int64)) *ModerationRepository_ResolveContentFlag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_ResolveContentFlag_Call) Return(_a0 *dto.ContentFlag, _a1 error) *ModerationRepository_ResolveContentFlag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_ResolveContentFlag_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64) (*dto.ContentFlag, error)) *ModerationRepository_ResolveContentFlag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUnderModeration provides a mock function with given fields: ctx, actorID, userID, flagged
+func (_m *ModerationRepository) SetUnderModeration(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, flagged bool) error {
+	ret := _m.Called(ctx, actorID, userID, flagged)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUnderModeration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) error); ok {
+		r0 = rf(ctx, actorID, userID, flagged)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ModerationRepository_SetUnderModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUnderModeration'
+type ModerationRepository_SetUnderModeration_Call struct {
+	*mock.Call
+}
+
+// SetUnderModeration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - flagged bool
+func (_e *ModerationRepository_Expecter) SetUnderModeration(ctx interface{}, actorID interface{}, userID interface{}, flagged interface{}) *ModerationRepository_SetUnderModeration_Call {
+	return &ModerationRepository_SetUnderModeration_Call{Call: _e.mock.On("SetUnderModeration", ctx, actorID, userID, flagged)}
+}
+
+func (_c *ModerationRepository_SetUnderModeration_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, flagged bool)) *ModerationRepository_SetUnderModeration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_SetUnderModeration_Call) Return(_a0 error) *ModerationRepository_SetUnderModeration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModerationRepository_SetUnderModeration_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, bool) error) *ModerationRepository_SetUnderModeration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModerationRepository creates a new instance of ModerationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModerationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModerationRepository {
+	mock := &ModerationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
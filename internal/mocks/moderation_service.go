@@ -0,0 +1,517 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ModerationService is an autogenerated mock type for the ModerationService type
+type ModerationService struct {
+	mock.Mock
+}
+
+type ModerationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModerationService) EXPECT() *ModerationService_Expecter {
+	return &ModerationService_Expecter{mock: &_m.Mock}
+}
+
+// ApproveChangeRequest provides a mock function with given fields: ctx, actorID, requestID
+func (_m *ModerationService) ApproveChangeRequest(ctx context.Context, actorID uuid.UUID, requestID int64) (*dto.ChangeRequest, error) {
+	ret := _m.Called(ctx, actorID, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveChangeRequest")
+	}
+
+	var r0 *dto.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) (*dto.ChangeRequest, error)); ok {
+		return rf(ctx, actorID, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) *dto.ChangeRequest); ok {
+		r0 = rf(ctx, actorID, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, actorID, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_ApproveChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveChangeRequest'
+type ModerationService_ApproveChangeRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveChangeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - requestID int64
+func (_e *ModerationService_Expecter) ApproveChangeRequest(ctx interface{}, actorID interface{}, requestID interface{}) *ModerationService_ApproveChangeRequest_Call {
+	return &ModerationService_ApproveChangeRequest_Call{Call: _e.mock.On("ApproveChangeRequest", ctx, actorID, requestID)}
+}
+
+func (_c *ModerationService_ApproveChangeRequest_Call) Run(run func(ctx context.Context, actorID uuid.UUID, requestID int64)) *ModerationService_ApproveChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ModerationService_ApproveChangeRequest_Call) Return(_a0 *dto.ChangeRequest, _a1 error) *ModerationService_ApproveChangeRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_ApproveChangeRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64) (*dto.ChangeRequest, error)) *ModerationService_ApproveChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListChangeRequests provides a mock function with given fields: ctx, status
+func (_m *ModerationService) ListChangeRequests(ctx context.Context, status dto.ChangeRequestStatus) (*dto.ChangeRequestsResponse, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChangeRequests")
+	}
+
+	var r0 *dto.ChangeRequestsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ChangeRequestStatus) (*dto.ChangeRequestsResponse, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ChangeRequestStatus) *dto.ChangeRequestsResponse); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ChangeRequestsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ChangeRequestStatus) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_ListChangeRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChangeRequests'
+type ModerationService_ListChangeRequests_Call struct {
+	*mock.Call
+}
+
+// ListChangeRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status dto.ChangeRequestStatus
+func (_e *ModerationService_Expecter) ListChangeRequests(ctx interface{}, status interface{}) *ModerationService_ListChangeRequests_Call {
+	return &ModerationService_ListChangeRequests_Call{Call: _e.mock.On("ListChangeRequests", ctx, status)}
+}
+
+func (_c *ModerationService_ListChangeRequests_Call) Run(run func(ctx context.Context, status dto.ChangeRequestStatus)) *ModerationService_ListChangeRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ChangeRequestStatus))
+	})
+	return _c
+}
+
+func (_c *ModerationService_ListChangeRequests_Call) Return(_a0 *dto.ChangeRequestsResponse, _a1 error) *ModerationService_ListChangeRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_ListChangeRequests_Call) RunAndReturn(run func(context.Context, dto.ChangeRequestStatus) (*dto.ChangeRequestsResponse, error)) *ModerationService_ListChangeRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListContentFlags provides a mock function with given fields: ctx
+func (_m *ModerationService) ListContentFlags(ctx context.Context) (*dto.ContentFlagsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListContentFlags")
+	}
+
+	var r0 *dto.ContentFlagsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.ContentFlagsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.ContentFlagsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ContentFlagsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_ListContentFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListContentFlags'
+type ModerationService_ListContentFlags_Call struct {
+	*mock.Call
+}
+
+// ListContentFlags is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ModerationService_Expecter) ListContentFlags(ctx interface{}) *ModerationService_ListContentFlags_Call {
+	return &ModerationService_ListContentFlags_Call{Call: _e.mock.On("ListContentFlags", ctx)}
+}
+
+func (_c *ModerationService_ListContentFlags_Call) Run(run func(ctx context.Context)) *ModerationService_ListContentFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ModerationService_ListContentFlags_Call) Return(_a0 *dto.ContentFlagsResponse, _a1 error) *ModerationService_ListContentFlags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_ListContentFlags_Call) RunAndReturn(run func(context.Context) (*dto.ContentFlagsResponse, error)) *ModerationService_ListContentFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserChangeRequests provides a mock function with given fields: ctx, userID
+func (_m *ModerationService) ListUserChangeRequests(ctx context.Context, userID uuid.UUID) (*dto.ChangeRequestsResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserChangeRequests")
+	}
+
+	var r0 *dto.ChangeRequestsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.ChangeRequestsResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.ChangeRequestsResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ChangeRequestsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_ListUserChangeRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserChangeRequests'
+type ModerationService_ListUserChangeRequests_Call struct {
+	*mock.Call
+}
+
+// ListUserChangeRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ModerationService_Expecter) ListUserChangeRequests(ctx interface{}, userID interface{}) *ModerationService_ListUserChangeRequests_Call {
+	return &ModerationService_ListUserChangeRequests_Call{Call: _e.mock.On("ListUserChangeRequests", ctx, userID)}
+}
+
+func (_c *ModerationService_ListUserChangeRequests_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ModerationService_ListUserChangeRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ModerationService_ListUserChangeRequests_Call) Return(_a0 *dto.ChangeRequestsResponse, _a1 error) *ModerationService_ListUserChangeRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_ListUserChangeRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.ChangeRequestsResponse, error)) *ModerationService_ListUserChangeRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectChangeRequest provides a mock function with given fields: ctx, actorID, requestID, reason
+func (_m *ModerationService) RejectChangeRequest(ctx context.Context, actorID uuid.UUID, requestID int64, reason string) (*dto.ChangeRequest, error) {
+	ret := _m.Called(ctx, actorID, requestID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectChangeRequest")
+	}
+
+	var r0 *dto.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64, string) (*dto.ChangeRequest, error)); ok {
+		return rf(ctx, actorID, requestID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64, string) *dto.ChangeRequest); ok {
+		r0 = rf(ctx, actorID, requestID, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64, string) error); ok {
+		r1 = rf(ctx, actorID, requestID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_RejectChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectChangeRequest'
+type ModerationService_RejectChangeRequest_Call struct {
+	*mock.Call
+}
+
+// RejectChangeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - requestID int64
+//   - reason string
+func (_e *ModerationService_Expecter) RejectChangeRequest(ctx interface{}, actorID interface{}, requestID interface{}, reason interface{}) *ModerationService_RejectChangeRequest_Call {
+	return &ModerationService_RejectChangeRequest_Call{Call: _e.mock.On("RejectChangeRequest", ctx, actorID, requestID, reason)}
+}
+
+func (_c *ModerationService_RejectChangeRequest_Call) Run(run func(ctx context.Context, actorID uuid.UUID, requestID int64, reason string)) *ModerationService_RejectChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ModerationService_RejectChangeRequest_Call) Return(_a0 *dto.ChangeRequest, _a1 error) *ModerationService_RejectChangeRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_RejectChangeRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64, string) (*dto.ChangeRequest, error)) *ModerationService_RejectChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveContentFlag provides a mock function with given fields: ctx, actorID, flagID
+func (_m *ModerationService) ResolveContentFlag(ctx context.Context, actorID uuid.UUID, flagID int64) (*dto.ContentFlag, error) {
+	ret := _m.Called(ctx, actorID, flagID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveContentFlag")
+	}
+
+	var r0 *dto.ContentFlag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) (*dto.ContentFlag, error)); ok {
+		return rf(ctx, actorID, flagID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) *dto.ContentFlag); ok {
+		r0 = rf(ctx, actorID, flagID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ContentFlag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, actorID, flagID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_ResolveContentFlag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveContentFlag'
+type ModerationService_ResolveContentFlag_Call struct {
+	*mock.Call
+}
+
+// ResolveContentFlag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - flagID int64
+func (_e *ModerationService_Expecter) ResolveContentFlag(ctx interface{}, actorID interface{}, flagID interface{}) *ModerationService_ResolveContentFlag_Call {
+	return &ModerationService_ResolveContentFlag_Call{Call: _e.mock.On("ResolveContentFlag", ctx, actorID, flagID)}
+}
+
+func (_c *ModerationService_ResolveContentFlag_Call) Run(run func(ctx context.Context, actorID uuid.UUID, flagID int64)) *ModerationService_ResolveContentFlag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ModerationService_ResolveContentFlag_Call) Return(_a0 *dto.ContentFlag, _a1 error) *ModerationService_ResolveContentFlag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_ResolveContentFlag_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64) (*dto.ContentFlag, error)) *ModerationService_ResolveContentFlag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUnderModeration provides a mock function with given fields: ctx, actorID, userID, flagged
+func (_m *ModerationService) SetUnderModeration(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, flagged bool) (*dto.ModerationStatusResponse, error) {
+	ret := _m.Called(ctx, actorID, userID, flagged)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUnderModeration")
+	}
+
+	var r0 *dto.ModerationStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) (*dto.ModerationStatusResponse, error)); ok {
+		return rf(ctx, actorID, userID, flagged)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) *dto.ModerationStatusResponse); ok {
+		r0 = rf(ctx, actorID, userID, flagged)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ModerationStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, actorID, userID, flagged)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_SetUnderModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUnderModeration'
+type ModerationService_SetUnderModeration_Call struct {
+	*mock.Call
+}
+
+// SetUnderModeration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - flagged bool
+func (_e *ModerationService_Expecter) SetUnderModeration(ctx interface{}, actorID interface{}, userID interface{}, flagged interface{}) *ModerationService_SetUnderModeration_Call {
+	return &ModerationService_SetUnderModeration_Call{Call: _e.mock.On("SetUnderModeration", ctx, actorID, userID, flagged)}
+}
+
+func (_c *ModerationService_SetUnderModeration_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, flagged bool)) *ModerationService_SetUnderModeration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *ModerationService_SetUnderModeration_Call) Return(_a0 *dto.ModerationStatusResponse, _a1 error) *ModerationService_SetUnderModeration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_SetUnderModeration_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, bool) (*dto.ModerationStatusResponse, error)) *ModerationService_SetUnderModeration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitChangeRequest provides a mock function with given fields: ctx, userID, req
+func (_m *ModerationService) SubmitChangeRequest(ctx context.Context, userID uuid.UUID, req *dto.ChangeRequestSubmission) (*dto.ChangeRequest, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitChangeRequest")
+	}
+
+	var r0 *dto.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.ChangeRequestSubmission) (*dto.ChangeRequest, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.ChangeRequestSubmission) *dto.ChangeRequest); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.ChangeRequestSubmission) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_SubmitChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitChangeRequest'
+type ModerationService_SubmitChangeRequest_Call struct {
+	*mock.Call
+}
+
+// SubmitChangeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - req *dto.ChangeRequestSubmission
+func (_e *ModerationService_Expecter) SubmitChangeRequest(ctx interface{}, userID interface{}, req interface{}) *ModerationService_SubmitChangeRequest_Call {
+	return &ModerationService_SubmitChangeRequest_Call{Call: _e.mock.On("SubmitChangeRequest", ctx, userID, req)}
+}
+
+func (_c *ModerationService_SubmitChangeRequest_Call) Run(run func(ctx context.Context, userID uuid.UUID, req *dto.ChangeRequestSubmission)) *ModerationService_SubmitChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.ChangeRequestSubmission))
+	})
+	return _c
+}
+
+func (_c *ModerationService_SubmitChangeRequest_Call) Return(_a0 *dto.ChangeRequest, _a1 error) *ModerationService_SubmitChangeRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_SubmitChangeRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.ChangeRequestSubmission) (*dto.ChangeRequest, error)) *ModerationService_SubmitChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModerationService creates a new instance of ModerationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModerationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModerationService {
+	mock := &ModerationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
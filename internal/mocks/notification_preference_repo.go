@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// NotificationPreferenceRepo is an autogenerated mock type for the NotificationPreferenceRepo type
+type NotificationPreferenceRepo struct {
+	mock.Mock
+}
+
+type NotificationPreferenceRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotificationPreferenceRepo) EXPECT() *NotificationPreferenceRepo_Expecter {
+	return &NotificationPreferenceRepo_Expecter{mock: &_m.Mock}
+}
+
+// GetNotificationPreferences provides a mock function with given fields: ctx, userID
+func (_m *NotificationPreferenceRepo) GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (*dto.NotificationPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationPreferences")
+	}
+
+	var r0 *dto.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.NotificationPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.NotificationPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.NotificationPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationPreferenceRepo_GetNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationPreferences'
+type NotificationPreferenceRepo_GetNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// GetNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *NotificationPreferenceRepo_Expecter) GetNotificationPreferences(ctx interface{}, userID interface{}) *NotificationPreferenceRepo_GetNotificationPreferences_Call {
+	return &NotificationPreferenceRepo_GetNotificationPreferences_Call{Call: _e.mock.On("GetNotificationPreferences", ctx, userID)}
+}
+
+func (_c *NotificationPreferenceRepo_GetNotificationPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *NotificationPreferenceRepo_GetNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *NotificationPreferenceRepo_GetNotificationPreferences_Call) Return(_a0 *dto.NotificationPreferences, _a1 error) *NotificationPreferenceRepo_GetNotificationPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationPreferenceRepo_GetNotificationPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.NotificationPreferences, error)) *NotificationPreferenceRepo_GetNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNotificationPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *NotificationPreferenceRepo) UpdateNotificationPreferences(ctx context.Context, userID uuid.UUID, u *dto.NotificationPreferencesUpdate) (*dto.NotificationPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationPreferences")
+	}
+
+	var r0 *dto.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.NotificationPreferencesUpdate) (*dto.NotificationPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.NotificationPreferencesUpdate) *dto.NotificationPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.NotificationPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.NotificationPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationPreferenceRepo_UpdateNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationPreferences'
+type NotificationPreferenceRepo_UpdateNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.NotificationPreferencesUpdate
+func (_e *NotificationPreferenceRepo_Expecter) UpdateNotificationPreferences(ctx interface{}, userID interface{}, u interface{}) *NotificationPreferenceRepo_UpdateNotificationPreferences_Call {
+	return &NotificationPreferenceRepo_UpdateNotificationPreferences_Call{Call: _e.mock.On("UpdateNotificationPreferences", ctx, userID, u)}
+}
+
+func (_c *NotificationPreferenceRepo_UpdateNotificationPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.NotificationPreferencesUpdate)) *NotificationPreferenceRepo_UpdateNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.NotificationPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *NotificationPreferenceRepo_UpdateNotificationPreferences_Call) Return(_a0 *dto.NotificationPreferences, _a1 error) *NotificationPreferenceRepo_UpdateNotificationPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationPreferenceRepo_UpdateNotificationPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.NotificationPreferencesUpdate) (*dto.NotificationPreferences, error)) *NotificationPreferenceRepo_UpdateNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotificationPreferenceRepo creates a new instance of NotificationPreferenceRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationPreferenceRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationPreferenceRepo {
+	mock := &NotificationPreferenceRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
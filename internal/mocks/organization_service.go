@@ -0,0 +1,437 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// OrganizationService is an autogenerated mock type for the OrganizationService type
+type OrganizationService struct {
+	mock.Mock
+}
+
+type OrganizationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrganizationService) EXPECT() *OrganizationService_Expecter {
+	return &OrganizationService_Expecter{mock: &_m.Mock}
+}
+
+// CanActAs provides a mock function with given fields: ctx, orgID, userID, destructive
+func (_m *OrganizationService) CanActAs(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, destructive bool) (bool, error) {
+	ret := _m.Called(ctx, orgID, userID, destructive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanActAs")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) (bool, error)); ok {
+		return rf(ctx, orgID, userID, destructive)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) bool); ok {
+		r0 = rf(ctx, orgID, userID, destructive)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, orgID, userID, destructive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_CanActAs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanActAs'
+type OrganizationService_CanActAs_Call struct {
+	*mock.Call
+}
+
+// CanActAs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID uuid.UUID
+//   - userID uuid.UUID
+//   - destructive bool
+func (_e *OrganizationService_Expecter) CanActAs(ctx interface{}, orgID interface{}, userID interface{}, destructive interface{}) *OrganizationService_CanActAs_Call {
+	return &OrganizationService_CanActAs_Call{Call: _e.mock.On("CanActAs", ctx, orgID, userID, destructive)}
+}
+
+func (_c *OrganizationService_CanActAs_Call) Run(run func(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, destructive bool)) *OrganizationService_CanActAs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_CanActAs_Call) Return(_a0 bool, _a1 error) *OrganizationService_CanActAs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_CanActAs_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, bool) (bool, error)) *OrganizationService_CanActAs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganization provides a mock function with given fields: ctx, ownerID, req
+func (_m *OrganizationService) CreateOrganization(ctx context.Context, ownerID uuid.UUID, req *dto.OrganizationCreateRequest) (*dto.Organization, error) {
+	ret := _m.Called(ctx, ownerID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 *dto.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.OrganizationCreateRequest) (*dto.Organization, error)); ok {
+		return rf(ctx, ownerID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.OrganizationCreateRequest) *dto.Organization); ok {
+		r0 = rf(ctx, ownerID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.OrganizationCreateRequest) error); ok {
+		r1 = rf(ctx, ownerID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type OrganizationService_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID uuid.UUID
+//   - req *dto.OrganizationCreateRequest
+func (_e *OrganizationService_Expecter) CreateOrganization(ctx interface{}, ownerID interface{}, req interface{}) *OrganizationService_CreateOrganization_Call {
+	return &OrganizationService_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, ownerID, req)}
+}
+
+func (_c *OrganizationService_CreateOrganization_Call) Run(run func(ctx context.Context, ownerID uuid.UUID, req *dto.OrganizationCreateRequest)) *OrganizationService_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.OrganizationCreateRequest))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_CreateOrganization_Call) Return(_a0 *dto.Organization, _a1 error) *OrganizationService_CreateOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_CreateOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.OrganizationCreateRequest) (*dto.Organization, error)) *OrganizationService_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: ctx, requesterID, orgID
+func (_m *OrganizationService) ListMembers(ctx context.Context, requesterID uuid.UUID, orgID uuid.UUID) (*dto.OrganizationMembersResponse, error) {
+	ret := _m.Called(ctx, requesterID, orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 *dto.OrganizationMembersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*dto.OrganizationMembersResponse, error)); ok {
+		return rf(ctx, requesterID, orgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *dto.OrganizationMembersResponse); ok {
+		r0 = rf(ctx, requesterID, orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrganizationMembersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, requesterID, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type OrganizationService_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - orgID uuid.UUID
+func (_e *OrganizationService_Expecter) ListMembers(ctx interface{}, requesterID interface{}, orgID interface{}) *OrganizationService_ListMembers_Call {
+	return &OrganizationService_ListMembers_Call{Call: _e.mock.On("ListMembers", ctx, requesterID, orgID)}
+}
+
+func (_c *OrganizationService_ListMembers_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, orgID uuid.UUID)) *OrganizationService_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_ListMembers_Call) Return(_a0 *dto.OrganizationMembersResponse, _a1 error) *OrganizationService_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_ListMembers_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*dto.OrganizationMembersResponse, error)) *OrganizationService_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserOrganizations provides a mock function with given fields: ctx, userID
+func (_m *OrganizationService) ListUserOrganizations(ctx context.Context, userID uuid.UUID) (*dto.OrganizationsResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserOrganizations")
+	}
+
+	var r0 *dto.OrganizationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.OrganizationsResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.OrganizationsResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrganizationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_ListUserOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserOrganizations'
+type OrganizationService_ListUserOrganizations_Call struct {
+	*mock.Call
+}
+
+// ListUserOrganizations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *OrganizationService_Expecter) ListUserOrganizations(ctx interface{}, userID interface{}) *OrganizationService_ListUserOrganizations_Call {
+	return &OrganizationService_ListUserOrganizations_Call{Call: _e.mock.On("ListUserOrganizations", ctx, userID)}
+}
+
+func (_c *OrganizationService_ListUserOrganizations_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *OrganizationService_ListUserOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_ListUserOrganizations_Call) Return(_a0 *dto.OrganizationsResponse, _a1 error) *OrganizationService_ListUserOrganizations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_ListUserOrganizations_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.OrganizationsResponse, error)) *OrganizationService_ListUserOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordAction provides a mock function with given fields: ctx, actorID, orgID, action
+func (_m *OrganizationService) RecordAction(ctx context.Context, actorID uuid.UUID, orgID uuid.UUID, action string) error {
+	ret := _m.Called(ctx, actorID, orgID, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, actorID, orgID, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrganizationService_RecordAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAction'
+type OrganizationService_RecordAction_Call struct {
+	*mock.Call
+}
+
+// RecordAction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - orgID uuid.UUID
+//   - action string
+func (_e *OrganizationService_Expecter) RecordAction(ctx interface{}, actorID interface{}, orgID interface{}, action interface{}) *OrganizationService_RecordAction_Call {
+	return &OrganizationService_RecordAction_Call{Call: _e.mock.On("RecordAction", ctx, actorID, orgID, action)}
+}
+
+func (_c *OrganizationService_RecordAction_Call) Run(run func(ctx context.Context, actorID uuid.UUID, orgID uuid.UUID, action string)) *OrganizationService_RecordAction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_RecordAction_Call) Return(_a0 error) *OrganizationService_RecordAction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationService_RecordAction_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *OrganizationService_RecordAction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, actorID, orgID, userID
+func (_m *OrganizationService) RemoveMember(ctx context.Context, actorID uuid.UUID, orgID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, actorID, orgID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, actorID, orgID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrganizationService_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type OrganizationService_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - orgID uuid.UUID
+//   - userID uuid.UUID
+func (_e *OrganizationService_Expecter) RemoveMember(ctx interface{}, actorID interface{}, orgID interface{}, userID interface{}) *OrganizationService_RemoveMember_Call {
+	return &OrganizationService_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, actorID, orgID, userID)}
+}
+
+func (_c *OrganizationService_RemoveMember_Call) Run(run func(ctx context.Context, actorID uuid.UUID, orgID uuid.UUID, userID uuid.UUID)) *OrganizationService_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_RemoveMember_Call) Return(_a0 error) *OrganizationService_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationService_RemoveMember_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *OrganizationService_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMember provides a mock function with given fields: ctx, actorID, orgID, userID, role
+func (_m *OrganizationService) SetMember(ctx context.Context, actorID uuid.UUID, orgID uuid.UUID, userID uuid.UUID, role dto.OrganizationRole) (*dto.OrganizationMembersResponse, error) {
+	ret := _m.Called(ctx, actorID, orgID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMember")
+	}
+
+	var r0 *dto.OrganizationMembersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, dto.OrganizationRole) (*dto.OrganizationMembersResponse, error)); ok {
+		return rf(ctx, actorID, orgID, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, dto.OrganizationRole) *dto.OrganizationMembersResponse); ok {
+		r0 = rf(ctx, actorID, orgID, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrganizationMembersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, dto.OrganizationRole) error); ok {
+		r1 = rf(ctx, actorID, orgID, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_SetMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMember'
+type OrganizationService_SetMember_Call struct {
+	*mock.Call
+}
+
+// SetMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - orgID uuid.UUID
+//   - userID uuid.UUID
+//   - role dto.OrganizationRole
+func (_e *OrganizationService_Expecter) SetMember(ctx interface{}, actorID interface{}, orgID interface{}, userID interface{}, role interface{}) *OrganizationService_SetMember_Call {
+	return &OrganizationService_SetMember_Call{Call: _e.mock.On("SetMember", ctx, actorID, orgID, userID, role)}
+}
+
+func (_c *OrganizationService_SetMember_Call) Run(run func(ctx context.Context, actorID uuid.UUID, orgID uuid.UUID, userID uuid.UUID, role dto.OrganizationRole)) *OrganizationService_SetMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(dto.OrganizationRole))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_SetMember_Call) Return(_a0 *dto.OrganizationMembersResponse, _a1 error) *OrganizationService_SetMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_SetMember_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, dto.OrganizationRole) (*dto.OrganizationMembersResponse, error)) *OrganizationService_SetMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrganizationService creates a new instance of OrganizationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrganizationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrganizationService {
+	mock := &OrganizationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,146 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PolicyAcceptanceRepository is an autogenerated mock type for the PolicyAcceptanceRepository type
+type PolicyAcceptanceRepository struct {
+	mock.Mock
+}
+
+type PolicyAcceptanceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PolicyAcceptanceRepository) EXPECT() *PolicyAcceptanceRepository_Expecter {
+	return &PolicyAcceptanceRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetPolicyAcceptances provides a mock function with given fields: ctx, userID
+func (_m *PolicyAcceptanceRepository) GetPolicyAcceptances(ctx context.Context, userID uuid.UUID) ([]dto.PolicyAcceptance, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicyAcceptances")
+	}
+
+	var r0 []dto.PolicyAcceptance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.PolicyAcceptance, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.PolicyAcceptance); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.PolicyAcceptance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyAcceptanceRepository_GetPolicyAcceptances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicyAcceptances'
+type PolicyAcceptanceRepository_GetPolicyAcceptances_Call struct {
+	*mock.Call
+}
+
+// GetPolicyAcceptances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PolicyAcceptanceRepository_Expecter) GetPolicyAcceptances(ctx interface{}, userID interface{}) *PolicyAcceptanceRepository_GetPolicyAcceptances_Call {
+	return &PolicyAcceptanceRepository_GetPolicyAcceptances_Call{Call: _e.mock.On("GetPolicyAcceptances", ctx, userID)}
+}
+
+func (_c *PolicyAcceptanceRepository_GetPolicyAcceptances_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PolicyAcceptanceRepository_GetPolicyAcceptances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PolicyAcceptanceRepository_GetPolicyAcceptances_Call) Return(_a0 []dto.PolicyAcceptance, _a1 error) *PolicyAcceptanceRepository_GetPolicyAcceptances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyAcceptanceRepository_GetPolicyAcceptances_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.PolicyAcceptance, error)) *PolicyAcceptanceRepository_GetPolicyAcceptances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordPolicyAcceptance provides a mock function with given fields: ctx, userID, acceptance
+func (_m *PolicyAcceptanceRepository) RecordPolicyAcceptance(ctx context.Context, userID uuid.UUID, acceptance *dto.PolicyAcceptance) error {
+	ret := _m.Called(ctx, userID, acceptance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPolicyAcceptance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PolicyAcceptance) error); ok {
+		r0 = rf(ctx, userID, acceptance)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PolicyAcceptanceRepository_RecordPolicyAcceptance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPolicyAcceptance'
+type PolicyAcceptanceRepository_RecordPolicyAcceptance_Call struct {
+	*mock.Call
+}
+
+// RecordPolicyAcceptance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - acceptance *dto.PolicyAcceptance
+func (_e *PolicyAcceptanceRepository_Expecter) RecordPolicyAcceptance(ctx interface{}, userID interface{}, acceptance interface{}) *PolicyAcceptanceRepository_RecordPolicyAcceptance_Call {
+	return &PolicyAcceptanceRepository_RecordPolicyAcceptance_Call{Call: _e.mock.On("RecordPolicyAcceptance", ctx, userID, acceptance)}
+}
+
+func (_c *PolicyAcceptanceRepository_RecordPolicyAcceptance_Call) Run(run func(ctx context.Context, userID uuid.UUID, acceptance *dto.PolicyAcceptance)) *PolicyAcceptanceRepository_RecordPolicyAcceptance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.PolicyAcceptance))
+	})
+	return _c
+}
+
+func (_c *PolicyAcceptanceRepository_RecordPolicyAcceptance_Call) Return(_a0 error) *PolicyAcceptanceRepository_RecordPolicyAcceptance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PolicyAcceptanceRepository_RecordPolicyAcceptance_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.PolicyAcceptance) error) *PolicyAcceptanceRepository_RecordPolicyAcceptance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPolicyAcceptanceRepository creates a new instance of PolicyAcceptanceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPolicyAcceptanceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PolicyAcceptanceRepository {
+	mock := &PolicyAcceptanceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
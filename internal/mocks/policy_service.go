@@ -0,0 +1,217 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PolicyService is an autogenerated mock type for the PolicyService type
+type PolicyService struct {
+	mock.Mock
+}
+
+type PolicyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PolicyService) EXPECT() *PolicyService_Expecter {
+	return &PolicyService_Expecter{mock: &_m.Mock}
+}
+
+// AcceptPolicy provides a mock function with given fields: ctx, userID, req
+func (_m *PolicyService) AcceptPolicy(ctx context.Context, userID uuid.UUID, req *dto.PolicyAcceptanceRequest) (*dto.PolicyAcceptance, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptPolicy")
+	}
+
+	var r0 *dto.PolicyAcceptance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PolicyAcceptanceRequest) (*dto.PolicyAcceptance, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PolicyAcceptanceRequest) *dto.PolicyAcceptance); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PolicyAcceptance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.PolicyAcceptanceRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyService_AcceptPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptPolicy'
+type PolicyService_AcceptPolicy_Call struct {
+	*mock.Call
+}
+
+// AcceptPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - req *dto.PolicyAcceptanceRequest
+func (_e *PolicyService_Expecter) AcceptPolicy(ctx interface{}, userID interface{}, req interface{}) *PolicyService_AcceptPolicy_Call {
+	return &PolicyService_AcceptPolicy_Call{Call: _e.mock.On("AcceptPolicy", ctx, userID, req)}
+}
+
+func (_c *PolicyService_AcceptPolicy_Call) Run(run func(ctx context.Context, userID uuid.UUID, req *dto.PolicyAcceptanceRequest)) *PolicyService_AcceptPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.PolicyAcceptanceRequest))
+	})
+	return _c
+}
+
+func (_c *PolicyService_AcceptPolicy_Call) Return(_a0 *dto.PolicyAcceptance, _a1 error) *PolicyService_AcceptPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyService_AcceptPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.PolicyAcceptanceRequest) (*dto.PolicyAcceptance, error)) *PolicyService_AcceptPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicyStatus provides a mock function with given fields: ctx, userID
+func (_m *PolicyService) GetPolicyStatus(ctx context.Context, userID uuid.UUID) (*dto.PolicyStatusResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicyStatus")
+	}
+
+	var r0 *dto.PolicyStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PolicyStatusResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PolicyStatusResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PolicyStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyService_GetPolicyStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicyStatus'
+type PolicyService_GetPolicyStatus_Call struct {
+	*mock.Call
+}
+
+// GetPolicyStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PolicyService_Expecter) GetPolicyStatus(ctx interface{}, userID interface{}) *PolicyService_GetPolicyStatus_Call {
+	return &PolicyService_GetPolicyStatus_Call{Call: _e.mock.On("GetPolicyStatus", ctx, userID)}
+}
+
+func (_c *PolicyService_GetPolicyStatus_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PolicyService_GetPolicyStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PolicyService_GetPolicyStatus_Call) Return(_a0 *dto.PolicyStatusResponse, _a1 error) *PolicyService_GetPolicyStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyService_GetPolicyStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.PolicyStatusResponse, error)) *PolicyService_GetPolicyStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PendingPolicies provides a mock function with given fields: ctx, userID
+func (_m *PolicyService) PendingPolicies(ctx context.Context, userID uuid.UUID) ([]dto.PolicyDocument, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingPolicies")
+	}
+
+	var r0 []dto.PolicyDocument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.PolicyDocument, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.PolicyDocument); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.PolicyDocument)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyService_PendingPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingPolicies'
+type PolicyService_PendingPolicies_Call struct {
+	*mock.Call
+}
+
+// PendingPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PolicyService_Expecter) PendingPolicies(ctx interface{}, userID interface{}) *PolicyService_PendingPolicies_Call {
+	return &PolicyService_PendingPolicies_Call{Call: _e.mock.On("PendingPolicies", ctx, userID)}
+}
+
+func (_c *PolicyService_PendingPolicies_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PolicyService_PendingPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PolicyService_PendingPolicies_Call) Return(_a0 []dto.PolicyDocument, _a1 error) *PolicyService_PendingPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyService_PendingPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.PolicyDocument, error)) *PolicyService_PendingPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPolicyService creates a new instance of PolicyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPolicyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PolicyService {
+	mock := &PolicyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
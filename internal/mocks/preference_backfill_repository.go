@@ -0,0 +1,155 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PreferenceBackfillRepository is an autogenerated mock type for the PreferenceBackfillRepository type
+type PreferenceBackfillRepository struct {
+	mock.Mock
+}
+
+type PreferenceBackfillRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreferenceBackfillRepository) EXPECT() *PreferenceBackfillRepository_Expecter {
+	return &PreferenceBackfillRepository_Expecter{mock: &_m.Mock}
+}
+
+// InsertDefaultPreferences provides a mock function with given fields: ctx, userIDs
+func (_m *PreferenceBackfillRepository) InsertDefaultPreferences(ctx context.Context, userIDs []uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertDefaultPreferences")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (int, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) int); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceBackfillRepository_InsertDefaultPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertDefaultPreferences'
+type PreferenceBackfillRepository_InsertDefaultPreferences_Call struct {
+	*mock.Call
+}
+
+// InsertDefaultPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []uuid.UUID
+func (_e *PreferenceBackfillRepository_Expecter) InsertDefaultPreferences(ctx interface{}, userIDs interface{}) *PreferenceBackfillRepository_InsertDefaultPreferences_Call {
+	return &PreferenceBackfillRepository_InsertDefaultPreferences_Call{Call: _e.mock.On("InsertDefaultPreferences", ctx, userIDs)}
+}
+
+func (_c *PreferenceBackfillRepository_InsertDefaultPreferences_Call) Run(run func(ctx context.Context, userIDs []uuid.UUID)) *PreferenceBackfillRepository_InsertDefaultPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceBackfillRepository_InsertDefaultPreferences_Call) Return(_a0 int, _a1 error) *PreferenceBackfillRepository_InsertDefaultPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceBackfillRepository_InsertDefaultPreferences_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (int, error)) *PreferenceBackfillRepository_InsertDefaultPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserIDs provides a mock function with given fields: ctx, after, limit
+func (_m *PreferenceBackfillRepository) ListUserIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserIDs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]uuid.UUID, error)); ok {
+		return rf(ctx, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []uuid.UUID); ok {
+		r0 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceBackfillRepository_ListUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserIDs'
+type PreferenceBackfillRepository_ListUserIDs_Call struct {
+	*mock.Call
+}
+
+// ListUserIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after uuid.UUID
+//   - limit int
+func (_e *PreferenceBackfillRepository_Expecter) ListUserIDs(ctx interface{}, after interface{}, limit interface{}) *PreferenceBackfillRepository_ListUserIDs_Call {
+	return &PreferenceBackfillRepository_ListUserIDs_Call{Call: _e.mock.On("ListUserIDs", ctx, after, limit)}
+}
+
+func (_c *PreferenceBackfillRepository_ListUserIDs_Call) Run(run func(ctx context.Context, after uuid.UUID, limit int)) *PreferenceBackfillRepository_ListUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *PreferenceBackfillRepository_ListUserIDs_Call) Return(_a0 []uuid.UUID, _a1 error) *PreferenceBackfillRepository_ListUserIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceBackfillRepository_ListUserIDs_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]uuid.UUID, error)) *PreferenceBackfillRepository_ListUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreferenceBackfillRepository creates a new instance of PreferenceBackfillRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreferenceBackfillRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreferenceBackfillRepository {
+	mock := &PreferenceBackfillRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
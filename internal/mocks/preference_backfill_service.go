@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PreferenceBackfillService is an autogenerated mock type for the PreferenceBackfillService type
+type PreferenceBackfillService struct {
+	mock.Mock
+}
+
+type PreferenceBackfillService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreferenceBackfillService) EXPECT() *PreferenceBackfillService_Expecter {
+	return &PreferenceBackfillService_Expecter{mock: &_m.Mock}
+}
+
+// Backfill provides a mock function with given fields: ctx
+func (_m *PreferenceBackfillService) Backfill(ctx context.Context) (*dto.PreferenceBackfillResult, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backfill")
+	}
+
+	var r0 *dto.PreferenceBackfillResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.PreferenceBackfillResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.PreferenceBackfillResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PreferenceBackfillResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceBackfillService_Backfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backfill'
+type PreferenceBackfillService_Backfill_Call struct {
+	*mock.Call
+}
+
+// Backfill is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PreferenceBackfillService_Expecter) Backfill(ctx interface{}) *PreferenceBackfillService_Backfill_Call {
+	return &PreferenceBackfillService_Backfill_Call{Call: _e.mock.On("Backfill", ctx)}
+}
+
+func (_c *PreferenceBackfillService_Backfill_Call) Run(run func(ctx context.Context)) *PreferenceBackfillService_Backfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PreferenceBackfillService_Backfill_Call) Return(_a0 *dto.PreferenceBackfillResult, _a1 error) *PreferenceBackfillService_Backfill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceBackfillService_Backfill_Call) RunAndReturn(run func(context.Context) (*dto.PreferenceBackfillResult, error)) *PreferenceBackfillService_Backfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreferenceBackfillService creates a new instance of PreferenceBackfillService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreferenceBackfillService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreferenceBackfillService {
+	mock := &PreferenceBackfillService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,152 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PreferenceCenterRepository is an autogenerated mock type for the PreferenceCenterRepository type
+type PreferenceCenterRepository struct {
+	mock.Mock
+}
+
+type PreferenceCenterRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreferenceCenterRepository) EXPECT() *PreferenceCenterRepository_Expecter {
+	return &PreferenceCenterRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetPreferenceCenterGeneration provides a mock function with given fields: ctx, userID
+func (_m *PreferenceCenterRepository) GetPreferenceCenterGeneration(ctx context.Context, userID uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreferenceCenterGeneration")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceCenterRepository_GetPreferenceCenterGeneration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreferenceCenterGeneration'
+type PreferenceCenterRepository_GetPreferenceCenterGeneration_Call struct {
+	*mock.Call
+}
+
+// GetPreferenceCenterGeneration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceCenterRepository_Expecter) GetPreferenceCenterGeneration(ctx interface{}, userID interface{}) *PreferenceCenterRepository_GetPreferenceCenterGeneration_Call {
+	return &PreferenceCenterRepository_GetPreferenceCenterGeneration_Call{Call: _e.mock.On("GetPreferenceCenterGeneration", ctx, userID)}
+}
+
+func (_c *PreferenceCenterRepository_GetPreferenceCenterGeneration_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceCenterRepository_GetPreferenceCenterGeneration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceCenterRepository_GetPreferenceCenterGeneration_Call) Return(_a0 int, _a1 error) *PreferenceCenterRepository_GetPreferenceCenterGeneration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceCenterRepository_GetPreferenceCenterGeneration_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int, error)) *PreferenceCenterRepository_GetPreferenceCenterGeneration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokePreferenceCenterLinks provides a mock function with given fields: ctx, userID
+func (_m *PreferenceCenterRepository) RevokePreferenceCenterLinks(ctx context.Context, userID uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokePreferenceCenterLinks")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceCenterRepository_RevokePreferenceCenterLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokePreferenceCenterLinks'
+type PreferenceCenterRepository_RevokePreferenceCenterLinks_Call struct {
+	*mock.Call
+}
+
+// RevokePreferenceCenterLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceCenterRepository_Expecter) RevokePreferenceCenterLinks(ctx interface{}, userID interface{}) *PreferenceCenterRepository_RevokePreferenceCenterLinks_Call {
+	return &PreferenceCenterRepository_RevokePreferenceCenterLinks_Call{Call: _e.mock.On("RevokePreferenceCenterLinks", ctx, userID)}
+}
+
+func (_c *PreferenceCenterRepository_RevokePreferenceCenterLinks_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceCenterRepository_RevokePreferenceCenterLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceCenterRepository_RevokePreferenceCenterLinks_Call) Return(_a0 int, _a1 error) *PreferenceCenterRepository_RevokePreferenceCenterLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceCenterRepository_RevokePreferenceCenterLinks_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int, error)) *PreferenceCenterRepository_RevokePreferenceCenterLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreferenceCenterRepository creates a new instance of PreferenceCenterRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreferenceCenterRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreferenceCenterRepository {
+	mock := &PreferenceCenterRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,264 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PreferenceCenterService is an autogenerated mock type for the PreferenceCenterService type
+type PreferenceCenterService struct {
+	mock.Mock
+}
+
+type PreferenceCenterService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreferenceCenterService) EXPECT() *PreferenceCenterService_Expecter {
+	return &PreferenceCenterService_Expecter{mock: &_m.Mock}
+}
+
+// GetPreferences provides a mock function with given fields: ctx, token
+func (_m *PreferenceCenterService) GetPreferences(ctx context.Context, token string) (*dto.PreferenceCenterResponse, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreferences")
+	}
+
+	var r0 *dto.PreferenceCenterResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.PreferenceCenterResponse, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.PreferenceCenterResponse); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PreferenceCenterResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceCenterService_GetPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreferences'
+type PreferenceCenterService_GetPreferences_Call struct {
+	*mock.Call
+}
+
+// GetPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *PreferenceCenterService_Expecter) GetPreferences(ctx interface{}, token interface{}) *PreferenceCenterService_GetPreferences_Call {
+	return &PreferenceCenterService_GetPreferences_Call{Call: _e.mock.On("GetPreferences", ctx, token)}
+}
+
+func (_c *PreferenceCenterService_GetPreferences_Call) Run(run func(ctx context.Context, token string)) *PreferenceCenterService_GetPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PreferenceCenterService_GetPreferences_Call) Return(_a0 *dto.PreferenceCenterResponse, _a1 error) *PreferenceCenterService_GetPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceCenterService_GetPreferences_Call) RunAndReturn(run func(context.Context, string) (*dto.PreferenceCenterResponse, error)) *PreferenceCenterService_GetPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueToken provides a mock function with given fields: ctx, userID
+func (_m *PreferenceCenterService) IssueToken(ctx context.Context, userID uuid.UUID) (*dto.PreferenceCenterTokenResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueToken")
+	}
+
+	var r0 *dto.PreferenceCenterTokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PreferenceCenterTokenResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PreferenceCenterTokenResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PreferenceCenterTokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceCenterService_IssueToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueToken'
+type PreferenceCenterService_IssueToken_Call struct {
+	*mock.Call
+}
+
+// IssueToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceCenterService_Expecter) IssueToken(ctx interface{}, userID interface{}) *PreferenceCenterService_IssueToken_Call {
+	return &PreferenceCenterService_IssueToken_Call{Call: _e.mock.On("IssueToken", ctx, userID)}
+}
+
+func (_c *PreferenceCenterService_IssueToken_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceCenterService_IssueToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceCenterService_IssueToken_Call) Return(_a0 *dto.PreferenceCenterTokenResponse, _a1 error) *PreferenceCenterService_IssueToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceCenterService_IssueToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.PreferenceCenterTokenResponse, error)) *PreferenceCenterService_IssueToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeTokens provides a mock function with given fields: ctx, userID
+func (_m *PreferenceCenterService) RevokeTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PreferenceCenterService_RevokeTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeTokens'
+type PreferenceCenterService_RevokeTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceCenterService_Expecter) RevokeTokens(ctx interface{}, userID interface{}) *PreferenceCenterService_RevokeTokens_Call {
+	return &PreferenceCenterService_RevokeTokens_Call{Call: _e.mock.On("RevokeTokens", ctx, userID)}
+}
+
+func (_c *PreferenceCenterService_RevokeTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceCenterService_RevokeTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceCenterService_RevokeTokens_Call) Return(_a0 error) *PreferenceCenterService_RevokeTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PreferenceCenterService_RevokeTokens_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *PreferenceCenterService_RevokeTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePreferences provides a mock function with given fields: ctx, token, update
+func (_m *PreferenceCenterService) UpdatePreferences(ctx context.Context, token string, update *dto.PreferenceCenterUpdateRequest) (*dto.PreferenceCenterResponse, error) {
+	ret := _m.Called(ctx, token, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 *dto.PreferenceCenterResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dto.PreferenceCenterUpdateRequest) (*dto.PreferenceCenterResponse, error)); ok {
+		return rf(ctx, token, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dto.PreferenceCenterUpdateRequest) *dto.PreferenceCenterResponse); ok {
+		r0 = rf(ctx, token, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PreferenceCenterResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *dto.PreferenceCenterUpdateRequest) error); ok {
+		r1 = rf(ctx, token, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceCenterService_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type PreferenceCenterService_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - update *dto.PreferenceCenterUpdateRequest
+func (_e *PreferenceCenterService_Expecter) UpdatePreferences(ctx interface{}, token interface{}, update interface{}) *PreferenceCenterService_UpdatePreferences_Call {
+	return &PreferenceCenterService_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", ctx, token, update)}
+}
+
+func (_c *PreferenceCenterService_UpdatePreferences_Call) Run(run func(ctx context.Context, token string, update *dto.PreferenceCenterUpdateRequest)) *PreferenceCenterService_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*dto.PreferenceCenterUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *PreferenceCenterService_UpdatePreferences_Call) Return(_a0 *dto.PreferenceCenterResponse, _a1 error) *PreferenceCenterService_UpdatePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceCenterService_UpdatePreferences_Call) RunAndReturn(run func(context.Context, string, *dto.PreferenceCenterUpdateRequest) (*dto.PreferenceCenterResponse, error)) *PreferenceCenterService_UpdatePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreferenceCenterService creates a new instance of PreferenceCenterService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreferenceCenterService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreferenceCenterService {
+	mock := &PreferenceCenterService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PreferenceMigrationService is an autogenerated mock type for the PreferenceMigrationService type
+type PreferenceMigrationService struct {
+	mock.Mock
+}
+
+type PreferenceMigrationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreferenceMigrationService) EXPECT() *PreferenceMigrationService_Expecter {
+	return &PreferenceMigrationService_Expecter{mock: &_m.Mock}
+}
+
+// MigrateToDocuments provides a mock function with given fields: ctx
+func (_m *PreferenceMigrationService) MigrateToDocuments(ctx context.Context) (*dto.PreferenceMigrationResult, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateToDocuments")
+	}
+
+	var r0 *dto.PreferenceMigrationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.PreferenceMigrationResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.PreferenceMigrationResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PreferenceMigrationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceMigrationService_MigrateToDocuments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateToDocuments'
+type PreferenceMigrationService_MigrateToDocuments_Call struct {
+	*mock.Call
+}
+
+// MigrateToDocuments is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PreferenceMigrationService_Expecter) MigrateToDocuments(ctx interface{}) *PreferenceMigrationService_MigrateToDocuments_Call {
+	return &PreferenceMigrationService_MigrateToDocuments_Call{Call: _e.mock.On("MigrateToDocuments", ctx)}
+}
+
+func (_c *PreferenceMigrationService_MigrateToDocuments_Call) Run(run func(ctx context.Context)) *PreferenceMigrationService_MigrateToDocuments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PreferenceMigrationService_MigrateToDocuments_Call) Return(_a0 *dto.PreferenceMigrationResult, _a1 error) *PreferenceMigrationService_MigrateToDocuments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceMigrationService_MigrateToDocuments_Call) RunAndReturn(run func(context.Context) (*dto.PreferenceMigrationResult, error)) *PreferenceMigrationService_MigrateToDocuments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigrateToTables provides a mock function with given fields: ctx
+func (_m *PreferenceMigrationService) MigrateToTables(ctx context.Context) (*dto.PreferenceMigrationResult, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateToTables")
+	}
+
+	var r0 *dto.PreferenceMigrationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.PreferenceMigrationResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.PreferenceMigrationResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PreferenceMigrationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceMigrationService_MigrateToTables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateToTables'
+type PreferenceMigrationService_MigrateToTables_Call struct {
+	*mock.Call
+}
+
+// MigrateToTables is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PreferenceMigrationService_Expecter) MigrateToTables(ctx interface{}) *PreferenceMigrationService_MigrateToTables_Call {
+	return &PreferenceMigrationService_MigrateToTables_Call{Call: _e.mock.On("MigrateToTables", ctx)}
+}
+
+func (_c *PreferenceMigrationService_MigrateToTables_Call) Run(run func(ctx context.Context)) *PreferenceMigrationService_MigrateToTables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PreferenceMigrationService_MigrateToTables_Call) Return(_a0 *dto.PreferenceMigrationResult, _a1 error) *PreferenceMigrationService_MigrateToTables_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceMigrationService_MigrateToTables_Call) RunAndReturn(run func(context.Context) (*dto.PreferenceMigrationResult, error)) *PreferenceMigrationService_MigrateToTables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreferenceMigrationService creates a new instance of PreferenceMigrationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreferenceMigrationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreferenceMigrationService {
+	mock := &PreferenceMigrationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,1215 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PreferenceRepository is an autogenerated mock type for the PreferenceRepository type
+type PreferenceRepository struct {
+	mock.Mock
+}
+
+type PreferenceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreferenceRepository) EXPECT() *PreferenceRepository_Expecter {
+	return &PreferenceRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAccessibilityPreferences provides a mock function with given fields: ctx, userID
+func (_m *PreferenceRepository) GetAccessibilityPreferences(ctx context.Context, userID uuid.UUID) (*dto.AccessibilityPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccessibilityPreferences")
+	}
+
+	var r0 *dto.AccessibilityPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.AccessibilityPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.AccessibilityPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AccessibilityPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_GetAccessibilityPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccessibilityPreferences'
+type PreferenceRepository_GetAccessibilityPreferences_Call struct {
+	*mock.Call
+}
+
+// GetAccessibilityPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceRepository_Expecter) GetAccessibilityPreferences(ctx interface{}, userID interface{}) *PreferenceRepository_GetAccessibilityPreferences_Call {
+	return &PreferenceRepository_GetAccessibilityPreferences_Call{Call: _e.mock.On("GetAccessibilityPreferences", ctx, userID)}
+}
+
+func (_c *PreferenceRepository_GetAccessibilityPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceRepository_GetAccessibilityPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_GetAccessibilityPreferences_Call) Return(_a0 *dto.AccessibilityPreferences, _a1 error) *PreferenceRepository_GetAccessibilityPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_GetAccessibilityPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.AccessibilityPreferences, error)) *PreferenceRepository_GetAccessibilityPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDisplayPreferences provides a mock function with given fields: ctx, userID
+func (_m *PreferenceRepository) GetDisplayPreferences(ctx context.Context, userID uuid.UUID) (*dto.DisplayPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDisplayPreferences")
+	}
+
+	var r0 *dto.DisplayPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.DisplayPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.DisplayPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DisplayPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_GetDisplayPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDisplayPreferences'
+type PreferenceRepository_GetDisplayPreferences_Call struct {
+	*mock.Call
+}
+
+// GetDisplayPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceRepository_Expecter) GetDisplayPreferences(ctx interface{}, userID interface{}) *PreferenceRepository_GetDisplayPreferences_Call {
+	return &PreferenceRepository_GetDisplayPreferences_Call{Call: _e.mock.On("GetDisplayPreferences", ctx, userID)}
+}
+
+func (_c *PreferenceRepository_GetDisplayPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceRepository_GetDisplayPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_GetDisplayPreferences_Call) Return(_a0 *dto.DisplayPreferences, _a1 error) *PreferenceRepository_GetDisplayPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_GetDisplayPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.DisplayPreferences, error)) *PreferenceRepository_GetDisplayPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLanguagePreferences provides a mock function with given fields: ctx, userID
+func (_m *PreferenceRepository) GetLanguagePreferences(ctx context.Context, userID uuid.UUID) (*dto.LanguagePreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLanguagePreferences")
+	}
+
+	var r0 *dto.LanguagePreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.LanguagePreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.LanguagePreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LanguagePreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_GetLanguagePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLanguagePreferences'
+type PreferenceRepository_GetLanguagePreferences_Call struct {
+	*mock.Call
+}
+
+// GetLanguagePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceRepository_Expecter) GetLanguagePreferences(ctx interface{}, userID interface{}) *PreferenceRepository_GetLanguagePreferences_Call {
+	return &PreferenceRepository_GetLanguagePreferences_Call{Call: _e.mock.On("GetLanguagePreferences", ctx, userID)}
+}
+
+func (_c *PreferenceRepository_GetLanguagePreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceRepository_GetLanguagePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_GetLanguagePreferences_Call) Return(_a0 *dto.LanguagePreferences, _a1 error) *PreferenceRepository_GetLanguagePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_GetLanguagePreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.LanguagePreferences, error)) *PreferenceRepository_GetLanguagePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationPreferences provides a mock function with given fields: ctx, userID
+func (_m *PreferenceRepository) GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (*dto.NotificationPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationPreferences")
+	}
+
+	var r0 *dto.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.NotificationPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.NotificationPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.NotificationPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_GetNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationPreferences'
+type PreferenceRepository_GetNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// GetNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceRepository_Expecter) GetNotificationPreferences(ctx interface{}, userID interface{}) *PreferenceRepository_GetNotificationPreferences_Call {
+	return &PreferenceRepository_GetNotificationPreferences_Call{Call: _e.mock.On("GetNotificationPreferences", ctx, userID)}
+}
+
+func (_c *PreferenceRepository_GetNotificationPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceRepository_GetNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_GetNotificationPreferences_Call) Return(_a0 *dto.NotificationPreferences, _a1 error) *PreferenceRepository_GetNotificationPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_GetNotificationPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.NotificationPreferences, error)) *PreferenceRepository_GetNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivacyPreferencesData provides a mock function with given fields: ctx, userID
+func (_m *PreferenceRepository) GetPrivacyPreferencesData(ctx context.Context, userID uuid.UUID) (*dto.UserPrivacyPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivacyPreferencesData")
+	}
+
+	var r0 *dto.UserPrivacyPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.UserPrivacyPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.UserPrivacyPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserPrivacyPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_GetPrivacyPreferencesData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivacyPreferencesData'
+type PreferenceRepository_GetPrivacyPreferencesData_Call struct {
+	*mock.Call
+}
+
+// GetPrivacyPreferencesData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceRepository_Expecter) GetPrivacyPreferencesData(ctx interface{}, userID interface{}) *PreferenceRepository_GetPrivacyPreferencesData_Call {
+	return &PreferenceRepository_GetPrivacyPreferencesData_Call{Call: _e.mock.On("GetPrivacyPreferencesData", ctx, userID)}
+}
+
+func (_c *PreferenceRepository_GetPrivacyPreferencesData_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceRepository_GetPrivacyPreferencesData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_GetPrivacyPreferencesData_Call) Return(_a0 *dto.UserPrivacyPreferences, _a1 error) *PreferenceRepository_GetPrivacyPreferencesData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_GetPrivacyPreferencesData_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.UserPrivacyPreferences, error)) *PreferenceRepository_GetPrivacyPreferencesData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecurityPreferences provides a mock function with given fields: ctx, userID
+func (_m *PreferenceRepository) GetSecurityPreferences(ctx context.Context, userID uuid.UUID) (*dto.SecurityPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecurityPreferences")
+	}
+
+	var r0 *dto.SecurityPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.SecurityPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.SecurityPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SecurityPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_GetSecurityPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecurityPreferences'
+type PreferenceRepository_GetSecurityPreferences_Call struct {
+	*mock.Call
+}
+
+// GetSecurityPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceRepository_Expecter) GetSecurityPreferences(ctx interface{}, userID interface{}) *PreferenceRepository_GetSecurityPreferences_Call {
+	return &PreferenceRepository_GetSecurityPreferences_Call{Call: _e.mock.On("GetSecurityPreferences", ctx, userID)}
+}
+
+func (_c *PreferenceRepository_GetSecurityPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceRepository_GetSecurityPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_GetSecurityPreferences_Call) Return(_a0 *dto.SecurityPreferences, _a1 error) *PreferenceRepository_GetSecurityPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_GetSecurityPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.SecurityPreferences, error)) *PreferenceRepository_GetSecurityPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSocialPreferences provides a mock function with given fields: ctx, userID
+func (_m *PreferenceRepository) GetSocialPreferences(ctx context.Context, userID uuid.UUID) (*dto.SocialPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSocialPreferences")
+	}
+
+	var r0 *dto.SocialPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.SocialPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.SocialPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SocialPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_GetSocialPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSocialPreferences'
+type PreferenceRepository_GetSocialPreferences_Call struct {
+	*mock.Call
+}
+
+// GetSocialPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceRepository_Expecter) GetSocialPreferences(ctx interface{}, userID interface{}) *PreferenceRepository_GetSocialPreferences_Call {
+	return &PreferenceRepository_GetSocialPreferences_Call{Call: _e.mock.On("GetSocialPreferences", ctx, userID)}
+}
+
+func (_c *PreferenceRepository_GetSocialPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceRepository_GetSocialPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_GetSocialPreferences_Call) Return(_a0 *dto.SocialPreferences, _a1 error) *PreferenceRepository_GetSocialPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_GetSocialPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.SocialPreferences, error)) *PreferenceRepository_GetSocialPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSoundPreferences provides a mock function with given fields: ctx, userID
+func (_m *PreferenceRepository) GetSoundPreferences(ctx context.Context, userID uuid.UUID) (*dto.SoundPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSoundPreferences")
+	}
+
+	var r0 *dto.SoundPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.SoundPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.SoundPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SoundPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_GetSoundPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSoundPreferences'
+type PreferenceRepository_GetSoundPreferences_Call struct {
+	*mock.Call
+}
+
+// GetSoundPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceRepository_Expecter) GetSoundPreferences(ctx interface{}, userID interface{}) *PreferenceRepository_GetSoundPreferences_Call {
+	return &PreferenceRepository_GetSoundPreferences_Call{Call: _e.mock.On("GetSoundPreferences", ctx, userID)}
+}
+
+func (_c *PreferenceRepository_GetSoundPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceRepository_GetSoundPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_GetSoundPreferences_Call) Return(_a0 *dto.SoundPreferences, _a1 error) *PreferenceRepository_GetSoundPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_GetSoundPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.SoundPreferences, error)) *PreferenceRepository_GetSoundPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetThemePreferences provides a mock function with given fields: ctx, userID
+func (_m *PreferenceRepository) GetThemePreferences(ctx context.Context, userID uuid.UUID) (*dto.ThemePreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetThemePreferences")
+	}
+
+	var r0 *dto.ThemePreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.ThemePreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.ThemePreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ThemePreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_GetThemePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetThemePreferences'
+type PreferenceRepository_GetThemePreferences_Call struct {
+	*mock.Call
+}
+
+// GetThemePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceRepository_Expecter) GetThemePreferences(ctx interface{}, userID interface{}) *PreferenceRepository_GetThemePreferences_Call {
+	return &PreferenceRepository_GetThemePreferences_Call{Call: _e.mock.On("GetThemePreferences", ctx, userID)}
+}
+
+func (_c *PreferenceRepository_GetThemePreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceRepository_GetThemePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_GetThemePreferences_Call) Return(_a0 *dto.ThemePreferences, _a1 error) *PreferenceRepository_GetThemePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_GetThemePreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.ThemePreferences, error)) *PreferenceRepository_GetThemePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPreferences provides a mock function with given fields: ctx, userID, categories
+func (_m *PreferenceRepository) ResetPreferences(ctx context.Context, userID uuid.UUID, categories []dto.PreferenceCategory) error {
+	ret := _m.Called(ctx, userID, categories)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPreferences")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []dto.PreferenceCategory) error); ok {
+		r0 = rf(ctx, userID, categories)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PreferenceRepository_ResetPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPreferences'
+type PreferenceRepository_ResetPreferences_Call struct {
+	*mock.Call
+}
+
+// ResetPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - categories []dto.PreferenceCategory
+func (_e *PreferenceRepository_Expecter) ResetPreferences(ctx interface{}, userID interface{}, categories interface{}) *PreferenceRepository_ResetPreferences_Call {
+	return &PreferenceRepository_ResetPreferences_Call{Call: _e.mock.On("ResetPreferences", ctx, userID, categories)}
+}
+
+func (_c *PreferenceRepository_ResetPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, categories []dto.PreferenceCategory)) *PreferenceRepository_ResetPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]dto.PreferenceCategory))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_ResetPreferences_Call) Return(_a0 error) *PreferenceRepository_ResetPreferences_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PreferenceRepository_ResetPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, []dto.PreferenceCategory) error) *PreferenceRepository_ResetPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAccessibilityPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *PreferenceRepository) UpdateAccessibilityPreferences(ctx context.Context, userID uuid.UUID, u *dto.AccessibilityPreferencesUpdate) (*dto.AccessibilityPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccessibilityPreferences")
+	}
+
+	var r0 *dto.AccessibilityPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.AccessibilityPreferencesUpdate) (*dto.AccessibilityPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.AccessibilityPreferencesUpdate) *dto.AccessibilityPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AccessibilityPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.AccessibilityPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_UpdateAccessibilityPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccessibilityPreferences'
+type PreferenceRepository_UpdateAccessibilityPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateAccessibilityPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.AccessibilityPreferencesUpdate
+func (_e *PreferenceRepository_Expecter) UpdateAccessibilityPreferences(ctx interface{}, userID interface{}, u interface{}) *PreferenceRepository_UpdateAccessibilityPreferences_Call {
+	return &PreferenceRepository_UpdateAccessibilityPreferences_Call{Call: _e.mock.On("UpdateAccessibilityPreferences", ctx, userID, u)}
+}
+
+func (_c *PreferenceRepository_UpdateAccessibilityPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.AccessibilityPreferencesUpdate)) *PreferenceRepository_UpdateAccessibilityPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.AccessibilityPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateAccessibilityPreferences_Call) Return(_a0 *dto.AccessibilityPreferences, _a1 error) *PreferenceRepository_UpdateAccessibilityPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateAccessibilityPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.AccessibilityPreferencesUpdate) (*dto.AccessibilityPreferences, error)) *PreferenceRepository_UpdateAccessibilityPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDisplayPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *PreferenceRepository) UpdateDisplayPreferences(ctx context.Context, userID uuid.UUID, u *dto.DisplayPreferencesUpdate) (*dto.DisplayPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDisplayPreferences")
+	}
+
+	var r0 *dto.DisplayPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.DisplayPreferencesUpdate) (*dto.DisplayPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.DisplayPreferencesUpdate) *dto.DisplayPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DisplayPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.DisplayPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_UpdateDisplayPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDisplayPreferences'
+type PreferenceRepository_UpdateDisplayPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateDisplayPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.DisplayPreferencesUpdate
+func (_e *PreferenceRepository_Expecter) UpdateDisplayPreferences(ctx interface{}, userID interface{}, u interface{}) *PreferenceRepository_UpdateDisplayPreferences_Call {
+	return &PreferenceRepository_UpdateDisplayPreferences_Call{Call: _e.mock.On("UpdateDisplayPreferences", ctx, userID, u)}
+}
+
+func (_c *PreferenceRepository_UpdateDisplayPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.DisplayPreferencesUpdate)) *PreferenceRepository_UpdateDisplayPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.DisplayPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateDisplayPreferences_Call) Return(_a0 *dto.DisplayPreferences, _a1 error) *PreferenceRepository_UpdateDisplayPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateDisplayPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.DisplayPreferencesUpdate) (*dto.DisplayPreferences, error)) *PreferenceRepository_UpdateDisplayPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLanguagePreferences provides a mock function with given fields: ctx, userID, u
+func (_m *PreferenceRepository) UpdateLanguagePreferences(ctx context.Context, userID uuid.UUID, u *dto.LanguagePreferencesUpdate) (*dto.LanguagePreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLanguagePreferences")
+	}
+
+	var r0 *dto.LanguagePreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.LanguagePreferencesUpdate) (*dto.LanguagePreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.LanguagePreferencesUpdate) *dto.LanguagePreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LanguagePreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.LanguagePreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_UpdateLanguagePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLanguagePreferences'
+type PreferenceRepository_UpdateLanguagePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateLanguagePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.LanguagePreferencesUpdate
+func (_e *PreferenceRepository_Expecter) UpdateLanguagePreferences(ctx interface{}, userID interface{}, u interface{}) *PreferenceRepository_UpdateLanguagePreferences_Call {
+	return &PreferenceRepository_UpdateLanguagePreferences_Call{Call: _e.mock.On("UpdateLanguagePreferences", ctx, userID, u)}
+}
+
+func (_c *PreferenceRepository_UpdateLanguagePreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.LanguagePreferencesUpdate)) *PreferenceRepository_UpdateLanguagePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.LanguagePreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateLanguagePreferences_Call) Return(_a0 *dto.LanguagePreferences, _a1 error) *PreferenceRepository_UpdateLanguagePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateLanguagePreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.LanguagePreferencesUpdate) (*dto.LanguagePreferences, error)) *PreferenceRepository_UpdateLanguagePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNotificationPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *PreferenceRepository) UpdateNotificationPreferences(ctx context.Context, userID uuid.UUID, u *dto.NotificationPreferencesUpdate) (*dto.NotificationPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationPreferences")
+	}
+
+	var r0 *dto.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.NotificationPreferencesUpdate) (*dto.NotificationPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.NotificationPreferencesUpdate) *dto.NotificationPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.NotificationPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.NotificationPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_UpdateNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationPreferences'
+type PreferenceRepository_UpdateNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.NotificationPreferencesUpdate
+func (_e *PreferenceRepository_Expecter) UpdateNotificationPreferences(ctx interface{}, userID interface{}, u interface{}) *PreferenceRepository_UpdateNotificationPreferences_Call {
+	return &PreferenceRepository_UpdateNotificationPreferences_Call{Call: _e.mock.On("UpdateNotificationPreferences", ctx, userID, u)}
+}
+
+func (_c *PreferenceRepository_UpdateNotificationPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.NotificationPreferencesUpdate)) *PreferenceRepository_UpdateNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.NotificationPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateNotificationPreferences_Call) Return(_a0 *dto.NotificationPreferences, _a1 error) *PreferenceRepository_UpdateNotificationPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateNotificationPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.NotificationPreferencesUpdate) (*dto.NotificationPreferences, error)) *PreferenceRepository_UpdateNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePrivacyPreferencesData provides a mock function with given fields: ctx, userID, u
+func (_m *PreferenceRepository) UpdatePrivacyPreferencesData(ctx context.Context, userID uuid.UUID, u *dto.PrivacyPreferencesUpdate) (*dto.UserPrivacyPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePrivacyPreferencesData")
+	}
+
+	var r0 *dto.UserPrivacyPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PrivacyPreferencesUpdate) (*dto.UserPrivacyPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PrivacyPreferencesUpdate) *dto.UserPrivacyPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserPrivacyPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.PrivacyPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_UpdatePrivacyPreferencesData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePrivacyPreferencesData'
+type PreferenceRepository_UpdatePrivacyPreferencesData_Call struct {
+	*mock.Call
+}
+
+// UpdatePrivacyPreferencesData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.PrivacyPreferencesUpdate
+func (_e *PreferenceRepository_Expecter) UpdatePrivacyPreferencesData(ctx interface{}, userID interface{}, u interface{}) *PreferenceRepository_UpdatePrivacyPreferencesData_Call {
+	return &PreferenceRepository_UpdatePrivacyPreferencesData_Call{Call: _e.mock.On("UpdatePrivacyPreferencesData", ctx, userID, u)}
+}
+
+func (_c *PreferenceRepository_UpdatePrivacyPreferencesData_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.PrivacyPreferencesUpdate)) *PreferenceRepository_UpdatePrivacyPreferencesData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.PrivacyPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdatePrivacyPreferencesData_Call) Return(_a0 *dto.UserPrivacyPreferences, _a1 error) *PreferenceRepository_UpdatePrivacyPreferencesData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdatePrivacyPreferencesData_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.PrivacyPreferencesUpdate) (*dto.UserPrivacyPreferences, error)) *PreferenceRepository_UpdatePrivacyPreferencesData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSecurityPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *PreferenceRepository) UpdateSecurityPreferences(ctx context.Context, userID uuid.UUID, u *dto.SecurityPreferencesUpdate) (*dto.SecurityPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSecurityPreferences")
+	}
+
+	var r0 *dto.SecurityPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SecurityPreferencesUpdate) (*dto.SecurityPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SecurityPreferencesUpdate) *dto.SecurityPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SecurityPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.SecurityPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_UpdateSecurityPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSecurityPreferences'
+type PreferenceRepository_UpdateSecurityPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateSecurityPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.SecurityPreferencesUpdate
+func (_e *PreferenceRepository_Expecter) UpdateSecurityPreferences(ctx interface{}, userID interface{}, u interface{}) *PreferenceRepository_UpdateSecurityPreferences_Call {
+	return &PreferenceRepository_UpdateSecurityPreferences_Call{Call: _e.mock.On("UpdateSecurityPreferences", ctx, userID, u)}
+}
+
+func (_c *PreferenceRepository_UpdateSecurityPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.SecurityPreferencesUpdate)) *PreferenceRepository_UpdateSecurityPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.SecurityPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateSecurityPreferences_Call) Return(_a0 *dto.SecurityPreferences, _a1 error) *PreferenceRepository_UpdateSecurityPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateSecurityPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.SecurityPreferencesUpdate) (*dto.SecurityPreferences, error)) *PreferenceRepository_UpdateSecurityPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSocialPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *PreferenceRepository) UpdateSocialPreferences(ctx context.Context, userID uuid.UUID, u *dto.SocialPreferencesUpdate) (*dto.SocialPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSocialPreferences")
+	}
+
+	var r0 *dto.SocialPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SocialPreferencesUpdate) (*dto.SocialPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SocialPreferencesUpdate) *dto.SocialPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SocialPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.SocialPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_UpdateSocialPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSocialPreferences'
+type PreferenceRepository_UpdateSocialPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateSocialPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.SocialPreferencesUpdate
+func (_e *PreferenceRepository_Expecter) UpdateSocialPreferences(ctx interface{}, userID interface{}, u interface{}) *PreferenceRepository_UpdateSocialPreferences_Call {
+	return &PreferenceRepository_UpdateSocialPreferences_Call{Call: _e.mock.On("UpdateSocialPreferences", ctx, userID, u)}
+}
+
+func (_c *PreferenceRepository_UpdateSocialPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.SocialPreferencesUpdate)) *PreferenceRepository_UpdateSocialPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.SocialPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateSocialPreferences_Call) Return(_a0 *dto.SocialPreferences, _a1 error) *PreferenceRepository_UpdateSocialPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateSocialPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.SocialPreferencesUpdate) (*dto.SocialPreferences, error)) *PreferenceRepository_UpdateSocialPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSoundPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *PreferenceRepository) UpdateSoundPreferences(ctx context.Context, userID uuid.UUID, u *dto.SoundPreferencesUpdate) (*dto.SoundPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSoundPreferences")
+	}
+
+	var r0 *dto.SoundPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SoundPreferencesUpdate) (*dto.SoundPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SoundPreferencesUpdate) *dto.SoundPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SoundPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.SoundPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_UpdateSoundPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSoundPreferences'
+type PreferenceRepository_UpdateSoundPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateSoundPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.SoundPreferencesUpdate
+func (_e *PreferenceRepository_Expecter) UpdateSoundPreferences(ctx interface{}, userID interface{}, u interface{}) *PreferenceRepository_UpdateSoundPreferences_Call {
+	return &PreferenceRepository_UpdateSoundPreferences_Call{Call: _e.mock.On("UpdateSoundPreferences", ctx, userID, u)}
+}
+
+func (_c *PreferenceRepository_UpdateSoundPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.SoundPreferencesUpdate)) *PreferenceRepository_UpdateSoundPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.SoundPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateSoundPreferences_Call) Return(_a0 *dto.SoundPreferences, _a1 error) *PreferenceRepository_UpdateSoundPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateSoundPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.SoundPreferencesUpdate) (*dto.SoundPreferences, error)) *PreferenceRepository_UpdateSoundPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateThemePreferences provides a mock function with given fields: ctx, userID, u
+func (_m *PreferenceRepository) UpdateThemePreferences(ctx context.Context, userID uuid.UUID, u *dto.ThemePreferencesUpdate) (*dto.ThemePreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateThemePreferences")
+	}
+
+	var r0 *dto.ThemePreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.ThemePreferencesUpdate) (*dto.ThemePreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.ThemePreferencesUpdate) *dto.ThemePreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ThemePreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.ThemePreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_UpdateThemePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateThemePreferences'
+type PreferenceRepository_UpdateThemePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateThemePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.ThemePreferencesUpdate
+func (_e *PreferenceRepository_Expecter) UpdateThemePreferences(ctx interface{}, userID interface{}, u interface{}) *PreferenceRepository_UpdateThemePreferences_Call {
+	return &PreferenceRepository_UpdateThemePreferences_Call{Call: _e.mock.On("UpdateThemePreferences", ctx, userID, u)}
+}
+
+func (_c *PreferenceRepository_UpdateThemePreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.ThemePreferencesUpdate)) *PreferenceRepository_UpdateThemePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.ThemePreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateThemePreferences_Call) Return(_a0 *dto.ThemePreferences, _a1 error) *PreferenceRepository_UpdateThemePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_UpdateThemePreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.ThemePreferencesUpdate) (*dto.ThemePreferences, error)) *PreferenceRepository_UpdateThemePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserExists provides a mock function with given fields: ctx, userID
+func (_m *PreferenceRepository) UserExists(ctx context.Context, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceRepository_UserExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserExists'
+type PreferenceRepository_UserExists_Call struct {
+	*mock.Call
+}
+
+// UserExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PreferenceRepository_Expecter) UserExists(ctx interface{}, userID interface{}) *PreferenceRepository_UserExists_Call {
+	return &PreferenceRepository_UserExists_Call{Call: _e.mock.On("UserExists", ctx, userID)}
+}
+
+func (_c *PreferenceRepository_UserExists_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PreferenceRepository_UserExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceRepository_UserExists_Call) Return(_a0 bool, _a1 error) *PreferenceRepository_UserExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceRepository_UserExists_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *PreferenceRepository_UserExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreferenceRepository creates a new instance of PreferenceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreferenceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreferenceRepository {
+	mock := &PreferenceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
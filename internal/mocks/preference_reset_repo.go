@@ -0,0 +1,87 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PreferenceResetRepo is an autogenerated mock type for the PreferenceResetRepo type
+type PreferenceResetRepo struct {
+	mock.Mock
+}
+
+type PreferenceResetRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreferenceResetRepo) EXPECT() *PreferenceResetRepo_Expecter {
+	return &PreferenceResetRepo_Expecter{mock: &_m.Mock}
+}
+
+// ResetPreferences provides a mock function with given fields: ctx, userID, categories
+func (_m *PreferenceResetRepo) ResetPreferences(ctx context.Context, userID uuid.UUID, categories []dto.PreferenceCategory) error {
+	ret := _m.Called(ctx, userID, categories)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPreferences")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []dto.PreferenceCategory) error); ok {
+		r0 = rf(ctx, userID, categories)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PreferenceResetRepo_ResetPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPreferences'
+type PreferenceResetRepo_ResetPreferences_Call struct {
+	*mock.Call
+}
+
+// ResetPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - categories []dto.PreferenceCategory
+func (_e *PreferenceResetRepo_Expecter) ResetPreferences(ctx interface{}, userID interface{}, categories interface{}) *PreferenceResetRepo_ResetPreferences_Call {
+	return &PreferenceResetRepo_ResetPreferences_Call{Call: _e.mock.On("ResetPreferences", ctx, userID, categories)}
+}
+
+func (_c *PreferenceResetRepo_ResetPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, categories []dto.PreferenceCategory)) *PreferenceResetRepo_ResetPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]dto.PreferenceCategory))
+	})
+	return _c
+}
+
+func (_c *PreferenceResetRepo_ResetPreferences_Call) Return(_a0 error) *PreferenceResetRepo_ResetPreferences_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PreferenceResetRepo_ResetPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, []dto.PreferenceCategory) error) *PreferenceResetRepo_ResetPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreferenceResetRepo creates a new instance of PreferenceResetRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreferenceResetRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreferenceResetRepo {
+	mock := &PreferenceResetRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,418 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PreferenceService is an autogenerated mock type for the PreferenceService type
+type PreferenceService struct {
+	mock.Mock
+}
+
+type PreferenceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreferenceService) EXPECT() *PreferenceService_Expecter {
+	return &PreferenceService_Expecter{mock: &_m.Mock}
+}
+
+// GetAllPreferences provides a mock function with given fields: ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope
+func (_m *PreferenceService) GetAllPreferences(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, categories []dto.PreferenceCategory, isAdmin bool, hasServiceScope bool) (*dto.UserPreferencesResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPreferences")
+	}
+
+	var r0 *dto.UserPreferencesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []dto.PreferenceCategory, bool, bool) (*dto.UserPreferencesResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []dto.PreferenceCategory, bool, bool) *dto.UserPreferencesResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserPreferencesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, []dto.PreferenceCategory, bool, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceService_GetAllPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPreferences'
+type PreferenceService_GetAllPreferences_Call struct {
+	*mock.Call
+}
+
+// GetAllPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - categories []dto.PreferenceCategory
+//   - isAdmin bool
+//   - hasServiceScope bool
+func (_e *PreferenceService_Expecter) GetAllPreferences(ctx interface{}, requesterID interface{}, targetUserID interface{}, categories interface{}, isAdmin interface{}, hasServiceScope interface{}) *PreferenceService_GetAllPreferences_Call {
+	return &PreferenceService_GetAllPreferences_Call{Call: _e.mock.On("GetAllPreferences", ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope)}
+}
+
+func (_c *PreferenceService_GetAllPreferences_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, categories []dto.PreferenceCategory, isAdmin bool, hasServiceScope bool)) *PreferenceService_GetAllPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]dto.PreferenceCategory), args[4].(bool), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *PreferenceService_GetAllPreferences_Call) Return(_a0 *dto.UserPreferencesResponse, _a1 error) *PreferenceService_GetAllPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceService_GetAllPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []dto.PreferenceCategory, bool, bool) (*dto.UserPreferencesResponse, error)) *PreferenceService_GetAllPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryPreferences provides a mock function with given fields: ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope
+func (_m *PreferenceService) GetCategoryPreferences(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, category dto.PreferenceCategory, isAdmin bool, hasServiceScope bool) (*dto.PreferenceCategoryResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryPreferences")
+	}
+
+	var r0 *dto.PreferenceCategoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, bool, bool) (*dto.PreferenceCategoryResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, bool, bool) *dto.PreferenceCategoryResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PreferenceCategoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, bool, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceService_GetCategoryPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryPreferences'
+type PreferenceService_GetCategoryPreferences_Call struct {
+	*mock.Call
+}
+
+// GetCategoryPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - category dto.PreferenceCategory
+//   - isAdmin bool
+//   - hasServiceScope bool
+func (_e *PreferenceService_Expecter) GetCategoryPreferences(ctx interface{}, requesterID interface{}, targetUserID interface{}, category interface{}, isAdmin interface{}, hasServiceScope interface{}) *PreferenceService_GetCategoryPreferences_Call {
+	return &PreferenceService_GetCategoryPreferences_Call{Call: _e.mock.On("GetCategoryPreferences", ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope)}
+}
+
+func (_c *PreferenceService_GetCategoryPreferences_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, category dto.PreferenceCategory, isAdmin bool, hasServiceScope bool)) *PreferenceService_GetCategoryPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(dto.PreferenceCategory), args[4].(bool), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *PreferenceService_GetCategoryPreferences_Call) Return(_a0 *dto.PreferenceCategoryResponse, _a1 error) *PreferenceService_GetCategoryPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceService_GetCategoryPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, bool, bool) (*dto.PreferenceCategoryResponse, error)) *PreferenceService_GetCategoryPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetAllPreferences provides a mock function with given fields: ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope
+func (_m *PreferenceService) ResetAllPreferences(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, categories []dto.PreferenceCategory, isAdmin bool, hasServiceScope bool) (*dto.UserPreferencesResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetAllPreferences")
+	}
+
+	var r0 *dto.UserPreferencesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []dto.PreferenceCategory, bool, bool) (*dto.UserPreferencesResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []dto.PreferenceCategory, bool, bool) *dto.UserPreferencesResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserPreferencesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, []dto.PreferenceCategory, bool, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceService_ResetAllPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetAllPreferences'
+type PreferenceService_ResetAllPreferences_Call struct {
+	*mock.Call
+}
+
+// ResetAllPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - categories []dto.PreferenceCategory
+//   - isAdmin bool
+//   - hasServiceScope bool
+func (_e *PreferenceService_Expecter) ResetAllPreferences(ctx interface{}, requesterID interface{}, targetUserID interface{}, categories interface{}, isAdmin interface{}, hasServiceScope interface{}) *PreferenceService_ResetAllPreferences_Call {
+	return &PreferenceService_ResetAllPreferences_Call{Call: _e.mock.On("ResetAllPreferences", ctx, requesterID, targetUserID, categories, isAdmin, hasServiceScope)}
+}
+
+func (_c *PreferenceService_ResetAllPreferences_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, categories []dto.PreferenceCategory, isAdmin bool, hasServiceScope bool)) *PreferenceService_ResetAllPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]dto.PreferenceCategory), args[4].(bool), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *PreferenceService_ResetAllPreferences_Call) Return(_a0 *dto.UserPreferencesResponse, _a1 error) *PreferenceService_ResetAllPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceService_ResetAllPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []dto.PreferenceCategory, bool, bool) (*dto.UserPreferencesResponse, error)) *PreferenceService_ResetAllPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetCategoryPreferences provides a mock function with given fields: ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope
+func (_m *PreferenceService) ResetCategoryPreferences(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, category dto.PreferenceCategory, isAdmin bool, hasServiceScope bool) (*dto.PreferenceCategoryResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetCategoryPreferences")
+	}
+
+	var r0 *dto.PreferenceCategoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, bool, bool) (*dto.PreferenceCategoryResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, bool, bool) *dto.PreferenceCategoryResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PreferenceCategoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, bool, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceService_ResetCategoryPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetCategoryPreferences'
+type PreferenceService_ResetCategoryPreferences_Call struct {
+	*mock.Call
+}
+
+// ResetCategoryPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - category dto.PreferenceCategory
+//   - isAdmin bool
+//   - hasServiceScope bool
+func (_e *PreferenceService_Expecter) ResetCategoryPreferences(ctx interface{}, requesterID interface{}, targetUserID interface{}, category interface{}, isAdmin interface{}, hasServiceScope interface{}) *PreferenceService_ResetCategoryPreferences_Call {
+	return &PreferenceService_ResetCategoryPreferences_Call{Call: _e.mock.On("ResetCategoryPreferences", ctx, requesterID, targetUserID, category, isAdmin, hasServiceScope)}
+}
+
+func (_c *PreferenceService_ResetCategoryPreferences_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, category dto.PreferenceCategory, isAdmin bool, hasServiceScope bool)) *PreferenceService_ResetCategoryPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(dto.PreferenceCategory), args[4].(bool), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *PreferenceService_ResetCategoryPreferences_Call) Return(_a0 *dto.PreferenceCategoryResponse, _a1 error) *PreferenceService_ResetCategoryPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceService_ResetCategoryPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, bool, bool) (*dto.PreferenceCategoryResponse, error)) *PreferenceService_ResetCategoryPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAllPreferences provides a mock function with given fields: ctx, requesterID, targetUserID, update, isAdmin, hasServiceScope
+func (_m *PreferenceService) UpdateAllPreferences(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, update *dto.UserPreferencesUpdateRequest, isAdmin bool, hasServiceScope bool) (*dto.UserPreferencesResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, update, isAdmin, hasServiceScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAllPreferences")
+	}
+
+	var r0 *dto.UserPreferencesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dto.UserPreferencesUpdateRequest, bool, bool) (*dto.UserPreferencesResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, update, isAdmin, hasServiceScope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dto.UserPreferencesUpdateRequest, bool, bool) *dto.UserPreferencesResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, update, isAdmin, hasServiceScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserPreferencesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *dto.UserPreferencesUpdateRequest, bool, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, update, isAdmin, hasServiceScope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceService_UpdateAllPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAllPreferences'
+type PreferenceService_UpdateAllPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateAllPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - update *dto.UserPreferencesUpdateRequest
+//   - isAdmin bool
+//   - hasServiceScope bool
+func (_e *PreferenceService_Expecter) UpdateAllPreferences(ctx interface{}, requesterID interface{}, targetUserID interface{}, update interface{}, isAdmin interface{}, hasServiceScope interface{}) *PreferenceService_UpdateAllPreferences_Call {
+	return &PreferenceService_UpdateAllPreferences_Call{Call: _e.mock.On("UpdateAllPreferences", ctx, requesterID, targetUserID, update, isAdmin, hasServiceScope)}
+}
+
+func (_c *PreferenceService_UpdateAllPreferences_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, update *dto.UserPreferencesUpdateRequest, isAdmin bool, hasServiceScope bool)) *PreferenceService_UpdateAllPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*dto.UserPreferencesUpdateRequest), args[4].(bool), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *PreferenceService_UpdateAllPreferences_Call) Return(_a0 *dto.UserPreferencesResponse, _a1 error) *PreferenceService_UpdateAllPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceService_UpdateAllPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, *dto.UserPreferencesUpdateRequest, bool, bool) (*dto.UserPreferencesResponse, error)) *PreferenceService_UpdateAllPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategoryPreferences provides a mock function with given fields: ctx, requesterID, targetUserID, category, update, isAdmin, hasServiceScope
+func (_m *PreferenceService) UpdateCategoryPreferences(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, category dto.PreferenceCategory, update any, isAdmin bool, hasServiceScope bool) (*dto.PreferenceCategoryResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, category, update, isAdmin, hasServiceScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategoryPreferences")
+	}
+
+	var r0 *dto.PreferenceCategoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, any, bool, bool) (*dto.PreferenceCategoryResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, category, update, isAdmin, hasServiceScope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, any, bool, bool) *dto.PreferenceCategoryResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, category, update, isAdmin, hasServiceScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PreferenceCategoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, any, bool, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, category, update, isAdmin, hasServiceScope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceService_UpdateCategoryPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategoryPreferences'
+type PreferenceService_UpdateCategoryPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateCategoryPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - category dto.PreferenceCategory
+//   - update any
+//   - isAdmin bool
+//   - hasServiceScope bool
+func (_e *PreferenceService_Expecter) UpdateCategoryPreferences(ctx interface{}, requesterID interface{}, targetUserID interface{}, category interface{}, update interface{}, isAdmin interface{}, hasServiceScope interface{}) *PreferenceService_UpdateCategoryPreferences_Call {
+	return &PreferenceService_UpdateCategoryPreferences_Call{Call: _e.mock.On("UpdateCategoryPreferences", ctx, requesterID, targetUserID, category, update, isAdmin, hasServiceScope)}
+}
+
+func (_c *PreferenceService_UpdateCategoryPreferences_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, category dto.PreferenceCategory, update any, isAdmin bool, hasServiceScope bool)) *PreferenceService_UpdateCategoryPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(dto.PreferenceCategory), args[4].(any), args[5].(bool), args[6].(bool))
+	})
+	return _c
+}
+
+func (_c *PreferenceService_UpdateCategoryPreferences_Call) Return(_a0 *dto.PreferenceCategoryResponse, _a1 error) *PreferenceService_UpdateCategoryPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceService_UpdateCategoryPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, dto.PreferenceCategory, any, bool, bool) (*dto.PreferenceCategoryResponse, error)) *PreferenceService_UpdateCategoryPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreferenceService creates a new instance of PreferenceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreferenceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreferenceService {
+	mock := &PreferenceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
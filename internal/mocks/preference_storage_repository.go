@@ -0,0 +1,212 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PreferenceStorageRepository is an autogenerated mock type for the PreferenceStorageRepository type
+type PreferenceStorageRepository struct {
+	mock.Mock
+}
+
+type PreferenceStorageRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreferenceStorageRepository) EXPECT() *PreferenceStorageRepository_Expecter {
+	return &PreferenceStorageRepository_Expecter{mock: &_m.Mock}
+}
+
+// CopyPreferencesToDocuments provides a mock function with given fields: ctx, userIDs
+func (_m *PreferenceStorageRepository) CopyPreferencesToDocuments(ctx context.Context, userIDs []uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyPreferencesToDocuments")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (int, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) int); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceStorageRepository_CopyPreferencesToDocuments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyPreferencesToDocuments'
+type PreferenceStorageRepository_CopyPreferencesToDocuments_Call struct {
+	*mock.Call
+}
+
+// CopyPreferencesToDocuments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []uuid.UUID
+func (_e *PreferenceStorageRepository_Expecter) CopyPreferencesToDocuments(ctx interface{}, userIDs interface{}) *PreferenceStorageRepository_CopyPreferencesToDocuments_Call {
+	return &PreferenceStorageRepository_CopyPreferencesToDocuments_Call{Call: _e.mock.On("CopyPreferencesToDocuments", ctx, userIDs)}
+}
+
+func (_c *PreferenceStorageRepository_CopyPreferencesToDocuments_Call) Run(run func(ctx context.Context, userIDs []uuid.UUID)) *PreferenceStorageRepository_CopyPreferencesToDocuments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceStorageRepository_CopyPreferencesToDocuments_Call) Return(_a0 int, _a1 error) *PreferenceStorageRepository_CopyPreferencesToDocuments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceStorageRepository_CopyPreferencesToDocuments_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (int, error)) *PreferenceStorageRepository_CopyPreferencesToDocuments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyPreferencesToTables provides a mock function with given fields: ctx, userIDs
+func (_m *PreferenceStorageRepository) CopyPreferencesToTables(ctx context.Context, userIDs []uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyPreferencesToTables")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (int, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) int); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceStorageRepository_CopyPreferencesToTables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyPreferencesToTables'
+type PreferenceStorageRepository_CopyPreferencesToTables_Call struct {
+	*mock.Call
+}
+
+// CopyPreferencesToTables is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []uuid.UUID
+func (_e *PreferenceStorageRepository_Expecter) CopyPreferencesToTables(ctx interface{}, userIDs interface{}) *PreferenceStorageRepository_CopyPreferencesToTables_Call {
+	return &PreferenceStorageRepository_CopyPreferencesToTables_Call{Call: _e.mock.On("CopyPreferencesToTables", ctx, userIDs)}
+}
+
+func (_c *PreferenceStorageRepository_CopyPreferencesToTables_Call) Run(run func(ctx context.Context, userIDs []uuid.UUID)) *PreferenceStorageRepository_CopyPreferencesToTables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PreferenceStorageRepository_CopyPreferencesToTables_Call) Return(_a0 int, _a1 error) *PreferenceStorageRepository_CopyPreferencesToTables_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceStorageRepository_CopyPreferencesToTables_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (int, error)) *PreferenceStorageRepository_CopyPreferencesToTables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserIDs provides a mock function with given fields: ctx, after, limit
+func (_m *PreferenceStorageRepository) ListUserIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserIDs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]uuid.UUID, error)); ok {
+		return rf(ctx, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []uuid.UUID); ok {
+		r0 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceStorageRepository_ListUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserIDs'
+type PreferenceStorageRepository_ListUserIDs_Call struct {
+	*mock.Call
+}
+
+// ListUserIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after uuid.UUID
+//   - limit int
+func (_e *PreferenceStorageRepository_Expecter) ListUserIDs(ctx interface{}, after interface{}, limit interface{}) *PreferenceStorageRepository_ListUserIDs_Call {
+	return &PreferenceStorageRepository_ListUserIDs_Call{Call: _e.mock.On("ListUserIDs", ctx, after, limit)}
+}
+
+func (_c *PreferenceStorageRepository_ListUserIDs_Call) Run(run func(ctx context.Context, after uuid.UUID, limit int)) *PreferenceStorageRepository_ListUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *PreferenceStorageRepository_ListUserIDs_Call) Return(_a0 []uuid.UUID, _a1 error) *PreferenceStorageRepository_ListUserIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceStorageRepository_ListUserIDs_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]uuid.UUID, error)) *PreferenceStorageRepository_ListUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreferenceStorageRepository creates a new instance of PreferenceStorageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreferenceStorageRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreferenceStorageRepository {
+	mock := &PreferenceStorageRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
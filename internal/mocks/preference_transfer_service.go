@@ -0,0 +1,159 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PreferenceTransferService is an autogenerated mock type for the PreferenceTransferService type
+type PreferenceTransferService struct {
+	mock.Mock
+}
+
+type PreferenceTransferService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreferenceTransferService) EXPECT() *PreferenceTransferService_Expecter {
+	return &PreferenceTransferService_Expecter{mock: &_m.Mock}
+}
+
+// ExportPreferences provides a mock function with given fields: ctx, userID, categories
+func (_m *PreferenceTransferService) ExportPreferences(ctx context.Context, userID uuid.UUID, categories []dto.PreferenceCategory) (*dto.PreferenceBundle, error) {
+	ret := _m.Called(ctx, userID, categories)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportPreferences")
+	}
+
+	var r0 *dto.PreferenceBundle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []dto.PreferenceCategory) (*dto.PreferenceBundle, error)); ok {
+		return rf(ctx, userID, categories)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []dto.PreferenceCategory) *dto.PreferenceBundle); ok {
+		r0 = rf(ctx, userID, categories)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PreferenceBundle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []dto.PreferenceCategory) error); ok {
+		r1 = rf(ctx, userID, categories)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceTransferService_ExportPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportPreferences'
+type PreferenceTransferService_ExportPreferences_Call struct {
+	*mock.Call
+}
+
+// ExportPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - categories []dto.PreferenceCategory
+func (_e *PreferenceTransferService_Expecter) ExportPreferences(ctx interface{}, userID interface{}, categories interface{}) *PreferenceTransferService_ExportPreferences_Call {
+	return &PreferenceTransferService_ExportPreferences_Call{Call: _e.mock.On("ExportPreferences", ctx, userID, categories)}
+}
+
+func (_c *PreferenceTransferService_ExportPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, categories []dto.PreferenceCategory)) *PreferenceTransferService_ExportPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]dto.PreferenceCategory))
+	})
+	return _c
+}
+
+func (_c *PreferenceTransferService_ExportPreferences_Call) Return(_a0 *dto.PreferenceBundle, _a1 error) *PreferenceTransferService_ExportPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceTransferService_ExportPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, []dto.PreferenceCategory) (*dto.PreferenceBundle, error)) *PreferenceTransferService_ExportPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportPreferences provides a mock function with given fields: ctx, userID, req
+func (_m *PreferenceTransferService) ImportPreferences(ctx context.Context, userID uuid.UUID, req *dto.PreferenceImportRequest) (*dto.UserPreferencesResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportPreferences")
+	}
+
+	var r0 *dto.UserPreferencesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PreferenceImportRequest) (*dto.UserPreferencesResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PreferenceImportRequest) *dto.UserPreferencesResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserPreferencesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.PreferenceImportRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreferenceTransferService_ImportPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportPreferences'
+type PreferenceTransferService_ImportPreferences_Call struct {
+	*mock.Call
+}
+
+// ImportPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - req *dto.PreferenceImportRequest
+func (_e *PreferenceTransferService_Expecter) ImportPreferences(ctx interface{}, userID interface{}, req interface{}) *PreferenceTransferService_ImportPreferences_Call {
+	return &PreferenceTransferService_ImportPreferences_Call{Call: _e.mock.On("ImportPreferences", ctx, userID, req)}
+}
+
+func (_c *PreferenceTransferService_ImportPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, req *dto.PreferenceImportRequest)) *PreferenceTransferService_ImportPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.PreferenceImportRequest))
+	})
+	return _c
+}
+
+func (_c *PreferenceTransferService_ImportPreferences_Call) Return(_a0 *dto.UserPreferencesResponse, _a1 error) *PreferenceTransferService_ImportPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PreferenceTransferService_ImportPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.PreferenceImportRequest) (*dto.UserPreferencesResponse, error)) *PreferenceTransferService_ImportPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreferenceTransferService creates a new instance of PreferenceTransferService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreferenceTransferService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreferenceTransferService {
+	mock := &PreferenceTransferService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
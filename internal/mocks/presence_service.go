@@ -0,0 +1,194 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PresenceService is an autogenerated mock type for the PresenceService type
+type PresenceService struct {
+	mock.Mock
+}
+
+type PresenceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PresenceService) EXPECT() *PresenceService_Expecter {
+	return &PresenceService_Expecter{mock: &_m.Mock}
+}
+
+// AttachPresence provides a mock function with given fields: ctx, requesterID, users
+func (_m *PresenceService) AttachPresence(ctx context.Context, requesterID uuid.UUID, users []dto.User) error {
+	ret := _m.Called(ctx, requesterID, users)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachPresence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []dto.User) error); ok {
+		r0 = rf(ctx, requesterID, users)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PresenceService_AttachPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachPresence'
+type PresenceService_AttachPresence_Call struct {
+	*mock.Call
+}
+
+// AttachPresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - users []dto.User
+func (_e *PresenceService_Expecter) AttachPresence(ctx interface{}, requesterID interface{}, users interface{}) *PresenceService_AttachPresence_Call {
+	return &PresenceService_AttachPresence_Call{Call: _e.mock.On("AttachPresence", ctx, requesterID, users)}
+}
+
+func (_c *PresenceService_AttachPresence_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, users []dto.User)) *PresenceService_AttachPresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]dto.User))
+	})
+	return _c
+}
+
+func (_c *PresenceService_AttachPresence_Call) Return(_a0 error) *PresenceService_AttachPresence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PresenceService_AttachPresence_Call) RunAndReturn(run func(context.Context, uuid.UUID, []dto.User) error) *PresenceService_AttachPresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPresence provides a mock function with given fields: ctx, requesterID, userID
+func (_m *PresenceService) GetPresence(ctx context.Context, requesterID *uuid.UUID, userID uuid.UUID) (*dto.UserPresenceResponse, error) {
+	ret := _m.Called(ctx, requesterID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPresence")
+	}
+
+	var r0 *dto.UserPresenceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, uuid.UUID) (*dto.UserPresenceResponse, error)); ok {
+		return rf(ctx, requesterID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, uuid.UUID) *dto.UserPresenceResponse); ok {
+		r0 = rf(ctx, requesterID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserPresenceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, requesterID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PresenceService_GetPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPresence'
+type PresenceService_GetPresence_Call struct {
+	*mock.Call
+}
+
+// GetPresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID *uuid.UUID
+//   - userID uuid.UUID
+func (_e *PresenceService_Expecter) GetPresence(ctx interface{}, requesterID interface{}, userID interface{}) *PresenceService_GetPresence_Call {
+	return &PresenceService_GetPresence_Call{Call: _e.mock.On("GetPresence", ctx, requesterID, userID)}
+}
+
+func (_c *PresenceService_GetPresence_Call) Run(run func(ctx context.Context, requesterID *uuid.UUID, userID uuid.UUID)) *PresenceService_GetPresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PresenceService_GetPresence_Call) Return(_a0 *dto.UserPresenceResponse, _a1 error) *PresenceService_GetPresence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PresenceService_GetPresence_Call) RunAndReturn(run func(context.Context, *uuid.UUID, uuid.UUID) (*dto.UserPresenceResponse, error)) *PresenceService_GetPresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Heartbeat provides a mock function with given fields: ctx, userID
+func (_m *PresenceService) Heartbeat(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Heartbeat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PresenceService_Heartbeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Heartbeat'
+type PresenceService_Heartbeat_Call struct {
+	*mock.Call
+}
+
+// Heartbeat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PresenceService_Expecter) Heartbeat(ctx interface{}, userID interface{}) *PresenceService_Heartbeat_Call {
+	return &PresenceService_Heartbeat_Call{Call: _e.mock.On("Heartbeat", ctx, userID)}
+}
+
+func (_c *PresenceService_Heartbeat_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PresenceService_Heartbeat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PresenceService_Heartbeat_Call) Return(_a0 error) *PresenceService_Heartbeat_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PresenceService_Heartbeat_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *PresenceService_Heartbeat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPresenceService creates a new instance of PresenceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPresenceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PresenceService {
+	mock := &PresenceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
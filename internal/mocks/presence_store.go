@@ -0,0 +1,148 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// PresenceStore is an autogenerated mock type for the PresenceStore type
+type PresenceStore struct {
+	mock.Mock
+}
+
+type PresenceStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PresenceStore) EXPECT() *PresenceStore_Expecter {
+	return &PresenceStore_Expecter{mock: &_m.Mock}
+}
+
+// GetLastSeen provides a mock function with given fields: ctx, userIDs
+func (_m *PresenceStore) GetLastSeen(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastSeen")
+	}
+
+	var r0 map[uuid.UUID]time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]time.Time, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]time.Time); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PresenceStore_GetLastSeen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastSeen'
+type PresenceStore_GetLastSeen_Call struct {
+	*mock.Call
+}
+
+// GetLastSeen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []uuid.UUID
+func (_e *PresenceStore_Expecter) GetLastSeen(ctx interface{}, userIDs interface{}) *PresenceStore_GetLastSeen_Call {
+	return &PresenceStore_GetLastSeen_Call{Call: _e.mock.On("GetLastSeen", ctx, userIDs)}
+}
+
+func (_c *PresenceStore_GetLastSeen_Call) Run(run func(ctx context.Context, userIDs []uuid.UUID)) *PresenceStore_GetLastSeen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PresenceStore_GetLastSeen_Call) Return(_a0 map[uuid.UUID]time.Time, _a1 error) *PresenceStore_GetLastSeen_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PresenceStore_GetLastSeen_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (map[uuid.UUID]time.Time, error)) *PresenceStore_GetLastSeen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchPresence provides a mock function with given fields: ctx, userID, at, ttl
+func (_m *PresenceStore) TouchPresence(ctx context.Context, userID uuid.UUID, at time.Time, ttl time.Duration) error {
+	ret := _m.Called(ctx, userID, at, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchPresence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Duration) error); ok {
+		r0 = rf(ctx, userID, at, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PresenceStore_TouchPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchPresence'
+type PresenceStore_TouchPresence_Call struct {
+	*mock.Call
+}
+
+// TouchPresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - at time.Time
+//   - ttl time.Duration
+func (_e *PresenceStore_Expecter) TouchPresence(ctx interface{}, userID interface{}, at interface{}, ttl interface{}) *PresenceStore_TouchPresence_Call {
+	return &PresenceStore_TouchPresence_Call{Call: _e.mock.On("TouchPresence", ctx, userID, at, ttl)}
+}
+
+func (_c *PresenceStore_TouchPresence_Call) Run(run func(ctx context.Context, userID uuid.UUID, at time.Time, ttl time.Duration)) *PresenceStore_TouchPresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *PresenceStore_TouchPresence_Call) Return(_a0 error) *PresenceStore_TouchPresence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PresenceStore_TouchPresence_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Duration) error) *PresenceStore_TouchPresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPresenceStore creates a new instance of PresenceStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPresenceStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PresenceStore {
+	mock := &PresenceStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
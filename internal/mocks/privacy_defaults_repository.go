@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// PrivacyDefaultsRepository is an autogenerated mock type for the PrivacyDefaultsRepository type
+type PrivacyDefaultsRepository struct {
+	mock.Mock
+}
+
+type PrivacyDefaultsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PrivacyDefaultsRepository) EXPECT() *PrivacyDefaultsRepository_Expecter {
+	return &PrivacyDefaultsRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountPrivacyDefaultsVersions provides a mock function with given fields: ctx
+func (_m *PrivacyDefaultsRepository) CountPrivacyDefaultsVersions(ctx context.Context) ([]dto.PrivacyDefaultsCohort, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPrivacyDefaultsVersions")
+	}
+
+	var r0 []dto.PrivacyDefaultsCohort
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]dto.PrivacyDefaultsCohort, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []dto.PrivacyDefaultsCohort); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.PrivacyDefaultsCohort)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivacyDefaultsRepository_CountPrivacyDefaultsVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPrivacyDefaultsVersions'
+type PrivacyDefaultsRepository_CountPrivacyDefaultsVersions_Call struct {
+	*mock.Call
+}
+
+// CountPrivacyDefaultsVersions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PrivacyDefaultsRepository_Expecter) CountPrivacyDefaultsVersions(ctx interface{}) *PrivacyDefaultsRepository_CountPrivacyDefaultsVersions_Call {
+	return &PrivacyDefaultsRepository_CountPrivacyDefaultsVersions_Call{Call: _e.mock.On("CountPrivacyDefaultsVersions", ctx)}
+}
+
+func (_c *PrivacyDefaultsRepository_CountPrivacyDefaultsVersions_Call) Run(run func(ctx context.Context)) *PrivacyDefaultsRepository_CountPrivacyDefaultsVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PrivacyDefaultsRepository_CountPrivacyDefaultsVersions_Call) Return(_a0 []dto.PrivacyDefaultsCohort, _a1 error) *PrivacyDefaultsRepository_CountPrivacyDefaultsVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivacyDefaultsRepository_CountPrivacyDefaultsVersions_Call) RunAndReturn(run func(context.Context) ([]dto.PrivacyDefaultsCohort, error)) *PrivacyDefaultsRepository_CountPrivacyDefaultsVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MovePrivacyDefaults provides a mock function with given fields: ctx, from, to, limit
+func (_m *PrivacyDefaultsRepository) MovePrivacyDefaults(ctx context.Context, from int, to int, limit int) ([]repository.PrivacyDefaultsMove, error) {
+	ret := _m.Called(ctx, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MovePrivacyDefaults")
+	}
+
+	var r0 []repository.PrivacyDefaultsMove
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) ([]repository.PrivacyDefaultsMove, error)); ok {
+		return rf(ctx, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) []repository.PrivacyDefaultsMove); ok {
+		r0 = rf(ctx, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.PrivacyDefaultsMove)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = rf(ctx, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivacyDefaultsRepository_MovePrivacyDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MovePrivacyDefaults'
+type PrivacyDefaultsRepository_MovePrivacyDefaults_Call struct {
+	*mock.Call
+}
+
+// MovePrivacyDefaults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from int
+//   - to int
+//   - limit int
+func (_e *PrivacyDefaultsRepository_Expecter) MovePrivacyDefaults(ctx interface{}, from interface{}, to interface{}, limit interface{}) *PrivacyDefaultsRepository_MovePrivacyDefaults_Call {
+	return &PrivacyDefaultsRepository_MovePrivacyDefaults_Call{Call: _e.mock.On("MovePrivacyDefaults", ctx, from, to, limit)}
+}
+
+func (_c *PrivacyDefaultsRepository_MovePrivacyDefaults_Call) Run(run func(ctx context.Context, from int, to int, limit int)) *PrivacyDefaultsRepository_MovePrivacyDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *PrivacyDefaultsRepository_MovePrivacyDefaults_Call) Return(_a0 []repository.PrivacyDefaultsMove, _a1 error) *PrivacyDefaultsRepository_MovePrivacyDefaults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivacyDefaultsRepository_MovePrivacyDefaults_Call) RunAndReturn(run func(context.Context, int, int, int) ([]repository.PrivacyDefaultsMove, error)) *PrivacyDefaultsRepository_MovePrivacyDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPrivacyDefaultsRepository creates a new instance of PrivacyDefaultsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPrivacyDefaultsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PrivacyDefaultsRepository {
+	mock := &PrivacyDefaultsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
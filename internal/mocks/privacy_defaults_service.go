@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PrivacyDefaultsService is an autogenerated mock type for the PrivacyDefaultsService type
+type PrivacyDefaultsService struct {
+	mock.Mock
+}
+
+type PrivacyDefaultsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PrivacyDefaultsService) EXPECT() *PrivacyDefaultsService_Expecter {
+	return &PrivacyDefaultsService_Expecter{mock: &_m.Mock}
+}
+
+// GetPrivacyDefaults provides a mock function with given fields: ctx
+func (_m *PrivacyDefaultsService) GetPrivacyDefaults(ctx context.Context) (*dto.PrivacyDefaultsStatusResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivacyDefaults")
+	}
+
+	var r0 *dto.PrivacyDefaultsStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.PrivacyDefaultsStatusResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.PrivacyDefaultsStatusResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PrivacyDefaultsStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivacyDefaultsService_GetPrivacyDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivacyDefaults'
+type PrivacyDefaultsService_GetPrivacyDefaults_Call struct {
+	*mock.Call
+}
+
+// GetPrivacyDefaults is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PrivacyDefaultsService_Expecter) GetPrivacyDefaults(ctx interface{}) *PrivacyDefaultsService_GetPrivacyDefaults_Call {
+	return &PrivacyDefaultsService_GetPrivacyDefaults_Call{Call: _e.mock.On("GetPrivacyDefaults", ctx)}
+}
+
+func (_c *PrivacyDefaultsService_GetPrivacyDefaults_Call) Run(run func(ctx context.Context)) *PrivacyDefaultsService_GetPrivacyDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PrivacyDefaultsService_GetPrivacyDefaults_Call) Return(_a0 *dto.PrivacyDefaultsStatusResponse, _a1 error) *PrivacyDefaultsService_GetPrivacyDefaults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivacyDefaultsService_GetPrivacyDefaults_Call) RunAndReturn(run func(context.Context) (*dto.PrivacyDefaultsStatusResponse, error)) *PrivacyDefaultsService_GetPrivacyDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigratePrivacyDefaults provides a mock function with given fields: ctx, actorID, req
+func (_m *PrivacyDefaultsService) MigratePrivacyDefaults(ctx context.Context, actorID uuid.UUID, req *dto.PrivacyDefaultsMigrationRequest) (*dto.PrivacyDefaultsMigrationResponse, error) {
+	ret := _m.Called(ctx, actorID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigratePrivacyDefaults")
+	}
+
+	var r0 *dto.PrivacyDefaultsMigrationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PrivacyDefaultsMigrationRequest) (*dto.PrivacyDefaultsMigrationResponse, error)); ok {
+		return rf(ctx, actorID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PrivacyDefaultsMigrationRequest) *dto.PrivacyDefaultsMigrationResponse); ok {
+		r0 = rf(ctx, actorID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PrivacyDefaultsMigrationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.PrivacyDefaultsMigrationRequest) error); ok {
+		r1 = rf(ctx, actorID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivacyDefaultsService_MigratePrivacyDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigratePrivacyDefaults'
+type PrivacyDefaultsService_MigratePrivacyDefaults_Call struct {
+	*mock.Call
+}
+
+// MigratePrivacyDefaults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - req *dto.PrivacyDefaultsMigrationRequest
+func (_e *PrivacyDefaultsService_Expecter) MigratePrivacyDefaults(ctx interface{}, actorID interface{}, req interface{}) *PrivacyDefaultsService_MigratePrivacyDefaults_Call {
+	return &PrivacyDefaultsService_MigratePrivacyDefaults_Call{Call: _e.mock.On("MigratePrivacyDefaults", ctx, actorID, req)}
+}
+
+func (_c *PrivacyDefaultsService_MigratePrivacyDefaults_Call) Run(run func(ctx context.Context, actorID uuid.UUID, req *dto.PrivacyDefaultsMigrationRequest)) *PrivacyDefaultsService_MigratePrivacyDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.PrivacyDefaultsMigrationRequest))
+	})
+	return _c
+}
+
+func (_c *PrivacyDefaultsService_MigratePrivacyDefaults_Call) Return(_a0 *dto.PrivacyDefaultsMigrationResponse, _a1 error) *PrivacyDefaultsService_MigratePrivacyDefaults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivacyDefaultsService_MigratePrivacyDefaults_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.PrivacyDefaultsMigrationRequest) (*dto.PrivacyDefaultsMigrationResponse, error)) *PrivacyDefaultsService_MigratePrivacyDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPrivacyDefaultsService creates a new instance of PrivacyDefaultsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPrivacyDefaultsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PrivacyDefaultsService {
+	mock := &PrivacyDefaultsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PrivacyPreferenceRepo is an autogenerated mock type for the PrivacyPreferenceRepo type
+type PrivacyPreferenceRepo struct {
+	mock.Mock
+}
+
+type PrivacyPreferenceRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PrivacyPreferenceRepo) EXPECT() *PrivacyPreferenceRepo_Expecter {
+	return &PrivacyPreferenceRepo_Expecter{mock: &_m.Mock}
+}
+
+// GetPrivacyPreferencesData provides a mock function with given fields: ctx, userID
+func (_m *PrivacyPreferenceRepo) GetPrivacyPreferencesData(ctx context.Context, userID uuid.UUID) (*dto.UserPrivacyPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivacyPreferencesData")
+	}
+
+	var r0 *dto.UserPrivacyPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.UserPrivacyPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.UserPrivacyPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserPrivacyPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivacyPreferenceRepo_GetPrivacyPreferencesData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivacyPreferencesData'
+type PrivacyPreferenceRepo_GetPrivacyPreferencesData_Call struct {
+	*mock.Call
+}
+
+// GetPrivacyPreferencesData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PrivacyPreferenceRepo_Expecter) GetPrivacyPreferencesData(ctx interface{}, userID interface{}) *PrivacyPreferenceRepo_GetPrivacyPreferencesData_Call {
+	return &PrivacyPreferenceRepo_GetPrivacyPreferencesData_Call{Call: _e.mock.On("GetPrivacyPreferencesData", ctx, userID)}
+}
+
+func (_c *PrivacyPreferenceRepo_GetPrivacyPreferencesData_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PrivacyPreferenceRepo_GetPrivacyPreferencesData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PrivacyPreferenceRepo_GetPrivacyPreferencesData_Call) Return(_a0 *dto.UserPrivacyPreferences, _a1 error) *PrivacyPreferenceRepo_GetPrivacyPreferencesData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivacyPreferenceRepo_GetPrivacyPreferencesData_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.UserPrivacyPreferences, error)) *PrivacyPreferenceRepo_GetPrivacyPreferencesData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePrivacyPreferencesData provides a mock function with given fields: ctx, userID, u
+func (_m *PrivacyPreferenceRepo) UpdatePrivacyPreferencesData(ctx context.Context, userID uuid.UUID, u *dto.PrivacyPreferencesUpdate) (*dto.UserPrivacyPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePrivacyPreferencesData")
+	}
+
+	var r0 *dto.UserPrivacyPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PrivacyPreferencesUpdate) (*dto.UserPrivacyPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.PrivacyPreferencesUpdate) *dto.UserPrivacyPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserPrivacyPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.PrivacyPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivacyPreferenceRepo_UpdatePrivacyPreferencesData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePrivacyPreferencesData'
+type PrivacyPreferenceRepo_UpdatePrivacyPreferencesData_Call struct {
+	*mock.Call
+}
+
+// UpdatePrivacyPreferencesData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.PrivacyPreferencesUpdate
+func (_e *PrivacyPreferenceRepo_Expecter) UpdatePrivacyPreferencesData(ctx interface{}, userID interface{}, u interface{}) *PrivacyPreferenceRepo_UpdatePrivacyPreferencesData_Call {
+	return &PrivacyPreferenceRepo_UpdatePrivacyPreferencesData_Call{Call: _e.mock.On("UpdatePrivacyPreferencesData", ctx, userID, u)}
+}
+
+func (_c *PrivacyPreferenceRepo_UpdatePrivacyPreferencesData_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.PrivacyPreferencesUpdate)) *PrivacyPreferenceRepo_UpdatePrivacyPreferencesData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.PrivacyPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *PrivacyPreferenceRepo_UpdatePrivacyPreferencesData_Call) Return(_a0 *dto.UserPrivacyPreferences, _a1 error) *PrivacyPreferenceRepo_UpdatePrivacyPreferencesData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivacyPreferenceRepo_UpdatePrivacyPreferencesData_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.PrivacyPreferencesUpdate) (*dto.UserPrivacyPreferences, error)) *PrivacyPreferenceRepo_UpdatePrivacyPreferencesData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPrivacyPreferenceRepo creates a new instance of PrivacyPreferenceRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPrivacyPreferenceRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PrivacyPreferenceRepo {
+	mock := &PrivacyPreferenceRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PrivacyReportService is an autogenerated mock type for the PrivacyReportService type
+type PrivacyReportService struct {
+	mock.Mock
+}
+
+type PrivacyReportService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PrivacyReportService) EXPECT() *PrivacyReportService_Expecter {
+	return &PrivacyReportService_Expecter{mock: &_m.Mock}
+}
+
+// GetPrivacyReport provides a mock function with given fields: ctx, userID
+func (_m *PrivacyReportService) GetPrivacyReport(ctx context.Context, userID uuid.UUID) (*dto.PrivacyReportResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivacyReport")
+	}
+
+	var r0 *dto.PrivacyReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PrivacyReportResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PrivacyReportResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PrivacyReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivacyReportService_GetPrivacyReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivacyReport'
+type PrivacyReportService_GetPrivacyReport_Call struct {
+	*mock.Call
+}
+
+// GetPrivacyReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PrivacyReportService_Expecter) GetPrivacyReport(ctx interface{}, userID interface{}) *PrivacyReportService_GetPrivacyReport_Call {
+	return &PrivacyReportService_GetPrivacyReport_Call{Call: _e.mock.On("GetPrivacyReport", ctx, userID)}
+}
+
+func (_c *PrivacyReportService_GetPrivacyReport_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PrivacyReportService_GetPrivacyReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PrivacyReportService_GetPrivacyReport_Call) Return(_a0 *dto.PrivacyReportResponse, _a1 error) *PrivacyReportService_GetPrivacyReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivacyReportService_GetPrivacyReport_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.PrivacyReportResponse, error)) *PrivacyReportService_GetPrivacyReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPrivacyReportService creates a new instance of PrivacyReportService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPrivacyReportService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PrivacyReportService {
+	mock := &PrivacyReportService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,204 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ProfileShareService is an autogenerated mock type for the ProfileShareService type
+type ProfileShareService struct {
+	mock.Mock
+}
+
+type ProfileShareService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ProfileShareService) EXPECT() *ProfileShareService_Expecter {
+	return &ProfileShareService_Expecter{mock: &_m.Mock}
+}
+
+// CreateShareToken provides a mock function with given fields: ctx, userID
+func (_m *ProfileShareService) CreateShareToken(ctx context.Context, userID uuid.UUID) (*dto.ProfileShareTokenResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShareToken")
+	}
+
+	var r0 *dto.ProfileShareTokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.ProfileShareTokenResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.ProfileShareTokenResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProfileShareTokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProfileShareService_CreateShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShareToken'
+type ProfileShareService_CreateShareToken_Call struct {
+	*mock.Call
+}
+
+// CreateShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ProfileShareService_Expecter) CreateShareToken(ctx interface{}, userID interface{}) *ProfileShareService_CreateShareToken_Call {
+	return &ProfileShareService_CreateShareToken_Call{Call: _e.mock.On("CreateShareToken", ctx, userID)}
+}
+
+func (_c *ProfileShareService_CreateShareToken_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ProfileShareService_CreateShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProfileShareService_CreateShareToken_Call) Return(_a0 *dto.ProfileShareTokenResponse, _a1 error) *ProfileShareService_CreateShareToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProfileShareService_CreateShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.ProfileShareTokenResponse, error)) *ProfileShareService_CreateShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSharedProfile provides a mock function with given fields: ctx, token
+func (_m *ProfileShareService) GetSharedProfile(ctx context.Context, token string) (*dto.UserProfileResponse, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSharedProfile")
+	}
+
+	var r0 *dto.UserProfileResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.UserProfileResponse, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.UserProfileResponse); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserProfileResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProfileShareService_GetSharedProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSharedProfile'
+type ProfileShareService_GetSharedProfile_Call struct {
+	*mock.Call
+}
+
+// GetSharedProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *ProfileShareService_Expecter) GetSharedProfile(ctx interface{}, token interface{}) *ProfileShareService_GetSharedProfile_Call {
+	return &ProfileShareService_GetSharedProfile_Call{Call: _e.mock.On("GetSharedProfile", ctx, token)}
+}
+
+func (_c *ProfileShareService_GetSharedProfile_Call) Run(run func(ctx context.Context, token string)) *ProfileShareService_GetSharedProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ProfileShareService_GetSharedProfile_Call) Return(_a0 *dto.UserProfileResponse, _a1 error) *ProfileShareService_GetSharedProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProfileShareService_GetSharedProfile_Call) RunAndReturn(run func(context.Context, string) (*dto.UserProfileResponse, error)) *ProfileShareService_GetSharedProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeShareToken provides a mock function with given fields: ctx, userID
+func (_m *ProfileShareService) RevokeShareToken(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeShareToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProfileShareService_RevokeShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeShareToken'
+type ProfileShareService_RevokeShareToken_Call struct {
+	*mock.Call
+}
+
+// RevokeShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ProfileShareService_Expecter) RevokeShareToken(ctx interface{}, userID interface{}) *ProfileShareService_RevokeShareToken_Call {
+	return &ProfileShareService_RevokeShareToken_Call{Call: _e.mock.On("RevokeShareToken", ctx, userID)}
+}
+
+func (_c *ProfileShareService_RevokeShareToken_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ProfileShareService_RevokeShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProfileShareService_RevokeShareToken_Call) Return(_a0 error) *ProfileShareService_RevokeShareToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProfileShareService_RevokeShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ProfileShareService_RevokeShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProfileShareService creates a new instance of ProfileShareService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProfileShareService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProfileShareService {
+	mock := &ProfileShareService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
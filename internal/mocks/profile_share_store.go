@@ -0,0 +1,193 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// ProfileShareStore is an autogenerated mock type for the ProfileShareStore type
+type ProfileShareStore struct {
+	mock.Mock
+}
+
+type ProfileShareStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ProfileShareStore) EXPECT() *ProfileShareStore_Expecter {
+	return &ProfileShareStore_Expecter{mock: &_m.Mock}
+}
+
+// DeleteProfileShareToken provides a mock function with given fields: ctx, userID
+func (_m *ProfileShareStore) DeleteProfileShareToken(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProfileShareToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProfileShareStore_DeleteProfileShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProfileShareToken'
+type ProfileShareStore_DeleteProfileShareToken_Call struct {
+	*mock.Call
+}
+
+// DeleteProfileShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ProfileShareStore_Expecter) DeleteProfileShareToken(ctx interface{}, userID interface{}) *ProfileShareStore_DeleteProfileShareToken_Call {
+	return &ProfileShareStore_DeleteProfileShareToken_Call{Call: _e.mock.On("DeleteProfileShareToken", ctx, userID)}
+}
+
+func (_c *ProfileShareStore_DeleteProfileShareToken_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ProfileShareStore_DeleteProfileShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProfileShareStore_DeleteProfileShareToken_Call) Return(_a0 error) *ProfileShareStore_DeleteProfileShareToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProfileShareStore_DeleteProfileShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ProfileShareStore_DeleteProfileShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfileShareToken provides a mock function with given fields: ctx, userID
+func (_m *ProfileShareStore) GetProfileShareToken(ctx context.Context, userID uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfileShareToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (string, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProfileShareStore_GetProfileShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfileShareToken'
+type ProfileShareStore_GetProfileShareToken_Call struct {
+	*mock.Call
+}
+
+// GetProfileShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ProfileShareStore_Expecter) GetProfileShareToken(ctx interface{}, userID interface{}) *ProfileShareStore_GetProfileShareToken_Call {
+	return &ProfileShareStore_GetProfileShareToken_Call{Call: _e.mock.On("GetProfileShareToken", ctx, userID)}
+}
+
+func (_c *ProfileShareStore_GetProfileShareToken_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ProfileShareStore_GetProfileShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProfileShareStore_GetProfileShareToken_Call) Return(_a0 string, _a1 error) *ProfileShareStore_GetProfileShareToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProfileShareStore_GetProfileShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) (string, error)) *ProfileShareStore_GetProfileShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreProfileShareToken provides a mock function with given fields: ctx, userID, tokenID, ttl
+func (_m *ProfileShareStore) StoreProfileShareToken(ctx context.Context, userID uuid.UUID, tokenID string, ttl time.Duration) error {
+	ret := _m.Called(ctx, userID, tokenID, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreProfileShareToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Duration) error); ok {
+		r0 = rf(ctx, userID, tokenID, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProfileShareStore_StoreProfileShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreProfileShareToken'
+type ProfileShareStore_StoreProfileShareToken_Call struct {
+	*mock.Call
+}
+
+// StoreProfileShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - tokenID string
+//   - ttl time.Duration
+func (_e *ProfileShareStore_Expecter) StoreProfileShareToken(ctx interface{}, userID interface{}, tokenID interface{}, ttl interface{}) *ProfileShareStore_StoreProfileShareToken_Call {
+	return &ProfileShareStore_StoreProfileShareToken_Call{Call: _e.mock.On("StoreProfileShareToken", ctx, userID, tokenID, ttl)}
+}
+
+func (_c *ProfileShareStore_StoreProfileShareToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, tokenID string, ttl time.Duration)) *ProfileShareStore_StoreProfileShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ProfileShareStore_StoreProfileShareToken_Call) Return(_a0 error) *ProfileShareStore_StoreProfileShareToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProfileShareStore_StoreProfileShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Duration) error) *ProfileShareStore_StoreProfileShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProfileShareStore creates a new instance of ProfileShareStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProfileShareStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProfileShareStore {
+	mock := &ProfileShareStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,142 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ProfileViewRepository is an autogenerated mock type for the ProfileViewRepository type
+type ProfileViewRepository struct {
+	mock.Mock
+}
+
+type ProfileViewRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ProfileViewRepository) EXPECT() *ProfileViewRepository_Expecter {
+	return &ProfileViewRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddProfileViews provides a mock function with given fields: ctx, views
+func (_m *ProfileViewRepository) AddProfileViews(ctx context.Context, views map[uuid.UUID]int64) error {
+	ret := _m.Called(ctx, views)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProfileViews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[uuid.UUID]int64) error); ok {
+		r0 = rf(ctx, views)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProfileViewRepository_AddProfileViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProfileViews'
+type ProfileViewRepository_AddProfileViews_Call struct {
+	*mock.Call
+}
+
+// AddProfileViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - views map[uuid.UUID]int64
+func (_e *ProfileViewRepository_Expecter) AddProfileViews(ctx interface{}, views interface{}) *ProfileViewRepository_AddProfileViews_Call {
+	return &ProfileViewRepository_AddProfileViews_Call{Call: _e.mock.On("AddProfileViews", ctx, views)}
+}
+
+func (_c *ProfileViewRepository_AddProfileViews_Call) Run(run func(ctx context.Context, views map[uuid.UUID]int64)) *ProfileViewRepository_AddProfileViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[uuid.UUID]int64))
+	})
+	return _c
+}
+
+func (_c *ProfileViewRepository_AddProfileViews_Call) Return(_a0 error) *ProfileViewRepository_AddProfileViews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProfileViewRepository_AddProfileViews_Call) RunAndReturn(run func(context.Context, map[uuid.UUID]int64) error) *ProfileViewRepository_AddProfileViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfileViewCount provides a mock function with given fields: ctx, userID
+func (_m *ProfileViewRepository) GetProfileViewCount(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfileViewCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProfileViewRepository_GetProfileViewCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfileViewCount'
+type ProfileViewRepository_GetProfileViewCount_Call struct {
+	*mock.Call
+}
+
+// GetProfileViewCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ProfileViewRepository_Expecter) GetProfileViewCount(ctx interface{}, userID interface{}) *ProfileViewRepository_GetProfileViewCount_Call {
+	return &ProfileViewRepository_GetProfileViewCount_Call{Call: _e.mock.On("GetProfileViewCount", ctx, userID)}
+}
+
+func (_c *ProfileViewRepository_GetProfileViewCount_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ProfileViewRepository_GetProfileViewCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProfileViewRepository_GetProfileViewCount_Call) Return(_a0 int64, _a1 error) *ProfileViewRepository_GetProfileViewCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProfileViewRepository_GetProfileViewCount_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int64, error)) *ProfileViewRepository_GetProfileViewCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProfileViewRepository creates a new instance of ProfileViewRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProfileViewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProfileViewRepository {
+	mock := &ProfileViewRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,203 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ProfileViewService is an autogenerated mock type for the ProfileViewService type
+type ProfileViewService struct {
+	mock.Mock
+}
+
+type ProfileViewService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ProfileViewService) EXPECT() *ProfileViewService_Expecter {
+	return &ProfileViewService_Expecter{mock: &_m.Mock}
+}
+
+// FlushViews provides a mock function with given fields: ctx
+func (_m *ProfileViewService) FlushViews(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushViews")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProfileViewService_FlushViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushViews'
+type ProfileViewService_FlushViews_Call struct {
+	*mock.Call
+}
+
+// FlushViews is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ProfileViewService_Expecter) FlushViews(ctx interface{}) *ProfileViewService_FlushViews_Call {
+	return &ProfileViewService_FlushViews_Call{Call: _e.mock.On("FlushViews", ctx)}
+}
+
+func (_c *ProfileViewService_FlushViews_Call) Run(run func(ctx context.Context)) *ProfileViewService_FlushViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ProfileViewService_FlushViews_Call) Return(_a0 int, _a1 error) *ProfileViewService_FlushViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProfileViewService_FlushViews_Call) RunAndReturn(run func(context.Context) (int, error)) *ProfileViewService_FlushViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfileViews provides a mock function with given fields: ctx, userID
+func (_m *ProfileViewService) GetProfileViews(ctx context.Context, userID uuid.UUID) (*dto.ProfileViewsResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfileViews")
+	}
+
+	var r0 *dto.ProfileViewsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.ProfileViewsResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.ProfileViewsResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProfileViewsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProfileViewService_GetProfileViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfileViews'
+type ProfileViewService_GetProfileViews_Call struct {
+	*mock.Call
+}
+
+// GetProfileViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ProfileViewService_Expecter) GetProfileViews(ctx interface{}, userID interface{}) *ProfileViewService_GetProfileViews_Call {
+	return &ProfileViewService_GetProfileViews_Call{Call: _e.mock.On("GetProfileViews", ctx, userID)}
+}
+
+func (_c *ProfileViewService_GetProfileViews_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ProfileViewService_GetProfileViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProfileViewService_GetProfileViews_Call) Return(_a0 *dto.ProfileViewsResponse, _a1 error) *ProfileViewService_GetProfileViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProfileViewService_GetProfileViews_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.ProfileViewsResponse, error)) *ProfileViewService_GetProfileViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordView provides a mock function with given fields: ctx, userID, viewerID, viewerIP
+func (_m *ProfileViewService) RecordView(ctx context.Context, userID uuid.UUID, viewerID *uuid.UUID, viewerIP string) error {
+	ret := _m.Called(ctx, userID, viewerID, viewerIP)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *uuid.UUID, string) error); ok {
+		r0 = rf(ctx, userID, viewerID, viewerIP)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProfileViewService_RecordView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordView'
+type ProfileViewService_RecordView_Call struct {
+	*mock.Call
+}
+
+// RecordView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - viewerID *uuid.UUID
+//   - viewerIP string
+func (_e *ProfileViewService_Expecter) RecordView(ctx interface{}, userID interface{}, viewerID interface{}, viewerIP interface{}) *ProfileViewService_RecordView_Call {
+	return &ProfileViewService_RecordView_Call{Call: _e.mock.On("RecordView", ctx, userID, viewerID, viewerIP)}
+}
+
+func (_c *ProfileViewService_RecordView_Call) Run(run func(ctx context.Context, userID uuid.UUID, viewerID *uuid.UUID, viewerIP string)) *ProfileViewService_RecordView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ProfileViewService_RecordView_Call) Return(_a0 error) *ProfileViewService_RecordView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProfileViewService_RecordView_Call) RunAndReturn(run func(context.Context, uuid.UUID, *uuid.UUID, string) error) *ProfileViewService_RecordView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProfileViewService creates a new instance of ProfileViewService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProfileViewService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProfileViewService {
+	mock := &ProfileViewService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,307 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// ProfileViewStore is an autogenerated mock type for the ProfileViewStore type
+type ProfileViewStore struct {
+	mock.Mock
+}
+
+type ProfileViewStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ProfileViewStore) EXPECT() *ProfileViewStore_Expecter {
+	return &ProfileViewStore_Expecter{mock: &_m.Mock}
+}
+
+// AckProfileViews provides a mock function with given fields: ctx
+func (_m *ProfileViewStore) AckProfileViews(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AckProfileViews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProfileViewStore_AckProfileViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AckProfileViews'
+type ProfileViewStore_AckProfileViews_Call struct {
+	*mock.Call
+}
+
+// AckProfileViews is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ProfileViewStore_Expecter) AckProfileViews(ctx interface{}) *ProfileViewStore_AckProfileViews_Call {
+	return &ProfileViewStore_AckProfileViews_Call{Call: _e.mock.On("AckProfileViews", ctx)}
+}
+
+func (_c *ProfileViewStore_AckProfileViews_Call) Run(run func(ctx context.Context)) *ProfileViewStore_AckProfileViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ProfileViewStore_AckProfileViews_Call) Return(_a0 error) *ProfileViewStore_AckProfileViews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProfileViewStore_AckProfileViews_Call) RunAndReturn(run func(context.Context) error) *ProfileViewStore_AckProfileViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingProfileViews provides a mock function with given fields: ctx, userID
+func (_m *ProfileViewStore) GetPendingProfileViews(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingProfileViews")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProfileViewStore_GetPendingProfileViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingProfileViews'
+type ProfileViewStore_GetPendingProfileViews_Call struct {
+	*mock.Call
+}
+
+// GetPendingProfileViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ProfileViewStore_Expecter) GetPendingProfileViews(ctx interface{}, userID interface{}) *ProfileViewStore_GetPendingProfileViews_Call {
+	return &ProfileViewStore_GetPendingProfileViews_Call{Call: _e.mock.On("GetPendingProfileViews", ctx, userID)}
+}
+
+func (_c *ProfileViewStore_GetPendingProfileViews_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ProfileViewStore_GetPendingProfileViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProfileViewStore_GetPendingProfileViews_Call) Return(_a0 int64, _a1 error) *ProfileViewStore_GetPendingProfileViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProfileViewStore_GetPendingProfileViews_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int64, error)) *ProfileViewStore_GetPendingProfileViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementProfileViews provides a mock function with given fields: ctx, userID
+func (_m *ProfileViewStore) IncrementProfileViews(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementProfileViews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProfileViewStore_IncrementProfileViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementProfileViews'
+type ProfileViewStore_IncrementProfileViews_Call struct {
+	*mock.Call
+}
+
+// IncrementProfileViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ProfileViewStore_Expecter) IncrementProfileViews(ctx interface{}, userID interface{}) *ProfileViewStore_IncrementProfileViews_Call {
+	return &ProfileViewStore_IncrementProfileViews_Call{Call: _e.mock.On("IncrementProfileViews", ctx, userID)}
+}
+
+func (_c *ProfileViewStore_IncrementProfileViews_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ProfileViewStore_IncrementProfileViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProfileViewStore_IncrementProfileViews_Call) Return(_a0 error) *ProfileViewStore_IncrementProfileViews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProfileViewStore_IncrementProfileViews_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ProfileViewStore_IncrementProfileViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkProfileViewed provides a mock function with given fields: ctx, userID, viewer, ttl
+func (_m *ProfileViewStore) MarkProfileViewed(ctx context.Context, userID uuid.UUID, viewer string, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, userID, viewer, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkProfileViewed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Duration) (bool, error)); ok {
+		return rf(ctx, userID, viewer, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Duration) bool); ok {
+		r0 = rf(ctx, userID, viewer, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Duration) error); ok {
+		r1 = rf(ctx, userID, viewer, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProfileViewStore_MarkProfileViewed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkProfileViewed'
+type ProfileViewStore_MarkProfileViewed_Call struct {
+	*mock.Call
+}
+
+// MarkProfileViewed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - viewer string
+//   - ttl time.Duration
+func (_e *ProfileViewStore_Expecter) MarkProfileViewed(ctx interface{}, userID interface{}, viewer interface{}, ttl interface{}) *ProfileViewStore_MarkProfileViewed_Call {
+	return &ProfileViewStore_MarkProfileViewed_Call{Call: _e.mock.On("MarkProfileViewed", ctx, userID, viewer, ttl)}
+}
+
+func (_c *ProfileViewStore_MarkProfileViewed_Call) Run(run func(ctx context.Context, userID uuid.UUID, viewer string, ttl time.Duration)) *ProfileViewStore_MarkProfileViewed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ProfileViewStore_MarkProfileViewed_Call) Return(_a0 bool, _a1 error) *ProfileViewStore_MarkProfileViewed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProfileViewStore_MarkProfileViewed_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Duration) (bool, error)) *ProfileViewStore_MarkProfileViewed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TakeProfileViews provides a mock function with given fields: ctx
+func (_m *ProfileViewStore) TakeProfileViews(ctx context.Context) (map[uuid.UUID]int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeProfileViews")
+	}
+
+	var r0 map[uuid.UUID]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[uuid.UUID]int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[uuid.UUID]int64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProfileViewStore_TakeProfileViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeProfileViews'
+type ProfileViewStore_TakeProfileViews_Call struct {
+	*mock.Call
+}
+
+// TakeProfileViews is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ProfileViewStore_Expecter) TakeProfileViews(ctx interface{}) *ProfileViewStore_TakeProfileViews_Call {
+	return &ProfileViewStore_TakeProfileViews_Call{Call: _e.mock.On("TakeProfileViews", ctx)}
+}
+
+func (_c *ProfileViewStore_TakeProfileViews_Call) Run(run func(ctx context.Context)) *ProfileViewStore_TakeProfileViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ProfileViewStore_TakeProfileViews_Call) Return(_a0 map[uuid.UUID]int64, _a1 error) *ProfileViewStore_TakeProfileViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProfileViewStore_TakeProfileViews_Call) RunAndReturn(run func(context.Context) (map[uuid.UUID]int64, error)) *ProfileViewStore_TakeProfileViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProfileViewStore creates a new instance of ProfileViewStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProfileViewStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProfileViewStore {
+	mock := &ProfileViewStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,257 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PublicProfileService is an autogenerated mock type for the PublicProfileService type
+type PublicProfileService struct {
+	mock.Mock
+}
+
+type PublicProfileService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PublicProfileService) EXPECT() *PublicProfileService_Expecter {
+	return &PublicProfileService_Expecter{mock: &_m.Mock}
+}
+
+// GetPublicProfile provides a mock function with given fields: ctx, userID
+func (_m *PublicProfileService) GetPublicProfile(ctx context.Context, userID uuid.UUID) (*dto.PublicProfile, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicProfile")
+	}
+
+	var r0 *dto.PublicProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PublicProfile, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PublicProfile); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PublicProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublicProfileService_GetPublicProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicProfile'
+type PublicProfileService_GetPublicProfile_Call struct {
+	*mock.Call
+}
+
+// GetPublicProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PublicProfileService_Expecter) GetPublicProfile(ctx interface{}, userID interface{}) *PublicProfileService_GetPublicProfile_Call {
+	return &PublicProfileService_GetPublicProfile_Call{Call: _e.mock.On("GetPublicProfile", ctx, userID)}
+}
+
+func (_c *PublicProfileService_GetPublicProfile_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PublicProfileService_GetPublicProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PublicProfileService_GetPublicProfile_Call) Return(_a0 *dto.PublicProfile, _a1 error) *PublicProfileService_GetPublicProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PublicProfileService_GetPublicProfile_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.PublicProfile, error)) *PublicProfileService_GetPublicProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invalidate provides a mock function with given fields: ctx, userID
+func (_m *PublicProfileService) Invalidate(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invalidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PublicProfileService_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type PublicProfileService_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PublicProfileService_Expecter) Invalidate(ctx interface{}, userID interface{}) *PublicProfileService_Invalidate_Call {
+	return &PublicProfileService_Invalidate_Call{Call: _e.mock.On("Invalidate", ctx, userID)}
+}
+
+func (_c *PublicProfileService_Invalidate_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PublicProfileService_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PublicProfileService_Invalidate_Call) Return(_a0 error) *PublicProfileService_Invalidate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PublicProfileService_Invalidate_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *PublicProfileService_Invalidate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rebuild provides a mock function with given fields: ctx
+func (_m *PublicProfileService) Rebuild(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rebuild")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublicProfileService_Rebuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rebuild'
+type PublicProfileService_Rebuild_Call struct {
+	*mock.Call
+}
+
+// Rebuild is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PublicProfileService_Expecter) Rebuild(ctx interface{}) *PublicProfileService_Rebuild_Call {
+	return &PublicProfileService_Rebuild_Call{Call: _e.mock.On("Rebuild", ctx)}
+}
+
+func (_c *PublicProfileService_Rebuild_Call) Run(run func(ctx context.Context)) *PublicProfileService_Rebuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PublicProfileService_Rebuild_Call) Return(_a0 int, _a1 error) *PublicProfileService_Rebuild_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PublicProfileService_Rebuild_Call) RunAndReturn(run func(context.Context) (int, error)) *PublicProfileService_Rebuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sync provides a mock function with given fields: ctx
+func (_m *PublicProfileService) Sync(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sync")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublicProfileService_Sync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sync'
+type PublicProfileService_Sync_Call struct {
+	*mock.Call
+}
+
+// Sync is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PublicProfileService_Expecter) Sync(ctx interface{}) *PublicProfileService_Sync_Call {
+	return &PublicProfileService_Sync_Call{Call: _e.mock.On("Sync", ctx)}
+}
+
+func (_c *PublicProfileService_Sync_Call) Run(run func(ctx context.Context)) *PublicProfileService_Sync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PublicProfileService_Sync_Call) Return(_a0 int, _a1 error) *PublicProfileService_Sync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PublicProfileService_Sync_Call) RunAndReturn(run func(context.Context) (int, error)) *PublicProfileService_Sync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPublicProfileService creates a new instance of PublicProfileService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPublicProfileService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PublicProfileService {
+	mock := &PublicProfileService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,295 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PublicProfileStore is an autogenerated mock type for the PublicProfileStore type
+type PublicProfileStore struct {
+	mock.Mock
+}
+
+type PublicProfileStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PublicProfileStore) EXPECT() *PublicProfileStore_Expecter {
+	return &PublicProfileStore_Expecter{mock: &_m.Mock}
+}
+
+// DeletePublicProfile provides a mock function with given fields: ctx, userID
+func (_m *PublicProfileStore) DeletePublicProfile(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePublicProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PublicProfileStore_DeletePublicProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePublicProfile'
+type PublicProfileStore_DeletePublicProfile_Call struct {
+	*mock.Call
+}
+
+// DeletePublicProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PublicProfileStore_Expecter) DeletePublicProfile(ctx interface{}, userID interface{}) *PublicProfileStore_DeletePublicProfile_Call {
+	return &PublicProfileStore_DeletePublicProfile_Call{Call: _e.mock.On("DeletePublicProfile", ctx, userID)}
+}
+
+func (_c *PublicProfileStore_DeletePublicProfile_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PublicProfileStore_DeletePublicProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PublicProfileStore_DeletePublicProfile_Call) Return(_a0 error) *PublicProfileStore_DeletePublicProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PublicProfileStore_DeletePublicProfile_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *PublicProfileStore_DeletePublicProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPublicProfile provides a mock function with given fields: ctx, userID
+func (_m *PublicProfileStore) GetPublicProfile(ctx context.Context, userID uuid.UUID) (*dto.PublicProfile, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicProfile")
+	}
+
+	var r0 *dto.PublicProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.PublicProfile, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.PublicProfile); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PublicProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublicProfileStore_GetPublicProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicProfile'
+type PublicProfileStore_GetPublicProfile_Call struct {
+	*mock.Call
+}
+
+// GetPublicProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PublicProfileStore_Expecter) GetPublicProfile(ctx interface{}, userID interface{}) *PublicProfileStore_GetPublicProfile_Call {
+	return &PublicProfileStore_GetPublicProfile_Call{Call: _e.mock.On("GetPublicProfile", ctx, userID)}
+}
+
+func (_c *PublicProfileStore_GetPublicProfile_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PublicProfileStore_GetPublicProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PublicProfileStore_GetPublicProfile_Call) Return(_a0 *dto.PublicProfile, _a1 error) *PublicProfileStore_GetPublicProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PublicProfileStore_GetPublicProfile_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.PublicProfile, error)) *PublicProfileStore_GetPublicProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPublicProfileCursor provides a mock function with given fields: ctx
+func (_m *PublicProfileStore) GetPublicProfileCursor(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicProfileCursor")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublicProfileStore_GetPublicProfileCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicProfileCursor'
+type PublicProfileStore_GetPublicProfileCursor_Call struct {
+	*mock.Call
+}
+
+// GetPublicProfileCursor is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PublicProfileStore_Expecter) GetPublicProfileCursor(ctx interface{}) *PublicProfileStore_GetPublicProfileCursor_Call {
+	return &PublicProfileStore_GetPublicProfileCursor_Call{Call: _e.mock.On("GetPublicProfileCursor", ctx)}
+}
+
+func (_c *PublicProfileStore_GetPublicProfileCursor_Call) Run(run func(ctx context.Context)) *PublicProfileStore_GetPublicProfileCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PublicProfileStore_GetPublicProfileCursor_Call) Return(_a0 int64, _a1 error) *PublicProfileStore_GetPublicProfileCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PublicProfileStore_GetPublicProfileCursor_Call) RunAndReturn(run func(context.Context) (int64, error)) *PublicProfileStore_GetPublicProfileCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPublicProfileCursor provides a mock function with given fields: ctx, cursor
+func (_m *PublicProfileStore) SetPublicProfileCursor(ctx context.Context, cursor int64) error {
+	ret := _m.Called(ctx, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPublicProfileCursor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, cursor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PublicProfileStore_SetPublicProfileCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPublicProfileCursor'
+type PublicProfileStore_SetPublicProfileCursor_Call struct {
+	*mock.Call
+}
+
+// SetPublicProfileCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cursor int64
+func (_e *PublicProfileStore_Expecter) SetPublicProfileCursor(ctx interface{}, cursor interface{}) *PublicProfileStore_SetPublicProfileCursor_Call {
+	return &PublicProfileStore_SetPublicProfileCursor_Call{Call: _e.mock.On("SetPublicProfileCursor", ctx, cursor)}
+}
+
+func (_c *PublicProfileStore_SetPublicProfileCursor_Call) Run(run func(ctx context.Context, cursor int64)) *PublicProfileStore_SetPublicProfileCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *PublicProfileStore_SetPublicProfileCursor_Call) Return(_a0 error) *PublicProfileStore_SetPublicProfileCursor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PublicProfileStore_SetPublicProfileCursor_Call) RunAndReturn(run func(context.Context, int64) error) *PublicProfileStore_SetPublicProfileCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPublicProfiles provides a mock function with given fields: ctx, profiles
+func (_m *PublicProfileStore) SetPublicProfiles(ctx context.Context, profiles []dto.PublicProfile) error {
+	ret := _m.Called(ctx, profiles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPublicProfiles")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []dto.PublicProfile) error); ok {
+		r0 = rf(ctx, profiles)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PublicProfileStore_SetPublicProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPublicProfiles'
+type PublicProfileStore_SetPublicProfiles_Call struct {
+	*mock.Call
+}
+
+// SetPublicProfiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profiles []dto.PublicProfile
+func (_e *PublicProfileStore_Expecter) SetPublicProfiles(ctx interface{}, profiles interface{}) *PublicProfileStore_SetPublicProfiles_Call {
+	return &PublicProfileStore_SetPublicProfiles_Call{Call: _e.mock.On("SetPublicProfiles", ctx, profiles)}
+}
+
+func (_c *PublicProfileStore_SetPublicProfiles_Call) Run(run func(ctx context.Context, profiles []dto.PublicProfile)) *PublicProfileStore_SetPublicProfiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]dto.PublicProfile))
+	})
+	return _c
+}
+
+func (_c *PublicProfileStore_SetPublicProfiles_Call) Return(_a0 error) *PublicProfileStore_SetPublicProfiles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PublicProfileStore_SetPublicProfiles_Call) RunAndReturn(run func(context.Context, []dto.PublicProfile) error) *PublicProfileStore_SetPublicProfiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPublicProfileStore creates a new instance of PublicProfileStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPublicProfileStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PublicProfileStore {
+	mock := &PublicProfileStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,193 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RecentlyViewedService is an autogenerated mock type for the RecentlyViewedService type
+type RecentlyViewedService struct {
+	mock.Mock
+}
+
+type RecentlyViewedService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RecentlyViewedService) EXPECT() *RecentlyViewedService_Expecter {
+	return &RecentlyViewedService_Expecter{mock: &_m.Mock}
+}
+
+// ClearRecentlyViewed provides a mock function with given fields: ctx, userID
+func (_m *RecentlyViewedService) ClearRecentlyViewed(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearRecentlyViewed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecentlyViewedService_ClearRecentlyViewed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearRecentlyViewed'
+type RecentlyViewedService_ClearRecentlyViewed_Call struct {
+	*mock.Call
+}
+
+// ClearRecentlyViewed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *RecentlyViewedService_Expecter) ClearRecentlyViewed(ctx interface{}, userID interface{}) *RecentlyViewedService_ClearRecentlyViewed_Call {
+	return &RecentlyViewedService_ClearRecentlyViewed_Call{Call: _e.mock.On("ClearRecentlyViewed", ctx, userID)}
+}
+
+func (_c *RecentlyViewedService_ClearRecentlyViewed_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *RecentlyViewedService_ClearRecentlyViewed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RecentlyViewedService_ClearRecentlyViewed_Call) Return(_a0 error) *RecentlyViewedService_ClearRecentlyViewed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RecentlyViewedService_ClearRecentlyViewed_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *RecentlyViewedService_ClearRecentlyViewed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentlyViewed provides a mock function with given fields: ctx, userID
+func (_m *RecentlyViewedService) GetRecentlyViewed(ctx context.Context, userID uuid.UUID) (*dto.RecentlyViewedResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentlyViewed")
+	}
+
+	var r0 *dto.RecentlyViewedResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.RecentlyViewedResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.RecentlyViewedResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RecentlyViewedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecentlyViewedService_GetRecentlyViewed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentlyViewed'
+type RecentlyViewedService_GetRecentlyViewed_Call struct {
+	*mock.Call
+}
+
+// GetRecentlyViewed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *RecentlyViewedService_Expecter) GetRecentlyViewed(ctx interface{}, userID interface{}) *RecentlyViewedService_GetRecentlyViewed_Call {
+	return &RecentlyViewedService_GetRecentlyViewed_Call{Call: _e.mock.On("GetRecentlyViewed", ctx, userID)}
+}
+
+func (_c *RecentlyViewedService_GetRecentlyViewed_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *RecentlyViewedService_GetRecentlyViewed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RecentlyViewedService_GetRecentlyViewed_Call) Return(_a0 *dto.RecentlyViewedResponse, _a1 error) *RecentlyViewedService_GetRecentlyViewed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RecentlyViewedService_GetRecentlyViewed_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.RecentlyViewedResponse, error)) *RecentlyViewedService_GetRecentlyViewed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordView provides a mock function with given fields: ctx, userID, viewedID
+func (_m *RecentlyViewedService) RecordView(ctx context.Context, userID uuid.UUID, viewedID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, viewedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, viewedID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecentlyViewedService_RecordView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordView'
+type RecentlyViewedService_RecordView_Call struct {
+	*mock.Call
+}
+
+// RecordView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - viewedID uuid.UUID
+func (_e *RecentlyViewedService_Expecter) RecordView(ctx interface{}, userID interface{}, viewedID interface{}) *RecentlyViewedService_RecordView_Call {
+	return &RecentlyViewedService_RecordView_Call{Call: _e.mock.On("RecordView", ctx, userID, viewedID)}
+}
+
+func (_c *RecentlyViewedService_RecordView_Call) Run(run func(ctx context.Context, userID uuid.UUID, viewedID uuid.UUID)) *RecentlyViewedService_RecordView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RecentlyViewedService_RecordView_Call) Return(_a0 error) *RecentlyViewedService_RecordView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RecentlyViewedService_RecordView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *RecentlyViewedService_RecordView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRecentlyViewedService creates a new instance of RecentlyViewedService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRecentlyViewedService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RecentlyViewedService {
+	mock := &RecentlyViewedService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
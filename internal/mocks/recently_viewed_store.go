@@ -0,0 +1,196 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// RecentlyViewedStore is an autogenerated mock type for the RecentlyViewedStore type
+type RecentlyViewedStore struct {
+	mock.Mock
+}
+
+type RecentlyViewedStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RecentlyViewedStore) EXPECT() *RecentlyViewedStore_Expecter {
+	return &RecentlyViewedStore_Expecter{mock: &_m.Mock}
+}
+
+// ClearRecentlyViewed provides a mock function with given fields: ctx, userID
+func (_m *RecentlyViewedStore) ClearRecentlyViewed(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearRecentlyViewed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecentlyViewedStore_ClearRecentlyViewed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearRecentlyViewed'
+type RecentlyViewedStore_ClearRecentlyViewed_Call struct {
+	*mock.Call
+}
+
+// ClearRecentlyViewed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *RecentlyViewedStore_Expecter) ClearRecentlyViewed(ctx interface{}, userID interface{}) *RecentlyViewedStore_ClearRecentlyViewed_Call {
+	return &RecentlyViewedStore_ClearRecentlyViewed_Call{Call: _e.mock.On("ClearRecentlyViewed", ctx, userID)}
+}
+
+func (_c *RecentlyViewedStore_ClearRecentlyViewed_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *RecentlyViewedStore_ClearRecentlyViewed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RecentlyViewedStore_ClearRecentlyViewed_Call) Return(_a0 error) *RecentlyViewedStore_ClearRecentlyViewed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RecentlyViewedStore_ClearRecentlyViewed_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *RecentlyViewedStore_ClearRecentlyViewed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentlyViewed provides a mock function with given fields: ctx, userID
+func (_m *RecentlyViewedStore) GetRecentlyViewed(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentlyViewed")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]uuid.UUID, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecentlyViewedStore_GetRecentlyViewed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentlyViewed'
+type RecentlyViewedStore_GetRecentlyViewed_Call struct {
+	*mock.Call
+}
+
+// GetRecentlyViewed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *RecentlyViewedStore_Expecter) GetRecentlyViewed(ctx interface{}, userID interface{}) *RecentlyViewedStore_GetRecentlyViewed_Call {
+	return &RecentlyViewedStore_GetRecentlyViewed_Call{Call: _e.mock.On("GetRecentlyViewed", ctx, userID)}
+}
+
+func (_c *RecentlyViewedStore_GetRecentlyViewed_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *RecentlyViewedStore_GetRecentlyViewed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RecentlyViewedStore_GetRecentlyViewed_Call) Return(_a0 []uuid.UUID, _a1 error) *RecentlyViewedStore_GetRecentlyViewed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RecentlyViewedStore_GetRecentlyViewed_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]uuid.UUID, error)) *RecentlyViewedStore_GetRecentlyViewed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PushRecentlyViewed provides a mock function with given fields: ctx, userID, viewedID, limit, ttl
+func (_m *RecentlyViewedStore) PushRecentlyViewed(ctx context.Context, userID uuid.UUID, viewedID uuid.UUID, limit int, ttl time.Duration) error {
+	ret := _m.Called(ctx, userID, viewedID, limit, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PushRecentlyViewed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int, time.Duration) error); ok {
+		r0 = rf(ctx, userID, viewedID, limit, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecentlyViewedStore_PushRecentlyViewed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushRecentlyViewed'
+type RecentlyViewedStore_PushRecentlyViewed_Call struct {
+	*mock.Call
+}
+
+// PushRecentlyViewed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - viewedID uuid.UUID
+//   - limit int
+//   - ttl time.Duration
+func (_e *RecentlyViewedStore_Expecter) PushRecentlyViewed(ctx interface{}, userID interface{}, viewedID interface{}, limit interface{}, ttl interface{}) *RecentlyViewedStore_PushRecentlyViewed_Call {
+	return &RecentlyViewedStore_PushRecentlyViewed_Call{Call: _e.mock.On("PushRecentlyViewed", ctx, userID, viewedID, limit, ttl)}
+}
+
+func (_c *RecentlyViewedStore_PushRecentlyViewed_Call) Run(run func(ctx context.Context, userID uuid.UUID, viewedID uuid.UUID, limit int, ttl time.Duration)) *RecentlyViewedStore_PushRecentlyViewed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int), args[4].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *RecentlyViewedStore_PushRecentlyViewed_Call) Return(_a0 error) *RecentlyViewedStore_PushRecentlyViewed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RecentlyViewedStore_PushRecentlyViewed_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int, time.Duration) error) *RecentlyViewedStore_PushRecentlyViewed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRecentlyViewedStore creates a new instance of RecentlyViewedStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRecentlyViewedStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RecentlyViewedStore {
+	mock := &RecentlyViewedStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RedisCacheClient is an autogenerated mock type for the RedisCacheClient type
+type RedisCacheClient struct {
+	mock.Mock
+}
+
+type RedisCacheClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RedisCacheClient) EXPECT() *RedisCacheClient_Expecter {
+	return &RedisCacheClient_Expecter{mock: &_m.Mock}
+}
+
+// ClearCache provides a mock function with given fields: ctx, pattern
+func (_m *RedisCacheClient) ClearCache(ctx context.Context, pattern string) (int, error) {
+	ret := _m.Called(ctx, pattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearCache")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, pattern)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, pattern)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, pattern)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedisCacheClient_ClearCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearCache'
+type RedisCacheClient_ClearCache_Call struct {
+	*mock.Call
+}
+
+// ClearCache is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pattern string
+func (_e *RedisCacheClient_Expecter) ClearCache(ctx interface{}, pattern interface{}) *RedisCacheClient_ClearCache_Call {
+	return &RedisCacheClient_ClearCache_Call{Call: _e.mock.On("ClearCache", ctx, pattern)}
+}
+
+func (_c *RedisCacheClient_ClearCache_Call) Run(run func(ctx context.Context, pattern string)) *RedisCacheClient_ClearCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RedisCacheClient_ClearCache_Call) Return(_a0 int, _a1 error) *RedisCacheClient_ClearCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RedisCacheClient_ClearCache_Call) RunAndReturn(run func(context.Context, string) (int, error)) *RedisCacheClient_ClearCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRedisCacheClient creates a new instance of RedisCacheClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRedisCacheClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RedisCacheClient {
+	mock := &RedisCacheClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RedisClient is an autogenerated mock type for the RedisClient type
+type RedisClient struct {
+	mock.Mock
+}
+
+type RedisClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RedisClient) EXPECT() *RedisClient_Expecter {
+	return &RedisClient_Expecter{mock: &_m.Mock}
+}
+
+// GetCacheMetrics provides a mock function with given fields: ctx
+func (_m *RedisClient) GetCacheMetrics(ctx context.Context) (*dto.CacheMetricsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCacheMetrics")
+	}
+
+	var r0 *dto.CacheMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.CacheMetricsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.CacheMetricsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CacheMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedisClient_GetCacheMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheMetrics'
+type RedisClient_GetCacheMetrics_Call struct {
+	*mock.Call
+}
+
+// GetCacheMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RedisClient_Expecter) GetCacheMetrics(ctx interface{}) *RedisClient_GetCacheMetrics_Call {
+	return &RedisClient_GetCacheMetrics_Call{Call: _e.mock.On("GetCacheMetrics", ctx)}
+}
+
+func (_c *RedisClient_GetCacheMetrics_Call) Run(run func(ctx context.Context)) *RedisClient_GetCacheMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RedisClient_GetCacheMetrics_Call) Return(_a0 *dto.CacheMetricsResponse, _a1 error) *RedisClient_GetCacheMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RedisClient_GetCacheMetrics_Call) RunAndReturn(run func(context.Context) (*dto.CacheMetricsResponse, error)) *RedisClient_GetCacheMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Health provides a mock function with given fields: ctx
+func (_m *RedisClient) Health(ctx context.Context) map[string]string {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Health")
+	}
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// RedisClient_Health_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Health'
+type RedisClient_Health_Call struct {
+	*mock.Call
+}
+
+// Health is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RedisClient_Expecter) Health(ctx interface{}) *RedisClient_Health_Call {
+	return &RedisClient_Health_Call{Call: _e.mock.On("Health", ctx)}
+}
+
+func (_c *RedisClient_Health_Call) Run(run func(ctx context.Context)) *RedisClient_Health_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RedisClient_Health_Call) Return(_a0 map[string]string) *RedisClient_Health_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RedisClient_Health_Call) RunAndReturn(run func(context.Context) map[string]string) *RedisClient_Health_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRedisClient creates a new instance of RedisClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRedisClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RedisClient {
+	mock := &RedisClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
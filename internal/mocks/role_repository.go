@@ -0,0 +1,145 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RoleRepository is an autogenerated mock type for the RoleRepository type
+type RoleRepository struct {
+	mock.Mock
+}
+
+type RoleRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RoleRepository) EXPECT() *RoleRepository_Expecter {
+	return &RoleRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetUserRole provides a mock function with given fields: ctx, userID
+func (_m *RoleRepository) GetUserRole(ctx context.Context, userID uuid.UUID) (dto.UserRole, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRole")
+	}
+
+	var r0 dto.UserRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dto.UserRole, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dto.UserRole); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(dto.UserRole)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleRepository_GetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRole'
+type RoleRepository_GetUserRole_Call struct {
+	*mock.Call
+}
+
+// GetUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *RoleRepository_Expecter) GetUserRole(ctx interface{}, userID interface{}) *RoleRepository_GetUserRole_Call {
+	return &RoleRepository_GetUserRole_Call{Call: _e.mock.On("GetUserRole", ctx, userID)}
+}
+
+func (_c *RoleRepository_GetUserRole_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *RoleRepository_GetUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RoleRepository_GetUserRole_Call) Return(_a0 dto.UserRole, _a1 error) *RoleRepository_GetUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleRepository_GetUserRole_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dto.UserRole, error)) *RoleRepository_GetUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserRole provides a mock function with given fields: ctx, actorID, userID, role
+func (_m *RoleRepository) SetUserRole(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, role dto.UserRole) error {
+	ret := _m.Called(ctx, actorID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dto.UserRole) error); ok {
+		r0 = rf(ctx, actorID, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RoleRepository_SetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserRole'
+type RoleRepository_SetUserRole_Call struct {
+	*mock.Call
+}
+
+// SetUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - role dto.UserRole
+func (_e *RoleRepository_Expecter) SetUserRole(ctx interface{}, actorID interface{}, userID interface{}, role interface{}) *RoleRepository_SetUserRole_Call {
+	return &RoleRepository_SetUserRole_Call{Call: _e.mock.On("SetUserRole", ctx, actorID, userID, role)}
+}
+
+func (_c *RoleRepository_SetUserRole_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, role dto.UserRole)) *RoleRepository_SetUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(dto.UserRole))
+	})
+	return _c
+}
+
+func (_c *RoleRepository_SetUserRole_Call) Return(_a0 error) *RoleRepository_SetUserRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RoleRepository_SetUserRole_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, dto.UserRole) error) *RoleRepository_SetUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRoleRepository creates a new instance of RoleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRoleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RoleRepository {
+	mock := &RoleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,216 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RoleService is an autogenerated mock type for the RoleService type
+type RoleService struct {
+	mock.Mock
+}
+
+type RoleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RoleService) EXPECT() *RoleService_Expecter {
+	return &RoleService_Expecter{mock: &_m.Mock}
+}
+
+// GetUserRole provides a mock function with given fields: ctx, userID
+func (_m *RoleService) GetUserRole(ctx context.Context, userID uuid.UUID) (*dto.UserRoleResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRole")
+	}
+
+	var r0 *dto.UserRoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.UserRoleResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.UserRoleResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserRoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleService_GetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRole'
+type RoleService_GetUserRole_Call struct {
+	*mock.Call
+}
+
+// GetUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *RoleService_Expecter) GetUserRole(ctx interface{}, userID interface{}) *RoleService_GetUserRole_Call {
+	return &RoleService_GetUserRole_Call{Call: _e.mock.On("GetUserRole", ctx, userID)}
+}
+
+func (_c *RoleService_GetUserRole_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *RoleService_GetUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RoleService_GetUserRole_Call) Return(_a0 *dto.UserRoleResponse, _a1 error) *RoleService_GetUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleService_GetUserRole_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.UserRoleResponse, error)) *RoleService_GetUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveRole provides a mock function with given fields: ctx, userID
+func (_m *RoleService) ResolveRole(ctx context.Context, userID uuid.UUID) (dto.UserRole, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveRole")
+	}
+
+	var r0 dto.UserRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dto.UserRole, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dto.UserRole); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(dto.UserRole)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleService_ResolveRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveRole'
+type RoleService_ResolveRole_Call struct {
+	*mock.Call
+}
+
+// ResolveRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *RoleService_Expecter) ResolveRole(ctx interface{}, userID interface{}) *RoleService_ResolveRole_Call {
+	return &RoleService_ResolveRole_Call{Call: _e.mock.On("ResolveRole", ctx, userID)}
+}
+
+func (_c *RoleService_ResolveRole_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *RoleService_ResolveRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RoleService_ResolveRole_Call) Return(_a0 dto.UserRole, _a1 error) *RoleService_ResolveRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleService_ResolveRole_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dto.UserRole, error)) *RoleService_ResolveRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserRole provides a mock function with given fields: ctx, actorID, userID, role
+func (_m *RoleService) SetUserRole(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, role dto.UserRole) (*dto.UserRoleResponse, error) {
+	ret := _m.Called(ctx, actorID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserRole")
+	}
+
+	var r0 *dto.UserRoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dto.UserRole) (*dto.UserRoleResponse, error)); ok {
+		return rf(ctx, actorID, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dto.UserRole) *dto.UserRoleResponse); ok {
+		r0 = rf(ctx, actorID, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserRoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, dto.UserRole) error); ok {
+		r1 = rf(ctx, actorID, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleService_SetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserRole'
+type RoleService_SetUserRole_Call struct {
+	*mock.Call
+}
+
+// SetUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID uuid.UUID
+//   - userID uuid.UUID
+//   - role dto.UserRole
+func (_e *RoleService_Expecter) SetUserRole(ctx interface{}, actorID interface{}, userID interface{}, role interface{}) *RoleService_SetUserRole_Call {
+	return &RoleService_SetUserRole_Call{Call: _e.mock.On("SetUserRole", ctx, actorID, userID, role)}
+}
+
+func (_c *RoleService_SetUserRole_Call) Run(run func(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, role dto.UserRole)) *RoleService_SetUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(dto.UserRole))
+	})
+	return _c
+}
+
+func (_c *RoleService_SetUserRole_Call) Return(_a0 *dto.UserRoleResponse, _a1 error) *RoleService_SetUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleService_SetUserRole_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, dto.UserRole) (*dto.UserRoleResponse, error)) *RoleService_SetUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRoleService creates a new instance of RoleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRoleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RoleService {
+	mock := &RoleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,193 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SearchCache is an autogenerated mock type for the SearchCache type
+type SearchCache struct {
+	mock.Mock
+}
+
+type SearchCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SearchCache) EXPECT() *SearchCache_Expecter {
+	return &SearchCache_Expecter{mock: &_m.Mock}
+}
+
+// GetSearchResults provides a mock function with given fields: ctx, key
+func (_m *SearchCache) GetSearchResults(ctx context.Context, key string) (*dto.UserSearchResponse, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSearchResults")
+	}
+
+	var r0 *dto.UserSearchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.UserSearchResponse, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.UserSearchResponse); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserSearchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchCache_GetSearchResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSearchResults'
+type SearchCache_GetSearchResults_Call struct {
+	*mock.Call
+}
+
+// GetSearchResults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *SearchCache_Expecter) GetSearchResults(ctx interface{}, key interface{}) *SearchCache_GetSearchResults_Call {
+	return &SearchCache_GetSearchResults_Call{Call: _e.mock.On("GetSearchResults", ctx, key)}
+}
+
+func (_c *SearchCache_GetSearchResults_Call) Run(run func(ctx context.Context, key string)) *SearchCache_GetSearchResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SearchCache_GetSearchResults_Call) Return(_a0 *dto.UserSearchResponse, _a1 error) *SearchCache_GetSearchResults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchCache_GetSearchResults_Call) RunAndReturn(run func(context.Context, string) (*dto.UserSearchResponse, error)) *SearchCache_GetSearchResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateSearchCache provides a mock function with given fields: ctx
+func (_m *SearchCache) InvalidateSearchCache(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateSearchCache")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchCache_InvalidateSearchCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateSearchCache'
+type SearchCache_InvalidateSearchCache_Call struct {
+	*mock.Call
+}
+
+// InvalidateSearchCache is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SearchCache_Expecter) InvalidateSearchCache(ctx interface{}) *SearchCache_InvalidateSearchCache_Call {
+	return &SearchCache_InvalidateSearchCache_Call{Call: _e.mock.On("InvalidateSearchCache", ctx)}
+}
+
+func (_c *SearchCache_InvalidateSearchCache_Call) Run(run func(ctx context.Context)) *SearchCache_InvalidateSearchCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SearchCache_InvalidateSearchCache_Call) Return(_a0 error) *SearchCache_InvalidateSearchCache_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SearchCache_InvalidateSearchCache_Call) RunAndReturn(run func(context.Context) error) *SearchCache_InvalidateSearchCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSearchResults provides a mock function with given fields: ctx, key, results, ttl
+func (_m *SearchCache) SetSearchResults(ctx context.Context, key string, results *dto.UserSearchResponse, ttl time.Duration) error {
+	ret := _m.Called(ctx, key, results, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSearchResults")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dto.UserSearchResponse, time.Duration) error); ok {
+		r0 = rf(ctx, key, results, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchCache_SetSearchResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSearchResults'
+type SearchCache_SetSearchResults_Call struct {
+	*mock.Call
+}
+
+// SetSearchResults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - results *dto.UserSearchResponse
+//   - ttl time.Duration
+func (_e *SearchCache_Expecter) SetSearchResults(ctx interface{}, key interface{}, results interface{}, ttl interface{}) *SearchCache_SetSearchResults_Call {
+	return &SearchCache_SetSearchResults_Call{Call: _e.mock.On("SetSearchResults", ctx, key, results, ttl)}
+}
+
+func (_c *SearchCache_SetSearchResults_Call) Run(run func(ctx context.Context, key string, results *dto.UserSearchResponse, ttl time.Duration)) *SearchCache_SetSearchResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*dto.UserSearchResponse), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *SearchCache_SetSearchResults_Call) Return(_a0 error) *SearchCache_SetSearchResults_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SearchCache_SetSearchResults_Call) RunAndReturn(run func(context.Context, string, *dto.UserSearchResponse, time.Duration) error) *SearchCache_SetSearchResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSearchCache creates a new instance of SearchCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearchCache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SearchCache {
+	mock := &SearchCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
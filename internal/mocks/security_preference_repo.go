@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// SecurityPreferenceRepo is an autogenerated mock type for the SecurityPreferenceRepo type
+type SecurityPreferenceRepo struct {
+	mock.Mock
+}
+
+type SecurityPreferenceRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SecurityPreferenceRepo) EXPECT() *SecurityPreferenceRepo_Expecter {
+	return &SecurityPreferenceRepo_Expecter{mock: &_m.Mock}
+}
+
+// GetSecurityPreferences provides a mock function with given fields: ctx, userID
+func (_m *SecurityPreferenceRepo) GetSecurityPreferences(ctx context.Context, userID uuid.UUID) (*dto.SecurityPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecurityPreferences")
+	}
+
+	var r0 *dto.SecurityPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.SecurityPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.SecurityPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SecurityPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecurityPreferenceRepo_GetSecurityPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecurityPreferences'
+type SecurityPreferenceRepo_GetSecurityPreferences_Call struct {
+	*mock.Call
+}
+
+// GetSecurityPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *SecurityPreferenceRepo_Expecter) GetSecurityPreferences(ctx interface{}, userID interface{}) *SecurityPreferenceRepo_GetSecurityPreferences_Call {
+	return &SecurityPreferenceRepo_GetSecurityPreferences_Call{Call: _e.mock.On("GetSecurityPreferences", ctx, userID)}
+}
+
+func (_c *SecurityPreferenceRepo_GetSecurityPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *SecurityPreferenceRepo_GetSecurityPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SecurityPreferenceRepo_GetSecurityPreferences_Call) Return(_a0 *dto.SecurityPreferences, _a1 error) *SecurityPreferenceRepo_GetSecurityPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecurityPreferenceRepo_GetSecurityPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.SecurityPreferences, error)) *SecurityPreferenceRepo_GetSecurityPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSecurityPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *SecurityPreferenceRepo) UpdateSecurityPreferences(ctx context.Context, userID uuid.UUID, u *dto.SecurityPreferencesUpdate) (*dto.SecurityPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSecurityPreferences")
+	}
+
+	var r0 *dto.SecurityPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SecurityPreferencesUpdate) (*dto.SecurityPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SecurityPreferencesUpdate) *dto.SecurityPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SecurityPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.SecurityPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecurityPreferenceRepo_UpdateSecurityPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSecurityPreferences'
+type SecurityPreferenceRepo_UpdateSecurityPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateSecurityPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.SecurityPreferencesUpdate
+func (_e *SecurityPreferenceRepo_Expecter) UpdateSecurityPreferences(ctx interface{}, userID interface{}, u interface{}) *SecurityPreferenceRepo_UpdateSecurityPreferences_Call {
+	return &SecurityPreferenceRepo_UpdateSecurityPreferences_Call{Call: _e.mock.On("UpdateSecurityPreferences", ctx, userID, u)}
+}
+
+func (_c *SecurityPreferenceRepo_UpdateSecurityPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.SecurityPreferencesUpdate)) *SecurityPreferenceRepo_UpdateSecurityPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.SecurityPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *SecurityPreferenceRepo_UpdateSecurityPreferences_Call) Return(_a0 *dto.SecurityPreferences, _a1 error) *SecurityPreferenceRepo_UpdateSecurityPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecurityPreferenceRepo_UpdateSecurityPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.SecurityPreferencesUpdate) (*dto.SecurityPreferences, error)) *SecurityPreferenceRepo_UpdateSecurityPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSecurityPreferenceRepo creates a new instance of SecurityPreferenceRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecurityPreferenceRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecurityPreferenceRepo {
+	mock := &SecurityPreferenceRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// SocialPreferenceRepo is an autogenerated mock type for the SocialPreferenceRepo type
+type SocialPreferenceRepo struct {
+	mock.Mock
+}
+
+type SocialPreferenceRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SocialPreferenceRepo) EXPECT() *SocialPreferenceRepo_Expecter {
+	return &SocialPreferenceRepo_Expecter{mock: &_m.Mock}
+}
+
+// GetSocialPreferences provides a mock function with given fields: ctx, userID
+func (_m *SocialPreferenceRepo) GetSocialPreferences(ctx context.Context, userID uuid.UUID) (*dto.SocialPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSocialPreferences")
+	}
+
+	var r0 *dto.SocialPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.SocialPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.SocialPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SocialPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialPreferenceRepo_GetSocialPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSocialPreferences'
+type SocialPreferenceRepo_GetSocialPreferences_Call struct {
+	*mock.Call
+}
+
+// GetSocialPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *SocialPreferenceRepo_Expecter) GetSocialPreferences(ctx interface{}, userID interface{}) *SocialPreferenceRepo_GetSocialPreferences_Call {
+	return &SocialPreferenceRepo_GetSocialPreferences_Call{Call: _e.mock.On("GetSocialPreferences", ctx, userID)}
+}
+
+func (_c *SocialPreferenceRepo_GetSocialPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *SocialPreferenceRepo_GetSocialPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialPreferenceRepo_GetSocialPreferences_Call) Return(_a0 *dto.SocialPreferences, _a1 error) *SocialPreferenceRepo_GetSocialPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialPreferenceRepo_GetSocialPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.SocialPreferences, error)) *SocialPreferenceRepo_GetSocialPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSocialPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *SocialPreferenceRepo) UpdateSocialPreferences(ctx context.Context, userID uuid.UUID, u *dto.SocialPreferencesUpdate) (*dto.SocialPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSocialPreferences")
+	}
+
+	var r0 *dto.SocialPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SocialPreferencesUpdate) (*dto.SocialPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SocialPreferencesUpdate) *dto.SocialPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SocialPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.SocialPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialPreferenceRepo_UpdateSocialPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSocialPreferences'
+type SocialPreferenceRepo_UpdateSocialPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateSocialPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.SocialPreferencesUpdate
+func (_e *SocialPreferenceRepo_Expecter) UpdateSocialPreferences(ctx interface{}, userID interface{}, u interface{}) *SocialPreferenceRepo_UpdateSocialPreferences_Call {
+	return &SocialPreferenceRepo_UpdateSocialPreferences_Call{Call: _e.mock.On("UpdateSocialPreferences", ctx, userID, u)}
+}
+
+func (_c *SocialPreferenceRepo_UpdateSocialPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.SocialPreferencesUpdate)) *SocialPreferenceRepo_UpdateSocialPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.SocialPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *SocialPreferenceRepo_UpdateSocialPreferences_Call) Return(_a0 *dto.SocialPreferences, _a1 error) *SocialPreferenceRepo_UpdateSocialPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialPreferenceRepo_UpdateSocialPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.SocialPreferencesUpdate) (*dto.SocialPreferences, error)) *SocialPreferenceRepo_UpdateSocialPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSocialPreferenceRepo creates a new instance of SocialPreferenceRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSocialPreferenceRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SocialPreferenceRepo {
+	mock := &SocialPreferenceRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
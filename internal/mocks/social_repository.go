@@ -0,0 +1,1340 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// SocialRepository is an autogenerated mock type for the SocialRepository type
+type SocialRepository struct {
+	mock.Mock
+}
+
+type SocialRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SocialRepository) EXPECT() *SocialRepository_Expecter {
+	return &SocialRepository_Expecter{mock: &_m.Mock}
+}
+
+// CheckFollowing provides a mock function with given fields: ctx, followerID, followeeID
+func (_m *SocialRepository) CheckFollowing(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) (*time.Time, error) {
+	ret := _m.Called(ctx, followerID, followeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckFollowing")
+	}
+
+	var r0 *time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*time.Time, error)); ok {
+		return rf(ctx, followerID, followeeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *time.Time); ok {
+		r0 = rf(ctx, followerID, followeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, followerID, followeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_CheckFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckFollowing'
+type SocialRepository_CheckFollowing_Call struct {
+	*mock.Call
+}
+
+// CheckFollowing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uuid.UUID
+//   - followeeID uuid.UUID
+func (_e *SocialRepository_Expecter) CheckFollowing(ctx interface{}, followerID interface{}, followeeID interface{}) *SocialRepository_CheckFollowing_Call {
+	return &SocialRepository_CheckFollowing_Call{Call: _e.mock.On("CheckFollowing", ctx, followerID, followeeID)}
+}
+
+func (_c *SocialRepository_CheckFollowing_Call) Run(run func(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID)) *SocialRepository_CheckFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_CheckFollowing_Call) Return(_a0 *time.Time, _a1 error) *SocialRepository_CheckFollowing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_CheckFollowing_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*time.Time, error)) *SocialRepository_CheckFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountFollowing provides a mock function with given fields: ctx, userID
+func (_m *SocialRepository) CountFollowing(ctx context.Context, userID uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFollowing")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_CountFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFollowing'
+type SocialRepository_CountFollowing_Call struct {
+	*mock.Call
+}
+
+// CountFollowing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *SocialRepository_Expecter) CountFollowing(ctx interface{}, userID interface{}) *SocialRepository_CountFollowing_Call {
+	return &SocialRepository_CountFollowing_Call{Call: _e.mock.On("CountFollowing", ctx, userID)}
+}
+
+func (_c *SocialRepository_CountFollowing_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *SocialRepository_CountFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_CountFollowing_Call) Return(_a0 int, _a1 error) *SocialRepository_CountFollowing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_CountFollowing_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int, error)) *SocialRepository_CountFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFollowEventsBefore provides a mock function with given fields: ctx, cutoff
+func (_m *SocialRepository) DeleteFollowEventsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	ret := _m.Called(ctx, cutoff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFollowEventsBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, cutoff)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, cutoff)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, cutoff)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_DeleteFollowEventsBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFollowEventsBefore'
+type SocialRepository_DeleteFollowEventsBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteFollowEventsBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cutoff time.Time
+func (_e *SocialRepository_Expecter) DeleteFollowEventsBefore(ctx interface{}, cutoff interface{}) *SocialRepository_DeleteFollowEventsBefore_Call {
+	return &SocialRepository_DeleteFollowEventsBefore_Call{Call: _e.mock.On("DeleteFollowEventsBefore", ctx, cutoff)}
+}
+
+func (_c *SocialRepository_DeleteFollowEventsBefore_Call) Run(run func(ctx context.Context, cutoff time.Time)) *SocialRepository_DeleteFollowEventsBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_DeleteFollowEventsBefore_Call) Return(_a0 int64, _a1 error) *SocialRepository_DeleteFollowEventsBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_DeleteFollowEventsBefore_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *SocialRepository_DeleteFollowEventsBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FollowUser provides a mock function with given fields: ctx, followerID, followeeID
+func (_m *SocialRepository) FollowUser(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) error {
+	ret := _m.Called(ctx, followerID, followeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, followerID, followeeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SocialRepository_FollowUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FollowUser'
+type SocialRepository_FollowUser_Call struct {
+	*mock.Call
+}
+
+// FollowUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uuid.UUID
+//   - followeeID uuid.UUID
+func (_e *SocialRepository_Expecter) FollowUser(ctx interface{}, followerID interface{}, followeeID interface{}) *SocialRepository_FollowUser_Call {
+	return &SocialRepository_FollowUser_Call{Call: _e.mock.On("FollowUser", ctx, followerID, followeeID)}
+}
+
+func (_c *SocialRepository_FollowUser_Call) Run(run func(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID)) *SocialRepository_FollowUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_FollowUser_Call) Return(_a0 error) *SocialRepository_FollowUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SocialRepository_FollowUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *SocialRepository_FollowUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCloseFriends provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *SocialRepository) GetCloseFriends(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]dto.User, int, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCloseFriends")
+	}
+
+	var r0 []dto.User
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]dto.User, int, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []dto.User); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = rf(ctx, userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SocialRepository_GetCloseFriends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCloseFriends'
+type SocialRepository_GetCloseFriends_Call struct {
+	*mock.Call
+}
+
+// GetCloseFriends is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *SocialRepository_Expecter) GetCloseFriends(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *SocialRepository_GetCloseFriends_Call {
+	return &SocialRepository_GetCloseFriends_Call{Call: _e.mock.On("GetCloseFriends", ctx, userID, limit, offset)}
+}
+
+func (_c *SocialRepository_GetCloseFriends_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int, offset int)) *SocialRepository_GetCloseFriends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetCloseFriends_Call) Return(_a0 []dto.User, _a1 int, _a2 error) *SocialRepository_GetCloseFriends_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *SocialRepository_GetCloseFriends_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) ([]dto.User, int, error)) *SocialRepository_GetCloseFriends_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowHistory provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *SocialRepository) GetFollowHistory(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]dto.FollowHistoryEntry, int, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowHistory")
+	}
+
+	var r0 []dto.FollowHistoryEntry
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]dto.FollowHistoryEntry, int, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []dto.FollowHistoryEntry); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.FollowHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = rf(ctx, userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SocialRepository_GetFollowHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowHistory'
+type SocialRepository_GetFollowHistory_Call struct {
+	*mock.Call
+}
+
+// GetFollowHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *SocialRepository_Expecter) GetFollowHistory(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *SocialRepository_GetFollowHistory_Call {
+	return &SocialRepository_GetFollowHistory_Call{Call: _e.mock.On("GetFollowHistory", ctx, userID, limit, offset)}
+}
+
+func (_c *SocialRepository_GetFollowHistory_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int, offset int)) *SocialRepository_GetFollowHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowHistory_Call) Return(_a0 []dto.FollowHistoryEntry, _a1 int, _a2 error) *SocialRepository_GetFollowHistory_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowHistory_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) ([]dto.FollowHistoryEntry, int, error)) *SocialRepository_GetFollowHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowLimit provides a mock function with given fields: ctx, userID
+func (_m *SocialRepository) GetFollowLimit(ctx context.Context, userID uuid.UUID) (*int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowLimit")
+	}
+
+	var r0 *int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_GetFollowLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowLimit'
+type SocialRepository_GetFollowLimit_Call struct {
+	*mock.Call
+}
+
+// GetFollowLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *SocialRepository_Expecter) GetFollowLimit(ctx interface{}, userID interface{}) *SocialRepository_GetFollowLimit_Call {
+	return &SocialRepository_GetFollowLimit_Call{Call: _e.mock.On("GetFollowLimit", ctx, userID)}
+}
+
+func (_c *SocialRepository_GetFollowLimit_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *SocialRepository_GetFollowLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowLimit_Call) Return(_a0 *int, _a1 error) *SocialRepository_GetFollowLimit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowLimit_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*int, error)) *SocialRepository_GetFollowLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowSettings provides a mock function with given fields: ctx, followerID, followeeIDs
+func (_m *SocialRepository) GetFollowSettings(ctx context.Context, followerID uuid.UUID, followeeIDs []uuid.UUID) (map[uuid.UUID]dto.FollowSettings, error) {
+	ret := _m.Called(ctx, followerID, followeeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowSettings")
+	}
+
+	var r0 map[uuid.UUID]dto.FollowSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (map[uuid.UUID]dto.FollowSettings, error)); ok {
+		return rf(ctx, followerID, followeeIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) map[uuid.UUID]dto.FollowSettings); ok {
+		r0 = rf(ctx, followerID, followeeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]dto.FollowSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, followerID, followeeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_GetFollowSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowSettings'
+type SocialRepository_GetFollowSettings_Call struct {
+	*mock.Call
+}
+
+// GetFollowSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uuid.UUID
+//   - followeeIDs []uuid.UUID
+func (_e *SocialRepository_Expecter) GetFollowSettings(ctx interface{}, followerID interface{}, followeeIDs interface{}) *SocialRepository_GetFollowSettings_Call {
+	return &SocialRepository_GetFollowSettings_Call{Call: _e.mock.On("GetFollowSettings", ctx, followerID, followeeIDs)}
+}
+
+func (_c *SocialRepository_GetFollowSettings_Call) Run(run func(ctx context.Context, followerID uuid.UUID, followeeIDs []uuid.UUID)) *SocialRepository_GetFollowSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowSettings_Call) Return(_a0 map[uuid.UUID]dto.FollowSettings, _a1 error) *SocialRepository_GetFollowSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (map[uuid.UUID]dto.FollowSettings, error)) *SocialRepository_GetFollowSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowedRecipes provides a mock function with given fields: ctx, userID, since, limit
+func (_m *SocialRepository) GetFollowedRecipes(ctx context.Context, userID uuid.UUID, since time.Time, limit int) ([]dto.DigestRecipe, error) {
+	ret := _m.Called(ctx, userID, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowedRecipes")
+	}
+
+	var r0 []dto.DigestRecipe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, int) ([]dto.DigestRecipe, error)); ok {
+		return rf(ctx, userID, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, int) []dto.DigestRecipe); ok {
+		r0 = rf(ctx, userID, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DigestRecipe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, int) error); ok {
+		r1 = rf(ctx, userID, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_GetFollowedRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowedRecipes'
+type SocialRepository_GetFollowedRecipes_Call struct {
+	*mock.Call
+}
+
+// GetFollowedRecipes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - since time.Time
+//   - limit int
+func (_e *SocialRepository_Expecter) GetFollowedRecipes(ctx interface{}, userID interface{}, since interface{}, limit interface{}) *SocialRepository_GetFollowedRecipes_Call {
+	return &SocialRepository_GetFollowedRecipes_Call{Call: _e.mock.On("GetFollowedRecipes", ctx, userID, since, limit)}
+}
+
+func (_c *SocialRepository_GetFollowedRecipes_Call) Run(run func(ctx context.Context, userID uuid.UUID, since time.Time, limit int)) *SocialRepository_GetFollowedRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowedRecipes_Call) Return(_a0 []dto.DigestRecipe, _a1 error) *SocialRepository_GetFollowedRecipes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowedRecipes_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, int) ([]dto.DigestRecipe, error)) *SocialRepository_GetFollowedRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *SocialRepository) GetFollowers(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]dto.User, int, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 []dto.User
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]dto.User, int, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []dto.User); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = rf(ctx, userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SocialRepository_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type SocialRepository_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *SocialRepository_Expecter) GetFollowers(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *SocialRepository_GetFollowers_Call {
+	return &SocialRepository_GetFollowers_Call{Call: _e.mock.On("GetFollowers", ctx, userID, limit, offset)}
+}
+
+func (_c *SocialRepository_GetFollowers_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int, offset int)) *SocialRepository_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowers_Call) Return(_a0 []dto.User, _a1 int, _a2 error) *SocialRepository_GetFollowers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowers_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) ([]dto.User, int, error)) *SocialRepository_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowing provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *SocialRepository) GetFollowing(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]dto.User, int, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowing")
+	}
+
+	var r0 []dto.User
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]dto.User, int, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []dto.User); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = rf(ctx, userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SocialRepository_GetFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowing'
+type SocialRepository_GetFollowing_Call struct {
+	*mock.Call
+}
+
+// GetFollowing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *SocialRepository_Expecter) GetFollowing(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *SocialRepository_GetFollowing_Call {
+	return &SocialRepository_GetFollowing_Call{Call: _e.mock.On("GetFollowing", ctx, userID, limit, offset)}
+}
+
+func (_c *SocialRepository_GetFollowing_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int, offset int)) *SocialRepository_GetFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowing_Call) Return(_a0 []dto.User, _a1 int, _a2 error) *SocialRepository_GetFollowing_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *SocialRepository_GetFollowing_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) ([]dto.User, int, error)) *SocialRepository_GetFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNewFollowers provides a mock function with given fields: ctx, userID, since, limit
+func (_m *SocialRepository) GetNewFollowers(ctx context.Context, userID uuid.UUID, since time.Time, limit int) ([]dto.UserSummary, error) {
+	ret := _m.Called(ctx, userID, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNewFollowers")
+	}
+
+	var r0 []dto.UserSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, int) ([]dto.UserSummary, error)); ok {
+		return rf(ctx, userID, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, int) []dto.UserSummary); ok {
+		r0 = rf(ctx, userID, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, int) error); ok {
+		r1 = rf(ctx, userID, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_GetNewFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewFollowers'
+type SocialRepository_GetNewFollowers_Call struct {
+	*mock.Call
+}
+
+// GetNewFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - since time.Time
+//   - limit int
+func (_e *SocialRepository_Expecter) GetNewFollowers(ctx interface{}, userID interface{}, since interface{}, limit interface{}) *SocialRepository_GetNewFollowers_Call {
+	return &SocialRepository_GetNewFollowers_Call{Call: _e.mock.On("GetNewFollowers", ctx, userID, since, limit)}
+}
+
+func (_c *SocialRepository_GetNewFollowers_Call) Run(run func(ctx context.Context, userID uuid.UUID, since time.Time, limit int)) *SocialRepository_GetNewFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetNewFollowers_Call) Return(_a0 []dto.UserSummary, _a1 error) *SocialRepository_GetNewFollowers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_GetNewFollowers_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, int) ([]dto.UserSummary, error)) *SocialRepository_GetNewFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationRecipients provides a mock function with given fields: ctx, followeeID, event
+func (_m *SocialRepository) GetNotificationRecipients(ctx context.Context, followeeID uuid.UUID, event dto.FollowNotificationEvent) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, followeeID, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationRecipients")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.FollowNotificationEvent) ([]uuid.UUID, error)); ok {
+		return rf(ctx, followeeID, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.FollowNotificationEvent) []uuid.UUID); ok {
+		r0 = rf(ctx, followeeID, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.FollowNotificationEvent) error); ok {
+		r1 = rf(ctx, followeeID, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_GetNotificationRecipients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationRecipients'
+type SocialRepository_GetNotificationRecipients_Call struct {
+	*mock.Call
+}
+
+// GetNotificationRecipients is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followeeID uuid.UUID
+//   - event dto.FollowNotificationEvent
+func (_e *SocialRepository_Expecter) GetNotificationRecipients(ctx interface{}, followeeID interface{}, event interface{}) *SocialRepository_GetNotificationRecipients_Call {
+	return &SocialRepository_GetNotificationRecipients_Call{Call: _e.mock.On("GetNotificationRecipients", ctx, followeeID, event)}
+}
+
+func (_c *SocialRepository_GetNotificationRecipients_Call) Run(run func(ctx context.Context, followeeID uuid.UUID, event dto.FollowNotificationEvent)) *SocialRepository_GetNotificationRecipients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.FollowNotificationEvent))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetNotificationRecipients_Call) Return(_a0 []uuid.UUID, _a1 error) *SocialRepository_GetNotificationRecipients_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_GetNotificationRecipients_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.FollowNotificationEvent) ([]uuid.UUID, error)) *SocialRepository_GetNotificationRecipients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentFavorites provides a mock function with given fields: ctx, userID, limit
+func (_m *SocialRepository) GetRecentFavorites(ctx context.Context, userID uuid.UUID, limit int) ([]dto.FavoriteSummary, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentFavorites")
+	}
+
+	var r0 []dto.FavoriteSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]dto.FavoriteSummary, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []dto.FavoriteSummary); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.FavoriteSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_GetRecentFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentFavorites'
+type SocialRepository_GetRecentFavorites_Call struct {
+	*mock.Call
+}
+
+// GetRecentFavorites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+func (_e *SocialRepository_Expecter) GetRecentFavorites(ctx interface{}, userID interface{}, limit interface{}) *SocialRepository_GetRecentFavorites_Call {
+	return &SocialRepository_GetRecentFavorites_Call{Call: _e.mock.On("GetRecentFavorites", ctx, userID, limit)}
+}
+
+func (_c *SocialRepository_GetRecentFavorites_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int)) *SocialRepository_GetRecentFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetRecentFavorites_Call) Return(_a0 []dto.FavoriteSummary, _a1 error) *SocialRepository_GetRecentFavorites_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_GetRecentFavorites_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]dto.FavoriteSummary, error)) *SocialRepository_GetRecentFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentFollows provides a mock function with given fields: ctx, userID, limit
+func (_m *SocialRepository) GetRecentFollows(ctx context.Context, userID uuid.UUID, limit int) ([]dto.UserSummary, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentFollows")
+	}
+
+	var r0 []dto.UserSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]dto.UserSummary, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []dto.UserSummary); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_GetRecentFollows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentFollows'
+type SocialRepository_GetRecentFollows_Call struct {
+	*mock.Call
+}
+
+// GetRecentFollows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+func (_e *SocialRepository_Expecter) GetRecentFollows(ctx interface{}, userID interface{}, limit interface{}) *SocialRepository_GetRecentFollows_Call {
+	return &SocialRepository_GetRecentFollows_Call{Call: _e.mock.On("GetRecentFollows", ctx, userID, limit)}
+}
+
+func (_c *SocialRepository_GetRecentFollows_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int)) *SocialRepository_GetRecentFollows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetRecentFollows_Call) Return(_a0 []dto.UserSummary, _a1 error) *SocialRepository_GetRecentFollows_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_GetRecentFollows_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]dto.UserSummary, error)) *SocialRepository_GetRecentFollows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentRecipes provides a mock function with given fields: ctx, userID, limit
+func (_m *SocialRepository) GetRecentRecipes(ctx context.Context, userID uuid.UUID, limit int) ([]dto.RecipeSummary, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentRecipes")
+	}
+
+	var r0 []dto.RecipeSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]dto.RecipeSummary, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []dto.RecipeSummary); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.RecipeSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_GetRecentRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentRecipes'
+type SocialRepository_GetRecentRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecentRecipes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+func (_e *SocialRepository_Expecter) GetRecentRecipes(ctx interface{}, userID interface{}, limit interface{}) *SocialRepository_GetRecentRecipes_Call {
+	return &SocialRepository_GetRecentRecipes_Call{Call: _e.mock.On("GetRecentRecipes", ctx, userID, limit)}
+}
+
+func (_c *SocialRepository_GetRecentRecipes_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int)) *SocialRepository_GetRecentRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetRecentRecipes_Call) Return(_a0 []dto.RecipeSummary, _a1 error) *SocialRepository_GetRecentRecipes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_GetRecentRecipes_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]dto.RecipeSummary, error)) *SocialRepository_GetRecentRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentReviews provides a mock function with given fields: ctx, userID, limit
+func (_m *SocialRepository) GetRecentReviews(ctx context.Context, userID uuid.UUID, limit int) ([]dto.ReviewSummary, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentReviews")
+	}
+
+	var r0 []dto.ReviewSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]dto.ReviewSummary, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []dto.ReviewSummary); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ReviewSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_GetRecentReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentReviews'
+type SocialRepository_GetRecentReviews_Call struct {
+	*mock.Call
+}
+
+// GetRecentReviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+func (_e *SocialRepository_Expecter) GetRecentReviews(ctx interface{}, userID interface{}, limit interface{}) *SocialRepository_GetRecentReviews_Call {
+	return &SocialRepository_GetRecentReviews_Call{Call: _e.mock.On("GetRecentReviews", ctx, userID, limit)}
+}
+
+func (_c *SocialRepository_GetRecentReviews_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int)) *SocialRepository_GetRecentReviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_GetRecentReviews_Call) Return(_a0 []dto.ReviewSummary, _a1 error) *SocialRepository_GetRecentReviews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_GetRecentReviews_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]dto.ReviewSummary, error)) *SocialRepository_GetRecentReviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCloseFriend provides a mock function with given fields: ctx, userID, friendID
+func (_m *SocialRepository) IsCloseFriend(ctx context.Context, userID uuid.UUID, friendID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, userID, friendID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCloseFriend")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, userID, friendID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, userID, friendID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, friendID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_IsCloseFriend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCloseFriend'
+type SocialRepository_IsCloseFriend_Call struct {
+	*mock.Call
+}
+
+// IsCloseFriend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - friendID uuid.UUID
+func (_e *SocialRepository_Expecter) IsCloseFriend(ctx interface{}, userID interface{}, friendID interface{}) *SocialRepository_IsCloseFriend_Call {
+	return &SocialRepository_IsCloseFriend_Call{Call: _e.mock.On("IsCloseFriend", ctx, userID, friendID)}
+}
+
+func (_c *SocialRepository_IsCloseFriend_Call) Run(run func(ctx context.Context, userID uuid.UUID, friendID uuid.UUID)) *SocialRepository_IsCloseFriend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_IsCloseFriend_Call) Return(_a0 bool, _a1 error) *SocialRepository_IsCloseFriend_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_IsCloseFriend_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *SocialRepository_IsCloseFriend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCloseFriend provides a mock function with given fields: ctx, userID, friendID, closeFriend
+func (_m *SocialRepository) SetCloseFriend(ctx context.Context, userID uuid.UUID, friendID uuid.UUID, closeFriend bool) error {
+	ret := _m.Called(ctx, userID, friendID, closeFriend)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCloseFriend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) error); ok {
+		r0 = rf(ctx, userID, friendID, closeFriend)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SocialRepository_SetCloseFriend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCloseFriend'
+type SocialRepository_SetCloseFriend_Call struct {
+	*mock.Call
+}
+
+// SetCloseFriend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - friendID uuid.UUID
+//   - closeFriend bool
+func (_e *SocialRepository_Expecter) SetCloseFriend(ctx interface{}, userID interface{}, friendID interface{}, closeFriend interface{}) *SocialRepository_SetCloseFriend_Call {
+	return &SocialRepository_SetCloseFriend_Call{Call: _e.mock.On("SetCloseFriend", ctx, userID, friendID, closeFriend)}
+}
+
+func (_c *SocialRepository_SetCloseFriend_Call) Run(run func(ctx context.Context, userID uuid.UUID, friendID uuid.UUID, closeFriend bool)) *SocialRepository_SetCloseFriend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_SetCloseFriend_Call) Return(_a0 error) *SocialRepository_SetCloseFriend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SocialRepository_SetCloseFriend_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, bool) error) *SocialRepository_SetCloseFriend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFollowLimit provides a mock function with given fields: ctx, userID, limit
+func (_m *SocialRepository) SetFollowLimit(ctx context.Context, userID uuid.UUID, limit *int) error {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFollowLimit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *int) error); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SocialRepository_SetFollowLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFollowLimit'
+type SocialRepository_SetFollowLimit_Call struct {
+	*mock.Call
+}
+
+// SetFollowLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit *int
+func (_e *SocialRepository_Expecter) SetFollowLimit(ctx interface{}, userID interface{}, limit interface{}) *SocialRepository_SetFollowLimit_Call {
+	return &SocialRepository_SetFollowLimit_Call{Call: _e.mock.On("SetFollowLimit", ctx, userID, limit)}
+}
+
+func (_c *SocialRepository_SetFollowLimit_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit *int)) *SocialRepository_SetFollowLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*int))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_SetFollowLimit_Call) Return(_a0 error) *SocialRepository_SetFollowLimit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SocialRepository_SetFollowLimit_Call) RunAndReturn(run func(context.Context, uuid.UUID, *int) error) *SocialRepository_SetFollowLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnfollowUser provides a mock function with given fields: ctx, followerID, followeeID
+func (_m *SocialRepository) UnfollowUser(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) error {
+	ret := _m.Called(ctx, followerID, followeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnfollowUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, followerID, followeeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SocialRepository_UnfollowUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfollowUser'
+type SocialRepository_UnfollowUser_Call struct {
+	*mock.Call
+}
+
+// UnfollowUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uuid.UUID
+//   - followeeID uuid.UUID
+func (_e *SocialRepository_Expecter) UnfollowUser(ctx interface{}, followerID interface{}, followeeID interface{}) *SocialRepository_UnfollowUser_Call {
+	return &SocialRepository_UnfollowUser_Call{Call: _e.mock.On("UnfollowUser", ctx, followerID, followeeID)}
+}
+
+func (_c *SocialRepository_UnfollowUser_Call) Run(run func(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID)) *SocialRepository_UnfollowUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_UnfollowUser_Call) Return(_a0 error) *SocialRepository_UnfollowUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SocialRepository_UnfollowUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *SocialRepository_UnfollowUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFollowSettings provides a mock function with given fields: ctx, followerID, followeeID, req
+func (_m *SocialRepository) UpdateFollowSettings(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID, req *dto.FollowSettingsUpdateRequest) (*dto.FollowSettings, error) {
+	ret := _m.Called(ctx, followerID, followeeID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFollowSettings")
+	}
+
+	var r0 *dto.FollowSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dto.FollowSettingsUpdateRequest) (*dto.FollowSettings, error)); ok {
+		return rf(ctx, followerID, followeeID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dto.FollowSettingsUpdateRequest) *dto.FollowSettings); ok {
+		r0 = rf(ctx, followerID, followeeID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FollowSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *dto.FollowSettingsUpdateRequest) error); ok {
+		r1 = rf(ctx, followerID, followeeID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialRepository_UpdateFollowSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFollowSettings'
+type SocialRepository_UpdateFollowSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateFollowSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uuid.UUID
+//   - followeeID uuid.UUID
+//   - req *dto.FollowSettingsUpdateRequest
+func (_e *SocialRepository_Expecter) UpdateFollowSettings(ctx interface{}, followerID interface{}, followeeID interface{}, req interface{}) *SocialRepository_UpdateFollowSettings_Call {
+	return &SocialRepository_UpdateFollowSettings_Call{Call: _e.mock.On("UpdateFollowSettings", ctx, followerID, followeeID, req)}
+}
+
+func (_c *SocialRepository_UpdateFollowSettings_Call) Run(run func(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID, req *dto.FollowSettingsUpdateRequest)) *SocialRepository_UpdateFollowSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*dto.FollowSettingsUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *SocialRepository_UpdateFollowSettings_Call) Return(_a0 *dto.FollowSettings, _a1 error) *SocialRepository_UpdateFollowSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialRepository_UpdateFollowSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, *dto.FollowSettingsUpdateRequest) (*dto.FollowSettings, error)) *SocialRepository_UpdateFollowSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSocialRepository creates a new instance of SocialRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSocialRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SocialRepository {
+	mock := &SocialRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
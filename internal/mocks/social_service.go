@@ -0,0 +1,938 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// SocialService is an autogenerated mock type for the SocialService type
+type SocialService struct {
+	mock.Mock
+}
+
+type SocialService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SocialService) EXPECT() *SocialService_Expecter {
+	return &SocialService_Expecter{mock: &_m.Mock}
+}
+
+// CheckFollowing provides a mock function with given fields: ctx, requesterID, userID, targetUserID
+func (_m *SocialService) CheckFollowing(ctx context.Context, requesterID uuid.UUID, userID uuid.UUID, targetUserID uuid.UUID) (*dto.FollowingCheckResponse, error) {
+	ret := _m.Called(ctx, requesterID, userID, targetUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckFollowing")
+	}
+
+	var r0 *dto.FollowingCheckResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*dto.FollowingCheckResponse, error)); ok {
+		return rf(ctx, requesterID, userID, targetUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) *dto.FollowingCheckResponse); ok {
+		r0 = rf(ctx, requesterID, userID, targetUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FollowingCheckResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, requesterID, userID, targetUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_CheckFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckFollowing'
+type SocialService_CheckFollowing_Call struct {
+	*mock.Call
+}
+
+// CheckFollowing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - userID uuid.UUID
+//   - targetUserID uuid.UUID
+func (_e *SocialService_Expecter) CheckFollowing(ctx interface{}, requesterID interface{}, userID interface{}, targetUserID interface{}) *SocialService_CheckFollowing_Call {
+	return &SocialService_CheckFollowing_Call{Call: _e.mock.On("CheckFollowing", ctx, requesterID, userID, targetUserID)}
+}
+
+func (_c *SocialService_CheckFollowing_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, userID uuid.UUID, targetUserID uuid.UUID)) *SocialService_CheckFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialService_CheckFollowing_Call) Return(_a0 *dto.FollowingCheckResponse, _a1 error) *SocialService_CheckFollowing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_CheckFollowing_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*dto.FollowingCheckResponse, error)) *SocialService_CheckFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FollowUser provides a mock function with given fields: ctx, followerID, targetUserID
+func (_m *SocialService) FollowUser(ctx context.Context, followerID uuid.UUID, targetUserID uuid.UUID) (*dto.FollowResponse, error) {
+	ret := _m.Called(ctx, followerID, targetUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowUser")
+	}
+
+	var r0 *dto.FollowResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*dto.FollowResponse, error)); ok {
+		return rf(ctx, followerID, targetUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *dto.FollowResponse); ok {
+		r0 = rf(ctx, followerID, targetUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FollowResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, followerID, targetUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_FollowUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FollowUser'
+type SocialService_FollowUser_Call struct {
+	*mock.Call
+}
+
+// FollowUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uuid.UUID
+//   - targetUserID uuid.UUID
+func (_e *SocialService_Expecter) FollowUser(ctx interface{}, followerID interface{}, targetUserID interface{}) *SocialService_FollowUser_Call {
+	return &SocialService_FollowUser_Call{Call: _e.mock.On("FollowUser", ctx, followerID, targetUserID)}
+}
+
+func (_c *SocialService_FollowUser_Call) Run(run func(ctx context.Context, followerID uuid.UUID, targetUserID uuid.UUID)) *SocialService_FollowUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialService_FollowUser_Call) Return(_a0 *dto.FollowResponse, _a1 error) *SocialService_FollowUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_FollowUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*dto.FollowResponse, error)) *SocialService_FollowUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCloseFriends provides a mock function with given fields: ctx, userID, limit, offset, countOnly
+func (_m *SocialService) GetCloseFriends(ctx context.Context, userID uuid.UUID, limit int, offset int, countOnly bool) (*dto.GetFollowedUsersResponse, error) {
+	ret := _m.Called(ctx, userID, limit, offset, countOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCloseFriends")
+	}
+
+	var r0 *dto.GetFollowedUsersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int, bool) (*dto.GetFollowedUsersResponse, error)); ok {
+		return rf(ctx, userID, limit, offset, countOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int, bool) *dto.GetFollowedUsersResponse); ok {
+		r0 = rf(ctx, userID, limit, offset, countOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetFollowedUsersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int, bool) error); ok {
+		r1 = rf(ctx, userID, limit, offset, countOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_GetCloseFriends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCloseFriends'
+type SocialService_GetCloseFriends_Call struct {
+	*mock.Call
+}
+
+// GetCloseFriends is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+//   - offset int
+//   - countOnly bool
+func (_e *SocialService_Expecter) GetCloseFriends(ctx interface{}, userID interface{}, limit interface{}, offset interface{}, countOnly interface{}) *SocialService_GetCloseFriends_Call {
+	return &SocialService_GetCloseFriends_Call{Call: _e.mock.On("GetCloseFriends", ctx, userID, limit, offset, countOnly)}
+}
+
+func (_c *SocialService_GetCloseFriends_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int, offset int, countOnly bool)) *SocialService_GetCloseFriends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *SocialService_GetCloseFriends_Call) Return(_a0 *dto.GetFollowedUsersResponse, _a1 error) *SocialService_GetCloseFriends_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_GetCloseFriends_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int, bool) (*dto.GetFollowedUsersResponse, error)) *SocialService_GetCloseFriends_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDigestPreview provides a mock function with given fields: ctx, userID, perTypeLimit
+func (_m *SocialService) GetDigestPreview(ctx context.Context, userID uuid.UUID, perTypeLimit int) (*dto.DigestPreviewResponse, error) {
+	ret := _m.Called(ctx, userID, perTypeLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDigestPreview")
+	}
+
+	var r0 *dto.DigestPreviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (*dto.DigestPreviewResponse, error)); ok {
+		return rf(ctx, userID, perTypeLimit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) *dto.DigestPreviewResponse); ok {
+		r0 = rf(ctx, userID, perTypeLimit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DigestPreviewResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, userID, perTypeLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_GetDigestPreview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDigestPreview'
+type SocialService_GetDigestPreview_Call struct {
+	*mock.Call
+}
+
+// GetDigestPreview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - perTypeLimit int
+func (_e *SocialService_Expecter) GetDigestPreview(ctx interface{}, userID interface{}, perTypeLimit interface{}) *SocialService_GetDigestPreview_Call {
+	return &SocialService_GetDigestPreview_Call{Call: _e.mock.On("GetDigestPreview", ctx, userID, perTypeLimit)}
+}
+
+func (_c *SocialService_GetDigestPreview_Call) Run(run func(ctx context.Context, userID uuid.UUID, perTypeLimit int)) *SocialService_GetDigestPreview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SocialService_GetDigestPreview_Call) Return(_a0 *dto.DigestPreviewResponse, _a1 error) *SocialService_GetDigestPreview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_GetDigestPreview_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) (*dto.DigestPreviewResponse, error)) *SocialService_GetDigestPreview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowHistory provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *SocialService) GetFollowHistory(ctx context.Context, userID uuid.UUID, limit int, offset int) (*dto.FollowHistoryResponse, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowHistory")
+	}
+
+	var r0 *dto.FollowHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) (*dto.FollowHistoryResponse, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) *dto.FollowHistoryResponse); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FollowHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_GetFollowHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowHistory'
+type SocialService_GetFollowHistory_Call struct {
+	*mock.Call
+}
+
+// GetFollowHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *SocialService_Expecter) GetFollowHistory(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *SocialService_GetFollowHistory_Call {
+	return &SocialService_GetFollowHistory_Call{Call: _e.mock.On("GetFollowHistory", ctx, userID, limit, offset)}
+}
+
+func (_c *SocialService_GetFollowHistory_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int, offset int)) *SocialService_GetFollowHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SocialService_GetFollowHistory_Call) Return(_a0 *dto.FollowHistoryResponse, _a1 error) *SocialService_GetFollowHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_GetFollowHistory_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) (*dto.FollowHistoryResponse, error)) *SocialService_GetFollowHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function with given fields: ctx, requesterID, targetUserID, limit, offset, countOnly
+func (_m *SocialService) GetFollowers(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, limit int, offset int, countOnly bool) (*dto.GetFollowedUsersResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, limit, offset, countOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 *dto.GetFollowedUsersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int, int, bool) (*dto.GetFollowedUsersResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, limit, offset, countOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int, int, bool) *dto.GetFollowedUsersResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, limit, offset, countOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetFollowedUsersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, int, int, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, limit, offset, countOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type SocialService_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - limit int
+//   - offset int
+//   - countOnly bool
+func (_e *SocialService_Expecter) GetFollowers(ctx interface{}, requesterID interface{}, targetUserID interface{}, limit interface{}, offset interface{}, countOnly interface{}) *SocialService_GetFollowers_Call {
+	return &SocialService_GetFollowers_Call{Call: _e.mock.On("GetFollowers", ctx, requesterID, targetUserID, limit, offset, countOnly)}
+}
+
+func (_c *SocialService_GetFollowers_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, limit int, offset int, countOnly bool)) *SocialService_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int), args[4].(int), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *SocialService_GetFollowers_Call) Return(_a0 *dto.GetFollowedUsersResponse, _a1 error) *SocialService_GetFollowers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_GetFollowers_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int, int, bool) (*dto.GetFollowedUsersResponse, error)) *SocialService_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowing provides a mock function with given fields: ctx, requesterID, targetUserID, limit, offset, countOnly
+func (_m *SocialService) GetFollowing(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, limit int, offset int, countOnly bool) (*dto.GetFollowedUsersResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, limit, offset, countOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowing")
+	}
+
+	var r0 *dto.GetFollowedUsersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int, int, bool) (*dto.GetFollowedUsersResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, limit, offset, countOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int, int, bool) *dto.GetFollowedUsersResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, limit, offset, countOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetFollowedUsersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, int, int, bool) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, limit, offset, countOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_GetFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowing'
+type SocialService_GetFollowing_Call struct {
+	*mock.Call
+}
+
+// GetFollowing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - limit int
+//   - offset int
+//   - countOnly bool
+func (_e *SocialService_Expecter) GetFollowing(ctx interface{}, requesterID interface{}, targetUserID interface{}, limit interface{}, offset interface{}, countOnly interface{}) *SocialService_GetFollowing_Call {
+	return &SocialService_GetFollowing_Call{Call: _e.mock.On("GetFollowing", ctx, requesterID, targetUserID, limit, offset, countOnly)}
+}
+
+func (_c *SocialService_GetFollowing_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID, limit int, offset int, countOnly bool)) *SocialService_GetFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int), args[4].(int), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *SocialService_GetFollowing_Call) Return(_a0 *dto.GetFollowedUsersResponse, _a1 error) *SocialService_GetFollowing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_GetFollowing_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int, int, bool) (*dto.GetFollowedUsersResponse, error)) *SocialService_GetFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationRecipients provides a mock function with given fields: ctx, userID, event
+func (_m *SocialService) GetNotificationRecipients(ctx context.Context, userID uuid.UUID, event dto.FollowNotificationEvent) (*dto.NotificationRecipientsResponse, error) {
+	ret := _m.Called(ctx, userID, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationRecipients")
+	}
+
+	var r0 *dto.NotificationRecipientsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.FollowNotificationEvent) (*dto.NotificationRecipientsResponse, error)); ok {
+		return rf(ctx, userID, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.FollowNotificationEvent) *dto.NotificationRecipientsResponse); ok {
+		r0 = rf(ctx, userID, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.NotificationRecipientsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.FollowNotificationEvent) error); ok {
+		r1 = rf(ctx, userID, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_GetNotificationRecipients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationRecipients'
+type SocialService_GetNotificationRecipients_Call struct {
+	*mock.Call
+}
+
+// GetNotificationRecipients is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - event dto.FollowNotificationEvent
+func (_e *SocialService_Expecter) GetNotificationRecipients(ctx interface{}, userID interface{}, event interface{}) *SocialService_GetNotificationRecipients_Call {
+	return &SocialService_GetNotificationRecipients_Call{Call: _e.mock.On("GetNotificationRecipients", ctx, userID, event)}
+}
+
+func (_c *SocialService_GetNotificationRecipients_Call) Run(run func(ctx context.Context, userID uuid.UUID, event dto.FollowNotificationEvent)) *SocialService_GetNotificationRecipients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.FollowNotificationEvent))
+	})
+	return _c
+}
+
+func (_c *SocialService_GetNotificationRecipients_Call) Return(_a0 *dto.NotificationRecipientsResponse, _a1 error) *SocialService_GetNotificationRecipients_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_GetNotificationRecipients_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.FollowNotificationEvent) (*dto.NotificationRecipientsResponse, error)) *SocialService_GetNotificationRecipients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuota provides a mock function with given fields: ctx, userID
+func (_m *SocialService) GetQuota(ctx context.Context, userID uuid.UUID) (*dto.QuotaResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuota")
+	}
+
+	var r0 *dto.QuotaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.QuotaResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.QuotaResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.QuotaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_GetQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuota'
+type SocialService_GetQuota_Call struct {
+	*mock.Call
+}
+
+// GetQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *SocialService_Expecter) GetQuota(ctx interface{}, userID interface{}) *SocialService_GetQuota_Call {
+	return &SocialService_GetQuota_Call{Call: _e.mock.On("GetQuota", ctx, userID)}
+}
+
+func (_c *SocialService_GetQuota_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *SocialService_GetQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialService_GetQuota_Call) Return(_a0 *dto.QuotaResponse, _a1 error) *SocialService_GetQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_GetQuota_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.QuotaResponse, error)) *SocialService_GetQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserActivity provides a mock function with given fields: ctx, requesterID, targetUserID, perTypeLimit
+func (_m *SocialService) GetUserActivity(ctx context.Context, requesterID *uuid.UUID, targetUserID uuid.UUID, perTypeLimit int) (*dto.UserActivityResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID, perTypeLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserActivity")
+	}
+
+	var r0 *dto.UserActivityResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, uuid.UUID, int) (*dto.UserActivityResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID, perTypeLimit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, uuid.UUID, int) *dto.UserActivityResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID, perTypeLimit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserActivityResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID, perTypeLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_GetUserActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserActivity'
+type SocialService_GetUserActivity_Call struct {
+	*mock.Call
+}
+
+// GetUserActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID *uuid.UUID
+//   - targetUserID uuid.UUID
+//   - perTypeLimit int
+func (_e *SocialService_Expecter) GetUserActivity(ctx interface{}, requesterID interface{}, targetUserID interface{}, perTypeLimit interface{}) *SocialService_GetUserActivity_Call {
+	return &SocialService_GetUserActivity_Call{Call: _e.mock.On("GetUserActivity", ctx, requesterID, targetUserID, perTypeLimit)}
+}
+
+func (_c *SocialService_GetUserActivity_Call) Run(run func(ctx context.Context, requesterID *uuid.UUID, targetUserID uuid.UUID, perTypeLimit int)) *SocialService_GetUserActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(uuid.UUID), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SocialService_GetUserActivity_Call) Return(_a0 *dto.UserActivityResponse, _a1 error) *SocialService_GetUserActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_GetUserActivity_Call) RunAndReturn(run func(context.Context, *uuid.UUID, uuid.UUID, int) (*dto.UserActivityResponse, error)) *SocialService_GetUserActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeFollowHistory provides a mock function with given fields: ctx, retention
+func (_m *SocialService) PurgeFollowHistory(ctx context.Context, retention time.Duration) (int64, error) {
+	ret := _m.Called(ctx, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeFollowHistory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (int64, error)); ok {
+		return rf(ctx, retention)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int64); ok {
+		r0 = rf(ctx, retention)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_PurgeFollowHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeFollowHistory'
+type SocialService_PurgeFollowHistory_Call struct {
+	*mock.Call
+}
+
+// PurgeFollowHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention time.Duration
+func (_e *SocialService_Expecter) PurgeFollowHistory(ctx interface{}, retention interface{}) *SocialService_PurgeFollowHistory_Call {
+	return &SocialService_PurgeFollowHistory_Call{Call: _e.mock.On("PurgeFollowHistory", ctx, retention)}
+}
+
+func (_c *SocialService_PurgeFollowHistory_Call) Run(run func(ctx context.Context, retention time.Duration)) *SocialService_PurgeFollowHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *SocialService_PurgeFollowHistory_Call) Return(_a0 int64, _a1 error) *SocialService_PurgeFollowHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_PurgeFollowHistory_Call) RunAndReturn(run func(context.Context, time.Duration) (int64, error)) *SocialService_PurgeFollowHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCloseFriend provides a mock function with given fields: ctx, userID, friendID, closeFriend
+func (_m *SocialService) SetCloseFriend(ctx context.Context, userID uuid.UUID, friendID uuid.UUID, closeFriend bool) error {
+	ret := _m.Called(ctx, userID, friendID, closeFriend)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCloseFriend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) error); ok {
+		r0 = rf(ctx, userID, friendID, closeFriend)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SocialService_SetCloseFriend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCloseFriend'
+type SocialService_SetCloseFriend_Call struct {
+	*mock.Call
+}
+
+// SetCloseFriend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - friendID uuid.UUID
+//   - closeFriend bool
+func (_e *SocialService_Expecter) SetCloseFriend(ctx interface{}, userID interface{}, friendID interface{}, closeFriend interface{}) *SocialService_SetCloseFriend_Call {
+	return &SocialService_SetCloseFriend_Call{Call: _e.mock.On("SetCloseFriend", ctx, userID, friendID, closeFriend)}
+}
+
+func (_c *SocialService_SetCloseFriend_Call) Run(run func(ctx context.Context, userID uuid.UUID, friendID uuid.UUID, closeFriend bool)) *SocialService_SetCloseFriend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *SocialService_SetCloseFriend_Call) Return(_a0 error) *SocialService_SetCloseFriend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SocialService_SetCloseFriend_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, bool) error) *SocialService_SetCloseFriend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFollowLimit provides a mock function with given fields: ctx, userID, limit
+func (_m *SocialService) SetFollowLimit(ctx context.Context, userID uuid.UUID, limit *int) (*dto.QuotaResponse, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFollowLimit")
+	}
+
+	var r0 *dto.QuotaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *int) (*dto.QuotaResponse, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *int) *dto.QuotaResponse); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.QuotaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_SetFollowLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFollowLimit'
+type SocialService_SetFollowLimit_Call struct {
+	*mock.Call
+}
+
+// SetFollowLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit *int
+func (_e *SocialService_Expecter) SetFollowLimit(ctx interface{}, userID interface{}, limit interface{}) *SocialService_SetFollowLimit_Call {
+	return &SocialService_SetFollowLimit_Call{Call: _e.mock.On("SetFollowLimit", ctx, userID, limit)}
+}
+
+func (_c *SocialService_SetFollowLimit_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit *int)) *SocialService_SetFollowLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*int))
+	})
+	return _c
+}
+
+func (_c *SocialService_SetFollowLimit_Call) Return(_a0 *dto.QuotaResponse, _a1 error) *SocialService_SetFollowLimit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_SetFollowLimit_Call) RunAndReturn(run func(context.Context, uuid.UUID, *int) (*dto.QuotaResponse, error)) *SocialService_SetFollowLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnfollowUser provides a mock function with given fields: ctx, followerID, targetUserID
+func (_m *SocialService) UnfollowUser(ctx context.Context, followerID uuid.UUID, targetUserID uuid.UUID) (*dto.FollowResponse, error) {
+	ret := _m.Called(ctx, followerID, targetUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnfollowUser")
+	}
+
+	var r0 *dto.FollowResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*dto.FollowResponse, error)); ok {
+		return rf(ctx, followerID, targetUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *dto.FollowResponse); ok {
+		r0 = rf(ctx, followerID, targetUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FollowResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, followerID, targetUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_UnfollowUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfollowUser'
+type SocialService_UnfollowUser_Call struct {
+	*mock.Call
+}
+
+// UnfollowUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uuid.UUID
+//   - targetUserID uuid.UUID
+func (_e *SocialService_Expecter) UnfollowUser(ctx interface{}, followerID interface{}, targetUserID interface{}) *SocialService_UnfollowUser_Call {
+	return &SocialService_UnfollowUser_Call{Call: _e.mock.On("UnfollowUser", ctx, followerID, targetUserID)}
+}
+
+func (_c *SocialService_UnfollowUser_Call) Run(run func(ctx context.Context, followerID uuid.UUID, targetUserID uuid.UUID)) *SocialService_UnfollowUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SocialService_UnfollowUser_Call) Return(_a0 *dto.FollowResponse, _a1 error) *SocialService_UnfollowUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_UnfollowUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*dto.FollowResponse, error)) *SocialService_UnfollowUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFollowSettings provides a mock function with given fields: ctx, followerID, targetUserID, req
+func (_m *SocialService) UpdateFollowSettings(ctx context.Context, followerID uuid.UUID, targetUserID uuid.UUID, req *dto.FollowSettingsUpdateRequest) (*dto.FollowSettings, error) {
+	ret := _m.Called(ctx, followerID, targetUserID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFollowSettings")
+	}
+
+	var r0 *dto.FollowSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dto.FollowSettingsUpdateRequest) (*dto.FollowSettings, error)); ok {
+		return rf(ctx, followerID, targetUserID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *dto.FollowSettingsUpdateRequest) *dto.FollowSettings); ok {
+		r0 = rf(ctx, followerID, targetUserID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FollowSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *dto.FollowSettingsUpdateRequest) error); ok {
+		r1 = rf(ctx, followerID, targetUserID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SocialService_UpdateFollowSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFollowSettings'
+type SocialService_UpdateFollowSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateFollowSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uuid.UUID
+//   - targetUserID uuid.UUID
+//   - req *dto.FollowSettingsUpdateRequest
+func (_e *SocialService_Expecter) UpdateFollowSettings(ctx interface{}, followerID interface{}, targetUserID interface{}, req interface{}) *SocialService_UpdateFollowSettings_Call {
+	return &SocialService_UpdateFollowSettings_Call{Call: _e.mock.On("UpdateFollowSettings", ctx, followerID, targetUserID, req)}
+}
+
+func (_c *SocialService_UpdateFollowSettings_Call) Run(run func(ctx context.Context, followerID uuid.UUID, targetUserID uuid.UUID, req *dto.FollowSettingsUpdateRequest)) *SocialService_UpdateFollowSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*dto.FollowSettingsUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *SocialService_UpdateFollowSettings_Call) Return(_a0 *dto.FollowSettings, _a1 error) *SocialService_UpdateFollowSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SocialService_UpdateFollowSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, *dto.FollowSettingsUpdateRequest) (*dto.FollowSettings, error)) *SocialService_UpdateFollowSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSocialService creates a new instance of SocialService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSocialService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SocialService {
+	mock := &SocialService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// SoundPreferenceRepo is an autogenerated mock type for the SoundPreferenceRepo type
+type SoundPreferenceRepo struct {
+	mock.Mock
+}
+
+type SoundPreferenceRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SoundPreferenceRepo) EXPECT() *SoundPreferenceRepo_Expecter {
+	return &SoundPreferenceRepo_Expecter{mock: &_m.Mock}
+}
+
+// GetSoundPreferences provides a mock function with given fields: ctx, userID
+func (_m *SoundPreferenceRepo) GetSoundPreferences(ctx context.Context, userID uuid.UUID) (*dto.SoundPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSoundPreferences")
+	}
+
+	var r0 *dto.SoundPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.SoundPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.SoundPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SoundPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoundPreferenceRepo_GetSoundPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSoundPreferences'
+type SoundPreferenceRepo_GetSoundPreferences_Call struct {
+	*mock.Call
+}
+
+// GetSoundPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *SoundPreferenceRepo_Expecter) GetSoundPreferences(ctx interface{}, userID interface{}) *SoundPreferenceRepo_GetSoundPreferences_Call {
+	return &SoundPreferenceRepo_GetSoundPreferences_Call{Call: _e.mock.On("GetSoundPreferences", ctx, userID)}
+}
+
+func (_c *SoundPreferenceRepo_GetSoundPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *SoundPreferenceRepo_GetSoundPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SoundPreferenceRepo_GetSoundPreferences_Call) Return(_a0 *dto.SoundPreferences, _a1 error) *SoundPreferenceRepo_GetSoundPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SoundPreferenceRepo_GetSoundPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.SoundPreferences, error)) *SoundPreferenceRepo_GetSoundPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSoundPreferences provides a mock function with given fields: ctx, userID, u
+func (_m *SoundPreferenceRepo) UpdateSoundPreferences(ctx context.Context, userID uuid.UUID, u *dto.SoundPreferencesUpdate) (*dto.SoundPreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSoundPreferences")
+	}
+
+	var r0 *dto.SoundPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SoundPreferencesUpdate) (*dto.SoundPreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.SoundPreferencesUpdate) *dto.SoundPreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SoundPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.SoundPreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoundPreferenceRepo_UpdateSoundPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSoundPreferences'
+type SoundPreferenceRepo_UpdateSoundPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateSoundPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.SoundPreferencesUpdate
+func (_e *SoundPreferenceRepo_Expecter) UpdateSoundPreferences(ctx interface{}, userID interface{}, u interface{}) *SoundPreferenceRepo_UpdateSoundPreferences_Call {
+	return &SoundPreferenceRepo_UpdateSoundPreferences_Call{Call: _e.mock.On("UpdateSoundPreferences", ctx, userID, u)}
+}
+
+func (_c *SoundPreferenceRepo_UpdateSoundPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.SoundPreferencesUpdate)) *SoundPreferenceRepo_UpdateSoundPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.SoundPreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *SoundPreferenceRepo_UpdateSoundPreferences_Call) Return(_a0 *dto.SoundPreferences, _a1 error) *SoundPreferenceRepo_UpdateSoundPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SoundPreferenceRepo_UpdateSoundPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.SoundPreferencesUpdate) (*dto.SoundPreferences, error)) *SoundPreferenceRepo_UpdateSoundPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSoundPreferenceRepo creates a new instance of SoundPreferenceRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSoundPreferenceRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SoundPreferenceRepo {
+	mock := &SoundPreferenceRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,402 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// StatsRepository is an autogenerated mock type for the StatsRepository type
+type StatsRepository struct {
+	mock.Mock
+}
+
+type StatsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatsRepository) EXPECT() *StatsRepository_Expecter {
+	return &StatsRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountByBucket provides a mock function with given fields: ctx, metric, from, to, interval
+func (_m *StatsRepository) CountByBucket(ctx context.Context, metric repository.StatsMetric, from time.Time, to time.Time, interval dto.StatsInterval) (map[time.Time]int, error) {
+	ret := _m.Called(ctx, metric, from, to, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByBucket")
+	}
+
+	var r0 map[time.Time]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.StatsMetric, time.Time, time.Time, dto.StatsInterval) (map[time.Time]int, error)); ok {
+		return rf(ctx, metric, from, to, interval)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.StatsMetric, time.Time, time.Time, dto.StatsInterval) map[time.Time]int); ok {
+		r0 = rf(ctx, metric, from, to, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[time.Time]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.StatsMetric, time.Time, time.Time, dto.StatsInterval) error); ok {
+		r1 = rf(ctx, metric, from, to, interval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_CountByBucket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByBucket'
+type StatsRepository_CountByBucket_Call struct {
+	*mock.Call
+}
+
+// CountByBucket is a helper method to define mock.On call
+//   - ctx context.Context
+//   - metric repository.StatsMetric
+//   - from time.Time
+//   - to time.Time
+//   - interval dto.StatsInterval
+func (_e *StatsRepository_Expecter) CountByBucket(ctx interface{}, metric interface{}, from interface{}, to interface{}, interval interface{}) *StatsRepository_CountByBucket_Call {
+	return &StatsRepository_CountByBucket_Call{Call: _e.mock.On("CountByBucket", ctx, metric, from, to, interval)}
+}
+
+func (_c *StatsRepository_CountByBucket_Call) Run(run func(ctx context.Context, metric repository.StatsMetric, from time.Time, to time.Time, interval dto.StatsInterval)) *StatsRepository_CountByBucket_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.StatsMetric), args[2].(time.Time), args[3].(time.Time), args[4].(dto.StatsInterval))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_CountByBucket_Call) Return(_a0 map[time.Time]int, _a1 error) *StatsRepository_CountByBucket_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_CountByBucket_Call) RunAndReturn(run func(context.Context, repository.StatsMetric, time.Time, time.Time, dto.StatsInterval) (map[time.Time]int, error)) *StatsRepository_CountByBucket_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountFollowEventsByBucket provides a mock function with given fields: ctx, userID, event, from, to, interval
+func (_m *StatsRepository) CountFollowEventsByBucket(ctx context.Context, userID uuid.UUID, event dto.FollowEvent, from time.Time, to time.Time, interval dto.StatsInterval) (map[time.Time]int, error) {
+	ret := _m.Called(ctx, userID, event, from, to, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFollowEventsByBucket")
+	}
+
+	var r0 map[time.Time]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.FollowEvent, time.Time, time.Time, dto.StatsInterval) (map[time.Time]int, error)); ok {
+		return rf(ctx, userID, event, from, to, interval)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.FollowEvent, time.Time, time.Time, dto.StatsInterval) map[time.Time]int); ok {
+		r0 = rf(ctx, userID, event, from, to, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[time.Time]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.FollowEvent, time.Time, time.Time, dto.StatsInterval) error); ok {
+		r1 = rf(ctx, userID, event, from, to, interval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_CountFollowEventsByBucket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFollowEventsByBucket'
+type StatsRepository_CountFollowEventsByBucket_Call struct {
+	*mock.Call
+}
+
+// CountFollowEventsByBucket is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - event dto.FollowEvent
+//   - from time.Time
+//   - to time.Time
+//   - interval dto.StatsInterval
+func (_e *StatsRepository_Expecter) CountFollowEventsByBucket(ctx interface{}, userID interface{}, event interface{}, from interface{}, to interface{}, interval interface{}) *StatsRepository_CountFollowEventsByBucket_Call {
+	return &StatsRepository_CountFollowEventsByBucket_Call{Call: _e.mock.On("CountFollowEventsByBucket", ctx, userID, event, from, to, interval)}
+}
+
+func (_c *StatsRepository_CountFollowEventsByBucket_Call) Run(run func(ctx context.Context, userID uuid.UUID, event dto.FollowEvent, from time.Time, to time.Time, interval dto.StatsInterval)) *StatsRepository_CountFollowEventsByBucket_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.FollowEvent), args[3].(time.Time), args[4].(time.Time), args[5].(dto.StatsInterval))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_CountFollowEventsByBucket_Call) Return(_a0 map[time.Time]int, _a1 error) *StatsRepository_CountFollowEventsByBucket_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_CountFollowEventsByBucket_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.FollowEvent, time.Time, time.Time, dto.StatsInterval) (map[time.Time]int, error)) *StatsRepository_CountFollowEventsByBucket_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountFollowersByCountry provides a mock function with given fields: ctx, userID
+func (_m *StatsRepository) CountFollowersByCountry(ctx context.Context, userID uuid.UUID) (map[string]int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFollowersByCountry")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (map[string]int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) map[string]int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_CountFollowersByCountry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFollowersByCountry'
+type StatsRepository_CountFollowersByCountry_Call struct {
+	*mock.Call
+}
+
+// CountFollowersByCountry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *StatsRepository_Expecter) CountFollowersByCountry(ctx interface{}, userID interface{}) *StatsRepository_CountFollowersByCountry_Call {
+	return &StatsRepository_CountFollowersByCountry_Call{Call: _e.mock.On("CountFollowersByCountry", ctx, userID)}
+}
+
+func (_c *StatsRepository_CountFollowersByCountry_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *StatsRepository_CountFollowersByCountry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_CountFollowersByCountry_Call) Return(_a0 map[string]int, _a1 error) *StatsRepository_CountFollowersByCountry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_CountFollowersByCountry_Call) RunAndReturn(run func(context.Context, uuid.UUID) (map[string]int, error)) *StatsRepository_CountFollowersByCountry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountPreferenceAdopters provides a mock function with given fields: ctx
+func (_m *StatsRepository) CountPreferenceAdopters(ctx context.Context) (map[dto.PreferenceCategory]int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPreferenceAdopters")
+	}
+
+	var r0 map[dto.PreferenceCategory]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[dto.PreferenceCategory]int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[dto.PreferenceCategory]int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[dto.PreferenceCategory]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_CountPreferenceAdopters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPreferenceAdopters'
+type StatsRepository_CountPreferenceAdopters_Call struct {
+	*mock.Call
+}
+
+// CountPreferenceAdopters is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StatsRepository_Expecter) CountPreferenceAdopters(ctx interface{}) *StatsRepository_CountPreferenceAdopters_Call {
+	return &StatsRepository_CountPreferenceAdopters_Call{Call: _e.mock.On("CountPreferenceAdopters", ctx)}
+}
+
+func (_c *StatsRepository_CountPreferenceAdopters_Call) Run(run func(ctx context.Context)) *StatsRepository_CountPreferenceAdopters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_CountPreferenceAdopters_Call) Return(_a0 map[dto.PreferenceCategory]int, _a1 error) *StatsRepository_CountPreferenceAdopters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_CountPreferenceAdopters_Call) RunAndReturn(run func(context.Context) (map[dto.PreferenceCategory]int, error)) *StatsRepository_CountPreferenceAdopters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowerTotals provides a mock function with given fields: ctx, userID
+func (_m *StatsRepository) GetFollowerTotals(ctx context.Context, userID uuid.UUID) (*dto.FollowerInsightsTotals, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowerTotals")
+	}
+
+	var r0 *dto.FollowerInsightsTotals
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.FollowerInsightsTotals, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.FollowerInsightsTotals); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FollowerInsightsTotals)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetFollowerTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowerTotals'
+type StatsRepository_GetFollowerTotals_Call struct {
+	*mock.Call
+}
+
+// GetFollowerTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *StatsRepository_Expecter) GetFollowerTotals(ctx interface{}, userID interface{}) *StatsRepository_GetFollowerTotals_Call {
+	return &StatsRepository_GetFollowerTotals_Call{Call: _e.mock.On("GetFollowerTotals", ctx, userID)}
+}
+
+func (_c *StatsRepository_GetFollowerTotals_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *StatsRepository_GetFollowerTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetFollowerTotals_Call) Return(_a0 *dto.FollowerInsightsTotals, _a1 error) *StatsRepository_GetFollowerTotals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetFollowerTotals_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.FollowerInsightsTotals, error)) *StatsRepository_GetFollowerTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatsTotals provides a mock function with given fields: ctx
+func (_m *StatsRepository) GetStatsTotals(ctx context.Context) (*dto.AdminStatsTotals, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatsTotals")
+	}
+
+	var r0 *dto.AdminStatsTotals
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.AdminStatsTotals, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.AdminStatsTotals); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AdminStatsTotals)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetStatsTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatsTotals'
+type StatsRepository_GetStatsTotals_Call struct {
+	*mock.Call
+}
+
+// GetStatsTotals is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StatsRepository_Expecter) GetStatsTotals(ctx interface{}) *StatsRepository_GetStatsTotals_Call {
+	return &StatsRepository_GetStatsTotals_Call{Call: _e.mock.On("GetStatsTotals", ctx)}
+}
+
+func (_c *StatsRepository_GetStatsTotals_Call) Run(run func(ctx context.Context)) *StatsRepository_GetStatsTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetStatsTotals_Call) Return(_a0 *dto.AdminStatsTotals, _a1 error) *StatsRepository_GetStatsTotals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetStatsTotals_Call) RunAndReturn(run func(context.Context) (*dto.AdminStatsTotals, error)) *StatsRepository_GetStatsTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatsRepository creates a new instance of StatsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatsRepository {
+	mock := &StatsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// StatsService is an autogenerated mock type for the StatsService type
+type StatsService struct {
+	mock.Mock
+}
+
+type StatsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatsService) EXPECT() *StatsService_Expecter {
+	return &StatsService_Expecter{mock: &_m.Mock}
+}
+
+// GetAdminStats provides a mock function with given fields: ctx, query
+func (_m *StatsService) GetAdminStats(ctx context.Context, query dto.AdminStatsQuery) (*dto.AdminStatsResponse, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdminStats")
+	}
+
+	var r0 *dto.AdminStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.AdminStatsQuery) (*dto.AdminStatsResponse, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.AdminStatsQuery) *dto.AdminStatsResponse); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AdminStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.AdminStatsQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsService_GetAdminStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdminStats'
+type StatsService_GetAdminStats_Call struct {
+	*mock.Call
+}
+
+// GetAdminStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dto.AdminStatsQuery
+func (_e *StatsService_Expecter) GetAdminStats(ctx interface{}, query interface{}) *StatsService_GetAdminStats_Call {
+	return &StatsService_GetAdminStats_Call{Call: _e.mock.On("GetAdminStats", ctx, query)}
+}
+
+func (_c *StatsService_GetAdminStats_Call) Run(run func(ctx context.Context, query dto.AdminStatsQuery)) *StatsService_GetAdminStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.AdminStatsQuery))
+	})
+	return _c
+}
+
+func (_c *StatsService_GetAdminStats_Call) Return(_a0 *dto.AdminStatsResponse, _a1 error) *StatsService_GetAdminStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsService_GetAdminStats_Call) RunAndReturn(run func(context.Context, dto.AdminStatsQuery) (*dto.AdminStatsResponse, error)) *StatsService_GetAdminStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowerInsights provides a mock function with given fields: ctx, userID, query
+func (_m *StatsService) GetFollowerInsights(ctx context.Context, userID uuid.UUID, query dto.FollowerInsightsQuery) (*dto.FollowerInsightsResponse, error) {
+	ret := _m.Called(ctx, userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowerInsights")
+	}
+
+	var r0 *dto.FollowerInsightsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.FollowerInsightsQuery) (*dto.FollowerInsightsResponse, error)); ok {
+		return rf(ctx, userID, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.FollowerInsightsQuery) *dto.FollowerInsightsResponse); ok {
+		r0 = rf(ctx, userID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FollowerInsightsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.FollowerInsightsQuery) error); ok {
+		r1 = rf(ctx, userID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsService_GetFollowerInsights_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowerInsights'
+type StatsService_GetFollowerInsights_Call struct {
+	*mock.Call
+}
+
+// GetFollowerInsights is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - query dto.FollowerInsightsQuery
+func (_e *StatsService_Expecter) GetFollowerInsights(ctx interface{}, userID interface{}, query interface{}) *StatsService_GetFollowerInsights_Call {
+	return &StatsService_GetFollowerInsights_Call{Call: _e.mock.On("GetFollowerInsights", ctx, userID, query)}
+}
+
+func (_c *StatsService_GetFollowerInsights_Call) Run(run func(ctx context.Context, userID uuid.UUID, query dto.FollowerInsightsQuery)) *StatsService_GetFollowerInsights_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.FollowerInsightsQuery))
+	})
+	return _c
+}
+
+func (_c *StatsService_GetFollowerInsights_Call) Return(_a0 *dto.FollowerInsightsResponse, _a1 error) *StatsService_GetFollowerInsights_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsService_GetFollowerInsights_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.FollowerInsightsQuery) (*dto.FollowerInsightsResponse, error)) *StatsService_GetFollowerInsights_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatsService creates a new instance of StatsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatsService {
+	mock := &StatsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
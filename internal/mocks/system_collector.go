@@ -0,0 +1,264 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	disk "github.com/shirou/gopsutil/v4/disk"
+	mem "github.com/shirou/gopsutil/v4/mem"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SystemCollector is an autogenerated mock type for the SystemCollector type
+type SystemCollector struct {
+	mock.Mock
+}
+
+type SystemCollector_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SystemCollector) EXPECT() *SystemCollector_Expecter {
+	return &SystemCollector_Expecter{mock: &_m.Mock}
+}
+
+// GetCPUPercent provides a mock function with no fields
+func (_m *SystemCollector) GetCPUPercent() (float64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCPUPercent")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (float64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemCollector_GetCPUPercent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCPUPercent'
+type SystemCollector_GetCPUPercent_Call struct {
+	*mock.Call
+}
+
+// GetCPUPercent is a helper method to define mock.On call
+func (_e *SystemCollector_Expecter) GetCPUPercent() *SystemCollector_GetCPUPercent_Call {
+	return &SystemCollector_GetCPUPercent_Call{Call: _e.mock.On("GetCPUPercent")}
+}
+
+func (_c *SystemCollector_GetCPUPercent_Call) Run(run func()) *SystemCollector_GetCPUPercent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SystemCollector_GetCPUPercent_Call) Return(_a0 float64, _a1 error) *SystemCollector_GetCPUPercent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemCollector_GetCPUPercent_Call) RunAndReturn(run func() (float64, error)) *SystemCollector_GetCPUPercent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDiskUsage provides a mock function with no fields
+func (_m *SystemCollector) GetDiskUsage() (*disk.UsageStat, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDiskUsage")
+	}
+
+	var r0 *disk.UsageStat
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*disk.UsageStat, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *disk.UsageStat); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*disk.UsageStat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemCollector_GetDiskUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiskUsage'
+type SystemCollector_GetDiskUsage_Call struct {
+	*mock.Call
+}
+
+// GetDiskUsage is a helper method to define mock.On call
+func (_e *SystemCollector_Expecter) GetDiskUsage() *SystemCollector_GetDiskUsage_Call {
+	return &SystemCollector_GetDiskUsage_Call{Call: _e.mock.On("GetDiskUsage")}
+}
+
+func (_c *SystemCollector_GetDiskUsage_Call) Run(run func()) *SystemCollector_GetDiskUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SystemCollector_GetDiskUsage_Call) Return(_a0 *disk.UsageStat, _a1 error) *SystemCollector_GetDiskUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemCollector_GetDiskUsage_Call) RunAndReturn(run func() (*disk.UsageStat, error)) *SystemCollector_GetDiskUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemoryInfo provides a mock function with no fields
+func (_m *SystemCollector) GetMemoryInfo() (*mem.VirtualMemoryStat, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemoryInfo")
+	}
+
+	var r0 *mem.VirtualMemoryStat
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*mem.VirtualMemoryStat, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *mem.VirtualMemoryStat); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mem.VirtualMemoryStat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemCollector_GetMemoryInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemoryInfo'
+type SystemCollector_GetMemoryInfo_Call struct {
+	*mock.Call
+}
+
+// GetMemoryInfo is a helper method to define mock.On call
+func (_e *SystemCollector_Expecter) GetMemoryInfo() *SystemCollector_GetMemoryInfo_Call {
+	return &SystemCollector_GetMemoryInfo_Call{Call: _e.mock.On("GetMemoryInfo")}
+}
+
+func (_c *SystemCollector_GetMemoryInfo_Call) Run(run func()) *SystemCollector_GetMemoryInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SystemCollector_GetMemoryInfo_Call) Return(_a0 *mem.VirtualMemoryStat, _a1 error) *SystemCollector_GetMemoryInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemCollector_GetMemoryInfo_Call) RunAndReturn(run func() (*mem.VirtualMemoryStat, error)) *SystemCollector_GetMemoryInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProcessInfo provides a mock function with no fields
+func (_m *SystemCollector) GetProcessInfo() (*dto.ProcessInfo, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProcessInfo")
+	}
+
+	var r0 *dto.ProcessInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*dto.ProcessInfo, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *dto.ProcessInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProcessInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemCollector_GetProcessInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProcessInfo'
+type SystemCollector_GetProcessInfo_Call struct {
+	*mock.Call
+}
+
+// GetProcessInfo is a helper method to define mock.On call
+func (_e *SystemCollector_Expecter) GetProcessInfo() *SystemCollector_GetProcessInfo_Call {
+	return &SystemCollector_GetProcessInfo_Call{Call: _e.mock.On("GetProcessInfo")}
+}
+
+func (_c *SystemCollector_GetProcessInfo_Call) Run(run func()) *SystemCollector_GetProcessInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SystemCollector_GetProcessInfo_Call) Return(_a0 *dto.ProcessInfo, _a1 error) *SystemCollector_GetProcessInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemCollector_GetProcessInfo_Call) RunAndReturn(run func() (*dto.ProcessInfo, error)) *SystemCollector_GetProcessInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSystemCollector creates a new instance of SystemCollector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSystemCollector(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SystemCollector {
+	mock := &SystemCollector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
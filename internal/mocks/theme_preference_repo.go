@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ThemePreferenceRepo is an autogenerated mock type for the ThemePreferenceRepo type
+type ThemePreferenceRepo struct {
+	mock.Mock
+}
+
+type ThemePreferenceRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ThemePreferenceRepo) EXPECT() *ThemePreferenceRepo_Expecter {
+	return &ThemePreferenceRepo_Expecter{mock: &_m.Mock}
+}
+
+// GetThemePreferences provides a mock function with given fields: ctx, userID
+func (_m *ThemePreferenceRepo) GetThemePreferences(ctx context.Context, userID uuid.UUID) (*dto.ThemePreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetThemePreferences")
+	}
+
+	var r0 *dto.ThemePreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.ThemePreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.ThemePreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ThemePreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ThemePreferenceRepo_GetThemePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetThemePreferences'
+type ThemePreferenceRepo_GetThemePreferences_Call struct {
+	*mock.Call
+}
+
+// GetThemePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ThemePreferenceRepo_Expecter) GetThemePreferences(ctx interface{}, userID interface{}) *ThemePreferenceRepo_GetThemePreferences_Call {
+	return &ThemePreferenceRepo_GetThemePreferences_Call{Call: _e.mock.On("GetThemePreferences", ctx, userID)}
+}
+
+func (_c *ThemePreferenceRepo_GetThemePreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ThemePreferenceRepo_GetThemePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ThemePreferenceRepo_GetThemePreferences_Call) Return(_a0 *dto.ThemePreferences, _a1 error) *ThemePreferenceRepo_GetThemePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ThemePreferenceRepo_GetThemePreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.ThemePreferences, error)) *ThemePreferenceRepo_GetThemePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateThemePreferences provides a mock function with given fields: ctx, userID, u
+func (_m *ThemePreferenceRepo) UpdateThemePreferences(ctx context.Context, userID uuid.UUID, u *dto.ThemePreferencesUpdate) (*dto.ThemePreferences, error) {
+	ret := _m.Called(ctx, userID, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateThemePreferences")
+	}
+
+	var r0 *dto.ThemePreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.ThemePreferencesUpdate) (*dto.ThemePreferences, error)); ok {
+		return rf(ctx, userID, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.ThemePreferencesUpdate) *dto.ThemePreferences); ok {
+		r0 = rf(ctx, userID, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ThemePreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.ThemePreferencesUpdate) error); ok {
+		r1 = rf(ctx, userID, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ThemePreferenceRepo_UpdateThemePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateThemePreferences'
+type ThemePreferenceRepo_UpdateThemePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateThemePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - u *dto.ThemePreferencesUpdate
+func (_e *ThemePreferenceRepo_Expecter) UpdateThemePreferences(ctx interface{}, userID interface{}, u interface{}) *ThemePreferenceRepo_UpdateThemePreferences_Call {
+	return &ThemePreferenceRepo_UpdateThemePreferences_Call{Call: _e.mock.On("UpdateThemePreferences", ctx, userID, u)}
+}
+
+func (_c *ThemePreferenceRepo_UpdateThemePreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, u *dto.ThemePreferencesUpdate)) *ThemePreferenceRepo_UpdateThemePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.ThemePreferencesUpdate))
+	})
+	return _c
+}
+
+func (_c *ThemePreferenceRepo_UpdateThemePreferences_Call) Return(_a0 *dto.ThemePreferences, _a1 error) *ThemePreferenceRepo_UpdateThemePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ThemePreferenceRepo_UpdateThemePreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.ThemePreferencesUpdate) (*dto.ThemePreferences, error)) *ThemePreferenceRepo_UpdateThemePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewThemePreferenceRepo creates a new instance of ThemePreferenceRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewThemePreferenceRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ThemePreferenceRepo {
+	mock := &ThemePreferenceRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
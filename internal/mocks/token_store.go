@@ -0,0 +1,193 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// TokenStore is an autogenerated mock type for the TokenStore type
+type TokenStore struct {
+	mock.Mock
+}
+
+type TokenStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenStore) EXPECT() *TokenStore_Expecter {
+	return &TokenStore_Expecter{mock: &_m.Mock}
+}
+
+// DeleteDeleteToken provides a mock function with given fields: ctx, userID
+func (_m *TokenStore) DeleteDeleteToken(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeleteToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenStore_DeleteDeleteToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDeleteToken'
+type TokenStore_DeleteDeleteToken_Call struct {
+	*mock.Call
+}
+
+// DeleteDeleteToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *TokenStore_Expecter) DeleteDeleteToken(ctx interface{}, userID interface{}) *TokenStore_DeleteDeleteToken_Call {
+	return &TokenStore_DeleteDeleteToken_Call{Call: _e.mock.On("DeleteDeleteToken", ctx, userID)}
+}
+
+func (_c *TokenStore_DeleteDeleteToken_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *TokenStore_DeleteDeleteToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TokenStore_DeleteDeleteToken_Call) Return(_a0 error) *TokenStore_DeleteDeleteToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenStore_DeleteDeleteToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *TokenStore_DeleteDeleteToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeleteToken provides a mock function with given fields: ctx, userID
+func (_m *TokenStore) GetDeleteToken(ctx context.Context, userID uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeleteToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (string, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenStore_GetDeleteToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeleteToken'
+type TokenStore_GetDeleteToken_Call struct {
+	*mock.Call
+}
+
+// GetDeleteToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *TokenStore_Expecter) GetDeleteToken(ctx interface{}, userID interface{}) *TokenStore_GetDeleteToken_Call {
+	return &TokenStore_GetDeleteToken_Call{Call: _e.mock.On("GetDeleteToken", ctx, userID)}
+}
+
+func (_c *TokenStore_GetDeleteToken_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *TokenStore_GetDeleteToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TokenStore_GetDeleteToken_Call) Return(_a0 string, _a1 error) *TokenStore_GetDeleteToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenStore_GetDeleteToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) (string, error)) *TokenStore_GetDeleteToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreDeleteToken provides a mock function with given fields: ctx, userID, token, ttl
+func (_m *TokenStore) StoreDeleteToken(ctx context.Context, userID uuid.UUID, token string, ttl time.Duration) error {
+	ret := _m.Called(ctx, userID, token, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreDeleteToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Duration) error); ok {
+		r0 = rf(ctx, userID, token, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenStore_StoreDeleteToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreDeleteToken'
+type TokenStore_StoreDeleteToken_Call struct {
+	*mock.Call
+}
+
+// StoreDeleteToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - token string
+//   - ttl time.Duration
+func (_e *TokenStore_Expecter) StoreDeleteToken(ctx interface{}, userID interface{}, token interface{}, ttl interface{}) *TokenStore_StoreDeleteToken_Call {
+	return &TokenStore_StoreDeleteToken_Call{Call: _e.mock.On("StoreDeleteToken", ctx, userID, token, ttl)}
+}
+
+func (_c *TokenStore_StoreDeleteToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, token string, ttl time.Duration)) *TokenStore_StoreDeleteToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *TokenStore_StoreDeleteToken_Call) Return(_a0 error) *TokenStore_StoreDeleteToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenStore_StoreDeleteToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Duration) error) *TokenStore_StoreDeleteToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTokenStore creates a new instance of TokenStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenStore {
+	mock := &TokenStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TxManager is an autogenerated mock type for the TxManager type
+type TxManager struct {
+	mock.Mock
+}
+
+type TxManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TxManager) EXPECT() *TxManager_Expecter {
+	return &TxManager_Expecter{mock: &_m.Mock}
+}
+
+// WithinTx provides a mock function with given fields: ctx, fn
+func (_m *TxManager) WithinTx(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxManager_WithinTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTx'
+type TxManager_WithinTx_Call struct {
+	*mock.Call
+}
+
+// WithinTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *TxManager_Expecter) WithinTx(ctx interface{}, fn interface{}) *TxManager_WithinTx_Call {
+	return &TxManager_WithinTx_Call{Call: _e.mock.On("WithinTx", ctx, fn)}
+}
+
+func (_c *TxManager_WithinTx_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *TxManager_WithinTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *TxManager_WithinTx_Call) Return(_a0 error) *TxManager_WithinTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxManager_WithinTx_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *TxManager_WithinTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTxManager creates a new instance of TxManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTxManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TxManager {
+	mock := &TxManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
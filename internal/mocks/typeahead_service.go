@@ -0,0 +1,212 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TypeaheadService is an autogenerated mock type for the TypeaheadService type
+type TypeaheadService struct {
+	mock.Mock
+}
+
+type TypeaheadService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TypeaheadService) EXPECT() *TypeaheadService_Expecter {
+	return &TypeaheadService_Expecter{mock: &_m.Mock}
+}
+
+// GetSearchIndexStatus provides a mock function with given fields: ctx
+func (_m *TypeaheadService) GetSearchIndexStatus(ctx context.Context) (*dto.SearchIndexStatusResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSearchIndexStatus")
+	}
+
+	var r0 *dto.SearchIndexStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.SearchIndexStatusResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.SearchIndexStatusResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SearchIndexStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TypeaheadService_GetSearchIndexStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSearchIndexStatus'
+type TypeaheadService_GetSearchIndexStatus_Call struct {
+	*mock.Call
+}
+
+// GetSearchIndexStatus is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TypeaheadService_Expecter) GetSearchIndexStatus(ctx interface{}) *TypeaheadService_GetSearchIndexStatus_Call {
+	return &TypeaheadService_GetSearchIndexStatus_Call{Call: _e.mock.On("GetSearchIndexStatus", ctx)}
+}
+
+func (_c *TypeaheadService_GetSearchIndexStatus_Call) Run(run func(ctx context.Context)) *TypeaheadService_GetSearchIndexStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TypeaheadService_GetSearchIndexStatus_Call) Return(_a0 *dto.SearchIndexStatusResponse, _a1 error) *TypeaheadService_GetSearchIndexStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TypeaheadService_GetSearchIndexStatus_Call) RunAndReturn(run func(context.Context) (*dto.SearchIndexStatusResponse, error)) *TypeaheadService_GetSearchIndexStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartReindex provides a mock function with given fields: ctx
+func (_m *TypeaheadService) StartReindex(ctx context.Context) (*dto.SearchReindexJob, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartReindex")
+	}
+
+	var r0 *dto.SearchReindexJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.SearchReindexJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.SearchReindexJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SearchReindexJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TypeaheadService_StartReindex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartReindex'
+type TypeaheadService_StartReindex_Call struct {
+	*mock.Call
+}
+
+// StartReindex is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TypeaheadService_Expecter) StartReindex(ctx interface{}) *TypeaheadService_StartReindex_Call {
+	return &TypeaheadService_StartReindex_Call{Call: _e.mock.On("StartReindex", ctx)}
+}
+
+func (_c *TypeaheadService_StartReindex_Call) Run(run func(ctx context.Context)) *TypeaheadService_StartReindex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TypeaheadService_StartReindex_Call) Return(_a0 *dto.SearchReindexJob, _a1 error) *TypeaheadService_StartReindex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TypeaheadService_StartReindex_Call) RunAndReturn(run func(context.Context) (*dto.SearchReindexJob, error)) *TypeaheadService_StartReindex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Typeahead provides a mock function with given fields: ctx, prefix
+func (_m *TypeaheadService) Typeahead(ctx context.Context, prefix string) (*dto.UserTypeaheadResponse, error) {
+	ret := _m.Called(ctx, prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Typeahead")
+	}
+
+	var r0 *dto.UserTypeaheadResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.UserTypeaheadResponse, error)); ok {
+		return rf(ctx, prefix)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.UserTypeaheadResponse); ok {
+		r0 = rf(ctx, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserTypeaheadResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TypeaheadService_Typeahead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Typeahead'
+type TypeaheadService_Typeahead_Call struct {
+	*mock.Call
+}
+
+// Typeahead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+func (_e *TypeaheadService_Expecter) Typeahead(ctx interface{}, prefix interface{}) *TypeaheadService_Typeahead_Call {
+	return &TypeaheadService_Typeahead_Call{Call: _e.mock.On("Typeahead", ctx, prefix)}
+}
+
+func (_c *TypeaheadService_Typeahead_Call) Run(run func(ctx context.Context, prefix string)) *TypeaheadService_Typeahead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TypeaheadService_Typeahead_Call) Return(_a0 *dto.UserTypeaheadResponse, _a1 error) *TypeaheadService_Typeahead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TypeaheadService_Typeahead_Call) RunAndReturn(run func(context.Context, string) (*dto.UserTypeaheadResponse, error)) *TypeaheadService_Typeahead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTypeaheadService creates a new instance of TypeaheadService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTypeaheadService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TypeaheadService {
+	mock := &TypeaheadService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
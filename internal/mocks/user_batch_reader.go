@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// UserBatchReader is an autogenerated mock type for the UserBatchReader type
+type UserBatchReader struct {
+	mock.Mock
+}
+
+type UserBatchReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserBatchReader) EXPECT() *UserBatchReader_Expecter {
+	return &UserBatchReader_Expecter{mock: &_m.Mock}
+}
+
+// FindPrivacyPreferencesByUserIDs provides a mock function with given fields: ctx, userIDs
+func (_m *UserBatchReader) FindPrivacyPreferencesByUserIDs(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]*dto.UserPrivacyPreferences, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPrivacyPreferencesByUserIDs")
+	}
+
+	var r0 map[uuid.UUID]*dto.UserPrivacyPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]*dto.UserPrivacyPreferences, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]*dto.UserPrivacyPreferences); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]*dto.UserPrivacyPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserBatchReader_FindPrivacyPreferencesByUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPrivacyPreferencesByUserIDs'
+type UserBatchReader_FindPrivacyPreferencesByUserIDs_Call struct {
+	*mock.Call
+}
+
+// FindPrivacyPreferencesByUserIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []uuid.UUID
+func (_e *UserBatchReader_Expecter) FindPrivacyPreferencesByUserIDs(ctx interface{}, userIDs interface{}) *UserBatchReader_FindPrivacyPreferencesByUserIDs_Call {
+	return &UserBatchReader_FindPrivacyPreferencesByUserIDs_Call{Call: _e.mock.On("FindPrivacyPreferencesByUserIDs", ctx, userIDs)}
+}
+
+func (_c *UserBatchReader_FindPrivacyPreferencesByUserIDs_Call) Run(run func(ctx context.Context, userIDs []uuid.UUID)) *UserBatchReader_FindPrivacyPreferencesByUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserBatchReader_FindPrivacyPreferencesByUserIDs_Call) Return(_a0 map[uuid.UUID]*dto.UserPrivacyPreferences, _a1 error) *UserBatchReader_FindPrivacyPreferencesByUserIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserBatchReader_FindPrivacyPreferencesByUserIDs_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (map[uuid.UUID]*dto.UserPrivacyPreferences, error)) *UserBatchReader_FindPrivacyPreferencesByUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUsersByIDs provides a mock function with given fields: ctx, userIDs
+func (_m *UserBatchReader) FindUsersByIDs(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]*dto.User, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUsersByIDs")
+	}
+
+	var r0 map[uuid.UUID]*dto.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]*dto.User, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]*dto.User); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]*dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserBatchReader_FindUsersByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUsersByIDs'
+type UserBatchReader_FindUsersByIDs_Call struct {
+	*mock.Call
+}
+
+// FindUsersByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []uuid.UUID
+func (_e *UserBatchReader_Expecter) FindUsersByIDs(ctx interface{}, userIDs interface{}) *UserBatchReader_FindUsersByIDs_Call {
+	return &UserBatchReader_FindUsersByIDs_Call{Call: _e.mock.On("FindUsersByIDs", ctx, userIDs)}
+}
+
+func (_c *UserBatchReader_FindUsersByIDs_Call) Run(run func(ctx context.Context, userIDs []uuid.UUID)) *UserBatchReader_FindUsersByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserBatchReader_FindUsersByIDs_Call) Return(_a0 map[uuid.UUID]*dto.User, _a1 error) *UserBatchReader_FindUsersByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserBatchReader_FindUsersByIDs_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (map[uuid.UUID]*dto.User, error)) *UserBatchReader_FindUsersByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserBatchReader creates a new instance of UserBatchReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserBatchReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserBatchReader {
+	mock := &UserBatchReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
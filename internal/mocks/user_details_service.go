@@ -0,0 +1,102 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// UserDetailsService is an autogenerated mock type for the UserDetailsService type
+type UserDetailsService struct {
+	mock.Mock
+}
+
+type UserDetailsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserDetailsService) EXPECT() *UserDetailsService_Expecter {
+	return &UserDetailsService_Expecter{mock: &_m.Mock}
+}
+
+// GetUserDetails provides a mock function with given fields: ctx, requesterID, userID, expand, isAdmin, hasServiceScope
+func (_m *UserDetailsService) GetUserDetails(ctx context.Context, requesterID uuid.UUID, userID uuid.UUID, expand []dto.UserExpansion, isAdmin bool, hasServiceScope bool) (*dto.UserDetailsResponse, error) {
+	ret := _m.Called(ctx, requesterID, userID, expand, isAdmin, hasServiceScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserDetails")
+	}
+
+	var r0 *dto.UserDetailsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []dto.UserExpansion, bool, bool) (*dto.UserDetailsResponse, error)); ok {
+		return rf(ctx, requesterID, userID, expand, isAdmin, hasServiceScope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []dto.UserExpansion, bool, bool) *dto.UserDetailsResponse); ok {
+		r0 = rf(ctx, requesterID, userID, expand, isAdmin, hasServiceScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserDetailsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, []dto.UserExpansion, bool, bool) error); ok {
+		r1 = rf(ctx, requesterID, userID, expand, isAdmin, hasServiceScope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserDetailsService_GetUserDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDetails'
+type UserDetailsService_GetUserDetails_Call struct {
+	*mock.Call
+}
+
+// GetUserDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - userID uuid.UUID
+//   - expand []dto.UserExpansion
+//   - isAdmin bool
+//   - hasServiceScope bool
+func (_e *UserDetailsService_Expecter) GetUserDetails(ctx interface{}, requesterID interface{}, userID interface{}, expand interface{}, isAdmin interface{}, hasServiceScope interface{}) *UserDetailsService_GetUserDetails_Call {
+	return &UserDetailsService_GetUserDetails_Call{Call: _e.mock.On("GetUserDetails", ctx, requesterID, userID, expand, isAdmin, hasServiceScope)}
+}
+
+func (_c *UserDetailsService_GetUserDetails_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, userID uuid.UUID, expand []dto.UserExpansion, isAdmin bool, hasServiceScope bool)) *UserDetailsService_GetUserDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]dto.UserExpansion), args[4].(bool), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *UserDetailsService_GetUserDetails_Call) Return(_a0 *dto.UserDetailsResponse, _a1 error) *UserDetailsService_GetUserDetails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserDetailsService_GetUserDetails_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []dto.UserExpansion, bool, bool) (*dto.UserDetailsResponse, error)) *UserDetailsService_GetUserDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserDetailsService creates a new instance of UserDetailsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserDetailsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserDetailsService {
+	mock := &UserDetailsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
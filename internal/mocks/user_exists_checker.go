@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// UserExistsChecker is an autogenerated mock type for the UserExistsChecker type
+type UserExistsChecker struct {
+	mock.Mock
+}
+
+type UserExistsChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserExistsChecker) EXPECT() *UserExistsChecker_Expecter {
+	return &UserExistsChecker_Expecter{mock: &_m.Mock}
+}
+
+// UserExists provides a mock function with given fields: ctx, userID
+func (_m *UserExistsChecker) UserExists(ctx context.Context, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserExistsChecker_UserExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserExists'
+type UserExistsChecker_UserExists_Call struct {
+	*mock.Call
+}
+
+// UserExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *UserExistsChecker_Expecter) UserExists(ctx interface{}, userID interface{}) *UserExistsChecker_UserExists_Call {
+	return &UserExistsChecker_UserExists_Call{Call: _e.mock.On("UserExists", ctx, userID)}
+}
+
+func (_c *UserExistsChecker_UserExists_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *UserExistsChecker_UserExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserExistsChecker_UserExists_Call) Return(_a0 bool, _a1 error) *UserExistsChecker_UserExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserExistsChecker_UserExists_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *UserExistsChecker_UserExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserExistsChecker creates a new instance of UserExistsChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserExistsChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserExistsChecker {
+	mock := &UserExistsChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
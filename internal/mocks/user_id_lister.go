@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// UserIDLister is an autogenerated mock type for the UserIDLister type
+type UserIDLister struct {
+	mock.Mock
+}
+
+type UserIDLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserIDLister) EXPECT() *UserIDLister_Expecter {
+	return &UserIDLister_Expecter{mock: &_m.Mock}
+}
+
+// ListUserIDs provides a mock function with given fields: ctx, after, limit
+func (_m *UserIDLister) ListUserIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserIDs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]uuid.UUID, error)); ok {
+		return rf(ctx, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []uuid.UUID); ok {
+		r0 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserIDLister_ListUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserIDs'
+type UserIDLister_ListUserIDs_Call struct {
+	*mock.Call
+}
+
+// ListUserIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after uuid.UUID
+//   - limit int
+func (_e *UserIDLister_Expecter) ListUserIDs(ctx interface{}, after interface{}, limit interface{}) *UserIDLister_ListUserIDs_Call {
+	return &UserIDLister_ListUserIDs_Call{Call: _e.mock.On("ListUserIDs", ctx, after, limit)}
+}
+
+func (_c *UserIDLister_ListUserIDs_Call) Run(run func(ctx context.Context, after uuid.UUID, limit int)) *UserIDLister_ListUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *UserIDLister_ListUserIDs_Call) Return(_a0 []uuid.UUID, _a1 error) *UserIDLister_ListUserIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserIDLister_ListUserIDs_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]uuid.UUID, error)) *UserIDLister_ListUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserIDLister creates a new instance of UserIDLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserIDLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserIDLister {
+	mock := &UserIDLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,826 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// UserRepository is an autogenerated mock type for the UserRepository type
+type UserRepository struct {
+	mock.Mock
+}
+
+type UserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserRepository) EXPECT() *UserRepository_Expecter {
+	return &UserRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountSearchableUsers provides a mock function with given fields: ctx
+func (_m *UserRepository) CountSearchableUsers(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountSearchableUsers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_CountSearchableUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSearchableUsers'
+type UserRepository_CountSearchableUsers_Call struct {
+	*mock.Call
+}
+
+// CountSearchableUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserRepository_Expecter) CountSearchableUsers(ctx interface{}) *UserRepository_CountSearchableUsers_Call {
+	return &UserRepository_CountSearchableUsers_Call{Call: _e.mock.On("CountSearchableUsers", ctx)}
+}
+
+func (_c *UserRepository_CountSearchableUsers_Call) Run(run func(ctx context.Context)) *UserRepository_CountSearchableUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserRepository_CountSearchableUsers_Call) Return(_a0 int, _a1 error) *UserRepository_CountSearchableUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_CountSearchableUsers_Call) RunAndReturn(run func(context.Context) (int, error)) *UserRepository_CountSearchableUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMentionableUsers provides a mock function with given fields: ctx, usernames
+func (_m *UserRepository) FindMentionableUsers(ctx context.Context, usernames []string) ([]dto.MentionedUser, error) {
+	ret := _m.Called(ctx, usernames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMentionableUsers")
+	}
+
+	var r0 []dto.MentionedUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]dto.MentionedUser, error)); ok {
+		return rf(ctx, usernames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []dto.MentionedUser); ok {
+		r0 = rf(ctx, usernames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.MentionedUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, usernames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_FindMentionableUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMentionableUsers'
+type UserRepository_FindMentionableUsers_Call struct {
+	*mock.Call
+}
+
+// FindMentionableUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usernames []string
+func (_e *UserRepository_Expecter) FindMentionableUsers(ctx interface{}, usernames interface{}) *UserRepository_FindMentionableUsers_Call {
+	return &UserRepository_FindMentionableUsers_Call{Call: _e.mock.On("FindMentionableUsers", ctx, usernames)}
+}
+
+func (_c *UserRepository_FindMentionableUsers_Call) Run(run func(ctx context.Context, usernames []string)) *UserRepository_FindMentionableUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_FindMentionableUsers_Call) Return(_a0 []dto.MentionedUser, _a1 error) *UserRepository_FindMentionableUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_FindMentionableUsers_Call) RunAndReturn(run func(context.Context, []string) ([]dto.MentionedUser, error)) *UserRepository_FindMentionableUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPrivacyPreferencesByUserID provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) FindPrivacyPreferencesByUserID(ctx context.Context, userID uuid.UUID) (*dto.UserPrivacyPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPrivacyPreferencesByUserID")
+	}
+
+	var r0 *dto.UserPrivacyPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.UserPrivacyPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.UserPrivacyPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserPrivacyPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_FindPrivacyPreferencesByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPrivacyPreferencesByUserID'
+type UserRepository_FindPrivacyPreferencesByUserID_Call struct {
+	*mock.Call
+}
+
+// FindPrivacyPreferencesByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *UserRepository_Expecter) FindPrivacyPreferencesByUserID(ctx interface{}, userID interface{}) *UserRepository_FindPrivacyPreferencesByUserID_Call {
+	return &UserRepository_FindPrivacyPreferencesByUserID_Call{Call: _e.mock.On("FindPrivacyPreferencesByUserID", ctx, userID)}
+}
+
+func (_c *UserRepository_FindPrivacyPreferencesByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *UserRepository_FindPrivacyPreferencesByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserRepository_FindPrivacyPreferencesByUserID_Call) Return(_a0 *dto.UserPrivacyPreferences, _a1 error) *UserRepository_FindPrivacyPreferencesByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_FindPrivacyPreferencesByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.UserPrivacyPreferences, error)) *UserRepository_FindPrivacyPreferencesByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserByID provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) FindUserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByID")
+	}
+
+	var r0 *dto.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_FindUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByID'
+type UserRepository_FindUserByID_Call struct {
+	*mock.Call
+}
+
+// FindUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *UserRepository_Expecter) FindUserByID(ctx interface{}, userID interface{}) *UserRepository_FindUserByID_Call {
+	return &UserRepository_FindUserByID_Call{Call: _e.mock.On("FindUserByID", ctx, userID)}
+}
+
+func (_c *UserRepository_FindUserByID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *UserRepository_FindUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserRepository_FindUserByID_Call) Return(_a0 *dto.User, _a1 error) *UserRepository_FindUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_FindUserByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.User, error)) *UserRepository_FindUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserByUsername provides a mock function with given fields: ctx, username
+func (_m *UserRepository) FindUserByUsername(ctx context.Context, username string) (*dto.User, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByUsername")
+	}
+
+	var r0 *dto.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.User, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.User); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_FindUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByUsername'
+type UserRepository_FindUserByUsername_Call struct {
+	*mock.Call
+}
+
+// FindUserByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *UserRepository_Expecter) FindUserByUsername(ctx interface{}, username interface{}) *UserRepository_FindUserByUsername_Call {
+	return &UserRepository_FindUserByUsername_Call{Call: _e.mock.On("FindUserByUsername", ctx, username)}
+}
+
+func (_c *UserRepository_FindUserByUsername_Call) Run(run func(ctx context.Context, username string)) *UserRepository_FindUserByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_FindUserByUsername_Call) Return(_a0 *dto.User, _a1 error) *UserRepository_FindUserByUsername_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_FindUserByUsername_Call) RunAndReturn(run func(context.Context, string) (*dto.User, error)) *UserRepository_FindUserByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserStats provides a mock function with given fields: ctx
+func (_m *UserRepository) GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserStats")
+	}
+
+	var r0 *dto.UserStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.UserStatsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.UserStatsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetUserStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserStats'
+type UserRepository_GetUserStats_Call struct {
+	*mock.Call
+}
+
+// GetUserStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserRepository_Expecter) GetUserStats(ctx interface{}) *UserRepository_GetUserStats_Call {
+	return &UserRepository_GetUserStats_Call{Call: _e.mock.On("GetUserStats", ctx)}
+}
+
+func (_c *UserRepository_GetUserStats_Call) Run(run func(ctx context.Context)) *UserRepository_GetUserStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetUserStats_Call) Return(_a0 *dto.UserStatsResponse, _a1 error) *UserRepository_GetUserStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetUserStats_Call) RunAndReturn(run func(context.Context) (*dto.UserStatsResponse, error)) *UserRepository_GetUserStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFollowing provides a mock function with given fields: ctx, followerID, followedID
+func (_m *UserRepository) IsFollowing(ctx context.Context, followerID uuid.UUID, followedID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, followerID, followedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFollowing")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, followerID, followedID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, followerID, followedID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, followerID, followedID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_IsFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFollowing'
+type UserRepository_IsFollowing_Call struct {
+	*mock.Call
+}
+
+// IsFollowing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uuid.UUID
+//   - followedID uuid.UUID
+func (_e *UserRepository_Expecter) IsFollowing(ctx interface{}, followerID interface{}, followedID interface{}) *UserRepository_IsFollowing_Call {
+	return &UserRepository_IsFollowing_Call{Call: _e.mock.On("IsFollowing", ctx, followerID, followedID)}
+}
+
+func (_c *UserRepository_IsFollowing_Call) Run(run func(ctx context.Context, followerID uuid.UUID, followedID uuid.UUID)) *UserRepository_IsFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserRepository_IsFollowing_Call) Return(_a0 bool, _a1 error) *UserRepository_IsFollowing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_IsFollowing_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *UserRepository_IsFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSearchableUsers provides a mock function with given fields: ctx, after, limit
+func (_m *UserRepository) ListSearchableUsers(ctx context.Context, after uuid.UUID, limit int) ([]dto.UserTypeaheadResult, error) {
+	ret := _m.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSearchableUsers")
+	}
+
+	var r0 []dto.UserTypeaheadResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]dto.UserTypeaheadResult, error)); ok {
+		return rf(ctx, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []dto.UserTypeaheadResult); ok {
+		r0 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserTypeaheadResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_ListSearchableUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSearchableUsers'
+type UserRepository_ListSearchableUsers_Call struct {
+	*mock.Call
+}
+
+// ListSearchableUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after uuid.UUID
+//   - limit int
+func (_e *UserRepository_Expecter) ListSearchableUsers(ctx interface{}, after interface{}, limit interface{}) *UserRepository_ListSearchableUsers_Call {
+	return &UserRepository_ListSearchableUsers_Call{Call: _e.mock.On("ListSearchableUsers", ctx, after, limit)}
+}
+
+func (_c *UserRepository_ListSearchableUsers_Call) Run(run func(ctx context.Context, after uuid.UUID, limit int)) *UserRepository_ListSearchableUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *UserRepository_ListSearchableUsers_Call) Return(_a0 []dto.UserTypeaheadResult, _a1 error) *UserRepository_ListSearchableUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_ListSearchableUsers_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]dto.UserTypeaheadResult, error)) *UserRepository_ListSearchableUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseRetiredIdentifiers provides a mock function with given fields: ctx, deactivatedBefore, limit
+func (_m *UserRepository) ReleaseRetiredIdentifiers(ctx context.Context, deactivatedBefore time.Time, limit int) (int, error) {
+	ret := _m.Called(ctx, deactivatedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseRetiredIdentifiers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return rf(ctx, deactivatedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = rf(ctx, deactivatedBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, deactivatedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_ReleaseRetiredIdentifiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseRetiredIdentifiers'
+type UserRepository_ReleaseRetiredIdentifiers_Call struct {
+	*mock.Call
+}
+
+// ReleaseRetiredIdentifiers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deactivatedBefore time.Time
+//   - limit int
+func (_e *UserRepository_Expecter) ReleaseRetiredIdentifiers(ctx interface{}, deactivatedBefore interface{}, limit interface{}) *UserRepository_ReleaseRetiredIdentifiers_Call {
+	return &UserRepository_ReleaseRetiredIdentifiers_Call{Call: _e.mock.On("ReleaseRetiredIdentifiers", ctx, deactivatedBefore, limit)}
+}
+
+func (_c *UserRepository_ReleaseRetiredIdentifiers_Call) Run(run func(ctx context.Context, deactivatedBefore time.Time, limit int)) *UserRepository_ReleaseRetiredIdentifiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *UserRepository_ReleaseRetiredIdentifiers_Call) Return(_a0 int, _a1 error) *UserRepository_ReleaseRetiredIdentifiers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_ReleaseRetiredIdentifiers_Call) RunAndReturn(run func(context.Context, time.Time, int) (int, error)) *UserRepository_ReleaseRetiredIdentifiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUsernamePrefix provides a mock function with given fields: ctx, prefix, limit
+func (_m *UserRepository) SearchUsernamePrefix(ctx context.Context, prefix string, limit int) ([]dto.UserTypeaheadResult, error) {
+	ret := _m.Called(ctx, prefix, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUsernamePrefix")
+	}
+
+	var r0 []dto.UserTypeaheadResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]dto.UserTypeaheadResult, error)); ok {
+		return rf(ctx, prefix, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []dto.UserTypeaheadResult); ok {
+		r0 = rf(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserTypeaheadResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_SearchUsernamePrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUsernamePrefix'
+type UserRepository_SearchUsernamePrefix_Call struct {
+	*mock.Call
+}
+
+// SearchUsernamePrefix is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - limit int
+func (_e *UserRepository_Expecter) SearchUsernamePrefix(ctx interface{}, prefix interface{}, limit interface{}) *UserRepository_SearchUsernamePrefix_Call {
+	return &UserRepository_SearchUsernamePrefix_Call{Call: _e.mock.On("SearchUsernamePrefix", ctx, prefix, limit)}
+}
+
+func (_c *UserRepository_SearchUsernamePrefix_Call) Run(run func(ctx context.Context, prefix string, limit int)) *UserRepository_SearchUsernamePrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *UserRepository_SearchUsernamePrefix_Call) Return(_a0 []dto.UserTypeaheadResult, _a1 error) *UserRepository_SearchUsernamePrefix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_SearchUsernamePrefix_Call) RunAndReturn(run func(context.Context, string, int) ([]dto.UserTypeaheadResult, error)) *UserRepository_SearchUsernamePrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUsers provides a mock function with given fields: ctx, query, location, limit, offset
+func (_m *UserRepository) SearchUsers(ctx context.Context, query string, location dto.LocationFilter, limit int, offset int) ([]dto.UserSearchResult, int, error) {
+	ret := _m.Called(ctx, query, location, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUsers")
+	}
+
+	var r0 []dto.UserSearchResult
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.LocationFilter, int, int) ([]dto.UserSearchResult, int, error)); ok {
+		return rf(ctx, query, location, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.LocationFilter, int, int) []dto.UserSearchResult); ok {
+		r0 = rf(ctx, query, location, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.LocationFilter, int, int) int); ok {
+		r1 = rf(ctx, query, location, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, dto.LocationFilter, int, int) error); ok {
+		r2 = rf(ctx, query, location, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UserRepository_SearchUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUsers'
+type UserRepository_SearchUsers_Call struct {
+	*mock.Call
+}
+
+// SearchUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - location dto.LocationFilter
+//   - limit int
+//   - offset int
+func (_e *UserRepository_Expecter) SearchUsers(ctx interface{}, query interface{}, location interface{}, limit interface{}, offset interface{}) *UserRepository_SearchUsers_Call {
+	return &UserRepository_SearchUsers_Call{Call: _e.mock.On("SearchUsers", ctx, query, location, limit, offset)}
+}
+
+func (_c *UserRepository_SearchUsers_Call) Run(run func(ctx context.Context, query string, location dto.LocationFilter, limit int, offset int)) *UserRepository_SearchUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(dto.LocationFilter), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *UserRepository_SearchUsers_Call) Return(_a0 []dto.UserSearchResult, _a1 int, _a2 error) *UserRepository_SearchUsers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *UserRepository_SearchUsers_Call) RunAndReturn(run func(context.Context, string, dto.LocationFilter, int, int) ([]dto.UserSearchResult, int, error)) *UserRepository_SearchUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUsersRanked provides a mock function with given fields: ctx, requesterID, query, location, limit, offset
+func (_m *UserRepository) SearchUsersRanked(ctx context.Context, requesterID uuid.UUID, query string, location dto.LocationFilter, limit int, offset int) ([]dto.UserSearchResult, int, error) {
+	ret := _m.Called(ctx, requesterID, query, location, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUsersRanked")
+	}
+
+	var r0 []dto.UserSearchResult
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, dto.LocationFilter, int, int) ([]dto.UserSearchResult, int, error)); ok {
+		return rf(ctx, requesterID, query, location, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, dto.LocationFilter, int, int) []dto.UserSearchResult); ok {
+		r0 = rf(ctx, requesterID, query, location, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, dto.LocationFilter, int, int) int); ok {
+		r1 = rf(ctx, requesterID, query, location, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, dto.LocationFilter, int, int) error); ok {
+		r2 = rf(ctx, requesterID, query, location, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UserRepository_SearchUsersRanked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUsersRanked'
+type UserRepository_SearchUsersRanked_Call struct {
+	*mock.Call
+}
+
+// SearchUsersRanked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - query string
+//   - location dto.LocationFilter
+//   - limit int
+//   - offset int
+func (_e *UserRepository_Expecter) SearchUsersRanked(ctx interface{}, requesterID interface{}, query interface{}, location interface{}, limit interface{}, offset interface{}) *UserRepository_SearchUsersRanked_Call {
+	return &UserRepository_SearchUsersRanked_Call{Call: _e.mock.On("SearchUsersRanked", ctx, requesterID, query, location, limit, offset)}
+}
+
+func (_c *UserRepository_SearchUsersRanked_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, query string, location dto.LocationFilter, limit int, offset int)) *UserRepository_SearchUsersRanked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(dto.LocationFilter), args[4].(int), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *UserRepository_SearchUsersRanked_Call) Return(_a0 []dto.UserSearchResult, _a1 int, _a2 error) *UserRepository_SearchUsersRanked_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *UserRepository_SearchUsersRanked_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, dto.LocationFilter, int, int) ([]dto.UserSearchResult, int, error)) *UserRepository_SearchUsersRanked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUser provides a mock function with given fields: ctx, userID, update
+func (_m *UserRepository) UpdateUser(ctx context.Context, userID uuid.UUID, update *dto.UserProfileUpdateRequest) (*dto.User, error) {
+	ret := _m.Called(ctx, userID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 *dto.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.UserProfileUpdateRequest) (*dto.User, error)); ok {
+		return rf(ctx, userID, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.UserProfileUpdateRequest) *dto.User); ok {
+		r0 = rf(ctx, userID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.UserProfileUpdateRequest) error); ok {
+		r1 = rf(ctx, userID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type UserRepository_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - update *dto.UserProfileUpdateRequest
+func (_e *UserRepository_Expecter) UpdateUser(ctx interface{}, userID interface{}, update interface{}) *UserRepository_UpdateUser_Call {
+	return &UserRepository_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, userID, update)}
+}
+
+func (_c *UserRepository_UpdateUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, update *dto.UserProfileUpdateRequest)) *UserRepository_UpdateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.UserProfileUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdateUser_Call) Return(_a0 *dto.User, _a1 error) *UserRepository_UpdateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_UpdateUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.UserProfileUpdateRequest) (*dto.User, error)) *UserRepository_UpdateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserRepository creates a new instance of UserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserRepository {
+	mock := &UserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
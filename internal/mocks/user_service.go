@@ -0,0 +1,575 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// UserService is an autogenerated mock type for the UserService type
+type UserService struct {
+	mock.Mock
+}
+
+type UserService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserService) EXPECT() *UserService_Expecter {
+	return &UserService_Expecter{mock: &_m.Mock}
+}
+
+// ConfirmAccountDeletion provides a mock function with given fields: ctx, userID, token
+func (_m *UserService) ConfirmAccountDeletion(ctx context.Context, userID uuid.UUID, token string) (*dto.UserConfirmAccountDeleteResponse, error) {
+	ret := _m.Called(ctx, userID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmAccountDeletion")
+	}
+
+	var r0 *dto.UserConfirmAccountDeleteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dto.UserConfirmAccountDeleteResponse, error)); ok {
+		return rf(ctx, userID, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dto.UserConfirmAccountDeleteResponse); ok {
+		r0 = rf(ctx, userID, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserConfirmAccountDeleteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_ConfirmAccountDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmAccountDeletion'
+type UserService_ConfirmAccountDeletion_Call struct {
+	*mock.Call
+}
+
+// ConfirmAccountDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - token string
+func (_e *UserService_Expecter) ConfirmAccountDeletion(ctx interface{}, userID interface{}, token interface{}) *UserService_ConfirmAccountDeletion_Call {
+	return &UserService_ConfirmAccountDeletion_Call{Call: _e.mock.On("ConfirmAccountDeletion", ctx, userID, token)}
+}
+
+func (_c *UserService_ConfirmAccountDeletion_Call) Run(run func(ctx context.Context, userID uuid.UUID, token string)) *UserService_ConfirmAccountDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserService_ConfirmAccountDeletion_Call) Return(_a0 *dto.UserConfirmAccountDeleteResponse, _a1 error) *UserService_ConfirmAccountDeletion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_ConfirmAccountDeletion_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dto.UserConfirmAccountDeleteResponse, error)) *UserService_ConfirmAccountDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function with given fields: ctx, userID
+func (_m *UserService) GetUserByID(ctx context.Context, userID uuid.UUID) (*dto.UserSearchResult, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 *dto.UserSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.UserSearchResult, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.UserSearchResult); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type UserService_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *UserService_Expecter) GetUserByID(ctx interface{}, userID interface{}) *UserService_GetUserByID_Call {
+	return &UserService_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, userID)}
+}
+
+func (_c *UserService_GetUserByID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *UserService_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserService_GetUserByID_Call) Return(_a0 *dto.UserSearchResult, _a1 error) *UserService_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetUserByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.UserSearchResult, error)) *UserService_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserProfile provides a mock function with given fields: ctx, requesterID, targetUserID
+func (_m *UserService) GetUserProfile(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID) (*dto.UserProfileResponse, error) {
+	ret := _m.Called(ctx, requesterID, targetUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserProfile")
+	}
+
+	var r0 *dto.UserProfileResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*dto.UserProfileResponse, error)); ok {
+		return rf(ctx, requesterID, targetUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *dto.UserProfileResponse); ok {
+		r0 = rf(ctx, requesterID, targetUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserProfileResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, requesterID, targetUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetUserProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserProfile'
+type UserService_GetUserProfile_Call struct {
+	*mock.Call
+}
+
+// GetUserProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - targetUserID uuid.UUID
+func (_e *UserService_Expecter) GetUserProfile(ctx interface{}, requesterID interface{}, targetUserID interface{}) *UserService_GetUserProfile_Call {
+	return &UserService_GetUserProfile_Call{Call: _e.mock.On("GetUserProfile", ctx, requesterID, targetUserID)}
+}
+
+func (_c *UserService_GetUserProfile_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, targetUserID uuid.UUID)) *UserService_GetUserProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserService_GetUserProfile_Call) Return(_a0 *dto.UserProfileResponse, _a1 error) *UserService_GetUserProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetUserProfile_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*dto.UserProfileResponse, error)) *UserService_GetUserProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserProfileByUsername provides a mock function with given fields: ctx, requesterID, username
+func (_m *UserService) GetUserProfileByUsername(ctx context.Context, requesterID uuid.UUID, username string) (*dto.UserProfileResponse, error) {
+	ret := _m.Called(ctx, requesterID, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserProfileByUsername")
+	}
+
+	var r0 *dto.UserProfileResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dto.UserProfileResponse, error)); ok {
+		return rf(ctx, requesterID, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dto.UserProfileResponse); ok {
+		r0 = rf(ctx, requesterID, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserProfileResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, requesterID, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetUserProfileByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserProfileByUsername'
+type UserService_GetUserProfileByUsername_Call struct {
+	*mock.Call
+}
+
+// GetUserProfileByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - username string
+func (_e *UserService_Expecter) GetUserProfileByUsername(ctx interface{}, requesterID interface{}, username interface{}) *UserService_GetUserProfileByUsername_Call {
+	return &UserService_GetUserProfileByUsername_Call{Call: _e.mock.On("GetUserProfileByUsername", ctx, requesterID, username)}
+}
+
+func (_c *UserService_GetUserProfileByUsername_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, username string)) *UserService_GetUserProfileByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserService_GetUserProfileByUsername_Call) Return(_a0 *dto.UserProfileResponse, _a1 error) *UserService_GetUserProfileByUsername_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetUserProfileByUsername_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dto.UserProfileResponse, error)) *UserService_GetUserProfileByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserStats provides a mock function with given fields: ctx
+func (_m *UserService) GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserStats")
+	}
+
+	var r0 *dto.UserStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*dto.UserStatsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.UserStatsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetUserStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserStats'
+type UserService_GetUserStats_Call struct {
+	*mock.Call
+}
+
+// GetUserStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserService_Expecter) GetUserStats(ctx interface{}) *UserService_GetUserStats_Call {
+	return &UserService_GetUserStats_Call{Call: _e.mock.On("GetUserStats", ctx)}
+}
+
+func (_c *UserService_GetUserStats_Call) Run(run func(ctx context.Context)) *UserService_GetUserStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserService_GetUserStats_Call) Return(_a0 *dto.UserStatsResponse, _a1 error) *UserService_GetUserStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetUserStats_Call) RunAndReturn(run func(context.Context) (*dto.UserStatsResponse, error)) *UserService_GetUserStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseRetiredIdentifiers provides a mock function with given fields: ctx
+func (_m *UserService) ReleaseRetiredIdentifiers(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseRetiredIdentifiers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_ReleaseRetiredIdentifiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseRetiredIdentifiers'
+type UserService_ReleaseRetiredIdentifiers_Call struct {
+	*mock.Call
+}
+
+// ReleaseRetiredIdentifiers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserService_Expecter) ReleaseRetiredIdentifiers(ctx interface{}) *UserService_ReleaseRetiredIdentifiers_Call {
+	return &UserService_ReleaseRetiredIdentifiers_Call{Call: _e.mock.On("ReleaseRetiredIdentifiers", ctx)}
+}
+
+func (_c *UserService_ReleaseRetiredIdentifiers_Call) Run(run func(ctx context.Context)) *UserService_ReleaseRetiredIdentifiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserService_ReleaseRetiredIdentifiers_Call) Return(_a0 int, _a1 error) *UserService_ReleaseRetiredIdentifiers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_ReleaseRetiredIdentifiers_Call) RunAndReturn(run func(context.Context) (int, error)) *UserService_ReleaseRetiredIdentifiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestAccountDeletion provides a mock function with given fields: ctx, userID
+func (_m *UserService) RequestAccountDeletion(ctx context.Context, userID uuid.UUID) (*dto.UserAccountDeleteRequestResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestAccountDeletion")
+	}
+
+	var r0 *dto.UserAccountDeleteRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.UserAccountDeleteRequestResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.UserAccountDeleteRequestResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserAccountDeleteRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_RequestAccountDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestAccountDeletion'
+type UserService_RequestAccountDeletion_Call struct {
+	*mock.Call
+}
+
+// RequestAccountDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *UserService_Expecter) RequestAccountDeletion(ctx interface{}, userID interface{}) *UserService_RequestAccountDeletion_Call {
+	return &UserService_RequestAccountDeletion_Call{Call: _e.mock.On("RequestAccountDeletion", ctx, userID)}
+}
+
+func (_c *UserService_RequestAccountDeletion_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *UserService_RequestAccountDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserService_RequestAccountDeletion_Call) Return(_a0 *dto.UserAccountDeleteRequestResponse, _a1 error) *UserService_RequestAccountDeletion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_RequestAccountDeletion_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.UserAccountDeleteRequestResponse, error)) *UserService_RequestAccountDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUsers provides a mock function with given fields: ctx, requesterID, query, location, limit, offset, countOnly
+func (_m *UserService) SearchUsers(ctx context.Context, requesterID uuid.UUID, query string, location dto.LocationFilter, limit int, offset int, countOnly bool) (*dto.UserSearchResponse, error) {
+	ret := _m.Called(ctx, requesterID, query, location, limit, offset, countOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUsers")
+	}
+
+	var r0 *dto.UserSearchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, dto.LocationFilter, int, int, bool) (*dto.UserSearchResponse, error)); ok {
+		return rf(ctx, requesterID, query, location, limit, offset, countOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, dto.LocationFilter, int, int, bool) *dto.UserSearchResponse); ok {
+		r0 = rf(ctx, requesterID, query, location, limit, offset, countOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserSearchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, dto.LocationFilter, int, int, bool) error); ok {
+		r1 = rf(ctx, requesterID, query, location, limit, offset, countOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_SearchUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUsers'
+type UserService_SearchUsers_Call struct {
+	*mock.Call
+}
+
+// SearchUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID uuid.UUID
+//   - query string
+//   - location dto.LocationFilter
+//   - limit int
+//   - offset int
+//   - countOnly bool
+func (_e *UserService_Expecter) SearchUsers(ctx interface{}, requesterID interface{}, query interface{}, location interface{}, limit interface{}, offset interface{}, countOnly interface{}) *UserService_SearchUsers_Call {
+	return &UserService_SearchUsers_Call{Call: _e.mock.On("SearchUsers", ctx, requesterID, query, location, limit, offset, countOnly)}
+}
+
+func (_c *UserService_SearchUsers_Call) Run(run func(ctx context.Context, requesterID uuid.UUID, query string, location dto.LocationFilter, limit int, offset int, countOnly bool)) *UserService_SearchUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(dto.LocationFilter), args[4].(int), args[5].(int), args[6].(bool))
+	})
+	return _c
+}
+
+func (_c *UserService_SearchUsers_Call) Return(_a0 *dto.UserSearchResponse, _a1 error) *UserService_SearchUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_SearchUsers_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, dto.LocationFilter, int, int, bool) (*dto.UserSearchResponse, error)) *UserService_SearchUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserProfile provides a mock function with given fields: ctx, userID, update
+func (_m *UserService) UpdateUserProfile(ctx context.Context, userID uuid.UUID, update *dto.UserProfileUpdateRequest) (*dto.UserProfileResponse, error) {
+	ret := _m.Called(ctx, userID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserProfile")
+	}
+
+	var r0 *dto.UserProfileResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.UserProfileUpdateRequest) (*dto.UserProfileResponse, error)); ok {
+		return rf(ctx, userID, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dto.UserProfileUpdateRequest) *dto.UserProfileResponse); ok {
+		r0 = rf(ctx, userID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserProfileResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dto.UserProfileUpdateRequest) error); ok {
+		r1 = rf(ctx, userID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_UpdateUserProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserProfile'
+type UserService_UpdateUserProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateUserProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - update *dto.UserProfileUpdateRequest
+func (_e *UserService_Expecter) UpdateUserProfile(ctx interface{}, userID interface{}, update interface{}) *UserService_UpdateUserProfile_Call {
+	return &UserService_UpdateUserProfile_Call{Call: _e.mock.On("UpdateUserProfile", ctx, userID, update)}
+}
+
+func (_c *UserService_UpdateUserProfile_Call) Run(run func(ctx context.Context, userID uuid.UUID, update *dto.UserProfileUpdateRequest)) *UserService_UpdateUserProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dto.UserProfileUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *UserService_UpdateUserProfile_Call) Return(_a0 *dto.UserProfileResponse, _a1 error) *UserService_UpdateUserProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_UpdateUserProfile_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dto.UserProfileUpdateRequest) (*dto.UserProfileResponse, error)) *UserService_UpdateUserProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserService creates a new instance of UserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserService {
+	mock := &UserService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
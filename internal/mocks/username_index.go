@@ -0,0 +1,239 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UsernameIndex is an autogenerated mock type for the UsernameIndex type
+type UsernameIndex struct {
+	mock.Mock
+}
+
+type UsernameIndex_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UsernameIndex) EXPECT() *UsernameIndex_Expecter {
+	return &UsernameIndex_Expecter{mock: &_m.Mock}
+}
+
+// DiscardUsernames provides a mock function with given fields: ctx, buildID
+func (_m *UsernameIndex) DiscardUsernames(ctx context.Context, buildID string) error {
+	ret := _m.Called(ctx, buildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscardUsernames")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, buildID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsernameIndex_DiscardUsernames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscardUsernames'
+type UsernameIndex_DiscardUsernames_Call struct {
+	*mock.Call
+}
+
+// DiscardUsernames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - buildID string
+func (_e *UsernameIndex_Expecter) DiscardUsernames(ctx interface{}, buildID interface{}) *UsernameIndex_DiscardUsernames_Call {
+	return &UsernameIndex_DiscardUsernames_Call{Call: _e.mock.On("DiscardUsernames", ctx, buildID)}
+}
+
+func (_c *UsernameIndex_DiscardUsernames_Call) Run(run func(ctx context.Context, buildID string)) *UsernameIndex_DiscardUsernames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UsernameIndex_DiscardUsernames_Call) Return(_a0 error) *UsernameIndex_DiscardUsernames_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsernameIndex_DiscardUsernames_Call) RunAndReturn(run func(context.Context, string) error) *UsernameIndex_DiscardUsernames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MatchUsernamePrefix provides a mock function with given fields: ctx, prefix, limit
+func (_m *UsernameIndex) MatchUsernamePrefix(ctx context.Context, prefix string, limit int) ([]dto.UserTypeaheadResult, error) {
+	ret := _m.Called(ctx, prefix, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MatchUsernamePrefix")
+	}
+
+	var r0 []dto.UserTypeaheadResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]dto.UserTypeaheadResult, error)); ok {
+		return rf(ctx, prefix, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []dto.UserTypeaheadResult); ok {
+		r0 = rf(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserTypeaheadResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UsernameIndex_MatchUsernamePrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MatchUsernamePrefix'
+type UsernameIndex_MatchUsernamePrefix_Call struct {
+	*mock.Call
+}
+
+// MatchUsernamePrefix is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - limit int
+func (_e *UsernameIndex_Expecter) MatchUsernamePrefix(ctx interface{}, prefix interface{}, limit interface{}) *UsernameIndex_MatchUsernamePrefix_Call {
+	return &UsernameIndex_MatchUsernamePrefix_Call{Call: _e.mock.On("MatchUsernamePrefix", ctx, prefix, limit)}
+}
+
+func (_c *UsernameIndex_MatchUsernamePrefix_Call) Run(run func(ctx context.Context, prefix string, limit int)) *UsernameIndex_MatchUsernamePrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *UsernameIndex_MatchUsernamePrefix_Call) Return(_a0 []dto.UserTypeaheadResult, _a1 error) *UsernameIndex_MatchUsernamePrefix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UsernameIndex_MatchUsernamePrefix_Call) RunAndReturn(run func(context.Context, string, int) ([]dto.UserTypeaheadResult, error)) *UsernameIndex_MatchUsernamePrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishUsernames provides a mock function with given fields: ctx, buildID
+func (_m *UsernameIndex) PublishUsernames(ctx context.Context, buildID string) error {
+	ret := _m.Called(ctx, buildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishUsernames")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, buildID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsernameIndex_PublishUsernames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishUsernames'
+type UsernameIndex_PublishUsernames_Call struct {
+	*mock.Call
+}
+
+// PublishUsernames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - buildID string
+func (_e *UsernameIndex_Expecter) PublishUsernames(ctx interface{}, buildID interface{}) *UsernameIndex_PublishUsernames_Call {
+	return &UsernameIndex_PublishUsernames_Call{Call: _e.mock.On("PublishUsernames", ctx, buildID)}
+}
+
+func (_c *UsernameIndex_PublishUsernames_Call) Run(run func(ctx context.Context, buildID string)) *UsernameIndex_PublishUsernames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UsernameIndex_PublishUsernames_Call) Return(_a0 error) *UsernameIndex_PublishUsernames_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsernameIndex_PublishUsernames_Call) RunAndReturn(run func(context.Context, string) error) *UsernameIndex_PublishUsernames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StageUsernames provides a mock function with given fields: ctx, buildID, users
+func (_m *UsernameIndex) StageUsernames(ctx context.Context, buildID string, users []dto.UserTypeaheadResult) error {
+	ret := _m.Called(ctx, buildID, users)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StageUsernames")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []dto.UserTypeaheadResult) error); ok {
+		r0 = rf(ctx, buildID, users)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsernameIndex_StageUsernames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StageUsernames'
+type UsernameIndex_StageUsernames_Call struct {
+	*mock.Call
+}
+
+// StageUsernames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - buildID string
+//   - users []dto.UserTypeaheadResult
+func (_e *UsernameIndex_Expecter) StageUsernames(ctx interface{}, buildID interface{}, users interface{}) *UsernameIndex_StageUsernames_Call {
+	return &UsernameIndex_StageUsernames_Call{Call: _e.mock.On("StageUsernames", ctx, buildID, users)}
+}
+
+func (_c *UsernameIndex_StageUsernames_Call) Run(run func(ctx context.Context, buildID string, users []dto.UserTypeaheadResult)) *UsernameIndex_StageUsernames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]dto.UserTypeaheadResult))
+	})
+	return _c
+}
+
+func (_c *UsernameIndex_StageUsernames_Call) Return(_a0 error) *UsernameIndex_StageUsernames_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsernameIndex_StageUsernames_Call) RunAndReturn(run func(context.Context, string, []dto.UserTypeaheadResult) error) *UsernameIndex_StageUsernames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUsernameIndex creates a new instance of UsernameIndex. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUsernameIndex(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UsernameIndex {
+	mock := &UsernameIndex{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -11,6 +11,11 @@ import (
 const (
 	pathNewFollower  = "/notifications/new-follower"
 	pathEmailChanged = "/notifications/email-changed"
+
+	pathEmailChangeConfirmation = "/notifications/email-change-confirmation"
+	pathAccountRecovery         = "/notifications/account-recovery"
+
+	pathPrivacyDefaultsChanged = "/notifications/privacy-defaults-changed"
 )
 
 // Client defines the interface for notification operations.
@@ -22,6 +27,19 @@ type Client interface {
 	// NotifyEmailChanged sends a security notification when a user changes their email.
 	// This is a fire-and-forget operation that logs errors but does not return them.
 	NotifyEmailChanged(ctx context.Context, recipientID uuid.UUID, oldEmail, newEmail string)
+
+	// NotifyEmailChangeConfirmation delivers an email change confirmation token to one address.
+	// This is a fire-and-forget operation that logs errors but does not return them.
+	NotifyEmailChangeConfirmation(ctx context.Context, recipientID uuid.UUID, email, token string)
+
+	// NotifyAccountRecovery delivers an account recovery token to a deactivated account's email.
+	// This is a fire-and-forget operation that logs errors but does not return them.
+	NotifyAccountRecovery(ctx context.Context, recipientID uuid.UUID, email, token string)
+
+	// NotifyPrivacyDefaultsChanged tells users their default privacy preferences changed to those of
+	// the given defaults version. This is a fire-and-forget operation that logs errors but does not
+	// return them.
+	NotifyPrivacyDefaultsChanged(ctx context.Context, recipientIDs []uuid.UUID, version int)
 }
 
 // NotificationClient implements Client using the notification service API.
@@ -97,6 +115,102 @@ func (c *NotificationClient) NotifyEmailChanged(
 	)
 }
 
+// NotifyEmailChangeConfirmation delivers an email change confirmation token to one address.
+// This operation is fire-and-forget - errors are logged but not returned.
+func (c *NotificationClient) NotifyEmailChangeConfirmation(
+	ctx context.Context,
+	recipientID uuid.UUID,
+	email, token string,
+) {
+	req := EmailChangeConfirmationRequest{
+		RecipientIDs:      []string{recipientID.String()},
+		Email:             email,
+		ConfirmationToken: token,
+	}
+
+	var resp BatchNotificationResponse
+
+	err := c.client.Do(ctx, http.MethodPost, pathEmailChangeConfirmation, req, &resp)
+	if err != nil {
+		c.logger.Warn("failed to send email change confirmation",
+			"recipient_id", recipientID,
+			"error", err,
+		)
+
+		return
+	}
+
+	c.logger.Debug("email change confirmation sent",
+		"recipient_id", recipientID,
+		"queued_count", resp.QueuedCount,
+	)
+}
+
+// NotifyAccountRecovery delivers an account recovery token to a deactivated account's email.
+// This operation is fire-and-forget - errors are logged but not returned.
+func (c *NotificationClient) NotifyAccountRecovery(
+	ctx context.Context,
+	recipientID uuid.UUID,
+	email, token string,
+) {
+	req := AccountRecoveryRequest{
+		RecipientIDs:  []string{recipientID.String()},
+		Email:         email,
+		RecoveryToken: token,
+	}
+
+	var resp BatchNotificationResponse
+
+	err := c.client.Do(ctx, http.MethodPost, pathAccountRecovery, req, &resp)
+	if err != nil {
+		c.logger.Warn("failed to send account recovery email",
+			"recipient_id", recipientID,
+			"error", err,
+		)
+
+		return
+	}
+
+	c.logger.Debug("account recovery email sent",
+		"recipient_id", recipientID,
+		"queued_count", resp.QueuedCount,
+	)
+}
+
+// NotifyPrivacyDefaultsChanged tells users their default privacy preferences changed to those of
+// the given defaults version. This operation is fire-and-forget - errors are logged but not returned.
+func (c *NotificationClient) NotifyPrivacyDefaultsChanged(
+	ctx context.Context,
+	recipientIDs []uuid.UUID,
+	version int,
+) {
+	req := PrivacyDefaultsChangedRequest{
+		RecipientIDs:   make([]string, len(recipientIDs)),
+		DefaultVersion: version,
+	}
+
+	for i, id := range recipientIDs {
+		req.RecipientIDs[i] = id.String()
+	}
+
+	var resp BatchNotificationResponse
+
+	err := c.client.Do(ctx, http.MethodPost, pathPrivacyDefaultsChanged, req, &resp)
+	if err != nil {
+		c.logger.Warn("failed to send privacy defaults notification",
+			"recipients", len(recipientIDs),
+			"error", err,
+		)
+
+		return
+	}
+
+	c.logger.Debug("privacy defaults notification sent",
+		"recipients", len(recipientIDs),
+		"queued_count", resp.QueuedCount,
+	)
+}
+
 // NoopClient is a no-op implementation for when notifications are disabled.
 type NoopClient struct{}
 
@@ -105,3 +219,12 @@ func (c *NoopClient) NotifyNewFollower(_ context.Context, _, _ uuid.UUID) {}
 
 // NotifyEmailChanged is a no-op.
 func (c *NoopClient) NotifyEmailChanged(_ context.Context, _ uuid.UUID, _, _ string) {}
+
+// NotifyEmailChangeConfirmation is a no-op.
+func (c *NoopClient) NotifyEmailChangeConfirmation(_ context.Context, _ uuid.UUID, _, _ string) {}
+
+// NotifyAccountRecovery is a no-op.
+func (c *NoopClient) NotifyAccountRecovery(_ context.Context, _ uuid.UUID, _, _ string) {}
+
+// NotifyPrivacyDefaultsChanged is a no-op.
+func (c *NoopClient) NotifyPrivacyDefaultsChanged(_ context.Context, _ []uuid.UUID, _ int) {}
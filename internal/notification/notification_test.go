@@ -126,6 +126,81 @@ func TestNotificationClient_NotifyEmailChanged_Error(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+func TestNotificationClient_NotifyEmailChangeConfirmation_Success(t *testing.T) {
+	t.Parallel()
+
+	mockClient := new(MockDownstreamClient)
+	recipientID := uuid.New()
+
+	mockClient.On("Do",
+		mock.Anything,
+		"POST",
+		"/notifications/email-change-confirmation",
+		mock.MatchedBy(func(req notification.EmailChangeConfirmationRequest) bool {
+			return len(req.RecipientIDs) == 1 &&
+				req.RecipientIDs[0] == recipientID.String() &&
+				req.Email == "new@example.com" &&
+				req.ConfirmationToken == "token-123"
+		}),
+		mock.Anything,
+	).Return(nil)
+
+	client := notification.NewNotificationClient(mockClient)
+	client.NotifyEmailChangeConfirmation(context.Background(), recipientID, "new@example.com", "token-123")
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestNotificationClient_NotifyAccountRecovery_Success(t *testing.T) {
+	t.Parallel()
+
+	mockClient := new(MockDownstreamClient)
+	recipientID := uuid.New()
+
+	mockClient.On("Do",
+		mock.Anything,
+		"POST",
+		"/notifications/account-recovery",
+		mock.MatchedBy(func(req notification.AccountRecoveryRequest) bool {
+			return len(req.RecipientIDs) == 1 &&
+				req.RecipientIDs[0] == recipientID.String() &&
+				req.Email == "user@example.com" &&
+				req.RecoveryToken == "token-123"
+		}),
+		mock.Anything,
+	).Return(nil)
+
+	client := notification.NewNotificationClient(mockClient)
+	client.NotifyAccountRecovery(context.Background(), recipientID, "user@example.com", "token-123")
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestNotificationClient_NotifyPrivacyDefaultsChanged_Success(t *testing.T) {
+	t.Parallel()
+
+	mockClient := new(MockDownstreamClient)
+	first, second := uuid.New(), uuid.New()
+
+	mockClient.On("Do",
+		mock.Anything,
+		"POST",
+		"/notifications/privacy-defaults-changed",
+		mock.MatchedBy(func(req notification.PrivacyDefaultsChangedRequest) bool {
+			return len(req.RecipientIDs) == 2 &&
+				req.RecipientIDs[0] == first.String() &&
+				req.RecipientIDs[1] == second.String() &&
+				req.DefaultVersion == 2
+		}),
+		mock.Anything,
+	).Return(nil)
+
+	client := notification.NewNotificationClient(mockClient)
+	client.NotifyPrivacyDefaultsChanged(context.Background(), []uuid.UUID{first, second}, 2)
+
+	mockClient.AssertExpectations(t)
+}
+
 func TestNoopClient_NotifyNewFollower(t *testing.T) {
 	t.Parallel()
 
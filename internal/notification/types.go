@@ -20,6 +20,32 @@ type EmailChangedRequest struct {
 	NewEmail     string   `json:"new_email"`
 }
 
+// EmailChangeConfirmationRequest represents the payload for POST /notifications/email-change-confirmation.
+//
+//nolint:tagliatelle // API spec requires snake_case
+type EmailChangeConfirmationRequest struct {
+	RecipientIDs      []string `json:"recipient_ids"`
+	Email             string   `json:"email"`
+	ConfirmationToken string   `json:"confirmation_token"`
+}
+
+// AccountRecoveryRequest represents the payload for POST /notifications/account-recovery.
+//
+//nolint:tagliatelle // API spec requires snake_case
+type AccountRecoveryRequest struct {
+	RecipientIDs  []string `json:"recipient_ids"`
+	Email         string   `json:"email"`
+	RecoveryToken string   `json:"recovery_token"`
+}
+
+// PrivacyDefaultsChangedRequest represents the payload for POST /notifications/privacy-defaults-changed.
+//
+//nolint:tagliatelle // API spec requires snake_case
+type PrivacyDefaultsChangedRequest struct {
+	RecipientIDs   []string `json:"recipient_ids"`
+	DefaultVersion int      `json:"default_version"`
+}
+
 // BatchNotificationResponse represents the response from notification endpoints.
 //
 //nolint:tagliatelle // API spec requires snake_case
@@ -0,0 +1,107 @@
+// Package querytrace records the SQL statements run for a request, so slow requests can be
+// diagnosed from the queries they made.
+package querytrace
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Query is a statement run for a request. Arguments are not recorded, as they may hold personal
+// data.
+type Query struct {
+	SQL       string    `json:"sql"`
+	StartedAt time.Time `json:"startedAt"`
+	// DurationMs is zero while the statement is still running.
+	DurationMs float64 `json:"durationMs"`
+	Rows       int64   `json:"rows"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// Log collects the statements run under a context made by WithLog. It is safe for concurrent use.
+type Log struct {
+	mu      sync.Mutex
+	limit   int
+	queries []Query
+	dropped int
+}
+
+type logKey struct{}
+
+// WithLog returns a context whose statements are recorded in the returned Log, up to limit of
+// them; later statements are only counted.
+func WithLog(ctx context.Context, limit int) (context.Context, *Log) {
+	log := &Log{limit: limit}
+
+	return context.WithValue(ctx, logKey{}, log), log
+}
+
+// Queries returns the recorded statements and how many were dropped over the limit.
+func (l *Log) Queries() (queries []Query, dropped int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]Query(nil), l.queries...), l.dropped
+}
+
+// start records a statement and returns its index, or -1 if it was dropped.
+func (l *Log) start(sql string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.queries) >= l.limit {
+		l.dropped++
+
+		return -1
+	}
+
+	l.queries = append(l.queries, Query{SQL: sql, StartedAt: time.Now()})
+
+	return len(l.queries) - 1
+}
+
+// end completes the statement at index i.
+func (l *Log) end(i int, data pgx.TraceQueryEndData) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	query := &l.queries[i]
+	query.DurationMs = float64(time.Since(query.StartedAt)) / float64(time.Millisecond)
+	query.Rows = data.CommandTag.RowsAffected()
+
+	if data.Err != nil {
+		query.Error = data.Err.Error()
+	}
+}
+
+// Tracer is a pgx.QueryTracer recording statements into the Log of their context, if any.
+type Tracer struct{}
+
+var _ pgx.QueryTracer = Tracer{}
+
+type queryKey struct{}
+
+// TraceQueryStart implements pgx.QueryTracer.
+func (Tracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	log, ok := ctx.Value(logKey{}).(*Log)
+	if !ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, queryKey{}, log.start(data.SQL))
+}
+
+// TraceQueryEnd implements pgx.QueryTracer.
+func (Tracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	log, ok := ctx.Value(logKey{}).(*Log)
+	if !ok {
+		return
+	}
+
+	if i, ok := ctx.Value(queryKey{}).(int); ok && i >= 0 {
+		log.end(i, data)
+	}
+}
@@ -0,0 +1,50 @@
+package querytrace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/querytrace"
+)
+
+func TestTracer(t *testing.T) {
+	t.Parallel()
+
+	var tracer querytrace.Tracer
+
+	run := func(ctx context.Context, sql string, end pgx.TraceQueryEndData) {
+		ctx = tracer.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: sql, Args: []any{"alice@example.com"}})
+		tracer.TraceQueryEnd(ctx, nil, end)
+	}
+
+	t.Run("records statements up to the limit", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, log := querytrace.WithLog(t.Context(), 2)
+
+		run(ctx, "SELECT 1", pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 1")})
+		run(ctx, "UPDATE users", pgx.TraceQueryEndData{Err: errors.New("deadlock detected")})
+		run(ctx, "SELECT 2", pgx.TraceQueryEndData{})
+
+		queries, dropped := log.Queries()
+		require.Len(t, queries, 2)
+		assert.Equal(t, 1, dropped)
+
+		assert.Equal(t, "SELECT 1", queries[0].SQL)
+		assert.Equal(t, int64(1), queries[0].Rows)
+		assert.False(t, queries[0].StartedAt.IsZero())
+		assert.Equal(t, "deadlock detected", queries[1].Error)
+	})
+
+	t.Run("untraced contexts are left alone", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NotPanics(t, func() { run(t.Context(), "SELECT 1", pgx.TraceQueryEndData{}) })
+	})
+}
@@ -0,0 +1,54 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+func accountRecoveryKey(tokenHash string) string {
+	return "account-recovery:" + tokenHash
+}
+
+// StoreRecoveryToken stores the hash of an account recovery token for a user with the specified TTL.
+func (s *Service) StoreRecoveryToken(ctx context.Context, tokenHash string, userID uuid.UUID, ttl time.Duration) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.Set(ctx, s.key(accountRecoveryKey(tokenHash)), userID.String(), ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to store recovery token: %w", err)
+	}
+
+	return nil
+}
+
+// TakeRecoveryToken removes an account recovery token and returns the user it was issued to, so
+// each token can only be redeemed once. Returns ErrTokenNotFound if the token does not exist or
+// has expired.
+func (s *Service) TakeRecoveryToken(ctx context.Context, tokenHash string) (uuid.UUID, error) {
+	if s == nil || s.client == nil {
+		return uuid.Nil, ErrRedisUnavailable
+	}
+
+	value, err := s.client.GetDel(ctx, s.key(accountRecoveryKey(tokenHash))).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return uuid.Nil, ErrTokenNotFound
+		}
+
+		return uuid.Nil, fmt.Errorf("failed to take recovery token: %w", err)
+	}
+
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to parse recovery token user: %w", err)
+	}
+
+	return userID, nil
+}
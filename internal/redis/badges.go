@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// badgeQueueKey is the set of users whose badges are due to be evaluated.
+const badgeQueueKey = "badges:pending"
+
+// EnqueueBadgeEvaluation queues the user's badges for evaluation.
+func (s *Service) EnqueueBadgeEvaluation(ctx context.Context, userID uuid.UUID) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.SAdd(ctx, s.key(badgeQueueKey), userID.String()).Err()
+	if err != nil {
+		return fmt.Errorf("failed to queue badge evaluation: %w", err)
+	}
+
+	return nil
+}
+
+// DequeueBadgeEvaluations removes and returns up to limit queued users. Entries that are not
+// user IDs are dropped.
+func (s *Service) DequeueBadgeEvaluations(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	if s == nil || s.client == nil {
+		return nil, ErrRedisUnavailable
+	}
+
+	members, err := s.client.SPopN(ctx, s.key(badgeQueueKey), int64(limit)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to dequeue badge evaluations: %w", err)
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(members))
+
+	for _, member := range members {
+		userID, err := uuid.Parse(member)
+		if err != nil {
+			continue
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, nil
+}
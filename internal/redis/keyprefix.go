@@ -0,0 +1,114 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+)
+
+// keyFamilies are the key names, or name prefixes, the service writes. Migrations move only these,
+// so keys of other services sharing the database are left alone.
+var keyFamilies = []string{
+	"delete-request:",
+	"email-change:",
+	"handle-reservation:",
+	"profile-share:",
+	"presence:",
+	"profile-views:",
+	"recently-viewed:",
+	"lock:",
+	"account-recovery:",
+	"request-nonce:",
+	usernameIndexKey,
+	badgeQueueKey,
+	maintenanceKey,
+}
+
+// KeyPrefix returns the namespace for the given service, environment and optional tenant, e.g.
+// "user-management-service:production:acme:".
+func KeyPrefix(service, environment, tenant string) string {
+	parts := []string{service, environment}
+	if tenant != "" {
+		parts = append(parts, tenant)
+	}
+
+	return strings.Join(parts, ":") + ":"
+}
+
+// ConfigKeyPrefix returns the namespace cfg asks for, or "" when redis.keynamespace is off.
+func ConfigKeyPrefix(cfg *config.Config) string {
+	if !cfg.Redis.KeyNamespace {
+		return ""
+	}
+
+	return KeyPrefix(cfg.Server.ServiceName, cfg.Environment, cfg.Redis.Tenant)
+}
+
+// SetKeyPrefix namespaces every key the service reads or writes, including ClearCache patterns.
+// It must be called before the service is used.
+func (s *Service) SetKeyPrefix(prefix string) {
+	s.prefix = prefix
+}
+
+// KeyPrefix returns the namespace set by SetKeyPrefix.
+func (s *Service) KeyPrefix() string {
+	return s.prefix
+}
+
+// key returns k in the service's namespace.
+func (s *Service) key(k string) string {
+	return s.prefix + k
+}
+
+// MigrateKeyPrefix renames the service's keys from the from namespace ("" for un-namespaced keys)
+// to the current one, keeping their TTLs. Keys already present in the current namespace are left
+// in place and counted as skipped. It is safe to run more than once.
+func (s *Service) MigrateKeyPrefix(ctx context.Context, from string) (moved, skipped int, err error) {
+	if s == nil || s.client == nil {
+		return 0, 0, ErrRedisUnavailable
+	}
+
+	if from == s.prefix {
+		return 0, 0, nil
+	}
+
+	for _, family := range keyFamilies {
+		var (
+			cursor uint64
+			keys   []string
+		)
+
+		for {
+			keys, cursor, err = s.client.Scan(ctx, cursor, escapePattern(from+family)+"*", defaultScanCount).Result()
+			if err != nil {
+				return moved, skipped, fmt.Errorf("failed to scan keys: %w", err)
+			}
+
+			for _, key := range keys {
+				renamed, err := s.client.RenameNX(ctx, key, s.prefix+strings.TrimPrefix(key, from)).Result()
+				if err != nil {
+					return moved, skipped, fmt.Errorf("failed to rename key %q: %w", key, err)
+				}
+
+				if renamed {
+					moved++
+				} else {
+					skipped++
+				}
+			}
+
+			if cursor == 0 {
+				break
+			}
+		}
+	}
+
+	return moved, skipped, nil
+}
+
+// escapePattern escapes the glob metacharacters of a SCAN pattern.
+func escapePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`, `]`, `\]`).Replace(s)
+}
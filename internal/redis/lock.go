@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// acquireLockScript takes the lease in KEYS[1] for owner ARGV[1] with a TTL of ARGV[2]
+// milliseconds, returning the next fencing token from KEYS[2], or 0 if the lease is held.
+var acquireLockScript = redis.NewScript(`
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return redis.call("INCR", KEYS[2])
+end
+return 0
+`)
+
+// renewLockScript extends the lease in KEYS[1] if owner ARGV[1] still holds it.
+var renewLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseLockScript deletes the lease in KEYS[1] if owner ARGV[1] still holds it.
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func lockKey(name string) string  { return "lock:" + name }
+func fenceKey(name string) string { return "lock:" + name + ":fence" }
+
+// AcquireLock takes the lease on name for owner and returns its fencing token, or 0 if another
+// owner holds it.
+func (s *Service) AcquireLock(ctx context.Context, name, owner string, ttl time.Duration) (int64, error) {
+	if s == nil || s.client == nil {
+		return 0, ErrRedisUnavailable
+	}
+
+	token, err := acquireLockScript.Run(ctx, s.client, []string{s.key(lockKey(name)), s.key(fenceKey(name))},
+		owner, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+
+	return token, nil
+}
+
+// RenewLock extends owner's lease on name by ttl and reports whether owner still held it.
+func (s *Service) RenewLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	if s == nil || s.client == nil {
+		return false, ErrRedisUnavailable
+	}
+
+	renewed, err := renewLockScript.Run(ctx, s.client, []string{s.key(lockKey(name))}, owner, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, fmt.Errorf("failed to renew lock: %w", err)
+	}
+
+	return renewed == 1, nil
+}
+
+// ReleaseLock gives up owner's lease on name. A lease held by another owner is left alone.
+func (s *Service) ReleaseLock(ctx context.Context, name, owner string) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := releaseLockScript.Run(ctx, s.client, []string{s.key(lockKey(name))}, owner).Err()
+	if err != nil {
+		return fmt.Errorf("failed to release lock: %w", err)
+	}
+
+	return nil
+}
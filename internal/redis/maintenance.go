@@ -0,0 +1,59 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// maintenanceKey holds the maintenance switch shared by every instance of the service.
+const maintenanceKey = "maintenance:mode"
+
+// GetMaintenance returns the maintenance switch, or nil if it was never set.
+func (s *Service) GetMaintenance(ctx context.Context) (*dto.MaintenanceStatus, error) {
+	if s == nil || s.client == nil {
+		return nil, ErrRedisUnavailable
+	}
+
+	payload, err := s.client.Get(ctx, s.key(maintenanceKey)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil //nolint:nilnil // never set is not an error
+		}
+
+		return nil, fmt.Errorf("failed to get maintenance mode: %w", err)
+	}
+
+	var status dto.MaintenanceStatus
+
+	err = json.Unmarshal(payload, &status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode maintenance mode: %w", err)
+	}
+
+	return &status, nil
+}
+
+// SetMaintenance replaces the maintenance switch. It does not expire.
+func (s *Service) SetMaintenance(ctx context.Context, status *dto.MaintenanceStatus) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("failed to encode maintenance mode: %w", err)
+	}
+
+	err = s.client.Set(ctx, s.key(maintenanceKey), payload, 0).Err()
+	if err != nil {
+		return fmt.Errorf("failed to store maintenance mode: %w", err)
+	}
+
+	return nil
+}
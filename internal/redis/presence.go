@@ -0,0 +1,80 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// touchPresenceScript stores the last seen time ARGV[1], in Unix milliseconds, in KEYS[1] with a
+// TTL of ARGV[2] milliseconds, unless it already holds a later one.
+var touchPresenceScript = redis.NewScript(`
+local seen = tonumber(redis.call("GET", KEYS[1]))
+if seen and seen > tonumber(ARGV[1]) then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+`)
+
+// presenceKey returns the Redis key holding when a user was last seen.
+func presenceKey(userID uuid.UUID) string {
+	return "presence:" + userID.String()
+}
+
+// TouchPresence records that the user was seen at at, remembered for ttl. Heartbeats arriving out
+// of order do not move the time back.
+func (s *Service) TouchPresence(ctx context.Context, userID uuid.UUID, at time.Time, ttl time.Duration) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := touchPresenceScript.Run(ctx, s.client, []string{s.key(presenceKey(userID))},
+		at.UnixMilli(), ttl.Milliseconds()).Err()
+	if err != nil {
+		return fmt.Errorf("failed to store presence: %w", err)
+	}
+
+	return nil
+}
+
+// GetLastSeen returns when each of the users was last seen, leaving out those not seen recently.
+func (s *Service) GetLastSeen(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	if s == nil || s.client == nil {
+		return nil, ErrRedisUnavailable
+	}
+
+	lastSeen := make(map[uuid.UUID]time.Time, len(userIDs))
+	if len(userIDs) == 0 {
+		return lastSeen, nil
+	}
+
+	keys := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		keys[i] = s.key(presenceKey(userID))
+	}
+
+	values, err := s.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presence: %w", err)
+	}
+
+	for i, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		millis, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode presence of %s: %w", userIDs[i], err)
+		}
+
+		lastSeen[userIDs[i]] = time.UnixMilli(millis)
+	}
+
+	return lastSeen, nil
+}
@@ -0,0 +1,149 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Profile view keys. Views are counted per user in the pending hash; a flush renames it to the
+// flushing hash, so views counted while the flush runs start a new pending hash.
+const (
+	profileViewsPendingKey  = "profile-views:pending"
+	profileViewsFlushingKey = "profile-views:flushing"
+)
+
+// takeProfileViewsScript returns the views set aside in KEYS[2] by an unacknowledged take, or
+// else sets KEYS[1] aside there and returns it.
+var takeProfileViewsScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[2]) == 0 then
+	if redis.call("EXISTS", KEYS[1]) == 0 then
+		return {}
+	end
+	redis.call("RENAME", KEYS[1], KEYS[2])
+end
+return redis.call("HGETALL", KEYS[2])
+`)
+
+// profileViewerKey returns the Redis key remembering that viewer saw the profile of userID.
+func profileViewerKey(userID uuid.UUID, viewer string) string {
+	return "profile-views:seen:" + userID.String() + ":" + viewer
+}
+
+// MarkProfileViewed remembers viewer for ttl and reports whether it was not already remembered.
+func (s *Service) MarkProfileViewed(
+	ctx context.Context,
+	userID uuid.UUID,
+	viewer string,
+	ttl time.Duration,
+) (bool, error) {
+	if s == nil || s.client == nil {
+		return false, ErrRedisUnavailable
+	}
+
+	first, err := s.client.SetNX(ctx, s.key(profileViewerKey(userID, viewer)), 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to mark profile view: %w", err)
+	}
+
+	return first, nil
+}
+
+// IncrementProfileViews counts one view of the user.
+func (s *Service) IncrementProfileViews(ctx context.Context, userID uuid.UUID) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.HIncrBy(ctx, s.key(profileViewsPendingKey), userID.String(), 1).Err()
+	if err != nil {
+		return fmt.Errorf("failed to count profile view: %w", err)
+	}
+
+	return nil
+}
+
+// GetPendingProfileViews returns the views of the user counted but not flushed yet, including
+// those of a flush in progress.
+func (s *Service) GetPendingProfileViews(ctx context.Context, userID uuid.UUID) (int64, error) {
+	if s == nil || s.client == nil {
+		return 0, ErrRedisUnavailable
+	}
+
+	pipe := s.client.Pipeline()
+	pending := pipe.HGet(ctx, s.key(profileViewsPendingKey), userID.String())
+	flushing := pipe.HGet(ctx, s.key(profileViewsFlushingKey), userID.String())
+
+	_, err := pipe.Exec(ctx)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, fmt.Errorf("failed to get pending profile views: %w", err)
+	}
+
+	var total int64
+
+	for _, cmd := range []*redis.StringCmd{pending, flushing} {
+		count, err := cmd.Int64()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+
+		if err != nil {
+			return 0, fmt.Errorf("failed to decode pending profile views: %w", err)
+		}
+
+		total += count
+	}
+
+	return total, nil
+}
+
+// TakeProfileViews sets the counted views aside for flushing and returns them. Fields that are
+// not user IDs are skipped.
+func (s *Service) TakeProfileViews(ctx context.Context) (map[uuid.UUID]int64, error) {
+	if s == nil || s.client == nil {
+		return nil, ErrRedisUnavailable
+	}
+
+	fields, err := takeProfileViewsScript.Run(ctx, s.client,
+		[]string{s.key(profileViewsPendingKey), s.key(profileViewsFlushingKey)}).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to take profile views: %w", err)
+	}
+
+	views := make(map[uuid.UUID]int64, len(fields)/2)
+
+	for i := 0; i+1 < len(fields); i += 2 {
+		userID, err := uuid.Parse(fields[i])
+		if err != nil {
+			continue
+		}
+
+		count, err := strconv.ParseInt(fields[i+1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode profile views of %s: %w", userID, err)
+		}
+
+		views[userID] = count
+	}
+
+	return views, nil
+}
+
+// AckProfileViews drops the views set aside by TakeProfileViews.
+func (s *Service) AckProfileViews(ctx context.Context) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.Del(ctx, s.key(profileViewsFlushingKey)).Err()
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge profile views: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+// keySchema is the shape of the keys of a family the service writes with a TTL: the part of the
+// name after the family must satisfy valid.
+type keySchema struct {
+	family string
+	valid  func(name string) bool
+}
+
+// expiringKeySchemas are the families whose keys always carry a TTL. More specific families come
+// before the families containing them.
+var expiringKeySchemas = []keySchema{
+	{family: "delete-request:", valid: isUUID},
+	{family: "email-change:", valid: isUUID},
+	{family: "handle-reservation:", valid: validation.IsValidHandle},
+	{family: "profile-share:", valid: isUUID},
+	{family: "presence:", valid: isUUID},
+	{family: "profile-views:seen:", valid: isProfileViewerName},
+	{family: "recently-viewed:", valid: isUUID},
+	{family: "account-recovery:", valid: isTokenHash},
+	{family: "request-nonce:", valid: func(name string) bool { return name != "" }},
+}
+
+// PruneResult counts the keys PruneKeys checked under one prefix.
+type PruneResult struct {
+	Prefix string
+	// Scanned is the number of keys found under the prefix.
+	Scanned int
+	// Orphaned is the number of those that match no live schema.
+	Orphaned int
+	// Deleted is the number of orphans removed; zero in a dry run.
+	Deleted int
+}
+
+// PruneKeys scans the keys under each prefix in the service's namespace and removes the orphans:
+// keys of no family the service writes, left behind when a family is renamed or retired, and keys
+// of an expiring family whose name does not fit it or that have no TTL. Keys of other live
+// families, such as locks and the badge queue, are never removed. With dryRun orphans are only
+// counted.
+func (s *Service) PruneKeys(ctx context.Context, prefixes []string, dryRun bool) ([]PruneResult, error) {
+	if s == nil || s.client == nil {
+		return nil, ErrRedisUnavailable
+	}
+
+	results := make([]PruneResult, 0, len(prefixes))
+
+	for _, prefix := range prefixes {
+		result, err := s.pruneKeys(ctx, prefix, dryRun)
+		results = append(results, result)
+
+		if err != nil {
+			return results, err
+		}
+	}
+
+	return results, nil
+}
+
+func (s *Service) pruneKeys(ctx context.Context, prefix string, dryRun bool) (PruneResult, error) {
+	result := PruneResult{Prefix: prefix}
+
+	var cursor uint64
+
+	for {
+		keys, next, err := s.client.Scan(ctx, cursor, escapePattern(s.key(prefix))+"*", defaultScanCount).Result()
+		if err != nil {
+			return result, fmt.Errorf("failed to scan keys: %w", err)
+		}
+
+		result.Scanned += len(keys)
+
+		orphans, err := s.orphanedKeys(ctx, keys)
+		if err != nil {
+			return result, err
+		}
+
+		result.Orphaned += len(orphans)
+
+		if !dryRun && len(orphans) > 0 {
+			deleted, err := s.client.Unlink(ctx, orphans...).Result()
+			if err != nil {
+				return result, fmt.Errorf("failed to delete orphaned keys: %w", err)
+			}
+
+			result.Deleted += int(deleted)
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return result, nil
+		}
+	}
+}
+
+// orphanedKeys returns the keys, in the service's namespace, that match no live schema.
+func (s *Service) orphanedKeys(ctx context.Context, keys []string) ([]string, error) {
+	var (
+		orphans  []string
+		expiring []string
+	)
+
+	for _, key := range keys {
+		name := strings.TrimPrefix(key, s.prefix)
+
+		schema, ok := expiringKeySchema(name)
+
+		switch {
+		case ok && schema.valid(strings.TrimPrefix(name, schema.family)):
+			expiring = append(expiring, key)
+		case ok || !isLiveKey(name):
+			orphans = append(orphans, key)
+		}
+	}
+
+	if len(expiring) == 0 {
+		return orphans, nil
+	}
+
+	// A well-formed key without a TTL would never expire
+	ttls := make([]*redis.DurationCmd, len(expiring))
+
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range expiring {
+			ttls[i] = pipe.TTL(ctx, key)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key TTLs: %w", err)
+	}
+
+	for i, key := range expiring {
+		// -1 means no TTL; -2, a key that expired since the scan, is left to Redis
+		if ttls[i].Val() == -1 {
+			orphans = append(orphans, key)
+		}
+	}
+
+	return orphans, nil
+}
+
+func expiringKeySchema(name string) (keySchema, bool) {
+	for _, schema := range expiringKeySchemas {
+		if strings.HasPrefix(name, schema.family) {
+			return schema, true
+		}
+	}
+
+	return keySchema{}, false
+}
+
+// isLiveKey reports whether name belongs to a family the service still writes.
+func isLiveKey(name string) bool {
+	for _, family := range keyFamilies {
+		if strings.HasPrefix(name, family) {
+			return true
+		}
+	}
+
+	for _, family := range []string{searchGenerationKey, searchResultsKeyPrefix, publicProfilesKey} {
+		if strings.HasPrefix(name, family) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isUUID reports whether name is a user ID as the service writes it.
+func isUUID(name string) bool {
+	id, err := uuid.Parse(name)
+
+	return err == nil && id.String() == name
+}
+
+// isProfileViewerName reports whether name is a profile's user ID followed by a viewer.
+func isProfileViewerName(name string) bool {
+	userID, viewer, ok := strings.Cut(name, ":")
+
+	return ok && isUUID(userID) && viewer != ""
+}
+
+// isTokenHash reports whether name is a hex-encoded SHA-256 hash.
+func isTokenHash(name string) bool {
+	if len(name) != 64 { //nolint:mnd // the length of a hex-encoded SHA-256 hash
+		return false
+	}
+
+	for _, r := range name {
+		if !((r >= '0' && r <= '9') || (r >= 'a' && r <= 'f')) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,119 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// publicProfilesKey is the hash of projected public profiles, keyed by user ID.
+const publicProfilesKey = "profiles:public"
+
+// publicProfileCursorKey holds the sequence of the last user change applied to the projection.
+const publicProfileCursorKey = "profiles:public:cursor"
+
+// GetPublicProfile returns the projected public profile of a user, or nil if there is none.
+func (s *Service) GetPublicProfile(ctx context.Context, userID uuid.UUID) (*dto.PublicProfile, error) {
+	if s == nil || s.client == nil {
+		return nil, ErrRedisUnavailable
+	}
+
+	payload, err := s.client.HGet(ctx, s.key(publicProfilesKey), userID.String()).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil //nolint:nilnil // a miss is not an error
+		}
+
+		return nil, fmt.Errorf("failed to get public profile: %w", err)
+	}
+
+	var profile dto.PublicProfile
+
+	err = json.Unmarshal(payload, &profile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public profile: %w", err)
+	}
+
+	return &profile, nil
+}
+
+// SetPublicProfiles stores projected public profiles, replacing those of the same users.
+func (s *Service) SetPublicProfiles(ctx context.Context, profiles []dto.PublicProfile) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	fields := make([]any, 0, 2*len(profiles)) //nolint:mnd // a field and a value per profile
+
+	for _, profile := range profiles {
+		payload, err := json.Marshal(profile)
+		if err != nil {
+			return fmt.Errorf("failed to encode public profile: %w", err)
+		}
+
+		fields = append(fields, profile.UserID, payload)
+	}
+
+	err := s.client.HSet(ctx, s.key(publicProfilesKey), fields...).Err()
+	if err != nil {
+		return fmt.Errorf("failed to store public profiles: %w", err)
+	}
+
+	return nil
+}
+
+// DeletePublicProfile drops the projected public profile of a user, if any.
+func (s *Service) DeletePublicProfile(ctx context.Context, userID uuid.UUID) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.HDel(ctx, s.key(publicProfilesKey), userID.String()).Err()
+	if err != nil {
+		return fmt.Errorf("failed to delete public profile: %w", err)
+	}
+
+	return nil
+}
+
+// GetPublicProfileCursor returns the sequence of the last user change applied, or zero.
+func (s *Service) GetPublicProfileCursor(ctx context.Context) (int64, error) {
+	if s == nil || s.client == nil {
+		return 0, ErrRedisUnavailable
+	}
+
+	cursor, err := s.client.Get(ctx, s.key(publicProfileCursorKey)).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("failed to get public profile cursor: %w", err)
+	}
+
+	return cursor, nil
+}
+
+// SetPublicProfileCursor records the sequence of the last user change applied.
+func (s *Service) SetPublicProfileCursor(ctx context.Context, cursor int64) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.Set(ctx, s.key(publicProfileCursorKey), cursor, 0).Err()
+	if err != nil {
+		return fmt.Errorf("failed to store public profile cursor: %w", err)
+	}
+
+	return nil
+}
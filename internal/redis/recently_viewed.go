@@ -0,0 +1,86 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// recentlyViewedKey returns the Redis key listing the profiles a user recently viewed, most
+// recent first.
+func recentlyViewedKey(userID uuid.UUID) string {
+	return "recently-viewed:" + userID.String()
+}
+
+// PushRecentlyViewed moves viewedID to the front of the list of userID, keeping at most limit
+// profiles, and keeps the list for ttl.
+func (s *Service) PushRecentlyViewed(
+	ctx context.Context,
+	userID, viewedID uuid.UUID,
+	limit int,
+	ttl time.Duration,
+) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	key := s.key(recentlyViewedKey(userID))
+	member := viewedID.String()
+
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LRem(ctx, key, 0, member)
+		pipe.LPush(ctx, key, member)
+		pipe.LTrim(ctx, key, 0, int64(limit)-1)
+		pipe.Expire(ctx, key, ttl)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store recently viewed profile: %w", err)
+	}
+
+	return nil
+}
+
+// GetRecentlyViewed returns the list of userID, most recent first. Entries that are not user IDs
+// are skipped.
+func (s *Service) GetRecentlyViewed(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	if s == nil || s.client == nil {
+		return nil, ErrRedisUnavailable
+	}
+
+	members, err := s.client.LRange(ctx, s.key(recentlyViewedKey(userID)), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recently viewed profiles: %w", err)
+	}
+
+	viewed := make([]uuid.UUID, 0, len(members))
+
+	for _, member := range members {
+		viewedID, err := uuid.Parse(member)
+		if err != nil {
+			continue
+		}
+
+		viewed = append(viewed, viewedID)
+	}
+
+	return viewed, nil
+}
+
+// ClearRecentlyViewed drops the list of userID.
+func (s *Service) ClearRecentlyViewed(ctx context.Context, userID uuid.UUID) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.Del(ctx, s.key(recentlyViewedKey(userID))).Err()
+	if err != nil {
+		return fmt.Errorf("failed to clear recently viewed profiles: %w", err)
+	}
+
+	return nil
+}
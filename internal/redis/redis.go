@@ -3,6 +3,7 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -24,6 +25,8 @@ type Service struct {
 	client     *redis.Client
 	prevStatus string
 	mu         sync.Mutex
+	// prefix namespaces every key; see SetKeyPrefix.
+	prefix string
 }
 
 var Instance *Service
@@ -156,7 +159,7 @@ func (s *Service) StoreDeleteToken(ctx context.Context, userID uuid.UUID, token
 		return ErrRedisUnavailable
 	}
 
-	key := deleteTokenKey(userID)
+	key := s.key(deleteTokenKey(userID))
 
 	err := s.client.Set(ctx, key, token, ttl).Err()
 	if err != nil {
@@ -173,7 +176,7 @@ func (s *Service) GetDeleteToken(ctx context.Context, userID uuid.UUID) (string,
 		return "", ErrRedisUnavailable
 	}
 
-	key := deleteTokenKey(userID)
+	key := s.key(deleteTokenKey(userID))
 
 	token, err := s.client.Get(ctx, key).Result()
 	if err != nil {
@@ -193,7 +196,7 @@ func (s *Service) DeleteDeleteToken(ctx context.Context, userID uuid.UUID) error
 		return ErrRedisUnavailable
 	}
 
-	key := deleteTokenKey(userID)
+	key := s.key(deleteTokenKey(userID))
 
 	err := s.client.Del(ctx, key).Err()
 	if err != nil {
@@ -203,6 +206,232 @@ func (s *Service) DeleteDeleteToken(ctx context.Context, userID uuid.UUID) error
 	return nil
 }
 
+// emailChangeKey returns the Redis key for storing a user's pending email change.
+func emailChangeKey(userID uuid.UUID) string {
+	return "email-change:" + userID.String()
+}
+
+// StoreEmailChange stores a pending email change for a user with the specified TTL.
+// If a pending change already exists for the user, it will be replaced.
+func (s *Service) StoreEmailChange(
+	ctx context.Context,
+	userID uuid.UUID,
+	change *dto.PendingEmailChange,
+	ttl time.Duration,
+) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("failed to encode email change: %w", err)
+	}
+
+	err = s.client.Set(ctx, s.key(emailChangeKey(userID)), payload, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to store email change: %w", err)
+	}
+
+	return nil
+}
+
+// GetEmailChange retrieves the pending email change for a user.
+// Returns ErrTokenNotFound if no change is pending or it has expired.
+func (s *Service) GetEmailChange(ctx context.Context, userID uuid.UUID) (*dto.PendingEmailChange, error) {
+	if s == nil || s.client == nil {
+		return nil, ErrRedisUnavailable
+	}
+
+	payload, err := s.client.Get(ctx, s.key(emailChangeKey(userID))).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrTokenNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get email change: %w", err)
+	}
+
+	var change dto.PendingEmailChange
+
+	err = json.Unmarshal(payload, &change)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode email change: %w", err)
+	}
+
+	return &change, nil
+}
+
+// DeleteEmailChange removes the pending email change for a user.
+func (s *Service) DeleteEmailChange(ctx context.Context, userID uuid.UUID) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.Del(ctx, s.key(emailChangeKey(userID))).Err()
+	if err != nil {
+		return fmt.Errorf("failed to delete email change: %w", err)
+	}
+
+	return nil
+}
+
+// handleReservationKey returns the Redis key for a handle reservation.
+func handleReservationKey(handle string) string {
+	return "handle-reservation:" + handle
+}
+
+// ReserveHandle places a hold on a handle for a user with the specified TTL.
+// Returns false if another user already holds the handle. Re-reserving a handle
+// the user already holds refreshes the TTL.
+func (s *Service) ReserveHandle(ctx context.Context, handle string, userID uuid.UUID, ttl time.Duration) (bool, error) {
+	if s == nil || s.client == nil {
+		return false, ErrRedisUnavailable
+	}
+
+	key := s.key(handleReservationKey(handle))
+
+	ok, err := s.client.SetNX(ctx, key, userID.String(), ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve handle: %w", err)
+	}
+
+	if ok {
+		return true, nil
+	}
+
+	holder, err := s.GetHandleReservation(ctx, handle)
+	if err != nil {
+		if errors.Is(err, ErrTokenNotFound) {
+			// Reservation expired between SETNX and GET; try once more
+			ok, err = s.client.SetNX(ctx, key, userID.String(), ttl).Result()
+			if err != nil {
+				return false, fmt.Errorf("failed to reserve handle: %w", err)
+			}
+
+			return ok, nil
+		}
+
+		return false, err
+	}
+
+	if holder != userID {
+		return false, nil
+	}
+
+	err = s.client.Expire(ctx, key, ttl).Err()
+	if err != nil {
+		return false, fmt.Errorf("failed to refresh handle reservation: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetHandleReservation returns the user currently holding a handle.
+// Returns ErrTokenNotFound if the handle is not reserved.
+func (s *Service) GetHandleReservation(ctx context.Context, handle string) (uuid.UUID, error) {
+	if s == nil || s.client == nil {
+		return uuid.Nil, ErrRedisUnavailable
+	}
+
+	value, err := s.client.Get(ctx, s.key(handleReservationKey(handle))).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return uuid.Nil, ErrTokenNotFound
+		}
+
+		return uuid.Nil, fmt.Errorf("failed to get handle reservation: %w", err)
+	}
+
+	holder, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid handle reservation value: %w", err)
+	}
+
+	return holder, nil
+}
+
+// ReleaseHandleReservation removes a handle reservation if it is held by the given user.
+func (s *Service) ReleaseHandleReservation(ctx context.Context, handle string, userID uuid.UUID) error {
+	holder, err := s.GetHandleReservation(ctx, handle)
+	if err != nil {
+		if errors.Is(err, ErrTokenNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if holder != userID {
+		return nil
+	}
+
+	err = s.client.Del(ctx, s.key(handleReservationKey(handle))).Err()
+	if err != nil {
+		return fmt.Errorf("failed to release handle reservation: %w", err)
+	}
+
+	return nil
+}
+
+// profileShareKey returns the Redis key for storing a user's active profile share token ID.
+func profileShareKey(userID uuid.UUID) string {
+	return "profile-share:" + userID.String()
+}
+
+// StoreProfileShareToken stores the ID of a user's active profile share token with the specified TTL.
+// Any previously issued share token for the user is superseded.
+func (s *Service) StoreProfileShareToken(
+	ctx context.Context,
+	userID uuid.UUID,
+	tokenID string,
+	ttl time.Duration,
+) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.Set(ctx, s.key(profileShareKey(userID)), tokenID, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to store profile share token: %w", err)
+	}
+
+	return nil
+}
+
+// GetProfileShareToken retrieves the ID of a user's active profile share token.
+// Returns ErrTokenNotFound if no share token is active.
+func (s *Service) GetProfileShareToken(ctx context.Context, userID uuid.UUID) (string, error) {
+	if s == nil || s.client == nil {
+		return "", ErrRedisUnavailable
+	}
+
+	tokenID, err := s.client.Get(ctx, s.key(profileShareKey(userID))).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrTokenNotFound
+		}
+
+		return "", fmt.Errorf("failed to get profile share token: %w", err)
+	}
+
+	return tokenID, nil
+}
+
+// DeleteProfileShareToken revokes a user's active profile share token.
+func (s *Service) DeleteProfileShareToken(ctx context.Context, userID uuid.UUID) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.Del(ctx, s.key(profileShareKey(userID))).Err()
+	if err != nil {
+		return fmt.Errorf("failed to delete profile share token: %w", err)
+	}
+
+	return nil
+}
+
 // GetCacheMetrics retrieves cache statistics from Redis.
 func (s *Service) GetCacheMetrics(ctx context.Context) (*dto.CacheMetricsResponse, error) {
 	if s == nil || s.client == nil {
@@ -311,7 +540,7 @@ var ErrRedisUnavailable = errors.New("redis is unavailable")
 // ErrTokenNotFound is returned when a token does not exist.
 var ErrTokenNotFound = errors.New("token not found")
 
-// ClearCache clears keys matching the given pattern.
+// ClearCache clears keys matching the given pattern within the service's key namespace.
 // It uses SCAN to find keys and DEL to remove them in batches.
 func (s *Service) ClearCache(ctx context.Context, pattern string) (int, error) {
 	if s == nil || s.client == nil {
@@ -331,6 +560,8 @@ func (s *Service) ClearCache(ctx context.Context, pattern string) (int, error) {
 		pattern = "*"
 	}
 
+	pattern = escapePattern(s.prefix) + pattern
+
 	for {
 		keys, cursor, err = s.client.Scan(ctx, cursor, pattern, defaultScanCount).Result()
 		if err != nil {
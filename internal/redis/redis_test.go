@@ -4,9 +4,12 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -114,3 +117,903 @@ func TestClearCache(t *testing.T) {
 	require.NoError(t, err)
 	assert.GreaterOrEqual(t, count, 1) // At least session:1
 }
+
+func TestEmailChangeStore(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	// Missing change
+	_, err = svc.GetEmailChange(ctx, userID)
+	require.ErrorIs(t, err, ErrTokenNotFound)
+
+	// Round trip
+	change := &dto.PendingEmailChange{
+		OldEmail: "old@example.com",
+		NewEmail: "new@example.com",
+		OldToken: "old-token",
+		NewToken: "new-token",
+	}
+	err = svc.StoreEmailChange(ctx, userID, change, time.Hour)
+	require.NoError(t, err)
+
+	stored, err := svc.GetEmailChange(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, change.NewEmail, stored.NewEmail)
+	assert.Equal(t, change.OldToken, stored.OldToken)
+
+	// Expiry drops the pending change
+	mr.FastForward(2 * time.Hour)
+
+	_, err = svc.GetEmailChange(ctx, userID)
+	require.ErrorIs(t, err, ErrTokenNotFound)
+
+	// Delete
+	err = svc.StoreEmailChange(ctx, userID, change, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, svc.DeleteEmailChange(ctx, userID))
+
+	_, err = svc.GetEmailChange(ctx, userID)
+	require.ErrorIs(t, err, ErrTokenNotFound)
+}
+
+func TestHandleReservationStore(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	owner := uuid.New()
+	other := uuid.New()
+
+	// First reservation wins
+	ok, err := svc.ReserveHandle(ctx, "chef-jane", owner, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// Another user is blocked while the hold is active
+	ok, err = svc.ReserveHandle(ctx, "chef-jane", other, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// The holder can refresh their own hold
+	ok, err = svc.ReserveHandle(ctx, "chef-jane", owner, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	holder, err := svc.GetHandleReservation(ctx, "chef-jane")
+	require.NoError(t, err)
+	assert.Equal(t, owner, holder)
+
+	// Releasing as a non-holder is a no-op
+	require.NoError(t, svc.ReleaseHandleReservation(ctx, "chef-jane", other))
+
+	holder, err = svc.GetHandleReservation(ctx, "chef-jane")
+	require.NoError(t, err)
+	assert.Equal(t, owner, holder)
+
+	// Hold expires
+	mr.FastForward(2 * time.Minute)
+
+	_, err = svc.GetHandleReservation(ctx, "chef-jane")
+	require.ErrorIs(t, err, ErrTokenNotFound)
+
+	ok, err = svc.ReserveHandle(ctx, "chef-jane", other, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestProfileShareStore(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	_, err = svc.GetProfileShareToken(ctx, userID)
+	require.ErrorIs(t, err, ErrTokenNotFound)
+
+	require.NoError(t, svc.StoreProfileShareToken(ctx, userID, "first", time.Minute))
+	require.NoError(t, svc.StoreProfileShareToken(ctx, userID, "second", time.Minute))
+
+	tokenID, err := svc.GetProfileShareToken(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "second", tokenID)
+
+	// Revocation
+	require.NoError(t, svc.DeleteProfileShareToken(ctx, userID))
+
+	_, err = svc.GetProfileShareToken(ctx, userID)
+	require.ErrorIs(t, err, ErrTokenNotFound)
+
+	// Expiry
+	require.NoError(t, svc.StoreProfileShareToken(ctx, userID, "third", time.Minute))
+	mr.FastForward(2 * time.Minute)
+
+	_, err = svc.GetProfileShareToken(ctx, userID)
+	require.ErrorIs(t, err, ErrTokenNotFound)
+}
+
+func TestAccountRecoveryStore(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	require.NoError(t, svc.StoreRecoveryToken(ctx, "hash", userID, time.Minute))
+
+	taken, err := svc.TakeRecoveryToken(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, userID, taken)
+
+	// Tokens are single use
+	_, err = svc.TakeRecoveryToken(ctx, "hash")
+	require.ErrorIs(t, err, ErrTokenNotFound)
+
+	// Expiry
+	require.NoError(t, svc.StoreRecoveryToken(ctx, "other", userID, time.Minute))
+	mr.FastForward(2 * time.Minute)
+
+	_, err = svc.TakeRecoveryToken(ctx, "other")
+	require.ErrorIs(t, err, ErrTokenNotFound)
+}
+
+func TestUsernameIndex(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	chef := dto.UserTypeaheadResult{UserID: uuid.NewString(), Username: "Chef"}
+	chloe := dto.UserTypeaheadResult{UserID: uuid.NewString(), Username: "chloe"}
+	ab := dto.UserTypeaheadResult{UserID: uuid.NewString(), Username: "ab"}
+	abc := dto.UserTypeaheadResult{UserID: uuid.NewString(), Username: "abc"}
+
+	require.NoError(t, svc.StageUsernames(ctx, "first", []dto.UserTypeaheadResult{chloe, chef}))
+	require.NoError(t, svc.StageUsernames(ctx, "first", []dto.UserTypeaheadResult{abc, ab}))
+
+	// Staged users are not served until the build is published
+	results, err := svc.MatchUsernamePrefix(ctx, "ch", 10)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	require.NoError(t, svc.PublishUsernames(ctx, "first"))
+
+	results, err = svc.MatchUsernamePrefix(ctx, "CH", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.UserTypeaheadResult{chef, chloe}, results)
+
+	results, err = svc.MatchUsernamePrefix(ctx, "ab", 1)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.UserTypeaheadResult{ab}, results)
+
+	// A discarded build leaves the index alone
+	require.NoError(t, svc.StageUsernames(ctx, "second", []dto.UserTypeaheadResult{chef}))
+	require.NoError(t, svc.DiscardUsernames(ctx, "second"))
+
+	results, err = svc.MatchUsernamePrefix(ctx, "", 10)
+	require.NoError(t, err)
+	assert.Len(t, results, 4)
+
+	// Publishing an empty build empties the index
+	require.NoError(t, svc.PublishUsernames(ctx, "third"))
+
+	results, err = svc.MatchUsernamePrefix(ctx, "", 10)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestMaintenance(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+
+	status, err := svc.GetMaintenance(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, status)
+
+	require.NoError(t, svc.SetMaintenance(ctx, &dto.MaintenanceStatus{Enabled: true, Message: "migrating"}))
+
+	status, err = svc.GetMaintenance(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &dto.MaintenanceStatus{Enabled: true, Message: "migrating"}, status)
+	assert.Equal(t, time.Duration(0), mr.TTL(maintenanceKey), "the switch does not expire")
+}
+
+func TestLock(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+
+	token, err := svc.AcquireLock(ctx, "jobs:purge", "replica-a", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), token)
+	assert.Equal(t, time.Minute, mr.TTL(lockKey("jobs:purge")))
+
+	// Held: neither another owner nor the holder can take it again
+	for _, owner := range []string{"replica-b", "replica-a"} {
+		token, err = svc.AcquireLock(ctx, "jobs:purge", owner, time.Minute)
+		require.NoError(t, err)
+		assert.Zero(t, token, owner)
+	}
+
+	renewed, err := svc.RenewLock(ctx, "jobs:purge", "replica-b", time.Hour)
+	require.NoError(t, err)
+	assert.False(t, renewed, "only the holder renews")
+
+	renewed, err = svc.RenewLock(ctx, "jobs:purge", "replica-a", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, renewed)
+	assert.Equal(t, time.Hour, mr.TTL(lockKey("jobs:purge")))
+
+	require.NoError(t, svc.ReleaseLock(ctx, "jobs:purge", "replica-b"))
+	assert.True(t, mr.Exists(lockKey("jobs:purge")), "only the holder releases")
+
+	require.NoError(t, svc.ReleaseLock(ctx, "jobs:purge", "replica-a"))
+
+	token, err = svc.AcquireLock(ctx, "jobs:purge", "replica-b", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), token, "fencing tokens increase with every lease")
+}
+
+func TestPresence(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+	seen := time.Now().Truncate(time.Millisecond)
+
+	require.NoError(t, svc.TouchPresence(ctx, alice, seen, time.Hour))
+	require.NoError(t, svc.TouchPresence(ctx, alice, seen.Add(-time.Minute), 2*time.Hour))
+
+	lastSeen, err := svc.GetLastSeen(ctx, []uuid.UUID{alice, bob})
+	require.NoError(t, err)
+	assert.Len(t, lastSeen, 1, "users never seen are left out")
+	assert.True(t, seen.Equal(lastSeen[alice]), "an older heartbeat does not move the time back")
+	assert.Equal(t, 2*time.Hour, mr.TTL(presenceKey(alice)), "every heartbeat extends the retention")
+
+	mr.FastForward(3 * time.Hour)
+
+	lastSeen, err = svc.GetLastSeen(ctx, []uuid.UUID{alice})
+	require.NoError(t, err)
+	assert.Empty(t, lastSeen)
+}
+
+func TestProfileViews(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+
+	svc, err := New(&config.RedisConfig{Host: mr.Host(), Port: port})
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+
+	first, err := svc.MarkProfileViewed(ctx, alice, "viewer", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, first)
+
+	first, err = svc.MarkProfileViewed(ctx, alice, "viewer", time.Hour)
+	require.NoError(t, err)
+	assert.False(t, first, "a viewer is remembered for the TTL")
+
+	mr.FastForward(2 * time.Hour)
+
+	first, err = svc.MarkProfileViewed(ctx, alice, "viewer", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, first, "and forgotten after")
+
+	views, err := svc.TakeProfileViews(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, views, "nothing counted")
+
+	require.NoError(t, svc.IncrementProfileViews(ctx, alice))
+	require.NoError(t, svc.IncrementProfileViews(ctx, alice))
+	require.NoError(t, svc.IncrementProfileViews(ctx, bob))
+
+	views, err = svc.TakeProfileViews(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int64{alice: 2, bob: 1}, views)
+
+	// Views counted during the flush wait for the next one, and are pending meanwhile
+	require.NoError(t, svc.IncrementProfileViews(ctx, alice))
+
+	pending, err := svc.GetPendingProfileViews(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), pending)
+
+	views, err = svc.TakeProfileViews(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int64{alice: 2, bob: 1}, views, "an unacknowledged take is retried")
+
+	require.NoError(t, svc.AckProfileViews(ctx))
+
+	views, err = svc.TakeProfileViews(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int64{alice: 1}, views)
+
+	pending, err = svc.GetPendingProfileViews(ctx, bob)
+	require.NoError(t, err)
+	assert.Zero(t, pending)
+}
+
+func TestRecentlyViewed(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+
+	svc, err := New(&config.RedisConfig{Host: mr.Host(), Port: port})
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	alice, bob, carol, dave := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	viewed, err := svc.GetRecentlyViewed(ctx, alice)
+	require.NoError(t, err)
+	assert.Empty(t, viewed)
+
+	for _, viewedID := range []uuid.UUID{bob, carol, bob, dave} {
+		require.NoError(t, svc.PushRecentlyViewed(ctx, alice, viewedID, 2, time.Hour))
+	}
+
+	// Viewing a profile again moves it to the front; the oldest fall off past the limit
+	viewed, err = svc.GetRecentlyViewed(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{dave, bob}, viewed)
+
+	require.NoError(t, svc.ClearRecentlyViewed(ctx, alice))
+
+	viewed, err = svc.GetRecentlyViewed(ctx, alice)
+	require.NoError(t, err)
+	assert.Empty(t, viewed)
+
+	require.NoError(t, svc.PushRecentlyViewed(ctx, alice, bob, 2, time.Hour))
+	mr.FastForward(2 * time.Hour)
+
+	viewed, err = svc.GetRecentlyViewed(ctx, alice)
+	require.NoError(t, err)
+	assert.Empty(t, viewed, "the list expires with the retention")
+}
+
+func TestSearchCache(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	results := &dto.UserSearchResponse{
+		Results:    []dto.UserSearchResult{{UserID: uuid.NewString(), Username: "remy"}},
+		TotalCount: 1,
+		Limit:      20,
+	}
+
+	cached, err := svc.GetSearchResults(ctx, "q")
+	require.NoError(t, err)
+	assert.Nil(t, cached)
+
+	require.NoError(t, svc.SetSearchResults(ctx, "q", results, time.Minute))
+
+	cached, err = svc.GetSearchResults(ctx, "q")
+	require.NoError(t, err)
+	assert.Equal(t, results, cached)
+	assert.Equal(t, time.Minute, mr.TTL(searchResultsKeyPrefix+"0:q"))
+
+	require.NoError(t, svc.InvalidateSearchCache(ctx))
+
+	cached, err = svc.GetSearchResults(ctx, "q")
+	require.NoError(t, err)
+	assert.Nil(t, cached, "invalidation drops earlier results")
+
+	require.NoError(t, svc.SetSearchResults(ctx, "q", results, time.Minute))
+	mr.FastForward(2 * time.Minute)
+
+	cached, err = svc.GetSearchResults(ctx, "q")
+	require.NoError(t, err)
+	assert.Nil(t, cached, "results expire")
+}
+
+func TestPublicProfiles(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	profile := dto.PublicProfile{
+		UserID:        userID.String(),
+		IsActive:      true,
+		Public:        true,
+		Profile:       &dto.UserProfileResponse{UserID: userID.String(), Username: "remy", IsActive: true},
+		FollowerCount: 3,
+		BuiltAt:       time.Now().UTC().Truncate(time.Second),
+	}
+
+	projected, err := svc.GetPublicProfile(ctx, userID)
+	require.NoError(t, err)
+	assert.Nil(t, projected)
+
+	require.NoError(t, svc.SetPublicProfiles(ctx, []dto.PublicProfile{profile}))
+
+	projected, err = svc.GetPublicProfile(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, &profile, projected)
+
+	cursor, err := svc.GetPublicProfileCursor(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, cursor)
+
+	require.NoError(t, svc.SetPublicProfileCursor(ctx, 42))
+
+	cursor, err = svc.GetPublicProfileCursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), cursor)
+}
+
+func TestBadgeQueue(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+
+	require.NoError(t, svc.EnqueueBadgeEvaluation(ctx, alice))
+	require.NoError(t, svc.EnqueueBadgeEvaluation(ctx, bob))
+	require.NoError(t, svc.EnqueueBadgeEvaluation(ctx, alice))
+
+	first, err := svc.DequeueBadgeEvaluations(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, first, 1)
+
+	rest, err := svc.DequeueBadgeEvaluations(ctx, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{alice, bob}, append(first, rest...), "a user queued twice is dequeued once")
+
+	empty, err := svc.DequeueBadgeEvaluations(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "user-management-service:production:", KeyPrefix("user-management-service", "production", ""))
+	assert.Equal(t, "ums:staging:acme:", KeyPrefix("ums", "staging", "acme"))
+
+	cfg := &config.Config{Environment: "production"}
+	cfg.Server.ServiceName = "ums"
+	cfg.Redis.Tenant = "acme"
+	assert.Empty(t, ConfigKeyPrefix(cfg), "namespacing is off by default")
+
+	cfg.Redis.KeyNamespace = true
+	assert.Equal(t, "ums:production:acme:", ConfigKeyPrefix(cfg))
+}
+
+func TestNamespacedKeys(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	svc.SetKeyPrefix("ums:production:")
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	require.NoError(t, svc.StoreDeleteToken(ctx, userID, "token", time.Hour))
+	assert.True(t, mr.Exists("ums:production:delete-request:"+userID.String()))
+	assert.False(t, mr.Exists("delete-request:"+userID.String()))
+
+	token, err := svc.GetDeleteToken(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
+
+	require.NoError(t, mr.Set("delete-request:other", "global"))
+
+	count, err := svc.ClearCache(ctx, "delete-request:*")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "only keys in the namespace are cleared")
+	assert.True(t, mr.Exists("delete-request:other"))
+}
+
+func TestMigrateKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	// Written before namespacing was turned on
+	require.NoError(t, svc.StoreDeleteToken(ctx, userID, "token", time.Hour))
+	require.NoError(t, svc.EnqueueBadgeEvaluation(ctx, userID))
+	require.NoError(t, mr.Set("maintenance:mode", "stale"))
+	require.NoError(t, mr.Set("ums:production:maintenance:mode", "current"))
+	require.NoError(t, mr.Set("session:other-service", "untouched"))
+
+	svc.SetKeyPrefix("ums:production:")
+
+	moved, skipped, err := svc.MigrateKeyPrefix(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, 2, moved)
+	assert.Equal(t, 1, skipped, "a key already in the namespace is not overwritten")
+
+	token, err := svc.GetDeleteToken(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
+	assert.Positive(t, mr.TTL("ums:production:delete-request:"+userID.String()), "the TTL is kept")
+
+	queued, err := svc.DequeueBadgeEvaluations(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{userID}, queued)
+
+	value, err := mr.Get("ums:production:maintenance:mode")
+	require.NoError(t, err)
+	assert.Equal(t, "current", value)
+	assert.True(t, mr.Exists("session:other-service"), "other services' keys are left alone")
+
+	// Moving to a new tenant, and re-running, only touches the old namespace
+	svc.SetKeyPrefix("ums:production:acme:")
+
+	moved, _, err = svc.MigrateKeyPrefix(ctx, "ums:production:")
+	require.NoError(t, err)
+	assert.Equal(t, 2, moved, "the delete token and maintenance switch; the badge queue was drained")
+
+	moved, skipped, err = svc.MigrateKeyPrefix(ctx, "ums:production:")
+	require.NoError(t, err)
+	assert.Zero(t, moved)
+	assert.Zero(t, skipped)
+}
+
+func TestReserveNonce(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+
+	svc, err := New(&config.RedisConfig{Host: mr.Host(), Port: port})
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	ctx := context.Background()
+
+	ok, err := svc.ReserveNonce(ctx, "abc", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = svc.ReserveNonce(ctx, "abc", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok, "a nonce is accepted once")
+
+	mr.FastForward(2 * time.Minute)
+
+	ok, err = svc.ReserveNonce(ctx, "abc", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok, "expired nonces are forgotten")
+}
+
+func TestPruneKeys(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer mr.Close()
+
+	port, _ := strconv.Atoi(mr.Port())
+	cfg := &config.RedisConfig{
+		Host:     mr.Host(),
+		Port:     port,
+		Database: 0,
+	}
+
+	svc, err := New(cfg)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, svc.Close())
+	}()
+
+	svc.SetKeyPrefix("ums:production:")
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	require.NoError(t, svc.StoreDeleteToken(ctx, userID, "token", time.Hour))
+	require.NoError(t, mr.Set("ums:production:delete-request:not-a-uuid", "stale"))
+	require.NoError(t, mr.Set("ums:production:delete-request:"+uuid.NewString(), "no ttl"))
+	require.NoError(t, mr.Set("ums:production:delete-token:"+userID.String(), "retired prefix"))
+	require.NoError(t, mr.Set("ums:production:lock:search-reindex", "owner"))
+	require.NoError(t, mr.Set("delete-request:"+uuid.NewString(), "outside the namespace"))
+
+	prefixes := []string{"delete-request:", "delete-token:", "lock:"}
+
+	results, err := svc.PruneKeys(ctx, prefixes, true)
+	require.NoError(t, err)
+	assert.Equal(t, []PruneResult{
+		{Prefix: "delete-request:", Scanned: 3, Orphaned: 2},
+		{Prefix: "delete-token:", Scanned: 1, Orphaned: 1},
+		{Prefix: "lock:", Scanned: 1},
+	}, results)
+	assert.True(t, mr.Exists("ums:production:delete-request:not-a-uuid"), "a dry run only counts")
+
+	results, err = svc.PruneKeys(ctx, prefixes, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, results[0].Deleted)
+	assert.Equal(t, 1, results[1].Deleted)
+
+	token, err := svc.GetDeleteToken(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "token", token, "live keys are kept")
+	assert.False(t, mr.Exists("ums:production:delete-request:not-a-uuid"))
+	assert.False(t, mr.Exists("ums:production:delete-token:"+userID.String()))
+	assert.True(t, mr.Exists("ums:production:lock:search-reindex"), "keys of live families without TTLs are kept")
+	assert.Len(t, mr.Keys(), 3)
+}
+
+func TestPruneKeysNilService(t *testing.T) {
+	t.Parallel()
+
+	var svc *Service
+
+	_, err := svc.PruneKeys(context.Background(), []string{"delete-request:"}, false)
+	require.ErrorIs(t, err, ErrRedisUnavailable)
+}
@@ -0,0 +1,26 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func requestNonceKey(nonce string) string {
+	return "request-nonce:" + nonce
+}
+
+// ReserveNonce records the nonce of a signed request for ttl. It returns false if the nonce is
+// already recorded, meaning the request is a replay.
+func (s *Service) ReserveNonce(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	if s == nil || s.client == nil {
+		return false, ErrRedisUnavailable
+	}
+
+	ok, err := s.client.SetNX(ctx, s.key(requestNonceKey(nonce)), 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve request nonce: %w", err)
+	}
+
+	return ok, nil
+}
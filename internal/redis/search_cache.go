@@ -0,0 +1,100 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// searchGenerationKey holds the current generation of the search cache. Results are stored under
+// it, so starting a new generation invalidates them all at once; the old entries expire unread.
+const searchGenerationKey = "search:generation"
+
+// searchResultsKeyPrefix prefixes the keys of cached search results, followed by the generation.
+const searchResultsKeyPrefix = "search:results:"
+
+// getSearchResultsScript returns the results stored as ARGV[1] under the generation in KEYS[1],
+// with KEYS[2] the key prefix.
+var getSearchResultsScript = redis.NewScript(`
+local generation = redis.call("GET", KEYS[1]) or "0"
+return redis.call("GET", KEYS[2] .. generation .. ":" .. ARGV[1])
+`)
+
+// setSearchResultsScript stores ARGV[2] as ARGV[1] under the generation in KEYS[1] for ARGV[3]
+// milliseconds, with KEYS[2] the key prefix.
+var setSearchResultsScript = redis.NewScript(`
+local generation = redis.call("GET", KEYS[1]) or "0"
+return redis.call("SET", KEYS[2] .. generation .. ":" .. ARGV[1], ARGV[2], "PX", ARGV[3])
+`)
+
+// GetSearchResults returns the search results cached under key, or nil if there are none.
+func (s *Service) GetSearchResults(ctx context.Context, key string) (*dto.UserSearchResponse, error) {
+	if s == nil || s.client == nil {
+		return nil, ErrRedisUnavailable
+	}
+
+	payload, err := getSearchResultsScript.Run(ctx, s.client,
+		[]string{s.key(searchGenerationKey), s.key(searchResultsKeyPrefix)}, key).Text()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil //nolint:nilnil // a miss is not an error
+		}
+
+		return nil, fmt.Errorf("failed to get cached search results: %w", err)
+	}
+
+	var results dto.UserSearchResponse
+
+	err = json.Unmarshal([]byte(payload), &results)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cached search results: %w", err)
+	}
+
+	return &results, nil
+}
+
+// SetSearchResults caches search results under key for ttl.
+func (s *Service) SetSearchResults(
+	ctx context.Context,
+	key string,
+	results *dto.UserSearchResponse,
+	ttl time.Duration,
+) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	payload, err := json.Marshal(results)
+	if err != nil {
+		return fmt.Errorf("failed to encode search results: %w", err)
+	}
+
+	err = setSearchResultsScript.Run(ctx, s.client,
+		[]string{s.key(searchGenerationKey), s.key(searchResultsKeyPrefix)}, key, payload, ttl.Milliseconds()).Err()
+	if err != nil {
+		return fmt.Errorf("failed to cache search results: %w", err)
+	}
+
+	return nil
+}
+
+// InvalidateSearchCache starts a new generation of the search cache, so no result cached before
+// is read again.
+func (s *Service) InvalidateSearchCache(ctx context.Context) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.Incr(ctx, s.key(searchGenerationKey)).Err()
+	if err != nil {
+		return fmt.Errorf("failed to invalidate search cache: %w", err)
+	}
+
+	return nil
+}
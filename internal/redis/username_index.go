@@ -0,0 +1,149 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// usernameIndexKey is the sorted set serving typeahead lookups. All members score 0, so they are
+// ordered by value and prefix lookups are ZRANGEBYLEX ranges.
+const usernameIndexKey = "typeahead:usernames"
+
+// usernameBuildTTL bounds how long an abandoned index build is kept.
+const usernameBuildTTL = 24 * time.Hour
+
+// usernameSeparator splits the fields of an index member. It sorts before every other
+// character, so "ab" sorts before "abc" whatever follows.
+const usernameSeparator = "\x00"
+
+func usernameBuildKey(buildID string) string {
+	return usernameIndexKey + ":build:" + buildID
+}
+
+// usernameMember encodes user as lower(username), username and user ID.
+func usernameMember(user dto.UserTypeaheadResult) string {
+	return strings.Join([]string{strings.ToLower(user.Username), user.Username, user.UserID}, usernameSeparator)
+}
+
+// MatchUsernamePrefix returns up to limit indexed users whose username starts with prefix,
+// ignoring case, in username order.
+func (s *Service) MatchUsernamePrefix(
+	ctx context.Context,
+	prefix string,
+	limit int,
+) ([]dto.UserTypeaheadResult, error) {
+	if s == nil || s.client == nil {
+		return nil, ErrRedisUnavailable
+	}
+
+	prefix = strings.ToLower(prefix)
+
+	members, err := s.client.ZRangeByLex(ctx, s.key(usernameIndexKey), &redis.ZRangeBy{
+		Min:   "[" + prefix,
+		Max:   "[" + prefix + "\xff",
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to match username prefix: %w", err)
+	}
+
+	results := make([]dto.UserTypeaheadResult, 0, len(members))
+
+	for _, member := range members {
+		fields := strings.Split(member, usernameSeparator)
+		if len(fields) != 3 { //nolint:mnd // lower(username), username and user ID
+			continue
+		}
+
+		results = append(results, dto.UserTypeaheadResult{UserID: fields[2], Username: fields[1]})
+	}
+
+	return results, nil
+}
+
+// StageUsernames adds users to the index build buildID. Builds are dropped after a day if they
+// are never published.
+func (s *Service) StageUsernames(ctx context.Context, buildID string, users []dto.UserTypeaheadResult) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	if len(users) == 0 {
+		return nil
+	}
+
+	members := make([]redis.Z, len(users))
+	for i, user := range users {
+		members[i] = redis.Z{Member: usernameMember(user)}
+	}
+
+	key := s.key(usernameBuildKey(buildID))
+
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, usernameBuildTTL)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stage usernames: %w", err)
+	}
+
+	return nil
+}
+
+// PublishUsernames atomically replaces the index with the build buildID. A build without users
+// empties the index.
+func (s *Service) PublishUsernames(ctx context.Context, buildID string) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	key := s.key(usernameBuildKey(buildID))
+
+	staged, err := s.client.Exists(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("failed to publish usernames: %w", err)
+	}
+
+	if staged == 0 {
+		err = s.client.Del(ctx, s.key(usernameIndexKey)).Err()
+		if err != nil {
+			return fmt.Errorf("failed to publish usernames: %w", err)
+		}
+
+		return nil
+	}
+
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Rename(ctx, key, s.key(usernameIndexKey))
+		pipe.Persist(ctx, s.key(usernameIndexKey))
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish usernames: %w", err)
+	}
+
+	return nil
+}
+
+// DiscardUsernames drops the index build buildID.
+func (s *Service) DiscardUsernames(ctx context.Context, buildID string) error {
+	if s == nil || s.client == nil {
+		return ErrRedisUnavailable
+	}
+
+	err := s.client.Del(ctx, s.key(usernameBuildKey(buildID))).Err()
+	if err != nil {
+		return fmt.Errorf("failed to discard usernames: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// AccountRecoveryRepository finds the deactivated accounts a recovery can be requested for.
+type AccountRecoveryRepository interface {
+	// FindDeactivatedUserByEmail returns the most recently deactivated user holding email,
+	// ignoring case, or ErrUserNotFound if no deactivated user does.
+	FindDeactivatedUserByEmail(ctx context.Context, email string) (*dto.User, error)
+}
+
+var _ AccountRecoveryRepository = (*SQLUserRepository)(nil)
+
+// FindDeactivatedUserByEmail returns the most recently deactivated user holding email.
+func (r *SQLUserRepository) FindDeactivatedUserByEmail(ctx context.Context, email string) (*dto.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM recipe_manager.users
+		WHERE LOWER(email) = LOWER($1) AND is_active = false
+		ORDER BY deactivated_at DESC NULLS LAST
+		LIMIT 1
+	`
+
+	user, err := scanUser(executorFor(ctx, r.db).QueryRowContext(ctx, query, email))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to query deactivated user by email: %w", err)
+	}
+
+	return user, nil
+}
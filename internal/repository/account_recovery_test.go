@@ -0,0 +1,69 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestUserRepositoryFindDeactivatedUserByEmail(t *testing.T) {
+	t.Parallel()
+
+	query := `SELECT user_id, username, email, full_name, bio, country, region, is_active, created_at, updated_at, ` +
+		`deactivated_at FROM recipe_manager.users WHERE LOWER\(email\) = LOWER\(\$1\) AND is_active = false ` +
+		`ORDER BY deactivated_at DESC`
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		userID := uuid.New()
+		now := time.Now()
+
+		mock.ExpectQuery(query).
+			WithArgs("Gone@Example.com").
+			WillReturnRows(sqlmock.NewRows(userColumns).
+				AddRow(userID.String(), "gone", "gone@example.com", nil, nil, nil, nil, false, now, now, now))
+
+		user, err := repository.NewUserRepository(db).FindDeactivatedUserByEmail(t.Context(), "Gone@Example.com")
+		require.NoError(t, err)
+		assert.Equal(t, userID.String(), user.UserID)
+		assert.False(t, user.IsActive)
+		require.NotNil(t, user.DeactivatedAt)
+		assert.Equal(t, now, *user.DeactivatedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(query).
+			WithArgs("active@example.com").
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+
+		_, err = repository.NewUserRepository(db).FindDeactivatedUserByEmail(t.Context(), "active@example.com")
+		require.ErrorIs(t, err, repository.ErrUserNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
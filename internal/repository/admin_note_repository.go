@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// ErrNoteNotFound is returned when a note does not exist on the given user.
+var ErrNoteNotFound = errors.New("note not found")
+
+// AdminNoteRepository stores internal notes on user accounts. Every change is recorded in the
+// audit trail in the same transaction, attributed to actorID.
+type AdminNoteRepository interface {
+	// CreateNote stores a note written by note.AuthorID and sets its ID and timestamps.
+	CreateNote(ctx context.Context, userID uuid.UUID, note *dto.AdminNote) error
+	// ListNotes returns the notes on a user, pinned notes first, then newest first.
+	ListNotes(ctx context.Context, userID uuid.UUID) ([]dto.AdminNote, error)
+	// UpdateNote changes the text and pinned flag of a note.
+	UpdateNote(
+		ctx context.Context,
+		actorID, userID uuid.UUID,
+		noteID int64,
+		update *dto.AdminNoteUpdateRequest,
+	) (*dto.AdminNote, error)
+	// DeleteNote removes a note.
+	DeleteNote(ctx context.Context, actorID, userID uuid.UUID, noteID int64) error
+}
+
+// SQLAdminNoteRepository implements AdminNoteRepository using a SQL database.
+type SQLAdminNoteRepository struct {
+	db *sql.DB
+	tx txRunner
+}
+
+// NewAdminNoteRepository creates a new SQLAdminNoteRepository.
+func NewAdminNoteRepository(db *sql.DB) *SQLAdminNoteRepository {
+	return &SQLAdminNoteRepository{db: db, tx: newTxRunner(db)}
+}
+
+const adminNoteColumns = `note_id, user_id, author_id, text, pinned, created_at, updated_at`
+
+// CreateNote stores a note and records it in the audit trail.
+func (r *SQLAdminNoteRepository) CreateNote(ctx context.Context, userID uuid.UUID, note *dto.AdminNote) error {
+	query := `
+		INSERT INTO recipe_manager.admin_notes (user_id, author_id, text, pinned)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + adminNoteColumns
+
+	authorID, err := uuid.Parse(note.AuthorID)
+	if err != nil {
+		return fmt.Errorf("invalid note author: %w", err)
+	}
+
+	err = r.tx.run(ctx, func(tx *sql.Tx) error {
+		err := scanAdminNote(tx.QueryRowContext(ctx, query, userID, authorID, note.Text, note.Pinned), note)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, authorID, dto.AuditActionNoteCreated, userID, noteResourceID(note.NoteID))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create note: %w", err)
+	}
+
+	return nil
+}
+
+// ListNotes returns the notes on a user, pinned notes first, then newest first.
+func (r *SQLAdminNoteRepository) ListNotes(ctx context.Context, userID uuid.UUID) ([]dto.AdminNote, error) {
+	query := `
+		SELECT ` + adminNoteColumns + `
+		FROM recipe_manager.admin_notes
+		WHERE user_id = $1
+		ORDER BY pinned DESC, note_id DESC
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch notes: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	notes := []dto.AdminNote{}
+
+	for rows.Next() {
+		var note dto.AdminNote
+
+		err = scanAdminNote(rows, &note)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan note: %w", err)
+		}
+
+		notes = append(notes, note)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating notes: %w", err)
+	}
+
+	return notes, nil
+}
+
+// UpdateNote changes the text and pinned flag of a note and records it in the audit trail.
+func (r *SQLAdminNoteRepository) UpdateNote(
+	ctx context.Context,
+	actorID, userID uuid.UUID,
+	noteID int64,
+	update *dto.AdminNoteUpdateRequest,
+) (*dto.AdminNote, error) {
+	query := `
+		UPDATE recipe_manager.admin_notes
+		SET text = COALESCE($3, text),
+			pinned = COALESCE($4, pinned),
+			updated_at = NOW()
+		WHERE note_id = $1 AND user_id = $2
+		RETURNING ` + adminNoteColumns
+
+	var note dto.AdminNote
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		err := scanAdminNote(tx.QueryRowContext(ctx, query, noteID, userID, update.Text, update.Pinned), &note)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNoteNotFound
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, actorID, dto.AuditActionNoteUpdated, userID, noteResourceID(noteID))
+	})
+	if err != nil {
+		if errors.Is(err, ErrNoteNotFound) {
+			return nil, ErrNoteNotFound
+		}
+
+		return nil, fmt.Errorf("failed to update note: %w", err)
+	}
+
+	return &note, nil
+}
+
+// DeleteNote removes a note and records it in the audit trail.
+func (r *SQLAdminNoteRepository) DeleteNote(ctx context.Context, actorID, userID uuid.UUID, noteID int64) error {
+	query := `
+		DELETE FROM recipe_manager.admin_notes
+		WHERE note_id = $1 AND user_id = $2
+	`
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query, noteID, userID)
+		if err != nil {
+			return err
+		}
+
+		deleted, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if deleted == 0 {
+			return ErrNoteNotFound
+		}
+
+		return recordAudit(ctx, tx, actorID, dto.AuditActionNoteDeleted, userID, noteResourceID(noteID))
+	})
+	if err != nil {
+		if errors.Is(err, ErrNoteNotFound) {
+			return ErrNoteNotFound
+		}
+
+		return fmt.Errorf("failed to delete note: %w", err)
+	}
+
+	return nil
+}
+
+func scanAdminNote(row rowScanner, note *dto.AdminNote) error {
+	return row.Scan(
+		&note.NoteID,
+		&note.UserID,
+		&note.AuthorID,
+		&note.Text,
+		&note.Pinned,
+		&note.CreatedAt,
+		&note.UpdatedAt,
+	)
+}
+
+func noteResourceID(noteID int64) string {
+	return "note:" + strconv.FormatInt(noteID, 10)
+}
@@ -0,0 +1,135 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+var adminNoteColumns = []string{"note_id", "user_id", "author_id", "text", "pinned", "created_at", "updated_at"}
+
+func TestAdminNoteRepositoryCreateNote(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewAdminNoteRepository(db)
+	userID, authorID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO recipe_manager.admin_notes`).
+		WithArgs(userID, authorID, "Refund issued", true).
+		WillReturnRows(sqlmock.NewRows(adminNoteColumns).
+			AddRow(int64(7), userID.String(), authorID.String(), "Refund issued", true, now, now))
+	mock.ExpectExec(`INSERT INTO recipe_manager.admin_audit_log`).
+		WithArgs(authorID, "note_created", userID, "note:7").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	note := &dto.AdminNote{AuthorID: authorID.String(), Text: "Refund issued", Pinned: true}
+
+	err = repo.CreateNote(t.Context(), userID, note)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), note.NoteID)
+	assert.Equal(t, userID.String(), note.UserID)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestAdminNoteRepositoryDeleteNote(t *testing.T) {
+	t.Parallel()
+
+	userID, actorID := uuid.New(), uuid.New()
+
+	t.Run("Success - records audit entry", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewAdminNoteRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM recipe_manager.admin_notes`).
+			WithArgs(int64(7), userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO recipe_manager.admin_audit_log`).
+			WithArgs(actorID, "note_deleted", userID, "note:7").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.DeleteNote(t.Context(), actorID, userID, 7))
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Not found - rolls back", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewAdminNoteRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM recipe_manager.admin_notes`).
+			WithArgs(int64(7), userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err = repo.DeleteNote(t.Context(), actorID, userID, 7)
+		require.ErrorIs(t, err, repository.ErrNoteNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestAuditRepositoryGetAuditTrail(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewAuditRepository(db)
+	userID, actorID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(`FROM recipe_manager.admin_audit_log`).
+		WithArgs(userID, 50).
+		WillReturnRows(sqlmock.NewRows([]string{"audit_id", "actor_id", "action", "user_id", "resource_id", "recorded_at"}).
+			AddRow(int64(2), actorID.String(), "note_deleted", userID.String(), "note:7", now).
+			AddRow(int64(1), actorID.String(), "note_created", userID.String(), "note:7", now))
+
+	entries, err := repo.GetAuditTrail(t.Context(), userID, 50)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, dto.AuditActionNoteDeleted, entries[0].Action)
+	assert.Equal(t, "note:7", entries[0].ResourceID)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
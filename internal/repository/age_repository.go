@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// AgeRepository stores users' birthdates and admin age overrides.
+type AgeRepository interface {
+	// GetAgeVerification returns the user's birthdate and override; both are unset if none is stored.
+	GetAgeVerification(ctx context.Context, userID uuid.UUID) (*dto.AgeVerification, error)
+	// SetBirthdate stores the user's birthdate, keeping any override.
+	SetBirthdate(ctx context.Context, userID uuid.UUID, birthdate time.Time) error
+	// SetAgeOverride stores an admin override, keeping the birthdate. An empty override clears it.
+	SetAgeOverride(ctx context.Context, userID uuid.UUID, override dto.AgeOverride) error
+}
+
+// SQLAgeRepository implements AgeRepository using a SQL database.
+type SQLAgeRepository struct {
+	db *sql.DB
+}
+
+// NewAgeRepository creates a new SQLAgeRepository.
+func NewAgeRepository(db *sql.DB) *SQLAgeRepository {
+	return &SQLAgeRepository{db: db}
+}
+
+// GetAgeVerification returns the user's birthdate and override.
+func (r *SQLAgeRepository) GetAgeVerification(ctx context.Context, userID uuid.UUID) (*dto.AgeVerification, error) {
+	query := `
+		SELECT birthdate, age_override
+		FROM recipe_manager.user_age_verifications
+		WHERE user_id = $1
+	`
+
+	var (
+		birthdate sql.NullTime
+		override  sql.NullString
+	)
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&birthdate, &override)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &dto.AgeVerification{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to fetch age verification: %w", err)
+	}
+
+	verification := &dto.AgeVerification{Override: dto.AgeOverride(override.String)}
+	if birthdate.Valid {
+		verification.Birthdate = &birthdate.Time
+	}
+
+	return verification, nil
+}
+
+// SetBirthdate stores the user's birthdate.
+func (r *SQLAgeRepository) SetBirthdate(ctx context.Context, userID uuid.UUID, birthdate time.Time) error {
+	query := `
+		INSERT INTO recipe_manager.user_age_verifications (user_id, birthdate)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET
+			birthdate = EXCLUDED.birthdate,
+			updated_at = NOW()
+	`
+
+	_, err := executorFor(ctx, r.db).ExecContext(ctx, query, userID, birthdate)
+	if err != nil {
+		return fmt.Errorf("failed to set birthdate: %w", err)
+	}
+
+	return nil
+}
+
+// SetAgeOverride stores an admin override; an empty override clears it.
+func (r *SQLAgeRepository) SetAgeOverride(ctx context.Context, userID uuid.UUID, override dto.AgeOverride) error {
+	query := `
+		INSERT INTO recipe_manager.user_age_verifications (user_id, age_override)
+		VALUES ($1, NULLIF($2, ''))
+		ON CONFLICT (user_id) DO UPDATE SET
+			age_override = EXCLUDED.age_override,
+			updated_at = NOW()
+	`
+
+	_, err := executorFor(ctx, r.db).ExecContext(ctx, query, userID, string(override))
+	if err != nil {
+		return fmt.Errorf("failed to set age override: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestAgeRepositoryGetAgeVerification(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	birthdate := time.Date(2011, time.May, 3, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success - birthdate and override", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewAgeRepository(db)
+
+		mock.ExpectQuery(`SELECT birthdate, age_override`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"birthdate", "age_override"}).AddRow(birthdate, "adult"))
+
+		verification, err := repo.GetAgeVerification(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, birthdate, *verification.Birthdate)
+		assert.Equal(t, dto.AgeOverrideAdult, verification.Override)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Success - nothing stored", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewAgeRepository(db)
+
+		mock.ExpectQuery(`SELECT birthdate, age_override`).
+			WithArgs(userID).
+			WillReturnError(sql.ErrNoRows)
+
+		verification, err := repo.GetAgeVerification(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Nil(t, verification.Birthdate)
+		assert.Empty(t, verification.Override)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestAgeRepositorySetAgeOverride(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	userID := uuid.New()
+	repo := repository.NewAgeRepository(db)
+
+	// An empty override is stored as NULL to clear it
+	mock.ExpectExec(`INSERT INTO recipe_manager.user_age_verifications`).
+		WithArgs(userID, "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.SetAgeOverride(t.Context(), userID, "")
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
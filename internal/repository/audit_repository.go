@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// AuditRepository reads the audit trail of admin actions on user accounts. Entries are written
+// by the repositories performing the actions, in the same transaction.
+type AuditRepository interface {
+	// GetAuditTrail returns up to limit entries about the user, newest first.
+	GetAuditTrail(ctx context.Context, userID uuid.UUID, limit int) ([]dto.AuditEntry, error)
+}
+
+// SQLAuditRepository implements AuditRepository using a SQL database.
+type SQLAuditRepository struct {
+	db *sql.DB
+}
+
+// NewAuditRepository creates a new SQLAuditRepository.
+func NewAuditRepository(db *sql.DB) *SQLAuditRepository {
+	return &SQLAuditRepository{db: db}
+}
+
+// GetAuditTrail returns up to limit entries about the user, newest first.
+func (r *SQLAuditRepository) GetAuditTrail(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit int,
+) ([]dto.AuditEntry, error) {
+	query := `
+		SELECT audit_id, actor_id, action, user_id, resource_id, recorded_at
+		FROM recipe_manager.admin_audit_log
+		WHERE user_id = $1
+		ORDER BY audit_id DESC
+		LIMIT $2
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch audit trail: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	entries := []dto.AuditEntry{}
+
+	for rows.Next() {
+		var entry dto.AuditEntry
+
+		err = rows.Scan(
+			&entry.AuditID,
+			&entry.ActorID,
+			&entry.Action,
+			&entry.UserID,
+			&entry.ResourceID,
+			&entry.RecordedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating audit trail: %w", err)
+	}
+
+	return entries, nil
+}
+
+// recordAudit appends an entry to the audit trail within tx, so it commits with the action.
+func recordAudit(
+	ctx context.Context,
+	tx *sql.Tx,
+	actorID uuid.UUID,
+	action dto.AuditAction,
+	userID uuid.UUID,
+	resourceID string,
+) error {
+	query := `
+		INSERT INTO recipe_manager.admin_audit_log (actor_id, action, user_id, resource_id)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := tx.ExecContext(ctx, query, actorID, string(action), userID, resourceID)
+	if err != nil {
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// BadgeRepository stores the badges users earned and reads the metrics they are awarded on.
+// Which badges exist is configuration; only the IDs of earned badges are stored.
+type BadgeRepository interface {
+	// GetBadgeMetrics returns the recipe and follower counts and sign-up time of a user.
+	GetBadgeMetrics(ctx context.Context, userID uuid.UUID) (*dto.BadgeMetrics, error)
+	// AwardBadges records the badges as earned by the user now, skipping those already earned,
+	// and returns the IDs of the ones newly earned.
+	AwardBadges(ctx context.Context, userID uuid.UUID, badgeIDs []string) ([]string, error)
+	// ListUserBadges returns the badges a user earned, earliest first.
+	ListUserBadges(ctx context.Context, userID uuid.UUID) ([]dto.EarnedBadge, error)
+	// ListUsersMissingBadge returns up to limit active users who signed up before joinedBefore
+	// and have not earned the badge.
+	ListUsersMissingBadge(ctx context.Context, badgeID string, joinedBefore time.Time, limit int) ([]uuid.UUID, error)
+}
+
+// SQLBadgeRepository implements BadgeRepository using a SQL database.
+type SQLBadgeRepository struct {
+	db *sql.DB
+	tx txRunner
+}
+
+// NewBadgeRepository creates a new SQLBadgeRepository.
+func NewBadgeRepository(db *sql.DB) *SQLBadgeRepository {
+	return &SQLBadgeRepository{db: db, tx: newTxRunner(db)}
+}
+
+// GetBadgeMetrics returns the recipe and follower counts and sign-up time of a user.
+func (r *SQLBadgeRepository) GetBadgeMetrics(ctx context.Context, userID uuid.UUID) (*dto.BadgeMetrics, error) {
+	query := `
+		SELECT u.created_at,
+			(SELECT COUNT(*) FROM recipe_manager.recipes WHERE user_id = u.user_id),
+			(SELECT COUNT(*) FROM recipe_manager.user_follows WHERE followee_id = u.user_id AND deleted_at IS NULL)
+		FROM recipe_manager.users u
+		WHERE u.user_id = $1
+	`
+
+	var metrics dto.BadgeMetrics
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).
+		Scan(&metrics.MemberSince, &metrics.Recipes, &metrics.Followers)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to query badge metrics: %w", err)
+	}
+
+	return &metrics, nil
+}
+
+// AwardBadges records the badges as earned by the user now and returns the ones newly earned.
+func (r *SQLBadgeRepository) AwardBadges(ctx context.Context, userID uuid.UUID, badgeIDs []string) ([]string, error) {
+	query := `
+		INSERT INTO recipe_manager.user_badges (user_id, badge_id)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id, badge_id) DO NOTHING
+	`
+
+	var awarded []string
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		awarded = nil
+
+		for _, badgeID := range badgeIDs {
+			result, err := tx.ExecContext(ctx, query, userID, badgeID)
+			if err != nil {
+				return err
+			}
+
+			inserted, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+
+			if inserted > 0 {
+				awarded = append(awarded, badgeID)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to award badges: %w", err)
+	}
+
+	return awarded, nil
+}
+
+// ListUserBadges returns the badges a user earned, earliest first.
+func (r *SQLBadgeRepository) ListUserBadges(ctx context.Context, userID uuid.UUID) ([]dto.EarnedBadge, error) {
+	query := `
+		SELECT badge_id, earned_at
+		FROM recipe_manager.user_badges
+		WHERE user_id = $1
+		ORDER BY earned_at, badge_id
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch badges: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	badges := []dto.EarnedBadge{}
+
+	for rows.Next() {
+		var badge dto.EarnedBadge
+
+		err = rows.Scan(&badge.BadgeID, &badge.EarnedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan badge: %w", err)
+		}
+
+		badges = append(badges, badge)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating badges: %w", err)
+	}
+
+	return badges, nil
+}
+
+// ListUsersMissingBadge returns up to limit active users who signed up before joinedBefore and
+// have not earned the badge.
+func (r *SQLBadgeRepository) ListUsersMissingBadge(
+	ctx context.Context,
+	badgeID string,
+	joinedBefore time.Time,
+	limit int,
+) ([]uuid.UUID, error) {
+	query := `
+		SELECT u.user_id
+		FROM recipe_manager.users u
+		WHERE u.is_active
+		  AND u.created_at < $2
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM recipe_manager.user_badges b
+			WHERE b.user_id = u.user_id AND b.badge_id = $1
+		  )
+		ORDER BY u.created_at, u.user_id
+		LIMIT $3
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, badgeID, joinedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users missing badge: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var userIDs []uuid.UUID
+
+	for rows.Next() {
+		var userID uuid.UUID
+
+		err = rows.Scan(&userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating users: %w", err)
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,115 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestBadgeRepositoryGetBadgeMetrics(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	createdAt := time.Now().AddDate(-1, 0, 0)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`SELECT u.created_at,.*FROM recipe_manager.users u`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "recipes", "followers"}).AddRow(createdAt, 3, 120))
+
+		metrics, err := repository.NewBadgeRepository(db).GetBadgeMetrics(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, &dto.BadgeMetrics{Recipes: 3, Followers: 120, MemberSince: createdAt}, metrics)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Unknown user", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`SELECT u.created_at`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "recipes", "followers"}))
+
+		_, err = repository.NewBadgeRepository(db).GetBadgeMetrics(t.Context(), userID)
+		require.ErrorIs(t, err, repository.ErrUserNotFound)
+		mock.ExpectClose()
+	})
+}
+
+func TestBadgeRepositoryAwardBadges(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO recipe_manager.user_badges .* ON CONFLICT \(user_id, badge_id\) DO NOTHING`).
+		WithArgs(userID, "first-recipe").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO recipe_manager.user_badges`).
+		WithArgs(userID, "followers-100").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	awarded, err := repository.NewBadgeRepository(db).AwardBadges(t.Context(), userID,
+		[]string{"first-recipe", "followers-100"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"followers-100"}, awarded, "badges already earned are skipped")
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestBadgeRepositoryListUsersMissingBadge(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := uuid.New(), uuid.New()
+	joinedBefore := time.Now().AddDate(-1, 0, 0)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	mock.ExpectQuery(`SELECT u.user_id\s+FROM recipe_manager.users u.*NOT EXISTS`).
+		WithArgs("member-1-year", joinedBefore, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(alice).AddRow(bob))
+
+	userIDs, err := repository.NewBadgeRepository(db).ListUsersMissingBadge(t.Context(), "member-1-year",
+		joinedBefore, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{alice, bob}, userIDs)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
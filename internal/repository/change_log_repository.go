@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// ChangeLogRepository defines the interface for reading the user change log.
+// The change log is populated by database triggers on the users and preference
+// tables (see the create_user_change_log migration), so this repository only
+// needs read access.
+type ChangeLogRepository interface {
+	GetChangesSince(ctx context.Context, cursor int64, limit int) ([]dto.UserChange, error)
+	GetLatestChangesForUser(ctx context.Context, userID uuid.UUID) ([]dto.UserChange, error)
+}
+
+// SQLChangeLogRepository implements ChangeLogRepository using a SQL database.
+type SQLChangeLogRepository struct {
+	db *sql.DB
+}
+
+// NewChangeLogRepository creates a new SQLChangeLogRepository.
+func NewChangeLogRepository(db *sql.DB) *SQLChangeLogRepository {
+	return &SQLChangeLogRepository{db: db}
+}
+
+// GetChangesSince retrieves change log entries with a sequence greater than cursor,
+// ordered by sequence ascending.
+func (r *SQLChangeLogRepository) GetChangesSince(
+	ctx context.Context,
+	cursor int64,
+	limit int,
+) ([]dto.UserChange, error) {
+	query := `
+		SELECT sequence, user_id, change_type, category, changed_at
+		FROM recipe_manager.user_change_log
+		WHERE sequence > $1
+		ORDER BY sequence ASC
+		LIMIT $2
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, cursor, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user changes: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanUserChanges(rows)
+}
+
+// GetLatestChangesForUser retrieves the most recent change of each type and category recorded
+// for a user.
+func (r *SQLChangeLogRepository) GetLatestChangesForUser(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]dto.UserChange, error) {
+	query := `
+		SELECT DISTINCT ON (change_type, category) sequence, user_id, change_type, category, changed_at
+		FROM recipe_manager.user_change_log
+		WHERE user_id = $1
+		ORDER BY change_type, category, sequence DESC
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch latest user changes: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanUserChanges(rows)
+}
+
+func scanUserChanges(rows *sql.Rows) ([]dto.UserChange, error) {
+	var changes []dto.UserChange
+
+	for rows.Next() {
+		var (
+			change   dto.UserChange
+			category sql.NullString
+		)
+
+		err := rows.Scan(&change.Sequence, &change.UserID, &change.ChangeType, &category, &change.ChangedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user change: %w", err)
+		}
+
+		if category.Valid {
+			change.Category = &category.String
+		}
+
+		changes = append(changes, change)
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating user changes: %w", err)
+	}
+
+	return changes, nil
+}
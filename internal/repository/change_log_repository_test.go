@@ -0,0 +1,76 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestChangeLogRepositoryGetChangesSince(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	userID := uuid.New()
+	changedAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT sequence, user_id, change_type, category, changed_at\s+`+
+		`FROM recipe_manager.user_change_log\s+WHERE sequence > \$1\s+ORDER BY sequence ASC\s+LIMIT \$2`).
+		WithArgs(int64(41), 2).
+		WillReturnRows(sqlmock.NewRows([]string{"sequence", "user_id", "change_type", "category", "changed_at"}).
+			AddRow(42, userID.String(), "USER_UPSERT", nil, changedAt).
+			AddRow(43, userID.String(), "PREFERENCE_CHANGED", "privacy", changedAt))
+
+	changes, err := repository.NewChangeLogRepository(db).GetChangesSince(t.Context(), 41, 2)
+	require.NoError(t, err)
+
+	privacy := "privacy"
+	assert.Equal(t, []dto.UserChange{
+		{Sequence: 42, UserID: userID.String(), ChangeType: dto.UserChangeTypeUpsert, ChangedAt: changedAt},
+		{
+			Sequence: 43, UserID: userID.String(), ChangeType: dto.UserChangeTypePreferenceChanged,
+			Category: &privacy, ChangedAt: changedAt,
+		},
+	}, changes)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestChangeLogRepositoryGetLatestChangesForUser(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery(`SELECT DISTINCT ON \(change_type, category\) sequence, user_id, change_type, category, ` +
+		`changed_at\s+FROM recipe_manager.user_change_log\s+WHERE user_id = \$1`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"sequence", "user_id", "change_type", "category", "changed_at"}).
+			AddRow(7, userID.String(), "USER_DEACTIVATED", nil, time.Now()))
+
+	changes, err := repository.NewChangeLogRepository(db).GetLatestChangesForUser(t.Context(), userID)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, dto.UserChangeTypeDeactivated, changes[0].ChangeType)
+	assert.Nil(t, changes[0].Category)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// ConsentRepository keeps the consent ledger. Records are never changed; the latest record of a
+// purpose is the user's current consent.
+type ConsentRepository interface {
+	// RecordConsent appends a decision to the ledger and sets record.RecordedAt. A withdrawal also
+	// turns off the preference the consent covered, in the same transaction.
+	RecordConsent(ctx context.Context, userID uuid.UUID, record *dto.ConsentRecord) error
+	GetCurrentConsents(ctx context.Context, userID uuid.UUID) ([]dto.ConsentRecord, error)
+	GetConsentHistory(ctx context.Context, userID uuid.UUID) ([]dto.ConsentRecord, error)
+}
+
+// consentWithdrawals turns off the preference covered by each consent purpose. Notification
+// preferences are turned off in both storage layouts, so a withdrawal holds whichever one is read.
+var consentWithdrawals = map[dto.ConsentPurpose][]string{
+	dto.ConsentPurposeMarketingEmails: {`
+		UPDATE recipe_manager.user_notification_preferences
+		SET marketing_emails = false, updated_at = NOW()
+		WHERE user_id = $1 AND marketing_emails`, `
+		UPDATE recipe_manager.user_preference_documents
+		SET doc = doc || '{"marketingEmails": false}', updated_at = NOW()
+		WHERE user_id = $1 AND category = 'notification' AND (doc->>'marketingEmails')::boolean`,
+	},
+	dto.ConsentPurposeAnalyticsTracking: {`
+		UPDATE recipe_manager.user_privacy_preferences
+		SET analytics_tracking = false, updated_at = NOW()
+		WHERE user_id = $1 AND analytics_tracking`,
+	},
+	dto.ConsentPurposeDataSharing: {`
+		UPDATE recipe_manager.user_privacy_preferences
+		SET data_sharing = false, updated_at = NOW()
+		WHERE user_id = $1 AND data_sharing`,
+	},
+}
+
+// SQLConsentRepository implements ConsentRepository using a SQL database.
+type SQLConsentRepository struct {
+	db *sql.DB
+	tx txRunner
+}
+
+// NewConsentRepository creates a new SQLConsentRepository.
+func NewConsentRepository(db *sql.DB) *SQLConsentRepository {
+	return &SQLConsentRepository{db: db, tx: newTxRunner(db)}
+}
+
+// RecordConsent appends a decision to the ledger, turning off the covered preference on withdrawal.
+func (r *SQLConsentRepository) RecordConsent(
+	ctx context.Context,
+	userID uuid.UUID,
+	record *dto.ConsentRecord,
+) error {
+	query := `
+		INSERT INTO recipe_manager.user_consents (user_id, purpose, granted, source, policy_version)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING recorded_at
+	`
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, query,
+			userID,
+			string(record.Purpose),
+			record.Granted,
+			string(record.Source),
+			record.PolicyVersion,
+		).Scan(&record.RecordedAt)
+		if err != nil || record.Granted {
+			return err
+		}
+
+		for _, withdrawal := range consentWithdrawals[record.Purpose] {
+			_, err = tx.ExecContext(ctx, withdrawal, userID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record consent: %w", err)
+	}
+
+	return nil
+}
+
+// GetCurrentConsents returns the latest decision for each purpose the user decided on.
+func (r *SQLConsentRepository) GetCurrentConsents(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]dto.ConsentRecord, error) {
+	query := `
+		SELECT DISTINCT ON (purpose) purpose, granted, source, policy_version, recorded_at
+		FROM recipe_manager.user_consents
+		WHERE user_id = $1
+		ORDER BY purpose, consent_id DESC
+	`
+
+	return r.queryConsents(ctx, query, userID)
+}
+
+// GetConsentHistory returns every decision of the user, newest first.
+func (r *SQLConsentRepository) GetConsentHistory(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]dto.ConsentRecord, error) {
+	query := `
+		SELECT purpose, granted, source, policy_version, recorded_at
+		FROM recipe_manager.user_consents
+		WHERE user_id = $1
+		ORDER BY consent_id DESC
+	`
+
+	return r.queryConsents(ctx, query, userID)
+}
+
+func (r *SQLConsentRepository) queryConsents(
+	ctx context.Context,
+	query string,
+	userID uuid.UUID,
+) ([]dto.ConsentRecord, error) {
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch consents: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	records := []dto.ConsentRecord{}
+
+	for rows.Next() {
+		var record dto.ConsentRecord
+
+		err = rows.Scan(&record.Purpose, &record.Granted, &record.Source, &record.PolicyVersion, &record.RecordedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan consent: %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating consents: %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,119 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestConsentRepositoryRecordConsent(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	recordedAt := time.Date(2026, time.October, 17, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Success - grant only appends to the ledger", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewConsentRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO recipe_manager.user_consents`).
+			WithArgs(userID, "marketing_emails", true, "ui", "2026-10").
+			WillReturnRows(sqlmock.NewRows([]string{"recorded_at"}).AddRow(recordedAt))
+		mock.ExpectCommit()
+
+		record := &dto.ConsentRecord{
+			Purpose:       dto.ConsentPurposeMarketingEmails,
+			Granted:       true,
+			Source:        dto.ConsentSourceUI,
+			PolicyVersion: "2026-10",
+		}
+
+		err = repo.RecordConsent(t.Context(), userID, record)
+		require.NoError(t, err)
+		assert.Equal(t, recordedAt, record.RecordedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Success - withdrawal turns off the preference in the same transaction", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewConsentRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO recipe_manager.user_consents`).
+			WithArgs(userID, "analytics_tracking", false, "admin", "2026-10").
+			WillReturnRows(sqlmock.NewRows([]string{"recorded_at"}).AddRow(recordedAt))
+		mock.ExpectExec(`UPDATE recipe_manager.user_privacy_preferences\s+SET analytics_tracking = false`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err = repo.RecordConsent(t.Context(), userID, &dto.ConsentRecord{
+			Purpose:       dto.ConsentPurposeAnalyticsTracking,
+			Source:        dto.ConsentSourceAdmin,
+			PolicyVersion: "2026-10",
+		})
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Success - marketing withdrawal turns off emails in both preference layouts", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewConsentRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO recipe_manager.user_consents`).
+			WithArgs(userID, "marketing_emails", false, "ui", "2026-10").
+			WillReturnRows(sqlmock.NewRows([]string{"recorded_at"}).AddRow(recordedAt))
+		mock.ExpectExec(`UPDATE recipe_manager.user_notification_preferences\s+SET marketing_emails = false`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`UPDATE recipe_manager.user_preference_documents\s+` +
+			`SET doc = doc \|\| '\{"marketingEmails": false\}'`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err = repo.RecordConsent(t.Context(), userID, &dto.ConsentRecord{
+			Purpose:       dto.ConsentPurposeMarketingEmails,
+			Source:        dto.ConsentSourceUI,
+			PolicyVersion: "2026-10",
+		})
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
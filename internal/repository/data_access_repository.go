@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// DataAccessRepository keeps the audit log of service accounts reading user data. Only the
+// latest access of each service and category is kept, with a running count.
+type DataAccessRepository interface {
+	RecordDataAccess(
+		ctx context.Context,
+		userID uuid.UUID,
+		clientID string,
+		category dto.DataCategory,
+		accessedAt time.Time,
+	) error
+	GetDataConsumers(ctx context.Context, userID uuid.UUID) ([]dto.DataConsumer, error)
+}
+
+// SQLDataAccessRepository implements DataAccessRepository using a SQL database.
+type SQLDataAccessRepository struct {
+	db *sql.DB
+}
+
+// NewDataAccessRepository creates a new SQLDataAccessRepository.
+func NewDataAccessRepository(db *sql.DB) *SQLDataAccessRepository {
+	return &SQLDataAccessRepository{db: db}
+}
+
+// RecordDataAccess counts one read of category by clientID.
+func (r *SQLDataAccessRepository) RecordDataAccess(
+	ctx context.Context,
+	userID uuid.UUID,
+	clientID string,
+	category dto.DataCategory,
+	accessedAt time.Time,
+) error {
+	query := `
+		INSERT INTO recipe_manager.user_data_access_log (
+			user_id, client_id, category, access_count, last_accessed_at
+		) VALUES ($1, $2, $3, 1, $4)
+		ON CONFLICT (user_id, client_id, category) DO UPDATE SET
+			access_count = user_data_access_log.access_count + 1,
+			last_accessed_at = GREATEST(user_data_access_log.last_accessed_at, EXCLUDED.last_accessed_at)
+	`
+
+	_, err := executorFor(ctx, r.db).ExecContext(ctx, query, userID, clientID, string(category), accessedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record data access: %w", err)
+	}
+
+	return nil
+}
+
+// GetDataConsumers lists the services that read a user's data, most recent first.
+func (r *SQLDataAccessRepository) GetDataConsumers(ctx context.Context, userID uuid.UUID) ([]dto.DataConsumer, error) {
+	query := `
+		SELECT client_id, category, access_count, last_accessed_at
+		FROM recipe_manager.user_data_access_log
+		WHERE user_id = $1
+		ORDER BY last_accessed_at DESC, client_id, category
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch data consumers: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var consumers []dto.DataConsumer
+
+	for rows.Next() {
+		var consumer dto.DataConsumer
+
+		err = rows.Scan(&consumer.ClientID, &consumer.Category, &consumer.AccessCount, &consumer.LastAccessedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan data consumer: %w", err)
+		}
+
+		consumers = append(consumers, consumer)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating data consumers: %w", err)
+	}
+
+	return consumers, nil
+}
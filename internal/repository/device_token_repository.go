@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// ErrDeviceNotFound is returned when a device is not registered to the given user.
+var ErrDeviceNotFound = errors.New("device not found")
+
+// DeviceTokenRepository stores the devices users registered for push notifications. A token
+// belongs to one user at a time; registering it again moves it to the registering user.
+type DeviceTokenRepository interface {
+	// RegisterDeviceToken stores device for userID, or refreshes its platform, app version and
+	// last seen time if the token is already registered, and sets its ID and timestamps. When the
+	// user then has more than limit devices, the least recently seen are removed; zero keeps all.
+	RegisterDeviceToken(ctx context.Context, userID uuid.UUID, device *dto.DeviceToken, limit int) error
+	// ListDeviceTokens returns the devices registered to a user, most recently seen first.
+	ListDeviceTokens(ctx context.Context, userID uuid.UUID) ([]dto.DeviceToken, error)
+	// DeleteDeviceToken unregisters a device from a user.
+	DeleteDeviceToken(ctx context.Context, userID uuid.UUID, deviceID int64) error
+	// DeleteStaleDeviceTokens removes devices last seen before cutoff and returns how many.
+	DeleteStaleDeviceTokens(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// SQLDeviceTokenRepository implements DeviceTokenRepository using a SQL database.
+type SQLDeviceTokenRepository struct {
+	db *sql.DB
+	tx txRunner
+}
+
+// NewDeviceTokenRepository creates a new SQLDeviceTokenRepository.
+func NewDeviceTokenRepository(db *sql.DB) *SQLDeviceTokenRepository {
+	return &SQLDeviceTokenRepository{db: db, tx: newTxRunner(db)}
+}
+
+const deviceTokenColumns = `device_id, platform, token, app_version, registered_at, last_seen_at`
+
+// RegisterDeviceToken stores or refreshes a device and trims the user's devices to limit.
+func (r *SQLDeviceTokenRepository) RegisterDeviceToken(
+	ctx context.Context,
+	userID uuid.UUID,
+	device *dto.DeviceToken,
+	limit int,
+) error {
+	// A token moving to another user counts as a new registration
+	upsert := `
+		INSERT INTO recipe_manager.user_device_tokens (user_id, platform, token, app_version)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (token) DO UPDATE
+		SET registered_at = CASE
+				WHEN user_device_tokens.user_id = EXCLUDED.user_id THEN user_device_tokens.registered_at
+				ELSE NOW()
+			END,
+			user_id = EXCLUDED.user_id,
+			platform = EXCLUDED.platform,
+			app_version = EXCLUDED.app_version,
+			last_seen_at = NOW()
+		RETURNING ` + deviceTokenColumns
+
+	trim := `
+		DELETE FROM recipe_manager.user_device_tokens
+		WHERE user_id = $1 AND device_id NOT IN (
+			SELECT device_id
+			FROM recipe_manager.user_device_tokens
+			WHERE user_id = $1
+			ORDER BY last_seen_at DESC, device_id DESC
+			LIMIT $2
+		)
+	`
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx, upsert, userID, device.Platform, device.Token, device.AppVersion)
+
+		err := scanDeviceToken(row, device)
+		if err != nil {
+			return err
+		}
+
+		if limit <= 0 {
+			return nil
+		}
+
+		_, err = tx.ExecContext(ctx, trim, userID, limit)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register device: %w", err)
+	}
+
+	return nil
+}
+
+// ListDeviceTokens returns the devices registered to a user, most recently seen first.
+func (r *SQLDeviceTokenRepository) ListDeviceTokens(ctx context.Context, userID uuid.UUID) ([]dto.DeviceToken, error) {
+	query := `
+		SELECT ` + deviceTokenColumns + `
+		FROM recipe_manager.user_device_tokens
+		WHERE user_id = $1
+		ORDER BY last_seen_at DESC, device_id DESC
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch devices: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	devices := []dto.DeviceToken{}
+
+	for rows.Next() {
+		var device dto.DeviceToken
+
+		err = scanDeviceToken(rows, &device)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan device: %w", err)
+		}
+
+		devices = append(devices, device)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating devices: %w", err)
+	}
+
+	return devices, nil
+}
+
+// DeleteDeviceToken unregisters a device from a user.
+func (r *SQLDeviceTokenRepository) DeleteDeviceToken(ctx context.Context, userID uuid.UUID, deviceID int64) error {
+	query := `
+		DELETE FROM recipe_manager.user_device_tokens
+		WHERE device_id = $1 AND user_id = $2
+	`
+
+	result, err := executorFor(ctx, r.db).ExecContext(ctx, query, deviceID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete device: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete device: %w", err)
+	}
+
+	if deleted == 0 {
+		return ErrDeviceNotFound
+	}
+
+	return nil
+}
+
+// DeleteStaleDeviceTokens removes devices last seen before cutoff and returns how many.
+func (r *SQLDeviceTokenRepository) DeleteStaleDeviceTokens(ctx context.Context, cutoff time.Time) (int64, error) {
+	query := `
+		DELETE FROM recipe_manager.user_device_tokens
+		WHERE last_seen_at < $1
+	`
+
+	result, err := executorFor(ctx, r.db).ExecContext(ctx, query, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale devices: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale devices: %w", err)
+	}
+
+	return deleted, nil
+}
+
+func scanDeviceToken(row rowScanner, device *dto.DeviceToken) error {
+	return row.Scan(
+		&device.DeviceID,
+		&device.Platform,
+		&device.Token,
+		&device.AppVersion,
+		&device.RegisteredAt,
+		&device.LastSeenAt,
+	)
+}
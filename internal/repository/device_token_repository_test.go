@@ -0,0 +1,128 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+var deviceTokenColumns = []string{"device_id", "platform", "token", "app_version", "registered_at", "last_seen_at"}
+
+func TestDeviceTokenRepositoryRegisterDeviceToken(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	now := time.Now()
+
+	t.Run("Success - trims to limit", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewDeviceTokenRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO recipe_manager.user_device_tokens .* ON CONFLICT \(token\) DO UPDATE`).
+			WithArgs(userID, dto.DevicePlatformIOS, "apns-token", "3.2.0").
+			WillReturnRows(sqlmock.NewRows(deviceTokenColumns).
+				AddRow(int64(4), "ios", "apns-token", "3.2.0", now, now))
+		mock.ExpectExec(`DELETE FROM recipe_manager.user_device_tokens`).
+			WithArgs(userID, 5).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		device := &dto.DeviceToken{Platform: dto.DevicePlatformIOS, Token: "apns-token", AppVersion: "3.2.0"}
+
+		err = repo.RegisterDeviceToken(t.Context(), userID, device, 5)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), device.DeviceID)
+		assert.Equal(t, now, device.LastSeenAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Success - no limit", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewDeviceTokenRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO recipe_manager.user_device_tokens`).
+			WithArgs(userID, dto.DevicePlatformWeb, "web-token", "").
+			WillReturnRows(sqlmock.NewRows(deviceTokenColumns).
+				AddRow(int64(5), "web", "web-token", "", now, now))
+		mock.ExpectCommit()
+
+		device := &dto.DeviceToken{Platform: dto.DevicePlatformWeb, Token: "web-token"}
+
+		require.NoError(t, repo.RegisterDeviceToken(t.Context(), userID, device, 0))
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestDeviceTokenRepositoryDeleteDeviceToken(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewDeviceTokenRepository(db)
+	userID := uuid.New()
+
+	mock.ExpectExec(`DELETE FROM recipe_manager.user_device_tokens`).
+		WithArgs(int64(9), userID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.DeleteDeviceToken(t.Context(), userID, 9)
+	require.ErrorIs(t, err, repository.ErrDeviceNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestDeviceTokenRepositoryDeleteStaleDeviceTokens(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewDeviceTokenRepository(db)
+	cutoff := time.Now().Add(-time.Hour)
+
+	mock.ExpectExec(`DELETE FROM recipe_manager.user_device_tokens\s+WHERE last_seen_at < \$1`).
+		WithArgs(cutoff).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	deleted, err := repo.DeleteStaleDeviceTokens(t.Context(), cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// Directory link errors.
+var (
+	// ErrDirectoryLinkNotFound is returned when the user is not linked to a directory entry.
+	ErrDirectoryLinkNotFound = errors.New("directory link not found")
+	// ErrExternalIDLinked is returned when the external ID is already linked to another user.
+	ErrExternalIDLinked = errors.New("external ID is linked to another user")
+)
+
+// DirectoryLinkRepository stores which accounts are mapped to an LDAP directory entry and how
+// their last sync went. Linking and unlinking are recorded in the audit trail in the same
+// transaction, attributed to actorID.
+type DirectoryLinkRepository interface {
+	// LinkDirectoryEntry maps the user to the external ID. Mapping to another ID than before
+	// starts over as PENDING; mapping to the same ID keeps the sync state.
+	LinkDirectoryEntry(ctx context.Context, actorID, userID uuid.UUID, externalID string) (*dto.DirectoryLink, error)
+	// GetDirectoryLink returns the link of a user.
+	GetDirectoryLink(ctx context.Context, userID uuid.UUID) (*dto.DirectoryLink, error)
+	// UnlinkDirectoryEntry removes the link of a user.
+	UnlinkDirectoryEntry(ctx context.Context, actorID, userID uuid.UUID) error
+	// ListDirectoryLinks returns up to limit links of users after the given one, in user ID order.
+	ListDirectoryLinks(ctx context.Context, after uuid.UUID, limit int) ([]dto.DirectoryLink, error)
+	// SaveDirectorySync stores the status, synced values, conflicts, last error and sync time of
+	// a link, unless it was unlinked or mapped to another external ID meanwhile.
+	SaveDirectorySync(ctx context.Context, link *dto.DirectoryLink) error
+}
+
+// SQLDirectoryLinkRepository implements DirectoryLinkRepository using a SQL database.
+type SQLDirectoryLinkRepository struct {
+	db *sql.DB
+	tx txRunner
+}
+
+// NewDirectoryLinkRepository creates a new SQLDirectoryLinkRepository.
+func NewDirectoryLinkRepository(db *sql.DB) *SQLDirectoryLinkRepository {
+	return &SQLDirectoryLinkRepository{db: db, tx: newTxRunner(db)}
+}
+
+const directoryLinkColumns = `user_id, external_id, status, synced_full_name, synced_email, synced_is_active,
+		array_to_string(conflicts, ','), COALESCE(last_error, ''), last_synced_at, created_at`
+
+// LinkDirectoryEntry maps the user to the external ID and records it in the audit trail.
+func (r *SQLDirectoryLinkRepository) LinkDirectoryEntry(
+	ctx context.Context,
+	actorID, userID uuid.UUID,
+	externalID string,
+) (*dto.DirectoryLink, error) {
+	// The update is skipped, returning no row, when the ID is unchanged
+	upsert := `
+		INSERT INTO recipe_manager.user_directory_links (user_id, external_id)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET external_id = EXCLUDED.external_id, status = 'PENDING', synced_full_name = NULL,
+			synced_email = NULL, synced_is_active = NULL, conflicts = '{}', last_error = NULL,
+			last_synced_at = NULL, created_at = NOW()
+		WHERE user_directory_links.external_id <> EXCLUDED.external_id
+		RETURNING ` + directoryLinkColumns
+
+	var link dto.DirectoryLink
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		err := scanDirectoryLink(tx.QueryRowContext(ctx, upsert, userID, externalID), &link)
+		if errors.Is(err, sql.ErrNoRows) {
+			return scanDirectoryLink(tx.QueryRowContext(ctx, `
+				SELECT `+directoryLinkColumns+`
+				FROM recipe_manager.user_directory_links
+				WHERE user_id = $1
+			`, userID), &link)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, actorID, dto.AuditActionDirectoryLinked, userID, externalID)
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return nil, ErrExternalIDLinked
+			case "23503":
+				return nil, ErrUserNotFound
+			}
+		}
+
+		return nil, fmt.Errorf("failed to link directory entry: %w", err)
+	}
+
+	return &link, nil
+}
+
+// GetDirectoryLink returns the link of a user.
+func (r *SQLDirectoryLinkRepository) GetDirectoryLink(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.DirectoryLink, error) {
+	query := `
+		SELECT ` + directoryLinkColumns + `
+		FROM recipe_manager.user_directory_links
+		WHERE user_id = $1
+	`
+
+	var link dto.DirectoryLink
+
+	err := scanDirectoryLink(executorFor(ctx, r.db).QueryRowContext(ctx, query, userID), &link)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDirectoryLinkNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch directory link: %w", err)
+	}
+
+	return &link, nil
+}
+
+// UnlinkDirectoryEntry removes the link of a user and records it in the audit trail.
+func (r *SQLDirectoryLinkRepository) UnlinkDirectoryEntry(ctx context.Context, actorID, userID uuid.UUID) error {
+	query := `
+		DELETE FROM recipe_manager.user_directory_links
+		WHERE user_id = $1
+		RETURNING external_id
+	`
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		var externalID string
+
+		err := tx.QueryRowContext(ctx, query, userID).Scan(&externalID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, actorID, dto.AuditActionDirectoryUnlinked, userID, externalID)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrDirectoryLinkNotFound
+		}
+
+		return fmt.Errorf("failed to unlink directory entry: %w", err)
+	}
+
+	return nil
+}
+
+// ListDirectoryLinks returns up to limit links of users after the given one, in user ID order.
+func (r *SQLDirectoryLinkRepository) ListDirectoryLinks(
+	ctx context.Context,
+	after uuid.UUID,
+	limit int,
+) ([]dto.DirectoryLink, error) {
+	query := `
+		SELECT ` + directoryLinkColumns + `
+		FROM recipe_manager.user_directory_links
+		WHERE user_id > $1
+		ORDER BY user_id
+		LIMIT $2
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list directory links: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var links []dto.DirectoryLink
+
+	for rows.Next() {
+		var link dto.DirectoryLink
+
+		err = scanDirectoryLink(rows, &link)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan directory link: %w", err)
+		}
+
+		links = append(links, link)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating directory links: %w", err)
+	}
+
+	return links, nil
+}
+
+// SaveDirectorySync stores the outcome of a sync of the link.
+func (r *SQLDirectoryLinkRepository) SaveDirectorySync(ctx context.Context, link *dto.DirectoryLink) error {
+	query := `
+		UPDATE recipe_manager.user_directory_links
+		SET status = $3, synced_full_name = $4, synced_email = $5, synced_is_active = $6,
+			conflicts = string_to_array($7, ','), last_error = NULLIF($8, ''), last_synced_at = $9
+		WHERE user_id = $1 AND external_id = $2
+	`
+
+	conflicts := make([]string, len(link.Conflicts))
+	for i, attribute := range link.Conflicts {
+		conflicts[i] = string(attribute)
+	}
+
+	_, err := executorFor(ctx, r.db).ExecContext(ctx, query,
+		link.UserID,
+		link.ExternalID,
+		string(link.Status),
+		link.Synced.FullName,
+		link.Synced.Email,
+		link.Synced.IsActive,
+		strings.Join(conflicts, ","),
+		link.LastError,
+		link.LastSyncedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save directory sync: %w", err)
+	}
+
+	return nil
+}
+
+func scanDirectoryLink(row rowScanner, link *dto.DirectoryLink) error {
+	var (
+		fullName     sql.NullString
+		email        sql.NullString
+		isActive     sql.NullBool
+		conflicts    string
+		lastSyncedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&link.UserID,
+		&link.ExternalID,
+		&link.Status,
+		&fullName,
+		&email,
+		&isActive,
+		&conflicts,
+		&link.LastError,
+		&lastSyncedAt,
+		&link.CreatedAt,
+	)
+	if err != nil {
+		return err //nolint:wrapcheck // callers wrap with query context
+	}
+
+	link.Synced = dto.DirectoryAttributes{}
+	if fullName.Valid {
+		link.Synced.FullName = &fullName.String
+	}
+
+	if email.Valid {
+		link.Synced.Email = &email.String
+	}
+
+	if isActive.Valid {
+		link.Synced.IsActive = &isActive.Bool
+	}
+
+	link.Conflicts = []dto.DirectoryAttribute{}
+	if conflicts != "" {
+		for attribute := range strings.SplitSeq(conflicts, ",") {
+			link.Conflicts = append(link.Conflicts, dto.DirectoryAttribute(attribute))
+		}
+	}
+
+	link.LastSyncedAt = nil
+	if lastSyncedAt.Valid {
+		synced := lastSyncedAt.Time
+		link.LastSyncedAt = &synced
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+var directoryLinkColumns = []string{
+	"user_id", "external_id", "status", "synced_full_name", "synced_email", "synced_is_active",
+	"array_to_string", "coalesce", "last_synced_at", "created_at",
+}
+
+func TestDirectoryLinkRepositoryLinkDirectoryEntry(t *testing.T) {
+	t.Parallel()
+
+	userID, actorID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	t.Run("Success - new link with audit entry", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewDirectoryLinkRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO recipe_manager.user_directory_links`).
+			WithArgs(userID, "ada").
+			WillReturnRows(sqlmock.NewRows(directoryLinkColumns).
+				AddRow(userID.String(), "ada", "PENDING", nil, nil, nil, "", "", nil, now))
+		mock.ExpectExec(`INSERT INTO recipe_manager.admin_audit_log`).
+			WithArgs(actorID, "directory_linked", userID, "ada").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		link, err := repo.LinkDirectoryEntry(t.Context(), actorID, userID, "ada")
+		require.NoError(t, err)
+		assert.Equal(t, dto.DirectorySyncPending, link.Status)
+		assert.Empty(t, link.Conflicts)
+		assert.Nil(t, link.LastSyncedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Same external ID - keeps sync state without audit entry", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewDirectoryLinkRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO recipe_manager.user_directory_links`).
+			WithArgs(userID, "ada").
+			WillReturnRows(sqlmock.NewRows(directoryLinkColumns))
+		mock.ExpectQuery(`SELECT user_id, external_id`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows(directoryLinkColumns).
+				AddRow(userID.String(), "ada", "CONFLICT", "Ada", "ada@example.com", true, "fullName,email", "", now, now))
+		mock.ExpectCommit()
+
+		link, err := repo.LinkDirectoryEntry(t.Context(), actorID, userID, "ada")
+		require.NoError(t, err)
+		assert.Equal(t, dto.DirectorySyncConflict, link.Status)
+		assert.Equal(t, []dto.DirectoryAttribute{dto.DirectoryAttributeFullName, dto.DirectoryAttributeEmail}, link.Conflicts)
+		require.NotNil(t, link.Synced.IsActive)
+		assert.True(t, *link.Synced.IsActive)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("External ID linked to another user", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewDirectoryLinkRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO recipe_manager.user_directory_links`).
+			WithArgs(userID, "ada").
+			WillReturnError(&pgconn.PgError{Code: "23505", Message: "duplicate key value"})
+		mock.ExpectRollback()
+
+		_, err = repo.LinkDirectoryEntry(t.Context(), actorID, userID, "ada")
+		require.ErrorIs(t, err, repository.ErrExternalIDLinked)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestDirectoryLinkRepositoryUnlinkDirectoryEntry(t *testing.T) {
+	t.Parallel()
+
+	userID, actorID := uuid.New(), uuid.New()
+
+	t.Run("Success - audit entry names the external ID", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewDirectoryLinkRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`DELETE FROM recipe_manager.user_directory_links`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"external_id"}).AddRow("ada"))
+		mock.ExpectExec(`INSERT INTO recipe_manager.admin_audit_log`).
+			WithArgs(actorID, "directory_unlinked", userID, "ada").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.UnlinkDirectoryEntry(t.Context(), actorID, userID))
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Not linked", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewDirectoryLinkRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`DELETE FROM recipe_manager.user_directory_links`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"external_id"}))
+		mock.ExpectRollback()
+
+		err = repo.UnlinkDirectoryEntry(t.Context(), actorID, userID)
+		require.ErrorIs(t, err, repository.ErrDirectoryLinkNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestDirectoryLinkRepositoryGetDirectoryLink_NotFound(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery(`FROM recipe_manager.user_directory_links`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows(directoryLinkColumns))
+
+	_, err = repository.NewDirectoryLinkRepository(db).GetDirectoryLink(t.Context(), userID)
+	require.ErrorIs(t, err, repository.ErrDirectoryLinkNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestDirectoryLinkRepositorySaveDirectorySync(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	userID := uuid.New()
+	now := time.Now()
+	name, active := "Ada", false
+
+	mock.ExpectExec(`UPDATE recipe_manager.user_directory_links`).
+		WithArgs(userID.String(), "ada", "CONFLICT", &name, nil, &active, "email,isActive", "", &now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repository.NewDirectoryLinkRepository(db).SaveDirectorySync(t.Context(), &dto.DirectoryLink{
+		UserID:       userID.String(),
+		ExternalID:   "ada",
+		Status:       dto.DirectorySyncConflict,
+		Synced:       dto.DirectoryAttributes{FullName: &name, IsActive: &active},
+		Conflicts:    []dto.DirectoryAttribute{dto.DirectoryAttributeEmail, dto.DirectoryAttributeIsActive},
+		LastSyncedAt: &now,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
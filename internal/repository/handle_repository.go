@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrHandleNotFound is returned when no user owns a handle.
+var ErrHandleNotFound = errors.New("handle not found")
+
+// ErrHandleTaken is returned when a handle is already owned by another user.
+var ErrHandleTaken = errors.New("handle already taken")
+
+// HandleRepository defines the interface for profile handle data access.
+// Handles are stored lowercased; callers are expected to normalize before lookup.
+type HandleRepository interface {
+	FindUserIDByHandle(ctx context.Context, handle string) (uuid.UUID, error)
+	ClaimHandle(ctx context.Context, userID uuid.UUID, handle string) (time.Time, error)
+}
+
+// SQLHandleRepository implements HandleRepository using a SQL database.
+type SQLHandleRepository struct {
+	db *sql.DB
+	tx txRunner
+}
+
+// NewHandleRepository creates a new SQLHandleRepository.
+func NewHandleRepository(db *sql.DB) *SQLHandleRepository {
+	return &SQLHandleRepository{db: db, tx: newTxRunner(db)}
+}
+
+// FindUserIDByHandle returns the ID of the user owning handle.
+func (r *SQLHandleRepository) FindUserIDByHandle(ctx context.Context, handle string) (uuid.UUID, error) {
+	query := `
+		SELECT user_id
+		FROM recipe_manager.user_handles
+		WHERE handle = $1
+	`
+
+	var userID uuid.UUID
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, handle).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, ErrHandleNotFound
+		}
+
+		return uuid.Nil, fmt.Errorf("failed to query handle: %w", err)
+	}
+
+	return userID, nil
+}
+
+// ClaimHandle assigns handle to userID, replacing any handle the user previously held.
+// Returns ErrHandleTaken if another user already owns the handle.
+func (r *SQLHandleRepository) ClaimHandle(ctx context.Context, userID uuid.UUID, handle string) (time.Time, error) {
+	query := `
+		INSERT INTO recipe_manager.user_handles (user_id, handle, claimed_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET handle = EXCLUDED.handle, claimed_at = NOW()
+		RETURNING claimed_at
+	`
+
+	var claimedAt time.Time
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query, userID, handle).Scan(&claimedAt)
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return time.Time{}, ErrHandleTaken
+		}
+
+		return time.Time{}, fmt.Errorf("failed to claim handle: %w", err)
+	}
+
+	return claimedAt, nil
+}
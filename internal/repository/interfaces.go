@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 )
 
 // HealthChecker defines the contract for components that can report health status and be closed.
@@ -20,3 +22,119 @@ type TokenStore interface {
 	GetDeleteToken(ctx context.Context, userID uuid.UUID) (string, error)
 	DeleteDeleteToken(ctx context.Context, userID uuid.UUID) error
 }
+
+// EmailChangeStore defines the contract for tracking pending email address changes.
+type EmailChangeStore interface {
+	StoreEmailChange(ctx context.Context, userID uuid.UUID, change *dto.PendingEmailChange, ttl time.Duration) error
+	GetEmailChange(ctx context.Context, userID uuid.UUID) (*dto.PendingEmailChange, error)
+	DeleteEmailChange(ctx context.Context, userID uuid.UUID) error
+}
+
+// HandleReservationStore defines the contract for short-lived handle holds during selection.
+type HandleReservationStore interface {
+	ReserveHandle(ctx context.Context, handle string, userID uuid.UUID, ttl time.Duration) (bool, error)
+	GetHandleReservation(ctx context.Context, handle string) (uuid.UUID, error)
+	ReleaseHandleReservation(ctx context.Context, handle string, userID uuid.UUID) error
+}
+
+// UsernameIndex is a rebuildable prefix index of searchable usernames kept outside PostgreSQL.
+// Rebuilds stage users under a build ID and publish them atomically, replacing the whole index.
+type UsernameIndex interface {
+	MatchUsernamePrefix(ctx context.Context, prefix string, limit int) ([]dto.UserTypeaheadResult, error)
+	StageUsernames(ctx context.Context, buildID string, users []dto.UserTypeaheadResult) error
+	PublishUsernames(ctx context.Context, buildID string) error
+	DiscardUsernames(ctx context.Context, buildID string) error
+}
+
+// MaintenanceStore holds the maintenance switch shared by every instance of the service.
+// GetMaintenance returns nil when the switch was never set.
+type MaintenanceStore interface {
+	GetMaintenance(ctx context.Context) (*dto.MaintenanceStatus, error)
+	SetMaintenance(ctx context.Context, status *dto.MaintenanceStatus) error
+}
+
+// PublicProfileStore holds the public profile projection, shared by every instance.
+// GetPublicProfile returns nil for users without a projected profile. The cursor is the sequence
+// of the last user change log entry applied, zero before any.
+type PublicProfileStore interface {
+	GetPublicProfile(ctx context.Context, userID uuid.UUID) (*dto.PublicProfile, error)
+	SetPublicProfiles(ctx context.Context, profiles []dto.PublicProfile) error
+	DeletePublicProfile(ctx context.Context, userID uuid.UUID) error
+	GetPublicProfileCursor(ctx context.Context) (int64, error)
+	SetPublicProfileCursor(ctx context.Context, cursor int64) error
+}
+
+// SearchCache holds user search results for a short time, shared by every instance.
+// GetSearchResults returns nil on a miss. InvalidateSearchCache drops every stored result.
+type SearchCache interface {
+	GetSearchResults(ctx context.Context, key string) (*dto.UserSearchResponse, error)
+	SetSearchResults(ctx context.Context, key string, results *dto.UserSearchResponse, ttl time.Duration) error
+	InvalidateSearchCache(ctx context.Context) error
+}
+
+// PresenceStore records when users were last seen. TouchPresence keeps the time for ttl;
+// GetLastSeen leaves users who were not seen within it out of the result.
+type PresenceStore interface {
+	TouchPresence(ctx context.Context, userID uuid.UUID, at time.Time, ttl time.Duration) error
+	GetLastSeen(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]time.Time, error)
+}
+
+// BadgeQueue holds the users whose badges are due to be evaluated, shared by every instance.
+// A user queued several times before being dequeued is evaluated once.
+type BadgeQueue interface {
+	EnqueueBadgeEvaluation(ctx context.Context, userID uuid.UUID) error
+	// DequeueBadgeEvaluations removes and returns up to limit queued users.
+	DequeueBadgeEvaluations(ctx context.Context, limit int) ([]uuid.UUID, error)
+}
+
+// ProfileViewStore counts profile views, shared by every instance, until they are added to the
+// database. Viewers are opaque keys so no identity is kept beyond the dedup window.
+type ProfileViewStore interface {
+	// MarkProfileViewed remembers viewer for ttl and reports whether it was not already remembered.
+	MarkProfileViewed(ctx context.Context, userID uuid.UUID, viewer string, ttl time.Duration) (bool, error)
+	// IncrementProfileViews counts one view of the user.
+	IncrementProfileViews(ctx context.Context, userID uuid.UUID) error
+	// GetPendingProfileViews returns the views of the user not added to the database yet.
+	GetPendingProfileViews(ctx context.Context, userID uuid.UUID) (int64, error)
+	// TakeProfileViews sets the counted views aside for adding to the database and returns them.
+	// Views counted meanwhile are kept for the next take. Until AckProfileViews, the same views
+	// are returned again, so a failed flush is retried rather than lost.
+	TakeProfileViews(ctx context.Context) (map[uuid.UUID]int64, error)
+	// AckProfileViews drops the views set aside by TakeProfileViews once they are stored.
+	AckProfileViews(ctx context.Context) error
+}
+
+// RecentlyViewedStore keeps, per user, the profiles they recently viewed, most recent first.
+type RecentlyViewedStore interface {
+	// PushRecentlyViewed moves viewedID to the front of the list of userID, keeping at most limit
+	// profiles, and keeps the list for ttl.
+	PushRecentlyViewed(ctx context.Context, userID, viewedID uuid.UUID, limit int, ttl time.Duration) error
+	// GetRecentlyViewed returns the list of userID, most recent first, empty if there is none.
+	GetRecentlyViewed(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	// ClearRecentlyViewed drops the list of userID.
+	ClearRecentlyViewed(ctx context.Context, userID uuid.UUID) error
+}
+
+// LockStore holds the leases that keep a background job from running on several instances at
+// once. AcquireLock returns the fencing token of the new lease, which increases with every
+// lease taken on name, or 0 if another owner holds it. RenewLock and ReleaseLock only act on a
+// lease the owner still holds; RenewLock reports whether it did.
+type LockStore interface {
+	AcquireLock(ctx context.Context, name, owner string, ttl time.Duration) (int64, error)
+	RenewLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error)
+	ReleaseLock(ctx context.Context, name, owner string) error
+}
+
+// ProfileShareStore defines the contract for tracking the active profile share token per user.
+type ProfileShareStore interface {
+	StoreProfileShareToken(ctx context.Context, userID uuid.UUID, tokenID string, ttl time.Duration) error
+	GetProfileShareToken(ctx context.Context, userID uuid.UUID) (string, error)
+	DeleteProfileShareToken(ctx context.Context, userID uuid.UUID) error
+}
+
+// AccountRecoveryStore holds account recovery tokens by their hash. TakeRecoveryToken removes the
+// token it returns the user of, so each token can only be redeemed once.
+type AccountRecoveryStore interface {
+	StoreRecoveryToken(ctx context.Context, tokenHash string, userID uuid.UUID, ttl time.Duration) error
+	TakeRecoveryToken(ctx context.Context, tokenHash string) (uuid.UUID, error)
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvariantRepository reads the figures the readiness invariants are checked against.
+type InvariantRepository interface {
+	// MissingRelations returns the schema-qualified tables or indexes in relations that do not
+	// exist, sorted.
+	MissingRelations(ctx context.Context, relations []string) ([]string, error)
+	// ChangeBacklog counts the user changes recorded after cursor and returns when the oldest of
+	// them was recorded, or nil when there are none.
+	ChangeBacklog(ctx context.Context, cursor int64) (int64, *time.Time, error)
+	// RecentFolloweeCounts returns the follower counts of the users followed in the limit newest
+	// follows.
+	RecentFolloweeCounts(ctx context.Context, limit int) (map[uuid.UUID]int, error)
+}
+
+// SQLInvariantRepository implements InvariantRepository using a SQL database.
+type SQLInvariantRepository struct {
+	db *sql.DB
+}
+
+// NewInvariantRepository creates a new SQLInvariantRepository.
+func NewInvariantRepository(db *sql.DB) *SQLInvariantRepository {
+	return &SQLInvariantRepository{db: db}
+}
+
+// MissingRelations returns the relations to_regclass cannot resolve.
+func (r *SQLInvariantRepository) MissingRelations(ctx context.Context, relations []string) ([]string, error) {
+	if len(relations) == 0 {
+		return nil, nil
+	}
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, `
+		SELECT name FROM unnest(string_to_array($1, ',')) AS name
+		WHERE to_regclass(name) IS NULL
+		ORDER BY name
+	`, strings.Join(relations, ","))
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up relations: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var missing []string
+
+	for rows.Next() {
+		var name string
+
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan relation: %w", err)
+		}
+
+		missing = append(missing, name)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating relations: %w", err)
+	}
+
+	return missing, nil
+}
+
+// ChangeBacklog counts the change log entries after cursor.
+func (r *SQLInvariantRepository) ChangeBacklog(ctx context.Context, cursor int64) (int64, *time.Time, error) {
+	var (
+		count  int64
+		oldest sql.NullTime
+	)
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT COUNT(*), MIN(changed_at)
+		FROM recipe_manager.user_change_log
+		WHERE sequence > $1
+	`, cursor).Scan(&count, &oldest)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to count user changes: %w", err)
+	}
+
+	if !oldest.Valid {
+		return count, nil, nil
+	}
+
+	return count, &oldest.Time, nil
+}
+
+// RecentFolloweeCounts counts the followers of the users with the newest follows, whose counts are
+// the most likely to have moved since they were last copied.
+func (r *SQLInvariantRepository) RecentFolloweeCounts(ctx context.Context, limit int) (map[uuid.UUID]int, error) {
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, `
+		SELECT f.followee_id, COUNT(*)
+		FROM recipe_manager.user_follows f
+		WHERE f.deleted_at IS NULL AND f.followee_id IN (
+			SELECT followee_id
+			FROM recipe_manager.user_follows
+			WHERE deleted_at IS NULL
+			ORDER BY followed_at DESC
+			LIMIT $1
+		)
+		GROUP BY f.followee_id
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count followers: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	counts := make(map[uuid.UUID]int, limit)
+
+	for rows.Next() {
+		var (
+			userID uuid.UUID
+			count  int
+		)
+
+		err = rows.Scan(&userID, &count)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan follower count: %w", err)
+		}
+
+		counts[userID] = count
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating follower counts: %w", err)
+	}
+
+	return counts, nil
+}
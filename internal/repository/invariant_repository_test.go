@@ -0,0 +1,111 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestInvariantRepositoryMissingRelations(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewInvariantRepository(db)
+
+	mock.ExpectQuery(`SELECT name FROM unnest\(string_to_array\(\$1, ','\)\) AS name\s+WHERE to_regclass\(name\) IS NULL`).
+		WithArgs("recipe_manager.idx_a,recipe_manager.idx_b").
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("recipe_manager.idx_b"))
+
+	missing, err := repo.MissingRelations(t.Context(), []string{"recipe_manager.idx_a", "recipe_manager.idx_b"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"recipe_manager.idx_b"}, missing)
+
+	missing, err = repo.MissingRelations(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestInvariantRepositoryChangeBacklog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success - pending changes", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		oldest := time.Date(2026, time.October, 17, 9, 0, 0, 0, time.UTC)
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\), MIN\(changed_at\)\s+` +
+			`FROM recipe_manager.user_change_log\s+WHERE sequence > \$1`).
+			WithArgs(int64(12)).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "min"}).AddRow(3, oldest))
+
+		count, got, err := repository.NewInvariantRepository(db).ChangeBacklog(t.Context(), 12)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+		assert.Equal(t, &oldest, got)
+		mock.ExpectClose()
+	})
+
+	t.Run("Success - caught up", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`FROM recipe_manager.user_change_log`).
+			WithArgs(int64(12)).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "min"}).AddRow(0, nil))
+
+		count, got, err := repository.NewInvariantRepository(db).ChangeBacklog(t.Context(), 12)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		assert.Nil(t, got)
+		mock.ExpectClose()
+	})
+}
+
+func TestInvariantRepositoryRecentFolloweeCounts(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery(`SELECT f.followee_id, COUNT\(\*\)\s+FROM recipe_manager.user_follows f`).
+		WithArgs(50).
+		WillReturnRows(sqlmock.NewRows([]string{"followee_id", "count"}).AddRow(userID, 8))
+
+	counts, err := repository.NewInvariantRepository(db).RecentFolloweeCounts(t.Context(), 50)
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int{userID: 8}, counts)
+	mock.ExpectClose()
+}
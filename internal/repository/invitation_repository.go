@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+var (
+	// ErrInvitationNotFound is returned when no invitation has the given code.
+	ErrInvitationNotFound = errors.New("invitation not found")
+	// ErrInvitationLimitReached is returned when a user has created as many invitations as allowed.
+	ErrInvitationLimitReached = errors.New("invitation limit reached")
+	// ErrInvitationRedeemed is returned when an invitation has already been redeemed.
+	ErrInvitationRedeemed = errors.New("invitation already redeemed")
+	// ErrAlreadyInvited is returned when a user who already redeemed an invitation redeems another.
+	ErrAlreadyInvited = errors.New("user already invited")
+)
+
+// InvitationRepository stores invitation codes and who redeemed them. Status is left for callers
+// to derive, since it depends on the time.
+type InvitationRepository interface {
+	// CreateInvitation stores an invitation with its code, inviter and expiry, and sets its
+	// creation time and inviter username. It fails with ErrInvitationLimitReached when the inviter
+	// has already created limit invitations; zero is unlimited.
+	CreateInvitation(ctx context.Context, invitation *dto.Invitation, limit int) error
+	// CountInvitations returns how many invitations a user has created.
+	CountInvitations(ctx context.Context, inviterID uuid.UUID) (int, error)
+	// ListInvitations returns the invitations a user created, newest first.
+	ListInvitations(ctx context.Context, inviterID uuid.UUID) ([]dto.Invitation, error)
+	// GetInvitation returns an invitation by code.
+	GetInvitation(ctx context.Context, code string) (*dto.Invitation, error)
+	// RedeemInvitation records that inviteeID redeemed an invitation that was not yet redeemed.
+	RedeemInvitation(ctx context.Context, code string, inviteeID uuid.UUID, redeemedAt time.Time) error
+	// GetInvitationStats counts all invitations and redemptions, with the limit users whose
+	// invitations were redeemed most.
+	GetInvitationStats(ctx context.Context, limit int) (*dto.InvitationStatsResponse, error)
+}
+
+// SQLInvitationRepository implements InvitationRepository using a SQL database.
+type SQLInvitationRepository struct {
+	db *sql.DB
+	tx txRunner
+}
+
+// NewInvitationRepository creates a new SQLInvitationRepository.
+func NewInvitationRepository(db *sql.DB) *SQLInvitationRepository {
+	return &SQLInvitationRepository{db: db, tx: newTxRunner(db)}
+}
+
+const invitationColumns = `i.code, i.inviter_id, u.username, i.created_at, i.expires_at, i.redeemed_by, i.redeemed_at`
+
+// CreateInvitation stores an invitation unless the inviter has reached limit.
+func (r *SQLInvitationRepository) CreateInvitation(ctx context.Context, invitation *dto.Invitation, limit int) error {
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		// Locking the inviter serializes their invitations, so concurrent requests cannot exceed
+		// the limit
+		err := tx.QueryRowContext(ctx, `
+			SELECT username FROM recipe_manager.users WHERE user_id = $1 FOR UPDATE
+		`, invitation.InviterID).Scan(&invitation.InviterUsername)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrUserNotFound
+			}
+
+			return err
+		}
+
+		if limit > 0 {
+			var created int
+
+			err = tx.QueryRowContext(ctx, `
+				SELECT COUNT(*) FROM recipe_manager.invitations WHERE inviter_id = $1
+			`, invitation.InviterID).Scan(&created)
+			if err != nil {
+				return err
+			}
+
+			if created >= limit {
+				return ErrInvitationLimitReached
+			}
+		}
+
+		return tx.QueryRowContext(ctx, `
+			INSERT INTO recipe_manager.invitations (code, inviter_id, expires_at)
+			VALUES ($1, $2, $3)
+			RETURNING created_at
+		`, invitation.Code, invitation.InviterID, invitation.ExpiresAt).Scan(&invitation.CreatedAt)
+	})
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) || errors.Is(err, ErrInvitationLimitReached) {
+			return err
+		}
+
+		return fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	return nil
+}
+
+// CountInvitations returns how many invitations a user has created.
+func (r *SQLInvitationRepository) CountInvitations(ctx context.Context, inviterID uuid.UUID) (int, error) {
+	var count int
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM recipe_manager.invitations WHERE inviter_id = $1
+	`, inviterID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count invitations: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListInvitations returns the invitations a user created, newest first.
+func (r *SQLInvitationRepository) ListInvitations(ctx context.Context, inviterID uuid.UUID) ([]dto.Invitation, error) {
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM recipe_manager.invitations i
+		JOIN recipe_manager.users u ON u.user_id = i.inviter_id
+		WHERE i.inviter_id = $1
+		ORDER BY i.created_at DESC, i.code
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, inviterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch invitations: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	invitations := []dto.Invitation{}
+
+	for rows.Next() {
+		var invitation dto.Invitation
+
+		err = scanInvitation(rows, &invitation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invitation: %w", err)
+		}
+
+		invitations = append(invitations, invitation)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+// GetInvitation returns an invitation by code.
+func (r *SQLInvitationRepository) GetInvitation(ctx context.Context, code string) (*dto.Invitation, error) {
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM recipe_manager.invitations i
+		JOIN recipe_manager.users u ON u.user_id = i.inviter_id
+		WHERE i.code = $1
+	`
+
+	var invitation dto.Invitation
+
+	err := scanInvitation(executorFor(ctx, r.db).QueryRowContext(ctx, query, code), &invitation)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvitationNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch invitation: %w", err)
+	}
+
+	return &invitation, nil
+}
+
+// RedeemInvitation marks an invitation as redeemed by inviteeID.
+func (r *SQLInvitationRepository) RedeemInvitation(
+	ctx context.Context,
+	code string,
+	inviteeID uuid.UUID,
+	redeemedAt time.Time,
+) error {
+	query := `
+		UPDATE recipe_manager.invitations
+		SET redeemed_by = $2, redeemed_at = $3
+		WHERE code = $1 AND redeemed_at IS NULL
+	`
+
+	result, err := executorFor(ctx, r.db).ExecContext(ctx, query, code, inviteeID, redeemedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return ErrAlreadyInvited
+			case "23503":
+				return ErrUserNotFound
+			}
+		}
+
+		return fmt.Errorf("failed to redeem invitation: %w", err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to redeem invitation: %w", err)
+	}
+
+	if updated == 0 {
+		return ErrInvitationRedeemed
+	}
+
+	return nil
+}
+
+// GetInvitationStats counts invitations and redemptions and ranks inviters by redemptions.
+func (r *SQLInvitationRepository) GetInvitationStats(
+	ctx context.Context,
+	limit int,
+) (*dto.InvitationStatsResponse, error) {
+	stats := &dto.InvitationStatsResponse{TopInviters: []dto.InviterStats{}}
+	exec := executorFor(ctx, r.db)
+
+	err := exec.QueryRowContext(ctx, `
+		SELECT COUNT(*), COUNT(redeemed_at) FROM recipe_manager.invitations
+	`).Scan(&stats.Created, &stats.Redeemed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count invitations: %w", err)
+	}
+
+	rows, err := exec.QueryContext(ctx, `
+		SELECT i.inviter_id, u.username, COUNT(*) AS redeemed
+		FROM recipe_manager.invitations i
+		JOIN recipe_manager.users u ON u.user_id = i.inviter_id
+		WHERE i.redeemed_at IS NOT NULL
+		GROUP BY i.inviter_id, u.username
+		ORDER BY redeemed DESC, i.inviter_id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch top inviters: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var inviter dto.InviterStats
+
+		err = rows.Scan(&inviter.UserID, &inviter.Username, &inviter.Redeemed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan inviter: %w", err)
+		}
+
+		stats.TopInviters = append(stats.TopInviters, inviter)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating inviters: %w", err)
+	}
+
+	return stats, nil
+}
+
+func scanInvitation(row rowScanner, invitation *dto.Invitation) error {
+	var (
+		redeemedBy sql.NullString
+		redeemedAt sql.NullTime
+	)
+
+	err := row.Scan(&invitation.Code, &invitation.InviterID, &invitation.InviterUsername,
+		&invitation.CreatedAt, &invitation.ExpiresAt, &redeemedBy, &redeemedAt)
+	if err != nil {
+		return err
+	}
+
+	if redeemedBy.Valid {
+		invitation.RedeemedBy = &redeemedBy.String
+	}
+
+	if redeemedAt.Valid {
+		invitation.RedeemedAt = &redeemedAt.Time
+	}
+
+	return nil
+}
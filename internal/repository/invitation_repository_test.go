@@ -0,0 +1,163 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestInvitationRepositoryCreateInvitation(t *testing.T) {
+	t.Parallel()
+
+	inviterID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		createdAt := time.Now()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT username FROM recipe_manager.users WHERE user_id = \$1 FOR UPDATE`).
+			WithArgs(inviterID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"username"}).AddRow("alice"))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM recipe_manager.invitations`).
+			WithArgs(inviterID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectQuery(`INSERT INTO recipe_manager.invitations`).
+			WithArgs("ABC", inviterID.String(), expiresAt).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(createdAt))
+		mock.ExpectCommit()
+
+		invitation := &dto.Invitation{Code: "ABC", InviterID: inviterID.String(), ExpiresAt: expiresAt}
+
+		err = repository.NewInvitationRepository(db).CreateInvitation(t.Context(), invitation, 3)
+		require.NoError(t, err)
+		assert.Equal(t, "alice", invitation.InviterUsername)
+		assert.Equal(t, createdAt, invitation.CreatedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Limit reached", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT username FROM recipe_manager.users`).
+			WithArgs(inviterID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"username"}).AddRow("alice"))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM recipe_manager.invitations`).
+			WithArgs(inviterID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectRollback()
+
+		invitation := &dto.Invitation{Code: "ABC", InviterID: inviterID.String(), ExpiresAt: expiresAt}
+
+		err = repository.NewInvitationRepository(db).CreateInvitation(t.Context(), invitation, 3)
+		require.ErrorIs(t, err, repository.ErrInvitationLimitReached)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestInvitationRepositoryRedeemInvitation(t *testing.T) {
+	t.Parallel()
+
+	inviteeID := uuid.New()
+	redeemedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		result  func(*sqlmock.ExpectedExec)
+		wantErr error
+	}{
+		{name: "Success", result: func(e *sqlmock.ExpectedExec) {
+			e.WillReturnResult(sqlmock.NewResult(0, 1))
+		}},
+		{name: "Already redeemed", result: func(e *sqlmock.ExpectedExec) {
+			e.WillReturnResult(sqlmock.NewResult(0, 0))
+		}, wantErr: repository.ErrInvitationRedeemed},
+		{name: "Invitee already invited", result: func(e *sqlmock.ExpectedExec) {
+			e.WillReturnError(&pgconn.PgError{Code: "23505"})
+		}, wantErr: repository.ErrAlreadyInvited},
+		{name: "Invitee not found", result: func(e *sqlmock.ExpectedExec) {
+			e.WillReturnError(&pgconn.PgError{Code: "23503"})
+		}, wantErr: repository.ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			defer func() {
+				require.NoError(t, db.Close())
+			}()
+
+			tt.result(mock.ExpectExec(`UPDATE recipe_manager.invitations`).WithArgs("ABC", inviteeID, redeemedAt))
+
+			err = repository.NewInvitationRepository(db).RedeemInvitation(t.Context(), "ABC", inviteeID, redeemedAt)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+			mock.ExpectClose()
+		})
+	}
+}
+
+func TestInvitationRepositoryGetInvitationStats(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	inviterID := uuid.NewString()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\), COUNT\(redeemed_at\) FROM recipe_manager.invitations`).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(12, 5))
+	mock.ExpectQuery(`SELECT i.inviter_id, u.username, COUNT\(\*\) AS redeemed`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"inviter_id", "username", "redeemed"}).AddRow(inviterID, "alice", 4))
+
+	stats, err := repository.NewInvitationRepository(db).GetInvitationStats(t.Context(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, &dto.InvitationStatsResponse{
+		Created:     12,
+		Redeemed:    5,
+		TopInviters: []dto.InviterStats{{UserID: inviterID, Username: "alice", Redeemed: 4}},
+	}, stats)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
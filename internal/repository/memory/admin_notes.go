@@ -0,0 +1,152 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// CreateNote stores a note and records it in the audit trail.
+func (s *Store) CreateNote(_ context.Context, userID uuid.UUID, note *dto.AdminNote) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	s.noteSequence++
+	note.NoteID = s.noteSequence
+	note.UserID = userID.String()
+	note.CreatedAt = now
+	note.UpdatedAt = now
+	s.notes = append(s.notes, *note)
+
+	s.recordAudit(note.AuthorID, dto.AuditActionNoteCreated, userID, noteResourceID(note.NoteID), now)
+
+	return nil
+}
+
+// ListNotes returns the notes on a user, pinned notes first, then newest first.
+func (s *Store) ListNotes(_ context.Context, userID uuid.UUID) ([]dto.AdminNote, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	notes := []dto.AdminNote{}
+
+	for _, note := range s.notes {
+		if note.UserID == userID.String() {
+			notes = append(notes, note)
+		}
+	}
+
+	slices.SortFunc(notes, func(a, b dto.AdminNote) int {
+		if a.Pinned != b.Pinned {
+			if a.Pinned {
+				return -1
+			}
+
+			return 1
+		}
+
+		return cmp.Compare(b.NoteID, a.NoteID)
+	})
+
+	return notes, nil
+}
+
+// UpdateNote changes the text and pinned flag of a note and records it in the audit trail.
+func (s *Store) UpdateNote(
+	_ context.Context,
+	actorID, userID uuid.UUID,
+	noteID int64,
+	update *dto.AdminNoteUpdateRequest,
+) (*dto.AdminNote, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.noteIndex(userID, noteID)
+	if i < 0 {
+		return nil, repository.ErrNoteNotFound
+	}
+
+	now := time.Now()
+	note := &s.notes[i]
+
+	if update.Text != nil {
+		note.Text = *update.Text
+	}
+
+	if update.Pinned != nil {
+		note.Pinned = *update.Pinned
+	}
+
+	note.UpdatedAt = now
+
+	s.recordAudit(actorID.String(), dto.AuditActionNoteUpdated, userID, noteResourceID(noteID), now)
+
+	updated := *note
+
+	return &updated, nil
+}
+
+// DeleteNote removes a note and records it in the audit trail.
+func (s *Store) DeleteNote(_ context.Context, actorID, userID uuid.UUID, noteID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.noteIndex(userID, noteID)
+	if i < 0 {
+		return repository.ErrNoteNotFound
+	}
+
+	s.notes = slices.Delete(s.notes, i, i+1)
+
+	s.recordAudit(actorID.String(), dto.AuditActionNoteDeleted, userID, noteResourceID(noteID), time.Now())
+
+	return nil
+}
+
+// GetAuditTrail returns up to limit entries about the user, newest first.
+func (s *Store) GetAuditTrail(_ context.Context, userID uuid.UUID, limit int) ([]dto.AuditEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := []dto.AuditEntry{}
+
+	for i := len(s.audit) - 1; i >= 0 && len(entries) < limit; i-- {
+		if s.audit[i].UserID == userID.String() {
+			entries = append(entries, s.audit[i])
+		}
+	}
+
+	return entries, nil
+}
+
+// noteIndex returns the position of the note in s.notes, or -1. Callers must hold s.mu.
+func (s *Store) noteIndex(userID uuid.UUID, noteID int64) int {
+	return slices.IndexFunc(s.notes, func(note dto.AdminNote) bool {
+		return note.NoteID == noteID && note.UserID == userID.String()
+	})
+}
+
+// recordAudit appends an entry to the audit trail. Callers must hold s.mu.
+func (s *Store) recordAudit(actorID string, action dto.AuditAction, userID uuid.UUID, resourceID string, at time.Time) {
+	s.audit = append(s.audit, dto.AuditEntry{
+		AuditID:    int64(len(s.audit) + 1),
+		ActorID:    actorID,
+		Action:     action,
+		UserID:     userID.String(),
+		ResourceID: resourceID,
+		RecordedAt: at,
+	})
+}
+
+func noteResourceID(noteID int64) string {
+	return "note:" + strconv.FormatInt(noteID, 10)
+}
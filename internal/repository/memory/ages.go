@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// GetAgeVerification returns the user's birthdate and override.
+func (s *Store) GetAgeVerification(_ context.Context, userID uuid.UUID) (*dto.AgeVerification, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	verification := s.ages[userID]
+
+	return &verification, nil
+}
+
+// SetBirthdate stores the user's birthdate, keeping any override.
+func (s *Store) SetBirthdate(_ context.Context, userID uuid.UUID, birthdate time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	verification := s.ages[userID]
+	verification.Birthdate = &birthdate
+	s.ages[userID] = verification
+
+	return nil
+}
+
+// SetAgeOverride stores an admin override, keeping the birthdate. An empty override clears it.
+func (s *Store) SetAgeOverride(_ context.Context, userID uuid.UUID, override dto.AgeOverride) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	verification := s.ages[userID]
+	verification.Override = override
+	s.ages[userID] = verification
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// GetBadgeMetrics returns the follower count and sign-up time of a user. Recipes are owned by
+// another service, so the recipe count is always zero.
+func (s *Store) GetBadgeMetrics(_ context.Context, userID uuid.UUID) (*dto.BadgeMetrics, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.users[userID]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	metrics := &dto.BadgeMetrics{MemberSince: user.CreatedAt}
+
+	for key := range s.follows {
+		if key.followee == userID {
+			metrics.Followers++
+		}
+	}
+
+	return metrics, nil
+}
+
+// AwardBadges records the badges as earned by the user now and returns the ones newly earned.
+func (s *Store) AwardBadges(_ context.Context, userID uuid.UUID, badgeIDs []string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	var awarded []string
+
+	for _, badgeID := range badgeIDs {
+		if s.hasBadge(userID, badgeID) {
+			continue
+		}
+
+		s.badges[userID] = append(s.badges[userID], dto.EarnedBadge{BadgeID: badgeID, EarnedAt: now})
+		awarded = append(awarded, badgeID)
+	}
+
+	return awarded, nil
+}
+
+// ListUserBadges returns the badges a user earned, earliest first.
+func (s *Store) ListUserBadges(_ context.Context, userID uuid.UUID) ([]dto.EarnedBadge, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Badges are appended as they are earned, so they are already in order
+	return append([]dto.EarnedBadge{}, s.badges[userID]...), nil
+}
+
+// ListUsersMissingBadge returns up to limit active users who signed up before joinedBefore and
+// have not earned the badge.
+func (s *Store) ListUsersMissingBadge(
+	_ context.Context,
+	badgeID string,
+	joinedBefore time.Time,
+	limit int,
+) ([]uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var userIDs []uuid.UUID
+
+	for id, user := range s.users {
+		if user.IsActive && user.CreatedAt.Before(joinedBefore) && !s.hasBadge(id, badgeID) {
+			userIDs = append(userIDs, id)
+		}
+	}
+
+	slices.SortFunc(userIDs, func(a, b uuid.UUID) int {
+		return cmp.Or(s.users[a].CreatedAt.Compare(s.users[b].CreatedAt), cmp.Compare(a.String(), b.String()))
+	})
+
+	return userIDs[:min(limit, len(userIDs))], nil
+}
+
+// EnqueueBadgeEvaluation queues the user's badges for evaluation.
+func (s *Store) EnqueueBadgeEvaluation(_ context.Context, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.badgeQueue[userID] = struct{}{}
+
+	return nil
+}
+
+// DequeueBadgeEvaluations removes and returns up to limit queued users.
+func (s *Store) DequeueBadgeEvaluations(_ context.Context, limit int) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var userIDs []uuid.UUID
+
+	for userID := range s.badgeQueue {
+		if len(userIDs) == limit {
+			break
+		}
+
+		delete(s.badgeQueue, userID)
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, nil
+}
+
+// hasBadge reports whether the user earned the badge. Callers must hold s.mu.
+func (s *Store) hasBadge(userID uuid.UUID, badgeID string) bool {
+	return slices.ContainsFunc(s.badges[userID], func(b dto.EarnedBadge) bool { return b.BadgeID == badgeID })
+}
@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// RecordConsent appends a decision to the ledger, turning off the covered preference on withdrawal.
+func (s *Store) RecordConsent(_ context.Context, userID uuid.UUID, record *dto.ConsentRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record.RecordedAt = time.Now()
+	s.consents[userID] = append(s.consents[userID], *record)
+
+	if !record.Granted {
+		s.withdrawPreference(userID, record.Purpose, record.RecordedAt)
+	}
+
+	return nil
+}
+
+// GetCurrentConsents returns the latest decision for each purpose the user decided on.
+func (s *Store) GetCurrentConsents(_ context.Context, userID uuid.UUID) ([]dto.ConsentRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	latest := make(map[dto.ConsentPurpose]dto.ConsentRecord)
+	for _, record := range s.consents[userID] {
+		latest[record.Purpose] = record
+	}
+
+	records := []dto.ConsentRecord{}
+
+	for _, purpose := range dto.ValidConsentPurposes {
+		if record, ok := latest[purpose]; ok {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+// GetConsentHistory returns every decision of the user, newest first.
+func (s *Store) GetConsentHistory(_ context.Context, userID uuid.UUID) ([]dto.ConsentRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records := slices.Clone(s.consents[userID])
+	slices.Reverse(records)
+
+	if records == nil {
+		records = []dto.ConsentRecord{}
+	}
+
+	return records, nil
+}
+
+// withdrawPreference turns off the saved preference covered by purpose. Unsaved categories
+// already read as off. Callers must hold the write lock.
+func (s *Store) withdrawPreference(userID uuid.UUID, purpose dto.ConsentPurpose, now time.Time) {
+	set, ok := s.preferences[userID]
+	if !ok {
+		return
+	}
+
+	var category dto.PreferenceCategory
+
+	switch purpose {
+	case dto.ConsentPurposeMarketingEmails:
+		if p := set.notification; p != nil && p.MarketingEmails {
+			p.MarketingEmails, p.UpdatedAt = false, now
+			category = dto.PreferenceCategoryNotification
+		}
+	case dto.ConsentPurposeAnalyticsTracking:
+		if p := set.privacy; p != nil && p.AnalyticsTracking {
+			p.AnalyticsTracking, p.UpdatedAt = false, now
+			category = dto.PreferenceCategoryPrivacy
+		}
+	case dto.ConsentPurposeDataSharing:
+		if p := set.privacy; p != nil && p.DataSharing {
+			p.DataSharing, p.UpdatedAt = false, now
+			category = dto.PreferenceCategoryPrivacy
+		}
+	}
+
+	if category != "" {
+		name := string(category)
+		s.recordChange(userID, dto.UserChangeTypePreferenceChanged, &name)
+	}
+}
@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+type dataAccessKey struct {
+	userID   uuid.UUID
+	clientID string
+	category dto.DataCategory
+}
+
+// RecordDataAccess counts one read of category by clientID.
+func (s *Store) RecordDataAccess(
+	_ context.Context,
+	userID uuid.UUID,
+	clientID string,
+	category dto.DataCategory,
+	accessedAt time.Time,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := dataAccessKey{userID: userID, clientID: clientID, category: category}
+
+	consumer, ok := s.dataAccess[key]
+	if !ok {
+		consumer = dto.DataConsumer{ClientID: clientID, Category: category}
+	}
+
+	consumer.AccessCount++
+	if accessedAt.After(consumer.LastAccessedAt) {
+		consumer.LastAccessedAt = accessedAt
+	}
+
+	s.dataAccess[key] = consumer
+
+	return nil
+}
+
+// GetDataConsumers lists the services that read a user's data, most recent first.
+func (s *Store) GetDataConsumers(_ context.Context, userID uuid.UUID) ([]dto.DataConsumer, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var consumers []dto.DataConsumer
+
+	for key, consumer := range s.dataAccess {
+		if key.userID == userID {
+			consumers = append(consumers, consumer)
+		}
+	}
+
+	slices.SortFunc(consumers, func(a, b dto.DataConsumer) int {
+		return cmp.Or(
+			b.LastAccessedAt.Compare(a.LastAccessedAt),
+			cmp.Compare(a.ClientID, b.ClientID),
+			cmp.Compare(a.Category, b.Category),
+		)
+	})
+
+	return consumers, nil
+}
@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// registeredDevice is a device token and the user it is registered to.
+type registeredDevice struct {
+	userID uuid.UUID
+	device dto.DeviceToken
+}
+
+// RegisterDeviceToken stores or refreshes a device and trims the user's devices to limit.
+func (s *Store) RegisterDeviceToken(_ context.Context, userID uuid.UUID, device *dto.DeviceToken, limit int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	i := slices.IndexFunc(s.devices, func(d registeredDevice) bool { return d.device.Token == device.Token })
+	if i < 0 {
+		s.deviceSequence++
+		s.devices = append(s.devices, registeredDevice{
+			userID: userID,
+			device: dto.DeviceToken{DeviceID: s.deviceSequence, Token: device.Token, RegisteredAt: now},
+		})
+		i = len(s.devices) - 1
+	}
+
+	// A token moving to another user counts as a new registration
+	entry := &s.devices[i]
+	if entry.userID != userID {
+		entry.userID = userID
+		entry.device.RegisteredAt = now
+	}
+
+	entry.device.Platform = device.Platform
+	entry.device.AppVersion = device.AppVersion
+	entry.device.LastSeenAt = now
+	*device = entry.device
+
+	if limit > 0 {
+		devices := s.userDevices(userID)
+		for _, stale := range devices[min(limit, len(devices)):] {
+			s.devices = slices.DeleteFunc(s.devices, func(d registeredDevice) bool {
+				return d.device.DeviceID == stale.DeviceID
+			})
+		}
+	}
+
+	return nil
+}
+
+// ListDeviceTokens returns the devices registered to a user, most recently seen first.
+func (s *Store) ListDeviceTokens(_ context.Context, userID uuid.UUID) ([]dto.DeviceToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.userDevices(userID), nil
+}
+
+// DeleteDeviceToken unregisters a device from a user.
+func (s *Store) DeleteDeviceToken(_ context.Context, userID uuid.UUID, deviceID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := len(s.devices)
+	s.devices = slices.DeleteFunc(s.devices, func(d registeredDevice) bool {
+		return d.userID == userID && d.device.DeviceID == deviceID
+	})
+
+	if len(s.devices) == before {
+		return repository.ErrDeviceNotFound
+	}
+
+	return nil
+}
+
+// DeleteStaleDeviceTokens removes devices last seen before cutoff and returns how many.
+func (s *Store) DeleteStaleDeviceTokens(_ context.Context, cutoff time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := len(s.devices)
+	s.devices = slices.DeleteFunc(s.devices, func(d registeredDevice) bool {
+		return d.device.LastSeenAt.Before(cutoff)
+	})
+
+	return int64(before - len(s.devices)), nil
+}
+
+// userDevices returns the devices registered to a user, most recently seen first.
+// Callers must hold s.mu.
+func (s *Store) userDevices(userID uuid.UUID) []dto.DeviceToken {
+	devices := []dto.DeviceToken{}
+
+	for _, d := range s.devices {
+		if d.userID == userID {
+			devices = append(devices, d.device)
+		}
+	}
+
+	slices.SortFunc(devices, func(a, b dto.DeviceToken) int {
+		return cmp.Or(b.LastSeenAt.Compare(a.LastSeenAt), cmp.Compare(b.DeviceID, a.DeviceID))
+	})
+
+	return devices
+}
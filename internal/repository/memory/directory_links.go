@@ -0,0 +1,135 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// LinkDirectoryEntry maps the user to the external ID and records it in the audit trail.
+func (s *Store) LinkDirectoryEntry(
+	_ context.Context,
+	actorID, userID uuid.UUID,
+	externalID string,
+) (*dto.DirectoryLink, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	for owner, link := range s.directoryLinks {
+		if link.ExternalID == externalID && owner != userID {
+			return nil, repository.ErrExternalIDLinked
+		}
+	}
+
+	if link, ok := s.directoryLinks[userID]; ok && link.ExternalID == externalID {
+		return copyDirectoryLink(link), nil
+	}
+
+	now := time.Now()
+	link := &dto.DirectoryLink{
+		UserID:     userID.String(),
+		ExternalID: externalID,
+		Status:     dto.DirectorySyncPending,
+		Conflicts:  []dto.DirectoryAttribute{},
+		CreatedAt:  now,
+	}
+	s.directoryLinks[userID] = link
+
+	s.recordAudit(actorID.String(), dto.AuditActionDirectoryLinked, userID, externalID, now)
+
+	return copyDirectoryLink(link), nil
+}
+
+// GetDirectoryLink returns the link of a user.
+func (s *Store) GetDirectoryLink(_ context.Context, userID uuid.UUID) (*dto.DirectoryLink, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	link, ok := s.directoryLinks[userID]
+	if !ok {
+		return nil, repository.ErrDirectoryLinkNotFound
+	}
+
+	return copyDirectoryLink(link), nil
+}
+
+// UnlinkDirectoryEntry removes the link of a user and records it in the audit trail.
+func (s *Store) UnlinkDirectoryEntry(_ context.Context, actorID, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	link, ok := s.directoryLinks[userID]
+	if !ok {
+		return repository.ErrDirectoryLinkNotFound
+	}
+
+	delete(s.directoryLinks, userID)
+
+	s.recordAudit(actorID.String(), dto.AuditActionDirectoryUnlinked, userID, link.ExternalID, time.Now())
+
+	return nil
+}
+
+// ListDirectoryLinks returns up to limit links of users after the given one, in user ID order.
+func (s *Store) ListDirectoryLinks(_ context.Context, after uuid.UUID, limit int) ([]dto.DirectoryLink, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	userIDs := make([]uuid.UUID, 0, len(s.directoryLinks))
+	for userID := range s.directoryLinks {
+		if bytes.Compare(userID[:], after[:]) > 0 {
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	slices.SortFunc(userIDs, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+
+	var links []dto.DirectoryLink
+	for _, userID := range paginate(userIDs, limit, 0) {
+		links = append(links, *copyDirectoryLink(s.directoryLinks[userID]))
+	}
+
+	return links, nil
+}
+
+// SaveDirectorySync stores the outcome of a sync, unless the link was removed or remapped.
+func (s *Store) SaveDirectorySync(_ context.Context, link *dto.DirectoryLink) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userID, err := uuid.Parse(link.UserID)
+	if err != nil {
+		return repository.ErrUserNotFound
+	}
+
+	stored, ok := s.directoryLinks[userID]
+	if !ok || stored.ExternalID != link.ExternalID {
+		return nil
+	}
+
+	stored.Status = link.Status
+	stored.Synced = link.Synced
+	stored.Conflicts = slices.Clone(link.Conflicts)
+	stored.LastError = link.LastError
+	stored.LastSyncedAt = link.LastSyncedAt
+
+	return nil
+}
+
+// copyDirectoryLink returns a copy of link so callers cannot mutate stored state.
+func copyDirectoryLink(link *dto.DirectoryLink) *dto.DirectoryLink {
+	c := *link
+	c.Conflicts = slices.Clone(link.Conflicts)
+
+	return &c
+}
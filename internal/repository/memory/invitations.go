@@ -0,0 +1,170 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// CreateInvitation stores an invitation unless the inviter has reached limit.
+func (s *Store) CreateInvitation(_ context.Context, invitation *dto.Invitation, limit int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inviterID, err := uuid.Parse(invitation.InviterID)
+	if err != nil {
+		return repository.ErrUserNotFound
+	}
+
+	inviter, ok := s.users[inviterID]
+	if !ok {
+		return repository.ErrUserNotFound
+	}
+
+	if limit > 0 && s.countInvitationsLocked(invitation.InviterID) >= limit {
+		return repository.ErrInvitationLimitReached
+	}
+
+	invitation.InviterUsername = inviter.Username
+	invitation.CreatedAt = time.Now()
+
+	stored := *invitation
+	s.invitations[invitation.Code] = &stored
+
+	return nil
+}
+
+// CountInvitations returns how many invitations a user has created.
+func (s *Store) CountInvitations(_ context.Context, inviterID uuid.UUID) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.countInvitationsLocked(inviterID.String()), nil
+}
+
+// ListInvitations returns the invitations a user created, newest first.
+func (s *Store) ListInvitations(_ context.Context, inviterID uuid.UUID) ([]dto.Invitation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	invitations := []dto.Invitation{}
+
+	for _, invitation := range s.invitations {
+		if invitation.InviterID == inviterID.String() {
+			invitations = append(invitations, s.invitationLocked(invitation))
+		}
+	}
+
+	slices.SortFunc(invitations, func(a, b dto.Invitation) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), cmp.Compare(a.Code, b.Code))
+	})
+
+	return invitations, nil
+}
+
+// GetInvitation returns an invitation by code.
+func (s *Store) GetInvitation(_ context.Context, code string) (*dto.Invitation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	invitation, ok := s.invitations[code]
+	if !ok {
+		return nil, repository.ErrInvitationNotFound
+	}
+
+	found := s.invitationLocked(invitation)
+
+	return &found, nil
+}
+
+// RedeemInvitation marks an invitation as redeemed by inviteeID.
+func (s *Store) RedeemInvitation(_ context.Context, code string, inviteeID uuid.UUID, redeemedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	invitation, ok := s.invitations[code]
+	if !ok || invitation.RedeemedAt != nil {
+		return repository.ErrInvitationRedeemed
+	}
+
+	if _, ok := s.users[inviteeID]; !ok {
+		return repository.ErrUserNotFound
+	}
+
+	invitee := inviteeID.String()
+	for _, other := range s.invitations {
+		if other.RedeemedBy != nil && *other.RedeemedBy == invitee {
+			return repository.ErrAlreadyInvited
+		}
+	}
+
+	invitation.RedeemedBy = &invitee
+	invitation.RedeemedAt = &redeemedAt
+
+	return nil
+}
+
+// GetInvitationStats counts invitations and redemptions and ranks inviters by redemptions.
+func (s *Store) GetInvitationStats(_ context.Context, limit int) (*dto.InvitationStatsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := &dto.InvitationStatsResponse{TopInviters: []dto.InviterStats{}}
+	redeemed := make(map[string]int64)
+
+	for _, invitation := range s.invitations {
+		stats.Created++
+
+		if invitation.RedeemedAt != nil {
+			stats.Redeemed++
+			redeemed[invitation.InviterID]++
+		}
+	}
+
+	for inviterID, count := range redeemed {
+		inviter := dto.InviterStats{UserID: inviterID, Redeemed: count}
+		if user, ok := s.users[uuid.MustParse(inviterID)]; ok {
+			inviter.Username = user.Username
+		}
+
+		stats.TopInviters = append(stats.TopInviters, inviter)
+	}
+
+	slices.SortFunc(stats.TopInviters, func(a, b dto.InviterStats) int {
+		return cmp.Or(cmp.Compare(b.Redeemed, a.Redeemed), cmp.Compare(a.UserID, b.UserID))
+	})
+
+	stats.TopInviters = stats.TopInviters[:min(limit, len(stats.TopInviters))]
+
+	return stats, nil
+}
+
+// invitationLocked returns a copy of an invitation with its inviter's current username. Callers
+// must hold s.mu.
+func (s *Store) invitationLocked(invitation *dto.Invitation) dto.Invitation {
+	found := *invitation
+	if user, ok := s.users[uuid.MustParse(invitation.InviterID)]; ok {
+		found.InviterUsername = user.Username
+	}
+
+	return found
+}
+
+// countInvitationsLocked returns how many invitations a user has created. Callers must hold s.mu.
+func (s *Store) countInvitationsLocked(inviterID string) int {
+	count := 0
+
+	for _, invitation := range s.invitations {
+		if invitation.InviterID == inviterID {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+// The key prefix mirrors the Redis key layout.
+func lockKey(name string) string { return "lock:" + name }
+
+// AcquireLock takes the lease on name for owner and returns its fencing token, or 0 if another
+// owner holds it.
+func (s *Store) AcquireLock(_ context.Context, name, owner string, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, held := s.getEphemeral(lockKey(name)); held {
+		return 0, nil
+	}
+
+	s.setEphemeral(lockKey(name), owner, ttl)
+	s.lockFences[name]++
+
+	return s.lockFences[name], nil
+}
+
+// RenewLock extends owner's lease on name by ttl and reports whether owner still held it.
+func (s *Store) RenewLock(_ context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if holder, held := s.getEphemeral(lockKey(name)); !held || holder != owner {
+		return false, nil
+	}
+
+	s.setEphemeral(lockKey(name), owner, ttl)
+
+	return true, nil
+}
+
+// ReleaseLock gives up owner's lease on name. A lease held by another owner is left alone.
+func (s *Store) ReleaseLock(_ context.Context, name, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if holder, held := s.getEphemeral(lockKey(name)); held && holder == owner {
+		delete(s.ephemeral, lockKey(name))
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// GetMaintenance returns the maintenance switch, or nil if it was never set.
+func (s *Store) GetMaintenance(_ context.Context) (*dto.MaintenanceStatus, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.maintenance == nil {
+		return nil, nil //nolint:nilnil // never set is not an error
+	}
+
+	status := *s.maintenance
+
+	return &status, nil
+}
+
+// SetMaintenance replaces the maintenance switch.
+func (s *Store) SetMaintenance(_ context.Context, status *dto.MaintenanceStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *status
+	s.maintenance = &stored
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// IsUnderModeration reports whether identity changes on the account need approval.
+func (s *Store) IsUnderModeration(_ context.Context, userID uuid.UUID) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.moderated[userID]
+
+	return ok, nil
+}
+
+// SetUnderModeration flags or unflags the account and records it in the audit trail.
+func (s *Store) SetUnderModeration(_ context.Context, actorID, userID uuid.UUID, flagged bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	action := dto.AuditActionModerationDisabled
+
+	if flagged {
+		s.moderated[userID] = struct{}{}
+		action = dto.AuditActionModerationEnabled
+	} else {
+		delete(s.moderated, userID)
+	}
+
+	s.recordAudit(actorID.String(), action, userID, "", time.Now())
+
+	return nil
+}
+
+// CreateChangeRequest queues a pending change. A second pending change of the same field fails
+// with ErrChangeRequestPending.
+func (s *Store) CreateChangeRequest(_ context.Context, request *dto.ChangeRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pending := slices.ContainsFunc(s.changeRequests, func(existing dto.ChangeRequest) bool {
+		return existing.UserID == request.UserID && existing.Field == request.Field &&
+			existing.Status == dto.ChangeRequestStatusPending
+	})
+	if pending {
+		return repository.ErrChangeRequestPending
+	}
+
+	request.RequestID = int64(len(s.changeRequests) + 1)
+	request.Status = dto.ChangeRequestStatusPending
+	request.RequestedAt = time.Now()
+	s.changeRequests = append(s.changeRequests, *request)
+
+	return nil
+}
+
+// ListChangeRequests returns up to limit requests, oldest first.
+func (s *Store) ListChangeRequests(
+	_ context.Context,
+	userID uuid.UUID,
+	status dto.ChangeRequestStatus,
+	limit int,
+) ([]dto.ChangeRequest, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	requests := []dto.ChangeRequest{}
+
+	for _, request := range s.changeRequests {
+		if len(requests) == limit {
+			break
+		}
+
+		if (userID == uuid.Nil || request.UserID == userID.String()) && (status == "" || request.Status == status) {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests, nil
+}
+
+// ApproveChangeRequest applies a pending change to the user and marks it approved.
+func (s *Store) ApproveChangeRequest(
+	_ context.Context,
+	actorID uuid.UUID,
+	requestID int64,
+) (*dto.ChangeRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	request, err := s.pendingChangeRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		return nil, repository.ErrUserNotFound
+	}
+
+	update := &dto.UserProfileUpdateRequest{}
+	if request.Field == dto.ChangeRequestFieldUsername {
+		update.Username = &request.NewValue
+	} else {
+		update.Email = &request.NewValue
+	}
+
+	_, err = s.updateUserLocked(userID, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decideChangeRequest(actorID, request, dto.ChangeRequestStatusApproved, ""), nil
+}
+
+// RejectChangeRequest marks a pending change rejected without applying it.
+func (s *Store) RejectChangeRequest(
+	_ context.Context,
+	actorID uuid.UUID,
+	requestID int64,
+	reason string,
+) (*dto.ChangeRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	request, err := s.pendingChangeRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decideChangeRequest(actorID, request, dto.ChangeRequestStatusRejected, reason), nil
+}
+
+// pendingChangeRequest returns the stored request, failing unless it is pending. Callers must
+// hold s.mu.
+func (s *Store) pendingChangeRequest(requestID int64) (*dto.ChangeRequest, error) {
+	if requestID < 1 || requestID > int64(len(s.changeRequests)) {
+		return nil, repository.ErrChangeRequestNotFound
+	}
+
+	request := &s.changeRequests[requestID-1]
+	if request.Status != dto.ChangeRequestStatusPending {
+		return nil, repository.ErrChangeRequestDecided
+	}
+
+	return request, nil
+}
+
+// decideChangeRequest records the decision and audits it. Callers must hold s.mu.
+func (s *Store) decideChangeRequest(
+	actorID uuid.UUID,
+	request *dto.ChangeRequest,
+	status dto.ChangeRequestStatus,
+	reason string,
+) *dto.ChangeRequest {
+	now := time.Now()
+
+	request.Status = status
+	request.Reason = reason
+	request.DecidedAt = &now
+	request.DecidedBy = actorID.String()
+
+	action := dto.AuditActionChangeRequestRejected
+	if status == dto.ChangeRequestStatusApproved {
+		action = dto.AuditActionChangeRequestApproved
+	}
+
+	userID, _ := uuid.Parse(request.UserID)
+	s.recordAudit(actorID.String(), action, userID, "change_request:"+strconv.FormatInt(request.RequestID, 10), now)
+
+	decided := *request
+
+	return &decided
+}
+
+// FlagContent queues profile text for admin review.
+func (s *Store) FlagContent(_ context.Context, flag *dto.ContentFlag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	flag.FlagID = int64(len(s.contentFlags) + 1)
+	flag.FlaggedAt = time.Now()
+	flag.Terms = slices.Clone(flag.Terms)
+
+	if flag.Terms == nil {
+		flag.Terms = []string{}
+	}
+
+	s.contentFlags = append(s.contentFlags, *flag)
+
+	return nil
+}
+
+// ListContentFlags returns up to limit unresolved flags, oldest first.
+func (s *Store) ListContentFlags(_ context.Context, limit int) ([]dto.ContentFlag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	flags := []dto.ContentFlag{}
+
+	for _, flag := range s.contentFlags {
+		if len(flags) == limit {
+			break
+		}
+
+		if flag.ResolvedAt == nil {
+			flags = append(flags, flag)
+		}
+	}
+
+	return flags, nil
+}
+
+// ResolveContentFlag marks an unresolved flag as reviewed and records it in the audit trail.
+func (s *Store) ResolveContentFlag(_ context.Context, actorID uuid.UUID, flagID int64) (*dto.ContentFlag, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if flagID < 1 || flagID > int64(len(s.contentFlags)) || s.contentFlags[flagID-1].ResolvedAt != nil {
+		return nil, repository.ErrContentFlagNotFound
+	}
+
+	now := time.Now()
+	flag := &s.contentFlags[flagID-1]
+	flag.ResolvedAt = &now
+	flag.ResolvedBy = actorID.String()
+
+	userID, _ := uuid.Parse(flag.UserID)
+	s.recordAudit(actorID.String(), dto.AuditActionContentFlagResolved, userID,
+		"content_flag:"+strconv.FormatInt(flagID, 10), now)
+
+	resolved := *flag
+
+	return &resolved, nil
+}
@@ -0,0 +1,294 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// organization is an organization and its members, in the order they joined.
+type organization struct {
+	createdBy uuid.UUID
+	createdAt time.Time
+	members   []organizationMember
+}
+
+type organizationMember struct {
+	userID  uuid.UUID
+	role    dto.OrganizationRole
+	addedBy uuid.UUID
+	addedAt time.Time
+}
+
+// CreateOrganization creates the organization's account, the organization and its first owner,
+// applying the same identifier uniqueness rules as profile updates.
+func (s *Store) CreateOrganization(
+	_ context.Context,
+	ownerID uuid.UUID,
+	req *dto.OrganizationCreateRequest,
+) (*dto.Organization, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[ownerID]; !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	orgID := uuid.New()
+
+	err := s.claimIdentifier(orgID, req.Username, usernameOf,
+		repository.ErrDuplicateUsername, repository.ErrUsernameRetired)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.claimIdentifier(orgID, req.Email, emailOf,
+		repository.ErrDuplicateEmail, repository.ErrEmailRetired)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	s.users[orgID] = &dto.User{
+		UserID:    orgID.String(),
+		Username:  req.Username,
+		Email:     stringPtr(req.Email),
+		FullName:  req.FullName,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	s.recordChange(orgID, dto.UserChangeTypeUpsert, nil)
+
+	s.organizations[orgID] = &organization{
+		createdBy: ownerID,
+		createdAt: now,
+		members: []organizationMember{
+			{userID: ownerID, role: dto.OrganizationRoleOwner, addedBy: ownerID, addedAt: now},
+		},
+	}
+	s.recordAudit(ownerID.String(), dto.AuditActionOrganizationCreated, orgID, ownerID.String(), now)
+
+	return s.organizationLocked(orgID), nil
+}
+
+// GetOrganization returns an organization.
+func (s *Store) GetOrganization(_ context.Context, orgID uuid.UUID) (*dto.Organization, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.organizations[orgID]; !ok {
+		return nil, repository.ErrOrganizationNotFound
+	}
+
+	return s.organizationLocked(orgID), nil
+}
+
+// ListUserOrganizations returns the organizations a user belongs to, oldest membership first.
+func (s *Store) ListUserOrganizations(_ context.Context, userID uuid.UUID) ([]dto.OrganizationMembership, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	type joined struct {
+		membership dto.OrganizationMembership
+		addedAt    time.Time
+	}
+
+	var found []joined
+
+	for orgID, org := range s.organizations {
+		i := org.memberIndex(userID)
+		if i < 0 {
+			continue
+		}
+
+		found = append(found, joined{
+			membership: dto.OrganizationMembership{
+				Organization: *s.organizationLocked(orgID),
+				Role:         org.members[i].role,
+			},
+			addedAt: org.members[i].addedAt,
+		})
+	}
+
+	slices.SortFunc(found, func(a, b joined) int {
+		return a.addedAt.Compare(b.addedAt)
+	})
+
+	memberships := make([]dto.OrganizationMembership, 0, len(found))
+	for _, j := range found {
+		memberships = append(memberships, j.membership)
+	}
+
+	return memberships, nil
+}
+
+// ListMembers returns the members of an organization, owners first.
+func (s *Store) ListMembers(_ context.Context, orgID uuid.UUID) ([]dto.OrganizationMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	members := []dto.OrganizationMember{}
+
+	org, ok := s.organizations[orgID]
+	if !ok {
+		return members, nil
+	}
+
+	for _, member := range org.members {
+		var username string
+		if user, ok := s.users[member.userID]; ok {
+			username = user.Username
+		}
+
+		members = append(members, dto.OrganizationMember{
+			UserID:   member.userID.String(),
+			Username: username,
+			Role:     member.role,
+			AddedBy:  member.addedBy.String(),
+			AddedAt:  member.addedAt,
+		})
+	}
+
+	// Stable, so members with the same role keep the order they joined in
+	slices.SortStableFunc(members, func(a, b dto.OrganizationMember) int {
+		return boolRank(a.Role == dto.OrganizationRoleOwner) - boolRank(b.Role == dto.OrganizationRoleOwner)
+	})
+
+	return members, nil
+}
+
+// GetMemberRole returns the role of a user in an organization.
+func (s *Store) GetMemberRole(_ context.Context, orgID, userID uuid.UUID) (dto.OrganizationRole, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	org, ok := s.organizations[orgID]
+	if !ok {
+		return "", repository.ErrNotOrganizationMember
+	}
+
+	i := org.memberIndex(userID)
+	if i < 0 {
+		return "", repository.ErrNotOrganizationMember
+	}
+
+	return org.members[i].role, nil
+}
+
+// SetMember adds a user to an organization or changes their role, keeping at least one owner.
+func (s *Store) SetMember(_ context.Context, actorID, orgID, userID uuid.UUID, role dto.OrganizationRole) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organizations[orgID]
+	if !ok {
+		return repository.ErrOrganizationNotFound
+	}
+
+	if _, ok := s.users[userID]; !ok {
+		return repository.ErrUserNotFound
+	}
+
+	now := time.Now()
+
+	i := org.memberIndex(userID)
+	switch {
+	case i < 0:
+		org.members = append(org.members, organizationMember{userID: userID, role: role, addedBy: actorID, addedAt: now})
+	case org.members[i].role == role:
+		return nil
+	case org.members[i].role == dto.OrganizationRoleOwner && org.owners() == 1:
+		return repository.ErrLastOrganizationOwner
+	default:
+		org.members[i].role = role
+	}
+
+	s.recordAudit(actorID.String(), dto.AuditActionOrganizationMemberSet, orgID,
+		userID.String()+":"+string(role), now)
+
+	return nil
+}
+
+// RemoveMember removes a user from an organization, keeping at least one owner.
+func (s *Store) RemoveMember(_ context.Context, actorID, orgID, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organizations[orgID]
+	if !ok {
+		return repository.ErrOrganizationNotFound
+	}
+
+	i := org.memberIndex(userID)
+	if i < 0 {
+		return repository.ErrNotOrganizationMember
+	}
+
+	if org.members[i].role == dto.OrganizationRoleOwner && org.owners() == 1 {
+		return repository.ErrLastOrganizationOwner
+	}
+
+	org.members = slices.Delete(org.members, i, i+1)
+	s.recordAudit(actorID.String(), dto.AuditActionOrganizationMemberRemoved, orgID, userID.String(), time.Now())
+
+	return nil
+}
+
+// RecordOrganizationAction records an action taken on behalf of an organization.
+func (s *Store) RecordOrganizationAction(_ context.Context, actorID, orgID uuid.UUID, action string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.recordAudit(actorID.String(), dto.AuditActionOrganizationActed, orgID, action, time.Now())
+
+	return nil
+}
+
+// organizationLocked returns an existing organization with its account's profile. Callers must
+// hold s.mu.
+func (s *Store) organizationLocked(orgID uuid.UUID) *dto.Organization {
+	org := s.organizations[orgID]
+	result := &dto.Organization{
+		OrgID:     orgID.String(),
+		CreatedBy: org.createdBy.String(),
+		CreatedAt: org.createdAt,
+	}
+
+	if user, ok := s.users[orgID]; ok {
+		result.Username = user.Username
+		result.FullName = user.FullName
+	}
+
+	return result
+}
+
+func (o *organization) memberIndex(userID uuid.UUID) int {
+	return slices.IndexFunc(o.members, func(m organizationMember) bool { return m.userID == userID })
+}
+
+func (o *organization) owners() int {
+	owners := 0
+
+	for _, member := range o.members {
+		if member.role == dto.OrganizationRoleOwner {
+			owners++
+		}
+	}
+
+	return owners
+}
+
+// boolRank orders true before false in ascending sorts.
+func boolRank(b bool) int {
+	if b {
+		return 0
+	}
+
+	return 1
+}
@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// RecordPolicyAcceptance stores an acceptance, keeping the first acceptance time of a version.
+func (s *Store) RecordPolicyAcceptance(_ context.Context, userID uuid.UUID, acceptance *dto.PolicyAcceptance) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.policyAcceptances[userID] {
+		if existing.Document == acceptance.Document && existing.Version == acceptance.Version {
+			acceptance.AcceptedAt = existing.AcceptedAt
+
+			return nil
+		}
+	}
+
+	acceptance.AcceptedAt = time.Now()
+	s.policyAcceptances[userID] = append(s.policyAcceptances[userID], *acceptance)
+
+	return nil
+}
+
+// GetPolicyAcceptances returns every version the user accepted, newest first.
+func (s *Store) GetPolicyAcceptances(_ context.Context, userID uuid.UUID) ([]dto.PolicyAcceptance, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	acceptances := slices.Clone(s.policyAcceptances[userID])
+	slices.Reverse(acceptances)
+
+	return acceptances, nil
+}
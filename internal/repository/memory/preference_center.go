@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// GetPreferenceCenterGeneration returns the current generation of a user's preference center links.
+func (s *Store) GetPreferenceCenterGeneration(_ context.Context, userID uuid.UUID) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return 0, repository.ErrUserNotFound
+	}
+
+	return s.linkGenerations[userID], nil
+}
+
+// RevokePreferenceCenterLinks bumps the generation of a user's preference center links.
+func (s *Store) RevokePreferenceCenterLinks(_ context.Context, userID uuid.UUID) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return 0, repository.ErrUserNotFound
+	}
+
+	s.linkGenerations[userID]++
+
+	return s.linkGenerations[userID], nil
+}
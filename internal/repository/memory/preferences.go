@@ -0,0 +1,503 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// preferenceSet holds a user's saved preferences. A nil category has never been saved and
+// reads as the repository defaults.
+type preferenceSet struct {
+	notification  *dto.NotificationPreferences
+	display       *dto.DisplayPreferences
+	privacy       *dto.UserPrivacyPreferences
+	accessibility *dto.AccessibilityPreferences
+	language      *dto.LanguagePreferences
+	security      *dto.SecurityPreferences
+	social        *dto.SocialPreferences
+	sound         *dto.SoundPreferences
+	theme         *dto.ThemePreferences
+}
+
+// getCategory returns a copy of the saved category, or its defaults.
+func getCategory[T any](s *Store, userID uuid.UUID, slot func(*preferenceSet) **T, defaults func() *T) *T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if set, ok := s.preferences[userID]; ok && *slot(set) != nil {
+		c := **slot(set)
+
+		return &c
+	}
+
+	return defaults()
+}
+
+// updateCategory applies an update to the saved category (or its defaults), stamps it and
+// records a change log entry.
+func updateCategory[T any](
+	s *Store,
+	userID uuid.UUID,
+	category dto.PreferenceCategory,
+	slot func(*preferenceSet) **T,
+	defaults func() *T,
+	apply func(prefs *T, now time.Time),
+) *T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, ok := s.preferences[userID]
+	if !ok {
+		set = &preferenceSet{}
+		s.preferences[userID] = set
+	}
+
+	prefs := *slot(set)
+	if prefs == nil {
+		prefs = defaults()
+		*slot(set) = prefs
+	}
+
+	apply(prefs, time.Now())
+
+	name := string(category)
+	s.recordChange(userID, dto.UserChangeTypePreferenceChanged, &name)
+
+	c := *prefs
+
+	return &c
+}
+
+func setIf[T any](dst *T, src *T) {
+	if src != nil {
+		*dst = *src
+	}
+}
+
+// applyPreferences saves every category present in update.
+func (s *Store) applyPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.UserPreferencesUpdateRequest,
+) error {
+	var err error
+
+	if update.Notification != nil {
+		_, err = s.UpdateNotificationPreferences(ctx, userID, update.Notification)
+	}
+
+	if err == nil && update.Display != nil {
+		_, err = s.UpdateDisplayPreferences(ctx, userID, update.Display)
+	}
+
+	if err == nil && update.Privacy != nil {
+		_, err = s.UpdatePrivacyPreferencesData(ctx, userID, update.Privacy)
+	}
+
+	if err == nil && update.Accessibility != nil {
+		_, err = s.UpdateAccessibilityPreferences(ctx, userID, update.Accessibility)
+	}
+
+	if err == nil && update.Language != nil {
+		_, err = s.UpdateLanguagePreferences(ctx, userID, update.Language)
+	}
+
+	if err == nil && update.Security != nil {
+		_, err = s.UpdateSecurityPreferences(ctx, userID, update.Security)
+	}
+
+	if err == nil && update.Social != nil {
+		_, err = s.UpdateSocialPreferences(ctx, userID, update.Social)
+	}
+
+	if err == nil && update.Sound != nil {
+		_, err = s.UpdateSoundPreferences(ctx, userID, update.Sound)
+	}
+
+	if err == nil && update.Theme != nil {
+		_, err = s.UpdateThemePreferences(ctx, userID, update.Theme)
+	}
+
+	return err
+}
+
+// GetNotificationPreferences retrieves notification preferences for a user.
+func (s *Store) GetNotificationPreferences(
+	_ context.Context,
+	userID uuid.UUID,
+) (*dto.NotificationPreferences, error) {
+	return getCategory(s, userID, func(p *preferenceSet) **dto.NotificationPreferences { return &p.notification },
+		repository.DefaultNotificationPreferences), nil
+}
+
+// UpdateNotificationPreferences updates notification preferences.
+func (s *Store) UpdateNotificationPreferences(
+	_ context.Context,
+	userID uuid.UUID,
+	update *dto.NotificationPreferencesUpdate,
+) (*dto.NotificationPreferences, error) {
+	return updateCategory(s, userID, dto.PreferenceCategoryNotification,
+		func(p *preferenceSet) **dto.NotificationPreferences { return &p.notification },
+		repository.DefaultNotificationPreferences,
+		func(prefs *dto.NotificationPreferences, now time.Time) {
+			setIf(&prefs.EmailNotifications, update.EmailNotifications)
+			setIf(&prefs.PushNotifications, update.PushNotifications)
+			setIf(&prefs.SMSNotifications, update.SMSNotifications)
+			setIf(&prefs.MarketingEmails, update.MarketingEmails)
+			setIf(&prefs.SecurityAlerts, update.SecurityAlerts)
+			setIf(&prefs.ActivitySummaries, update.ActivitySummaries)
+			setIf(&prefs.RecipeRecommendations, update.RecipeRecommendations)
+			setIf(&prefs.SocialInteractions, update.SocialInteractions)
+			prefs.UpdatedAt = now
+		}), nil
+}
+
+// GetDisplayPreferences retrieves display preferences for a user.
+func (s *Store) GetDisplayPreferences(_ context.Context, userID uuid.UUID) (*dto.DisplayPreferences, error) {
+	return getCategory(s, userID, func(p *preferenceSet) **dto.DisplayPreferences { return &p.display },
+		repository.DefaultDisplayPreferences), nil
+}
+
+// UpdateDisplayPreferences updates display preferences.
+func (s *Store) UpdateDisplayPreferences(
+	_ context.Context,
+	userID uuid.UUID,
+	update *dto.DisplayPreferencesUpdate,
+) (*dto.DisplayPreferences, error) {
+	return updateCategory(s, userID, dto.PreferenceCategoryDisplay,
+		func(p *preferenceSet) **dto.DisplayPreferences { return &p.display },
+		repository.DefaultDisplayPreferences,
+		func(prefs *dto.DisplayPreferences, now time.Time) {
+			setIf(&prefs.FontSize, update.FontSize)
+			setIf(&prefs.ColorScheme, update.ColorScheme)
+			setIf(&prefs.LayoutDensity, update.LayoutDensity)
+			setIf(&prefs.ShowImages, update.ShowImages)
+			setIf(&prefs.CompactMode, update.CompactMode)
+			prefs.UpdatedAt = now
+		}), nil
+}
+
+// GetPrivacyPreferencesData retrieves privacy preferences for a user, or the defaults of the
+// version they were provisioned under when none were saved.
+func (s *Store) GetPrivacyPreferencesData(
+	_ context.Context,
+	userID uuid.UUID,
+) (*dto.UserPrivacyPreferences, error) {
+	// Not getCategory: falling back to the defaults may provision the user, which writes
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if set, ok := s.preferences[userID]; ok && set.privacy != nil {
+		c := *set.privacy
+
+		return &c, nil
+	}
+
+	return s.privacyDefaultsLocked(userID), nil
+}
+
+// UpdatePrivacyPreferencesData updates privacy preferences.
+func (s *Store) UpdatePrivacyPreferencesData(
+	_ context.Context,
+	userID uuid.UUID,
+	update *dto.PrivacyPreferencesUpdate,
+) (*dto.UserPrivacyPreferences, error) {
+	return updateCategory(s, userID, dto.PreferenceCategoryPrivacy,
+		func(p *preferenceSet) **dto.UserPrivacyPreferences { return &p.privacy },
+		func() *dto.UserPrivacyPreferences { return s.privacyDefaultsLocked(userID) },
+		func(prefs *dto.UserPrivacyPreferences, now time.Time) {
+			// Saving provisions the user even over a saved row, as in the SQL repository
+			s.privacyDefaultsLocked(userID)
+
+			setIf(&prefs.ProfileVisibility, update.ProfileVisibility)
+			setIf(&prefs.RecipeVisibility, update.RecipeVisibility)
+			setIf(&prefs.ActivityVisibility, update.ActivityVisibility)
+			setIf(&prefs.ContactInfoVisibility, update.ContactInfoVisibility)
+			setIf(&prefs.ShowFullName, update.ShowFullName)
+			setIf(&prefs.AllowFollows, update.AllowFollows)
+			setIf(&prefs.AllowMessages, update.AllowMessages)
+			setIf(&prefs.DataSharing, update.DataSharing)
+			setIf(&prefs.AnalyticsTracking, update.AnalyticsTracking)
+			setIf(&prefs.Discoverable, update.Discoverable)
+			setIf(&prefs.ShowLastSeen, update.ShowLastSeen)
+			setIf(&prefs.ShowLocation, update.ShowLocation)
+			setIf(&prefs.CountProfileViews, update.CountProfileViews)
+			setIf(&prefs.KeepRecentlyViewed, update.KeepRecentlyViewed)
+			prefs.UpdatedAt = now
+		}), nil
+}
+
+// GetAccessibilityPreferences retrieves accessibility preferences for a user.
+func (s *Store) GetAccessibilityPreferences(
+	_ context.Context,
+	userID uuid.UUID,
+) (*dto.AccessibilityPreferences, error) {
+	return getCategory(s, userID, func(p *preferenceSet) **dto.AccessibilityPreferences { return &p.accessibility },
+		repository.DefaultAccessibilityPreferences), nil
+}
+
+// UpdateAccessibilityPreferences updates accessibility preferences.
+func (s *Store) UpdateAccessibilityPreferences(
+	_ context.Context,
+	userID uuid.UUID,
+	update *dto.AccessibilityPreferencesUpdate,
+) (*dto.AccessibilityPreferences, error) {
+	return updateCategory(s, userID, dto.PreferenceCategoryAccessibility,
+		func(p *preferenceSet) **dto.AccessibilityPreferences { return &p.accessibility },
+		repository.DefaultAccessibilityPreferences,
+		func(prefs *dto.AccessibilityPreferences, now time.Time) {
+			setIf(&prefs.ScreenReader, update.ScreenReader)
+			setIf(&prefs.HighContrast, update.HighContrast)
+			setIf(&prefs.ReducedMotion, update.ReducedMotion)
+			setIf(&prefs.LargeText, update.LargeText)
+			setIf(&prefs.KeyboardNavigation, update.KeyboardNavigation)
+			prefs.UpdatedAt = now
+		}), nil
+}
+
+// GetLanguagePreferences retrieves language preferences for a user.
+func (s *Store) GetLanguagePreferences(_ context.Context, userID uuid.UUID) (*dto.LanguagePreferences, error) {
+	return getCategory(s, userID, func(p *preferenceSet) **dto.LanguagePreferences { return &p.language },
+		repository.DefaultLanguagePreferences), nil
+}
+
+// UpdateLanguagePreferences updates language preferences.
+func (s *Store) UpdateLanguagePreferences(
+	_ context.Context,
+	userID uuid.UUID,
+	update *dto.LanguagePreferencesUpdate,
+) (*dto.LanguagePreferences, error) {
+	return updateCategory(s, userID, dto.PreferenceCategoryLanguage,
+		func(p *preferenceSet) **dto.LanguagePreferences { return &p.language },
+		repository.DefaultLanguagePreferences,
+		func(prefs *dto.LanguagePreferences, now time.Time) {
+			setIf(&prefs.PrimaryLanguage, update.PrimaryLanguage)
+
+			if update.SecondaryLanguage != nil {
+				secondary := *update.SecondaryLanguage
+				prefs.SecondaryLanguage = &secondary
+			}
+
+			setIf(&prefs.TranslationEnabled, update.TranslationEnabled)
+			prefs.UpdatedAt = now
+		}), nil
+}
+
+// GetSecurityPreferences retrieves security preferences for a user.
+func (s *Store) GetSecurityPreferences(_ context.Context, userID uuid.UUID) (*dto.SecurityPreferences, error) {
+	return getCategory(s, userID, func(p *preferenceSet) **dto.SecurityPreferences { return &p.security },
+		repository.DefaultSecurityPreferences), nil
+}
+
+// UpdateSecurityPreferences updates security preferences.
+func (s *Store) UpdateSecurityPreferences(
+	_ context.Context,
+	userID uuid.UUID,
+	update *dto.SecurityPreferencesUpdate,
+) (*dto.SecurityPreferences, error) {
+	return updateCategory(s, userID, dto.PreferenceCategorySecurity,
+		func(p *preferenceSet) **dto.SecurityPreferences { return &p.security },
+		repository.DefaultSecurityPreferences,
+		func(prefs *dto.SecurityPreferences, now time.Time) {
+			setIf(&prefs.TwoFactorAuth, update.TwoFactorAuth)
+			setIf(&prefs.LoginNotifications, update.LoginNotifications)
+			setIf(&prefs.SessionTimeout, update.SessionTimeout)
+			setIf(&prefs.PasswordRequirements, update.PasswordRequirements)
+			prefs.UpdatedAt = now
+		}), nil
+}
+
+// GetSocialPreferences retrieves social preferences for a user.
+func (s *Store) GetSocialPreferences(_ context.Context, userID uuid.UUID) (*dto.SocialPreferences, error) {
+	return getCategory(s, userID, func(p *preferenceSet) **dto.SocialPreferences { return &p.social },
+		repository.DefaultSocialPreferences), nil
+}
+
+// UpdateSocialPreferences updates social preferences.
+func (s *Store) UpdateSocialPreferences(
+	_ context.Context,
+	userID uuid.UUID,
+	update *dto.SocialPreferencesUpdate,
+) (*dto.SocialPreferences, error) {
+	return updateCategory(s, userID, dto.PreferenceCategorySocial,
+		func(p *preferenceSet) **dto.SocialPreferences { return &p.social },
+		repository.DefaultSocialPreferences,
+		func(prefs *dto.SocialPreferences, now time.Time) {
+			setIf(&prefs.FriendRequests, update.FriendRequests)
+			setIf(&prefs.MessageNotifications, update.MessageNotifications)
+			setIf(&prefs.GroupInvites, update.GroupInvites)
+			setIf(&prefs.ShareActivity, update.ShareActivity)
+			prefs.UpdatedAt = now
+		}), nil
+}
+
+// GetSoundPreferences retrieves sound preferences for a user.
+func (s *Store) GetSoundPreferences(_ context.Context, userID uuid.UUID) (*dto.SoundPreferences, error) {
+	return getCategory(s, userID, func(p *preferenceSet) **dto.SoundPreferences { return &p.sound },
+		repository.DefaultSoundPreferences), nil
+}
+
+// UpdateSoundPreferences updates sound preferences.
+func (s *Store) UpdateSoundPreferences(
+	_ context.Context,
+	userID uuid.UUID,
+	update *dto.SoundPreferencesUpdate,
+) (*dto.SoundPreferences, error) {
+	return updateCategory(s, userID, dto.PreferenceCategorySound,
+		func(p *preferenceSet) **dto.SoundPreferences { return &p.sound },
+		repository.DefaultSoundPreferences,
+		func(prefs *dto.SoundPreferences, now time.Time) {
+			setIf(&prefs.NotificationSounds, update.NotificationSounds)
+			setIf(&prefs.SystemSounds, update.SystemSounds)
+			setIf(&prefs.VolumeLevel, update.VolumeLevel)
+			setIf(&prefs.MuteNotifications, update.MuteNotifications)
+			prefs.UpdatedAt = now
+		}), nil
+}
+
+// GetThemePreferences retrieves theme preferences for a user.
+func (s *Store) GetThemePreferences(_ context.Context, userID uuid.UUID) (*dto.ThemePreferences, error) {
+	return getCategory(s, userID, func(p *preferenceSet) **dto.ThemePreferences { return &p.theme },
+		repository.DefaultThemePreferences), nil
+}
+
+// UpdateThemePreferences updates theme preferences.
+func (s *Store) UpdateThemePreferences(
+	_ context.Context,
+	userID uuid.UUID,
+	update *dto.ThemePreferencesUpdate,
+) (*dto.ThemePreferences, error) {
+	return updateCategory(s, userID, dto.PreferenceCategoryTheme,
+		func(p *preferenceSet) **dto.ThemePreferences { return &p.theme },
+		repository.DefaultThemePreferences,
+		func(prefs *dto.ThemePreferences, now time.Time) {
+			setIf(&prefs.DarkMode, update.DarkMode)
+			setIf(&prefs.LightMode, update.LightMode)
+			setIf(&prefs.AutoTheme, update.AutoTheme)
+
+			if update.CustomTheme != nil {
+				theme := *update.CustomTheme
+				prefs.CustomTheme = &theme
+			}
+
+			prefs.UpdatedAt = now
+		}), nil
+}
+
+// ResetPreferences forgets the user's saved values of the given categories and records a change
+// log entry for each.
+//
+//nolint:cyclop // Switch over 9 categories is inherent to domain design.
+func (s *Store) ResetPreferences(_ context.Context, userID uuid.UUID, categories []dto.PreferenceCategory) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, ok := s.preferences[userID]
+	if !ok {
+		set = &preferenceSet{}
+		s.preferences[userID] = set
+	}
+
+	for _, category := range categories {
+		switch category {
+		case dto.PreferenceCategoryNotification:
+			set.notification = nil
+		case dto.PreferenceCategoryDisplay:
+			set.display = nil
+		case dto.PreferenceCategoryPrivacy:
+			set.privacy = nil
+		case dto.PreferenceCategoryAccessibility:
+			set.accessibility = nil
+		case dto.PreferenceCategoryLanguage:
+			set.language = nil
+		case dto.PreferenceCategorySecurity:
+			set.security = nil
+		case dto.PreferenceCategorySocial:
+			set.social = nil
+		case dto.PreferenceCategorySound:
+			set.sound = nil
+		case dto.PreferenceCategoryTheme:
+			set.theme = nil
+		default:
+			return fmt.Errorf("%w: %s", repository.ErrInvalidPreferenceCategory, category)
+		}
+
+		name := string(category)
+		s.recordChange(userID, dto.UserChangeTypePreferenceChanged, &name)
+	}
+
+	return nil
+}
+
+// ListUserIDs returns up to limit user IDs after the given one, in ID order.
+func (s *Store) ListUserIDs(_ context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var ids []uuid.UUID
+
+	for id := range s.users {
+		if bytes.Compare(id[:], after[:]) > 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+
+	return paginate(ids, limit, 0), nil
+}
+
+// InsertDefaultPreferences saves the defaults of every category the users have not saved and
+// returns how many categories were saved.
+func (s *Store) InsertDefaultPreferences(_ context.Context, userIDs []uuid.UUID) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inserted := 0
+
+	for _, userID := range userIDs {
+		if _, ok := s.users[userID]; !ok {
+			continue
+		}
+
+		set, ok := s.preferences[userID]
+		if !ok {
+			set = &preferenceSet{}
+			s.preferences[userID] = set
+		}
+
+		// Provisions the user even when their privacy row is saved, as the SQL backfill does
+		privacy := s.privacyDefaultsLocked(userID)
+
+		inserted += fillDefault(&set.notification, repository.DefaultNotificationPreferences) +
+			fillDefault(&set.display, repository.DefaultDisplayPreferences) +
+			fillDefault(&set.privacy, func() *dto.UserPrivacyPreferences { return privacy }) +
+			fillDefault(&set.accessibility, repository.DefaultAccessibilityPreferences) +
+			fillDefault(&set.language, repository.DefaultLanguagePreferences) +
+			fillDefault(&set.security, repository.DefaultSecurityPreferences) +
+			fillDefault(&set.social, repository.DefaultSocialPreferences) +
+			fillDefault(&set.sound, repository.DefaultSoundPreferences) +
+			fillDefault(&set.theme, repository.DefaultThemePreferences)
+	}
+
+	return inserted, nil
+}
+
+// fillDefault saves the defaults in an unsaved category slot and reports whether it did.
+func fillDefault[T any](slot **T, defaults func() *T) int {
+	if *slot != nil {
+		return 0
+	}
+
+	*slot = defaults()
+
+	return 1
+}
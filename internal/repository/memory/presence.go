@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The key prefix mirrors the Redis key layout.
+func presenceKey(userID uuid.UUID) string { return "presence:" + userID.String() }
+
+// TouchPresence records that the user was seen at at, remembered for ttl.
+func (s *Store) TouchPresence(_ context.Context, userID uuid.UUID, at time.Time, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Heartbeats can arrive out of order; keep the latest
+	if seen, ok := s.lastSeen(userID); ok && seen.After(at) {
+		at = seen
+	}
+
+	s.setEphemeral(presenceKey(userID), at, ttl)
+
+	return nil
+}
+
+// GetLastSeen returns when each of the users was last seen, leaving out those not seen recently.
+func (s *Store) GetLastSeen(_ context.Context, userIDs []uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	lastSeen := make(map[uuid.UUID]time.Time, len(userIDs))
+
+	for _, userID := range userIDs {
+		if seen, ok := s.lastSeen(userID); ok {
+			lastSeen[userID] = seen
+		}
+	}
+
+	return lastSeen, nil
+}
+
+// lastSeen returns the unexpired last seen time of the user. Callers must hold a lock.
+func (s *Store) lastSeen(userID uuid.UUID) (time.Time, bool) {
+	value, ok := s.getEphemeral(presenceKey(userID))
+	if !ok {
+		return time.Time{}, false
+	}
+
+	seen, ok := value.(time.Time)
+
+	return seen, ok
+}
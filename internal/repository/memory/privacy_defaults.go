@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// SetPrivacyDefaultsVersion sets the defaults version users not provisioned yet are pinned to the
+// first time their privacy preferences are read or written.
+func (s *Store) SetPrivacyDefaultsVersion(version int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.privacyRollout = version
+}
+
+// privacyDefaultsLocked pins a user not provisioned yet to the rollout version and returns the
+// defaults of the user's version. Callers must hold s.mu for writing.
+func (s *Store) privacyDefaultsLocked(userID uuid.UUID) *dto.UserPrivacyPreferences {
+	if s.privacyRollout == 0 {
+		return repository.DefaultPrivacyPreferences()
+	}
+
+	version, ok := s.privacyVersions[userID]
+	if !ok {
+		if _, exists := s.users[userID]; !exists {
+			return repository.DefaultPrivacyPreferences()
+		}
+
+		version = s.privacyRollout
+		s.privacyVersions[userID] = version
+	}
+
+	return repository.DefaultPrivacyPreferencesForVersion(version)
+}
+
+// CountPrivacyDefaultsVersions returns how many users are on each version, in version order.
+func (s *Store) CountPrivacyDefaultsVersions(_ context.Context) ([]dto.PrivacyDefaultsCohort, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[int]int)
+
+	for userID := range s.users {
+		counts[s.privacyVersions[userID]]++
+	}
+
+	cohorts := []dto.PrivacyDefaultsCohort{}
+
+	for _, version := range slices.Sorted(maps.Keys(counts)) {
+		cohorts = append(cohorts, dto.PrivacyDefaultsCohort{Version: version, Users: counts[version]})
+	}
+
+	return cohorts, nil
+}
+
+// MovePrivacyDefaults moves up to limit users from one version to another, in ID order, with the
+// same rules as the SQL repository.
+func (s *Store) MovePrivacyDefaults(_ context.Context, from, to, limit int) ([]repository.PrivacyDefaultsMove, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var userIDs []uuid.UUID
+
+	for userID, version := range s.privacyVersions {
+		if _, exists := s.users[userID]; exists && version == from {
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	slices.SortFunc(userIDs, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+
+	oldDefaults := repository.DefaultPrivacyPreferencesForVersion(from)
+	newDefaults := repository.DefaultPrivacyPreferencesForVersion(to)
+	moves := []repository.PrivacyDefaultsMove{}
+
+	for _, userID := range paginate(userIDs, limit, 0) {
+		s.privacyVersions[userID] = to
+		move := repository.PrivacyDefaultsMove{UserID: userID}
+
+		set, ok := s.preferences[userID]
+		if !ok {
+			set = &preferenceSet{}
+			s.preferences[userID] = set
+		}
+
+		if set.privacy == nil || samePrivacyChoices(set.privacy, oldDefaults) {
+			move.Changed = !samePrivacyChoices(oldDefaults, newDefaults)
+			set.privacy = repository.DefaultPrivacyPreferencesForVersion(to)
+		}
+
+		moves = append(moves, move)
+	}
+
+	return moves, nil
+}
+
+// samePrivacyChoices reports whether two sets of privacy preferences hold the same choices.
+func samePrivacyChoices(a, b *dto.UserPrivacyPreferences) bool {
+	x, y := *a, *b
+	x.UpdatedAt = y.UpdatedAt
+
+	return x == y
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The key prefix mirrors the Redis key layout.
+func profileViewerKey(userID uuid.UUID, viewer string) string {
+	return "profile-views:seen:" + userID.String() + ":" + viewer
+}
+
+// MarkProfileViewed remembers viewer for ttl and reports whether it was not already remembered.
+func (s *Store) MarkProfileViewed(_ context.Context, userID uuid.UUID, viewer string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := profileViewerKey(userID, viewer)
+	if _, ok := s.getEphemeral(key); ok {
+		return false, nil
+	}
+
+	s.setEphemeral(key, struct{}{}, ttl)
+
+	return true, nil
+}
+
+// IncrementProfileViews counts one view of the user.
+func (s *Store) IncrementProfileViews(_ context.Context, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pendingViews[userID]++
+
+	return nil
+}
+
+// GetPendingProfileViews returns the views of the user not flushed yet.
+func (s *Store) GetPendingProfileViews(_ context.Context, userID uuid.UUID) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.pendingViews[userID] + s.flushingViews[userID], nil
+}
+
+// TakeProfileViews sets the counted views aside for flushing and returns them.
+func (s *Store) TakeProfileViews(_ context.Context) (map[uuid.UUID]int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.flushingViews) == 0 {
+		s.flushingViews, s.pendingViews = s.pendingViews, make(map[uuid.UUID]int64)
+	}
+
+	return maps.Clone(s.flushingViews), nil
+}
+
+// AckProfileViews drops the views set aside by TakeProfileViews.
+func (s *Store) AckProfileViews(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.flushingViews = make(map[uuid.UUID]int64)
+
+	return nil
+}
+
+// AddProfileViews adds the counted views to each user's total, skipping deleted users.
+func (s *Store) AddProfileViews(_ context.Context, views map[uuid.UUID]int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for userID, count := range views {
+		if _, ok := s.users[userID]; ok {
+			s.profileViews[userID] += count
+		}
+	}
+
+	return nil
+}
+
+// GetProfileViewCount returns the stored total of a user.
+func (s *Store) GetProfileViewCount(_ context.Context, userID uuid.UUID) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.profileViews[userID], nil
+}
@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// GetPublicProfile returns the projected public profile of a user, or nil if there is none.
+func (s *Store) GetPublicProfile(_ context.Context, userID uuid.UUID) (*dto.PublicProfile, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	profile, ok := s.publicProfiles[userID]
+	if !ok {
+		return nil, nil //nolint:nilnil // a miss is not an error
+	}
+
+	return &profile, nil
+}
+
+// SetPublicProfiles stores projected public profiles, replacing those of the same users.
+func (s *Store) SetPublicProfiles(_ context.Context, profiles []dto.PublicProfile) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, profile := range profiles {
+		userID, err := uuid.Parse(profile.UserID)
+		if err != nil {
+			return fmt.Errorf("invalid user id %q: %w", profile.UserID, err)
+		}
+
+		s.publicProfiles[userID] = profile
+	}
+
+	return nil
+}
+
+// DeletePublicProfile drops the projected public profile of a user, if any.
+func (s *Store) DeletePublicProfile(_ context.Context, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.publicProfiles, userID)
+
+	return nil
+}
+
+// GetPublicProfileCursor returns the sequence of the last user change applied, or zero.
+func (s *Store) GetPublicProfileCursor(_ context.Context) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.publicCursor, nil
+}
+
+// SetPublicProfileCursor records the sequence of the last user change applied.
+func (s *Store) SetPublicProfileCursor(_ context.Context, cursor int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.publicCursor = cursor
+
+	return nil
+}
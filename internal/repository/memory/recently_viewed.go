@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The key prefix mirrors the Redis key layout.
+func recentlyViewedKey(userID uuid.UUID) string { return "recently-viewed:" + userID.String() }
+
+// PushRecentlyViewed moves viewedID to the front of the list of userID, keeping at most limit
+// profiles, and keeps the list for ttl.
+func (s *Store) PushRecentlyViewed(
+	_ context.Context,
+	userID, viewedID uuid.UUID,
+	limit int,
+	ttl time.Duration,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := recentlyViewedKey(userID)
+	previous, _ := s.getEphemeral(key)
+	viewed, _ := previous.([]uuid.UUID)
+
+	// Build a new slice so lists already returned are left untouched
+	list := []uuid.UUID{viewedID}
+	for _, id := range viewed {
+		if id != viewedID {
+			list = append(list, id)
+		}
+	}
+
+	if len(list) > limit {
+		list = list[:limit]
+	}
+
+	s.setEphemeral(key, list, ttl)
+
+	return nil
+}
+
+// GetRecentlyViewed returns the list of userID, most recent first.
+func (s *Store) GetRecentlyViewed(_ context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	value, _ := s.getEphemeral(recentlyViewedKey(userID))
+	viewed, _ := value.([]uuid.UUID)
+
+	return slices.Clone(viewed), nil
+}
+
+// ClearRecentlyViewed drops the list of userID.
+func (s *Store) ClearRecentlyViewed(_ context.Context, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.ephemeral, recentlyViewedKey(userID))
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// GetUserRole returns the role of a user; users never assigned one are plain users.
+func (s *Store) GetUserRole(_ context.Context, userID uuid.UUID) (dto.UserRole, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return "", repository.ErrUserNotFound
+	}
+
+	if role, ok := s.roles[userID]; ok {
+		return role, nil
+	}
+
+	return dto.UserRoleUser, nil
+}
+
+// SetUserRole assigns a role to a user and records the change in the audit trail.
+func (s *Store) SetUserRole(_ context.Context, actorID, userID uuid.UUID, role dto.UserRole) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return repository.ErrUserNotFound
+	}
+
+	current, ok := s.roles[userID]
+	if !ok {
+		current = dto.UserRoleUser
+	}
+
+	if current == role {
+		return nil
+	}
+
+	s.roles[userID] = role
+	s.recordAudit(actorID.String(), dto.AuditActionRoleChanged, userID, string(role), time.Now())
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// searchResultsKeyPrefix prefixes the ephemeral keys of cached search results.
+const searchResultsKeyPrefix = "search:results:"
+
+// GetSearchResults returns the search results cached under key, or nil if there are none.
+func (s *Store) GetSearchResults(_ context.Context, key string) (*dto.UserSearchResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	value, _ := s.getEphemeral(searchResultsKeyPrefix + key)
+
+	cached, ok := value.(*dto.UserSearchResponse)
+	if !ok {
+		return nil, nil //nolint:nilnil // a miss is not an error
+	}
+
+	results := *cached
+
+	return &results, nil
+}
+
+// SetSearchResults caches search results under key for ttl.
+func (s *Store) SetSearchResults(
+	_ context.Context,
+	key string,
+	results *dto.UserSearchResponse,
+	ttl time.Duration,
+) error {
+	stored := *results
+	s.storeValue(searchResultsKeyPrefix+key, &stored, ttl)
+
+	return nil
+}
+
+// InvalidateSearchCache drops every cached search result.
+func (s *Store) InvalidateSearchCache(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key := range s.ephemeral {
+		if strings.HasPrefix(key, searchResultsKeyPrefix) {
+			delete(s.ephemeral, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,435 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+type followEdge struct {
+	userID     uuid.UUID
+	followedAt time.Time
+}
+
+// edges returns the users on the other side of userID's follow edges, newest first.
+// Callers must hold the read lock.
+func (s *Store) edges(userID uuid.UUID, following bool) []followEdge {
+	var edges []followEdge
+
+	for key, followedAt := range s.follows {
+		switch {
+		case following && key.follower == userID:
+			edges = append(edges, followEdge{userID: key.followee, followedAt: followedAt})
+		case !following && key.followee == userID:
+			edges = append(edges, followEdge{userID: key.follower, followedAt: followedAt})
+		}
+	}
+
+	slices.SortFunc(edges, func(a, b followEdge) int {
+		return b.followedAt.Compare(a.followedAt)
+	})
+
+	return edges
+}
+
+// listUsers returns a page of users on userID's follow edges and the total edge count.
+func (s *Store) listUsers(userID uuid.UUID, following bool, limit, offset int) ([]dto.User, int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	edges := s.edges(userID, following)
+	page := paginate(edges, limit, offset)
+	users := make([]dto.User, 0, len(page))
+
+	for _, edge := range page {
+		if user, ok := s.users[edge.userID]; ok {
+			users = append(users, *user)
+		}
+	}
+
+	return users, len(edges)
+}
+
+// GetFollowing retrieves the users that userID follows, most recently followed first.
+func (s *Store) GetFollowing(_ context.Context, userID uuid.UUID, limit, offset int) ([]dto.User, int, error) {
+	users, total := s.listUsers(userID, true, limit, offset)
+
+	return users, total, nil
+}
+
+// GetFollowers retrieves the users who follow userID, most recent first.
+func (s *Store) GetFollowers(_ context.Context, userID uuid.UUID, limit, offset int) ([]dto.User, int, error) {
+	users, total := s.listUsers(userID, false, limit, offset)
+
+	return users, total, nil
+}
+
+// FollowUser creates a follow relationship. Following twice keeps the original timestamp.
+func (s *Store) FollowUser(_ context.Context, followerID, followeeID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := followKey{follower: followerID, followee: followeeID}
+	if _, ok := s.follows[key]; !ok {
+		now := time.Now()
+		s.follows[key] = now
+		s.followEvents = append(s.followEvents, followEvent{key: key, event: dto.FollowEventFollow, occurredAt: now})
+	}
+
+	return nil
+}
+
+// UnfollowUser removes a follow relationship. Removing a missing relationship succeeds.
+func (s *Store) UnfollowUser(_ context.Context, followerID, followeeID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := followKey{follower: followerID, followee: followeeID}
+	if _, ok := s.follows[key]; ok {
+		s.followEvents = append(s.followEvents, followEvent{
+			key:        key,
+			event:      dto.FollowEventUnfollow,
+			occurredAt: time.Now(),
+		})
+	}
+
+	delete(s.follows, key)
+	delete(s.followSettings, key)
+	delete(s.closeFriends, key)
+
+	return nil
+}
+
+// CheckFollowing returns when followerID followed followeeID, or nil if not following.
+func (s *Store) CheckFollowing(_ context.Context, followerID, followeeID uuid.UUID) (*time.Time, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	followedAt, ok := s.follows[followKey{follower: followerID, followee: followeeID}]
+	if !ok {
+		return nil, nil //nolint:nilnil // nil,nil is valid: no error, just not following
+	}
+
+	return &followedAt, nil
+}
+
+// GetRecentRecipes returns no recipes; recipes are owned by another service.
+func (s *Store) GetRecentRecipes(_ context.Context, _ uuid.UUID, _ int) ([]dto.RecipeSummary, error) {
+	return nil, nil
+}
+
+// GetRecentFollows retrieves the most recent active users followed by userID.
+func (s *Store) GetRecentFollows(_ context.Context, userID uuid.UUID, limit int) ([]dto.UserSummary, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var summaries []dto.UserSummary
+
+	for _, edge := range s.edges(userID, true) {
+		if len(summaries) >= limit {
+			break
+		}
+
+		user, ok := s.users[edge.userID]
+		if !ok || !user.IsActive {
+			continue
+		}
+
+		summaries = append(summaries, dto.UserSummary{
+			UserID:     user.UserID,
+			Username:   user.Username,
+			FollowedAt: edge.followedAt,
+		})
+	}
+
+	return summaries, nil
+}
+
+// GetNewFollowers retrieves the active users who started following userID since the given time.
+func (s *Store) GetNewFollowers(
+	_ context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	limit int,
+) ([]dto.UserSummary, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var summaries []dto.UserSummary
+
+	for _, edge := range s.edges(userID, false) {
+		if len(summaries) >= limit || edge.followedAt.Before(since) {
+			break
+		}
+
+		user, ok := s.users[edge.userID]
+		if !ok || !user.IsActive {
+			continue
+		}
+
+		summaries = append(summaries, dto.UserSummary{
+			UserID:     user.UserID,
+			Username:   user.Username,
+			FollowedAt: edge.followedAt,
+		})
+	}
+
+	return summaries, nil
+}
+
+// GetFollowedRecipes returns no recipes; recipes are owned by another service.
+func (s *Store) GetFollowedRecipes(_ context.Context, _ uuid.UUID, _ time.Time, _ int) ([]dto.DigestRecipe, error) {
+	return nil, nil
+}
+
+// GetRecentReviews returns no reviews; reviews are owned by another service.
+func (s *Store) GetRecentReviews(_ context.Context, _ uuid.UUID, _ int) ([]dto.ReviewSummary, error) {
+	return nil, nil
+}
+
+// GetRecentFavorites returns no favorites; favorites are owned by another service.
+func (s *Store) GetRecentFavorites(_ context.Context, _ uuid.UUID, _ int) ([]dto.FavoriteSummary, error) {
+	return nil, nil
+}
+
+// followSettingsFor returns the settings of a follow, the defaults if they were never changed.
+// Callers must hold s.mu.
+func (s *Store) followSettingsFor(key followKey) dto.FollowSettings {
+	settings, ok := s.followSettings[key]
+	if !ok {
+		return dto.DefaultFollowSettings()
+	}
+
+	return settings
+}
+
+// UpdateFollowSettings changes the notification settings of a follow, keeping fields the request omits.
+func (s *Store) UpdateFollowSettings(
+	_ context.Context,
+	followerID, followeeID uuid.UUID,
+	req *dto.FollowSettingsUpdateRequest,
+) (*dto.FollowSettings, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := followKey{follower: followerID, followee: followeeID}
+	if _, ok := s.follows[key]; !ok {
+		return nil, repository.ErrNotFollowing
+	}
+
+	settings := s.followSettingsFor(key)
+
+	if req.Muted != nil {
+		settings.Muted = *req.Muted
+	}
+
+	if req.NotifyNewRecipes != nil {
+		settings.NotifyNewRecipes = *req.NotifyNewRecipes
+	}
+
+	if req.NotifyReviews != nil {
+		settings.NotifyReviews = *req.NotifyReviews
+	}
+
+	s.followSettings[key] = settings
+
+	return &settings, nil
+}
+
+// GetFollowSettings returns the notification settings of followerID's follows of followeeIDs.
+func (s *Store) GetFollowSettings(
+	_ context.Context,
+	followerID uuid.UUID,
+	followeeIDs []uuid.UUID,
+) (map[uuid.UUID]dto.FollowSettings, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	settings := make(map[uuid.UUID]dto.FollowSettings, len(followeeIDs))
+
+	for _, followeeID := range followeeIDs {
+		key := followKey{follower: followerID, followee: followeeID}
+		if _, ok := s.follows[key]; ok {
+			settings[followeeID] = s.followSettingsFor(key)
+		}
+	}
+
+	return settings, nil
+}
+
+// GetNotificationRecipients returns the active followers of followeeID whose follow settings
+// allow notifications of event, earliest follower first.
+func (s *Store) GetNotificationRecipients(
+	_ context.Context,
+	followeeID uuid.UUID,
+	event dto.FollowNotificationEvent,
+) ([]uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	edges := s.edges(followeeID, false)
+	slices.Reverse(edges)
+
+	var recipients []uuid.UUID
+
+	for _, edge := range edges {
+		user, ok := s.users[edge.userID]
+		if !ok || !user.IsActive {
+			continue
+		}
+
+		if s.followSettingsFor(followKey{follower: edge.userID, followee: followeeID}).Notifies(event) {
+			recipients = append(recipients, edge.userID)
+		}
+	}
+
+	return recipients, nil
+}
+
+// SetCloseFriend marks or unmarks friendID as a close friend of userID, which friendID must follow.
+func (s *Store) SetCloseFriend(_ context.Context, userID, friendID uuid.UUID, closeFriend bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := followKey{follower: friendID, followee: userID}
+	if _, ok := s.follows[key]; !ok {
+		return repository.ErrNotFollowing
+	}
+
+	if closeFriend {
+		s.closeFriends[key] = struct{}{}
+	} else {
+		delete(s.closeFriends, key)
+	}
+
+	return nil
+}
+
+// GetCloseFriends retrieves the close friends of userID, most recent followers first.
+func (s *Store) GetCloseFriends(_ context.Context, userID uuid.UUID, limit, offset int) ([]dto.User, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var friends []followEdge
+
+	for _, edge := range s.edges(userID, false) {
+		if _, ok := s.closeFriends[followKey{follower: edge.userID, followee: userID}]; ok {
+			friends = append(friends, edge)
+		}
+	}
+
+	page := paginate(friends, limit, offset)
+	users := make([]dto.User, 0, len(page))
+
+	for _, edge := range page {
+		if user, ok := s.users[edge.userID]; ok {
+			users = append(users, *user)
+		}
+	}
+
+	return users, len(friends), nil
+}
+
+// IsCloseFriend reports whether userID has marked friendID as a close friend.
+func (s *Store) IsCloseFriend(_ context.Context, userID, friendID uuid.UUID) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.closeFriends[followKey{follower: friendID, followee: userID}]
+
+	return ok, nil
+}
+
+// GetFollowHistory returns a page of the follows and unfollows of userID, newest first.
+func (s *Store) GetFollowHistory(
+	_ context.Context,
+	userID uuid.UUID,
+	limit, offset int,
+) ([]dto.FollowHistoryEntry, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var events []dto.FollowHistoryEntry
+
+	// Events are appended in order, so walking backwards yields newest first
+	for i := len(s.followEvents) - 1; i >= 0; i-- {
+		entry := s.followEvents[i]
+		if entry.key.followee != userID {
+			continue
+		}
+
+		follower, ok := s.users[entry.key.follower]
+		if !ok {
+			continue
+		}
+
+		events = append(events, dto.FollowHistoryEntry{
+			UserID:     follower.UserID,
+			Username:   follower.Username,
+			Event:      entry.event,
+			OccurredAt: entry.occurredAt,
+		})
+	}
+
+	return paginate(events, limit, offset), len(events), nil
+}
+
+// DeleteFollowEventsBefore removes follow history events that occurred before cutoff.
+func (s *Store) DeleteFollowEventsBefore(_ context.Context, cutoff time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.followEvents[:0]
+
+	for _, entry := range s.followEvents {
+		if !entry.occurredAt.Before(cutoff) {
+			kept = append(kept, entry)
+		}
+	}
+
+	deleted := int64(len(s.followEvents) - len(kept))
+	s.followEvents = kept
+
+	return deleted, nil
+}
+
+// CountFollowing returns how many users userID follows.
+func (s *Store) CountFollowing(_ context.Context, userID uuid.UUID) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.edges(userID, true)), nil
+}
+
+// GetFollowLimit returns the admin override of how many users userID may follow, or nil if there
+// is none.
+func (s *Store) GetFollowLimit(_ context.Context, userID uuid.UUID) (*int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	limit, ok := s.followLimits[userID]
+	if !ok {
+		return nil, nil //nolint:nilnil // no override
+	}
+
+	return &limit, nil
+}
+
+// SetFollowLimit stores an admin override of how many users userID may follow; nil clears it.
+func (s *Store) SetFollowLimit(_ context.Context, userID uuid.UUID, limit *int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if limit == nil {
+		delete(s.followLimits, userID)
+	} else {
+		s.followLimits[userID] = *limit
+	}
+
+	return nil
+}
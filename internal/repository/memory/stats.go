@@ -0,0 +1,202 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// GetStatsTotals returns the current user and follow counts.
+func (s *Store) GetStatsTotals(_ context.Context) (*dto.AdminStatsTotals, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	totals := dto.AdminStatsTotals{
+		TotalUsers:  len(s.users),
+		FollowEdges: len(s.follows),
+	}
+
+	for _, user := range s.users {
+		if user.IsActive {
+			totals.ActiveUsers++
+		} else {
+			totals.InactiveUsers++
+		}
+	}
+
+	return &totals, nil
+}
+
+// CountByBucket counts metric in [from, to), keyed by bucket start.
+func (s *Store) CountByBucket(
+	_ context.Context,
+	metric repository.StatsMetric,
+	from, to time.Time,
+	interval dto.StatsInterval,
+) (map[time.Time]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[time.Time]int)
+	count := func(at time.Time) {
+		if !at.Before(from) && at.Before(to) {
+			counts[interval.Truncate(at)]++
+		}
+	}
+
+	switch metric {
+	case repository.StatsMetricSignups:
+		for _, user := range s.users {
+			count(user.CreatedAt)
+		}
+	case repository.StatsMetricNewFollows:
+		for _, followedAt := range s.follows {
+			count(followedAt)
+		}
+	case repository.StatsMetricDeactivations:
+		for _, change := range s.changes {
+			if change.ChangeType == dto.UserChangeTypeDeactivated {
+				count(change.ChangedAt)
+			}
+		}
+	case repository.StatsMetricActiveUsers:
+		type userBucket struct {
+			userID string
+			start  time.Time
+		}
+
+		seen := make(map[userBucket]bool)
+
+		for _, change := range s.changes {
+			key := userBucket{userID: change.UserID, start: interval.Truncate(change.ChangedAt)}
+			if !seen[key] {
+				seen[key] = true
+
+				count(change.ChangedAt)
+			}
+		}
+	default:
+		return nil, repository.ErrUnknownStatsMetric
+	}
+
+	return counts, nil
+}
+
+// CountPreferenceAdopters returns the number of users who saved each preference category.
+func (s *Store) CountPreferenceAdopters(_ context.Context) (map[dto.PreferenceCategory]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	adopters := make(map[dto.PreferenceCategory]int, len(dto.ValidPreferenceCategories))
+
+	for _, category := range dto.ValidPreferenceCategories {
+		adopters[category] = 0
+	}
+
+	for userID, set := range s.preferences {
+		if _, ok := s.users[userID]; !ok {
+			continue
+		}
+
+		for category, saved := range savedCategories(set) {
+			if saved {
+				adopters[category]++
+			}
+		}
+	}
+
+	return adopters, nil
+}
+
+// savedCategories reports which categories of set hold saved preferences.
+func savedCategories(set *preferenceSet) map[dto.PreferenceCategory]bool {
+	return map[dto.PreferenceCategory]bool{
+		dto.PreferenceCategoryNotification:  set.notification != nil,
+		dto.PreferenceCategoryDisplay:       set.display != nil,
+		dto.PreferenceCategoryPrivacy:       set.privacy != nil,
+		dto.PreferenceCategoryAccessibility: set.accessibility != nil,
+		dto.PreferenceCategoryLanguage:      set.language != nil,
+		dto.PreferenceCategorySecurity:      set.security != nil,
+		dto.PreferenceCategorySocial:        set.social != nil,
+		dto.PreferenceCategorySound:         set.sound != nil,
+		dto.PreferenceCategoryTheme:         set.theme != nil,
+	}
+}
+
+// GetFollowerTotals returns the current follower counts of userID.
+func (s *Store) GetFollowerTotals(_ context.Context, userID uuid.UUID) (*dto.FollowerInsightsTotals, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var totals dto.FollowerInsightsTotals
+
+	for key := range s.follows {
+		if key.followee != userID {
+			continue
+		}
+
+		totals.Followers++
+
+		set, ok := s.preferences[key.follower]
+		if !ok || set.privacy == nil || set.privacy.ProfileVisibility == dto.ProfileVisibilityPublic {
+			totals.PublicProfileFollowers++
+		}
+	}
+
+	return &totals, nil
+}
+
+// CountFollowersByCountry counts the current followers of userID who show their location, keyed
+// by country.
+func (s *Store) CountFollowersByCountry(_ context.Context, userID uuid.UUID) (map[string]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[string]int)
+
+	for key := range s.follows {
+		if key.followee != userID {
+			continue
+		}
+
+		follower, ok := s.users[key.follower]
+		if !ok || follower.Country == nil {
+			continue
+		}
+
+		set, ok := s.preferences[key.follower]
+		if ok && set.privacy != nil && set.privacy.ShowLocation {
+			counts[*follower.Country]++
+		}
+	}
+
+	return counts, nil
+}
+
+// CountFollowEventsByBucket counts the follow history events of the followers of userID in
+// [from, to), keyed by bucket start.
+func (s *Store) CountFollowEventsByBucket(
+	_ context.Context,
+	userID uuid.UUID,
+	event dto.FollowEvent,
+	from, to time.Time,
+	interval dto.StatsInterval,
+) (map[time.Time]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[time.Time]int)
+
+	for _, entry := range s.followEvents {
+		if entry.key.followee == userID && entry.event == event &&
+			!entry.occurredAt.Before(from) && entry.occurredAt.Before(to) {
+			counts[interval.Truncate(entry.occurredAt)]++
+		}
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,384 @@
+// Package memory provides in-memory implementations of the repositories and token stores, so the
+// service can run without PostgreSQL or Redis for demos and frontend development.
+// Data lives for the life of the process.
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// Compile-time checks that Store satisfies every interface it stands in for.
+var (
+	_ repository.HealthChecker                = (*Store)(nil)
+	_ repository.UserRepository               = (*Store)(nil)
+	_ repository.SocialRepository             = (*Store)(nil)
+	_ repository.PreferenceRepository         = (*Store)(nil)
+	_ repository.HandleRepository             = (*Store)(nil)
+	_ repository.ChangeLogRepository          = (*Store)(nil)
+	_ repository.TokenStore                   = (*Store)(nil)
+	_ repository.EmailChangeStore             = (*Store)(nil)
+	_ repository.HandleReservationStore       = (*Store)(nil)
+	_ repository.ProfileShareStore            = (*Store)(nil)
+	_ repository.AccountRecoveryStore         = (*Store)(nil)
+	_ repository.AccountRecoveryRepository    = (*Store)(nil)
+	_ repository.StatsRepository              = (*Store)(nil)
+	_ repository.DataAccessRepository         = (*Store)(nil)
+	_ repository.ConsentRepository            = (*Store)(nil)
+	_ repository.PolicyAcceptanceRepository   = (*Store)(nil)
+	_ repository.AgeRepository                = (*Store)(nil)
+	_ repository.RoleRepository               = (*Store)(nil)
+	_ repository.OrganizationRepository       = (*Store)(nil)
+	_ repository.InvitationRepository         = (*Store)(nil)
+	_ repository.PreferenceCenterRepository   = (*Store)(nil)
+	_ repository.AdminNoteRepository          = (*Store)(nil)
+	_ repository.AuditRepository              = (*Store)(nil)
+	_ repository.ModerationRepository         = (*Store)(nil)
+	_ repository.DeviceTokenRepository        = (*Store)(nil)
+	_ repository.UsernameIndex                = (*Store)(nil)
+	_ repository.MaintenanceStore             = (*Store)(nil)
+	_ repository.SearchCache                  = (*Store)(nil)
+	_ repository.PublicProfileStore           = (*Store)(nil)
+	_ repository.LockStore                    = (*Store)(nil)
+	_ repository.PresenceStore                = (*Store)(nil)
+	_ repository.ProfileViewStore             = (*Store)(nil)
+	_ repository.ProfileViewRepository        = (*Store)(nil)
+	_ repository.RecentlyViewedStore          = (*Store)(nil)
+	_ repository.BadgeRepository              = (*Store)(nil)
+	_ repository.BadgeQueue                   = (*Store)(nil)
+	_ repository.DirectoryLinkRepository      = (*Store)(nil)
+	_ repository.PreferenceBackfillRepository = (*Store)(nil)
+	_ repository.PrivacyDefaultsRepository    = (*Store)(nil)
+	_ repository.TxManager                    = (*Store)(nil)
+)
+
+type followKey struct {
+	follower uuid.UUID
+	followee uuid.UUID
+}
+
+// followEvent is an entry of the follow history.
+type followEvent struct {
+	key        followKey
+	event      dto.FollowEvent
+	occurredAt time.Time
+}
+
+type expiringValue struct {
+	value     any
+	expiresAt time.Time
+}
+
+// Store holds all service data in memory. It is safe for concurrent use.
+type Store struct {
+	mu sync.RWMutex
+
+	users             map[uuid.UUID]*dto.User
+	follows           map[followKey]time.Time
+	followSettings    map[followKey]dto.FollowSettings
+	closeFriends      map[followKey]struct{}
+	followEvents      []followEvent
+	followLimits      map[uuid.UUID]int
+	preferences       map[uuid.UUID]*preferenceSet
+	handles           map[string]uuid.UUID
+	changes           []dto.UserChange
+	sequence          int64
+	dataAccess        map[dataAccessKey]dto.DataConsumer
+	consents          map[uuid.UUID][]dto.ConsentRecord
+	policyAcceptances map[uuid.UUID][]dto.PolicyAcceptance
+	ages              map[uuid.UUID]dto.AgeVerification
+	roles             map[uuid.UUID]dto.UserRole
+	organizations     map[uuid.UUID]*organization
+	invitations       map[string]*dto.Invitation
+	linkGenerations   map[uuid.UUID]int
+	notes             []dto.AdminNote
+	noteSequence      int64
+	audit             []dto.AuditEntry
+	moderated         map[uuid.UUID]struct{}
+	changeRequests    []dto.ChangeRequest
+	contentFlags      []dto.ContentFlag
+	devices           []registeredDevice
+	deviceSequence    int64
+	usernameIndex     []dto.UserTypeaheadResult
+	usernameBuilds    map[string][]dto.UserTypeaheadResult
+	maintenance       *dto.MaintenanceStatus
+	lockFences        map[string]int64
+	badges            map[uuid.UUID][]dto.EarnedBadge
+	badgeQueue        map[uuid.UUID]struct{}
+	directoryLinks    map[uuid.UUID]*dto.DirectoryLink
+	privacyRollout    int
+	privacyVersions   map[uuid.UUID]int
+	publicProfiles    map[uuid.UUID]dto.PublicProfile
+	publicCursor      int64
+	profileViews      map[uuid.UUID]int64
+	pendingViews      map[uuid.UUID]int64
+	flushingViews     map[uuid.UUID]int64
+
+	// ephemeral replaces Redis keys with a TTL
+	ephemeral map[string]expiringValue
+}
+
+// New creates an empty Store.
+func New() *Store {
+	return &Store{
+		users:             make(map[uuid.UUID]*dto.User),
+		follows:           make(map[followKey]time.Time),
+		followSettings:    make(map[followKey]dto.FollowSettings),
+		closeFriends:      make(map[followKey]struct{}),
+		followLimits:      make(map[uuid.UUID]int),
+		preferences:       make(map[uuid.UUID]*preferenceSet),
+		handles:           make(map[string]uuid.UUID),
+		dataAccess:        make(map[dataAccessKey]dto.DataConsumer),
+		consents:          make(map[uuid.UUID][]dto.ConsentRecord),
+		policyAcceptances: make(map[uuid.UUID][]dto.PolicyAcceptance),
+		ages:              make(map[uuid.UUID]dto.AgeVerification),
+		roles:             make(map[uuid.UUID]dto.UserRole),
+		organizations:     make(map[uuid.UUID]*organization),
+		invitations:       make(map[string]*dto.Invitation),
+		linkGenerations:   make(map[uuid.UUID]int),
+		moderated:         make(map[uuid.UUID]struct{}),
+		usernameBuilds:    make(map[string][]dto.UserTypeaheadResult),
+		lockFences:        make(map[string]int64),
+		badges:            make(map[uuid.UUID][]dto.EarnedBadge),
+		badgeQueue:        make(map[uuid.UUID]struct{}),
+		directoryLinks:    make(map[uuid.UUID]*dto.DirectoryLink),
+		privacyVersions:   make(map[uuid.UUID]int),
+		publicProfiles:    make(map[uuid.UUID]dto.PublicProfile),
+		profileViews:      make(map[uuid.UUID]int64),
+		pendingViews:      make(map[uuid.UUID]int64),
+		flushingViews:     make(map[uuid.UUID]int64),
+		ephemeral:         make(map[string]expiringValue),
+	}
+}
+
+// NewFromFixtures creates a Store seeded with the users, follows and preferences in f.
+func NewFromFixtures(ctx context.Context, f *fixtures.Fixtures) (*Store, error) {
+	s := New()
+
+	err := s.Seed(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Seed adds the fixture data to the store, replacing users that already exist.
+func (s *Store) Seed(ctx context.Context, f *fixtures.Fixtures) error {
+	now := time.Now()
+
+	s.mu.Lock()
+
+	for i := range f.Users {
+		seed := &f.Users[i]
+		id := seed.ID()
+
+		s.users[id] = &dto.User{
+			UserID:    id.String(),
+			Username:  seed.Username,
+			Email:     seed.Email,
+			FullName:  seed.FullName,
+			Bio:       seed.Bio,
+			IsActive:  seed.Active(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+
+		if !seed.Active() {
+			s.users[id].DeactivatedAt = &now
+		}
+	}
+
+	for i, follow := range f.Follows {
+		follower, _ := f.UserID(follow.Follower)
+		followee, _ := f.UserID(follow.Followee)
+
+		// Stagger timestamps so listings ordered by follow time match the file order
+		key := followKey{follower: follower, followee: followee}
+		followedAt := now.Add(time.Duration(i) * time.Millisecond)
+		s.follows[key] = followedAt
+		s.followEvents = append(s.followEvents, followEvent{key: key, event: dto.FollowEventFollow, occurredAt: followedAt})
+	}
+
+	s.mu.Unlock()
+
+	for i := range f.Users {
+		if f.Users[i].Preferences == nil {
+			continue
+		}
+
+		err := s.applyPreferences(ctx, f.Users[i].ID(), f.Users[i].Preferences)
+		if err != nil {
+			return fmt.Errorf("failed to seed preferences for %s: %w", f.Users[i].Username, err)
+		}
+	}
+
+	return nil
+}
+
+// Health reports the store as up.
+func (s *Store) Health(_ context.Context) map[string]string {
+	return map[string]string{
+		"status":  "up",
+		"message": "in-memory storage",
+	}
+}
+
+// Close is a no-op; the data is discarded with the process.
+func (s *Store) Close() error {
+	return nil
+}
+
+// WithinTx runs fn. Each call fn makes is atomic on its own, but a failure part way through keeps
+// the calls before it; the store has no rollback.
+func (s *Store) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// recordChange appends to the change log. Callers must hold the write lock.
+func (s *Store) recordChange(userID uuid.UUID, changeType dto.UserChangeType, category *string) {
+	s.sequence++
+	s.changes = append(s.changes, dto.UserChange{
+		Sequence:   s.sequence,
+		UserID:     userID.String(),
+		ChangeType: changeType,
+		Category:   category,
+		ChangedAt:  time.Now(),
+	})
+}
+
+// GetLatestChangesForUser returns the most recent change of each type and category for a user.
+func (s *Store) GetLatestChangesForUser(_ context.Context, userID uuid.UUID) ([]dto.UserChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	type changeKey struct {
+		changeType dto.UserChangeType
+		category   string
+	}
+
+	latest := make(map[changeKey]int)
+
+	for i, change := range s.changes {
+		if change.UserID != userID.String() {
+			continue
+		}
+
+		key := changeKey{changeType: change.ChangeType}
+		if change.Category != nil {
+			key.category = *change.Category
+		}
+
+		latest[key] = i
+	}
+
+	changes := make([]dto.UserChange, 0, len(latest))
+	for _, i := range latest {
+		changes = append(changes, s.changes[i])
+	}
+
+	slices.SortFunc(changes, func(a, b dto.UserChange) int { return cmp.Compare(a.Sequence, b.Sequence) })
+
+	return changes, nil
+}
+
+// GetChangesSince returns change log entries with a sequence greater than cursor.
+func (s *Store) GetChangesSince(_ context.Context, cursor int64, limit int) ([]dto.UserChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var changes []dto.UserChange
+
+	for _, change := range s.changes {
+		if change.Sequence <= cursor {
+			continue
+		}
+
+		if len(changes) >= limit {
+			break
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// FindUserIDByHandle returns the ID of the user owning handle.
+func (s *Store) FindUserIDByHandle(_ context.Context, handle string) (uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	userID, ok := s.handles[handle]
+	if !ok {
+		return uuid.Nil, repository.ErrHandleNotFound
+	}
+
+	return userID, nil
+}
+
+// ClaimHandle assigns handle to userID, replacing any handle the user previously held.
+func (s *Store) ClaimHandle(_ context.Context, userID uuid.UUID, handle string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if owner, ok := s.handles[handle]; ok && owner != userID {
+		return time.Time{}, repository.ErrHandleTaken
+	}
+
+	for existing, owner := range s.handles {
+		if owner == userID {
+			delete(s.handles, existing)
+		}
+	}
+
+	s.handles[handle] = userID
+
+	return time.Now(), nil
+}
+
+// copyUser returns a copy of u so callers cannot mutate stored state.
+func copyUser(u *dto.User) *dto.User {
+	c := *u
+
+	return &c
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// paginate returns the [offset, offset+limit) window of items.
+func paginate[T any](items []T, limit, offset int) []T {
+	if offset >= len(items) {
+		return nil
+	}
+
+	end := min(offset+limit, len(items))
+
+	return items[offset:end]
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// optionalString returns nil for an empty s, as the SQL repository stores empty values as NULL.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
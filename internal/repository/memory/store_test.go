@@ -0,0 +1,1073 @@
+package memory_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func newSeededStore(t *testing.T) (*memory.Store, *fixtures.Fixtures) {
+	t.Helper()
+
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{
+			{Username: "alice", Email: ptr("alice@example.com"), FullName: ptr("Alice Baker")},
+			{Username: "bob", FullName: ptr("Bob Griller")},
+			{Username: "carol", Preferences: &dto.UserPreferencesUpdateRequest{
+				Privacy: &dto.PrivacyPreferencesUpdate{ProfileVisibility: ptr(dto.ProfileVisibilityPrivate)},
+			}},
+			{Username: "dave", IsActive: ptr(false)},
+		},
+		Follows: []fixtures.Follow{
+			{Follower: "alice", Followee: "bob"},
+			{Follower: "alice", Followee: "carol"},
+			{Follower: "bob", Followee: "alice"},
+		},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	return store, f
+}
+
+func userID(t *testing.T, f *fixtures.Fixtures, username string) uuid.UUID {
+	t.Helper()
+
+	id, ok := f.UserID(username)
+	require.True(t, ok)
+
+	return id
+}
+
+func TestStore_Users(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice := userID(t, f, "alice")
+
+	user, err := store.FindUserByUsername(ctx, "ALICE")
+	require.NoError(t, err)
+	assert.Equal(t, alice.String(), user.UserID)
+
+	_, err = store.FindUserByID(ctx, uuid.New())
+	require.ErrorIs(t, err, repository.ErrUserNotFound)
+
+	results, total, err := store.SearchUsers(ctx, "er", dto.LocationFilter{}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total, "matches full names of active users only")
+	assert.Equal(t, "alice", results[0].Username)
+
+	_, err = store.UpdatePrivacyPreferencesData(ctx, alice, &dto.PrivacyPreferencesUpdate{Discoverable: ptr(false)})
+	require.NoError(t, err)
+
+	results, total, err = store.SearchUsers(ctx, "er", dto.LocationFilter{}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total, "undiscoverable users are left out of search")
+	assert.Equal(t, "bob", results[0].Username)
+
+	_, err = store.UpdateUser(ctx, alice, &dto.UserProfileUpdateRequest{Username: ptr("Bob")})
+	require.ErrorIs(t, err, repository.ErrDuplicateUsername)
+
+	_, err = store.UpdateUser(ctx, alice, &dto.UserProfileUpdateRequest{Username: ptr("dave")})
+	require.ErrorIs(t, err, repository.ErrUsernameRetired)
+
+	updated, err := store.UpdateUser(ctx, alice, &dto.UserProfileUpdateRequest{Bio: ptr("Bakes bread")})
+	require.NoError(t, err)
+	assert.Equal(t, "Bakes bread", *updated.Bio)
+
+	stats, err := store.GetUserStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 4, stats.TotalUsers)
+	assert.Equal(t, 1, stats.InactiveUsers)
+	assert.Equal(t, 4, stats.NewUsersToday)
+
+	privacy, err := store.FindPrivacyPreferencesByUserID(ctx, userID(t, f, "carol"))
+	require.NoError(t, err)
+	assert.Equal(t, dto.ProfileVisibilityPrivate, privacy.ProfileVisibility)
+
+	_, err = store.FindDeactivatedUserByEmail(ctx, "alice@example.com")
+	require.ErrorIs(t, err, repository.ErrUserNotFound, "active users cannot be recovered")
+
+	_, err = store.UpdateUser(ctx, alice, &dto.UserProfileUpdateRequest{IsActive: ptr(false)})
+	require.NoError(t, err)
+
+	deactivated, err := store.FindDeactivatedUserByEmail(ctx, "Alice@Example.com")
+	require.NoError(t, err)
+	assert.Equal(t, alice.String(), deactivated.UserID)
+}
+
+func TestStore_ReleaseRetiredIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, dave := userID(t, f, "alice"), userID(t, f, "dave")
+
+	_, err := store.UpdateUser(ctx, alice, &dto.UserProfileUpdateRequest{IsActive: ptr(false)})
+	require.NoError(t, err)
+
+	deactivated, err := store.FindUserByID(ctx, alice)
+	require.NoError(t, err)
+	require.NotNil(t, deactivated.DeactivatedAt)
+
+	// Later writes to a deactivated user keep its deactivation time
+	updated, err := store.UpdateUser(ctx, alice, &dto.UserProfileUpdateRequest{Bio: ptr("Gone baking")})
+	require.NoError(t, err)
+	assert.Equal(t, deactivated.DeactivatedAt, updated.DeactivatedAt)
+
+	// Only users deactivated before the cutoff are released
+	released, err := store.ReleaseRetiredIdentifiers(ctx, *deactivated.DeactivatedAt, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, released)
+
+	_, err = store.UpdateUser(ctx, userID(t, f, "bob"), &dto.UserProfileUpdateRequest{Username: ptr("dave")})
+	require.NoError(t, err, "dave's username was released")
+
+	_, err = store.UpdateUser(ctx, userID(t, f, "bob"), &dto.UserProfileUpdateRequest{Username: ptr("alice")})
+	require.ErrorIs(t, err, repository.ErrUsernameRetired)
+
+	released, err = store.ReleaseRetiredIdentifiers(ctx, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, released, "released users are not released again")
+
+	user, err := store.FindUserByID(ctx, dave)
+	require.NoError(t, err)
+	assert.Equal(t, "released_"+strings.ReplaceAll(dave.String(), "-", ""), user.Username)
+
+	// Reactivating clears the deactivation time
+	reactivated, err := store.UpdateUser(ctx, alice, &dto.UserProfileUpdateRequest{IsActive: ptr(true)})
+	require.NoError(t, err)
+	assert.Nil(t, reactivated.DeactivatedAt)
+}
+
+func TestStore_SearchUsersRanked(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	bob := userID(t, f, "bob")
+
+	results, total, err := store.SearchUsersRanked(t.Context(), bob, "", dto.LocationFilter{}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, "alice", results[0].Username)
+	assert.Equal(t, dto.SearchConnectionFollowing, results[0].Connection)
+	assert.Equal(t, "carol", results[1].Username, "followed by alice, whom bob follows")
+	assert.Equal(t, dto.SearchConnectionSecondDegree, results[1].Connection)
+	assert.Equal(t, "bob", results[2].Username)
+	assert.Equal(t, dto.SearchConnectionNone, results[2].Connection)
+
+	page, _, err := store.SearchUsersRanked(t.Context(), bob, "", dto.LocationFilter{}, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, "carol", page[0].Username)
+}
+
+func TestStore_SearchUsers_Location(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob := userID(t, f, "alice"), userID(t, f, "bob")
+
+	for _, id := range []uuid.UUID{alice, bob} {
+		_, err := store.UpdateUser(ctx, id, &dto.UserProfileUpdateRequest{Country: ptr("IT"), Region: ptr("IT-25")})
+		require.NoError(t, err)
+	}
+
+	_, err := store.UpdatePrivacyPreferencesData(ctx, alice, &dto.PrivacyPreferencesUpdate{ShowLocation: ptr(true)})
+	require.NoError(t, err)
+
+	results, total, err := store.SearchUsers(ctx, "", dto.LocationFilter{Country: "IT"}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total, "users who hide their location are left out")
+	assert.Equal(t, "alice", results[0].Username)
+
+	_, total, err = store.SearchUsers(ctx, "", dto.LocationFilter{Region: "IT-21"}, 10, 0)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+
+	cleared, err := store.UpdateUser(ctx, alice, &dto.UserProfileUpdateRequest{Country: ptr(""), Region: ptr("")})
+	require.NoError(t, err)
+	assert.Nil(t, cleared.Country, "empty locations are cleared")
+
+	_, total, err = store.SearchUsersRanked(ctx, bob, "", dto.LocationFilter{Country: "IT"}, 10, 0)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func TestStore_SearchUsernamePrefix(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newSeededStore(t)
+
+	results, err := store.SearchUsernamePrefix(t.Context(), "A", 10)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "alice", results[0].Username)
+
+	results, err = store.SearchUsernamePrefix(t.Context(), "d", 10)
+	require.NoError(t, err)
+	assert.Empty(t, results, "inactive users are not suggested")
+
+	results, err = store.SearchUsernamePrefix(t.Context(), "", 2)
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+}
+
+func TestStore_FindMentionableUsers(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+
+	_, err := store.UpdatePrivacyPreferencesData(ctx, userID(t, f, "bob"),
+		&dto.PrivacyPreferencesUpdate{ShowFullName: ptr(false)})
+	require.NoError(t, err)
+
+	users, err := store.FindMentionableUsers(ctx, []string{"alice", "bob", "dave", "nobody"})
+	require.NoError(t, err)
+	assert.Equal(t, []dto.MentionedUser{
+		{UserID: userID(t, f, "alice").String(), Username: "alice", FullName: ptr("Alice Baker")},
+		{UserID: userID(t, f, "bob").String(), Username: "bob"},
+	}, users, "inactive users cannot be mentioned and hidden full names stay hidden")
+}
+
+func TestStore_Social(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob, dave := userID(t, f, "alice"), userID(t, f, "bob"), userID(t, f, "dave")
+
+	following, total, err := store.GetFollowing(ctx, alice, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, following, 1)
+	assert.Equal(t, "carol", following[0].Username, "most recent follow first")
+
+	require.NoError(t, store.FollowUser(ctx, dave, alice))
+	require.NoError(t, store.FollowUser(ctx, dave, alice), "following twice is idempotent")
+
+	_, total, err = store.GetFollowers(ctx, alice, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	followedAt, err := store.CheckFollowing(ctx, bob, alice)
+	require.NoError(t, err)
+	assert.NotNil(t, followedAt)
+
+	require.NoError(t, store.UnfollowUser(ctx, bob, alice))
+
+	followedAt, err = store.CheckFollowing(ctx, bob, alice)
+	require.NoError(t, err)
+	assert.Nil(t, followedAt)
+
+	recent, err := store.GetRecentFollows(ctx, dave, 10)
+	require.NoError(t, err)
+	require.Len(t, recent, 1)
+	assert.Equal(t, "alice", recent[0].Username)
+}
+
+func TestStore_FollowSettings(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob, carol := userID(t, f, "alice"), userID(t, f, "bob"), userID(t, f, "carol")
+
+	require.NoError(t, store.FollowUser(ctx, carol, bob))
+
+	_, err := store.UpdateFollowSettings(ctx, bob, carol, &dto.FollowSettingsUpdateRequest{Muted: ptr(true)})
+	require.ErrorIs(t, err, repository.ErrNotFollowing)
+
+	settings, err := store.UpdateFollowSettings(ctx, alice, bob, &dto.FollowSettingsUpdateRequest{Muted: ptr(true)})
+	require.NoError(t, err)
+	assert.Equal(t, dto.FollowSettings{Muted: true, NotifyNewRecipes: true, NotifyReviews: true}, *settings)
+
+	byFollowee, err := store.GetFollowSettings(ctx, alice, []uuid.UUID{bob, carol, alice})
+	require.NoError(t, err)
+	assert.Len(t, byFollowee, 2, "users alice does not follow are absent")
+	assert.True(t, byFollowee[bob].Muted)
+	assert.Equal(t, dto.DefaultFollowSettings(), byFollowee[carol])
+
+	recipients, err := store.GetNotificationRecipients(ctx, bob, dto.FollowNotificationEventNewRecipe)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{carol}, recipients, "muted followers are left out")
+
+	// Unfollowing drops the settings, so a new follow starts from the defaults
+	require.NoError(t, store.UnfollowUser(ctx, alice, bob))
+	require.NoError(t, store.FollowUser(ctx, alice, bob))
+
+	byFollowee, err = store.GetFollowSettings(ctx, alice, []uuid.UUID{bob})
+	require.NoError(t, err)
+	assert.False(t, byFollowee[bob].Muted)
+}
+
+func TestStore_CloseFriends(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob, carol := userID(t, f, "alice"), userID(t, f, "bob"), userID(t, f, "carol")
+
+	// carol does not follow bob, so she cannot be his close friend
+	require.ErrorIs(t, store.SetCloseFriend(ctx, bob, carol, true), repository.ErrNotFollowing)
+
+	require.NoError(t, store.SetCloseFriend(ctx, bob, alice, true))
+
+	closeFriend, err := store.IsCloseFriend(ctx, bob, alice)
+	require.NoError(t, err)
+	assert.True(t, closeFriend)
+
+	friends, total, err := store.GetCloseFriends(ctx, bob, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, friends, 1)
+	assert.Equal(t, "alice", friends[0].Username)
+
+	// Unfollowing drops the close friend flag with the follow
+	require.NoError(t, store.UnfollowUser(ctx, alice, bob))
+	require.NoError(t, store.FollowUser(ctx, alice, bob))
+
+	closeFriend, err = store.IsCloseFriend(ctx, bob, alice)
+	require.NoError(t, err)
+	assert.False(t, closeFriend)
+}
+
+func TestStore_Preferences(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice := userID(t, f, "alice")
+
+	display, err := store.GetDisplayPreferences(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, repository.DefaultDisplayPreferences().FontSize, display.FontSize)
+
+	changes, err := store.GetChangesSince(ctx, 0, 100)
+	require.NoError(t, err)
+
+	cursor := changes[len(changes)-1].Sequence
+
+	updated, err := store.UpdateDisplayPreferences(ctx, alice, &dto.DisplayPreferencesUpdate{
+		FontSize: ptr(dto.FontSizeLarge),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, dto.FontSizeLarge, updated.FontSize)
+	assert.Equal(t, dto.ColorSchemeLight, updated.ColorScheme, "unset fields keep their defaults")
+
+	display, err = store.GetDisplayPreferences(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, dto.FontSizeLarge, display.FontSize)
+
+	changes, err = store.GetChangesSince(ctx, cursor, 100)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, dto.UserChangeTypePreferenceChanged, changes[0].ChangeType)
+	assert.Equal(t, "display", *changes[0].Category)
+}
+
+func TestStore_ResetPreferences(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice := userID(t, f, "alice")
+
+	_, err := store.UpdateDisplayPreferences(ctx, alice, &dto.DisplayPreferencesUpdate{FontSize: ptr(dto.FontSizeLarge)})
+	require.NoError(t, err)
+
+	_, err = store.UpdateSoundPreferences(ctx, alice, &dto.SoundPreferencesUpdate{VolumeLevel: ptr(dto.VolumeLevelHigh)})
+	require.NoError(t, err)
+
+	changes, err := store.GetChangesSince(ctx, 0, 100)
+	require.NoError(t, err)
+
+	cursor := changes[len(changes)-1].Sequence
+
+	require.NoError(t, store.ResetPreferences(ctx, alice, []dto.PreferenceCategory{dto.PreferenceCategoryDisplay}))
+
+	display, err := store.GetDisplayPreferences(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, repository.DefaultDisplayPreferences().FontSize, display.FontSize)
+
+	sound, err := store.GetSoundPreferences(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, dto.VolumeLevelHigh, sound.VolumeLevel, "other categories keep their values")
+
+	changes, err = store.GetChangesSince(ctx, cursor, 100)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "display", *changes[0].Category)
+
+	err = store.ResetPreferences(ctx, alice, []dto.PreferenceCategory{"colors"})
+	require.ErrorIs(t, err, repository.ErrInvalidPreferenceCategory)
+}
+
+func TestStore_InsertDefaultPreferences(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	carol := userID(t, f, "carol")
+
+	first, err := store.ListUserIDs(ctx, uuid.Nil, 3)
+	require.NoError(t, err)
+	require.Len(t, first, 3)
+
+	rest, err := store.ListUserIDs(ctx, first[2], 3)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+
+	// Carol saved her privacy preferences, every other category of every user is missing
+	inserted, err := store.InsertDefaultPreferences(ctx, append(first, rest...))
+	require.NoError(t, err)
+	assert.Equal(t, 4*9-1, inserted)
+
+	inserted, err = store.InsertDefaultPreferences(ctx, append(first, rest...))
+	require.NoError(t, err)
+	assert.Zero(t, inserted, "a second run inserts nothing")
+
+	privacy, err := store.GetPrivacyPreferencesData(ctx, carol)
+	require.NoError(t, err)
+	assert.Equal(t, dto.ProfileVisibilityPrivate, privacy.ProfileVisibility)
+
+	// Backfilled rows keep their own updated_at rather than reading as fresh defaults
+	theme, err := store.GetThemePreferences(ctx, carol)
+	require.NoError(t, err)
+
+	again, err := store.GetThemePreferences(ctx, carol)
+	require.NoError(t, err)
+	assert.Equal(t, theme.UpdatedAt, again.UpdatedAt)
+}
+
+func TestStore_PrivacyDefaults(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob, carol := userID(t, f, "alice"), userID(t, f, "bob"), userID(t, f, "carol")
+
+	// Reads and writes provision users under the rollout version of the moment
+	store.SetPrivacyDefaultsVersion(dto.PrivacyDefaultsVersionPublicProfile)
+
+	_, err := store.GetPrivacyPreferencesData(ctx, alice)
+	require.NoError(t, err)
+
+	_, err = store.UpdatePrivacyPreferencesData(ctx, carol, &dto.PrivacyPreferencesUpdate{AllowMessages: ptr(false)})
+	require.NoError(t, err)
+
+	store.SetPrivacyDefaultsVersion(dto.PrivacyDefaultsVersionPrivateProfile)
+
+	privacy, err := store.GetPrivacyPreferencesData(ctx, bob)
+	require.NoError(t, err)
+	assert.Equal(t, dto.ProfileVisibilityPrivate, privacy.ProfileVisibility)
+
+	privacy, err = store.GetPrivacyPreferencesData(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, dto.ProfileVisibilityPublic, privacy.ProfileVisibility)
+
+	cohorts, err := store.CountPrivacyDefaultsVersions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.PrivacyDefaultsCohort{{Version: 0, Users: 1}, {Version: 1, Users: 2}, {Version: 2, Users: 1}},
+		cohorts)
+
+	// Moving the first cohort changes Alice's defaults; Carol's saved row is kept
+	moves, err := store.MovePrivacyDefaults(ctx, 1, 2, 10)
+	require.NoError(t, err)
+	assert.Len(t, moves, 2)
+
+	for _, move := range moves {
+		assert.Equal(t, move.UserID == alice, move.Changed)
+	}
+
+	privacy, err = store.GetPrivacyPreferencesData(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, dto.ProfileVisibilityPrivate, privacy.ProfileVisibility)
+
+	moves, err = store.MovePrivacyDefaults(ctx, 1, 2, 10)
+	require.NoError(t, err)
+	assert.Empty(t, moves)
+}
+
+func TestStore_EphemeralValues(t *testing.T) {
+	t.Parallel()
+
+	store := memory.New()
+	ctx := t.Context()
+	alice, bob := uuid.New(), uuid.New()
+
+	require.NoError(t, store.StoreDeleteToken(ctx, alice, "token", time.Minute))
+
+	token, err := store.GetDeleteToken(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
+
+	require.NoError(t, store.StoreProfileShareToken(ctx, alice, "share", -time.Second))
+
+	_, err = store.GetProfileShareToken(ctx, alice)
+	require.ErrorIs(t, err, redis.ErrTokenNotFound, "expired values are gone")
+
+	ok, err := store.ReserveHandle(ctx, "chef", alice, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = store.ReserveHandle(ctx, "chef", bob, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, store.ReleaseHandleReservation(ctx, "chef", bob), "only the holder can release")
+
+	holder, err := store.GetHandleReservation(ctx, "chef")
+	require.NoError(t, err)
+	assert.Equal(t, alice, holder)
+
+	_, err = store.ClaimHandle(ctx, alice, "chef")
+	require.NoError(t, err)
+
+	_, err = store.ClaimHandle(ctx, bob, "chef")
+	require.ErrorIs(t, err, repository.ErrHandleTaken)
+
+	require.NoError(t, store.StoreRecoveryToken(ctx, "hash", alice, time.Minute))
+
+	recovered, err := store.TakeRecoveryToken(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, alice, recovered)
+
+	_, err = store.TakeRecoveryToken(ctx, "hash")
+	require.ErrorIs(t, err, redis.ErrTokenNotFound, "recovery tokens are single use")
+
+	ok, err = store.ReserveNonce(ctx, "nonce", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = store.ReserveNonce(ctx, "nonce", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok, "request nonces are accepted once")
+}
+
+func TestStore_Stats(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+
+	totals, err := store.GetStatsTotals(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, dto.AdminStatsTotals{TotalUsers: 4, ActiveUsers: 3, InactiveUsers: 1, FollowEdges: 3}, *totals)
+
+	today := dto.StatsIntervalDay.Truncate(time.Now())
+	tomorrow := dto.StatsIntervalDay.Next(today)
+
+	signups, err := store.CountByBucket(ctx, repository.StatsMetricSignups, today, tomorrow, dto.StatsIntervalDay)
+	require.NoError(t, err)
+	assert.Equal(t, map[time.Time]int{today: 4}, signups)
+
+	follows, err := store.CountByBucket(ctx, repository.StatsMetricNewFollows, tomorrow, tomorrow.AddDate(0, 0, 1),
+		dto.StatsIntervalDay)
+	require.NoError(t, err)
+	assert.Empty(t, follows, "follows outside the range are not counted")
+
+	_, err = store.UpdateUser(ctx, userID(t, f, "alice"), &dto.UserProfileUpdateRequest{IsActive: ptr(false)})
+	require.NoError(t, err)
+
+	deactivations, err := store.CountByBucket(ctx, repository.StatsMetricDeactivations, today, tomorrow,
+		dto.StatsIntervalDay)
+	require.NoError(t, err)
+	assert.Equal(t, map[time.Time]int{today: 1}, deactivations)
+
+	_, err = store.CountByBucket(ctx, "unknown", today, tomorrow, dto.StatsIntervalDay)
+	require.ErrorIs(t, err, repository.ErrUnknownStatsMetric)
+
+	adopters, err := store.CountPreferenceAdopters(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, adopters[dto.PreferenceCategoryPrivacy])
+	assert.Equal(t, 0, adopters[dto.PreferenceCategoryTheme])
+}
+
+func TestStore_FollowerInsights(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob, carol := userID(t, f, "alice"), userID(t, f, "bob"), userID(t, f, "carol")
+
+	require.NoError(t, store.FollowUser(ctx, carol, alice))
+	require.NoError(t, store.FollowUser(ctx, carol, alice), "repeated follows are recorded once")
+
+	totals, err := store.GetFollowerTotals(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, dto.FollowerInsightsTotals{Followers: 2, PublicProfileFollowers: 1}, *totals)
+
+	for _, id := range []uuid.UUID{bob, carol} {
+		_, err = store.UpdateUser(ctx, id, &dto.UserProfileUpdateRequest{Country: ptr("NZ")})
+		require.NoError(t, err)
+	}
+
+	_, err = store.UpdatePrivacyPreferencesData(ctx, carol, &dto.PrivacyPreferencesUpdate{ShowLocation: ptr(true)})
+	require.NoError(t, err)
+
+	countries, err := store.CountFollowersByCountry(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"NZ": 1}, countries, "followers who hide their location are not counted")
+
+	require.NoError(t, store.UnfollowUser(ctx, bob, alice))
+	require.NoError(t, store.UnfollowUser(ctx, bob, alice), "repeated unfollows are recorded once")
+
+	today := dto.StatsIntervalDay.Truncate(time.Now())
+	tomorrow := dto.StatsIntervalDay.Next(today)
+
+	follows, err := store.CountFollowEventsByBucket(ctx, alice, dto.FollowEventFollow, today, tomorrow,
+		dto.StatsIntervalDay)
+	require.NoError(t, err)
+	assert.Equal(t, map[time.Time]int{today: 2}, follows, "seeded follows are part of the history")
+
+	unfollows, err := store.CountFollowEventsByBucket(ctx, alice, dto.FollowEventUnfollow, today, tomorrow,
+		dto.StatsIntervalDay)
+	require.NoError(t, err)
+	assert.Equal(t, map[time.Time]int{today: 1}, unfollows)
+}
+
+func TestStore_FollowHistory(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob := userID(t, f, "alice"), userID(t, f, "bob")
+
+	require.NoError(t, store.UnfollowUser(ctx, bob, alice))
+
+	events, total, err := store.GetFollowHistory(ctx, alice, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, events, 2)
+	assert.Equal(t, dto.FollowEventUnfollow, events[0].Event, "newest first")
+	assert.Equal(t, "bob", events[0].Username)
+	assert.Equal(t, dto.FollowEventFollow, events[1].Event)
+
+	page, _, err := store.GetFollowHistory(ctx, alice, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, events[1:], page)
+
+	deleted, err := store.DeleteFollowEventsBefore(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), deleted, "three seeded follows and one unfollow")
+
+	_, total, err = store.GetFollowHistory(ctx, alice, 10, 0)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func TestStore_DataAccess(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice := userID(t, f, "alice")
+	earlier := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+
+	require.NoError(t, store.RecordDataAccess(ctx, alice, "recipe-service", dto.DataCategoryProfile, later))
+	require.NoError(t, store.RecordDataAccess(ctx, alice, "recipe-service", dto.DataCategoryProfile, earlier))
+	require.NoError(t, store.RecordDataAccess(ctx, alice, "mail-service", dto.DataCategoryPreferences, earlier))
+
+	consumers, err := store.GetDataConsumers(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.DataConsumer{
+		{ClientID: "recipe-service", Category: dto.DataCategoryProfile, AccessCount: 2, LastAccessedAt: later},
+		{ClientID: "mail-service", Category: dto.DataCategoryPreferences, AccessCount: 1, LastAccessedAt: earlier},
+	}, consumers)
+
+	others, err := store.GetDataConsumers(ctx, userID(t, f, "bob"))
+	require.NoError(t, err)
+	assert.Empty(t, others)
+}
+
+func TestStore_DeviceTokens(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob := userID(t, f, "alice"), userID(t, f, "bob")
+
+	for _, token := range []string{"first", "second", "third"} {
+		device := &dto.DeviceToken{Platform: dto.DevicePlatformAndroid, Token: token}
+		require.NoError(t, store.RegisterDeviceToken(ctx, alice, device, 2))
+	}
+
+	devices, err := store.ListDeviceTokens(ctx, alice)
+	require.NoError(t, err)
+	require.Len(t, devices, 2)
+	assert.Equal(t, "third", devices[0].Token)
+	assert.Equal(t, "second", devices[1].Token)
+
+	// Registering a token on another account moves it there
+	moved := &dto.DeviceToken{Platform: dto.DevicePlatformAndroid, Token: "second", AppVersion: "2.0"}
+	require.NoError(t, store.RegisterDeviceToken(ctx, bob, moved, 2))
+	assert.Equal(t, devices[1].DeviceID, moved.DeviceID)
+
+	devices, err = store.ListDeviceTokens(ctx, alice)
+	require.NoError(t, err)
+	assert.Len(t, devices, 1)
+
+	require.ErrorIs(t, store.DeleteDeviceToken(ctx, alice, moved.DeviceID), repository.ErrDeviceNotFound)
+	require.NoError(t, store.DeleteDeviceToken(ctx, bob, moved.DeviceID))
+
+	deleted, err := store.DeleteStaleDeviceTokens(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
+
+func TestStore_Badges(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob := userID(t, f, "alice"), userID(t, f, "bob")
+
+	metrics, err := store.GetBadgeMetrics(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, 1, metrics.Followers)
+	assert.Zero(t, metrics.Recipes)
+
+	_, err = store.GetBadgeMetrics(ctx, uuid.New())
+	require.ErrorIs(t, err, repository.ErrUserNotFound)
+
+	awarded, err := store.AwardBadges(ctx, alice, []string{"first-recipe", "followers-100"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first-recipe", "followers-100"}, awarded)
+
+	awarded, err = store.AwardBadges(ctx, alice, []string{"followers-100", "member-1-year"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"member-1-year"}, awarded, "badges are earned once")
+
+	badges, err := store.ListUserBadges(ctx, alice)
+	require.NoError(t, err)
+	assert.Len(t, badges, 3)
+
+	missing, err := store.ListUsersMissingBadge(ctx, "member-1-year", time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	assert.NotContains(t, missing, alice)
+	assert.Contains(t, missing, bob)
+	assert.Len(t, missing, 2, "inactive users are left out")
+
+	require.NoError(t, store.EnqueueBadgeEvaluation(ctx, alice))
+	require.NoError(t, store.EnqueueBadgeEvaluation(ctx, alice))
+
+	queued, err := store.DequeueBadgeEvaluations(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{alice}, queued)
+}
+
+func TestStore_ProfileViews(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice := userID(t, f, "alice")
+
+	first, err := store.MarkProfileViewed(ctx, alice, "viewer", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, first)
+
+	first, err = store.MarkProfileViewed(ctx, alice, "viewer", time.Hour)
+	require.NoError(t, err)
+	assert.False(t, first, "a viewer is remembered for the TTL")
+
+	require.NoError(t, store.IncrementProfileViews(ctx, alice))
+	require.NoError(t, store.IncrementProfileViews(ctx, uuid.New()))
+
+	views, err := store.TakeProfileViews(ctx)
+	require.NoError(t, err)
+	assert.Len(t, views, 2)
+
+	require.NoError(t, store.IncrementProfileViews(ctx, alice))
+
+	pending, err := store.GetPendingProfileViews(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pending, "views set aside are still pending")
+
+	require.NoError(t, store.AddProfileViews(ctx, views))
+	require.NoError(t, store.AckProfileViews(ctx))
+
+	count, err := store.GetProfileViewCount(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count, "unknown users are skipped")
+
+	views, err = store.TakeProfileViews(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int64{alice: 1}, views, "views counted during a flush wait for the next")
+}
+
+func TestStore_RecentlyViewed(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob, carol := userID(t, f, "alice"), userID(t, f, "bob"), uuid.New()
+
+	for _, viewedID := range []uuid.UUID{bob, carol, bob} {
+		require.NoError(t, store.PushRecentlyViewed(ctx, alice, viewedID, 5, time.Hour))
+	}
+
+	viewed, err := store.GetRecentlyViewed(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{bob, carol}, viewed, "a profile viewed again moves to the front")
+
+	require.NoError(t, store.PushRecentlyViewed(ctx, alice, carol, 1, time.Hour))
+
+	viewed, err = store.GetRecentlyViewed(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{carol}, viewed, "the list is capped")
+
+	require.NoError(t, store.ClearRecentlyViewed(ctx, alice))
+
+	viewed, err = store.GetRecentlyViewed(ctx, alice)
+	require.NoError(t, err)
+	assert.Empty(t, viewed)
+}
+
+func TestStore_Roles(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice := userID(t, f, "alice")
+	admin := uuid.New()
+
+	role, err := store.GetUserRole(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, dto.UserRoleUser, role)
+
+	require.NoError(t, store.SetUserRole(ctx, admin, alice, dto.UserRoleModerator))
+	require.NoError(t, store.SetUserRole(ctx, admin, alice, dto.UserRoleModerator))
+
+	role, err = store.GetUserRole(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, dto.UserRoleModerator, role)
+
+	trail, err := store.GetAuditTrail(ctx, alice, 10)
+	require.NoError(t, err)
+	require.Len(t, trail, 1, "assigning the current role again is not recorded")
+	assert.Equal(t, dto.AuditActionRoleChanged, trail[0].Action)
+	assert.Equal(t, "moderator", trail[0].ResourceID)
+
+	err = store.SetUserRole(ctx, admin, uuid.New(), dto.UserRoleAdmin)
+	require.ErrorIs(t, err, repository.ErrUserNotFound)
+}
+
+func TestStore_DirectoryLinks(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob := userID(t, f, "alice"), userID(t, f, "bob")
+	admin := uuid.New()
+
+	link, err := store.LinkDirectoryEntry(ctx, admin, alice, "alice.baker")
+	require.NoError(t, err)
+	assert.Equal(t, dto.DirectorySyncPending, link.Status)
+
+	_, err = store.LinkDirectoryEntry(ctx, admin, bob, "alice.baker")
+	require.ErrorIs(t, err, repository.ErrExternalIDLinked)
+
+	_, err = store.LinkDirectoryEntry(ctx, admin, uuid.New(), "nobody")
+	require.ErrorIs(t, err, repository.ErrUserNotFound)
+
+	now := time.Now()
+	link.Status = dto.DirectorySyncConflict
+	link.Conflicts = []dto.DirectoryAttribute{dto.DirectoryAttributeEmail}
+	link.LastSyncedAt = &now
+	require.NoError(t, store.SaveDirectorySync(ctx, link))
+
+	// Relinking to the same ID keeps the sync state
+	link, err = store.LinkDirectoryEntry(ctx, admin, alice, "alice.baker")
+	require.NoError(t, err)
+	assert.Equal(t, dto.DirectorySyncConflict, link.Status)
+
+	// A sync of a remapped link is dropped
+	_, err = store.LinkDirectoryEntry(ctx, admin, alice, "abaker")
+	require.NoError(t, err)
+	require.NoError(t, store.SaveDirectorySync(ctx, link))
+
+	link, err = store.GetDirectoryLink(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, "abaker", link.ExternalID)
+	assert.Equal(t, dto.DirectorySyncPending, link.Status)
+
+	_, err = store.LinkDirectoryEntry(ctx, admin, bob, "bob")
+	require.NoError(t, err)
+
+	links, err := store.ListDirectoryLinks(ctx, uuid.Nil, 1)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+
+	rest, err := store.ListDirectoryLinks(ctx, uuid.MustParse(links[0].UserID), 10)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.NotEqual(t, links[0].UserID, rest[0].UserID)
+
+	require.NoError(t, store.UnlinkDirectoryEntry(ctx, admin, alice))
+	require.ErrorIs(t, store.UnlinkDirectoryEntry(ctx, admin, alice), repository.ErrDirectoryLinkNotFound)
+
+	entries, err := store.GetAuditTrail(ctx, alice, 10)
+	require.NoError(t, err)
+	assert.Len(t, entries, 3, "the same-ID relink is not audited")
+}
+
+func TestStore_ContentFlags(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice := userID(t, f, "alice")
+	admin := uuid.New()
+
+	flag := &dto.ContentFlag{UserID: alice.String(), Field: "bio", Content: "darn it", Terms: []string{"darn"}}
+	require.NoError(t, store.FlagContent(ctx, flag))
+	assert.NotZero(t, flag.FlagID)
+
+	flags, err := store.ListContentFlags(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	assert.Equal(t, []string{"darn"}, flags[0].Terms)
+
+	resolved, err := store.ResolveContentFlag(ctx, admin, flag.FlagID)
+	require.NoError(t, err)
+	require.NotNil(t, resolved.ResolvedAt)
+	assert.Equal(t, admin.String(), resolved.ResolvedBy)
+
+	_, err = store.ResolveContentFlag(ctx, admin, flag.FlagID)
+	require.ErrorIs(t, err, repository.ErrContentFlagNotFound)
+
+	flags, err = store.ListContentFlags(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, flags)
+}
+
+func TestStore_Organizations(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob := userID(t, f, "alice"), userID(t, f, "bob")
+
+	org, err := store.CreateOrganization(ctx, alice,
+		&dto.OrganizationCreateRequest{Username: "tasty_kitchen", Email: "hello@tasty.example"})
+	require.NoError(t, err)
+
+	orgID := uuid.MustParse(org.OrgID)
+
+	_, err = store.CreateOrganization(ctx, bob,
+		&dto.OrganizationCreateRequest{Username: "tasty_kitchen", Email: "other@tasty.example"})
+	require.ErrorIs(t, err, repository.ErrDuplicateUsername)
+
+	require.NoError(t, store.SetMember(ctx, alice, orgID, bob, dto.OrganizationRoleEditor))
+	require.NoError(t, store.SetMember(ctx, alice, orgID, bob, dto.OrganizationRoleEditor))
+
+	role, err := store.GetMemberRole(ctx, orgID, bob)
+	require.NoError(t, err)
+	assert.Equal(t, dto.OrganizationRoleEditor, role)
+
+	memberships, err := store.ListUserOrganizations(ctx, bob)
+	require.NoError(t, err)
+	require.Len(t, memberships, 1)
+	assert.Equal(t, "tasty_kitchen", memberships[0].Organization.Username)
+
+	err = store.SetMember(ctx, alice, orgID, alice, dto.OrganizationRoleEditor)
+	require.ErrorIs(t, err, repository.ErrLastOrganizationOwner)
+	require.ErrorIs(t, store.RemoveMember(ctx, alice, orgID, alice), repository.ErrLastOrganizationOwner)
+
+	require.NoError(t, store.RemoveMember(ctx, bob, orgID, bob))
+	require.ErrorIs(t, store.RemoveMember(ctx, alice, orgID, bob), repository.ErrNotOrganizationMember)
+
+	_, err = store.GetMemberRole(ctx, orgID, bob)
+	require.ErrorIs(t, err, repository.ErrNotOrganizationMember)
+
+	trail, err := store.GetAuditTrail(ctx, orgID, 10)
+	require.NoError(t, err)
+	assert.Len(t, trail, 3, "setting the current role again is not recorded")
+}
+
+func TestStore_Invitations(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice, bob := userID(t, f, "alice"), userID(t, f, "bob")
+	expiresAt := time.Now().Add(time.Hour)
+
+	for _, code := range []string{"FIRST", "SECOND"} {
+		invitation := &dto.Invitation{Code: code, InviterID: alice.String(), ExpiresAt: expiresAt}
+		require.NoError(t, store.CreateInvitation(ctx, invitation, 2))
+		assert.Equal(t, "alice", invitation.InviterUsername)
+	}
+
+	err := store.CreateInvitation(ctx, &dto.Invitation{Code: "THIRD", InviterID: alice.String()}, 2)
+	require.ErrorIs(t, err, repository.ErrInvitationLimitReached)
+
+	invitations, err := store.ListInvitations(ctx, alice)
+	require.NoError(t, err)
+	require.Len(t, invitations, 2)
+
+	require.NoError(t, store.RedeemInvitation(ctx, "FIRST", bob, time.Now()))
+	require.ErrorIs(t, store.RedeemInvitation(ctx, "FIRST", bob, time.Now()), repository.ErrInvitationRedeemed)
+	require.ErrorIs(t, store.RedeemInvitation(ctx, "SECOND", bob, time.Now()), repository.ErrAlreadyInvited)
+
+	invitation, err := store.GetInvitation(ctx, "FIRST")
+	require.NoError(t, err)
+	require.NotNil(t, invitation.RedeemedBy)
+	assert.Equal(t, bob.String(), *invitation.RedeemedBy)
+
+	_, err = store.GetInvitation(ctx, "MISSING")
+	require.ErrorIs(t, err, repository.ErrInvitationNotFound)
+
+	stats, err := store.GetInvitationStats(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Created)
+	assert.Equal(t, int64(1), stats.Redeemed)
+	require.Len(t, stats.TopInviters, 1)
+	assert.Equal(t, "alice", stats.TopInviters[0].Username)
+}
+
+func TestStore_PreferenceCenterLinks(t *testing.T) {
+	t.Parallel()
+
+	store, f := newSeededStore(t)
+	ctx := t.Context()
+	alice := userID(t, f, "alice")
+
+	generation, err := store.GetPreferenceCenterGeneration(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, 0, generation)
+
+	generation, err = store.RevokePreferenceCenterLinks(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, 1, generation)
+
+	generation, err = store.GetPreferenceCenterGeneration(ctx, alice)
+	require.NoError(t, err)
+	assert.Equal(t, 1, generation)
+
+	_, err = store.GetPreferenceCenterGeneration(ctx, uuid.New())
+	require.ErrorIs(t, err, repository.ErrUserNotFound)
+}
@@ -0,0 +1,234 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+)
+
+// The key prefixes mirror the Redis key layout.
+func deleteTokenKey(userID uuid.UUID) string     { return "delete-token:" + userID.String() }
+func emailChangeKey(userID uuid.UUID) string     { return "email-change:" + userID.String() }
+func handleReservationKey(handle string) string  { return "handle-reservation:" + handle }
+func profileShareKey(userID uuid.UUID) string    { return "profile-share:" + userID.String() }
+func accountRecoveryKey(tokenHash string) string { return "account-recovery:" + tokenHash }
+func requestNonceKey(nonce string) string        { return "request-nonce:" + nonce }
+
+// setEphemeral stores value under key until ttl elapses. Callers must hold the write lock.
+func (s *Store) setEphemeral(key string, value any, ttl time.Duration) {
+	s.ephemeral[key] = expiringValue{value: value, expiresAt: time.Now().Add(ttl)}
+}
+
+// getEphemeral returns the unexpired value under key. Callers must hold a lock.
+func (s *Store) getEphemeral(key string) (any, bool) {
+	entry, ok := s.ephemeral[key]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+func (s *Store) storeValue(key string, value any, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.setEphemeral(key, value, ttl)
+}
+
+func (s *Store) loadValue(key string) (any, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	value, ok := s.getEphemeral(key)
+	if !ok {
+		return nil, redis.ErrTokenNotFound
+	}
+
+	return value, nil
+}
+
+func (s *Store) deleteValue(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.ephemeral, key)
+}
+
+// StoreDeleteToken stores a delete confirmation token for a user with the specified TTL.
+func (s *Store) StoreDeleteToken(_ context.Context, userID uuid.UUID, token string, ttl time.Duration) error {
+	s.storeValue(deleteTokenKey(userID), token, ttl)
+
+	return nil
+}
+
+// GetDeleteToken retrieves a delete confirmation token for a user.
+// Returns redis.ErrTokenNotFound if no token exists.
+func (s *Store) GetDeleteToken(_ context.Context, userID uuid.UUID) (string, error) {
+	value, err := s.loadValue(deleteTokenKey(userID))
+	if err != nil {
+		return "", err
+	}
+
+	token, _ := value.(string)
+
+	return token, nil
+}
+
+// DeleteDeleteToken removes a delete confirmation token for a user.
+func (s *Store) DeleteDeleteToken(_ context.Context, userID uuid.UUID) error {
+	s.deleteValue(deleteTokenKey(userID))
+
+	return nil
+}
+
+// StoreEmailChange stores a pending email change for a user with the specified TTL.
+func (s *Store) StoreEmailChange(
+	_ context.Context,
+	userID uuid.UUID,
+	change *dto.PendingEmailChange,
+	ttl time.Duration,
+) error {
+	s.storeValue(emailChangeKey(userID), *change, ttl)
+
+	return nil
+}
+
+// GetEmailChange retrieves the pending email change for a user.
+// Returns redis.ErrTokenNotFound if no change is pending or it has expired.
+func (s *Store) GetEmailChange(_ context.Context, userID uuid.UUID) (*dto.PendingEmailChange, error) {
+	value, err := s.loadValue(emailChangeKey(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	change, _ := value.(dto.PendingEmailChange)
+
+	return &change, nil
+}
+
+// DeleteEmailChange removes the pending email change for a user.
+func (s *Store) DeleteEmailChange(_ context.Context, userID uuid.UUID) error {
+	s.deleteValue(emailChangeKey(userID))
+
+	return nil
+}
+
+// ReserveHandle places a hold on a handle for a user with the specified TTL.
+// Returns false if another user already holds the handle; re-reserving refreshes the TTL.
+func (s *Store) ReserveHandle(_ context.Context, handle string, userID uuid.UUID, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := handleReservationKey(handle)
+
+	if holder, ok := s.getEphemeral(key); ok && holder != userID {
+		return false, nil
+	}
+
+	s.setEphemeral(key, userID, ttl)
+
+	return true, nil
+}
+
+// ReserveNonce records the nonce of a signed request for ttl.
+// Returns false if the nonce is already recorded.
+func (s *Store) ReserveNonce(_ context.Context, nonce string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := requestNonceKey(nonce)
+
+	if _, ok := s.getEphemeral(key); ok {
+		return false, nil
+	}
+
+	s.setEphemeral(key, struct{}{}, ttl)
+
+	return true, nil
+}
+
+// GetHandleReservation returns the user currently holding a handle.
+// Returns redis.ErrTokenNotFound if the handle is not reserved.
+func (s *Store) GetHandleReservation(_ context.Context, handle string) (uuid.UUID, error) {
+	value, err := s.loadValue(handleReservationKey(handle))
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	holder, _ := value.(uuid.UUID)
+
+	return holder, nil
+}
+
+// ReleaseHandleReservation removes a handle reservation if it is held by the given user.
+func (s *Store) ReleaseHandleReservation(_ context.Context, handle string, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := handleReservationKey(handle)
+
+	if holder, ok := s.getEphemeral(key); ok && holder == userID {
+		delete(s.ephemeral, key)
+	}
+
+	return nil
+}
+
+// StoreProfileShareToken stores the ID of a user's active profile share token with the specified TTL.
+func (s *Store) StoreProfileShareToken(_ context.Context, userID uuid.UUID, tokenID string, ttl time.Duration) error {
+	s.storeValue(profileShareKey(userID), tokenID, ttl)
+
+	return nil
+}
+
+// GetProfileShareToken retrieves the ID of a user's active profile share token.
+// Returns redis.ErrTokenNotFound if no share token is active.
+func (s *Store) GetProfileShareToken(_ context.Context, userID uuid.UUID) (string, error) {
+	value, err := s.loadValue(profileShareKey(userID))
+	if err != nil {
+		return "", err
+	}
+
+	tokenID, _ := value.(string)
+
+	return tokenID, nil
+}
+
+// DeleteProfileShareToken revokes a user's active profile share token.
+func (s *Store) DeleteProfileShareToken(_ context.Context, userID uuid.UUID) error {
+	s.deleteValue(profileShareKey(userID))
+
+	return nil
+}
+
+// StoreRecoveryToken stores the hash of an account recovery token for a user with the specified TTL.
+func (s *Store) StoreRecoveryToken(_ context.Context, tokenHash string, userID uuid.UUID, ttl time.Duration) error {
+	s.storeValue(accountRecoveryKey(tokenHash), userID, ttl)
+
+	return nil
+}
+
+// TakeRecoveryToken removes an account recovery token and returns the user it was issued to.
+// Returns redis.ErrTokenNotFound if the token does not exist or has expired.
+func (s *Store) TakeRecoveryToken(_ context.Context, tokenHash string) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := accountRecoveryKey(tokenHash)
+
+	value, ok := s.getEphemeral(key)
+	if !ok {
+		return uuid.Nil, redis.ErrTokenNotFound
+	}
+
+	delete(s.ephemeral, key)
+
+	userID, _ := value.(uuid.UUID)
+
+	return userID, nil
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// MatchUsernamePrefix returns up to limit indexed users whose username starts with prefix,
+// ignoring case, in username order.
+func (s *Store) MatchUsernamePrefix(
+	_ context.Context,
+	prefix string,
+	limit int,
+) ([]dto.UserTypeaheadResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	prefix = strings.ToLower(prefix)
+
+	var results []dto.UserTypeaheadResult
+
+	for _, user := range s.usernameIndex {
+		if strings.HasPrefix(strings.ToLower(user.Username), prefix) {
+			results = append(results, user)
+		}
+	}
+
+	return paginate(results, limit, 0), nil
+}
+
+// StageUsernames adds users to the index build buildID.
+func (s *Store) StageUsernames(_ context.Context, buildID string, users []dto.UserTypeaheadResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.usernameBuilds[buildID] = append(s.usernameBuilds[buildID], users...)
+
+	return nil
+}
+
+// PublishUsernames replaces the index with the build buildID.
+func (s *Store) PublishUsernames(_ context.Context, buildID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	index := s.usernameBuilds[buildID]
+	delete(s.usernameBuilds, buildID)
+
+	slices.SortFunc(index, func(a, b dto.UserTypeaheadResult) int {
+		return strings.Compare(strings.ToLower(a.Username), strings.ToLower(b.Username))
+	})
+
+	s.usernameIndex = index
+
+	return nil
+}
+
+// DiscardUsernames drops the index build buildID.
+func (s *Store) DiscardUsernames(_ context.Context, buildID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.usernameBuilds, buildID)
+
+	return nil
+}
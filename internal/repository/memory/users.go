@@ -0,0 +1,508 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+// FindUserByID retrieves a user by their ID.
+func (s *Store) FindUserByID(_ context.Context, userID uuid.UUID) (*dto.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.users[userID]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	return copyUser(user), nil
+}
+
+// FindUserByUsername retrieves a user by username, ignoring case.
+func (s *Store) FindUserByUsername(_ context.Context, username string) (*dto.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, user := range s.users {
+		if strings.EqualFold(user.Username, username) {
+			return copyUser(user), nil
+		}
+	}
+
+	return nil, repository.ErrUserNotFound
+}
+
+// FindDeactivatedUserByEmail returns the most recently deactivated user holding email, ignoring
+// case.
+func (s *Store) FindDeactivatedUserByEmail(_ context.Context, email string) (*dto.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var found *dto.User
+
+	for _, user := range s.users {
+		if user.IsActive || !strings.EqualFold(emailOf(user), email) {
+			continue
+		}
+
+		if found == nil || deactivatedAt(user).After(deactivatedAt(found)) {
+			found = user
+		}
+	}
+
+	if found == nil {
+		return nil, repository.ErrUserNotFound
+	}
+
+	return copyUser(found), nil
+}
+
+// FindPrivacyPreferencesByUserID retrieves the user's privacy preferences, or the defaults when
+// none were saved.
+func (s *Store) FindPrivacyPreferencesByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.UserPrivacyPreferences, error) {
+	return s.GetPrivacyPreferencesData(ctx, userID)
+}
+
+// IsFollowing checks if followerID follows followedID.
+func (s *Store) IsFollowing(_ context.Context, followerID, followedID uuid.UUID) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.follows[followKey{follower: followerID, followee: followedID}]
+
+	return ok, nil
+}
+
+// UpdateUser updates a user's profile and returns the updated user, applying the same
+// identifier uniqueness and retention rules as the SQL repository.
+func (s *Store) UpdateUser(
+	_ context.Context,
+	userID uuid.UUID,
+	update *dto.UserProfileUpdateRequest,
+) (*dto.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.updateUserLocked(userID, update)
+}
+
+// updateUserLocked applies update after claiming new identifiers. Callers must hold s.mu.
+func (s *Store) updateUserLocked(userID uuid.UUID, update *dto.UserProfileUpdateRequest) (*dto.User, error) {
+	user, ok := s.users[userID]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	if update.Username != nil {
+		err := s.claimIdentifier(userID, *update.Username, usernameOf,
+			repository.ErrDuplicateUsername, repository.ErrUsernameRetired)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if update.Email != nil {
+		err := s.claimIdentifier(userID, *update.Email, emailOf,
+			repository.ErrDuplicateEmail, repository.ErrEmailRetired)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	wasActive := user.IsActive
+
+	if update.Username != nil {
+		user.Username = *update.Username
+	}
+
+	if update.Email != nil {
+		user.Email = stringPtr(*update.Email)
+	}
+
+	if update.FullName != nil {
+		user.FullName = stringPtr(*update.FullName)
+	}
+
+	if update.Bio != nil {
+		user.Bio = stringPtr(*update.Bio)
+	}
+
+	if update.Country != nil {
+		user.Country = optionalString(*update.Country)
+	}
+
+	if update.Region != nil {
+		user.Region = optionalString(*update.Region)
+	}
+
+	if update.IsActive != nil {
+		user.IsActive = *update.IsActive
+	}
+
+	user.UpdatedAt = time.Now()
+
+	switch {
+	case user.IsActive:
+		user.DeactivatedAt = nil
+	case wasActive:
+		deactivated := user.UpdatedAt
+		user.DeactivatedAt = &deactivated
+	}
+
+	changeType := dto.UserChangeTypeUpsert
+	if wasActive && !user.IsActive {
+		changeType = dto.UserChangeTypeDeactivated
+	}
+
+	s.recordChange(userID, changeType, nil)
+
+	return copyUser(user), nil
+}
+
+func usernameOf(u *dto.User) string {
+	return u.Username
+}
+
+func emailOf(u *dto.User) string {
+	if u.Email == nil {
+		return ""
+	}
+
+	return *u.Email
+}
+
+// releasedUsername is the placeholder username u gets when its identifiers are released.
+func releasedUsername(u *dto.User) string {
+	return validation.ReleasedUsernamePrefix + strings.ReplaceAll(u.UserID, "-", "")
+}
+
+// deactivatedAt returns when u was deactivated, or the zero time for an active user.
+func deactivatedAt(u *dto.User) time.Time {
+	if u.DeactivatedAt == nil {
+		return time.Time{}
+	}
+
+	return *u.DeactivatedAt
+}
+
+// claimIdentifier checks whether value is free for userID. Active holders produce duplicateErr
+// and deactivated holders retiredErr, until ReleaseRetiredIdentifiers frees the value. Callers
+// must hold the write lock.
+func (s *Store) claimIdentifier(
+	userID uuid.UUID,
+	value string,
+	get func(*dto.User) string,
+	duplicateErr, retiredErr error,
+) error {
+	retired := false
+
+	for id, other := range s.users {
+		if id == userID || !strings.EqualFold(get(other), value) {
+			continue
+		}
+
+		if other.IsActive {
+			return duplicateErr
+		}
+
+		retired = true
+	}
+
+	if retired {
+		return retiredErr
+	}
+
+	return nil
+}
+
+// ReleaseRetiredIdentifiers frees the identifiers of up to limit users deactivated before
+// deactivatedBefore, longest deactivated first, and returns how many users it released.
+func (s *Store) ReleaseRetiredIdentifiers(_ context.Context, deactivatedBefore time.Time, limit int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var retired []*dto.User
+
+	for _, user := range s.users {
+		if !user.IsActive && deactivatedAt(user).Before(deactivatedBefore) &&
+			(user.Email != nil || user.Username != releasedUsername(user)) {
+			retired = append(retired, user)
+		}
+	}
+
+	slices.SortFunc(retired, func(a, b *dto.User) int {
+		return deactivatedAt(a).Compare(deactivatedAt(b))
+	})
+
+	retired = retired[:min(limit, len(retired))]
+
+	for _, user := range retired {
+		user.Username = releasedUsername(user)
+		user.Email = nil
+	}
+
+	return len(retired), nil
+}
+
+// SearchUsers searches active, discoverable users other than minors by username or full name in
+// location, ordered by username.
+func (s *Store) SearchUsers(
+	_ context.Context,
+	query string,
+	location dto.LocationFilter,
+	limit, offset int,
+) ([]dto.UserSearchResult, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matches := s.inLocation(s.searchMatches(query), location)
+
+	return paginate(matches, limit, offset), len(matches), nil
+}
+
+// SearchUsersRanked searches like SearchUsers but ranks users the requester follows first, then
+// users followed by someone the requester follows.
+func (s *Store) SearchUsersRanked(
+	_ context.Context,
+	requesterID uuid.UUID,
+	query string,
+	location dto.LocationFilter,
+	limit, offset int,
+) ([]dto.UserSearchResult, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	following := make(map[uuid.UUID]bool)
+
+	for key := range s.follows {
+		if key.follower == requesterID {
+			following[key.followee] = true
+		}
+	}
+
+	connections := make(map[string]dto.SearchConnection)
+
+	for key := range s.follows {
+		if following[key.follower] && key.followee != requesterID {
+			connections[key.followee.String()] = dto.SearchConnectionSecondDegree
+		}
+	}
+
+	for followee := range following {
+		connections[followee.String()] = dto.SearchConnectionFollowing
+	}
+
+	matches := s.inLocation(s.searchMatches(query), location)
+	for i := range matches {
+		matches[i].Connection = connections[matches[i].UserID]
+	}
+
+	// Stable, so equally connected users stay ordered by username
+	slices.SortStableFunc(matches, func(a, b dto.UserSearchResult) int {
+		return cmp.Compare(b.Connection, a.Connection)
+	})
+
+	return paginate(matches, limit, offset), len(matches), nil
+}
+
+// SearchUsernamePrefix returns up to limit searchable users whose username starts with prefix,
+// ignoring case, in username order.
+func (s *Store) SearchUsernamePrefix(
+	_ context.Context,
+	prefix string,
+	limit int,
+) ([]dto.UserTypeaheadResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	prefix = strings.ToLower(prefix)
+
+	var results []dto.UserTypeaheadResult
+
+	for _, match := range s.searchMatches(prefix) {
+		if strings.HasPrefix(strings.ToLower(match.Username), prefix) {
+			results = append(results, dto.UserTypeaheadResult{UserID: match.UserID, Username: match.Username})
+		}
+	}
+
+	return paginate(results, limit, 0), nil
+}
+
+// CountSearchableUsers counts the users that search and typeahead can return.
+func (s *Store) CountSearchableUsers(_ context.Context) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.searchMatches("")), nil
+}
+
+// ListSearchableUsers returns up to limit searchable users with an ID greater than after, in ID
+// order.
+func (s *Store) ListSearchableUsers(
+	_ context.Context,
+	after uuid.UUID,
+	limit int,
+) ([]dto.UserTypeaheadResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var results []dto.UserTypeaheadResult
+
+	for _, match := range s.searchMatches("") {
+		if strings.Compare(match.UserID, after.String()) > 0 {
+			results = append(results, dto.UserTypeaheadResult{UserID: match.UserID, Username: match.Username})
+		}
+	}
+
+	slices.SortFunc(results, func(a, b dto.UserTypeaheadResult) int {
+		return strings.Compare(a.UserID, b.UserID)
+	})
+
+	return paginate(results, limit, 0), nil
+}
+
+// FindMentionableUsers returns the searchable users with the given lowercase usernames.
+func (s *Store) FindMentionableUsers(_ context.Context, usernames []string) ([]dto.MentionedUser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var users []dto.MentionedUser
+
+	for _, match := range s.searchMatches("") {
+		if !slices.Contains(usernames, strings.ToLower(match.Username)) {
+			continue
+		}
+
+		user := dto.MentionedUser{UserID: match.UserID, Username: match.Username, FullName: match.FullName}
+
+		userID, _ := uuid.Parse(match.UserID)
+
+		set, ok := s.preferences[userID]
+		if ok && set.privacy != nil && !set.privacy.ShowFullName {
+			user.FullName = nil
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+// searchMatches returns the searchable users matching query, ordered by username. The caller
+// holds s.mu.
+func (s *Store) searchMatches(query string) []dto.UserSearchResult {
+	var matches []dto.UserSearchResult
+
+	now := time.Now()
+
+	for userID, user := range s.users {
+		verification := s.ages[userID]
+		if !user.IsActive || verification.IsMinor(now) {
+			continue
+		}
+
+		if set, ok := s.preferences[userID]; ok && set.privacy != nil && !set.privacy.Discoverable {
+			continue
+		}
+
+		if !containsFold(user.Username, query) && (user.FullName == nil || !containsFold(*user.FullName, query)) {
+			continue
+		}
+
+		matches = append(matches, dto.UserSearchResult{
+			UserID:    user.UserID,
+			Username:  user.Username,
+			FullName:  user.FullName,
+			IsActive:  user.IsActive,
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+		})
+	}
+
+	slices.SortFunc(matches, func(a, b dto.UserSearchResult) int {
+		return strings.Compare(a.Username, b.Username)
+	})
+
+	return matches
+}
+
+// inLocation keeps the matches in location who show their location. The caller holds s.mu.
+func (s *Store) inLocation(matches []dto.UserSearchResult, location dto.LocationFilter) []dto.UserSearchResult {
+	if location.IsZero() {
+		return matches
+	}
+
+	return slices.DeleteFunc(matches, func(match dto.UserSearchResult) bool {
+		userID, _ := uuid.Parse(match.UserID)
+
+		set, ok := s.preferences[userID]
+		if !ok || set.privacy == nil || !set.privacy.ShowLocation {
+			return true
+		}
+
+		user := s.users[userID]
+
+		return (location.Country != "" && (user.Country == nil || *user.Country != location.Country)) ||
+			(location.Region != "" && (user.Region == nil || *user.Region != location.Region))
+	})
+}
+
+// GetUserStats computes aggregated user statistics.
+func (s *Store) GetUserStats(_ context.Context) (*dto.UserStatsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	// Weeks start on Monday, matching PostgreSQL's date_trunc('week', ...)
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	var stats dto.UserStatsResponse
+
+	for _, user := range s.users {
+		stats.TotalUsers++
+
+		if user.IsActive {
+			stats.ActiveUsers++
+		} else {
+			stats.InactiveUsers++
+		}
+
+		if !user.CreatedAt.Before(today) {
+			stats.NewUsersToday++
+		}
+
+		if !user.CreatedAt.Before(weekStart) {
+			stats.NewUsersThisWeek++
+		}
+
+		if !user.CreatedAt.Before(monthStart) {
+			stats.NewUsersThisMonth++
+		}
+	}
+
+	return &stats, nil
+}
+
+// UserExists checks if a user exists.
+func (s *Store) UserExists(_ context.Context, userID uuid.UUID) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.users[userID]
+
+	return ok, nil
+}
@@ -0,0 +1,517 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// Change request errors.
+var (
+	// ErrChangeRequestNotFound is returned when a change request does not exist.
+	ErrChangeRequestNotFound = errors.New("change request not found")
+	// ErrChangeRequestPending is returned when the user already has a pending change of the field.
+	ErrChangeRequestPending = errors.New("change request already pending")
+	// ErrChangeRequestDecided is returned when a change request was already approved or rejected.
+	ErrChangeRequestDecided = errors.New("change request already decided")
+	// ErrContentFlagNotFound is returned when a content flag does not exist or is already resolved.
+	ErrContentFlagNotFound = errors.New("content flag not found")
+)
+
+// ModerationRepository stores which accounts are under moderation, the queue of identity changes
+// waiting for admin approval and the profile text flagged by content moderation. Admin decisions
+// are recorded in the audit trail in the same transaction, attributed to actorID.
+type ModerationRepository interface {
+	// IsUnderModeration reports whether identity changes on the account need approval.
+	IsUnderModeration(ctx context.Context, userID uuid.UUID) (bool, error)
+	// SetUnderModeration flags or unflags the account.
+	SetUnderModeration(ctx context.Context, actorID, userID uuid.UUID, flagged bool) error
+	// CreateChangeRequest queues a pending change and sets its ID, status and request time.
+	CreateChangeRequest(ctx context.Context, request *dto.ChangeRequest) error
+	// ListChangeRequests returns up to limit requests, oldest first. uuid.Nil lists every user and
+	// an empty status every status.
+	ListChangeRequests(
+		ctx context.Context,
+		userID uuid.UUID,
+		status dto.ChangeRequestStatus,
+		limit int,
+	) ([]dto.ChangeRequest, error)
+	// ApproveChangeRequest applies a pending change to the user and marks it approved, atomically.
+	ApproveChangeRequest(ctx context.Context, actorID uuid.UUID, requestID int64) (*dto.ChangeRequest, error)
+	// RejectChangeRequest marks a pending change rejected without applying it.
+	RejectChangeRequest(
+		ctx context.Context,
+		actorID uuid.UUID,
+		requestID int64,
+		reason string,
+	) (*dto.ChangeRequest, error)
+	// FlagContent queues profile text for admin review and sets its ID and flag time.
+	FlagContent(ctx context.Context, flag *dto.ContentFlag) error
+	// ListContentFlags returns up to limit unresolved flags, oldest first.
+	ListContentFlags(ctx context.Context, limit int) ([]dto.ContentFlag, error)
+	// ResolveContentFlag marks an unresolved flag as reviewed.
+	ResolveContentFlag(ctx context.Context, actorID uuid.UUID, flagID int64) (*dto.ContentFlag, error)
+}
+
+// SQLModerationRepository implements ModerationRepository using a SQL database.
+type SQLModerationRepository struct {
+	db    *sql.DB
+	tx    txRunner
+	users *SQLUserRepository
+}
+
+// NewModerationRepository creates a new SQLModerationRepository.
+func NewModerationRepository(db *sql.DB) *SQLModerationRepository {
+	return &SQLModerationRepository{db: db, tx: newTxRunner(db), users: NewUserRepository(db)}
+}
+
+const changeRequestColumns = `request_id, user_id, field, old_value, new_value, status, reason,
+		requested_at, decided_at, decided_by`
+
+const contentFlagColumns = `flag_id, user_id, field, content, array_to_string(terms, ','), flagged_at,
+		resolved_at, resolved_by`
+
+// IsUnderModeration reports whether identity changes on the account need approval.
+func (r *SQLModerationRepository) IsUnderModeration(ctx context.Context, userID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM recipe_manager.user_moderation WHERE user_id = $1)`
+
+	var flagged bool
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&flagged)
+	if err != nil {
+		return false, fmt.Errorf("failed to check moderation: %w", err)
+	}
+
+	return flagged, nil
+}
+
+// SetUnderModeration flags or unflags the account and records it in the audit trail.
+func (r *SQLModerationRepository) SetUnderModeration(
+	ctx context.Context,
+	actorID, userID uuid.UUID,
+	flagged bool,
+) error {
+	query := `DELETE FROM recipe_manager.user_moderation WHERE user_id = $1`
+	args := []any{userID}
+	action := dto.AuditActionModerationDisabled
+
+	if flagged {
+		query = `
+			INSERT INTO recipe_manager.user_moderation (user_id, flagged_by)
+			VALUES ($1, $2)
+			ON CONFLICT (user_id) DO NOTHING
+		`
+		args = append(args, actorID)
+		action = dto.AuditActionModerationEnabled
+	}
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, actorID, action, userID, "")
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set moderation: %w", err)
+	}
+
+	return nil
+}
+
+// CreateChangeRequest queues a pending change. A second pending change of the same field fails
+// with ErrChangeRequestPending.
+func (r *SQLModerationRepository) CreateChangeRequest(ctx context.Context, request *dto.ChangeRequest) error {
+	query := `
+		INSERT INTO recipe_manager.identity_change_requests (user_id, field, old_value, new_value)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + changeRequestColumns
+
+	row := executorFor(ctx, r.db).
+		QueryRowContext(ctx, query, request.UserID, string(request.Field), request.OldValue, request.NewValue)
+
+	err := scanChangeRequest(row, request)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrChangeRequestPending
+		}
+
+		return fmt.Errorf("failed to create change request: %w", err)
+	}
+
+	return nil
+}
+
+// ListChangeRequests returns up to limit requests, oldest first.
+func (r *SQLModerationRepository) ListChangeRequests(
+	ctx context.Context,
+	userID uuid.UUID,
+	status dto.ChangeRequestStatus,
+	limit int,
+) ([]dto.ChangeRequest, error) {
+	query := `
+		SELECT ` + changeRequestColumns + `
+		FROM recipe_manager.identity_change_requests
+		WHERE ($1 = '00000000-0000-0000-0000-000000000000'::uuid OR user_id = $1)
+			AND ($2 = '' OR status = $2)
+		ORDER BY request_id
+		LIMIT $3
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, string(status), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch change requests: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	requests := []dto.ChangeRequest{}
+
+	for rows.Next() {
+		var request dto.ChangeRequest
+
+		err = scanChangeRequest(rows, &request)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan change request: %w", err)
+		}
+
+		requests = append(requests, request)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating change requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+// ApproveChangeRequest applies a pending change to the user, marks it approved and records it in
+// the audit trail in one transaction. If the new username or email is no longer available the
+// request stays pending and the identifier error is returned.
+func (r *SQLModerationRepository) ApproveChangeRequest(
+	ctx context.Context,
+	actorID uuid.UUID,
+	requestID int64,
+) (*dto.ChangeRequest, error) {
+	var request dto.ChangeRequest
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		pending, err := lockPendingChangeRequest(ctx, tx, requestID)
+		if err != nil {
+			return err
+		}
+
+		userID, err := uuid.Parse(pending.UserID)
+		if err != nil {
+			return fmt.Errorf("invalid change request user: %w", err)
+		}
+
+		update := &dto.UserProfileUpdateRequest{}
+		if pending.Field == dto.ChangeRequestFieldUsername {
+			update.Username = &pending.NewValue
+		} else {
+			update.Email = &pending.NewValue
+		}
+
+		_, err = r.users.updateUserTx(ctx, tx, userID, update)
+		if err != nil {
+			return err
+		}
+
+		return decideChangeRequest(ctx, tx, actorID, requestID, dto.ChangeRequestStatusApproved, "", &request)
+	})
+	if err != nil {
+		return nil, mapChangeRequestError("approve", err)
+	}
+
+	return &request, nil
+}
+
+// RejectChangeRequest marks a pending change rejected and records it in the audit trail.
+func (r *SQLModerationRepository) RejectChangeRequest(
+	ctx context.Context,
+	actorID uuid.UUID,
+	requestID int64,
+	reason string,
+) (*dto.ChangeRequest, error) {
+	var request dto.ChangeRequest
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		_, err := lockPendingChangeRequest(ctx, tx, requestID)
+		if err != nil {
+			return err
+		}
+
+		return decideChangeRequest(ctx, tx, actorID, requestID, dto.ChangeRequestStatusRejected, reason, &request)
+	})
+	if err != nil {
+		return nil, mapChangeRequestError("reject", err)
+	}
+
+	return &request, nil
+}
+
+// FlagContent queues profile text for admin review.
+func (r *SQLModerationRepository) FlagContent(ctx context.Context, flag *dto.ContentFlag) error {
+	query := `
+		INSERT INTO recipe_manager.content_flags (user_id, field, content, terms)
+		VALUES ($1, $2, $3, string_to_array(NULLIF($4, ''), ','))
+		RETURNING ` + contentFlagColumns
+
+	row := executorFor(ctx, r.db).
+		QueryRowContext(ctx, query, flag.UserID, flag.Field, flag.Content, strings.Join(flag.Terms, ","))
+
+	err := scanContentFlag(row, flag)
+	if err != nil {
+		return fmt.Errorf("failed to flag content: %w", err)
+	}
+
+	return nil
+}
+
+// ListContentFlags returns up to limit unresolved flags, oldest first.
+func (r *SQLModerationRepository) ListContentFlags(ctx context.Context, limit int) ([]dto.ContentFlag, error) {
+	query := `
+		SELECT ` + contentFlagColumns + `
+		FROM recipe_manager.content_flags
+		WHERE resolved_at IS NULL
+		ORDER BY flag_id
+		LIMIT $1
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch content flags: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	flags := []dto.ContentFlag{}
+
+	for rows.Next() {
+		var flag dto.ContentFlag
+
+		err = scanContentFlag(rows, &flag)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan content flag: %w", err)
+		}
+
+		flags = append(flags, flag)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating content flags: %w", err)
+	}
+
+	return flags, nil
+}
+
+// ResolveContentFlag marks an unresolved flag as reviewed and records it in the audit trail.
+func (r *SQLModerationRepository) ResolveContentFlag(
+	ctx context.Context,
+	actorID uuid.UUID,
+	flagID int64,
+) (*dto.ContentFlag, error) {
+	query := `
+		UPDATE recipe_manager.content_flags
+		SET resolved_at = NOW(), resolved_by = $2
+		WHERE flag_id = $1 AND resolved_at IS NULL
+		RETURNING ` + contentFlagColumns
+
+	var flag dto.ContentFlag
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		err := scanContentFlag(tx.QueryRowContext(ctx, query, flagID, actorID), &flag)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrContentFlagNotFound
+		}
+
+		if err != nil {
+			return err
+		}
+
+		userID, err := uuid.Parse(flag.UserID)
+		if err != nil {
+			return fmt.Errorf("invalid content flag user: %w", err)
+		}
+
+		return recordAudit(ctx, tx, actorID, dto.AuditActionContentFlagResolved, userID, contentFlagResourceID(flagID))
+	})
+	if err != nil {
+		if errors.Is(err, ErrContentFlagNotFound) {
+			return nil, ErrContentFlagNotFound
+		}
+
+		return nil, fmt.Errorf("failed to resolve content flag: %w", err)
+	}
+
+	return &flag, nil
+}
+
+// lockPendingChangeRequest reads a change request for update, failing unless it is pending.
+func lockPendingChangeRequest(ctx context.Context, tx *sql.Tx, requestID int64) (*dto.ChangeRequest, error) {
+	query := `
+		SELECT ` + changeRequestColumns + `
+		FROM recipe_manager.identity_change_requests
+		WHERE request_id = $1
+		FOR UPDATE
+	`
+
+	var request dto.ChangeRequest
+
+	err := scanChangeRequest(tx.QueryRowContext(ctx, query, requestID), &request)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrChangeRequestNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Status != dto.ChangeRequestStatusPending {
+		return nil, ErrChangeRequestDecided
+	}
+
+	return &request, nil
+}
+
+// decideChangeRequest records the decision on a locked pending request and audits it.
+func decideChangeRequest(
+	ctx context.Context,
+	tx *sql.Tx,
+	actorID uuid.UUID,
+	requestID int64,
+	status dto.ChangeRequestStatus,
+	reason string,
+	request *dto.ChangeRequest,
+) error {
+	query := `
+		UPDATE recipe_manager.identity_change_requests
+		SET status = $2, reason = NULLIF($3, ''), decided_at = NOW(), decided_by = $4
+		WHERE request_id = $1
+		RETURNING ` + changeRequestColumns
+
+	err := scanChangeRequest(tx.QueryRowContext(ctx, query, requestID, string(status), reason, actorID), request)
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		return fmt.Errorf("invalid change request user: %w", err)
+	}
+
+	action := dto.AuditActionChangeRequestRejected
+	if status == dto.ChangeRequestStatusApproved {
+		action = dto.AuditActionChangeRequestApproved
+	}
+
+	return recordAudit(ctx, tx, actorID, action, userID, changeRequestResourceID(requestID))
+}
+
+// mapChangeRequestError passes through the errors callers act on and wraps the rest.
+func mapChangeRequestError(operation string, err error) error {
+	for _, known := range []error{
+		ErrChangeRequestNotFound,
+		ErrChangeRequestDecided,
+		ErrUserNotFound,
+		ErrDuplicateUsername,
+		ErrDuplicateEmail,
+		ErrUsernameRetired,
+		ErrEmailRetired,
+	} {
+		if errors.Is(err, known) {
+			return known
+		}
+	}
+
+	return fmt.Errorf("failed to %s change request: %w", operation, err)
+}
+
+func scanChangeRequest(row rowScanner, request *dto.ChangeRequest) error {
+	var (
+		reason    sql.NullString
+		decidedAt sql.NullTime
+		decidedBy sql.NullString
+	)
+
+	err := row.Scan(
+		&request.RequestID,
+		&request.UserID,
+		&request.Field,
+		&request.OldValue,
+		&request.NewValue,
+		&request.Status,
+		&reason,
+		&request.RequestedAt,
+		&decidedAt,
+		&decidedBy,
+	)
+	if err != nil {
+		return err //nolint:wrapcheck // callers wrap with query context
+	}
+
+	request.Reason = reason.String
+	request.DecidedBy = decidedBy.String
+
+	if decidedAt.Valid {
+		request.DecidedAt = &decidedAt.Time
+	}
+
+	return nil
+}
+
+func changeRequestResourceID(requestID int64) string {
+	return "change_request:" + strconv.FormatInt(requestID, 10)
+}
+
+func scanContentFlag(row rowScanner, flag *dto.ContentFlag) error {
+	var (
+		terms      string
+		resolvedAt sql.NullTime
+		resolvedBy sql.NullString
+	)
+
+	err := row.Scan(
+		&flag.FlagID,
+		&flag.UserID,
+		&flag.Field,
+		&flag.Content,
+		&terms,
+		&flag.FlaggedAt,
+		&resolvedAt,
+		&resolvedBy,
+	)
+	if err != nil {
+		return err //nolint:wrapcheck // callers wrap with query context
+	}
+
+	flag.Terms = []string{}
+	if terms != "" {
+		flag.Terms = strings.Split(terms, ",")
+	}
+
+	flag.ResolvedBy = resolvedBy.String
+
+	if resolvedAt.Valid {
+		flag.ResolvedAt = &resolvedAt.Time
+	}
+
+	return nil
+}
+
+func contentFlagResourceID(flagID int64) string {
+	return "content_flag:" + strconv.FormatInt(flagID, 10)
+}
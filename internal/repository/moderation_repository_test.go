@@ -0,0 +1,210 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+var changeRequestColumns = []string{
+	"request_id", "user_id", "field", "old_value", "new_value", "status", "reason",
+	"requested_at", "decided_at", "decided_by",
+}
+
+func TestModerationRepositoryApproveChangeRequest(t *testing.T) {
+	t.Parallel()
+
+	userID, actorID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	t.Run("Success - applies change with audit entry", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewModerationRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FOR UPDATE`).
+			WithArgs(int64(3)).
+			WillReturnRows(sqlmock.NewRows(changeRequestColumns).
+				AddRow(int64(3), userID.String(), "username", "alice", "alice2", "PENDING", nil, now, nil, nil))
+		mock.ExpectQuery(`SELECT is_active FROM recipe_manager.users`).
+			WithArgs("alice2", userID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_active"}))
+		mock.ExpectQuery(`UPDATE recipe_manager.users`).
+			WithArgs("alice2", userID).
+			WillReturnRows(sqlmock.NewRows(userColumns).
+				AddRow(userID.String(), "alice2", nil, nil, nil, nil, nil, true, now, now, nil))
+		mock.ExpectQuery(`UPDATE recipe_manager.identity_change_requests`).
+			WithArgs(int64(3), "APPROVED", "", actorID).
+			WillReturnRows(sqlmock.NewRows(changeRequestColumns).
+				AddRow(int64(3), userID.String(), "username", "alice", "alice2", "APPROVED", nil, now, now, actorID.String()))
+		mock.ExpectExec(`INSERT INTO recipe_manager.admin_audit_log`).
+			WithArgs(actorID, "change_request_approved", userID, "change_request:3").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		request, err := repo.ApproveChangeRequest(t.Context(), actorID, 3)
+		require.NoError(t, err)
+		assert.Equal(t, dto.ChangeRequestStatusApproved, request.Status)
+		assert.Equal(t, actorID.String(), request.DecidedBy)
+		require.NotNil(t, request.DecidedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Conflict - username taken, request stays pending", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewModerationRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FOR UPDATE`).
+			WithArgs(int64(3)).
+			WillReturnRows(sqlmock.NewRows(changeRequestColumns).
+				AddRow(int64(3), userID.String(), "username", "alice", "bob", "PENDING", nil, now, nil, nil))
+		mock.ExpectQuery(`SELECT is_active FROM recipe_manager.users`).
+			WithArgs("bob", userID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_active"}).AddRow(true))
+		mock.ExpectRollback()
+
+		_, err = repo.ApproveChangeRequest(t.Context(), actorID, 3)
+		require.ErrorIs(t, err, repository.ErrDuplicateUsername)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Already decided", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewModerationRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FOR UPDATE`).
+			WithArgs(int64(3)).
+			WillReturnRows(sqlmock.NewRows(changeRequestColumns).
+				AddRow(int64(3), userID.String(), "username", "alice", "alice2", "REJECTED", "spam", now, now, actorID.String()))
+		mock.ExpectRollback()
+
+		_, err = repo.ApproveChangeRequest(t.Context(), actorID, 3)
+		require.ErrorIs(t, err, repository.ErrChangeRequestDecided)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+var contentFlagColumns = []string{
+	"flag_id", "user_id", "field", "content", "terms", "flagged_at", "resolved_at", "resolved_by",
+}
+
+func TestModerationRepositoryContentFlags(t *testing.T) {
+	t.Parallel()
+
+	userID, actorID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	t.Run("FlagContent stores the terms", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`INSERT INTO recipe_manager.content_flags`).
+			WithArgs(userID.String(), "bio", "Darn it, heck", "Darn,heck").
+			WillReturnRows(sqlmock.NewRows(contentFlagColumns).
+				AddRow(int64(7), userID.String(), "bio", "Darn it, heck", "Darn,heck", now, nil, nil))
+
+		flag := &dto.ContentFlag{
+			UserID: userID.String(), Field: "bio", Content: "Darn it, heck", Terms: []string{"Darn", "heck"},
+		}
+
+		err = repository.NewModerationRepository(db).FlagContent(t.Context(), flag)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), flag.FlagID)
+		assert.Equal(t, []string{"Darn", "heck"}, flag.Terms)
+		assert.Nil(t, flag.ResolvedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("ResolveContentFlag records an audit entry", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE recipe_manager.content_flags`).
+			WithArgs(int64(7), actorID).
+			WillReturnRows(sqlmock.NewRows(contentFlagColumns).
+				AddRow(int64(7), userID.String(), "bio", "Darn", "Darn", now, now, actorID.String()))
+		mock.ExpectExec(`INSERT INTO recipe_manager.admin_audit_log`).
+			WithArgs(actorID, "content_flag_resolved", userID, "content_flag:7").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		flag, err := repository.NewModerationRepository(db).ResolveContentFlag(t.Context(), actorID, 7)
+		require.NoError(t, err)
+		assert.Equal(t, actorID.String(), flag.ResolvedBy)
+		require.NotNil(t, flag.ResolvedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("ResolveContentFlag of a resolved flag", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE recipe_manager.content_flags`).
+			WithArgs(int64(7), actorID).
+			WillReturnRows(sqlmock.NewRows(contentFlagColumns))
+		mock.ExpectRollback()
+
+		_, err = repository.NewModerationRepository(db).ResolveContentFlag(t.Context(), actorID, 7)
+		require.ErrorIs(t, err, repository.ErrContentFlagNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
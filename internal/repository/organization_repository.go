@@ -0,0 +1,440 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// ErrOrganizationNotFound is returned when no organization has the given ID.
+var ErrOrganizationNotFound = errors.New("organization not found")
+
+// ErrNotOrganizationMember is returned when a user is not a member of an organization.
+var ErrNotOrganizationMember = errors.New("not an organization member")
+
+// ErrLastOrganizationOwner is returned when a change would leave an organization without owners.
+var ErrLastOrganizationOwner = errors.New("organization must keep an owner")
+
+// OrganizationRepository stores organizations and their members. Membership changes are recorded
+// in the audit trail of the organization's account in the same transaction, attributed to actorID.
+type OrganizationRepository interface {
+	// CreateOrganization creates the account holding an organization's profile and the
+	// organization, with ownerID as its first owner.
+	CreateOrganization(
+		ctx context.Context,
+		ownerID uuid.UUID,
+		req *dto.OrganizationCreateRequest,
+	) (*dto.Organization, error)
+	// GetOrganization returns an organization.
+	GetOrganization(ctx context.Context, orgID uuid.UUID) (*dto.Organization, error)
+	// ListUserOrganizations returns the organizations a user belongs to, oldest membership first.
+	ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]dto.OrganizationMembership, error)
+	// ListMembers returns the members of an organization, owners first.
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]dto.OrganizationMember, error)
+	// GetMemberRole returns the role of a user in an organization.
+	GetMemberRole(ctx context.Context, orgID, userID uuid.UUID) (dto.OrganizationRole, error)
+	// SetMember adds a user to an organization or changes their role. Setting the role the
+	// member already has changes nothing and is not recorded.
+	SetMember(ctx context.Context, actorID, orgID, userID uuid.UUID, role dto.OrganizationRole) error
+	// RemoveMember removes a user from an organization.
+	RemoveMember(ctx context.Context, actorID, orgID, userID uuid.UUID) error
+	// RecordOrganizationAction records in the organization's audit trail that actorID did
+	// something while acting as it.
+	RecordOrganizationAction(ctx context.Context, actorID, orgID uuid.UUID, action string) error
+}
+
+// SQLOrganizationRepository implements OrganizationRepository using a SQL database.
+type SQLOrganizationRepository struct {
+	db *sql.DB
+	tx txRunner
+}
+
+// NewOrganizationRepository creates a new SQLOrganizationRepository.
+func NewOrganizationRepository(db *sql.DB) *SQLOrganizationRepository {
+	return &SQLOrganizationRepository{db: db, tx: newTxRunner(db)}
+}
+
+const organizationColumns = `o.org_id, u.username, u.full_name, o.created_by, o.created_at`
+
+// CreateOrganization creates the organization's account, the organization and its first owner.
+func (r *SQLOrganizationRepository) CreateOrganization(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	req *dto.OrganizationCreateRequest,
+) (*dto.Organization, error) {
+	orgID := uuid.New()
+
+	var org dto.Organization
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO recipe_manager.users (user_id, username, email, full_name, is_active, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, true, NOW(), NOW())
+		`, orgID, req.Username, req.Email, req.FullName)
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO recipe_manager.organizations (org_id, created_by)
+			VALUES ($1, $2)
+			RETURNING created_at
+		`, orgID, ownerID).Scan(&org.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO recipe_manager.organization_members (org_id, user_id, role, added_by)
+			VALUES ($1, $2, $3, $2)
+		`, orgID, ownerID, string(dto.OrganizationRoleOwner))
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, ownerID, dto.AuditActionOrganizationCreated, orgID, ownerID.String())
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch {
+			case pgErr.Code == "23505" && strings.Contains(pgErr.ConstraintName, "email"):
+				return nil, ErrDuplicateEmail
+			case pgErr.Code == "23505":
+				return nil, ErrDuplicateUsername
+			case pgErr.Code == "23503":
+				return nil, ErrUserNotFound
+			}
+		}
+
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	org.OrgID = orgID.String()
+	org.Username = req.Username
+	org.FullName = req.FullName
+	org.CreatedBy = ownerID.String()
+
+	return &org, nil
+}
+
+// GetOrganization returns an organization.
+func (r *SQLOrganizationRepository) GetOrganization(ctx context.Context, orgID uuid.UUID) (*dto.Organization, error) {
+	query := `
+		SELECT ` + organizationColumns + `
+		FROM recipe_manager.organizations o
+		JOIN recipe_manager.users u ON u.user_id = o.org_id
+		WHERE o.org_id = $1
+	`
+
+	var org dto.Organization
+
+	err := scanOrganization(executorFor(ctx, r.db).QueryRowContext(ctx, query, orgID), &org)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrganizationNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch organization: %w", err)
+	}
+
+	return &org, nil
+}
+
+// ListUserOrganizations returns the organizations a user belongs to, oldest membership first.
+func (r *SQLOrganizationRepository) ListUserOrganizations(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]dto.OrganizationMembership, error) {
+	query := `
+		SELECT ` + organizationColumns + `, m.role
+		FROM recipe_manager.organization_members m
+		JOIN recipe_manager.organizations o ON o.org_id = m.org_id
+		JOIN recipe_manager.users u ON u.user_id = o.org_id
+		WHERE m.user_id = $1
+		ORDER BY m.added_at, o.org_id
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organizations: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	memberships := []dto.OrganizationMembership{}
+
+	for rows.Next() {
+		var (
+			membership dto.OrganizationMembership
+			role       string
+		)
+
+		err = scanOrganization(rows, &membership.Organization, &role)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization: %w", err)
+		}
+
+		membership.Role = dto.OrganizationRole(role)
+		memberships = append(memberships, membership)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating organizations: %w", err)
+	}
+
+	return memberships, nil
+}
+
+// ListMembers returns the members of an organization, owners first.
+func (r *SQLOrganizationRepository) ListMembers(
+	ctx context.Context,
+	orgID uuid.UUID,
+) ([]dto.OrganizationMember, error) {
+	query := `
+		SELECT m.user_id, u.username, m.role, m.added_by, m.added_at
+		FROM recipe_manager.organization_members m
+		JOIN recipe_manager.users u ON u.user_id = m.user_id
+		WHERE m.org_id = $1
+		ORDER BY m.role = 'owner' DESC, m.added_at, m.user_id
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organization members: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	members := []dto.OrganizationMember{}
+
+	for rows.Next() {
+		var (
+			member dto.OrganizationMember
+			role   string
+		)
+
+		err = rows.Scan(&member.UserID, &member.Username, &role, &member.AddedBy, &member.AddedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization member: %w", err)
+		}
+
+		member.Role = dto.OrganizationRole(role)
+		members = append(members, member)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating organization members: %w", err)
+	}
+
+	return members, nil
+}
+
+// GetMemberRole returns the role of a user in an organization.
+func (r *SQLOrganizationRepository) GetMemberRole(
+	ctx context.Context,
+	orgID, userID uuid.UUID,
+) (dto.OrganizationRole, error) {
+	query := `
+		SELECT role FROM recipe_manager.organization_members
+		WHERE org_id = $1 AND user_id = $2
+	`
+
+	var role string
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, orgID, userID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrNotOrganizationMember
+		}
+
+		return "", fmt.Errorf("failed to fetch organization role: %w", err)
+	}
+
+	return dto.OrganizationRole(role), nil
+}
+
+// SetMember adds a user to an organization or changes their role, keeping at least one owner.
+func (r *SQLOrganizationRepository) SetMember(
+	ctx context.Context,
+	actorID, orgID, userID uuid.UUID,
+	role dto.OrganizationRole,
+) error {
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		current, err := lockMemberRole(ctx, tx, orgID, userID)
+		if err != nil {
+			return err
+		}
+
+		if current == role {
+			return nil
+		}
+
+		if current == dto.OrganizationRoleOwner {
+			err = ensureOtherOwner(ctx, tx, orgID, userID)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO recipe_manager.organization_members (org_id, user_id, role, added_by)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (org_id, user_id) DO UPDATE SET role = EXCLUDED.role
+		`, orgID, userID, string(role), actorID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, actorID, dto.AuditActionOrganizationMemberSet, orgID,
+			userID.String()+":"+string(role))
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return ErrUserNotFound
+		}
+
+		if errors.Is(err, ErrOrganizationNotFound) || errors.Is(err, ErrLastOrganizationOwner) {
+			return err
+		}
+
+		return fmt.Errorf("failed to set organization member: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveMember removes a user from an organization, keeping at least one owner.
+func (r *SQLOrganizationRepository) RemoveMember(ctx context.Context, actorID, orgID, userID uuid.UUID) error {
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		current, err := lockMemberRole(ctx, tx, orgID, userID)
+		if err != nil {
+			return err
+		}
+
+		if current == "" {
+			return ErrNotOrganizationMember
+		}
+
+		if current == dto.OrganizationRoleOwner {
+			err = ensureOtherOwner(ctx, tx, orgID, userID)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM recipe_manager.organization_members
+			WHERE org_id = $1 AND user_id = $2
+		`, orgID, userID)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, actorID, dto.AuditActionOrganizationMemberRemoved, orgID, userID.String())
+	})
+	if err != nil {
+		if errors.Is(err, ErrOrganizationNotFound) || errors.Is(err, ErrNotOrganizationMember) ||
+			errors.Is(err, ErrLastOrganizationOwner) {
+			return err
+		}
+
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+
+	return nil
+}
+
+// RecordOrganizationAction records an action taken on behalf of an organization.
+func (r *SQLOrganizationRepository) RecordOrganizationAction(
+	ctx context.Context,
+	actorID, orgID uuid.UUID,
+	action string,
+) error {
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return recordAudit(ctx, tx, actorID, dto.AuditActionOrganizationActed, orgID, action)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record organization action: %w", err)
+	}
+
+	return nil
+}
+
+// lockMemberRole locks the organization's membership and returns the user's role in it, or an
+// empty role for non-members.
+func lockMemberRole(ctx context.Context, tx *sql.Tx, orgID, userID uuid.UUID) (dto.OrganizationRole, error) {
+	// Locking the organization row serializes membership changes, so two owners cannot demote
+	// each other at once
+	var locked uuid.UUID
+
+	err := tx.QueryRowContext(ctx, `
+		SELECT org_id FROM recipe_manager.organizations WHERE org_id = $1 FOR UPDATE
+	`, orgID).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrOrganizationNotFound
+		}
+
+		return "", err
+	}
+
+	var role string
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT role FROM recipe_manager.organization_members
+		WHERE org_id = $1 AND user_id = $2
+	`, orgID, userID).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+
+	return dto.OrganizationRole(role), err
+}
+
+// ensureOtherOwner returns ErrLastOrganizationOwner unless the organization has an owner other
+// than userID.
+func ensureOtherOwner(ctx context.Context, tx *sql.Tx, orgID, userID uuid.UUID) error {
+	var others int
+
+	err := tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM recipe_manager.organization_members
+		WHERE org_id = $1 AND role = 'owner' AND user_id <> $2
+	`, orgID, userID).Scan(&others)
+	if err != nil {
+		return err
+	}
+
+	if others == 0 {
+		return ErrLastOrganizationOwner
+	}
+
+	return nil
+}
+
+func scanOrganization(row rowScanner, org *dto.Organization, extra ...any) error {
+	var fullName sql.NullString
+
+	dest := append([]any{&org.OrgID, &org.Username, &fullName, &org.CreatedBy, &org.CreatedAt}, extra...)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return err
+	}
+
+	if fullName.Valid {
+		org.FullName = &fullName.String
+	}
+
+	return nil
+}
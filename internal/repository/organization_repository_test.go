@@ -0,0 +1,213 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestOrganizationRepositoryGetMemberRole(t *testing.T) {
+	t.Parallel()
+
+	orgID, userID := uuid.New(), uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.organization_members`).
+			WithArgs(orgID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("editor"))
+
+		role, err := repository.NewOrganizationRepository(db).GetMemberRole(t.Context(), orgID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, dto.OrganizationRoleEditor, role)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Not a member", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.organization_members`).
+			WithArgs(orgID, userID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err = repository.NewOrganizationRepository(db).GetMemberRole(t.Context(), orgID, userID)
+		require.ErrorIs(t, err, repository.ErrNotOrganizationMember)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestOrganizationRepositorySetMember(t *testing.T) {
+	t.Parallel()
+
+	orgID, actorID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("Success - member added with audit entry", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT org_id FROM recipe_manager.organizations`).
+			WithArgs(orgID).
+			WillReturnRows(sqlmock.NewRows([]string{"org_id"}).AddRow(orgID))
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.organization_members`).
+			WithArgs(orgID, userID).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectExec(`INSERT INTO recipe_manager.organization_members`).
+			WithArgs(orgID, userID, "editor", actorID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO recipe_manager.admin_audit_log`).
+			WithArgs(actorID, "organization_member_set", orgID, userID.String()+":editor").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err = repository.NewOrganizationRepository(db).
+			SetMember(t.Context(), actorID, orgID, userID, dto.OrganizationRoleEditor)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Last owner cannot be demoted", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT org_id FROM recipe_manager.organizations`).
+			WithArgs(orgID).
+			WillReturnRows(sqlmock.NewRows([]string{"org_id"}).AddRow(orgID))
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.organization_members`).
+			WithArgs(orgID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("owner"))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM recipe_manager.organization_members`).
+			WithArgs(orgID, actorID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectRollback()
+
+		err = repository.NewOrganizationRepository(db).
+			SetMember(t.Context(), actorID, orgID, actorID, dto.OrganizationRoleEditor)
+		require.ErrorIs(t, err, repository.ErrLastOrganizationOwner)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Organization not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT org_id FROM recipe_manager.organizations`).
+			WithArgs(orgID).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err = repository.NewOrganizationRepository(db).
+			SetMember(t.Context(), actorID, orgID, userID, dto.OrganizationRoleOwner)
+		require.ErrorIs(t, err, repository.ErrOrganizationNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestOrganizationRepositoryRemoveMember(t *testing.T) {
+	t.Parallel()
+
+	orgID, actorID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("Success - member removed with audit entry", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT org_id FROM recipe_manager.organizations`).
+			WithArgs(orgID).
+			WillReturnRows(sqlmock.NewRows([]string{"org_id"}).AddRow(orgID))
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.organization_members`).
+			WithArgs(orgID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("editor"))
+		mock.ExpectExec(`DELETE FROM recipe_manager.organization_members`).
+			WithArgs(orgID, userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO recipe_manager.admin_audit_log`).
+			WithArgs(actorID, "organization_member_removed", orgID, userID.String()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err = repository.NewOrganizationRepository(db).RemoveMember(t.Context(), actorID, orgID, userID)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Not a member", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT org_id FROM recipe_manager.organizations`).
+			WithArgs(orgID).
+			WillReturnRows(sqlmock.NewRows([]string{"org_id"}).AddRow(orgID))
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.organization_members`).
+			WithArgs(orgID, userID).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err = repository.NewOrganizationRepository(db).RemoveMember(t.Context(), actorID, orgID, userID)
+		require.ErrorIs(t, err, repository.ErrNotOrganizationMember)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// PolicyAcceptanceRepository stores which versions of the policy documents each user accepted.
+type PolicyAcceptanceRepository interface {
+	// RecordPolicyAcceptance stores an acceptance and sets acceptance.AcceptedAt. Accepting the
+	// same version again keeps the first acceptance time.
+	RecordPolicyAcceptance(ctx context.Context, userID uuid.UUID, acceptance *dto.PolicyAcceptance) error
+	// GetPolicyAcceptances returns every version the user accepted, newest first.
+	GetPolicyAcceptances(ctx context.Context, userID uuid.UUID) ([]dto.PolicyAcceptance, error)
+}
+
+// SQLPolicyAcceptanceRepository implements PolicyAcceptanceRepository using a SQL database.
+type SQLPolicyAcceptanceRepository struct {
+	db *sql.DB
+}
+
+// NewPolicyAcceptanceRepository creates a new SQLPolicyAcceptanceRepository.
+func NewPolicyAcceptanceRepository(db *sql.DB) *SQLPolicyAcceptanceRepository {
+	return &SQLPolicyAcceptanceRepository{db: db}
+}
+
+// RecordPolicyAcceptance stores an acceptance, keeping the first acceptance time of a version.
+func (r *SQLPolicyAcceptanceRepository) RecordPolicyAcceptance(
+	ctx context.Context,
+	userID uuid.UUID,
+	acceptance *dto.PolicyAcceptance,
+) error {
+	query := `
+		INSERT INTO recipe_manager.user_policy_acceptances (user_id, document, version)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, document, version) DO UPDATE SET
+			accepted_at = user_policy_acceptances.accepted_at
+		RETURNING accepted_at
+	`
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID, string(acceptance.Document), acceptance.Version).
+		Scan(&acceptance.AcceptedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record policy acceptance: %w", err)
+	}
+
+	return nil
+}
+
+// GetPolicyAcceptances returns every version the user accepted, newest first.
+func (r *SQLPolicyAcceptanceRepository) GetPolicyAcceptances(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]dto.PolicyAcceptance, error) {
+	query := `
+		SELECT document, version, accepted_at
+		FROM recipe_manager.user_policy_acceptances
+		WHERE user_id = $1
+		ORDER BY accepted_at DESC
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch policy acceptances: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var acceptances []dto.PolicyAcceptance
+
+	for rows.Next() {
+		var acceptance dto.PolicyAcceptance
+
+		err = rows.Scan(&acceptance.Document, &acceptance.Version, &acceptance.AcceptedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan policy acceptance: %w", err)
+		}
+
+		acceptances = append(acceptances, acceptance)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating policy acceptances: %w", err)
+	}
+
+	return acceptances, nil
+}
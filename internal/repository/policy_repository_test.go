@@ -0,0 +1,73 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestPolicyAcceptanceRepository(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	acceptedAt := time.Date(2026, time.October, 17, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Success - record returns the first acceptance time", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewPolicyAcceptanceRepository(db)
+
+		mock.ExpectQuery(`INSERT INTO recipe_manager.user_policy_acceptances`).
+			WithArgs(userID, "terms_of_service", "2026-10").
+			WillReturnRows(sqlmock.NewRows([]string{"accepted_at"}).AddRow(acceptedAt))
+
+		acceptance := &dto.PolicyAcceptance{Document: dto.PolicyDocumentTerms, Version: "2026-10"}
+
+		err = repo.RecordPolicyAcceptance(t.Context(), userID, acceptance)
+		require.NoError(t, err)
+		assert.Equal(t, acceptedAt, acceptance.AcceptedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Success - list acceptances", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewPolicyAcceptanceRepository(db)
+
+		mock.ExpectQuery(`SELECT document, version, accepted_at`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"document", "version", "accepted_at"}).
+				AddRow("privacy_policy", "2026-09", acceptedAt).
+				AddRow("terms_of_service", "2026-01", acceptedAt.AddDate(0, -9, 0)))
+
+		acceptances, err := repo.GetPolicyAcceptances(t.Context(), userID)
+		require.NoError(t, err)
+		require.Len(t, acceptances, 2)
+		assert.Equal(t, dto.PolicyDocumentPrivacy, acceptances[0].Document)
+		assert.Equal(t, "2026-01", acceptances[1].Version)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
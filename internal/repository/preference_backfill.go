@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// UserIDLister pages through the IDs of every user.
+type UserIDLister interface {
+	// ListUserIDs returns up to limit user IDs after the given one, in ID order.
+	ListUserIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error)
+}
+
+// PreferenceBackfillRepository writes explicit default preference rows for users who never saved
+// a category, so reads find a row instead of falling back to the defaults.
+type PreferenceBackfillRepository interface {
+	UserIDLister
+	// InsertDefaultPreferences inserts the default row of every category the users have not saved
+	// and returns how many rows were inserted. Saved rows are left alone.
+	InsertDefaultPreferences(ctx context.Context, userIDs []uuid.UUID) (int, error)
+}
+
+var _ PreferenceBackfillRepository = (*SQLPreferenceRepository)(nil)
+
+// defaultPreferenceRows inserts the default row of each category for the users in $1 that have
+// none. The values match the fallbacks of the Get and Update methods.
+var defaultPreferenceRows = []string{
+	`INSERT INTO recipe_manager.user_notification_preferences (
+		user_id, email_notifications, push_notifications, sms_notifications, marketing_emails,
+		security_alerts, activity_summaries, recipe_recommendations, social_interactions, updated_at
+	)
+	SELECT user_id, true, true, false, false, true, true, true, true, NOW()`,
+	`INSERT INTO recipe_manager.user_display_preferences (
+		user_id, font_size, color_scheme, layout_density, show_images, compact_mode, updated_at
+	)
+	SELECT user_id, 'MEDIUM', 'LIGHT', 'COMFORTABLE', true, false, NOW()`,
+	defaultPrivacyRow,
+	`INSERT INTO recipe_manager.user_accessibility_preferences (
+		user_id, screen_reader, high_contrast, reduced_motion, large_text, keyboard_navigation, updated_at
+	)
+	SELECT user_id, false, false, false, false, false, NOW()`,
+	`INSERT INTO recipe_manager.user_language_preferences (
+		user_id, primary_language, secondary_language, translation_enabled, updated_at
+	)
+	SELECT user_id, 'EN', NULL, false, NOW()`,
+	`INSERT INTO recipe_manager.user_security_preferences (
+		user_id, two_factor_auth, login_notifications, session_timeout, password_requirements, updated_at
+	)
+	SELECT user_id, false, true, false, true, NOW()`,
+	`INSERT INTO recipe_manager.user_social_preferences (
+		user_id, friend_requests, message_notifications, group_invites, share_activity, updated_at
+	)
+	SELECT user_id, true, true, true, true, NOW()`,
+	`INSERT INTO recipe_manager.user_sound_preferences (
+		user_id, notification_sounds, system_sounds, volume_level, mute_notifications, updated_at
+	)
+	SELECT user_id, true, true, 'MEDIUM', false, NOW()`,
+	`INSERT INTO recipe_manager.user_theme_preferences (
+		user_id, dark_mode, light_mode, auto_theme, custom_theme, updated_at
+	)
+	SELECT user_id, false, true, false, NULL, NOW()`,
+}
+
+// defaultPrivacyRow inserts the default privacy row, which stays in its table in every storage
+// layout.
+const defaultPrivacyRow = `INSERT INTO recipe_manager.user_privacy_preferences (
+		user_id, profile_visibility, recipe_visibility, activity_visibility, contact_info_visibility,
+		show_full_name, allow_follows, allow_messages, data_sharing, analytics_tracking, discoverable,
+		show_last_seen, show_location, count_profile_views, keep_recently_viewed, updated_at
+	)
+	SELECT user_id,
+		-- Matches DefaultPrivacyPreferencesForVersion
+		CASE privacy_defaults_version WHEN 2 THEN 'PRIVATE' ELSE 'PUBLIC' END,
+		'PUBLIC', 'PUBLIC', 'PRIVATE', true, true, true, false, false, true, true, false, true, true, NOW()`
+
+// ListUserIDs returns up to limit user IDs after the given one, in ID order.
+func (r *SQLPreferenceRepository) ListUserIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	query := `
+		SELECT user_id
+		FROM recipe_manager.users
+		WHERE user_id > $1
+		ORDER BY user_id
+		LIMIT $2
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user ids: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var ids []uuid.UUID
+
+	for rows.Next() {
+		var id uuid.UUID
+
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user id: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate user ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// InsertDefaultPreferences inserts the default row of every category the users have not saved, in
+// one transaction. Users deleted since they were listed are skipped, and privacy rows get the
+// defaults of the version each user was provisioned under.
+func (r *SQLPreferenceRepository) InsertDefaultPreferences(ctx context.Context, userIDs []uuid.UUID) (int, error) {
+	return r.insertDefaults(ctx, userIDs, func(tx *sql.Tx, ids string) (int, error) {
+		return insertDefaultRows(ctx, tx, ids, defaultPreferenceRows)
+	})
+}
+
+// insertDefaults provisions the users not provisioned yet under the configured privacy defaults
+// version, then runs insert in the same transaction and returns the rows it inserted.
+func (r *SQLPreferenceRepository) insertDefaults(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+	insert func(tx *sql.Tx, ids string) (int, error),
+) (int, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+
+	ids := uuidArray(userIDs)
+	inserted := 0
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		// Users not provisioned yet get the privacy defaults of the configured version
+		if r.version != 0 {
+			_, err := tx.ExecContext(ctx, `
+				UPDATE recipe_manager.users
+				SET privacy_defaults_version = $2
+				WHERE user_id = ANY($1::uuid[]) AND privacy_defaults_version IS NULL
+			`, ids, r.version)
+			if err != nil {
+				return err
+			}
+		}
+
+		var err error
+
+		inserted, err = insert(tx, ids)
+
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert default preferences: %w", err)
+	}
+
+	return inserted, nil
+}
+
+// insertDefaultRows runs the default row inserts for the users in ids and returns how many rows
+// they inserted.
+func insertDefaultRows(ctx context.Context, tx *sql.Tx, ids string, inserts []string) (int, error) {
+	inserted := 0
+
+	for _, insert := range inserts {
+		// ON CONFLICT DO NOTHING keeps saved rows and their updated_at as they are
+		result, err := tx.ExecContext(ctx, insert+`
+			FROM recipe_manager.users
+			WHERE user_id = ANY($1::uuid[])
+			ON CONFLICT (user_id) DO NOTHING
+		`, ids)
+		if err != nil {
+			return 0, err
+		}
+
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+
+		inserted += int(rows)
+	}
+
+	return inserted, nil
+}
@@ -0,0 +1,103 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestPreferenceRepositoryListUserIDs(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	after, first, second := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery(`SELECT user_id FROM recipe_manager.users WHERE user_id > \$1 ORDER BY user_id LIMIT \$2`).
+		WithArgs(after, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(first).AddRow(second))
+
+	ids, err := repository.NewPreferenceRepository(db).ListUserIDs(t.Context(), after, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{first, second}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestPreferenceRepositoryInsertDefaultPreferences(t *testing.T) {
+	t.Parallel()
+
+	tables := []string{
+		"notification", "display", "privacy", "accessibility", "language", "security", "social", "sound", "theme",
+	}
+
+	t.Run("Success - inserts missing rows of every category", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		first, second := uuid.New(), uuid.New()
+
+		mock.ExpectBegin()
+
+		for i, table := range tables {
+			// Only the first user is missing a theme row
+			rows := int64(2)
+			if i == len(tables)-1 {
+				rows = 1
+			}
+
+			mock.ExpectExec(`INSERT INTO recipe_manager.user_` + table + `_preferences .* ` +
+				`WHERE user_id = ANY\(\$1::uuid\[\]\) ON CONFLICT \(user_id\) DO NOTHING`).
+				WithArgs("{" + first.String() + "," + second.String() + "}").
+				WillReturnResult(sqlmock.NewResult(0, rows))
+		}
+
+		mock.ExpectCommit()
+
+		inserted, err := repository.NewPreferenceRepository(db).
+			InsertDefaultPreferences(t.Context(), []uuid.UUID{first, second})
+		require.NoError(t, err)
+		assert.Equal(t, 17, inserted)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Error - rolls back every category", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO recipe_manager.user_notification_preferences`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO recipe_manager.user_display_preferences`).
+			WillReturnError(assert.AnError)
+		mock.ExpectRollback()
+
+		_, err = repository.NewPreferenceRepository(db).InsertDefaultPreferences(t.Context(), []uuid.UUID{uuid.New()})
+		require.ErrorIs(t, err, assert.AnError)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
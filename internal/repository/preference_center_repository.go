@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// PreferenceCenterRepository stores the generation of each user's preference center links. Links
+// are signed for the generation current when they were sent, so bumping it revokes them all.
+type PreferenceCenterRepository interface {
+	// GetPreferenceCenterGeneration returns the current generation of a user's links.
+	GetPreferenceCenterGeneration(ctx context.Context, userID uuid.UUID) (int, error)
+	// RevokePreferenceCenterLinks bumps the generation of a user's links and returns the new one.
+	RevokePreferenceCenterLinks(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// SQLPreferenceCenterRepository implements PreferenceCenterRepository using a SQL database.
+type SQLPreferenceCenterRepository struct {
+	db *sql.DB
+}
+
+// NewPreferenceCenterRepository creates a new SQLPreferenceCenterRepository.
+func NewPreferenceCenterRepository(db *sql.DB) *SQLPreferenceCenterRepository {
+	return &SQLPreferenceCenterRepository{db: db}
+}
+
+// GetPreferenceCenterGeneration returns the current generation of a user's links.
+func (r *SQLPreferenceCenterRepository) GetPreferenceCenterGeneration(
+	ctx context.Context,
+	userID uuid.UUID,
+) (int, error) {
+	query := `SELECT preference_center_generation FROM recipe_manager.users WHERE user_id = $1`
+
+	var generation int
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&generation)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrUserNotFound
+		}
+
+		return 0, fmt.Errorf("failed to fetch preference center generation: %w", err)
+	}
+
+	return generation, nil
+}
+
+// RevokePreferenceCenterLinks bumps the generation of a user's links.
+func (r *SQLPreferenceCenterRepository) RevokePreferenceCenterLinks(
+	ctx context.Context,
+	userID uuid.UUID,
+) (int, error) {
+	query := `
+		UPDATE recipe_manager.users
+		SET preference_center_generation = preference_center_generation + 1
+		WHERE user_id = $1
+		RETURNING preference_center_generation
+	`
+
+	var generation int
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&generation)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrUserNotFound
+		}
+
+		return 0, fmt.Errorf("failed to revoke preference center links: %w", err)
+	}
+
+	return generation, nil
+}
@@ -0,0 +1,83 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestPreferenceCenterRepositoryGetPreferenceCenterGeneration(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`SELECT preference_center_generation FROM recipe_manager.users WHERE user_id = \$1`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"preference_center_generation"}).AddRow(3))
+
+		generation, err := repository.NewPreferenceCenterRepository(db).GetPreferenceCenterGeneration(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, generation)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`SELECT preference_center_generation FROM recipe_manager.users`).
+			WithArgs(userID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err = repository.NewPreferenceCenterRepository(db).GetPreferenceCenterGeneration(t.Context(), userID)
+		require.ErrorIs(t, err, repository.ErrUserNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestPreferenceCenterRepositoryRevokePreferenceCenterLinks(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery(`UPDATE recipe_manager.users\s+SET preference_center_generation = preference_center_generation \+ 1`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"preference_center_generation"}).AddRow(4))
+
+	generation, err := repository.NewPreferenceCenterRepository(db).RevokePreferenceCenterLinks(t.Context(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, 4, generation)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
@@ -0,0 +1,523 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+// PreferenceStorageRepository copies saved preferences between the table and document layouts.
+type PreferenceStorageRepository interface {
+	UserIDLister
+	// CopyPreferencesToDocuments copies the users' saved rows of every category but privacy into
+	// documents and returns how many it wrote. A document newer than its row is kept.
+	CopyPreferencesToDocuments(ctx context.Context, userIDs []uuid.UUID) (int, error)
+	// CopyPreferencesToTables copies the users' documents back into the category tables and
+	// returns how many rows it wrote. A row newer than its document is kept.
+	CopyPreferencesToTables(ctx context.Context, userIDs []uuid.UUID) (int, error)
+}
+
+// documentColumns are the columns of each category table kept in documents, in table order. Their
+// document keys are the camelCase JSON names of the category's DTO fields.
+var documentColumns = map[dto.PreferenceCategory][]string{
+	dto.PreferenceCategoryNotification: {
+		"email_notifications", "push_notifications", "sms_notifications", "marketing_emails",
+		"security_alerts", "activity_summaries", "recipe_recommendations", "social_interactions",
+	},
+	dto.PreferenceCategoryDisplay: {"font_size", "color_scheme", "layout_density", "show_images", "compact_mode"},
+	dto.PreferenceCategoryAccessibility: {
+		"screen_reader", "high_contrast", "reduced_motion", "large_text", "keyboard_navigation",
+	},
+	dto.PreferenceCategoryLanguage: {"primary_language", "secondary_language", "translation_enabled"},
+	dto.PreferenceCategorySecurity: {
+		"two_factor_auth", "login_notifications", "session_timeout", "password_requirements",
+	},
+	dto.PreferenceCategorySocial: {"friend_requests", "message_notifications", "group_invites", "share_activity"},
+	dto.PreferenceCategorySound:  {"notification_sounds", "system_sounds", "volume_level", "mute_notifications"},
+	dto.PreferenceCategoryTheme:  {"dark_mode", "light_mode", "auto_theme", "custom_theme"},
+}
+
+// documentDefaults returns the defaults of each category kept in documents.
+var documentDefaults = map[dto.PreferenceCategory]func() any{
+	dto.PreferenceCategoryNotification:  func() any { return DefaultNotificationPreferences() },
+	dto.PreferenceCategoryDisplay:       func() any { return DefaultDisplayPreferences() },
+	dto.PreferenceCategoryAccessibility: func() any { return DefaultAccessibilityPreferences() },
+	dto.PreferenceCategoryLanguage:      func() any { return DefaultLanguagePreferences() },
+	dto.PreferenceCategorySecurity:      func() any { return DefaultSecurityPreferences() },
+	dto.PreferenceCategorySocial:        func() any { return DefaultSocialPreferences() },
+	dto.PreferenceCategorySound:         func() any { return DefaultSoundPreferences() },
+	dto.PreferenceCategoryTheme:         func() any { return DefaultThemePreferences() },
+}
+
+// documentValue selects a document with its updated_at folded in, in the shape of the category's
+// DTO.
+const documentValue = `doc || jsonb_build_object('updatedAt', updated_at)`
+
+// documentValidator checks updates before they are merged into documents, which have no column
+// types to reject values the tables would.
+var documentValidator = validation.New()
+
+// DocumentPreferenceRepository stores every preference category but privacy as a JSONB document
+// in recipe_manager.user_preference_documents, one row per user and category, so adding a setting
+// takes no migration. Privacy stays in its table, which the user, stats and consent queries join;
+// everything else is served by the embedded table repository.
+type DocumentPreferenceRepository struct {
+	*SQLPreferenceRepository
+}
+
+var (
+	_ PreferenceRepository         = (*DocumentPreferenceRepository)(nil)
+	_ PreferenceBackfillRepository = (*DocumentPreferenceRepository)(nil)
+	_ PrivacyDefaultsRepository    = (*DocumentPreferenceRepository)(nil)
+	_ PreferenceStorageRepository  = (*DocumentPreferenceRepository)(nil)
+)
+
+// NewDocumentPreferenceRepository creates a DocumentPreferenceRepository that keeps privacy
+// preferences in tables.
+func NewDocumentPreferenceRepository(tables *SQLPreferenceRepository) *DocumentPreferenceRepository {
+	return &DocumentPreferenceRepository{SQLPreferenceRepository: tables}
+}
+
+// GetNotificationPreferences retrieves notification preferences for a user.
+func (r *DocumentPreferenceRepository) GetNotificationPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.NotificationPreferences, error) {
+	return getDocument(ctx, r.db, userID, dto.PreferenceCategoryNotification, DefaultNotificationPreferences)
+}
+
+// UpdateNotificationPreferences merges an update into the user's notification preferences.
+func (r *DocumentPreferenceRepository) UpdateNotificationPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.NotificationPreferencesUpdate,
+) (*dto.NotificationPreferences, error) {
+	return updateDocument(ctx, r.tx, userID, dto.PreferenceCategoryNotification, DefaultNotificationPreferences,
+		update)
+}
+
+// GetDisplayPreferences retrieves display preferences for a user.
+func (r *DocumentPreferenceRepository) GetDisplayPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.DisplayPreferences, error) {
+	return getDocument(ctx, r.db, userID, dto.PreferenceCategoryDisplay, DefaultDisplayPreferences)
+}
+
+// UpdateDisplayPreferences merges an update into the user's display preferences.
+func (r *DocumentPreferenceRepository) UpdateDisplayPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.DisplayPreferencesUpdate,
+) (*dto.DisplayPreferences, error) {
+	return updateDocument(ctx, r.tx, userID, dto.PreferenceCategoryDisplay, DefaultDisplayPreferences, update)
+}
+
+// GetAccessibilityPreferences retrieves accessibility preferences for a user.
+func (r *DocumentPreferenceRepository) GetAccessibilityPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.AccessibilityPreferences, error) {
+	return getDocument(ctx, r.db, userID, dto.PreferenceCategoryAccessibility, DefaultAccessibilityPreferences)
+}
+
+// UpdateAccessibilityPreferences merges an update into the user's accessibility preferences.
+func (r *DocumentPreferenceRepository) UpdateAccessibilityPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.AccessibilityPreferencesUpdate,
+) (*dto.AccessibilityPreferences, error) {
+	return updateDocument(ctx, r.tx, userID, dto.PreferenceCategoryAccessibility, DefaultAccessibilityPreferences,
+		update)
+}
+
+// GetLanguagePreferences retrieves language preferences for a user.
+func (r *DocumentPreferenceRepository) GetLanguagePreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.LanguagePreferences, error) {
+	return getDocument(ctx, r.db, userID, dto.PreferenceCategoryLanguage, DefaultLanguagePreferences)
+}
+
+// UpdateLanguagePreferences merges an update into the user's language preferences.
+func (r *DocumentPreferenceRepository) UpdateLanguagePreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.LanguagePreferencesUpdate,
+) (*dto.LanguagePreferences, error) {
+	return updateDocument(ctx, r.tx, userID, dto.PreferenceCategoryLanguage, DefaultLanguagePreferences, update)
+}
+
+// GetSecurityPreferences retrieves security preferences for a user.
+func (r *DocumentPreferenceRepository) GetSecurityPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.SecurityPreferences, error) {
+	return getDocument(ctx, r.db, userID, dto.PreferenceCategorySecurity, DefaultSecurityPreferences)
+}
+
+// UpdateSecurityPreferences merges an update into the user's security preferences.
+func (r *DocumentPreferenceRepository) UpdateSecurityPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.SecurityPreferencesUpdate,
+) (*dto.SecurityPreferences, error) {
+	return updateDocument(ctx, r.tx, userID, dto.PreferenceCategorySecurity, DefaultSecurityPreferences, update)
+}
+
+// GetSocialPreferences retrieves social preferences for a user.
+func (r *DocumentPreferenceRepository) GetSocialPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.SocialPreferences, error) {
+	return getDocument(ctx, r.db, userID, dto.PreferenceCategorySocial, DefaultSocialPreferences)
+}
+
+// UpdateSocialPreferences merges an update into the user's social preferences.
+func (r *DocumentPreferenceRepository) UpdateSocialPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.SocialPreferencesUpdate,
+) (*dto.SocialPreferences, error) {
+	return updateDocument(ctx, r.tx, userID, dto.PreferenceCategorySocial, DefaultSocialPreferences, update)
+}
+
+// GetSoundPreferences retrieves sound preferences for a user.
+func (r *DocumentPreferenceRepository) GetSoundPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.SoundPreferences, error) {
+	return getDocument(ctx, r.db, userID, dto.PreferenceCategorySound, DefaultSoundPreferences)
+}
+
+// UpdateSoundPreferences merges an update into the user's sound preferences.
+func (r *DocumentPreferenceRepository) UpdateSoundPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.SoundPreferencesUpdate,
+) (*dto.SoundPreferences, error) {
+	return updateDocument(ctx, r.tx, userID, dto.PreferenceCategorySound, DefaultSoundPreferences, update)
+}
+
+// GetThemePreferences retrieves theme preferences for a user.
+func (r *DocumentPreferenceRepository) GetThemePreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.ThemePreferences, error) {
+	return getDocument(ctx, r.db, userID, dto.PreferenceCategoryTheme, DefaultThemePreferences)
+}
+
+// UpdateThemePreferences merges an update into the user's theme preferences.
+func (r *DocumentPreferenceRepository) UpdateThemePreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.ThemePreferencesUpdate,
+) (*dto.ThemePreferences, error) {
+	return updateDocument(ctx, r.tx, userID, dto.PreferenceCategoryTheme, DefaultThemePreferences, update)
+}
+
+// ResetPreferences deletes the user's documents and privacy row of the given categories in one
+// transaction.
+func (r *DocumentPreferenceRepository) ResetPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	categories []dto.PreferenceCategory,
+) error {
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		for _, category := range categories {
+			var err error
+
+			switch {
+			case category == dto.PreferenceCategoryPrivacy:
+				_, err = tx.ExecContext(ctx, `DELETE FROM `+preferenceTables[category]+` WHERE user_id = $1`, userID)
+			case documentColumns[category] != nil:
+				_, err = tx.ExecContext(ctx, `
+					DELETE FROM recipe_manager.user_preference_documents WHERE user_id = $1 AND category = $2
+				`, userID, category)
+			default:
+				err = fmt.Errorf("%w: %s", ErrInvalidPreferenceCategory, category)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset preferences: %w", err)
+	}
+
+	return nil
+}
+
+// InsertDefaultPreferences inserts the default document of every category the users have not
+// saved, and their default privacy row, in one transaction.
+func (r *DocumentPreferenceRepository) InsertDefaultPreferences(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) (int, error) {
+	return r.insertDefaults(ctx, userIDs, func(tx *sql.Tx, ids string) (int, error) {
+		inserted, err := insertDefaultRows(ctx, tx, ids, []string{defaultPrivacyRow})
+		if err != nil {
+			return 0, err
+		}
+
+		for _, category := range documentCategories() {
+			defaults, err := json.Marshal(documentDefaults[category]())
+			if err != nil {
+				return 0, fmt.Errorf("failed to encode %s defaults: %w", category, err)
+			}
+
+			// ON CONFLICT DO NOTHING keeps saved documents and their updated_at as they are
+			result, err := tx.ExecContext(ctx, `
+				INSERT INTO recipe_manager.user_preference_documents (user_id, category, doc, updated_at)
+				SELECT user_id, $2, $3::jsonb - 'updatedAt', NOW()
+				FROM recipe_manager.users
+				WHERE user_id = ANY($1::uuid[])
+				ON CONFLICT (user_id, category) DO NOTHING
+			`, ids, category, defaults)
+			if err != nil {
+				return 0, err
+			}
+
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return 0, err
+			}
+
+			inserted += int(rows)
+		}
+
+		return inserted, nil
+	})
+}
+
+// CopyPreferencesToDocuments copies the users' saved rows into documents, category by category, in
+// one transaction. Running it again copies only rows saved since.
+func (r *DocumentPreferenceRepository) CopyPreferencesToDocuments(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) (int, error) {
+	return r.copyPreferences(ctx, userIDs, func(category dto.PreferenceCategory) (string, []any, error) {
+		columns := documentColumns[category]
+		fields := make([]string, len(columns))
+
+		for i, column := range columns {
+			fields[i] = fmt.Sprintf("'%s', %s", documentKey(column), column)
+		}
+
+		return `
+			INSERT INTO recipe_manager.user_preference_documents AS d (user_id, category, doc, updated_at)
+			SELECT user_id, '` + string(category) + `', jsonb_build_object(` + strings.Join(fields, ", ") + `),
+			       updated_at
+			FROM ` + preferenceTables[category] + `
+			WHERE user_id = ANY($1::uuid[])
+			ON CONFLICT (user_id, category) DO UPDATE SET doc = EXCLUDED.doc, updated_at = EXCLUDED.updated_at
+			WHERE d.updated_at < EXCLUDED.updated_at
+		`, nil, nil
+	})
+}
+
+// CopyPreferencesToTables copies the users' documents into the category tables, category by
+// category, in one transaction. Keys missing from a document are written as their defaults.
+func (r *DocumentPreferenceRepository) CopyPreferencesToTables(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) (int, error) {
+	return r.copyPreferences(ctx, userIDs, func(category dto.PreferenceCategory) (string, []any, error) {
+		defaults, err := json.Marshal(documentDefaults[category]())
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to encode %s defaults: %w", category, err)
+		}
+
+		columns := documentColumns[category]
+		fields := make([]string, len(columns))
+		updates := make([]string, len(columns))
+
+		for i, column := range columns {
+			fields[i] = fmt.Sprintf("'%s', d.doc->'%s'", column, documentKey(column))
+			updates[i] = column + " = EXCLUDED." + column
+		}
+
+		return `
+			INSERT INTO ` + preferenceTables[category] + ` AS t (user_id, ` + strings.Join(columns, ", ") + `, updated_at)
+			SELECT d.user_id, p.` + strings.Join(columns, ", p.") + `, d.updated_at
+			FROM (
+				SELECT user_id, ($2::jsonb - 'updatedAt') || doc AS doc, updated_at
+				FROM recipe_manager.user_preference_documents
+				WHERE category = '` + string(category) + `' AND user_id = ANY($1::uuid[])
+			) d
+			CROSS JOIN LATERAL jsonb_populate_record(NULL::` + preferenceTables[category] + `,
+				jsonb_build_object(` + strings.Join(fields, ", ") + `)) p
+			ON CONFLICT (user_id) DO UPDATE SET ` + strings.Join(updates, ", ") + `, updated_at = EXCLUDED.updated_at
+			WHERE t.updated_at < EXCLUDED.updated_at
+		`, []any{defaults}, nil
+	})
+}
+
+// copyPreferences runs the copy statement of each category kept in documents for the users in one
+// transaction and returns how many rows the statements wrote. Statements get the user IDs as $1,
+// followed by their own arguments.
+func (r *DocumentPreferenceRepository) copyPreferences(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+	statement func(category dto.PreferenceCategory) (string, []any, error),
+) (int, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+
+	ids := uuidArray(userIDs)
+	copied := 0
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		copied = 0
+
+		for _, category := range documentCategories() {
+			query, args, err := statement(category)
+			if err != nil {
+				return err
+			}
+
+			result, err := tx.ExecContext(ctx, query, append([]any{ids}, args...)...)
+			if err != nil {
+				return err
+			}
+
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+
+			copied += int(rows)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to copy preferences: %w", err)
+	}
+
+	return copied, nil
+}
+
+// documentCategories lists the categories kept in documents, in the usual category order.
+func documentCategories() []dto.PreferenceCategory {
+	categories := make([]dto.PreferenceCategory, 0, len(documentColumns))
+
+	for _, category := range dto.ValidPreferenceCategories {
+		if documentColumns[category] != nil {
+			categories = append(categories, category)
+		}
+	}
+
+	return categories
+}
+
+// documentKey converts a snake_case column name to the camelCase key of its document field.
+func documentKey(column string) string {
+	words := strings.Split(column, "_")
+	for i := 1; i < len(words); i++ {
+		words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+	}
+
+	return strings.Join(words, "")
+}
+
+// getDocument reads the user's document of a category, or its defaults when there is none. Keys
+// the document lacks keep their defaults.
+func getDocument[T any](
+	ctx context.Context,
+	db *sql.DB,
+	userID uuid.UUID,
+	category dto.PreferenceCategory,
+	defaults func() *T,
+) (*T, error) {
+	query := `
+		SELECT ` + documentValue + `
+		FROM recipe_manager.user_preference_documents
+		WHERE user_id = $1 AND category = $2
+	`
+
+	var payload []byte
+
+	err := executorFor(ctx, db).QueryRowContext(ctx, query, userID, category).Scan(&payload)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return defaults(), nil
+		}
+
+		return nil, fmt.Errorf("failed to get %s preferences: %w", category, err)
+	}
+
+	return decodeDocument(payload, category, defaults)
+}
+
+// updateDocument merges the fields set in update into the user's document of a category, creating
+// it from the defaults on a first save.
+func updateDocument[T, U any](
+	ctx context.Context,
+	runner txRunner,
+	userID uuid.UUID,
+	category dto.PreferenceCategory,
+	defaults func() *T,
+	update *U,
+) (*T, error) {
+	err := documentValidator.Validate(update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update %s preferences: %w", category, err)
+	}
+
+	base, err := json.Marshal(defaults())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s defaults: %w", category, err)
+	}
+
+	// Update DTOs leave out unset fields, so merging their JSON changes only what was set
+	patch, err := json.Marshal(update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s update: %w", category, err)
+	}
+
+	query := `
+		INSERT INTO recipe_manager.user_preference_documents (user_id, category, doc, updated_at)
+		VALUES ($1, $2, ($3::jsonb - 'updatedAt') || $4::jsonb, NOW())
+		ON CONFLICT (user_id, category) DO UPDATE SET
+			doc = user_preference_documents.doc || $4::jsonb,
+			updated_at = NOW()
+		RETURNING ` + documentValue + `
+	`
+
+	var payload []byte
+
+	err = runner.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query, userID, category, base, patch).Scan(&payload)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update %s preferences: %w", category, err)
+	}
+
+	return decodeDocument(payload, category, defaults)
+}
+
+// decodeDocument decodes a document over the category's defaults.
+func decodeDocument[T any](payload []byte, category dto.PreferenceCategory, defaults func() *T) (*T, error) {
+	prefs := defaults()
+
+	err := json.Unmarshal(payload, prefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s preferences: %w", category, err)
+	}
+
+	return prefs, nil
+}
@@ -0,0 +1,178 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func newDocumentRepository(db *sql.DB) *repository.DocumentPreferenceRepository {
+	return repository.NewDocumentPreferenceRepository(repository.NewPreferenceRepository(db))
+}
+
+func TestDocumentPreferenceRepositoryGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success - missing document reads as defaults", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		userID := uuid.New()
+
+		mock.ExpectQuery(`FROM recipe_manager.user_preference_documents\s+WHERE user_id = \$1 AND category = \$2`).
+			WithArgs(userID, dto.PreferenceCategorySound).
+			WillReturnError(sql.ErrNoRows)
+
+		prefs, err := newDocumentRepository(db).GetSoundPreferences(t.Context(), userID)
+		require.NoError(t, err)
+		assert.True(t, prefs.NotificationSounds)
+		assert.Equal(t, dto.VolumeLevelMedium, prefs.VolumeLevel)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Success - keys the document lacks keep their defaults", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`FROM recipe_manager.user_preference_documents`).
+			WillReturnRows(sqlmock.NewRows([]string{"doc"}).
+				AddRow([]byte(`{"volumeLevel": "HIGH", "updatedAt": "2026-10-01T12:00:00Z"}`)))
+
+		prefs, err := newDocumentRepository(db).GetSoundPreferences(t.Context(), uuid.New())
+		require.NoError(t, err)
+		assert.Equal(t, dto.VolumeLevelHigh, prefs.VolumeLevel)
+		assert.True(t, prefs.SystemSounds)
+		assert.Equal(t, 2026, prefs.UpdatedAt.Year())
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestDocumentPreferenceRepositoryUpdate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success - merges only the fields set", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		userID := uuid.New()
+		muted := true
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO recipe_manager.user_preference_documents .+ ON CONFLICT \(user_id, category\)`).
+			WithArgs(userID, dto.PreferenceCategorySound, sqlmock.AnyArg(), []byte(`{"muteNotifications":true}`)).
+			WillReturnRows(sqlmock.NewRows([]string{"doc"}).
+				AddRow([]byte(`{"muteNotifications": true, "updatedAt": "2026-10-01T12:00:00Z"}`)))
+		mock.ExpectCommit()
+
+		prefs, err := newDocumentRepository(db).UpdateSoundPreferences(t.Context(), userID,
+			&dto.SoundPreferencesUpdate{MuteNotifications: &muted})
+		require.NoError(t, err)
+		assert.True(t, prefs.MuteNotifications)
+		assert.True(t, prefs.NotificationSounds)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Error - invalid value is not written", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		loud := dto.VolumeLevel("DEAFENING")
+
+		_, err = newDocumentRepository(db).UpdateSoundPreferences(t.Context(), uuid.New(),
+			&dto.SoundPreferencesUpdate{VolumeLevel: &loud})
+		require.Error(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestDocumentPreferenceRepositoryResetPreferences(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	userID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM recipe_manager.user_preference_documents WHERE user_id = \$1 AND category = \$2`).
+		WithArgs(userID, dto.PreferenceCategoryTheme).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM recipe_manager.user_privacy_preferences WHERE user_id = \$1`).
+		WithArgs(userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = newDocumentRepository(db).ResetPreferences(t.Context(), userID,
+		[]dto.PreferenceCategory{dto.PreferenceCategoryTheme, dto.PreferenceCategoryPrivacy})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestDocumentPreferenceRepositoryCopyPreferencesToDocuments(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	categories := []string{"notification", "display", "accessibility", "language", "security", "social", "sound", "theme"}
+
+	mock.ExpectBegin()
+
+	for _, category := range categories {
+		mock.ExpectExec(`SELECT user_id, '` + category + `', jsonb_build_object\(.+\), updated_at FROM recipe_manager.user_` +
+			category + `_preferences .+ WHERE d.updated_at < EXCLUDED.updated_at`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	mock.ExpectCommit()
+
+	copied, err := newDocumentRepository(db).CopyPreferencesToDocuments(t.Context(), []uuid.UUID{uuid.New()})
+	require.NoError(t, err)
+	assert.Equal(t, len(categories), copied)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
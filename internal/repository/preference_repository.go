@@ -12,8 +12,13 @@ import (
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 )
 
-// ErrPreferencesNotFound is returned when preferences don't exist for a user.
-var ErrPreferencesNotFound = errors.New("preferences not found")
+// Repository-level errors for preferences.
+var (
+	// ErrPreferencesNotFound is returned when preferences don't exist for a user.
+	ErrPreferencesNotFound = errors.New("preferences not found")
+	// ErrInvalidPreferenceCategory is returned when a reset names an unknown category.
+	ErrInvalidPreferenceCategory = errors.New("invalid preference category")
+)
 
 // UserExistsChecker checks if a user exists.
 type UserExistsChecker interface {
@@ -92,9 +97,17 @@ type ThemePreferenceRepo interface {
 	) (*dto.ThemePreferences, error)
 }
 
+// PreferenceResetRepo restores preference categories to their defaults.
+type PreferenceResetRepo interface {
+	// ResetPreferences forgets the saved values of the given categories, so reads fall back to the
+	// defaults again.
+	ResetPreferences(ctx context.Context, userID uuid.UUID, categories []dto.PreferenceCategory) error
+}
+
 // PreferenceRepository combines all preference repository interfaces.
 type PreferenceRepository interface {
 	UserExistsChecker
+	PreferenceResetRepo
 	NotificationPreferenceRepo
 	DisplayPreferenceRepo
 	PrivacyPreferenceRepo
@@ -108,12 +121,15 @@ type PreferenceRepository interface {
 
 // SQLPreferenceRepository implements PreferenceRepository using SQL.
 type SQLPreferenceRepository struct {
+	privacyDefaults
+
 	db *sql.DB
+	tx txRunner
 }
 
 // NewPreferenceRepository creates a new SQLPreferenceRepository.
 func NewPreferenceRepository(db *sql.DB) *SQLPreferenceRepository {
-	return &SQLPreferenceRepository{db: db}
+	return &SQLPreferenceRepository{db: db, tx: newTxRunner(db)}
 }
 
 // UserExists checks if a user exists.
@@ -122,7 +138,7 @@ func (r *SQLPreferenceRepository) UserExists(ctx context.Context, userID uuid.UU
 
 	var exists bool
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(&exists)
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&exists)
 	if err != nil {
 		return false, fmt.Errorf("failed to check user existence: %w", err)
 	}
@@ -145,7 +161,7 @@ func (r *SQLPreferenceRepository) GetNotificationPreferences(
 
 	prefs := &dto.NotificationPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(
 		&prefs.EmailNotifications,
 		&prefs.PushNotifications,
 		&prefs.SMSNotifications,
@@ -158,7 +174,7 @@ func (r *SQLPreferenceRepository) GetNotificationPreferences(
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return defaultNotificationPreferences(), nil
+			return DefaultNotificationPreferences(), nil
 		}
 
 		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
@@ -167,7 +183,8 @@ func (r *SQLPreferenceRepository) GetNotificationPreferences(
 	return prefs, nil
 }
 
-func defaultNotificationPreferences() *dto.NotificationPreferences {
+// DefaultNotificationPreferences returns the notification preferences of a user who has never saved any.
+func DefaultNotificationPreferences() *dto.NotificationPreferences {
 	return &dto.NotificationPreferences{
 		EmailNotifications:    true,
 		PushNotifications:     true,
@@ -215,27 +232,29 @@ func (r *SQLPreferenceRepository) UpdateNotificationPreferences(
 
 	prefs := &dto.NotificationPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query,
-		userID,
-		update.EmailNotifications,
-		update.PushNotifications,
-		update.SMSNotifications,
-		update.MarketingEmails,
-		update.SecurityAlerts,
-		update.ActivitySummaries,
-		update.RecipeRecommendations,
-		update.SocialInteractions,
-	).Scan(
-		&prefs.EmailNotifications,
-		&prefs.PushNotifications,
-		&prefs.SMSNotifications,
-		&prefs.MarketingEmails,
-		&prefs.SecurityAlerts,
-		&prefs.ActivitySummaries,
-		&prefs.RecipeRecommendations,
-		&prefs.SocialInteractions,
-		&prefs.UpdatedAt,
-	)
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query,
+			userID,
+			update.EmailNotifications,
+			update.PushNotifications,
+			update.SMSNotifications,
+			update.MarketingEmails,
+			update.SecurityAlerts,
+			update.ActivitySummaries,
+			update.RecipeRecommendations,
+			update.SocialInteractions,
+		).Scan(
+			&prefs.EmailNotifications,
+			&prefs.PushNotifications,
+			&prefs.SMSNotifications,
+			&prefs.MarketingEmails,
+			&prefs.SecurityAlerts,
+			&prefs.ActivitySummaries,
+			&prefs.RecipeRecommendations,
+			&prefs.SocialInteractions,
+			&prefs.UpdatedAt,
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update notification preferences: %w", err)
 	}
@@ -256,7 +275,7 @@ func (r *SQLPreferenceRepository) GetDisplayPreferences(
 
 	prefs := &dto.DisplayPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(
 		&prefs.FontSize,
 		&prefs.ColorScheme,
 		&prefs.LayoutDensity,
@@ -266,7 +285,7 @@ func (r *SQLPreferenceRepository) GetDisplayPreferences(
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return defaultDisplayPreferences(), nil
+			return DefaultDisplayPreferences(), nil
 		}
 
 		return nil, fmt.Errorf("failed to get display preferences: %w", err)
@@ -275,7 +294,8 @@ func (r *SQLPreferenceRepository) GetDisplayPreferences(
 	return prefs, nil
 }
 
-func defaultDisplayPreferences() *dto.DisplayPreferences {
+// DefaultDisplayPreferences returns the display preferences of a user who has never saved any.
+func DefaultDisplayPreferences() *dto.DisplayPreferences {
 	return &dto.DisplayPreferences{
 		FontSize:      dto.FontSizeMedium,
 		ColorScheme:   dto.ColorSchemeLight,
@@ -312,21 +332,23 @@ func (r *SQLPreferenceRepository) UpdateDisplayPreferences(
 
 	prefs := &dto.DisplayPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query,
-		userID,
-		update.FontSize,
-		update.ColorScheme,
-		update.LayoutDensity,
-		update.ShowImages,
-		update.CompactMode,
-	).Scan(
-		&prefs.FontSize,
-		&prefs.ColorScheme,
-		&prefs.LayoutDensity,
-		&prefs.ShowImages,
-		&prefs.CompactMode,
-		&prefs.UpdatedAt,
-	)
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query,
+			userID,
+			update.FontSize,
+			update.ColorScheme,
+			update.LayoutDensity,
+			update.ShowImages,
+			update.CompactMode,
+		).Scan(
+			&prefs.FontSize,
+			&prefs.ColorScheme,
+			&prefs.LayoutDensity,
+			&prefs.ShowImages,
+			&prefs.CompactMode,
+			&prefs.UpdatedAt,
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update display preferences: %w", err)
 	}
@@ -334,48 +356,85 @@ func (r *SQLPreferenceRepository) UpdateDisplayPreferences(
 	return prefs, nil
 }
 
-// GetPrivacyPreferencesData retrieves privacy preferences for a user.
+// GetPrivacyPreferencesData retrieves privacy preferences for a user. Users who never saved any
+// get the defaults of the version they were provisioned under.
 func (r *SQLPreferenceRepository) GetPrivacyPreferencesData(
 	ctx context.Context,
 	userID uuid.UUID,
 ) (*dto.UserPrivacyPreferences, error) {
 	query := `
-		SELECT profile_visibility, recipe_visibility, activity_visibility,
-		       contact_info_visibility, data_sharing, analytics_tracking, updated_at
+		SELECT ` + privacyColumns + `
 		FROM recipe_manager.user_privacy_preferences
 		WHERE user_id = $1
 	`
 
+	prefs, err := scanPrivacyPreferences(executorFor(ctx, r.db).QueryRowContext(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			defaults, err := r.provision(ctx, executorFor(ctx, r.db), []uuid.UUID{userID})
+			if err != nil {
+				return nil, err
+			}
+
+			return defaults[userID], nil
+		}
+
+		return nil, fmt.Errorf("failed to get privacy preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// privacyColumns are the user_privacy_preferences columns read by scanPrivacyPreferences.
+const privacyColumns = `profile_visibility, recipe_visibility, activity_visibility, contact_info_visibility,
+		       show_full_name, allow_follows, allow_messages, data_sharing, analytics_tracking, discoverable,
+		       show_last_seen, show_location, count_profile_views, keep_recently_viewed, updated_at`
+
+// scanPrivacyPreferences scans the privacyColumns of a row.
+func scanPrivacyPreferences(row rowScanner, dest ...any) (*dto.UserPrivacyPreferences, error) {
 	prefs := &dto.UserPrivacyPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := row.Scan(append(dest,
 		&prefs.ProfileVisibility,
 		&prefs.RecipeVisibility,
 		&prefs.ActivityVisibility,
 		&prefs.ContactInfoVisibility,
+		&prefs.ShowFullName,
+		&prefs.AllowFollows,
+		&prefs.AllowMessages,
 		&prefs.DataSharing,
 		&prefs.AnalyticsTracking,
+		&prefs.Discoverable,
+		&prefs.ShowLastSeen,
+		&prefs.ShowLocation,
+		&prefs.CountProfileViews,
+		&prefs.KeepRecentlyViewed,
 		&prefs.UpdatedAt,
-	)
+	)...)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return defaultPrivacyPreferences(), nil
-		}
-
-		return nil, fmt.Errorf("failed to get privacy preferences: %w", err)
+		return nil, err //nolint:wrapcheck // callers wrap with query context
 	}
 
 	return prefs, nil
 }
 
-func defaultPrivacyPreferences() *dto.UserPrivacyPreferences {
+// DefaultPrivacyPreferences returns the privacy preferences of a user who has never saved any.
+func DefaultPrivacyPreferences() *dto.UserPrivacyPreferences {
 	return &dto.UserPrivacyPreferences{
 		ProfileVisibility:     dto.ProfileVisibilityPublic,
 		RecipeVisibility:      dto.ProfileVisibilityPublic,
-		ActivityVisibility:    dto.ProfileVisibilityPublic,
+		ActivityVisibility:    dto.ActivityVisibilityPublic,
 		ContactInfoVisibility: dto.ProfileVisibilityPrivate,
+		ShowFullName:          true,
+		AllowFollows:          true,
+		AllowMessages:         true,
 		DataSharing:           false,
 		AnalyticsTracking:     false,
+		Discoverable:          true,
+		ShowLastSeen:          true,
+		ShowLocation:          false,
+		CountProfileViews:     true,
+		KeepRecentlyViewed:    true,
 		UpdatedAt:             time.Now(),
 	}
 }
@@ -389,43 +448,73 @@ func (r *SQLPreferenceRepository) UpdatePrivacyPreferencesData(
 	query := `
 		INSERT INTO recipe_manager.user_privacy_preferences (
 			user_id, profile_visibility, recipe_visibility, activity_visibility,
-			contact_info_visibility, data_sharing, analytics_tracking, updated_at
+			contact_info_visibility, show_full_name, allow_follows, allow_messages,
+			data_sharing, analytics_tracking, discoverable, show_last_seen, show_location,
+			count_profile_views, keep_recently_viewed, updated_at
 		)
 		VALUES ($1,
 			COALESCE($2, 'PUBLIC'), COALESCE($3, 'PUBLIC'), COALESCE($4, 'PUBLIC'),
-			COALESCE($5, 'PRIVATE'), COALESCE($6, false), COALESCE($7, false), NOW()
+			COALESCE($5, 'PRIVATE'), COALESCE($6, true), COALESCE($7, true), COALESCE($8, true),
+			COALESCE($9, false), COALESCE($10, false), COALESCE($11, true), COALESCE($12, true),
+			COALESCE($13, false), COALESCE($14, true), COALESCE($15, true), NOW()
 		)
 		ON CONFLICT (user_id) DO UPDATE SET
 			profile_visibility = COALESCE($2, user_privacy_preferences.profile_visibility),
 			recipe_visibility = COALESCE($3, user_privacy_preferences.recipe_visibility),
 			activity_visibility = COALESCE($4, user_privacy_preferences.activity_visibility),
 			contact_info_visibility = COALESCE($5, user_privacy_preferences.contact_info_visibility),
-			data_sharing = COALESCE($6, user_privacy_preferences.data_sharing),
-			analytics_tracking = COALESCE($7, user_privacy_preferences.analytics_tracking),
+			show_full_name = COALESCE($6, user_privacy_preferences.show_full_name),
+			allow_follows = COALESCE($7, user_privacy_preferences.allow_follows),
+			allow_messages = COALESCE($8, user_privacy_preferences.allow_messages),
+			data_sharing = COALESCE($9, user_privacy_preferences.data_sharing),
+			analytics_tracking = COALESCE($10, user_privacy_preferences.analytics_tracking),
+			discoverable = COALESCE($11, user_privacy_preferences.discoverable),
+			show_last_seen = COALESCE($12, user_privacy_preferences.show_last_seen),
+			show_location = COALESCE($13, user_privacy_preferences.show_location),
+			count_profile_views = COALESCE($14, user_privacy_preferences.count_profile_views),
+			keep_recently_viewed = COALESCE($15, user_privacy_preferences.keep_recently_viewed),
 			updated_at = NOW()
-		RETURNING profile_visibility, recipe_visibility, activity_visibility,
-		          contact_info_visibility, data_sharing, analytics_tracking, updated_at
+		RETURNING ` + privacyColumns + `
 	`
 
-	prefs := &dto.UserPrivacyPreferences{}
+	var prefs *dto.UserPrivacyPreferences
 
-	err := r.db.QueryRowContext(ctx, query,
-		userID,
-		update.ProfileVisibility,
-		update.RecipeVisibility,
-		update.ActivityVisibility,
-		update.ContactInfoVisibility,
-		update.DataSharing,
-		update.AnalyticsTracking,
-	).Scan(
-		&prefs.ProfileVisibility,
-		&prefs.RecipeVisibility,
-		&prefs.ActivityVisibility,
-		&prefs.ContactInfoVisibility,
-		&prefs.DataSharing,
-		&prefs.AnalyticsTracking,
-		&prefs.UpdatedAt,
-	)
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		// A first save starts from the user's versioned defaults rather than the upsert's fallbacks
+		if r.version != 0 {
+			defaults, err := r.provision(ctx, tx, []uuid.UUID{userID})
+			if err != nil {
+				return err
+			}
+
+			err = writePrivacyDefaults(ctx, tx, userID, defaults[userID], keepSavedPrivacy)
+			if err != nil {
+				return err
+			}
+		}
+
+		var err error
+
+		prefs, err = scanPrivacyPreferences(tx.QueryRowContext(ctx, query,
+			userID,
+			update.ProfileVisibility,
+			update.RecipeVisibility,
+			update.ActivityVisibility,
+			update.ContactInfoVisibility,
+			update.ShowFullName,
+			update.AllowFollows,
+			update.AllowMessages,
+			update.DataSharing,
+			update.AnalyticsTracking,
+			update.Discoverable,
+			update.ShowLastSeen,
+			update.ShowLocation,
+			update.CountProfileViews,
+			update.KeepRecentlyViewed,
+		))
+
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update privacy preferences: %w", err)
 	}
@@ -446,7 +535,7 @@ func (r *SQLPreferenceRepository) GetAccessibilityPreferences(
 
 	prefs := &dto.AccessibilityPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(
 		&prefs.ScreenReader,
 		&prefs.HighContrast,
 		&prefs.ReducedMotion,
@@ -456,7 +545,7 @@ func (r *SQLPreferenceRepository) GetAccessibilityPreferences(
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return defaultAccessibilityPreferences(), nil
+			return DefaultAccessibilityPreferences(), nil
 		}
 
 		return nil, fmt.Errorf("failed to get accessibility preferences: %w", err)
@@ -465,7 +554,8 @@ func (r *SQLPreferenceRepository) GetAccessibilityPreferences(
 	return prefs, nil
 }
 
-func defaultAccessibilityPreferences() *dto.AccessibilityPreferences {
+// DefaultAccessibilityPreferences returns the accessibility preferences of a user who has never saved any.
+func DefaultAccessibilityPreferences() *dto.AccessibilityPreferences {
 	return &dto.AccessibilityPreferences{
 		ScreenReader:       false,
 		HighContrast:       false,
@@ -502,21 +592,23 @@ func (r *SQLPreferenceRepository) UpdateAccessibilityPreferences(
 
 	prefs := &dto.AccessibilityPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query,
-		userID,
-		update.ScreenReader,
-		update.HighContrast,
-		update.ReducedMotion,
-		update.LargeText,
-		update.KeyboardNavigation,
-	).Scan(
-		&prefs.ScreenReader,
-		&prefs.HighContrast,
-		&prefs.ReducedMotion,
-		&prefs.LargeText,
-		&prefs.KeyboardNavigation,
-		&prefs.UpdatedAt,
-	)
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query,
+			userID,
+			update.ScreenReader,
+			update.HighContrast,
+			update.ReducedMotion,
+			update.LargeText,
+			update.KeyboardNavigation,
+		).Scan(
+			&prefs.ScreenReader,
+			&prefs.HighContrast,
+			&prefs.ReducedMotion,
+			&prefs.LargeText,
+			&prefs.KeyboardNavigation,
+			&prefs.UpdatedAt,
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update accessibility preferences: %w", err)
 	}
@@ -539,7 +631,7 @@ func (r *SQLPreferenceRepository) GetLanguagePreferences(
 
 	var secondaryLang sql.NullString
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(
 		&prefs.PrimaryLanguage,
 		&secondaryLang,
 		&prefs.TranslationEnabled,
@@ -547,7 +639,7 @@ func (r *SQLPreferenceRepository) GetLanguagePreferences(
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return defaultLanguagePreferences(), nil
+			return DefaultLanguagePreferences(), nil
 		}
 
 		return nil, fmt.Errorf("failed to get language preferences: %w", err)
@@ -561,7 +653,8 @@ func (r *SQLPreferenceRepository) GetLanguagePreferences(
 	return prefs, nil
 }
 
-func defaultLanguagePreferences() *dto.LanguagePreferences {
+// DefaultLanguagePreferences returns the language preferences of a user who has never saved any.
+func DefaultLanguagePreferences() *dto.LanguagePreferences {
 	return &dto.LanguagePreferences{
 		PrimaryLanguage:    dto.LanguageEN,
 		SecondaryLanguage:  nil,
@@ -593,17 +686,19 @@ func (r *SQLPreferenceRepository) UpdateLanguagePreferences(
 
 	var secondaryLang sql.NullString
 
-	err := r.db.QueryRowContext(ctx, query,
-		userID,
-		update.PrimaryLanguage,
-		update.SecondaryLanguage,
-		update.TranslationEnabled,
-	).Scan(
-		&prefs.PrimaryLanguage,
-		&secondaryLang,
-		&prefs.TranslationEnabled,
-		&prefs.UpdatedAt,
-	)
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query,
+			userID,
+			update.PrimaryLanguage,
+			update.SecondaryLanguage,
+			update.TranslationEnabled,
+		).Scan(
+			&prefs.PrimaryLanguage,
+			&secondaryLang,
+			&prefs.TranslationEnabled,
+			&prefs.UpdatedAt,
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update language preferences: %w", err)
 	}
@@ -629,7 +724,7 @@ func (r *SQLPreferenceRepository) GetSecurityPreferences(
 
 	prefs := &dto.SecurityPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(
 		&prefs.TwoFactorAuth,
 		&prefs.LoginNotifications,
 		&prefs.SessionTimeout,
@@ -638,7 +733,7 @@ func (r *SQLPreferenceRepository) GetSecurityPreferences(
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return defaultSecurityPreferences(), nil
+			return DefaultSecurityPreferences(), nil
 		}
 
 		return nil, fmt.Errorf("failed to get security preferences: %w", err)
@@ -647,7 +742,8 @@ func (r *SQLPreferenceRepository) GetSecurityPreferences(
 	return prefs, nil
 }
 
-func defaultSecurityPreferences() *dto.SecurityPreferences {
+// DefaultSecurityPreferences returns the security preferences of a user who has never saved any.
+func DefaultSecurityPreferences() *dto.SecurityPreferences {
 	return &dto.SecurityPreferences{
 		TwoFactorAuth:        false,
 		LoginNotifications:   true,
@@ -681,19 +777,21 @@ func (r *SQLPreferenceRepository) UpdateSecurityPreferences(
 
 	prefs := &dto.SecurityPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query,
-		userID,
-		update.TwoFactorAuth,
-		update.LoginNotifications,
-		update.SessionTimeout,
-		update.PasswordRequirements,
-	).Scan(
-		&prefs.TwoFactorAuth,
-		&prefs.LoginNotifications,
-		&prefs.SessionTimeout,
-		&prefs.PasswordRequirements,
-		&prefs.UpdatedAt,
-	)
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query,
+			userID,
+			update.TwoFactorAuth,
+			update.LoginNotifications,
+			update.SessionTimeout,
+			update.PasswordRequirements,
+		).Scan(
+			&prefs.TwoFactorAuth,
+			&prefs.LoginNotifications,
+			&prefs.SessionTimeout,
+			&prefs.PasswordRequirements,
+			&prefs.UpdatedAt,
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update security preferences: %w", err)
 	}
@@ -714,7 +812,7 @@ func (r *SQLPreferenceRepository) GetSocialPreferences(
 
 	prefs := &dto.SocialPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(
 		&prefs.FriendRequests,
 		&prefs.MessageNotifications,
 		&prefs.GroupInvites,
@@ -723,7 +821,7 @@ func (r *SQLPreferenceRepository) GetSocialPreferences(
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return defaultSocialPreferences(), nil
+			return DefaultSocialPreferences(), nil
 		}
 
 		return nil, fmt.Errorf("failed to get social preferences: %w", err)
@@ -732,7 +830,8 @@ func (r *SQLPreferenceRepository) GetSocialPreferences(
 	return prefs, nil
 }
 
-func defaultSocialPreferences() *dto.SocialPreferences {
+// DefaultSocialPreferences returns the social preferences of a user who has never saved any.
+func DefaultSocialPreferences() *dto.SocialPreferences {
 	return &dto.SocialPreferences{
 		FriendRequests:       true,
 		MessageNotifications: true,
@@ -766,19 +865,21 @@ func (r *SQLPreferenceRepository) UpdateSocialPreferences(
 
 	prefs := &dto.SocialPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query,
-		userID,
-		update.FriendRequests,
-		update.MessageNotifications,
-		update.GroupInvites,
-		update.ShareActivity,
-	).Scan(
-		&prefs.FriendRequests,
-		&prefs.MessageNotifications,
-		&prefs.GroupInvites,
-		&prefs.ShareActivity,
-		&prefs.UpdatedAt,
-	)
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query,
+			userID,
+			update.FriendRequests,
+			update.MessageNotifications,
+			update.GroupInvites,
+			update.ShareActivity,
+		).Scan(
+			&prefs.FriendRequests,
+			&prefs.MessageNotifications,
+			&prefs.GroupInvites,
+			&prefs.ShareActivity,
+			&prefs.UpdatedAt,
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update social preferences: %w", err)
 	}
@@ -799,7 +900,7 @@ func (r *SQLPreferenceRepository) GetSoundPreferences(
 
 	prefs := &dto.SoundPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(
 		&prefs.NotificationSounds,
 		&prefs.SystemSounds,
 		&prefs.VolumeLevel,
@@ -808,7 +909,7 @@ func (r *SQLPreferenceRepository) GetSoundPreferences(
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return defaultSoundPreferences(), nil
+			return DefaultSoundPreferences(), nil
 		}
 
 		return nil, fmt.Errorf("failed to get sound preferences: %w", err)
@@ -817,7 +918,8 @@ func (r *SQLPreferenceRepository) GetSoundPreferences(
 	return prefs, nil
 }
 
-func defaultSoundPreferences() *dto.SoundPreferences {
+// DefaultSoundPreferences returns the sound preferences of a user who has never saved any.
+func DefaultSoundPreferences() *dto.SoundPreferences {
 	return &dto.SoundPreferences{
 		NotificationSounds: true,
 		SystemSounds:       true,
@@ -851,19 +953,21 @@ func (r *SQLPreferenceRepository) UpdateSoundPreferences(
 
 	prefs := &dto.SoundPreferences{}
 
-	err := r.db.QueryRowContext(ctx, query,
-		userID,
-		update.NotificationSounds,
-		update.SystemSounds,
-		update.VolumeLevel,
-		update.MuteNotifications,
-	).Scan(
-		&prefs.NotificationSounds,
-		&prefs.SystemSounds,
-		&prefs.VolumeLevel,
-		&prefs.MuteNotifications,
-		&prefs.UpdatedAt,
-	)
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query,
+			userID,
+			update.NotificationSounds,
+			update.SystemSounds,
+			update.VolumeLevel,
+			update.MuteNotifications,
+		).Scan(
+			&prefs.NotificationSounds,
+			&prefs.SystemSounds,
+			&prefs.VolumeLevel,
+			&prefs.MuteNotifications,
+			&prefs.UpdatedAt,
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update sound preferences: %w", err)
 	}
@@ -886,7 +990,7 @@ func (r *SQLPreferenceRepository) GetThemePreferences(
 
 	var customTheme sql.NullString
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(
 		&prefs.DarkMode,
 		&prefs.LightMode,
 		&prefs.AutoTheme,
@@ -895,7 +999,7 @@ func (r *SQLPreferenceRepository) GetThemePreferences(
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return defaultThemePreferences(), nil
+			return DefaultThemePreferences(), nil
 		}
 
 		return nil, fmt.Errorf("failed to get theme preferences: %w", err)
@@ -909,7 +1013,8 @@ func (r *SQLPreferenceRepository) GetThemePreferences(
 	return prefs, nil
 }
 
-func defaultThemePreferences() *dto.ThemePreferences {
+// DefaultThemePreferences returns the theme preferences of a user who has never saved any.
+func DefaultThemePreferences() *dto.ThemePreferences {
 	return &dto.ThemePreferences{
 		DarkMode:    false,
 		LightMode:   true,
@@ -943,19 +1048,21 @@ func (r *SQLPreferenceRepository) UpdateThemePreferences(
 
 	var customTheme sql.NullString
 
-	err := r.db.QueryRowContext(ctx, query,
-		userID,
-		update.DarkMode,
-		update.LightMode,
-		update.AutoTheme,
-		update.CustomTheme,
-	).Scan(
-		&prefs.DarkMode,
-		&prefs.LightMode,
-		&prefs.AutoTheme,
-		&customTheme,
-		&prefs.UpdatedAt,
-	)
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query,
+			userID,
+			update.DarkMode,
+			update.LightMode,
+			update.AutoTheme,
+			update.CustomTheme,
+		).Scan(
+			&prefs.DarkMode,
+			&prefs.LightMode,
+			&prefs.AutoTheme,
+			&customTheme,
+			&prefs.UpdatedAt,
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update theme preferences: %w", err)
 	}
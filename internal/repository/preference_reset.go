@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// preferenceTables maps each preference category to the table its saved values live in.
+var preferenceTables = map[dto.PreferenceCategory]string{
+	dto.PreferenceCategoryNotification:  "recipe_manager.user_notification_preferences",
+	dto.PreferenceCategoryDisplay:       "recipe_manager.user_display_preferences",
+	dto.PreferenceCategoryPrivacy:       "recipe_manager.user_privacy_preferences",
+	dto.PreferenceCategoryAccessibility: "recipe_manager.user_accessibility_preferences",
+	dto.PreferenceCategoryLanguage:      "recipe_manager.user_language_preferences",
+	dto.PreferenceCategorySecurity:      "recipe_manager.user_security_preferences",
+	dto.PreferenceCategorySocial:        "recipe_manager.user_social_preferences",
+	dto.PreferenceCategorySound:         "recipe_manager.user_sound_preferences",
+	dto.PreferenceCategoryTheme:         "recipe_manager.user_theme_preferences",
+}
+
+// ResetPreferences deletes the user's rows of the given categories in one transaction. Reads of a
+// category without a row return its defaults, so deleting is all a reset takes.
+func (r *SQLPreferenceRepository) ResetPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	categories []dto.PreferenceCategory,
+) error {
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		for _, category := range categories {
+			table, ok := preferenceTables[category]
+			if !ok {
+				return fmt.Errorf("%w: %s", ErrInvalidPreferenceCategory, category)
+			}
+
+			_, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE user_id = $1`, userID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset preferences: %w", err)
+	}
+
+	return nil
+}
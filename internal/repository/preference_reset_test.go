@@ -0,0 +1,66 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestPreferenceRepositoryResetPreferences(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success - deletes the rows of each category", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		userID := uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM recipe_manager.user_display_preferences WHERE user_id = \$1`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM recipe_manager.user_theme_preferences WHERE user_id = \$1`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err = repository.NewPreferenceRepository(db).ResetPreferences(t.Context(), userID,
+			[]dto.PreferenceCategory{dto.PreferenceCategoryDisplay, dto.PreferenceCategoryTheme})
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Error - unknown category rolls back", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM recipe_manager.user_sound_preferences`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectRollback()
+
+		err = repository.NewPreferenceRepository(db).ResetPreferences(t.Context(), uuid.New(),
+			[]dto.PreferenceCategory{dto.PreferenceCategorySound, "colors"})
+		require.ErrorIs(t, err, repository.ErrInvalidPreferenceCategory)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
@@ -0,0 +1,400 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// PrivacyDefaultsRepository tracks which version of the privacy defaults each user was provisioned
+// under and moves cohorts of users between versions.
+type PrivacyDefaultsRepository interface {
+	// CountPrivacyDefaultsVersions returns how many users are on each version, in version order.
+	// Users not provisioned yet are counted under version 0.
+	CountPrivacyDefaultsVersions(ctx context.Context) ([]dto.PrivacyDefaultsCohort, error)
+	// MovePrivacyDefaults moves up to limit users from one version to another and returns them.
+	// Users still on the old version's defaults get the new version's; saved choices are kept.
+	MovePrivacyDefaults(ctx context.Context, from, to, limit int) ([]PrivacyDefaultsMove, error)
+}
+
+// PrivacyDefaultsMove is a user moved between privacy defaults versions.
+type PrivacyDefaultsMove struct {
+	UserID uuid.UUID
+	// Changed is set when the user's effective privacy preferences changed with the move.
+	Changed bool
+}
+
+var _ PrivacyDefaultsRepository = (*SQLPreferenceRepository)(nil)
+
+// DefaultPrivacyPreferencesForVersion returns the privacy preferences of a user provisioned under
+// the given defaults version who has never saved any. Unknown versions get the original defaults.
+func DefaultPrivacyPreferencesForVersion(version int) *dto.UserPrivacyPreferences {
+	prefs := DefaultPrivacyPreferences()
+
+	if version == dto.PrivacyDefaultsVersionPrivateProfile {
+		prefs.ProfileVisibility = dto.ProfileVisibilityPrivate
+	}
+
+	return prefs
+}
+
+// privacyDefaults holds the defaults version new users are provisioned under. Repositories that
+// fall back to default privacy preferences embed it; left unset, every user gets the original
+// defaults and nothing is recorded.
+type privacyDefaults struct {
+	version int
+}
+
+// SetPrivacyDefaultsVersion sets the defaults version users not provisioned yet are pinned to the
+// first time their privacy preferences are read or written. Call it before serving requests.
+func (p *privacyDefaults) SetPrivacyDefaultsVersion(version int) {
+	p.version = version
+}
+
+// queryer runs queries on a database or inside a transaction.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// provision returns the defaults of each user, pinning the users not provisioned yet to the
+// configured version. Users already provisioned are only read, so repeated reads write nothing.
+// Users missing from the users table get the original defaults.
+func (p *privacyDefaults) provision(
+	ctx context.Context,
+	q queryer,
+	userIDs []uuid.UUID,
+) (map[uuid.UUID]*dto.UserPrivacyPreferences, error) {
+	defaults := make(map[uuid.UUID]*dto.UserPrivacyPreferences, len(userIDs))
+
+	for _, userID := range userIDs {
+		defaults[userID] = DefaultPrivacyPreferences()
+	}
+
+	if p.version == 0 || len(userIDs) == 0 {
+		return defaults, nil
+	}
+
+	versions, err := queryPrivacyDefaultsVersions(ctx, q, `
+		SELECT user_id, privacy_defaults_version
+		FROM recipe_manager.users
+		WHERE user_id = ANY($1::uuid[])
+	`, uuidArray(userIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	var unprovisioned []uuid.UUID
+
+	for userID, version := range versions {
+		if !version.Valid {
+			unprovisioned = append(unprovisioned, userID)
+
+			// Whoever pins the user first, this instance or another, pins the configured version
+			version.Int64 = int64(p.version)
+		}
+
+		defaults[userID] = DefaultPrivacyPreferencesForVersion(int(version.Int64))
+	}
+
+	if len(unprovisioned) == 0 {
+		return defaults, nil
+	}
+
+	pinned, err := queryPrivacyDefaultsVersions(ctx, q, `
+		UPDATE recipe_manager.users
+		SET privacy_defaults_version = $2
+		WHERE user_id = ANY($1::uuid[]) AND privacy_defaults_version IS NULL
+		RETURNING user_id, privacy_defaults_version
+	`, uuidArray(unprovisioned), p.version)
+	if err != nil {
+		return nil, err
+	}
+
+	for userID, version := range pinned {
+		defaults[userID] = DefaultPrivacyPreferencesForVersion(int(version.Int64))
+	}
+
+	return defaults, nil
+}
+
+// queryPrivacyDefaultsVersions runs a query returning user IDs and their defaults versions.
+func queryPrivacyDefaultsVersions(
+	ctx context.Context,
+	q queryer,
+	query string,
+	args ...any,
+) (map[uuid.UUID]sql.NullInt64, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query privacy defaults versions: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	versions := map[uuid.UUID]sql.NullInt64{}
+
+	for rows.Next() {
+		var (
+			userID  uuid.UUID
+			version sql.NullInt64
+		)
+
+		err = rows.Scan(&userID, &version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan privacy defaults version: %w", err)
+		}
+
+		versions[userID] = version
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate privacy defaults versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// Conflict clauses of writePrivacyDefaults.
+const (
+	// keepSavedPrivacy leaves an existing privacy row alone.
+	keepSavedPrivacy = `ON CONFLICT (user_id) DO NOTHING`
+	// replaceSavedPrivacy overwrites an existing privacy row.
+	replaceSavedPrivacy = `ON CONFLICT (user_id) DO UPDATE SET
+			profile_visibility = EXCLUDED.profile_visibility,
+			recipe_visibility = EXCLUDED.recipe_visibility,
+			activity_visibility = EXCLUDED.activity_visibility,
+			contact_info_visibility = EXCLUDED.contact_info_visibility,
+			show_full_name = EXCLUDED.show_full_name,
+			allow_follows = EXCLUDED.allow_follows,
+			allow_messages = EXCLUDED.allow_messages,
+			data_sharing = EXCLUDED.data_sharing,
+			analytics_tracking = EXCLUDED.analytics_tracking,
+			discoverable = EXCLUDED.discoverable,
+			show_last_seen = EXCLUDED.show_last_seen,
+			show_location = EXCLUDED.show_location,
+			count_profile_views = EXCLUDED.count_profile_views,
+			keep_recently_viewed = EXCLUDED.keep_recently_viewed,
+			updated_at = NOW()`
+)
+
+// writePrivacyDefaults writes the given defaults as the user's privacy row, resolving an existing
+// row with onConflict.
+func writePrivacyDefaults(
+	ctx context.Context,
+	tx *sql.Tx,
+	userID uuid.UUID,
+	prefs *dto.UserPrivacyPreferences,
+	onConflict string,
+) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO recipe_manager.user_privacy_preferences (
+			user_id, profile_visibility, recipe_visibility, activity_visibility,
+			contact_info_visibility, show_full_name, allow_follows, allow_messages,
+			data_sharing, analytics_tracking, discoverable, show_last_seen, show_location,
+			count_profile_views, keep_recently_viewed, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, NOW())
+		`+onConflict,
+		userID,
+		prefs.ProfileVisibility,
+		prefs.RecipeVisibility,
+		prefs.ActivityVisibility,
+		prefs.ContactInfoVisibility,
+		prefs.ShowFullName,
+		prefs.AllowFollows,
+		prefs.AllowMessages,
+		prefs.DataSharing,
+		prefs.AnalyticsTracking,
+		prefs.Discoverable,
+		prefs.ShowLastSeen,
+		prefs.ShowLocation,
+		prefs.CountProfileViews,
+		prefs.KeepRecentlyViewed,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to write privacy defaults: %w", err)
+	}
+
+	return nil
+}
+
+// CountPrivacyDefaultsVersions returns how many users are on each version, in version order.
+func (r *SQLPreferenceRepository) CountPrivacyDefaultsVersions(
+	ctx context.Context,
+) ([]dto.PrivacyDefaultsCohort, error) {
+	query := `
+		SELECT COALESCE(privacy_defaults_version, 0), COUNT(*)
+		FROM recipe_manager.users
+		GROUP BY 1
+		ORDER BY 1
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count privacy defaults versions: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	cohorts := []dto.PrivacyDefaultsCohort{}
+
+	for rows.Next() {
+		var cohort dto.PrivacyDefaultsCohort
+
+		err = rows.Scan(&cohort.Version, &cohort.Users)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan privacy defaults cohort: %w", err)
+		}
+
+		cohorts = append(cohorts, cohort)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate privacy defaults cohorts: %w", err)
+	}
+
+	return cohorts, nil
+}
+
+// MovePrivacyDefaults moves up to limit users from one version to another in one transaction, in
+// ID order. A user whose privacy row is missing or still holds the old version's defaults gets the
+// new version's defaults written; any saved choice keeps the whole row as it is.
+func (r *SQLPreferenceRepository) MovePrivacyDefaults(
+	ctx context.Context,
+	from, to, limit int,
+) ([]PrivacyDefaultsMove, error) {
+	var moves []PrivacyDefaultsMove
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		moves = nil
+
+		userIDs, err := lockPrivacyDefaultsCohort(ctx, tx, from, limit)
+		if err != nil || len(userIDs) == 0 {
+			return err
+		}
+
+		current, err := findPrivacyPreferences(ctx, tx, userIDs)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE recipe_manager.users
+			SET privacy_defaults_version = $2
+			WHERE user_id = ANY($1::uuid[])
+		`, uuidArray(userIDs), to)
+		if err != nil {
+			return err
+		}
+
+		oldDefaults := DefaultPrivacyPreferencesForVersion(from)
+		newDefaults := DefaultPrivacyPreferencesForVersion(to)
+
+		for _, userID := range userIDs {
+			prefs, saved := current[userID]
+			move := PrivacyDefaultsMove{UserID: userID}
+
+			if !saved || samePrivacyPreferences(prefs, oldDefaults) {
+				move.Changed = !samePrivacyPreferences(oldDefaults, newDefaults)
+
+				err = writePrivacyDefaults(ctx, tx, userID, newDefaults, replaceSavedPrivacy)
+				if err != nil {
+					return err
+				}
+			}
+
+			moves = append(moves, move)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to move privacy defaults: %w", err)
+	}
+
+	return moves, nil
+}
+
+// lockPrivacyDefaultsCohort locks and returns up to limit users on the given version.
+func lockPrivacyDefaultsCohort(ctx context.Context, tx *sql.Tx, version, limit int) ([]uuid.UUID, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT user_id
+		FROM recipe_manager.users
+		WHERE privacy_defaults_version = $1
+		ORDER BY user_id
+		LIMIT $2
+		FOR UPDATE
+	`, version, limit)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // MovePrivacyDefaults wraps
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var userIDs []uuid.UUID
+
+	for rows.Next() {
+		var userID uuid.UUID
+
+		err = rows.Scan(&userID)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // MovePrivacyDefaults wraps
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, rows.Err() //nolint:wrapcheck // MovePrivacyDefaults wraps
+}
+
+// findPrivacyPreferences returns the saved privacy rows of the given users.
+func findPrivacyPreferences(
+	ctx context.Context,
+	q queryer,
+	userIDs []uuid.UUID,
+) (map[uuid.UUID]*dto.UserPrivacyPreferences, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT user_id, `+privacyColumns+`
+		FROM recipe_manager.user_privacy_preferences
+		WHERE user_id = ANY($1::uuid[])
+	`, uuidArray(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query privacy preferences: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	prefs := make(map[uuid.UUID]*dto.UserPrivacyPreferences, len(userIDs))
+
+	for rows.Next() {
+		var userID uuid.UUID
+
+		userPrefs, err := scanPrivacyPreferences(rows, &userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan privacy preferences: %w", err)
+		}
+
+		prefs[userID] = userPrefs
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate privacy preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// samePrivacyPreferences reports whether two sets of privacy preferences hold the same choices.
+func samePrivacyPreferences(a, b *dto.UserPrivacyPreferences) bool {
+	x, y := *a, *b
+	x.UpdatedAt = y.UpdatedAt
+
+	return x == y
+}
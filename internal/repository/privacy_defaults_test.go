@@ -0,0 +1,163 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+var privacyRowColumns = []string{
+	"user_id", "profile_visibility", "recipe_visibility", "activity_visibility", "contact_info_visibility",
+	"show_full_name", "allow_follows", "allow_messages", "data_sharing", "analytics_tracking", "discoverable",
+	"show_last_seen", "show_location", "count_profile_views", "keep_recently_viewed", "updated_at",
+}
+
+func TestDefaultPrivacyPreferencesForVersion(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, dto.ProfileVisibilityPublic,
+		repository.DefaultPrivacyPreferencesForVersion(dto.PrivacyDefaultsVersionPublicProfile).ProfileVisibility)
+	assert.Equal(t, dto.ProfileVisibilityPrivate,
+		repository.DefaultPrivacyPreferencesForVersion(dto.PrivacyDefaultsVersionPrivateProfile).ProfileVisibility)
+	assert.Equal(t, dto.ProfileVisibilityPublic, repository.DefaultPrivacyPreferencesForVersion(0).ProfileVisibility)
+}
+
+func TestPreferenceRepositoryGetPrivacyPreferencesData_Provisions(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery(`SELECT .* FROM recipe_manager.user_privacy_preferences WHERE user_id = \$1`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows(privacyRowColumns[1:]))
+	mock.ExpectQuery(`SELECT user_id, privacy_defaults_version FROM recipe_manager.users WHERE user_id = ANY`).
+		WithArgs("{" + userID.String() + "}").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "privacy_defaults_version"}).AddRow(userID, nil))
+	mock.ExpectQuery(`UPDATE recipe_manager.users SET privacy_defaults_version = \$2 `+
+		`WHERE user_id = ANY\(\$1::uuid\[\]\) AND privacy_defaults_version IS NULL`).
+		WithArgs("{"+userID.String()+"}", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "privacy_defaults_version"}).AddRow(userID, 2))
+
+	repo := repository.NewPreferenceRepository(db)
+	repo.SetPrivacyDefaultsVersion(2)
+
+	prefs, err := repo.GetPrivacyPreferencesData(t.Context(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, dto.ProfileVisibilityPrivate, prefs.ProfileVisibility)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestPreferenceRepositoryGetPrivacyPreferencesData_ProvisionedOnlyRead(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	userID := uuid.New()
+
+	// A user already pinned to a version is read without writing, whatever the configured version
+	mock.ExpectQuery(`SELECT .* FROM recipe_manager.user_privacy_preferences WHERE user_id = \$1`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows(privacyRowColumns[1:]))
+	mock.ExpectQuery(`SELECT user_id, privacy_defaults_version FROM recipe_manager.users WHERE user_id = ANY`).
+		WithArgs("{" + userID.String() + "}").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "privacy_defaults_version"}).AddRow(userID, 1))
+
+	repo := repository.NewPreferenceRepository(db)
+	repo.SetPrivacyDefaultsVersion(2)
+
+	prefs, err := repo.GetPrivacyPreferencesData(t.Context(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, dto.ProfileVisibilityPublic, prefs.ProfileVisibility)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestPreferenceRepositoryMovePrivacyDefaults(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	untouched, saved, missing := uuid.New(), uuid.New(), uuid.New()
+	defaults := repository.DefaultPrivacyPreferences()
+	ids := "{" + untouched.String() + "," + saved.String() + "," + missing.String() + "}"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT user_id FROM recipe_manager.users WHERE privacy_defaults_version = \$1 .* FOR UPDATE`).
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(untouched).AddRow(saved).AddRow(missing))
+	mock.ExpectQuery(`FROM recipe_manager.user_privacy_preferences WHERE user_id = ANY`).
+		WithArgs(ids).
+		WillReturnRows(sqlmock.NewRows(privacyRowColumns).
+			AddRow(untouched, "PUBLIC", "PUBLIC", "PUBLIC", "PRIVATE", true, true, true, false, false, true, true,
+				false, true, true, defaults.UpdatedAt).
+			AddRow(saved, "PUBLIC", "PUBLIC", "PUBLIC", "PRIVATE", true, true, false, false, false, true, true,
+				false, true, true, defaults.UpdatedAt))
+	mock.ExpectExec(`UPDATE recipe_manager.users SET privacy_defaults_version = \$2`).
+		WithArgs(ids, 2).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`INSERT INTO recipe_manager.user_privacy_preferences .* ON CONFLICT \(user_id\) DO UPDATE`).
+		WithArgs(untouched, dto.ProfileVisibilityPrivate, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO recipe_manager.user_privacy_preferences`).
+		WithArgs(missing, dto.ProfileVisibilityPrivate, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	moves, err := repository.NewPreferenceRepository(db).MovePrivacyDefaults(t.Context(), 1, 2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []repository.PrivacyDefaultsMove{
+		{UserID: untouched, Changed: true},
+		{UserID: saved},
+		{UserID: missing, Changed: true},
+	}, moves)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestPreferenceRepositoryCountPrivacyDefaultsVersions(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	mock.ExpectQuery(`SELECT COALESCE\(privacy_defaults_version, 0\), COUNT\(\*\) FROM recipe_manager.users`).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "count"}).AddRow(0, 3).AddRow(1, 12))
+
+	cohorts, err := repository.NewPreferenceRepository(db).CountPrivacyDefaultsVersions(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []dto.PrivacyDefaultsCohort{{Version: 0, Users: 3}, {Version: 1, Users: 12}}, cohorts)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
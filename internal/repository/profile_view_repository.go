@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// ProfileViewRepository keeps the number of times each profile was viewed. Only totals are stored;
+// views are counted elsewhere and added in batches.
+type ProfileViewRepository interface {
+	// AddProfileViews adds the counted views to each user's total. Users deleted since are skipped.
+	AddProfileViews(ctx context.Context, views map[uuid.UUID]int64) error
+	// GetProfileViewCount returns the stored total of a user, 0 if none was added yet.
+	GetProfileViewCount(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+// SQLProfileViewRepository implements ProfileViewRepository using a SQL database.
+type SQLProfileViewRepository struct {
+	db *sql.DB
+	tx txRunner
+}
+
+// NewProfileViewRepository creates a new SQLProfileViewRepository.
+func NewProfileViewRepository(db *sql.DB) *SQLProfileViewRepository {
+	return &SQLProfileViewRepository{db: db, tx: newTxRunner(db)}
+}
+
+// AddProfileViews adds the counted views to each user's total in one transaction.
+func (r *SQLProfileViewRepository) AddProfileViews(ctx context.Context, views map[uuid.UUID]int64) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO recipe_manager.user_profile_views (user_id, view_count, updated_at)
+		SELECT user_id, $2, NOW() FROM recipe_manager.users WHERE user_id = $1
+		ON CONFLICT (user_id) DO UPDATE
+		SET view_count = user_profile_views.view_count + EXCLUDED.view_count, updated_at = NOW()
+	`
+
+	// Rows are locked in ID order so concurrent flushes cannot deadlock
+	userIDs := make([]uuid.UUID, 0, len(views))
+	for userID := range views {
+		userIDs = append(userIDs, userID)
+	}
+
+	slices.SortFunc(userIDs, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		for _, userID := range userIDs {
+			_, err := tx.ExecContext(ctx, query, userID, views[userID])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add profile views: %w", err)
+	}
+
+	return nil
+}
+
+// GetProfileViewCount returns the stored total of a user.
+func (r *SQLProfileViewRepository) GetProfileViewCount(ctx context.Context, userID uuid.UUID) (int64, error) {
+	query := `SELECT view_count FROM recipe_manager.user_profile_views WHERE user_id = $1`
+
+	var count int64
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&count)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to get profile views: %w", err)
+	}
+
+	return count, nil
+}
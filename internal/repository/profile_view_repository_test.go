@@ -0,0 +1,69 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestProfileViewRepositoryAddProfileViews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	first := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	second := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	upsert := `INSERT INTO recipe_manager.user_profile_views \(user_id, view_count, updated_at\)\s+` +
+		`SELECT user_id, \$2, NOW\(\) FROM recipe_manager.users WHERE user_id = \$1\s+` +
+		`ON CONFLICT \(user_id\) DO UPDATE\s+SET view_count = user_profile_views.view_count \+ EXCLUDED.view_count`
+
+	mock.ExpectBegin()
+	mock.ExpectExec(upsert).WithArgs(first, int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(upsert).WithArgs(second, int64(5)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := repository.NewProfileViewRepository(db)
+
+	require.NoError(t, repo.AddProfileViews(t.Context(), map[uuid.UUID]int64{second: 5, first: 2}))
+	require.NoError(t, repo.AddProfileViews(t.Context(), nil), "nothing to add runs no query")
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestProfileViewRepositoryGetProfileViewCount(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	counted, uncounted := uuid.New(), uuid.New()
+	query := `SELECT view_count FROM recipe_manager.user_profile_views WHERE user_id = \$1`
+
+	mock.ExpectQuery(query).WithArgs(counted).WillReturnRows(sqlmock.NewRows([]string{"view_count"}).AddRow(42))
+	mock.ExpectQuery(query).WithArgs(uncounted).WillReturnRows(sqlmock.NewRows([]string{"view_count"}))
+
+	repo := repository.NewProfileViewRepository(db)
+
+	count, err := repo.GetProfileViewCount(t.Context(), counted)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), count)
+
+	count, err = repo.GetProfileViewCount(t.Context(), uncounted)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	mock.ExpectClose()
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// RoleRepository stores the role of each user account. Role changes are recorded in the audit
+// trail in the same transaction, attributed to actorID.
+type RoleRepository interface {
+	// GetUserRole returns the role of a user.
+	GetUserRole(ctx context.Context, userID uuid.UUID) (dto.UserRole, error)
+	// SetUserRole assigns a role to a user. Assigning the role the user already has changes
+	// nothing and is not recorded.
+	SetUserRole(ctx context.Context, actorID, userID uuid.UUID, role dto.UserRole) error
+}
+
+// SQLRoleRepository implements RoleRepository using a SQL database.
+type SQLRoleRepository struct {
+	db *sql.DB
+	tx txRunner
+}
+
+// NewRoleRepository creates a new SQLRoleRepository.
+func NewRoleRepository(db *sql.DB) *SQLRoleRepository {
+	return &SQLRoleRepository{db: db, tx: newTxRunner(db)}
+}
+
+// GetUserRole returns the role of a user.
+func (r *SQLRoleRepository) GetUserRole(ctx context.Context, userID uuid.UUID) (dto.UserRole, error) {
+	query := `SELECT role FROM recipe_manager.users WHERE user_id = $1`
+
+	var role string
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrUserNotFound
+		}
+
+		return "", fmt.Errorf("failed to fetch user role: %w", err)
+	}
+
+	return dto.UserRole(role), nil
+}
+
+// SetUserRole assigns a role to a user and records the change in the audit trail.
+func (r *SQLRoleRepository) SetUserRole(ctx context.Context, actorID, userID uuid.UUID, role dto.UserRole) error {
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		var current string
+
+		err := tx.QueryRowContext(ctx, `
+			SELECT role FROM recipe_manager.users WHERE user_id = $1 FOR UPDATE
+		`, userID).Scan(&current)
+		if err != nil {
+			return err
+		}
+
+		if dto.UserRole(current) == role {
+			return nil
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE recipe_manager.users
+			SET role = $2, updated_at = NOW()
+			WHERE user_id = $1
+		`, userID, string(role))
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, actorID, dto.AuditActionRoleChanged, userID, string(role))
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUserNotFound
+		}
+
+		return fmt.Errorf("failed to set user role: %w", err)
+	}
+
+	return nil
+}
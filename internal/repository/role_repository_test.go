@@ -0,0 +1,139 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestRoleRepositoryGetUserRole(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.users`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("moderator"))
+
+		role, err := repository.NewRoleRepository(db).GetUserRole(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, dto.UserRoleModerator, role)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.users`).
+			WithArgs(userID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err = repository.NewRoleRepository(db).GetUserRole(t.Context(), userID)
+		require.ErrorIs(t, err, repository.ErrUserNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestRoleRepositorySetUserRole(t *testing.T) {
+	t.Parallel()
+
+	userID, actorID := uuid.New(), uuid.New()
+
+	t.Run("Success - role changed with audit entry", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.users`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("user"))
+		mock.ExpectExec(`UPDATE recipe_manager.users`).
+			WithArgs(userID, "admin").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO recipe_manager.admin_audit_log`).
+			WithArgs(actorID, "role_changed", userID, "admin").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err = repository.NewRoleRepository(db).SetUserRole(t.Context(), actorID, userID, dto.UserRoleAdmin)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Same role - nothing recorded", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.users`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("admin"))
+		mock.ExpectCommit()
+
+		err = repository.NewRoleRepository(db).SetUserRole(t.Context(), actorID, userID, dto.UserRoleAdmin)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT role FROM recipe_manager.users`).
+			WithArgs(userID).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err = repository.NewRoleRepository(db).SetUserRole(t.Context(), actorID, userID, dto.UserRoleAdmin)
+		require.ErrorIs(t, err, repository.ErrUserNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
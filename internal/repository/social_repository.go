@@ -25,16 +25,60 @@ type SocialRepository interface {
 	GetRecentFollows(ctx context.Context, userID uuid.UUID, limit int) ([]dto.UserSummary, error)
 	GetRecentReviews(ctx context.Context, userID uuid.UUID, limit int) ([]dto.ReviewSummary, error)
 	GetRecentFavorites(ctx context.Context, userID uuid.UUID, limit int) ([]dto.FavoriteSummary, error)
+	UpdateFollowSettings(
+		ctx context.Context,
+		followerID, followeeID uuid.UUID,
+		req *dto.FollowSettingsUpdateRequest,
+	) (*dto.FollowSettings, error)
+	GetFollowSettings(
+		ctx context.Context,
+		followerID uuid.UUID,
+		followeeIDs []uuid.UUID,
+	) (map[uuid.UUID]dto.FollowSettings, error)
+	GetNotificationRecipients(
+		ctx context.Context,
+		followeeID uuid.UUID,
+		event dto.FollowNotificationEvent,
+	) ([]uuid.UUID, error)
+	SetCloseFriend(ctx context.Context, userID, friendID uuid.UUID, closeFriend bool) error
+	GetCloseFriends(ctx context.Context, userID uuid.UUID, limit, offset int) ([]dto.User, int, error)
+	IsCloseFriend(ctx context.Context, userID, friendID uuid.UUID) (bool, error)
+	GetFollowHistory(
+		ctx context.Context,
+		userID uuid.UUID,
+		limit, offset int,
+	) ([]dto.FollowHistoryEntry, int, error)
+	DeleteFollowEventsBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	// CountFollowing returns how many users userID follows.
+	CountFollowing(ctx context.Context, userID uuid.UUID) (int, error)
+	// GetFollowLimit returns the admin override of how many users userID may follow, or nil if
+	// there is none.
+	GetFollowLimit(ctx context.Context, userID uuid.UUID) (*int, error)
+	// SetFollowLimit stores an admin override of how many users userID may follow. nil clears it.
+	SetFollowLimit(ctx context.Context, userID uuid.UUID, limit *int) error
+	// GetNewFollowers returns the active users who started following userID since the given time,
+	// newest first.
+	GetNewFollowers(ctx context.Context, userID uuid.UUID, since time.Time, limit int) ([]dto.UserSummary, error)
+	// GetFollowedRecipes returns the recipes created since the given time by the users userID
+	// follows and is notified of new recipes by, newest first.
+	GetFollowedRecipes(ctx context.Context, userID uuid.UUID, since time.Time, limit int) ([]dto.DigestRecipe, error)
 }
 
+// ErrNotFollowing is returned when a follow relationship does not exist.
+var ErrNotFollowing = errors.New("not following")
+
+// maxScanCapacity caps the slice preallocated for a page so a huge limit cannot force a huge allocation.
+const maxScanCapacity = 1000
+
 // SQLSocialRepository implements SocialRepository using a SQL database.
 type SQLSocialRepository struct {
 	db *sql.DB
+	tx txRunner
 }
 
 // NewSocialRepository creates a new SQLSocialRepository.
 func NewSocialRepository(db *sql.DB) *SQLSocialRepository {
-	return &SQLSocialRepository{db: db}
+	return &SQLSocialRepository{db: db, tx: newTxRunner(db)}
 }
 
 // GetFollowing retrieves the list of users that the specified user follows with pagination.
@@ -44,7 +88,7 @@ func (r *SQLSocialRepository) GetFollowing(
 	limit, offset int,
 ) ([]dto.User, int, error) {
 	// Get total count first
-	totalCount, err := r.countFollowing(ctx, userID)
+	totalCount, err := r.CountFollowing(ctx, userID)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -58,16 +102,17 @@ func (r *SQLSocialRepository) GetFollowing(
 	return users, totalCount, nil
 }
 
-func (r *SQLSocialRepository) countFollowing(ctx context.Context, userID uuid.UUID) (int, error) {
+// CountFollowing returns how many users userID follows.
+func (r *SQLSocialRepository) CountFollowing(ctx context.Context, userID uuid.UUID) (int, error) {
 	query := `
 		SELECT COUNT(*)
 		FROM recipe_manager.user_follows
-		WHERE follower_id = $1
+		WHERE follower_id = $1 AND deleted_at IS NULL
 	`
 
 	var count int
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count following: %w", err)
 	}
@@ -84,23 +129,24 @@ func (r *SQLSocialRepository) fetchFollowing(
 		SELECT u.user_id, u.username, u.email, u.full_name, u.bio, u.is_active, u.created_at, u.updated_at
 		FROM recipe_manager.user_follows uf
 		JOIN recipe_manager.users u ON uf.followee_id = u.user_id
-		WHERE uf.follower_id = $1
+		WHERE uf.follower_id = $1 AND uf.deleted_at IS NULL
 		ORDER BY uf.followed_at DESC
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch following: %w", err)
 	}
 
 	defer func() { _ = rows.Close() }()
 
-	return scanUsers(rows)
+	return scanUsers(rows, limit)
 }
 
-func scanUsers(rows *sql.Rows) ([]dto.User, error) {
-	var users []dto.User
+// scanUsers reads a page of users; limit sizes the slice so large pages are not regrown while scanning.
+func scanUsers(rows *sql.Rows, limit int) ([]dto.User, error) {
+	users := make([]dto.User, 0, min(max(limit, 0), maxScanCapacity))
 
 	for rows.Next() {
 		var (
@@ -170,12 +216,12 @@ func (r *SQLSocialRepository) countFollowers(ctx context.Context, userID uuid.UU
 	query := `
 		SELECT COUNT(*)
 		FROM recipe_manager.user_follows
-		WHERE followee_id = $1
+		WHERE followee_id = $1 AND deleted_at IS NULL
 	`
 
 	var count int
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count followers: %w", err)
 	}
@@ -192,32 +238,33 @@ func (r *SQLSocialRepository) fetchFollowers(
 		SELECT u.user_id, u.username, u.email, u.full_name, u.bio, u.is_active, u.created_at, u.updated_at
 		FROM recipe_manager.user_follows uf
 		JOIN recipe_manager.users u ON uf.follower_id = u.user_id
-		WHERE uf.followee_id = $1
+		WHERE uf.followee_id = $1 AND uf.deleted_at IS NULL
 		ORDER BY uf.followed_at DESC
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch followers: %w", err)
 	}
 
 	defer func() { _ = rows.Close() }()
 
-	return scanUsers(rows)
+	return scanUsers(rows, limit)
 }
 
-// FollowUser creates a follow relationship between follower and followee.
-// Uses ON CONFLICT DO NOTHING for idempotency - duplicate follows are silently ignored.
-// Also handles the case where a database trigger raises an error for existing follows.
+// FollowUser creates a follow relationship between follower and followee. Uses ON CONFLICT DO
+// NOTHING for idempotency - duplicate follows are silently ignored. Also handles the case where a
+// database trigger raises an error for existing follows. A follow after an unfollow starts a new
+// edge, so the unfollowed one stays in the follow history.
 func (r *SQLSocialRepository) FollowUser(ctx context.Context, followerID, followeeID uuid.UUID) error {
 	query := `
 		INSERT INTO recipe_manager.user_follows (follower_id, followee_id, followed_at)
 		VALUES ($1, $2, NOW())
-		ON CONFLICT (follower_id, followee_id) DO NOTHING
+		ON CONFLICT (follower_id, followee_id) WHERE deleted_at IS NULL DO NOTHING
 	`
 
-	_, err := r.db.ExecContext(ctx, query, followerID, followeeID)
+	_, err := executorFor(ctx, r.db).ExecContext(ctx, query, followerID, followeeID)
 	if err != nil {
 		// Handle PostgreSQL trigger that raises "already following" error
 		// This is an idempotent operation - treat existing follows as success
@@ -233,15 +280,17 @@ func (r *SQLSocialRepository) FollowUser(ctx context.Context, followerID, follow
 	return nil
 }
 
-// UnfollowUser removes a follow relationship between follower and followee.
-// This operation is idempotent - deleting a non-existent relationship succeeds.
+// UnfollowUser ends the follow relationship between follower and followee. The edge is kept,
+// marked deleted, for the follow history until DeleteFollowEventsBefore purges it. This operation
+// is idempotent - ending a non-existent relationship succeeds.
 func (r *SQLSocialRepository) UnfollowUser(ctx context.Context, followerID, followeeID uuid.UUID) error {
 	query := `
-		DELETE FROM recipe_manager.user_follows
-		WHERE follower_id = $1 AND followee_id = $2
+		UPDATE recipe_manager.user_follows
+		SET deleted_at = NOW()
+		WHERE follower_id = $1 AND followee_id = $2 AND deleted_at IS NULL
 	`
 
-	_, err := r.db.ExecContext(ctx, query, followerID, followeeID)
+	_, err := executorFor(ctx, r.db).ExecContext(ctx, query, followerID, followeeID)
 	if err != nil {
 		return fmt.Errorf("failed to delete follow relationship: %w", err)
 	}
@@ -258,12 +307,12 @@ func (r *SQLSocialRepository) CheckFollowing(
 	query := `
 		SELECT followed_at
 		FROM recipe_manager.user_follows
-		WHERE follower_id = $1 AND followee_id = $2
+		WHERE follower_id = $1 AND followee_id = $2 AND deleted_at IS NULL
 	`
 
 	var followedAt time.Time
 
-	err := r.db.QueryRowContext(ctx, query, followerID, followeeID).Scan(&followedAt)
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, followerID, followeeID).Scan(&followedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil //nolint:nilnil // nil,nil is valid: no error, just not following
@@ -289,7 +338,7 @@ func (r *SQLSocialRepository) GetRecentRecipes(
 		LIMIT $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch recent recipes: %w", err)
 	}
@@ -331,12 +380,12 @@ func (r *SQLSocialRepository) GetRecentFollows(
 		SELECT u.user_id, u.username, uf.followed_at
 		FROM recipe_manager.user_follows uf
 		JOIN recipe_manager.users u ON uf.followee_id = u.user_id
-		WHERE uf.follower_id = $1 AND u.is_active = true
+		WHERE uf.follower_id = $1 AND uf.deleted_at IS NULL AND u.is_active = true
 		ORDER BY uf.followed_at DESC
 		LIMIT $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch recent follows: %w", err)
 	}
@@ -368,6 +417,79 @@ func scanUserSummaries(rows *sql.Rows) ([]dto.UserSummary, error) {
 	return users, nil
 }
 
+// GetNewFollowers retrieves the active users who started following userID since the given time.
+func (r *SQLSocialRepository) GetNewFollowers(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	limit int,
+) ([]dto.UserSummary, error) {
+	query := `
+		SELECT u.user_id, u.username, uf.followed_at
+		FROM recipe_manager.user_follows uf
+		JOIN recipe_manager.users u ON uf.follower_id = u.user_id
+		WHERE uf.followee_id = $1 AND uf.deleted_at IS NULL AND uf.followed_at >= $2 AND u.is_active = true
+		ORDER BY uf.followed_at DESC
+		LIMIT $3
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch new followers: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanUserSummaries(rows)
+}
+
+// GetFollowedRecipes retrieves the recipes created since the given time by active users userID
+// follows, skipping muted follows and those without new recipe notifications.
+func (r *SQLSocialRepository) GetFollowedRecipes(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	limit int,
+) ([]dto.DigestRecipe, error) {
+	query := `
+		SELECT r.recipe_id, r.title, r.created_at, u.user_id, u.username
+		FROM recipe_manager.user_follows uf
+		JOIN recipe_manager.users u ON uf.followee_id = u.user_id
+		JOIN recipe_manager.recipes r ON r.user_id = uf.followee_id
+		WHERE uf.follower_id = $1 AND uf.deleted_at IS NULL AND NOT uf.muted AND uf.notify_new_recipes
+			AND u.is_active = true AND r.created_at >= $2
+		ORDER BY r.created_at DESC
+		LIMIT $3
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch followed recipes: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var recipes []dto.DigestRecipe
+
+	for rows.Next() {
+		var recipe dto.DigestRecipe
+
+		err = rows.Scan(&recipe.RecipeID, &recipe.Title, &recipe.CreatedAt, &recipe.AuthorID, &recipe.AuthorUsername)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan followed recipe: %w", err)
+		}
+
+		recipes = append(recipes, recipe)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating followed recipes: %w", err)
+	}
+
+	return recipes, nil
+}
+
 // GetRecentReviews retrieves the most recent reviews written by a user.
 func (r *SQLSocialRepository) GetRecentReviews(
 	ctx context.Context,
@@ -382,7 +504,7 @@ func (r *SQLSocialRepository) GetRecentReviews(
 		LIMIT $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch recent reviews: %w", err)
 	}
@@ -435,7 +557,7 @@ func (r *SQLSocialRepository) GetRecentFavorites(
 		LIMIT $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch recent favorites: %w", err)
 	}
@@ -466,3 +588,371 @@ func scanFavoriteSummaries(rows *sql.Rows) ([]dto.FavoriteSummary, error) {
 
 	return favorites, nil
 }
+
+// UpdateFollowSettings changes the notification settings of a follow, keeping fields the request
+// omits. Returns ErrNotFollowing if followerID does not follow followeeID.
+func (r *SQLSocialRepository) UpdateFollowSettings(
+	ctx context.Context,
+	followerID, followeeID uuid.UUID,
+	req *dto.FollowSettingsUpdateRequest,
+) (*dto.FollowSettings, error) {
+	query := `
+		UPDATE recipe_manager.user_follows
+		SET muted = COALESCE($3, muted),
+			notify_new_recipes = COALESCE($4, notify_new_recipes),
+			notify_reviews = COALESCE($5, notify_reviews)
+		WHERE follower_id = $1 AND followee_id = $2 AND deleted_at IS NULL
+		RETURNING muted, notify_new_recipes, notify_reviews
+	`
+
+	var settings dto.FollowSettings
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query, followerID, followeeID, req.Muted, req.NotifyNewRecipes, req.NotifyReviews).
+			Scan(&settings.Muted, &settings.NotifyNewRecipes, &settings.NotifyReviews)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFollowing
+		}
+
+		return nil, fmt.Errorf("failed to update follow settings: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// GetFollowSettings returns the notification settings of followerID's follows of followeeIDs.
+// Users followerID does not follow are absent from the result.
+func (r *SQLSocialRepository) GetFollowSettings(
+	ctx context.Context,
+	followerID uuid.UUID,
+	followeeIDs []uuid.UUID,
+) (map[uuid.UUID]dto.FollowSettings, error) {
+	settings := make(map[uuid.UUID]dto.FollowSettings, len(followeeIDs))
+	if len(followeeIDs) == 0 {
+		return settings, nil
+	}
+
+	query := `
+		SELECT followee_id, muted, notify_new_recipes, notify_reviews
+		FROM recipe_manager.user_follows
+		WHERE follower_id = $1 AND followee_id = ANY($2::uuid[]) AND deleted_at IS NULL
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, followerID, uuidArray(followeeIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch follow settings: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var (
+			followeeID uuid.UUID
+			s          dto.FollowSettings
+		)
+
+		err = rows.Scan(&followeeID, &s.Muted, &s.NotifyNewRecipes, &s.NotifyReviews)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan follow settings: %w", err)
+		}
+
+		settings[followeeID] = s
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating follow settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// GetNotificationRecipients returns the active followers of followeeID whose follow settings
+// allow notifications of event.
+func (r *SQLSocialRepository) GetNotificationRecipients(
+	ctx context.Context,
+	followeeID uuid.UUID,
+	event dto.FollowNotificationEvent,
+) ([]uuid.UUID, error) {
+	var flag string
+
+	switch event {
+	case dto.FollowNotificationEventNewRecipe:
+		flag = "uf.notify_new_recipes"
+	case dto.FollowNotificationEventReview:
+		flag = "uf.notify_reviews"
+	default:
+		return nil, fmt.Errorf("unknown follow notification event %q", event)
+	}
+
+	query := `
+		SELECT uf.follower_id
+		FROM recipe_manager.user_follows uf
+		JOIN recipe_manager.users u ON uf.follower_id = u.user_id
+		WHERE uf.followee_id = $1 AND uf.deleted_at IS NULL AND u.is_active = true AND NOT uf.muted AND ` + flag + `
+		ORDER BY uf.followed_at
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, followeeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch notification recipients: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var recipients []uuid.UUID
+
+	for rows.Next() {
+		var id uuid.UUID
+
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification recipient: %w", err)
+		}
+
+		recipients = append(recipients, id)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating notification recipients: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// SetCloseFriend marks or unmarks friendID as a close friend of userID. The flag lives on friendID's
+// follow of userID; returns ErrNotFollowing if friendID does not follow userID.
+func (r *SQLSocialRepository) SetCloseFriend(
+	ctx context.Context,
+	userID, friendID uuid.UUID,
+	closeFriend bool,
+) error {
+	query := `
+		UPDATE recipe_manager.user_follows
+		SET is_close_friend = $3
+		WHERE follower_id = $1 AND followee_id = $2 AND deleted_at IS NULL
+	`
+
+	var affected int64
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query, friendID, userID, closeFriend)
+		if err != nil {
+			return err
+		}
+
+		affected, err = result.RowsAffected()
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update close friend: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrNotFollowing
+	}
+
+	return nil
+}
+
+// GetCloseFriends retrieves the close friends of userID, most recent followers first, with pagination.
+func (r *SQLSocialRepository) GetCloseFriends(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset int,
+) ([]dto.User, int, error) {
+	countQuery := `
+		SELECT COUNT(*)
+		FROM recipe_manager.user_follows
+		WHERE followee_id = $1 AND is_close_friend AND deleted_at IS NULL
+	`
+
+	var totalCount int
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, countQuery, userID).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count close friends: %w", err)
+	}
+
+	query := `
+		SELECT u.user_id, u.username, u.email, u.full_name, u.bio, u.is_active, u.created_at, u.updated_at
+		FROM recipe_manager.user_follows uf
+		JOIN recipe_manager.users u ON uf.follower_id = u.user_id
+		WHERE uf.followee_id = $1 AND uf.is_close_friend AND uf.deleted_at IS NULL
+		ORDER BY uf.followed_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch close friends: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	users, err := scanUsers(rows, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return users, totalCount, nil
+}
+
+// IsCloseFriend reports whether userID has marked friendID as a close friend.
+func (r *SQLSocialRepository) IsCloseFriend(ctx context.Context, userID, friendID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM recipe_manager.user_follows
+			WHERE follower_id = $1 AND followee_id = $2 AND is_close_friend AND deleted_at IS NULL
+		)
+	`
+
+	var closeFriend bool
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, friendID, userID).Scan(&closeFriend)
+	if err != nil {
+		return false, fmt.Errorf("failed to check close friend: %w", err)
+	}
+
+	return closeFriend, nil
+}
+
+// followHistory lists a follow event for every edge and an unfollow event for every deleted one.
+const followHistory = `
+	SELECT follower_id, followee_id, 'FOLLOW' AS event, followed_at AS occurred_at, 0 AS tiebreak
+	FROM recipe_manager.user_follows
+	UNION ALL
+	SELECT follower_id, followee_id, 'UNFOLLOW', deleted_at, 1
+	FROM recipe_manager.user_follows
+	WHERE deleted_at IS NOT NULL
+`
+
+// GetFollowHistory returns a page of the follows and unfollows of userID, newest first, and the
+// total number of events. Events are read from the follow edges, which unfollows keep.
+func (r *SQLSocialRepository) GetFollowHistory(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset int,
+) ([]dto.FollowHistoryEntry, int, error) {
+	countQuery := `
+		SELECT COUNT(*) + COUNT(deleted_at)
+		FROM recipe_manager.user_follows
+		WHERE followee_id = $1
+	`
+
+	var totalCount int
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, countQuery, userID).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count follow history: %w", err)
+	}
+
+	query := `
+		SELECT e.follower_id, u.username, e.event, e.occurred_at
+		FROM (` + followHistory + `) e
+		JOIN recipe_manager.users u ON e.follower_id = u.user_id
+		WHERE e.followee_id = $1
+		ORDER BY e.occurred_at DESC, e.tiebreak DESC, e.follower_id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch follow history: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	events := make([]dto.FollowHistoryEntry, 0, min(limit, maxScanCapacity))
+
+	for rows.Next() {
+		var event dto.FollowHistoryEntry
+
+		err = rows.Scan(&event.UserID, &event.Username, &event.Event, &event.OccurredAt)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan follow history: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate follow history: %w", err)
+	}
+
+	return events, totalCount, nil
+}
+
+// DeleteFollowEventsBefore purges the edges unfollowed before cutoff, and with them their follow
+// and unfollow events, returning how many were removed. Current follows are never purged.
+func (r *SQLSocialRepository) DeleteFollowEventsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	query := `
+		DELETE FROM recipe_manager.user_follows
+		WHERE deleted_at < $1
+	`
+
+	result, err := executorFor(ctx, r.db).ExecContext(ctx, query, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete follow history: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted follow history: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// GetFollowLimit returns the admin override of how many users userID may follow, or nil if there
+// is none.
+func (r *SQLSocialRepository) GetFollowLimit(ctx context.Context, userID uuid.UUID) (*int, error) {
+	query := `
+		SELECT max_following
+		FROM recipe_manager.user_follow_limits
+		WHERE user_id = $1
+	`
+
+	var limit int
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&limit)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil //nolint:nilnil // no override
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follow limit: %w", err)
+	}
+
+	return &limit, nil
+}
+
+// SetFollowLimit stores an admin override of how many users userID may follow; nil clears it.
+func (r *SQLSocialRepository) SetFollowLimit(ctx context.Context, userID uuid.UUID, limit *int) error {
+	query := `DELETE FROM recipe_manager.user_follow_limits WHERE user_id = $1`
+	args := []any{userID}
+
+	if limit != nil {
+		query = `
+			INSERT INTO recipe_manager.user_follow_limits (user_id, max_following)
+			VALUES ($1, $2)
+			ON CONFLICT (user_id) DO UPDATE SET
+				max_following = EXCLUDED.max_following,
+				updated_at = NOW()
+		`
+		args = append(args, *limit)
+	}
+
+	_, err := executorFor(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to set follow limit: %w", err)
+	}
+
+	return nil
+}
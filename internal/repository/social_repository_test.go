@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
 )
 
@@ -20,7 +21,7 @@ const (
 	selectRecentFollowsQuery = `SELECT u.user_id, u.username, uf.followed_at ` +
 		`FROM recipe_manager.user_follows uf ` +
 		`JOIN recipe_manager.users u ON uf.followee_id = u.user_id ` +
-		`WHERE uf.follower_id = \$1 AND u.is_active = true ` +
+		`WHERE uf.follower_id = \$1 AND uf.deleted_at IS NULL AND u.is_active = true ` +
 		`ORDER BY uf.followed_at DESC LIMIT \$2`
 	selectRecentReviewsQuery = `SELECT review_id, recipe_id, rating, comment, created_at ` +
 		`FROM recipe_manager.reviews WHERE user_id = \$1 ` +
@@ -495,3 +496,394 @@ func TestSocialRepositoryScanErrors(t *testing.T) {
 		assert.Nil(t, favorites)
 	})
 }
+
+func TestSocialRepositoryUnfollowUser(t *testing.T) {
+	t.Parallel()
+
+	followerID := uuid.New()
+	followeeID := uuid.New()
+
+	tests := []struct {
+		name     string
+		affected int64
+	}{
+		{name: "Success - marks the edge deleted", affected: 1},
+		{name: "Success - not following changes nothing", affected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			defer func() {
+				require.NoError(t, db.Close())
+			}()
+
+			repo := repository.NewSocialRepository(db)
+
+			mock.ExpectExec(`UPDATE recipe_manager.user_follows\s+SET deleted_at = NOW\(\)\s+`+
+				`WHERE follower_id = \$1 AND followee_id = \$2 AND deleted_at IS NULL`).
+				WithArgs(followerID, followeeID).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			require.NoError(t, repo.UnfollowUser(t.Context(), followerID, followeeID))
+			require.NoError(t, mock.ExpectationsWereMet())
+			mock.ExpectClose()
+		})
+	}
+}
+
+func TestSocialRepositoryUpdateFollowSettings(t *testing.T) {
+	t.Parallel()
+
+	followerID := uuid.New()
+	followeeID := uuid.New()
+	muted := true
+
+	t.Run("Success - returns merged settings", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewSocialRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE recipe_manager.user_follows\s+SET muted = COALESCE\(\$3, muted\)`).
+			WithArgs(followerID, followeeID, &muted, nil, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"muted", "notify_new_recipes", "notify_reviews"}).
+				AddRow(true, true, false))
+		mock.ExpectCommit()
+
+		settings, err := repo.UpdateFollowSettings(t.Context(), followerID, followeeID,
+			&dto.FollowSettingsUpdateRequest{Muted: &muted})
+		require.NoError(t, err)
+		assert.Equal(t, dto.FollowSettings{Muted: true, NotifyNewRecipes: true}, *settings)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Not following", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewSocialRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE recipe_manager.user_follows`).
+			WillReturnRows(sqlmock.NewRows([]string{"muted", "notify_new_recipes", "notify_reviews"}))
+		mock.ExpectRollback()
+
+		_, err = repo.UpdateFollowSettings(t.Context(), followerID, followeeID,
+			&dto.FollowSettingsUpdateRequest{Muted: &muted})
+		require.ErrorIs(t, err, repository.ErrNotFollowing)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+}
+
+func TestSocialRepositoryGetFollowSettings(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewSocialRepository(db)
+	followerID := uuid.New()
+	followed := uuid.New()
+	notFollowed := uuid.New()
+
+	mock.ExpectQuery(`SELECT followee_id, muted, notify_new_recipes, notify_reviews .* ANY\(\$2::uuid\[\]\)`).
+		WithArgs(followerID, "{"+followed.String()+","+notFollowed.String()+"}").
+		WillReturnRows(sqlmock.NewRows([]string{"followee_id", "muted", "notify_new_recipes", "notify_reviews"}).
+			AddRow(followed, true, true, true))
+
+	settings, err := repo.GetFollowSettings(t.Context(), followerID, []uuid.UUID{followed, notFollowed})
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]dto.FollowSettings{
+		followed: {Muted: true, NotifyNewRecipes: true, NotifyReviews: true},
+	}, settings)
+
+	// No followees skips the query
+	settings, err = repo.GetFollowSettings(t.Context(), followerID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, settings)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSocialRepositoryGetNotificationRecipients(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewSocialRepository(db)
+	followeeID := uuid.New()
+	follower := uuid.New()
+
+	mock.ExpectQuery(`SELECT uf.follower_id .* NOT uf.muted AND uf.notify_reviews`).
+		WithArgs(followeeID).
+		WillReturnRows(sqlmock.NewRows([]string{"follower_id"}).AddRow(follower))
+
+	recipients, err := repo.GetNotificationRecipients(t.Context(), followeeID, dto.FollowNotificationEventReview)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{follower}, recipients)
+
+	_, err = repo.GetNotificationRecipients(t.Context(), followeeID, "unknown")
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSocialRepositorySetCloseFriend(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	friendID := uuid.New()
+
+	tests := []struct {
+		name     string
+		affected int64
+		wantErr  error
+	}{
+		{name: "Success", affected: 1},
+		{name: "Not a follower", affected: 0, wantErr: repository.ErrNotFollowing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			defer func() {
+				require.NoError(t, db.Close())
+			}()
+
+			repo := repository.NewSocialRepository(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE recipe_manager.user_follows\s+SET is_close_friend = \$3`).
+				WithArgs(friendID, userID, true).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			mock.ExpectCommit()
+
+			err = repo.SetCloseFriend(t.Context(), userID, friendID, true)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+			mock.ExpectClose()
+		})
+	}
+}
+
+func TestSocialRepositoryGetCloseFriends(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewSocialRepository(db)
+	userID := uuid.New()
+	friendID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM recipe_manager.user_follows WHERE followee_id = \$1 AND is_close_friend`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`ON uf.follower_id = u.user_id\s+WHERE uf.followee_id = \$1 AND uf.is_close_friend`).
+		WithArgs(userID, 20, 0).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"user_id", "username", "email", "full_name", "bio", "is_active", "created_at", "updated_at",
+		}).AddRow(friendID.String(), "friend", nil, nil, nil, true, now, now))
+
+	users, total, err := repo.GetCloseFriends(t.Context(), userID, 20, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, users, 1)
+	assert.Equal(t, "friend", users[0].Username)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSocialRepositoryIsCloseFriend(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewSocialRepository(db)
+	userID := uuid.New()
+	friendID := uuid.New()
+
+	mock.ExpectQuery(`SELECT EXISTS`).
+		WithArgs(friendID, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	closeFriend, err := repo.IsCloseFriend(t.Context(), userID, friendID)
+	require.NoError(t, err)
+	assert.True(t, closeFriend)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSocialRepositoryGetFollowHistory(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewSocialRepository(db)
+	userID := uuid.New()
+	followerID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) \+ COUNT\(deleted_at\) FROM recipe_manager.user_follows WHERE followee_id = \$1`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(`SELECT follower_id, followee_id, 'UNFOLLOW', deleted_at, 1\s+`+
+		`FROM recipe_manager.user_follows\s+WHERE deleted_at IS NOT NULL`).
+		WithArgs(userID, 20, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"follower_id", "username", "event", "occurred_at"}).
+			AddRow(followerID.String(), "bob", "UNFOLLOW", now).
+			AddRow(followerID.String(), "bob", "FOLLOW", now.Add(-time.Hour)))
+
+	events, total, err := repo.GetFollowHistory(t.Context(), userID, 20, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, events, 2)
+	assert.Equal(t, dto.FollowEventUnfollow, events[0].Event)
+	assert.Equal(t, "bob", events[0].Username)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSocialRepositoryDeleteFollowEventsBefore(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewSocialRepository(db)
+	cutoff := time.Now().AddDate(-1, 0, 0)
+
+	mock.ExpectExec(`DELETE FROM recipe_manager.user_follows\s+WHERE deleted_at < \$1`).
+		WithArgs(cutoff).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+
+	deleted, err := repo.DeleteFollowEventsBefore(t.Context(), cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), deleted)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSocialRepositoryGetNewFollowers(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewSocialRepository(db)
+	userID := uuid.New()
+	followerID := uuid.New()
+	since := time.Now().AddDate(0, 0, -7)
+
+	mock.ExpectQuery(`WHERE uf.followee_id = \$1 AND uf.deleted_at IS NULL `+
+		`AND uf.followed_at >= \$2 AND u.is_active = true`).
+		WithArgs(userID, since, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "username", "followed_at"}).
+			AddRow(followerID.String(), "remy", time.Now()))
+
+	followers, err := repo.GetNewFollowers(t.Context(), userID, since, 10)
+	require.NoError(t, err)
+	require.Len(t, followers, 1)
+	assert.Equal(t, "remy", followers[0].Username)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSocialRepositoryGetFollowedRecipes(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewSocialRepository(db)
+	userID := uuid.New()
+	authorID := uuid.New()
+	since := time.Now().AddDate(0, 0, -7)
+
+	mock.ExpectQuery(`WHERE uf.follower_id = \$1 AND uf.deleted_at IS NULL AND NOT uf.muted AND uf.notify_new_recipes`).
+		WithArgs(userID, since, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"recipe_id", "title", "created_at", "user_id", "username"}).
+			AddRow(7, "Ratatouille", time.Now(), authorID.String(), "remy"))
+
+	recipes, err := repo.GetFollowedRecipes(t.Context(), userID, since, 10)
+	require.NoError(t, err)
+	require.Len(t, recipes, 1)
+	assert.Equal(t, 7, recipes[0].RecipeID)
+	assert.Equal(t, authorID.String(), recipes[0].AuthorID)
+
+	mock.ExpectQuery(`AND r.created_at >= \$2`).WillReturnError(errDBMock)
+
+	_, err = repo.GetFollowedRecipes(t.Context(), userID, since, 10)
+	require.ErrorIs(t, err, errDBMock)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
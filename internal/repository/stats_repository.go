@@ -0,0 +1,325 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// StatsMetric identifies a time series of the admin statistics.
+type StatsMetric string
+
+// Admin statistics time series.
+const (
+	StatsMetricSignups       StatsMetric = "signups"
+	StatsMetricActiveUsers   StatsMetric = "active_users"
+	StatsMetricDeactivations StatsMetric = "deactivations"
+	StatsMetricNewFollows    StatsMetric = "new_follows"
+)
+
+// ErrUnknownStatsMetric is returned for a StatsMetric without a query.
+var ErrUnknownStatsMetric = errors.New("unknown stats metric")
+
+// StatsRepository runs the aggregate queries behind the admin statistics dashboard and the
+// follower insights of creators.
+type StatsRepository interface {
+	// GetStatsTotals returns the current user and follow counts.
+	GetStatsTotals(ctx context.Context) (*dto.AdminStatsTotals, error)
+	// CountByBucket counts metric in [from, to), keyed by bucket start. Empty buckets are omitted.
+	CountByBucket(
+		ctx context.Context,
+		metric StatsMetric,
+		from, to time.Time,
+		interval dto.StatsInterval,
+	) (map[time.Time]int, error)
+	// CountPreferenceAdopters returns the number of users who saved each preference category.
+	CountPreferenceAdopters(ctx context.Context) (map[dto.PreferenceCategory]int, error)
+	// GetFollowerTotals returns the current follower counts of userID.
+	GetFollowerTotals(ctx context.Context, userID uuid.UUID) (*dto.FollowerInsightsTotals, error)
+	// CountFollowersByCountry counts the current followers of userID who show their location, keyed
+	// by country. Followers without a country are omitted.
+	CountFollowersByCountry(ctx context.Context, userID uuid.UUID) (map[string]int, error)
+	// CountFollowEventsByBucket counts the follow history events of the followers of userID in
+	// [from, to), keyed by bucket start. Empty buckets are omitted.
+	CountFollowEventsByBucket(
+		ctx context.Context,
+		userID uuid.UUID,
+		event dto.FollowEvent,
+		from, to time.Time,
+		interval dto.StatsInterval,
+	) (map[time.Time]int, error)
+}
+
+// statsBucketQueries group each metric by bucket. $1 is the date_trunc field and [$2, $3) the range.
+// Timestamps are bucketed in UTC.
+var statsBucketQueries = map[StatsMetric]string{
+	StatsMetricSignups: `
+		SELECT date_trunc($1, created_at AT TIME ZONE 'UTC') AS bucket, COUNT(*)
+		FROM recipe_manager.users
+		WHERE created_at >= $2 AND created_at < $3
+		GROUP BY bucket
+	`,
+	StatsMetricActiveUsers: `
+		SELECT date_trunc($1, changed_at AT TIME ZONE 'UTC') AS bucket, COUNT(DISTINCT user_id)
+		FROM recipe_manager.user_change_log
+		WHERE changed_at >= $2 AND changed_at < $3
+		GROUP BY bucket
+	`,
+	StatsMetricDeactivations: `
+		SELECT date_trunc($1, changed_at AT TIME ZONE 'UTC') AS bucket, COUNT(*)
+		FROM recipe_manager.user_change_log
+		WHERE change_type = 'USER_DEACTIVATED' AND changed_at >= $2 AND changed_at < $3
+		GROUP BY bucket
+	`,
+	StatsMetricNewFollows: `
+		SELECT date_trunc($1, followed_at AT TIME ZONE 'UTC') AS bucket, COUNT(*)
+		FROM recipe_manager.user_follows
+		WHERE followed_at >= $2 AND followed_at < $3
+		GROUP BY bucket
+	`,
+}
+
+// SQLStatsRepository implements StatsRepository using a SQL database.
+type SQLStatsRepository struct {
+	db *sql.DB
+}
+
+// NewStatsRepository creates a new SQLStatsRepository.
+func NewStatsRepository(db *sql.DB) *SQLStatsRepository {
+	return &SQLStatsRepository{db: db}
+}
+
+// GetStatsTotals returns the current user and follow counts.
+func (r *SQLStatsRepository) GetStatsTotals(ctx context.Context) (*dto.AdminStatsTotals, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM recipe_manager.users) AS total_users,
+			(SELECT COUNT(*) FROM recipe_manager.users WHERE is_active = true) AS active_users,
+			(SELECT COUNT(*) FROM recipe_manager.users WHERE is_active = false) AS inactive_users,
+			(SELECT COUNT(*) FROM recipe_manager.user_follows WHERE deleted_at IS NULL) AS follow_edges
+	`
+
+	var totals dto.AdminStatsTotals
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query).Scan(
+		&totals.TotalUsers,
+		&totals.ActiveUsers,
+		&totals.InactiveUsers,
+		&totals.FollowEdges,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stats totals: %w", err)
+	}
+
+	return &totals, nil
+}
+
+// CountByBucket counts metric in [from, to), keyed by bucket start.
+func (r *SQLStatsRepository) CountByBucket(
+	ctx context.Context,
+	metric StatsMetric,
+	from, to time.Time,
+	interval dto.StatsInterval,
+) (map[time.Time]int, error) {
+	query, ok := statsBucketQueries[metric]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownStatsMetric, metric)
+	}
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, string(interval), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s by %s: %w", metric, interval, err)
+	}
+
+	return scanBuckets(rows, string(metric))
+}
+
+// scanBuckets reads (bucket, count) rows keyed by bucket start in UTC, closing rows. what names
+// the series in errors.
+func scanBuckets(rows *sql.Rows, what string) (map[time.Time]int, error) {
+	defer func() { _ = rows.Close() }()
+
+	counts := make(map[time.Time]int)
+
+	for rows.Next() {
+		var (
+			bucket time.Time
+			count  int
+		)
+
+		err := rows.Scan(&bucket, &count)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s bucket: %w", what, err)
+		}
+
+		counts[bucket.UTC()] = count
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate %s buckets: %w", what, err)
+	}
+
+	return counts, nil
+}
+
+// CountPreferenceAdopters returns the number of users who saved each preference category, in
+// either storage layout.
+func (r *SQLStatsRepository) CountPreferenceAdopters(ctx context.Context) (map[dto.PreferenceCategory]int, error) {
+	query := `
+		SELECT category, COUNT(DISTINCT user_id) FROM (
+			SELECT 'notification' AS category, user_id FROM recipe_manager.user_notification_preferences
+			UNION ALL SELECT 'display', user_id FROM recipe_manager.user_display_preferences
+			UNION ALL SELECT 'privacy', user_id FROM recipe_manager.user_privacy_preferences
+			UNION ALL SELECT 'accessibility', user_id FROM recipe_manager.user_accessibility_preferences
+			UNION ALL SELECT 'language', user_id FROM recipe_manager.user_language_preferences
+			UNION ALL SELECT 'security', user_id FROM recipe_manager.user_security_preferences
+			UNION ALL SELECT 'social', user_id FROM recipe_manager.user_social_preferences
+			UNION ALL SELECT 'sound', user_id FROM recipe_manager.user_sound_preferences
+			UNION ALL SELECT 'theme', user_id FROM recipe_manager.user_theme_preferences
+			UNION ALL SELECT category, user_id FROM recipe_manager.user_preference_documents
+		) saved
+		GROUP BY category
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query preference adoption: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	adopters := make(map[dto.PreferenceCategory]int, len(dto.ValidPreferenceCategories))
+
+	for rows.Next() {
+		var (
+			category dto.PreferenceCategory
+			count    int
+		)
+
+		err = rows.Scan(&category, &count)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan preference adoption: %w", err)
+		}
+
+		adopters[category] = count
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate preference adoption: %w", err)
+	}
+
+	return adopters, nil
+}
+
+// GetFollowerTotals returns the current follower counts of userID. Followers without saved privacy
+// preferences have the default public profile.
+func (r *SQLStatsRepository) GetFollowerTotals(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.FollowerInsightsTotals, error) {
+	query := `
+		SELECT
+			COUNT(*) AS followers,
+			COUNT(*) FILTER (WHERE COALESCE(p.profile_visibility, 'PUBLIC') = 'PUBLIC') AS public_profiles
+		FROM recipe_manager.user_follows f
+		LEFT JOIN recipe_manager.user_privacy_preferences p ON p.user_id = f.follower_id
+		WHERE f.followee_id = $1 AND f.deleted_at IS NULL
+	`
+
+	var totals dto.FollowerInsightsTotals
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, userID).
+		Scan(&totals.Followers, &totals.PublicProfileFollowers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query follower totals: %w", err)
+	}
+
+	return &totals, nil
+}
+
+// CountFollowersByCountry counts the current followers of userID who show their location, keyed
+// by country.
+func (r *SQLStatsRepository) CountFollowersByCountry(ctx context.Context, userID uuid.UUID) (map[string]int, error) {
+	query := `
+		SELECT u.country, COUNT(*)
+		FROM recipe_manager.user_follows f
+		JOIN recipe_manager.users u ON u.user_id = f.follower_id
+		JOIN recipe_manager.user_privacy_preferences p ON p.user_id = f.follower_id
+		WHERE f.followee_id = $1 AND f.deleted_at IS NULL AND u.country IS NOT NULL AND p.show_location
+		GROUP BY u.country
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query follower countries: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	counts := make(map[string]int)
+
+	for rows.Next() {
+		var (
+			country string
+			count   int
+		)
+
+		err = rows.Scan(&country, &count)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan follower country: %w", err)
+		}
+
+		counts[country] = count
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate follower countries: %w", err)
+	}
+
+	return counts, nil
+}
+
+// followEventColumns holds, for each follow history event, the column of the follow edge that
+// records when it occurred.
+var followEventColumns = map[dto.FollowEvent]string{
+	dto.FollowEventFollow:   "followed_at",
+	dto.FollowEventUnfollow: "deleted_at",
+}
+
+// CountFollowEventsByBucket counts the follow history events of the followers of userID in
+// [from, to), keyed by bucket start. Events are read from the follow edges, which unfollows keep.
+func (r *SQLStatsRepository) CountFollowEventsByBucket(
+	ctx context.Context,
+	userID uuid.UUID,
+	event dto.FollowEvent,
+	from, to time.Time,
+	interval dto.StatsInterval,
+) (map[time.Time]int, error) {
+	column, ok := followEventColumns[event]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownStatsMetric, event)
+	}
+
+	query := `
+		SELECT date_trunc($1, ` + column + ` AT TIME ZONE 'UTC') AS bucket, COUNT(*)
+		FROM recipe_manager.user_follows
+		WHERE followee_id = $2 AND ` + column + ` >= $3 AND ` + column + ` < $4
+		GROUP BY bucket
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, string(interval), userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s events by %s: %w", event, interval, err)
+	}
+
+	return scanBuckets(rows, string(event))
+}
@@ -0,0 +1,185 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+func TestStatsRepositoryCountByBucket(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+
+	t.Run("Success - returns counts by bucket", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewStatsRepository(db)
+
+		rows := sqlmock.NewRows([]string{"bucket", "count"}).
+			AddRow(from, 3).
+			AddRow(from.AddDate(0, 0, 7), 1)
+
+		mock.ExpectQuery(`SELECT date_trunc\(\$1, followed_at AT TIME ZONE 'UTC'\) AS bucket, COUNT\(\*\) `+
+			`FROM recipe_manager.user_follows`).
+			WithArgs("week", from, to).
+			WillReturnRows(rows)
+
+		counts, err := repo.CountByBucket(t.Context(), repository.StatsMetricNewFollows, from, to, dto.StatsIntervalWeek)
+		require.NoError(t, err)
+		assert.Equal(t, map[time.Time]int{from: 3, from.AddDate(0, 0, 7): 1}, counts)
+		mock.ExpectClose()
+	})
+
+	t.Run("Error - unknown metric", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewStatsRepository(db)
+
+		mock.ExpectClose()
+
+		_, err = repo.CountByBucket(t.Context(), "unknown", from, to, dto.StatsIntervalDay)
+		require.ErrorIs(t, err, repository.ErrUnknownStatsMetric)
+	})
+
+	t.Run("Error - database failure", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewStatsRepository(db)
+
+		mock.ExpectQuery(`FROM recipe_manager.users`).WillReturnError(errDBMock)
+		mock.ExpectClose()
+
+		_, err = repo.CountByBucket(t.Context(), repository.StatsMetricSignups, from, to, dto.StatsIntervalDay)
+		require.ErrorIs(t, err, errDBMock)
+	})
+}
+
+func TestStatsRepositoryFollowerInsights(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	from := time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	t.Run("Success - follower totals", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewStatsRepository(db)
+
+		mock.ExpectQuery(`LEFT JOIN recipe_manager.user_privacy_preferences p ON p.user_id = f.follower_id`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"followers", "public_profiles"}).AddRow(8, 6))
+
+		totals, err := repo.GetFollowerTotals(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, dto.FollowerInsightsTotals{Followers: 8, PublicProfileFollowers: 6}, *totals)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Success - followers by country", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewStatsRepository(db)
+
+		mock.ExpectQuery(`WHERE f.followee_id = \$1 AND f.deleted_at IS NULL ` +
+			`AND u.country IS NOT NULL AND p.show_location\s+` +
+			`GROUP BY u.country`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"country", "count"}).AddRow("JP", 4).AddRow("KR", 1))
+
+		counts, err := repo.CountFollowersByCountry(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"JP": 4, "KR": 1}, counts)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Success - follow events by bucket", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewStatsRepository(db)
+
+		mock.ExpectQuery(`SELECT date_trunc\(\$1, deleted_at AT TIME ZONE 'UTC'\) AS bucket, COUNT\(\*\)\s+`+
+			`FROM recipe_manager.user_follows\s+WHERE followee_id = \$2 AND deleted_at >= \$3`).
+			WithArgs("day", userID, from, to).
+			WillReturnRows(sqlmock.NewRows([]string{"bucket", "count"}).AddRow(from.AddDate(0, 0, 2), 3))
+
+		counts, err := repo.CountFollowEventsByBucket(t.Context(), userID, dto.FollowEventUnfollow, from, to,
+			dto.StatsIntervalDay)
+		require.NoError(t, err)
+		assert.Equal(t, map[time.Time]int{from.AddDate(0, 0, 2): 3}, counts)
+		require.NoError(t, mock.ExpectationsWereMet())
+		mock.ExpectClose()
+	})
+
+	t.Run("Error - database failure", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewStatsRepository(db)
+
+		mock.ExpectQuery(`FROM recipe_manager.user_follows f`).WillReturnError(errDBMock)
+		mock.ExpectClose()
+
+		_, err = repo.GetFollowerTotals(t.Context(), userID)
+		require.ErrorIs(t, err, errDBMock)
+	})
+}
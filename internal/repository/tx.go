@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// PostgreSQL error codes of transactions that were aborted and may succeed when retried.
+const (
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+)
+
+// Transaction retry defaults.
+const (
+	// defaultTxMaxAttempts is the number of times a transaction is run before giving up.
+	defaultTxMaxAttempts = 5
+	// defaultTxBaseDelay is the backoff before the first retry; it doubles for each retry.
+	defaultTxBaseDelay = 10 * time.Millisecond
+	// defaultTxMaxDelay caps the backoff between retries.
+	defaultTxMaxDelay = 500 * time.Millisecond
+)
+
+// ErrTxRetriesExhausted is returned when a transaction still fails with a retryable error
+// after the last attempt.
+var ErrTxRetriesExhausted = errors.New("transaction retries exhausted")
+
+// TxManager runs a unit of work spanning several repository calls in one transaction. Repository
+// calls made with the context passed to fn join the transaction, so their writes commit together
+// or not at all.
+type TxManager interface {
+	// WithinTx runs fn in a transaction and commits it when fn returns nil. fn may be called several
+	// times when a transaction is retried, so it must not have side effects outside the repositories.
+	// Called inside another unit of work, fn joins it.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// SQLTxManager implements TxManager using a SQL database.
+type SQLTxManager struct {
+	tx txRunner
+}
+
+// NewTxManager creates a new SQLTxManager.
+func NewTxManager(db *sql.DB) *SQLTxManager {
+	return &SQLTxManager{tx: newTxRunner(db)}
+}
+
+// WithinTx runs fn in a transaction carried by its context.
+func (m *SQLTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.tx.run(ctx, func(tx *sql.Tx) error {
+		return fn(withTx(ctx, m.tx.db, tx))
+	})
+}
+
+// executor runs statements on a database or inside a transaction.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// txKey is the context key of the transaction a unit of work runs in.
+type txKey struct{}
+
+// ctxTx is a transaction carried by a context, with the database it was begun on.
+type ctxTx struct {
+	db *sql.DB
+	tx *sql.Tx
+}
+
+func withTx(ctx context.Context, db *sql.DB, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, ctxTx{db: db, tx: tx})
+}
+
+// txFrom returns the transaction ctx carries on db, or nil outside a unit of work.
+func txFrom(ctx context.Context, db *sql.DB) *sql.Tx {
+	current, ok := ctx.Value(txKey{}).(ctxTx)
+	if !ok || current.db != db {
+		return nil
+	}
+
+	return current.tx
+}
+
+// executorFor returns the transaction ctx carries on db, or db itself outside a unit of work.
+func executorFor(ctx context.Context, db *sql.DB) executor {
+	if tx := txFrom(ctx, db); tx != nil {
+		return tx
+	}
+
+	return db
+}
+
+// txRunner runs repository writes in transactions, retrying those aborted by serialization
+// failures or deadlocks with jittered exponential backoff.
+type txRunner struct {
+	db          *sql.DB
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+}
+
+func newTxRunner(db *sql.DB) txRunner {
+	return txRunner{
+		db:          db,
+		maxAttempts: defaultTxMaxAttempts,
+		baseDelay:   defaultTxBaseDelay,
+		maxDelay:    defaultTxMaxDelay,
+	}
+}
+
+// run executes fn in a transaction and commits it. fn may be called several times, so it must
+// not have side effects outside the transaction. Errors returned by fn are passed through
+// unchanged unless they are retryable. Inside a unit of work fn runs in its transaction once,
+// leaving the commit and any retry to the unit of work.
+func (r txRunner) run(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	if tx := txFrom(ctx, r.db); tx != nil {
+		return fn(tx)
+	}
+
+	var err error
+
+	for attempt := range r.maxAttempts {
+		if attempt > 0 {
+			err = r.backoff(ctx, attempt)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = r.attempt(ctx, fn)
+		if !isRetryable(err) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("%w after %d attempts: %w", ErrTxRetriesExhausted, r.maxAttempts, err)
+}
+
+func (r txRunner) attempt(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	err = fn(tx)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			return errors.Join(err, fmt.Errorf("failed to roll back transaction: %w", rollbackErr))
+		}
+
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// backoff waits before the given retry, returning early with the context's error.
+func (r txRunner) backoff(ctx context.Context, attempt int) error {
+	delay := min(r.baseDelay<<(attempt-1), r.maxDelay)
+	delay = delay/2 + rand.N(delay/2+1) //nolint:gosec // jitter does not need a secure source
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("transaction retry cancelled: %w", ctx.Err())
+	}
+}
+
+// isRetryable reports whether err aborted a transaction that may succeed when run again.
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == sqlStateSerializationFailure || pgErr.Code == sqlStateDeadlockDetected
+}
@@ -0,0 +1,184 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+const (
+	insertFollowQuery = `INSERT INTO recipe_manager.user_follows `
+	closeFriendQuery  = `UPDATE recipe_manager.user_follows\s+SET is_close_friend`
+	deleteFollowQuery = `UPDATE recipe_manager.user_follows\s+SET deleted_at`
+)
+
+func TestSQLRepositoryWritesRetrySerializationFailures(t *testing.T) {
+	t.Parallel()
+
+	userID, friendID := uuid.New(), uuid.New()
+	serialization := &pgconn.PgError{Code: "40001", Message: "could not serialize access"}
+	deadlock := &pgconn.PgError{Code: "40P01", Message: "deadlock detected"}
+
+	tests := []struct {
+		name        string
+		failures    []error
+		expectedErr error
+	}{
+		{
+			name:     "Success - first attempt",
+			failures: nil,
+		},
+		{
+			name:     "Success - after serialization failure and deadlock",
+			failures: []error{serialization, deadlock},
+		},
+		{
+			name:        "Error - retries exhausted",
+			failures:    []error{serialization, serialization, serialization, serialization, serialization},
+			expectedErr: repository.ErrTxRetriesExhausted,
+		},
+		{
+			name:        "Error - other errors are not retried",
+			failures:    []error{&pgconn.PgError{Code: "23503", Message: "foreign key violation"}},
+			expectedErr: &pgconn.PgError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			defer func() {
+				require.NoError(t, db.Close())
+			}()
+
+			repo := repository.NewSocialRepository(db)
+
+			for _, failure := range tt.failures {
+				mock.ExpectBegin()
+				mock.ExpectExec(closeFriendQuery).WithArgs(friendID, userID, true).WillReturnError(failure)
+				mock.ExpectRollback()
+			}
+
+			if tt.expectedErr == nil {
+				mock.ExpectBegin()
+				mock.ExpectExec(closeFriendQuery).
+					WithArgs(friendID, userID, true).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			}
+
+			mock.ExpectClose()
+
+			err = repo.SetCloseFriend(context.Background(), userID, friendID, true)
+
+			var pgErr *pgconn.PgError
+
+			switch {
+			case tt.expectedErr == nil:
+				require.NoError(t, err)
+			case errors.As(tt.expectedErr, &pgErr):
+				require.ErrorAs(t, err, &pgErr)
+				require.NotErrorIs(t, err, repository.ErrTxRetriesExhausted)
+			default:
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.ErrorAs(t, err, &pgErr, "the last database error is kept")
+			}
+		})
+	}
+}
+
+func TestTxManagerWithinTx(t *testing.T) {
+	t.Parallel()
+
+	followerID, followeeID := uuid.New(), uuid.New()
+
+	expectFollowAndUnfollow := func(mock sqlmock.Sqlmock, unfollowErr error) {
+		mock.ExpectExec(insertFollowQuery).
+			WithArgs(followerID, followeeID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		if unfollowErr != nil {
+			mock.ExpectExec(deleteFollowQuery).WithArgs(followerID, followeeID).WillReturnError(unfollowErr)
+
+			return
+		}
+
+		mock.ExpectExec(deleteFollowQuery).
+			WithArgs(followerID, followeeID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	tests := []struct {
+		name        string
+		unfollowErr []error
+		expectedErr error
+	}{
+		{
+			name: "Success - repository writes share one transaction",
+		},
+		{
+			name:        "Success - unit of work retried after serialization failure",
+			unfollowErr: []error{&pgconn.PgError{Code: "40001", Message: "could not serialize access"}},
+		},
+		{
+			name:        "Error - a failed write rolls back the earlier ones",
+			unfollowErr: []error{errors.New("connection reset")},
+			expectedErr: errors.New("connection reset"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			defer func() {
+				require.NoError(t, db.Close())
+			}()
+
+			for _, failure := range tt.unfollowErr {
+				mock.ExpectBegin()
+				expectFollowAndUnfollow(mock, failure)
+				mock.ExpectRollback()
+			}
+
+			if tt.expectedErr == nil {
+				mock.ExpectBegin()
+				expectFollowAndUnfollow(mock, nil)
+				mock.ExpectCommit()
+			}
+
+			mock.ExpectClose()
+
+			repo := repository.NewSocialRepository(db)
+
+			err = repository.NewTxManager(db).WithinTx(t.Context(), func(ctx context.Context) error {
+				err := repo.FollowUser(ctx, followerID, followeeID)
+				if err != nil {
+					return err
+				}
+
+				return repo.UnfollowUser(ctx, followerID, followeeID)
+			})
+
+			if tt.expectedErr != nil {
+				require.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
 )
 
 // ErrUserNotFound is returned when a user is not found.
@@ -18,55 +21,170 @@ var ErrUserNotFound = errors.New("user not found")
 // ErrDuplicateUsername is returned when a username already exists.
 var ErrDuplicateUsername = errors.New("username already exists")
 
+// ErrDuplicateEmail is returned when an email already belongs to an active user.
+var ErrDuplicateEmail = errors.New("email already exists")
+
+// ErrUsernameRetired is returned when a username belongs to a deactivated user
+// and has not been released yet.
+var ErrUsernameRetired = errors.New("username is retired")
+
+// ErrEmailRetired is returned when an email belongs to a deactivated user
+// and has not been released yet.
+var ErrEmailRetired = errors.New("email is retired")
+
+// IdentifierRetentionPeriod is how long a deactivated user keeps their username
+// and email before ReleaseRetiredIdentifiers frees them for other accounts.
+const IdentifierRetentionPeriod = 90 * 24 * time.Hour
+
 // UserRepository defines the interface for user data access.
 type UserRepository interface {
 	FindUserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error)
-	FindPrivacyPreferencesByUserID(ctx context.Context, userID uuid.UUID) (*dto.PrivacyPreferences, error)
+	FindUserByUsername(ctx context.Context, username string) (*dto.User, error)
+	FindPrivacyPreferencesByUserID(ctx context.Context, userID uuid.UUID) (*dto.UserPrivacyPreferences, error)
 	IsFollowing(ctx context.Context, followerID, followedID uuid.UUID) (bool, error)
 	UpdateUser(ctx context.Context, userID uuid.UUID, update *dto.UserProfileUpdateRequest) (*dto.User, error)
-	SearchUsers(ctx context.Context, query string, limit, offset int) ([]dto.UserSearchResult, int, error)
+	SearchUsers(
+		ctx context.Context,
+		query string,
+		location dto.LocationFilter,
+		limit, offset int,
+	) ([]dto.UserSearchResult, int, error)
+	SearchUsersRanked(
+		ctx context.Context,
+		requesterID uuid.UUID,
+		query string,
+		location dto.LocationFilter,
+		limit, offset int,
+	) ([]dto.UserSearchResult, int, error)
+	SearchUsernamePrefix(ctx context.Context, prefix string, limit int) ([]dto.UserTypeaheadResult, error)
+	CountSearchableUsers(ctx context.Context) (int, error)
+	ListSearchableUsers(ctx context.Context, after uuid.UUID, limit int) ([]dto.UserTypeaheadResult, error)
+	FindMentionableUsers(ctx context.Context, usernames []string) ([]dto.MentionedUser, error)
 	GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error)
+	ReleaseRetiredIdentifiers(ctx context.Context, deactivatedBefore time.Time, limit int) (int, error)
+}
+
+// UserBatchReader is implemented by user repositories that can read many users in one query.
+// Request-scoped loaders use it to batch lookups; other repositories are read one ID at a time.
+type UserBatchReader interface {
+	FindUsersByIDs(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]*dto.User, error)
+	FindPrivacyPreferencesByUserIDs(
+		ctx context.Context,
+		userIDs []uuid.UUID,
+	) (map[uuid.UUID]*dto.UserPrivacyPreferences, error)
 }
 
 // SQLUserRepository implements UserRepository using a SQL database.
 type SQLUserRepository struct {
+	privacyDefaults
+
 	db *sql.DB
+	tx txRunner
 }
 
 // NewUserRepository creates a new SQLUserRepository.
 func NewUserRepository(db *sql.DB) *SQLUserRepository {
-	return &SQLUserRepository{db: db}
+	return &SQLUserRepository{db: db, tx: newTxRunner(db)}
 }
 
 // FindUserByID retrieves a user by their ID.
 func (r *SQLUserRepository) FindUserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
 	query := `
-		SELECT user_id, username, email, full_name, bio, is_active, created_at, updated_at
+		SELECT ` + userColumns + `
 		FROM recipe_manager.users
 		WHERE user_id = $1
 	`
 
+	user, err := scanUser(executorFor(ctx, r.db).QueryRowContext(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+
+	return user, nil
+}
+
+// FindUsersByIDs retrieves the users with the given IDs in one query. Unknown IDs are absent
+// from the result.
+func (r *SQLUserRepository) FindUsersByIDs(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) (map[uuid.UUID]*dto.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM recipe_manager.users
+		WHERE user_id = ANY($1::uuid[])
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, uuidArray(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make(map[uuid.UUID]*dto.User, len(userIDs))
+
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+
+		id, err := uuid.Parse(user.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user id %q: %w", user.UserID, err)
+		}
+
+		users[id] = user
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate users: %w", err)
+	}
+
+	return users, nil
+}
+
+// rowScanner is satisfied by *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// userColumns are the users columns read by scanUser.
+const userColumns = `user_id, username, email, full_name, bio, country, region, is_active, created_at, updated_at,
+	deactivated_at`
+
+// scanUser scans the userColumns of a row into a user.
+func scanUser(row rowScanner) (*dto.User, error) {
 	var (
-		user                 dto.User
-		email, fullName, bio sql.NullString
+		user                                  dto.User
+		email, fullName, bio, country, region sql.NullString
+		deactivatedAt                         sql.NullTime
 	)
 
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := row.Scan(
 		&user.UserID,
 		&user.Username,
 		&email,
 		&fullName,
 		&bio,
+		&country,
+		&region,
 		&user.IsActive,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&deactivatedAt,
 	)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrUserNotFound
-		}
+		return nil, err //nolint:wrapcheck // callers wrap with query context
+	}
 
-		return nil, fmt.Errorf("failed to query user: %w", err)
+	if deactivatedAt.Valid {
+		user.DeactivatedAt = &deactivatedAt.Time
 	}
 
 	if email.Valid {
@@ -81,9 +199,48 @@ func (r *SQLUserRepository) FindUserByID(ctx context.Context, userID uuid.UUID)
 		user.Bio = &bio.String
 	}
 
+	if country.Valid {
+		user.Country = &country.String
+	}
+
+	if region.Valid {
+		user.Region = &region.String
+	}
+
 	return &user, nil
 }
 
+// uuidArray formats ids as a PostgreSQL array literal, for use with ANY($n::uuid[]).
+func uuidArray(ids []uuid.UUID) string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+
+	return "{" + strings.Join(values, ",") + "}"
+}
+
+// FindUserByUsername retrieves a user by username, ignoring case.
+// The LOWER(username) predicate is served by the users_username_lower_idx expression index.
+func (r *SQLUserRepository) FindUserByUsername(ctx context.Context, username string) (*dto.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM recipe_manager.users
+		WHERE LOWER(username) = LOWER($1)
+	`
+
+	user, err := scanUser(executorFor(ctx, r.db).QueryRowContext(ctx, query, username))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to query user by username: %w", err)
+	}
+
+	return user, nil
+}
+
 // GetUserStats retrieves aggregated user statistics.
 func (r *SQLUserRepository) GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error) {
 	query := `
@@ -98,7 +255,7 @@ func (r *SQLUserRepository) GetUserStats(ctx context.Context) (*dto.UserStatsRes
 
 	var stats dto.UserStatsResponse
 
-	err := r.db.QueryRowContext(ctx, query).Scan(
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query).Scan(
 		&stats.TotalUsers,
 		&stats.ActiveUsers,
 		&stats.InactiveUsers,
@@ -113,54 +270,61 @@ func (r *SQLUserRepository) GetUserStats(ctx context.Context) (*dto.UserStatsRes
 	return &stats, nil
 }
 
-// FindPrivacyPreferencesByUserID retrieves privacy preferences for a user.
+// FindPrivacyPreferencesByUserID retrieves privacy preferences for a user. Users who never saved
+// any get the defaults of the version they were provisioned under.
 func (r *SQLUserRepository) FindPrivacyPreferencesByUserID(
 	ctx context.Context,
 	userID uuid.UUID,
-) (*dto.PrivacyPreferences, error) {
+) (*dto.UserPrivacyPreferences, error) {
 	query := `
-		SELECT profile_visibility, contact_info_visibility
+		SELECT ` + privacyColumns + `
 		FROM recipe_manager.user_privacy_preferences
 		WHERE user_id = $1
 	`
 
-	prefs := &dto.PrivacyPreferences{
-		ProfileVisibility: "public",
-		ShowEmail:         false,
-		ShowFullName:      true,
-		AllowFollows:      true,
-		AllowMessages:     true,
-	}
-
-	var profileVisibility, contactVisibility string
-
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
-		&profileVisibility,
-		&contactVisibility,
-	)
+	prefs, err := scanPrivacyPreferences(executorFor(ctx, r.db).QueryRowContext(ctx, query, userID))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return prefs, nil
+			defaults, err := r.provision(ctx, executorFor(ctx, r.db), []uuid.UUID{userID})
+			if err != nil {
+				return nil, err
+			}
+
+			return defaults[userID], nil
 		}
 
 		return nil, fmt.Errorf("failed to query privacy preferences: %w", err)
 	}
 
-	switch profileVisibility {
-	case "FRIENDS_ONLY":
-		prefs.ProfileVisibility = "followers_only"
-	case "PRIVATE":
-		prefs.ProfileVisibility = "private"
-	default:
-		prefs.ProfileVisibility = "public"
+	return prefs, nil
+}
+
+// FindPrivacyPreferencesByUserIDs retrieves privacy preferences for the given users in one query.
+// Users without stored preferences get the defaults, as with FindPrivacyPreferencesByUserID.
+func (r *SQLUserRepository) FindPrivacyPreferencesByUserIDs(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) (map[uuid.UUID]*dto.UserPrivacyPreferences, error) {
+	prefs, err := findPrivacyPreferences(ctx, executorFor(ctx, r.db), userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []uuid.UUID
+
+	for _, userID := range userIDs {
+		if _, ok := prefs[userID]; !ok {
+			missing = append(missing, userID)
+		}
 	}
 
-	if contactVisibility == "PUBLIC" {
-		prefs.ShowEmail = true
-	} else {
-		prefs.ShowEmail = false
+	defaults, err := r.provision(ctx, executorFor(ctx, r.db), missing)
+	if err != nil {
+		return nil, err
 	}
 
+	maps.Copy(prefs, defaults)
+
 	return prefs, nil
 }
 
@@ -168,12 +332,12 @@ func (r *SQLUserRepository) FindPrivacyPreferencesByUserID(
 func (r *SQLUserRepository) IsFollowing(ctx context.Context, followerID, followedID uuid.UUID) (bool, error) {
 	query := `
 		SELECT 1 FROM recipe_manager.user_follows
-		WHERE follower_id = $1 AND followee_id = $2
+		WHERE follower_id = $1 AND followee_id = $2 AND deleted_at IS NULL
 	`
 
 	var exists int
 
-	err := r.db.QueryRowContext(ctx, query, followerID, followedID).Scan(&exists)
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, query, followerID, followedID).Scan(&exists)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return false, nil
@@ -186,10 +350,35 @@ func (r *SQLUserRepository) IsFollowing(ctx context.Context, followerID, followe
 }
 
 // UpdateUser updates a user's profile and returns the updated user.
+// Identifiers held by deactivated users stay retired until ReleaseRetiredIdentifiers
+// frees them; other users' rows are never written.
 func (r *SQLUserRepository) UpdateUser(
 	ctx context.Context,
 	userID uuid.UUID,
 	update *dto.UserProfileUpdateRequest,
+) (*dto.User, error) {
+	var user *dto.User
+
+	err := r.tx.run(ctx, func(tx *sql.Tx) error {
+		var err error
+
+		user, err = r.updateUserTx(ctx, tx, userID, update)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// updateUserTx applies update within tx after checking that new identifiers are available.
+func (r *SQLUserRepository) updateUserTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	userID uuid.UUID,
+	update *dto.UserProfileUpdateRequest,
 ) (*dto.User, error) {
 	setClauses, args, argIndex := buildUpdateClauses(update)
 	args = append(args, userID)
@@ -198,15 +387,129 @@ func (r *SQLUserRepository) UpdateUser(
 		`UPDATE recipe_manager.users
 		SET %s
 		WHERE user_id = $%d
-		RETURNING user_id, username, email, full_name, bio, is_active, created_at, updated_at`,
+		RETURNING `+userColumns,
 		strings.Join(setClauses, ", "), argIndex)
 
-	user, err := r.executeUpdateQuery(ctx, query, args)
+	err := r.ensureIdentifiersAvailable(ctx, tx, userID, update)
 	if err != nil {
 		return nil, err
 	}
 
-	return user, nil
+	return r.executeUpdateQuery(ctx, tx, query, args)
+}
+
+func (r *SQLUserRepository) ensureIdentifiersAvailable(
+	ctx context.Context,
+	tx *sql.Tx,
+	userID uuid.UUID,
+	update *dto.UserProfileUpdateRequest,
+) error {
+	if update.Username != nil {
+		err := r.ensureIdentifierAvailable(ctx, tx, userID, "username", *update.Username,
+			ErrDuplicateUsername, ErrUsernameRetired)
+		if err != nil {
+			return err
+		}
+	}
+
+	if update.Email != nil {
+		err := r.ensureIdentifierAvailable(ctx, tx, userID, "email", *update.Email,
+			ErrDuplicateEmail, ErrEmailRetired)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureIdentifierAvailable checks whether value is free for userID to claim in column.
+// Active holders produce duplicateErr and deactivated holders retiredErr, until
+// ReleaseRetiredIdentifiers frees the value once their retention window has passed.
+func (r *SQLUserRepository) ensureIdentifierAvailable(
+	ctx context.Context,
+	tx *sql.Tx,
+	userID uuid.UUID,
+	column, value string,
+	duplicateErr, retiredErr error,
+) error {
+	// column is never user input; it is one of the fixed identifier columns.
+	query := fmt.Sprintf(`
+		SELECT is_active
+		FROM recipe_manager.users
+		WHERE LOWER(%s) = LOWER($1) AND user_id <> $2
+	`, column)
+
+	rows, err := tx.QueryContext(ctx, query, value, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check %s availability: %w", column, err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	held := false
+
+	for rows.Next() {
+		var isActive bool
+
+		err = rows.Scan(&isActive)
+		if err != nil {
+			return fmt.Errorf("failed to scan %s holder: %w", column, err)
+		}
+
+		if isActive {
+			return duplicateErr
+		}
+
+		held = true
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return fmt.Errorf("error iterating %s holders: %w", column, err)
+	}
+
+	if held {
+		return retiredErr
+	}
+
+	return nil
+}
+
+// ReleaseRetiredIdentifiers frees the identifiers of up to limit users deactivated before
+// deactivatedBefore, longest deactivated first, and returns how many users it released.
+// Usernames are rewritten to a placeholder derived from the user ID; emails are cleared.
+func (r *SQLUserRepository) ReleaseRetiredIdentifiers(
+	ctx context.Context,
+	deactivatedBefore time.Time,
+	limit int,
+) (int, error) {
+	query := `
+		UPDATE recipe_manager.users
+		SET username = $3 || REPLACE(user_id::text, '-', ''), email = NULL
+		WHERE user_id IN (
+			SELECT user_id
+			FROM recipe_manager.users
+			WHERE NOT is_active
+			  AND deactivated_at < $1
+			  AND (email IS NOT NULL OR NOT starts_with(username, $3))
+			ORDER BY deactivated_at
+			LIMIT $2
+		)
+	`
+
+	result, err := executorFor(ctx, r.db).ExecContext(ctx, query,
+		deactivatedBefore, limit, validation.ReleasedUsernamePrefix)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release retired identifiers: %w", err)
+	}
+
+	released, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count released identifiers: %w", err)
+	}
+
+	return int(released), nil
 }
 
 func buildUpdateClauses(update *dto.UserProfileUpdateRequest) ([]string, []any, int) {
@@ -238,6 +541,19 @@ func buildUpdateClauses(update *dto.UserProfileUpdateRequest) ([]string, []any,
 		argIndex++
 	}
 
+	// Empty locations are stored as NULL
+	if update.Country != nil {
+		setClauses = append(setClauses, fmt.Sprintf("country = NULLIF($%d, '')", argIndex))
+		args = append(args, *update.Country)
+		argIndex++
+	}
+
+	if update.Region != nil {
+		setClauses = append(setClauses, fmt.Sprintf("region = NULLIF($%d, '')", argIndex))
+		args = append(args, *update.Region)
+		argIndex++
+	}
+
 	if update.IsActive != nil {
 		setClauses = append(setClauses, fmt.Sprintf("is_active = $%d", argIndex))
 		args = append(args, *update.IsActive)
@@ -247,29 +563,18 @@ func buildUpdateClauses(update *dto.UserProfileUpdateRequest) ([]string, []any,
 	return setClauses, args, argIndex
 }
 
-func (r *SQLUserRepository) executeUpdateQuery(ctx context.Context, query string, args []any) (*dto.User, error) {
-	var (
-		user                 dto.User
-		email, fullName, bio sql.NullString
-	)
-
-	err := r.db.QueryRowContext(ctx, query, args...).Scan(
-		&user.UserID,
-		&user.Username,
-		&email,
-		&fullName,
-		&bio,
-		&user.IsActive,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+func (r *SQLUserRepository) executeUpdateQuery(
+	ctx context.Context,
+	tx *sql.Tx,
+	query string,
+	args []any,
+) (*dto.User, error) {
+	user, err := scanUser(tx.QueryRowContext(ctx, query, args...))
 	if err != nil {
 		return nil, mapUpdateError(err)
 	}
 
-	assignNullableFields(&user, email, fullName, bio)
-
-	return &user, nil
+	return user, nil
 }
 
 func mapUpdateError(err error) error {
@@ -279,43 +584,143 @@ func mapUpdateError(err error) error {
 
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		if strings.Contains(pgErr.ConstraintName, "email") {
+			return ErrDuplicateEmail
+		}
+
 		return ErrDuplicateUsername
 	}
 
 	return fmt.Errorf("failed to update user: %w", err)
 }
 
-func assignNullableFields(user *dto.User, email, fullName, bio sql.NullString) {
-	if email.Valid {
-		user.Email = &email.String
+// SearchUsers searches for active users by username or full name in location with pagination.
+// Minors and users who opted out of discoverability are left out of the results.
+func (r *SQLUserRepository) SearchUsers(
+	ctx context.Context,
+	query string,
+	location dto.LocationFilter,
+	limit, offset int,
+) ([]dto.UserSearchResult, int, error) {
+	// Build search pattern for ILIKE
+	searchPattern := "%" + query + "%"
+
+	// Get total count first
+	totalCount, err := r.countSearchResults(ctx, searchPattern, location)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	if fullName.Valid {
-		user.FullName = &fullName.String
+	// Get paginated results
+	results, err := r.fetchSearchResults(ctx, searchPattern, location, limit, offset)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	if bio.Valid {
-		user.Bio = &bio.String
+	return results, totalCount, nil
+}
+
+// notMinor excludes users that minor restrictions apply to; $2 is dto.AdultAge. It mirrors
+// dto.AgeVerification.IsMinor: an override wins, and users without a birthdate are not minors.
+const notMinor = `
+	NOT EXISTS (
+		SELECT 1 FROM recipe_manager.user_age_verifications a
+		WHERE a.user_id = users.user_id
+		  AND COALESCE(a.age_override = 'minor', a.birthdate > CURRENT_DATE - make_interval(years => $2), false)
+	)
+`
+
+// discoverable excludes users whose privacy preferences opt out of search. Users without saved
+// preferences are discoverable.
+const discoverable = `
+	NOT EXISTS (
+		SELECT 1 FROM recipe_manager.user_privacy_preferences p
+		WHERE p.user_id = users.user_id AND NOT p.discoverable
+	)
+`
+
+// locationFilter returns the condition restricting search to location, with its parameters
+// numbered from next, and their values. Only users who show their location match a filter.
+func locationFilter(location dto.LocationFilter, next int) (string, []any) {
+	if location.IsZero() {
+		return "", nil
+	}
+
+	condition := `
+		AND EXISTS (
+			SELECT 1 FROM recipe_manager.user_privacy_preferences p
+			WHERE p.user_id = users.user_id AND p.show_location
+		)`
+
+	var args []any
+
+	if location.Country != "" {
+		condition += fmt.Sprintf(" AND users.country = $%d", next)
+		args = append(args, location.Country)
+		next++
 	}
+
+	if location.Region != "" {
+		condition += fmt.Sprintf(" AND users.region = $%d", next)
+		args = append(args, location.Region)
+	}
+
+	return condition, args
 }
 
-// SearchUsers searches for active users by username or full name with pagination.
-func (r *SQLUserRepository) SearchUsers(
+// SearchUsersRanked searches like SearchUsers but ranks users the requester follows first, then
+// users followed by someone the requester follows, and sets the Connection of each result.
+func (r *SQLUserRepository) SearchUsersRanked(
 	ctx context.Context,
+	requesterID uuid.UUID,
 	query string,
+	location dto.LocationFilter,
 	limit, offset int,
 ) ([]dto.UserSearchResult, int, error) {
-	// Build search pattern for ILIKE
 	searchPattern := "%" + query + "%"
 
-	// Get total count first
-	totalCount, err := r.countSearchResults(ctx, searchPattern)
+	totalCount, err := r.countSearchResults(ctx, searchPattern, location)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	// Get paginated results
-	results, err := r.fetchSearchResults(ctx, searchPattern, limit, offset)
+	inLocation, locationArgs := locationFilter(location, 6)
+
+	// The connection scores are the dto.SearchConnection values
+	resultsQuery := `
+		SELECT users.user_id, users.username, users.full_name, users.is_active, users.created_at, users.updated_at,
+		       CASE
+		           WHEN f.follower_id IS NOT NULL THEN 2
+		           WHEN EXISTS (
+		               SELECT 1
+		               FROM recipe_manager.user_follows f1
+		               JOIN recipe_manager.user_follows f2
+		                 ON f2.follower_id = f1.followee_id AND f2.deleted_at IS NULL
+		               WHERE f1.follower_id = $5 AND f1.deleted_at IS NULL
+		                 AND f2.followee_id = users.user_id AND users.user_id <> $5
+		           ) THEN 1
+		           ELSE 0
+		       END AS connection
+		FROM recipe_manager.users
+		LEFT JOIN recipe_manager.user_follows f
+		  ON f.follower_id = $5 AND f.followee_id = users.user_id AND f.deleted_at IS NULL
+		WHERE users.is_active = true
+		  AND (users.username ILIKE $1 OR users.full_name ILIKE $1)
+		  AND ` + notMinor + `
+		  AND ` + discoverable + inLocation + `
+		ORDER BY connection DESC, users.username ASC
+		LIMIT $3 OFFSET $4
+	`
+	args := append([]any{searchPattern, dto.AdultAge, limit, offset, requesterID}, locationArgs...)
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, resultsQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	results, err := scanSearchResults(rows, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -323,17 +728,170 @@ func (r *SQLUserRepository) SearchUsers(
 	return results, totalCount, nil
 }
 
-func (r *SQLUserRepository) countSearchResults(ctx context.Context, searchPattern string) (int, error) {
+// SearchUsernamePrefix returns up to limit searchable users whose username starts with prefix,
+// ignoring case, in username order. Both the filter and the order are served by
+// users_username_prefix_idx, so the scan stops after limit rows.
+func (r *SQLUserRepository) SearchUsernamePrefix(
+	ctx context.Context,
+	prefix string,
+	limit int,
+) ([]dto.UserTypeaheadResult, error) {
+	query := `
+		SELECT user_id, username
+		FROM recipe_manager.users
+		WHERE is_active
+		  AND LOWER(username) LIKE $1 ESCAPE '\'
+		  AND ` + notMinor + `
+		  AND ` + discoverable + `
+		ORDER BY LOWER(username)
+		LIMIT $3
+	`
+
+	rows, err := executorFor(ctx, r.db).
+		QueryContext(ctx, query, escapeLike(strings.ToLower(prefix))+"%", dto.AdultAge, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search usernames: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanTypeaheadResults(rows)
+}
+
+// CountSearchableUsers counts the users that search and typeahead can return.
+func (r *SQLUserRepository) CountSearchableUsers(ctx context.Context) (int, error) {
+	// Every username matches the empty search pattern
+	return r.countSearchResults(ctx, "%", dto.LocationFilter{})
+}
+
+// ListSearchableUsers returns up to limit searchable users with an ID greater than after, in ID
+// order, so callers can page through all of them with stable keyset pagination.
+func (r *SQLUserRepository) ListSearchableUsers(
+	ctx context.Context,
+	after uuid.UUID,
+	limit int,
+) ([]dto.UserTypeaheadResult, error) {
+	query := `
+		SELECT user_id, username
+		FROM recipe_manager.users
+		WHERE is_active
+		  AND user_id > $1
+		  AND ` + notMinor + `
+		  AND ` + discoverable + `
+		ORDER BY user_id
+		LIMIT $3
+	`
+
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, after, dto.AdultAge, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list searchable users: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanTypeaheadResults(rows)
+}
+
+// FindMentionableUsers returns the users with the given lowercase usernames that search can
+// return, in no particular order. FullName is set only for users who show it.
+func (r *SQLUserRepository) FindMentionableUsers(
+	ctx context.Context,
+	usernames []string,
+) ([]dto.MentionedUser, error) {
+	query := `
+		SELECT users.user_id, users.username,
+		       CASE WHEN COALESCE(p.show_full_name, true) THEN users.full_name END
+		FROM recipe_manager.users
+		LEFT JOIN recipe_manager.user_privacy_preferences p ON p.user_id = users.user_id
+		WHERE LOWER(users.username) = ANY($1::text[])
+		  AND users.is_active
+		  AND ` + notMinor + `
+		  AND COALESCE(p.discoverable, true)
+	`
+
+	// Usernames are alphanumeric, so they need no quoting in the array literal
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, query, "{"+strings.Join(usernames, ",")+"}", dto.AdultAge)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find mentioned users: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var users []dto.MentionedUser
+
+	for rows.Next() {
+		var (
+			user     dto.MentionedUser
+			fullName sql.NullString
+		)
+
+		err := rows.Scan(&user.UserID, &user.Username, &fullName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan mentioned user: %w", err)
+		}
+
+		if fullName.Valid {
+			user.FullName = &fullName.String
+		}
+
+		users = append(users, user)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating mentioned users: %w", err)
+	}
+
+	return users, nil
+}
+
+func scanTypeaheadResults(rows *sql.Rows) ([]dto.UserTypeaheadResult, error) {
+	var results []dto.UserTypeaheadResult
+
+	for rows.Next() {
+		var result dto.UserTypeaheadResult
+
+		err := rows.Scan(&result.UserID, &result.Username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan username: %w", err)
+		}
+
+		results = append(results, result)
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error iterating usernames: %w", err)
+	}
+
+	return results, nil
+}
+
+// escapeLike escapes the LIKE wildcards in s so it matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+func (r *SQLUserRepository) countSearchResults(
+	ctx context.Context,
+	searchPattern string,
+	location dto.LocationFilter,
+) (int, error) {
+	inLocation, locationArgs := locationFilter(location, 3)
+
 	countQuery := `
 		SELECT COUNT(*)
 		FROM recipe_manager.users
 		WHERE is_active = true
 		  AND (username ILIKE $1 OR full_name ILIKE $1)
-	`
+		  AND ` + notMinor + `
+		  AND ` + discoverable + inLocation
 
 	var count int
 
-	err := r.db.QueryRowContext(ctx, countQuery, searchPattern).Scan(&count)
+	args := append([]any{searchPattern, dto.AdultAge}, locationArgs...)
+
+	err := executorFor(ctx, r.db).QueryRowContext(ctx, countQuery, args...).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count search results: %w", err)
 	}
@@ -344,28 +902,35 @@ func (r *SQLUserRepository) countSearchResults(ctx context.Context, searchPatter
 func (r *SQLUserRepository) fetchSearchResults(
 	ctx context.Context,
 	searchPattern string,
+	location dto.LocationFilter,
 	limit, offset int,
 ) ([]dto.UserSearchResult, error) {
+	inLocation, locationArgs := locationFilter(location, 5)
+
 	resultsQuery := `
 		SELECT user_id, username, full_name, is_active, created_at, updated_at
 		FROM recipe_manager.users
 		WHERE is_active = true
 		  AND (username ILIKE $1 OR full_name ILIKE $1)
+		  AND ` + notMinor + `
+		  AND ` + discoverable + inLocation + `
 		ORDER BY username ASC
-		LIMIT $2 OFFSET $3
+		LIMIT $3 OFFSET $4
 	`
+	args := append([]any{searchPattern, dto.AdultAge, limit, offset}, locationArgs...)
 
-	rows, err := r.db.QueryContext(ctx, resultsQuery, searchPattern, limit, offset)
+	rows, err := executorFor(ctx, r.db).QueryContext(ctx, resultsQuery, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search users: %w", err)
 	}
 
 	defer func() { _ = rows.Close() }()
 
-	return scanSearchResults(rows)
+	return scanSearchResults(rows, false)
 }
 
-func scanSearchResults(rows *sql.Rows) ([]dto.UserSearchResult, error) {
+// scanSearchResults scans search rows; ranked rows carry a trailing connection column.
+func scanSearchResults(rows *sql.Rows, ranked bool) ([]dto.UserSearchResult, error) {
 	var results []dto.UserSearchResult
 
 	for rows.Next() {
@@ -374,14 +939,19 @@ func scanSearchResults(rows *sql.Rows) ([]dto.UserSearchResult, error) {
 			fullName sql.NullString
 		)
 
-		err := rows.Scan(
+		dest := []any{
 			&result.UserID,
 			&result.Username,
 			&fullName,
 			&result.IsActive,
 			&result.CreatedAt,
 			&result.UpdatedAt,
-		)
+		}
+		if ranked {
+			dest = append(dest, &result.Connection)
+		}
+
+		err := rows.Scan(dest...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan search result: %w", err)
 		}
@@ -3,23 +3,47 @@ package repository_test
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 const (
-	selectUserQuery = `SELECT user_id, username, email, full_name, bio, is_active, ` +
-		`created_at, updated_at FROM recipe_manager.users WHERE user_id = \$1`
-	selectPrivacyQuery = `SELECT profile_visibility, contact_info_visibility ` +
+	selectUserQuery = `SELECT user_id, username, email, full_name, bio, country, region, ` +
+		`is_active, created_at, updated_at, deactivated_at FROM recipe_manager.users WHERE user_id = \$1`
+	selectPrivacyQuery = `SELECT profile_visibility, recipe_visibility, activity_visibility, ` +
+		`contact_info_visibility, show_full_name, allow_follows, allow_messages, data_sharing, ` +
+		`analytics_tracking, discoverable, show_last_seen, show_location, count_profile_views, ` +
+		`keep_recently_viewed, updated_at ` +
 		`FROM recipe_manager.user_privacy_preferences WHERE user_id = \$1`
 )
 
+var userColumns = []string{
+	"user_id", "username", "email", "full_name", "bio", "country", "region", "is_active", "created_at", "updated_at",
+	"deactivated_at",
+}
+
+var privacyColumns = []string{
+	"profile_visibility", "recipe_visibility", "activity_visibility", "contact_info_visibility",
+	"show_full_name", "allow_follows", "allow_messages", "data_sharing", "analytics_tracking", "discoverable",
+	"show_last_seen", "show_location", "count_profile_views", "keep_recently_viewed", "updated_at",
+}
+
+// privacyRow returns the stored privacy columns for the given visibilities, with everything else
+// left at the column defaults.
+func privacyRow(profile, contact string) []driver.Value {
+	return []driver.Value{
+		profile, "PUBLIC", "PUBLIC", contact, true, true, true, false, false, true, true, false, true, true, time.Now(),
+	}
+}
+
 func TestSQLUserRepositoryFindUserByID(t *testing.T) {
 	t.Parallel()
 
@@ -38,10 +62,8 @@ func TestSQLUserRepositoryFindUserByID(t *testing.T) {
 
 		repo := repository.NewUserRepository(db)
 
-		rows := sqlmock.NewRows([]string{
-			"user_id", "username", "email", "full_name",
-			"bio", "is_active", "created_at", "updated_at",
-		}).AddRow(userID, "testuser", "email@example.com", "Test User", "Bio", true, now, now)
+		rows := sqlmock.NewRows(userColumns).
+			AddRow(userID, "testuser", "email@example.com", "Test User", "Bio", "US", "US-CA", true, now, now, nil)
 
 		mock.ExpectQuery(selectUserQuery).
 			WithArgs(userID).
@@ -53,6 +75,7 @@ func TestSQLUserRepositoryFindUserByID(t *testing.T) {
 		assert.NotNil(t, user)
 		assert.Equal(t, userID.String(), user.UserID)
 		assert.Equal(t, "email@example.com", *user.Email)
+		assert.Equal(t, "US-CA", *user.Region)
 	})
 
 	t.Run("Not Found", func(t *testing.T) {
@@ -78,10 +101,73 @@ func TestSQLUserRepositoryFindUserByID(t *testing.T) {
 	})
 }
 
+func TestSQLUserRepositoryFindUsersByIDs(t *testing.T) {
+	t.Parallel()
+
+	found, missing := uuid.New(), uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewUserRepository(db)
+
+	rows := sqlmock.NewRows(userColumns).
+		AddRow(found.String(), "testuser", nil, "Test User", nil, nil, nil, true, now, now, nil)
+
+	mock.ExpectQuery(`FROM recipe_manager.users WHERE user_id = ANY\(\$1::uuid\[\]\)`).
+		WithArgs("{" + found.String() + "," + missing.String() + "}").
+		WillReturnRows(rows)
+	mock.ExpectClose()
+
+	users, err := repo.FindUsersByIDs(context.Background(), []uuid.UUID{found, missing})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "testuser", users[found].Username)
+	assert.Nil(t, users[found].Email)
+	assert.Equal(t, "Test User", *users[found].FullName)
+}
+
+func TestSQLUserRepositoryFindPrivacyPreferencesByUserIDs(t *testing.T) {
+	t.Parallel()
+
+	stored, unset := uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewUserRepository(db)
+
+	rows := sqlmock.NewRows(append([]string{"user_id"}, privacyColumns...)).
+		AddRow(append([]driver.Value{stored.String()}, privacyRow("PRIVATE", "PUBLIC")...)...)
+
+	mock.ExpectQuery(`FROM recipe_manager.user_privacy_preferences WHERE user_id = ANY\(\$1::uuid\[\]\)`).
+		WithArgs("{" + stored.String() + "," + unset.String() + "}").
+		WillReturnRows(rows)
+	mock.ExpectClose()
+
+	prefs, err := repo.FindPrivacyPreferencesByUserIDs(context.Background(), []uuid.UUID{stored, unset})
+	require.NoError(t, err)
+	require.Len(t, prefs, 2)
+	assert.Equal(t, dto.ProfileVisibilityPrivate, prefs[stored].ProfileVisibility)
+	assert.True(t, prefs[stored].ShowEmail())
+	assert.Equal(t, dto.ProfileVisibilityPublic, prefs[unset].ProfileVisibility, "users without a row get the defaults")
+	assert.False(t, prefs[unset].ShowEmail())
+	assert.True(t, prefs[unset].AllowFollows)
+}
+
 type privacyTestCase struct {
 	name               string
 	mockSetup          func(sqlmock.Sqlmock)
-	expectedVisibility string
+	expectedVisibility dto.ProfileVisibility
 	expectedEmailShow  bool
 }
 
@@ -94,21 +180,19 @@ func TestSQLUserRepositoryFindPrivacyPreferencesByUserID(t *testing.T) {
 		{
 			name: "Success - Public",
 			mockSetup: func(m sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"profile_visibility", "contact_info_visibility"}).
-					AddRow("PUBLIC", "PUBLIC")
+				rows := sqlmock.NewRows(privacyColumns).AddRow(privacyRow("PUBLIC", "PUBLIC")...)
 				m.ExpectQuery(selectPrivacyQuery).WithArgs(userID).WillReturnRows(rows)
 			},
-			expectedVisibility: "public",
+			expectedVisibility: dto.ProfileVisibilityPublic,
 			expectedEmailShow:  true,
 		},
 		{
-			name: "Success - Friends Only Mapped",
+			name: "Success - Friends Only",
 			mockSetup: func(m sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"profile_visibility", "contact_info_visibility"}).
-					AddRow("FRIENDS_ONLY", "PRIVATE")
+				rows := sqlmock.NewRows(privacyColumns).AddRow(privacyRow("FRIENDS_ONLY", "PRIVATE")...)
 				m.ExpectQuery(selectPrivacyQuery).WithArgs(userID).WillReturnRows(rows)
 			},
-			expectedVisibility: "followers_only",
+			expectedVisibility: dto.ProfileVisibilityFriendsOnly,
 			expectedEmailShow:  false,
 		},
 		{
@@ -116,7 +200,7 @@ func TestSQLUserRepositoryFindPrivacyPreferencesByUserID(t *testing.T) {
 			mockSetup: func(m sqlmock.Sqlmock) {
 				m.ExpectQuery(selectPrivacyQuery).WithArgs(userID).WillReturnError(sql.ErrNoRows)
 			},
-			expectedVisibility: "public",
+			expectedVisibility: dto.ProfileVisibilityPublic,
 			expectedEmailShow:  false,
 		},
 	}
@@ -146,7 +230,322 @@ func runPrivacyTest(t *testing.T, tests []privacyTestCase, userID uuid.UUID) {
 			prefs, err := repo.FindPrivacyPreferencesByUserID(context.Background(), userID)
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedVisibility, prefs.ProfileVisibility)
-			assert.Equal(t, tt.expectedEmailShow, prefs.ShowEmail)
+			assert.Equal(t, tt.expectedEmailShow, prefs.ShowEmail())
+		})
+	}
+}
+
+func TestSQLUserRepositoryUpdateUserIdentifierRetention(t *testing.T) {
+	t.Parallel()
+
+	const holderQuery = `SELECT is_active FROM recipe_manager.users ` +
+		`WHERE LOWER\(username\) = LOWER\(\$1\) AND user_id <> \$2`
+
+	userID := uuid.New()
+	now := time.Now()
+	username := "takenname"
+
+	tests := []struct {
+		name        string
+		holderRows  *sqlmock.Rows
+		setupExtra  func(mock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		{
+			name:        "Active Holder",
+			holderRows:  sqlmock.NewRows([]string{"is_active"}).AddRow(true),
+			expectedErr: repository.ErrDuplicateUsername,
+		},
+		{
+			// Retired identifiers are only freed by ReleaseRetiredIdentifiers, never by another
+			// user's update
+			name:        "Deactivated Holder",
+			holderRows:  sqlmock.NewRows([]string{"is_active"}).AddRow(false),
+			expectedErr: repository.ErrUsernameRetired,
+		},
+		{
+			name:       "No Holder",
+			holderRows: sqlmock.NewRows([]string{"is_active"}),
+			setupExtra: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE recipe_manager.users SET`).
+					WillReturnRows(sqlmock.NewRows(userColumns).
+						AddRow(userID, username, nil, nil, nil, nil, nil, true, now, now, nil))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			defer func() {
+				require.NoError(t, db.Close())
+			}()
+
+			repo := repository.NewUserRepository(db)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(holderQuery).
+				WithArgs(username, userID).
+				WillReturnRows(tt.holderRows)
+
+			if tt.setupExtra != nil {
+				tt.setupExtra(mock)
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			mock.ExpectClose()
+
+			user, err := repo.UpdateUser(context.Background(), userID, &dto.UserProfileUpdateRequest{
+				Username: &username,
+			})
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, user)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, username, user.Username)
+			}
 		})
 	}
 }
+
+func TestSQLUserRepositoryReleaseRetiredIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	cutoff := time.Now().Add(-repository.IdentifierRetentionPeriod)
+
+	mock.ExpectExec(`UPDATE recipe_manager.users SET username = \$3 \|\| REPLACE\(user_id::text, '-', ''\), `+
+		`email = NULL WHERE user_id IN \( SELECT user_id FROM recipe_manager.users WHERE NOT is_active `+
+		`AND deactivated_at < \$1 .* ORDER BY deactivated_at LIMIT \$2 \)`).
+		WithArgs(cutoff, 100, "released_").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	released, err := repository.NewUserRepository(db).ReleaseRetiredIdentifiers(t.Context(), cutoff, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 3, released)
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSQLUserRepositoryFindUserByUsername(t *testing.T) {
+	t.Parallel()
+
+	const selectByUsernameQuery = `SELECT user_id, username, email, full_name, bio, country, region, ` +
+		`is_active, created_at, updated_at, deactivated_at FROM recipe_manager.users ` +
+		`WHERE LOWER\(username\) = LOWER\(\$1\)`
+
+	userID := uuid.New()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewUserRepository(db)
+
+		rows := sqlmock.NewRows(userColumns).AddRow(userID, "ChefJane", nil, "Jane", nil, nil, nil, true, now, now, nil)
+
+		mock.ExpectQuery(selectByUsernameQuery).
+			WithArgs("chefjane").
+			WillReturnRows(rows)
+		mock.ExpectClose()
+
+		user, err := repo.FindUserByUsername(context.Background(), "chefjane")
+		require.NoError(t, err)
+		assert.Equal(t, "ChefJane", user.Username)
+		assert.Nil(t, user.Email)
+		assert.Equal(t, "Jane", *user.FullName)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		repo := repository.NewUserRepository(db)
+
+		mock.ExpectQuery(selectByUsernameQuery).
+			WithArgs("ghost").
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectClose()
+
+		user, err := repo.FindUserByUsername(context.Background(), "ghost")
+		require.ErrorIs(t, err, repository.ErrUserNotFound)
+		assert.Nil(t, user)
+	})
+}
+
+func TestSQLUserRepositorySearchUsersRanked(t *testing.T) {
+	t.Parallel()
+
+	requesterID, followedID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewUserRepository(db)
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM recipe_manager.users`).
+		WithArgs("%chef%", dto.AdultAge).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	rows := sqlmock.NewRows([]string{
+		"user_id", "username", "full_name", "is_active", "created_at", "updated_at", "connection",
+	}).AddRow(followedID.String(), "chef", nil, true, now, now, int64(dto.SearchConnectionFollowing))
+
+	mock.ExpectQuery(`LEFT JOIN recipe_manager.user_follows f .+ORDER BY connection DESC, users.username ASC`).
+		WithArgs("%chef%", dto.AdultAge, 10, 0, requesterID).
+		WillReturnRows(rows)
+
+	results, total, err := repo.SearchUsersRanked(context.Background(), requesterID, "chef", dto.LocationFilter{}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, results, 1)
+	assert.Equal(t, dto.SearchConnectionFollowing, results[0].Connection)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSQLUserRepositorySearchUsers_Location(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	location := dto.LocationFilter{Country: "FR", Region: "FR-IDF"}
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) .+p.show_location\s+\) AND users.country = \$3 AND users.region = \$4`).
+		WithArgs("%chef%", dto.AdultAge, "FR", "FR-IDF").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`p.show_location\s+\) AND users.country = \$5 AND users.region = \$6\s+ORDER BY username`).
+		WithArgs("%chef%", dto.AdultAge, 10, 0, "FR", "FR-IDF").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "username", "full_name", "is_active", "created_at", "updated_at"}))
+
+	results, total, err := repository.NewUserRepository(db).SearchUsers(t.Context(), "chef", location, 10, 0)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, results)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSQLUserRepositorySearchUsernamePrefix(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewUserRepository(db)
+
+	// LIKE wildcards in the prefix match literally
+	mock.ExpectQuery(`WHERE is_active\s+AND LOWER\(username\) LIKE \$1 ESCAPE .+ORDER BY LOWER\(username\)\s+LIMIT \$3`).
+		WithArgs(`chef\_50\%%`, dto.AdultAge, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "username"}).AddRow(userID.String(), "Chef_50%"))
+
+	results, err := repo.SearchUsernamePrefix(context.Background(), "Chef_50%", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.UserTypeaheadResult{{UserID: userID.String(), Username: "Chef_50%"}}, results)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSQLUserRepositoryListSearchableUsers(t *testing.T) {
+	t.Parallel()
+
+	after, next := uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewUserRepository(db)
+
+	mock.ExpectQuery(`AND user_id > \$1 .+ORDER BY user_id\s+LIMIT \$3`).
+		WithArgs(after, dto.AdultAge, 500).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "username"}).AddRow(next.String(), "chef"))
+
+	users, err := repo.ListSearchableUsers(context.Background(), after, 500)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.UserTypeaheadResult{{UserID: next.String(), Username: "chef"}}, users)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
+
+func TestSQLUserRepositoryFindMentionableUsers(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	repo := repository.NewUserRepository(db)
+
+	mock.ExpectQuery(`WHERE LOWER\(users.username\) = ANY\(\$1::text\[\]\).+COALESCE\(p.discoverable, true\)`).
+		WithArgs("{alice,bob,nobody}", dto.AdultAge).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "username", "full_name"}).
+			AddRow(alice.String(), "Alice", "Alice Smith").
+			AddRow(bob.String(), "bob", nil))
+
+	users, err := repo.FindMentionableUsers(context.Background(), []string{"alice", "bob", "nobody"})
+	require.NoError(t, err)
+
+	fullName := "Alice Smith"
+	assert.Equal(t, []dto.MentionedUser{
+		{UserID: alice.String(), Username: "Alice", FullName: &fullName},
+		{UserID: bob.String(), Username: "bob"},
+	}, users)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	mock.ExpectClose()
+}
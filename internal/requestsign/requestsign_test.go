@@ -0,0 +1,100 @@
+package requestsign_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/requestsign"
+)
+
+var (
+	testSecret  = []byte("sibling-service-secret")
+	otherSecret = []byte("another-secret")
+)
+
+func signedRequest(t *testing.T, body string, now time.Time) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost,
+		"/internal/v1/users/resolve-mentions?limit=5", strings.NewReader(body))
+	require.NoError(t, requestsign.Sign(req, testSecret, []byte(body), now))
+
+	return req
+}
+
+func TestSignAndVerify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	body := `{"handles":["chef"]}`
+	secrets := [][]byte{otherSecret, testSecret}
+
+	req := signedRequest(t, body, now)
+	assert.Equal(t, strconv.FormatInt(now.Unix(), 10), req.Header.Get(requestsign.HeaderTimestamp))
+
+	nonce, err := requestsign.Verify(req, []byte(body), secrets, now.Add(time.Minute), 5*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, req.Header.Get(requestsign.HeaderNonce), nonce)
+
+	assert.NotEqual(t, nonce, signedRequest(t, body, now).Header.Get(requestsign.HeaderNonce), "nonces are random")
+
+	t.Run("tampered", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := requestsign.Verify(req, []byte(`{"handles":["admin"]}`), secrets, now, time.Minute)
+		require.ErrorIs(t, err, requestsign.ErrInvalid, "body")
+
+		moved := req.Clone(t.Context())
+		moved.URL.RawQuery = "limit=500"
+		_, err = requestsign.Verify(moved, []byte(body), secrets, now, time.Minute)
+		require.ErrorIs(t, err, requestsign.ErrInvalid, "query")
+
+		method := req.Clone(t.Context())
+		method.Method = http.MethodPut
+		_, err = requestsign.Verify(method, []byte(body), secrets, now, time.Minute)
+		require.ErrorIs(t, err, requestsign.ErrInvalid, "method")
+
+		_, err = requestsign.Verify(req, []byte(body), [][]byte{otherSecret}, now, time.Minute)
+		require.ErrorIs(t, err, requestsign.ErrInvalid, "secret")
+
+		restamped := req.Clone(t.Context())
+		restamped.Header.Set(requestsign.HeaderTimestamp, strconv.FormatInt(now.Unix()+1, 10))
+		_, err = requestsign.Verify(restamped, []byte(body), secrets, now, time.Minute)
+		require.ErrorIs(t, err, requestsign.ErrInvalid, "timestamp")
+	})
+
+	t.Run("outside the allowed skew", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := requestsign.Verify(req, []byte(body), secrets, now.Add(6*time.Minute), 5*time.Minute)
+		require.ErrorIs(t, err, requestsign.ErrExpired)
+
+		_, err = requestsign.Verify(req, []byte(body), secrets, now.Add(-6*time.Minute), 5*time.Minute)
+		require.ErrorIs(t, err, requestsign.ErrExpired, "from the future")
+	})
+
+	t.Run("unsigned or malformed", func(t *testing.T) {
+		t.Parallel()
+
+		unsigned := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/internal/v1/users", nil)
+		_, err := requestsign.Verify(unsigned, nil, secrets, now, time.Minute)
+		require.ErrorIs(t, err, requestsign.ErrMissing)
+
+		noNonce := req.Clone(t.Context())
+		noNonce.Header.Del(requestsign.HeaderNonce)
+		_, err = requestsign.Verify(noNonce, []byte(body), secrets, now, time.Minute)
+		require.ErrorIs(t, err, requestsign.ErrInvalid)
+
+		badTimestamp := req.Clone(t.Context())
+		badTimestamp.Header.Set(requestsign.HeaderTimestamp, "yesterday")
+		_, err = requestsign.Verify(badTimestamp, []byte(body), secrets, now, time.Minute)
+		require.ErrorIs(t, err, requestsign.ErrInvalid)
+	})
+}
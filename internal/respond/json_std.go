@@ -0,0 +1,14 @@
+//go:build !jsonv2 || !go1.27
+
+package respond
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// encodeJSON writes v followed by a newline using encoding/json. Build with -tags jsonv2 on Go 1.27
+// or later to use encoding/json/v2 instead.
+func encodeJSON(w io.Writer, v any) error {
+	return json.NewEncoder(w).Encode(v)
+}
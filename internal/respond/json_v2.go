@@ -0,0 +1,29 @@
+//go:build jsonv2 && go1.27
+
+package respond
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"io"
+)
+
+// jsonOptions keep the v2 encoder's output identical to encoding/json for our response types:
+// nil slices and maps stay null and HTML characters are escaped.
+var jsonOptions = jsonv2.JoinOptions(
+	jsonv2.FormatNilSliceAsNull(true),
+	jsonv2.FormatNilMapAsNull(true),
+	jsontext.EscapeForHTML(true),
+)
+
+// encodeJSON writes v followed by a newline using encoding/json/v2.
+func encodeJSON(w io.Writer, v any) error {
+	err := jsonv2.MarshalWrite(w, v, jsonOptions)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n")
+
+	return err
+}
@@ -0,0 +1,102 @@
+// Package respond writes every JSON response the service sends, from handlers and middleware
+// alike, so they share one shape: the resource itself on success and dto.Error on failure.
+package respond
+
+import (
+	"bytes"
+	"maps"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"golang.org/x/text/language"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/i18n"
+)
+
+// maxPooledBufferSize keeps unusually large responses from pinning their buffers in the pool.
+const maxPooledBufferSize = 1 << 20
+
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// JSON writes a JSON response with the given status code. The body is encoded into a pooled
+// buffer before the header is sent, so an encoding failure still yields a clean 500 and the
+// Content-Length is exact, including for HEAD requests served by the GET handler.
+func JSON(w http.ResponseWriter, status int, data any) {
+	if data == nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+
+		return
+	}
+
+	buf, _ := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			bufferPool.Put(buf)
+		}
+	}()
+
+	err := encodeJSON(buf, data)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to encode response", nil)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// Error writes an error response with a machine-readable code and a message for people.
+func Error(w http.ResponseWriter, status int, code, message string, details map[string]string) {
+	Problem(w, status, dto.Error{Code: code, Message: message, Details: details})
+}
+
+// Problem writes an error response. Its messages are translated into the language negotiated
+// for the request, if any; the code stays as it is for machines.
+func Problem(w http.ResponseWriter, status int, body dto.Error) {
+	if lang, ok := i18n.Language(w); ok {
+		localize(&body, lang)
+
+		w.Header().Set("Content-Language", lang.String())
+		w.Header().Add("Vary", "Accept-Language")
+	}
+
+	JSON(w, status, body)
+}
+
+// localize translates the messages of body into lang. Field messages are rebuilt from their
+// rule, and details, which map fields to those messages, follow.
+func localize(body *dto.Error, lang language.Tag) {
+	body.Message = i18n.Translate(lang, body.Message)
+
+	if len(body.Errors) == 0 {
+		return
+	}
+
+	fields := make([]dto.FieldError, len(body.Errors))
+	details := maps.Clone(body.Details)
+
+	for i, field := range body.Errors {
+		if message, ok := i18n.Rule(lang, field.Code, field.Param); ok {
+			field.Message = message
+		}
+
+		if _, ok := details[field.Field]; ok {
+			details[field.Field] = field.Message
+		}
+
+		fields[i] = field
+	}
+
+	body.Errors = fields
+	body.Details = details
+}
@@ -0,0 +1,53 @@
+// Package cron parses the schedules background jobs run on: standard five-field cron
+// expressions ("minute hour day-of-month month day-of-week") and the usual descriptors such as
+// "@daily" or "@every 15m".
+package cron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Schedule is a parsed schedule.
+type Schedule struct {
+	spec     string
+	schedule cron.Schedule
+}
+
+// Parse parses a cron expression or descriptor.
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	// The parser rounds shorter intervals up to a second rather than refusing them
+	if rest, ok := strings.CutPrefix(spec, "@every "); ok {
+		every, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("invalid @every duration: %w", err)
+		}
+
+		if every < time.Second {
+			return nil, fmt.Errorf("@every duration must be at least 1s, got %s", every)
+		}
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: %w", spec, err)
+	}
+
+	return &Schedule{spec: spec, schedule: schedule}, nil
+}
+
+// String returns the schedule as it was written.
+func (s *Schedule) String() string {
+	return s.spec
+}
+
+// Next returns the first time after t the schedule fires, in t's location, or the zero time if
+// it never does.
+func (s *Schedule) Next(t time.Time) time.Time {
+	return s.schedule.Next(t)
+}
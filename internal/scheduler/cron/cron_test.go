@@ -0,0 +1,75 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/scheduler/cron"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	t.Parallel()
+
+	// A Wednesday
+	from := time.Date(2026, time.March, 18, 10, 17, 42, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, time.March, 18, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, time.March, 18, 10, 30, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2026, time.March, 18, 10, 25, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2026, time.March, 19, 3, 0, 0, 0, time.UTC)},
+		{"30 9-17 * * mon-fri", time.Date(2026, time.March, 18, 10, 30, 0, 0, time.UTC)},
+		{"0 0 * * sun", time.Date(2026, time.March, 22, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan *", time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		// Restricting both day fields fires on either
+		{"0 0 1 * fri", time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, time.March, 18, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, time.March, 19, 0, 0, 0, 0, time.UTC)},
+		{"@every 90m", from.Add(90 * time.Minute)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, err := cron.Parse(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(from))
+			assert.Equal(t, tt.spec, schedule.String())
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		// Sunday is 0 only
+		"* * * * 7",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"@fortnightly",
+		"@every soon",
+		"@every 10ms",
+	} {
+		_, err := cron.Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
@@ -0,0 +1,346 @@
+// Package scheduler runs the periodic background jobs (stale device cleanup, follow history
+// purge, search reindex and the like) on cron schedules. A job never overlaps itself: a run
+// that finds the previous one still going, here or on another replica, is skipped.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/errortracking"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/lock"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/metrics"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/scheduler/cron"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// Run triggers.
+const (
+	TriggerSchedule = "schedule"
+	TriggerStartup  = "startup"
+	TriggerAdmin    = "admin"
+)
+
+// lockPrefix namespaces the leases jobs run under, apart from the ones the jobs may take
+// themselves.
+const lockPrefix = "scheduler:"
+
+// ErrSkipped marks a run that did no work because there was nothing it could do, e.g. the same
+// work was already in progress. Wrap it to give the reason.
+var ErrSkipped = errors.New("skipped")
+
+// Job is a unit of periodic work.
+type Job struct {
+	Name string
+	// Schedule is when the job runs. A job without one only runs when an admin starts it.
+	Schedule *cron.Schedule
+	Run      func(ctx context.Context) error
+	// RunOnStart also runs the job as soon as the scheduler starts, for work whose result is
+	// needed before the first scheduled run.
+	RunOnStart bool
+}
+
+// entry is a registered job and its state.
+type entry struct {
+	job     Job
+	running bool
+	next    time.Time
+	lastRun *dto.JobRun
+}
+
+// Scheduler runs registered jobs on their schedules until it is stopped. It implements
+// service.JobService.
+type Scheduler struct {
+	locker   *lock.Locker
+	reporter errortracking.ErrorReporter
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	jobs    map[string]*entry
+	started bool
+}
+
+var _ service.JobService = (*Scheduler)(nil)
+
+// New creates a Scheduler whose jobs run under leases from locker. A nil locker keeps jobs from
+// overlapping on this instance only.
+func New(locker *lock.Locker) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Scheduler{
+		locker:   locker,
+		reporter: errortracking.NoopReporter{},
+		ctx:      ctx,
+		cancel:   cancel,
+		jobs:     make(map[string]*entry),
+	}
+}
+
+// SetErrorReporter sends failed runs to reporter, tagged with the job name and trigger.
+func (s *Scheduler) SetErrorReporter(reporter errortracking.ErrorReporter) {
+	s.reporter = reporter
+}
+
+// Register adds a job. Jobs registered after Start run from the next Start only, so register
+// them all first.
+func (s *Scheduler) Register(job Job) error {
+	if job.Name == "" || job.Run == nil {
+		return errors.New("job needs a name and a function")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.jobs[job.Name]; ok {
+		return fmt.Errorf("job %s is already registered", job.Name)
+	}
+
+	s.jobs[job.Name] = &entry{job: job}
+
+	return nil
+}
+
+// Start runs every registered job on its schedule in the background. It does nothing when
+// called again.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return
+	}
+
+	s.started = true
+
+	for _, e := range s.jobs {
+		if e.job.Schedule != nil || e.job.RunOnStart {
+			s.wg.Go(func() { s.loop(e) })
+		}
+	}
+}
+
+// Stop cancels running jobs and waits for them to return.
+func (s *Scheduler) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// ListJobs returns every registered job by name.
+func (s *Scheduler) ListJobs(_ context.Context) []dto.ScheduledJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := make([]dto.ScheduledJob, 0, len(s.jobs))
+	for _, e := range s.jobs {
+		jobs = append(jobs, e.snapshot())
+	}
+
+	slices.SortFunc(jobs, func(a, b dto.ScheduledJob) int { return strings.Compare(a.Name, b.Name) })
+
+	return jobs
+}
+
+// RunJob starts the named job now, outside its schedule. The run outlives the request and is
+// cancelled only when the scheduler stops.
+func (s *Scheduler) RunJob(_ context.Context, name string) (*dto.ScheduledJob, error) {
+	s.mu.Lock()
+	e, ok := s.jobs[name]
+	s.mu.Unlock()
+
+	if !ok {
+		return nil, service.ErrJobNotFound
+	}
+
+	lease, err := s.begin(s.ctx, e, TriggerAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	s.wg.Go(func() { s.run(lease, e) })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job := e.snapshot()
+
+	return &job, nil
+}
+
+// loop runs e on its schedule until the scheduler stops.
+func (s *Scheduler) loop(e *entry) {
+	if e.job.RunOnStart {
+		s.tick(e, TriggerStartup)
+	}
+
+	if e.job.Schedule == nil {
+		return
+	}
+
+	for {
+		next := e.job.Schedule.Next(time.Now())
+		if next.IsZero() {
+			slog.Warn("job schedule never fires", "job", e.job.Name, "schedule", e.job.Schedule.String())
+
+			return
+		}
+
+		s.mu.Lock()
+		e.next = next
+		s.mu.Unlock()
+
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+
+			return
+		case <-timer.C:
+		}
+
+		s.tick(e, TriggerSchedule)
+	}
+}
+
+// tick runs e unless it is already running here or elsewhere.
+func (s *Scheduler) tick(e *entry, trigger string) {
+	lease, err := s.begin(s.ctx, e, trigger)
+
+	switch {
+	case errors.Is(err, service.ErrJobRunning):
+		slog.Debug("job skipped, it is already running", "job", e.job.Name)
+		metrics.JobRunsTotal.WithLabelValues(e.job.Name, dto.JobOutcomeSkipped).Inc()
+	case err != nil:
+		if s.ctx.Err() == nil {
+			slog.Warn("job skipped", "job", e.job.Name, "error", err)
+		}
+	default:
+		s.run(lease, e)
+	}
+}
+
+// begin marks e running on this instance and takes its lease, or returns ErrJobRunning.
+func (s *Scheduler) begin(ctx context.Context, e *entry, trigger string) (*lock.Lease, error) {
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("failed to start job %s: %w", e.job.Name, ctx.Err())
+	}
+
+	s.mu.Lock()
+	if e.running {
+		s.mu.Unlock()
+
+		return nil, service.ErrJobRunning
+	}
+
+	e.running = true
+	s.mu.Unlock()
+
+	lease, err := s.locker.Acquire(ctx, lockPrefix+e.job.Name)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		e.running = false
+
+		if errors.Is(err, lock.ErrNotAcquired) {
+			return nil, service.ErrJobRunning
+		}
+
+		return nil, fmt.Errorf("failed to start job %s: %w", e.job.Name, err)
+	}
+
+	e.lastRun = &dto.JobRun{Trigger: trigger, StartedAt: time.Now()}
+
+	return lease, nil
+}
+
+// run runs e under lease and records the outcome.
+func (s *Scheduler) run(lease *lock.Lease, e *entry) {
+	s.mu.Lock()
+	started, trigger := e.lastRun.StartedAt, e.lastRun.Trigger
+	s.mu.Unlock()
+
+	err := call(lease.Context(), e.job.Run)
+	finished := time.Now()
+
+	lease.Release()
+
+	outcome := dto.JobOutcomeSuccess
+
+	switch {
+	case errors.Is(err, ErrSkipped):
+		outcome = dto.JobOutcomeSkipped
+
+		slog.Debug("job skipped", "job", e.job.Name, "reason", err)
+	case err != nil:
+		outcome = dto.JobOutcomeFailure
+
+		slog.Error("job failed", "job", e.job.Name, "error", err)
+		s.reporter.Report(s.ctx, errortracking.Event{
+			Err:  err,
+			Tags: map[string]string{"job": e.job.Name, "trigger": trigger},
+		})
+	default:
+		metrics.JobLastSuccess.WithLabelValues(e.job.Name).Set(float64(finished.Unix()))
+	}
+
+	metrics.JobRunsTotal.WithLabelValues(e.job.Name, outcome).Inc()
+	metrics.JobDuration.WithLabelValues(e.job.Name).Observe(finished.Sub(started).Seconds())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e.running = false
+	e.lastRun.Outcome = outcome
+	e.lastRun.FinishedAt = &finished
+
+	if err != nil {
+		e.lastRun.Error = err.Error()
+	}
+}
+
+// call runs fn, turning a panic into an error so one broken job cannot take the instance down.
+func call(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return fn(ctx)
+}
+
+// snapshot copies the state of e; the caller holds the scheduler lock.
+func (e *entry) snapshot() dto.ScheduledJob {
+	job := dto.ScheduledJob{
+		Name:    e.job.Name,
+		Running: e.running,
+	}
+
+	if e.job.Schedule != nil {
+		job.Schedule = e.job.Schedule.String()
+	}
+
+	if !e.next.IsZero() {
+		next := e.next
+		job.NextRunAt = &next
+	}
+
+	if e.lastRun != nil {
+		lastRun := *e.lastRun
+		job.LastRun = &lastRun
+	}
+
+	return job
+}
@@ -0,0 +1,276 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/errortracking"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/lock"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/scheduler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/scheduler/cron"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func mustParse(t *testing.T, spec string) *cron.Schedule {
+	t.Helper()
+
+	schedule, err := cron.Parse(spec)
+	require.NoError(t, err)
+
+	return schedule
+}
+
+// lastRun waits for the named job to finish its latest run.
+func lastRun(t *testing.T, s *scheduler.Scheduler, name string) *dto.JobRun {
+	t.Helper()
+
+	var run *dto.JobRun
+
+	require.Eventually(t, func() bool {
+		for _, job := range s.ListJobs(t.Context()) {
+			if job.Name == name && job.LastRun != nil && job.LastRun.FinishedAt != nil {
+				run = job.LastRun
+
+				return true
+			}
+		}
+
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return run
+}
+
+func TestScheduler_Register(t *testing.T) {
+	t.Parallel()
+
+	s := scheduler.New(nil)
+	job := scheduler.Job{Name: "purge", Schedule: mustParse(t, "@daily"), Run: func(context.Context) error { return nil }}
+
+	require.NoError(t, s.Register(job))
+	require.Error(t, s.Register(job), "names are unique")
+	require.Error(t, s.Register(scheduler.Job{Name: "reindex", Schedule: mustParse(t, "@daily")}))
+}
+
+func TestScheduler_OnDemandJob(t *testing.T) {
+	t.Parallel()
+
+	s := scheduler.New(nil)
+	t.Cleanup(s.Stop)
+
+	require.NoError(t, s.Register(scheduler.Job{Name: "backfill", Run: func(context.Context) error { return nil }}))
+
+	s.Start()
+
+	jobs := s.ListJobs(t.Context())
+	require.Len(t, jobs, 1)
+	assert.Empty(t, jobs[0].Schedule)
+	assert.Nil(t, jobs[0].NextRunAt)
+
+	_, err := s.RunJob(t.Context(), "backfill")
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.TriggerAdmin, lastRun(t, s, "backfill").Trigger)
+}
+
+func TestScheduler_RunJob(t *testing.T) {
+	t.Parallel()
+
+	s := scheduler.New(nil)
+	t.Cleanup(s.Stop)
+
+	release := make(chan struct{})
+	require.NoError(t, s.Register(scheduler.Job{
+		Name:     "purge",
+		Schedule: mustParse(t, "0 3 * * *"),
+		Run: func(ctx context.Context) error {
+			select {
+			case <-release:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	}))
+
+	job, err := s.RunJob(t.Context(), "purge")
+	require.NoError(t, err)
+	assert.True(t, job.Running)
+	assert.Equal(t, scheduler.TriggerAdmin, job.LastRun.Trigger)
+
+	_, err = s.RunJob(t.Context(), "purge")
+	require.ErrorIs(t, err, service.ErrJobRunning, "runs do not overlap")
+
+	_, err = s.RunJob(t.Context(), "reindex")
+	require.ErrorIs(t, err, service.ErrJobNotFound)
+
+	close(release)
+
+	run := lastRun(t, s, "purge")
+	assert.Equal(t, dto.JobOutcomeSuccess, run.Outcome)
+	assert.Empty(t, run.Error)
+}
+
+func TestScheduler_RunJob_OtherReplica(t *testing.T) {
+	t.Parallel()
+
+	store := memory.New()
+	replicaA := scheduler.New(lock.New(store, time.Minute))
+	replicaB := scheduler.New(lock.New(store, time.Minute))
+
+	t.Cleanup(replicaA.Stop)
+	t.Cleanup(replicaB.Stop)
+
+	release := make(chan struct{})
+	for _, s := range []*scheduler.Scheduler{replicaA, replicaB} {
+		require.NoError(t, s.Register(scheduler.Job{
+			Name:     "purge",
+			Schedule: mustParse(t, "@daily"),
+			Run: func(context.Context) error {
+				<-release
+
+				return nil
+			},
+		}))
+	}
+
+	_, err := replicaA.RunJob(t.Context(), "purge")
+	require.NoError(t, err)
+
+	_, err = replicaB.RunJob(t.Context(), "purge")
+	require.ErrorIs(t, err, service.ErrJobRunning)
+
+	close(release)
+	lastRun(t, replicaA, "purge")
+
+	_, err = replicaB.RunJob(t.Context(), "purge")
+	require.NoError(t, err, "the lease is given up once the run ends")
+}
+
+func TestScheduler_Outcomes(t *testing.T) {
+	t.Parallel()
+
+	s := scheduler.New(nil)
+	t.Cleanup(s.Stop)
+
+	jobs := map[string]func(context.Context) error{
+		"failing": func(context.Context) error { return errors.New("database is down") },
+		"skipping": func(context.Context) error {
+			return fmt.Errorf("%w: nothing to purge", scheduler.ErrSkipped)
+		},
+		"panicking": func(context.Context) error { panic("nil map") },
+	}
+	for name, run := range jobs {
+		require.NoError(t, s.Register(scheduler.Job{Name: name, Schedule: mustParse(t, "@hourly"), Run: run}))
+
+		_, err := s.RunJob(t.Context(), name)
+		require.NoError(t, err)
+	}
+
+	failed := lastRun(t, s, "failing")
+	assert.Equal(t, dto.JobOutcomeFailure, failed.Outcome)
+	assert.Equal(t, "database is down", failed.Error)
+
+	assert.Equal(t, dto.JobOutcomeSkipped, lastRun(t, s, "skipping").Outcome)
+
+	panicked := lastRun(t, s, "panicking")
+	assert.Equal(t, dto.JobOutcomeFailure, panicked.Outcome)
+	assert.Contains(t, panicked.Error, "nil map")
+}
+
+type fakeErrorReporter struct {
+	mu     sync.Mutex
+	events []errortracking.Event
+}
+
+func (f *fakeErrorReporter) Report(_ context.Context, event errortracking.Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.events = append(f.events, event)
+}
+
+func TestScheduler_ReportsFailures(t *testing.T) {
+	t.Parallel()
+
+	reporter := &fakeErrorReporter{}
+
+	s := scheduler.New(nil)
+	s.SetErrorReporter(reporter)
+	t.Cleanup(s.Stop)
+
+	jobs := map[string]func(context.Context) error{
+		"failing":  func(context.Context) error { return errors.New("database is down") },
+		"skipping": func(context.Context) error { return scheduler.ErrSkipped },
+	}
+	for name, run := range jobs {
+		require.NoError(t, s.Register(scheduler.Job{Name: name, Run: run}))
+
+		_, err := s.RunJob(t.Context(), name)
+		require.NoError(t, err)
+
+		lastRun(t, s, name)
+	}
+
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+
+	require.Len(t, reporter.events, 1)
+	require.EqualError(t, reporter.events[0].Err, "database is down")
+	assert.Equal(t, map[string]string{"job": "failing", "trigger": scheduler.TriggerAdmin}, reporter.events[0].Tags)
+}
+
+func TestScheduler_Start(t *testing.T) {
+	t.Parallel()
+
+	s := scheduler.New(nil)
+
+	stopped := make(chan struct{})
+	require.NoError(t, s.Register(scheduler.Job{
+		Name:       "reindex",
+		Schedule:   mustParse(t, "@every 1h"),
+		RunOnStart: true,
+		Run:        func(context.Context) error { return nil },
+	}))
+	require.NoError(t, s.Register(scheduler.Job{
+		Name:     "purge",
+		Schedule: mustParse(t, "@every 1h"),
+		Run: func(context.Context) error {
+			close(stopped)
+
+			return nil
+		},
+	}))
+
+	s.Start()
+
+	run := lastRun(t, s, "reindex")
+	assert.Equal(t, scheduler.TriggerStartup, run.Trigger)
+
+	require.Eventually(t, func() bool {
+		jobs := s.ListJobs(t.Context())
+
+		return len(jobs) == 2 && jobs[0].NextRunAt != nil && jobs[1].NextRunAt != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	jobs := s.ListJobs(t.Context())
+	assert.Equal(t, "purge", jobs[0].Name, "jobs are listed by name")
+	assert.Nil(t, jobs[0].LastRun, "purge waits for its schedule")
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *jobs[0].NextRunAt, time.Minute)
+
+	s.Stop()
+
+	select {
+	case <-stopped:
+		t.Fatal("purge ran before its schedule")
+	default:
+	}
+}
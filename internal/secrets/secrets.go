@@ -0,0 +1,142 @@
+// Package secrets resolves credentials from external secret stores.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long a resolved secret is served from cache before being re-fetched.
+const DefaultCacheTTL = 5 * time.Minute
+
+// ErrSecretNotFound is returned when the store has no value for the requested secret.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// Provider resolves named secrets from an external store.
+type Provider interface {
+	GetSecret(ctx context.Context, name string) (string, error)
+}
+
+// RotationHook is called with the new value when a cached secret changes on refresh.
+type RotationHook func(name, value string)
+
+type cachedSecret struct {
+	value     string
+	fetchedAt time.Time
+}
+
+// CachingProvider wraps a Provider, caching values for a TTL and invoking rotation hooks
+// when a re-fetched value differs from the cached one.
+type CachingProvider struct {
+	provider Provider
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cachedSecret
+	hooks   map[string][]RotationHook
+}
+
+// NewCachingProvider creates a CachingProvider. A non-positive ttl uses DefaultCacheTTL.
+func NewCachingProvider(provider Provider, ttl time.Duration) *CachingProvider {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	return &CachingProvider{
+		provider: provider,
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  make(map[string]cachedSecret),
+		hooks:    make(map[string][]RotationHook),
+	}
+}
+
+// GetSecret returns the cached value if it is fresh, otherwise fetches it from the provider.
+func (p *CachingProvider) GetSecret(ctx context.Context, name string) (string, error) {
+	p.mu.Lock()
+	entry, ok := p.entries[name]
+	p.mu.Unlock()
+
+	if ok && p.now().Sub(entry.fetchedAt) < p.ttl {
+		return entry.value, nil
+	}
+
+	return p.fetch(ctx, name)
+}
+
+// OnRotate registers a hook that runs whenever the named secret changes.
+func (p *CachingProvider) OnRotate(name string, hook RotationHook) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.hooks[name] = append(p.hooks[name], hook)
+}
+
+// Refresh re-fetches every cached secret, running rotation hooks for values that changed.
+// Secrets that fail to refresh keep their previous value.
+func (p *CachingProvider) Refresh(ctx context.Context) error {
+	p.mu.Lock()
+
+	names := make([]string, 0, len(p.entries))
+	for name := range p.entries {
+		names = append(names, name)
+	}
+
+	p.mu.Unlock()
+
+	var errs []error
+
+	for _, name := range names {
+		_, err := p.fetch(ctx, name)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Watch refreshes cached secrets every interval until ctx is cancelled.
+func (p *CachingProvider) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := p.Refresh(ctx)
+			if err != nil {
+				slog.Warn("failed to refresh secrets", "error", err)
+			}
+		}
+	}
+}
+
+func (p *CachingProvider) fetch(ctx context.Context, name string) (string, error) {
+	value, err := p.provider.GetSecret(ctx, name)
+	if err != nil {
+		return "", err //nolint:wrapcheck // provider errors are already wrapped
+	}
+
+	p.mu.Lock()
+	previous, existed := p.entries[name]
+	p.entries[name] = cachedSecret{value: value, fetchedAt: p.now()}
+	hooks := p.hooks[name]
+	p.mu.Unlock()
+
+	if existed && previous.value != value {
+		slog.Info("secret rotated", "name", name)
+
+		for _, hook := range hooks {
+			hook(name, value)
+		}
+	}
+
+	return value, nil
+}
@@ -0,0 +1,101 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errStoreDown = errors.New("store down")
+
+type fakeProvider struct {
+	values map[string]string
+	err    error
+	calls  int
+}
+
+func (f *fakeProvider) GetSecret(_ context.Context, name string) (string, error) {
+	f.calls++
+
+	if f.err != nil {
+		return "", f.err
+	}
+
+	value, ok := f.values[name]
+	if !ok {
+		return "", ErrSecretNotFound
+	}
+
+	return value, nil
+}
+
+func TestCachingProvider_CachesUntilTTL(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	fake := &fakeProvider{values: map[string]string{"db": "one"}}
+	p := NewCachingProvider(fake, time.Minute)
+	p.now = func() time.Time { return now }
+
+	for range 3 {
+		value, err := p.GetSecret(t.Context(), "db")
+		require.NoError(t, err)
+		assert.Equal(t, "one", value)
+	}
+
+	assert.Equal(t, 1, fake.calls)
+
+	now = now.Add(time.Minute)
+	fake.values["db"] = "two"
+
+	value, err := p.GetSecret(t.Context(), "db")
+	require.NoError(t, err)
+	assert.Equal(t, "two", value)
+	assert.Equal(t, 2, fake.calls)
+}
+
+func TestCachingProvider_NotFound(t *testing.T) {
+	t.Parallel()
+
+	p := NewCachingProvider(&fakeProvider{}, 0)
+
+	_, err := p.GetSecret(t.Context(), "missing")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+}
+
+func TestCachingProvider_RefreshRunsRotationHooks(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeProvider{values: map[string]string{"db": "one", "cache": "same"}}
+	p := NewCachingProvider(fake, time.Minute)
+
+	var rotated []string
+
+	hook := func(name, value string) { rotated = append(rotated, name+"="+value) }
+	p.OnRotate("db", hook)
+	p.OnRotate("cache", hook)
+
+	_, err := p.GetSecret(t.Context(), "db")
+	require.NoError(t, err)
+	_, err = p.GetSecret(t.Context(), "cache")
+	require.NoError(t, err)
+	assert.Empty(t, rotated)
+
+	fake.values["db"] = "two"
+
+	require.NoError(t, p.Refresh(t.Context()))
+	assert.Equal(t, []string{"db=two"}, rotated)
+
+	// A failed refresh keeps the previous value
+	fake.err = errStoreDown
+
+	require.ErrorIs(t, p.Refresh(t.Context()), errStoreDown)
+
+	value, err := p.GetSecret(t.Context(), "db")
+	require.NoError(t, err)
+	assert.Equal(t, "two", value)
+}
@@ -0,0 +1,110 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const defaultVaultTimeout = 10 * time.Second
+
+// ErrVaultRequestFailed is returned when Vault responds with an unexpected status.
+var ErrVaultRequestFailed = errors.New("vault request failed")
+
+// VaultConfig configures a VaultProvider.
+type VaultConfig struct {
+	// Address is the Vault server URL, e.g. https://vault.example.com:8200.
+	Address string
+	// Token is the Vault token used to authenticate.
+	Token string
+	// Mount is the KV v2 secrets engine mount. Defaults to "secret".
+	Mount string
+	// Path is the secret path under the mount holding this service's secrets.
+	Path string
+	// Timeout bounds each request to Vault.
+	Timeout time.Duration
+}
+
+// VaultProvider reads secrets from a single HashiCorp Vault KV v2 secret, where each
+// key of the secret's data is a secret name.
+type VaultProvider struct {
+	httpClient *http.Client
+	url        string
+	token      string
+}
+
+// NewVaultProvider creates a new VaultProvider.
+func NewVaultProvider(cfg VaultConfig) *VaultProvider {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultVaultTimeout
+	}
+
+	return NewVaultProviderWithHTTP(cfg, &http.Client{Timeout: timeout})
+}
+
+// NewVaultProviderWithHTTP creates a VaultProvider with a custom HTTP client (for testing).
+func NewVaultProviderWithHTTP(cfg VaultConfig, httpClient *http.Client) *VaultProvider {
+	mount := cfg.Mount
+	if mount == "" {
+		mount = "secret"
+	}
+
+	return &VaultProvider{
+		httpClient: httpClient,
+		url: fmt.Sprintf("%s/v1/%s/data/%s",
+			strings.TrimSuffix(cfg.Address, "/"),
+			strings.Trim(mount, "/"),
+			strings.Trim(cfg.Path, "/")),
+		token: cfg.Token,
+	}
+}
+
+type vaultKVResponse struct {
+	Data struct {
+		Data map[string]any `json:"data"`
+	} `json:"data"`
+}
+
+// GetSecret returns the named key from the configured Vault secret.
+func (p *VaultProvider) GetSecret(ctx context.Context, name string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create vault request: %w", err)
+	}
+
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("vault request failed: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return "", fmt.Errorf("%w: %s", ErrSecretNotFound, name)
+	default:
+		return "", fmt.Errorf("%w: status %d", ErrVaultRequestFailed, resp.StatusCode)
+	}
+
+	var body vaultKVResponse
+
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode vault response: %w", err)
+	}
+
+	value, ok := body.Data.Data[name].(string)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrSecretNotFound, name)
+	}
+
+	return value, nil
+}
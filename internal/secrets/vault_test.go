@@ -0,0 +1,54 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultProvider_GetSecret(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/kv/data/user-management", r.URL.Path)
+
+		if r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"data":{"postgres_password":"s3cret"}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := VaultConfig{Address: server.URL + "/", Token: "root", Mount: "kv", Path: "/user-management"}
+	p := NewVaultProviderWithHTTP(cfg, server.Client())
+
+	value, err := p.GetSecret(t.Context(), "postgres_password")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", value)
+
+	_, err = p.GetSecret(t.Context(), "redis_password")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	cfg.Token = "wrong"
+	_, err = NewVaultProviderWithHTTP(cfg, server.Client()).GetSecret(t.Context(), "postgres_password")
+	require.ErrorIs(t, err, ErrVaultRequestFailed)
+}
+
+func TestVaultProvider_MissingSecretPath(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	p := NewVaultProviderWithHTTP(VaultConfig{Address: server.URL, Path: "missing"}, server.Client())
+
+	_, err := p.GetSecret(t.Context(), "postgres_password")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+}
@@ -0,0 +1,225 @@
+// Package seed loads fixture files into the PostgreSQL database.
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// wipeTables lists the tables cleared by a wipe, children before parents so foreign keys hold.
+// Rows owned by other services that reference users make the wipe fail rather than cascade.
+var wipeTables = []string{
+	"recipe_manager.user_follows",
+	"recipe_manager.user_handles",
+	"recipe_manager.user_notification_preferences",
+	"recipe_manager.user_display_preferences",
+	"recipe_manager.user_privacy_preferences",
+	"recipe_manager.user_accessibility_preferences",
+	"recipe_manager.user_language_preferences",
+	"recipe_manager.user_security_preferences",
+	"recipe_manager.user_social_preferences",
+	"recipe_manager.user_sound_preferences",
+	"recipe_manager.user_theme_preferences",
+	"recipe_manager.users",
+}
+
+// Options controls a seed run.
+type Options struct {
+	// Wipe deletes all users, follows, handles and preferences before loading.
+	Wipe bool
+}
+
+// Result summarizes a seed run.
+type Result struct {
+	Users       int
+	Follows     int
+	Preferences int
+}
+
+// Seeder upserts fixtures into the database.
+type Seeder struct {
+	db          *sql.DB
+	preferences repository.PreferenceRepository
+}
+
+// New creates a Seeder for db.
+func New(db *sql.DB) *Seeder {
+	return &Seeder{
+		db:          db,
+		preferences: repository.NewPreferenceRepository(db),
+	}
+}
+
+// Load writes the fixtures to the database. Users are upserted by ID and follows are only added,
+// so loading the same file again leaves the database unchanged. The wipe, users and follows are
+// applied in one transaction; preferences are upserted afterwards.
+func (s *Seeder) Load(ctx context.Context, f *fixtures.Fixtures, opts Options) (*Result, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin seed transaction: %w", err)
+	}
+
+	result := &Result{}
+
+	err = s.loadGraph(ctx, tx, f, opts, result)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit seed transaction: %w", err)
+	}
+
+	for i := range f.Users {
+		if f.Users[i].Preferences == nil {
+			continue
+		}
+
+		count, err := s.upsertPreferences(ctx, &f.Users[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to seed preferences for %s: %w", f.Users[i].Username, err)
+		}
+
+		result.Preferences += count
+	}
+
+	return result, nil
+}
+
+func (s *Seeder) loadGraph(
+	ctx context.Context,
+	tx *sql.Tx,
+	f *fixtures.Fixtures,
+	opts Options,
+	result *Result,
+) error {
+	if opts.Wipe {
+		for _, table := range wipeTables {
+			// table comes from the fixed list above, never from input
+			_, err := tx.ExecContext(ctx, "DELETE FROM "+table)
+			if err != nil {
+				return fmt.Errorf("failed to wipe %s: %w", table, err)
+			}
+		}
+	}
+
+	for i := range f.Users {
+		err := upsertUser(ctx, tx, &f.Users[i])
+		if err != nil {
+			return err
+		}
+
+		result.Users++
+	}
+
+	for _, follow := range f.Follows {
+		follower, _ := f.UserID(follow.Follower)
+		followee, _ := f.UserID(follow.Followee)
+
+		// NOT EXISTS rather than ON CONFLICT so the "already following" trigger never fires
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO recipe_manager.user_follows (follower_id, followee_id, followed_at)
+			SELECT $1, $2, NOW()
+			WHERE NOT EXISTS (
+				SELECT 1 FROM recipe_manager.user_follows
+				WHERE follower_id = $1 AND followee_id = $2 AND deleted_at IS NULL
+			)
+		`, follower, followee)
+		if err != nil {
+			return fmt.Errorf("failed to seed follow %s -> %s: %w", follow.Follower, follow.Followee, err)
+		}
+
+		result.Follows++
+	}
+
+	return nil
+}
+
+func upsertUser(ctx context.Context, tx *sql.Tx, user *fixtures.User) error {
+	query := `
+		INSERT INTO recipe_manager.users (user_id, username, email, full_name, bio, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		ON CONFLICT (user_id) DO UPDATE SET
+			username = EXCLUDED.username,
+			email = EXCLUDED.email,
+			full_name = EXCLUDED.full_name,
+			bio = EXCLUDED.bio,
+			is_active = EXCLUDED.is_active,
+			updated_at = NOW()
+	`
+
+	_, err := tx.ExecContext(ctx, query,
+		user.ID(), user.Username, user.Email, user.FullName, user.Bio, user.Active())
+	if err != nil {
+		return fmt.Errorf("failed to seed user %s: %w", user.Username, err)
+	}
+
+	return nil
+}
+
+// upsertPreferences saves each category in the user's fixture and returns how many were written.
+func (s *Seeder) upsertPreferences(ctx context.Context, user *fixtures.User) (int, error) {
+	var (
+		userID = user.ID()
+		prefs  = user.Preferences
+		steps  []func() error
+	)
+
+	add := func(present bool, step func() error) {
+		if present {
+			steps = append(steps, step)
+		}
+	}
+
+	add(prefs.Notification != nil, func() error {
+		_, err := s.preferences.UpdateNotificationPreferences(ctx, userID, prefs.Notification)
+		return err
+	})
+	add(prefs.Display != nil, func() error {
+		_, err := s.preferences.UpdateDisplayPreferences(ctx, userID, prefs.Display)
+		return err
+	})
+	add(prefs.Privacy != nil, func() error {
+		_, err := s.preferences.UpdatePrivacyPreferencesData(ctx, userID, prefs.Privacy)
+		return err
+	})
+	add(prefs.Accessibility != nil, func() error {
+		_, err := s.preferences.UpdateAccessibilityPreferences(ctx, userID, prefs.Accessibility)
+		return err
+	})
+	add(prefs.Language != nil, func() error {
+		_, err := s.preferences.UpdateLanguagePreferences(ctx, userID, prefs.Language)
+		return err
+	})
+	add(prefs.Security != nil, func() error {
+		_, err := s.preferences.UpdateSecurityPreferences(ctx, userID, prefs.Security)
+		return err
+	})
+	add(prefs.Social != nil, func() error {
+		_, err := s.preferences.UpdateSocialPreferences(ctx, userID, prefs.Social)
+		return err
+	})
+	add(prefs.Sound != nil, func() error {
+		_, err := s.preferences.UpdateSoundPreferences(ctx, userID, prefs.Sound)
+		return err
+	})
+	add(prefs.Theme != nil, func() error {
+		_, err := s.preferences.UpdateThemePreferences(ctx, userID, prefs.Theme)
+		return err
+	})
+
+	for _, step := range steps {
+		err := step()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(steps), nil
+}
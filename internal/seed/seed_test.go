@@ -0,0 +1,130 @@
+package seed_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/seed"
+)
+
+const (
+	upsertUserQuery    = `INSERT INTO recipe_manager\.users .* ON CONFLICT \(user_id\) DO UPDATE`
+	insertFollowQuery  = `INSERT INTO recipe_manager\.user_follows .* WHERE NOT EXISTS`
+	upsertDisplayQuery = `INSERT INTO recipe_manager\.user_display_preferences`
+)
+
+func newFixtures(t *testing.T) *fixtures.Fixtures {
+	t.Helper()
+
+	large := dto.FontSizeLarge
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{
+			{Username: "alice", Preferences: &dto.UserPreferencesUpdateRequest{
+				Display: &dto.DisplayPreferencesUpdate{FontSize: &large},
+			}},
+			{Username: "bob"},
+		},
+		Follows: []fixtures.Follow{{Follower: "alice", Followee: "bob"}},
+	}
+	require.NoError(t, f.Validate())
+
+	return f
+}
+
+func TestSeeder_Load(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success - upserts users, follows and preferences", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		f := newFixtures(t)
+		alice, _ := f.UserID("alice")
+		bob, _ := f.UserID("bob")
+
+		mock.ExpectBegin()
+		mock.ExpectExec(upsertUserQuery).
+			WithArgs(alice, "alice", nil, nil, nil, true).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(upsertUserQuery).
+			WithArgs(bob, "bob", nil, nil, nil, true).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(insertFollowQuery).
+			WithArgs(alice, bob).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(upsertDisplayQuery).
+			WillReturnRows(sqlmock.NewRows(
+				[]string{"font_size", "color_scheme", "layout_density", "show_images", "compact_mode", "updated_at"},
+			).AddRow("LARGE", "LIGHT", "COMFORTABLE", true, false, time.Now()))
+		mock.ExpectCommit()
+		mock.ExpectClose()
+
+		result, err := seed.New(db).Load(t.Context(), f, seed.Options{})
+		require.NoError(t, err)
+		assert.Equal(t, &seed.Result{Users: 2, Follows: 1, Preferences: 1}, result)
+	})
+
+	t.Run("Success - wipe clears tables first", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		f := &fixtures.Fixtures{}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM recipe_manager\.user_follows`).WillReturnResult(sqlmock.NewResult(0, 3))
+
+		for range 10 {
+			mock.ExpectExec(`DELETE FROM recipe_manager\.user_`).WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+
+		mock.ExpectExec(`DELETE FROM recipe_manager\.users`).WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectCommit()
+		mock.ExpectClose()
+
+		result, err := seed.New(db).Load(t.Context(), f, seed.Options{Wipe: true})
+		require.NoError(t, err)
+		assert.Equal(t, &seed.Result{}, result)
+	})
+
+	t.Run("Error - rolls back on failure", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+
+		dbErr := errors.New("connection reset")
+
+		mock.ExpectBegin()
+		mock.ExpectExec(upsertUserQuery).WillReturnError(dbErr)
+		mock.ExpectRollback()
+		mock.ExpectClose()
+
+		_, err = seed.New(db).Load(t.Context(), newFixtures(t), seed.Options{})
+		require.ErrorIs(t, err, dbErr)
+	})
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnosticsRoutes(t *testing.T) {
+	t.Parallel()
+
+	routes := DiagnosticsRoutes(Handlers{Diagnostics: handler.NewDiagnosticsHandler()})
+
+	t.Run("runtime stats", func(t *testing.T) {
+		t.Parallel()
+
+		rr := httptest.NewRecorder()
+		routes.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/debug/runtime", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var stats dto.RuntimeStatsResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &stats))
+		assert.Positive(t, stats.Goroutines)
+		assert.Positive(t, stats.Heap.AllocBytes)
+		assert.NotEmpty(t, stats.GoVersion)
+	})
+
+	t.Run("goroutine dump", func(t *testing.T) {
+		t.Parallel()
+
+		rr := httptest.NewRecorder()
+		routes.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/debug/goroutines", nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "goroutine ")
+	})
+
+	t.Run("pprof named profile", func(t *testing.T) {
+		t.Parallel()
+
+		rr := httptest.NewRecorder()
+		routes.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/debug/pprof/heap?debug=1", nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "heap profile")
+	})
+}
+
+func TestDiagnostics_MainPortRequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(diagnostics config.DiagnosticsConfig) *http.Server {
+		return NewServerWithContainer(&app.Container{
+			Config:        &config.Config{Diagnostics: diagnostics},
+			HealthService: service.NewHealthService(nil, nil),
+		})
+	}
+
+	request := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/debug/runtime", nil)
+		req.Header.Set("X-User-Id", uuid.New().String())
+
+		return req
+	}
+
+	// Header-authenticated users carry no scopes, so they are not admins
+	rr := httptest.NewRecorder()
+	newServer(config.DiagnosticsConfig{Enabled: true}).Handler.ServeHTTP(rr, request())
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+
+	// Not mounted on the main port when disabled or served on the internal port
+	rr = httptest.NewRecorder()
+	newServer(config.DiagnosticsConfig{Enabled: true, Port: 6060}).Handler.ServeHTTP(rr, request())
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	rr = httptest.NewRecorder()
+	newServer(config.DiagnosticsConfig{}).Handler.ServeHTTP(rr, request())
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestNewDiagnosticsServer(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, NewDiagnosticsServer(&app.Container{}))
+	assert.Nil(t, NewDiagnosticsServer(&app.Container{
+		Config: &config.Config{Diagnostics: config.DiagnosticsConfig{Enabled: true}},
+	}))
+
+	srv := NewDiagnosticsServer(&app.Container{
+		Config: &config.Config{Diagnostics: config.DiagnosticsConfig{Enabled: true, Port: 6060}},
+	})
+	require.NotNil(t, srv)
+	assert.Equal(t, "127.0.0.1:6060", srv.Addr, "loopback unless another address is configured")
+
+	srv = NewDiagnosticsServer(&app.Container{
+		Config: &config.Config{Diagnostics: config.DiagnosticsConfig{Enabled: true, Port: 6060, Host: "0.0.0.0"}},
+	})
+	require.NotNil(t, srv)
+	assert.Equal(t, "0.0.0.0:6060", srv.Addr)
+}
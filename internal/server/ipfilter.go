@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+)
+
+// IPFilterReloader re-reads the IP filter rules from their config file when it changes, so
+// allowlists can be updated without a restart.
+type IPFilterReloader struct {
+	file   string
+	filter *middleware.IPFilter
+}
+
+// NewIPFilterReloader creates a reloader applying the rules in file to filter.
+func NewIPFilterReloader(file string, filter *middleware.IPFilter) *IPFilterReloader {
+	return &IPFilterReloader{file: file, filter: filter}
+}
+
+// Reload re-reads the rules. On failure the current rules stay in force.
+func (r *IPFilterReloader) Reload() error {
+	cfg, err := config.ReadIPFilterFile(r.file)
+	if err != nil {
+		return err
+	}
+
+	rules, err := app.IPFilterRules(cfg)
+	if err != nil {
+		return err
+	}
+
+	if !cfg.Enabled {
+		// Switching the filter on or off changes the middleware chain, which needs a restart
+		slog.Warn("ipfilter.enabled is false in the reloaded file; restart to disable the filter", "file", r.file)
+	}
+
+	r.filter.SetRules(rules)
+
+	return nil
+}
+
+// Watch reloads the rules whenever the file's directory changes, until ctx is cancelled.
+// The directory is watched rather than the file because mounted config maps are swapped via
+// symlinks.
+func (r *IPFilterReloader) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create IP filter watcher: %w", err)
+	}
+
+	defer func() { _ = watcher.Close() }()
+
+	dir := filepath.Dir(r.file)
+
+	err = watcher.Add(dir)
+	if err != nil {
+		return fmt.Errorf("failed to watch %s: %w", dir, err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+				continue
+			}
+
+			err = r.Reload()
+			if err != nil {
+				slog.Warn("failed to reload IP filter rules", "error", err)
+
+				continue
+			}
+
+			slog.Info("reloaded IP filter rules", "file", r.file)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			slog.Warn("IP filter watcher error", "error", err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeIPFilterFile(t *testing.T, file, allow string) {
+	t.Helper()
+
+	contents := "ipfilter:\n  enabled: true\n  admin:\n    allow: [\"" + allow + "\"]\n"
+	require.NoError(t, os.WriteFile(file, []byte(contents), 0600))
+}
+
+func adminStatus(filter *middleware.IPFilter, remoteAddr string) int {
+	h := filter.Middleware(middleware.IPFilterGroupAdmin)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/stats", nil)
+	req.RemoteAddr = remoteAddr
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	return rr.Code
+}
+
+//nolint:paralleltest // t.Setenv clears IP filter environment overrides
+func TestIPFilterReloader(t *testing.T) {
+	for _, name := range []string{
+		"IP_FILTER_ENABLED", "IP_FILTER_TRUSTED_PROXIES", "IP_FILTER_ADMIN_ALLOW", "IP_FILTER_ADMIN_DENY",
+		"IP_FILTER_INTERNAL_ALLOW", "IP_FILTER_INTERNAL_DENY", "IP_FILTER_FILE",
+	} {
+		t.Setenv(name, "")
+	}
+
+	file := filepath.Join(t.TempDir(), "ipfilter.yaml")
+	writeIPFilterFile(t, file, "192.168.1.0/24")
+
+	filter := middleware.NewIPFilter(middleware.IPFilterRules{})
+	reloader := NewIPFilterReloader(file, filter)
+	require.NoError(t, reloader.Reload())
+	assert.Equal(t, http.StatusForbidden, adminStatus(filter, "198.51.100.7:80"))
+
+	go func() { _ = reloader.Watch(t.Context()) }()
+
+	// Give the watcher time to register before changing the rules
+	time.Sleep(100 * time.Millisecond)
+	writeIPFilterFile(t, file, "198.51.100.0/24")
+
+	assert.Eventually(t, func() bool {
+		return adminStatus(filter, "198.51.100.7:80") == http.StatusOK
+	}, 5*time.Second, 20*time.Millisecond)
+
+	t.Run("invalid rules keep the current ones", func(t *testing.T) {
+		writeIPFilterFile(t, file, "198.51.100.0/33")
+		require.Error(t, reloader.Reload())
+		assert.Equal(t, http.StatusOK, adminStatus(filter, "198.51.100.7:80"))
+	})
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+)
+
+// ListenerConfigs returns the configured listeners, defaulting to a single TCP listener on srv.Addr.
+func ListenerConfigs(cfg *config.Config, srv *http.Server) []config.ListenerConfig {
+	if cfg != nil && len(cfg.Server.Listeners) > 0 {
+		return cfg.Server.Listeners
+	}
+
+	return []config.ListenerConfig{{Network: "tcp", Address: srv.Addr}}
+}
+
+// Listen opens every configured listener. If any fails, those already opened are closed.
+func Listen(listenerCfgs []config.ListenerConfig) ([]net.Listener, error) {
+	listeners := make([]net.Listener, 0, len(listenerCfgs))
+
+	for _, listenerCfg := range listenerCfgs {
+		listener, err := listen(listenerCfg)
+		if err != nil {
+			for _, opened := range listeners {
+				_ = opened.Close()
+			}
+
+			return nil, err
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return listeners, nil
+}
+
+func listen(listenerCfg config.ListenerConfig) (net.Listener, error) {
+	if listenerCfg.Network == "unix" {
+		// A socket left behind by an unclean shutdown would make the bind fail
+		err := os.Remove(listenerCfg.Address)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to remove stale socket %s: %w", listenerCfg.Address, err)
+		}
+	}
+
+	listener, err := net.Listen(listenerCfg.Network, listenerCfg.Address) //nolint:noctx // startup only
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s %s: %w", listenerCfg.Network, listenerCfg.Address, err)
+	}
+
+	if listenerCfg.Network == "unix" && listenerCfg.SocketMode != "" {
+		mode, err := strconv.ParseUint(listenerCfg.SocketMode, 8, 32)
+		if err == nil {
+			err = os.Chmod(listenerCfg.Address, fs.FileMode(mode))
+		}
+
+		if err != nil {
+			_ = listener.Close()
+
+			return nil, fmt.Errorf("failed to set mode on socket %s: %w", listenerCfg.Address, err)
+		}
+	}
+
+	return listener, nil
+}
+
+// Serve serves srv on every listener. Each listener's terminal error other than
+// http.ErrServerClosed is sent on the returned channel. srv.Shutdown stops all of them
+// together and removes unix socket files.
+func Serve(srv *http.Server, listeners []net.Listener, useTLS bool) <-chan error {
+	errs := make(chan error, len(listeners))
+
+	for _, listener := range listeners {
+		go func() {
+			var err error
+			if useTLS {
+				// Certificates are served by TLSConfig.GetCertificate
+				err = srv.ServeTLS(listener, "", "")
+			} else {
+				err = srv.Serve(listener)
+			}
+
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errs <- fmt.Errorf("listener %s: %w", listener.Addr(), err)
+			}
+		}()
+	}
+
+	return errs
+}
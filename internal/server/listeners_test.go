@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenerConfigs(t *testing.T) {
+	t.Parallel()
+
+	srv := &http.Server{Addr: ":8080", ReadHeaderTimeout: time.Second}
+
+	assert.Equal(t, []config.ListenerConfig{{Network: "tcp", Address: ":8080"}}, ListenerConfigs(nil, srv))
+
+	listeners := []config.ListenerConfig{{Network: "unix", Address: "/run/usermgmt.sock"}}
+	cfg := &config.Config{Server: config.ServerConfig{Listeners: listeners}}
+	assert.Equal(t, listeners, ListenerConfigs(cfg, srv))
+}
+
+func TestServe_TCPAndUnixListeners(t *testing.T) {
+	t.Parallel()
+
+	// Unix socket paths are length-limited, so avoid the long t.TempDir path
+	dir, err := os.MkdirTemp("", "usermgmt")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	socketPath := filepath.Join(dir, "api.sock")
+
+	// A stale socket file from a previous run must not block startup
+	require.NoError(t, os.WriteFile(socketPath, nil, 0600))
+
+	listeners, err := Listen([]config.ListenerConfig{
+		{Network: "tcp", Address: "127.0.0.1:0"},
+		{Network: "unix", Address: socketPath, SocketMode: "0660"},
+	})
+	require.NoError(t, err)
+
+	info, err := os.Stat(socketPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0660), info.Mode().Perm())
+
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("ok"))
+		}),
+		ReadHeaderTimeout: time.Second,
+	}
+	errs := Serve(srv, listeners, false)
+
+	tcpClient := &http.Client{}
+	unixClient := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+		},
+	}}
+
+	assertResponds := func(client *http.Client, url string) {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+		require.NoError(t, err)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+
+		defer func() { _ = resp.Body.Close() }()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", string(body))
+	}
+
+	assertResponds(tcpClient, "http://"+listeners[0].Addr().String())
+	assertResponds(unixClient, "http://unix/")
+
+	// Shutdown stops every listener together and removes the socket file
+	require.NoError(t, srv.Shutdown(t.Context()))
+
+	_, err = os.Stat(socketPath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	assert.Empty(t, errs)
+}
+
+func TestListen_ClosesOpenedListenersOnFailure(t *testing.T) {
+	t.Parallel()
+
+	_, err := Listen([]config.ListenerConfig{
+		{Network: "tcp", Address: "127.0.0.1:0"},
+		{Network: "unix", Address: filepath.Join(t.TempDir(), "missing", "api.sock")},
+	})
+	require.Error(t, err)
+}
@@ -2,6 +2,9 @@ package server
 
 import (
 	"net/http"
+	"net/http/pprof"
+	"net/netip"
+	"slices"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -10,51 +13,264 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dataloader"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/errortracking"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
 	customMiddleware "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
 )
 
 // Handlers contains all HTTP handlers.
 type Handlers struct {
-	Health     *handler.HealthHandler
-	User       *handler.UserHandler
-	Social     *handler.SocialHandler
-	Admin      *handler.AdminHandler
-	Metrics    *handler.MetricsHandler
-	Preference *handler.PreferenceHandler
+	Health          *handler.HealthHandler
+	User            *handler.UserHandler
+	Social          *handler.SocialHandler
+	Admin           *handler.AdminHandler
+	Metrics         *handler.MetricsHandler
+	Preference      *handler.PreferenceHandler
+	ChangeFeed      *handler.ChangeFeedHandler
+	EmailChange     *handler.EmailChangeHandler
+	Recovery        *handler.AccountRecoveryHandler
+	Handle          *handler.HandleHandler
+	ProfileShare    *handler.ProfileShareHandler
+	Diagnostics     *handler.DiagnosticsHandler
+	PrivacyReport   *handler.PrivacyReportHandler
+	Consent         *handler.ConsentHandler
+	Policy          *handler.PolicyHandler
+	Age             *handler.AgeHandler
+	AdminNote       *handler.AdminNoteHandler
+	Role            *handler.RoleHandler
+	Organization    *handler.OrganizationHandler
+	Invitation      *handler.InvitationHandler
+	PrefCenter      *handler.PreferenceCenterHandler
+	ListUnsub       *handler.ListUnsubscribeHandler
+	Moderation      *handler.ModerationHandler
+	Device          *handler.DeviceHandler
+	Insights        *handler.FollowerInsightsHandler
+	Typeahead       *handler.TypeaheadHandler
+	Maintenance     *handler.MaintenanceHandler
+	Drain           *handler.DrainHandler
+	Job             *handler.JobHandler
+	PrivacyDefaults *handler.PrivacyDefaultsHandler
+	Presence        *handler.PresenceHandler
+	RecentlyViewed  *handler.RecentlyViewedHandler
+	Badge           *handler.BadgeHandler
+	Mention         *handler.MentionHandler
+	Embed           *handler.EmbedHandler
+	Directory       *handler.DirectoryHandler
+}
+
+// RouteGroupSharedProfiles is the public route group serving shared profile links.
+const RouteGroupSharedProfiles = "shared-profiles"
+
+// RouteGroupEmbeds is the public route group serving the link previews of public profiles.
+const RouteGroupEmbeds = "embeds"
+
+// AccessConfig controls where the routes are mounted, throttling and anonymous access.
+type AccessConfig struct {
+	// BasePath prefixes the public API routes; empty uses config.DefaultBasePath.
+	BasePath string
+
+	RateLimit customMiddleware.RateLimitConfig
+
+	// AnonymousDisabledGroups lists public route groups that require authentication instead.
+	AnonymousDisabledGroups []string
+
+	// Shadow mirrors sampled reads on authenticated routes to a candidate implementation.
+	Shadow customMiddleware.ShadowConfig
+
+	// DataAccess records reads of user data by service accounts for the privacy report.
+	DataAccess customMiddleware.DataAccessRecorder
+
+	// Roles looks up the role stored on the authenticated user's account for authorization checks.
+	// Without it every user is a plain user, and only the admin scope makes an admin.
+	Roles customMiddleware.RoleResolver
+
+	// Organizations lets members act as their organizations with the X-Act-As header. Without it
+	// the header is refused.
+	Organizations customMiddleware.OrganizationActor
+
+	// Policies gates mutating routes on acceptance of the current terms and privacy policy when set.
+	Policies customMiddleware.PolicyChecker
+
+	// DiagnosticsEnabled mounts /debug on the main router, restricted to the admin scope.
+	DiagnosticsEnabled bool
+
+	// Maintenance rejects mutating routes while maintenance mode is on when set.
+	Maintenance customMiddleware.MaintenanceChecker
+
+	// MaintenanceRetryAfter is the Retry-After sent with writes rejected for maintenance.
+	MaintenanceRetryAfter time.Duration
+
+	// ErrorReporter receives panics and 5xx responses when set.
+	ErrorReporter errortracking.ErrorReporter
+
+	// SlowRequests writes a diagnostic bundle for requests over a threshold.
+	SlowRequests customMiddleware.SlowRequestConfig
+
+	// SecurityHeaders sets security headers on every response.
+	SecurityHeaders customMiddleware.SecurityHeadersConfig
+
+	// IPFilter restricts the admin and internal routes by client address when set.
+	IPFilter *customMiddleware.IPFilter
+
+	// TrustedProxies returns the proxies whose X-Forwarded-For headers name the client. Without
+	// any, the client is the connection's peer.
+	TrustedProxies func() []netip.Prefix
+
+	// RequestSigning requires HMAC-signed requests on the internal routes.
+	RequestSigning customMiddleware.RequestSigningConfig
+}
+
+// basePath returns the prefix the public API routes are mounted under.
+func (c AccessConfig) basePath() string {
+	if c.BasePath == "" {
+		return config.DefaultBasePath
+	}
+
+	return c.BasePath
+}
+
+// errorReporter returns the configured error reporter, or one that drops every event.
+func (c AccessConfig) errorReporter() errortracking.ErrorReporter {
+	if c.ErrorReporter == nil {
+		return errortracking.NoopReporter{}
+	}
+
+	return c.ErrorReporter
+}
+
+// anonymousAllowed reports whether a public route group accepts anonymous callers.
+func (c AccessConfig) anonymousAllowed(group string) bool {
+	return !slices.Contains(c.AnonymousDisabledGroups, group)
 }
 
 // RegisterRoutesWithHandlers creates routes with injected handlers.
-func RegisterRoutesWithHandlers(h Handlers, authCfg customMiddleware.AuthConfig) http.Handler {
+func RegisterRoutesWithHandlers(
+	h Handlers,
+	authCfg customMiddleware.AuthConfig,
+	accessCfg AccessConfig,
+) http.Handler {
 	r := chi.NewRouter()
 
-	setupMiddleware(r)
+	setupMiddleware(r, accessCfg)
+
+	// Shared across groups so a caller has one allowance regardless of the route hit
+	rateLimit := customMiddleware.RateLimit(accessCfg.RateLimit)
 
 	// Prometheus metrics endpoint (public - no auth)
 	r.Handle("/metrics", promhttp.Handler())
 
-	r.Route("/api/v1/user-management", func(r chi.Router) {
+	// Internal service-to-service routes - require authentication
+	r.Route("/internal/v1", func(r chi.Router) {
+		r.Use(accessCfg.IPFilter.Middleware(customMiddleware.IPFilterGroupInternal))
+		r.Use(customMiddleware.RequireSignature(accessCfg.RequestSigning))
+		r.Use(customMiddleware.Auth(authCfg))
+		registerInternalRoutes(r, h)
+	})
+
+	// Diagnostics - admin only when served on the public port
+	if accessCfg.DiagnosticsEnabled {
+		r.Route("/debug", func(r chi.Router) {
+			r.Use(customMiddleware.Auth(authCfg))
+			r.Use(customMiddleware.RequireScope(customMiddleware.ScopeAdmin))
+			registerDiagnosticsRoutes(r, h)
+		})
+	}
+
+	r.Route(accessCfg.basePath(), func(r chi.Router) {
+		// Checked ahead of authentication so blocked addresses get 403 whatever they send
+		r.Use(accessCfg.IPFilter.Middleware(customMiddleware.IPFilterGroupAdmin, accessCfg.basePath()+"/admin"))
+
 		// Health routes - public (kubernetes probes)
 		registerHealthRoutes(r, h)
 
+		// Shared profile links - public (anonymous viewers) unless disabled
+		r.Group(func(r chi.Router) {
+			if !accessCfg.anonymousAllowed(RouteGroupSharedProfiles) {
+				r.Use(customMiddleware.Auth(authCfg))
+			}
+
+			r.Use(rateLimit)
+			r.Get("/shared-profiles/{token}", h.ProfileShare.GetSharedProfile)
+		})
+
+		// Profile embeds - public (link preview crawlers) unless disabled
+		r.Group(func(r chi.Router) {
+			if !accessCfg.anonymousAllowed(RouteGroupEmbeds) {
+				r.Use(customMiddleware.Auth(authCfg))
+			}
+
+			r.Use(rateLimit)
+			r.Get("/users/{user_id}/embed", h.Embed.GetProfileEmbed)
+		})
+
+		// Account recovery - public, since deactivated users can no longer sign in
+		r.Group(func(r chi.Router) {
+			r.Use(rateLimit)
+			r.Use(customMiddleware.RejectOrganizationActors)
+			r.Use(customMiddleware.RejectDuringMaintenance(accessCfg.Maintenance, accessCfg.MaintenanceRetryAfter))
+			r.Post("/users/account/recovery", h.Recovery.RequestRecovery)
+			r.Post("/users/account/recovery/confirm", h.Recovery.ConfirmRecovery)
+		})
+
+		// Preference center and one-click unsubscribe links in emails - public, authorized by the
+		// signed token they carry
+		r.Group(func(r chi.Router) {
+			r.Use(rateLimit)
+			r.Use(customMiddleware.RejectDuringMaintenance(accessCfg.Maintenance, accessCfg.MaintenanceRetryAfter))
+			r.Get("/preference-center/{token}", h.PrefCenter.GetPreferences)
+			r.Post("/preference-center/{token}", h.PrefCenter.UpdatePreferences)
+			r.Post("/unsubscribe/{token}", h.ListUnsub.Unsubscribe)
+		})
+
 		// Protected routes - require authentication
 		r.Group(func(r chi.Router) {
 			r.Use(customMiddleware.Auth(authCfg))
-			registerUserRoutes(r, h)
-			registerAdminRoutes(r, h)
-			registerMetricsRoutes(r, h)
+			// Ahead of role resolution, so members acting as an organization get its role
+			r.Use(customMiddleware.ActAsOrganization(accessCfg.Organizations))
+			r.Use(customMiddleware.ResolveRoles(accessCfg.Roles))
+			r.Use(rateLimit)
+			r.Use(customMiddleware.Shadow(accessCfg.Shadow))
+			r.Use(customMiddleware.DataAccessAudit(accessCfg.DataAccess))
+			registerMaintenanceRoutes(r, h)
+
+			r.Group(func(r chi.Router) {
+				r.Use(customMiddleware.RejectDuringMaintenance(accessCfg.Maintenance, accessCfg.MaintenanceRetryAfter))
+				registerPolicyExemptRoutes(r, h)
+
+				r.Group(func(r chi.Router) {
+					r.Use(customMiddleware.RequirePolicyAcceptance(accessCfg.Policies))
+					registerUserRoutes(r, h)
+					registerAdminRoutes(r, h)
+					registerMetricsRoutes(r, h)
+
+					// Routes about the account rather than its profile, which members acting as an
+					// organization must not reach
+					r.Group(func(r chi.Router) {
+						r.Use(customMiddleware.RejectOrganizationActors)
+						registerHandleRoutes(r, h)
+						registerOrganizationRoutes(r, h)
+						registerInvitationRoutes(r, h)
+					})
+				})
+			})
 		})
 	})
 
 	return r
 }
 
-func setupMiddleware(r chi.Router) {
+func setupMiddleware(r chi.Router, accessCfg AccessConfig) {
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(customMiddleware.PeerAddr)
+	r.Use(customMiddleware.RealIP(accessCfg.TrustedProxies))
+	r.Use(customMiddleware.SecurityHeaders(accessCfg.SecurityHeaders))
+	r.Use(customMiddleware.Language)
 	r.Use(customMiddleware.Metrics)
+	r.Use(customMiddleware.SlowRequests(accessCfg.SlowRequests))
 	r.Use(customMiddleware.Logger)
-	r.Use(middleware.Recoverer)
+	r.Use(customMiddleware.ReportErrors(accessCfg.errorReporter()))
+	r.Use(customMiddleware.Head)
 	r.Use(middleware.Compress(5)) //nolint:mnd // compression level
 
 	corsOptions := cors.Options{}
@@ -70,51 +286,209 @@ func setupMiddleware(r chi.Router) {
 	}
 
 	r.Use(cors.Handler(corsOptions))
+	r.Use(customMiddleware.Options)
+	r.NotFound(customMiddleware.NotFound)
+	r.MethodNotAllowed(customMiddleware.MethodNotAllowed)
 
 	timeout := 60 * time.Second //nolint:mnd // default timeout
 	if config.Instance != nil {
 		timeout = config.Instance.Server.Timeout
 	}
-	r.Use(middleware.Timeout(timeout))
+	r.Use(customMiddleware.Timeout(timeout))
+	r.Use(dataloader.Middleware)
 }
 
 func registerHealthRoutes(r chi.Router, h Handlers) {
 	r.Get("/health", h.Health.Health)
 	r.Get("/ready", h.Health.Ready)
+	r.Get("/version", h.Health.Version)
+}
+
+// registerPolicyExemptRoutes registers the routes a user can reach before accepting the current
+// policies: accepting them, or leaving instead. Neither is open to members acting as an organization.
+func registerPolicyExemptRoutes(r chi.Router, h Handlers) {
+	r.Group(func(r chi.Router) {
+		r.Use(customMiddleware.RejectOrganizationActors)
+		r.Get("/users/account/policies", h.Policy.GetPolicyStatus)
+		r.Post("/users/account/policies", h.Policy.AcceptPolicy)
+		r.Post("/users/account/delete-request", h.User.RequestAccountDeletion)
+		r.Delete("/users/account", h.User.ConfirmAccountDeletion)
+	})
+}
+
+// registerMaintenanceRoutes registers the maintenance switch, which stays writable during
+// maintenance so it can be turned off, and draining, which must work whatever the switch says.
+func registerMaintenanceRoutes(r chi.Router, h Handlers) {
+	r.Group(func(r chi.Router) {
+		r.Use(customMiddleware.RequireAdmin)
+		r.Get("/admin/maintenance", h.Maintenance.GetMaintenance)
+		r.Put("/admin/maintenance", h.Maintenance.SetMaintenance)
+		r.Get("/admin/drain", h.Drain.GetDrain)
+		r.Post("/admin/drain", h.Drain.StartDrain)
+	})
 }
 
 func registerUserRoutes(r chi.Router, h Handlers) {
 	r.Route("/users", func(r chi.Router) {
 		r.Get("/search", h.User.SearchUsers)
+		r.Get("/search/typeahead", h.Typeahead.Typeahead)
+		r.Get("/by-username/{username}", h.User.GetUserProfileByUsername)
 		r.Put("/profile", h.User.UpdateUserProfile)
-		r.Post("/account/delete-request", h.User.RequestAccountDeletion)
-		r.Delete("/account", h.User.ConfirmAccountDeletion)
+		r.Get("/profile/constraints", h.User.GetProfileConstraints)
+		r.Get("/profile/share-token", h.ProfileShare.GetShareToken)
+		r.Delete("/profile/share-token", h.ProfileShare.RevokeShareToken)
+		r.Get("/preferences/notifications/digest-preview", h.Social.GetDigestPreview)
+
+		// Account routes, which members acting as an organization must not reach
+		r.Group(func(r chi.Router) {
+			r.Use(customMiddleware.RejectOrganizationActors)
+			r.Post("/account/email-change", h.EmailChange.RequestEmailChange)
+			r.Post("/account/email-change/confirm-old", h.EmailChange.ConfirmOldEmail)
+			r.Post("/account/email-change/confirm-new", h.EmailChange.ConfirmNewEmail)
+			r.Get("/account/privacy-report", h.PrivacyReport.GetPrivacyReport)
+			r.Get("/account/preferences/export", h.Preference.ExportPreferences)
+			r.Post("/account/preferences/import", h.Preference.ImportPreferences)
+			r.Get("/account/birthdate", h.Age.GetBirthdate)
+			r.Put("/account/birthdate", h.Age.SetBirthdate)
+			r.Get("/account/change-requests", h.Moderation.ListMyChangeRequests)
+			r.Post("/account/change-requests", h.Moderation.SubmitChangeRequest)
+			r.Delete("/account/preference-center-links", h.PrefCenter.RevokeLinks)
+			r.Get("/account/devices", h.Device.ListDevices)
+			r.Post("/account/devices", h.Device.RegisterDevice)
+			r.Delete("/account/devices/{device_id}", h.Device.UnregisterDevice)
+			r.Put("/handle", h.Handle.ClaimHandle)
+			r.Post("/handle/reservation", h.Handle.ReserveHandle)
+		})
+
+		r.Route("/"+customMiddleware.SelfPathSegment, func(r chi.Router) {
+			r.Use(customMiddleware.ResolveSelf("user_id"))
+			r.Get("/recently-viewed", h.RecentlyViewed.GetRecentlyViewed)
+			r.Delete("/recently-viewed", h.RecentlyViewed.ClearRecentlyViewed)
+			registerUserSubjectRoutes(r, h)
+		})
 
 		r.Route("/{user_id}", func(r chi.Router) {
 			r.Get("/", h.User.GetUserByID)
-			r.Get("/profile", h.User.GetUserProfile)
-			r.Get("/following", h.Social.GetFollowing)
-			r.Get("/followers", h.Social.GetFollowers)
 			r.Get("/following/{target_user_id}", h.Social.CheckFollowing)
-			r.Get("/activity", h.Social.GetUserActivity)
+			r.Put("/following/{target_user_id}/settings", h.Social.UpdateFollowSettings)
+			r.Get("/close-friends", h.Social.GetCloseFriends)
+			r.Put("/close-friends/{target_user_id}", h.Social.AddCloseFriend)
+			r.Delete("/close-friends/{target_user_id}", h.Social.RemoveCloseFriend)
 			r.Post("/follow/{target_user_id}", h.Social.FollowUser)
 			r.Delete("/follow/{target_user_id}", h.Social.UnfollowUser)
-
-			// Preference routes
-			r.Route("/preferences", func(r chi.Router) {
-				r.Get("/", h.Preference.GetAllPreferences)
-				r.Put("/", h.Preference.UpdateAllPreferences)
-				r.Get("/{category}", h.Preference.GetCategoryPreferences)
-				r.Put("/{category}", h.Preference.UpdateCategoryPreferences)
-			})
+			registerUserSubjectRoutes(r, h)
 		})
 	})
 }
 
+// registerUserSubjectRoutes registers the reads and preference routes about one user, mounted under
+// both /users/{user_id} and /users/me.
+func registerUserSubjectRoutes(r chi.Router, h Handlers) {
+	r.Get("/profile", h.User.GetUserProfile)
+	r.Get("/profile/views", h.User.GetProfileViews)
+	r.Get("/following", h.Social.GetFollowing)
+	r.Get("/followers", h.Social.GetFollowers)
+	r.Get("/followers/insights", h.Insights.GetFollowerInsights)
+	r.Get("/followers/history", h.Social.GetFollowHistory)
+	r.Get("/quota", h.Social.GetQuota)
+	r.Get("/activity", h.Social.GetUserActivity)
+	r.Get("/presence", h.Presence.GetPresence)
+	r.Get("/badges", h.Badge.GetBadges)
+
+	// Preference routes
+	r.Route("/preferences", func(r chi.Router) {
+		r.Get("/", h.Preference.GetAllPreferences)
+		r.Put("/", h.Preference.UpdateAllPreferences)
+		r.Delete("/", h.Preference.ResetAllPreferences)
+		r.Get("/{category}", h.Preference.GetCategoryPreferences)
+		r.Put("/{category}", h.Preference.UpdateCategoryPreferences)
+		r.Delete("/{category}", h.Preference.ResetCategoryPreferences)
+	})
+
+	r.Route("/consents", func(r chi.Router) {
+		r.Get("/", h.Consent.GetConsents)
+		r.Post("/", h.Consent.RecordConsent)
+		r.Get("/history", h.Consent.GetConsentHistory)
+	})
+}
+
+func registerHandleRoutes(r chi.Router, h Handlers) {
+	r.Get("/handles/{handle}", h.Handle.ResolveHandle)
+}
+
+func registerOrganizationRoutes(r chi.Router, h Handlers) {
+	r.Route("/organizations", func(r chi.Router) {
+		r.Get("/", h.Organization.ListOrganizations)
+		r.Post("/", h.Organization.CreateOrganization)
+		r.Get("/{org_id}/members", h.Organization.ListMembers)
+		r.Put("/{org_id}/members/{user_id}", h.Organization.SetMember)
+		r.Delete("/{org_id}/members/{user_id}", h.Organization.RemoveMember)
+	})
+}
+
+func registerInvitationRoutes(r chi.Router, h Handlers) {
+	r.Get("/users/account/invitations", h.Invitation.ListInvitations)
+	r.Post("/users/account/invitations", h.Invitation.CreateInvitation)
+	r.Get("/invitations/{code}", h.Invitation.GetInvitation)
+	r.Post("/invitations/{code}/redeem", h.Invitation.RedeemInvitation)
+}
+
+func registerInternalRoutes(r chi.Router, h Handlers) {
+	r.Get("/users/changes", h.ChangeFeed.GetUserChanges)
+	r.Post("/users/resolve-mentions", h.Mention.ResolveMentions)
+	r.Get("/users/{user_id}/push-tokens", h.Device.GetPushTargets)
+	r.Post("/users/{user_id}/preference-center-token", h.PrefCenter.IssueToken)
+	r.Get("/users/{user_id}/list-unsubscribe", h.ListUnsub.GetListUnsubscribe)
+	r.Get("/users/{user_id}/notification-recipients", h.Social.GetNotificationRecipients)
+	r.Post("/users/{user_id}/heartbeat", h.Presence.Heartbeat)
+	r.Post("/users/{user_id}/events", h.Badge.RecordEvent)
+}
+
+// registerAdminRoutes registers the /admin routes. Every one of them needs an admin, except the
+// moderation queues and flags, which moderators work through too.
 func registerAdminRoutes(r chi.Router, h Handlers) {
 	r.Route("/admin", func(r chi.Router) {
-		r.Get("/users/stats", h.Admin.GetUserStats)
-		r.Post("/cache/clear", h.Admin.ClearCache)
+		r.Group(func(r chi.Router) {
+			r.Use(customMiddleware.RequireModerator)
+			r.Put("/users/{user_id}/moderation", h.Moderation.SetModeration)
+			r.Delete("/users/{user_id}/moderation", h.Moderation.ClearModeration)
+			r.Get("/change-requests", h.Moderation.ListChangeRequests)
+			r.Post("/change-requests/{request_id}/approve", h.Moderation.ApproveChangeRequest)
+			r.Post("/change-requests/{request_id}/reject", h.Moderation.RejectChangeRequest)
+			r.Get("/content-flags", h.Moderation.ListContentFlags)
+			r.Post("/content-flags/{flag_id}/resolve", h.Moderation.ResolveContentFlag)
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(customMiddleware.RequireAdmin)
+			r.Get("/stats", h.Admin.GetAdminStats)
+			r.Get("/users/stats", h.Admin.GetUserStats)
+			r.Post("/cache/clear", h.Admin.ClearCache)
+			r.Get("/search/index", h.Typeahead.GetSearchIndexStatus)
+			r.Post("/search/reindex", h.Typeahead.StartReindex)
+			r.Get("/jobs", h.Job.ListJobs)
+			r.Post("/jobs/{name}/run", h.Job.RunJob)
+			r.Get("/preferences/privacy-defaults", h.PrivacyDefaults.GetPrivacyDefaults)
+			r.Post("/preferences/privacy-defaults/migrate", h.PrivacyDefaults.MigratePrivacyDefaults)
+			r.Get("/users/{user_id}/age", h.Age.GetUserAge)
+			r.Put("/users/{user_id}/age/override", h.Age.SetAgeOverride)
+			r.Delete("/users/{user_id}/age/override", h.Age.ClearAgeOverride)
+			r.Get("/users/{user_id}/notes", h.AdminNote.ListNotes)
+			r.Post("/users/{user_id}/notes", h.AdminNote.CreateNote)
+			r.Put("/users/{user_id}/notes/{note_id}", h.AdminNote.UpdateNote)
+			r.Delete("/users/{user_id}/notes/{note_id}", h.AdminNote.DeleteNote)
+			r.Get("/users/{user_id}/audit", h.AdminNote.GetAuditTrail)
+			r.Get("/users/{user_id}/role", h.Role.GetUserRole)
+			r.Put("/users/{user_id}/role", h.Role.SetUserRole)
+			r.Get("/users/{user_id}/quota", h.Social.GetUserQuota)
+			r.Get("/invitations/stats", h.Invitation.GetInvitationStats)
+			r.Put("/users/{user_id}/quota/following", h.Social.SetFollowLimit)
+			r.Delete("/users/{user_id}/quota/following", h.Social.ClearFollowLimit)
+			r.Get("/users/{user_id}/directory", h.Directory.GetLink)
+			r.Put("/users/{user_id}/directory", h.Directory.LinkUser)
+			r.Delete("/users/{user_id}/directory", h.Directory.UnlinkUser)
+			r.Post("/users/{user_id}/directory/sync", h.Directory.SyncUser)
+		})
 	})
 }
 
@@ -128,3 +502,31 @@ func registerMetricsRoutes(r chi.Router, h Handlers) {
 		r.Get("/health/detailed", h.Metrics.GetDetailedHealthMetrics)
 	})
 }
+
+// DiagnosticsRoutes creates an unauthenticated /debug router for the internal diagnostics port.
+func DiagnosticsRoutes(h Handlers) http.Handler {
+	r := chi.NewRouter()
+	r.Use(customMiddleware.Recoverer)
+
+	r.Route("/debug", func(r chi.Router) {
+		registerDiagnosticsRoutes(r, h)
+	})
+
+	return r
+}
+
+func registerDiagnosticsRoutes(r chi.Router, h Handlers) {
+	r.Get("/runtime", h.Diagnostics.GetRuntimeStats)
+	r.Get("/goroutines", h.Diagnostics.GetGoroutineDump)
+
+	r.Route("/pprof", func(r chi.Router) {
+		r.Get("/", pprof.Index)
+		r.Get("/cmdline", pprof.Cmdline)
+		r.Get("/profile", pprof.Profile)
+		r.Get("/symbol", pprof.Symbol)
+		r.Post("/symbol", pprof.Symbol)
+		r.Get("/trace", pprof.Trace)
+		// Named profiles: heap, goroutine, allocs, block, mutex, threadcreate
+		r.Get("/{profile}", pprof.Index)
+	})
+}
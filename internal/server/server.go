@@ -2,10 +2,15 @@ package server
 
 import (
 	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/netip"
+	"strconv"
 	"time"
 
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/handler"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
 )
@@ -19,21 +24,58 @@ func NewServerWithContainer(container *app.Container) *http.Server {
 	readTimeout := 10 * time.Second
 	writeTimeout := 30 * time.Second
 
+	var embedMaxAge time.Duration
+
 	if cfg != nil {
 		port = cfg.Server.Port
 		idleTimeout = cfg.Server.IdleTimeout
 		readTimeout = cfg.Server.ReadTimeout
 		writeTimeout = cfg.Server.WriteTimeout
+		embedMaxAge = cfg.Embed.CacheTTL
 	}
 
-	// Create handlers with dependencies
+	// Create handlers with dependencies; profile views are counted by both profile handlers
+	profileViews := container.ProfileViewService
+	userHandler := handler.NewUserHandler(container.UserService, container.UserDetailsService, profileViews,
+		container.RecentlyViewedService)
+
 	handlers := Handlers{
-		Health:     handler.NewHealthHandler(container.HealthService),
-		User:       handler.NewUserHandler(container.UserService),
-		Social:     handler.NewSocialHandler(container.SocialService),
-		Admin:      handler.NewAdminHandler(container.UserService, container.AdminService),
-		Metrics:    handler.NewMetricsHandler(container.MetricsService),
-		Preference: handler.NewPreferenceHandler(container.PreferenceService),
+		Health:          handler.NewHealthHandler(container.HealthService),
+		User:            userHandler,
+		Social:          handler.NewSocialHandler(container.SocialService, container.PresenceService),
+		Admin:           handler.NewAdminHandler(container.UserService, container.AdminService, container.StatsService),
+		Metrics:         handler.NewMetricsHandler(container.MetricsService),
+		Preference:      handler.NewPreferenceHandler(container.PreferenceService, container.PreferenceTransferService),
+		ChangeFeed:      handler.NewChangeFeedHandler(container.ChangeFeedService),
+		EmailChange:     handler.NewEmailChangeHandler(container.EmailChangeService),
+		Recovery:        handler.NewAccountRecoveryHandler(container.AccountRecoveryService),
+		Handle:          handler.NewHandleHandler(container.HandleService),
+		ProfileShare:    handler.NewProfileShareHandler(container.ProfileShareService, profileViews),
+		Diagnostics:     handler.NewDiagnosticsHandler(),
+		PrivacyReport:   handler.NewPrivacyReportHandler(container.PrivacyReportService),
+		Consent:         handler.NewConsentHandler(container.ConsentService),
+		Policy:          handler.NewPolicyHandler(container.PolicyService),
+		Age:             handler.NewAgeHandler(container.AgeService),
+		AdminNote:       handler.NewAdminNoteHandler(container.AdminNoteService),
+		Role:            handler.NewRoleHandler(container.RoleService),
+		Organization:    handler.NewOrganizationHandler(container.OrganizationService),
+		Invitation:      handler.NewInvitationHandler(container.InvitationService),
+		PrefCenter:      handler.NewPreferenceCenterHandler(container.PreferenceCenterService),
+		ListUnsub:       handler.NewListUnsubscribeHandler(container.ListUnsubscribeService),
+		Moderation:      handler.NewModerationHandler(container.ModerationService),
+		Device:          handler.NewDeviceHandler(container.DeviceTokenService),
+		Insights:        handler.NewFollowerInsightsHandler(container.StatsService),
+		Typeahead:       handler.NewTypeaheadHandler(container.TypeaheadService),
+		Maintenance:     handler.NewMaintenanceHandler(container.MaintenanceService),
+		Drain:           handler.NewDrainHandler(container.DrainService),
+		Job:             handler.NewJobHandler(container.JobService),
+		PrivacyDefaults: handler.NewPrivacyDefaultsHandler(container.PrivacyDefaultsService),
+		Presence:        handler.NewPresenceHandler(container.PresenceService),
+		RecentlyViewed:  handler.NewRecentlyViewedHandler(container.RecentlyViewedService),
+		Badge:           handler.NewBadgeHandler(container.BadgeService),
+		Mention:         handler.NewMentionHandler(container.MentionService),
+		Embed:           handler.NewEmbedHandler(container.EmbedService, embedMaxAge),
+		Directory:       handler.NewDirectoryHandler(container.DirectorySyncService),
 	}
 
 	// Build auth middleware config
@@ -41,7 +83,7 @@ func NewServerWithContainer(container *app.Container) *http.Server {
 
 	server := &http.Server{
 		Addr:         fmt.Sprintf(":%d", port),
-		Handler:      RegisterRoutesWithHandlers(handlers, authCfg),
+		Handler:      RegisterRoutesWithHandlers(handlers, authCfg, buildAccessConfig(container)),
 		IdleTimeout:  idleTimeout,
 		ReadTimeout:  readTimeout,
 		WriteTimeout: writeTimeout,
@@ -80,3 +122,184 @@ func buildAuthConfig(container *app.Container) middleware.AuthConfig {
 		OAuth2Client:         container.OAuth2Client,
 	}
 }
+
+// buildAccessConfig creates the rate limiting and anonymous access configuration from the container.
+func buildAccessConfig(container *app.Container) AccessConfig {
+	cfg := container.Config
+
+	// Default: no throttling, anonymous access allowed
+	if cfg == nil {
+		return AccessConfig{
+			DataAccess:     container.DataAccessRepo,
+			Roles:          container.RoleService,
+			Organizations:  container.OrganizationService,
+			Maintenance:    maintenanceChecker(container),
+			ErrorReporter:  container.ErrorReporter,
+			IPFilter:       container.IPFilter,
+			TrustedProxies: trustedProxies(container),
+		}
+	}
+
+	return AccessConfig{
+		BasePath: cfg.Server.BasePath,
+		RateLimit: middleware.RateLimitConfig{
+			Enabled:            cfg.RateLimit.Enabled,
+			Window:             cfg.RateLimit.Window,
+			AuthenticatedLimit: cfg.RateLimit.AuthenticatedLimit,
+			AnonymousLimit:     cfg.RateLimit.AnonymousLimit,
+		},
+		AnonymousDisabledGroups: cfg.RateLimit.AnonymousDisabledGroups,
+		Shadow: middleware.ShadowConfig{
+			Enabled:       cfg.Shadow.Enabled,
+			SamplePercent: cfg.Shadow.SamplePercent,
+			Candidate:     shadowCandidate(container),
+			Timeout:       cfg.Shadow.Timeout,
+			MaxInFlight:   cfg.Shadow.MaxInFlight,
+			IgnorePaths:   cfg.Shadow.IgnorePaths,
+		},
+		DataAccess:            container.DataAccessRepo,
+		Roles:                 container.RoleService,
+		Organizations:         container.OrganizationService,
+		Policies:              policyChecker(container),
+		DiagnosticsEnabled:    cfg.Diagnostics.Enabled && cfg.Diagnostics.Port == 0,
+		Maintenance:           maintenanceChecker(container),
+		MaintenanceRetryAfter: cfg.Maintenance.RetryAfter,
+		ErrorReporter:         container.ErrorReporter,
+		SlowRequests: middleware.SlowRequestConfig{
+			Enabled:       cfg.Diagnostics.SlowRequests.Enabled,
+			Threshold:     cfg.Diagnostics.SlowRequests.Threshold,
+			Dir:           cfg.Diagnostics.SlowRequests.Dir,
+			GoroutineDump: cfg.Diagnostics.SlowRequests.GoroutineDump,
+			MaxBundles:    cfg.Diagnostics.SlowRequests.MaxBundles,
+			MaxAge:        cfg.Diagnostics.SlowRequests.MaxAge,
+			MaxQueries:    cfg.Diagnostics.SlowRequests.MaxQueries,
+		},
+		SecurityHeaders: securityHeadersConfig(&cfg.Server),
+		IPFilter:        container.IPFilter,
+		TrustedProxies:  trustedProxies(container),
+		RequestSigning:  requestSigningConfig(container),
+	}
+}
+
+// requestSigningConfig accepts signatures made with the current or previous secret, and rejects
+// replays using the shared cache when there is one.
+func requestSigningConfig(container *app.Container) middleware.RequestSigningConfig {
+	cfg := container.Config.RequestSigning
+	if !cfg.Enabled {
+		return middleware.RequestSigningConfig{}
+	}
+
+	secrets := [][]byte{[]byte(cfg.Secret)}
+	if cfg.PreviousSecret != "" {
+		secrets = append(secrets, []byte(cfg.PreviousSecret))
+	}
+
+	nonces, ok := container.Cache.(middleware.NonceStore)
+	if !ok {
+		slog.Warn("request signing has no nonce store; signed requests can be replayed until they expire")
+	}
+
+	return middleware.RequestSigningConfig{
+		Enabled: true,
+		Secrets: secrets,
+		MaxSkew: cfg.MaxSkew,
+		Nonces:  nonces,
+	}
+}
+
+// securityHeadersConfig sends HSTS only when the server terminates TLS, and relaxes the content
+// security policy for the Swagger UI before applying the configured overrides.
+func securityHeadersConfig(cfg *config.ServerConfig) middleware.SecurityHeadersConfig {
+	headers := cfg.SecurityHeaders
+	result := middleware.SecurityHeadersConfig{
+		Enabled:               headers.Enabled,
+		ContentSecurityPolicy: headers.ContentSecurityPolicy,
+	}
+
+	if cfg.TLS.Enabled {
+		result.HSTSMaxAge = headers.HSTSMaxAge
+	}
+
+	if headers.DocsPath != "" {
+		result.Overrides = append(result.Overrides, middleware.SecurityHeaderOverride{
+			PathPrefix: headers.DocsPath,
+			Headers:    map[string]string{"Content-Security-Policy": headers.DocsContentSecurityPolicy},
+		})
+	}
+
+	for _, override := range headers.Overrides {
+		result.Overrides = append(result.Overrides, middleware.SecurityHeaderOverride{
+			PathPrefix: override.PathPrefix,
+			Headers:    override.Headers,
+		})
+	}
+
+	return result
+}
+
+// maintenanceChecker returns the maintenance service, or nil to leave mutating routes ungated.
+func maintenanceChecker(container *app.Container) middleware.MaintenanceChecker {
+	if container.MaintenanceService == nil {
+		return nil
+	}
+
+	return container.MaintenanceService
+}
+
+// trustedProxies follows the IP filter's rules, which are reloaded from their file, and otherwise
+// uses the configured proxies.
+func trustedProxies(container *app.Container) func() []netip.Prefix {
+	if container.IPFilter != nil {
+		return container.IPFilter.TrustedProxies
+	}
+
+	return func() []netip.Prefix { return container.TrustedProxies }
+}
+
+// policyChecker returns the policy service when acceptance is enforced, and nil to leave mutating
+// routes ungated.
+func policyChecker(container *app.Container) middleware.PolicyChecker {
+	if !container.Config.Policies.EnforceAcceptance || container.PolicyService == nil {
+		return nil
+	}
+
+	return container.PolicyService
+}
+
+// shadowCandidate returns the router that mirrored reads are replayed against while a refactor
+// is being verified. To shadow a new implementation, build Handlers from the candidate services
+// and return RegisterRoutesWithHandlers for them (with shadowing disabled). It returns nil when
+// no candidate is registered, which leaves shadowing off even if it is enabled in config.
+func shadowCandidate(container *app.Container) http.Handler {
+	if container.Config.Shadow.Enabled {
+		slog.Warn("shadow traffic is enabled but no candidate implementation is registered")
+	}
+
+	return nil
+}
+
+// NewDiagnosticsServer creates the internal diagnostics server, or returns nil when diagnostics
+// are disabled or served on the main port.
+func NewDiagnosticsServer(container *app.Container) *http.Server {
+	cfg := container.Config
+	if cfg == nil || !cfg.Diagnostics.Enabled || cfg.Diagnostics.Port == 0 {
+		return nil
+	}
+
+	return &http.Server{
+		Addr:              net.JoinHostPort(diagnosticsHost(cfg.Diagnostics), strconv.Itoa(cfg.Diagnostics.Port)),
+		Handler:           DiagnosticsRoutes(Handlers{Diagnostics: handler.NewDiagnosticsHandler()}),
+		ReadHeaderTimeout: cfg.Server.ReadTimeout,
+		// No write timeout: CPU profiles and traces stream for the requested duration
+	}
+}
+
+// diagnosticsHost returns the address the diagnostics server binds to, loopback unless another
+// one is configured, since its endpoints are unauthenticated.
+func diagnosticsHost(cfg config.DiagnosticsConfig) string {
+	if cfg.Host == "" {
+		return config.DefaultDiagnosticsHost
+	}
+
+	return cfg.Host
+}
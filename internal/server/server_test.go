@@ -9,6 +9,9 @@ import (
 
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/requestsign"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -68,6 +71,148 @@ func TestNewServerWithContainer(t *testing.T) {
 	})
 }
 
+func TestNewServerWithContainer_BasePath(t *testing.T) {
+	t.Parallel()
+
+	healthService := service.NewHealthService(nil, nil)
+	healthService.SetServiceName("accounts")
+
+	container := &app.Container{
+		Config:        &config.Config{Server: config.ServerConfig{Port: 8080, BasePath: "/accounts/v2"}},
+		HealthService: healthService,
+	}
+
+	handler := NewServerWithContainer(container).Handler
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/accounts/v2/health", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"status":"UP","service":"accounts"}`, rr.Body.String())
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, config.DefaultBasePath+"/health", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestNewServerWithContainer_SecurityHeaders(t *testing.T) {
+	t.Parallel()
+
+	newHandler := func(tlsEnabled bool) http.Handler {
+		return NewServerWithContainer(&app.Container{
+			Config: &config.Config{Server: config.ServerConfig{
+				Port: 8080,
+				TLS:  config.TLSConfig{Enabled: tlsEnabled},
+				SecurityHeaders: config.SecurityHeadersConfig{
+					Enabled:                   true,
+					ContentSecurityPolicy:     "default-src 'none'",
+					HSTSMaxAge:                time.Hour,
+					DocsPath:                  "/swagger",
+					DocsContentSecurityPolicy: "default-src 'self'",
+					Overrides: []config.SecurityHeaderOverrideConfig{
+						{PathPrefix: "/swagger/assets", Headers: map[string]string{"Referrer-Policy": "same-origin"}},
+					},
+				},
+			}},
+			HealthService: service.NewHealthService(nil, nil),
+		}).Handler
+	}
+
+	rr := httptest.NewRecorder()
+	newHandler(true).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, config.DefaultBasePath+"/health", nil))
+	assert.Equal(t, "nosniff", rr.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "DENY", rr.Header().Get("X-Frame-Options"))
+	assert.Equal(t, "no-referrer", rr.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "default-src 'none'", rr.Header().Get("Content-Security-Policy"))
+	assert.Equal(t, "max-age=3600; includeSubDomains", rr.Header().Get("Strict-Transport-Security"))
+
+	// Headers are set on responses for unknown routes too
+	rr = httptest.NewRecorder()
+	newHandler(false).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/swagger/index.html", nil))
+	assert.Equal(t, "default-src 'self'", rr.Header().Get("Content-Security-Policy"))
+	assert.Empty(t, rr.Header().Get("Strict-Transport-Security"), "no HSTS without TLS")
+
+	rr = httptest.NewRecorder()
+	newHandler(false).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/swagger/assets/app.css", nil))
+	assert.Equal(t, "same-origin", rr.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "DENY", rr.Header().Get("X-Frame-Options"))
+}
+
+func TestNewServerWithContainer_IPFilter(t *testing.T) {
+	t.Parallel()
+
+	allow, err := middleware.ParseIPPrefixes([]string{"192.168.1.0/24"})
+	require.NoError(t, err)
+
+	handler := NewServerWithContainer(&app.Container{
+		Config:        &config.Config{Server: config.ServerConfig{Port: 8080}},
+		HealthService: service.NewHealthService(nil, nil),
+		IPFilter: middleware.NewIPFilter(middleware.IPFilterRules{
+			Groups: map[string]middleware.IPRuleSet{
+				middleware.IPFilterGroupAdmin:    {Allow: allow},
+				middleware.IPFilterGroupInternal: {Allow: allow},
+			},
+		}),
+	}).Handler
+
+	serve := func(path, remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = remoteAddr
+		// Ignored: the peer is not a trusted proxy
+		req.Header.Set("X-Forwarded-For", "192.168.1.10")
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		return rr.Code
+	}
+
+	// Rejected before authentication is checked
+	assert.Equal(t, http.StatusForbidden, serve(config.DefaultBasePath+"/admin/stats", "198.51.100.7:1234"))
+	assert.Equal(t, http.StatusForbidden, serve(config.DefaultBasePath+"/admin/maintenance", "198.51.100.7:1234"))
+	assert.Equal(t, http.StatusForbidden, serve("/internal/v1/users/abc", "198.51.100.7:1234"))
+
+	assert.Equal(t, http.StatusUnauthorized, serve(config.DefaultBasePath+"/admin/stats", "192.168.1.10:1234"))
+	assert.Equal(t, http.StatusOK, serve(config.DefaultBasePath+"/health", "198.51.100.7:1234"))
+}
+
+func TestNewServerWithContainer_RequestSigning(t *testing.T) {
+	t.Parallel()
+
+	secret := "0123456789abcdef0123456789abcdef"
+
+	handler := NewServerWithContainer(&app.Container{
+		Config: &config.Config{
+			Server:         config.ServerConfig{Port: 8080},
+			RequestSigning: config.RequestSigningConfig{Enabled: true, Secret: secret, MaxSkew: time.Minute},
+		},
+		Cache:         memory.New(),
+		HealthService: service.NewHealthService(nil, nil),
+	}).Handler
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/internal/v1/users/changes", nil))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Contains(t, rr.Body.String(), "INVALID_SIGNATURE")
+
+	// A signed request gets past the signature check to authentication, and only once
+	req := httptest.NewRequest(http.MethodGet, "/internal/v1/users/changes", nil)
+	require.NoError(t, requestsign.Sign(req, []byte(secret), nil, time.Now()))
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req.Clone(t.Context()))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.NotContains(t, rr.Body.String(), "INVALID_SIGNATURE")
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req.Clone(t.Context()))
+	assert.Contains(t, rr.Body.String(), "INVALID_SIGNATURE")
+
+	// Public routes are not signed
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, config.DefaultBasePath+"/health", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
 func TestRegisterRoutesWithHandlers_HealthReady(t *testing.T) {
 	t.Parallel()
 
@@ -95,7 +240,7 @@ func TestRegisterRoutesWithHandlers_HealthReady(t *testing.T) {
 		req, err := http.NewRequestWithContext(
 			context.Background(),
 			http.MethodGet,
-			ts.URL+"/api/v1/user-management/health",
+			ts.URL+config.DefaultBasePath+"/health",
 			nil,
 		)
 		require.NoError(t, err)
@@ -113,7 +258,7 @@ func TestRegisterRoutesWithHandlers_HealthReady(t *testing.T) {
 		req, err := http.NewRequestWithContext(
 			context.Background(),
 			http.MethodGet,
-			ts.URL+"/api/v1/user-management/ready",
+			ts.URL+config.DefaultBasePath+"/ready",
 			nil,
 		)
 		require.NoError(t, err)
@@ -143,3 +288,57 @@ func TestRegisterRoutesWithHandlers_HealthReady(t *testing.T) {
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 	})
 }
+
+func TestRegisterRoutesWithHandlers_AnonymousAccess(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled group requires authentication", func(t *testing.T) {
+		t.Parallel()
+
+		container := &app.Container{
+			Config: &config.Config{
+				RateLimit: config.RateLimitConfig{
+					AnonymousDisabledGroups: []string{RouteGroupSharedProfiles},
+				},
+			},
+			HealthService: service.NewHealthService(nil, nil),
+		}
+
+		srv := NewServerWithContainer(container)
+
+		req := httptest.NewRequest(http.MethodGet, config.DefaultBasePath+"/shared-profiles/abc.def", nil)
+		rr := httptest.NewRecorder()
+		srv.Handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("anonymous tier throttles public group", func(t *testing.T) {
+		t.Parallel()
+
+		container := &app.Container{
+			Config: &config.Config{
+				RateLimit: config.RateLimitConfig{
+					Enabled:        true,
+					Window:         time.Minute,
+					AnonymousLimit: 1,
+				},
+			},
+			HealthService: service.NewHealthService(nil, nil),
+		}
+
+		srv := NewServerWithContainer(container)
+
+		// The first request is allowed through (and fails without a share service);
+		// the second is rejected by the limiter before reaching the handler.
+		first := httptest.NewRecorder()
+		srv.Handler.ServeHTTP(first,
+			httptest.NewRequest(http.MethodGet, config.DefaultBasePath+"/shared-profiles/abc.def", nil))
+		assert.NotEqual(t, http.StatusTooManyRequests, first.Code)
+
+		second := httptest.NewRecorder()
+		srv.Handler.ServeHTTP(second,
+			httptest.NewRequest(http.MethodGet, config.DefaultBasePath+"/shared-profiles/abc.def", nil))
+		assert.Equal(t, http.StatusTooManyRequests, second.Code)
+	})
+}
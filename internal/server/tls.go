@@ -0,0 +1,221 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+)
+
+const (
+	defaultHTTPSPort          = 443
+	redirectReadHeaderTimeout = 5 * time.Second
+)
+
+// ErrInvalidTLSConfig is returned when the TLS settings cannot be applied.
+var ErrInvalidTLSConfig = errors.New("invalid TLS configuration")
+
+var tlsVersions = map[string]uint16{
+	"":    tls.VersionTLS12,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// CertReloader serves the current certificate from disk and reloads it when the files change,
+// so rotated certificates (e.g. cert-manager or Kubernetes secret updates) apply without a restart.
+type CertReloader struct {
+	certFile string
+	keyFile  string
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+}
+
+// NewCertReloader loads the certificate and key, returning an error if they are unreadable.
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	r := &CertReloader{certFile: certFile, keyFile: keyFile}
+
+	err := r.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Reload re-reads the certificate and key. On failure the previous certificate stays in use.
+func (r *CertReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.mu.Unlock()
+
+	return nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (r *CertReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.cert, nil
+}
+
+// Watch reloads the certificate whenever its directory changes, until ctx is cancelled.
+// Directories are watched rather than files because mounted secrets are swapped via symlinks.
+func (r *CertReloader) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create certificate watcher: %w", err)
+	}
+
+	defer func() { _ = watcher.Close() }()
+
+	for _, dir := range uniqueDirs(r.certFile, r.keyFile) {
+		err = watcher.Add(dir)
+		if err != nil {
+			return fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+				continue
+			}
+
+			err = r.Reload()
+			if err != nil {
+				// Cert and key may be written separately; the next event retries
+				slog.Warn("failed to reload TLS certificate", "error", err)
+
+				continue
+			}
+
+			slog.Info("reloaded TLS certificate", "file", r.certFile)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			slog.Warn("certificate watcher error", "error", err)
+		}
+	}
+}
+
+func uniqueDirs(paths ...string) []string {
+	var dirs []string
+
+	seen := make(map[string]bool)
+
+	for _, path := range paths {
+		dir := filepath.Dir(path)
+		if !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs
+}
+
+// BuildTLSConfig creates the server TLS configuration, serving certificates from reloader.
+func BuildTLSConfig(cfg config.TLSConfig, reloader *CertReloader) (*tls.Config, error) {
+	minVersion, ok := tlsVersions[cfg.MinVersion]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported min_version %q", ErrInvalidTLSConfig, cfg.MinVersion)
+	}
+
+	suites := make(map[string]uint16, len(tls.CipherSuites()))
+	for _, suite := range tls.CipherSuites() {
+		suites[suite.Name] = suite.ID
+	}
+
+	var cipherSuites []uint16
+
+	for _, name := range cfg.CipherSuites {
+		id, ok := suites[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown or insecure cipher suite %q", ErrInvalidTLSConfig, name)
+		}
+
+		cipherSuites = append(cipherSuites, id)
+	}
+
+	return &tls.Config{
+		MinVersion:     minVersion,
+		CipherSuites:   cipherSuites,
+		GetCertificate: reloader.GetCertificate,
+	}, nil
+}
+
+// ConfigureTLS loads the certificate and sets the server's TLS and HTTP/2 options.
+// The returned reloader should be watched for certificate rotation.
+func ConfigureTLS(srv *http.Server, cfg config.TLSConfig) (*CertReloader, error) {
+	reloader, err := NewCertReloader(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := BuildTLSConfig(cfg, reloader)
+	if err != nil {
+		return nil, err
+	}
+
+	var protocols http.Protocols
+
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(cfg.HTTP2)
+
+	srv.TLSConfig = tlsConfig
+	srv.Protocols = &protocols
+
+	return reloader, nil
+}
+
+// NewRedirectServer creates a plain HTTP server that redirects every request to HTTPS on httpsPort.
+func NewRedirectServer(redirectPort, httpsPort int) *http.Server {
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", redirectPort),
+		Handler:           redirectToHTTPS(httpsPort),
+		ReadHeaderTimeout: redirectReadHeaderTimeout,
+	}
+}
+
+func redirectToHTTPS(httpsPort int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+
+		if httpsPort != defaultHTTPSPort {
+			host = net.JoinHostPort(host, strconv.Itoa(httpsPort))
+		}
+
+		target := "https://" + host + r.URL.RequestURI()
+
+		http.Redirect(w, r, target, http.StatusPermanentRedirect)
+	})
+}
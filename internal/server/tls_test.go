@@ -0,0 +1,159 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSelfSignedCert writes a self-signed certificate for commonName to dir and returns the paths.
+func writeSelfSignedCert(t *testing.T, dir, commonName string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+
+	// Write the key first so a watcher reacting to the cert write sees a matching pair
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+
+	return certFile, keyFile
+}
+
+func servedCommonName(t *testing.T, reloader *CertReloader) string {
+	t.Helper()
+
+	cert, err := reloader.GetCertificate(nil)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+
+	return leaf.Subject.CommonName
+}
+
+func TestCertReloader(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := writeSelfSignedCert(t, dir, "first")
+
+	reloader, err := NewCertReloader(certFile, keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, "first", servedCommonName(t, reloader))
+
+	go func() { _ = reloader.Watch(t.Context()) }()
+
+	// Give the watcher time to register before rotating
+	time.Sleep(100 * time.Millisecond)
+	writeSelfSignedCert(t, dir, "second")
+
+	assert.Eventually(t, func() bool {
+		return servedCommonName(t, reloader) == "second"
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestNewCertReloader_MissingFiles(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewCertReloader("missing.crt", "missing.key")
+	require.Error(t, err)
+}
+
+func TestConfigureTLS(t *testing.T) {
+	t.Parallel()
+
+	certFile, keyFile := writeSelfSignedCert(t, t.TempDir(), "localhost")
+
+	t.Run("applies version, suites and protocols", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &http.Server{ReadHeaderTimeout: time.Second}
+		_, err := ConfigureTLS(srv, config.TLSConfig{
+			CertFile:     certFile,
+			KeyFile:      keyFile,
+			MinVersion:   "1.3",
+			CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+			HTTP2:        false,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint16(tls.VersionTLS13), srv.TLSConfig.MinVersion)
+		assert.Equal(t, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256}, srv.TLSConfig.CipherSuites)
+		assert.True(t, srv.Protocols.HTTP1())
+		assert.False(t, srv.Protocols.HTTP2())
+	})
+
+	t.Run("rejects unknown cipher suite", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &http.Server{ReadHeaderTimeout: time.Second}
+		_, err := ConfigureTLS(srv, config.TLSConfig{
+			CertFile:     certFile,
+			KeyFile:      keyFile,
+			CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"},
+		})
+		require.ErrorIs(t, err, ErrInvalidTLSConfig)
+	})
+}
+
+func TestRedirectToHTTPS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		httpsPort int
+		host      string
+		expected  string
+	}{
+		{name: "default port", httpsPort: 443, host: "example.com:80", expected: "https://example.com/users?x=1"},
+		{name: "custom port", httpsPort: 8443, host: "example.com:8080", expected: "https://example.com:8443/users?x=1"},
+		{name: "host without port", httpsPort: 8443, host: "example.com", expected: "https://example.com:8443/users?x=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/users?x=1", nil)
+			req.Host = tt.host
+
+			rr := httptest.NewRecorder()
+			NewRedirectServer(8080, tt.httpsPort).Handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusPermanentRedirect, rr.Code)
+			assert.Equal(t, tt.expected, rr.Header().Get("Location"))
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package servertest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// Request is a request being built against a Server.
+type Request struct {
+	srv *Server
+	req *http.Request
+	err error
+}
+
+// NewRequest starts a request. body may be nil, a string, []byte, an io.Reader, or any other value,
+// which is encoded as JSON. Any non-nil body is sent with Content-Type application/json.
+func (s *Server) NewRequest(method, path string, body any) *Request {
+	reader, err := bodyReader(body)
+	req := httptest.NewRequest(method, path, reader)
+
+	if reader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return &Request{srv: s, req: req, err: err}
+}
+
+// Get starts a GET request.
+func (s *Server) Get(path string) *Request {
+	return s.NewRequest(http.MethodGet, path, nil)
+}
+
+// Post starts a POST request with an optional body.
+func (s *Server) Post(path string, body any) *Request {
+	return s.NewRequest(http.MethodPost, path, body)
+}
+
+// Put starts a PUT request with an optional body.
+func (s *Server) Put(path string, body any) *Request {
+	return s.NewRequest(http.MethodPut, path, body)
+}
+
+// Delete starts a DELETE request.
+func (s *Server) Delete(path string) *Request {
+	return s.NewRequest(http.MethodDelete, path, nil)
+}
+
+func bodyReader(body any) (io.Reader, error) {
+	switch b := body.(type) {
+	case nil:
+		return nil, nil
+	case io.Reader:
+		return b, nil
+	case string:
+		return strings.NewReader(b), nil
+	case []byte:
+		return bytes.NewReader(b), nil
+	default:
+		data, err := json.Marshal(b)
+
+		return bytes.NewReader(data), err
+	}
+}
+
+// As authenticates the request as userID through the X-User-Id header used when OAuth2 is disabled.
+func (r *Request) As(userID uuid.UUID) *Request {
+	return r.WithHeader("X-User-Id", userID.String())
+}
+
+// From sends the request from remoteAddr, a host:port, instead of httptest's 192.0.2.1:1234.
+func (r *Request) From(remoteAddr string) *Request {
+	r.req.RemoteAddr = remoteAddr
+
+	return r
+}
+
+// WithHeader sets a request header.
+func (r *Request) WithHeader(key, value string) *Request {
+	r.req.Header.Set(key, value)
+
+	return r
+}
+
+// WithoutHeader removes a request header, e.g. the default Content-Type.
+func (r *Request) WithoutHeader(key string) *Request {
+	r.req.Header.Del(key)
+
+	return r
+}
+
+// Do serves the request and returns the recorded response. Assertions on the response report to tb,
+// so subtests pass their own t rather than the one the server was built with.
+func (r *Request) Do(tb testing.TB) *Response {
+	tb.Helper()
+
+	require.NoError(tb, r.err, "failed to encode request body")
+
+	rr := httptest.NewRecorder()
+	r.srv.Handler.ServeHTTP(rr, r.req)
+
+	return &Response{ResponseRecorder: rr, tb: tb}
+}
+
+// Response is a recorded response with assertion helpers. Assertions report failures without
+// stopping the test, like assert; use DecodeJSON for values the rest of the test depends on.
+type Response struct {
+	*httptest.ResponseRecorder
+
+	tb testing.TB
+}
+
+// AssertStatus checks the status code.
+func (r *Response) AssertStatus(want int) *Response {
+	r.tb.Helper()
+
+	assert.Equal(r.tb, want, r.Code, "unexpected status, body: %s", r.Body.String())
+
+	return r
+}
+
+// AssertError checks the status code and the error code of a dto.Error body.
+func (r *Response) AssertError(status int, code string) *Response {
+	r.tb.Helper()
+
+	r.AssertStatus(status)
+
+	var body dto.Error
+	if assert.NoError(r.tb, json.Unmarshal(r.Body.Bytes(), &body), "body is not an error response") {
+		assert.Equal(r.tb, code, body.Code)
+	}
+
+	return r
+}
+
+// AssertBodyContains checks that the body contains every fragment.
+func (r *Response) AssertBodyContains(fragments ...string) *Response {
+	r.tb.Helper()
+
+	for _, fragment := range fragments {
+		assert.Contains(r.tb, r.Body.String(), fragment)
+	}
+
+	return r
+}
+
+// AssertBodyNotContains checks that the body contains none of the fragments.
+func (r *Response) AssertBodyNotContains(fragments ...string) *Response {
+	r.tb.Helper()
+
+	for _, fragment := range fragments {
+		assert.NotContains(r.tb, r.Body.String(), fragment)
+	}
+
+	return r
+}
+
+// DecodeJSON decodes the response body into a T, failing the test if it is not valid JSON.
+func DecodeJSON[T any](r *Response) T {
+	r.tb.Helper()
+
+	var v T
+
+	require.NoError(r.tb, json.Unmarshal(r.Body.Bytes(), &v), "failed to decode body: %s", r.Body.String())
+
+	return v
+}
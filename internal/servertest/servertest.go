@@ -0,0 +1,260 @@
+// Package servertest builds the fully routed HTTP handler around fake dependencies so tests can
+// exercise requests end to end without hand-wiring a container and server in every test.
+//
+//	srv := servertest.New(t, servertest.WithRepositories(users, social, tokens))
+//	resp := srv.Get(servertest.Path("users", id.String(), "profile")).As(requester).Do(t)
+//	resp.AssertStatus(http.StatusOK)
+//	profile := servertest.DecodeJSON[dto.UserProfileResponse](resp)
+package servertest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// BasePath is the prefix of every public API route when server.base_path is not set.
+const BasePath = config.DefaultBasePath
+
+// ListUnsubscribeBaseURL is the base URL of the unsubscribe links WithMemoryStore hands out.
+const ListUnsubscribeBaseURL = "https://servertest.invalid" + BasePath
+
+// Path joins segments under BasePath, e.g. Path("users", id, "followers").
+func Path(segments ...string) string {
+	return BasePath + "/" + strings.Join(segments, "/")
+}
+
+// Option customizes the container before the server is built.
+type Option func(*app.Container)
+
+// Server is a routed handler backed by the container it was built from.
+type Server struct {
+	Handler   http.Handler
+	Container *app.Container
+}
+
+// New builds a server with a no-op health service and config.Instance as its configuration,
+// then applies opts in order. Services that are not provided stay nil, as in the real
+// container when their dependencies are missing; the user details service is composed from
+// the user, social and preference services unless set explicitly.
+func New(tb testing.TB, opts ...Option) *Server {
+	tb.Helper()
+
+	health := service.NewHealthService(nil, nil)
+	drain := service.NewDrainService(0)
+	health.SetDrainService(drain)
+
+	c := &app.Container{
+		Config:        config.Instance,
+		HealthService: health,
+		DrainService:  drain,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.UserDetailsService == nil && c.UserService != nil && c.SocialService != nil {
+		c.UserDetailsService = service.NewUserDetailsService(c.UserService, c.SocialService, c.PreferenceService)
+	}
+
+	return &Server{
+		Handler:   server.NewServerWithContainer(c).Handler,
+		Container: c,
+	}
+}
+
+// WithConfig replaces the container configuration.
+func WithConfig(cfg *config.Config) Option {
+	return func(c *app.Container) {
+		c.Config = cfg
+	}
+}
+
+// WithRepositories builds the user, typeahead and social services from fake repositories, the
+// way the container does. A nil social repository leaves the social service unset.
+func WithRepositories(
+	users repository.UserRepository,
+	social repository.SocialRepository,
+	tokens repository.TokenStore,
+) Option {
+	return func(c *app.Container) {
+		c.UserService = service.NewUserService(users, tokens, nil, nil)
+		c.TypeaheadService = service.NewTypeaheadService(users, service.TypeaheadOptions{})
+
+		if social != nil {
+			c.SocialService = service.NewSocialService(users, social, nil)
+		}
+	}
+}
+
+// WithMemoryStore backs the user, typeahead, email change, account recovery, social, preference,
+// preference transfer, preference center, list unsubscribe, consent, age, admin note, moderation,
+// invitation, device token, stats, privacy report, maintenance, presence, embed and privacy
+// defaults services, the data access log and policy acceptances with an in-memory store, typically
+// built with memory.NewFromFixtures. No policy versions are published, device tokens and
+// invitations are not limited, nothing is cached, no notifications are sent, users keep the
+// original privacy defaults and unsubscribe links point at ListUnsubscribeBaseURL.
+func WithMemoryStore(store *memory.Store) Option {
+	return func(c *app.Container) {
+		c.UserService = service.NewUserService(store, store, nil, store)
+		c.TypeaheadService = service.NewTypeaheadService(store, service.TypeaheadOptions{Index: store})
+		c.EmailChangeService = service.NewEmailChangeService(store, store, nil, store)
+		c.AccountRecoveryService = service.NewAccountRecoveryService(store, store, store, nil)
+		c.SocialService = service.NewSocialService(store, store, nil)
+		c.PreferenceService = service.NewPreferenceService(store, store, store)
+		c.PreferenceTransferService = service.NewPreferenceTransferService(c.PreferenceService,
+			[]byte("servertest-preference-bundle"))
+		c.PreferenceCenterService = service.NewPreferenceCenterService(store, c.PreferenceService,
+			[]byte("servertest-preference-center"), 0)
+		c.ListUnsubscribeService = service.NewListUnsubscribeService(store, c.PreferenceService,
+			[]byte("servertest-list-unsubscribe"), service.ListUnsubscribeOptions{BaseURL: ListUnsubscribeBaseURL})
+		c.ConsentService = service.NewConsentService(store, store, store)
+		c.AgeService = service.NewAgeService(store, store, store)
+		c.AdminNoteService = service.NewAdminNoteService(store, store, store)
+		c.RoleService = service.NewRoleService(store)
+		c.OrganizationService = service.NewOrganizationService(store)
+		c.InvitationService = service.NewInvitationService(store, store, service.InvitationOptions{})
+		c.ModerationService = service.NewModerationService(store, store, nil)
+		c.DeviceTokenService = service.NewDeviceTokenService(store, store, 0, 0)
+		c.StatsService = service.NewStatsService(store, 0)
+		c.PrivacyReportService = service.NewPrivacyReportService(store, store, store, store)
+		c.DataAccessRepo = store
+		c.PolicyService = service.NewPolicyService(store, nil)
+		c.MaintenanceService = service.NewMaintenanceService(store, service.MaintenanceOptions{})
+		c.PresenceService = service.NewPresenceService(store, store, store, service.PresenceOptions{})
+		c.ProfileViewService = service.NewProfileViewService(store, store, store)
+		c.RecentlyViewedService = service.NewRecentlyViewedService(store, store, service.RecentlyViewedOptions{})
+		c.ProfileShareService = service.NewProfileShareService(store, store, []byte("servertest-profile-share"))
+		c.EmbedService = service.NewEmbedService(store, store, service.EmbedOptions{})
+		c.PrivacyDefaultsService = service.NewPrivacyDefaultsService(store, 0, nil)
+	}
+}
+
+// WithPrivacyDefaultsVersion provisions the users of the memory store not provisioned yet under
+// the given privacy defaults version.
+func WithPrivacyDefaultsVersion(store *memory.Store, version int) Option {
+	return func(c *app.Container) {
+		store.SetPrivacyDefaultsVersion(version)
+		c.PrivacyDefaultsService = service.NewPrivacyDefaultsService(store, version, nil)
+	}
+}
+
+// WithBadges defines the badges users of the memory store earn and adds them to profiles and
+// follows. Events are evaluated as they are reported. Apply it after WithMemoryStore.
+func WithBadges(store *memory.Store, definitions ...dto.BadgeDefinition) Option {
+	return func(c *app.Container) {
+		svc := service.NewBadgeService(store, store, service.BadgeOptions{Definitions: definitions})
+		c.BadgeService = svc
+
+		if userService, ok := c.UserService.(*service.UserServiceImpl); ok {
+			userService.SetBadgeService(svc)
+		}
+
+		if socialService, ok := c.SocialService.(*service.SocialServiceImpl); ok {
+			socialService.SetBadgeService(svc)
+		}
+	}
+}
+
+// WithPublicProfiles serves anonymous profile views and embeds of the memory store from the public
+// profile projection, kept in the store, and drops projected profiles on the writes that change
+// them. Apply it after WithMemoryStore.
+func WithPublicProfiles(store *memory.Store, opts service.PublicProfileOptions) Option {
+	return func(c *app.Container) {
+		svc := service.NewPublicProfileService(store, store, store, store, store, opts)
+
+		if userService, ok := c.UserService.(*service.UserServiceImpl); ok {
+			userService.SetPublicProfiles(svc)
+		}
+
+		if embedService, ok := c.EmbedService.(*service.EmbedServiceImpl); ok {
+			embedService.SetPublicProfiles(svc)
+		}
+
+		if preferenceService, ok := c.PreferenceService.(*service.PreferenceServiceImpl); ok {
+			preferenceService.SetPublicProfiles(svc)
+		}
+
+		if ageService, ok := c.AgeService.(*service.AgeServiceImpl); ok {
+			ageService.SetPublicProfiles(svc)
+		}
+
+		if moderationService, ok := c.ModerationService.(*service.ModerationServiceImpl); ok {
+			moderationService.SetPublicProfiles(svc)
+		}
+	}
+}
+
+// WithDirectory syncs the accounts of the memory store linked to a directory entry from reader.
+// Apply it after WithMemoryStore.
+func WithDirectory(store *memory.Store, reader service.DirectoryReader) Option {
+	return func(c *app.Container) {
+		c.DirectorySyncService = service.NewDirectorySyncService(store, store, service.DirectorySyncOptions{
+			Reader: reader,
+		})
+	}
+}
+
+// WithInvitations lets users of the memory store invite others under opts. Apply it after
+// WithMemoryStore.
+func WithInvitations(store *memory.Store, opts service.InvitationOptions) Option {
+	return func(c *app.Container) {
+		c.InvitationService = service.NewInvitationService(store, store, opts)
+	}
+}
+
+// WithRoles looks up the roles stored on user accounts in roles.
+func WithRoles(roles repository.RoleRepository) Option {
+	return func(c *app.Container) {
+		c.RoleService = service.NewRoleService(roles)
+	}
+}
+
+// WithUserService sets the user service.
+func WithUserService(svc service.UserService) Option {
+	return func(c *app.Container) {
+		c.UserService = svc
+	}
+}
+
+// WithSocialService sets the social service.
+func WithSocialService(svc service.SocialService) Option {
+	return func(c *app.Container) {
+		c.SocialService = svc
+	}
+}
+
+// WithAdminService sets the admin service.
+func WithAdminService(svc service.AdminService) Option {
+	return func(c *app.Container) {
+		c.AdminService = svc
+	}
+}
+
+// WithMetricsService sets the metrics service.
+func WithMetricsService(svc service.MetricsService) Option {
+	return func(c *app.Container) {
+		c.MetricsService = svc
+	}
+}
+
+// WithHealthService replaces the default no-op health service.
+func WithHealthService(svc service.HealthServicer) Option {
+	return func(c *app.Container) {
+		c.HealthService = svc
+	}
+}
+
+// WithContainer applies an arbitrary change for dependencies without a dedicated option.
+func WithContainer(fn func(*app.Container)) Option {
+	return fn
+}
@@ -0,0 +1,47 @@
+package servertest_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestServer_WithMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{
+		Users:   []fixtures.User{{Username: "alice"}, {Username: "bob"}},
+		Follows: []fixtures.Follow{{Follower: "bob", Followee: "alice"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+
+	resp := srv.Get(servertest.Path("users", alice.String(), "followers")).As(alice).Do(t)
+	resp.AssertStatus(http.StatusOK)
+
+	followers := servertest.DecodeJSON[dto.GetFollowedUsersResponse](resp)
+	require.Len(t, followers.FollowedUsers, 1)
+	assert.Equal(t, bob.String(), followers.FollowedUsers[0].UserID)
+
+	srv.Put(servertest.Path("users/profile"), map[string]string{"username": "ab"}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusBadRequest, "VALIDATION_ERROR")
+
+	srv.Get(servertest.Path("users", uuid.NewString(), "profile")).
+		Do(t).
+		AssertError(http.StatusUnauthorized, "UNAUTHORIZED")
+}
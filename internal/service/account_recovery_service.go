@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/notification"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// AccountRecoveryTokenTTL is how long an account recovery token can be redeemed for.
+const AccountRecoveryTokenTTL = time.Hour
+
+// ErrRecoveryWindowExpired is returned when an account was deactivated longer ago than the
+// identifier retention period and can no longer be recovered.
+var ErrRecoveryWindowExpired = errors.New("account recovery window has expired")
+
+// AccountRecoveryService lets users who deactivated their account and can no longer sign in
+// reactivate it through their email address.
+type AccountRecoveryService interface {
+	// RequestRecovery sends a recovery token to email if it belongs to a recoverable account.
+	RequestRecovery(ctx context.Context, email string) error
+	// ConfirmRecovery redeems a recovery token and reactivates its account.
+	ConfirmRecovery(ctx context.Context, token string) (*dto.AccountRecoveryResponse, error)
+}
+
+// AccountRecoveryServiceImpl implements AccountRecoveryService.
+// Accounts can be recovered for as long as they keep their identifiers, which is the identifier
+// retention period after deactivation. Only the hash of a token is stored, and a token is
+// removed when it is redeemed, so each one reactivates its account at most once.
+type AccountRecoveryServiceImpl struct {
+	users              repository.UserRepository
+	recovery           repository.AccountRecoveryRepository
+	store              repository.AccountRecoveryStore
+	notificationClient notification.Client
+}
+
+// NewAccountRecoveryService creates a new AccountRecoveryService.
+func NewAccountRecoveryService(
+	users repository.UserRepository,
+	recovery repository.AccountRecoveryRepository,
+	store repository.AccountRecoveryStore,
+	notificationClient notification.Client,
+) *AccountRecoveryServiceImpl {
+	return &AccountRecoveryServiceImpl{
+		users:              users,
+		recovery:           recovery,
+		store:              store,
+		notificationClient: notificationClient,
+	}
+}
+
+// RequestRecovery sends a recovery token to email if it belongs to an account deactivated within
+// the identifier retention period. Unknown, active and expired addresses succeed without sending
+// anything, so callers cannot tell which addresses have accounts.
+func (s *AccountRecoveryServiceImpl) RequestRecovery(ctx context.Context, email string) error {
+	// 1. Check if the store is available
+	if s.store == nil {
+		return ErrCacheUnavailable
+	}
+
+	// 2. Find the deactivated account holding the address
+	user, err := s.recovery.FindDeactivatedUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			logAccountRecoveryEvent("account_recovery_ignored", uuid.Nil, "reason", "no_deactivated_account")
+
+			return nil
+		}
+
+		return fmt.Errorf("failed to fetch deactivated user: %w", err)
+	}
+
+	userID, err := uuid.Parse(user.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to parse user ID: %w", err)
+	}
+
+	if !recoverable(user) {
+		logAccountRecoveryEvent("account_recovery_ignored", userID, "reason", "window_expired")
+
+		return nil
+	}
+
+	// 3. Store the hash of a new token
+	token, err := newRecoveryToken()
+	if err != nil {
+		return err
+	}
+
+	err = s.store.StoreRecoveryToken(ctx, hashRecoveryToken(token), userID, AccountRecoveryTokenTTL)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
+
+	logAccountRecoveryEvent("account_recovery_requested", userID)
+
+	// 4. Deliver the token to the account's address (fire-and-forget)
+	if s.notificationClient != nil && user.Email != nil {
+		go s.notificationClient.NotifyAccountRecovery( //nolint:contextcheck
+			context.Background(), userID, *user.Email, token,
+		)
+	}
+
+	return nil
+}
+
+// ConfirmRecovery redeems a recovery token and reactivates its account, provided the account is
+// still deactivated and within the identifier retention period.
+func (s *AccountRecoveryServiceImpl) ConfirmRecovery(
+	ctx context.Context,
+	token string,
+) (*dto.AccountRecoveryResponse, error) {
+	// 1. Check if the store is available
+	if s.store == nil {
+		return nil, ErrCacheUnavailable
+	}
+
+	// 2. Redeem the token
+	userID, err := s.store.TakeRecoveryToken(ctx, hashRecoveryToken(token))
+	if err != nil {
+		if errors.Is(err, redis.ErrTokenNotFound) {
+			logAccountRecoveryEvent("account_recovery_rejected", uuid.Nil, "reason", "invalid_token")
+
+			return nil, ErrInvalidToken
+		}
+
+		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
+
+	// 3. Check the account can still be recovered
+	user, err := s.users.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	if user.IsActive {
+		logAccountRecoveryEvent("account_recovery_rejected", userID, "reason", "already_active")
+
+		return nil, ErrInvalidToken
+	}
+
+	if !recoverable(user) {
+		logAccountRecoveryEvent("account_recovery_rejected", userID, "reason", "window_expired")
+
+		return nil, ErrRecoveryWindowExpired
+	}
+
+	// 4. Reactivate the account
+	isActive := true
+
+	_, err = s.users.UpdateUser(ctx, userID, &dto.UserProfileUpdateRequest{IsActive: &isActive})
+	if err != nil {
+		logAccountRecoveryEvent("account_recovery_failed", userID, "error", err)
+
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to reactivate user: %w", err)
+	}
+
+	logAccountRecoveryEvent("account_recovery_completed", userID)
+
+	return &dto.AccountRecoveryResponse{
+		UserID:        user.UserID,
+		Username:      user.Username,
+		ReactivatedAt: time.Now(),
+	}, nil
+}
+
+// recoverable reports whether a deactivated user is still within the identifier retention
+// period.
+func recoverable(user *dto.User) bool {
+	return user.DeactivatedAt != nil && time.Since(*user.DeactivatedAt) <= repository.IdentifierRetentionPeriod
+}
+
+// newRecoveryToken returns a random URL-safe recovery token.
+func newRecoveryToken() (string, error) {
+	buf := make([]byte, 32)
+
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate recovery token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashRecoveryToken returns the key a recovery token is stored under.
+func hashRecoveryToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// logAccountRecoveryEvent records a security event for the account recovery flow.
+// Addresses and tokens are deliberately left out of the log.
+func logAccountRecoveryEvent(event string, userID uuid.UUID, attrs ...any) {
+	args := []any{"event", event}
+	if userID != uuid.Nil {
+		args = append(args, "user_id", userID)
+	}
+
+	slog.Info("security event", append(args, attrs...)...)
+}
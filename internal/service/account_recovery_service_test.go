@@ -0,0 +1,165 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func deactivatedAgo(d time.Duration) *time.Time {
+	at := time.Now().Add(-d)
+
+	return &at
+}
+
+func TestAccountRecoveryServiceRequestRecovery(t *testing.T) {
+	t.Parallel()
+
+	email := "gone@example.com"
+
+	t.Run("unknown addresses send nothing", func(t *testing.T) {
+		t.Parallel()
+
+		recovery := mocks.NewAccountRecoveryRepository(t)
+		recovery.On("FindDeactivatedUserByEmail", mock.Anything, email).Return(nil, repository.ErrUserNotFound)
+
+		svc := service.NewAccountRecoveryService(mocks.NewUserRepository(t), recovery,
+			mocks.NewAccountRecoveryStore(t), nil)
+		require.NoError(t, svc.RequestRecovery(t.Context(), email))
+	})
+
+	t.Run("accounts past the retention period send nothing", func(t *testing.T) {
+		t.Parallel()
+
+		recovery := mocks.NewAccountRecoveryRepository(t)
+		recovery.On("FindDeactivatedUserByEmail", mock.Anything, email).Return(&dto.User{
+			UserID:        uuid.NewString(),
+			Email:         &email,
+			DeactivatedAt: deactivatedAgo(repository.IdentifierRetentionPeriod + time.Hour),
+		}, nil)
+
+		svc := service.NewAccountRecoveryService(mocks.NewUserRepository(t), recovery,
+			mocks.NewAccountRecoveryStore(t), nil)
+		require.NoError(t, svc.RequestRecovery(t.Context(), email))
+	})
+
+	t.Run("stores a token for recent deactivations", func(t *testing.T) {
+		t.Parallel()
+
+		userID := uuid.New()
+
+		recovery := mocks.NewAccountRecoveryRepository(t)
+		recovery.On("FindDeactivatedUserByEmail", mock.Anything, email).Return(&dto.User{
+			UserID:        userID.String(),
+			Email:         &email,
+			DeactivatedAt: deactivatedAgo(time.Hour),
+		}, nil)
+
+		store := mocks.NewAccountRecoveryStore(t)
+		store.On("StoreRecoveryToken", mock.Anything, mock.AnythingOfType("string"), userID,
+			service.AccountRecoveryTokenTTL).Return(nil)
+
+		svc := service.NewAccountRecoveryService(mocks.NewUserRepository(t), recovery, store, nil)
+		require.NoError(t, svc.RequestRecovery(t.Context(), email))
+	})
+
+	t.Run("no store", func(t *testing.T) {
+		t.Parallel()
+
+		svc := service.NewAccountRecoveryService(mocks.NewUserRepository(t), mocks.NewAccountRecoveryRepository(t),
+			nil, nil)
+		require.ErrorIs(t, svc.RequestRecovery(t.Context(), email), service.ErrCacheUnavailable)
+	})
+}
+
+func TestAccountRecoveryServiceConfirmRecovery(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("unknown token", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewAccountRecoveryStore(t)
+		store.On("TakeRecoveryToken", mock.Anything, mock.Anything).Return(uuid.Nil, redis.ErrTokenNotFound)
+
+		svc := service.NewAccountRecoveryService(mocks.NewUserRepository(t), mocks.NewAccountRecoveryRepository(t),
+			store, nil)
+
+		_, err := svc.ConfirmRecovery(t.Context(), "token")
+		require.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+
+	t.Run("reactivates the account", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewAccountRecoveryStore(t)
+		store.On("TakeRecoveryToken", mock.Anything, mock.Anything).Return(userID, nil)
+
+		users := mocks.NewUserRepository(t)
+		users.On("FindUserByID", mock.Anything, userID).Return(&dto.User{
+			UserID:        userID.String(),
+			Username:      "gone",
+			DeactivatedAt: deactivatedAgo(time.Hour),
+		}, nil)
+		users.On("UpdateUser", mock.Anything, userID, mock.MatchedBy(func(u *dto.UserProfileUpdateRequest) bool {
+			return u.IsActive != nil && *u.IsActive
+		})).Return(&dto.User{UserID: userID.String(), IsActive: true}, nil)
+
+		svc := service.NewAccountRecoveryService(users, mocks.NewAccountRecoveryRepository(t), store, nil)
+
+		response, err := svc.ConfirmRecovery(t.Context(), "token")
+		require.NoError(t, err)
+		assert.Equal(t, userID.String(), response.UserID)
+		assert.Equal(t, "gone", response.Username)
+	})
+
+	t.Run("rejects accounts past the retention period", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewAccountRecoveryStore(t)
+		store.On("TakeRecoveryToken", mock.Anything, mock.Anything).Return(userID, nil)
+
+		users := mocks.NewUserRepository(t)
+		// A later write to the deactivated row doesn't restart the window
+		users.On("FindUserByID", mock.Anything, userID).Return(&dto.User{
+			UserID:        userID.String(),
+			UpdatedAt:     time.Now(),
+			DeactivatedAt: deactivatedAgo(repository.IdentifierRetentionPeriod + time.Hour),
+		}, nil)
+
+		svc := service.NewAccountRecoveryService(users, mocks.NewAccountRecoveryRepository(t), store, nil)
+
+		_, err := svc.ConfirmRecovery(t.Context(), "token")
+		require.ErrorIs(t, err, service.ErrRecoveryWindowExpired)
+	})
+
+	t.Run("rejects active accounts", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewAccountRecoveryStore(t)
+		store.On("TakeRecoveryToken", mock.Anything, mock.Anything).Return(userID, nil)
+
+		users := mocks.NewUserRepository(t)
+		users.On("FindUserByID", mock.Anything, userID).Return(&dto.User{
+			UserID:    userID.String(),
+			IsActive:  true,
+			UpdatedAt: time.Now(),
+		}, nil)
+
+		svc := service.NewAccountRecoveryService(users, mocks.NewAccountRecoveryRepository(t), store, nil)
+
+		_, err := svc.ConfirmRecovery(t.Context(), "token")
+		require.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+}
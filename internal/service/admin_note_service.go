@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// auditTrailLimit caps the audit entries returned for one user.
+const auditTrailLimit = 200
+
+// ErrNoteNotFound is returned when a note does not exist on the given user.
+var ErrNoteNotFound = errors.New("note not found")
+
+// AdminNoteService manages internal notes on user accounts and the audit trail of admin actions.
+// Every note change is attributed to the acting admin in the audit trail.
+type AdminNoteService interface {
+	// ListNotes returns the notes on a user, pinned notes first, then newest first.
+	ListNotes(ctx context.Context, userID uuid.UUID) (*dto.AdminNotesResponse, error)
+	// CreateNote adds a note to a user, written by actorID.
+	CreateNote(ctx context.Context, actorID, userID uuid.UUID, req *dto.AdminNoteRequest) (*dto.AdminNote, error)
+	// UpdateNote changes the text or pinned flag of a note.
+	UpdateNote(
+		ctx context.Context,
+		actorID, userID uuid.UUID,
+		noteID int64,
+		req *dto.AdminNoteUpdateRequest,
+	) (*dto.AdminNote, error)
+	// DeleteNote removes a note.
+	DeleteNote(ctx context.Context, actorID, userID uuid.UUID, noteID int64) error
+	// GetAuditTrail returns the most recent admin actions on a user, newest first.
+	GetAuditTrail(ctx context.Context, userID uuid.UUID) (*dto.AuditTrailResponse, error)
+}
+
+// AdminNoteServiceImpl implements AdminNoteService.
+type AdminNoteServiceImpl struct {
+	users repository.UserRepository
+	notes repository.AdminNoteRepository
+	audit repository.AuditRepository
+}
+
+// NewAdminNoteService creates a new AdminNoteService.
+func NewAdminNoteService(
+	users repository.UserRepository,
+	notes repository.AdminNoteRepository,
+	audit repository.AuditRepository,
+) *AdminNoteServiceImpl {
+	return &AdminNoteServiceImpl{users: users, notes: notes, audit: audit}
+}
+
+// ListNotes returns the notes on a user, pinned notes first, then newest first.
+func (s *AdminNoteServiceImpl) ListNotes(ctx context.Context, userID uuid.UUID) (*dto.AdminNotesResponse, error) {
+	err := s.requireUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := s.notes.ListNotes(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch notes: %w", err)
+	}
+
+	return &dto.AdminNotesResponse{UserID: userID.String(), Notes: notes}, nil
+}
+
+// CreateNote adds a note to a user, written by actorID.
+func (s *AdminNoteServiceImpl) CreateNote(
+	ctx context.Context,
+	actorID, userID uuid.UUID,
+	req *dto.AdminNoteRequest,
+) (*dto.AdminNote, error) {
+	err := s.requireUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	note := &dto.AdminNote{
+		UserID:   userID.String(),
+		AuthorID: actorID.String(),
+		Text:     req.Text,
+		Pinned:   req.Pinned,
+	}
+
+	err = s.notes.CreateNote(ctx, userID, note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create note: %w", err)
+	}
+
+	return note, nil
+}
+
+// UpdateNote changes the text or pinned flag of a note.
+func (s *AdminNoteServiceImpl) UpdateNote(
+	ctx context.Context,
+	actorID, userID uuid.UUID,
+	noteID int64,
+	req *dto.AdminNoteUpdateRequest,
+) (*dto.AdminNote, error) {
+	note, err := s.notes.UpdateNote(ctx, actorID, userID, noteID, req)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoteNotFound) {
+			return nil, ErrNoteNotFound
+		}
+
+		return nil, fmt.Errorf("failed to update note: %w", err)
+	}
+
+	return note, nil
+}
+
+// DeleteNote removes a note.
+func (s *AdminNoteServiceImpl) DeleteNote(ctx context.Context, actorID, userID uuid.UUID, noteID int64) error {
+	err := s.notes.DeleteNote(ctx, actorID, userID, noteID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoteNotFound) {
+			return ErrNoteNotFound
+		}
+
+		return fmt.Errorf("failed to delete note: %w", err)
+	}
+
+	return nil
+}
+
+// GetAuditTrail returns the most recent admin actions on a user, newest first.
+func (s *AdminNoteServiceImpl) GetAuditTrail(ctx context.Context, userID uuid.UUID) (*dto.AuditTrailResponse, error) {
+	err := s.requireUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.audit.GetAuditTrail(ctx, userID, auditTrailLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch audit trail: %w", err)
+	}
+
+	return &dto.AuditTrailResponse{UserID: userID.String(), Entries: entries}, nil
+}
+
+func (s *AdminNoteServiceImpl) requireUser(ctx context.Context, userID uuid.UUID) error {
+	_, err := s.users.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+
+		return fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestAdminNoteService_CreateNote_AttributesAuthor(t *testing.T) {
+	t.Parallel()
+
+	actorID, userID := uuid.New(), uuid.New()
+
+	users := mocks.NewUserRepository(t)
+	notes := mocks.NewAdminNoteRepository(t)
+
+	users.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+	notes.On("CreateNote", mock.Anything, userID, mock.MatchedBy(func(note *dto.AdminNote) bool {
+		return note.AuthorID == actorID.String() && note.Text == "Refund issued" && note.Pinned
+	})).Return(nil)
+
+	svc := service.NewAdminNoteService(users, notes, mocks.NewAuditRepository(t))
+
+	note, err := svc.CreateNote(t.Context(), actorID, userID, &dto.AdminNoteRequest{Text: "Refund issued", Pinned: true})
+	require.NoError(t, err)
+	assert.Equal(t, userID.String(), note.UserID)
+}
+
+func TestAdminNoteService_CreateNote_UnknownUser(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	users := mocks.NewUserRepository(t)
+	users.On("FindUserByID", mock.Anything, userID).Return(nil, repository.ErrUserNotFound)
+
+	svc := service.NewAdminNoteService(users, mocks.NewAdminNoteRepository(t), mocks.NewAuditRepository(t))
+
+	_, err := svc.CreateNote(t.Context(), uuid.New(), userID, &dto.AdminNoteRequest{Text: "Refund issued"})
+	require.ErrorIs(t, err, service.ErrUserNotFound)
+}
+
+func TestAdminNoteService_DeleteNote_NotFound(t *testing.T) {
+	t.Parallel()
+
+	actorID, userID := uuid.New(), uuid.New()
+
+	notes := mocks.NewAdminNoteRepository(t)
+	notes.On("DeleteNote", mock.Anything, actorID, userID, int64(7)).Return(repository.ErrNoteNotFound)
+
+	svc := service.NewAdminNoteService(mocks.NewUserRepository(t), notes, mocks.NewAuditRepository(t))
+
+	err := svc.DeleteNote(t.Context(), actorID, userID, 7)
+	require.ErrorIs(t, err, service.ErrNoteNotFound)
+}
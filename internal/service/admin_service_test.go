@@ -1,22 +1,15 @@
-package service
+package service_test
 
 import (
 	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-)
-
-type MockRedisCacheClient struct {
-	mock.Mock
-}
 
-func (m *MockRedisCacheClient) ClearCache(ctx context.Context, pattern string) (int, error) {
-	args := m.Called(ctx, pattern)
-	return args.Int(0), args.Error(1)
-}
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
 
 func TestAdminService_ClearCache(t *testing.T) {
 	t.Parallel()
@@ -24,8 +17,8 @@ func TestAdminService_ClearCache(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		t.Parallel()
 
-		mockRedis := new(MockRedisCacheClient)
-		svc := NewAdminService(mockRedis)
+		mockRedis := new(mocks.RedisCacheClient)
+		svc := service.NewAdminService(mockRedis)
 		ctx := context.Background()
 		pattern := "user:*"
 
@@ -44,8 +37,8 @@ func TestAdminService_ClearCache(t *testing.T) {
 	t.Run("redis error", func(t *testing.T) {
 		t.Parallel()
 
-		mockRedis := new(MockRedisCacheClient)
-		svc := NewAdminService(mockRedis)
+		mockRedis := new(mocks.RedisCacheClient)
+		svc := service.NewAdminService(mockRedis)
 		ctx := context.Background()
 		pattern := "user:*"
 
@@ -62,7 +55,7 @@ func TestAdminService_ClearCache(t *testing.T) {
 	t.Run("nil redis client", func(t *testing.T) {
 		t.Parallel()
 
-		svc := NewAdminService(nil)
+		svc := service.NewAdminService(nil)
 		ctx := context.Background()
 
 		resp, err := svc.ClearCache(ctx, "*")
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// maxAgeYears bounds how far in the past a birthdate may be.
+const maxAgeYears = 120
+
+// Age verification errors.
+var (
+	// ErrInvalidBirthdate is returned for birthdates in the future or implausibly far in the past.
+	ErrInvalidBirthdate = errors.New("invalid birthdate")
+	// ErrBirthdateAlreadySet is returned when a user changes a birthdate they already provided.
+	// Only an admin override can change the outcome afterwards.
+	ErrBirthdateAlreadySet = errors.New("birthdate already set")
+	// ErrAgeRestricted is returned when a change is not allowed on a minor's account.
+	ErrAgeRestricted = errors.New("not allowed for minors")
+)
+
+// AgeService records birthdates and applies the restrictions for minors: a private profile, no
+// appearance in search and no marketing consent.
+type AgeService interface {
+	// GetAgeStatus returns the user's birthdate and the restrictions that apply to them.
+	GetAgeStatus(ctx context.Context, userID uuid.UUID) (*dto.AgeStatusResponse, error)
+	// SetBirthdate records the user's birthdate once and applies restrictions if they are a minor.
+	SetBirthdate(ctx context.Context, userID uuid.UUID, req *dto.BirthdateRequest) (*dto.AgeStatusResponse, error)
+	// SetAgeOverride records an admin decision on whether the user is a minor. An empty override
+	// clears it, leaving the birthdate to decide.
+	SetAgeOverride(ctx context.Context, userID uuid.UUID, override dto.AgeOverride) (*dto.AgeStatusResponse, error)
+}
+
+// AgeServiceImpl implements AgeService.
+type AgeServiceImpl struct {
+	ages        repository.AgeRepository
+	preferences repository.PreferenceRepository
+	consents    repository.ConsentRepository
+	tx          repository.TxManager
+	profiles    PublicProfileService
+}
+
+// NewAgeService creates a new AgeService.
+func NewAgeService(
+	ages repository.AgeRepository,
+	preferences repository.PreferenceRepository,
+	consents repository.ConsentRepository,
+) *AgeServiceImpl {
+	return &AgeServiceImpl{ages: ages, preferences: preferences, consents: consents}
+}
+
+// SetTxManager records a birthdate or override together with the restrictions it applies, so a
+// failure part way through leaves neither.
+func (s *AgeServiceImpl) SetTxManager(tx repository.TxManager) {
+	s.tx = tx
+}
+
+// SetPublicProfiles drops a user's projected public profile when restrictions make it private.
+func (s *AgeServiceImpl) SetPublicProfiles(profiles PublicProfileService) {
+	s.profiles = profiles
+}
+
+// GetAgeStatus returns the user's birthdate and the restrictions that apply to them.
+func (s *AgeServiceImpl) GetAgeStatus(ctx context.Context, userID uuid.UUID) (*dto.AgeStatusResponse, error) {
+	err := s.requireUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := s.ages.GetAgeVerification(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch age verification: %w", err)
+	}
+
+	return ageStatus(userID, verification, time.Now()), nil
+}
+
+// SetBirthdate records the user's birthdate once and applies restrictions if they are a minor.
+func (s *AgeServiceImpl) SetBirthdate(
+	ctx context.Context,
+	userID uuid.UUID,
+	req *dto.BirthdateRequest,
+) (*dto.AgeStatusResponse, error) {
+	now := time.Now()
+
+	birthdate, err := time.Parse(time.DateOnly, req.Birthdate)
+	if err != nil || birthdate.After(now) || birthdate.Before(now.AddDate(-maxAgeYears, 0, 0)) {
+		return nil, ErrInvalidBirthdate
+	}
+
+	err = s.requireUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var status *dto.AgeStatusResponse
+
+	err = withinTx(ctx, s.tx, func(ctx context.Context) error {
+		verification, err := s.ages.GetAgeVerification(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch age verification: %w", err)
+		}
+
+		if verification.Birthdate != nil {
+			return ErrBirthdateAlreadySet
+		}
+
+		err = s.ages.SetBirthdate(ctx, userID, birthdate)
+		if err != nil {
+			return fmt.Errorf("failed to set birthdate: %w", err)
+		}
+
+		verification.Birthdate = &birthdate
+		status, err = s.applyRestrictions(ctx, userID, verification, now)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if status.IsMinor {
+		invalidatePublicProfile(ctx, s.profiles, userID)
+	}
+
+	return status, nil
+}
+
+// SetAgeOverride records an admin decision on whether the user is a minor.
+func (s *AgeServiceImpl) SetAgeOverride(
+	ctx context.Context,
+	userID uuid.UUID,
+	override dto.AgeOverride,
+) (*dto.AgeStatusResponse, error) {
+	err := s.requireUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var status *dto.AgeStatusResponse
+
+	err = withinTx(ctx, s.tx, func(ctx context.Context) error {
+		err := s.ages.SetAgeOverride(ctx, userID, override)
+		if err != nil {
+			return fmt.Errorf("failed to set age override: %w", err)
+		}
+
+		verification, err := s.ages.GetAgeVerification(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch age verification: %w", err)
+		}
+
+		status, err = s.applyRestrictions(ctx, userID, verification, time.Now())
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if status.IsMinor {
+		invalidatePublicProfile(ctx, s.profiles, userID)
+	}
+
+	return status, nil
+}
+
+// applyRestrictions makes a minor's profile private and withdraws any marketing consent. Lifting
+// the restrictions leaves the settings as they are for the user to change.
+func (s *AgeServiceImpl) applyRestrictions(
+	ctx context.Context,
+	userID uuid.UUID,
+	verification *dto.AgeVerification,
+	now time.Time,
+) (*dto.AgeStatusResponse, error) {
+	if !verification.IsMinor(now) {
+		return ageStatus(userID, verification, now), nil
+	}
+
+	private := dto.ProfileVisibilityPrivate
+
+	_, err := s.preferences.UpdatePrivacyPreferencesData(ctx, userID, &dto.PrivacyPreferencesUpdate{
+		ProfileVisibility: &private,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to make profile private: %w", err)
+	}
+
+	consents, err := s.consents.GetCurrentConsents(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch consents: %w", err)
+	}
+
+	for _, consent := range consents {
+		if consent.Purpose != dto.ConsentPurposeMarketingEmails || !consent.Granted {
+			continue
+		}
+
+		err = s.consents.RecordConsent(ctx, userID, &dto.ConsentRecord{
+			Purpose:       dto.ConsentPurposeMarketingEmails,
+			Source:        dto.ConsentSourcePolicy,
+			PolicyVersion: consent.PolicyVersion,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to withdraw marketing consent: %w", err)
+		}
+	}
+
+	return ageStatus(userID, verification, now), nil
+}
+
+func (s *AgeServiceImpl) requireUser(ctx context.Context, userID uuid.UUID) error {
+	exists, err := s.preferences.UserExists(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to verify user: %w", err)
+	}
+
+	if !exists {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+func ageStatus(userID uuid.UUID, verification *dto.AgeVerification, now time.Time) *dto.AgeStatusResponse {
+	status := &dto.AgeStatusResponse{
+		UserID:       userID.String(),
+		Override:     verification.Override,
+		IsMinor:      verification.IsMinor(now),
+		Restrictions: []dto.AgeRestriction{},
+	}
+
+	if verification.Birthdate != nil {
+		status.Birthdate = verification.Birthdate.Format(time.DateOnly)
+	}
+
+	if status.IsMinor {
+		status.Restrictions = dto.MinorRestrictions
+	}
+
+	return status
+}
+
+// requireAdult fails with ErrAgeRestricted if minor restrictions apply to the user. A nil
+// repository applies no age restrictions.
+func requireAdult(ctx context.Context, ages repository.AgeRepository, userID uuid.UUID) error {
+	if ages == nil {
+		return nil
+	}
+
+	verification, err := ages.GetAgeVerification(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch age verification: %w", err)
+	}
+
+	if verification.IsMinor(time.Now()) {
+		return ErrAgeRestricted
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestAgeVerification_IsMinor(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	turns18Today := time.Date(2008, time.October, 17, 0, 0, 0, 0, time.UTC)
+	turns18Tomorrow := time.Date(2008, time.October, 18, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		verification *dto.AgeVerification
+		want         bool
+	}{
+		{name: "nothing stored", verification: &dto.AgeVerification{}, want: false},
+		{name: "adult birthday today", verification: &dto.AgeVerification{Birthdate: &turns18Today}, want: false},
+		{name: "minor until tomorrow", verification: &dto.AgeVerification{Birthdate: &turns18Tomorrow}, want: true},
+		{
+			name:         "adult override wins",
+			verification: &dto.AgeVerification{Birthdate: &turns18Tomorrow, Override: dto.AgeOverrideAdult},
+			want:         false,
+		},
+		{
+			name:         "minor override without birthdate",
+			verification: &dto.AgeVerification{Override: dto.AgeOverrideMinor},
+			want:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.verification.IsMinor(now))
+		})
+	}
+}
+
+func TestAgeService_SetAgeOverride_RestrictsMinor(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	ages := mocks.NewAgeRepository(t)
+	preferences := mocks.NewPreferenceRepository(t)
+	consents := mocks.NewConsentRepository(t)
+
+	preferences.On("UserExists", mock.Anything, userID).Return(true, nil)
+	ages.On("SetAgeOverride", mock.Anything, userID, dto.AgeOverrideMinor).Return(nil)
+	ages.On("GetAgeVerification", mock.Anything, userID).
+		Return(&dto.AgeVerification{Override: dto.AgeOverrideMinor}, nil)
+	preferences.On("UpdatePrivacyPreferencesData", mock.Anything, userID, mock.MatchedBy(
+		func(u *dto.PrivacyPreferencesUpdate) bool {
+			return *u.ProfileVisibility == dto.ProfileVisibilityPrivate
+		},
+	)).Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}, nil)
+	consents.On("GetCurrentConsents", mock.Anything, userID).Return([]dto.ConsentRecord{
+		{Purpose: dto.ConsentPurposeMarketingEmails, Granted: true, PolicyVersion: "2026-10"},
+		{Purpose: dto.ConsentPurposeAnalyticsTracking, Granted: true, PolicyVersion: "2026-10"},
+	}, nil)
+	consents.On("RecordConsent", mock.Anything, userID, &dto.ConsentRecord{
+		Purpose:       dto.ConsentPurposeMarketingEmails,
+		Source:        dto.ConsentSourcePolicy,
+		PolicyVersion: "2026-10",
+	}).Return(nil).Once()
+
+	svc := service.NewAgeService(ages, preferences, consents)
+
+	status, err := svc.SetAgeOverride(t.Context(), userID, dto.AgeOverrideMinor)
+	require.NoError(t, err)
+
+	assert.True(t, status.IsMinor)
+	assert.Equal(t, dto.AgeOverrideMinor, status.Override)
+	assert.Empty(t, status.Birthdate)
+}
+
+func TestAgeService_SetBirthdate_OneUnitOfWork(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	birthdate := time.Now().AddDate(-12, 0, 0).Format(time.DateOnly)
+
+	ages := mocks.NewAgeRepository(t)
+	preferences := mocks.NewPreferenceRepository(t)
+	consents := mocks.NewConsentRepository(t)
+	tx := mocks.NewTxManager(t)
+
+	preferences.On("UserExists", mock.Anything, userID).Return(true, nil)
+	tx.On("WithinTx", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) },
+	).Once()
+	ages.On("GetAgeVerification", mock.Anything, userID).Return(&dto.AgeVerification{}, nil)
+	ages.On("SetBirthdate", mock.Anything, userID, mock.Anything).Return(nil)
+	preferences.On("UpdatePrivacyPreferencesData", mock.Anything, userID, mock.Anything).
+		Return(nil, assert.AnError)
+
+	svc := service.NewAgeService(ages, preferences, consents)
+	svc.SetTxManager(tx)
+
+	_, err := svc.SetBirthdate(t.Context(), userID, &dto.BirthdateRequest{Birthdate: birthdate})
+	require.ErrorIs(t, err, assert.AnError, "the failed restriction fails the unit of work")
+}
+
+func TestConsentService_RecordConsent_BlocksMinorMarketing(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	granted := true
+
+	consents := mocks.NewConsentRepository(t)
+	preferences := mocks.NewPreferenceRepository(t)
+	ages := mocks.NewAgeRepository(t)
+
+	preferences.On("UserExists", mock.Anything, userID).Return(true, nil)
+	ages.On("GetAgeVerification", mock.Anything, userID).
+		Return(&dto.AgeVerification{Override: dto.AgeOverrideMinor}, nil)
+
+	svc := service.NewConsentService(consents, preferences, ages)
+
+	_, err := svc.RecordConsent(t.Context(), userID, userID, &dto.ConsentRequest{
+		Purpose:       dto.ConsentPurposeMarketingEmails,
+		Granted:       &granted,
+		PolicyVersion: "2026-10",
+	}, false, false)
+	require.ErrorIs(t, err, service.ErrAgeRestricted)
+	consents.AssertNotCalled(t, "RecordConsent", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// badgeEvaluationBatch is how many users are read from the queue, or the sweep, at a time.
+const badgeEvaluationBatch = 100
+
+// BadgeService awards the badges defined in configuration when the recipe, follower or
+// membership metric they count reaches their threshold, and lists the badges users earned.
+type BadgeService interface {
+	// RecordEvent reports something the user did that may earn them a badge.
+	RecordEvent(ctx context.Context, userID uuid.UUID, event dto.BadgeEvent) error
+	// GetBadges returns the badges a user earned, if requester (uuid.Nil when anonymous) can
+	// view their profile.
+	GetBadges(ctx context.Context, requesterID, userID uuid.UUID) (*dto.UserBadgesResponse, error)
+	// ListEarnedBadges returns the badges a user earned, earliest first, without privacy checks.
+	ListEarnedBadges(ctx context.Context, userID uuid.UUID) ([]dto.Badge, error)
+}
+
+// BadgeOptions configures badges.
+type BadgeOptions struct {
+	// Definitions are the badges users can earn.
+	Definitions []dto.BadgeDefinition
+	// Queue defers the evaluation of reported events to EvaluateQueued, so bursts of events for
+	// a user are evaluated once. Without a queue each event is evaluated as it is reported.
+	Queue repository.BadgeQueue
+}
+
+// BadgeServiceImpl implements BadgeService.
+type BadgeServiceImpl struct {
+	repo        repository.BadgeRepository
+	userRepo    repository.UserRepository
+	definitions []dto.BadgeDefinition
+	queue       repository.BadgeQueue
+	now         func() time.Time
+}
+
+// NewBadgeService creates a new BadgeService.
+func NewBadgeService(
+	repo repository.BadgeRepository,
+	userRepo repository.UserRepository,
+	opts BadgeOptions,
+) *BadgeServiceImpl {
+	return &BadgeServiceImpl{
+		repo:        repo,
+		userRepo:    userRepo,
+		definitions: opts.Definitions,
+		queue:       opts.Queue,
+		now:         time.Now,
+	}
+}
+
+// RecordEvent queues the user's badges for evaluation, or evaluates them right away without a
+// queue. Queued events are not checked against the users, so the gateway can report them
+// without a database read.
+func (s *BadgeServiceImpl) RecordEvent(ctx context.Context, userID uuid.UUID, event dto.BadgeEvent) error {
+	slog.DebugContext(ctx, "badge event", "user_id", userID, "event", event)
+
+	if s.queue == nil {
+		_, err := s.Evaluate(ctx, userID)
+
+		return err
+	}
+
+	err := s.queue.EnqueueBadgeEvaluation(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to record badge event: %w", err)
+	}
+
+	return nil
+}
+
+// Evaluate awards the user every badge whose threshold they reached and returns the IDs of
+// those newly earned.
+func (s *BadgeServiceImpl) Evaluate(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	metrics, err := s.repo.GetBadgeMetrics(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch badge metrics: %w", err)
+	}
+
+	var reached []string
+
+	for _, badge := range s.definitions {
+		if s.reached(badge, metrics) {
+			reached = append(reached, badge.BadgeID)
+		}
+	}
+
+	if len(reached) == 0 {
+		return nil, nil
+	}
+
+	awarded, err := s.repo.AwardBadges(ctx, userID, reached)
+	if err != nil {
+		return nil, fmt.Errorf("failed to award badges: %w", err)
+	}
+
+	if len(awarded) > 0 {
+		slog.InfoContext(ctx, "badges awarded", "user_id", userID, "badges", awarded)
+	}
+
+	return awarded, nil
+}
+
+// EvaluateQueued evaluates the users with events reported since the last run and returns how
+// many. Users it could not get to are queued again.
+func (s *BadgeServiceImpl) EvaluateQueued(ctx context.Context) (int, error) {
+	if s.queue == nil {
+		return 0, nil
+	}
+
+	evaluated := 0
+
+	for {
+		userIDs, err := s.queue.DequeueBadgeEvaluations(ctx, badgeEvaluationBatch)
+		if err != nil {
+			return evaluated, fmt.Errorf("failed to dequeue badge evaluations: %w", err)
+		}
+
+		for i, userID := range userIDs {
+			_, err = s.Evaluate(ctx, userID)
+			if err != nil && !errors.Is(err, ErrUserNotFound) {
+				s.requeue(ctx, userIDs[i:])
+
+				return evaluated, err
+			}
+
+			evaluated++
+		}
+
+		if len(userIDs) < badgeEvaluationBatch {
+			return evaluated, nil
+		}
+	}
+}
+
+// requeue puts back users whose evaluation did not happen, even when ctx was cancelled.
+func (s *BadgeServiceImpl) requeue(ctx context.Context, userIDs []uuid.UUID) {
+	ctx = context.WithoutCancel(ctx)
+
+	for _, userID := range userIDs {
+		err := s.queue.EnqueueBadgeEvaluation(ctx, userID)
+		if err != nil {
+			slog.WarnContext(ctx, "badge evaluation dropped", "user_id", userID, "error", err)
+		}
+	}
+}
+
+// AwardMembershipBadges awards the membership badges to the users who reached them since the
+// last run, which no event reports, and returns how many were awarded.
+func (s *BadgeServiceImpl) AwardMembershipBadges(ctx context.Context) (int, error) {
+	awarded := 0
+
+	for _, badge := range s.definitions {
+		if badge.Metric != dto.BadgeMetricMembershipDays {
+			continue
+		}
+
+		joinedBefore := s.now().AddDate(0, 0, -badge.Threshold)
+
+		for {
+			userIDs, err := s.repo.ListUsersMissingBadge(ctx, badge.BadgeID, joinedBefore, badgeEvaluationBatch)
+			if err != nil {
+				return awarded, fmt.Errorf("failed to list users for badge %s: %w", badge.BadgeID, err)
+			}
+
+			for _, userID := range userIDs {
+				earned, err := s.repo.AwardBadges(ctx, userID, []string{badge.BadgeID})
+				if err != nil {
+					return awarded, fmt.Errorf("failed to award badge %s: %w", badge.BadgeID, err)
+				}
+
+				awarded += len(earned)
+			}
+
+			if len(userIDs) < badgeEvaluationBatch {
+				break
+			}
+		}
+	}
+
+	return awarded, nil
+}
+
+// GetBadges returns the badges a user earned, if requester can view their profile.
+func (s *BadgeServiceImpl) GetBadges(
+	ctx context.Context,
+	requesterID, userID uuid.UUID,
+) (*dto.UserBadgesResponse, error) {
+	// 1. Verify user exists and is active
+	user, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	if !user.IsActive && requesterID != userID {
+		return nil, ErrUserNotFound
+	}
+
+	// 2. Badges are shown to whoever can view the profile
+	privacy, err := s.userRepo.FindPrivacyPreferencesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	visible, err := profileVisible(ctx, s.userRepo, requesterID, userID, privacy)
+	if err != nil {
+		return nil, err
+	}
+
+	if !visible {
+		return nil, ErrProfilePrivate
+	}
+
+	// 3. Fetch badges
+	badges, err := s.ListEarnedBadges(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UserBadgesResponse{UserID: userID.String(), Badges: badges}, nil
+}
+
+// ListEarnedBadges returns the badges a user earned, earliest first. Badges no longer defined
+// are left out.
+func (s *BadgeServiceImpl) ListEarnedBadges(ctx context.Context, userID uuid.UUID) ([]dto.Badge, error) {
+	earned, err := s.repo.ListUserBadges(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch badges: %w", err)
+	}
+
+	badges := make([]dto.Badge, 0, len(earned))
+
+	for _, e := range earned {
+		for _, badge := range s.definitions {
+			if badge.BadgeID == e.BadgeID {
+				badges = append(badges, dto.Badge{
+					BadgeID:     badge.BadgeID,
+					Name:        badge.Name,
+					Description: badge.Description,
+					EarnedAt:    e.EarnedAt,
+				})
+			}
+		}
+	}
+
+	return badges, nil
+}
+
+// reached reports whether metrics reach the threshold of badge.
+func (s *BadgeServiceImpl) reached(badge dto.BadgeDefinition, metrics *dto.BadgeMetrics) bool {
+	switch badge.Metric {
+	case dto.BadgeMetricRecipes:
+		return metrics.Recipes >= badge.Threshold
+	case dto.BadgeMetricFollowers:
+		return metrics.Followers >= badge.Threshold
+	case dto.BadgeMetricMembershipDays:
+		return metrics.MemberSince.Before(s.now().AddDate(0, 0, -badge.Threshold))
+	default:
+		return false
+	}
+}
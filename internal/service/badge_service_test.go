@@ -0,0 +1,213 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+var badgeDefinitions = []dto.BadgeDefinition{
+	{BadgeID: "first-recipe", Name: "First Recipe", Metric: dto.BadgeMetricRecipes, Threshold: 1},
+	{BadgeID: "followers-100", Name: "Crowd Pleaser", Metric: dto.BadgeMetricFollowers, Threshold: 100},
+	{BadgeID: "member-1-year", Name: "One Year Member", Metric: dto.BadgeMetricMembershipDays, Threshold: 365},
+}
+
+func TestBadgeService_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name    string
+		metrics dto.BadgeMetrics
+		reached []string
+	}{
+		{
+			name:    "new member without recipes",
+			metrics: dto.BadgeMetrics{MemberSince: time.Now().AddDate(0, 0, -10)},
+		},
+		{
+			name:    "first recipe",
+			metrics: dto.BadgeMetrics{Recipes: 1, Followers: 99, MemberSince: time.Now().AddDate(0, 0, -364)},
+			reached: []string{"first-recipe"},
+		},
+		{
+			name:    "every threshold",
+			metrics: dto.BadgeMetrics{Recipes: 5, Followers: 100, MemberSince: time.Now().AddDate(-1, 0, -1)},
+			reached: []string{"first-recipe", "followers-100", "member-1-year"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewBadgeRepository(t)
+			repo.On("GetBadgeMetrics", mock.Anything, userID).Return(&tt.metrics, nil)
+
+			if tt.reached != nil {
+				repo.On("AwardBadges", mock.Anything, userID, tt.reached).Return(tt.reached[:1], nil)
+			}
+
+			svc := service.NewBadgeService(repo, mocks.NewUserRepository(t),
+				service.BadgeOptions{Definitions: badgeDefinitions})
+
+			awarded, err := svc.Evaluate(t.Context(), userID)
+			require.NoError(t, err)
+
+			if tt.reached != nil {
+				assert.Equal(t, tt.reached[:1], awarded, "only the newly earned badges are returned")
+			}
+		})
+	}
+}
+
+func TestBadgeService_RecordEvent(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("queued", func(t *testing.T) {
+		t.Parallel()
+
+		queue := mocks.NewBadgeQueue(t)
+		queue.On("EnqueueBadgeEvaluation", mock.Anything, userID).Return(nil)
+
+		svc := service.NewBadgeService(mocks.NewBadgeRepository(t), mocks.NewUserRepository(t),
+			service.BadgeOptions{Definitions: badgeDefinitions, Queue: queue})
+
+		require.NoError(t, svc.RecordEvent(t.Context(), userID, dto.BadgeEventRecipeCreated))
+	})
+
+	t.Run("evaluated right away without a queue", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewBadgeRepository(t)
+		repo.On("GetBadgeMetrics", mock.Anything, userID).Return(nil, repository.ErrUserNotFound)
+
+		svc := service.NewBadgeService(repo, mocks.NewUserRepository(t),
+			service.BadgeOptions{Definitions: badgeDefinitions})
+
+		err := svc.RecordEvent(t.Context(), userID, dto.BadgeEventRecipeCreated)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestBadgeService_EvaluateQueued(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	errDB := errors.New("connection reset")
+
+	queue := mocks.NewBadgeQueue(t)
+	repo := mocks.NewBadgeRepository(t)
+
+	queue.On("DequeueBadgeEvaluations", mock.Anything, 100).Return([]uuid.UUID{alice, bob, carol}, nil).Once()
+	repo.On("GetBadgeMetrics", mock.Anything, alice).Return(nil, repository.ErrUserNotFound)
+	repo.On("GetBadgeMetrics", mock.Anything, bob).Return(nil, errDB)
+
+	// Users not evaluated are put back for the next run
+	queue.On("EnqueueBadgeEvaluation", mock.Anything, bob).Return(nil)
+	queue.On("EnqueueBadgeEvaluation", mock.Anything, carol).Return(nil)
+
+	svc := service.NewBadgeService(repo, mocks.NewUserRepository(t),
+		service.BadgeOptions{Definitions: badgeDefinitions, Queue: queue})
+
+	evaluated, err := svc.EvaluateQueued(t.Context())
+	require.ErrorIs(t, err, errDB)
+	assert.Equal(t, 1, evaluated, "deleted users are skipped")
+}
+
+func TestBadgeService_AwardMembershipBadges(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := uuid.New(), uuid.New()
+
+	repo := mocks.NewBadgeRepository(t)
+	repo.On("ListUsersMissingBadge", mock.Anything, "member-1-year", mock.AnythingOfType("time.Time"), 100).
+		Return([]uuid.UUID{alice, bob}, nil)
+	repo.On("AwardBadges", mock.Anything, alice, []string{"member-1-year"}).Return([]string{"member-1-year"}, nil)
+	repo.On("AwardBadges", mock.Anything, bob, []string{"member-1-year"}).Return([]string{}, nil)
+
+	svc := service.NewBadgeService(repo, mocks.NewUserRepository(t), service.BadgeOptions{Definitions: badgeDefinitions})
+
+	awarded, err := svc.AwardMembershipBadges(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, awarded)
+
+	joinedBefore, ok := repo.Calls[0].Arguments.Get(2).(time.Time)
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, -365), joinedBefore, time.Minute)
+}
+
+func TestBadgeService_GetBadges(t *testing.T) {
+	t.Parallel()
+
+	userID, requesterID := uuid.New(), uuid.New()
+	earnedAt := time.Now().Add(-time.Hour)
+	active := &dto.User{UserID: userID.String(), IsActive: true}
+
+	t.Run("public profile", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewBadgeRepository(t)
+		userRepo := mocks.NewUserRepository(t)
+
+		userRepo.On("FindUserByID", mock.Anything, userID).Return(active, nil)
+		userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+			Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}, nil)
+		repo.On("ListUserBadges", mock.Anything, userID).Return([]dto.EarnedBadge{
+			{BadgeID: "first-recipe", EarnedAt: earnedAt},
+			{BadgeID: "retired-badge", EarnedAt: earnedAt},
+		}, nil)
+
+		svc := service.NewBadgeService(repo, userRepo, service.BadgeOptions{Definitions: badgeDefinitions})
+
+		response, err := svc.GetBadges(t.Context(), uuid.Nil, userID)
+		require.NoError(t, err)
+		assert.Equal(t, &dto.UserBadgesResponse{
+			UserID: userID.String(),
+			Badges: []dto.Badge{{BadgeID: "first-recipe", Name: "First Recipe", EarnedAt: earnedAt}},
+		}, response, "badges no longer defined are left out")
+	})
+
+	t.Run("friends only profile of a stranger", func(t *testing.T) {
+		t.Parallel()
+
+		userRepo := mocks.NewUserRepository(t)
+
+		userRepo.On("FindUserByID", mock.Anything, userID).Return(active, nil)
+		userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+			Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}, nil)
+		userRepo.On("IsFollowing", mock.Anything, requesterID, userID).Return(false, nil)
+
+		svc := service.NewBadgeService(mocks.NewBadgeRepository(t), userRepo,
+			service.BadgeOptions{Definitions: badgeDefinitions})
+
+		_, err := svc.GetBadges(t.Context(), requesterID, userID)
+		require.ErrorIs(t, err, service.ErrProfilePrivate)
+	})
+
+	t.Run("inactive user", func(t *testing.T) {
+		t.Parallel()
+
+		userRepo := mocks.NewUserRepository(t)
+		userRepo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+
+		svc := service.NewBadgeService(mocks.NewBadgeRepository(t), userRepo,
+			service.BadgeOptions{Definitions: badgeDefinitions})
+
+		_, err := svc.GetBadges(t.Context(), requesterID, userID)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
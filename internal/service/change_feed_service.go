@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// ChangeFeedService exposes the user change log to downstream consumers.
+type ChangeFeedService interface {
+	GetUserChanges(ctx context.Context, since int64, limit int) (*dto.UserChangesResponse, error)
+}
+
+// ChangeFeedServiceImpl implements ChangeFeedService.
+type ChangeFeedServiceImpl struct {
+	repo repository.ChangeLogRepository
+}
+
+// NewChangeFeedService creates a new ChangeFeedService.
+func NewChangeFeedService(repo repository.ChangeLogRepository) *ChangeFeedServiceImpl {
+	return &ChangeFeedServiceImpl{repo: repo}
+}
+
+// GetUserChanges returns up to limit changes recorded after the since cursor.
+// The returned NextCursor should be passed back as since to continue the feed;
+// it equals since when no new changes are available.
+func (s *ChangeFeedServiceImpl) GetUserChanges(
+	ctx context.Context,
+	since int64,
+	limit int,
+) (*dto.UserChangesResponse, error) {
+	// Fetch one extra row to determine whether another page exists
+	changes, err := s.repo.GetChangesSince(ctx, since, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user changes: %w", err)
+	}
+
+	hasMore := len(changes) > limit
+	if hasMore {
+		changes = changes[:limit]
+	}
+
+	if changes == nil {
+		changes = []dto.UserChange{}
+	}
+
+	nextCursor := since
+	if len(changes) > 0 {
+		nextCursor = changes[len(changes)-1].Sequence
+	}
+
+	return &dto.UserChangesResponse{
+		Changes:    changes,
+		NextCursor: strconv.FormatInt(nextCursor, 10),
+		HasMore:    hasMore,
+	}, nil
+}
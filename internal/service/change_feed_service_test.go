@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func createUserChanges(start int64, count int) []dto.UserChange {
+	changes := make([]dto.UserChange, 0, count)
+	for i := range count {
+		changes = append(changes, dto.UserChange{
+			Sequence:   start + int64(i),
+			UserID:     uuid.New().String(),
+			ChangeType: dto.UserChangeTypeUpsert,
+			ChangedAt:  time.Now(),
+		})
+	}
+
+	return changes
+}
+
+func TestChangeFeedServiceGetUserChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns page with next cursor and hasMore", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mocks.ChangeLogRepository)
+		svc := service.NewChangeFeedService(repo)
+		ctx := context.Background()
+
+		repo.On("GetChangesSince", ctx, int64(10), 3).Return(createUserChanges(11, 3), nil)
+
+		resp, err := svc.GetUserChanges(ctx, 10, 2)
+
+		require.NoError(t, err)
+		assert.Len(t, resp.Changes, 2)
+		assert.Equal(t, "12", resp.NextCursor)
+		assert.True(t, resp.HasMore)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("last page has no more", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mocks.ChangeLogRepository)
+		svc := service.NewChangeFeedService(repo)
+		ctx := context.Background()
+
+		repo.On("GetChangesSince", ctx, int64(0), 11).Return(createUserChanges(1, 4), nil)
+
+		resp, err := svc.GetUserChanges(ctx, 0, 10)
+
+		require.NoError(t, err)
+		assert.Len(t, resp.Changes, 4)
+		assert.Equal(t, "4", resp.NextCursor)
+		assert.False(t, resp.HasMore)
+	})
+
+	t.Run("no changes keeps cursor", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mocks.ChangeLogRepository)
+		svc := service.NewChangeFeedService(repo)
+		ctx := context.Background()
+
+		repo.On("GetChangesSince", ctx, int64(42), 11).Return(nil, nil)
+
+		resp, err := svc.GetUserChanges(ctx, 42, 10)
+
+		require.NoError(t, err)
+		assert.NotNil(t, resp.Changes)
+		assert.Empty(t, resp.Changes)
+		assert.Equal(t, "42", resp.NextCursor)
+		assert.False(t, resp.HasMore)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(mocks.ChangeLogRepository)
+		svc := service.NewChangeFeedService(repo)
+		ctx := context.Background()
+
+		repo.On("GetChangesSince", ctx, int64(0), 11).Return(nil, errDB)
+
+		resp, err := svc.GetUserChanges(ctx, 0, 10)
+
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, errDB)
+	})
+}
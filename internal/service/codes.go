@@ -0,0 +1,39 @@
+package service
+
+import (
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/crypto"
+)
+
+// hashCode returns what to store for a confirmation code: its argon2id hash, or the code itself
+// without a hasher.
+func hashCode(hasher *crypto.Hasher, code string) (string, error) {
+	if hasher == nil {
+		return code, nil
+	}
+
+	hash, err := hasher.Hash(code)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash confirmation code: %w", err)
+	}
+
+	return hash, nil
+}
+
+// matchesCode reports whether code matches stored, a hash made by hashCode or, for codes stored
+// before hashing was turned on, the code itself. Both comparisons take constant time.
+func matchesCode(stored, code string) bool {
+	if stored == "" {
+		return false
+	}
+
+	if crypto.IsHash(stored) {
+		ok, err := crypto.Verify(code, stored)
+
+		return err == nil && ok
+	}
+
+	return subtle.ConstantTimeCompare([]byte(stored), []byte(code)) == 1
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// ConsentService records consent decisions and reports a user's consents. Access follows the
+// preference rules: the user, admins and service accounts with user scopes.
+type ConsentService interface {
+	// GetConsents returns the latest decision for each purpose.
+	GetConsents(
+		ctx context.Context,
+		requesterID, targetUserID uuid.UUID,
+		isAdmin bool,
+		hasServiceScope bool,
+	) (*dto.ConsentsResponse, error)
+
+	// GetConsentHistory returns the full consent ledger of a user, newest first.
+	GetConsentHistory(
+		ctx context.Context,
+		requesterID, targetUserID uuid.UUID,
+		isAdmin bool,
+		hasServiceScope bool,
+	) (*dto.ConsentHistoryResponse, error)
+
+	// RecordConsent records a grant or withdrawal. The source is derived from the requester.
+	RecordConsent(
+		ctx context.Context,
+		requesterID, targetUserID uuid.UUID,
+		req *dto.ConsentRequest,
+		isAdmin bool,
+		hasServiceScope bool,
+	) (*dto.ConsentRecord, error)
+}
+
+// ConsentServiceImpl implements ConsentService.
+type ConsentServiceImpl struct {
+	consents    repository.ConsentRepository
+	preferences repository.PreferenceRepository
+	ages        repository.AgeRepository
+}
+
+// NewConsentService creates a new ConsentService. Minors in ages cannot consent to marketing
+// emails; a nil ages applies no age restrictions.
+func NewConsentService(
+	consents repository.ConsentRepository,
+	preferences repository.PreferenceRepository,
+	ages repository.AgeRepository,
+) *ConsentServiceImpl {
+	return &ConsentServiceImpl{consents: consents, preferences: preferences, ages: ages}
+}
+
+// GetConsents returns the latest decision for each purpose.
+func (s *ConsentServiceImpl) GetConsents(
+	ctx context.Context,
+	requesterID, targetUserID uuid.UUID,
+	isAdmin bool,
+	hasServiceScope bool,
+) (*dto.ConsentsResponse, error) {
+	_, err := s.authorize(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	consents, err := s.consents.GetCurrentConsents(ctx, targetUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch consents: %w", err)
+	}
+
+	return &dto.ConsentsResponse{UserID: targetUserID.String(), Consents: consents}, nil
+}
+
+// GetConsentHistory returns the full consent ledger of a user, newest first.
+func (s *ConsentServiceImpl) GetConsentHistory(
+	ctx context.Context,
+	requesterID, targetUserID uuid.UUID,
+	isAdmin bool,
+	hasServiceScope bool,
+) (*dto.ConsentHistoryResponse, error) {
+	_, err := s.authorize(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.consents.GetConsentHistory(ctx, targetUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch consent history: %w", err)
+	}
+
+	return &dto.ConsentHistoryResponse{UserID: targetUserID.String(), Records: records}, nil
+}
+
+// RecordConsent records a grant or withdrawal. A withdrawal also turns off the preference the
+// consent covered.
+func (s *ConsentServiceImpl) RecordConsent(
+	ctx context.Context,
+	requesterID, targetUserID uuid.UUID,
+	req *dto.ConsentRequest,
+	isAdmin bool,
+	hasServiceScope bool,
+) (*dto.ConsentRecord, error) {
+	source, err := s.authorize(ctx, requesterID, targetUserID, isAdmin, hasServiceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &dto.ConsentRecord{
+		Purpose:       req.Purpose,
+		Granted:       req.Granted != nil && *req.Granted,
+		Source:        source,
+		PolicyVersion: req.PolicyVersion,
+	}
+
+	if record.Granted && record.Purpose == dto.ConsentPurposeMarketingEmails {
+		err = requireAdult(ctx, s.ages, targetUserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.consents.RecordConsent(ctx, targetUserID, record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record consent: %w", err)
+	}
+
+	return record, nil
+}
+
+// authorize checks access to the target's consents and returns the source recorded for
+// decisions made by the requester.
+func (s *ConsentServiceImpl) authorize(
+	ctx context.Context,
+	requesterID, targetUserID uuid.UUID,
+	isAdmin bool,
+	hasServiceScope bool,
+) (dto.ConsentSource, error) {
+	var source dto.ConsentSource
+
+	switch {
+	case requesterID == targetUserID:
+		source = dto.ConsentSourceUI
+	case isAdmin:
+		source = dto.ConsentSourceAdmin
+	case hasServiceScope:
+		source = dto.ConsentSourceImport
+	default:
+		return "", ErrUnauthorizedAccess
+	}
+
+	exists, err := s.preferences.UserExists(ctx, targetUserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to verify user: %w", err)
+	}
+
+	if !exists {
+		return "", ErrUserNotFound
+	}
+
+	return source, nil
+}
@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestConsentService_RecordConsent_Source(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	granted := true
+
+	tests := []struct {
+		name            string
+		requesterID     uuid.UUID
+		isAdmin         bool
+		hasServiceScope bool
+		want            dto.ConsentSource
+	}{
+		{name: "user", requesterID: userID, want: dto.ConsentSourceUI},
+		{name: "admin", requesterID: uuid.New(), isAdmin: true, want: dto.ConsentSourceAdmin},
+		{name: "service account", requesterID: uuid.Nil, hasServiceScope: true, want: dto.ConsentSourceImport},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			consents := mocks.NewConsentRepository(t)
+			preferences := mocks.NewPreferenceRepository(t)
+
+			preferences.On("UserExists", mock.Anything, userID).Return(true, nil)
+			consents.On("RecordConsent", mock.Anything, userID, mock.Anything).
+				Run(func(args mock.Arguments) {
+					args.Get(2).(*dto.ConsentRecord).RecordedAt = time.Now()
+				}).
+				Return(nil)
+
+			svc := service.NewConsentService(consents, preferences, nil)
+
+			record, err := svc.RecordConsent(t.Context(), tt.requesterID, userID, &dto.ConsentRequest{
+				Purpose:       dto.ConsentPurposeDataSharing,
+				Granted:       &granted,
+				PolicyVersion: "2026-10",
+			}, tt.isAdmin, tt.hasServiceScope)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, record.Source)
+			assert.True(t, record.Granted)
+			assert.Equal(t, "2026-10", record.PolicyVersion)
+			assert.False(t, record.RecordedAt.IsZero())
+		})
+	}
+}
+
+func TestConsentService_GetConsents_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	svc := service.NewConsentService(mocks.NewConsentRepository(t), mocks.NewPreferenceRepository(t), nil)
+
+	_, err := svc.GetConsents(t.Context(), uuid.New(), uuid.New(), false, false)
+	require.ErrorIs(t, err, service.ErrUnauthorizedAccess)
+}
+
+func TestPreferenceService_RequiresConsent(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	enabled := true
+
+	repo := mocks.NewPreferenceRepository(t)
+	consents := mocks.NewConsentRepository(t)
+
+	repo.On("UserExists", mock.Anything, userID).Return(true, nil)
+	consents.On("GetCurrentConsents", mock.Anything, userID).Return([]dto.ConsentRecord{
+		{Purpose: dto.ConsentPurposeAnalyticsTracking, Granted: true},
+		{Purpose: dto.ConsentPurposeDataSharing, Granted: false},
+	}, nil)
+
+	svc := service.NewPreferenceService(repo, consents, nil)
+
+	_, err := svc.UpdateCategoryPreferences(t.Context(), userID, userID, dto.PreferenceCategoryPrivacy,
+		&dto.PrivacyPreferencesUpdate{AnalyticsTracking: &enabled, DataSharing: &enabled}, false, false)
+	require.ErrorIs(t, err, service.ErrConsentRequired)
+	assert.ErrorContains(t, err, string(dto.ConsentPurposeDataSharing))
+
+	// Turning on a preference that does not need consent skips the ledger
+	repo.On("UpdateNotificationPreferences", mock.Anything, userID, mock.Anything).
+		Return(&dto.NotificationPreferences{PushNotifications: true}, nil)
+
+	_, err = svc.UpdateAllPreferences(t.Context(), userID, userID, &dto.UserPreferencesUpdateRequest{
+		Notification: &dto.NotificationPreferencesUpdate{PushNotifications: &enabled},
+	}, false, false)
+	require.NoError(t, err)
+	consents.AssertNumberOfCalls(t, "GetCurrentConsents", 1)
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// ErrDeviceNotFound is returned when a device is not registered to the user.
+var ErrDeviceNotFound = errors.New("device not found")
+
+// DeviceTokenService manages the devices users register for push notifications and serves them
+// to the notification service.
+type DeviceTokenService interface {
+	// RegisterDevice registers a device token to a user, or refreshes its registration.
+	RegisterDevice(ctx context.Context, userID uuid.UUID, req *dto.DeviceTokenRequest) (*dto.DeviceToken, error)
+	// ListDevices returns the devices registered to a user, most recently seen first.
+	ListDevices(ctx context.Context, userID uuid.UUID) (*dto.DeviceTokensResponse, error)
+	// UnregisterDevice removes a device from a user.
+	UnregisterDevice(ctx context.Context, userID uuid.UUID, deviceID int64) error
+	// GetPushTargets returns the devices to push to for a user, none if the user turned push
+	// notifications off.
+	GetPushTargets(ctx context.Context, userID uuid.UUID) (*dto.PushTargetsResponse, error)
+	// PruneStaleDevices removes devices not registered again within the stale period and returns
+	// how many were removed.
+	PruneStaleDevices(ctx context.Context) (int64, error)
+}
+
+// DeviceTokenServiceImpl implements DeviceTokenService.
+type DeviceTokenServiceImpl struct {
+	devices     repository.DeviceTokenRepository
+	preferences repository.PreferenceRepository
+	maxDevices  int
+	staleAfter  time.Duration
+	now         func() time.Time
+}
+
+// NewDeviceTokenService creates a new DeviceTokenService. Users keep at most maxDevices devices
+// and devices not seen for staleAfter are pruned; zero disables either limit.
+func NewDeviceTokenService(
+	devices repository.DeviceTokenRepository,
+	preferences repository.PreferenceRepository,
+	maxDevices int,
+	staleAfter time.Duration,
+) *DeviceTokenServiceImpl {
+	return &DeviceTokenServiceImpl{
+		devices:     devices,
+		preferences: preferences,
+		maxDevices:  maxDevices,
+		staleAfter:  staleAfter,
+		now:         time.Now,
+	}
+}
+
+// RegisterDevice registers a device token to a user, or refreshes its registration.
+func (s *DeviceTokenServiceImpl) RegisterDevice(
+	ctx context.Context,
+	userID uuid.UUID,
+	req *dto.DeviceTokenRequest,
+) (*dto.DeviceToken, error) {
+	device := &dto.DeviceToken{Platform: req.Platform, Token: req.Token, AppVersion: req.AppVersion}
+
+	err := s.devices.RegisterDeviceToken(ctx, userID, device, s.maxDevices)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register device: %w", err)
+	}
+
+	return device, nil
+}
+
+// ListDevices returns the devices registered to a user, most recently seen first.
+func (s *DeviceTokenServiceImpl) ListDevices(ctx context.Context, userID uuid.UUID) (*dto.DeviceTokensResponse, error) {
+	devices, err := s.devices.ListDeviceTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch devices: %w", err)
+	}
+
+	return &dto.DeviceTokensResponse{Devices: devices}, nil
+}
+
+// UnregisterDevice removes a device from a user.
+func (s *DeviceTokenServiceImpl) UnregisterDevice(ctx context.Context, userID uuid.UUID, deviceID int64) error {
+	err := s.devices.DeleteDeviceToken(ctx, userID, deviceID)
+	if err != nil {
+		if errors.Is(err, repository.ErrDeviceNotFound) {
+			return ErrDeviceNotFound
+		}
+
+		return fmt.Errorf("failed to unregister device: %w", err)
+	}
+
+	return nil
+}
+
+// GetPushTargets returns the devices to push to for a user, none if the user turned push
+// notifications off.
+func (s *DeviceTokenServiceImpl) GetPushTargets(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.PushTargetsResponse, error) {
+	exists, err := s.preferences.UserExists(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify user: %w", err)
+	}
+
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	prefs, err := s.preferences.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch notification preferences: %w", err)
+	}
+
+	response := &dto.PushTargetsResponse{
+		UserID:      userID.String(),
+		PushEnabled: prefs.PushNotifications,
+		Devices:     []dto.DeviceToken{},
+	}
+
+	if !prefs.PushNotifications {
+		return response, nil
+	}
+
+	response.Devices, err = s.devices.ListDeviceTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch devices: %w", err)
+	}
+
+	return response, nil
+}
+
+// PruneStaleDevices removes devices not registered again within the stale period.
+func (s *DeviceTokenServiceImpl) PruneStaleDevices(ctx context.Context) (int64, error) {
+	if s.staleAfter <= 0 {
+		return 0, nil
+	}
+
+	deleted, err := s.devices.DeleteStaleDeviceTokens(ctx, s.now().Add(-s.staleAfter))
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune stale devices: %w", err)
+	}
+
+	return deleted, nil
+}
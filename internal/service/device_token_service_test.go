@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestDeviceTokenService_RegisterDevice_PassesLimit(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	devices := mocks.NewDeviceTokenRepository(t)
+
+	devices.On("RegisterDeviceToken", mock.Anything, userID, mock.MatchedBy(func(d *dto.DeviceToken) bool {
+		return d.Platform == dto.DevicePlatformIOS && d.Token == "apns-token" && d.AppVersion == "3.2.0"
+	}), 5).Run(func(args mock.Arguments) {
+		args.Get(2).(*dto.DeviceToken).DeviceID = 4
+	}).Return(nil)
+
+	svc := service.NewDeviceTokenService(devices, mocks.NewPreferenceRepository(t), 5, time.Hour)
+
+	device, err := svc.RegisterDevice(t.Context(), userID, &dto.DeviceTokenRequest{
+		Platform:   dto.DevicePlatformIOS,
+		Token:      "apns-token",
+		AppVersion: "3.2.0",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), device.DeviceID)
+}
+
+func TestDeviceTokenService_UnregisterDevice_NotFound(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	devices := mocks.NewDeviceTokenRepository(t)
+	devices.On("DeleteDeviceToken", mock.Anything, userID, int64(9)).Return(repository.ErrDeviceNotFound)
+
+	svc := service.NewDeviceTokenService(devices, mocks.NewPreferenceRepository(t), 0, 0)
+
+	err := svc.UnregisterDevice(t.Context(), userID, 9)
+	require.ErrorIs(t, err, service.ErrDeviceNotFound)
+}
+
+func TestDeviceTokenService_GetPushTargets(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	registered := []dto.DeviceToken{{DeviceID: 1, Platform: dto.DevicePlatformAndroid, Token: "fcm-token"}}
+
+	t.Run("push enabled", func(t *testing.T) {
+		t.Parallel()
+
+		devices := mocks.NewDeviceTokenRepository(t)
+		preferences := mocks.NewPreferenceRepository(t)
+
+		preferences.On("UserExists", mock.Anything, userID).Return(true, nil)
+		preferences.On("GetNotificationPreferences", mock.Anything, userID).
+			Return(&dto.NotificationPreferences{PushNotifications: true}, nil)
+		devices.On("ListDeviceTokens", mock.Anything, userID).Return(registered, nil)
+
+		svc := service.NewDeviceTokenService(devices, preferences, 0, 0)
+
+		targets, err := svc.GetPushTargets(t.Context(), userID)
+		require.NoError(t, err)
+		assert.True(t, targets.PushEnabled)
+		assert.Equal(t, registered, targets.Devices)
+	})
+
+	t.Run("push disabled", func(t *testing.T) {
+		t.Parallel()
+
+		devices := mocks.NewDeviceTokenRepository(t)
+		preferences := mocks.NewPreferenceRepository(t)
+
+		preferences.On("UserExists", mock.Anything, userID).Return(true, nil)
+		preferences.On("GetNotificationPreferences", mock.Anything, userID).
+			Return(&dto.NotificationPreferences{PushNotifications: false}, nil)
+
+		svc := service.NewDeviceTokenService(devices, preferences, 0, 0)
+
+		targets, err := svc.GetPushTargets(t.Context(), userID)
+		require.NoError(t, err)
+		assert.False(t, targets.PushEnabled)
+		assert.Empty(t, targets.Devices)
+		devices.AssertNotCalled(t, "ListDeviceTokens", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		t.Parallel()
+
+		preferences := mocks.NewPreferenceRepository(t)
+		preferences.On("UserExists", mock.Anything, userID).Return(false, nil)
+
+		svc := service.NewDeviceTokenService(mocks.NewDeviceTokenRepository(t), preferences, 0, 0)
+
+		_, err := svc.GetPushTargets(t.Context(), userID)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestDeviceTokenService_PruneStaleDevices(t *testing.T) {
+	t.Parallel()
+
+	devices := mocks.NewDeviceTokenRepository(t)
+	before := time.Now()
+
+	devices.On("DeleteStaleDeviceTokens", mock.Anything, mock.MatchedBy(func(cutoff time.Time) bool {
+		return !cutoff.Before(before.Add(-24*time.Hour)) && !cutoff.After(time.Now().Add(-24*time.Hour))
+	})).Return(int64(2), nil)
+
+	svc := service.NewDeviceTokenService(devices, mocks.NewPreferenceRepository(t), 0, 24*time.Hour)
+
+	deleted, err := svc.PruneStaleDevices(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	// Without a stale period nothing is pruned
+	disabled := service.NewDeviceTokenService(mocks.NewDeviceTokenRepository(t), mocks.NewPreferenceRepository(t), 0, 0)
+
+	deleted, err = disabled.PruneStaleDevices(t.Context())
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+}
@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// directorySyncBatch is how many links are synced per directory connection.
+const directorySyncBatch = 100
+
+// Directory sync errors.
+var (
+	// ErrDirectoryLinkNotFound is returned when the user is not linked to a directory entry.
+	ErrDirectoryLinkNotFound = errors.New("directory link not found")
+	// ErrExternalIDLinked is returned when the external ID is already linked to another user.
+	ErrExternalIDLinked = errors.New("external ID is linked to another user")
+	// ErrDirectoryUnavailable is returned when a sync is requested but no directory is configured.
+	ErrDirectoryUnavailable = errors.New("directory is not configured")
+)
+
+// DirectoryReader looks up the directory entries of external IDs. IDs without exactly one entry
+// are left out of the result.
+type DirectoryReader interface {
+	LookupEntries(ctx context.Context, externalIDs []string) (map[string]dto.DirectoryEntry, error)
+}
+
+// DirectorySyncService maps user accounts to LDAP directory entries and pulls their full name,
+// email and active flag from the directory. Links are managed by admins, who can see how the last
+// sync of each account went.
+type DirectorySyncService interface {
+	// GetLink returns the directory link and sync status of a user.
+	GetLink(ctx context.Context, userID uuid.UUID) (*dto.DirectoryLink, error)
+	// LinkUser maps a user to the directory entry with externalID.
+	LinkUser(ctx context.Context, actorID, userID uuid.UUID, externalID string) (*dto.DirectoryLink, error)
+	// UnlinkUser stops syncing a user. Values already synced are kept.
+	UnlinkUser(ctx context.Context, actorID, userID uuid.UUID) error
+	// SyncUser syncs a linked user right away and returns the outcome.
+	SyncUser(ctx context.Context, userID uuid.UUID) (*dto.DirectoryLink, error)
+}
+
+// DirectorySyncOptions configures directory sync.
+type DirectorySyncOptions struct {
+	// Reader looks up directory entries. Without one links can be managed but not synced.
+	Reader DirectoryReader
+	// Rules are the conflict rules per attribute. Attributes without a rule use DirectoryWins.
+	Rules map[dto.DirectoryAttribute]dto.DirectoryConflictRule
+}
+
+// DirectorySyncServiceImpl implements DirectorySyncService.
+type DirectorySyncServiceImpl struct {
+	links    repository.DirectoryLinkRepository
+	userRepo repository.UserRepository
+	reader   DirectoryReader
+	rules    map[dto.DirectoryAttribute]dto.DirectoryConflictRule
+	now      func() time.Time
+}
+
+// NewDirectorySyncService creates a new DirectorySyncService.
+func NewDirectorySyncService(
+	links repository.DirectoryLinkRepository,
+	userRepo repository.UserRepository,
+	opts DirectorySyncOptions,
+) *DirectorySyncServiceImpl {
+	return &DirectorySyncServiceImpl{
+		links:    links,
+		userRepo: userRepo,
+		reader:   opts.Reader,
+		rules:    opts.Rules,
+		now:      time.Now,
+	}
+}
+
+// GetLink returns the directory link and sync status of a user.
+func (s *DirectorySyncServiceImpl) GetLink(ctx context.Context, userID uuid.UUID) (*dto.DirectoryLink, error) {
+	link, err := s.links.GetDirectoryLink(ctx, userID)
+	if err != nil {
+		return nil, mapDirectoryLinkError(err)
+	}
+
+	return link, nil
+}
+
+// LinkUser maps a user to the directory entry with externalID. Relinking to another entry resets
+// the sync status; the next sync treats it as a first sync.
+func (s *DirectorySyncServiceImpl) LinkUser(
+	ctx context.Context,
+	actorID, userID uuid.UUID,
+	externalID string,
+) (*dto.DirectoryLink, error) {
+	link, err := s.links.LinkDirectoryEntry(ctx, actorID, userID, externalID)
+	if err != nil {
+		return nil, mapDirectoryLinkError(err)
+	}
+
+	return link, nil
+}
+
+// UnlinkUser stops syncing a user.
+func (s *DirectorySyncServiceImpl) UnlinkUser(ctx context.Context, actorID, userID uuid.UUID) error {
+	err := s.links.UnlinkDirectoryEntry(ctx, actorID, userID)
+	if err != nil {
+		return mapDirectoryLinkError(err)
+	}
+
+	return nil
+}
+
+// SyncUser syncs a linked user right away and returns the outcome.
+func (s *DirectorySyncServiceImpl) SyncUser(ctx context.Context, userID uuid.UUID) (*dto.DirectoryLink, error) {
+	if s.reader == nil {
+		return nil, ErrDirectoryUnavailable
+	}
+
+	link, err := s.links.GetDirectoryLink(ctx, userID)
+	if err != nil {
+		return nil, mapDirectoryLinkError(err)
+	}
+
+	links := []dto.DirectoryLink{*link}
+
+	err = s.syncLinks(ctx, links)
+	if err != nil {
+		return nil, err
+	}
+
+	return &links[0], nil
+}
+
+// SyncAll syncs every linked user and returns how many were synced. A directory that cannot be
+// reached fails the run and leaves the sync status of the remaining links as it was.
+func (s *DirectorySyncServiceImpl) SyncAll(ctx context.Context) (int, error) {
+	if s.reader == nil {
+		return 0, nil
+	}
+
+	synced := 0
+	after := uuid.Nil
+
+	for {
+		links, err := s.links.ListDirectoryLinks(ctx, after, directorySyncBatch)
+		if err != nil {
+			return synced, fmt.Errorf("failed to list directory links: %w", err)
+		}
+
+		if len(links) == 0 {
+			return synced, nil
+		}
+
+		err = s.syncLinks(ctx, links)
+		if err != nil {
+			return synced, err
+		}
+
+		synced += len(links)
+
+		if len(links) < directorySyncBatch {
+			return synced, nil
+		}
+
+		after, err = uuid.Parse(links[len(links)-1].UserID)
+		if err != nil {
+			return synced, fmt.Errorf("invalid directory link user ID: %w", err)
+		}
+	}
+}
+
+// syncLinks looks up the directory entries of links and applies them, updating links in place.
+func (s *DirectorySyncServiceImpl) syncLinks(ctx context.Context, links []dto.DirectoryLink) error {
+	externalIDs := make([]string, len(links))
+	for i, link := range links {
+		externalIDs[i] = link.ExternalID
+	}
+
+	entries, err := s.reader.LookupEntries(ctx, externalIDs)
+	if err != nil {
+		return fmt.Errorf("failed to look up directory entries: %w", err)
+	}
+
+	for i := range links {
+		err = s.syncLink(ctx, &links[i], entries)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncLink applies the directory entry of one link to its user and saves the outcome. Values the
+// user cannot take, like an email held by another account, fail the link rather than the run.
+func (s *DirectorySyncServiceImpl) syncLink(
+	ctx context.Context,
+	link *dto.DirectoryLink,
+	entries map[string]dto.DirectoryEntry,
+) error {
+	userID, err := uuid.Parse(link.UserID)
+	if err != nil {
+		return fmt.Errorf("invalid directory link user ID: %w", err)
+	}
+
+	now := s.now()
+	link.LastSyncedAt = &now
+	link.LastError = ""
+	link.Conflicts = []dto.DirectoryAttribute{}
+
+	entry, ok := entries[link.ExternalID]
+	if !ok {
+		// A missing entry may be a directory hiccup, so the account is left as it is
+		link.Status = dto.DirectorySyncNotFound
+
+		return s.saveSync(ctx, link)
+	}
+
+	user, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	update, conflicts := s.reconcile(user, link.Synced, entry.DirectoryAttributes)
+	link.Conflicts = conflicts
+
+	if update.FullName != nil || update.Email != nil || update.IsActive != nil {
+		_, err = s.userRepo.UpdateUser(ctx, userID, update)
+		if err != nil {
+			mapped := mapUpdateProfileError(err)
+			if !isProfileConflict(mapped) {
+				return mapped
+			}
+
+			// The synced values stay as they were, so local-wins attributes are retried as unchanged
+			link.Status = dto.DirectorySyncFailed
+			link.LastError = mapped.Error()
+
+			return s.saveSync(ctx, link)
+		}
+	}
+
+	link.Synced = entry.DirectoryAttributes
+
+	link.Status = dto.DirectorySyncSynced
+	if len(conflicts) > 0 {
+		link.Status = dto.DirectorySyncConflict
+	}
+
+	return s.saveSync(ctx, link)
+}
+
+func (s *DirectorySyncServiceImpl) saveSync(ctx context.Context, link *dto.DirectoryLink) error {
+	err := s.links.SaveDirectorySync(ctx, link)
+	if err != nil {
+		return fmt.Errorf("failed to save directory sync: %w", err)
+	}
+
+	if link.Status == dto.DirectorySyncFailed || link.Status == dto.DirectorySyncNotFound {
+		slog.WarnContext(ctx, "directory sync incomplete",
+			"user_id", link.UserID, "status", link.Status, "error", link.LastError)
+	}
+
+	return nil
+}
+
+// reconcile compares the user's values with the directory entry and returns the update to apply
+// and the attributes whose local value is kept over the directory value.
+func (s *DirectorySyncServiceImpl) reconcile(
+	user *dto.User,
+	synced, directory dto.DirectoryAttributes,
+) (*dto.UserProfileUpdateRequest, []dto.DirectoryAttribute) {
+	update := &dto.UserProfileUpdateRequest{}
+	conflicts := []dto.DirectoryAttribute{}
+
+	apply, conflict := reconcileAttribute(s.rule(dto.DirectoryAttributeFullName),
+		user.FullName, synced.FullName, directory.FullName)
+	if apply {
+		update.FullName = directory.FullName
+	} else if conflict {
+		conflicts = append(conflicts, dto.DirectoryAttributeFullName)
+	}
+
+	apply, conflict = reconcileAttribute(s.rule(dto.DirectoryAttributeEmail), user.Email, synced.Email, directory.Email)
+	if apply {
+		update.Email = directory.Email
+	} else if conflict {
+		conflicts = append(conflicts, dto.DirectoryAttributeEmail)
+	}
+
+	apply, conflict = reconcileAttribute(s.rule(dto.DirectoryAttributeIsActive),
+		&user.IsActive, synced.IsActive, directory.IsActive)
+	if apply {
+		update.IsActive = directory.IsActive
+	} else if conflict {
+		conflicts = append(conflicts, dto.DirectoryAttributeIsActive)
+	}
+
+	return update, conflicts
+}
+
+func (s *DirectorySyncServiceImpl) rule(attribute dto.DirectoryAttribute) dto.DirectoryConflictRule {
+	if rule, ok := s.rules[attribute]; ok {
+		return rule
+	}
+
+	return dto.DirectoryWins
+}
+
+// reconcileAttribute decides whether the directory value replaces the local one, and whether the
+// local value is kept as a conflict. Under LocalWins the directory value is applied only while
+// the local value still matches the last synced value, or is empty on the first sync.
+func reconcileAttribute[T comparable](rule dto.DirectoryConflictRule, local, synced, directory *T) (bool, bool) {
+	if directory == nil || equalValues(local, directory) {
+		return false, false
+	}
+
+	if rule == dto.DirectoryWins {
+		return true, false
+	}
+
+	if synced == nil {
+		return local == nil, local != nil
+	}
+
+	if equalValues(local, synced) {
+		return true, false
+	}
+
+	return false, true
+}
+
+func equalValues[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// isProfileConflict reports whether err is a value the user cannot take, rather than a failure.
+func isProfileConflict(err error) bool {
+	return errors.Is(err, ErrDuplicateUsername) || errors.Is(err, ErrDuplicateEmail) ||
+		errors.Is(err, ErrUsernameRetired) || errors.Is(err, ErrEmailRetired)
+}
+
+func mapDirectoryLinkError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrDirectoryLinkNotFound):
+		return ErrDirectoryLinkNotFound
+	case errors.Is(err, repository.ErrExternalIDLinked):
+		return ErrExternalIDLinked
+	case errors.Is(err, repository.ErrUserNotFound):
+		return ErrUserNotFound
+	default:
+		return fmt.Errorf("directory link operation failed: %w", err)
+	}
+}
@@ -0,0 +1,205 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestDirectorySyncService_SyncUser(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	inactive := false
+
+	entry := dto.DirectoryEntry{DN: "uid=target,dc=example,dc=com", DirectoryAttributes: dto.DirectoryAttributes{
+		FullName: strPtr("Target Person"),
+		Email:    strPtr("target@example.com"),
+		IsActive: &inactive,
+	}}
+
+	tests := []struct {
+		name      string
+		rules     map[dto.DirectoryAttribute]dto.DirectoryConflictRule
+		synced    dto.DirectoryAttributes
+		update    *dto.UserProfileUpdateRequest
+		updateErr error
+		status    dto.DirectorySyncStatus
+		conflicts []dto.DirectoryAttribute
+	}{
+		{
+			name:      "directory wins by default",
+			update:    &dto.UserProfileUpdateRequest{FullName: entry.FullName, Email: entry.Email, IsActive: &inactive},
+			status:    dto.DirectorySyncSynced,
+			conflicts: []dto.DirectoryAttribute{},
+		},
+		{
+			name: "local wins keeps values changed since the last sync",
+			rules: map[dto.DirectoryAttribute]dto.DirectoryConflictRule{
+				dto.DirectoryAttributeFullName: dto.LocalWins,
+				dto.DirectoryAttributeEmail:    dto.LocalWins,
+			},
+			// The email still matches the last sync, the full name was edited locally since
+			synced:    dto.DirectoryAttributes{FullName: strPtr("Old Name"), Email: strPtr("email@example.com")},
+			update:    &dto.UserProfileUpdateRequest{Email: entry.Email, IsActive: &inactive},
+			status:    dto.DirectorySyncConflict,
+			conflicts: []dto.DirectoryAttribute{dto.DirectoryAttributeFullName},
+		},
+		{
+			name: "local wins on a first sync keeps local values",
+			rules: map[dto.DirectoryAttribute]dto.DirectoryConflictRule{
+				dto.DirectoryAttributeFullName: dto.LocalWins,
+				dto.DirectoryAttributeEmail:    dto.LocalWins,
+				dto.DirectoryAttributeIsActive: dto.LocalWins,
+			},
+			status: dto.DirectorySyncConflict,
+			conflicts: []dto.DirectoryAttribute{
+				dto.DirectoryAttributeFullName, dto.DirectoryAttributeEmail, dto.DirectoryAttributeIsActive,
+			},
+		},
+		{
+			name:      "email held by another account",
+			update:    &dto.UserProfileUpdateRequest{FullName: entry.FullName, Email: entry.Email, IsActive: &inactive},
+			updateErr: repository.ErrDuplicateEmail,
+			status:    dto.DirectorySyncFailed,
+			conflicts: []dto.DirectoryAttribute{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			links := mocks.NewDirectoryLinkRepository(t)
+			userRepo := mocks.NewUserRepository(t)
+			reader := mocks.NewDirectoryReader(t)
+
+			links.On("GetDirectoryLink", mock.Anything, userID).Return(&dto.DirectoryLink{
+				UserID:     userID.String(),
+				ExternalID: "target",
+				Status:     dto.DirectorySyncSynced,
+				Synced:     tt.synced,
+			}, nil)
+			reader.On("LookupEntries", mock.Anything, []string{"target"}).
+				Return(map[string]dto.DirectoryEntry{"target": entry}, nil)
+			userRepo.On("FindUserByID", mock.Anything, userID).Return(createBaseUser(userID), nil)
+
+			if tt.update != nil {
+				userRepo.On("UpdateUser", mock.Anything, userID, tt.update).Return(nil, tt.updateErr)
+			}
+
+			links.On("SaveDirectorySync", mock.Anything, mock.Anything).Return(nil)
+
+			svc := service.NewDirectorySyncService(links, userRepo, service.DirectorySyncOptions{
+				Reader: reader,
+				Rules:  tt.rules,
+			})
+
+			link, err := svc.SyncUser(t.Context(), userID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, link.Status)
+			assert.Equal(t, tt.conflicts, link.Conflicts)
+			require.NotNil(t, link.LastSyncedAt)
+
+			if tt.updateErr != nil {
+				assert.Equal(t, tt.synced, link.Synced, "failed syncs keep the last synced values")
+				assert.Equal(t, service.ErrDuplicateEmail.Error(), link.LastError)
+			} else {
+				assert.Equal(t, entry.DirectoryAttributes, link.Synced)
+			}
+		})
+	}
+
+	t.Run("entry not found leaves the account alone", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewDirectoryLinkRepository(t)
+		reader := mocks.NewDirectoryReader(t)
+
+		links.On("GetDirectoryLink", mock.Anything, userID).
+			Return(&dto.DirectoryLink{UserID: userID.String(), ExternalID: "gone"}, nil)
+		reader.On("LookupEntries", mock.Anything, []string{"gone"}).Return(map[string]dto.DirectoryEntry{}, nil)
+		links.On("SaveDirectorySync", mock.Anything, mock.MatchedBy(func(link *dto.DirectoryLink) bool {
+			return link.Status == dto.DirectorySyncNotFound
+		})).Return(nil)
+
+		svc := service.NewDirectorySyncService(links, mocks.NewUserRepository(t), service.DirectorySyncOptions{
+			Reader: reader,
+		})
+
+		link, err := svc.SyncUser(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, dto.DirectorySyncNotFound, link.Status)
+	})
+
+	t.Run("no directory configured", func(t *testing.T) {
+		t.Parallel()
+
+		svc := service.NewDirectorySyncService(mocks.NewDirectoryLinkRepository(t), mocks.NewUserRepository(t),
+			service.DirectorySyncOptions{})
+
+		_, err := svc.SyncUser(t.Context(), userID)
+		require.ErrorIs(t, err, service.ErrDirectoryUnavailable)
+	})
+}
+
+func TestDirectorySyncService_SyncAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("directory unreachable saves nothing", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewDirectoryLinkRepository(t)
+		reader := mocks.NewDirectoryReader(t)
+
+		links.On("ListDirectoryLinks", mock.Anything, uuid.Nil, 100).
+			Return([]dto.DirectoryLink{{UserID: uuid.NewString(), ExternalID: "target"}}, nil)
+		reader.On("LookupEntries", mock.Anything, []string{"target"}).Return(nil, errors.New("connection refused"))
+
+		svc := service.NewDirectorySyncService(links, mocks.NewUserRepository(t), service.DirectorySyncOptions{
+			Reader: reader,
+		})
+
+		synced, err := svc.SyncAll(t.Context())
+		require.Error(t, err)
+		assert.Zero(t, synced)
+	})
+
+	t.Run("no directory configured", func(t *testing.T) {
+		t.Parallel()
+
+		synced, err := service.NewDirectorySyncService(mocks.NewDirectoryLinkRepository(t), mocks.NewUserRepository(t),
+			service.DirectorySyncOptions{}).SyncAll(t.Context())
+		require.NoError(t, err)
+		assert.Zero(t, synced)
+	})
+}
+
+func TestDirectorySyncService_LinkUser(t *testing.T) {
+	t.Parallel()
+
+	actorID, userID := uuid.New(), uuid.New()
+
+	links := mocks.NewDirectoryLinkRepository(t)
+	links.On("LinkDirectoryEntry", mock.Anything, actorID, userID, "taken").Return(nil, repository.ErrExternalIDLinked)
+	links.On("UnlinkDirectoryEntry", mock.Anything, actorID, userID).Return(repository.ErrDirectoryLinkNotFound)
+
+	svc := service.NewDirectorySyncService(links, mocks.NewUserRepository(t), service.DirectorySyncOptions{})
+
+	_, err := svc.LinkUser(t.Context(), actorID, userID, "taken")
+	require.ErrorIs(t, err, service.ErrExternalIDLinked)
+	require.ErrorIs(t, svc.UnlinkUser(t.Context(), actorID, userID), service.ErrDirectoryLinkNotFound)
+}
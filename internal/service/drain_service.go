@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// Drain triggers.
+const (
+	DrainTriggerAdmin  = "admin"
+	DrainTriggerSignal = "signal"
+)
+
+// DrainService takes the instance out of rotation before it shuts down: readiness fails for the
+// drain period while in-flight and new requests are still served, so a rollout does not drop
+// the requests routed to it before the load balancer notices.
+type DrainService interface {
+	GetDrainStatus(ctx context.Context) *dto.DrainStatus
+	StartDrain(ctx context.Context, trigger string) *dto.DrainStatus
+	Draining() bool
+	// Drained is closed once the drain period has passed and the server can shut down.
+	Drained() <-chan struct{}
+}
+
+// DrainServiceImpl implements DrainService. A drain cannot be cancelled; the instance shuts
+// down at its end.
+type DrainServiceImpl struct {
+	period  time.Duration
+	drained chan struct{}
+
+	mu     sync.Mutex
+	status *dto.DrainStatus
+}
+
+// NewDrainService creates a new DrainService that drains for period.
+func NewDrainService(period time.Duration) *DrainServiceImpl {
+	return &DrainServiceImpl{
+		period:  period,
+		drained: make(chan struct{}),
+	}
+}
+
+// GetDrainStatus reports whether the instance is draining and when it shuts down.
+func (s *DrainServiceImpl) GetDrainStatus(_ context.Context) *dto.DrainStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.status == nil {
+		return &dto.DrainStatus{}
+	}
+
+	status := *s.status
+
+	return &status
+}
+
+// StartDrain starts draining. Starting again while draining keeps the original deadline.
+func (s *DrainServiceImpl) StartDrain(ctx context.Context, trigger string) *dto.DrainStatus {
+	s.mu.Lock()
+
+	if s.status == nil {
+		startedAt := time.Now()
+		shutdownAt := startedAt.Add(s.period)
+		s.status = &dto.DrainStatus{
+			Draining:   true,
+			Trigger:    trigger,
+			StartedAt:  &startedAt,
+			ShutdownAt: &shutdownAt,
+		}
+
+		time.AfterFunc(s.period, func() { close(s.drained) })
+
+		slog.InfoContext(ctx, "draining before shutdown", "trigger", trigger, "period", s.period)
+	}
+
+	s.mu.Unlock()
+
+	return s.GetDrainStatus(ctx)
+}
+
+// Draining reports whether readiness should fail.
+func (s *DrainServiceImpl) Draining() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.status != nil
+}
+
+// Drained is closed once the drain period has passed.
+func (s *DrainServiceImpl) Drained() <-chan struct{} {
+	return s.drained
+}
@@ -0,0 +1,37 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestDrainService(t *testing.T) {
+	t.Parallel()
+
+	svc := service.NewDrainService(20 * time.Millisecond)
+
+	assert.False(t, svc.Draining())
+	assert.False(t, svc.GetDrainStatus(t.Context()).Draining)
+
+	status := svc.StartDrain(t.Context(), service.DrainTriggerAdmin)
+	assert.True(t, status.Draining)
+	assert.Equal(t, service.DrainTriggerAdmin, status.Trigger)
+	assert.True(t, svc.Draining())
+
+	// Starting again keeps the original drain
+	again := svc.StartDrain(t.Context(), service.DrainTriggerSignal)
+	assert.Equal(t, service.DrainTriggerAdmin, again.Trigger)
+	assert.Equal(t, status.ShutdownAt, again.ShutdownAt)
+
+	select {
+	case <-svc.Drained():
+	case <-time.After(5 * time.Second):
+		t.Fatal("drain period did not end")
+	}
+
+	assert.True(t, svc.Draining(), "readiness keeps failing until shutdown")
+}
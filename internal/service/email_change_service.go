@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/crypto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/notification"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// EmailChangeTokenTTL is the duration within which both sides of an email change must be confirmed.
+const EmailChangeTokenTTL = 24 * time.Hour
+
+// EmailChangeSide identifies which address a confirmation token belongs to.
+type EmailChangeSide string
+
+// Email change confirmation sides.
+const (
+	EmailChangeSideOld EmailChangeSide = "old"
+	EmailChangeSideNew EmailChangeSide = "new"
+)
+
+// ErrEmailUnchanged is returned when the requested email matches the current email.
+var ErrEmailUnchanged = errors.New("new email matches current email")
+
+// EmailChangeService defines business logic for confirming email address changes.
+type EmailChangeService interface {
+	RequestEmailChange(ctx context.Context, userID uuid.UUID, newEmail string) (*dto.EmailChangeRequestResponse, error)
+	ConfirmEmailChange(
+		ctx context.Context,
+		userID uuid.UUID,
+		side EmailChangeSide,
+		token string,
+	) (*dto.EmailChangeStatusResponse, error)
+}
+
+// EmailChangeServiceImpl implements EmailChangeService.
+// An email change is only applied once both the old and the new address have
+// confirmed it. Pending state lives in the cache with a TTL, so if either token
+// expires the whole change is dropped and the account keeps its original email.
+type EmailChangeServiceImpl struct {
+	repo               repository.UserRepository
+	store              repository.EmailChangeStore
+	notificationClient notification.Client
+	moderation         repository.ModerationRepository
+	codeHasher         *crypto.Hasher
+}
+
+// NewEmailChangeService creates a new EmailChangeService. Accounts flagged in moderation cannot
+// change their email this way; a nil moderation moderates no accounts.
+func NewEmailChangeService(
+	repo repository.UserRepository,
+	store repository.EmailChangeStore,
+	notificationClient notification.Client,
+	moderation repository.ModerationRepository,
+) *EmailChangeServiceImpl {
+	return &EmailChangeServiceImpl{
+		repo:               repo,
+		store:              store,
+		notificationClient: notificationClient,
+		moderation:         moderation,
+	}
+}
+
+// SetCodeHasher stores only argon2id hashes of the confirmation tokens. Without it tokens are
+// stored as sent.
+func (s *EmailChangeServiceImpl) SetCodeHasher(hasher *crypto.Hasher) {
+	s.codeHasher = hasher
+}
+
+// RequestEmailChange starts a dual-confirmation email change and sends a token to each address.
+func (s *EmailChangeServiceImpl) RequestEmailChange(
+	ctx context.Context,
+	userID uuid.UUID,
+	newEmail string,
+) (*dto.EmailChangeRequestResponse, error) {
+	// 1. Check if the store is available
+	if s.store == nil {
+		return nil, ErrCacheUnavailable
+	}
+
+	// 2. Email changes on moderated accounts go through the change-request queue
+	err := requireUnmoderated(ctx, s.moderation, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Load the current email
+	user, err := s.repo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	var oldEmail string
+	if user.Email != nil {
+		oldEmail = *user.Email
+	}
+
+	if oldEmail == newEmail {
+		return nil, ErrEmailUnchanged
+	}
+
+	// 4. Store pending change (replaces any change already in flight).
+	// Accounts without an email have nothing to confirm on the old side.
+	expiresAt := time.Now().Add(EmailChangeTokenTTL)
+	oldToken, newToken := uuid.New().String(), uuid.New().String()
+	change := &dto.PendingEmailChange{
+		OldEmail:     oldEmail,
+		NewEmail:     newEmail,
+		OldConfirmed: oldEmail == "",
+		ExpiresAt:    expiresAt,
+	}
+
+	change.OldToken, err = hashCode(s.codeHasher, oldToken)
+	if err != nil {
+		return nil, err
+	}
+
+	change.NewToken, err = hashCode(s.codeHasher, newToken)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.StoreEmailChange(ctx, userID, change, EmailChangeTokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
+
+	logEmailChangeEvent("email_change_requested", userID)
+
+	// 5. Deliver tokens to both addresses (fire-and-forget)
+	if s.notificationClient != nil {
+		if !change.OldConfirmed {
+			go s.notificationClient.NotifyEmailChangeConfirmation( //nolint:contextcheck
+				context.Background(), userID, change.OldEmail, oldToken,
+			)
+		}
+
+		go s.notificationClient.NotifyEmailChangeConfirmation( //nolint:contextcheck
+			context.Background(), userID, change.NewEmail, newToken,
+		)
+	}
+
+	return &dto.EmailChangeRequestResponse{
+		UserID:    userID.String(),
+		NewEmail:  newEmail,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// ConfirmEmailChange confirms one side of a pending email change and applies it once both sides agree.
+func (s *EmailChangeServiceImpl) ConfirmEmailChange(
+	ctx context.Context,
+	userID uuid.UUID,
+	side EmailChangeSide,
+	token string,
+) (*dto.EmailChangeStatusResponse, error) {
+	// 1. Check if the store is available
+	if s.store == nil {
+		return nil, ErrCacheUnavailable
+	}
+
+	// 2. Load the pending change; an expired change has already been rolled back
+	change, err := s.store.GetEmailChange(ctx, userID)
+	if err != nil {
+		if errors.Is(err, redis.ErrTokenNotFound) {
+			logEmailChangeEvent("email_change_confirmation_rejected", userID, "side", side, "reason", "not_pending")
+
+			return nil, ErrInvalidToken
+		}
+
+		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
+
+	// 3. Validate the token for the requested side
+	if !confirmEmailChangeSide(change, side, token) {
+		logEmailChangeEvent("email_change_confirmation_rejected", userID, "side", side, "reason", "token_mismatch")
+
+		return nil, ErrInvalidToken
+	}
+
+	logEmailChangeEvent("email_change_confirmed", userID, "side", side)
+
+	// 4. Wait for the other side, keeping the original expiry
+	if !change.OldConfirmed || !change.NewConfirmed {
+		return s.savePartialConfirmation(ctx, userID, change)
+	}
+
+	// 5. Both sides confirmed - apply the change
+	return s.applyEmailChange(ctx, userID, change)
+}
+
+func (s *EmailChangeServiceImpl) savePartialConfirmation(
+	ctx context.Context,
+	userID uuid.UUID,
+	change *dto.PendingEmailChange,
+) (*dto.EmailChangeStatusResponse, error) {
+	remaining := time.Until(change.ExpiresAt)
+	if remaining <= 0 {
+		_ = s.store.DeleteEmailChange(ctx, userID)
+
+		logEmailChangeEvent("email_change_expired", userID)
+
+		return nil, ErrInvalidToken
+	}
+
+	err := s.store.StoreEmailChange(ctx, userID, change, remaining)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
+
+	return buildEmailChangeStatus(userID, change, nil), nil
+}
+
+func (s *EmailChangeServiceImpl) applyEmailChange(
+	ctx context.Context,
+	userID uuid.UUID,
+	change *dto.PendingEmailChange,
+) (*dto.EmailChangeStatusResponse, error) {
+	// The account may have been flagged since the change was requested
+	err := requireUnmoderated(ctx, s.moderation, userID)
+	if err != nil {
+		_ = s.store.DeleteEmailChange(ctx, userID)
+
+		return nil, err
+	}
+
+	updatedUser, err := s.repo.UpdateUser(ctx, userID, &dto.UserProfileUpdateRequest{
+		Email: &change.NewEmail,
+	})
+	if err != nil {
+		// A change that can no longer be applied is abandoned rather than retried
+		_ = s.store.DeleteEmailChange(ctx, userID)
+
+		logEmailChangeEvent("email_change_failed", userID, "error", err)
+
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, mapUpdateProfileError(err)
+	}
+
+	// Best-effort cleanup; the change is already applied
+	_ = s.store.DeleteEmailChange(ctx, userID)
+
+	logEmailChangeEvent("email_change_completed", userID)
+
+	if s.notificationClient != nil && change.OldEmail != "" {
+		go s.notificationClient.NotifyEmailChanged( //nolint:contextcheck
+			context.Background(),
+			userID,
+			change.OldEmail,
+			change.NewEmail,
+		)
+	}
+
+	return buildEmailChangeStatus(userID, change, updatedUser.Email), nil
+}
+
+// confirmEmailChangeSide marks the given side as confirmed if the token matches.
+func confirmEmailChangeSide(change *dto.PendingEmailChange, side EmailChangeSide, token string) bool {
+	var expected string
+
+	switch side {
+	case EmailChangeSideOld:
+		expected = change.OldToken
+	case EmailChangeSideNew:
+		expected = change.NewToken
+	default:
+		return false
+	}
+
+	if !matchesCode(expected, token) {
+		return false
+	}
+
+	if side == EmailChangeSideOld {
+		change.OldConfirmed = true
+	} else {
+		change.NewConfirmed = true
+	}
+
+	return true
+}
+
+func buildEmailChangeStatus(
+	userID uuid.UUID,
+	change *dto.PendingEmailChange,
+	email *string,
+) *dto.EmailChangeStatusResponse {
+	return &dto.EmailChangeStatusResponse{
+		UserID:            userID.String(),
+		OldEmailConfirmed: change.OldConfirmed,
+		NewEmailConfirmed: change.NewConfirmed,
+		Completed:         email != nil,
+		Email:             email,
+		ExpiresAt:         change.ExpiresAt,
+	}
+}
+
+// logEmailChangeEvent records a security event for the email change sequence.
+// Addresses and tokens are deliberately left out of the log.
+func logEmailChangeEvent(event string, userID uuid.UUID, attrs ...any) {
+	args := append([]any{"event", event, "user_id", userID}, attrs...)
+	slog.Info("security event", args...)
+}
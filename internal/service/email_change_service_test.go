@@ -0,0 +1,262 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/crypto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+const (
+	testOldEmail = "old@example.com"
+	testNewEmail = "new@example.com"
+	testOldToken = "old-token"
+	testNewToken = "new-token"
+)
+
+func newPendingEmailChange(oldConfirmed, newConfirmed bool) *dto.PendingEmailChange {
+	return &dto.PendingEmailChange{
+		OldEmail:     testOldEmail,
+		NewEmail:     testNewEmail,
+		OldToken:     testOldToken,
+		NewToken:     testNewToken,
+		OldConfirmed: oldConfirmed,
+		NewConfirmed: newConfirmed,
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+}
+
+func TestEmailChangeServiceRequestEmailChange(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	oldEmail := testOldEmail
+	user := &dto.User{UserID: userID.String(), Username: "user", Email: &oldEmail, IsActive: true}
+
+	tests := []struct {
+		name        string
+		newEmail    string
+		setupMock   func(*mocks.UserRepository, *mocks.EmailChangeStore)
+		expectedErr error
+	}{
+		{
+			name:     "Success",
+			newEmail: testNewEmail,
+			setupMock: func(r *mocks.UserRepository, s *mocks.EmailChangeStore) {
+				r.On("FindUserByID", mock.Anything, userID).Return(user, nil)
+				s.On("StoreEmailChange", mock.Anything, userID, mock.MatchedBy(func(c *dto.PendingEmailChange) bool {
+					return c.OldEmail == testOldEmail && c.NewEmail == testNewEmail &&
+						c.OldToken != "" && c.NewToken != "" && c.OldToken != c.NewToken &&
+						!c.OldConfirmed && !c.NewConfirmed
+				}), service.EmailChangeTokenTTL).Return(nil)
+			},
+		},
+		{
+			name:     "Unchanged Email",
+			newEmail: testOldEmail,
+			setupMock: func(r *mocks.UserRepository, _ *mocks.EmailChangeStore) {
+				r.On("FindUserByID", mock.Anything, userID).Return(user, nil)
+			},
+			expectedErr: service.ErrEmailUnchanged,
+		},
+		{
+			name:     "User Not Found",
+			newEmail: testNewEmail,
+			setupMock: func(r *mocks.UserRepository, _ *mocks.EmailChangeStore) {
+				r.On("FindUserByID", mock.Anything, userID).Return(nil, repository.ErrUserNotFound)
+			},
+			expectedErr: service.ErrUserNotFound,
+		},
+		{
+			name:     "Store Error",
+			newEmail: testNewEmail,
+			setupMock: func(r *mocks.UserRepository, s *mocks.EmailChangeStore) {
+				r.On("FindUserByID", mock.Anything, userID).Return(user, nil)
+				s.On("StoreEmailChange", mock.Anything, userID, mock.Anything, mock.Anything).Return(errRedis)
+			},
+			expectedErr: service.ErrCacheUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := new(mocks.UserRepository)
+			mockStore := new(mocks.EmailChangeStore)
+			tt.setupMock(mockRepo, mockStore)
+
+			svc := service.NewEmailChangeService(mockRepo, mockStore, nil, nil)
+
+			resp, err := svc.RequestEmailChange(context.Background(), userID, tt.newEmail)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testNewEmail, resp.NewEmail)
+				assert.WithinDuration(t, time.Now().Add(service.EmailChangeTokenTTL), resp.ExpiresAt, time.Minute)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEmailChangeServiceConfirmEmailChange(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	newEmail := testNewEmail
+	updatedUser := &dto.User{UserID: userID.String(), Username: "user", Email: &newEmail, IsActive: true}
+
+	tests := []struct {
+		name              string
+		side              service.EmailChangeSide
+		token             string
+		setupMock         func(*mocks.UserRepository, *mocks.EmailChangeStore)
+		expectedErr       error
+		expectedCompleted bool
+	}{
+		{
+			name:  "First Side Confirmed",
+			side:  service.EmailChangeSideOld,
+			token: testOldToken,
+			setupMock: func(_ *mocks.UserRepository, s *mocks.EmailChangeStore) {
+				s.On("GetEmailChange", mock.Anything, userID).Return(newPendingEmailChange(false, false), nil)
+				s.On("StoreEmailChange", mock.Anything, userID, mock.MatchedBy(func(c *dto.PendingEmailChange) bool {
+					return c.OldConfirmed && !c.NewConfirmed
+				}), mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:  "Both Sides Confirmed",
+			side:  service.EmailChangeSideNew,
+			token: testNewToken,
+			setupMock: func(r *mocks.UserRepository, s *mocks.EmailChangeStore) {
+				s.On("GetEmailChange", mock.Anything, userID).Return(newPendingEmailChange(true, false), nil)
+				r.On("UpdateUser", mock.Anything, userID, mock.MatchedBy(func(u *dto.UserProfileUpdateRequest) bool {
+					return u.Email != nil && *u.Email == testNewEmail
+				})).Return(updatedUser, nil)
+				s.On("DeleteEmailChange", mock.Anything, userID).Return(nil)
+			},
+			expectedCompleted: true,
+		},
+		{
+			name:  "Token For Other Side",
+			side:  service.EmailChangeSideNew,
+			token: testOldToken,
+			setupMock: func(_ *mocks.UserRepository, s *mocks.EmailChangeStore) {
+				s.On("GetEmailChange", mock.Anything, userID).Return(newPendingEmailChange(false, false), nil)
+			},
+			expectedErr: service.ErrInvalidToken,
+		},
+		{
+			name:  "Expired Change",
+			side:  service.EmailChangeSideOld,
+			token: testOldToken,
+			setupMock: func(_ *mocks.UserRepository, s *mocks.EmailChangeStore) {
+				s.On("GetEmailChange", mock.Anything, userID).Return(nil, redis.ErrTokenNotFound)
+			},
+			expectedErr: service.ErrInvalidToken,
+		},
+		{
+			name:  "Email Taken Before Completion",
+			side:  service.EmailChangeSideNew,
+			token: testNewToken,
+			setupMock: func(r *mocks.UserRepository, s *mocks.EmailChangeStore) {
+				s.On("GetEmailChange", mock.Anything, userID).Return(newPendingEmailChange(true, false), nil)
+				r.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(nil, repository.ErrDuplicateEmail)
+				s.On("DeleteEmailChange", mock.Anything, userID).Return(nil)
+			},
+			expectedErr: service.ErrDuplicateEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := new(mocks.UserRepository)
+			mockStore := new(mocks.EmailChangeStore)
+			tt.setupMock(mockRepo, mockStore)
+
+			svc := service.NewEmailChangeService(mockRepo, mockStore, nil, nil)
+
+			resp, err := svc.ConfirmEmailChange(context.Background(), userID, tt.side, tt.token)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedCompleted, resp.Completed)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEmailChangeServiceHashedTokens(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	oldEmail := testOldEmail
+	hasher := crypto.NewHasher(crypto.Argon2Params{Time: 1, MemoryKiB: 64, Threads: 1})
+
+	t.Run("Request Stores Hashes", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(mocks.UserRepository)
+		mockStore := new(mocks.EmailChangeStore)
+		mockRepo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String(), Email: &oldEmail}, nil)
+		mockStore.On("StoreEmailChange", mock.Anything, userID, mock.MatchedBy(func(c *dto.PendingEmailChange) bool {
+			return crypto.IsHash(c.OldToken) && crypto.IsHash(c.NewToken)
+		}), service.EmailChangeTokenTTL).Return(nil)
+
+		svc := service.NewEmailChangeService(mockRepo, mockStore, nil, nil)
+		svc.SetCodeHasher(hasher)
+
+		_, err := svc.RequestEmailChange(t.Context(), userID, testNewEmail)
+		require.NoError(t, err)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("Confirm Verifies Against Hashes", func(t *testing.T) {
+		t.Parallel()
+
+		change := newPendingEmailChange(false, false)
+
+		var err error
+
+		change.OldToken, err = hasher.Hash(testOldToken)
+		require.NoError(t, err)
+
+		mockStore := new(mocks.EmailChangeStore)
+		mockStore.On("GetEmailChange", mock.Anything, userID).Return(change, nil)
+		mockStore.On("StoreEmailChange", mock.Anything, userID, mock.Anything, mock.Anything).Return(nil)
+
+		svc := service.NewEmailChangeService(new(mocks.UserRepository), mockStore, nil, nil)
+		svc.SetCodeHasher(hasher)
+
+		_, err = svc.ConfirmEmailChange(t.Context(), userID, service.EmailChangeSideOld, change.OldToken)
+		require.ErrorIs(t, err, service.ErrInvalidToken, "the stored hash is not a valid token")
+
+		resp, err := svc.ConfirmEmailChange(t.Context(), userID, service.EmailChangeSideOld, testOldToken)
+		require.NoError(t, err)
+		assert.False(t, resp.Completed)
+	})
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// maxEmbedDescription is the length link previews show of a description; longer bios are cut.
+const maxEmbedDescription = 200
+
+// Placeholders replaced in the embed URL templates.
+const (
+	embedUserIDPlaceholder   = "{userId}"
+	embedUsernamePlaceholder = "{username}"
+)
+
+// EmbedService builds the link preview metadata of profiles, for anonymous crawlers and the web
+// frontend.
+type EmbedService interface {
+	// GetProfileEmbed returns the Open Graph metadata of a public profile. Missing, inactive and
+	// non-public profiles all return ErrUserNotFound, so previews do not reveal who exists.
+	GetProfileEmbed(ctx context.Context, userID uuid.UUID) (*dto.ProfileEmbedResponse, error)
+}
+
+// EmbedOptions configures profile embeds.
+type EmbedOptions struct {
+	// ProfileURL and AvatarURL are templates for the profile page and avatar image links, with
+	// {userId} and {username} placeholders. Empty leaves the link out.
+	ProfileURL string
+	AvatarURL  string
+	// CacheTTL is how long an embed is reused. Zero disables caching.
+	CacheTTL time.Duration
+}
+
+// EmbedServiceImpl implements EmbedService. Embeds are cached per user for the configured TTL, so
+// profile edits and privacy changes show up once it expires.
+type EmbedServiceImpl struct {
+	userRepo   repository.UserRepository
+	socialRepo repository.SocialRepository
+	opts       EmbedOptions
+	cache      *ttlCache[uuid.UUID, *dto.ProfileEmbedResponse]
+	profiles   PublicProfileService
+}
+
+// NewEmbedService creates a new EmbedService.
+func NewEmbedService(
+	userRepo repository.UserRepository,
+	socialRepo repository.SocialRepository,
+	opts EmbedOptions,
+) *EmbedServiceImpl {
+	return &EmbedServiceImpl{
+		userRepo:   userRepo,
+		socialRepo: socialRepo,
+		opts:       opts,
+		cache:      newTTLCache[uuid.UUID, *dto.ProfileEmbedResponse](opts.CacheTTL),
+	}
+}
+
+// SetPublicProfiles builds embeds from the public profile projection. Without it each embed reads
+// the user, their privacy preferences and follower count.
+func (s *EmbedServiceImpl) SetPublicProfiles(profiles PublicProfileService) {
+	s.profiles = profiles
+}
+
+// GetProfileEmbed returns the Open Graph metadata of a public profile, as an anonymous viewer
+// sees it.
+func (s *EmbedServiceImpl) GetProfileEmbed(ctx context.Context, userID uuid.UUID) (*dto.ProfileEmbedResponse, error) {
+	if cached, ok := s.cache.get(userID); ok {
+		return cached, nil
+	}
+
+	if s.profiles != nil {
+		return s.projectedEmbed(ctx, userID)
+	}
+
+	// 1. Only active, public profiles can be embedded
+	user, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	if !user.IsActive {
+		return nil, ErrUserNotFound
+	}
+
+	privacy, err := s.userRepo.FindPrivacyPreferencesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	if privacy.ProfileVisibility != dto.ProfileVisibilityPublic {
+		return nil, ErrUserNotFound
+	}
+
+	// 2. Count followers, which public profiles show to anyone
+	_, followers, err := s.socialRepo.GetFollowers(ctx, userID, 1, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count followers: %w", err)
+	}
+
+	// 3. Build the metadata
+	var fullName *string
+	if privacy.ShowFullName {
+		fullName = user.FullName
+	}
+
+	embed := s.newEmbed(user.UserID, user.Username, fullName, user.Bio, followers)
+	s.cache.put(userID, embed)
+
+	return embed, nil
+}
+
+// projectedEmbed builds the embed of a public profile from the public profile projection.
+func (s *EmbedServiceImpl) projectedEmbed(ctx context.Context, userID uuid.UUID) (*dto.ProfileEmbedResponse, error) {
+	projected, err := s.profiles.GetPublicProfile(ctx, userID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // preserve service errors for handler mapping
+	}
+
+	if !projected.IsActive || !projected.Public {
+		return nil, ErrUserNotFound
+	}
+
+	profile := projected.Profile
+	embed := s.newEmbed(profile.UserID, profile.Username, profile.FullName, profile.Bio, projected.FollowerCount)
+	s.cache.put(userID, embed)
+
+	return embed, nil
+}
+
+// newEmbed builds the metadata of a profile from what anonymous viewers may see of it: fullName
+// is nil when it is hidden.
+func (s *EmbedServiceImpl) newEmbed(
+	userID, username string,
+	fullName, bio *string,
+	followers int,
+) *dto.ProfileEmbedResponse {
+	title := "@" + username
+	if fullName != nil && *fullName != "" {
+		title = *fullName + " (@" + username + ")"
+	}
+
+	embed := &dto.ProfileEmbedResponse{
+		UserID:        userID,
+		Username:      username,
+		Title:         title,
+		URL:           s.expand(s.opts.ProfileURL, userID, username),
+		AvatarURL:     s.expand(s.opts.AvatarURL, userID, username),
+		FollowerCount: followers,
+	}
+
+	if bio != nil {
+		embed.Description = truncateDescription(strings.TrimSpace(*bio))
+	}
+
+	return embed
+}
+
+// expand fills the placeholders of an embed URL template.
+func (s *EmbedServiceImpl) expand(template, userID, username string) string {
+	if template == "" {
+		return ""
+	}
+
+	return strings.NewReplacer(
+		embedUserIDPlaceholder, userID,
+		embedUsernamePlaceholder, url.PathEscape(username),
+	).Replace(template)
+}
+
+// truncateDescription cuts description to maxEmbedDescription characters at a word boundary,
+// marking the cut with an ellipsis.
+func truncateDescription(description string) string {
+	if utf8.RuneCountInString(description) <= maxEmbedDescription {
+		return description
+	}
+
+	cut := string([]rune(description)[:maxEmbedDescription-1])
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+
+	return strings.TrimRight(cut, " ,.;:") + "…"
+}
@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestEmbedService_GetProfileEmbed(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	public := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic, ShowFullName: true}
+
+	t.Run("public profile", func(t *testing.T) {
+		t.Parallel()
+
+		userRepo := mocks.NewUserRepository(t)
+		socialRepo := mocks.NewSocialRepository(t)
+
+		// Embeds are cached, so the repositories are read once
+		userRepo.On("FindUserByID", mock.Anything, userID).Return(createBaseUser(userID), nil).Once()
+		userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).Return(public, nil).Once()
+		socialRepo.On("GetFollowers", mock.Anything, userID, 1, 0).Return([]dto.User{{}}, 42, nil).Once()
+
+		svc := service.NewEmbedService(userRepo, socialRepo, service.EmbedOptions{
+			ProfileURL: "https://recipes.example.com/u/{username}",
+			AvatarURL:  "https://media.example.com/avatars/{userId}.png",
+			CacheTTL:   time.Minute,
+		})
+
+		for range 2 {
+			embed, err := svc.GetProfileEmbed(t.Context(), userID)
+			require.NoError(t, err)
+			assert.Equal(t, &dto.ProfileEmbedResponse{
+				UserID:        userID.String(),
+				Username:      "targetuser",
+				Title:         "Target User (@targetuser)",
+				Description:   "Bio",
+				URL:           "https://recipes.example.com/u/targetuser",
+				AvatarURL:     "https://media.example.com/avatars/" + userID.String() + ".png",
+				FollowerCount: 42,
+			}, embed)
+		}
+	})
+
+	t.Run("hidden full name and long bio", func(t *testing.T) {
+		t.Parallel()
+
+		user := createBaseUser(userID)
+		bio := strings.Repeat("Slow cooked stews and crusty bread. ", 10)
+		user.Bio = &bio
+
+		userRepo := mocks.NewUserRepository(t)
+		socialRepo := mocks.NewSocialRepository(t)
+
+		userRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
+		userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+			Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}, nil)
+		socialRepo.On("GetFollowers", mock.Anything, userID, 1, 0).Return(nil, 0, nil)
+
+		embed, err := service.NewEmbedService(userRepo, socialRepo, service.EmbedOptions{}).
+			GetProfileEmbed(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, "@targetuser", embed.Title)
+		assert.Empty(t, embed.URL)
+		assert.Empty(t, embed.AvatarURL)
+		assert.LessOrEqual(t, utf8.RuneCountInString(embed.Description), 200)
+
+		// Cut at a word boundary, never mid-word
+		cut, ok := strings.CutSuffix(embed.Description, "…")
+		require.True(t, ok, embed.Description)
+		assert.True(t, strings.HasPrefix(bio, cut+" "), embed.Description)
+	})
+
+	for _, visibility := range []dto.ProfileVisibility{dto.ProfileVisibilityFriendsOnly, dto.ProfileVisibilityPrivate} {
+		t.Run(string(visibility)+" profile looks missing", func(t *testing.T) {
+			t.Parallel()
+
+			userRepo := mocks.NewUserRepository(t)
+			userRepo.On("FindUserByID", mock.Anything, userID).Return(createBaseUser(userID), nil)
+			userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+				Return(&dto.UserPrivacyPreferences{ProfileVisibility: visibility}, nil)
+
+			_, err := service.NewEmbedService(userRepo, mocks.NewSocialRepository(t), service.EmbedOptions{}).
+				GetProfileEmbed(t.Context(), userID)
+			require.ErrorIs(t, err, service.ErrUserNotFound)
+		})
+	}
+
+	t.Run("inactive user", func(t *testing.T) {
+		t.Parallel()
+
+		user := createBaseUser(userID)
+		user.IsActive = false
+
+		userRepo := mocks.NewUserRepository(t)
+		userRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
+
+		_, err := service.NewEmbedService(userRepo, mocks.NewSocialRepository(t), service.EmbedOptions{}).
+			GetProfileEmbed(t.Context(), userID)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("public profile projection", func(t *testing.T) {
+		t.Parallel()
+
+		fullName := "Target User"
+		bio := "Bio"
+
+		profiles := mocks.NewPublicProfileService(t)
+		profiles.On("GetPublicProfile", mock.Anything, userID).Return(&dto.PublicProfile{
+			UserID:   userID.String(),
+			IsActive: true,
+			Public:   true,
+			Profile: &dto.UserProfileResponse{
+				UserID:   userID.String(),
+				Username: "targetuser",
+				FullName: &fullName,
+				Bio:      &bio,
+			},
+			FollowerCount: 42,
+		}, nil)
+
+		// The repositories are not read
+		svc := service.NewEmbedService(mocks.NewUserRepository(t), mocks.NewSocialRepository(t), service.EmbedOptions{})
+		svc.SetPublicProfiles(profiles)
+
+		embed, err := svc.GetProfileEmbed(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, "Target User (@targetuser)", embed.Title)
+		assert.Equal(t, "Bio", embed.Description)
+		assert.Equal(t, 42, embed.FollowerCount)
+	})
+
+	t.Run("non-public projected profile looks missing", func(t *testing.T) {
+		t.Parallel()
+
+		profiles := mocks.NewPublicProfileService(t)
+		profiles.On("GetPublicProfile", mock.Anything, userID).
+			Return(&dto.PublicProfile{UserID: userID.String(), IsActive: true}, nil)
+
+		svc := service.NewEmbedService(mocks.NewUserRepository(t), mocks.NewSocialRepository(t), service.EmbedOptions{})
+		svc.SetPublicProfiles(profiles)
+
+		_, err := svc.GetProfileEmbed(t.Context(), userID)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
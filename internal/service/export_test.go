@@ -0,0 +1,13 @@
+package service
+
+import "time"
+
+// SetActivitySectionTimeout overrides the per-section activity timeout in tests.
+func (s *SocialServiceImpl) SetActivitySectionTimeout(d time.Duration) {
+	s.activitySectionTimeout = d
+}
+
+// SetProfileReadTimeout overrides the timeout of shared profile lookups in tests.
+func (s *UserServiceImpl) SetProfileReadTimeout(d time.Duration) {
+	s.reads.timeout = d
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+// HandleReservationTTL is how long a handle is held for a user while they finish selecting it.
+const HandleReservationTTL = 10 * time.Minute
+
+// Handle length limits.
+const (
+	minHandleLength = 3
+	maxHandleLength = 30
+)
+
+// ErrHandleInvalid is returned when a handle does not satisfy the handle rules.
+var ErrHandleInvalid = errors.New("invalid handle")
+
+// ErrHandleNotAllowed is returned when a handle is on the blocked list.
+var ErrHandleNotAllowed = errors.New("handle is not allowed")
+
+// ErrHandleTaken is returned when a handle is already owned by another user.
+var ErrHandleTaken = errors.New("handle already taken")
+
+// ErrHandleHeld is returned when another user currently holds a reservation on a handle.
+var ErrHandleHeld = errors.New("handle is reserved by another user")
+
+// ErrHandleNotReserved is returned when claiming a handle without holding its reservation.
+var ErrHandleNotReserved = errors.New("handle is not reserved by this user")
+
+// blockedHandles are handles that would collide with routes or impersonate staff.
+var blockedHandles = map[string]struct{}{
+	"admin":     {},
+	"api":       {},
+	"help":      {},
+	"login":     {},
+	"logout":    {},
+	"me":        {},
+	"moderator": {},
+	"root":      {},
+	"settings":  {},
+	"support":   {},
+	"system":    {},
+}
+
+// HandleService defines business logic for vanity profile handles.
+type HandleService interface {
+	ReserveHandle(ctx context.Context, userID uuid.UUID, handle string) (*dto.HandleReservationResponse, error)
+	ClaimHandle(ctx context.Context, userID uuid.UUID, handle string) (*dto.HandleResponse, error)
+	ResolveHandle(ctx context.Context, handle string) (*dto.HandleResolutionResponse, error)
+}
+
+// HandleServiceImpl implements HandleService.
+type HandleServiceImpl struct {
+	repo         repository.HandleRepository
+	reservations repository.HandleReservationStore
+	users        UserService
+}
+
+// NewHandleService creates a new HandleService.
+func NewHandleService(
+	repo repository.HandleRepository,
+	reservations repository.HandleReservationStore,
+	users UserService,
+) *HandleServiceImpl {
+	return &HandleServiceImpl{
+		repo:         repo,
+		reservations: reservations,
+		users:        users,
+	}
+}
+
+// ReserveHandle places a temporary hold on a handle so the user can finish selecting it.
+func (s *HandleServiceImpl) ReserveHandle(
+	ctx context.Context,
+	userID uuid.UUID,
+	handle string,
+) (*dto.HandleReservationResponse, error) {
+	// 1. Validate and normalize
+	handle, err := normalizeHandle(handle)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.reservations == nil {
+		return nil, ErrCacheUnavailable
+	}
+
+	// 2. Check the handle is not already owned by someone else
+	err = s.ensureHandleAvailable(ctx, userID, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Place the hold
+	ok, err := s.reservations.ReserveHandle(ctx, handle, userID, HandleReservationTTL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
+
+	if !ok {
+		return nil, ErrHandleHeld
+	}
+
+	return &dto.HandleReservationResponse{
+		Handle:    handle,
+		ExpiresAt: time.Now().Add(HandleReservationTTL),
+	}, nil
+}
+
+// ClaimHandle permanently assigns a reserved handle to the user.
+func (s *HandleServiceImpl) ClaimHandle(
+	ctx context.Context,
+	userID uuid.UUID,
+	handle string,
+) (*dto.HandleResponse, error) {
+	// 1. Validate and normalize
+	handle, err := normalizeHandle(handle)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.reservations == nil {
+		return nil, ErrCacheUnavailable
+	}
+
+	// 2. Require an active reservation held by this user
+	holder, err := s.reservations.GetHandleReservation(ctx, handle)
+	if err != nil {
+		if errors.Is(err, redis.ErrTokenNotFound) {
+			return nil, ErrHandleNotReserved
+		}
+
+		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
+
+	if holder != userID {
+		return nil, ErrHandleNotReserved
+	}
+
+	// 3. Persist the handle
+	claimedAt, err := s.repo.ClaimHandle(ctx, userID, handle)
+	if err != nil {
+		if errors.Is(err, repository.ErrHandleTaken) {
+			return nil, ErrHandleTaken
+		}
+
+		return nil, fmt.Errorf("failed to claim handle: %w", err)
+	}
+
+	// 4. Drop the hold (best-effort; it expires on its own)
+	_ = s.reservations.ReleaseHandleReservation(ctx, handle, userID)
+
+	return &dto.HandleResponse{
+		UserID:    userID.String(),
+		Handle:    handle,
+		ClaimedAt: claimedAt,
+	}, nil
+}
+
+// ResolveHandle returns the public summary of the user owning a handle.
+// Unknown handles and non-public owners both return ErrUserNotFound.
+func (s *HandleServiceImpl) ResolveHandle(ctx context.Context, handle string) (*dto.HandleResolutionResponse, error) {
+	handle, err := normalizeHandle(handle)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	userID, err := s.repo.FindUserIDByHandle(ctx, handle)
+	if err != nil {
+		if errors.Is(err, repository.ErrHandleNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to resolve handle: %w", err)
+	}
+
+	user, err := s.users.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // preserve service errors for handler mapping
+	}
+
+	return &dto.HandleResolutionResponse{
+		Handle: handle,
+		User:   *user,
+	}, nil
+}
+
+func (s *HandleServiceImpl) ensureHandleAvailable(ctx context.Context, userID uuid.UUID, handle string) error {
+	ownerID, err := s.repo.FindUserIDByHandle(ctx, handle)
+	if err != nil {
+		if errors.Is(err, repository.ErrHandleNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to check handle availability: %w", err)
+	}
+
+	if ownerID != userID {
+		return ErrHandleTaken
+	}
+
+	return nil
+}
+
+// normalizeHandle lowercases a handle and checks it against the handle rules.
+func normalizeHandle(handle string) (string, error) {
+	handle = strings.ToLower(strings.TrimSpace(handle))
+
+	if len(handle) < minHandleLength || len(handle) > maxHandleLength || !validation.IsValidHandle(handle) {
+		return "", ErrHandleInvalid
+	}
+
+	if _, blocked := blockedHandles[handle]; blocked {
+		return "", ErrHandleNotAllowed
+	}
+
+	return handle, nil
+}
@@ -0,0 +1,216 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+const testHandle = "chef-jane"
+
+func TestHandleServiceReserveHandle(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	otherID := uuid.New()
+
+	tests := []struct {
+		name        string
+		handle      string
+		setupMock   func(*mocks.HandleRepository, *mocks.HandleReservationStore)
+		expectedErr error
+	}{
+		{
+			name:   "Success - Normalized",
+			handle: "  Chef-Jane ",
+			setupMock: func(r *mocks.HandleRepository, s *mocks.HandleReservationStore) {
+				r.On("FindUserIDByHandle", mock.Anything, testHandle).Return(uuid.Nil, repository.ErrHandleNotFound)
+				s.On("ReserveHandle", mock.Anything, testHandle, userID, service.HandleReservationTTL).Return(true, nil)
+			},
+		},
+		{
+			name:        "Invalid Handle",
+			handle:      "chef--jane",
+			setupMock:   func(_ *mocks.HandleRepository, _ *mocks.HandleReservationStore) {},
+			expectedErr: service.ErrHandleInvalid,
+		},
+		{
+			name:        "Blocked Handle",
+			handle:      "admin",
+			setupMock:   func(_ *mocks.HandleRepository, _ *mocks.HandleReservationStore) {},
+			expectedErr: service.ErrHandleNotAllowed,
+		},
+		{
+			name:   "Owned By Another User",
+			handle: testHandle,
+			setupMock: func(r *mocks.HandleRepository, _ *mocks.HandleReservationStore) {
+				r.On("FindUserIDByHandle", mock.Anything, testHandle).Return(otherID, nil)
+			},
+			expectedErr: service.ErrHandleTaken,
+		},
+		{
+			name:   "Held By Another User",
+			handle: testHandle,
+			setupMock: func(r *mocks.HandleRepository, s *mocks.HandleReservationStore) {
+				r.On("FindUserIDByHandle", mock.Anything, testHandle).Return(uuid.Nil, repository.ErrHandleNotFound)
+				s.On("ReserveHandle", mock.Anything, testHandle, userID, mock.Anything).Return(false, nil)
+			},
+			expectedErr: service.ErrHandleHeld,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := new(mocks.HandleRepository)
+			mockStore := new(mocks.HandleReservationStore)
+			tt.setupMock(mockRepo, mockStore)
+
+			svc := service.NewHandleService(mockRepo, mockStore, nil)
+
+			resp, err := svc.ReserveHandle(context.Background(), userID, tt.handle)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testHandle, resp.Handle)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleServiceClaimHandle(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		setupMock   func(*mocks.HandleRepository, *mocks.HandleReservationStore)
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			setupMock: func(r *mocks.HandleRepository, s *mocks.HandleReservationStore) {
+				s.On("GetHandleReservation", mock.Anything, testHandle).Return(userID, nil)
+				r.On("ClaimHandle", mock.Anything, userID, testHandle).Return(now, nil)
+				s.On("ReleaseHandleReservation", mock.Anything, testHandle, userID).Return(nil)
+			},
+		},
+		{
+			name: "Reservation Expired",
+			setupMock: func(_ *mocks.HandleRepository, s *mocks.HandleReservationStore) {
+				s.On("GetHandleReservation", mock.Anything, testHandle).Return(uuid.Nil, redis.ErrTokenNotFound)
+			},
+			expectedErr: service.ErrHandleNotReserved,
+		},
+		{
+			name: "Reserved By Someone Else",
+			setupMock: func(_ *mocks.HandleRepository, s *mocks.HandleReservationStore) {
+				s.On("GetHandleReservation", mock.Anything, testHandle).Return(uuid.New(), nil)
+			},
+			expectedErr: service.ErrHandleNotReserved,
+		},
+		{
+			name: "Unique Violation",
+			setupMock: func(r *mocks.HandleRepository, s *mocks.HandleReservationStore) {
+				s.On("GetHandleReservation", mock.Anything, testHandle).Return(userID, nil)
+				r.On("ClaimHandle", mock.Anything, userID, testHandle).Return(time.Time{}, repository.ErrHandleTaken)
+			},
+			expectedErr: service.ErrHandleTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := new(mocks.HandleRepository)
+			mockStore := new(mocks.HandleReservationStore)
+			tt.setupMock(mockRepo, mockStore)
+
+			svc := service.NewHandleService(mockRepo, mockStore, nil)
+
+			resp, err := svc.ClaimHandle(context.Background(), userID, testHandle)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testHandle, resp.Handle)
+				assert.Equal(t, now, resp.ClaimedAt)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleServiceResolveHandle(t *testing.T) {
+	t.Parallel()
+
+	ownerID := uuid.New()
+	owner := &dto.User{
+		UserID:   ownerID.String(),
+		Username: "janedoe",
+		IsActive: true,
+	}
+
+	t.Run("Public Owner", func(t *testing.T) {
+		t.Parallel()
+
+		mockHandles := new(mocks.HandleRepository)
+		mockUsers := new(mocks.UserRepository)
+
+		mockHandles.On("FindUserIDByHandle", mock.Anything, testHandle).Return(ownerID, nil)
+		mockUsers.On("FindUserByID", mock.Anything, ownerID).Return(owner, nil)
+		mockUsers.On("FindPrivacyPreferencesByUserID", mock.Anything, ownerID).
+			Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}, nil)
+
+		svc := service.NewHandleService(mockHandles, nil, service.NewUserService(mockUsers, nil, nil, nil))
+
+		resp, err := svc.ResolveHandle(context.Background(), "Chef-Jane")
+		require.NoError(t, err)
+		assert.Equal(t, testHandle, resp.Handle)
+		assert.Equal(t, "janedoe", resp.User.Username)
+	})
+
+	t.Run("Unknown Handle", func(t *testing.T) {
+		t.Parallel()
+
+		mockHandles := new(mocks.HandleRepository)
+		mockHandles.On("FindUserIDByHandle", mock.Anything, testHandle).Return(uuid.Nil, repository.ErrHandleNotFound)
+
+		svc := service.NewHandleService(mockHandles, nil, nil)
+
+		_, err := svc.ResolveHandle(context.Background(), testHandle)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("Malformed Handle", func(t *testing.T) {
+		t.Parallel()
+
+		svc := service.NewHandleService(new(mocks.HandleRepository), nil, nil)
+
+		_, err := svc.ResolveHandle(context.Background(), "not a handle")
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
@@ -9,8 +9,16 @@ import (
 
 // HealthService handles health-related business logic.
 type HealthService struct {
-	db    repository.HealthChecker
-	cache repository.HealthChecker
+	db          repository.HealthChecker
+	cache       repository.HealthChecker
+	serviceName string
+	drain       DrainService
+	invariants  InvariantChecker
+}
+
+// InvariantChecker evaluates the schema and data invariants readiness depends on.
+type InvariantChecker interface {
+	Check(ctx context.Context) []InvariantResult
 }
 
 // NewHealthService creates a new health service.
@@ -21,22 +29,43 @@ func NewHealthService(db, cache repository.HealthChecker) *HealthService {
 	}
 }
 
+// SetServiceName sets the name reported with every health status so probes and dashboards can
+// tell deployments apart.
+func (s *HealthService) SetServiceName(name string) {
+	s.serviceName = name
+}
+
+// SetDrainService makes readiness fail while the instance drains before shutdown.
+func (s *HealthService) SetDrainService(drain DrainService) {
+	s.drain = drain
+}
+
+// SetInvariants adds the invariants' results to readiness. A violated invariant of severity fail
+// makes the instance not ready.
+func (s *HealthService) SetInvariants(invariants InvariantChecker) {
+	s.invariants = invariants
+}
+
 // HealthStatus represents the overall health status.
 type HealthStatus struct {
 	Status   string            `json:"status"`
+	Service  string            `json:"service,omitempty"`
 	Database map[string]string `json:"database,omitempty"`
 	Redis    map[string]string `json:"redis,omitempty"`
+
+	Invariants []InvariantResult `json:"invariants,omitempty"`
 }
 
 // GetHealth returns simple health status (liveness).
 func (s *HealthService) GetHealth(_ context.Context) HealthStatus {
-	return HealthStatus{Status: "UP"}
+	return HealthStatus{Status: "UP", Service: s.serviceName}
 }
 
 // GetReadiness returns detailed readiness status.
 func (s *HealthService) GetReadiness(ctx context.Context) HealthStatus {
 	status := HealthStatus{
-		Status: "READY",
+		Status:  "READY",
+		Service: s.serviceName,
 	}
 
 	if s.db != nil {
@@ -59,5 +88,19 @@ func (s *HealthService) GetReadiness(ctx context.Context) HealthStatus {
 		status.Status = "DEGRADED"
 	}
 
+	if s.invariants != nil {
+		status.Invariants = s.invariants.Check(ctx)
+
+		for _, result := range status.Invariants {
+			if result.Status == InvariantSeverityFail {
+				status.Status = "NOT_READY"
+			}
+		}
+	}
+
+	if s.drain != nil && s.drain.Draining() {
+		status.Status = "DRAINING"
+	}
+
 	return status
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -30,6 +31,16 @@ func TestHealthService_GetHealth(t *testing.T) {
 	assert.Equal(t, "UP", status.Status)
 }
 
+func TestHealthService_ServiceName(t *testing.T) {
+	t.Parallel()
+
+	svc := NewHealthService(nil, nil)
+	svc.SetServiceName("user-management-eu")
+
+	assert.Equal(t, "user-management-eu", svc.GetHealth(context.Background()).Service)
+	assert.Equal(t, "user-management-eu", svc.GetReadiness(context.Background()).Service)
+}
+
 func TestHealthService_GetReadiness_AllUp(t *testing.T) {
 	t.Parallel()
 
@@ -96,3 +107,43 @@ func TestHealthService_GetReadiness_NilDependencies(t *testing.T) {
 	assert.Equal(t, "down", status.Redis["status"])
 	assert.Equal(t, "cache not configured", status.Redis["message"])
 }
+
+func TestHealthService_GetReadiness_Draining(t *testing.T) {
+	t.Parallel()
+
+	up := &mockHealthChecker{healthStatus: map[string]string{"status": "up"}}
+	drain := NewDrainService(time.Minute)
+
+	svc := NewHealthService(up, up)
+	svc.SetDrainService(drain)
+	assert.Equal(t, "READY", svc.GetReadiness(context.Background()).Status)
+
+	drain.StartDrain(context.Background(), DrainTriggerSignal)
+	assert.Equal(t, "DRAINING", svc.GetReadiness(context.Background()).Status)
+}
+
+// stubInvariants returns fixed invariant results.
+type stubInvariants []InvariantResult
+
+func (s stubInvariants) Check(_ context.Context) []InvariantResult {
+	return s
+}
+
+func TestHealthService_GetReadiness_Invariants(t *testing.T) {
+	t.Parallel()
+
+	up := &mockHealthChecker{healthStatus: map[string]string{"status": "up"}}
+
+	svc := NewHealthService(up, up)
+	svc.SetInvariants(stubInvariants{{Name: InvariantIndexes, Status: InvariantSeverityWarn}})
+
+	status := svc.GetReadiness(context.Background())
+	assert.Equal(t, "READY", status.Status)
+	assert.Len(t, status.Invariants, 1)
+
+	svc.SetInvariants(stubInvariants{
+		{Name: InvariantIndexes, Status: InvariantOK},
+		{Name: InvariantChangeAge, Status: InvariantSeverityFail},
+	})
+	assert.Equal(t, "NOT_READY", svc.GetReadiness(context.Background()).Status)
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/logger"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// DefaultInvitationTTL is how long invitations stay redeemable when InvitationOptions leaves it
+// unset.
+const DefaultInvitationTTL = 30 * 24 * time.Hour
+
+// topInviters is how many inviters the invitation stats rank.
+const topInviters = 10
+
+// invitationCodeBytes is the entropy of an invitation code, encoded as 16 base32 characters.
+const invitationCodeBytes = 10
+
+var (
+	// ErrInvitationNotFound is returned when no invitation has the given code.
+	ErrInvitationNotFound = errors.New("invitation not found")
+	// ErrInvitationLimitReached is returned when a user has created as many invitations as allowed.
+	ErrInvitationLimitReached = errors.New("invitation limit reached")
+	// ErrInvitationRedeemed is returned when an invitation has already been redeemed.
+	ErrInvitationRedeemed = errors.New("invitation already redeemed")
+	// ErrInvitationExpired is returned when an invitation is redeemed after it expired.
+	ErrInvitationExpired = errors.New("invitation expired")
+	// ErrAlreadyInvited is returned when a user who already redeemed an invitation redeems another.
+	ErrAlreadyInvited = errors.New("user already invited")
+	// ErrCannotRedeemOwnInvitation is returned when users redeem their own invitation.
+	ErrCannotRedeemOwnInvitation = errors.New("cannot redeem own invitation")
+)
+
+// invitationCodeEncoding spells codes in upper-case letters and digits that are easy to type.
+var invitationCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// InvitationService lets users invite others with codes and tracks who invited whom.
+type InvitationService interface {
+	// CreateInvitation creates an invitation code for inviterID.
+	CreateInvitation(ctx context.Context, inviterID uuid.UUID) (*dto.Invitation, error)
+	// ListInvitations returns the invitations a user created and how many more they may create.
+	ListInvitations(ctx context.Context, inviterID uuid.UUID) (*dto.InvitationsResponse, error)
+	// GetInvitation returns an invitation by code. Only its inviter sees who redeemed it.
+	GetInvitation(ctx context.Context, requesterID uuid.UUID, code string) (*dto.Invitation, error)
+	// RedeemInvitation records that inviteeID joined through an invitation.
+	RedeemInvitation(ctx context.Context, inviteeID uuid.UUID, code string) (*dto.InvitationRedemption, error)
+	// GetInvitationStats summarizes invitations and ranks the users whose invitations were redeemed
+	// most.
+	GetInvitationStats(ctx context.Context) (*dto.InvitationStatsResponse, error)
+}
+
+// InvitationOptions configures invitations.
+type InvitationOptions struct {
+	// MaxPerUser caps the invitations each user may create. Zero is unlimited.
+	MaxPerUser int
+	// TTL is how long an invitation stays redeemable.
+	TTL time.Duration
+	// MutualFollow makes the inviter and the invitee follow each other on redemption.
+	MutualFollow bool
+}
+
+// InvitationServiceImpl implements InvitationService.
+type InvitationServiceImpl struct {
+	invitations repository.InvitationRepository
+	socialRepo  repository.SocialRepository
+	tx          repository.TxManager
+	opts        InvitationOptions
+	now         func() time.Time
+}
+
+// NewInvitationService creates a new InvitationService. socialRepo may be nil when opts leaves
+// MutualFollow off.
+func NewInvitationService(
+	invitations repository.InvitationRepository,
+	socialRepo repository.SocialRepository,
+	opts InvitationOptions,
+) *InvitationServiceImpl {
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultInvitationTTL
+	}
+
+	return &InvitationServiceImpl{
+		invitations: invitations,
+		socialRepo:  socialRepo,
+		opts:        opts,
+		now:         time.Now,
+	}
+}
+
+// SetTxManager records a redemption together with the mutual follow it creates, so a failure part
+// way through leaves neither.
+func (s *InvitationServiceImpl) SetTxManager(tx repository.TxManager) {
+	s.tx = tx
+}
+
+// CreateInvitation creates an invitation code that expires after the configured TTL.
+func (s *InvitationServiceImpl) CreateInvitation(ctx context.Context, inviterID uuid.UUID) (*dto.Invitation, error) {
+	code, err := newInvitationCode()
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	invitation := &dto.Invitation{
+		Code:      code,
+		InviterID: inviterID.String(),
+		ExpiresAt: now.Add(s.opts.TTL),
+	}
+
+	err = s.invitations.CreateInvitation(ctx, invitation, s.opts.MaxPerUser)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil, ErrUserNotFound
+		case errors.Is(err, repository.ErrInvitationLimitReached):
+			return nil, ErrInvitationLimitReached
+		default:
+			return nil, fmt.Errorf("failed to create invitation: %w", err)
+		}
+	}
+
+	invitation.Status = invitationStatus(invitation, now)
+
+	return invitation, nil
+}
+
+// ListInvitations returns the invitations a user created, newest first.
+func (s *InvitationServiceImpl) ListInvitations(
+	ctx context.Context,
+	inviterID uuid.UUID,
+) (*dto.InvitationsResponse, error) {
+	invitations, err := s.invitations.ListInvitations(ctx, inviterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch invitations: %w", err)
+	}
+
+	now := s.now()
+	for i := range invitations {
+		invitations[i].Status = invitationStatus(&invitations[i], now)
+	}
+
+	response := &dto.InvitationsResponse{Invitations: invitations}
+
+	if s.opts.MaxPerUser > 0 {
+		remaining := max(s.opts.MaxPerUser-len(invitations), 0)
+		response.Remaining = &remaining
+	}
+
+	return response, nil
+}
+
+// GetInvitation returns an invitation by code, without its invitee unless requested by the inviter.
+func (s *InvitationServiceImpl) GetInvitation(
+	ctx context.Context,
+	requesterID uuid.UUID,
+	code string,
+) (*dto.Invitation, error) {
+	invitation, err := s.invitations.GetInvitation(ctx, normalizeInvitationCode(code))
+	if err != nil {
+		if errors.Is(err, repository.ErrInvitationNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch invitation: %w", err)
+	}
+
+	invitation.Status = invitationStatus(invitation, s.now())
+
+	if invitation.InviterID != requesterID.String() {
+		invitation.RedeemedBy = nil
+		invitation.RedeemedAt = nil
+	}
+
+	return invitation, nil
+}
+
+// RedeemInvitation records that inviteeID joined through an invitation and, when configured,
+// makes the inviter and the invitee follow each other. The follows come with the invitation, so
+// follow limits and privacy settings do not apply to them.
+func (s *InvitationServiceImpl) RedeemInvitation(
+	ctx context.Context,
+	inviteeID uuid.UUID,
+	code string,
+) (*dto.InvitationRedemption, error) {
+	now := s.now()
+	mutualFollow := s.opts.MutualFollow && s.socialRepo != nil
+
+	var redemption *dto.InvitationRedemption
+
+	err := withinTx(ctx, s.tx, func(ctx context.Context) error {
+		invitation, err := s.invitations.GetInvitation(ctx, normalizeInvitationCode(code))
+		if err != nil {
+			return mapInvitationError(err, "failed to fetch invitation")
+		}
+
+		switch {
+		case invitation.InviterID == inviteeID.String():
+			return ErrCannotRedeemOwnInvitation
+		case invitation.RedeemedAt != nil:
+			return ErrInvitationRedeemed
+		case !now.Before(invitation.ExpiresAt):
+			return ErrInvitationExpired
+		}
+
+		err = s.invitations.RedeemInvitation(ctx, invitation.Code, inviteeID, now)
+		if err != nil {
+			return mapInvitationError(err, "failed to redeem invitation")
+		}
+
+		if mutualFollow {
+			err = s.follow(ctx, uuid.MustParse(invitation.InviterID), inviteeID)
+			if err != nil {
+				return err
+			}
+		}
+
+		redemption = &dto.InvitationRedemption{
+			Code:            invitation.Code,
+			InviterID:       invitation.InviterID,
+			InviterUsername: invitation.InviterUsername,
+			RedeemedAt:      now,
+			MutualFollow:    mutualFollow,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inviterID := uuid.MustParse(redemption.InviterID)
+	logger.Events().InvitationRedeemed(ctx, inviterID, inviteeID)
+
+	if mutualFollow {
+		logger.Events().FollowCreated(ctx, inviterID, inviteeID)
+		logger.Events().FollowCreated(ctx, inviteeID, inviterID)
+	}
+
+	return redemption, nil
+}
+
+// GetInvitationStats summarizes invitations for growth analytics.
+func (s *InvitationServiceImpl) GetInvitationStats(ctx context.Context) (*dto.InvitationStatsResponse, error) {
+	stats, err := s.invitations.GetInvitationStats(ctx, topInviters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch invitation stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// follow makes two users follow each other.
+func (s *InvitationServiceImpl) follow(ctx context.Context, inviterID, inviteeID uuid.UUID) error {
+	err := s.socialRepo.FollowUser(ctx, inviterID, inviteeID)
+	if err != nil {
+		return fmt.Errorf("failed to follow invitee: %w", err)
+	}
+
+	err = s.socialRepo.FollowUser(ctx, inviteeID, inviterID)
+	if err != nil {
+		return fmt.Errorf("failed to follow inviter: %w", err)
+	}
+
+	return nil
+}
+
+// invitationStatus derives the status of an invitation at now.
+func invitationStatus(invitation *dto.Invitation, now time.Time) dto.InvitationStatus {
+	switch {
+	case invitation.RedeemedAt != nil:
+		return dto.InvitationStatusRedeemed
+	case !now.Before(invitation.ExpiresAt):
+		return dto.InvitationStatusExpired
+	default:
+		return dto.InvitationStatusPending
+	}
+}
+
+// newInvitationCode returns a random invitation code.
+func newInvitationCode() (string, error) {
+	buf := make([]byte, invitationCodeBytes)
+
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate invitation code: %w", err)
+	}
+
+	return invitationCodeEncoding.EncodeToString(buf), nil
+}
+
+// normalizeInvitationCode accepts codes typed in lower case or with surrounding spaces.
+func normalizeInvitationCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func mapInvitationError(err error, message string) error {
+	switch {
+	case errors.Is(err, repository.ErrInvitationNotFound):
+		return ErrInvitationNotFound
+	case errors.Is(err, repository.ErrInvitationRedeemed):
+		return ErrInvitationRedeemed
+	case errors.Is(err, repository.ErrAlreadyInvited):
+		return ErrAlreadyInvited
+	case errors.Is(err, repository.ErrUserNotFound):
+		return ErrUserNotFound
+	default:
+		return fmt.Errorf("%s: %w", message, err)
+	}
+}
@@ -0,0 +1,220 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestInvitationService_CreateInvitation(t *testing.T) {
+	t.Parallel()
+
+	inviterID := uuid.New()
+	opts := service.InvitationOptions{MaxPerUser: 3, TTL: time.Hour}
+
+	t.Run("created", func(t *testing.T) {
+		t.Parallel()
+
+		invitations := mocks.NewInvitationRepository(t)
+		invitations.On("CreateInvitation", mock.Anything, mock.MatchedBy(func(invitation *dto.Invitation) bool {
+			return invitation.InviterID == inviterID.String() && len(invitation.Code) == 16 &&
+				time.Until(invitation.ExpiresAt) > 59*time.Minute
+		}), 3).Return(nil)
+
+		invitation, err := service.NewInvitationService(invitations, nil, opts).CreateInvitation(t.Context(), inviterID)
+		require.NoError(t, err)
+		assert.Equal(t, dto.InvitationStatusPending, invitation.Status)
+	})
+
+	t.Run("limit reached", func(t *testing.T) {
+		t.Parallel()
+
+		invitations := mocks.NewInvitationRepository(t)
+		invitations.On("CreateInvitation", mock.Anything, mock.Anything, 3).
+			Return(repository.ErrInvitationLimitReached)
+
+		_, err := service.NewInvitationService(invitations, nil, opts).CreateInvitation(t.Context(), inviterID)
+		require.ErrorIs(t, err, service.ErrInvitationLimitReached)
+	})
+}
+
+func TestInvitationService_ListInvitations(t *testing.T) {
+	t.Parallel()
+
+	inviterID := uuid.New()
+	redeemedAt := time.Now().Add(-time.Hour)
+	stored := []dto.Invitation{
+		{Code: "PENDING", ExpiresAt: time.Now().Add(time.Hour)},
+		{Code: "REDEEMED", ExpiresAt: time.Now().Add(time.Hour), RedeemedAt: &redeemedAt},
+		{Code: "EXPIRED", ExpiresAt: time.Now().Add(-time.Minute)},
+	}
+
+	invitations := mocks.NewInvitationRepository(t)
+	invitations.On("ListInvitations", mock.Anything, inviterID).Return(stored, nil)
+
+	response, err := service.NewInvitationService(invitations, nil, service.InvitationOptions{MaxPerUser: 5}).
+		ListInvitations(t.Context(), inviterID)
+	require.NoError(t, err)
+	require.Len(t, response.Invitations, 3)
+	assert.Equal(t, dto.InvitationStatusPending, response.Invitations[0].Status)
+	assert.Equal(t, dto.InvitationStatusRedeemed, response.Invitations[1].Status)
+	assert.Equal(t, dto.InvitationStatusExpired, response.Invitations[2].Status)
+	require.NotNil(t, response.Remaining)
+	assert.Equal(t, 2, *response.Remaining)
+}
+
+func TestInvitationService_GetInvitation(t *testing.T) {
+	t.Parallel()
+
+	inviterID, inviteeID := uuid.New(), uuid.New()
+	redeemedBy, redeemedAt := inviteeID.String(), time.Now()
+
+	stored := func() *dto.Invitation {
+		return &dto.Invitation{
+			Code: "ABC", InviterID: inviterID.String(), ExpiresAt: time.Now().Add(time.Hour),
+			RedeemedBy: &redeemedBy, RedeemedAt: &redeemedAt,
+		}
+	}
+
+	t.Run("inviter sees the invitee", func(t *testing.T) {
+		t.Parallel()
+
+		invitations := mocks.NewInvitationRepository(t)
+		invitations.On("GetInvitation", mock.Anything, "ABC").Return(stored(), nil)
+
+		invitation, err := service.NewInvitationService(invitations, nil, service.InvitationOptions{}).
+			GetInvitation(t.Context(), inviterID, " abc ")
+		require.NoError(t, err)
+		assert.Equal(t, &redeemedBy, invitation.RedeemedBy)
+		assert.Equal(t, dto.InvitationStatusRedeemed, invitation.Status)
+	})
+
+	t.Run("others do not", func(t *testing.T) {
+		t.Parallel()
+
+		invitations := mocks.NewInvitationRepository(t)
+		invitations.On("GetInvitation", mock.Anything, "ABC").Return(stored(), nil)
+
+		invitation, err := service.NewInvitationService(invitations, nil, service.InvitationOptions{}).
+			GetInvitation(t.Context(), uuid.New(), "ABC")
+		require.NoError(t, err)
+		assert.Nil(t, invitation.RedeemedBy)
+		assert.Nil(t, invitation.RedeemedAt)
+		assert.Equal(t, dto.InvitationStatusRedeemed, invitation.Status)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		invitations := mocks.NewInvitationRepository(t)
+		invitations.On("GetInvitation", mock.Anything, "NOPE").Return(nil, repository.ErrInvitationNotFound)
+
+		_, err := service.NewInvitationService(invitations, nil, service.InvitationOptions{}).
+			GetInvitation(t.Context(), inviterID, "nope")
+		require.ErrorIs(t, err, service.ErrInvitationNotFound)
+	})
+}
+
+func TestInvitationService_RedeemInvitation(t *testing.T) {
+	t.Parallel()
+
+	inviterID, inviteeID := uuid.New(), uuid.New()
+	redeemedAt := time.Now().Add(-time.Hour)
+
+	pending := func() *dto.Invitation {
+		return &dto.Invitation{
+			Code: "ABC", InviterID: inviterID.String(), InviterUsername: "alice",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	}
+
+	t.Run("redeemed with mutual follow", func(t *testing.T) {
+		t.Parallel()
+
+		invitations := mocks.NewInvitationRepository(t)
+		invitations.On("GetInvitation", mock.Anything, "ABC").Return(pending(), nil)
+		invitations.On("RedeemInvitation", mock.Anything, "ABC", inviteeID, mock.Anything).Return(nil)
+
+		socialRepo := mocks.NewSocialRepository(t)
+		socialRepo.On("FollowUser", mock.Anything, inviterID, inviteeID).Return(nil)
+		socialRepo.On("FollowUser", mock.Anything, inviteeID, inviterID).Return(nil)
+
+		svc := service.NewInvitationService(invitations, socialRepo, service.InvitationOptions{MutualFollow: true})
+
+		redemption, err := svc.RedeemInvitation(t.Context(), inviteeID, "abc")
+		require.NoError(t, err)
+		assert.Equal(t, inviterID.String(), redemption.InviterID)
+		assert.Equal(t, "alice", redemption.InviterUsername)
+		assert.True(t, redemption.MutualFollow)
+	})
+
+	t.Run("redeemed without mutual follow", func(t *testing.T) {
+		t.Parallel()
+
+		invitations := mocks.NewInvitationRepository(t)
+		invitations.On("GetInvitation", mock.Anything, "ABC").Return(pending(), nil)
+		invitations.On("RedeemInvitation", mock.Anything, "ABC", inviteeID, mock.Anything).Return(nil)
+
+		svc := service.NewInvitationService(invitations, mocks.NewSocialRepository(t), service.InvitationOptions{})
+
+		redemption, err := svc.RedeemInvitation(t.Context(), inviteeID, "ABC")
+		require.NoError(t, err)
+		assert.False(t, redemption.MutualFollow)
+	})
+
+	tests := []struct {
+		name       string
+		inviteeID  uuid.UUID
+		invitation func() *dto.Invitation
+		redeemErr  error
+		wantErr    error
+	}{
+		{name: "own invitation", inviteeID: inviterID, invitation: pending,
+			wantErr: service.ErrCannotRedeemOwnInvitation},
+		{name: "already redeemed", inviteeID: inviteeID, invitation: func() *dto.Invitation {
+			invitation := pending()
+			invitation.RedeemedAt = &redeemedAt
+
+			return invitation
+		}, wantErr: service.ErrInvitationRedeemed},
+		{name: "expired", inviteeID: inviteeID, invitation: func() *dto.Invitation {
+			invitation := pending()
+			invitation.ExpiresAt = time.Now().Add(-time.Minute)
+
+			return invitation
+		}, wantErr: service.ErrInvitationExpired},
+		{name: "redeemed concurrently", inviteeID: inviteeID, invitation: pending,
+			redeemErr: repository.ErrInvitationRedeemed, wantErr: service.ErrInvitationRedeemed},
+		{name: "invitee already invited", inviteeID: inviteeID, invitation: pending,
+			redeemErr: repository.ErrAlreadyInvited, wantErr: service.ErrAlreadyInvited},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			invitations := mocks.NewInvitationRepository(t)
+			invitations.On("GetInvitation", mock.Anything, "ABC").Return(tt.invitation(), nil)
+
+			if tt.redeemErr != nil {
+				invitations.On("RedeemInvitation", mock.Anything, "ABC", tt.inviteeID, mock.Anything).
+					Return(tt.redeemErr)
+			}
+
+			svc := service.NewInvitationService(invitations, mocks.NewSocialRepository(t),
+				service.InvitationOptions{MutualFollow: true})
+
+			_, err := svc.RedeemInvitation(t.Context(), tt.inviteeID, "ABC")
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
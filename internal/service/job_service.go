@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+var (
+	// ErrJobNotFound is returned when no job is scheduled under the requested name.
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobRunning is returned when a job is started while it runs on any instance.
+	ErrJobRunning = errors.New("job is already running")
+)
+
+// JobService lists the scheduled background jobs and runs them on demand.
+type JobService interface {
+	ListJobs(ctx context.Context) []dto.ScheduledJob
+	// RunJob starts the named job in the background and returns it with the new run.
+	RunJob(ctx context.Context, name string) (*dto.ScheduledJob, error)
+}
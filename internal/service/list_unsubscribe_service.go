@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/signedtoken"
+)
+
+// DefaultListUnsubscribeTokenTTL is how long unsubscribe links stay valid when no TTL is
+// configured.
+const DefaultListUnsubscribeTokenTTL = 90 * 24 * time.Hour
+
+// listUnsubscribePurpose is the purpose unsubscribe tokens are signed for, followed by the email
+// category and the generation of the user's preference center links.
+const listUnsubscribePurpose = "list-unsubscribe"
+
+// ListUnsubscribePost is the List-Unsubscribe-Post header value, and the body mailbox providers
+// post, for one-click unsubscribes (RFC 8058).
+const ListUnsubscribePost = "List-Unsubscribe=One-Click"
+
+var (
+	// ErrUnsubscribeLinkInvalid is returned for an unsubscribe token that is malformed, revoked,
+	// or issued to an account that no longer exists.
+	ErrUnsubscribeLinkInvalid = errors.New("invalid unsubscribe link")
+	// ErrUnsubscribeLinkExpired is returned for an unsubscribe token past its expiry.
+	ErrUnsubscribeLinkExpired = errors.New("unsubscribe link expired")
+)
+
+// ListUnsubscribeOptions configures ListUnsubscribeServiceImpl.
+type ListUnsubscribeOptions struct {
+	// BaseURL is the public URL of this service's API that one-click unsubscribes are posted to.
+	BaseURL string
+	// Mailto is the address emailed unsubscribe requests go to. Empty leaves mailto out.
+	Mailto string
+	// TTL is how long an unsubscribe link stays valid. Zero uses DefaultListUnsubscribeTokenTTL.
+	TTL time.Duration
+}
+
+// ListUnsubscribeService is the source of truth for the List-Unsubscribe headers of the emails
+// other services send, and processes the one-click unsubscribes they lead to.
+type ListUnsubscribeService interface {
+	// GetListUnsubscribe returns the List-Unsubscribe header payload for an email of a category.
+	GetListUnsubscribe(
+		ctx context.Context,
+		userID uuid.UUID,
+		category dto.EmailCategory,
+	) (*dto.ListUnsubscribeResponse, error)
+	// Unsubscribe turns off the emails of the category a token was issued for.
+	Unsubscribe(ctx context.Context, token string) error
+}
+
+// ListUnsubscribeServiceImpl implements ListUnsubscribeService. Unsubscribe links are revoked
+// together with the user's preference center links.
+type ListUnsubscribeServiceImpl struct {
+	links       repository.PreferenceCenterRepository
+	preferences PreferenceService
+	signer      *signedtoken.Signer
+	opts        ListUnsubscribeOptions
+	now         func() time.Time
+}
+
+// NewListUnsubscribeService creates a new ListUnsubscribeService.
+func NewListUnsubscribeService(
+	links repository.PreferenceCenterRepository,
+	preferences PreferenceService,
+	signingKey []byte,
+	opts ListUnsubscribeOptions,
+) *ListUnsubscribeServiceImpl {
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultListUnsubscribeTokenTTL
+	}
+
+	opts.BaseURL = strings.TrimSuffix(opts.BaseURL, "/")
+
+	return &ListUnsubscribeServiceImpl{
+		links:       links,
+		preferences: preferences,
+		signer:      signedtoken.New(signingKey),
+		opts:        opts,
+		now:         time.Now,
+	}
+}
+
+// GetListUnsubscribe signs a token for the category and builds the one-click URL and, when
+// configured, the mailto link carrying it.
+func (s *ListUnsubscribeServiceImpl) GetListUnsubscribe(
+	ctx context.Context,
+	userID uuid.UUID,
+	category dto.EmailCategory,
+) (*dto.ListUnsubscribeResponse, error) {
+	generation, err := s.links.GetPreferenceCenterGeneration(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch preference center generation: %w", err)
+	}
+
+	expiresAt := s.now().Add(s.opts.TTL).Truncate(time.Second)
+
+	token, err := s.signer.Sign(userID, listUnsubscribeTokenPurpose(category, generation), expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign unsubscribe token: %w", err)
+	}
+
+	response := &dto.ListUnsubscribeResponse{
+		Category:            category,
+		URL:                 s.opts.BaseURL + "/unsubscribe/" + token,
+		ListUnsubscribePost: ListUnsubscribePost,
+		ExpiresAt:           expiresAt,
+	}
+	response.ListUnsubscribe = "<" + response.URL + ">"
+
+	if s.opts.Mailto != "" {
+		// The mailbox handling these relays the token in the subject to the one-click URL
+		response.Mailto = "mailto:" + s.opts.Mailto + "?subject=" + url.PathEscape("unsubscribe "+token)
+		response.ListUnsubscribe += ", <" + response.Mailto + ">"
+	}
+
+	return response, nil
+}
+
+// Unsubscribe turns the preference behind the token's email category off. Turning preferences
+// off never needs consent, so it only fails for bad tokens or deleted accounts.
+func (s *ListUnsubscribeServiceImpl) Unsubscribe(ctx context.Context, token string) error {
+	userID, category, err := s.verify(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	off := false
+	update := &dto.NotificationPreferencesUpdate{}
+
+	switch category {
+	case dto.EmailCategoryMarketing:
+		update.MarketingEmails = &off
+	case dto.EmailCategoryActivitySummaries:
+		update.ActivitySummaries = &off
+	case dto.EmailCategoryRecipeRecommendations:
+		update.RecipeRecommendations = &off
+	}
+
+	_, err = s.preferences.UpdateCategoryPreferences(ctx, userID, userID, dto.PreferenceCategoryNotification,
+		update, false, false)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return ErrUnsubscribeLinkInvalid
+		}
+
+		return fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+
+	slog.InfoContext(ctx, "security event", "event", "email_unsubscribed",
+		"user_id", userID, "category", category)
+
+	return nil
+}
+
+// verify checks a token's signature and expiry, then that it was signed for an email category
+// and the current generation of its user's links.
+func (s *ListUnsubscribeServiceImpl) verify(
+	ctx context.Context,
+	token string,
+) (uuid.UUID, dto.EmailCategory, error) {
+	claims, err := s.signer.Open(token, s.now())
+	if err != nil {
+		if errors.Is(err, signedtoken.ErrExpired) {
+			return uuid.Nil, "", ErrUnsubscribeLinkExpired
+		}
+
+		return uuid.Nil, "", ErrUnsubscribeLinkInvalid
+	}
+
+	// The purpose is list-unsubscribe:<category>:<generation>
+	parts := strings.Split(claims.Purpose, ":")
+	if len(parts) != 3 || parts[0] != listUnsubscribePurpose || !dto.EmailCategory(parts[1]).IsValid() {
+		return uuid.Nil, "", ErrUnsubscribeLinkInvalid
+	}
+
+	category := dto.EmailCategory(parts[1])
+
+	generation, err := s.links.GetPreferenceCenterGeneration(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return uuid.Nil, "", ErrUnsubscribeLinkInvalid
+		}
+
+		return uuid.Nil, "", fmt.Errorf("failed to fetch preference center generation: %w", err)
+	}
+
+	if parts[2] != strconv.Itoa(generation) {
+		return uuid.Nil, "", ErrUnsubscribeLinkInvalid
+	}
+
+	return claims.UserID, category, nil
+}
+
+func listUnsubscribeTokenPurpose(category dto.EmailCategory, generation int) string {
+	return fmt.Sprintf("%s:%s:%d", listUnsubscribePurpose, category, generation)
+}
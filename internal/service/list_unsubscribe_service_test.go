@@ -0,0 +1,145 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/signedtoken"
+)
+
+var listUnsubscribeKey = []byte("list-unsubscribe-test-key")
+
+func TestListUnsubscribeService_GetListUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("url and mailto", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewPreferenceCenterRepository(t)
+		links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(1, nil)
+
+		svc := service.NewListUnsubscribeService(links, nil, listUnsubscribeKey, service.ListUnsubscribeOptions{
+			BaseURL: "https://recipes.example.com/api/v1/user-management/",
+			Mailto:  "unsubscribe@recipes.example.com",
+		})
+
+		response, err := svc.GetListUnsubscribe(t.Context(), userID, dto.EmailCategoryMarketing)
+		require.NoError(t, err)
+
+		token, ok := strings.CutPrefix(response.URL, "https://recipes.example.com/api/v1/user-management/unsubscribe/")
+		require.True(t, ok, response.URL)
+
+		claims, err := signedtoken.New(listUnsubscribeKey).Verify(token, "list-unsubscribe:marketing:1", time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, userID, claims.UserID)
+
+		assert.Equal(t, "mailto:unsubscribe@recipes.example.com?subject=unsubscribe%20"+token, response.Mailto)
+		assert.Equal(t, "<"+response.URL+">, <"+response.Mailto+">", response.ListUnsubscribe)
+		assert.Equal(t, "List-Unsubscribe=One-Click", response.ListUnsubscribePost)
+		assert.WithinDuration(t, time.Now().Add(service.DefaultListUnsubscribeTokenTTL), response.ExpiresAt, time.Minute)
+	})
+
+	t.Run("url only", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewPreferenceCenterRepository(t)
+		links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(0, nil)
+
+		svc := service.NewListUnsubscribeService(links, nil, listUnsubscribeKey,
+			service.ListUnsubscribeOptions{BaseURL: "https://recipes.example.com"})
+
+		response, err := svc.GetListUnsubscribe(t.Context(), userID, dto.EmailCategoryActivitySummaries)
+		require.NoError(t, err)
+		assert.Empty(t, response.Mailto)
+		assert.Equal(t, "<"+response.URL+">", response.ListUnsubscribe)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewPreferenceCenterRepository(t)
+		links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(0, repository.ErrUserNotFound)
+
+		svc := service.NewListUnsubscribeService(links, nil, listUnsubscribeKey, service.ListUnsubscribeOptions{})
+
+		_, err := svc.GetListUnsubscribe(t.Context(), userID, dto.EmailCategoryMarketing)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestListUnsubscribeService_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	off := false
+
+	sign := func(t *testing.T, purpose string, expiresAt time.Time) string {
+		t.Helper()
+
+		token, err := signedtoken.New(listUnsubscribeKey).Sign(userID, purpose, expiresAt)
+		require.NoError(t, err)
+
+		return token
+	}
+
+	t.Run("turns the category off", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewPreferenceCenterRepository(t)
+		links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(2, nil)
+
+		preferences := mocks.NewPreferenceService(t)
+		preferences.On("UpdateCategoryPreferences", mock.Anything, userID, userID, dto.PreferenceCategoryNotification,
+			&dto.NotificationPreferencesUpdate{RecipeRecommendations: &off}, false, false).
+			Return(&dto.PreferenceCategoryResponse{}, nil)
+
+		svc := service.NewListUnsubscribeService(links, preferences, listUnsubscribeKey, service.ListUnsubscribeOptions{})
+
+		token := sign(t, "list-unsubscribe:recipe_recommendations:2", time.Now().Add(time.Hour))
+		require.NoError(t, svc.Unsubscribe(t.Context(), token))
+	})
+
+	tests := []struct {
+		name       string
+		purpose    string
+		expiresAt  time.Time
+		generation int
+		wantErr    error
+	}{
+		{name: "revoked", purpose: "list-unsubscribe:marketing:0", expiresAt: time.Now().Add(time.Hour),
+			generation: 1, wantErr: service.ErrUnsubscribeLinkInvalid},
+		{name: "unknown category", purpose: "list-unsubscribe:security_alerts:0",
+			expiresAt: time.Now().Add(time.Hour), wantErr: service.ErrUnsubscribeLinkInvalid},
+		{name: "preference center token", purpose: "preference-center:0",
+			expiresAt: time.Now().Add(time.Hour), wantErr: service.ErrUnsubscribeLinkInvalid},
+		{name: "expired", purpose: "list-unsubscribe:marketing:0", expiresAt: time.Now().Add(-time.Minute),
+			wantErr: service.ErrUnsubscribeLinkExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			links := mocks.NewPreferenceCenterRepository(t)
+			links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(tt.generation, nil).Maybe()
+
+			svc := service.NewListUnsubscribeService(links, mocks.NewPreferenceService(t), listUnsubscribeKey,
+				service.ListUnsubscribeOptions{})
+
+			err := svc.Unsubscribe(t.Context(), sign(t, tt.purpose, tt.expiresAt))
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
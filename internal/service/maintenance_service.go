@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// DefaultMaintenanceMessage is shown to rejected writes when no message is configured.
+const DefaultMaintenanceMessage = "The service is undergoing maintenance, please retry later"
+
+// MaintenanceService switches maintenance mode, during which mutating endpoints are rejected so
+// long migrations can run while reads stay available.
+type MaintenanceService interface {
+	GetMaintenance(ctx context.Context) (*dto.MaintenanceStatus, error)
+	SetMaintenance(ctx context.Context, actorID uuid.UUID, req *dto.MaintenanceRequest) (*dto.MaintenanceStatus, error)
+	MaintenanceMode(ctx context.Context) (bool, string)
+}
+
+// MaintenanceOptions configures maintenance mode.
+type MaintenanceOptions struct {
+	// Forced keeps maintenance mode on whatever the shared switch says.
+	Forced bool
+	// Message is shown to rejected writes unless the switch carries its own.
+	Message string
+	// RefreshInterval is how long the shared switch is reused before it is read again, which
+	// bounds how quickly other instances follow a change. Zero reads it on every check.
+	RefreshInterval time.Duration
+}
+
+// MaintenanceServiceImpl implements MaintenanceService. The switch is kept in the store so every
+// instance follows it; without a store it only applies to this instance.
+type MaintenanceServiceImpl struct {
+	store repository.MaintenanceStore
+	opts  MaintenanceOptions
+	cache *ttlCache[struct{}, *dto.MaintenanceStatus]
+
+	mu    sync.Mutex
+	local *dto.MaintenanceStatus
+}
+
+// NewMaintenanceService creates a new MaintenanceService. store may be nil.
+func NewMaintenanceService(store repository.MaintenanceStore, opts MaintenanceOptions) *MaintenanceServiceImpl {
+	if opts.Message == "" {
+		opts.Message = DefaultMaintenanceMessage
+	}
+
+	return &MaintenanceServiceImpl{
+		store: store,
+		opts:  opts,
+		cache: newTTLCache[struct{}, *dto.MaintenanceStatus](opts.RefreshInterval),
+	}
+}
+
+// GetMaintenance returns whether maintenance mode is on and the message rejected writes get.
+func (s *MaintenanceServiceImpl) GetMaintenance(ctx context.Context) (*dto.MaintenanceStatus, error) {
+	stored, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var status dto.MaintenanceStatus
+	if stored != nil {
+		status = *stored
+	}
+
+	if s.opts.Forced {
+		status.Enabled = true
+		status.Forced = true
+	}
+
+	if status.Message == "" {
+		status.Message = s.opts.Message
+	}
+
+	return &status, nil
+}
+
+// SetMaintenance switches maintenance mode on or off for every instance. Turning it off has no
+// effect while it is forced in configuration, which the returned status reports.
+func (s *MaintenanceServiceImpl) SetMaintenance(
+	ctx context.Context,
+	actorID uuid.UUID,
+	req *dto.MaintenanceRequest,
+) (*dto.MaintenanceStatus, error) {
+	now := time.Now()
+	stored := &dto.MaintenanceStatus{
+		Enabled:   *req.Enabled,
+		Message:   req.Message,
+		UpdatedBy: actorID.String(),
+		UpdatedAt: &now,
+	}
+
+	if s.store == nil {
+		s.mu.Lock()
+		s.local = stored
+		s.mu.Unlock()
+	} else {
+		err := s.store.SetMaintenance(ctx, stored)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set maintenance mode: %w", err)
+		}
+	}
+
+	s.cache.put(struct{}{}, stored)
+
+	slog.InfoContext(ctx, "maintenance mode changed", "enabled", stored.Enabled, "actor_id", actorID)
+
+	return s.GetMaintenance(ctx)
+}
+
+// MaintenanceMode reports whether writes are rejected and with which message. If the switch
+// cannot be read, only the configured setting applies, so an outage of the store does not take
+// writes down with it.
+func (s *MaintenanceServiceImpl) MaintenanceMode(ctx context.Context) (bool, string) {
+	status, err := s.GetMaintenance(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to read maintenance mode", "error", err)
+
+		return s.opts.Forced, s.opts.Message
+	}
+
+	return status.Enabled, status.Message
+}
+
+// load returns the shared switch, or nil if it was never set.
+func (s *MaintenanceServiceImpl) load(ctx context.Context) (*dto.MaintenanceStatus, error) {
+	if s.store == nil {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		return s.local, nil
+	}
+
+	if cached, ok := s.cache.get(struct{}{}); ok {
+		return cached, nil
+	}
+
+	stored, err := s.store.GetMaintenance(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance mode: %w", err)
+	}
+
+	s.cache.put(struct{}{}, stored)
+
+	return stored, nil
+}
@@ -0,0 +1,86 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestMaintenanceService_Switch(t *testing.T) {
+	t.Parallel()
+
+	store := memory.New()
+	svc := service.NewMaintenanceService(store, service.MaintenanceOptions{Message: "Back soon"})
+	adminID := uuid.New()
+	enabled, disabled := true, false
+
+	on, _ := svc.MaintenanceMode(t.Context())
+	assert.False(t, on)
+
+	status, err := svc.SetMaintenance(t.Context(), adminID, &dto.MaintenanceRequest{Enabled: &enabled})
+	require.NoError(t, err)
+	assert.True(t, status.Enabled)
+	assert.Equal(t, "Back soon", status.Message, "the configured message is the default")
+	assert.Equal(t, adminID.String(), status.UpdatedBy)
+
+	// Another instance sharing the store follows the switch
+	other := service.NewMaintenanceService(store, service.MaintenanceOptions{})
+	on, message := other.MaintenanceMode(t.Context())
+	assert.True(t, on)
+	assert.Equal(t, service.DefaultMaintenanceMessage, message)
+
+	_, err = svc.SetMaintenance(t.Context(), adminID, &dto.MaintenanceRequest{Enabled: &disabled})
+	require.NoError(t, err)
+
+	on, _ = other.MaintenanceMode(t.Context())
+	assert.False(t, on)
+}
+
+func TestMaintenanceService_Forced(t *testing.T) {
+	t.Parallel()
+
+	svc := service.NewMaintenanceService(nil, service.MaintenanceOptions{Forced: true})
+	disabled := false
+
+	status, err := svc.SetMaintenance(t.Context(), uuid.New(), &dto.MaintenanceRequest{
+		Enabled: &disabled,
+		Message: "Migrating follows",
+	})
+	require.NoError(t, err)
+	assert.True(t, status.Enabled, "configuration keeps maintenance on")
+	assert.True(t, status.Forced)
+	assert.Equal(t, "Migrating follows", status.Message)
+}
+
+func TestMaintenanceService_StoreError(t *testing.T) {
+	t.Parallel()
+
+	store := mocks.NewMaintenanceStore(t)
+	store.On("GetMaintenance", mock.Anything).Return(nil, errDB).Twice()
+
+	svc := service.NewMaintenanceService(store, service.MaintenanceOptions{RefreshInterval: time.Minute})
+
+	_, err := svc.GetMaintenance(t.Context())
+	require.ErrorIs(t, err, errDB)
+
+	// Writes are not rejected because the switch cannot be read
+	on, _ := svc.MaintenanceMode(t.Context())
+	assert.False(t, on)
+
+	// Once read, the switch is reused for the refresh interval
+	store.On("GetMaintenance", mock.Anything).Return(&dto.MaintenanceStatus{Enabled: true}, nil).Once()
+
+	for range 2 {
+		on, _ = svc.MaintenanceMode(t.Context())
+		assert.True(t, on)
+	}
+}
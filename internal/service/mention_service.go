@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
+)
+
+// DefaultMaxMentions is the usernames resolved in one call when MentionOptions leaves it unset.
+const DefaultMaxMentions = 100
+
+// ErrTooManyMentions is returned when more usernames are resolved at once than allowed.
+var ErrTooManyMentions = errors.New("too many usernames")
+
+// MentionService resolves the @username mentions other services render into links.
+type MentionService interface {
+	// ResolveMentions returns the users the usernames link to. Users that do not exist and users
+	// that cannot be found, because they are inactive, minors or not discoverable, are reported
+	// alike as unresolved.
+	ResolveMentions(ctx context.Context, usernames []string) (*dto.MentionResolveResponse, error)
+}
+
+// MentionOptions configures mention resolution.
+type MentionOptions struct {
+	// MaxUsernames caps the usernames resolved in one call.
+	MaxUsernames int
+	// CacheTTL is how long a resolved username is reused. Zero disables caching.
+	CacheTTL time.Duration
+}
+
+// MentionServiceImpl implements MentionService. Lookups are cached per lowercased username,
+// misses included, so the same mention rendered many times costs one query per TTL.
+type MentionServiceImpl struct {
+	userRepo repository.UserRepository
+	opts     MentionOptions
+	cache    *ttlCache[string, *dto.MentionedUser]
+}
+
+// NewMentionService creates a new MentionService.
+func NewMentionService(userRepo repository.UserRepository, opts MentionOptions) *MentionServiceImpl {
+	if opts.MaxUsernames <= 0 {
+		opts.MaxUsernames = DefaultMaxMentions
+	}
+
+	return &MentionServiceImpl{
+		userRepo: userRepo,
+		opts:     opts,
+		cache:    newTTLCache[string, *dto.MentionedUser](opts.CacheTTL),
+	}
+}
+
+// ResolveMentions returns the users the usernames link to, in the order first requested. A
+// leading @ is ignored, as is case; repeated usernames are resolved once.
+func (s *MentionServiceImpl) ResolveMentions(
+	ctx context.Context,
+	usernames []string,
+) (*dto.MentionResolveResponse, error) {
+	if len(usernames) > s.opts.MaxUsernames {
+		return nil, fmt.Errorf("%w: at most %d", ErrTooManyMentions, s.opts.MaxUsernames)
+	}
+
+	// 1. Normalize, dropping duplicates and names no user can have
+	var (
+		keys     []string
+		names    = make(map[string]string, len(usernames))
+		resolved = make(map[string]*dto.MentionedUser, len(usernames))
+		missing  []string
+		limits   = validation.CurrentProfileLimits()
+	)
+
+	for _, username := range usernames {
+		key := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(username), "@"))
+		if _, seen := names[key]; seen {
+			continue
+		}
+
+		names[key] = username
+		keys = append(keys, key)
+
+		if len(key) < limits.UsernameMin || len(key) > limits.UsernameMax || !validation.IsValidUsername(key) {
+			continue
+		}
+
+		if user, ok := s.cache.get(key); ok {
+			resolved[key] = user
+		} else {
+			missing = append(missing, key)
+		}
+	}
+
+	// 2. Look up the usernames not cached
+	if len(missing) > 0 {
+		users, err := s.userRepo.FindMentionableUsers(ctx, missing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve mentions: %w", err)
+		}
+
+		for i := range users {
+			resolved[strings.ToLower(users[i].Username)] = &users[i]
+		}
+
+		for _, key := range missing {
+			s.cache.put(key, resolved[key])
+		}
+	}
+
+	// 3. Answer in request order; hidden and unknown users look the same
+	response := &dto.MentionResolveResponse{Users: []dto.MentionedUser{}, Unresolved: []string{}}
+
+	for _, key := range keys {
+		if user := resolved[key]; user != nil {
+			response.Users = append(response.Users, *user)
+		} else {
+			response.Unresolved = append(response.Unresolved, names[key])
+		}
+	}
+
+	return response, nil
+}
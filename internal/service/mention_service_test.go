@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestMentionService_ResolveMentions(t *testing.T) {
+	t.Parallel()
+
+	alice := dto.MentionedUser{UserID: "1", Username: "Alice"}
+
+	repo := mocks.NewUserRepository(t)
+	// Names no user can have are never looked up; misses are cached like hits
+	repo.On("FindMentionableUsers", mock.Anything, []string{"alice", "hidden"}).
+		Return([]dto.MentionedUser{alice}, nil).
+		Once()
+
+	svc := service.NewMentionService(repo, service.MentionOptions{CacheTTL: time.Minute})
+
+	for range 2 {
+		response, err := svc.ResolveMentions(t.Context(), []string{"@Alice", "hidden", "no-such.name", "ALICE"})
+		require.NoError(t, err)
+		assert.Equal(t, &dto.MentionResolveResponse{
+			Users:      []dto.MentionedUser{alice},
+			Unresolved: []string{"hidden", "no-such.name"},
+		}, response)
+	}
+}
+
+func TestMentionService_ResolveMentions_Uncached(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewUserRepository(t)
+	repo.On("FindMentionableUsers", mock.Anything, []string{"bob"}).
+		Return([]dto.MentionedUser{{UserID: "2", Username: "bob"}}, nil).
+		Twice()
+
+	svc := service.NewMentionService(repo, service.MentionOptions{})
+
+	for range 2 {
+		response, err := svc.ResolveMentions(t.Context(), []string{"bob"})
+		require.NoError(t, err)
+		assert.Len(t, response.Users, 1)
+		assert.NotNil(t, response.Unresolved)
+	}
+}
+
+func TestMentionService_ResolveMentions_TooMany(t *testing.T) {
+	t.Parallel()
+
+	svc := service.NewMentionService(mocks.NewUserRepository(t), service.MentionOptions{MaxUsernames: 2})
+
+	_, err := svc.ResolveMentions(t.Context(), strings.Fields("alice bob carol"))
+	require.ErrorIs(t, err, service.ErrTooManyMentions)
+}
+
+func TestMentionService_ResolveMentions_RepositoryError(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewUserRepository(t)
+	repo.On("FindMentionableUsers", mock.Anything, []string{"alice"}).Return(nil, errDB)
+
+	svc := service.NewMentionService(repo, service.MentionOptions{CacheTTL: time.Minute})
+
+	_, err := svc.ResolveMentions(t.Context(), []string{"alice"})
+	require.ErrorIs(t, err, errDB)
+}
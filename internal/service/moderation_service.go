@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/notification"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// changeRequestListLimit caps the change requests returned by one listing.
+const changeRequestListLimit = 200
+
+// contentFlagListLimit caps the content flags returned by one listing.
+const contentFlagListLimit = 200
+
+// Change request errors.
+var (
+	// ErrChangeApprovalRequired is returned when a moderated account changes its username or email
+	// directly instead of submitting a change request.
+	ErrChangeApprovalRequired = errors.New("change requires admin approval")
+	// ErrNotUnderModeration is returned when a change request is submitted for an account that can
+	// change its identity directly.
+	ErrNotUnderModeration = errors.New("account is not under moderation")
+	// ErrValueUnchanged is returned when a change request matches the current value.
+	ErrValueUnchanged = errors.New("value matches current value")
+	// ErrChangeRequestNotFound is returned when a change request does not exist.
+	ErrChangeRequestNotFound = errors.New("change request not found")
+	// ErrChangeRequestPending is returned when the user already has a pending change of the field.
+	ErrChangeRequestPending = errors.New("change request already pending")
+	// ErrChangeRequestDecided is returned when a change request was already approved or rejected.
+	ErrChangeRequestDecided = errors.New("change request already decided")
+	// ErrContentFlagNotFound is returned when a content flag does not exist or is already resolved.
+	ErrContentFlagNotFound = errors.New("content flag not found")
+)
+
+// ModerationService flags accounts for moderation, runs the approval queue for username and
+// email changes on them and the review queue of profile text flagged by content moderation.
+// Admin decisions are attributed to the acting admin in the audit trail.
+type ModerationService interface {
+	// SetUnderModeration flags or unflags a user account.
+	SetUnderModeration(
+		ctx context.Context,
+		actorID, userID uuid.UUID,
+		flagged bool,
+	) (*dto.ModerationStatusResponse, error)
+	// SubmitChangeRequest queues a username or email change on the user's moderated account.
+	SubmitChangeRequest(
+		ctx context.Context,
+		userID uuid.UUID,
+		req *dto.ChangeRequestSubmission,
+	) (*dto.ChangeRequest, error)
+	// ListUserChangeRequests returns the user's change requests, oldest first.
+	ListUserChangeRequests(ctx context.Context, userID uuid.UUID) (*dto.ChangeRequestsResponse, error)
+	// ListChangeRequests returns the change requests in status, oldest first. An empty status lists
+	// every request.
+	ListChangeRequests(ctx context.Context, status dto.ChangeRequestStatus) (*dto.ChangeRequestsResponse, error)
+	// ApproveChangeRequest applies a pending change.
+	ApproveChangeRequest(ctx context.Context, actorID uuid.UUID, requestID int64) (*dto.ChangeRequest, error)
+	// RejectChangeRequest declines a pending change.
+	RejectChangeRequest(
+		ctx context.Context,
+		actorID uuid.UUID,
+		requestID int64,
+		reason string,
+	) (*dto.ChangeRequest, error)
+	// ListContentFlags returns the unresolved content flags, oldest first.
+	ListContentFlags(ctx context.Context) (*dto.ContentFlagsResponse, error)
+	// ResolveContentFlag marks an unresolved content flag as reviewed.
+	ResolveContentFlag(ctx context.Context, actorID uuid.UUID, flagID int64) (*dto.ContentFlag, error)
+}
+
+// ModerationServiceImpl implements ModerationService.
+type ModerationServiceImpl struct {
+	users              repository.UserRepository
+	moderation         repository.ModerationRepository
+	notificationClient notification.Client
+	searchCache        repository.SearchCache
+	profiles           PublicProfileService
+}
+
+// NewModerationService creates a new ModerationService.
+func NewModerationService(
+	users repository.UserRepository,
+	moderation repository.ModerationRepository,
+	notificationClient notification.Client,
+) *ModerationServiceImpl {
+	return &ModerationServiceImpl{users: users, moderation: moderation, notificationClient: notificationClient}
+}
+
+// SetSearchCache drops the cached user search results when an approved change renames a user.
+// Without it the old username can be found until the results expire.
+func (s *ModerationServiceImpl) SetSearchCache(cache repository.SearchCache) {
+	s.searchCache = cache
+}
+
+// SetPublicProfiles drops a user's projected public profile when an approved change renames them.
+func (s *ModerationServiceImpl) SetPublicProfiles(profiles PublicProfileService) {
+	s.profiles = profiles
+}
+
+// SetUnderModeration flags or unflags a user account.
+func (s *ModerationServiceImpl) SetUnderModeration(
+	ctx context.Context,
+	actorID, userID uuid.UUID,
+	flagged bool,
+) (*dto.ModerationStatusResponse, error) {
+	_, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.moderation.SetUnderModeration(ctx, actorID, userID, flagged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set moderation: %w", err)
+	}
+
+	return &dto.ModerationStatusResponse{UserID: userID.String(), UnderModeration: flagged}, nil
+}
+
+// SubmitChangeRequest queues a username or email change on the user's moderated account.
+func (s *ModerationServiceImpl) SubmitChangeRequest(
+	ctx context.Context,
+	userID uuid.UUID,
+	req *dto.ChangeRequestSubmission,
+) (*dto.ChangeRequest, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	flagged, err := s.moderation.IsUnderModeration(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check moderation: %w", err)
+	}
+
+	if !flagged {
+		return nil, ErrNotUnderModeration
+	}
+
+	oldValue := user.Username
+	if req.Field == dto.ChangeRequestFieldEmail {
+		oldValue = ""
+		if user.Email != nil {
+			oldValue = *user.Email
+		}
+	}
+
+	if strings.EqualFold(oldValue, req.Value) {
+		return nil, ErrValueUnchanged
+	}
+
+	request := &dto.ChangeRequest{
+		UserID:   userID.String(),
+		Field:    req.Field,
+		OldValue: oldValue,
+		NewValue: req.Value,
+	}
+
+	err = s.moderation.CreateChangeRequest(ctx, request)
+	if err != nil {
+		if errors.Is(err, repository.ErrChangeRequestPending) {
+			return nil, ErrChangeRequestPending
+		}
+
+		return nil, fmt.Errorf("failed to create change request: %w", err)
+	}
+
+	return request, nil
+}
+
+// ListUserChangeRequests returns the user's change requests, oldest first.
+func (s *ModerationServiceImpl) ListUserChangeRequests(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.ChangeRequestsResponse, error) {
+	requests, err := s.moderation.ListChangeRequests(ctx, userID, "", changeRequestListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch change requests: %w", err)
+	}
+
+	return &dto.ChangeRequestsResponse{Requests: requests}, nil
+}
+
+// ListChangeRequests returns the change requests in status, oldest first.
+func (s *ModerationServiceImpl) ListChangeRequests(
+	ctx context.Context,
+	status dto.ChangeRequestStatus,
+) (*dto.ChangeRequestsResponse, error) {
+	requests, err := s.moderation.ListChangeRequests(ctx, uuid.Nil, status, changeRequestListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch change requests: %w", err)
+	}
+
+	return &dto.ChangeRequestsResponse{Requests: requests}, nil
+}
+
+// ApproveChangeRequest applies a pending change. If the new username or email has been taken
+// since the request was made, the request stays pending for the admin to reject.
+func (s *ModerationServiceImpl) ApproveChangeRequest(
+	ctx context.Context,
+	actorID uuid.UUID,
+	requestID int64,
+) (*dto.ChangeRequest, error) {
+	request, err := s.moderation.ApproveChangeRequest(ctx, actorID, requestID)
+	if err != nil {
+		return nil, mapChangeRequestError(err)
+	}
+
+	invalidateSearchResults(ctx, s.searchCache)
+
+	userID, parseErr := uuid.Parse(request.UserID)
+	if parseErr != nil {
+		return request, nil
+	}
+
+	invalidatePublicProfile(ctx, s.profiles, userID)
+
+	// Send email changed notification (fire-and-forget), as for direct profile updates
+	if request.Field == dto.ChangeRequestFieldEmail && request.OldValue != "" && s.notificationClient != nil {
+		go s.notificationClient.NotifyEmailChanged( //nolint:contextcheck
+			context.Background(),
+			userID,
+			request.OldValue,
+			request.NewValue,
+		)
+	}
+
+	return request, nil
+}
+
+// RejectChangeRequest declines a pending change.
+func (s *ModerationServiceImpl) RejectChangeRequest(
+	ctx context.Context,
+	actorID uuid.UUID,
+	requestID int64,
+	reason string,
+) (*dto.ChangeRequest, error) {
+	request, err := s.moderation.RejectChangeRequest(ctx, actorID, requestID, reason)
+	if err != nil {
+		return nil, mapChangeRequestError(err)
+	}
+
+	return request, nil
+}
+
+// ListContentFlags returns the unresolved content flags, oldest first.
+func (s *ModerationServiceImpl) ListContentFlags(ctx context.Context) (*dto.ContentFlagsResponse, error) {
+	flags, err := s.moderation.ListContentFlags(ctx, contentFlagListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch content flags: %w", err)
+	}
+
+	return &dto.ContentFlagsResponse{Flags: flags}, nil
+}
+
+// ResolveContentFlag marks an unresolved content flag as reviewed, taking it off the queue. The
+// profile text is left as it is.
+func (s *ModerationServiceImpl) ResolveContentFlag(
+	ctx context.Context,
+	actorID uuid.UUID,
+	flagID int64,
+) (*dto.ContentFlag, error) {
+	flag, err := s.moderation.ResolveContentFlag(ctx, actorID, flagID)
+	if err != nil {
+		if errors.Is(err, repository.ErrContentFlagNotFound) {
+			return nil, ErrContentFlagNotFound
+		}
+
+		return nil, fmt.Errorf("failed to resolve content flag: %w", err)
+	}
+
+	return flag, nil
+}
+
+func (s *ModerationServiceImpl) findUser(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
+	user, err := s.users.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return user, nil
+}
+
+func mapChangeRequestError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrChangeRequestNotFound):
+		return ErrChangeRequestNotFound
+	case errors.Is(err, repository.ErrChangeRequestDecided):
+		return ErrChangeRequestDecided
+	case errors.Is(err, repository.ErrUserNotFound):
+		return ErrUserNotFound
+	default:
+		return mapUpdateProfileError(err)
+	}
+}
+
+// requireUnmoderated fails with ErrChangeApprovalRequired if the account is under moderation. A
+// nil repository moderates no accounts.
+func requireUnmoderated(ctx context.Context, moderation repository.ModerationRepository, userID uuid.UUID) error {
+	if moderation == nil {
+		return nil
+	}
+
+	flagged, err := moderation.IsUnderModeration(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check moderation: %w", err)
+	}
+
+	if flagged {
+		return ErrChangeApprovalRequired
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestModerationService_SubmitChangeRequest(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	email := "alice@example.com"
+	user := &dto.User{UserID: userID.String(), Username: "alice", Email: &email}
+
+	tests := []struct {
+		name      string
+		flagged   bool
+		req       *dto.ChangeRequestSubmission
+		setupMock func(*mocks.ModerationRepository)
+		wantErr   error
+	}{
+		{
+			name:    "queues change with current value",
+			flagged: true,
+			req:     &dto.ChangeRequestSubmission{Field: dto.ChangeRequestFieldEmail, Value: "new@example.com"},
+			setupMock: func(m *mocks.ModerationRepository) {
+				m.On("CreateChangeRequest", mock.Anything, &dto.ChangeRequest{
+					UserID:   userID.String(),
+					Field:    dto.ChangeRequestFieldEmail,
+					OldValue: email,
+					NewValue: "new@example.com",
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "account not under moderation",
+			flagged: false,
+			req:     &dto.ChangeRequestSubmission{Field: dto.ChangeRequestFieldUsername, Value: "alice2"},
+			wantErr: service.ErrNotUnderModeration,
+		},
+		{
+			name:    "unchanged value",
+			flagged: true,
+			req:     &dto.ChangeRequestSubmission{Field: dto.ChangeRequestFieldUsername, Value: "Alice"},
+			wantErr: service.ErrValueUnchanged,
+		},
+		{
+			name:    "field already pending",
+			flagged: true,
+			req:     &dto.ChangeRequestSubmission{Field: dto.ChangeRequestFieldUsername, Value: "alice2"},
+			setupMock: func(m *mocks.ModerationRepository) {
+				m.On("CreateChangeRequest", mock.Anything, mock.Anything).Return(repository.ErrChangeRequestPending)
+			},
+			wantErr: service.ErrChangeRequestPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			users := mocks.NewUserRepository(t)
+			moderation := mocks.NewModerationRepository(t)
+
+			users.On("FindUserByID", mock.Anything, userID).Return(user, nil)
+			moderation.On("IsUnderModeration", mock.Anything, userID).Return(tt.flagged, nil)
+
+			if tt.setupMock != nil {
+				tt.setupMock(moderation)
+			}
+
+			svc := service.NewModerationService(users, moderation, nil)
+
+			_, err := svc.SubmitChangeRequest(t.Context(), userID, tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestModerationService_ApproveChangeRequest_MapsErrors(t *testing.T) {
+	t.Parallel()
+
+	actorID := uuid.New()
+
+	tests := []struct {
+		repoErr error
+		want    error
+	}{
+		{repoErr: repository.ErrChangeRequestNotFound, want: service.ErrChangeRequestNotFound},
+		{repoErr: repository.ErrChangeRequestDecided, want: service.ErrChangeRequestDecided},
+		{repoErr: repository.ErrDuplicateUsername, want: service.ErrDuplicateUsername},
+		{repoErr: repository.ErrEmailRetired, want: service.ErrEmailRetired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.repoErr.Error(), func(t *testing.T) {
+			t.Parallel()
+
+			moderation := mocks.NewModerationRepository(t)
+			moderation.On("ApproveChangeRequest", mock.Anything, actorID, int64(3)).Return(nil, tt.repoErr)
+
+			svc := service.NewModerationService(mocks.NewUserRepository(t), moderation, nil)
+
+			request, err := svc.ApproveChangeRequest(t.Context(), actorID, 3)
+			require.ErrorIs(t, err, tt.want)
+			assert.Nil(t, request)
+		})
+	}
+}
+
+func TestModerationService_ApproveChangeRequest_InvalidatesSearchCache(t *testing.T) {
+	t.Parallel()
+
+	actorID := uuid.New()
+	approved := &dto.ChangeRequest{
+		RequestID: 3,
+		UserID:    uuid.NewString(),
+		Field:     dto.ChangeRequestFieldUsername,
+		NewValue:  "renamed",
+	}
+
+	moderation := mocks.NewModerationRepository(t)
+	moderation.On("ApproveChangeRequest", mock.Anything, actorID, int64(3)).Return(approved, nil)
+
+	cache := mocks.NewSearchCache(t)
+	cache.On("InvalidateSearchCache", mock.Anything).Return(nil).Once()
+
+	svc := service.NewModerationService(mocks.NewUserRepository(t), moderation, nil)
+	svc.SetSearchCache(cache)
+
+	request, err := svc.ApproveChangeRequest(t.Context(), actorID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, approved, request)
+}
+
+func TestModerationService_ContentFlags(t *testing.T) {
+	t.Parallel()
+
+	actorID := uuid.New()
+	flag := dto.ContentFlag{FlagID: 4, UserID: uuid.NewString(), Field: "bio", Content: "Darn", Terms: []string{"Darn"}}
+
+	moderation := mocks.NewModerationRepository(t)
+	moderation.On("ListContentFlags", mock.Anything, 200).Return([]dto.ContentFlag{flag}, nil)
+	moderation.On("ResolveContentFlag", mock.Anything, actorID, int64(4)).Return(&flag, nil)
+	moderation.On("ResolveContentFlag", mock.Anything, actorID, int64(5)).
+		Return(nil, repository.ErrContentFlagNotFound)
+
+	svc := service.NewModerationService(mocks.NewUserRepository(t), moderation, nil)
+
+	flags, err := svc.ListContentFlags(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []dto.ContentFlag{flag}, flags.Flags)
+
+	resolved, err := svc.ResolveContentFlag(t.Context(), actorID, 4)
+	require.NoError(t, err)
+	assert.Equal(t, &flag, resolved)
+
+	_, err = svc.ResolveContentFlag(t.Context(), actorID, 5)
+	require.ErrorIs(t, err, service.ErrContentFlagNotFound)
+}
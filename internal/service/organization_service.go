@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+var (
+	// ErrOrganizationNotFound is returned when no organization has the given ID.
+	ErrOrganizationNotFound = errors.New("organization not found")
+	// ErrNotOrganizationMember is returned when the requester is not a member of the organization.
+	ErrNotOrganizationMember = errors.New("not an organization member")
+	// ErrOrganizationMemberNotFound is returned when removing a user who is not a member.
+	ErrOrganizationMemberNotFound = errors.New("organization member not found")
+	// ErrOrganizationOwnerRequired is returned when a member who is not an owner manages the
+	// membership.
+	ErrOrganizationOwnerRequired = errors.New("organization owner required")
+	// ErrLastOrganizationOwner is returned when a change would leave an organization without owners.
+	ErrLastOrganizationOwner = errors.New("organization must keep an owner")
+)
+
+// OrganizationService manages organizations: shared accounts whose members act as them. Owners
+// manage the membership; every member may act as the organization, with each action attributed
+// to them in the organization's audit trail, but only owners may delete as it.
+type OrganizationService interface {
+	// CreateOrganization creates an organization owned by ownerID.
+	CreateOrganization(
+		ctx context.Context,
+		ownerID uuid.UUID,
+		req *dto.OrganizationCreateRequest,
+	) (*dto.Organization, error)
+	// ListUserOrganizations returns the organizations a user belongs to.
+	ListUserOrganizations(ctx context.Context, userID uuid.UUID) (*dto.OrganizationsResponse, error)
+	// ListMembers returns the members of an organization to one of its members.
+	ListMembers(ctx context.Context, requesterID, orgID uuid.UUID) (*dto.OrganizationMembersResponse, error)
+	// SetMember adds a user to an organization or changes their role on behalf of an owner.
+	SetMember(
+		ctx context.Context,
+		actorID, orgID, userID uuid.UUID,
+		role dto.OrganizationRole,
+	) (*dto.OrganizationMembersResponse, error)
+	// RemoveMember removes a user from an organization on behalf of an owner, or of the member
+	// leaving.
+	RemoveMember(ctx context.Context, actorID, orgID, userID uuid.UUID) error
+	// CanActAs reports whether a user may act as an organization, in a destructive request or not.
+	CanActAs(ctx context.Context, orgID, userID uuid.UUID, destructive bool) (bool, error)
+	// RecordAction records that actorID did something while acting as an organization.
+	RecordAction(ctx context.Context, actorID, orgID uuid.UUID, action string) error
+}
+
+// OrganizationServiceImpl implements OrganizationService.
+type OrganizationServiceImpl struct {
+	orgs repository.OrganizationRepository
+}
+
+// NewOrganizationService creates a new OrganizationService.
+func NewOrganizationService(orgs repository.OrganizationRepository) *OrganizationServiceImpl {
+	return &OrganizationServiceImpl{orgs: orgs}
+}
+
+// CreateOrganization creates an organization owned by ownerID.
+func (s *OrganizationServiceImpl) CreateOrganization(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	req *dto.OrganizationCreateRequest,
+) (*dto.Organization, error) {
+	org, err := s.orgs.CreateOrganization(ctx, ownerID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil, ErrUserNotFound
+		case errors.Is(err, repository.ErrDuplicateUsername):
+			return nil, ErrDuplicateUsername
+		case errors.Is(err, repository.ErrDuplicateEmail):
+			return nil, ErrDuplicateEmail
+		case errors.Is(err, repository.ErrUsernameRetired):
+			return nil, ErrUsernameRetired
+		case errors.Is(err, repository.ErrEmailRetired):
+			return nil, ErrEmailRetired
+		default:
+			return nil, fmt.Errorf("failed to create organization: %w", err)
+		}
+	}
+
+	return org, nil
+}
+
+// ListUserOrganizations returns the organizations a user belongs to, oldest membership first.
+func (s *OrganizationServiceImpl) ListUserOrganizations(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.OrganizationsResponse, error) {
+	memberships, err := s.orgs.ListUserOrganizations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organizations: %w", err)
+	}
+
+	return &dto.OrganizationsResponse{Organizations: memberships}, nil
+}
+
+// ListMembers returns the members of an organization, owners first.
+func (s *OrganizationServiceImpl) ListMembers(
+	ctx context.Context,
+	requesterID, orgID uuid.UUID,
+) (*dto.OrganizationMembersResponse, error) {
+	_, err := s.requireRole(ctx, orgID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.members(ctx, orgID)
+}
+
+// SetMember adds a user to an organization or changes their role. Only owners manage members.
+func (s *OrganizationServiceImpl) SetMember(
+	ctx context.Context,
+	actorID, orgID, userID uuid.UUID,
+	role dto.OrganizationRole,
+) (*dto.OrganizationMembersResponse, error) {
+	err := s.requireOwner(ctx, orgID, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.orgs.SetMember(ctx, actorID, orgID, userID, role)
+	if err != nil {
+		return nil, mapOrganizationError(err, "failed to set organization member")
+	}
+
+	return s.members(ctx, orgID)
+}
+
+// RemoveMember removes a user from an organization. Owners remove anyone; other members may only
+// leave.
+func (s *OrganizationServiceImpl) RemoveMember(ctx context.Context, actorID, orgID, userID uuid.UUID) error {
+	if actorID == userID {
+		_, err := s.requireRole(ctx, orgID, actorID)
+		if err != nil {
+			return err
+		}
+	} else {
+		err := s.requireOwner(ctx, orgID, actorID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := s.orgs.RemoveMember(ctx, actorID, orgID, userID)
+	if errors.Is(err, repository.ErrNotOrganizationMember) {
+		return ErrOrganizationMemberNotFound
+	}
+
+	if err != nil {
+		return mapOrganizationError(err, "failed to remove organization member")
+	}
+
+	return nil
+}
+
+// CanActAs reports whether a user is a member of an organization, and an owner when the request
+// is destructive.
+func (s *OrganizationServiceImpl) CanActAs(
+	ctx context.Context,
+	orgID, userID uuid.UUID,
+	destructive bool,
+) (bool, error) {
+	role, err := s.orgs.GetMemberRole(ctx, orgID, userID)
+	if errors.Is(err, repository.ErrNotOrganizationMember) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to check organization membership: %w", err)
+	}
+
+	return !destructive || role == dto.OrganizationRoleOwner, nil
+}
+
+// RecordAction records an action taken on behalf of an organization in its audit trail.
+func (s *OrganizationServiceImpl) RecordAction(ctx context.Context, actorID, orgID uuid.UUID, action string) error {
+	err := s.orgs.RecordOrganizationAction(ctx, actorID, orgID, action)
+	if err != nil {
+		return fmt.Errorf("failed to record organization action: %w", err)
+	}
+
+	return nil
+}
+
+func (s *OrganizationServiceImpl) members(
+	ctx context.Context,
+	orgID uuid.UUID,
+) (*dto.OrganizationMembersResponse, error) {
+	members, err := s.orgs.ListMembers(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organization members: %w", err)
+	}
+
+	return &dto.OrganizationMembersResponse{OrgID: orgID.String(), Members: members}, nil
+}
+
+// requireOwner returns nil if userID owns the organization.
+func (s *OrganizationServiceImpl) requireOwner(ctx context.Context, orgID, userID uuid.UUID) error {
+	role, err := s.requireRole(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+
+	if role != dto.OrganizationRoleOwner {
+		return ErrOrganizationOwnerRequired
+	}
+
+	return nil
+}
+
+// requireRole returns the role of userID in an existing organization they belong to.
+func (s *OrganizationServiceImpl) requireRole(
+	ctx context.Context,
+	orgID, userID uuid.UUID,
+) (dto.OrganizationRole, error) {
+	_, err := s.orgs.GetOrganization(ctx, orgID)
+	if err != nil {
+		return "", mapOrganizationError(err, "failed to fetch organization")
+	}
+
+	role, err := s.orgs.GetMemberRole(ctx, orgID, userID)
+	if err != nil {
+		return "", mapOrganizationError(err, "failed to fetch organization role")
+	}
+
+	return role, nil
+}
+
+func mapOrganizationError(err error, message string) error {
+	switch {
+	case errors.Is(err, repository.ErrOrganizationNotFound):
+		return ErrOrganizationNotFound
+	case errors.Is(err, repository.ErrNotOrganizationMember):
+		return ErrNotOrganizationMember
+	case errors.Is(err, repository.ErrLastOrganizationOwner):
+		return ErrLastOrganizationOwner
+	case errors.Is(err, repository.ErrUserNotFound):
+		return ErrUserNotFound
+	default:
+		return fmt.Errorf("%s: %w", message, err)
+	}
+}
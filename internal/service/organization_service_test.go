@@ -0,0 +1,175 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestOrganizationService_CreateOrganization(t *testing.T) {
+	t.Parallel()
+
+	ownerID := uuid.New()
+	req := &dto.OrganizationCreateRequest{Username: "tasty_kitchen", Email: "hello@tasty.example"}
+
+	t.Run("created", func(t *testing.T) {
+		t.Parallel()
+
+		org := &dto.Organization{OrgID: uuid.NewString(), Username: req.Username, CreatedBy: ownerID.String()}
+		orgs := mocks.NewOrganizationRepository(t)
+		orgs.On("CreateOrganization", mock.Anything, ownerID, req).Return(org, nil)
+
+		result, err := service.NewOrganizationService(orgs).CreateOrganization(t.Context(), ownerID, req)
+		require.NoError(t, err)
+		assert.Equal(t, org, result)
+	})
+
+	t.Run("username taken", func(t *testing.T) {
+		t.Parallel()
+
+		orgs := mocks.NewOrganizationRepository(t)
+		orgs.On("CreateOrganization", mock.Anything, ownerID, req).Return(nil, repository.ErrDuplicateUsername)
+
+		_, err := service.NewOrganizationService(orgs).CreateOrganization(t.Context(), ownerID, req)
+		require.ErrorIs(t, err, service.ErrDuplicateUsername)
+	})
+}
+
+func TestOrganizationService_SetMember(t *testing.T) {
+	t.Parallel()
+
+	orgID, ownerID, editorID, userID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	org := &dto.Organization{OrgID: orgID.String()}
+
+	t.Run("owner adds a member", func(t *testing.T) {
+		t.Parallel()
+
+		members := []dto.OrganizationMember{{UserID: ownerID.String(), Role: dto.OrganizationRoleOwner}}
+		orgs := mocks.NewOrganizationRepository(t)
+		orgs.On("GetOrganization", mock.Anything, orgID).Return(org, nil)
+		orgs.On("GetMemberRole", mock.Anything, orgID, ownerID).Return(dto.OrganizationRoleOwner, nil)
+		orgs.On("SetMember", mock.Anything, ownerID, orgID, userID, dto.OrganizationRoleEditor).Return(nil)
+		orgs.On("ListMembers", mock.Anything, orgID).Return(members, nil)
+
+		response, err := service.NewOrganizationService(orgs).
+			SetMember(t.Context(), ownerID, orgID, userID, dto.OrganizationRoleEditor)
+		require.NoError(t, err)
+		assert.Equal(t, &dto.OrganizationMembersResponse{OrgID: orgID.String(), Members: members}, response)
+	})
+
+	t.Run("editors cannot manage members", func(t *testing.T) {
+		t.Parallel()
+
+		orgs := mocks.NewOrganizationRepository(t)
+		orgs.On("GetOrganization", mock.Anything, orgID).Return(org, nil)
+		orgs.On("GetMemberRole", mock.Anything, orgID, editorID).Return(dto.OrganizationRoleEditor, nil)
+
+		_, err := service.NewOrganizationService(orgs).
+			SetMember(t.Context(), editorID, orgID, userID, dto.OrganizationRoleEditor)
+		require.ErrorIs(t, err, service.ErrOrganizationOwnerRequired)
+	})
+
+	t.Run("last owner cannot be demoted", func(t *testing.T) {
+		t.Parallel()
+
+		orgs := mocks.NewOrganizationRepository(t)
+		orgs.On("GetOrganization", mock.Anything, orgID).Return(org, nil)
+		orgs.On("GetMemberRole", mock.Anything, orgID, ownerID).Return(dto.OrganizationRoleOwner, nil)
+		orgs.On("SetMember", mock.Anything, ownerID, orgID, ownerID, dto.OrganizationRoleEditor).
+			Return(repository.ErrLastOrganizationOwner)
+
+		_, err := service.NewOrganizationService(orgs).
+			SetMember(t.Context(), ownerID, orgID, ownerID, dto.OrganizationRoleEditor)
+		require.ErrorIs(t, err, service.ErrLastOrganizationOwner)
+	})
+
+	t.Run("unknown organization", func(t *testing.T) {
+		t.Parallel()
+
+		orgs := mocks.NewOrganizationRepository(t)
+		orgs.On("GetOrganization", mock.Anything, orgID).Return(nil, repository.ErrOrganizationNotFound)
+
+		_, err := service.NewOrganizationService(orgs).
+			SetMember(t.Context(), ownerID, orgID, userID, dto.OrganizationRoleEditor)
+		require.ErrorIs(t, err, service.ErrOrganizationNotFound)
+	})
+}
+
+func TestOrganizationService_RemoveMember(t *testing.T) {
+	t.Parallel()
+
+	orgID, ownerID, editorID, userID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	org := &dto.Organization{OrgID: orgID.String()}
+
+	t.Run("members may leave", func(t *testing.T) {
+		t.Parallel()
+
+		orgs := mocks.NewOrganizationRepository(t)
+		orgs.On("GetOrganization", mock.Anything, orgID).Return(org, nil)
+		orgs.On("GetMemberRole", mock.Anything, orgID, editorID).Return(dto.OrganizationRoleEditor, nil)
+		orgs.On("RemoveMember", mock.Anything, editorID, orgID, editorID).Return(nil)
+
+		err := service.NewOrganizationService(orgs).RemoveMember(t.Context(), editorID, orgID, editorID)
+		require.NoError(t, err)
+	})
+
+	t.Run("editors cannot remove others", func(t *testing.T) {
+		t.Parallel()
+
+		orgs := mocks.NewOrganizationRepository(t)
+		orgs.On("GetOrganization", mock.Anything, orgID).Return(org, nil)
+		orgs.On("GetMemberRole", mock.Anything, orgID, editorID).Return(dto.OrganizationRoleEditor, nil)
+
+		err := service.NewOrganizationService(orgs).RemoveMember(t.Context(), editorID, orgID, ownerID)
+		require.ErrorIs(t, err, service.ErrOrganizationOwnerRequired)
+	})
+
+	t.Run("target is not a member", func(t *testing.T) {
+		t.Parallel()
+
+		orgs := mocks.NewOrganizationRepository(t)
+		orgs.On("GetOrganization", mock.Anything, orgID).Return(org, nil)
+		orgs.On("GetMemberRole", mock.Anything, orgID, ownerID).Return(dto.OrganizationRoleOwner, nil)
+		orgs.On("RemoveMember", mock.Anything, ownerID, orgID, userID).Return(repository.ErrNotOrganizationMember)
+
+		err := service.NewOrganizationService(orgs).RemoveMember(t.Context(), ownerID, orgID, userID)
+		require.ErrorIs(t, err, service.ErrOrganizationMemberNotFound)
+	})
+}
+
+func TestOrganizationService_CanActAs(t *testing.T) {
+	t.Parallel()
+
+	orgID, strangerID, editorID, ownerID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	orgs := mocks.NewOrganizationRepository(t)
+	orgs.On("GetMemberRole", mock.Anything, orgID, strangerID).
+		Return(dto.OrganizationRole(""), repository.ErrNotOrganizationMember)
+	orgs.On("GetMemberRole", mock.Anything, orgID, editorID).Return(dto.OrganizationRoleEditor, nil)
+	orgs.On("GetMemberRole", mock.Anything, orgID, ownerID).Return(dto.OrganizationRoleOwner, nil)
+
+	svc := service.NewOrganizationService(orgs)
+
+	for _, tt := range []struct {
+		userID      uuid.UUID
+		destructive bool
+		allowed     bool
+	}{
+		{userID: strangerID, allowed: false},
+		{userID: editorID, allowed: true},
+		{userID: editorID, destructive: true, allowed: false},
+		{userID: ownerID, destructive: true, allowed: true},
+	} {
+		allowed, err := svc.CanActAs(t.Context(), orgID, tt.userID, tt.destructive)
+		require.NoError(t, err)
+		assert.Equal(t, tt.allowed, allowed)
+	}
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// Policy acceptance errors.
+var (
+	// ErrPolicyNotPublished is returned when accepting a document without a current version.
+	ErrPolicyNotPublished = errors.New("policy document is not published")
+	// ErrPolicyVersionNotCurrent is returned when accepting a version other than the current one.
+	ErrPolicyVersionNotCurrent = errors.New("policy version is not current")
+)
+
+// PolicyService tracks which versions of the terms of service and privacy policy users accepted.
+type PolicyService interface {
+	// GetPolicyStatus compares the user's accepted versions with the current ones.
+	GetPolicyStatus(ctx context.Context, userID uuid.UUID) (*dto.PolicyStatusResponse, error)
+	// AcceptPolicy records acceptance of the current version of a document.
+	AcceptPolicy(ctx context.Context, userID uuid.UUID, req *dto.PolicyAcceptanceRequest) (*dto.PolicyAcceptance, error)
+	// PendingPolicies lists the published documents whose current version the user has not accepted.
+	PendingPolicies(ctx context.Context, userID uuid.UUID) ([]dto.PolicyDocument, error)
+}
+
+// PolicyServiceImpl implements PolicyService.
+type PolicyServiceImpl struct {
+	repo    repository.PolicyAcceptanceRepository
+	current map[dto.PolicyDocument]string
+}
+
+// NewPolicyService creates a new PolicyService. current maps each document to its current
+// version; documents without a version are not published and never need acceptance.
+func NewPolicyService(
+	repo repository.PolicyAcceptanceRepository,
+	current map[dto.PolicyDocument]string,
+) *PolicyServiceImpl {
+	return &PolicyServiceImpl{repo: repo, current: current}
+}
+
+// GetPolicyStatus compares the user's accepted versions with the current ones.
+func (s *PolicyServiceImpl) GetPolicyStatus(ctx context.Context, userID uuid.UUID) (*dto.PolicyStatusResponse, error) {
+	acceptances, err := s.repo.GetPolicyAcceptances(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch policy acceptances: %w", err)
+	}
+
+	response := &dto.PolicyStatusResponse{UserID: userID.String(), Policies: []dto.PolicyStatus{}}
+
+	for _, document := range dto.ValidPolicyDocuments {
+		version := s.current[document]
+		if version == "" {
+			continue
+		}
+
+		status := dto.PolicyStatus{
+			Document:           document,
+			CurrentVersion:     version,
+			AcceptanceRequired: !accepted(acceptances, document, version),
+		}
+
+		// Acceptances are newest first, so the first match is the latest accepted version
+		for _, acceptance := range acceptances {
+			if acceptance.Document == document {
+				status.AcceptedVersion = acceptance.Version
+				status.AcceptedAt = &acceptance.AcceptedAt
+
+				break
+			}
+		}
+
+		response.Policies = append(response.Policies, status)
+	}
+
+	return response, nil
+}
+
+// AcceptPolicy records acceptance of the current version of a document.
+func (s *PolicyServiceImpl) AcceptPolicy(
+	ctx context.Context,
+	userID uuid.UUID,
+	req *dto.PolicyAcceptanceRequest,
+) (*dto.PolicyAcceptance, error) {
+	version := s.current[req.Document]
+	if version == "" {
+		return nil, ErrPolicyNotPublished
+	}
+
+	if req.Version != version {
+		return nil, fmt.Errorf("%w: current version is %s", ErrPolicyVersionNotCurrent, version)
+	}
+
+	acceptance := &dto.PolicyAcceptance{Document: req.Document, Version: version}
+
+	err := s.repo.RecordPolicyAcceptance(ctx, userID, acceptance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record policy acceptance: %w", err)
+	}
+
+	return acceptance, nil
+}
+
+// PendingPolicies lists the published documents whose current version the user has not accepted.
+func (s *PolicyServiceImpl) PendingPolicies(ctx context.Context, userID uuid.UUID) ([]dto.PolicyDocument, error) {
+	status, err := s.GetPolicyStatus(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []dto.PolicyDocument
+
+	for _, policy := range status.Policies {
+		if policy.AcceptanceRequired {
+			pending = append(pending, policy.Document)
+		}
+	}
+
+	return pending, nil
+}
+
+func accepted(acceptances []dto.PolicyAcceptance, document dto.PolicyDocument, version string) bool {
+	for _, acceptance := range acceptances {
+		if acceptance.Document == document && acceptance.Version == version {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPolicyService_GetPolicyStatus(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	acceptedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	repo := mocks.NewPolicyAcceptanceRepository(t)
+	repo.On("GetPolicyAcceptances", mock.Anything, userID).Return([]dto.PolicyAcceptance{
+		{Document: dto.PolicyDocumentTerms, Version: "2026-03", AcceptedAt: acceptedAt},
+		{Document: dto.PolicyDocumentTerms, Version: "2026-01", AcceptedAt: acceptedAt.AddDate(0, -2, 0)},
+	}, nil)
+
+	// Rolled back to an earlier terms version the user already accepted; privacy is unpublished
+	svc := service.NewPolicyService(repo, map[dto.PolicyDocument]string{dto.PolicyDocumentTerms: "2026-01"})
+
+	status, err := svc.GetPolicyStatus(t.Context(), userID)
+	require.NoError(t, err)
+
+	require.Len(t, status.Policies, 1)
+	assert.Equal(t, dto.PolicyDocumentTerms, status.Policies[0].Document)
+	assert.Equal(t, "2026-03", status.Policies[0].AcceptedVersion)
+	assert.Equal(t, acceptedAt, *status.Policies[0].AcceptedAt)
+	assert.False(t, status.Policies[0].AcceptanceRequired)
+}
+
+func TestPolicyService_PendingPolicies(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	repo := mocks.NewPolicyAcceptanceRepository(t)
+	repo.On("GetPolicyAcceptances", mock.Anything, userID).Return([]dto.PolicyAcceptance{
+		{Document: dto.PolicyDocumentTerms, Version: "2026-01"},
+		{Document: dto.PolicyDocumentPrivacy, Version: "2026-01"},
+	}, nil)
+
+	svc := service.NewPolicyService(repo, map[dto.PolicyDocument]string{
+		dto.PolicyDocumentTerms:   "2026-10",
+		dto.PolicyDocumentPrivacy: "2026-01",
+	})
+
+	pending, err := svc.PendingPolicies(t.Context(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.PolicyDocument{dto.PolicyDocumentTerms}, pending)
+}
+
+func TestPolicyService_AcceptPolicy(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	current := map[dto.PolicyDocument]string{dto.PolicyDocumentTerms: "2026-10"}
+
+	repo := mocks.NewPolicyAcceptanceRepository(t)
+	svc := service.NewPolicyService(repo, current)
+
+	_, err := svc.AcceptPolicy(t.Context(), userID, &dto.PolicyAcceptanceRequest{
+		Document: dto.PolicyDocumentPrivacy,
+		Version:  "2026-10",
+	})
+	require.ErrorIs(t, err, service.ErrPolicyNotPublished)
+
+	_, err = svc.AcceptPolicy(t.Context(), userID, &dto.PolicyAcceptanceRequest{
+		Document: dto.PolicyDocumentTerms,
+		Version:  "2026-09",
+	})
+	require.ErrorIs(t, err, service.ErrPolicyVersionNotCurrent)
+
+	repo.On("RecordPolicyAcceptance", mock.Anything, userID, mock.Anything).Return(nil)
+
+	acceptance, err := svc.AcceptPolicy(t.Context(), userID, &dto.PolicyAcceptanceRequest{
+		Document: dto.PolicyDocumentTerms,
+		Version:  "2026-10",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10", acceptance.Version)
+}
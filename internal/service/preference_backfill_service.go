@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// defaultBackfillBatchSize is how many users a backfill handles per transaction unless configured.
+const defaultBackfillBatchSize = 500
+
+// PreferenceBackfillService writes explicit default preference rows for every user who never saved
+// a category. Reads then find a row rather than falling back to the defaults, and each row keeps
+// its own updated_at from then on.
+type PreferenceBackfillService interface {
+	// Backfill goes through every user once and returns what it inserted.
+	Backfill(ctx context.Context) (*dto.PreferenceBackfillResult, error)
+}
+
+// PreferenceBackfillOptions configures a preference backfill.
+type PreferenceBackfillOptions struct {
+	// BatchSize is how many users are backfilled per transaction. Zero uses 500.
+	BatchSize int
+	// Rate caps the users backfilled per second. Zero is unlimited.
+	Rate int
+	// Progress is called after each batch with the totals so far.
+	Progress func(dto.PreferenceBackfillResult)
+}
+
+// PreferenceBackfillServiceImpl implements PreferenceBackfillService.
+type PreferenceBackfillServiceImpl struct {
+	repo repository.PreferenceBackfillRepository
+	opts PreferenceBackfillOptions
+}
+
+// NewPreferenceBackfillService creates a new PreferenceBackfillService.
+func NewPreferenceBackfillService(
+	repo repository.PreferenceBackfillRepository,
+	opts PreferenceBackfillOptions,
+) *PreferenceBackfillServiceImpl {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBackfillBatchSize
+	}
+
+	return &PreferenceBackfillServiceImpl{repo: repo, opts: opts}
+}
+
+// Backfill goes through every user in ID order, in batches paced to the configured rate. Rows
+// already saved are left alone, so an interrupted backfill can simply be run again.
+func (s *PreferenceBackfillServiceImpl) Backfill(ctx context.Context) (*dto.PreferenceBackfillResult, error) {
+	result := &dto.PreferenceBackfillResult{}
+	started := time.Now()
+	after := uuid.Nil
+
+	for {
+		userIDs, err := s.repo.ListUserIDs(ctx, after, s.opts.BatchSize)
+		if err != nil {
+			return result, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		if len(userIDs) == 0 {
+			return result, nil
+		}
+
+		rows, err := s.repo.InsertDefaultPreferences(ctx, userIDs)
+		if err != nil {
+			return result, err
+		}
+
+		result.Users += len(userIDs)
+		result.Rows += rows
+
+		if s.opts.Progress != nil {
+			s.opts.Progress(*result)
+		}
+
+		if len(userIDs) < s.opts.BatchSize {
+			return result, nil
+		}
+
+		after = userIDs[len(userIDs)-1]
+
+		err = s.pace(ctx, started, result.Users)
+		if err != nil {
+			return result, err
+		}
+	}
+}
+
+// pace waits until backfilling users since started stays within the configured rate.
+func (s *PreferenceBackfillServiceImpl) pace(ctx context.Context, started time.Time, users int) error {
+	if s.opts.Rate <= 0 {
+		return nil
+	}
+
+	due := started.Add(time.Duration(users) * time.Second / time.Duration(s.opts.Rate))
+
+	timer := time.NewTimer(time.Until(due))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("preference backfill interrupted: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,61 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPreferenceBackfillService_Backfill(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pages through every user", func(t *testing.T) {
+		t.Parallel()
+
+		first := []uuid.UUID{uuid.New(), uuid.New()}
+		last := []uuid.UUID{uuid.New()}
+
+		repo := mocks.NewPreferenceBackfillRepository(t)
+		repo.On("ListUserIDs", mock.Anything, uuid.Nil, 2).Return(first, nil)
+		repo.On("InsertDefaultPreferences", mock.Anything, first).Return(18, nil)
+		repo.On("ListUserIDs", mock.Anything, first[1], 2).Return(last, nil)
+		repo.On("InsertDefaultPreferences", mock.Anything, last).Return(0, nil)
+
+		var progress []dto.PreferenceBackfillResult
+
+		svc := service.NewPreferenceBackfillService(repo, service.PreferenceBackfillOptions{
+			BatchSize: 2,
+			Rate:      1000,
+			Progress:  func(result dto.PreferenceBackfillResult) { progress = append(progress, result) },
+		})
+
+		result, err := svc.Backfill(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, &dto.PreferenceBackfillResult{Users: 3, Rows: 18}, result)
+		assert.Equal(t, []dto.PreferenceBackfillResult{{Users: 2, Rows: 18}, {Users: 3, Rows: 18}}, progress)
+	})
+
+	t.Run("failed batch stops with the totals so far", func(t *testing.T) {
+		t.Parallel()
+
+		first := []uuid.UUID{uuid.New()}
+
+		repo := mocks.NewPreferenceBackfillRepository(t)
+		repo.On("ListUserIDs", mock.Anything, uuid.Nil, 1).Return(first, nil)
+		repo.On("InsertDefaultPreferences", mock.Anything, first).Return(9, nil)
+		repo.On("ListUserIDs", mock.Anything, first[0], 1).Return([]uuid.UUID{uuid.New()}, nil)
+		repo.On("InsertDefaultPreferences", mock.Anything, mock.Anything).Return(0, assert.AnError)
+
+		result, err := service.NewPreferenceBackfillService(repo, service.PreferenceBackfillOptions{BatchSize: 1}).
+			Backfill(t.Context())
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, &dto.PreferenceBackfillResult{Users: 1, Rows: 9}, result)
+	})
+}
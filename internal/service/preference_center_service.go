@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/signedtoken"
+)
+
+// DefaultPreferenceCenterTokenTTL is how long preference center links stay valid when no TTL is
+// configured. Emails are often opened weeks after they are sent.
+const DefaultPreferenceCenterTokenTTL = 90 * 24 * time.Hour
+
+// preferenceCenterPurpose is the purpose preference center tokens are signed for, followed by the
+// generation of the user's links.
+const preferenceCenterPurpose = "preference-center"
+
+var (
+	// ErrPreferenceCenterLinkInvalid is returned for a preference center token that is malformed,
+	// revoked, or issued to an account that no longer exists.
+	ErrPreferenceCenterLinkInvalid = errors.New("invalid preference center link")
+	// ErrPreferenceCenterLinkExpired is returned for a preference center token past its expiry.
+	ErrPreferenceCenterLinkExpired = errors.New("preference center link expired")
+)
+
+// PreferenceCenterService lets email recipients manage the emails they receive through signed
+// links, without signing in.
+type PreferenceCenterService interface {
+	// IssueToken signs a token for a user's preference center link.
+	IssueToken(ctx context.Context, userID uuid.UUID) (*dto.PreferenceCenterTokenResponse, error)
+	// GetPreferences returns the notification preferences a token grants access to.
+	GetPreferences(ctx context.Context, token string) (*dto.PreferenceCenterResponse, error)
+	// UpdatePreferences changes the optional mailings of the user a token was issued to.
+	UpdatePreferences(
+		ctx context.Context,
+		token string,
+		update *dto.PreferenceCenterUpdateRequest,
+	) (*dto.PreferenceCenterResponse, error)
+	// RevokeTokens invalidates every preference center link issued to a user so far.
+	RevokeTokens(ctx context.Context, userID uuid.UUID) error
+}
+
+// PreferenceCenterServiceImpl implements PreferenceCenterService.
+type PreferenceCenterServiceImpl struct {
+	links       repository.PreferenceCenterRepository
+	preferences PreferenceService
+	signer      *signedtoken.Signer
+	ttl         time.Duration
+	now         func() time.Time
+}
+
+// NewPreferenceCenterService creates a new PreferenceCenterService. Preferences are read and
+// updated through preferences, so consent and category rules apply as they do when signed in.
+func NewPreferenceCenterService(
+	links repository.PreferenceCenterRepository,
+	preferences PreferenceService,
+	signingKey []byte,
+	ttl time.Duration,
+) *PreferenceCenterServiceImpl {
+	if ttl <= 0 {
+		ttl = DefaultPreferenceCenterTokenTTL
+	}
+
+	return &PreferenceCenterServiceImpl{
+		links:       links,
+		preferences: preferences,
+		signer:      signedtoken.New(signingKey),
+		ttl:         ttl,
+		now:         time.Now,
+	}
+}
+
+// IssueToken signs a token for the current generation of the user's links.
+func (s *PreferenceCenterServiceImpl) IssueToken(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.PreferenceCenterTokenResponse, error) {
+	generation, err := s.links.GetPreferenceCenterGeneration(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch preference center generation: %w", err)
+	}
+
+	expiresAt := s.now().Add(s.ttl).Truncate(time.Second)
+
+	token, err := s.signer.Sign(userID, preferenceCenterTokenPurpose(generation), expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign preference center token: %w", err)
+	}
+
+	return &dto.PreferenceCenterTokenResponse{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// GetPreferences returns the notification preferences of the user a token was issued to.
+func (s *PreferenceCenterServiceImpl) GetPreferences(
+	ctx context.Context,
+	token string,
+) (*dto.PreferenceCenterResponse, error) {
+	claims, err := s.verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.preferences.GetCategoryPreferences(ctx, claims.UserID, claims.UserID,
+		dto.PreferenceCategoryNotification, false, false)
+	if err != nil {
+		return nil, mapPreferenceCenterError(err)
+	}
+
+	return preferenceCenterResponse(response, claims)
+}
+
+// UpdatePreferences changes the marketing emails, activity summaries and recipe recommendations
+// of the user a token was issued to. Turning marketing emails on still needs a granted consent.
+func (s *PreferenceCenterServiceImpl) UpdatePreferences(
+	ctx context.Context,
+	token string,
+	update *dto.PreferenceCenterUpdateRequest,
+) (*dto.PreferenceCenterResponse, error) {
+	claims, err := s.verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.preferences.UpdateCategoryPreferences(ctx, claims.UserID, claims.UserID,
+		dto.PreferenceCategoryNotification, &dto.NotificationPreferencesUpdate{
+			MarketingEmails:       update.MarketingEmails,
+			ActivitySummaries:     update.ActivitySummaries,
+			RecipeRecommendations: update.RecipeRecommendations,
+		}, false, false)
+	if err != nil {
+		return nil, mapPreferenceCenterError(err)
+	}
+
+	slog.InfoContext(ctx, "security event", "event", "preference_center_updated", "user_id", claims.UserID)
+
+	return preferenceCenterResponse(response, claims)
+}
+
+// RevokeTokens bumps the generation of the user's links, so links sent before no longer verify.
+func (s *PreferenceCenterServiceImpl) RevokeTokens(ctx context.Context, userID uuid.UUID) error {
+	_, err := s.links.RevokePreferenceCenterLinks(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+
+		return fmt.Errorf("failed to revoke preference center links: %w", err)
+	}
+
+	slog.InfoContext(ctx, "security event", "event", "preference_center_links_revoked", "user_id", userID)
+
+	return nil
+}
+
+// verify checks a token's signature and expiry, then that it was signed for the current
+// generation of its user's links.
+func (s *PreferenceCenterServiceImpl) verify(ctx context.Context, token string) (*signedtoken.Claims, error) {
+	claims, err := s.signer.Open(token, s.now())
+	if err != nil {
+		if errors.Is(err, signedtoken.ErrExpired) {
+			return nil, ErrPreferenceCenterLinkExpired
+		}
+
+		return nil, ErrPreferenceCenterLinkInvalid
+	}
+
+	generation, err := s.links.GetPreferenceCenterGeneration(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrPreferenceCenterLinkInvalid
+		}
+
+		return nil, fmt.Errorf("failed to fetch preference center generation: %w", err)
+	}
+
+	if claims.Purpose != preferenceCenterTokenPurpose(generation) {
+		return nil, ErrPreferenceCenterLinkInvalid
+	}
+
+	return claims, nil
+}
+
+func preferenceCenterTokenPurpose(generation int) string {
+	return fmt.Sprintf("%s:%d", preferenceCenterPurpose, generation)
+}
+
+func preferenceCenterResponse(
+	response *dto.PreferenceCategoryResponse,
+	claims *signedtoken.Claims,
+) (*dto.PreferenceCenterResponse, error) {
+	prefs, ok := response.Preferences.(*dto.NotificationPreferences)
+	if !ok {
+		return nil, fmt.Errorf("unexpected notification preferences type %T", response.Preferences)
+	}
+
+	return &dto.PreferenceCenterResponse{Notification: prefs, ExpiresAt: claims.ExpiresAt}, nil
+}
+
+// mapPreferenceCenterError treats a link to an account that no longer exists as invalid.
+func mapPreferenceCenterError(err error) error {
+	if errors.Is(err, ErrUserNotFound) {
+		return ErrPreferenceCenterLinkInvalid
+	}
+
+	return err
+}
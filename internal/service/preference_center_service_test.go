@@ -0,0 +1,166 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/signedtoken"
+)
+
+var preferenceCenterKey = []byte("preference-center-test-key")
+
+func TestPreferenceCenterService_IssueToken(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("issued", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewPreferenceCenterRepository(t)
+		links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(2, nil)
+
+		svc := service.NewPreferenceCenterService(links, nil, preferenceCenterKey, time.Hour)
+
+		response, err := svc.IssueToken(t.Context(), userID)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), response.ExpiresAt, time.Minute)
+
+		claims, err := signedtoken.New(preferenceCenterKey).Verify(response.Token, "preference-center:2", time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, userID, claims.UserID)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewPreferenceCenterRepository(t)
+		links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(0, repository.ErrUserNotFound)
+
+		_, err := service.NewPreferenceCenterService(links, nil, preferenceCenterKey, 0).IssueToken(t.Context(), userID)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestPreferenceCenterService_GetPreferences(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	prefs := &dto.NotificationPreferences{MarketingEmails: true}
+
+	sign := func(t *testing.T, purpose string, expiresAt time.Time) string {
+		t.Helper()
+
+		token, err := signedtoken.New(preferenceCenterKey).Sign(userID, purpose, expiresAt)
+		require.NoError(t, err)
+
+		return token
+	}
+
+	t.Run("valid link", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewPreferenceCenterRepository(t)
+		links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(1, nil)
+
+		preferences := mocks.NewPreferenceService(t)
+		preferences.On("GetCategoryPreferences", mock.Anything, userID, userID,
+			dto.PreferenceCategoryNotification, false, false).
+			Return(&dto.PreferenceCategoryResponse{Preferences: prefs}, nil)
+
+		svc := service.NewPreferenceCenterService(links, preferences, preferenceCenterKey, 0)
+
+		response, err := svc.GetPreferences(t.Context(), sign(t, "preference-center:1", time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+		assert.Equal(t, prefs, response.Notification)
+	})
+
+	t.Run("revoked link", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewPreferenceCenterRepository(t)
+		links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(2, nil)
+
+		svc := service.NewPreferenceCenterService(links, mocks.NewPreferenceService(t), preferenceCenterKey, 0)
+
+		_, err := svc.GetPreferences(t.Context(), sign(t, "preference-center:1", time.Now().Add(time.Hour)))
+		require.ErrorIs(t, err, service.ErrPreferenceCenterLinkInvalid)
+	})
+
+	t.Run("expired link", func(t *testing.T) {
+		t.Parallel()
+
+		svc := service.NewPreferenceCenterService(mocks.NewPreferenceCenterRepository(t),
+			mocks.NewPreferenceService(t), preferenceCenterKey, 0)
+
+		_, err := svc.GetPreferences(t.Context(), sign(t, "preference-center:0", time.Now().Add(-time.Minute)))
+		require.ErrorIs(t, err, service.ErrPreferenceCenterLinkExpired)
+	})
+
+	t.Run("malformed link", func(t *testing.T) {
+		t.Parallel()
+
+		svc := service.NewPreferenceCenterService(mocks.NewPreferenceCenterRepository(t),
+			mocks.NewPreferenceService(t), preferenceCenterKey, 0)
+
+		_, err := svc.GetPreferences(t.Context(), "not-a-token")
+		require.ErrorIs(t, err, service.ErrPreferenceCenterLinkInvalid)
+	})
+
+	t.Run("deleted account", func(t *testing.T) {
+		t.Parallel()
+
+		links := mocks.NewPreferenceCenterRepository(t)
+		links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(0, repository.ErrUserNotFound)
+
+		svc := service.NewPreferenceCenterService(links, mocks.NewPreferenceService(t), preferenceCenterKey, 0)
+
+		_, err := svc.GetPreferences(t.Context(), sign(t, "preference-center:0", time.Now().Add(time.Hour)))
+		require.ErrorIs(t, err, service.ErrPreferenceCenterLinkInvalid)
+	})
+}
+
+func TestPreferenceCenterService_UpdatePreferences(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	off := false
+
+	token, err := signedtoken.New(preferenceCenterKey).Sign(userID, "preference-center:0", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	links := mocks.NewPreferenceCenterRepository(t)
+	links.On("GetPreferenceCenterGeneration", mock.Anything, userID).Return(0, nil)
+
+	preferences := mocks.NewPreferenceService(t)
+	preferences.On("UpdateCategoryPreferences", mock.Anything, userID, userID, dto.PreferenceCategoryNotification,
+		&dto.NotificationPreferencesUpdate{MarketingEmails: &off}, false, false).
+		Return(&dto.PreferenceCategoryResponse{Preferences: &dto.NotificationPreferences{}}, nil)
+
+	svc := service.NewPreferenceCenterService(links, preferences, preferenceCenterKey, 0)
+
+	response, err := svc.UpdatePreferences(t.Context(), token, &dto.PreferenceCenterUpdateRequest{MarketingEmails: &off})
+	require.NoError(t, err)
+	assert.False(t, response.Notification.MarketingEmails)
+}
+
+func TestPreferenceCenterService_RevokeTokens(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	links := mocks.NewPreferenceCenterRepository(t)
+	links.On("RevokePreferenceCenterLinks", mock.Anything, userID).Return(1, nil)
+
+	err := service.NewPreferenceCenterService(links, nil, preferenceCenterKey, 0).RevokeTokens(t.Context(), userID)
+	require.NoError(t, err)
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// PreferenceMigrationService copies saved preferences between the table and document storage
+// layouts, so a deployment can switch preferences.storage without users losing their settings.
+type PreferenceMigrationService interface {
+	// MigrateToDocuments copies every user's saved rows into documents.
+	MigrateToDocuments(ctx context.Context) (*dto.PreferenceMigrationResult, error)
+	// MigrateToTables copies every user's documents into the category tables.
+	MigrateToTables(ctx context.Context) (*dto.PreferenceMigrationResult, error)
+}
+
+// PreferenceMigrationOptions configures a preference storage migration.
+type PreferenceMigrationOptions struct {
+	// BatchSize is how many users are copied per transaction. Zero uses 500.
+	BatchSize int
+	// Progress is called after each batch with the totals so far.
+	Progress func(dto.PreferenceMigrationResult)
+}
+
+// PreferenceMigrationServiceImpl implements PreferenceMigrationService.
+type PreferenceMigrationServiceImpl struct {
+	repo repository.PreferenceStorageRepository
+	opts PreferenceMigrationOptions
+}
+
+// NewPreferenceMigrationService creates a new PreferenceMigrationService.
+func NewPreferenceMigrationService(
+	repo repository.PreferenceStorageRepository,
+	opts PreferenceMigrationOptions,
+) *PreferenceMigrationServiceImpl {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBackfillBatchSize
+	}
+
+	return &PreferenceMigrationServiceImpl{repo: repo, opts: opts}
+}
+
+// MigrateToDocuments copies every user's saved rows into documents, in batches.
+func (s *PreferenceMigrationServiceImpl) MigrateToDocuments(
+	ctx context.Context,
+) (*dto.PreferenceMigrationResult, error) {
+	return s.migrate(ctx, s.repo.CopyPreferencesToDocuments)
+}
+
+// MigrateToTables copies every user's documents into the category tables, in batches.
+func (s *PreferenceMigrationServiceImpl) MigrateToTables(ctx context.Context) (*dto.PreferenceMigrationResult, error) {
+	return s.migrate(ctx, s.repo.CopyPreferencesToTables)
+}
+
+// migrate goes through every user in ID order and copies their preferences a batch at a time. The
+// newer of a row and a document wins, so a migration can be run again to pick up what changed
+// while the service was still writing the old layout.
+func (s *PreferenceMigrationServiceImpl) migrate(
+	ctx context.Context,
+	copyBatch func(ctx context.Context, userIDs []uuid.UUID) (int, error),
+) (*dto.PreferenceMigrationResult, error) {
+	result := &dto.PreferenceMigrationResult{}
+	after := uuid.Nil
+
+	for {
+		userIDs, err := s.repo.ListUserIDs(ctx, after, s.opts.BatchSize)
+		if err != nil {
+			return result, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		if len(userIDs) == 0 {
+			return result, nil
+		}
+
+		rows, err := copyBatch(ctx, userIDs)
+		if err != nil {
+			return result, err
+		}
+
+		result.Users += len(userIDs)
+		result.Rows += rows
+
+		if s.opts.Progress != nil {
+			s.opts.Progress(*result)
+		}
+
+		if len(userIDs) < s.opts.BatchSize {
+			return result, nil
+		}
+
+		after = userIDs[len(userIDs)-1]
+	}
+}
@@ -0,0 +1,60 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPreferenceMigrationService_Migrate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("copies every user to documents", func(t *testing.T) {
+		t.Parallel()
+
+		first := []uuid.UUID{uuid.New(), uuid.New()}
+		last := []uuid.UUID{uuid.New()}
+
+		repo := mocks.NewPreferenceStorageRepository(t)
+		repo.On("ListUserIDs", mock.Anything, uuid.Nil, 2).Return(first, nil)
+		repo.On("CopyPreferencesToDocuments", mock.Anything, first).Return(16, nil)
+		repo.On("ListUserIDs", mock.Anything, first[1], 2).Return(last, nil)
+		repo.On("CopyPreferencesToDocuments", mock.Anything, last).Return(3, nil)
+
+		var progress []dto.PreferenceMigrationResult
+
+		svc := service.NewPreferenceMigrationService(repo, service.PreferenceMigrationOptions{
+			BatchSize: 2,
+			Progress:  func(result dto.PreferenceMigrationResult) { progress = append(progress, result) },
+		})
+
+		result, err := svc.MigrateToDocuments(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, &dto.PreferenceMigrationResult{Users: 3, Rows: 19}, result)
+		assert.Equal(t, []dto.PreferenceMigrationResult{{Users: 2, Rows: 16}, {Users: 3, Rows: 19}}, progress)
+	})
+
+	t.Run("failed batch stops with the totals so far", func(t *testing.T) {
+		t.Parallel()
+
+		first := []uuid.UUID{uuid.New()}
+
+		repo := mocks.NewPreferenceStorageRepository(t)
+		repo.On("ListUserIDs", mock.Anything, uuid.Nil, 1).Return(first, nil)
+		repo.On("CopyPreferencesToTables", mock.Anything, first).Return(8, nil)
+		repo.On("ListUserIDs", mock.Anything, first[0], 1).Return([]uuid.UUID{uuid.New()}, nil)
+		repo.On("CopyPreferencesToTables", mock.Anything, mock.Anything).Return(0, assert.AnError)
+
+		result, err := service.NewPreferenceMigrationService(repo, service.PreferenceMigrationOptions{BatchSize: 1}).
+			MigrateToTables(t.Context())
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, &dto.PreferenceMigrationResult{Users: 1, Rows: 8}, result)
+	})
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/logger"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
 )
 
@@ -17,6 +19,11 @@ var (
 	ErrUnauthorizedAccess = errors.New("unauthorized access to preferences")
 	ErrInvalidCategory    = errors.New("invalid preference category")
 	ErrInvalidUpdateType  = errors.New("invalid update type for preference category")
+	// ErrCategoryDisabled is returned for a preference category this deployment has disabled.
+	ErrCategoryDisabled = errors.New("preference category disabled")
+	// ErrConsentRequired is returned when an update turns on a preference whose consent is not
+	// recorded as granted in the consent ledger.
+	ErrConsentRequired = errors.New("consent required")
 )
 
 // PreferenceService defines business logic for preference operations.
@@ -57,16 +64,71 @@ type PreferenceService interface {
 		isAdmin bool,
 		hasServiceScope bool,
 	) (*dto.PreferenceCategoryResponse, error)
+
+	// ResetAllPreferences restores all or the given categories to their defaults.
+	ResetAllPreferences(
+		ctx context.Context,
+		requesterID, targetUserID uuid.UUID,
+		categories []dto.PreferenceCategory,
+		isAdmin bool,
+		hasServiceScope bool,
+	) (*dto.UserPreferencesResponse, error)
+
+	// ResetCategoryPreferences restores a single preference category to its defaults.
+	ResetCategoryPreferences(
+		ctx context.Context,
+		requesterID, targetUserID uuid.UUID,
+		category dto.PreferenceCategory,
+		isAdmin bool,
+		hasServiceScope bool,
+	) (*dto.PreferenceCategoryResponse, error)
 }
 
 // PreferenceServiceImpl implements PreferenceService.
 type PreferenceServiceImpl struct {
-	repo repository.PreferenceRepository
+	repo     repository.PreferenceRepository
+	consents repository.ConsentRepository
+	ages     repository.AgeRepository
+	search   repository.SearchCache
+	profiles PublicProfileService
+	tx       repository.TxManager
+	disabled []dto.PreferenceCategory
+}
+
+// NewPreferenceService creates a new PreferenceService. Updates that turn on marketing emails,
+// analytics tracking or data sharing are checked against the consent ledger in consents, and
+// minors in ages cannot make their profile visible; a nil ages applies no age restrictions.
+func NewPreferenceService(
+	repo repository.PreferenceRepository,
+	consents repository.ConsentRepository,
+	ages repository.AgeRepository,
+) *PreferenceServiceImpl {
+	return &PreferenceServiceImpl{repo: repo, consents: consents, ages: ages}
+}
+
+// SetSearchCache drops the cached user search results when privacy preferences change, since
+// they decide who is discoverable. Without it hidden users can show up until the results expire.
+func (s *PreferenceServiceImpl) SetSearchCache(cache repository.SearchCache) {
+	s.search = cache
 }
 
-// NewPreferenceService creates a new PreferenceService.
-func NewPreferenceService(repo repository.PreferenceRepository) *PreferenceServiceImpl {
-	return &PreferenceServiceImpl{repo: repo}
+// SetPublicProfiles drops a user's projected public profile when their privacy preferences
+// change, so anonymous viewers never see a profile its owner has since hidden.
+func (s *PreferenceServiceImpl) SetPublicProfiles(profiles PublicProfileService) {
+	s.profiles = profiles
+}
+
+// SetTxManager writes the categories of a full update, or a reset and the private profile it
+// keeps for a minor, in one transaction, so a failure part way through leaves none of them.
+func (s *PreferenceServiceImpl) SetTxManager(tx repository.TxManager) {
+	s.tx = tx
+}
+
+// SetDisabledCategories disables preference categories the deployment does not use. Reading,
+// updating or resetting one of them alone fails with ErrCategoryDisabled, and the bulk operations
+// leave them out.
+func (s *PreferenceServiceImpl) SetDisabledCategories(categories []dto.PreferenceCategory) {
+	s.disabled = categories
 }
 
 // GetAllPreferences retrieves all or filtered preferences for a user.
@@ -95,6 +157,8 @@ func (s *PreferenceServiceImpl) GetAllPreferences(
 		categoriesToFetch = categories
 	}
 
+	categoriesToFetch = s.enabledCategories(categoriesToFetch)
+
 	response := &dto.UserPreferencesResponse{UserID: targetUserID.String()}
 
 	for _, category := range categoriesToFetch {
@@ -132,6 +196,10 @@ func (s *PreferenceServiceImpl) GetCategoryPreferences(
 		return nil, ErrInvalidCategory
 	}
 
+	if slices.Contains(s.disabled, category) {
+		return nil, ErrCategoryDisabled
+	}
+
 	prefs, updatedAt, err := s.fetchSingleCategory(ctx, targetUserID, category)
 	if err != nil {
 		return nil, err
@@ -147,7 +215,7 @@ func (s *PreferenceServiceImpl) GetCategoryPreferences(
 
 // UpdateAllPreferences updates multiple preference categories.
 //
-//nolint:cyclop,funlen // Updating 9 categories sequentially is inherent to domain design.
+//nolint:cyclop,funlen // Updating 9 categories in one unit of work is inherent to domain design.
 func (s *PreferenceServiceImpl) UpdateAllPreferences(
 	ctx context.Context,
 	requesterID, targetUserID uuid.UUID,
@@ -168,62 +236,189 @@ func (s *PreferenceServiceImpl) UpdateAllPreferences(
 		return nil, ErrUserNotFound
 	}
 
-	response := &dto.UserPreferencesResponse{UserID: targetUserID.String()}
+	update = s.withoutDisabled(update)
 
-	err = s.updateNotificationIfPresent(ctx, targetUserID, update, response)
+	err = s.requireConsents(ctx, targetUserID, consentPurposes(update.Notification, update.Privacy))
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.updateDisplayIfPresent(ctx, targetUserID, update, response)
+	err = s.requireAdultToOpenProfile(ctx, targetUserID, update.Privacy)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.updatePrivacyIfPresent(ctx, targetUserID, update, response)
+	response := &dto.UserPreferencesResponse{UserID: targetUserID.String()}
+
+	err = withinTx(ctx, s.tx, func(ctx context.Context) error {
+		for _, updateIfPresent := range []func(
+			context.Context, uuid.UUID, *dto.UserPreferencesUpdateRequest, *dto.UserPreferencesResponse,
+		) error{
+			s.updateNotificationIfPresent,
+			s.updateDisplayIfPresent,
+			s.updatePrivacyIfPresent,
+			s.updateAccessibilityIfPresent,
+			s.updateLanguageIfPresent,
+			s.updateSecurityIfPresent,
+			s.updateSocialIfPresent,
+			s.updateSoundIfPresent,
+			s.updateThemeIfPresent,
+		} {
+			err := updateIfPresent(ctx, targetUserID, update, response)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.updateAccessibilityIfPresent(ctx, targetUserID, update, response)
+	if response.Privacy != nil {
+		s.privacyChanged(ctx, targetUserID)
+	}
+
+	if categories := updatedCategories(response); len(categories) > 0 {
+		logger.Events().PreferenceChanged(ctx, targetUserID, categories)
+	}
+
+	return response, nil
+}
+
+// updatedCategories names the categories a full preferences update wrote.
+func updatedCategories(response *dto.UserPreferencesResponse) []string {
+	var categories []string
+
+	for category, set := range map[dto.PreferenceCategory]bool{
+		dto.PreferenceCategoryNotification:  response.Notification != nil,
+		dto.PreferenceCategoryDisplay:       response.Display != nil,
+		dto.PreferenceCategoryPrivacy:       response.Privacy != nil,
+		dto.PreferenceCategoryAccessibility: response.Accessibility != nil,
+		dto.PreferenceCategoryLanguage:      response.Language != nil,
+		dto.PreferenceCategorySecurity:      response.Security != nil,
+		dto.PreferenceCategorySocial:        response.Social != nil,
+		dto.PreferenceCategorySound:         response.Sound != nil,
+		dto.PreferenceCategoryTheme:         response.Theme != nil,
+	} {
+		if set {
+			categories = append(categories, string(category))
+		}
+	}
+
+	slices.Sort(categories)
+
+	return categories
+}
+
+// UpdateCategoryPreferences updates a single preference category.
+func (s *PreferenceServiceImpl) UpdateCategoryPreferences(
+	ctx context.Context,
+	requesterID, targetUserID uuid.UUID,
+	category dto.PreferenceCategory,
+	update any,
+	isAdmin bool,
+	hasServiceScope bool,
+) (*dto.PreferenceCategoryResponse, error) {
+	if !s.canAccessPreferences(requesterID, targetUserID, isAdmin, hasServiceScope) {
+		return nil, ErrUnauthorizedAccess
+	}
+
+	exists, err := s.repo.UserExists(ctx, targetUserID)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to verify user: %w", err)
 	}
 
-	err = s.updateLanguageIfPresent(ctx, targetUserID, update, response)
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	if !dto.IsValidPreferenceCategory(string(category)) {
+		return nil, ErrInvalidCategory
+	}
+
+	if slices.Contains(s.disabled, category) {
+		return nil, ErrCategoryDisabled
+	}
+
+	err = s.requireConsents(ctx, targetUserID, consentPurposes(update))
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.updateSecurityIfPresent(ctx, targetUserID, update, response)
+	err = s.requireAdultToOpenProfile(ctx, targetUserID, update)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.updateSocialIfPresent(ctx, targetUserID, update, response)
+	prefs, updatedAt, err := s.updateSingleCategory(ctx, targetUserID, category, update)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.updateSoundIfPresent(ctx, targetUserID, update, response)
+	logger.Events().PreferenceChanged(ctx, targetUserID, []string{string(category)})
+
+	return &dto.PreferenceCategoryResponse{
+		UserID:      targetUserID.String(),
+		Category:    string(category),
+		Preferences: prefs,
+		UpdatedAt:   updatedAt,
+	}, nil
+}
+
+// ResetAllPreferences restores all or the given categories to their defaults and returns the
+// effective values.
+func (s *PreferenceServiceImpl) ResetAllPreferences(
+	ctx context.Context,
+	requesterID, targetUserID uuid.UUID,
+	categories []dto.PreferenceCategory,
+	isAdmin bool,
+	hasServiceScope bool,
+) (*dto.UserPreferencesResponse, error) {
+	if !s.canAccessPreferences(requesterID, targetUserID, isAdmin, hasServiceScope) {
+		return nil, ErrUnauthorizedAccess
+	}
+
+	exists, err := s.repo.UserExists(ctx, targetUserID)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to verify user: %w", err)
 	}
 
-	err = s.updateThemeIfPresent(ctx, targetUserID, update, response)
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	categoriesToReset := dto.ValidPreferenceCategories
+	if len(categories) > 0 {
+		categoriesToReset = categories
+	}
+
+	categoriesToReset = s.enabledCategories(categoriesToReset)
+
+	err = s.resetCategories(ctx, targetUserID, categoriesToReset)
 	if err != nil {
 		return nil, err
 	}
 
+	response := &dto.UserPreferencesResponse{UserID: targetUserID.String()}
+
+	for _, category := range categoriesToReset {
+		err = s.fetchCategory(ctx, targetUserID, category, response)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return response, nil
 }
 
-// UpdateCategoryPreferences updates a single preference category.
-func (s *PreferenceServiceImpl) UpdateCategoryPreferences(
+// ResetCategoryPreferences restores a single preference category to its defaults and returns the
+// effective values.
+func (s *PreferenceServiceImpl) ResetCategoryPreferences(
 	ctx context.Context,
 	requesterID, targetUserID uuid.UUID,
 	category dto.PreferenceCategory,
-	update any,
 	isAdmin bool,
 	hasServiceScope bool,
 ) (*dto.PreferenceCategoryResponse, error) {
@@ -244,7 +439,16 @@ func (s *PreferenceServiceImpl) UpdateCategoryPreferences(
 		return nil, ErrInvalidCategory
 	}
 
-	prefs, updatedAt, err := s.updateSingleCategory(ctx, targetUserID, category, update)
+	if slices.Contains(s.disabled, category) {
+		return nil, ErrCategoryDisabled
+	}
+
+	err = s.resetCategories(ctx, targetUserID, []dto.PreferenceCategory{category})
+	if err != nil {
+		return nil, err
+	}
+
+	prefs, updatedAt, err := s.fetchSingleCategory(ctx, targetUserID, category)
 	if err != nil {
 		return nil, err
 	}
@@ -259,6 +463,55 @@ func (s *PreferenceServiceImpl) UpdateCategoryPreferences(
 
 // --- Private methods below ---
 
+// enabledCategories returns categories without the disabled ones.
+func (s *PreferenceServiceImpl) enabledCategories(categories []dto.PreferenceCategory) []dto.PreferenceCategory {
+	if len(s.disabled) == 0 {
+		return categories
+	}
+
+	return slices.DeleteFunc(slices.Clone(categories), func(category dto.PreferenceCategory) bool {
+		return slices.Contains(s.disabled, category)
+	})
+}
+
+// withoutDisabled returns a copy of update that leaves the disabled categories untouched.
+//
+//nolint:cyclop // One check per category is inherent to domain design.
+func (s *PreferenceServiceImpl) withoutDisabled(
+	update *dto.UserPreferencesUpdateRequest,
+) *dto.UserPreferencesUpdateRequest {
+	if len(s.disabled) == 0 {
+		return update
+	}
+
+	filtered := *update
+
+	for _, category := range s.disabled {
+		switch category {
+		case dto.PreferenceCategoryNotification:
+			filtered.Notification = nil
+		case dto.PreferenceCategoryDisplay:
+			filtered.Display = nil
+		case dto.PreferenceCategoryPrivacy:
+			filtered.Privacy = nil
+		case dto.PreferenceCategoryAccessibility:
+			filtered.Accessibility = nil
+		case dto.PreferenceCategoryLanguage:
+			filtered.Language = nil
+		case dto.PreferenceCategorySecurity:
+			filtered.Security = nil
+		case dto.PreferenceCategorySocial:
+			filtered.Social = nil
+		case dto.PreferenceCategorySound:
+			filtered.Sound = nil
+		case dto.PreferenceCategoryTheme:
+			filtered.Theme = nil
+		}
+	}
+
+	return &filtered
+}
+
 func (s *PreferenceServiceImpl) canAccessPreferences(
 	requesterID, targetUserID uuid.UUID,
 	isAdmin bool,
@@ -279,6 +532,131 @@ func (s *PreferenceServiceImpl) canAccessPreferences(
 	return false
 }
 
+// consentPurposes returns the consent purposes of the preferences the updates turn on.
+func consentPurposes(updates ...any) []dto.ConsentPurpose {
+	var purposes []dto.ConsentPurpose
+
+	for _, update := range updates {
+		switch u := update.(type) {
+		case *dto.NotificationPreferencesUpdate:
+			if u != nil && isTrue(u.MarketingEmails) {
+				purposes = append(purposes, dto.ConsentPurposeMarketingEmails)
+			}
+		case *dto.PrivacyPreferencesUpdate:
+			if u != nil && isTrue(u.AnalyticsTracking) {
+				purposes = append(purposes, dto.ConsentPurposeAnalyticsTracking)
+			}
+
+			if u != nil && isTrue(u.DataSharing) {
+				purposes = append(purposes, dto.ConsentPurposeDataSharing)
+			}
+		}
+	}
+
+	return purposes
+}
+
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
+
+// requireConsents fails with ErrConsentRequired unless the latest consent decision for each
+// purpose is a grant.
+func (s *PreferenceServiceImpl) requireConsents(
+	ctx context.Context,
+	userID uuid.UUID,
+	purposes []dto.ConsentPurpose,
+) error {
+	if len(purposes) == 0 {
+		return nil
+	}
+
+	consents, err := s.consents.GetCurrentConsents(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch consents: %w", err)
+	}
+
+	for _, purpose := range purposes {
+		granted := slices.ContainsFunc(consents, func(c dto.ConsentRecord) bool {
+			return c.Purpose == purpose && c.Granted
+		})
+		if !granted {
+			return fmt.Errorf("%w: %s", ErrConsentRequired, purpose)
+		}
+	}
+
+	return nil
+}
+
+// requireAdultToOpenProfile fails with ErrAgeRestricted if a minor sets a profile visibility other
+// than private.
+func (s *PreferenceServiceImpl) requireAdultToOpenProfile(ctx context.Context, userID uuid.UUID, update any) error {
+	privacy, ok := update.(*dto.PrivacyPreferencesUpdate)
+	if !ok || privacy == nil || privacy.ProfileVisibility == nil ||
+		*privacy.ProfileVisibility == dto.ProfileVisibilityPrivate {
+		return nil
+	}
+
+	return requireAdult(ctx, s.ages, userID)
+}
+
+// resetCategories restores the categories to their defaults. The default profile visibility is
+// public, so a minor's reset privacy category keeps a private profile.
+func (s *PreferenceServiceImpl) resetCategories(
+	ctx context.Context,
+	userID uuid.UUID,
+	categories []dto.PreferenceCategory,
+) error {
+	minor := false
+
+	if slices.Contains(categories, dto.PreferenceCategoryPrivacy) {
+		err := requireAdult(ctx, s.ages, userID)
+		if err != nil && !errors.Is(err, ErrAgeRestricted) {
+			return err
+		}
+
+		minor = err != nil
+	}
+
+	err := withinTx(ctx, s.tx, func(ctx context.Context) error {
+		err := s.repo.ResetPreferences(ctx, userID, categories)
+		if err != nil {
+			return fmt.Errorf("failed to reset preferences: %w", err)
+		}
+
+		if !minor {
+			return nil
+		}
+
+		private := dto.ProfileVisibilityPrivate
+
+		_, err = s.repo.UpdatePrivacyPreferencesData(ctx, userID, &dto.PrivacyPreferencesUpdate{
+			ProfileVisibility: &private,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to keep profile private: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(categories, dto.PreferenceCategoryPrivacy) {
+		s.privacyChanged(ctx, userID)
+	}
+
+	return nil
+}
+
+// privacyChanged drops what was derived from a user's privacy preferences before they changed:
+// the cached search results and their projected public profile.
+func (s *PreferenceServiceImpl) privacyChanged(ctx context.Context, userID uuid.UUID) {
+	invalidateSearchResults(ctx, s.search)
+	invalidatePublicProfile(ctx, s.profiles, userID)
+}
+
 //nolint:cyclop // Switch over 9 categories is inherent to domain design.
 func (s *PreferenceServiceImpl) fetchCategory(
 	ctx context.Context,
@@ -407,6 +785,10 @@ func (s *PreferenceServiceImpl) updateSingleCategory(
 
 		p, e := s.repo.UpdatePrivacyPreferencesData(ctx, userID, u)
 		prefs, updatedAt, err = p, p.UpdatedAt, e
+
+		if e == nil {
+			s.privacyChanged(ctx, userID)
+		}
 	case dto.PreferenceCategoryAccessibility:
 		u, ok := update.(*dto.AccessibilityPreferencesUpdate)
 		if !ok {
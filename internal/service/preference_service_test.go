@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPreferenceService_DisabledCategories(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "chef"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	userID, _ := f.UserID("chef")
+
+	svc := service.NewPreferenceService(store, store, nil)
+	svc.SetDisabledCategories([]dto.PreferenceCategory{dto.PreferenceCategorySound, dto.PreferenceCategoryTheme})
+
+	t.Run("Single Category Routes", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := svc.GetCategoryPreferences(t.Context(), userID, userID, dto.PreferenceCategorySound, false, false)
+		require.ErrorIs(t, err, service.ErrCategoryDisabled)
+
+		_, err = svc.UpdateCategoryPreferences(t.Context(), userID, userID, dto.PreferenceCategoryTheme,
+			&dto.ThemePreferencesUpdate{}, false, false)
+		require.ErrorIs(t, err, service.ErrCategoryDisabled)
+
+		_, err = svc.ResetCategoryPreferences(t.Context(), userID, userID, dto.PreferenceCategorySound, false, false)
+		require.ErrorIs(t, err, service.ErrCategoryDisabled)
+
+		_, err = svc.GetCategoryPreferences(t.Context(), userID, userID, dto.PreferenceCategoryDisplay, false, false)
+		require.NoError(t, err)
+	})
+
+	t.Run("Bulk Reads Omit Them", func(t *testing.T) {
+		t.Parallel()
+
+		prefs, err := svc.GetAllPreferences(t.Context(), userID, userID, nil, false, false)
+		require.NoError(t, err)
+		assert.NotNil(t, prefs.Display)
+		assert.Nil(t, prefs.Sound)
+		assert.Nil(t, prefs.Theme)
+
+		prefs, err = svc.GetAllPreferences(t.Context(), userID, userID,
+			[]dto.PreferenceCategory{dto.PreferenceCategorySound}, false, false)
+		require.NoError(t, err)
+		assert.Nil(t, prefs.Sound, "asking for a disabled category returns nothing")
+	})
+
+	t.Run("Bulk Updates Skip Them", func(t *testing.T) {
+		t.Parallel()
+
+		muted := false
+
+		prefs, err := svc.UpdateAllPreferences(t.Context(), userID, userID, &dto.UserPreferencesUpdateRequest{
+			Sound: &dto.SoundPreferencesUpdate{NotificationSounds: &muted},
+		}, false, false)
+		require.NoError(t, err)
+		assert.Nil(t, prefs.Sound)
+
+		sound, err := store.GetSoundPreferences(t.Context(), userID)
+		require.NoError(t, err)
+		assert.True(t, sound.NotificationSounds, "the disabled category is left as it was")
+	})
+}
+
+func TestPreferenceService_UpdateAllPreferencesInOneTransaction(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	dark := true
+
+	prefs := mocks.NewPreferenceRepository(t)
+	tx := mocks.NewTxManager(t)
+
+	prefs.On("UserExists", mock.Anything, userID).Return(true, nil)
+	tx.On("WithinTx", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) },
+	).Once()
+	prefs.On("UpdateDisplayPreferences", mock.Anything, userID, mock.Anything).
+		Return(&dto.DisplayPreferences{}, nil).Once()
+	prefs.On("UpdateThemePreferences", mock.Anything, userID, mock.Anything).Return(nil, assert.AnError).Once()
+
+	svc := service.NewPreferenceService(prefs, mocks.NewConsentRepository(t), nil)
+	svc.SetTxManager(tx)
+
+	_, err := svc.UpdateAllPreferences(t.Context(), userID, userID, &dto.UserPreferencesUpdateRequest{
+		Display: &dto.DisplayPreferencesUpdate{},
+		Theme:   &dto.ThemePreferencesUpdate{DarkMode: &dark},
+	}, false, false)
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// Service-level errors for preference bundles.
+var (
+	// ErrPreferenceBundleInvalid is returned when a bundle's signature does not match its content.
+	ErrPreferenceBundleInvalid = errors.New("invalid preference bundle signature")
+	// ErrPreferenceBundleVersion is returned when a bundle was written with another schema version.
+	ErrPreferenceBundleVersion = errors.New("unsupported preference bundle schema version")
+)
+
+// PreferenceTransferService moves a user's preferences between their accounts as signed bundles.
+type PreferenceTransferService interface {
+	// ExportPreferences returns a signed bundle of all or the given categories of the user's
+	// effective preferences.
+	ExportPreferences(
+		ctx context.Context,
+		userID uuid.UUID,
+		categories []dto.PreferenceCategory,
+	) (*dto.PreferenceBundle, error)
+
+	// ImportPreferences applies a bundle exported by one of the user's accounts to the user's
+	// preferences and returns the updated categories.
+	ImportPreferences(
+		ctx context.Context,
+		userID uuid.UUID,
+		req *dto.PreferenceImportRequest,
+	) (*dto.UserPreferencesResponse, error)
+}
+
+// PreferenceTransferServiceImpl implements PreferenceTransferService.
+type PreferenceTransferServiceImpl struct {
+	preferences PreferenceService
+	signingKey  []byte
+}
+
+// NewPreferenceTransferService creates a new PreferenceTransferService. Bundles are read and
+// written through preferences, so imports go through the same consent and age checks as any
+// update, and are signed with signingKey.
+func NewPreferenceTransferService(preferences PreferenceService, signingKey []byte) *PreferenceTransferServiceImpl {
+	return &PreferenceTransferServiceImpl{preferences: preferences, signingKey: signingKey}
+}
+
+// ExportPreferences returns a signed bundle of all or the given categories of the user's
+// effective preferences. Categories never saved are exported with their default values.
+func (s *PreferenceTransferServiceImpl) ExportPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	categories []dto.PreferenceCategory,
+) (*dto.PreferenceBundle, error) {
+	prefs, err := s.preferences.GetAllPreferences(ctx, userID, userID, categories, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &dto.PreferenceBundle{
+		SchemaVersion: dto.PreferenceBundleSchemaVersion,
+		UserID:        userID.String(),
+		ExportedAt:    time.Now().UTC().Truncate(time.Second),
+	}
+
+	// The effective values and the update requests share their JSON field names
+	data, err := json.Marshal(prefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode preferences: %w", err)
+	}
+
+	err = json.Unmarshal(data, &bundle.Preferences)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode preferences: %w", err)
+	}
+
+	signature, err := s.sign(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle.Signature = base64.RawURLEncoding.EncodeToString(signature)
+
+	return bundle, nil
+}
+
+// ImportPreferences verifies the bundle's schema version and signature, then updates the
+// categories selected in req (all of the bundle's when none are) as a single preferences update.
+// The signature shows the bundle was exported by a signed-in user, which is what makes it theirs
+// to import into another account.
+func (s *PreferenceTransferServiceImpl) ImportPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	req *dto.PreferenceImportRequest,
+) (*dto.UserPreferencesResponse, error) {
+	bundle := req.Bundle
+
+	if bundle.SchemaVersion != dto.PreferenceBundleSchemaVersion {
+		return nil, fmt.Errorf("%w: %d", ErrPreferenceBundleVersion, bundle.SchemaVersion)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(bundle.Signature)
+	if err != nil {
+		return nil, ErrPreferenceBundleInvalid
+	}
+
+	expected, err := s.sign(&bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal(signature, expected) {
+		return nil, ErrPreferenceBundleInvalid
+	}
+
+	update := selectPreferenceCategories(&bundle.Preferences, req.Categories)
+
+	response, err := s.preferences.UpdateAllPreferences(ctx, userID, userID, update, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("security event", "event", "preference_bundle_imported", "user_id", userID,
+		"source_user_id", bundle.UserID)
+
+	return response, nil
+}
+
+// sign returns the HMAC-SHA256 of the bundle's JSON encoding without its signature.
+func (s *PreferenceTransferServiceImpl) sign(bundle *dto.PreferenceBundle) ([]byte, error) {
+	unsigned := *bundle
+	unsigned.Signature = ""
+
+	payload, err := json.Marshal(unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode preference bundle: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write(payload)
+
+	return mac.Sum(nil), nil
+}
+
+// selectPreferenceCategories returns the part of update covering categories, or all of it when no
+// categories are given.
+//
+//nolint:cyclop // Switch over 9 categories is inherent to domain design.
+func selectPreferenceCategories(
+	update *dto.UserPreferencesUpdateRequest,
+	categories []dto.PreferenceCategory,
+) *dto.UserPreferencesUpdateRequest {
+	if len(categories) == 0 {
+		return update
+	}
+
+	selected := &dto.UserPreferencesUpdateRequest{}
+
+	for _, category := range categories {
+		switch category {
+		case dto.PreferenceCategoryNotification:
+			selected.Notification = update.Notification
+		case dto.PreferenceCategoryDisplay:
+			selected.Display = update.Display
+		case dto.PreferenceCategoryPrivacy:
+			selected.Privacy = update.Privacy
+		case dto.PreferenceCategoryAccessibility:
+			selected.Accessibility = update.Accessibility
+		case dto.PreferenceCategoryLanguage:
+			selected.Language = update.Language
+		case dto.PreferenceCategorySecurity:
+			selected.Security = update.Security
+		case dto.PreferenceCategorySocial:
+			selected.Social = update.Social
+		case dto.PreferenceCategorySound:
+			selected.Sound = update.Sound
+		case dto.PreferenceCategoryTheme:
+			selected.Theme = update.Theme
+		}
+	}
+
+	return selected
+}
@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPreferenceTransferService(t *testing.T) {
+	t.Parallel()
+
+	source, target := uuid.New(), uuid.New()
+	key := []byte("test-key")
+
+	export := func(t *testing.T) *dto.PreferenceBundle {
+		t.Helper()
+
+		prefs := mocks.NewPreferenceService(t)
+		prefs.On("GetAllPreferences", mock.Anything, source, source, []dto.PreferenceCategory(nil), false, false).
+			Return(&dto.UserPreferencesResponse{
+				UserID:  source.String(),
+				Display: &dto.DisplayPreferences{FontSize: dto.FontSizeLarge, ShowImages: true},
+				Sound:   &dto.SoundPreferences{VolumeLevel: dto.VolumeLevelLow},
+			}, nil)
+
+		bundle, err := service.NewPreferenceTransferService(prefs, key).ExportPreferences(t.Context(), source, nil)
+		require.NoError(t, err)
+
+		return bundle
+	}
+
+	t.Run("exports the effective values as updates", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := export(t)
+
+		assert.Equal(t, dto.PreferenceBundleSchemaVersion, bundle.SchemaVersion)
+		assert.Equal(t, source.String(), bundle.UserID)
+		assert.NotEmpty(t, bundle.Signature)
+		require.NotNil(t, bundle.Preferences.Display)
+		assert.Equal(t, dto.FontSizeLarge, *bundle.Preferences.Display.FontSize)
+		assert.True(t, *bundle.Preferences.Display.ShowImages)
+		assert.Nil(t, bundle.Preferences.Notification)
+	})
+
+	t.Run("imports the selected categories into another account", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := export(t)
+
+		prefs := mocks.NewPreferenceService(t)
+		prefs.On("UpdateAllPreferences", mock.Anything, target, target,
+			&dto.UserPreferencesUpdateRequest{Sound: bundle.Preferences.Sound}, false, false).
+			Return(&dto.UserPreferencesResponse{UserID: target.String()}, nil)
+
+		response, err := service.NewPreferenceTransferService(prefs, key).ImportPreferences(t.Context(), target,
+			&dto.PreferenceImportRequest{Bundle: *bundle, Categories: []dto.PreferenceCategory{dto.PreferenceCategorySound}})
+		require.NoError(t, err)
+		assert.Equal(t, target.String(), response.UserID)
+	})
+
+	t.Run("rejects a modified bundle", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := export(t)
+		large := dto.FontSizeExtraLarge
+		bundle.Preferences.Display.FontSize = &large
+
+		_, err := service.NewPreferenceTransferService(mocks.NewPreferenceService(t), key).
+			ImportPreferences(t.Context(), target, &dto.PreferenceImportRequest{Bundle: *bundle})
+		require.ErrorIs(t, err, service.ErrPreferenceBundleInvalid)
+	})
+
+	t.Run("rejects a bundle signed with another key", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := export(t)
+
+		_, err := service.NewPreferenceTransferService(mocks.NewPreferenceService(t), []byte("other-key")).
+			ImportPreferences(t.Context(), target, &dto.PreferenceImportRequest{Bundle: *bundle})
+		require.ErrorIs(t, err, service.ErrPreferenceBundleInvalid)
+	})
+
+	t.Run("rejects other schema versions", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := export(t)
+		bundle.SchemaVersion = dto.PreferenceBundleSchemaVersion + 1
+
+		_, err := service.NewPreferenceTransferService(mocks.NewPreferenceService(t), key).
+			ImportPreferences(t.Context(), target, &dto.PreferenceImportRequest{Bundle: *bundle})
+		require.ErrorIs(t, err, service.ErrPreferenceBundleVersion)
+	})
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// Presence defaults, used when PresenceOptions leaves them unset.
+const (
+	DefaultPresenceOnlineWindow = 5 * time.Minute
+	DefaultPresenceRetention    = 30 * 24 * time.Hour
+)
+
+// maxPresenceChecks bounds the privacy checks run at once when attaching presence to a list.
+const maxPresenceChecks = 8
+
+// PresenceService tracks whether users are online from the heartbeats the gateway sends while
+// they use the apps. A user's presence is shown to the people who can see their activity, unless
+// they turned off the show last seen privacy preference.
+type PresenceService interface {
+	// Heartbeat records that the user is online now.
+	Heartbeat(ctx context.Context, userID uuid.UUID) error
+	// GetPresence returns the presence of a user as seen by requester, who may be anonymous.
+	GetPresence(ctx context.Context, requesterID *uuid.UUID, userID uuid.UUID) (*dto.UserPresenceResponse, error)
+	// AttachPresence sets the presence of each of the users as seen by requester.
+	AttachPresence(ctx context.Context, requesterID uuid.UUID, users []dto.User) error
+}
+
+// PresenceOptions configures presence.
+type PresenceOptions struct {
+	// OnlineWindow is how long after their last heartbeat a user is still online.
+	OnlineWindow time.Duration
+	// Retention is how long the last seen time is kept.
+	Retention time.Duration
+}
+
+// PresenceServiceImpl implements PresenceService.
+type PresenceServiceImpl struct {
+	store      repository.PresenceStore
+	userRepo   repository.UserRepository
+	socialRepo repository.SocialRepository
+	opts       PresenceOptions
+	now        func() time.Time
+}
+
+// NewPresenceService creates a new PresenceService.
+func NewPresenceService(
+	store repository.PresenceStore,
+	userRepo repository.UserRepository,
+	socialRepo repository.SocialRepository,
+	opts PresenceOptions,
+) *PresenceServiceImpl {
+	if opts.OnlineWindow <= 0 {
+		opts.OnlineWindow = DefaultPresenceOnlineWindow
+	}
+
+	if opts.Retention <= 0 {
+		opts.Retention = DefaultPresenceRetention
+	}
+
+	return &PresenceServiceImpl{
+		store:      store,
+		userRepo:   userRepo,
+		socialRepo: socialRepo,
+		opts:       opts,
+		now:        time.Now,
+	}
+}
+
+// Heartbeat records that the user is online now. The user is not looked up, so the gateway can
+// send a heartbeat with every request without a database read.
+func (s *PresenceServiceImpl) Heartbeat(ctx context.Context, userID uuid.UUID) error {
+	err := s.store.TouchPresence(ctx, userID, s.now(), s.opts.Retention)
+	if err != nil {
+		return fmt.Errorf("failed to record heartbeat: %w", err)
+	}
+
+	return nil
+}
+
+// GetPresence returns the presence of a user as seen by requester, who may be anonymous.
+func (s *PresenceServiceImpl) GetPresence(
+	ctx context.Context,
+	requesterID *uuid.UUID,
+	userID uuid.UUID,
+) (*dto.UserPresenceResponse, error) {
+	// 1. Verify user exists and is active
+	user, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	if !user.IsActive {
+		return nil, ErrUserNotFound
+	}
+
+	// 2. Check privacy settings
+	visible, err := s.presenceVisible(ctx, requesterID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.UserPresenceResponse{
+		UserID:   userID.String(),
+		Presence: dto.Presence{Status: dto.PresenceHidden},
+	}
+
+	if !visible {
+		return response, nil
+	}
+
+	// 3. Read the last heartbeat
+	lastSeen, err := s.store.GetLastSeen(ctx, []uuid.UUID{userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch presence: %w", err)
+	}
+
+	response.Presence = s.presence(lastSeen, userID)
+
+	return response, nil
+}
+
+// AttachPresence sets the presence of each of the users as seen by requester. The privacy checks
+// run concurrently, so the request-scoped loaders batch their preference lookups.
+func (s *PresenceServiceImpl) AttachPresence(ctx context.Context, requesterID uuid.UUID, users []dto.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(users))
+
+	for i := range users {
+		id, err := uuid.Parse(users[i].UserID)
+		if err != nil {
+			return fmt.Errorf("invalid user ID %q: %w", users[i].UserID, err)
+		}
+
+		ids[i] = id
+	}
+
+	visible := make([]bool, len(users))
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(maxPresenceChecks)
+
+	for i, id := range ids {
+		group.Go(func() error {
+			var err error
+
+			visible[i], err = s.presenceVisible(groupCtx, &requesterID, id)
+
+			return err
+		})
+	}
+
+	err := group.Wait()
+	if err != nil {
+		return err
+	}
+
+	lastSeen, err := s.store.GetLastSeen(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to fetch presence: %w", err)
+	}
+
+	for i := range users {
+		presence := dto.Presence{Status: dto.PresenceHidden}
+		if visible[i] {
+			presence = s.presence(lastSeen, ids[i])
+		}
+
+		users[i].Presence = &presence
+	}
+
+	return nil
+}
+
+// presenceVisible checks if requester can see the presence of a user: always their own, and
+// otherwise that of users who show when they were last seen and whose activity requester can see.
+func (s *PresenceServiceImpl) presenceVisible(
+	ctx context.Context,
+	requesterID *uuid.UUID,
+	userID uuid.UUID,
+) (bool, error) {
+	if requesterID != nil && *requesterID == userID {
+		return true, nil
+	}
+
+	privacy, err := s.userRepo.FindPrivacyPreferencesByUserID(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	if !privacy.ShowLastSeen {
+		return false, nil
+	}
+
+	return activityVisible(ctx, s.userRepo, s.socialRepo, privacy, requesterID, userID)
+}
+
+// presence derives the presence of a user from their last heartbeat, if any.
+func (s *PresenceServiceImpl) presence(lastSeen map[uuid.UUID]time.Time, userID uuid.UUID) dto.Presence {
+	seen, ok := lastSeen[userID]
+	if !ok {
+		return dto.Presence{Status: dto.PresenceOffline}
+	}
+
+	status := dto.PresenceOffline
+	if s.now().Sub(seen) < s.opts.OnlineWindow {
+		status = dto.PresenceOnline
+	}
+
+	return dto.Presence{Status: status, LastSeenAt: &seen}
+}
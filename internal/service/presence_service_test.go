@@ -0,0 +1,181 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+var presenceOpts = service.PresenceOptions{OnlineWindow: 5 * time.Minute, Retention: 24 * time.Hour}
+
+func publicPresencePrivacy(showLastSeen bool) *dto.UserPrivacyPreferences {
+	return &dto.UserPrivacyPreferences{
+		ProfileVisibility:  dto.ProfileVisibilityPublic,
+		ActivityVisibility: dto.ActivityVisibilityPublic,
+		ShowLastSeen:       showLastSeen,
+	}
+}
+
+func TestPresenceService_Heartbeat(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	store := mocks.NewPresenceStore(t)
+	store.On("TouchPresence", mock.Anything, userID, mock.AnythingOfType("time.Time"), 24*time.Hour).Return(nil)
+
+	svc := service.NewPresenceService(store, mocks.NewUserRepository(t), mocks.NewSocialRepository(t), presenceOpts)
+
+	require.NoError(t, svc.Heartbeat(t.Context(), userID))
+}
+
+func TestPresenceService_GetPresence(t *testing.T) {
+	t.Parallel()
+
+	userID, requesterID := uuid.New(), uuid.New()
+	active := &dto.User{UserID: userID.String(), IsActive: true}
+
+	tests := []struct {
+		name       string
+		requester  *uuid.UUID
+		privacy    *dto.UserPrivacyPreferences
+		lastSeen   time.Duration
+		seen       bool
+		wantStatus dto.PresenceStatus
+	}{
+		{
+			name:       "recent heartbeat is online",
+			requester:  &requesterID,
+			privacy:    publicPresencePrivacy(true),
+			lastSeen:   time.Minute,
+			seen:       true,
+			wantStatus: dto.PresenceOnline,
+		},
+		{
+			name:       "old heartbeat is offline",
+			requester:  nil,
+			privacy:    publicPresencePrivacy(true),
+			lastSeen:   time.Hour,
+			seen:       true,
+			wantStatus: dto.PresenceOffline,
+		},
+		{
+			name:       "never seen is offline",
+			requester:  &requesterID,
+			privacy:    publicPresencePrivacy(true),
+			wantStatus: dto.PresenceOffline,
+		},
+		{
+			name:       "show last seen off is hidden",
+			requester:  &requesterID,
+			privacy:    publicPresencePrivacy(false),
+			wantStatus: dto.PresenceHidden,
+		},
+		{
+			name:      "private activity is hidden",
+			requester: &requesterID,
+			privacy: &dto.UserPrivacyPreferences{
+				ProfileVisibility:  dto.ProfileVisibilityPublic,
+				ActivityVisibility: dto.ActivityVisibilityPrivate,
+				ShowLastSeen:       true,
+			},
+			wantStatus: dto.PresenceHidden,
+		},
+		{
+			name:       "own presence is never hidden",
+			requester:  &userID,
+			lastSeen:   time.Minute,
+			seen:       true,
+			wantStatus: dto.PresenceOnline,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := mocks.NewPresenceStore(t)
+			userRepo := mocks.NewUserRepository(t)
+
+			userRepo.On("FindUserByID", mock.Anything, userID).Return(active, nil)
+
+			if tt.privacy != nil {
+				userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).Return(tt.privacy, nil)
+			}
+
+			if tt.wantStatus != dto.PresenceHidden {
+				lastSeen := map[uuid.UUID]time.Time{}
+				if tt.seen {
+					lastSeen[userID] = time.Now().Add(-tt.lastSeen)
+				}
+
+				store.On("GetLastSeen", mock.Anything, []uuid.UUID{userID}).Return(lastSeen, nil)
+			}
+
+			svc := service.NewPresenceService(store, userRepo, mocks.NewSocialRepository(t), presenceOpts)
+
+			presence, err := svc.GetPresence(t.Context(), tt.requester, userID)
+			require.NoError(t, err)
+			assert.Equal(t, userID.String(), presence.UserID)
+			assert.Equal(t, tt.wantStatus, presence.Status)
+			assert.Equal(t, tt.seen, presence.LastSeenAt != nil)
+		})
+	}
+}
+
+func TestPresenceService_GetPresence_InactiveUser(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	userRepo := mocks.NewUserRepository(t)
+	userRepo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+
+	svc := service.NewPresenceService(mocks.NewPresenceStore(t), userRepo, mocks.NewSocialRepository(t), presenceOpts)
+
+	_, err := svc.GetPresence(t.Context(), nil, userID)
+	require.ErrorIs(t, err, service.ErrUserNotFound)
+}
+
+func TestPresenceService_AttachPresence(t *testing.T) {
+	t.Parallel()
+
+	requesterID, followed, stranger, hidden := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	seen := time.Now().Add(-time.Minute)
+
+	store := mocks.NewPresenceStore(t)
+	userRepo := mocks.NewUserRepository(t)
+	socialRepo := mocks.NewSocialRepository(t)
+
+	friendsOnly := &dto.UserPrivacyPreferences{
+		ProfileVisibility:  dto.ProfileVisibilityPublic,
+		ActivityVisibility: dto.ActivityVisibilityFriendsOnly,
+		ShowLastSeen:       true,
+	}
+
+	userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, followed).Return(friendsOnly, nil)
+	userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, stranger).Return(friendsOnly, nil)
+	userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, hidden).Return(publicPresencePrivacy(false), nil)
+	userRepo.On("IsFollowing", mock.Anything, requesterID, followed).Return(true, nil)
+	userRepo.On("IsFollowing", mock.Anything, requesterID, stranger).Return(false, nil)
+	store.On("GetLastSeen", mock.Anything, []uuid.UUID{followed, stranger, hidden}).
+		Return(map[uuid.UUID]time.Time{followed: seen, stranger: seen, hidden: seen}, nil)
+
+	users := []dto.User{{UserID: followed.String()}, {UserID: stranger.String()}, {UserID: hidden.String()}}
+
+	svc := service.NewPresenceService(store, userRepo, socialRepo, presenceOpts)
+
+	require.NoError(t, svc.AttachPresence(t.Context(), requesterID, users))
+
+	require.NotNil(t, users[0].Presence)
+	assert.Equal(t, dto.PresenceOnline, users[0].Presence.Status)
+	assert.True(t, seen.Equal(*users[0].Presence.LastSeenAt))
+	assert.Equal(t, &dto.Presence{Status: dto.PresenceHidden}, users[1].Presence, "followers only activity")
+	assert.Equal(t, &dto.Presence{Status: dto.PresenceHidden}, users[2].Presence, "show last seen off")
+}
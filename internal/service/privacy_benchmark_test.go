@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+const benchmarkFollowers = 1000
+
+// privacyBenchmarkStore seeds an in-memory store with a public and a private user, each followed by
+// benchmarkFollowers users, so the benchmarks measure the privacy path rather than a mock framework.
+func privacyBenchmarkStore(b *testing.B) (*memory.Store, map[string]uuid.UUID) {
+	b.Helper()
+
+	f := &fixtures.Fixtures{}
+
+	for _, name := range []string{"open", "closed"} {
+		visibility := dto.ProfileVisibilityPublic
+		if name == "closed" {
+			visibility = dto.ProfileVisibilityPrivate
+		}
+
+		f.Users = append(f.Users, fixtures.User{
+			Username: name,
+			Preferences: &dto.UserPreferencesUpdateRequest{
+				Privacy: &dto.PrivacyPreferencesUpdate{ProfileVisibility: &visibility},
+			},
+		})
+	}
+
+	for i := range benchmarkFollowers {
+		follower := fmt.Sprintf("follower_%d", i)
+		f.Users = append(f.Users, fixtures.User{Username: follower})
+		f.Follows = append(f.Follows,
+			fixtures.Follow{Follower: follower, Followee: "open"},
+			fixtures.Follow{Follower: follower, Followee: "closed"},
+		)
+	}
+
+	store, err := memory.NewFromFixtures(b.Context(), f)
+	if err != nil {
+		b.Fatalf("failed to seed store: %v", err)
+	}
+
+	ids := make(map[string]uuid.UUID)
+	for _, name := range []string{"open", "closed", "follower_0"} {
+		ids[name], _ = f.UserID(name)
+	}
+
+	return store, ids
+}
+
+func BenchmarkSocialService_GetFollowers(b *testing.B) {
+	store, ids := privacyBenchmarkStore(b)
+	svc := service.NewSocialService(store, store, nil)
+
+	cases := []struct {
+		name      string
+		requester uuid.UUID
+		target    uuid.UUID
+	}{
+		{name: "public", requester: ids["follower_0"], target: ids["open"]},
+		{name: "self", requester: ids["closed"], target: ids["closed"]},
+		{name: "denied", requester: ids["follower_0"], target: ids["closed"]},
+	}
+
+	for _, tc := range cases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for b.Loop() {
+				_, _ = svc.GetFollowers(b.Context(), tc.requester, tc.target, 100, 0, false)
+			}
+		})
+	}
+}
+
+func BenchmarkUserService_GetUserProfile(b *testing.B) {
+	store, ids := privacyBenchmarkStore(b)
+	svc := service.NewUserService(store, store, nil, nil)
+
+	cases := []struct {
+		name      string
+		requester uuid.UUID
+		target    uuid.UUID
+	}{
+		{name: "public", requester: ids["follower_0"], target: ids["open"]},
+		{name: "self", requester: ids["closed"], target: ids["closed"]},
+		{name: "denied", requester: ids["follower_0"], target: ids["closed"]},
+	}
+
+	for _, tc := range cases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for b.Loop() {
+				_, _ = svc.GetUserProfile(b.Context(), tc.requester, tc.target)
+			}
+		})
+	}
+}
+
+// countingUserRepository counts the profile lookups that reach the store and delays each by
+// latency, standing in for a database round trip.
+type countingUserRepository struct {
+	repository.UserRepository
+
+	latency time.Duration
+	queries atomic.Int64
+}
+
+func (r *countingUserRepository) FindUserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
+	r.queries.Add(1)
+	time.Sleep(r.latency)
+
+	return r.UserRepository.FindUserByID(ctx, userID) //nolint:wrapcheck // test double
+}
+
+func (r *countingUserRepository) FindPrivacyPreferencesByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.UserPrivacyPreferences, error) {
+	r.queries.Add(1)
+	time.Sleep(r.latency)
+
+	return r.UserRepository.FindPrivacyPreferencesByUserID(ctx, userID) //nolint:wrapcheck // test double
+}
+
+// BenchmarkUserService_GetUserProfile_Concurrent views one popular profile from many goroutines
+// and reports the lookups reaching the store per view: without shared reads it would be 2.
+func BenchmarkUserService_GetUserProfile_Concurrent(b *testing.B) {
+	store, ids := privacyBenchmarkStore(b)
+	repo := &countingUserRepository{UserRepository: store, latency: time.Millisecond}
+	svc := service.NewUserService(repo, store, nil, nil)
+
+	b.SetParallelism(64)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = svc.GetUserProfile(b.Context(), ids["follower_0"], ids["open"])
+		}
+	})
+
+	b.ReportMetric(float64(repo.queries.Load())/float64(b.N), "queries/op")
+}
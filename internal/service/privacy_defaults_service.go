@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/notification"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// ErrUnknownPrivacyDefaultsVersion is returned when a migration names a defaults version that
+// does not exist.
+var ErrUnknownPrivacyDefaultsVersion = errors.New("unknown privacy defaults version")
+
+// PrivacyDefaultsService reports and migrates the privacy defaults versions users are on.
+type PrivacyDefaultsService interface {
+	// GetPrivacyDefaults returns the version new users get and how many users are on each version.
+	GetPrivacyDefaults(ctx context.Context) (*dto.PrivacyDefaultsStatusResponse, error)
+	// MigratePrivacyDefaults moves a batch of users from one defaults version to another.
+	MigratePrivacyDefaults(
+		ctx context.Context,
+		actorID uuid.UUID,
+		req *dto.PrivacyDefaultsMigrationRequest,
+	) (*dto.PrivacyDefaultsMigrationResponse, error)
+}
+
+// PrivacyDefaultsServiceImpl implements PrivacyDefaultsService.
+// New users are provisioned under the configured version the first time their privacy
+// preferences are read or written, and keep it until an admin migrates their cohort.
+type PrivacyDefaultsServiceImpl struct {
+	repo               repository.PrivacyDefaultsRepository
+	version            int
+	notificationClient notification.Client
+}
+
+// NewPrivacyDefaultsService creates a new PrivacyDefaultsService for the version new users are
+// provisioned under.
+func NewPrivacyDefaultsService(
+	repo repository.PrivacyDefaultsRepository,
+	version int,
+	notificationClient notification.Client,
+) *PrivacyDefaultsServiceImpl {
+	return &PrivacyDefaultsServiceImpl{
+		repo:               repo,
+		version:            version,
+		notificationClient: notificationClient,
+	}
+}
+
+// GetPrivacyDefaults returns the version new users get and how many users are on each version.
+// Users not provisioned yet are counted under version 0.
+func (s *PrivacyDefaultsServiceImpl) GetPrivacyDefaults(
+	ctx context.Context,
+) (*dto.PrivacyDefaultsStatusResponse, error) {
+	cohorts, err := s.repo.CountPrivacyDefaultsVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count privacy defaults versions: %w", err)
+	}
+
+	return &dto.PrivacyDefaultsStatusResponse{
+		CurrentVersion: s.version,
+		LatestVersion:  dto.LatestPrivacyDefaultsVersion,
+		Cohorts:        cohorts,
+	}, nil
+}
+
+// MigratePrivacyDefaults moves up to req.Limit users from one defaults version to another. Users
+// who never changed the old version's defaults get the new version's, and are notified unless
+// req.SkipNotifications is set; users who saved their own choices keep them. Run it again until
+// nothing remains to move the whole cohort.
+func (s *PrivacyDefaultsServiceImpl) MigratePrivacyDefaults(
+	ctx context.Context,
+	actorID uuid.UUID,
+	req *dto.PrivacyDefaultsMigrationRequest,
+) (*dto.PrivacyDefaultsMigrationResponse, error) {
+	// 1. Check both versions exist
+	for _, version := range []int{req.FromVersion, req.ToVersion} {
+		if version < 1 || version > dto.LatestPrivacyDefaultsVersion {
+			return nil, fmt.Errorf("%w: %d", ErrUnknownPrivacyDefaultsVersion, version)
+		}
+	}
+
+	// 2. Move a batch of the cohort
+	moves, err := s.repo.MovePrivacyDefaults(ctx, req.FromVersion, req.ToVersion, req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate privacy defaults: %w", err)
+	}
+
+	response := &dto.PrivacyDefaultsMigrationResponse{
+		FromVersion: req.FromVersion,
+		ToVersion:   req.ToVersion,
+		Moved:       len(moves),
+	}
+
+	var changed []uuid.UUID
+
+	for _, move := range moves {
+		if move.Changed {
+			changed = append(changed, move.UserID)
+		}
+	}
+
+	response.Changed = len(changed)
+
+	// 3. Tell the users whose preferences changed
+	if len(changed) > 0 && !req.SkipNotifications && s.notificationClient != nil {
+		go s.notificationClient.NotifyPrivacyDefaultsChanged( //nolint:contextcheck
+			context.Background(), changed, req.ToVersion)
+
+		response.Notified = len(changed)
+	}
+
+	// 4. Count what is left of the cohort
+	cohorts, err := s.repo.CountPrivacyDefaultsVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count privacy defaults versions: %w", err)
+	}
+
+	for _, cohort := range cohorts {
+		if cohort.Version == req.FromVersion {
+			response.Remaining = cohort.Users
+		}
+	}
+
+	slog.Info("security event", "event", "privacy_defaults_migrated", "actor_id", actorID,
+		"from_version", req.FromVersion, "to_version", req.ToVersion,
+		"moved", response.Moved, "changed", response.Changed, "notified", response.Notified)
+
+	return response, nil
+}
@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPrivacyDefaultsService(t *testing.T) {
+	t.Parallel()
+
+	cohorts := []dto.PrivacyDefaultsCohort{{Version: 0, Users: 4}, {Version: 1, Users: 10}, {Version: 2, Users: 3}}
+
+	t.Run("reports the cohorts", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewPrivacyDefaultsRepository(t)
+		repo.On("CountPrivacyDefaultsVersions", mock.Anything).Return(cohorts, nil)
+
+		status, err := service.NewPrivacyDefaultsService(repo, 2, nil).GetPrivacyDefaults(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, &dto.PrivacyDefaultsStatusResponse{
+			CurrentVersion: 2,
+			LatestVersion:  dto.LatestPrivacyDefaultsVersion,
+			Cohorts:        cohorts,
+		}, status)
+	})
+
+	t.Run("migrates a batch and counts what remains", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewPrivacyDefaultsRepository(t)
+		repo.On("MovePrivacyDefaults", mock.Anything, 1, 2, 2).Return([]repository.PrivacyDefaultsMove{
+			{UserID: uuid.New(), Changed: true},
+			{UserID: uuid.New()},
+		}, nil)
+		repo.On("CountPrivacyDefaultsVersions", mock.Anything).Return(cohorts, nil)
+
+		response, err := service.NewPrivacyDefaultsService(repo, 2, nil).MigratePrivacyDefaults(t.Context(),
+			uuid.New(), &dto.PrivacyDefaultsMigrationRequest{FromVersion: 1, ToVersion: 2, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, &dto.PrivacyDefaultsMigrationResponse{
+			FromVersion: 1,
+			ToVersion:   2,
+			Moved:       2,
+			Changed:     1,
+			Remaining:   10,
+		}, response)
+	})
+
+	t.Run("rejects unknown versions", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := service.NewPrivacyDefaultsService(mocks.NewPrivacyDefaultsRepository(t), 1, nil).
+			MigratePrivacyDefaults(t.Context(), uuid.New(),
+				&dto.PrivacyDefaultsMigrationRequest{FromVersion: 1, ToVersion: 9, Limit: 10})
+		require.ErrorIs(t, err, service.ErrUnknownPrivacyDefaultsVersion)
+	})
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// PrivacyReportService builds the data transparency report users request about themselves.
+type PrivacyReportService interface {
+	GetPrivacyReport(ctx context.Context, userID uuid.UUID) (*dto.PrivacyReportResponse, error)
+}
+
+// Plain-language descriptions of each data category in the privacy report.
+const (
+	profileDescription     = "Your account and the profile details you entered."
+	socialGraphDescription = "The people you follow and the people who follow you."
+	activityDescription    = "Your recent recipes, reviews and favorites. They are stored by the recipe " +
+		"service and only combined here when someone views your activity."
+	preferencesDescription = "Settings you changed from their defaults."
+
+	socialGraphRetention = "Kept until you or the other person ends the follow, or an account is deleted. " +
+		"The people you follow can see when you followed or unfollowed them until that history expires."
+	activityRetention    = "Not stored by this service."
+	preferencesRetention = "Kept while your account exists."
+)
+
+// profileRetention explains how long profile data outlives deactivation.
+var profileRetention = fmt.Sprintf(
+	"Kept while your account exists. After you deactivate it, your username and email stay "+
+		"reserved for %d days so nobody else can claim them.",
+	int(repository.IdentifierRetentionPeriod/(24*time.Hour)),
+)
+
+// PrivacyReportServiceImpl implements PrivacyReportService.
+type PrivacyReportServiceImpl struct {
+	users   repository.UserRepository
+	social  repository.SocialRepository
+	changes repository.ChangeLogRepository
+	access  repository.DataAccessRepository
+}
+
+// NewPrivacyReportService creates a new PrivacyReportService. Without a data access repository
+// the report lists no consumers.
+func NewPrivacyReportService(
+	users repository.UserRepository,
+	social repository.SocialRepository,
+	changes repository.ChangeLogRepository,
+	access repository.DataAccessRepository,
+) *PrivacyReportServiceImpl {
+	return &PrivacyReportServiceImpl{users: users, social: social, changes: changes, access: access}
+}
+
+// GetPrivacyReport summarizes the data kept about userID, when it last changed, who can see it
+// and which services have read it.
+func (s *PrivacyReportServiceImpl) GetPrivacyReport(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.PrivacyReportResponse, error) {
+	user, err := s.users.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	privacy, err := s.users.FindPrivacyPreferencesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	social, err := s.socialGraphCategory(ctx, userID, privacy)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.preferencesCategory(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := []dto.PrivacyReportCategory{
+		profileCategory(user, privacy),
+		social,
+		{
+			Category:    dto.DataCategoryActivity,
+			Description: activityDescription,
+			Items:       []string{},
+			Visibility:  dto.ProfileVisibility(privacy.EffectiveActivityVisibility()),
+			Retention:   activityRetention,
+		},
+		preferences,
+	}
+
+	err = s.attachConsumers(ctx, userID, categories)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PrivacyReportResponse{
+		UserID:          userID.String(),
+		GeneratedAt:     time.Now().UTC(),
+		Categories:      categories,
+		PrivacySettings: privacy,
+	}, nil
+}
+
+func profileCategory(user *dto.User, privacy *dto.UserPrivacyPreferences) dto.PrivacyReportCategory {
+	items := []string{"Username"}
+
+	if user.Email != nil {
+		items = append(items, "Email address")
+	}
+
+	if user.FullName != nil {
+		items = append(items, "Full name")
+	}
+
+	if user.Bio != nil {
+		items = append(items, "Bio")
+	}
+
+	if user.Country != nil {
+		items = append(items, "Location")
+	}
+
+	updatedAt := user.UpdatedAt
+
+	return dto.PrivacyReportCategory{
+		Category:      dto.DataCategoryProfile,
+		Description:   profileDescription,
+		Items:         items,
+		Visibility:    privacy.ProfileVisibility,
+		LastUpdatedAt: &updatedAt,
+		Retention:     profileRetention,
+	}
+}
+
+func (s *PrivacyReportServiceImpl) socialGraphCategory(
+	ctx context.Context,
+	userID uuid.UUID,
+	privacy *dto.UserPrivacyPreferences,
+) (dto.PrivacyReportCategory, error) {
+	_, following, err := s.social.GetFollowing(ctx, userID, 1, 0)
+	if err != nil {
+		return dto.PrivacyReportCategory{}, fmt.Errorf("failed to count following: %w", err)
+	}
+
+	_, followers, err := s.social.GetFollowers(ctx, userID, 1, 0)
+	if err != nil {
+		return dto.PrivacyReportCategory{}, fmt.Errorf("failed to count followers: %w", err)
+	}
+
+	return dto.PrivacyReportCategory{
+		Category:    dto.DataCategorySocialGraph,
+		Description: socialGraphDescription,
+		Items: []string{
+			"You follow " + people(following),
+			"Followed by " + people(followers),
+		},
+		Visibility: privacy.ProfileVisibility,
+		Retention:  socialGraphRetention,
+	}, nil
+}
+
+// people phrases a follow count for the report.
+func people(count int) string {
+	if count == 1 {
+		return "1 person"
+	}
+
+	return fmt.Sprintf("%d people", count)
+}
+
+// preferencesCategory lists the preference categories the change log shows were saved.
+func (s *PrivacyReportServiceImpl) preferencesCategory(
+	ctx context.Context,
+	userID uuid.UUID,
+) (dto.PrivacyReportCategory, error) {
+	changes, err := s.changes.GetLatestChangesForUser(ctx, userID)
+	if err != nil {
+		return dto.PrivacyReportCategory{}, fmt.Errorf("failed to fetch user changes: %w", err)
+	}
+
+	changedAt := make(map[dto.PreferenceCategory]time.Time)
+
+	var lastUpdatedAt *time.Time
+
+	for _, change := range changes {
+		if change.ChangeType != dto.UserChangeTypePreferenceChanged || change.Category == nil {
+			continue
+		}
+
+		changedAt[dto.PreferenceCategory(*change.Category)] = change.ChangedAt
+
+		if lastUpdatedAt == nil || change.ChangedAt.After(*lastUpdatedAt) {
+			lastUpdatedAt = &change.ChangedAt
+		}
+	}
+
+	items := []string{}
+
+	for _, category := range dto.ValidPreferenceCategories {
+		if _, ok := changedAt[category]; ok {
+			name := string(category)
+			items = append(items, strings.ToUpper(name[:1])+name[1:]+" preferences")
+		}
+	}
+
+	return dto.PrivacyReportCategory{
+		Category:      dto.DataCategoryPreferences,
+		Description:   preferencesDescription,
+		Items:         items,
+		Visibility:    dto.ProfileVisibilityPrivate,
+		LastUpdatedAt: lastUpdatedAt,
+		Retention:     preferencesRetention,
+	}, nil
+}
+
+func (s *PrivacyReportServiceImpl) attachConsumers(
+	ctx context.Context,
+	userID uuid.UUID,
+	categories []dto.PrivacyReportCategory,
+) error {
+	var consumers []dto.DataConsumer
+
+	if s.access != nil {
+		var err error
+
+		consumers, err = s.access.GetDataConsumers(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch data consumers: %w", err)
+		}
+	}
+
+	for i := range categories {
+		categories[i].ConsumedBy = []dto.DataConsumer{}
+
+		for _, consumer := range consumers {
+			if consumer.Category == categories[i].Category {
+				categories[i].ConsumedBy = append(categories[i].ConsumedBy, consumer)
+			}
+		}
+	}
+
+	return nil
+}
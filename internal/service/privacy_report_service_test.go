@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPrivacyReportService_GetPrivacyReport(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	email := "alice@example.com"
+	updatedAt := time.Date(2026, time.October, 1, 9, 0, 0, 0, time.UTC)
+	themeChangedAt := updatedAt.Add(time.Hour)
+	privacyChangedAt := updatedAt.Add(2 * time.Hour)
+	theme, privacyCategory := "theme", "privacy"
+
+	users := mocks.NewUserRepository(t)
+	social := mocks.NewSocialRepository(t)
+	changes := mocks.NewChangeLogRepository(t)
+	access := mocks.NewDataAccessRepository(t)
+
+	privacy := &dto.UserPrivacyPreferences{
+		ProfileVisibility:  dto.ProfileVisibilityFriendsOnly,
+		ActivityVisibility: dto.ActivityVisibilityPrivate,
+	}
+
+	users.On("FindUserByID", mock.Anything, userID).
+		Return(&dto.User{UserID: userID.String(), Username: "alice", Email: &email, UpdatedAt: updatedAt}, nil)
+	users.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).Return(privacy, nil)
+	social.On("GetFollowing", mock.Anything, userID, 1, 0).Return([]dto.User{}, 3, nil)
+	social.On("GetFollowers", mock.Anything, userID, 1, 0).Return([]dto.User{}, 2, nil)
+	changes.On("GetLatestChangesForUser", mock.Anything, userID).Return([]dto.UserChange{
+		{ChangeType: dto.UserChangeTypeUpsert, ChangedAt: updatedAt},
+		{ChangeType: dto.UserChangeTypePreferenceChanged, Category: &theme, ChangedAt: themeChangedAt},
+		{ChangeType: dto.UserChangeTypePreferenceChanged, Category: &privacyCategory, ChangedAt: privacyChangedAt},
+	}, nil)
+
+	consumer := dto.DataConsumer{
+		ClientID:       "meal-planner",
+		Category:       dto.DataCategoryPreferences,
+		AccessCount:    4,
+		LastAccessedAt: privacyChangedAt,
+	}
+	access.On("GetDataConsumers", mock.Anything, userID).Return([]dto.DataConsumer{consumer}, nil)
+
+	svc := service.NewPrivacyReportService(users, social, changes, access)
+
+	report, err := svc.GetPrivacyReport(t.Context(), userID)
+	require.NoError(t, err)
+
+	assert.Equal(t, userID.String(), report.UserID)
+	assert.Same(t, privacy, report.PrivacySettings)
+	require.Len(t, report.Categories, 4)
+
+	profile, socialGraph, activity, preferences := report.Categories[0], report.Categories[1],
+		report.Categories[2], report.Categories[3]
+
+	assert.Equal(t, dto.DataCategoryProfile, profile.Category)
+	assert.Equal(t, []string{"Username", "Email address"}, profile.Items)
+	assert.Equal(t, dto.ProfileVisibilityFriendsOnly, profile.Visibility)
+	assert.Equal(t, &updatedAt, profile.LastUpdatedAt)
+	assert.Contains(t, profile.Retention, "90 days")
+	assert.Empty(t, profile.ConsumedBy)
+
+	assert.Equal(t, []string{"You follow 3 people", "Followed by 2 people"}, socialGraph.Items)
+
+	assert.Equal(t, dto.ProfileVisibilityPrivate, activity.Visibility)
+	assert.Empty(t, activity.Items)
+
+	assert.Equal(t, []string{"Privacy preferences", "Theme preferences"}, preferences.Items)
+	assert.Equal(t, &privacyChangedAt, preferences.LastUpdatedAt)
+	assert.Equal(t, []dto.DataConsumer{consumer}, preferences.ConsumedBy)
+}
+
+func TestPrivacyReportService_GetPrivacyReport_UserNotFound(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	users := mocks.NewUserRepository(t)
+	users.On("FindUserByID", mock.Anything, userID).Return(nil, repository.ErrUserNotFound)
+
+	svc := service.NewPrivacyReportService(users, mocks.NewSocialRepository(t), mocks.NewChangeLogRepository(t), nil)
+
+	_, err := svc.GetPrivacyReport(t.Context(), userID)
+	require.ErrorIs(t, err, service.ErrUserNotFound)
+}
+
+func TestPrivacyReportService_GetPrivacyReport_RepositoryError(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	errRepo := errors.New("connection reset")
+
+	users := mocks.NewUserRepository(t)
+	users.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+	users.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).Return(repository.DefaultPrivacyPreferences(), nil)
+
+	social := mocks.NewSocialRepository(t)
+	social.On("GetFollowing", mock.Anything, userID, 1, 0).Return(nil, 0, errRepo)
+
+	svc := service.NewPrivacyReportService(users, social, mocks.NewChangeLogRepository(t), nil)
+
+	_, err := svc.GetPrivacyReport(t.Context(), userID)
+	require.ErrorIs(t, err, errRepo)
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// defaultProfileReadTimeout bounds a shared profile lookup, which no single caller's deadline
+// applies to.
+const defaultProfileReadTimeout = 5 * time.Second
+
+// profileReads shares the user and privacy lookups behind profile views, so concurrent reads of
+// one popular profile make a single round trip to the database. Only reads already in flight are
+// shared; nothing is kept once they return. Callers must not modify what they get back.
+type profileReads struct {
+	repo    repository.UserRepository
+	timeout time.Duration
+	users   singleflight.Group
+	privacy singleflight.Group
+}
+
+func (r *profileReads) findUserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
+	return sharedRead(ctx, &r.users, r.timeout, userID.String(), func(ctx context.Context) (*dto.User, error) {
+		return r.repo.FindUserByID(ctx, userID) //nolint:wrapcheck // callers map repository errors
+	})
+}
+
+func (r *profileReads) findPrivacyPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.UserPrivacyPreferences, error) {
+	read := func(ctx context.Context) (*dto.UserPrivacyPreferences, error) {
+		return r.repo.FindPrivacyPreferencesByUserID(ctx, userID) //nolint:wrapcheck // callers map repository errors
+	}
+
+	return sharedRead(ctx, &r.privacy, r.timeout, userID.String(), read)
+}
+
+// sharedRead joins the read of key already in flight in group, or starts it. The read runs without
+// the caller's cancellation, since other callers may be waiting on it, but gives up after timeout
+// so a hung query cannot hold every caller of key; a caller whose context ends stops waiting and
+// returns its error.
+func sharedRead[T any](
+	ctx context.Context,
+	group *singleflight.Group,
+	timeout time.Duration,
+	key string,
+	read func(ctx context.Context) (T, error),
+) (T, error) {
+	results := group.DoChan(key, func() (any, error) {
+		shared, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+
+		return read(shared)
+	})
+
+	select {
+	case <-ctx.Done():
+		var zero T
+
+		return zero, ctx.Err() //nolint:wrapcheck // the caller's own cancellation
+	case result := <-results:
+		if result.Err != nil {
+			var zero T
+
+			return zero, result.Err //nolint:wrapcheck // returned by read
+		}
+
+		return result.Val.(T), nil //nolint:forcetypeassert // read returns nothing else
+	}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// ProfileShareTokenTTL is how long a profile share token remains valid.
+const ProfileShareTokenTTL = time.Hour
+
+// Share token payload layout: user ID, expiry (unix seconds) and a random token ID.
+const (
+	shareTokenIDLength      = 16
+	shareTokenExpiryLength  = 8
+	shareTokenPayloadLength = len(uuid.Nil) + shareTokenExpiryLength + shareTokenIDLength
+)
+
+// ErrShareTokenInvalid is returned when a share token is malformed, expired, revoked or
+// no longer grants access to the profile.
+var ErrShareTokenInvalid = errors.New("invalid or expired share token")
+
+// ProfileShareService defines business logic for sharing a profile with anonymous viewers.
+type ProfileShareService interface {
+	CreateShareToken(ctx context.Context, userID uuid.UUID) (*dto.ProfileShareTokenResponse, error)
+	RevokeShareToken(ctx context.Context, userID uuid.UUID) error
+	GetSharedProfile(ctx context.Context, token string) (*dto.UserProfileResponse, error)
+}
+
+// ProfileShareServiceImpl implements ProfileShareService.
+type ProfileShareServiceImpl struct {
+	repo       repository.UserRepository
+	store      repository.ProfileShareStore
+	signingKey []byte
+}
+
+// NewProfileShareService creates a new ProfileShareService.
+func NewProfileShareService(
+	repo repository.UserRepository,
+	store repository.ProfileShareStore,
+	signingKey []byte,
+) *ProfileShareServiceImpl {
+	return &ProfileShareServiceImpl{
+		repo:       repo,
+		store:      store,
+		signingKey: signingKey,
+	}
+}
+
+// CreateShareToken issues a signed share token for the user's profile. Issuing a token is the
+// owner's explicit opt-in to anonymous viewing and replaces any previously issued token.
+func (s *ProfileShareServiceImpl) CreateShareToken(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.ProfileShareTokenResponse, error) {
+	if s.store == nil {
+		return nil, ErrCacheUnavailable
+	}
+
+	// 1. Private profiles cannot be shared
+	_, privacy, err := s.loadShareableProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if privacy.ProfileVisibility == dto.ProfileVisibilityPrivate {
+		return nil, ErrProfilePrivate
+	}
+
+	// 2. Generate and sign the token
+	tokenID := make([]byte, shareTokenIDLength)
+
+	_, err = rand.Read(tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate share token: %w", err)
+	}
+
+	expiresAt := time.Now().Add(ProfileShareTokenTTL).Truncate(time.Second)
+	token := s.signShareToken(userID, expiresAt, tokenID)
+
+	// 3. Record it as the user's only active share token
+	err = s.store.StoreProfileShareToken(ctx, userID, hex.EncodeToString(tokenID), ProfileShareTokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
+
+	slog.Info("security event", "event", "profile_share_token_issued", "user_id", userID)
+
+	return &dto.ProfileShareTokenResponse{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// RevokeShareToken invalidates the user's active share token, if any.
+func (s *ProfileShareServiceImpl) RevokeShareToken(ctx context.Context, userID uuid.UUID) error {
+	if s.store == nil {
+		return ErrCacheUnavailable
+	}
+
+	err := s.store.DeleteProfileShareToken(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
+
+	slog.Info("security event", "event", "profile_share_token_revoked", "user_id", userID)
+
+	return nil
+}
+
+// GetSharedProfile returns the profile a share token grants access to, as seen by an anonymous
+// viewer. Followers-only profiles are viewable; profiles made private after the token was issued
+// are not.
+func (s *ProfileShareServiceImpl) GetSharedProfile(
+	ctx context.Context,
+	token string,
+) (*dto.UserProfileResponse, error) {
+	if s.store == nil {
+		return nil, ErrCacheUnavailable
+	}
+
+	// 1. Verify signature and expiry
+	userID, tokenID, ok := s.parseShareToken(token, time.Now())
+	if !ok {
+		return nil, ErrShareTokenInvalid
+	}
+
+	// 2. Verify the token has not been revoked or superseded
+	activeID, err := s.store.GetProfileShareToken(ctx, userID)
+	if err != nil {
+		if errors.Is(err, redis.ErrTokenNotFound) {
+			return nil, ErrShareTokenInvalid
+		}
+
+		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(activeID), []byte(hex.EncodeToString(tokenID))) != 1 {
+		return nil, ErrShareTokenInvalid
+	}
+
+	// 3. Re-check the profile is still shareable
+	user, privacy, err := s.loadShareableProfile(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrShareTokenInvalid
+		}
+
+		return nil, err
+	}
+
+	if privacy.ProfileVisibility == dto.ProfileVisibilityPrivate {
+		return nil, ErrShareTokenInvalid
+	}
+
+	return buildProfileResponse(user, privacy, false), nil
+}
+
+func (s *ProfileShareServiceImpl) loadShareableProfile(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.User, *dto.UserPrivacyPreferences, error) {
+	user, err := s.repo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, nil, ErrUserNotFound
+		}
+
+		return nil, nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	if !user.IsActive {
+		return nil, nil, ErrUserNotFound
+	}
+
+	privacy, err := s.repo.FindPrivacyPreferencesByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	return user, privacy, nil
+}
+
+// signShareToken encodes the payload and its HMAC-SHA256 signature as "<payload>.<signature>".
+func (s *ProfileShareServiceImpl) signShareToken(userID uuid.UUID, expiresAt time.Time, tokenID []byte) string {
+	payload := make([]byte, 0, shareTokenPayloadLength)
+	payload = append(payload, userID[:]...)
+	payload = binary.BigEndian.AppendUint64(payload, uint64(expiresAt.Unix())) //nolint:gosec // unix time is positive
+	payload = append(payload, tokenID...)
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(s.sign(payload))
+}
+
+// parseShareToken verifies a share token and returns the user and token IDs it carries.
+func (s *ProfileShareServiceImpl) parseShareToken(token string, now time.Time) (uuid.UUID, []byte, bool) {
+	encodedPayload, encodedSig, found := strings.Cut(token, ".")
+	if !found {
+		return uuid.Nil, nil, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil || len(payload) != shareTokenPayloadLength {
+		return uuid.Nil, nil, false
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, s.sign(payload)) {
+		return uuid.Nil, nil, false
+	}
+
+	userID, err := uuid.FromBytes(payload[:len(uuid.Nil)])
+	if err != nil {
+		return uuid.Nil, nil, false
+	}
+
+	expiry := binary.BigEndian.Uint64(payload[len(uuid.Nil) : len(uuid.Nil)+shareTokenExpiryLength])
+	if now.Unix() >= int64(expiry) { //nolint:gosec // expiry was written from a positive unix time
+		return uuid.Nil, nil, false
+	}
+
+	return userID, payload[len(uuid.Nil)+shareTokenExpiryLength:], true
+}
+
+func (s *ProfileShareServiceImpl) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
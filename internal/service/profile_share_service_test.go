@@ -0,0 +1,184 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+var testShareSigningKey = []byte("test-share-signing-key")
+
+// MockProfileShareStore is an in-memory implementation of repository.ProfileShareStore.
+type MockProfileShareStore struct {
+	tokens map[uuid.UUID]string
+}
+
+func newMockProfileShareStore() *MockProfileShareStore {
+	return &MockProfileShareStore{tokens: map[uuid.UUID]string{}}
+}
+
+func (m *MockProfileShareStore) StoreProfileShareToken(
+	_ context.Context,
+	userID uuid.UUID,
+	tokenID string,
+	_ time.Duration,
+) error {
+	m.tokens[userID] = tokenID
+
+	return nil
+}
+
+func (m *MockProfileShareStore) GetProfileShareToken(_ context.Context, userID uuid.UUID) (string, error) {
+	tokenID, ok := m.tokens[userID]
+	if !ok {
+		return "", fmt.Errorf(mockErrorFmt, redis.ErrTokenNotFound)
+	}
+
+	return tokenID, nil
+}
+
+func (m *MockProfileShareStore) DeleteProfileShareToken(_ context.Context, userID uuid.UUID) error {
+	delete(m.tokens, userID)
+
+	return nil
+}
+
+func setupShareOwner(repo *mocks.UserRepository, userID uuid.UUID, visibility dto.ProfileVisibility) {
+	repo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{
+		UserID:   userID.String(),
+		Username: "janedoe",
+		Email:    func() *string { s := testEmail; return &s }(),
+		IsActive: true,
+	}, nil)
+	repo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+		Return(&dto.UserPrivacyPreferences{ProfileVisibility: visibility}, nil)
+}
+
+func TestProfileShareServiceRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	mockRepo := new(mocks.UserRepository)
+	setupShareOwner(mockRepo, userID, dto.ProfileVisibilityFriendsOnly)
+
+	svc := service.NewProfileShareService(mockRepo, newMockProfileShareStore(), testShareSigningKey)
+
+	issued, err := svc.CreateShareToken(context.Background(), userID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(service.ProfileShareTokenTTL), issued.ExpiresAt, 2*time.Second)
+
+	// Followers-only profile is visible to anonymous viewers holding the token
+	profile, err := svc.GetSharedProfile(context.Background(), issued.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "janedoe", profile.Username)
+	assert.Nil(t, profile.Email, "anonymous viewers only see fields the owner made public")
+
+	// Revocation invalidates the token
+	require.NoError(t, svc.RevokeShareToken(context.Background(), userID))
+
+	_, err = svc.GetSharedProfile(context.Background(), issued.Token)
+	require.ErrorIs(t, err, service.ErrShareTokenInvalid)
+}
+
+func TestProfileShareServiceReissueSupersedes(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	mockRepo := new(mocks.UserRepository)
+	setupShareOwner(mockRepo, userID, dto.ProfileVisibilityPublic)
+
+	svc := service.NewProfileShareService(mockRepo, newMockProfileShareStore(), testShareSigningKey)
+
+	first, err := svc.CreateShareToken(context.Background(), userID)
+	require.NoError(t, err)
+
+	second, err := svc.CreateShareToken(context.Background(), userID)
+	require.NoError(t, err)
+
+	_, err = svc.GetSharedProfile(context.Background(), first.Token)
+	require.ErrorIs(t, err, service.ErrShareTokenInvalid)
+
+	_, err = svc.GetSharedProfile(context.Background(), second.Token)
+	require.NoError(t, err)
+}
+
+func TestProfileShareServiceRejectsForgedTokens(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	mockRepo := new(mocks.UserRepository)
+	setupShareOwner(mockRepo, userID, dto.ProfileVisibilityPublic)
+
+	store := newMockProfileShareStore()
+	issuer := service.NewProfileShareService(mockRepo, store, testShareSigningKey)
+
+	issued, err := issuer.CreateShareToken(context.Background(), userID)
+	require.NoError(t, err)
+
+	payload, sig, _ := strings.Cut(issued.Token, ".")
+
+	tampered := "A"
+	if payload[0] == 'A' {
+		tampered = "B"
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		key   []byte
+	}{
+		{name: "Empty", token: "", key: testShareSigningKey},
+		{name: "Missing Signature", token: payload, key: testShareSigningKey},
+		{name: "Tampered Signature", token: payload + "." + strings.Repeat("A", len(sig)), key: testShareSigningKey},
+		{name: "Tampered Payload", token: tampered + payload[1:] + "." + sig, key: testShareSigningKey},
+		{name: "Different Key", token: issued.Token, key: []byte("another-key")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := service.NewProfileShareService(mockRepo, store, tt.key)
+
+			_, err := svc.GetSharedProfile(context.Background(), tt.token)
+			require.ErrorIs(t, err, service.ErrShareTokenInvalid)
+		})
+	}
+}
+
+func TestProfileShareServicePrivateProfiles(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	mockRepo := new(mocks.UserRepository)
+	setupShareOwner(mockRepo, userID, dto.ProfileVisibilityPrivate)
+
+	svc := service.NewProfileShareService(mockRepo, newMockProfileShareStore(), testShareSigningKey)
+
+	_, err := svc.CreateShareToken(context.Background(), userID)
+	require.ErrorIs(t, err, service.ErrProfilePrivate)
+}
+
+func TestProfileShareServiceWithoutCache(t *testing.T) {
+	t.Parallel()
+
+	svc := service.NewProfileShareService(new(mocks.UserRepository), nil, testShareSigningKey)
+
+	_, err := svc.CreateShareToken(context.Background(), uuid.New())
+	require.ErrorIs(t, err, service.ErrCacheUnavailable)
+
+	err = svc.RevokeShareToken(context.Background(), uuid.New())
+	require.ErrorIs(t, err, service.ErrCacheUnavailable)
+}
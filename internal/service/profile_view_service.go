@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// ProfileViewService counts how many times each profile is viewed. Views are counted in the shared
+// store and periodically added to the database; only totals are kept. Each viewer counts once per
+// UTC day: signed-in viewers by user, anonymous ones by IP address. Owners viewing their own
+// profile and users who turned off the count profile views privacy preference are not counted.
+type ProfileViewService interface {
+	// RecordView counts a view of the profile of userID by viewerID, or by an anonymous viewer
+	// from viewerIP when viewerID is nil.
+	RecordView(ctx context.Context, userID uuid.UUID, viewerID *uuid.UUID, viewerIP string) error
+	// GetProfileViews returns how many times the profile of userID was viewed.
+	GetProfileViews(ctx context.Context, userID uuid.UUID) (*dto.ProfileViewsResponse, error)
+	// FlushViews adds the views counted since the last flush to the database and returns how many
+	// profiles they were of.
+	FlushViews(ctx context.Context) (int, error)
+}
+
+// ProfileViewServiceImpl implements ProfileViewService.
+type ProfileViewServiceImpl struct {
+	store    repository.ProfileViewStore
+	repo     repository.ProfileViewRepository
+	userRepo repository.UserRepository
+	now      func() time.Time
+}
+
+// NewProfileViewService creates a new ProfileViewService.
+func NewProfileViewService(
+	store repository.ProfileViewStore,
+	repo repository.ProfileViewRepository,
+	userRepo repository.UserRepository,
+) *ProfileViewServiceImpl {
+	return &ProfileViewServiceImpl{store: store, repo: repo, userRepo: userRepo, now: time.Now}
+}
+
+// RecordView counts a view unless the viewer was already counted today. The viewer is only kept
+// as a digest, and only until the end of the day. The owner's preference is read after the
+// dedup, so repeat views cost no database read.
+func (s *ProfileViewServiceImpl) RecordView(
+	ctx context.Context,
+	userID uuid.UUID,
+	viewerID *uuid.UUID,
+	viewerIP string,
+) error {
+	var viewer string
+
+	switch {
+	case viewerID != nil && *viewerID == userID:
+		return nil
+	case viewerID != nil:
+		viewer = "user:" + viewerID.String()
+	case viewerIP != "":
+		viewer = "ip:" + viewerIP
+	default:
+		return nil
+	}
+
+	now := s.now().UTC()
+	day := now.Format(time.DateOnly)
+	endOfDay := now.Truncate(24 * time.Hour).Add(24 * time.Hour)
+	digest := sha256.Sum256([]byte(day + "|" + viewer))
+
+	first, err := s.store.MarkProfileViewed(ctx, userID, hex.EncodeToString(digest[:16]), endOfDay.Sub(now))
+	if err != nil {
+		return fmt.Errorf("failed to record profile view: %w", err)
+	}
+
+	if !first {
+		return nil
+	}
+
+	privacy, err := s.userRepo.FindPrivacyPreferencesByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	if !privacy.CountProfileViews {
+		return nil
+	}
+
+	err = s.store.IncrementProfileViews(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to record profile view: %w", err)
+	}
+
+	return nil
+}
+
+// GetProfileViews returns the stored total of the user plus the views not flushed yet.
+func (s *ProfileViewServiceImpl) GetProfileViews(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.ProfileViewsResponse, error) {
+	privacy, err := s.userRepo.FindPrivacyPreferencesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	stored, err := s.repo.GetProfileViewCount(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch profile views: %w", err)
+	}
+
+	pending, err := s.store.GetPendingProfileViews(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch profile views: %w", err)
+	}
+
+	return &dto.ProfileViewsResponse{
+		UserID:    userID.String(),
+		ViewCount: stored + pending,
+		Counting:  privacy.CountProfileViews,
+	}, nil
+}
+
+// FlushViews adds the counted views to the database. Views are only dropped from the store once
+// stored, so a failed flush is retried by the next one.
+func (s *ProfileViewServiceImpl) FlushViews(ctx context.Context) (int, error) {
+	views, err := s.store.TakeProfileViews(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to take profile views: %w", err)
+	}
+
+	if len(views) == 0 {
+		return 0, nil
+	}
+
+	err = s.repo.AddProfileViews(ctx, views)
+	if err != nil {
+		return 0, fmt.Errorf("failed to store profile views: %w", err)
+	}
+
+	err = s.store.AckProfileViews(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acknowledge profile views: %w", err)
+	}
+
+	return len(views), nil
+}
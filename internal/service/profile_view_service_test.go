@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// untilEndOfDay matches the TTL of a viewer remembered for the rest of the UTC day.
+var untilEndOfDay = mock.MatchedBy(func(ttl time.Duration) bool { return ttl > 0 && ttl <= 24*time.Hour })
+
+func TestProfileViewService_RecordView(t *testing.T) {
+	t.Parallel()
+
+	userID, viewerID := uuid.New(), uuid.New()
+
+	t.Run("first view of the day is counted", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewProfileViewStore(t)
+		store.On("MarkProfileViewed", mock.Anything, userID, mock.AnythingOfType("string"), untilEndOfDay).
+			Return(true, nil)
+		store.On("IncrementProfileViews", mock.Anything, userID).Return(nil)
+
+		userRepo := mocks.NewUserRepository(t)
+		userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+			Return(&dto.UserPrivacyPreferences{CountProfileViews: true}, nil)
+
+		svc := service.NewProfileViewService(store, mocks.NewProfileViewRepository(t), userRepo)
+
+		require.NoError(t, svc.RecordView(t.Context(), userID, nil, "203.0.113.7"))
+	})
+
+	t.Run("repeat views skip the preference lookup", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewProfileViewStore(t)
+		store.On("MarkProfileViewed", mock.Anything, userID, mock.AnythingOfType("string"), untilEndOfDay).
+			Return(false, nil)
+
+		svc := service.NewProfileViewService(store, mocks.NewProfileViewRepository(t), mocks.NewUserRepository(t))
+
+		require.NoError(t, svc.RecordView(t.Context(), userID, &viewerID, "203.0.113.7"))
+	})
+
+	t.Run("opted out users are not counted", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewProfileViewStore(t)
+		store.On("MarkProfileViewed", mock.Anything, userID, mock.AnythingOfType("string"), untilEndOfDay).
+			Return(true, nil)
+
+		userRepo := mocks.NewUserRepository(t)
+		userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+			Return(&dto.UserPrivacyPreferences{CountProfileViews: false}, nil)
+
+		svc := service.NewProfileViewService(store, mocks.NewProfileViewRepository(t), userRepo)
+
+		require.NoError(t, svc.RecordView(t.Context(), userID, &viewerID, ""))
+	})
+
+	t.Run("owners and unidentified viewers are not counted", func(t *testing.T) {
+		t.Parallel()
+
+		svc := service.NewProfileViewService(mocks.NewProfileViewStore(t), mocks.NewProfileViewRepository(t),
+			mocks.NewUserRepository(t))
+
+		require.NoError(t, svc.RecordView(t.Context(), userID, &userID, "203.0.113.7"))
+		require.NoError(t, svc.RecordView(t.Context(), userID, nil, ""))
+	})
+
+	t.Run("viewers are told apart without keeping who they are", func(t *testing.T) {
+		t.Parallel()
+
+		var viewers []string
+
+		store := mocks.NewProfileViewStore(t)
+		store.On("MarkProfileViewed", mock.Anything, userID, mock.AnythingOfType("string"), untilEndOfDay).
+			Run(func(args mock.Arguments) { viewers = append(viewers, args.String(2)) }).
+			Return(false, nil)
+
+		svc := service.NewProfileViewService(store, mocks.NewProfileViewRepository(t), mocks.NewUserRepository(t))
+
+		require.NoError(t, svc.RecordView(t.Context(), userID, nil, "203.0.113.7"))
+		require.NoError(t, svc.RecordView(t.Context(), userID, nil, "203.0.113.7"))
+		require.NoError(t, svc.RecordView(t.Context(), userID, nil, "203.0.113.8"))
+		require.NoError(t, svc.RecordView(t.Context(), userID, &viewerID, "203.0.113.7"))
+
+		require.Len(t, viewers, 4)
+		assert.Equal(t, viewers[0], viewers[1])
+		assert.NotEqual(t, viewers[0], viewers[2])
+		assert.NotEqual(t, viewers[0], viewers[3], "signed-in viewers are counted by user")
+		assert.NotContains(t, viewers[0], "203.0.113.7")
+	})
+}
+
+func TestProfileViewService_GetProfileViews(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	store := mocks.NewProfileViewStore(t)
+	store.On("GetPendingProfileViews", mock.Anything, userID).Return(int64(3), nil)
+
+	repo := mocks.NewProfileViewRepository(t)
+	repo.On("GetProfileViewCount", mock.Anything, userID).Return(int64(40), nil)
+
+	userRepo := mocks.NewUserRepository(t)
+	userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+		Return(&dto.UserPrivacyPreferences{CountProfileViews: false}, nil)
+
+	views, err := service.NewProfileViewService(store, repo, userRepo).GetProfileViews(t.Context(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, &dto.ProfileViewsResponse{UserID: userID.String(), ViewCount: 43, Counting: false}, views)
+}
+
+func TestProfileViewService_FlushViews(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	views := map[uuid.UUID]int64{userID: 7}
+
+	t.Run("stored views are acknowledged", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewProfileViewStore(t)
+		store.On("TakeProfileViews", mock.Anything).Return(views, nil)
+		store.On("AckProfileViews", mock.Anything).Return(nil)
+
+		repo := mocks.NewProfileViewRepository(t)
+		repo.On("AddProfileViews", mock.Anything, views).Return(nil)
+
+		flushed, err := service.NewProfileViewService(store, repo, mocks.NewUserRepository(t)).FlushViews(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 1, flushed)
+	})
+
+	t.Run("views are kept when storing fails", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewProfileViewStore(t)
+		store.On("TakeProfileViews", mock.Anything).Return(views, nil)
+
+		repo := mocks.NewProfileViewRepository(t)
+		repo.On("AddProfileViews", mock.Anything, views).Return(assert.AnError)
+
+		_, err := service.NewProfileViewService(store, repo, mocks.NewUserRepository(t)).FlushViews(t.Context())
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("nothing counted", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewProfileViewStore(t)
+		store.On("TakeProfileViews", mock.Anything).Return(map[uuid.UUID]int64{}, nil)
+
+		flushed, err := service.NewProfileViewService(store, mocks.NewProfileViewRepository(t),
+			mocks.NewUserRepository(t)).FlushViews(t.Context())
+		require.NoError(t, err)
+		assert.Zero(t, flushed)
+	})
+}
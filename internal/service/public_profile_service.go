@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// Defaults of the public profile projection unless configured.
+const (
+	defaultPublicProfileStaleness = 15 * time.Minute
+	defaultPublicProfileBatchSize = 500
+)
+
+// PublicProfileService maintains the public profile projection: each user's profile as anonymous
+// viewers see it, so anonymous reads fetch one record instead of the user, their privacy
+// preferences and follower count.
+type PublicProfileService interface {
+	// GetPublicProfile returns the projected profile of a user, rebuilding it first when it is
+	// missing or stale. Missing users return ErrUserNotFound.
+	GetPublicProfile(ctx context.Context, userID uuid.UUID) (*dto.PublicProfile, error)
+	// Sync rebuilds the profiles of the users recorded in the user change log since the last sync
+	// and returns how many it rebuilt.
+	Sync(ctx context.Context) (int, error)
+	// Rebuild rebuilds the profile of every user and returns how many it rebuilt.
+	Rebuild(ctx context.Context) (int, error)
+	// Invalidate drops the projected profile of a user after a change to what anonymous viewers
+	// may see, so the next read rebuilds it from the database.
+	Invalidate(ctx context.Context, userID uuid.UUID) error
+}
+
+// PublicProfileOptions configures the public profile projection.
+type PublicProfileOptions struct {
+	// MaxStaleness is the age past which a projected profile is rebuilt before it is served. Zero
+	// uses 15 minutes.
+	MaxStaleness time.Duration
+	// BatchSize is how many profiles are written at once while syncing or rebuilding. Zero uses 500.
+	BatchSize int
+	// Progress is called after each batch of a rebuild with the number rebuilt so far.
+	Progress func(rebuilt int)
+}
+
+// PublicProfileServiceImpl implements PublicProfileService.
+type PublicProfileServiceImpl struct {
+	users   repository.UserRepository
+	social  repository.SocialRepository
+	changes repository.ChangeLogRepository
+	ids     repository.UserIDLister
+	store   repository.PublicProfileStore
+	opts    PublicProfileOptions
+}
+
+// NewPublicProfileService creates a new PublicProfileService. Profiles are read from users and
+// social and kept in store; changes drives Sync and ids drives Rebuild.
+func NewPublicProfileService(
+	users repository.UserRepository,
+	social repository.SocialRepository,
+	changes repository.ChangeLogRepository,
+	ids repository.UserIDLister,
+	store repository.PublicProfileStore,
+	opts PublicProfileOptions,
+) *PublicProfileServiceImpl {
+	if opts.MaxStaleness <= 0 {
+		opts.MaxStaleness = defaultPublicProfileStaleness
+	}
+
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultPublicProfileBatchSize
+	}
+
+	return &PublicProfileServiceImpl{
+		users:   users,
+		social:  social,
+		changes: changes,
+		ids:     ids,
+		store:   store,
+		opts:    opts,
+	}
+}
+
+// GetPublicProfile returns the projected profile of a user when it is fresh enough, or rebuilds
+// and stores it. The projection is best effort: when the store fails the profile is built from the
+// database as if it were missing.
+func (s *PublicProfileServiceImpl) GetPublicProfile(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.PublicProfile, error) {
+	projected, err := s.store.GetPublicProfile(ctx, userID)
+
+	switch {
+	case err != nil:
+		slog.WarnContext(ctx, "failed to read public profile", "error", err)
+	case projected != nil && time.Since(projected.BuiltAt) <= s.opts.MaxStaleness:
+		return projected, nil
+	}
+
+	profile, err := s.build(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.SetPublicProfiles(ctx, []dto.PublicProfile{*profile})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to store public profile", "error", err)
+	}
+
+	return profile, nil
+}
+
+// Sync follows the user change log from the stored cursor, rebuilding the profiles of the users
+// changed in each page and then moving the cursor past it. An interrupted sync resumes from the
+// last page it finished.
+func (s *PublicProfileServiceImpl) Sync(ctx context.Context) (int, error) {
+	cursor, err := s.store.GetPublicProfileCursor(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get public profile cursor: %w", err)
+	}
+
+	rebuilt := 0
+
+	for {
+		changes, err := s.changes.GetChangesSince(ctx, cursor, s.opts.BatchSize)
+		if err != nil {
+			return rebuilt, fmt.Errorf("failed to get user changes: %w", err)
+		}
+
+		if len(changes) == 0 {
+			return rebuilt, nil
+		}
+
+		seen := make(map[uuid.UUID]bool, len(changes))
+		userIDs := make([]uuid.UUID, 0, len(changes))
+
+		for _, change := range changes {
+			userID, err := uuid.Parse(change.UserID)
+			if err != nil || seen[userID] {
+				continue
+			}
+
+			seen[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+
+		n, err := s.rebuild(ctx, userIDs)
+		rebuilt += n
+
+		if err != nil {
+			return rebuilt, err
+		}
+
+		cursor = changes[len(changes)-1].Sequence
+
+		err = s.store.SetPublicProfileCursor(ctx, cursor)
+		if err != nil {
+			return rebuilt, fmt.Errorf("failed to store public profile cursor: %w", err)
+		}
+	}
+}
+
+// Rebuild goes through every user in ID order, in batches. Profiles are replaced one batch at a
+// time, so an interrupted rebuild leaves the rest of the projection as it was.
+func (s *PublicProfileServiceImpl) Rebuild(ctx context.Context) (int, error) {
+	rebuilt := 0
+	after := uuid.Nil
+
+	for {
+		userIDs, err := s.ids.ListUserIDs(ctx, after, s.opts.BatchSize)
+		if err != nil {
+			return rebuilt, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		if len(userIDs) == 0 {
+			return rebuilt, nil
+		}
+
+		n, err := s.rebuild(ctx, userIDs)
+		rebuilt += n
+
+		if err != nil {
+			return rebuilt, err
+		}
+
+		if s.opts.Progress != nil {
+			s.opts.Progress(rebuilt)
+		}
+
+		after = userIDs[len(userIDs)-1]
+	}
+}
+
+// Invalidate drops the projected profile of a user. Reads of a profile that is not projected check
+// the user's privacy preferences in the database.
+func (s *PublicProfileServiceImpl) Invalidate(ctx context.Context, userID uuid.UUID) error {
+	err := s.store.DeletePublicProfile(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete public profile: %w", err)
+	}
+
+	return nil
+}
+
+// invalidatePublicProfile drops the projected profile of a user after a privacy, profile or
+// account change, in the request that made it. A failure is only logged: the change log sync
+// rebuilds the profile.
+func invalidatePublicProfile(ctx context.Context, profiles PublicProfileService, userID uuid.UUID) {
+	if profiles == nil {
+		return
+	}
+
+	err := profiles.Invalidate(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to invalidate public profile", "error", err)
+	}
+}
+
+// rebuild builds and stores the profiles of userIDs, skipping users that no longer exist, and
+// returns how many it stored.
+func (s *PublicProfileServiceImpl) rebuild(ctx context.Context, userIDs []uuid.UUID) (int, error) {
+	profiles := make([]dto.PublicProfile, 0, len(userIDs))
+
+	for _, userID := range userIDs {
+		profile, err := s.build(ctx, userID)
+		if errors.Is(err, ErrUserNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		profiles = append(profiles, *profile)
+	}
+
+	err := s.store.SetPublicProfiles(ctx, profiles)
+	if err != nil {
+		return 0, fmt.Errorf("failed to store public profiles: %w", err)
+	}
+
+	return len(profiles), nil
+}
+
+// build reads a user's profile as an anonymous viewer sees it. Non-public profiles keep only
+// whether they are active, so the projection holds nothing their owners hid.
+func (s *PublicProfileServiceImpl) build(ctx context.Context, userID uuid.UUID) (*dto.PublicProfile, error) {
+	builtAt := time.Now()
+
+	user, err := s.users.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	privacy, err := s.users.FindPrivacyPreferencesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	profile := &dto.PublicProfile{UserID: user.UserID, IsActive: user.IsActive, BuiltAt: builtAt}
+	if privacy.ProfileVisibility != dto.ProfileVisibilityPublic {
+		return profile, nil
+	}
+
+	_, followers, err := s.social.GetFollowers(ctx, userID, 1, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count followers: %w", err)
+	}
+
+	profile.Public = true
+	profile.Profile = buildProfileResponse(user, privacy, false)
+	profile.FollowerCount = followers
+
+	return profile, nil
+}
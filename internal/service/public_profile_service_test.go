@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// newPublicProfileStore seeds a public profile followed by a private one.
+func newPublicProfileStore(t *testing.T) (*memory.Store, uuid.UUID, uuid.UUID) {
+	t.Helper()
+
+	bio := "Braises"
+	private := dto.ProfileVisibilityPrivate
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{
+			{Username: "chef", Bio: &bio},
+			{Username: "hermit", Preferences: &dto.UserPreferencesUpdateRequest{
+				Privacy: &dto.PrivacyPreferencesUpdate{ProfileVisibility: &private},
+			}},
+		},
+		Follows: []fixtures.Follow{{Follower: "hermit", Followee: "chef"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	chefID, _ := f.UserID("chef")
+	hermitID, _ := f.UserID("hermit")
+
+	return store, chefID, hermitID
+}
+
+func newPublicProfileService(store *memory.Store, maxStaleness time.Duration) *service.PublicProfileServiceImpl {
+	return service.NewPublicProfileService(store, store, store, store, store, service.PublicProfileOptions{
+		MaxStaleness: maxStaleness,
+	})
+}
+
+func TestPublicProfileService_Rebuild(t *testing.T) {
+	t.Parallel()
+
+	store, chefID, hermitID := newPublicProfileStore(t)
+	svc := newPublicProfileService(store, time.Hour)
+
+	rebuilt, err := svc.Rebuild(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 2, rebuilt)
+
+	chef, err := store.GetPublicProfile(t.Context(), chefID)
+	require.NoError(t, err)
+	require.NotNil(t, chef)
+	assert.True(t, chef.Public)
+	assert.Equal(t, "chef", chef.Profile.Username)
+	assert.Equal(t, 1, chef.FollowerCount)
+
+	hermit, err := store.GetPublicProfile(t.Context(), hermitID)
+	require.NoError(t, err)
+	require.NotNil(t, hermit)
+	assert.False(t, hermit.Public)
+	assert.Nil(t, hermit.Profile, "nothing of a private profile is kept")
+}
+
+func TestPublicProfileService_Sync(t *testing.T) {
+	t.Parallel()
+
+	store, chefID, _ := newPublicProfileStore(t)
+	svc := newPublicProfileService(store, time.Hour)
+
+	_, err := svc.Rebuild(t.Context())
+	require.NoError(t, err)
+
+	// Catch up with the preference changes seeding recorded
+	_, err = svc.Sync(t.Context())
+	require.NoError(t, err)
+
+	bio := "Bakes"
+	_, err = store.UpdateUser(t.Context(), chefID, &dto.UserProfileUpdateRequest{Bio: &bio})
+	require.NoError(t, err)
+
+	profile, err := svc.GetPublicProfile(t.Context(), chefID)
+	require.NoError(t, err)
+	assert.Equal(t, "Braises", *profile.Profile.Bio, "fresh profiles are served until synced")
+
+	rebuilt, err := svc.Sync(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, rebuilt)
+
+	profile, err = svc.GetPublicProfile(t.Context(), chefID)
+	require.NoError(t, err)
+	assert.Equal(t, "Bakes", *profile.Profile.Bio)
+
+	rebuilt, err = svc.Sync(t.Context())
+	require.NoError(t, err)
+	assert.Zero(t, rebuilt, "changes are applied once")
+}
+
+func TestPublicProfileService_GetPublicProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Builds Missing Profiles", func(t *testing.T) {
+		t.Parallel()
+
+		store, chefID, _ := newPublicProfileStore(t)
+		svc := newPublicProfileService(store, time.Hour)
+
+		profile, err := svc.GetPublicProfile(t.Context(), chefID)
+		require.NoError(t, err)
+		assert.Equal(t, "chef", profile.Profile.Username)
+
+		stored, err := store.GetPublicProfile(t.Context(), chefID)
+		require.NoError(t, err)
+		assert.Equal(t, profile, stored)
+	})
+
+	t.Run("Rebuilds Stale Profiles", func(t *testing.T) {
+		t.Parallel()
+
+		store, chefID, _ := newPublicProfileStore(t)
+		svc := newPublicProfileService(store, time.Nanosecond)
+
+		_, err := svc.GetPublicProfile(t.Context(), chefID)
+		require.NoError(t, err)
+
+		bio := "Bakes"
+		_, err = store.UpdateUser(t.Context(), chefID, &dto.UserProfileUpdateRequest{Bio: &bio})
+		require.NoError(t, err)
+
+		profile, err := svc.GetPublicProfile(t.Context(), chefID)
+		require.NoError(t, err)
+		assert.Equal(t, "Bakes", *profile.Profile.Bio)
+	})
+
+	t.Run("Checks Privacy Once Invalidated", func(t *testing.T) {
+		t.Parallel()
+
+		store, chefID, _ := newPublicProfileStore(t)
+		svc := newPublicProfileService(store, time.Hour)
+
+		_, err := svc.GetPublicProfile(t.Context(), chefID)
+		require.NoError(t, err)
+
+		private := dto.ProfileVisibilityPrivate
+		_, err = store.UpdatePrivacyPreferencesData(t.Context(), chefID, &dto.PrivacyPreferencesUpdate{
+			ProfileVisibility: &private,
+		})
+		require.NoError(t, err)
+		require.NoError(t, svc.Invalidate(t.Context(), chefID))
+
+		profile, err := svc.GetPublicProfile(t.Context(), chefID)
+		require.NoError(t, err)
+		assert.False(t, profile.Public)
+		assert.Nil(t, profile.Profile)
+	})
+
+	t.Run("Missing User", func(t *testing.T) {
+		t.Parallel()
+
+		store, _, _ := newPublicProfileStore(t)
+		svc := newPublicProfileService(store, time.Hour)
+
+		_, err := svc.GetPublicProfile(t.Context(), uuid.New())
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
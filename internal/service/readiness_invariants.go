@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// Readiness invariant names.
+const (
+	InvariantIndexes       = "indexes"
+	InvariantCounterDrift  = "counter_drift"
+	InvariantChangeBacklog = "change_backlog"
+	InvariantChangeAge     = "change_age"
+)
+
+// Invariant severities. A check that holds reports InvariantOK; one that is violated, or cannot be
+// evaluated, reports its severity.
+const (
+	InvariantOK           = "ok"
+	InvariantSeverityOff  = "off"
+	InvariantSeverityWarn = "warn"
+	InvariantSeverityFail = "fail"
+)
+
+// InvariantResult is the outcome of one readiness invariant.
+type InvariantResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// InvariantRule configures one invariant. An empty severity is off.
+type InvariantRule struct {
+	Severity string
+	// Max is the largest count that passes, for the checks that count.
+	Max int
+	// MaxAge is the longest wait that passes, for the checks that measure age.
+	MaxAge time.Duration
+	// Sample is how many users a sampling check looks at.
+	Sample int
+}
+
+// ReadinessInvariantOptions configures the readiness invariants.
+type ReadinessInvariantOptions struct {
+	// CheckInterval is how long results are reused. Zero checks on every probe.
+	CheckInterval time.Duration
+	// RequiredIndexes are the schema-qualified indexes the Indexes check looks for.
+	RequiredIndexes []string
+
+	Indexes       InvariantRule
+	CounterDrift  InvariantRule
+	ChangeBacklog InvariantRule
+	ChangeAge     InvariantRule
+}
+
+// ReadinessInvariants checks the schema and data invariants readiness depends on: the indexes the
+// migrations create exist and, when the public profile projection is kept, its follower counts and
+// its lag behind the user change log stay within bounds.
+type ReadinessInvariants struct {
+	repo       repository.InvariantRepository
+	projection repository.PublicProfileStore
+	opts       ReadinessInvariantOptions
+	now        func() time.Time
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	results   []InvariantResult
+}
+
+// NewReadinessInvariants creates the readiness invariants. A nil projection leaves out the checks
+// of the public profile projection.
+func NewReadinessInvariants(
+	repo repository.InvariantRepository,
+	projection repository.PublicProfileStore,
+	opts ReadinessInvariantOptions,
+) *ReadinessInvariants {
+	return &ReadinessInvariants{repo: repo, projection: projection, opts: opts, now: time.Now}
+}
+
+// Check returns the result of every invariant that is on. Results are reused for the check
+// interval; concurrent probes wait for one evaluation rather than each querying the database.
+func (r *ReadinessInvariants) Check(ctx context.Context) []InvariantResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.results != nil && r.now().Sub(r.checkedAt) < r.opts.CheckInterval {
+		return r.results
+	}
+
+	results := []InvariantResult{}
+
+	if enabled(r.opts.Indexes) {
+		results = append(results, r.checkIndexes(ctx))
+	}
+
+	if r.projection != nil {
+		if enabled(r.opts.CounterDrift) {
+			results = append(results, r.checkCounterDrift(ctx))
+		}
+
+		if enabled(r.opts.ChangeBacklog) || enabled(r.opts.ChangeAge) {
+			results = append(results, r.checkChangeLag(ctx)...)
+		}
+	}
+
+	r.results = results
+	r.checkedAt = r.now()
+
+	return results
+}
+
+func (r *ReadinessInvariants) checkIndexes(ctx context.Context) InvariantResult {
+	rule := r.opts.Indexes
+
+	missing, err := r.repo.MissingRelations(ctx, r.opts.RequiredIndexes)
+	if err != nil {
+		return violated(InvariantIndexes, rule, err.Error())
+	}
+
+	if len(missing) > 0 {
+		return violated(InvariantIndexes, rule, "missing "+strings.Join(missing, ", "))
+	}
+
+	return InvariantResult{
+		Name:   InvariantIndexes,
+		Status: InvariantOK,
+		Detail: fmt.Sprintf("all %d present", len(r.opts.RequiredIndexes)),
+	}
+}
+
+// checkCounterDrift compares projected follower counts with the follows. Only public profiles keep
+// a count, and a count can trail the follows by up to the projection's staleness, so the rule's Max
+// allows for the follows of that window.
+func (r *ReadinessInvariants) checkCounterDrift(ctx context.Context) InvariantResult {
+	rule := r.opts.CounterDrift
+
+	counts, err := r.repo.RecentFolloweeCounts(ctx, rule.Sample)
+	if err != nil {
+		return violated(InvariantCounterDrift, rule, err.Error())
+	}
+
+	compared, largest := 0, 0
+
+	for userID, followers := range counts {
+		profile, err := r.projection.GetPublicProfile(ctx, userID)
+		if err != nil {
+			return violated(InvariantCounterDrift, rule, err.Error())
+		}
+
+		if profile == nil || !profile.Public {
+			continue
+		}
+
+		compared++
+		largest = max(largest, abs(profile.FollowerCount-followers))
+	}
+
+	detail := fmt.Sprintf("largest difference %d across %d profiles", largest, compared)
+	if largest > rule.Max {
+		return violated(InvariantCounterDrift, rule, detail)
+	}
+
+	return InvariantResult{Name: InvariantCounterDrift, Status: InvariantOK, Detail: detail}
+}
+
+// checkChangeLag measures the user changes the projection has not applied yet: how many there are
+// and how long the oldest has waited.
+func (r *ReadinessInvariants) checkChangeLag(ctx context.Context) []InvariantResult {
+	var results []InvariantResult
+
+	backlog, oldest, err := r.changeBacklog(ctx)
+
+	if enabled(r.opts.ChangeBacklog) {
+		rule := r.opts.ChangeBacklog
+
+		switch {
+		case err != nil:
+			results = append(results, violated(InvariantChangeBacklog, rule, err.Error()))
+		case backlog > int64(rule.Max):
+			results = append(results, violated(InvariantChangeBacklog, rule,
+				fmt.Sprintf("%d changes pending, more than %d", backlog, rule.Max)))
+		default:
+			results = append(results, InvariantResult{
+				Name: InvariantChangeBacklog, Status: InvariantOK, Detail: fmt.Sprintf("%d changes pending", backlog),
+			})
+		}
+	}
+
+	if enabled(r.opts.ChangeAge) {
+		rule := r.opts.ChangeAge
+
+		var age time.Duration
+		if oldest != nil {
+			age = r.now().Sub(*oldest).Truncate(time.Second)
+		}
+
+		switch {
+		case err != nil:
+			results = append(results, violated(InvariantChangeAge, rule, err.Error()))
+		case age > rule.MaxAge:
+			results = append(results, violated(InvariantChangeAge, rule,
+				fmt.Sprintf("oldest pending change is %s old, more than %s", age, rule.MaxAge)))
+		default:
+			results = append(results, InvariantResult{
+				Name: InvariantChangeAge, Status: InvariantOK, Detail: fmt.Sprintf("oldest pending change is %s old", age),
+			})
+		}
+	}
+
+	return results
+}
+
+func (r *ReadinessInvariants) changeBacklog(ctx context.Context) (int64, *time.Time, error) {
+	cursor, err := r.projection.GetPublicProfileCursor(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return r.repo.ChangeBacklog(ctx, cursor)
+}
+
+func enabled(rule InvariantRule) bool {
+	return rule.Severity != "" && rule.Severity != InvariantSeverityOff
+}
+
+func violated(name string, rule InvariantRule, detail string) InvariantResult {
+	return InvariantResult{Name: name, Status: rule.Severity, Detail: detail}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
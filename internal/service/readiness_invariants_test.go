@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestReadinessInvariants_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reports every invariant that holds", func(t *testing.T) {
+		t.Parallel()
+
+		userID := uuid.New()
+
+		repo := mocks.NewInvariantRepository(t)
+		repo.On("MissingRelations", mock.Anything, []string{"recipe_manager.idx_a"}).Return(nil, nil)
+		repo.On("RecentFolloweeCounts", mock.Anything, 5).Return(map[uuid.UUID]int{userID: 4}, nil)
+		repo.On("ChangeBacklog", mock.Anything, int64(7)).Return(int64(0), nil, nil)
+
+		projection := mocks.NewPublicProfileStore(t)
+		projection.On("GetPublicProfile", mock.Anything, userID).
+			Return(&dto.PublicProfile{Public: true, FollowerCount: 3}, nil)
+		projection.On("GetPublicProfileCursor", mock.Anything).Return(int64(7), nil)
+
+		warn := service.InvariantRule{Severity: service.InvariantSeverityWarn, Max: 1, MaxAge: time.Minute, Sample: 5}
+
+		results := service.NewReadinessInvariants(repo, projection, service.ReadinessInvariantOptions{
+			RequiredIndexes: []string{"recipe_manager.idx_a"},
+			Indexes:         warn,
+			CounterDrift:    warn,
+			ChangeBacklog:   warn,
+			ChangeAge:       warn,
+		}).Check(t.Context())
+
+		assert.Equal(t, []service.InvariantResult{
+			{Name: service.InvariantIndexes, Status: service.InvariantOK, Detail: "all 1 present"},
+			{Name: service.InvariantCounterDrift, Status: service.InvariantOK, Detail: "largest difference 1 across 1 profiles"},
+			{Name: service.InvariantChangeBacklog, Status: service.InvariantOK, Detail: "0 changes pending"},
+			{Name: service.InvariantChangeAge, Status: service.InvariantOK, Detail: "oldest pending change is 0s old"},
+		}, results)
+	})
+
+	t.Run("violations report their severity", func(t *testing.T) {
+		t.Parallel()
+
+		oldest := time.Now().Add(-time.Hour)
+
+		repo := mocks.NewInvariantRepository(t)
+		repo.On("MissingRelations", mock.Anything, mock.Anything).Return([]string{"recipe_manager.idx_a"}, nil)
+		repo.On("ChangeBacklog", mock.Anything, int64(0)).Return(int64(50), &oldest, nil)
+
+		projection := mocks.NewPublicProfileStore(t)
+		projection.On("GetPublicProfileCursor", mock.Anything).Return(int64(0), nil)
+
+		results := service.NewReadinessInvariants(repo, projection, service.ReadinessInvariantOptions{
+			RequiredIndexes: []string{"recipe_manager.idx_a"},
+			Indexes:         service.InvariantRule{Severity: service.InvariantSeverityFail},
+			CounterDrift:    service.InvariantRule{Severity: service.InvariantSeverityOff},
+			ChangeBacklog:   service.InvariantRule{Severity: service.InvariantSeverityWarn, Max: 10},
+			ChangeAge:       service.InvariantRule{Severity: service.InvariantSeverityFail, MaxAge: time.Minute},
+		}).Check(t.Context())
+
+		assert.Len(t, results, 3)
+		assert.Equal(t, service.InvariantResult{
+			Name: service.InvariantIndexes, Status: service.InvariantSeverityFail, Detail: "missing recipe_manager.idx_a",
+		}, results[0])
+		assert.Equal(t, service.InvariantSeverityWarn, results[1].Status)
+		assert.Equal(t, service.InvariantSeverityFail, results[2].Status)
+	})
+
+	t.Run("skips profiles that keep no count", func(t *testing.T) {
+		t.Parallel()
+
+		hidden, missing := uuid.New(), uuid.New()
+
+		repo := mocks.NewInvariantRepository(t)
+		repo.On("RecentFolloweeCounts", mock.Anything, 2).Return(map[uuid.UUID]int{hidden: 40, missing: 9}, nil)
+
+		projection := mocks.NewPublicProfileStore(t)
+		projection.On("GetPublicProfile", mock.Anything, hidden).Return(&dto.PublicProfile{Public: false}, nil)
+		projection.On("GetPublicProfile", mock.Anything, missing).Return(nil, nil)
+
+		results := service.NewReadinessInvariants(repo, projection, service.ReadinessInvariantOptions{
+			CounterDrift: service.InvariantRule{Severity: service.InvariantSeverityFail, Sample: 2},
+		}).Check(t.Context())
+
+		assert.Equal(t, []service.InvariantResult{{
+			Name: service.InvariantCounterDrift, Status: service.InvariantOK, Detail: "largest difference 0 across 0 profiles",
+		}}, results)
+	})
+
+	t.Run("errors report the severity and results are reused", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewInvariantRepository(t)
+		repo.On("MissingRelations", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+
+		invariants := service.NewReadinessInvariants(repo, nil, service.ReadinessInvariantOptions{
+			CheckInterval: time.Hour,
+			Indexes:       service.InvariantRule{Severity: service.InvariantSeverityWarn},
+			CounterDrift:  service.InvariantRule{Severity: service.InvariantSeverityFail},
+		})
+
+		first := invariants.Check(t.Context())
+		assert.Equal(t, []service.InvariantResult{{
+			Name: service.InvariantIndexes, Status: service.InvariantSeverityWarn, Detail: assert.AnError.Error(),
+		}}, first)
+		assert.Equal(t, first, invariants.Check(t.Context()))
+	})
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// Recently viewed defaults, used when RecentlyViewedOptions leaves them unset.
+const (
+	DefaultRecentlyViewedLimit     = 20
+	DefaultRecentlyViewedRetention = 30 * 24 * time.Hour
+)
+
+// maxRecentlyViewedLookups bounds the profiles resolved at once when listing recently viewed ones.
+const maxRecentlyViewedLookups = 8
+
+// RecentlyViewedService keeps, for each user, the profiles they recently viewed so the apps can
+// offer them again. The list is only shown to its user, and not kept for users who turned off the
+// keep recently viewed privacy preference.
+type RecentlyViewedService interface {
+	// RecordView records that userID viewed the profile of viewedID.
+	RecordView(ctx context.Context, userID, viewedID uuid.UUID) error
+	// GetRecentlyViewed returns the profiles userID recently viewed, most recent first.
+	GetRecentlyViewed(ctx context.Context, userID uuid.UUID) (*dto.RecentlyViewedResponse, error)
+	// ClearRecentlyViewed forgets the profiles userID viewed.
+	ClearRecentlyViewed(ctx context.Context, userID uuid.UUID) error
+}
+
+// RecentlyViewedOptions configures the recently viewed list.
+type RecentlyViewedOptions struct {
+	// Limit is how many profiles the list keeps.
+	Limit int
+	// Retention is how long the list is kept after the user last viewed a profile.
+	Retention time.Duration
+}
+
+// RecentlyViewedServiceImpl implements RecentlyViewedService.
+type RecentlyViewedServiceImpl struct {
+	store    repository.RecentlyViewedStore
+	userRepo repository.UserRepository
+	opts     RecentlyViewedOptions
+}
+
+// NewRecentlyViewedService creates a new RecentlyViewedService.
+func NewRecentlyViewedService(
+	store repository.RecentlyViewedStore,
+	userRepo repository.UserRepository,
+	opts RecentlyViewedOptions,
+) *RecentlyViewedServiceImpl {
+	if opts.Limit <= 0 {
+		opts.Limit = DefaultRecentlyViewedLimit
+	}
+
+	if opts.Retention <= 0 {
+		opts.Retention = DefaultRecentlyViewedRetention
+	}
+
+	return &RecentlyViewedServiceImpl{store: store, userRepo: userRepo, opts: opts}
+}
+
+// RecordView moves viewedID to the front of the list of userID. Users viewing their own profile
+// are not recorded. For users who opted out, what was kept before is dropped instead.
+func (s *RecentlyViewedServiceImpl) RecordView(ctx context.Context, userID, viewedID uuid.UUID) error {
+	if userID == viewedID {
+		return nil
+	}
+
+	keep, err := s.keeping(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !keep {
+		return s.ClearRecentlyViewed(ctx, userID)
+	}
+
+	err = s.store.PushRecentlyViewed(ctx, userID, viewedID, s.opts.Limit, s.opts.Retention)
+	if err != nil {
+		return fmt.Errorf("failed to record recently viewed profile: %w", err)
+	}
+
+	return nil
+}
+
+// GetRecentlyViewed returns the profiles userID recently viewed. Profiles that were deleted,
+// deactivated or made invisible to userID since are left out.
+func (s *RecentlyViewedServiceImpl) GetRecentlyViewed(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.RecentlyViewedResponse, error) {
+	response := &dto.RecentlyViewedResponse{Profiles: []dto.RecentlyViewedProfile{}}
+
+	keep, err := s.keeping(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !keep {
+		return response, s.ClearRecentlyViewed(ctx, userID)
+	}
+
+	response.Recording = true
+
+	viewedIDs, err := s.store.GetRecentlyViewed(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recently viewed profiles: %w", err)
+	}
+
+	profiles := make([]*dto.RecentlyViewedProfile, len(viewedIDs))
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(maxRecentlyViewedLookups)
+
+	for i, viewedID := range viewedIDs {
+		group.Go(func() error {
+			var err error
+
+			profiles[i], err = s.viewedProfile(groupCtx, userID, viewedID)
+
+			return err
+		})
+	}
+
+	err = group.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, profile := range profiles {
+		if profile != nil {
+			response.Profiles = append(response.Profiles, *profile)
+		}
+	}
+
+	return response, nil
+}
+
+// ClearRecentlyViewed drops the list of userID.
+func (s *RecentlyViewedServiceImpl) ClearRecentlyViewed(ctx context.Context, userID uuid.UUID) error {
+	err := s.store.ClearRecentlyViewed(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to clear recently viewed profiles: %w", err)
+	}
+
+	return nil
+}
+
+// keeping reports whether userID keeps the profiles they recently viewed.
+func (s *RecentlyViewedServiceImpl) keeping(ctx context.Context, userID uuid.UUID) (bool, error) {
+	privacy, err := s.userRepo.FindPrivacyPreferencesByUserID(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	return privacy.KeepRecentlyViewed, nil
+}
+
+// viewedProfile returns the profile of viewedID as userID can see it now, or nil if they cannot.
+func (s *RecentlyViewedServiceImpl) viewedProfile(
+	ctx context.Context,
+	userID, viewedID uuid.UUID,
+) (*dto.RecentlyViewedProfile, error) {
+	user, err := s.userRepo.FindUserByID(ctx, viewedID)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	if !user.IsActive {
+		return nil, nil
+	}
+
+	privacy, err := s.userRepo.FindPrivacyPreferencesByUserID(ctx, viewedID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	visible, err := profileVisible(ctx, s.userRepo, userID, viewedID, privacy)
+	if err != nil || !visible {
+		return nil, err
+	}
+
+	profile := &dto.RecentlyViewedProfile{UserID: user.UserID, Username: user.Username}
+	if privacy.ShowFullName {
+		profile.FullName = user.FullName
+	}
+
+	return profile, nil
+}
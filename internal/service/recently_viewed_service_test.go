@@ -0,0 +1,116 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestRecentlyViewedService_RecordView(t *testing.T) {
+	t.Parallel()
+
+	userID, viewedID := uuid.New(), uuid.New()
+	opts := service.RecentlyViewedOptions{Limit: 5, Retention: time.Hour}
+
+	t.Run("views are pushed to the front", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewRecentlyViewedStore(t)
+		store.On("PushRecentlyViewed", mock.Anything, userID, viewedID, 5, time.Hour).Return(nil)
+
+		userRepo := mocks.NewUserRepository(t)
+		userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+			Return(&dto.UserPrivacyPreferences{KeepRecentlyViewed: true}, nil)
+
+		svc := service.NewRecentlyViewedService(store, userRepo, opts)
+
+		require.NoError(t, svc.RecordView(t.Context(), userID, viewedID))
+	})
+
+	t.Run("own profile is not recorded", func(t *testing.T) {
+		t.Parallel()
+
+		svc := service.NewRecentlyViewedService(mocks.NewRecentlyViewedStore(t), mocks.NewUserRepository(t), opts)
+
+		require.NoError(t, svc.RecordView(t.Context(), userID, userID))
+	})
+
+	t.Run("opted out users have their list dropped", func(t *testing.T) {
+		t.Parallel()
+
+		store := mocks.NewRecentlyViewedStore(t)
+		store.On("ClearRecentlyViewed", mock.Anything, userID).Return(nil)
+
+		userRepo := mocks.NewUserRepository(t)
+		userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+			Return(&dto.UserPrivacyPreferences{KeepRecentlyViewed: false}, nil)
+
+		svc := service.NewRecentlyViewedService(store, userRepo, opts)
+
+		require.NoError(t, svc.RecordView(t.Context(), userID, viewedID))
+	})
+}
+
+func TestRecentlyViewedService_GetRecentlyViewed(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	public, private, inactive, deleted := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	fullName := "Public Chef"
+
+	store := mocks.NewRecentlyViewedStore(t)
+	store.On("GetRecentlyViewed", mock.Anything, userID).Return([]uuid.UUID{private, public, inactive, deleted}, nil)
+
+	userRepo := mocks.NewUserRepository(t)
+	userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+		Return(&dto.UserPrivacyPreferences{KeepRecentlyViewed: true}, nil)
+	userRepo.On("FindUserByID", mock.Anything, public).
+		Return(&dto.User{UserID: public.String(), Username: "public", FullName: &fullName, IsActive: true}, nil)
+	userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, public).
+		Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic, ShowFullName: true}, nil)
+	userRepo.On("FindUserByID", mock.Anything, private).
+		Return(&dto.User{UserID: private.String(), Username: "private", IsActive: true}, nil)
+	userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, private).
+		Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}, nil)
+	userRepo.On("FindUserByID", mock.Anything, inactive).
+		Return(&dto.User{UserID: inactive.String(), Username: "inactive"}, nil)
+	userRepo.On("FindUserByID", mock.Anything, deleted).Return(nil, repository.ErrUserNotFound)
+
+	svc := service.NewRecentlyViewedService(store, userRepo, service.RecentlyViewedOptions{})
+
+	response, err := svc.GetRecentlyViewed(t.Context(), userID)
+	require.NoError(t, err)
+	assert.True(t, response.Recording)
+	assert.Equal(t, []dto.RecentlyViewedProfile{
+		{UserID: public.String(), Username: "public", FullName: &fullName},
+	}, response.Profiles, "profiles the user can no longer see are left out")
+}
+
+func TestRecentlyViewedService_GetRecentlyViewed_OptedOut(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	store := mocks.NewRecentlyViewedStore(t)
+	store.On("ClearRecentlyViewed", mock.Anything, userID).Return(nil)
+
+	userRepo := mocks.NewUserRepository(t)
+	userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).
+		Return(&dto.UserPrivacyPreferences{KeepRecentlyViewed: false}, nil)
+
+	svc := service.NewRecentlyViewedService(store, userRepo, service.RecentlyViewedOptions{})
+
+	response, err := svc.GetRecentlyViewed(t.Context(), userID)
+	require.NoError(t, err)
+	assert.False(t, response.Recording)
+	assert.Empty(t, response.Profiles)
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// ErrCannotChangeOwnRole is returned when an admin changes their own role, which could leave the
+// service without admins.
+var ErrCannotChangeOwnRole = errors.New("cannot change own role")
+
+// RoleService manages the roles stored on user accounts, which the authorization checks consult
+// alongside token scopes.
+type RoleService interface {
+	// GetUserRole returns the role of a user.
+	GetUserRole(ctx context.Context, userID uuid.UUID) (*dto.UserRoleResponse, error)
+	// SetUserRole assigns a role to a user on behalf of actorID.
+	SetUserRole(ctx context.Context, actorID, userID uuid.UUID, role dto.UserRole) (*dto.UserRoleResponse, error)
+	// ResolveRole returns the role the user acts with. Unknown users are plain users.
+	ResolveRole(ctx context.Context, userID uuid.UUID) (dto.UserRole, error)
+}
+
+// RoleServiceImpl implements RoleService.
+type RoleServiceImpl struct {
+	roles repository.RoleRepository
+}
+
+// NewRoleService creates a new RoleService.
+func NewRoleService(roles repository.RoleRepository) *RoleServiceImpl {
+	return &RoleServiceImpl{roles: roles}
+}
+
+// GetUserRole returns the role of a user.
+func (s *RoleServiceImpl) GetUserRole(ctx context.Context, userID uuid.UUID) (*dto.UserRoleResponse, error) {
+	role, err := s.roles.GetUserRole(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user role: %w", err)
+	}
+
+	return &dto.UserRoleResponse{UserID: userID.String(), Role: role}, nil
+}
+
+// SetUserRole assigns a role to a user. Admins cannot change their own role.
+func (s *RoleServiceImpl) SetUserRole(
+	ctx context.Context,
+	actorID, userID uuid.UUID,
+	role dto.UserRole,
+) (*dto.UserRoleResponse, error) {
+	if actorID == userID {
+		return nil, ErrCannotChangeOwnRole
+	}
+
+	err := s.roles.SetUserRole(ctx, actorID, userID, role)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to set user role: %w", err)
+	}
+
+	return &dto.UserRoleResponse{UserID: userID.String(), Role: role}, nil
+}
+
+// ResolveRole returns the role the user acts with.
+func (s *RoleServiceImpl) ResolveRole(ctx context.Context, userID uuid.UUID) (dto.UserRole, error) {
+	role, err := s.roles.GetUserRole(ctx, userID)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return dto.UserRoleUser, nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve user role: %w", err)
+	}
+
+	return role, nil
+}
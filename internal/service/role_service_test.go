@@ -0,0 +1,78 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestRoleService_SetUserRole(t *testing.T) {
+	t.Parallel()
+
+	actorID, userID := uuid.New(), uuid.New()
+
+	t.Run("role is assigned on behalf of the actor", func(t *testing.T) {
+		t.Parallel()
+
+		roles := mocks.NewRoleRepository(t)
+		roles.On("SetUserRole", mock.Anything, actorID, userID, dto.UserRoleModerator).Return(nil)
+
+		response, err := service.NewRoleService(roles).SetUserRole(t.Context(), actorID, userID, dto.UserRoleModerator)
+		require.NoError(t, err)
+		assert.Equal(t, &dto.UserRoleResponse{UserID: userID.String(), Role: dto.UserRoleModerator}, response)
+	})
+
+	t.Run("own role cannot be changed", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := service.NewRoleService(mocks.NewRoleRepository(t)).
+			SetUserRole(t.Context(), actorID, actorID, dto.UserRoleUser)
+		require.ErrorIs(t, err, service.ErrCannotChangeOwnRole)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		t.Parallel()
+
+		roles := mocks.NewRoleRepository(t)
+		roles.On("SetUserRole", mock.Anything, actorID, userID, dto.UserRoleAdmin).Return(repository.ErrUserNotFound)
+
+		_, err := service.NewRoleService(roles).SetUserRole(t.Context(), actorID, userID, dto.UserRoleAdmin)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestRoleService_ResolveRole(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("stored role", func(t *testing.T) {
+		t.Parallel()
+
+		roles := mocks.NewRoleRepository(t)
+		roles.On("GetUserRole", mock.Anything, userID).Return(dto.UserRoleAdmin, nil)
+
+		role, err := service.NewRoleService(roles).ResolveRole(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, dto.UserRoleAdmin, role)
+	})
+
+	t.Run("unknown users are plain users", func(t *testing.T) {
+		t.Parallel()
+
+		roles := mocks.NewRoleRepository(t)
+		roles.On("GetUserRole", mock.Anything, userID).Return(dto.UserRole(""), repository.ErrUserNotFound)
+
+		role, err := service.NewRoleService(roles).ResolveRole(t.Context(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, dto.UserRoleUser, role)
+	})
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/metrics"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// searchResultCache reuses user search results for ttl across requests and instances. Concurrent
+// misses on one key share a single search, so a popular query expiring does not send a burst of
+// identical queries to the database.
+type searchResultCache struct {
+	store repository.SearchCache
+	ttl   time.Duration
+	group singleflight.Group
+}
+
+// search returns the results cached under key, or loads and caches them. The cache is best
+// effort: when the store fails the results are loaded as if it were empty.
+func (c *searchResultCache) search(
+	ctx context.Context,
+	key string,
+	load func(ctx context.Context) (*dto.UserSearchResponse, error),
+) (*dto.UserSearchResponse, error) {
+	cached, err := c.store.GetSearchResults(ctx, key)
+
+	switch {
+	case err != nil:
+		metrics.SearchCacheLookupsTotal.WithLabelValues("error").Inc()
+		slog.WarnContext(ctx, "failed to read search cache", "error", err)
+	case cached != nil:
+		metrics.SearchCacheLookupsTotal.WithLabelValues("hit").Inc()
+
+		return cached, nil
+	default:
+		metrics.SearchCacheLookupsTotal.WithLabelValues("miss").Inc()
+	}
+
+	results, err, _ := c.group.Do(key, func() (any, error) {
+		results, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = c.store.SetSearchResults(ctx, key, results, c.ttl)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to cache search results", "error", err)
+		}
+
+		return results, nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // returned by load, already wrapped
+	}
+
+	return results.(*dto.UserSearchResponse), nil //nolint:forcetypeassert // load returns nothing else
+}
+
+// searchCacheKey identifies a search by its normalized query, filters and page. Queries match
+// case-insensitively, so they are lowercased; the key is hashed to bound its length.
+func searchCacheKey(query string, location dto.LocationFilter, limit, offset int, countOnly bool) string {
+	normalized := strings.Join([]string{
+		strings.ToLower(query),
+		location.Country,
+		location.Region,
+		strconv.Itoa(limit),
+		strconv.Itoa(offset),
+		strconv.FormatBool(countOnly),
+	}, "\x00")
+
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// invalidateSearchResults drops the cached search results after a change that can alter them. A
+// failure is only logged: the stale results expire with their TTL.
+func invalidateSearchResults(ctx context.Context, cache repository.SearchCache) {
+	if cache == nil {
+		return
+	}
+
+	err := cache.InvalidateSearchCache(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to invalidate search cache", "error", err)
+	}
+}
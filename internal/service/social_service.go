@@ -4,9 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/logger"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/notification"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
 )
@@ -43,6 +51,35 @@ type SocialService interface {
 		targetUserID uuid.UUID,
 		perTypeLimit int,
 	) (*dto.UserActivityResponse, error)
+	UpdateFollowSettings(
+		ctx context.Context,
+		followerID, targetUserID uuid.UUID,
+		req *dto.FollowSettingsUpdateRequest,
+	) (*dto.FollowSettings, error)
+	GetNotificationRecipients(
+		ctx context.Context,
+		userID uuid.UUID,
+		event dto.FollowNotificationEvent,
+	) (*dto.NotificationRecipientsResponse, error)
+	SetCloseFriend(ctx context.Context, userID, friendID uuid.UUID, closeFriend bool) error
+	GetCloseFriends(
+		ctx context.Context,
+		userID uuid.UUID,
+		limit, offset int,
+		countOnly bool,
+	) (*dto.GetFollowedUsersResponse, error)
+	GetFollowHistory(ctx context.Context, userID uuid.UUID, limit, offset int) (*dto.FollowHistoryResponse, error)
+	// PurgeFollowHistory removes follow history older than retention and returns how many events
+	// were removed. A zero retention keeps the history forever.
+	PurgeFollowHistory(ctx context.Context, retention time.Duration) (int64, error)
+	// GetQuota returns how much of their quotas the user has used.
+	GetQuota(ctx context.Context, userID uuid.UUID) (*dto.QuotaResponse, error)
+	// SetFollowLimit overrides how many users the user may follow. nil restores the configured
+	// limit and zero lifts it.
+	SetFollowLimit(ctx context.Context, userID uuid.UUID, limit *int) (*dto.QuotaResponse, error)
+	// GetDigestPreview assembles what the next activity digest of the user would contain, with
+	// at most perTypeLimit entries per section.
+	GetDigestPreview(ctx context.Context, userID uuid.UUID, perTypeLimit int) (*dto.DigestPreviewResponse, error)
 }
 
 // ErrAccessDenied is returned when access to a resource is denied due to privacy settings.
@@ -57,18 +94,33 @@ var ErrFollowNotAllowed = errors.New("user does not allow follows")
 // ErrCannotUnfollowSelf is returned when a user tries to unfollow themselves.
 var ErrCannotUnfollowSelf = errors.New("cannot unfollow yourself")
 
-// Profile visibility constants.
-const (
-	profileVisibilityPublic        = "public"
-	profileVisibilityFollowersOnly = "followers_only"
-	profileVisibilityPrivate       = "private"
-)
+// ErrNotFollowing is returned when changing the settings of a follow that does not exist.
+var ErrNotFollowing = errors.New("not following user")
+
+// ErrNotAFollower is returned when marking a user who does not follow you as a close friend.
+var ErrNotAFollower = errors.New("user is not a follower")
+
+// ErrFollowLimitReached is returned when a user who follows as many users as allowed follows
+// another.
+var ErrFollowLimitReached = errors.New("follow limit reached")
+
+// defaultActivitySectionTimeout bounds each activity section query, so one slow section cannot hold up
+// the others.
+const defaultActivitySectionTimeout = 2 * time.Second
+
+// defaultDigestPeriod is how far back an activity digest looks unless SetDigestPeriod is called.
+const defaultDigestPeriod = 7 * 24 * time.Hour
 
 // SocialServiceImpl implements SocialService.
 type SocialServiceImpl struct {
 	userRepo           repository.UserRepository
 	socialRepo         repository.SocialRepository
 	notificationClient notification.Client
+	badges             BadgeService
+
+	activitySectionTimeout time.Duration
+	maxFollowing           int
+	digestPeriod           time.Duration
 }
 
 // NewSocialService creates a new SocialService.
@@ -81,9 +133,28 @@ func NewSocialService(
 		userRepo:           userRepo,
 		socialRepo:         socialRepo,
 		notificationClient: notificationClient,
+
+		activitySectionTimeout: defaultActivitySectionTimeout,
+		digestPeriod:           defaultDigestPeriod,
 	}
 }
 
+// SetBadgeService reports new followers to the badges engine. Without it follows earn no badges.
+func (s *SocialServiceImpl) SetBadgeService(badges BadgeService) {
+	s.badges = badges
+}
+
+// SetMaxFollowing caps how many users an account may follow unless an admin set its own limit.
+// Zero, the default, is unlimited. Follows made before the cap stay in place.
+func (s *SocialServiceImpl) SetMaxFollowing(limit int) {
+	s.maxFollowing = limit
+}
+
+// SetDigestPeriod sets how far back an activity digest looks, matching how often digests are sent.
+func (s *SocialServiceImpl) SetDigestPeriod(period time.Duration) {
+	s.digestPeriod = period
+}
+
 // GetFollowing retrieves the list of users that the target user follows.
 func (s *SocialServiceImpl) GetFollowing(
 	ctx context.Context,
@@ -122,7 +193,15 @@ func (s *SocialServiceImpl) GetFollowing(
 		return nil, fmt.Errorf("failed to get following list: %w", err)
 	}
 
-	// 5. Build response
+	// 5. Users viewing their own list also see the notification settings of each follow
+	if requesterID == targetUserID && !countOnly {
+		err = s.attachFollowSettings(ctx, requesterID, users)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 6. Build response
 	return s.buildFollowingResponse(users, totalCount, limit, offset, countOnly), nil
 }
 
@@ -202,20 +281,36 @@ func (s *SocialServiceImpl) FollowUser(
 		return nil, ErrFollowNotAllowed
 	}
 
-	// 4. Create follow relationship (idempotent - duplicate follows are OK)
+	// 4. Check the follower's follow limit
+	err = s.checkFollowLimit(ctx, followerID, targetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. Create follow relationship (idempotent - duplicate follows are OK)
 	err = s.socialRepo.FollowUser(ctx, followerID, targetUserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to follow user: %w", err)
 	}
 
-	// 5. Send notification (fire-and-forget)
+	logger.Events().FollowCreated(ctx, followerID, targetUserID)
+
+	// 6. Send notification (fire-and-forget)
 	// Use context.Background() to decouple from request context so notification
 	// continues even if the request is cancelled.
 	if s.notificationClient != nil {
 		go s.notificationClient.NotifyNewFollower(context.Background(), targetUserID, followerID) //nolint:contextcheck
 	}
 
-	// 6. Return success response
+	// 7. Report the new follower; a lost event is made up by the target's next one
+	if s.badges != nil {
+		err = s.badges.RecordEvent(ctx, targetUserID, dto.BadgeEventFollowerGained)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to record badge event", "user_id", targetUserID, "error", err)
+		}
+	}
+
+	// 8. Return success response
 	return &dto.FollowResponse{
 		Message:     "Successfully followed user",
 		IsFollowing: true,
@@ -315,9 +410,9 @@ func (s *SocialServiceImpl) CheckFollowing(
 	}, nil
 }
 
-// GetUserActivity retrieves a user's recent activity with privacy checks.
-//
-//nolint:cyclop,funlen // Complexity and length are from sequential error checks which are clear and necessary
+// GetUserActivity retrieves a user's recent activity with privacy checks. The activity sections are
+// fetched concurrently, each under its own timeout; a section that fails is returned empty and
+// listed in UnavailableSections, and only a request where every section fails returns an error.
 func (s *SocialServiceImpl) GetUserActivity(
 	ctx context.Context,
 	requesterID *uuid.UUID,
@@ -348,51 +443,143 @@ func (s *SocialServiceImpl) GetUserActivity(
 		return nil, ErrAccessDenied
 	}
 
-	// 3. Fetch all activity data
-	recipes, err := s.socialRepo.GetRecentRecipes(ctx, targetUserID, perTypeLimit)
+	// 3. Fetch all activity sections concurrently
+	response := &dto.UserActivityResponse{UserID: targetUserID.String()}
+
+	fetch := activityFetcher{ctx: ctx, timeout: s.activitySectionTimeout}
+	fetch.section(dto.ActivitySectionRecipes, func(ctx context.Context) (err error) {
+		response.RecentRecipes, err = s.socialRepo.GetRecentRecipes(ctx, targetUserID, perTypeLimit)
+		return err
+	})
+	fetch.section(dto.ActivitySectionFollows, func(ctx context.Context) (err error) {
+		response.RecentFollows, err = s.socialRepo.GetRecentFollows(ctx, targetUserID, perTypeLimit)
+		return err
+	})
+	fetch.section(dto.ActivitySectionReviews, func(ctx context.Context) (err error) {
+		response.RecentReviews, err = s.socialRepo.GetRecentReviews(ctx, targetUserID, perTypeLimit)
+		return err
+	})
+	fetch.section(dto.ActivitySectionFavorites, func(ctx context.Context) (err error) {
+		response.RecentFavorites, err = s.socialRepo.GetRecentFavorites(ctx, targetUserID, perTypeLimit)
+		return err
+	})
+
+	response.UnavailableSections, err = fetch.unavailable(dto.ActivitySections, targetUserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get recent recipes: %w", err)
+		return nil, fmt.Errorf("failed to get user activity: %w", err)
 	}
 
-	follows, err := s.socialRepo.GetRecentFollows(ctx, targetUserID, perTypeLimit)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get recent follows: %w", err)
+	// 4. Ensure slices are not nil (return empty arrays in JSON)
+	if response.RecentRecipes == nil {
+		response.RecentRecipes = []dto.RecipeSummary{}
 	}
 
-	reviews, err := s.socialRepo.GetRecentReviews(ctx, targetUserID, perTypeLimit)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get recent reviews: %w", err)
+	if response.RecentFollows == nil {
+		response.RecentFollows = []dto.UserSummary{}
+	}
+
+	if response.RecentReviews == nil {
+		response.RecentReviews = []dto.ReviewSummary{}
 	}
 
-	favorites, err := s.socialRepo.GetRecentFavorites(ctx, targetUserID, perTypeLimit)
+	if response.RecentFavorites == nil {
+		response.RecentFavorites = []dto.FavoriteSummary{}
+	}
+
+	return response, nil
+}
+
+// GetDigestPreview assembles the next activity digest of userID from the same section queries as
+// the activity feed: who started following them and what the users they follow published since the
+// start of the digest period. Sections that fail are reported as unavailable.
+func (s *SocialServiceImpl) GetDigestPreview(
+	ctx context.Context,
+	userID uuid.UUID,
+	perTypeLimit int,
+) (*dto.DigestPreviewResponse, error) {
+	response := &dto.DigestPreviewResponse{Since: time.Now().Add(-s.digestPeriod)}
+
+	fetch := activityFetcher{ctx: ctx, timeout: s.activitySectionTimeout}
+	fetch.section(dto.DigestSectionNewFollowers, func(ctx context.Context) (err error) {
+		response.NewFollowers, err = s.socialRepo.GetNewFollowers(ctx, userID, response.Since, perTypeLimit)
+		return err
+	})
+	fetch.section(dto.DigestSectionFollowedRecipes, func(ctx context.Context) (err error) {
+		response.FollowedRecipes, err = s.socialRepo.GetFollowedRecipes(ctx, userID, response.Since, perTypeLimit)
+		return err
+	})
+
+	var err error
+
+	response.UnavailableSections, err = fetch.unavailable(dto.DigestSections, userID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get recent favorites: %w", err)
+		return nil, fmt.Errorf("failed to preview digest: %w", err)
 	}
 
-	// 4. Ensure slices are not nil (return empty arrays in JSON)
-	if recipes == nil {
-		recipes = []dto.RecipeSummary{}
+	if response.NewFollowers == nil {
+		response.NewFollowers = []dto.UserSummary{}
 	}
 
-	if follows == nil {
-		follows = []dto.UserSummary{}
+	if response.FollowedRecipes == nil {
+		response.FollowedRecipes = []dto.DigestRecipe{}
 	}
 
-	if reviews == nil {
-		reviews = []dto.ReviewSummary{}
+	return response, nil
+}
+
+// activityFetcher runs activity section queries concurrently and collects their failures.
+type activityFetcher struct {
+	ctx     context.Context //nolint:containedctx // scoped to a single activity or digest fetch
+	timeout time.Duration
+	group   errgroup.Group
+
+	mu       sync.Mutex
+	failures map[string]error
+}
+
+// section starts load under the per-section timeout. A failure is recorded rather than returned
+// so the other sections still complete.
+func (f *activityFetcher) section(name string, load func(ctx context.Context) error) {
+	f.group.Go(func() error {
+		ctx, cancel := context.WithTimeout(f.ctx, f.timeout)
+		defer cancel()
+
+		err := load(ctx)
+		if err != nil {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			if f.failures == nil {
+				f.failures = make(map[string]error)
+			}
+
+			f.failures[name] = fmt.Errorf("failed to get %s: %w", name, err)
+		}
+
+		return nil
+	})
+}
+
+// unavailable blocks until every section has finished and returns the failed ones, in the order
+// of sections, logging why. It fails if every section did.
+func (f *activityFetcher) unavailable(sections []string, userID uuid.UUID) ([]string, error) {
+	_ = f.group.Wait()
+
+	if len(f.failures) == len(sections) {
+		return nil, errors.Join(slices.Collect(maps.Values(f.failures))...)
 	}
 
-	if favorites == nil {
-		favorites = []dto.FavoriteSummary{}
+	var unavailable []string
+
+	for _, section := range sections {
+		if err, failed := f.failures[section]; failed {
+			slog.WarnContext(f.ctx, "activity section unavailable", "section", section, "user_id", userID, "error", err)
+
+			unavailable = append(unavailable, section)
+		}
 	}
 
-	return &dto.UserActivityResponse{
-		UserID:          targetUserID.String(),
-		RecentRecipes:   recipes,
-		RecentFollows:   follows,
-		RecentReviews:   reviews,
-		RecentFavorites: favorites,
-	}, nil
+	return unavailable, nil
 }
 
 // canAccessUserActivity checks if requester can view target's activity.
@@ -412,22 +599,48 @@ func (s *SocialServiceImpl) canAccessUserActivity(
 		return false, fmt.Errorf("failed to fetch privacy preferences: %w", err)
 	}
 
-	switch privacy.ProfileVisibility {
-	case profileVisibilityPublic:
+	return activityVisible(ctx, s.userRepo, s.socialRepo, privacy, requesterID, targetUserID)
+}
+
+// activityVisible checks if requester can view the activity of a target user with the given
+// privacy preferences. It is shared by the activity feed and presence.
+func activityVisible(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	socialRepo repository.SocialRepository,
+	privacy *dto.UserPrivacyPreferences,
+	requesterID *uuid.UUID,
+	targetUserID uuid.UUID,
+) (bool, error) {
+	// Activity follows the stricter of the activity and profile visibility
+	switch privacy.EffectiveActivityVisibility() {
+	case dto.ActivityVisibilityPublic:
 		return true, nil
-	case profileVisibilityFollowersOnly:
+	case dto.ActivityVisibilityFriendsOnly:
 		// Anonymous users cannot access followers_only profiles
 		if requesterID == nil {
 			return false, nil
 		}
 		// Check if requester follows the target user
-		isFollowing, err := s.userRepo.IsFollowing(ctx, *requesterID, targetUserID)
+		isFollowing, err := userRepo.IsFollowing(ctx, *requesterID, targetUserID)
 		if err != nil {
 			return false, fmt.Errorf("failed to check following status: %w", err)
 		}
 
 		return isFollowing, nil
-	case profileVisibilityPrivate:
+	case dto.ActivityVisibilityCloseFriends:
+		// Only followers the target marked as close friends, a subset of followers_only
+		if requesterID == nil {
+			return false, nil
+		}
+
+		isCloseFriend, err := socialRepo.IsCloseFriend(ctx, targetUserID, *requesterID)
+		if err != nil {
+			return false, fmt.Errorf("failed to check close friend status: %w", err)
+		}
+
+		return isCloseFriend, nil
+	case dto.ActivityVisibilityPrivate:
 		return false, nil
 	default:
 		return false, nil
@@ -450,9 +663,9 @@ func (s *SocialServiceImpl) canAccessFollowingList(
 	}
 
 	switch privacy.ProfileVisibility {
-	case profileVisibilityPublic:
+	case dto.ProfileVisibilityPublic:
 		return true, nil
-	case profileVisibilityFollowersOnly:
+	case dto.ProfileVisibilityFriendsOnly:
 		// Check if requester follows the target user
 		isFollowing, err := s.userRepo.IsFollowing(ctx, requesterID, targetUserID)
 		if err != nil {
@@ -460,13 +673,261 @@ func (s *SocialServiceImpl) canAccessFollowingList(
 		}
 
 		return isFollowing, nil
-	case profileVisibilityPrivate:
+	case dto.ProfileVisibilityPrivate:
 		return false, nil
 	default:
 		return false, nil
 	}
 }
 
+// UpdateFollowSettings changes the notification settings of followerID's follow of targetUserID.
+func (s *SocialServiceImpl) UpdateFollowSettings(
+	ctx context.Context,
+	followerID, targetUserID uuid.UUID,
+	req *dto.FollowSettingsUpdateRequest,
+) (*dto.FollowSettings, error) {
+	settings, err := s.socialRepo.UpdateFollowSettings(ctx, followerID, targetUserID, req)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFollowing) {
+			return nil, ErrNotFollowing
+		}
+
+		return nil, fmt.Errorf("failed to update follow settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// GetNotificationRecipients returns the followers of userID to notify of event, leaving out
+// followers who muted userID or turned that kind of notification off.
+func (s *SocialServiceImpl) GetNotificationRecipients(
+	ctx context.Context,
+	userID uuid.UUID,
+	event dto.FollowNotificationEvent,
+) (*dto.NotificationRecipientsResponse, error) {
+	// 1. Verify user exists and is active
+	user, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	if !user.IsActive {
+		return nil, ErrUserNotFound
+	}
+
+	// 2. Collect followers whose settings allow the event
+	recipients, err := s.socialRepo.GetNotificationRecipients(ctx, userID, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification recipients: %w", err)
+	}
+
+	response := &dto.NotificationRecipientsResponse{
+		UserID:       userID.String(),
+		Event:        event,
+		RecipientIDs: make([]string, 0, len(recipients)),
+	}
+
+	for _, id := range recipients {
+		response.RecipientIDs = append(response.RecipientIDs, id.String())
+	}
+
+	return response, nil
+}
+
+// SetCloseFriend marks or unmarks friendID as a close friend of userID. Only followers of userID can
+// be close friends; unmarking a user who is not a close friend succeeds.
+func (s *SocialServiceImpl) SetCloseFriend(ctx context.Context, userID, friendID uuid.UUID, closeFriend bool) error {
+	err := s.socialRepo.SetCloseFriend(ctx, userID, friendID, closeFriend)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFollowing) {
+			if !closeFriend {
+				return nil
+			}
+
+			return ErrNotAFollower
+		}
+
+		return fmt.Errorf("failed to update close friend: %w", err)
+	}
+
+	return nil
+}
+
+// GetCloseFriends retrieves the close friends of userID.
+func (s *SocialServiceImpl) GetCloseFriends(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset int,
+	countOnly bool,
+) (*dto.GetFollowedUsersResponse, error) {
+	users, totalCount, err := s.socialRepo.GetCloseFriends(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get close friends: %w", err)
+	}
+
+	return s.buildFollowingResponse(users, totalCount, limit, offset, countOnly), nil
+}
+
+// GetFollowHistory returns a page of the follows and unfollows of userID, newest first.
+func (s *SocialServiceImpl) GetFollowHistory(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset int,
+) (*dto.FollowHistoryResponse, error) {
+	events, totalCount, err := s.socialRepo.GetFollowHistory(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follow history: %w", err)
+	}
+
+	return &dto.FollowHistoryResponse{
+		TotalCount: totalCount,
+		Events:     events,
+		Limit:      limit,
+		Offset:     offset,
+	}, nil
+}
+
+// PurgeFollowHistory removes follow history older than retention.
+func (s *SocialServiceImpl) PurgeFollowHistory(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		return 0, nil
+	}
+
+	deleted, err := s.socialRepo.DeleteFollowEventsBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge follow history: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// GetQuota returns how much of their quotas the user has used.
+func (s *SocialServiceImpl) GetQuota(ctx context.Context, userID uuid.UUID) (*dto.QuotaResponse, error) {
+	used, err := s.socialRepo.CountFollowing(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count following: %w", err)
+	}
+
+	limit, overridden, err := s.followLimit(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := dto.QuotaUsage{Used: used, Overridden: overridden}
+	if limit > 0 {
+		usage.Limit = &limit
+	}
+
+	return &dto.QuotaResponse{Following: usage}, nil
+}
+
+// SetFollowLimit overrides how many users the user may follow.
+func (s *SocialServiceImpl) SetFollowLimit(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit *int,
+) (*dto.QuotaResponse, error) {
+	_, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	err = s.socialRepo.SetFollowLimit(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set follow limit: %w", err)
+	}
+
+	return s.GetQuota(ctx, userID)
+}
+
+// followLimit returns how many users userID may follow, zero for any number, and whether an admin
+// set it.
+func (s *SocialServiceImpl) followLimit(ctx context.Context, userID uuid.UUID) (int, bool, error) {
+	override, err := s.socialRepo.GetFollowLimit(ctx, userID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get follow limit: %w", err)
+	}
+
+	if override != nil {
+		return *override, true, nil
+	}
+
+	return s.maxFollowing, false, nil
+}
+
+// checkFollowLimit fails with ErrFollowLimitReached if followerID may not follow anyone else.
+// Following a user again is always allowed, and follows beyond a lowered limit are kept.
+func (s *SocialServiceImpl) checkFollowLimit(ctx context.Context, followerID, targetUserID uuid.UUID) error {
+	limit, _, err := s.followLimit(ctx, followerID)
+	if err != nil || limit == 0 {
+		return err
+	}
+
+	following, err := s.socialRepo.CountFollowing(ctx, followerID)
+	if err != nil {
+		return fmt.Errorf("failed to count following: %w", err)
+	}
+
+	if following < limit {
+		return nil
+	}
+
+	followedAt, err := s.socialRepo.CheckFollowing(ctx, followerID, targetUserID)
+	if err != nil {
+		return fmt.Errorf("failed to check following: %w", err)
+	}
+
+	if followedAt != nil {
+		return nil
+	}
+
+	slog.InfoContext(ctx, "follow limit reached", "user_id", followerID, "limit", limit)
+
+	return fmt.Errorf("%w: at most %d", ErrFollowLimitReached, limit)
+}
+
+// attachFollowSettings sets the follow settings of followerID's follow on each followed user.
+func (s *SocialServiceImpl) attachFollowSettings(ctx context.Context, followerID uuid.UUID, users []dto.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(users))
+
+	for _, user := range users {
+		id, err := uuid.Parse(user.UserID)
+		if err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	settings, err := s.socialRepo.GetFollowSettings(ctx, followerID, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get follow settings: %w", err)
+	}
+
+	for i := range users {
+		id, err := uuid.Parse(users[i].UserID)
+		if err != nil {
+			continue
+		}
+
+		if followSettings, ok := settings[id]; ok {
+			users[i].FollowSettings = &followSettings
+		}
+	}
+
+	return nil
+}
+
 func (s *SocialServiceImpl) buildFollowingResponse(
 	users []dto.User,
 	totalCount, limit, offset int,
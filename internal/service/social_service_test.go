@@ -13,279 +13,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 )
 
-const mockSocialErrorFmt = "mock error: %w"
-
-var (
-	errMockSocialArgs    = errors.New("mock: missing args")
-	errMockSocialUser    = errors.New("invalid type assertion for User")
-	errMockSocialPrivacy = errors.New("invalid type assertion for PrivacyPreferences")
-	errRepoSocial        = errors.New("repository error")
-)
-
-// MockUserRepoForSocial is a mock implementation of repository.UserRepository.
-type MockUserRepoForSocial struct {
-	mock.Mock
-}
-
-func (m *MockUserRepoForSocial) FindUserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf(mockSocialErrorFmt, err)
-		}
-
-		return nil, errMockSocialArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.User); ok {
-		return val, nil
-	}
-
-	return nil, errMockSocialUser
-}
-
-func (m *MockUserRepoForSocial) FindPrivacyPreferencesByUserID(
-	ctx context.Context,
-	userID uuid.UUID,
-) (*dto.PrivacyPreferences, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf(mockSocialErrorFmt, err)
-		}
-
-		return nil, errMockSocialArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.PrivacyPreferences); ok {
-		return val, nil
-	}
-
-	return nil, errMockSocialPrivacy
-}
-
-func (m *MockUserRepoForSocial) IsFollowing(
-	ctx context.Context,
-	followerID, followedID uuid.UUID,
-) (bool, error) {
-	args := m.Called(ctx, followerID, followedID)
-
-	err := args.Error(1)
-	if err != nil {
-		return args.Bool(0), fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	return args.Bool(0), nil
-}
-
-func (m *MockUserRepoForSocial) UpdateUser(
-	ctx context.Context,
-	userID uuid.UUID,
-	update *dto.UserProfileUpdateRequest,
-) (*dto.User, error) {
-	args := m.Called(ctx, userID, update)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf(mockSocialErrorFmt, err)
-		}
-
-		return nil, errMockSocialArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.User); ok {
-		return val, nil
-	}
-
-	return nil, errMockSocialUser
-}
-
-func (m *MockUserRepoForSocial) SearchUsers(
-	ctx context.Context,
-	query string,
-	limit, offset int,
-) ([]dto.UserSearchResult, int, error) {
-	args := m.Called(ctx, query, limit, offset)
-
-	err := args.Error(2)
-	if err != nil {
-		return nil, 0, fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	results, _ := args.Get(0).([]dto.UserSearchResult)
-
-	return results, args.Int(1), nil
-}
-
-func (m *MockUserRepoForSocial) GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error) {
-	args := m.Called(ctx)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	result, _ := args.Get(0).(*dto.UserStatsResponse)
-
-	return result, nil
-}
-
-// MockSocialRepo is a mock implementation of repository.SocialRepository.
-type MockSocialRepo struct {
-	mock.Mock
-}
-
-func (m *MockSocialRepo) GetFollowing(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit, offset int,
-) ([]dto.User, int, error) {
-	args := m.Called(ctx, userID, limit, offset)
-
-	err := args.Error(2)
-	if err != nil {
-		return nil, 0, fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	users, _ := args.Get(0).([]dto.User)
-
-	return users, args.Int(1), nil
-}
-
-func (m *MockSocialRepo) GetFollowers(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit, offset int,
-) ([]dto.User, int, error) {
-	args := m.Called(ctx, userID, limit, offset)
-
-	err := args.Error(2)
-	if err != nil {
-		return nil, 0, fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	users, _ := args.Get(0).([]dto.User)
-
-	return users, args.Int(1), nil
-}
-
-func (m *MockSocialRepo) FollowUser(
-	ctx context.Context,
-	followerID, followeeID uuid.UUID,
-) error {
-	args := m.Called(ctx, followerID, followeeID)
-
-	err := args.Error(0)
-	if err != nil {
-		return fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	return nil
-}
-
-func (m *MockSocialRepo) UnfollowUser(
-	ctx context.Context,
-	followerID, followeeID uuid.UUID,
-) error {
-	args := m.Called(ctx, followerID, followeeID)
-
-	err := args.Error(0)
-	if err != nil {
-		return fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	return nil
-}
-
-func (m *MockSocialRepo) GetRecentRecipes(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.RecipeSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	recipes, _ := args.Get(0).([]dto.RecipeSummary)
-
-	return recipes, nil
-}
-
-func (m *MockSocialRepo) GetRecentFollows(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.UserSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	follows, _ := args.Get(0).([]dto.UserSummary)
-
-	return follows, nil
-}
-
-func (m *MockSocialRepo) GetRecentReviews(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.ReviewSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	reviews, _ := args.Get(0).([]dto.ReviewSummary)
-
-	return reviews, nil
-}
-
-func (m *MockSocialRepo) GetRecentFavorites(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.FavoriteSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	favorites, _ := args.Get(0).([]dto.FavoriteSummary)
-
-	return favorites, nil
-}
-
-func (m *MockSocialRepo) CheckFollowing(
-	ctx context.Context,
-	followerID, followeeID uuid.UUID,
-) (*time.Time, error) {
-	args := m.Called(ctx, followerID, followeeID)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockSocialErrorFmt, err)
-	}
-
-	followedAt, _ := args.Get(0).(*time.Time)
-
-	return followedAt, nil
-}
+var errRepoSocial = errors.New("repository error")
 
 func createTestUser(userID uuid.UUID, isActive bool) *dto.User {
 	now := time.Now()
@@ -332,11 +65,11 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Success - public profile returns following list", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 		followedUsers := createFollowedUsers(2)
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
@@ -362,17 +95,22 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Success - viewing own following list with private profile", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		ownUser := createTestUser(requesterID, true)
-		privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+		privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 		followedUsers := createFollowedUsers(1)
 
 		// User viewing their own list - no privacy check needed beyond initial fetch
 		mockUserRepo.On("FindUserByID", mock.Anything, requesterID).Return(ownUser, nil).Once()
 		mockSocialRepo.On("GetFollowing", mock.Anything, requesterID, 20, 0).Return(followedUsers, 1, nil).Once()
 
+		followedID := uuid.MustParse(followedUsers[0].UserID)
+		muted := dto.FollowSettings{Muted: true, NotifyNewRecipes: true, NotifyReviews: true}
+		mockSocialRepo.On("GetFollowSettings", mock.Anything, requesterID, []uuid.UUID{followedID}).
+			Return(map[uuid.UUID]dto.FollowSettings{followedID: muted}, nil).Once()
+
 		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
 		resp, err := svc.GetFollowing(context.Background(), requesterID, requesterID, 20, 0, false)
 
@@ -380,6 +118,10 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 		require.NotNil(t, resp)
 		assert.Equal(t, 1, resp.TotalCount)
 
+		// Settings are only attached when viewing one's own list
+		require.NotNil(t, resp.FollowedUsers[0].FollowSettings)
+		assert.True(t, resp.FollowedUsers[0].FollowSettings.Muted)
+
 		// Privacy preferences should not be fetched when viewing own list
 		mockUserRepo.AssertNotCalled(t, "FindPrivacyPreferencesByUserID", mock.Anything, mock.Anything)
 		mockUserRepo.AssertExpectations(t)
@@ -391,11 +133,11 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Success - followers_only profile when requester follows target", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 		followedUsers := createFollowedUsers(3)
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
@@ -417,11 +159,11 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Success - countOnly mode", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
@@ -444,11 +186,11 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Success - empty following list", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
@@ -469,8 +211,8 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Error - user not found", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(nil, repository.ErrUserNotFound).Once()
 
@@ -488,8 +230,8 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Error - user inactive", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		inactiveUser := createTestUser(targetID, false)
 
@@ -509,11 +251,11 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Error - access denied for private profile", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+		privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(privatePrivacy, nil).Once()
@@ -532,11 +274,11 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Error - access denied for followers_only when not following", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(followersOnlyPrivacy, nil).Once()
@@ -556,11 +298,11 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Error - repository error on GetFollowing", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
@@ -580,8 +322,8 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Error - repository error on FindUserByID", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(nil, errRepoSocial).Once()
 
@@ -598,8 +340,8 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Error - repository error on FindPrivacyPreferencesByUserID", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
 
@@ -619,11 +361,11 @@ func TestSocialServiceGetFollowing(t *testing.T) {
 	t.Run("Error - repository error on IsFollowing", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(followersOnlyPrivacy, nil).Once()
@@ -650,14 +392,15 @@ func TestSocialServiceFollowUser(t *testing.T) {
 	t.Run("Success - follow user with public profile", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{AllowFollows: true}
+		publicPrivacy := &dto.UserPrivacyPreferences{AllowFollows: true}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
+		mockSocialRepo.On("GetFollowLimit", mock.Anything, requesterID).Return(nil, nil).Once()
 		mockSocialRepo.On("FollowUser", mock.Anything, requesterID, targetID).Return(nil).Once()
 
 		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
@@ -672,18 +415,41 @@ func TestSocialServiceFollowUser(t *testing.T) {
 		mockSocialRepo.AssertExpectations(t)
 	})
 
+	t.Run("Success - reports the new follower for badges", func(t *testing.T) {
+		t.Parallel()
+
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
+		badgeSvc := mocks.NewBadgeService(t)
+
+		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(createTestUser(targetID, true), nil).Once()
+		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).
+			Return(&dto.UserPrivacyPreferences{AllowFollows: true}, nil).Once()
+		mockSocialRepo.On("GetFollowLimit", mock.Anything, requesterID).Return(nil, nil).Once()
+		mockSocialRepo.On("FollowUser", mock.Anything, requesterID, targetID).Return(nil).Once()
+		badgeSvc.On("RecordEvent", mock.Anything, targetID, dto.BadgeEventFollowerGained).Return(errDB)
+
+		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+		svc.SetBadgeService(badgeSvc)
+
+		resp, err := svc.FollowUser(context.Background(), requesterID, targetID)
+		require.NoError(t, err, "badge events do not fail the follow")
+		assert.True(t, resp.IsFollowing)
+	})
+
 	t.Run("Success - idempotent follow (already following)", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{AllowFollows: true}
+		publicPrivacy := &dto.UserPrivacyPreferences{AllowFollows: true}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
 		// FollowUser still succeeds due to ON CONFLICT DO NOTHING
+		mockSocialRepo.On("GetFollowLimit", mock.Anything, requesterID).Return(nil, nil).Once()
 		mockSocialRepo.On("FollowUser", mock.Anything, requesterID, targetID).Return(nil).Once()
 
 		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
@@ -700,8 +466,8 @@ func TestSocialServiceFollowUser(t *testing.T) {
 	t.Run("Error - cannot follow self", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
 		resp, err := svc.FollowUser(context.Background(), requesterID, requesterID)
@@ -718,8 +484,8 @@ func TestSocialServiceFollowUser(t *testing.T) {
 	t.Run("Error - target user not found", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(nil, repository.ErrUserNotFound).Once()
 
@@ -737,8 +503,8 @@ func TestSocialServiceFollowUser(t *testing.T) {
 	t.Run("Error - target user inactive", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		inactiveUser := createTestUser(targetID, false)
 
@@ -758,11 +524,11 @@ func TestSocialServiceFollowUser(t *testing.T) {
 	t.Run("Error - target user does not allow follows", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		noFollowsPrivacy := &dto.PrivacyPreferences{AllowFollows: false}
+		noFollowsPrivacy := &dto.UserPrivacyPreferences{AllowFollows: false}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(noFollowsPrivacy, nil).Once()
@@ -781,8 +547,8 @@ func TestSocialServiceFollowUser(t *testing.T) {
 	t.Run("Error - repository error on FindUserByID", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(nil, errRepoSocial).Once()
 
@@ -799,8 +565,8 @@ func TestSocialServiceFollowUser(t *testing.T) {
 	t.Run("Error - repository error on FindPrivacyPreferencesByUserID", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
 
@@ -820,14 +586,15 @@ func TestSocialServiceFollowUser(t *testing.T) {
 	t.Run("Error - repository error on FollowUser", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{AllowFollows: true}
+		publicPrivacy := &dto.UserPrivacyPreferences{AllowFollows: true}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
+		mockSocialRepo.On("GetFollowLimit", mock.Anything, requesterID).Return(nil, nil).Once()
 		mockSocialRepo.On("FollowUser", mock.Anything, requesterID, targetID).Return(errRepoSocial).Once()
 
 		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
@@ -842,6 +609,127 @@ func TestSocialServiceFollowUser(t *testing.T) {
 	})
 }
 
+func TestSocialServiceFollowUser_FollowLimit(t *testing.T) {
+	t.Parallel()
+
+	requesterID := uuid.New()
+	targetID := uuid.New()
+
+	newService := func(t *testing.T) (*service.SocialServiceImpl, *mocks.SocialRepository) {
+		t.Helper()
+
+		userRepo := mocks.NewUserRepository(t)
+		socialRepo := mocks.NewSocialRepository(t)
+
+		userRepo.On("FindUserByID", mock.Anything, targetID).Return(createTestUser(targetID, true), nil)
+		userRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).
+			Return(&dto.UserPrivacyPreferences{AllowFollows: true}, nil)
+
+		svc := service.NewSocialService(userRepo, socialRepo, nil)
+		svc.SetMaxFollowing(2)
+
+		return svc, socialRepo
+	}
+
+	t.Run("refuses new follows at the limit", func(t *testing.T) {
+		t.Parallel()
+
+		svc, socialRepo := newService(t)
+		socialRepo.On("GetFollowLimit", mock.Anything, requesterID).Return(nil, nil)
+		socialRepo.On("CountFollowing", mock.Anything, requesterID).Return(2, nil)
+		socialRepo.On("CheckFollowing", mock.Anything, requesterID, targetID).Return(nil, nil)
+
+		_, err := svc.FollowUser(context.Background(), requesterID, targetID)
+		require.ErrorIs(t, err, service.ErrFollowLimitReached)
+	})
+
+	t.Run("allows following a followed user again", func(t *testing.T) {
+		t.Parallel()
+
+		followedAt := time.Now()
+
+		svc, socialRepo := newService(t)
+		socialRepo.On("GetFollowLimit", mock.Anything, requesterID).Return(nil, nil)
+		socialRepo.On("CountFollowing", mock.Anything, requesterID).Return(3, nil)
+		socialRepo.On("CheckFollowing", mock.Anything, requesterID, targetID).Return(&followedAt, nil)
+		socialRepo.On("FollowUser", mock.Anything, requesterID, targetID).Return(nil)
+
+		_, err := svc.FollowUser(context.Background(), requesterID, targetID)
+		require.NoError(t, err)
+	})
+
+	t.Run("an override of zero lifts the limit", func(t *testing.T) {
+		t.Parallel()
+
+		unlimited := 0
+
+		svc, socialRepo := newService(t)
+		socialRepo.On("GetFollowLimit", mock.Anything, requesterID).Return(&unlimited, nil)
+		socialRepo.On("FollowUser", mock.Anything, requesterID, targetID).Return(nil)
+
+		_, err := svc.FollowUser(context.Background(), requesterID, targetID)
+		require.NoError(t, err)
+	})
+}
+
+func TestSocialServiceQuota(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("reports usage against the configured limit", func(t *testing.T) {
+		t.Parallel()
+
+		socialRepo := mocks.NewSocialRepository(t)
+		socialRepo.On("CountFollowing", mock.Anything, userID).Return(12, nil)
+		socialRepo.On("GetFollowLimit", mock.Anything, userID).Return(nil, nil)
+
+		svc := service.NewSocialService(nil, socialRepo, nil)
+		svc.SetMaxFollowing(7500)
+
+		quota, err := svc.GetQuota(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 12, quota.Following.Used)
+		require.NotNil(t, quota.Following.Limit)
+		assert.Equal(t, 7500, *quota.Following.Limit)
+		assert.False(t, quota.Following.Overridden)
+	})
+
+	t.Run("admins override the limit", func(t *testing.T) {
+		t.Parallel()
+
+		limit := 10000
+
+		userRepo := mocks.NewUserRepository(t)
+		userRepo.On("FindUserByID", mock.Anything, userID).Return(createTestUser(userID, true), nil)
+
+		socialRepo := mocks.NewSocialRepository(t)
+		socialRepo.On("SetFollowLimit", mock.Anything, userID, &limit).Return(nil)
+		socialRepo.On("CountFollowing", mock.Anything, userID).Return(12, nil)
+		socialRepo.On("GetFollowLimit", mock.Anything, userID).Return(&limit, nil)
+
+		svc := service.NewSocialService(userRepo, socialRepo, nil)
+		svc.SetMaxFollowing(7500)
+
+		quota, err := svc.SetFollowLimit(context.Background(), userID, &limit)
+		require.NoError(t, err)
+		assert.Equal(t, &limit, quota.Following.Limit)
+		assert.True(t, quota.Following.Overridden)
+	})
+
+	t.Run("unknown users", func(t *testing.T) {
+		t.Parallel()
+
+		userRepo := mocks.NewUserRepository(t)
+		userRepo.On("FindUserByID", mock.Anything, userID).Return(nil, repository.ErrUserNotFound)
+
+		svc := service.NewSocialService(userRepo, mocks.NewSocialRepository(t), nil)
+
+		_, err := svc.SetFollowLimit(context.Background(), userID, nil)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
 //nolint:funlen // test with many test cases
 func TestSocialServiceUnfollowUser(t *testing.T) {
 	t.Parallel()
@@ -852,8 +740,8 @@ func TestSocialServiceUnfollowUser(t *testing.T) {
 	t.Run("Success - unfollow user", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
 
@@ -875,8 +763,8 @@ func TestSocialServiceUnfollowUser(t *testing.T) {
 	t.Run("Success - idempotent unfollow (not following)", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
 
@@ -898,8 +786,8 @@ func TestSocialServiceUnfollowUser(t *testing.T) {
 	t.Run("Error - cannot unfollow self", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
 		resp, err := svc.UnfollowUser(context.Background(), requesterID, requesterID)
@@ -916,8 +804,8 @@ func TestSocialServiceUnfollowUser(t *testing.T) {
 	t.Run("Error - target user not found", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(nil, repository.ErrUserNotFound).Once()
 
@@ -935,8 +823,8 @@ func TestSocialServiceUnfollowUser(t *testing.T) {
 	t.Run("Error - target user inactive", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		inactiveUser := createTestUser(targetID, false)
 
@@ -956,8 +844,8 @@ func TestSocialServiceUnfollowUser(t *testing.T) {
 	t.Run("Error - repository error on FindUserByID", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(nil, errRepoSocial).Once()
 
@@ -974,8 +862,8 @@ func TestSocialServiceUnfollowUser(t *testing.T) {
 	t.Run("Error - repository error on UnfollowUser", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
 
@@ -1030,11 +918,11 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Success - public profile returns all activity", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 		recipes, follows, reviews, favorites := createTestActivityData()
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
@@ -1062,8 +950,8 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Success - viewing own activity with private profile", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		ownUser := createTestUser(requesterID, true)
 		recipes, follows, reviews, favorites := createTestActivityData()
@@ -1090,11 +978,11 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Success - anonymous user views public profile", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 		recipes, follows, reviews, favorites := createTestActivityData()
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
@@ -1118,11 +1006,11 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Success - followers_only profile when requester follows target", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 		recipes, follows, reviews, favorites := createTestActivityData()
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
@@ -1147,11 +1035,11 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Success - custom per_type_limit", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
@@ -1173,11 +1061,11 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Success - empty activity data", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
@@ -1204,8 +1092,8 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Error - user not found", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(nil, repository.ErrUserNotFound).Once()
 
@@ -1223,8 +1111,8 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Error - user inactive", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		inactiveUser := createTestUser(targetID, false)
 
@@ -1244,11 +1132,11 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Error - access denied for private profile", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+		privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(privatePrivacy, nil).Once()
@@ -1267,11 +1155,11 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Error - access denied for followers_only when not following", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(followersOnlyPrivacy, nil).Once()
@@ -1291,11 +1179,11 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Error - access denied for followers_only with anonymous user", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(followersOnlyPrivacy, nil).Once()
@@ -1316,8 +1204,8 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Error - repository error on FindUserByID", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(nil, errRepoSocial).Once()
 
@@ -1334,8 +1222,8 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Error - repository error on FindPrivacyPreferencesByUserID", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
 
@@ -1355,11 +1243,11 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 	t.Run("Error - repository error on IsFollowing", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(followersOnlyPrivacy, nil).Once()
@@ -1375,108 +1263,408 @@ func TestSocialServiceGetUserActivity(t *testing.T) {
 		mockUserRepo.AssertExpectations(t)
 	})
 
-	t.Run("Error - repository error on GetRecentRecipes", func(t *testing.T) {
+	t.Run("Error - every section fails", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := new(mocks.UserRepository)
+		mockSocialRepo := new(mocks.SocialRepository)
 
 		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
 		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
 		mockSocialRepo.On("GetRecentRecipes", mock.Anything, targetID, 15).Return(nil, errRepoSocial).Once()
+		mockSocialRepo.On("GetRecentFollows", mock.Anything, targetID, 15).Return(nil, errRepoSocial).Once()
+		mockSocialRepo.On("GetRecentReviews", mock.Anything, targetID, 15).Return(nil, errRepoSocial).Once()
+		mockSocialRepo.On("GetRecentFavorites", mock.Anything, targetID, 15).Return(nil, errRepoSocial).Once()
 
 		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
 		resp, err := svc.GetUserActivity(context.Background(), &requesterID, targetID, 15)
 
-		require.Error(t, err)
+		require.ErrorIs(t, err, errRepoSocial)
 		assert.Nil(t, resp)
-		assert.Contains(t, err.Error(), "failed to get recent recipes")
+		assert.Contains(t, err.Error(), "failed to get recentRecipes")
+		assert.Contains(t, err.Error(), "failed to get recentFavorites")
 
 		mockUserRepo.AssertExpectations(t)
 		mockSocialRepo.AssertExpectations(t)
 	})
+}
+
+func TestSocialServiceGetUserActivity_PartialResults(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.New()
+	requesterID := uuid.New()
+
+	for _, failing := range dto.ActivitySections {
+		t.Run(failing, func(t *testing.T) {
+			t.Parallel()
+
+			mockUserRepo := new(mocks.UserRepository)
+			mockSocialRepo := new(mocks.SocialRepository)
+
+			targetUser := createTestUser(targetID, true)
+			publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
+			recipes, follows, reviews, favorites := createTestActivityData()
+
+			results := map[string]struct {
+				method string
+				data   any
+			}{
+				dto.ActivitySectionRecipes:   {"GetRecentRecipes", recipes},
+				dto.ActivitySectionFollows:   {"GetRecentFollows", follows},
+				dto.ActivitySectionReviews:   {"GetRecentReviews", reviews},
+				dto.ActivitySectionFavorites: {"GetRecentFavorites", favorites},
+			}
+
+			mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
+			mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
+
+			for section, result := range results {
+				if section == failing {
+					mockSocialRepo.On(result.method, mock.Anything, targetID, 15).Return(nil, errRepoSocial).Once()
+				} else {
+					mockSocialRepo.On(result.method, mock.Anything, targetID, 15).Return(result.data, nil).Once()
+				}
+			}
+
+			svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+			resp, err := svc.GetUserActivity(context.Background(), &requesterID, targetID, 15)
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{failing}, resp.UnavailableSections)
+
+			sections := map[string]int{
+				dto.ActivitySectionRecipes:   len(resp.RecentRecipes),
+				dto.ActivitySectionFollows:   len(resp.RecentFollows),
+				dto.ActivitySectionReviews:   len(resp.RecentReviews),
+				dto.ActivitySectionFavorites: len(resp.RecentFavorites),
+			}
+			for section, count := range sections {
+				if section == failing {
+					assert.Zero(t, count, section)
+				} else {
+					assert.NotZero(t, count, section)
+				}
+			}
+
+			assert.NotNil(t, resp.RecentRecipes)
+			assert.NotNil(t, resp.RecentFollows)
+			assert.NotNil(t, resp.RecentReviews)
+			assert.NotNil(t, resp.RecentFavorites)
+
+			mockUserRepo.AssertExpectations(t)
+			mockSocialRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSocialServiceGetUserActivity_SectionTimeout(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.New()
+
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
 
-	t.Run("Error - repository error on GetRecentFollows", func(t *testing.T) {
+	recipes, follows, reviews, _ := createTestActivityData()
+
+	mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(createTestUser(targetID, true), nil).Once()
+	mockSocialRepo.On("GetRecentRecipes", mock.Anything, targetID, 15).Return(recipes, nil).Once()
+	mockSocialRepo.On("GetRecentFollows", mock.Anything, targetID, 15).Return(follows, nil).Once()
+	mockSocialRepo.On("GetRecentReviews", mock.Anything, targetID, 15).Return(reviews, nil).Once()
+	mockSocialRepo.On("GetRecentFavorites", mock.Anything, targetID, 15).
+		Return(
+			func(ctx context.Context, _ uuid.UUID, _ int) []dto.FavoriteSummary {
+				<-ctx.Done()
+
+				return nil
+			},
+			func(ctx context.Context, _ uuid.UUID, _ int) error { return ctx.Err() },
+		).
+		Once()
+
+	svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	svc.SetActivitySectionTimeout(10 * time.Millisecond)
+
+	start := time.Now()
+	resp, err := svc.GetUserActivity(context.Background(), &targetID, targetID, 15)
+
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, []string{dto.ActivitySectionFavorites}, resp.UnavailableSections)
+	assert.Len(t, resp.RecentRecipes, len(recipes))
+	assert.Empty(t, resp.RecentFavorites)
+
+	mockUserRepo.AssertExpectations(t)
+	mockSocialRepo.AssertExpectations(t)
+}
+
+func TestSocialServiceGetDigestPreview(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	_, follows, _, _ := createTestActivityData()
+	recipes := []dto.DigestRecipe{{
+		RecipeSummary:  dto.RecipeSummary{RecipeID: 7, Title: "Ratatouille", CreatedAt: time.Now()},
+		AuthorID:       uuid.NewString(),
+		AuthorUsername: "remy",
+	}}
+
+	t.Run("covers the digest period", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		socialRepo := mocks.NewSocialRepository(t)
+		socialRepo.On("GetNewFollowers", mock.Anything, userID, mock.AnythingOfType("time.Time"), 10).
+			Return(follows, nil)
+		socialRepo.On("GetFollowedRecipes", mock.Anything, userID, mock.AnythingOfType("time.Time"), 10).
+			Return(recipes, nil)
 
-		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
-		recipes, _, _, _ := createTestActivityData()
+		svc := service.NewSocialService(nil, socialRepo, nil)
+		svc.SetDigestPeriod(24 * time.Hour)
 
-		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
-		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
-		mockSocialRepo.On("GetRecentRecipes", mock.Anything, targetID, 15).Return(recipes, nil).Once()
-		mockSocialRepo.On("GetRecentFollows", mock.Anything, targetID, 15).Return(nil, errRepoSocial).Once()
+		resp, err := svc.GetDigestPreview(context.Background(), userID, 10)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(-24*time.Hour), resp.Since, time.Minute)
+		assert.Equal(t, follows, resp.NewFollowers)
+		assert.Equal(t, recipes, resp.FollowedRecipes)
+		assert.Empty(t, resp.UnavailableSections)
+	})
 
-		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-		resp, err := svc.GetUserActivity(context.Background(), &requesterID, targetID, 15)
+	t.Run("reports failed sections", func(t *testing.T) {
+		t.Parallel()
 
-		require.Error(t, err)
-		assert.Nil(t, resp)
-		assert.Contains(t, err.Error(), "failed to get recent follows")
+		socialRepo := mocks.NewSocialRepository(t)
+		socialRepo.On("GetNewFollowers", mock.Anything, userID, mock.Anything, 10).Return(follows, nil)
+		socialRepo.On("GetFollowedRecipes", mock.Anything, userID, mock.Anything, 10).Return(nil, errRepoSocial)
 
-		mockUserRepo.AssertExpectations(t)
-		mockSocialRepo.AssertExpectations(t)
+		resp, err := service.NewSocialService(nil, socialRepo, nil).GetDigestPreview(context.Background(), userID, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{dto.DigestSectionFollowedRecipes}, resp.UnavailableSections)
+		assert.NotNil(t, resp.FollowedRecipes)
+		assert.Empty(t, resp.FollowedRecipes)
 	})
 
-	t.Run("Error - repository error on GetRecentReviews", func(t *testing.T) {
+	t.Run("fails when every section does", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		socialRepo := mocks.NewSocialRepository(t)
+		socialRepo.On("GetNewFollowers", mock.Anything, userID, mock.Anything, 10).Return(nil, errRepoSocial)
+		socialRepo.On("GetFollowedRecipes", mock.Anything, userID, mock.Anything, 10).Return(nil, errRepoSocial)
 
-		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
-		recipes, follows, _, _ := createTestActivityData()
+		_, err := service.NewSocialService(nil, socialRepo, nil).GetDigestPreview(context.Background(), userID, 10)
+		require.ErrorIs(t, err, errRepoSocial)
+	})
+}
 
-		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
-		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
-		mockSocialRepo.On("GetRecentRecipes", mock.Anything, targetID, 15).Return(recipes, nil).Once()
-		mockSocialRepo.On("GetRecentFollows", mock.Anything, targetID, 15).Return(follows, nil).Once()
-		mockSocialRepo.On("GetRecentReviews", mock.Anything, targetID, 15).Return(nil, errRepoSocial).Once()
+func TestSocialServiceUpdateFollowSettings(t *testing.T) {
+	t.Parallel()
+
+	followerID := uuid.New()
+	targetID := uuid.New()
+	req := &dto.FollowSettingsUpdateRequest{Muted: func() *bool { b := true; return &b }()}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockSocialRepo := mocks.NewSocialRepository(t)
+		mockSocialRepo.On("UpdateFollowSettings", mock.Anything, followerID, targetID, req).
+			Return(&dto.FollowSettings{Muted: true, NotifyNewRecipes: true, NotifyReviews: true}, nil)
+
+		svc := service.NewSocialService(mocks.NewUserRepository(t), mockSocialRepo, nil)
+
+		settings, err := svc.UpdateFollowSettings(context.Background(), followerID, targetID, req)
+		require.NoError(t, err)
+		assert.True(t, settings.Muted)
+	})
+
+	t.Run("Not following", func(t *testing.T) {
+		t.Parallel()
+
+		mockSocialRepo := mocks.NewSocialRepository(t)
+		mockSocialRepo.On("UpdateFollowSettings", mock.Anything, followerID, targetID, req).
+			Return(nil, repository.ErrNotFollowing)
+
+		svc := service.NewSocialService(mocks.NewUserRepository(t), mockSocialRepo, nil)
+
+		_, err := svc.UpdateFollowSettings(context.Background(), followerID, targetID, req)
+		require.ErrorIs(t, err, service.ErrNotFollowing)
+	})
+}
+
+func TestSocialServiceGetNotificationRecipients(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		follower := uuid.New()
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockSocialRepo := mocks.NewSocialRepository(t)
+
+		mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(createTestUser(userID, true), nil)
+		mockSocialRepo.On("GetNotificationRecipients", mock.Anything, userID, dto.FollowNotificationEventNewRecipe).
+			Return([]uuid.UUID{follower}, nil)
 
 		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-		resp, err := svc.GetUserActivity(context.Background(), &requesterID, targetID, 15)
 
-		require.Error(t, err)
-		assert.Nil(t, resp)
-		assert.Contains(t, err.Error(), "failed to get recent reviews")
+		resp, err := svc.GetNotificationRecipients(context.Background(), userID, dto.FollowNotificationEventNewRecipe)
+		require.NoError(t, err)
+		assert.Equal(t, []string{follower.String()}, resp.RecipientIDs)
+		assert.Equal(t, dto.FollowNotificationEventNewRecipe, resp.Event)
+	})
 
-		mockUserRepo.AssertExpectations(t)
-		mockSocialRepo.AssertExpectations(t)
+	t.Run("Inactive user", func(t *testing.T) {
+		t.Parallel()
+
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(createTestUser(userID, false), nil)
+
+		svc := service.NewSocialService(mockUserRepo, mocks.NewSocialRepository(t), nil)
+
+		_, err := svc.GetNotificationRecipients(context.Background(), userID, dto.FollowNotificationEventReview)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
 	})
+}
+
+func TestSocialServiceGetUserActivity_CloseFriends(t *testing.T) {
+	t.Parallel()
 
-	t.Run("Error - repository error on GetRecentFavorites", func(t *testing.T) {
+	targetID := uuid.New()
+	requesterID := uuid.New()
+	privacy := &dto.UserPrivacyPreferences{
+		ProfileVisibility:  dto.ProfileVisibilityPublic,
+		ActivityVisibility: dto.ActivityVisibilityCloseFriends,
+	}
+
+	t.Run("Close friend sees activity", func(t *testing.T) {
 		t.Parallel()
 
-		mockUserRepo := new(MockUserRepoForSocial)
-		mockSocialRepo := new(MockSocialRepo)
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockSocialRepo := mocks.NewSocialRepository(t)
 
-		targetUser := createTestUser(targetID, true)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
-		recipes, follows, reviews, _ := createTestActivityData()
+		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(createTestUser(targetID, true), nil)
+		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(privacy, nil)
+		mockSocialRepo.On("IsCloseFriend", mock.Anything, targetID, requesterID).Return(true, nil)
+		mockSocialRepo.On("GetRecentRecipes", mock.Anything, targetID, 5).Return(nil, nil)
+		mockSocialRepo.On("GetRecentFollows", mock.Anything, targetID, 5).Return(nil, nil)
+		mockSocialRepo.On("GetRecentReviews", mock.Anything, targetID, 5).Return(nil, nil)
+		mockSocialRepo.On("GetRecentFavorites", mock.Anything, targetID, 5).Return(nil, nil)
 
-		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(targetUser, nil).Once()
-		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(publicPrivacy, nil).Once()
-		mockSocialRepo.On("GetRecentRecipes", mock.Anything, targetID, 15).Return(recipes, nil).Once()
-		mockSocialRepo.On("GetRecentFollows", mock.Anything, targetID, 15).Return(follows, nil).Once()
-		mockSocialRepo.On("GetRecentReviews", mock.Anything, targetID, 15).Return(reviews, nil).Once()
-		mockSocialRepo.On("GetRecentFavorites", mock.Anything, targetID, 15).Return(nil, errRepoSocial).Once()
+		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+
+		_, err := svc.GetUserActivity(context.Background(), &requesterID, targetID, 5)
+		require.NoError(t, err)
+	})
+
+	t.Run("Follower who is not a close friend is denied", func(t *testing.T) {
+		t.Parallel()
+
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockSocialRepo := mocks.NewSocialRepository(t)
+
+		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(createTestUser(targetID, true), nil)
+		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(privacy, nil)
+		mockSocialRepo.On("IsCloseFriend", mock.Anything, targetID, requesterID).Return(false, nil)
 
 		svc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-		resp, err := svc.GetUserActivity(context.Background(), &requesterID, targetID, 15)
 
-		require.Error(t, err)
-		assert.Nil(t, resp)
-		assert.Contains(t, err.Error(), "failed to get recent favorites")
+		_, err := svc.GetUserActivity(context.Background(), &requesterID, targetID, 5)
+		require.ErrorIs(t, err, service.ErrAccessDenied)
+	})
 
-		mockUserRepo.AssertExpectations(t)
-		mockSocialRepo.AssertExpectations(t)
+	t.Run("Anonymous requester is denied", func(t *testing.T) {
+		t.Parallel()
+
+		mockUserRepo := mocks.NewUserRepository(t)
+
+		mockUserRepo.On("FindUserByID", mock.Anything, targetID).Return(createTestUser(targetID, true), nil)
+		mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(privacy, nil)
+
+		svc := service.NewSocialService(mockUserRepo, mocks.NewSocialRepository(t), nil)
+
+		_, err := svc.GetUserActivity(context.Background(), nil, targetID, 5)
+		require.ErrorIs(t, err, service.ErrAccessDenied)
+	})
+}
+
+func TestSocialServiceSetCloseFriend(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	friendID := uuid.New()
+
+	t.Run("Adding a non-follower fails", func(t *testing.T) {
+		t.Parallel()
+
+		mockSocialRepo := mocks.NewSocialRepository(t)
+		mockSocialRepo.On("SetCloseFriend", mock.Anything, userID, friendID, true).Return(repository.ErrNotFollowing)
+
+		svc := service.NewSocialService(mocks.NewUserRepository(t), mockSocialRepo, nil)
+
+		err := svc.SetCloseFriend(context.Background(), userID, friendID, true)
+		require.ErrorIs(t, err, service.ErrNotAFollower)
+	})
+
+	t.Run("Removing a non-follower succeeds", func(t *testing.T) {
+		t.Parallel()
+
+		mockSocialRepo := mocks.NewSocialRepository(t)
+		mockSocialRepo.On("SetCloseFriend", mock.Anything, userID, friendID, false).Return(repository.ErrNotFollowing)
+
+		svc := service.NewSocialService(mocks.NewUserRepository(t), mockSocialRepo, nil)
+
+		require.NoError(t, svc.SetCloseFriend(context.Background(), userID, friendID, false))
+	})
+}
+
+func TestSocialServiceGetFollowHistory(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	events := []dto.FollowHistoryEntry{
+		{UserID: uuid.NewString(), Username: "bob", Event: dto.FollowEventUnfollow, OccurredAt: time.Now()},
+	}
+
+	mockSocialRepo := mocks.NewSocialRepository(t)
+	mockSocialRepo.On("GetFollowHistory", mock.Anything, userID, 10, 20).Return(events, 21, nil)
+
+	svc := service.NewSocialService(mocks.NewUserRepository(t), mockSocialRepo, nil)
+
+	history, err := svc.GetFollowHistory(context.Background(), userID, 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, &dto.FollowHistoryResponse{TotalCount: 21, Events: events, Limit: 10, Offset: 20}, history)
+}
+
+func TestSocialServicePurgeFollowHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Deletes events older than the retention", func(t *testing.T) {
+		t.Parallel()
+
+		mockSocialRepo := mocks.NewSocialRepository(t)
+		mockSocialRepo.On("DeleteFollowEventsBefore", mock.Anything, mock.MatchedBy(func(cutoff time.Time) bool {
+			return time.Since(cutoff) >= 24*time.Hour && time.Since(cutoff) < 25*time.Hour
+		})).Return(int64(3), nil)
+
+		svc := service.NewSocialService(mocks.NewUserRepository(t), mockSocialRepo, nil)
+
+		deleted, err := svc.PurgeFollowHistory(context.Background(), 24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+	})
+
+	t.Run("Zero retention keeps everything", func(t *testing.T) {
+		t.Parallel()
+
+		svc := service.NewSocialService(mocks.NewUserRepository(t), mocks.NewSocialRepository(t), nil)
+
+		deleted, err := svc.PurgeFollowHistory(context.Background(), 0)
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
 	})
 }
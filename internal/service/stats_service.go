@@ -0,0 +1,228 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// StatsService builds the admin statistics dashboard and the follower insights of creators.
+type StatsService interface {
+	GetAdminStats(ctx context.Context, query dto.AdminStatsQuery) (*dto.AdminStatsResponse, error)
+	GetFollowerInsights(
+		ctx context.Context,
+		userID uuid.UUID,
+		query dto.FollowerInsightsQuery,
+	) (*dto.FollowerInsightsResponse, error)
+}
+
+// percent is the scale of the shares reported in follower insights.
+const percent = 100
+
+// statsSeries maps each time series of the dashboard to the bucket field it fills.
+var statsSeries = []struct {
+	metric repository.StatsMetric
+	field  func(*dto.AdminStatsBucket) *int
+}{
+	{repository.StatsMetricSignups, func(b *dto.AdminStatsBucket) *int { return &b.Signups }},
+	{repository.StatsMetricActiveUsers, func(b *dto.AdminStatsBucket) *int { return &b.ActiveUsers }},
+	{repository.StatsMetricDeactivations, func(b *dto.AdminStatsBucket) *int { return &b.Deactivations }},
+	{repository.StatsMetricNewFollows, func(b *dto.AdminStatsBucket) *int { return &b.NewFollows }},
+}
+
+// StatsServiceImpl implements StatsService. Results are cached per query for the configured TTL,
+// since the aggregate queries scan whole tables.
+type StatsServiceImpl struct {
+	repo repository.StatsRepository
+
+	stats    *ttlCache[dto.AdminStatsQuery, *dto.AdminStatsResponse]
+	insights *ttlCache[followerInsightsKey, *dto.FollowerInsightsResponse]
+}
+
+type followerInsightsKey struct {
+	userID uuid.UUID
+	query  dto.FollowerInsightsQuery
+}
+
+// NewStatsService creates a new StatsService. A zero cacheTTL disables caching.
+func NewStatsService(repo repository.StatsRepository, cacheTTL time.Duration) *StatsServiceImpl {
+	return &StatsServiceImpl{
+		repo:     repo,
+		stats:    newTTLCache[dto.AdminStatsQuery, *dto.AdminStatsResponse](cacheTTL),
+		insights: newTTLCache[followerInsightsKey, *dto.FollowerInsightsResponse](cacheTTL),
+	}
+}
+
+// GetAdminStats returns the dashboard for query. From and To are widened to whole buckets, so
+// the series always covers complete intervals with empty intervals reported as zero.
+func (s *StatsServiceImpl) GetAdminStats(
+	ctx context.Context,
+	query dto.AdminStatsQuery,
+) (*dto.AdminStatsResponse, error) {
+	query = dto.AdminStatsQuery{
+		From:     query.Interval.Truncate(query.From),
+		To:       query.Interval.Next(query.Interval.Truncate(query.To.Add(-time.Nanosecond))),
+		Interval: query.Interval,
+	}
+
+	if stats, ok := s.stats.get(query); ok {
+		return stats, nil
+	}
+
+	stats, err := s.buildStats(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	s.stats.put(query, stats)
+
+	return stats, nil
+}
+
+// GetFollowerInsights returns the follower insights of userID for query, widened to whole buckets
+// like GetAdminStats.
+func (s *StatsServiceImpl) GetFollowerInsights(
+	ctx context.Context,
+	userID uuid.UUID,
+	query dto.FollowerInsightsQuery,
+) (*dto.FollowerInsightsResponse, error) {
+	query = dto.FollowerInsightsQuery{
+		From:     query.Interval.Truncate(query.From),
+		To:       query.Interval.Next(query.Interval.Truncate(query.To.Add(-time.Nanosecond))),
+		Interval: query.Interval,
+	}
+	key := followerInsightsKey{userID: userID, query: query}
+
+	if insights, ok := s.insights.get(key); ok {
+		return insights, nil
+	}
+
+	insights, err := s.buildFollowerInsights(ctx, userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	s.insights.put(key, insights)
+
+	return insights, nil
+}
+
+func (s *StatsServiceImpl) buildStats(
+	ctx context.Context,
+	query dto.AdminStatsQuery,
+) (*dto.AdminStatsResponse, error) {
+	totals, err := s.repo.GetStatsTotals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats totals: %w", err)
+	}
+
+	var series []dto.AdminStatsBucket
+	for start := query.From; start.Before(query.To); start = query.Interval.Next(start) {
+		series = append(series, dto.AdminStatsBucket{Start: start})
+	}
+
+	for _, entry := range statsSeries {
+		counts, err := s.repo.CountByBucket(ctx, entry.metric, query.From, query.To, query.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", entry.metric, err)
+		}
+
+		for i := range series {
+			*entry.field(&series[i]) = counts[series[i].Start]
+		}
+	}
+
+	adopters, err := s.repo.CountPreferenceAdopters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count preference adopters: %w", err)
+	}
+
+	adoption := make(map[dto.PreferenceCategory]float64, len(dto.ValidPreferenceCategories))
+	for _, category := range dto.ValidPreferenceCategories {
+		if totals.TotalUsers > 0 {
+			adoption[category] = float64(adopters[category]) / float64(totals.TotalUsers)
+		} else {
+			adoption[category] = 0
+		}
+	}
+
+	return &dto.AdminStatsResponse{
+		From:               query.From,
+		To:                 query.To,
+		Interval:           query.Interval,
+		Totals:             *totals,
+		Series:             series,
+		PreferenceAdoption: adoption,
+		GeneratedAt:        time.Now().UTC(),
+	}, nil
+}
+
+func (s *StatsServiceImpl) buildFollowerInsights(
+	ctx context.Context,
+	userID uuid.UUID,
+	query dto.FollowerInsightsQuery,
+) (*dto.FollowerInsightsResponse, error) {
+	totals, err := s.repo.GetFollowerTotals(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follower totals: %w", err)
+	}
+
+	follows, err := s.repo.CountFollowEventsByBucket(
+		ctx, userID, dto.FollowEventFollow, query.From, query.To, query.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count follows: %w", err)
+	}
+
+	unfollows, err := s.repo.CountFollowEventsByBucket(
+		ctx, userID, dto.FollowEventUnfollow, query.From, query.To, query.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unfollows: %w", err)
+	}
+
+	insights := &dto.FollowerInsightsResponse{
+		UserID:      userID.String(),
+		From:        query.From,
+		To:          query.To,
+		Interval:    query.Interval,
+		Totals:      *totals,
+		Series:      []dto.FollowerInsightsBucket{},
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	for start := query.From; start.Before(query.To); start = query.Interval.Next(start) {
+		insights.Series = append(insights.Series, dto.FollowerInsightsBucket{
+			Start:        start,
+			NewFollowers: follows[start],
+			Unfollows:    unfollows[start],
+		})
+		insights.RecentUnfollows += unfollows[start]
+	}
+
+	if totals.Followers > 0 {
+		insights.PublicProfilePercent = float64(totals.PublicProfileFollowers) * percent / float64(totals.Followers)
+	}
+
+	countries, err := s.repo.CountFollowersByCountry(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count follower countries: %w", err)
+	}
+
+	insights.Locations = make([]dto.FollowerLocation, 0, len(countries))
+	for country, followers := range countries {
+		insights.Locations = append(insights.Locations, dto.FollowerLocation{Country: country, Followers: followers})
+	}
+
+	slices.SortFunc(insights.Locations, func(a, b dto.FollowerLocation) int {
+		return cmp.Or(cmp.Compare(b.Followers, a.Followers), strings.Compare(a.Country, b.Country))
+	})
+
+	return insights, nil
+}
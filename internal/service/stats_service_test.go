@@ -0,0 +1,190 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+var (
+	statsMonday    = time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC)
+	statsNextWeek  = statsMonday.AddDate(0, 0, 7)
+	statsWeekAfter = statsMonday.AddDate(0, 0, 14)
+)
+
+func expectStatsQueries(repo *mocks.StatsRepository, from, to time.Time, interval dto.StatsInterval) {
+	repo.On("GetStatsTotals", mock.Anything).
+		Return(&dto.AdminStatsTotals{TotalUsers: 4, ActiveUsers: 3, InactiveUsers: 1, FollowEdges: 7}, nil).
+		Once()
+	repo.On("CountByBucket", mock.Anything, repository.StatsMetricSignups, from, to, interval).
+		Return(map[time.Time]int{statsNextWeek: 2}, nil).
+		Once()
+	repo.On("CountByBucket", mock.Anything, repository.StatsMetricActiveUsers, from, to, interval).
+		Return(map[time.Time]int{statsMonday: 3, statsNextWeek: 1}, nil).
+		Once()
+	repo.On("CountByBucket", mock.Anything, repository.StatsMetricDeactivations, from, to, interval).
+		Return(map[time.Time]int{}, nil).
+		Once()
+	repo.On("CountByBucket", mock.Anything, repository.StatsMetricNewFollows, from, to, interval).
+		Return(map[time.Time]int{statsMonday: 5}, nil).
+		Once()
+	repo.On("CountPreferenceAdopters", mock.Anything).
+		Return(map[dto.PreferenceCategory]int{dto.PreferenceCategoryPrivacy: 1, dto.PreferenceCategoryTheme: 4}, nil).
+		Once()
+}
+
+func TestStatsService_GetAdminStats(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewStatsRepository(t)
+	expectStatsQueries(repo, statsMonday, statsWeekAfter, dto.StatsIntervalWeek)
+
+	svc := service.NewStatsService(repo, 0)
+
+	// A mid-week range is widened to whole weeks
+	stats, err := svc.GetAdminStats(t.Context(), dto.AdminStatsQuery{
+		From:     statsMonday.Add(50 * time.Hour),
+		To:       statsNextWeek.Add(30 * time.Hour),
+		Interval: dto.StatsIntervalWeek,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, statsMonday, stats.From)
+	assert.Equal(t, statsWeekAfter, stats.To)
+	assert.Equal(t, dto.AdminStatsTotals{TotalUsers: 4, ActiveUsers: 3, InactiveUsers: 1, FollowEdges: 7}, stats.Totals)
+	assert.Equal(t, []dto.AdminStatsBucket{
+		{Start: statsMonday, ActiveUsers: 3, NewFollows: 5},
+		{Start: statsNextWeek, Signups: 2, ActiveUsers: 1},
+	}, stats.Series)
+	assert.Len(t, stats.PreferenceAdoption, len(dto.ValidPreferenceCategories))
+	assert.InDelta(t, 0.25, stats.PreferenceAdoption[dto.PreferenceCategoryPrivacy], 1e-9)
+	assert.InDelta(t, 1.0, stats.PreferenceAdoption[dto.PreferenceCategoryTheme], 1e-9)
+	assert.Zero(t, stats.PreferenceAdoption[dto.PreferenceCategorySound])
+}
+
+func TestStatsService_GetAdminStats_Cached(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewStatsRepository(t)
+	// Each query runs once; the second request is served from the cache
+	expectStatsQueries(repo, statsMonday, statsNextWeek, dto.StatsIntervalDay)
+
+	svc := service.NewStatsService(repo, time.Hour)
+	query := dto.AdminStatsQuery{From: statsMonday, To: statsNextWeek, Interval: dto.StatsIntervalDay}
+
+	first, err := svc.GetAdminStats(t.Context(), query)
+	require.NoError(t, err)
+	assert.Len(t, first.Series, 7)
+
+	second, err := svc.GetAdminStats(t.Context(), query)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+}
+
+func TestStatsService_GetAdminStats_RepositoryError(t *testing.T) {
+	t.Parallel()
+
+	errRepo := errors.New("connection reset")
+
+	repo := mocks.NewStatsRepository(t)
+	repo.On("GetStatsTotals", mock.Anything).Return(&dto.AdminStatsTotals{}, nil)
+	repo.On("CountByBucket", mock.Anything, repository.StatsMetricSignups, statsMonday, statsNextWeek,
+		dto.StatsIntervalWeek).Return(nil, errRepo)
+
+	svc := service.NewStatsService(repo, time.Hour)
+
+	_, err := svc.GetAdminStats(t.Context(), dto.AdminStatsQuery{
+		From:     statsMonday,
+		To:       statsNextWeek,
+		Interval: dto.StatsIntervalWeek,
+	})
+	require.ErrorIs(t, err, errRepo)
+}
+
+func TestStatsService_GetFollowerInsights(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	repo := mocks.NewStatsRepository(t)
+	repo.On("GetFollowerTotals", mock.Anything, userID).
+		Return(&dto.FollowerInsightsTotals{Followers: 8, PublicProfileFollowers: 6}, nil).
+		Once()
+	repo.On("CountFollowEventsByBucket", mock.Anything, userID, dto.FollowEventFollow, statsMonday, statsWeekAfter,
+		dto.StatsIntervalWeek).
+		Return(map[time.Time]int{statsMonday: 5, statsNextWeek: 3}, nil).
+		Once()
+	repo.On("CountFollowEventsByBucket", mock.Anything, userID, dto.FollowEventUnfollow, statsMonday, statsWeekAfter,
+		dto.StatsIntervalWeek).
+		Return(map[time.Time]int{statsNextWeek: 2}, nil).
+		Once()
+	repo.On("CountFollowersByCountry", mock.Anything, userID).
+		Return(map[string]int{"DE": 1, "BR": 3, "AT": 1}, nil).
+		Once()
+
+	svc := service.NewStatsService(repo, time.Hour)
+	query := dto.FollowerInsightsQuery{
+		From:     statsMonday.Add(50 * time.Hour),
+		To:       statsNextWeek.Add(30 * time.Hour),
+		Interval: dto.StatsIntervalWeek,
+	}
+
+	insights, err := svc.GetFollowerInsights(t.Context(), userID, query)
+	require.NoError(t, err)
+
+	assert.Equal(t, userID.String(), insights.UserID)
+	assert.Equal(t, statsMonday, insights.From)
+	assert.Equal(t, statsWeekAfter, insights.To)
+	assert.Equal(t, []dto.FollowerInsightsBucket{
+		{Start: statsMonday, NewFollowers: 5},
+		{Start: statsNextWeek, NewFollowers: 3, Unfollows: 2},
+	}, insights.Series)
+	assert.InDelta(t, 75.0, insights.PublicProfilePercent, 1e-9)
+	assert.Equal(t, 2, insights.RecentUnfollows)
+	assert.Equal(t, []dto.FollowerLocation{
+		{Country: "BR", Followers: 3},
+		{Country: "AT", Followers: 1},
+		{Country: "DE", Followers: 1},
+	}, insights.Locations)
+
+	// The repository is only queried once; the second request is served from the cache
+	cached, err := svc.GetFollowerInsights(t.Context(), userID, query)
+	require.NoError(t, err)
+	assert.Same(t, insights, cached)
+}
+
+func TestStatsService_GetFollowerInsights_NoFollowers(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	repo := mocks.NewStatsRepository(t)
+	repo.On("GetFollowerTotals", mock.Anything, userID).Return(&dto.FollowerInsightsTotals{}, nil)
+	repo.On("CountFollowEventsByBucket", mock.Anything, userID, mock.Anything, statsMonday, statsNextWeek,
+		dto.StatsIntervalDay).Return(map[time.Time]int{}, nil)
+	repo.On("CountFollowersByCountry", mock.Anything, userID).Return(map[string]int{}, nil)
+
+	svc := service.NewStatsService(repo, 0)
+
+	insights, err := svc.GetFollowerInsights(t.Context(), userID, dto.FollowerInsightsQuery{
+		From:     statsMonday,
+		To:       statsNextWeek,
+		Interval: dto.StatsIntervalDay,
+	})
+	require.NoError(t, err)
+	assert.Len(t, insights.Series, 7)
+	assert.Zero(t, insights.PublicProfilePercent)
+	assert.Zero(t, insights.RecentUnfollows)
+	assert.Empty(t, insights.Locations)
+	assert.NotNil(t, insights.Locations, "serialized as an empty list")
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlCache keeps values for a fixed TTL. A zero TTL disables caching.
+type ttlCache[K comparable, V any] struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[K]ttlEntry[V]
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+func newTTLCache[K comparable, V any](ttl time.Duration) *ttlCache[K, V] {
+	return &ttlCache[K, V]{ttl: ttl, entries: make(map[K]ttlEntry[V])}
+}
+
+func (c *ttlCache[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		var zero V
+
+		return zero, false
+	}
+
+	return entry.value, true
+}
+
+// put caches value for key, evicting expired entries so the cache stays bounded by the number
+// of distinct keys used within one TTL.
+func (c *ttlCache[K, V]) put(key K, value V) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = ttlEntry[V]{value: value, expiresAt: now.Add(c.ttl)}
+}
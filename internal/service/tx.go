@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// withinTx runs fn as one unit of work of tx, or directly when the service has no transaction
+// manager. fn may be retried, so it must leave side effects outside the repositories to the caller.
+func withinTx(ctx context.Context, tx repository.TxManager, fn func(ctx context.Context) error) error {
+	if tx == nil {
+		return fn(ctx)
+	}
+
+	return tx.WithinTx(ctx, fn) //nolint:wrapcheck // errors of fn are returned as they are
+}
@@ -0,0 +1,360 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/lock"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+)
+
+// MaxTypeaheadResults is the most users a typeahead lookup returns.
+const MaxTypeaheadResults = 10
+
+// reindexBatchSize is how many users a reindex reads from PostgreSQL and stages at a time.
+const reindexBatchSize = 500
+
+// Reindex triggers.
+const (
+	ReindexTriggerAdmin    = "admin"
+	ReindexTriggerSchedule = "schedule"
+)
+
+var (
+	// ErrSearchIndexUnavailable is returned when no search index is configured.
+	ErrSearchIndexUnavailable = errors.New("search index is not available")
+	// ErrReindexRunning is returned when a reindex is started while another one runs on any
+	// instance.
+	ErrReindexRunning = errors.New("a reindex is already running")
+)
+
+// TypeaheadService autocompletes usernames and maintains the search index they can be read from.
+// It is kept apart from UserService.SearchUsers so the lookups stay cheap: a prefix index scan,
+// no counts and no ranking.
+type TypeaheadService interface {
+	Typeahead(ctx context.Context, prefix string) (*dto.UserTypeaheadResponse, error)
+	StartReindex(ctx context.Context) (*dto.SearchReindexJob, error)
+	GetSearchIndexStatus(ctx context.Context) (*dto.SearchIndexStatusResponse, error)
+}
+
+// TypeaheadOptions configures where typeahead results come from and how the search index is
+// rebuilt.
+type TypeaheadOptions struct {
+	// Index is the search index; nil serves every lookup from PostgreSQL.
+	Index repository.UsernameIndex
+	// Source selects where lookups are read from; empty means dto.TypeaheadSourcePostgres.
+	Source dto.TypeaheadSource
+	// CacheTTL is how long results are reused per prefix. Zero disables caching.
+	CacheTTL time.Duration
+	// ReindexRate caps the users copied into the index per second. Zero is unlimited.
+	ReindexRate int
+}
+
+// TypeaheadServiceImpl implements TypeaheadService. Results are cached per lowercased prefix for
+// the configured TTL, so renames and new users show up once it expires.
+type TypeaheadServiceImpl struct {
+	repo  repository.UserRepository
+	opts  TypeaheadOptions
+	cache *ttlCache[string, *dto.UserTypeaheadResponse]
+
+	reads      atomic.Int64
+	mismatches atomic.Int64
+	locker     *lock.Locker
+
+	mu      sync.Mutex
+	lastJob *dto.SearchReindexJob
+}
+
+// NewTypeaheadService creates a new TypeaheadService.
+func NewTypeaheadService(repo repository.UserRepository, opts TypeaheadOptions) *TypeaheadServiceImpl {
+	if opts.Source == "" || opts.Index == nil {
+		opts.Source = dto.TypeaheadSourcePostgres
+	}
+
+	return &TypeaheadServiceImpl{
+		repo:  repo,
+		opts:  opts,
+		cache: newTTLCache[string, *dto.UserTypeaheadResponse](opts.CacheTTL),
+	}
+}
+
+// Typeahead returns up to MaxTypeaheadResults searchable users whose username starts with
+// prefix, ignoring case. In verify mode the PostgreSQL results are served and the search index
+// is read as well; differences are logged and counted.
+func (s *TypeaheadServiceImpl) Typeahead(ctx context.Context, prefix string) (*dto.UserTypeaheadResponse, error) {
+	key := strings.ToLower(prefix)
+
+	if cached, ok := s.cache.get(key); ok {
+		return cached, nil
+	}
+
+	var (
+		results []dto.UserTypeaheadResult
+		err     error
+	)
+
+	if s.opts.Source == dto.TypeaheadSourceIndex {
+		results, err = s.opts.Index.MatchUsernamePrefix(ctx, key, MaxTypeaheadResults)
+	} else {
+		results, err = s.repo.SearchUsernamePrefix(ctx, key, MaxTypeaheadResults)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to autocomplete usernames: %w", err)
+	}
+
+	if s.opts.Source == dto.TypeaheadSourceVerify {
+		s.verify(ctx, key, results)
+	}
+
+	if results == nil {
+		results = []dto.UserTypeaheadResult{}
+	}
+
+	response := &dto.UserTypeaheadResponse{Results: results}
+	s.cache.put(key, response)
+
+	return response, nil
+}
+
+// verify compares the PostgreSQL results for prefix with the search index. Index errors are
+// counted as mismatches so they never fail the lookup.
+func (s *TypeaheadServiceImpl) verify(ctx context.Context, prefix string, want []dto.UserTypeaheadResult) {
+	s.reads.Add(1)
+
+	got, err := s.opts.Index.MatchUsernamePrefix(ctx, prefix, MaxTypeaheadResults)
+	if err != nil {
+		s.mismatches.Add(1)
+		slog.WarnContext(ctx, "search index read failed", "prefix", prefix, "error", err)
+
+		return
+	}
+
+	if !slices.Equal(got, want) {
+		s.mismatches.Add(1)
+		slog.WarnContext(ctx, "search index differs from postgres", "prefix", prefix,
+			"postgres", len(want), "index", len(got))
+	}
+}
+
+// SetLocker makes every reindex take a lease first, so only one instance rebuilds the shared
+// index at a time.
+func (s *TypeaheadServiceImpl) SetLocker(locker *lock.Locker) {
+	s.locker = locker
+}
+
+// StartReindex rebuilds the search index in the background and returns the new job. Progress is
+// reported by GetSearchIndexStatus.
+func (s *TypeaheadServiceImpl) StartReindex(ctx context.Context) (*dto.SearchReindexJob, error) {
+	// The rebuild outlives the request that started it
+	lease, job, err := s.beginReindex(context.WithoutCancel(ctx), ReindexTriggerAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		defer lease.Release()
+
+		s.reindex(lease.Context(), job)
+	}()
+
+	return job, nil
+}
+
+// Reindex rebuilds the search index and returns the finished job.
+func (s *TypeaheadServiceImpl) Reindex(ctx context.Context, trigger string) (*dto.SearchReindexJob, error) {
+	lease, job, err := s.beginReindex(ctx, trigger)
+	if err != nil {
+		return nil, err
+	}
+	defer lease.Release()
+
+	s.reindex(lease.Context(), job)
+
+	return s.snapshot(), nil
+}
+
+// GetSearchIndexStatus returns the typeahead source, the last reindex and, in verify mode, the
+// verification counts.
+func (s *TypeaheadServiceImpl) GetSearchIndexStatus(_ context.Context) (*dto.SearchIndexStatusResponse, error) {
+	status := &dto.SearchIndexStatusResponse{
+		Source:  s.opts.Source,
+		LastJob: s.snapshot(),
+	}
+
+	if s.opts.Source == dto.TypeaheadSourceVerify {
+		status.Verification = &dto.TypeaheadVerification{
+			Reads:      s.reads.Load(),
+			Mismatches: s.mismatches.Load(),
+		}
+	}
+
+	return status, nil
+}
+
+// beginReindex takes the reindex lease and records a new running job, refusing to start while
+// another one runs here or on another instance. The job runs with the lease's context and must
+// release it when done.
+func (s *TypeaheadServiceImpl) beginReindex(
+	ctx context.Context,
+	trigger string,
+) (*lock.Lease, *dto.SearchReindexJob, error) {
+	if s.opts.Index == nil {
+		return nil, nil, ErrSearchIndexUnavailable
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lastJob != nil && s.lastJob.State == dto.SearchReindexRunning {
+		return nil, nil, ErrReindexRunning
+	}
+
+	lease, err := s.locker.Acquire(ctx, lock.NameSearchReindex)
+	if errors.Is(err, lock.ErrNotAcquired) {
+		return nil, nil, ErrReindexRunning
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start reindex: %w", err)
+	}
+
+	s.lastJob = &dto.SearchReindexJob{
+		JobID:     uuid.NewString(),
+		State:     dto.SearchReindexRunning,
+		Trigger:   trigger,
+		StartedAt: time.Now(),
+	}
+
+	job := *s.lastJob
+
+	slog.InfoContext(ctx, "reindex started", "job_id", job.JobID, "trigger", trigger, "token", lease.Token())
+
+	return lease, &job, nil
+}
+
+// reindex streams every searchable user from PostgreSQL into a new index build, then publishes
+// it. The previous index keeps serving until the build is published; a failed build is
+// discarded.
+func (s *TypeaheadServiceImpl) reindex(ctx context.Context, job *dto.SearchReindexJob) {
+	err := s.copyUsers(ctx, job.JobID)
+	if err == nil {
+		err = s.opts.Index.PublishUsernames(ctx, job.JobID)
+	}
+
+	if err != nil {
+		discardErr := s.opts.Index.DiscardUsernames(context.WithoutCancel(ctx), job.JobID)
+		if discardErr != nil {
+			slog.WarnContext(ctx, "failed to discard search index build", "job_id", job.JobID, "error", discardErr)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	finishedAt := time.Now()
+	s.lastJob.FinishedAt = &finishedAt
+	s.lastJob.State = dto.SearchReindexCompleted
+
+	if err != nil {
+		s.lastJob.State = dto.SearchReindexFailed
+		s.lastJob.Error = err.Error()
+	}
+}
+
+// copyUsers stages all searchable users under buildID, in batches paced to the reindex rate.
+func (s *TypeaheadServiceImpl) copyUsers(ctx context.Context, buildID string) error {
+	total, err := s.repo.CountSearchableUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count searchable users: %w", err)
+	}
+
+	s.updateJob(func(job *dto.SearchReindexJob) { job.Total = total })
+
+	started := time.Now()
+	indexed := 0
+	after := uuid.Nil
+
+	for {
+		users, err := s.repo.ListSearchableUsers(ctx, after, reindexBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list searchable users: %w", err)
+		}
+
+		if len(users) == 0 {
+			return nil
+		}
+
+		err = s.opts.Index.StageUsernames(ctx, buildID, users)
+		if err != nil {
+			return fmt.Errorf("failed to stage usernames: %w", err)
+		}
+
+		indexed += len(users)
+		s.updateJob(func(job *dto.SearchReindexJob) { job.Indexed = indexed })
+
+		after, err = uuid.Parse(users[len(users)-1].UserID)
+		if err != nil {
+			return fmt.Errorf("invalid user id %q: %w", users[len(users)-1].UserID, err)
+		}
+
+		err = s.pace(ctx, started, indexed)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// pace waits until copying indexed users since started stays within the reindex rate.
+func (s *TypeaheadServiceImpl) pace(ctx context.Context, started time.Time, indexed int) error {
+	if s.opts.ReindexRate <= 0 {
+		return nil
+	}
+
+	due := started.Add(time.Duration(indexed) * time.Second / time.Duration(s.opts.ReindexRate))
+
+	timer := time.NewTimer(time.Until(due))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("reindex interrupted: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (s *TypeaheadServiceImpl) updateJob(update func(*dto.SearchReindexJob)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	update(s.lastJob)
+}
+
+// snapshot returns a copy of the last job, or nil before the first reindex.
+func (s *TypeaheadServiceImpl) snapshot() *dto.SearchReindexJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lastJob == nil {
+		return nil
+	}
+
+	job := *s.lastJob
+	if job.FinishedAt != nil {
+		finishedAt := *job.FinishedAt
+		job.FinishedAt = &finishedAt
+	}
+
+	return &job
+}
@@ -0,0 +1,230 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/lock"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestTypeaheadService_Typeahead(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewUserRepository(t)
+	// Prefixes are cached case-insensitively, so the repository is queried once
+	repo.On("SearchUsernamePrefix", mock.Anything, "ch", service.MaxTypeaheadResults).
+		Return([]dto.UserTypeaheadResult{{UserID: "1", Username: "chef"}}, nil).
+		Once()
+
+	svc := service.NewTypeaheadService(repo, service.TypeaheadOptions{CacheTTL: time.Minute})
+
+	first, err := svc.Typeahead(t.Context(), "ch")
+	require.NoError(t, err)
+	assert.Equal(t, []dto.UserTypeaheadResult{{UserID: "1", Username: "chef"}}, first.Results)
+
+	second, err := svc.Typeahead(t.Context(), "CH")
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+}
+
+func TestTypeaheadService_Typeahead_NoMatches(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewUserRepository(t)
+	repo.On("SearchUsernamePrefix", mock.Anything, "zz", service.MaxTypeaheadResults).Return(nil, nil).Twice()
+
+	svc := service.NewTypeaheadService(repo, service.TypeaheadOptions{})
+
+	for range 2 {
+		resp, err := svc.Typeahead(t.Context(), "zz")
+		require.NoError(t, err)
+		assert.Empty(t, resp.Results)
+		assert.NotNil(t, resp.Results)
+	}
+}
+
+func TestTypeaheadService_Typeahead_RepositoryError(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewUserRepository(t)
+	repo.On("SearchUsernamePrefix", mock.Anything, "ch", service.MaxTypeaheadResults).Return(nil, errDB)
+
+	svc := service.NewTypeaheadService(repo, service.TypeaheadOptions{CacheTTL: time.Minute})
+
+	_, err := svc.Typeahead(t.Context(), "ch")
+	require.ErrorIs(t, err, errDB)
+}
+
+func newTypeaheadStore(t *testing.T) *memory.Store {
+	t.Helper()
+
+	store, err := memory.NewFromFixtures(t.Context(), &fixtures.Fixtures{
+		Users: []fixtures.User{{Username: "chef"}, {Username: "Chloe"}, {Username: "baker"}},
+	})
+	require.NoError(t, err)
+
+	return store
+}
+
+func TestTypeaheadService_Reindex(t *testing.T) {
+	t.Parallel()
+
+	store := newTypeaheadStore(t)
+	svc := service.NewTypeaheadService(store, service.TypeaheadOptions{
+		Index:  store,
+		Source: dto.TypeaheadSourceIndex,
+	})
+
+	// The index is empty until it is built
+	resp, err := svc.Typeahead(t.Context(), "ch")
+	require.NoError(t, err)
+	assert.Empty(t, resp.Results)
+
+	job, err := svc.Reindex(t.Context(), service.ReindexTriggerAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, dto.SearchReindexCompleted, job.State)
+	assert.Equal(t, 3, job.Total)
+	assert.Equal(t, 3, job.Indexed)
+	assert.NotNil(t, job.FinishedAt)
+
+	resp, err = svc.Typeahead(t.Context(), "CH")
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	assert.Equal(t, "chef", resp.Results[0].Username)
+	assert.Equal(t, "Chloe", resp.Results[1].Username)
+
+	status, err := svc.GetSearchIndexStatus(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, dto.TypeaheadSourceIndex, status.Source)
+	assert.Equal(t, job.JobID, status.LastJob.JobID)
+	assert.Nil(t, status.Verification)
+}
+
+func TestTypeaheadService_Verify(t *testing.T) {
+	t.Parallel()
+
+	store := newTypeaheadStore(t)
+	svc := service.NewTypeaheadService(store, service.TypeaheadOptions{
+		Index:  store,
+		Source: dto.TypeaheadSourceVerify,
+	})
+
+	// PostgreSQL results are served while the index disagrees
+	resp, err := svc.Typeahead(t.Context(), "ch")
+	require.NoError(t, err)
+	assert.Len(t, resp.Results, 2)
+
+	_, err = svc.Reindex(t.Context(), service.ReindexTriggerSchedule)
+	require.NoError(t, err)
+
+	_, err = svc.Typeahead(t.Context(), "b")
+	require.NoError(t, err)
+
+	status, err := svc.GetSearchIndexStatus(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, &dto.TypeaheadVerification{Reads: 2, Mismatches: 1}, status.Verification)
+	assert.Equal(t, service.ReindexTriggerSchedule, status.LastJob.Trigger)
+}
+
+func TestTypeaheadService_Reindex_FailureKeepsIndex(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewUserRepository(t)
+	repo.On("CountSearchableUsers", mock.Anything).Return(1, nil)
+	repo.On("ListSearchableUsers", mock.Anything, mock.Anything, mock.Anything).Return(nil, errDB)
+
+	index := mocks.NewUsernameIndex(t)
+	// The failed build is dropped and never published
+	index.On("DiscardUsernames", mock.Anything, mock.Anything).Return(nil)
+
+	svc := service.NewTypeaheadService(repo, service.TypeaheadOptions{Index: index, Source: dto.TypeaheadSourceIndex})
+
+	job, err := svc.Reindex(t.Context(), service.ReindexTriggerAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, dto.SearchReindexFailed, job.State)
+	assert.Contains(t, job.Error, errDB.Error())
+}
+
+func TestTypeaheadService_StartReindex(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan time.Time)
+
+	repo := mocks.NewUserRepository(t)
+	repo.On("CountSearchableUsers", mock.Anything).Return(0, nil).WaitUntil(release)
+	repo.On("ListSearchableUsers", mock.Anything, uuid.Nil, mock.Anything).Return(nil, nil)
+
+	index := mocks.NewUsernameIndex(t)
+	index.On("PublishUsernames", mock.Anything, mock.Anything).Return(nil)
+
+	svc := service.NewTypeaheadService(repo, service.TypeaheadOptions{Index: index, Source: dto.TypeaheadSourceIndex})
+
+	job, err := svc.StartReindex(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, dto.SearchReindexRunning, job.State)
+
+	_, err = svc.StartReindex(t.Context())
+	require.ErrorIs(t, err, service.ErrReindexRunning)
+
+	close(release)
+
+	assert.Eventually(t, func() bool {
+		status, _ := svc.GetSearchIndexStatus(t.Context())
+
+		return status.LastJob.State == dto.SearchReindexCompleted
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTypeaheadService_Reindex_OtherReplica(t *testing.T) {
+	t.Parallel()
+
+	store := newTypeaheadStore(t)
+	opts := service.TypeaheadOptions{Index: store, Source: dto.TypeaheadSourceIndex}
+
+	replicaA := service.NewTypeaheadService(store, opts)
+	replicaA.SetLocker(lock.New(store, time.Minute))
+	replicaB := service.NewTypeaheadService(store, opts)
+	replicaB.SetLocker(lock.New(store, time.Minute))
+
+	lease, err := lock.New(store, time.Minute).Acquire(t.Context(), lock.NameSearchReindex)
+	require.NoError(t, err)
+
+	_, err = replicaA.StartReindex(t.Context())
+	require.ErrorIs(t, err, service.ErrReindexRunning, "another replica is rebuilding the shared index")
+
+	lease.Release()
+
+	job, err := replicaA.Reindex(t.Context(), service.ReindexTriggerSchedule)
+	require.NoError(t, err)
+	assert.Equal(t, dto.SearchReindexCompleted, job.State)
+
+	// The lease was given up once the rebuild finished
+	job, err = replicaB.Reindex(t.Context(), service.ReindexTriggerSchedule)
+	require.NoError(t, err)
+	assert.Equal(t, dto.SearchReindexCompleted, job.State)
+}
+
+func TestTypeaheadService_StartReindex_NoIndex(t *testing.T) {
+	t.Parallel()
+
+	svc := service.NewTypeaheadService(mocks.NewUserRepository(t), service.TypeaheadOptions{
+		Source: dto.TypeaheadSourceIndex,
+	})
+
+	_, err := svc.StartReindex(t.Context())
+	require.ErrorIs(t, err, service.ErrSearchIndexUnavailable)
+
+	status, err := svc.GetSearchIndexStatus(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, dto.TypeaheadSourcePostgres, status.Source, "without an index, postgres is read")
+}
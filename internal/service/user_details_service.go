@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// Expansion fetch limits.
+const (
+	// maxExpansionFetches bounds the concurrent lookups made for one user details request.
+	maxExpansionFetches = 4
+	// expansionActivityLimit is the per-type item limit of an embedded recent activity.
+	expansionActivityLimit = 5
+)
+
+// UserDetailsService defines business logic for reading a user together with related resources.
+type UserDetailsService interface {
+	// GetUserDetails returns the public user and the requested expansions. Each expansion applies
+	// the privacy rules of the endpoint it mirrors and is left empty when the requester may not
+	// see it.
+	GetUserDetails(
+		ctx context.Context,
+		requesterID, userID uuid.UUID,
+		expand []dto.UserExpansion,
+		isAdmin bool,
+		hasServiceScope bool,
+	) (*dto.UserDetailsResponse, error)
+}
+
+// UserDetailsServiceImpl implements UserDetailsService on top of the user, social and
+// preference services.
+type UserDetailsServiceImpl struct {
+	users       UserService
+	social      SocialService
+	preferences PreferenceService
+}
+
+// NewUserDetailsService creates a new UserDetailsService.
+func NewUserDetailsService(
+	users UserService,
+	social SocialService,
+	preferences PreferenceService,
+) *UserDetailsServiceImpl {
+	return &UserDetailsServiceImpl{
+		users:       users,
+		social:      social,
+		preferences: preferences,
+	}
+}
+
+// GetUserDetails retrieves the public user first, so nothing is fetched for users the requester
+// cannot see, then fetches the expansions concurrently.
+func (s *UserDetailsServiceImpl) GetUserDetails(
+	ctx context.Context,
+	requesterID, userID uuid.UUID,
+	expand []dto.UserExpansion,
+	isAdmin bool,
+	hasServiceScope bool,
+) (*dto.UserDetailsResponse, error) {
+	user, err := s.users.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.UserDetailsResponse{UserSearchResult: *user}
+
+	var followers, following *dto.GetFollowedUsersResponse
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxExpansionFetches)
+
+	seen := make(map[dto.UserExpansion]bool, len(expand))
+
+	for _, expansion := range expand {
+		if seen[expansion] {
+			continue
+		}
+
+		seen[expansion] = true
+
+		switch expansion {
+		case dto.UserExpansionPrivacy:
+			g.Go(func() error {
+				privacy, err := s.fetchPrivacy(gctx, requesterID, userID, isAdmin, hasServiceScope)
+				response.Privacy = privacy
+
+				return err
+			})
+		case dto.UserExpansionStats:
+			g.Go(func() error {
+				resp, err := s.social.GetFollowers(gctx, requesterID, userID, 1, 0, true)
+				followers = resp
+
+				return hideDenied(err)
+			})
+			g.Go(func() error {
+				resp, err := s.social.GetFollowing(gctx, requesterID, userID, 1, 0, true)
+				following = resp
+
+				return hideDenied(err)
+			})
+		case dto.UserExpansionRecentActivity:
+			g.Go(func() error {
+				var requester *uuid.UUID
+				if requesterID != uuid.Nil {
+					requester = &requesterID
+				}
+
+				activity, err := s.social.GetUserActivity(gctx, requester, userID, expansionActivityLimit)
+				response.RecentActivity = activity
+
+				return hideDenied(err)
+			})
+		}
+	}
+
+	err = g.Wait()
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand user: %w", err)
+	}
+
+	if followers != nil && following != nil {
+		response.Stats = &dto.UserSocialStats{
+			FollowerCount:  followers.TotalCount,
+			FollowingCount: following.TotalCount,
+		}
+	}
+
+	return response, nil
+}
+
+func (s *UserDetailsServiceImpl) fetchPrivacy(
+	ctx context.Context,
+	requesterID, userID uuid.UUID,
+	isAdmin bool,
+	hasServiceScope bool,
+) (*dto.UserPrivacyPreferences, error) {
+	if s.preferences == nil {
+		return nil, nil //nolint:nilnil // privacy expansion unavailable without preferences
+	}
+
+	resp, err := s.preferences.GetCategoryPreferences(
+		ctx,
+		requesterID,
+		userID,
+		dto.PreferenceCategoryPrivacy,
+		isAdmin,
+		hasServiceScope,
+	)
+	if err != nil {
+		return nil, hideDenied(err)
+	}
+
+	privacy, ok := resp.Preferences.(*dto.UserPrivacyPreferences)
+	if !ok {
+		return nil, fmt.Errorf("unexpected privacy preferences type %T", resp.Preferences)
+	}
+
+	return privacy, nil
+}
+
+// hideDenied turns an access-denied error from a related resource into an empty expansion.
+func hideDenied(err error) error {
+	if errors.Is(err, ErrAccessDenied) ||
+		errors.Is(err, ErrUnauthorizedAccess) ||
+		errors.Is(err, ErrProfilePrivate) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,134 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+type userDetailsMocks struct {
+	users       *mocks.UserService
+	social      *mocks.SocialService
+	preferences *mocks.PreferenceService
+}
+
+func newUserDetailsService(t *testing.T, userID uuid.UUID) (*service.UserDetailsServiceImpl, userDetailsMocks) {
+	t.Helper()
+
+	m := userDetailsMocks{
+		users:       mocks.NewUserService(t),
+		social:      mocks.NewSocialService(t),
+		preferences: mocks.NewPreferenceService(t),
+	}
+
+	m.users.On("GetUserByID", mock.Anything, userID).
+		Return(&dto.UserSearchResult{UserID: userID.String(), Username: "target", IsActive: true}, nil).
+		Maybe()
+
+	return service.NewUserDetailsService(m.users, m.social, m.preferences), m
+}
+
+func TestUserDetailsService_GetUserDetails_AllExpansions(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	svc, m := newUserDetailsService(t, userID)
+
+	privacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
+	activity := &dto.UserActivityResponse{UserID: userID.String()}
+
+	m.preferences.On("GetCategoryPreferences", mock.Anything, userID, userID, dto.PreferenceCategoryPrivacy, false, false).
+		Return(&dto.PreferenceCategoryResponse{Preferences: privacy}, nil)
+	m.social.On("GetFollowers", mock.Anything, userID, userID, 1, 0, true).
+		Return(&dto.GetFollowedUsersResponse{TotalCount: 3}, nil)
+	m.social.On("GetFollowing", mock.Anything, userID, userID, 1, 0, true).
+		Return(&dto.GetFollowedUsersResponse{TotalCount: 2}, nil)
+	m.social.On("GetUserActivity", mock.Anything, &userID, userID, mock.AnythingOfType("int")).
+		Return(activity, nil)
+
+	details, err := svc.GetUserDetails(t.Context(), userID, userID, []dto.UserExpansion{
+		dto.UserExpansionPrivacy,
+		dto.UserExpansionStats,
+		dto.UserExpansionRecentActivity,
+		dto.UserExpansionStats,
+	}, false, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, "target", details.Username)
+	assert.Same(t, privacy, details.Privacy)
+	assert.Equal(t, &dto.UserSocialStats{FollowerCount: 3, FollowingCount: 2}, details.Stats)
+	assert.Same(t, activity, details.RecentActivity)
+}
+
+func TestUserDetailsService_GetUserDetails_HidesDeniedExpansions(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	svc, m := newUserDetailsService(t, userID)
+
+	m.preferences.
+		On("GetCategoryPreferences", mock.Anything, uuid.Nil, userID, dto.PreferenceCategoryPrivacy, false, false).
+		Return(nil, service.ErrUnauthorizedAccess)
+	m.social.On("GetFollowers", mock.Anything, uuid.Nil, userID, 1, 0, true).
+		Return(nil, service.ErrAccessDenied)
+	m.social.On("GetFollowing", mock.Anything, uuid.Nil, userID, 1, 0, true).
+		Return(nil, service.ErrAccessDenied)
+	m.social.On("GetUserActivity", mock.Anything, (*uuid.UUID)(nil), userID, mock.AnythingOfType("int")).
+		Return(nil, service.ErrAccessDenied)
+
+	details, err := svc.GetUserDetails(t.Context(), uuid.Nil, userID, []dto.UserExpansion{
+		dto.UserExpansionPrivacy,
+		dto.UserExpansionStats,
+		dto.UserExpansionRecentActivity,
+	}, false, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, userID.String(), details.UserID)
+	assert.Nil(t, details.Privacy)
+	assert.Nil(t, details.Stats)
+	assert.Nil(t, details.RecentActivity)
+}
+
+func TestUserDetailsService_GetUserDetails_UserNotVisible(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	m := userDetailsMocks{
+		users:       mocks.NewUserService(t),
+		social:      mocks.NewSocialService(t),
+		preferences: mocks.NewPreferenceService(t),
+	}
+	m.users.On("GetUserByID", mock.Anything, userID).Return(nil, service.ErrUserNotFound)
+
+	svc := service.NewUserDetailsService(m.users, m.social, m.preferences)
+
+	expand := []dto.UserExpansion{dto.UserExpansionStats}
+
+	_, err := svc.GetUserDetails(t.Context(), uuid.New(), userID, expand, false, false)
+	require.ErrorIs(t, err, service.ErrUserNotFound)
+}
+
+func TestUserDetailsService_GetUserDetails_PropagatesErrors(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	svc, m := newUserDetailsService(t, userID)
+
+	errRepo := errors.New("connection reset")
+
+	m.social.On("GetFollowers", mock.Anything, userID, userID, 1, 0, true).Return(nil, errRepo)
+	m.social.On("GetFollowing", mock.Anything, userID, userID, 1, 0, true).
+		Return(&dto.GetFollowedUsersResponse{}, nil).
+		Maybe()
+
+	_, err := svc.GetUserDetails(t.Context(), userID, userID, []dto.UserExpansion{dto.UserExpansionStats}, false, false)
+	require.ErrorIs(t, err, errRepo)
+}
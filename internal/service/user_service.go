@@ -1,24 +1,38 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/contentmod"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/crypto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/logger"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/metrics"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/notification"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/signedtoken"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
 )
 
 // DeleteTokenTTL is the duration for which delete confirmation tokens are valid.
 const DeleteTokenTTL = 24 * time.Hour
 
+// DeleteTokenPurpose is the purpose delete confirmation tokens are signed for.
+const DeleteTokenPurpose = "account-deletion"
+
 // UserService defines business logic for user operations.
 type UserService interface {
 	GetUserProfile(ctx context.Context, requesterID, targetUserID uuid.UUID) (*dto.UserProfileResponse, error)
+	GetUserProfileByUsername(ctx context.Context, requesterID uuid.UUID, username string) (*dto.UserProfileResponse, error)
 	UpdateUserProfile(
 		ctx context.Context,
 		userID uuid.UUID,
@@ -32,12 +46,17 @@ type UserService interface {
 	) (*dto.UserConfirmAccountDeleteResponse, error)
 	SearchUsers(
 		ctx context.Context,
+		requesterID uuid.UUID,
 		query string,
+		location dto.LocationFilter,
 		limit, offset int,
 		countOnly bool,
 	) (*dto.UserSearchResponse, error)
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*dto.UserSearchResult, error)
 	GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error)
+	// ReleaseRetiredIdentifiers frees the usernames and emails of users deactivated for longer
+	// than the identifier retention period and returns how many users were released.
+	ReleaseRetiredIdentifiers(ctx context.Context) (int, error)
 }
 
 // ErrUserNotFound is returned when a user is not found.
@@ -49,39 +68,131 @@ var ErrProfilePrivate = errors.New("profile is private")
 // ErrDuplicateUsername is returned when trying to use a username that already exists.
 var ErrDuplicateUsername = errors.New("username already exists")
 
+// ErrDuplicateEmail is returned when trying to use an email that already belongs to an active user.
+var ErrDuplicateEmail = errors.New("email already exists")
+
+// ErrUsernameRetired is returned when a username is still reserved by a deactivated user.
+var ErrUsernameRetired = errors.New("username is retired")
+
+// ErrEmailRetired is returned when an email is still reserved by a deactivated user.
+var ErrEmailRetired = errors.New("email is retired")
+
 // ErrCacheUnavailable is returned when the cache (Redis) is not available.
 var ErrCacheUnavailable = errors.New("cache unavailable")
 
 // ErrInvalidToken is returned when a confirmation token is invalid or expired.
 var ErrInvalidToken = errors.New("invalid or expired token")
 
+// ErrRegionOutsideCountry is returned when a profile's region is not a subdivision of its country.
+var ErrRegionOutsideCountry = errors.New("region is not in country")
+
+// ErrEmailChangeRequiresConfirmation is returned when a profile update sets the email, which only
+// the confirmed email change flow may change.
+var ErrEmailChangeRequiresConfirmation = errors.New("email changes require confirmation")
+
+// ErrObjectionableContent is returned when content moderation rejects profile text. It wraps
+// validation.ValidationErrors naming the rejected fields.
+var ErrObjectionableContent = errors.New("profile text contains objectionable content")
+
 // UserServiceImpl implements UserService.
 type UserServiceImpl struct {
 	repo               repository.UserRepository
 	tokenStore         repository.TokenStore
 	notificationClient notification.Client
+	moderation         repository.ModerationRepository
+	badges             BadgeService
+	tokenSigner        *signedtoken.Signer
+	codeHasher         *crypto.Hasher
+	contentModerator   contentmod.Moderator
+	contentAction      contentmod.Action
+	personalizedSearch bool
+	searchCache        *searchResultCache
+	reads              *profileReads
+	publicProfiles     PublicProfileService
+	tx                 repository.TxManager
 }
 
-// NewUserService creates a new UserService.
+// NewUserService creates a new UserService. Username and email changes on accounts flagged in
+// moderation are refused; a nil moderation moderates no accounts.
 func NewUserService(
 	repo repository.UserRepository,
 	tokenStore repository.TokenStore,
 	notificationClient notification.Client,
+	moderation repository.ModerationRepository,
 ) *UserServiceImpl {
 	return &UserServiceImpl{
 		repo:               repo,
 		tokenStore:         tokenStore,
 		notificationClient: notificationClient,
+		moderation:         moderation,
+		reads:              &profileReads{repo: repo, timeout: defaultProfileReadTimeout},
 	}
 }
 
-// GetUserProfile retrieves a user profile respecting privacy settings.
+// SetPersonalizedSearch turns friends-first ranking of search results on or off. It is off by
+// default so relevance can be compared with and without it.
+func (s *UserServiceImpl) SetPersonalizedSearch(enabled bool) {
+	s.personalizedSearch = enabled
+}
+
+// SetTxManager saves a profile update together with the content flags it raises, so flagged text
+// is never saved without being queued for review.
+func (s *UserServiceImpl) SetTxManager(tx repository.TxManager) {
+	s.tx = tx
+}
+
+// SetBadgeService lists the badges users earned in their profiles. Without it profiles carry
+// no badges.
+func (s *UserServiceImpl) SetBadgeService(badges BadgeService) {
+	s.badges = badges
+}
+
+// SetTokenSigner signs delete confirmation tokens with the user ID, purpose and expiry, and
+// refuses tokens that do not verify before the token store is consulted. Without it tokens are
+// random strings checked against the token store alone.
+func (s *UserServiceImpl) SetTokenSigner(signer *signedtoken.Signer) {
+	s.tokenSigner = signer
+}
+
+// SetCodeHasher stores only argon2id hashes of delete confirmation tokens. Without it tokens are
+// stored as issued.
+func (s *UserServiceImpl) SetCodeHasher(hasher *crypto.Hasher) {
+	s.codeHasher = hasher
+}
+
+// SetContentModerator checks the full names and bios users write with moderator and applies
+// action to the text it flags. Without it profile text is saved as written.
+func (s *UserServiceImpl) SetContentModerator(moderator contentmod.Moderator, action contentmod.Action) {
+	s.contentModerator = moderator
+	s.contentAction = action
+}
+
+// SetSearchCache caches the results of searches that are not personalized in cache for ttl, and
+// drops them when a profile or account changes. Without it every search queries the database.
+func (s *UserServiceImpl) SetSearchCache(cache repository.SearchCache, ttl time.Duration) {
+	s.searchCache = &searchResultCache{store: cache, ttl: ttl}
+}
+
+// SetPublicProfiles serves profiles to anonymous viewers from the public profile projection, and
+// drops a user's projected profile when they edit or deactivate it. Without it anonymous views
+// read the database like any other.
+func (s *UserServiceImpl) SetPublicProfiles(profiles PublicProfileService) {
+	s.publicProfiles = profiles
+}
+
+// GetUserProfile retrieves a user profile respecting privacy settings. Concurrent views of one
+// profile share their user and privacy lookups, and anonymous views read the public profile
+// projection when one is set.
 func (s *UserServiceImpl) GetUserProfile(
 	ctx context.Context,
 	requesterID, targetUserID uuid.UUID,
 ) (*dto.UserProfileResponse, error) {
+	if requesterID == uuid.Nil && s.publicProfiles != nil {
+		return s.anonymousProfile(ctx, targetUserID)
+	}
+
 	// 1. Fetch user
-	user, err := s.repo.FindUserByID(ctx, targetUserID)
+	user, err := s.reads.findUserByID(ctx, targetUserID)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			return nil, ErrUserNotFound
@@ -91,7 +202,7 @@ func (s *UserServiceImpl) GetUserProfile(
 	}
 
 	// 2. Fetch privacy preferences
-	privacy, err := s.repo.FindPrivacyPreferencesByUserID(ctx, targetUserID)
+	privacy, err := s.reads.findPrivacyPreferences(ctx, targetUserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch privacy preferences: %w", err)
 	}
@@ -107,14 +218,91 @@ func (s *UserServiceImpl) GetUserProfile(
 	}
 
 	// 4. Construct Response
-	return s.buildProfileResponse(user, privacy, requesterID == targetUserID), nil
+	return s.withBadges(ctx, buildProfileResponse(user, privacy, requesterID == targetUserID), targetUserID), nil
+}
+
+// anonymousProfile returns the profile of userID from the public profile projection, which holds
+// it as anonymous viewers see it.
+func (s *UserServiceImpl) anonymousProfile(ctx context.Context, userID uuid.UUID) (*dto.UserProfileResponse, error) {
+	projected, err := s.publicProfiles.GetPublicProfile(ctx, userID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // preserve service errors for handler mapping
+	}
+
+	if !projected.Public {
+		return nil, ErrProfilePrivate
+	}
+
+	profile := *projected.Profile
+
+	return s.withBadges(ctx, &profile, userID), nil
+}
+
+// GetUserProfileByUsername retrieves a user profile by username respecting privacy settings.
+// Missing, deactivated and non-viewable profiles all return ErrUserNotFound so callers cannot
+// probe which usernames exist. Users who opted out of discoverability are only found by
+// themselves and the people they follow.
+func (s *UserServiceImpl) GetUserProfileByUsername(
+	ctx context.Context,
+	requesterID uuid.UUID,
+	username string,
+) (*dto.UserProfileResponse, error) {
+	// 1. Resolve username
+	user, err := s.repo.FindUserByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to fetch user by username: %w", err)
+	}
+
+	targetUserID, err := uuid.Parse(user.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id for %q: %w", username, err)
+	}
+
+	isSelf := requesterID == targetUserID
+	if !user.IsActive && !isSelf {
+		return nil, ErrUserNotFound
+	}
+
+	// 2. Fetch privacy preferences
+	privacy, err := s.reads.findPrivacyPreferences(ctx, targetUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch privacy preferences: %w", err)
+	}
+
+	// 3. Apply Privacy Logic
+	canViewProfile, err := s.canViewProfile(ctx, requesterID, targetUserID, privacy)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canViewProfile {
+		return nil, ErrUserNotFound
+	}
+
+	if !privacy.Discoverable && !isSelf {
+		followsRequester, err := s.repo.IsFollowing(ctx, targetUserID, requesterID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check following status: %w", err)
+		}
+
+		if !followsRequester {
+			return nil, ErrUserNotFound
+		}
+	}
+
+	// 4. Construct Response
+	return s.withBadges(ctx, buildProfileResponse(user, privacy, isSelf), targetUserID), nil
 }
 
 // GetUserByID retrieves a public user profile by ID.
 // Private and followers_only profiles are not accessible (returns ErrUserNotFound).
 func (s *UserServiceImpl) GetUserByID(ctx context.Context, userID uuid.UUID) (*dto.UserSearchResult, error) {
 	// 1. Fetch user
-	user, err := s.repo.FindUserByID(ctx, userID)
+	user, err := s.reads.findUserByID(ctx, userID)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			return nil, ErrUserNotFound
@@ -129,13 +317,13 @@ func (s *UserServiceImpl) GetUserByID(ctx context.Context, userID uuid.UUID) (*d
 	}
 
 	// 3. Fetch privacy preferences
-	privacy, err := s.repo.FindPrivacyPreferencesByUserID(ctx, userID)
+	privacy, err := s.reads.findPrivacyPreferences(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch privacy preferences: %w", err)
 	}
 
 	// 4. Apply privacy rule - only public profiles are accessible
-	if privacy.ProfileVisibility != "public" {
+	if privacy.ProfileVisibility != dto.ProfileVisibilityPublic {
 		return nil, ErrUserNotFound
 	}
 
@@ -153,32 +341,43 @@ func (s *UserServiceImpl) GetUserByID(ctx context.Context, userID uuid.UUID) (*d
 func (s *UserServiceImpl) canViewProfile(
 	ctx context.Context,
 	requesterID, targetUserID uuid.UUID,
-	privacy *dto.PrivacyPreferences,
+	privacy *dto.UserPrivacyPreferences,
+) (bool, error) {
+	return profileVisible(ctx, s.repo, requesterID, targetUserID, privacy)
+}
+
+// profileVisible checks if requester, uuid.Nil when anonymous, can view the profile of a target
+// user with the given privacy preferences. It is shared by profiles and badges.
+func profileVisible(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	requesterID, targetUserID uuid.UUID,
+	privacy *dto.UserPrivacyPreferences,
 ) (bool, error) {
 	if requesterID == targetUserID {
 		return true, nil
 	}
 
 	switch privacy.ProfileVisibility {
-	case "public":
+	case dto.ProfileVisibilityPublic:
 		return true, nil
-	case "followers_only":
-		isFollowing, err := s.repo.IsFollowing(ctx, requesterID, targetUserID)
+	case dto.ProfileVisibilityFriendsOnly:
+		isFollowing, err := userRepo.IsFollowing(ctx, requesterID, targetUserID)
 		if err != nil {
 			return false, fmt.Errorf("failed to check following status: %w", err)
 		}
 
 		return isFollowing, nil
-	case "private":
+	case dto.ProfileVisibilityPrivate:
 		return false, nil
 	default:
 		return false, nil
 	}
 }
 
-func (s *UserServiceImpl) buildProfileResponse(
+func buildProfileResponse(
 	user *dto.User,
-	privacy *dto.PrivacyPreferences,
+	privacy *dto.UserPrivacyPreferences,
 	isSelf bool,
 ) *dto.UserProfileResponse {
 	response := &dto.UserProfileResponse{
@@ -196,20 +395,54 @@ func (s *UserServiceImpl) buildProfileResponse(
 	}
 
 	// Email
-	if isSelf || privacy.ShowEmail {
+	if isSelf || privacy.ShowEmail() {
 		response.Email = user.Email
 	}
 
+	// Location
+	if isSelf || privacy.ShowLocation {
+		response.Country = user.Country
+		response.Region = user.Region
+	}
+
 	return response
 }
 
+// withBadges adds the badges the user earned to their profile. Badges are left out when they
+// cannot be read rather than failing the profile.
+func (s *UserServiceImpl) withBadges(
+	ctx context.Context,
+	profile *dto.UserProfileResponse,
+	userID uuid.UUID,
+) *dto.UserProfileResponse {
+	if s.badges == nil {
+		return profile
+	}
+
+	badges, err := s.badges.ListEarnedBadges(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "badges unavailable for profile", "user_id", userID, "error", err)
+
+		return profile
+	}
+
+	profile.Badges = badges
+
+	return profile
+}
+
 // UpdateUserProfile updates a user's profile and returns the updated profile.
 func (s *UserServiceImpl) UpdateUserProfile(
 	ctx context.Context,
 	userID uuid.UUID,
 	update *dto.UserProfileUpdateRequest,
 ) (*dto.UserProfileResponse, error) {
-	// 1. Verify user exists before attempting update
+	// 1. Email changes must be confirmed from both addresses through EmailChangeService
+	if update.Email != nil {
+		return nil, ErrEmailChangeRequiresConfirmation
+	}
+
+	// 2. Verify user exists before attempting update
 	existingUser, err := s.repo.FindUserByID(ctx, userID)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
@@ -219,9 +452,10 @@ func (s *UserServiceImpl) UpdateUserProfile(
 		return nil, fmt.Errorf("failed to verify user exists: %w", err)
 	}
 
-	// 2. Check if there are any fields to update
-	noFieldsToUpdate := update.Username == nil && update.Email == nil &&
-		update.FullName == nil && update.Bio == nil && update.IsActive == nil
+	// 3. Check if there are any fields to update
+	noFieldsToUpdate := update.Username == nil &&
+		update.FullName == nil && update.Bio == nil && update.Country == nil && update.Region == nil &&
+		update.IsActive == nil
 	if noFieldsToUpdate {
 		// No changes requested, return current profile
 		return &dto.UserProfileResponse{
@@ -230,47 +464,54 @@ func (s *UserServiceImpl) UpdateUserProfile(
 			Email:     existingUser.Email,
 			FullName:  existingUser.FullName,
 			Bio:       existingUser.Bio,
+			Country:   existingUser.Country,
+			Region:    existingUser.Region,
 			IsActive:  existingUser.IsActive,
 			CreatedAt: existingUser.CreatedAt,
 			UpdatedAt: existingUser.UpdatedAt,
 		}, nil
 	}
 
-	// 3. Track email change for notification
-	var oldEmail string
+	// 4. Identity changes on moderated accounts go through the change-request queue
+	if update.Username != nil {
+		err = requireUnmoderated(ctx, s.moderation, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	isEmailChanging := update.Email != nil &&
-		existingUser.Email != nil &&
-		*update.Email != *existingUser.Email
-	if isEmailChanging {
-		oldEmail = *existingUser.Email
+	update, err = withConsistentLocation(existingUser, update)
+	if err != nil {
+		return nil, err
 	}
 
-	// 4. Perform the update
-	updatedUser, err := s.repo.UpdateUser(ctx, userID, update)
+	update, flags, err := s.moderateProfileText(ctx, userID, update)
 	if err != nil {
-		if errors.Is(err, repository.ErrUserNotFound) {
-			return nil, ErrUserNotFound
-		}
+		return nil, err
+	}
+
+	// 5. Perform the update and queue its flagged text for review
+	var updatedUser *dto.User
 
-		if errors.Is(err, repository.ErrDuplicateUsername) {
-			return nil, ErrDuplicateUsername
+	err = withinTx(ctx, s.tx, func(ctx context.Context) error {
+		updatedUser, err = s.repo.UpdateUser(ctx, userID, update)
+		if err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				return ErrUserNotFound
+			}
+
+			return mapUpdateProfileError(err)
 		}
 
-		return nil, fmt.Errorf("failed to update user profile: %w", err)
+		return s.recordContentFlags(ctx, flags)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 5. Send email changed notification (fire-and-forget)
-	// Use context.Background() to decouple from request context so notification
-	// continues even if the request is cancelled.
-	if isEmailChanging && s.notificationClient != nil && updatedUser.Email != nil {
-		go s.notificationClient.NotifyEmailChanged( //nolint:contextcheck
-			context.Background(),
-			userID,
-			oldEmail,
-			*updatedUser.Email,
-		)
-	}
+	logger.Events().ProfileUpdated(ctx, userID, updatedProfileFields(update))
+	s.invalidateSearchResults(ctx)
+	invalidatePublicProfile(ctx, s.publicProfiles, userID)
 
 	// 6. Build response
 	return &dto.UserProfileResponse{
@@ -279,12 +520,174 @@ func (s *UserServiceImpl) UpdateUserProfile(
 		Email:     updatedUser.Email,
 		FullName:  updatedUser.FullName,
 		Bio:       updatedUser.Bio,
+		Country:   updatedUser.Country,
+		Region:    updatedUser.Region,
 		IsActive:  updatedUser.IsActive,
 		CreatedAt: updatedUser.CreatedAt,
 		UpdatedAt: updatedUser.UpdatedAt,
 	}, nil
 }
 
+// withConsistentLocation checks that the region the profile ends up with is a subdivision of its
+// country. Changing or clearing the country without setting a region clears a region outside the
+// new country; update is copied rather than modified.
+func withConsistentLocation(
+	user *dto.User,
+	update *dto.UserProfileUpdateRequest,
+) (*dto.UserProfileUpdateRequest, error) {
+	country, region := "", ""
+
+	if user.Country != nil {
+		country = *user.Country
+	}
+
+	if user.Region != nil {
+		region = *user.Region
+	}
+
+	if update.Country != nil {
+		country = *update.Country
+	}
+
+	if update.Region != nil {
+		region = *update.Region
+	}
+
+	if region == "" || strings.HasPrefix(region, country+"-") {
+		return update, nil
+	}
+
+	if update.Region != nil {
+		return nil, ErrRegionOutsideCountry
+	}
+
+	noRegion := ""
+	cleared := *update
+	cleared.Region = &noRegion
+
+	return &cleared, nil
+}
+
+// moderateProfileText checks the full name and bio an update sets. Rejected text fails with
+// ErrObjectionableContent and masked text replaces the original in a copy of update. Flagged
+// text is returned, to be queued for review once it is saved. Text that is flagged without any
+// maskable words is rejected rather than masked.
+func (s *UserServiceImpl) moderateProfileText(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *dto.UserProfileUpdateRequest,
+) (*dto.UserProfileUpdateRequest, []dto.ContentFlag, error) {
+	if s.contentModerator == nil {
+		return update, nil, nil
+	}
+
+	moderated := *update
+
+	var (
+		flags    []dto.ContentFlag
+		rejected validation.ValidationErrors
+	)
+
+	for _, field := range []struct {
+		name  string
+		value **string
+	}{
+		{"fullName", &moderated.FullName},
+		{"bio", &moderated.Bio},
+	} {
+		if *field.value == nil || **field.value == "" {
+			continue
+		}
+
+		text := **field.value
+
+		verdict, err := s.contentModerator.Moderate(ctx, text)
+		if err != nil {
+			metrics.ContentModerationTotal.WithLabelValues(field.name, "error").Inc()
+
+			return nil, nil, fmt.Errorf("failed to moderate %s: %w", field.name, err)
+		}
+
+		outcome := "clean"
+
+		switch {
+		case !verdict.Flagged:
+		case s.contentAction == contentmod.ActionFlag:
+			flags = append(flags, dto.ContentFlag{
+				UserID: userID.String(), Field: field.name, Content: text, Terms: verdict.Terms,
+			})
+			outcome = "flagged"
+		case s.contentAction == contentmod.ActionMask && verdict.Masked != text:
+			*field.value = &verdict.Masked
+			outcome = "masked"
+		default:
+			rejected = append(rejected, validation.Invalid(field.name, "moderation", "", nil))
+			outcome = "rejected"
+		}
+
+		metrics.ContentModerationTotal.WithLabelValues(field.name, outcome).Inc()
+	}
+
+	if len(rejected) > 0 {
+		return nil, nil, fmt.Errorf("%w: %w", ErrObjectionableContent, rejected)
+	}
+
+	return &moderated, flags, nil
+}
+
+// recordContentFlags queues flagged profile text for admin review.
+func (s *UserServiceImpl) recordContentFlags(ctx context.Context, flags []dto.ContentFlag) error {
+	if s.moderation == nil {
+		return nil
+	}
+
+	for i := range flags {
+		err := s.moderation.FlagContent(ctx, &flags[i])
+		if err != nil {
+			return fmt.Errorf("failed to flag %s for review: %w", flags[i].Field, err)
+		}
+	}
+
+	return nil
+}
+
+// updatedProfileFields names the profile fields an update sets.
+func updatedProfileFields(update *dto.UserProfileUpdateRequest) []string {
+	var fields []string
+
+	for name, set := range map[string]bool{
+		"username": update.Username != nil,
+		"fullName": update.FullName != nil,
+		"bio":      update.Bio != nil,
+		"country":  update.Country != nil,
+		"region":   update.Region != nil,
+		"isActive": update.IsActive != nil,
+	} {
+		if set {
+			fields = append(fields, name)
+		}
+	}
+
+	slices.Sort(fields)
+
+	return fields
+}
+
+func mapUpdateProfileError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrDuplicateUsername):
+		return ErrDuplicateUsername
+	case errors.Is(err, repository.ErrDuplicateEmail):
+		return ErrDuplicateEmail
+	case errors.Is(err, repository.ErrUsernameRetired):
+		return ErrUsernameRetired
+	case errors.Is(err, repository.ErrEmailRetired):
+		return ErrEmailRetired
+	default:
+		return fmt.Errorf("failed to update user profile: %w", err)
+	}
+}
+
 // RequestAccountDeletion creates a deletion request and returns a confirmation token.
 func (s *UserServiceImpl) RequestAccountDeletion(
 	ctx context.Context,
@@ -306,16 +709,26 @@ func (s *UserServiceImpl) RequestAccountDeletion(
 	}
 
 	// 3. Generate confirmation token
+	expiresAt := time.Now().Add(DeleteTokenTTL)
 	token := uuid.New().String()
 
-	// 4. Store token in cache with TTL (replaces any existing token)
-	err = s.tokenStore.StoreDeleteToken(ctx, userID, token, DeleteTokenTTL)
+	if s.tokenSigner != nil {
+		token, err = s.tokenSigner.Sign(userID, DeleteTokenPurpose, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign confirmation token: %w", err)
+		}
+	}
+
+	// 4. Store its hash in cache with TTL (replaces any existing token)
+	stored, err := hashCode(s.codeHasher, token)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+		return nil, err
 	}
 
-	// 5. Calculate expiration time
-	expiresAt := time.Now().Add(DeleteTokenTTL)
+	err = s.tokenStore.StoreDeleteToken(ctx, userID, stored, DeleteTokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
+	}
 
 	return &dto.UserAccountDeleteRequestResponse{
 		UserID:            userID.String(),
@@ -335,7 +748,15 @@ func (s *UserServiceImpl) ConfirmAccountDeletion(
 		return nil, ErrCacheUnavailable
 	}
 
-	// 2. Retrieve stored token from cache
+	// 2. Verify the signature, purpose, owner and expiry without trusting the cache
+	if s.tokenSigner != nil {
+		claims, err := s.tokenSigner.Verify(token, DeleteTokenPurpose, time.Now())
+		if err != nil || claims.UserID != userID {
+			return nil, ErrInvalidToken
+		}
+	}
+
+	// 3. Retrieve stored token from cache
 	storedToken, err := s.tokenStore.GetDeleteToken(ctx, userID)
 	if err != nil {
 		if errors.Is(err, redis.ErrTokenNotFound) {
@@ -345,12 +766,12 @@ func (s *UserServiceImpl) ConfirmAccountDeletion(
 		return nil, fmt.Errorf("%w: %w", ErrCacheUnavailable, err)
 	}
 
-	// 3. Validate token matches
-	if storedToken != token {
+	// 4. Validate token matches, so only the latest token issued is accepted
+	if !matchesCode(storedToken, token) {
 		return nil, ErrInvalidToken
 	}
 
-	// 4. Deactivate user (set is_active = false)
+	// 5. Deactivate user (set is_active = false)
 	isActive := false
 
 	_, err = s.repo.UpdateUser(ctx, userID, &dto.UserProfileUpdateRequest{
@@ -364,29 +785,77 @@ func (s *UserServiceImpl) ConfirmAccountDeletion(
 		return nil, fmt.Errorf("failed to deactivate user: %w", err)
 	}
 
-	// 5. Delete token from cache (best-effort cleanup)
+	s.invalidateSearchResults(ctx)
+	invalidatePublicProfile(ctx, s.publicProfiles, userID)
+
+	// 6. Delete token from cache (best-effort cleanup)
 	_ = s.tokenStore.DeleteDeleteToken(ctx, userID)
 
-	// 6. Return response
+	// 7. Return response
 	return &dto.UserConfirmAccountDeleteResponse{
 		UserID:        userID.String(),
 		DeactivatedAt: time.Now(),
 	}, nil
 }
 
-// SearchUsers searches for users by username or full name with pagination.
+// SearchUsers searches for users by username or full name in location with pagination. With
+// personalized search on, users the requester follows rank first, then users followed by someone
+// the requester follows, and each page is re-ranked by how well the username matches. Other
+// searches are served from the search cache when one is set.
 func (s *UserServiceImpl) SearchUsers(
 	ctx context.Context,
+	requesterID uuid.UUID,
+	query string,
+	location dto.LocationFilter,
+	limit, offset int,
+	countOnly bool,
+) (*dto.UserSearchResponse, error) {
+	personalized := s.personalizedSearch && requesterID != uuid.Nil
+	if personalized || s.searchCache == nil {
+		return s.searchUsers(ctx, requesterID, personalized, query, location, limit, offset, countOnly)
+	}
+
+	// Results that are not personalized are the same for every requester, so they can be shared
+	return s.searchCache.search(
+		ctx,
+		searchCacheKey(query, location, limit, offset, countOnly),
+		func(ctx context.Context) (*dto.UserSearchResponse, error) {
+			return s.searchUsers(ctx, requesterID, false, query, location, limit, offset, countOnly)
+		},
+	)
+}
+
+// searchUsers runs a search against the repository, ranked for requesterID when personalized.
+func (s *UserServiceImpl) searchUsers(
+	ctx context.Context,
+	requesterID uuid.UUID,
+	personalized bool,
 	query string,
+	location dto.LocationFilter,
 	limit, offset int,
 	countOnly bool,
 ) (*dto.UserSearchResponse, error) {
 	// Get results from repository
-	results, totalCount, err := s.repo.SearchUsers(ctx, query, limit, offset)
+	var (
+		results    []dto.UserSearchResult
+		totalCount int
+		err        error
+	)
+
+	if personalized {
+		results, totalCount, err = s.repo.SearchUsersRanked(ctx, requesterID, query, location, limit, offset)
+	} else {
+		results, totalCount, err = s.repo.SearchUsers(ctx, query, location, limit, offset)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to search users: %w", err)
 	}
 
+	if personalized {
+		rerankSearchResults(results, query)
+	}
+
 	// If countOnly, return only the count with empty results
 	if countOnly {
 		return &dto.UserSearchResponse{
@@ -410,6 +879,44 @@ func (s *UserServiceImpl) SearchUsers(
 	}, nil
 }
 
+// invalidateSearchResults drops cached search results after a profile or account change.
+func (s *UserServiceImpl) invalidateSearchResults(ctx context.Context) {
+	if s.searchCache != nil {
+		invalidateSearchResults(ctx, s.searchCache.store)
+	}
+}
+
+// Username match quality used to re-rank personalized search results.
+const (
+	searchMatchOther = iota
+	searchMatchPrefix
+	searchMatchExact
+)
+
+// rerankSearchResults orders a page of ranked results by connection, then by how well the
+// username matches query: exact matches, then prefixes, then anything else. Results that tie
+// keep the repository order. Only the page is reordered, so pagination stays stable.
+func rerankSearchResults(results []dto.UserSearchResult, query string) {
+	query = strings.ToLower(query)
+
+	match := func(result dto.UserSearchResult) int {
+		username := strings.ToLower(result.Username)
+
+		switch {
+		case username == query:
+			return searchMatchExact
+		case strings.HasPrefix(username, query):
+			return searchMatchPrefix
+		default:
+			return searchMatchOther
+		}
+	}
+
+	slices.SortStableFunc(results, func(a, b dto.UserSearchResult) int {
+		return cmp.Or(cmp.Compare(b.Connection, a.Connection), cmp.Compare(match(b), match(a)))
+	})
+}
+
 // GetUserStats retrieves aggregated user statistics.
 func (s *UserServiceImpl) GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error) {
 	stats, err := s.repo.GetUserStats(ctx)
@@ -419,3 +926,25 @@ func (s *UserServiceImpl) GetUserStats(ctx context.Context) (*dto.UserStatsRespo
 
 	return stats, nil
 }
+
+// identifierReleaseBatchSize is how many users ReleaseRetiredIdentifiers releases per statement.
+const identifierReleaseBatchSize = 500
+
+// ReleaseRetiredIdentifiers releases retired identifiers in batches until none are left.
+func (s *UserServiceImpl) ReleaseRetiredIdentifiers(ctx context.Context) (int, error) {
+	cutoff := time.Now().Add(-repository.IdentifierRetentionPeriod)
+	total := 0
+
+	for {
+		released, err := s.repo.ReleaseRetiredIdentifiers(ctx, cutoff, identifierReleaseBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to release retired identifiers: %w", err)
+		}
+
+		total += released
+
+		if released < identifierReleaseBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -3,7 +3,8 @@ package service_test
 import (
 	"context"
 	"errors"
-	"fmt"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,10 +13,15 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/contentmod"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/signedtoken"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/validation"
 )
 
 const mockErrorFmt = "mock error: %w"
@@ -24,169 +30,15 @@ const testUserFullName = "Target User"
 const testNewUsername = "newusername"
 
 var (
-	errMockArgs           = errors.New("mock: missing args")
-	errMockInvalidUser    = errors.New("invalid type assertion for User")
-	errMockInvalidPrivacy = errors.New("invalid type assertion for PrivacyPreferences")
-	errRedis              = errors.New("redis error")
-	errDB                 = errors.New("database error")
+	errRedis = errors.New("redis error")
+	errDB    = errors.New("database error")
 )
 
-// MockUserRepository is a mock implementation of repository.UserRepository.
-type MockUserRepository struct {
-	mock.Mock
-}
-
-func (m *MockUserRepository) FindUserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf(mockErrorFmt, err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.User); ok {
-		return val, nil
-	}
-
-	return nil, errMockInvalidUser
-}
-
-func (m *MockUserRepository) FindPrivacyPreferencesByUserID(
-	ctx context.Context,
-	userID uuid.UUID,
-) (*dto.PrivacyPreferences, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf(mockErrorFmt, err) // wrapcheck
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.PrivacyPreferences); ok {
-		return val, nil
-	}
-
-	return nil, errMockInvalidPrivacy
-}
-
-func (m *MockUserRepository) IsFollowing(ctx context.Context, followerID, followedID uuid.UUID) (bool, error) {
-	args := m.Called(ctx, followerID, followedID)
-	// wrapcheck: Error might be nil, but if it's not, we should technically wrap it or just ignore.
-	// However, for bool returns it's simpler.
-	err := args.Error(1)
-	if err != nil {
-		return args.Bool(0), fmt.Errorf(mockErrorFmt, err)
-	}
-
-	return args.Bool(0), nil
-}
-
-func (m *MockUserRepository) UpdateUser(
-	ctx context.Context,
-	userID uuid.UUID,
-	update *dto.UserProfileUpdateRequest,
-) (*dto.User, error) {
-	args := m.Called(ctx, userID, update)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf(mockErrorFmt, err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.User); ok {
-		return val, nil
-	}
-
-	return nil, errMockInvalidUser
-}
-
-func (m *MockUserRepository) SearchUsers(
-	ctx context.Context,
-	query string,
-	limit, offset int,
-) ([]dto.UserSearchResult, int, error) {
-	args := m.Called(ctx, query, limit, offset)
-
-	err := args.Error(2)
-	if err != nil {
-		return nil, 0, fmt.Errorf(mockErrorFmt, err)
-	}
-
-	results, _ := args.Get(0).([]dto.UserSearchResult)
-
-	return results, args.Int(1), nil
-}
-
-func (m *MockUserRepository) GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error) {
-	args := m.Called(ctx)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockErrorFmt, err)
-	}
-
-	result, _ := args.Get(0).(*dto.UserStatsResponse)
-
-	return result, nil
-}
-
-// MockTokenStore is a mock implementation of repository.TokenStore.
-type MockTokenStore struct {
-	mock.Mock
-}
-
-func (m *MockTokenStore) StoreDeleteToken(
-	ctx context.Context,
-	userID uuid.UUID,
-	token string,
-	ttl time.Duration,
-) error {
-	args := m.Called(ctx, userID, token, ttl)
-
-	err := args.Error(0)
-	if err != nil {
-		return fmt.Errorf(mockErrorFmt, err)
-	}
-
-	return nil
-}
-
-func (m *MockTokenStore) GetDeleteToken(ctx context.Context, userID uuid.UUID) (string, error) {
-	args := m.Called(ctx, userID)
-
-	err := args.Error(1)
-	if err != nil {
-		return args.String(0), fmt.Errorf(mockErrorFmt, err)
-	}
-
-	return args.String(0), nil
-}
-
-func (m *MockTokenStore) DeleteDeleteToken(ctx context.Context, userID uuid.UUID) error {
-	args := m.Called(ctx, userID)
-
-	err := args.Error(0)
-	if err != nil {
-		return fmt.Errorf(mockErrorFmt, err)
-	}
-
-	return nil
-}
-
 type userServiceTestCase struct {
 	name          string
 	requesterID   uuid.UUID
 	targetUser    *dto.User
-	targetPrivacy *dto.PrivacyPreferences
+	targetPrivacy *dto.UserPrivacyPreferences
 	isFollowing   bool
 	expectedErr   error
 	validateResp  func(*testing.T, *dto.UserProfileResponse)
@@ -225,38 +77,53 @@ func getSuccessTestCases(
 			name:        "Self Profile - Always Allow",
 			requesterID: targetID,
 			targetUser:  baseUser,
-			targetPrivacy: &dto.PrivacyPreferences{
-				ProfileVisibility: "private",
-				ShowEmail:         false,
+			targetPrivacy: &dto.UserPrivacyPreferences{
+				ProfileVisibility: dto.ProfileVisibilityPrivate,
 			},
 			validateResp: func(t *testing.T, r *dto.UserProfileResponse) {
 				t.Helper()
 				assert.Equal(t, testEmail, *r.Email)
 				assert.Equal(t, testUserFullName, *r.FullName)
+				assert.Equal(t, "CA-QC", *r.Region, "own location is always shown")
 			},
 		},
 		{
 			name:        "Public Profile - Can View",
 			requesterID: requesterID,
 			targetUser:  baseUser,
-			targetPrivacy: &dto.PrivacyPreferences{
-				ProfileVisibility: "public",
-				ShowEmail:         false,
+			targetPrivacy: &dto.UserPrivacyPreferences{
+				ProfileVisibility: dto.ProfileVisibilityPublic,
 				ShowFullName:      true,
 			},
 			validateResp: func(t *testing.T, r *dto.UserProfileResponse) {
 				t.Helper()
 				assert.Nil(t, r.Email)
 				assert.Equal(t, testUserFullName, *r.FullName)
+				assert.Nil(t, r.Country)
+				assert.Nil(t, r.Region)
+			},
+		},
+		{
+			name:        "Public Profile - Shows Location",
+			requesterID: requesterID,
+			targetUser:  baseUser,
+			targetPrivacy: &dto.UserPrivacyPreferences{
+				ProfileVisibility: dto.ProfileVisibilityPublic,
+				ShowLocation:      true,
+			},
+			validateResp: func(t *testing.T, r *dto.UserProfileResponse) {
+				t.Helper()
+				assert.Equal(t, "CA", *r.Country)
+				assert.Equal(t, "CA-QC", *r.Region)
 			},
 		},
 		{
 			name:        "Followers Only - Following - Allow",
 			requesterID: followerID,
 			targetUser:  baseUser,
-			targetPrivacy: &dto.PrivacyPreferences{
-				ProfileVisibility: "followers_only",
-				ShowEmail:         true,
+			targetPrivacy: &dto.UserPrivacyPreferences{
+				ProfileVisibility:     dto.ProfileVisibilityFriendsOnly,
+				ContactInfoVisibility: dto.ProfileVisibilityPublic,
 			},
 			isFollowing: true,
 			validateResp: func(t *testing.T, r *dto.UserProfileResponse) {
@@ -273,8 +140,8 @@ func getErrorTestCases(requesterID uuid.UUID, baseUser *dto.User) []userServiceT
 			name:        "Private Profile - Not Self - Deny",
 			requesterID: requesterID,
 			targetUser:  baseUser,
-			targetPrivacy: &dto.PrivacyPreferences{
-				ProfileVisibility: "private",
+			targetPrivacy: &dto.UserPrivacyPreferences{
+				ProfileVisibility: dto.ProfileVisibilityPrivate,
 			},
 			expectedErr: service.ErrProfilePrivate,
 		},
@@ -282,8 +149,8 @@ func getErrorTestCases(requesterID uuid.UUID, baseUser *dto.User) []userServiceT
 			name:        "Followers Only - Not Following - Deny",
 			requesterID: requesterID,
 			targetUser:  baseUser,
-			targetPrivacy: &dto.PrivacyPreferences{
-				ProfileVisibility: "followers_only",
+			targetPrivacy: &dto.UserPrivacyPreferences{
+				ProfileVisibility: dto.ProfileVisibilityFriendsOnly,
 			},
 			isFollowing: false,
 			expectedErr: service.ErrProfilePrivate,
@@ -303,6 +170,8 @@ func createBaseUser(targetID uuid.UUID) *dto.User {
 		Email:     func() *string { s := testEmail; return &s }(),
 		FullName:  func() *string { s := testUserFullName; return &s }(),
 		Bio:       func() *string { s := "Bio"; return &s }(),
+		Country:   func() *string { s := "CA"; return &s }(),
+		Region:    func() *string { s := "CA-QC"; return &s }(),
 		IsActive:  true,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -316,9 +185,9 @@ func runUserServiceTest(t *testing.T, tests []userServiceTestCase, targetID uuid
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockRepo := new(MockUserRepository)
-			mockTokenStore := new(MockTokenStore)
-			svc := service.NewUserService(mockRepo, mockTokenStore, nil)
+			mockRepo := new(mocks.UserRepository)
+			mockTokenStore := new(mocks.TokenStore)
+			svc := service.NewUserService(mockRepo, mockTokenStore, nil, nil)
 
 			ctx := context.Background()
 
@@ -333,18 +202,18 @@ func runUserServiceTest(t *testing.T, tests []userServiceTestCase, targetID uuid
 
 func setupMockExpectations(
 	ctx context.Context,
-	mockRepo *MockUserRepository,
+	mockRepo *mocks.UserRepository,
 	tt userServiceTestCase,
 	targetID uuid.UUID,
 ) {
-	// Setup expectations
+	// Setup expectations. Profile lookups are shared between requests, so they run detached from ctx.
 	if tt.targetUser == nil {
-		mockRepo.On("FindUserByID", ctx, targetID).Return(nil, repository.ErrUserNotFound)
+		mockRepo.On("FindUserByID", mock.Anything, targetID).Return(nil, repository.ErrUserNotFound)
 	} else {
-		mockRepo.On("FindUserByID", ctx, targetID).Return(tt.targetUser, nil)
-		mockRepo.On("FindPrivacyPreferencesByUserID", ctx, targetID).Return(tt.targetPrivacy, nil)
+		mockRepo.On("FindUserByID", mock.Anything, targetID).Return(tt.targetUser, nil)
+		mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(tt.targetPrivacy, nil)
 
-		if tt.targetPrivacy.ProfileVisibility == "followers_only" && tt.requesterID != targetID {
+		if tt.targetPrivacy.ProfileVisibility == dto.ProfileVisibilityFriendsOnly && tt.requesterID != targetID {
 			mockRepo.On("IsFollowing", ctx, tt.requesterID, targetID).Return(tt.isFollowing, nil)
 		}
 	}
@@ -392,7 +261,7 @@ func TestUserServiceUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 	tests := []struct {
 		name         string
 		update       *dto.UserProfileUpdateRequest
-		setupMock    func(*MockUserRepository)
+		setupMock    func(*mocks.UserRepository)
 		expectedErr  error
 		validateResp func(*testing.T, *dto.UserProfileResponse)
 	}{
@@ -401,7 +270,7 @@ func TestUserServiceUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 			update: &dto.UserProfileUpdateRequest{
 				Username: func() *string { s := testNewUsername; return &s }(),
 			},
-			setupMock: func(m *MockUserRepository) {
+			setupMock: func(m *mocks.UserRepository) {
 				m.On("FindUserByID", mock.Anything, userID).Return(baseUser, nil)
 				updatedUser := *baseUser
 				updatedUser.Username = testNewUsername
@@ -416,16 +285,15 @@ func TestUserServiceUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 			name: "Success - Update All Fields",
 			update: &dto.UserProfileUpdateRequest{
 				Username: func() *string { s := testNewUsername; return &s }(),
-				Email:    func() *string { s := "new@email.com"; return &s }(),
 				FullName: func() *string { s := "New Name"; return &s }(),
 				Bio:      func() *string { s := "New bio"; return &s }(),
 			},
-			setupMock: func(m *MockUserRepository) {
+			setupMock: func(m *mocks.UserRepository) {
 				m.On("FindUserByID", mock.Anything, userID).Return(baseUser, nil)
 				updatedUser := &dto.User{
 					UserID:    userID.String(),
 					Username:  testNewUsername,
-					Email:     func() *string { s := "new@email.com"; return &s }(),
+					Email:     baseUser.Email,
 					FullName:  func() *string { s := "New Name"; return &s }(),
 					Bio:       func() *string { s := "New bio"; return &s }(),
 					IsActive:  true,
@@ -437,7 +305,7 @@ func TestUserServiceUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 			validateResp: func(t *testing.T, r *dto.UserProfileResponse) {
 				t.Helper()
 				assert.Equal(t, testNewUsername, r.Username)
-				assert.Equal(t, "new@email.com", *r.Email)
+				assert.Equal(t, "old@email.com", *r.Email)
 				assert.Equal(t, "New Name", *r.FullName)
 				assert.Equal(t, "New bio", *r.Bio)
 			},
@@ -445,7 +313,7 @@ func TestUserServiceUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 		{
 			name:   "Success - No Changes (empty request)",
 			update: &dto.UserProfileUpdateRequest{},
-			setupMock: func(m *MockUserRepository) {
+			setupMock: func(m *mocks.UserRepository) {
 				m.On("FindUserByID", mock.Anything, userID).Return(baseUser, nil)
 				// UpdateUser should not be called
 			},
@@ -459,7 +327,7 @@ func TestUserServiceUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 			update: &dto.UserProfileUpdateRequest{
 				Username: func() *string { s := testNewUsername; return &s }(),
 			},
-			setupMock: func(m *MockUserRepository) {
+			setupMock: func(m *mocks.UserRepository) {
 				m.On("FindUserByID", mock.Anything, userID).Return(nil, repository.ErrUserNotFound)
 			},
 			expectedErr: service.ErrUserNotFound,
@@ -469,7 +337,7 @@ func TestUserServiceUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 			update: &dto.UserProfileUpdateRequest{
 				Username: func() *string { s := testNewUsername; return &s }(),
 			},
-			setupMock: func(m *MockUserRepository) {
+			setupMock: func(m *mocks.UserRepository) {
 				m.On("FindUserByID", mock.Anything, userID).Return(baseUser, nil)
 				m.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(nil, repository.ErrUserNotFound)
 			},
@@ -480,21 +348,42 @@ func TestUserServiceUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 			update: &dto.UserProfileUpdateRequest{
 				Username: func() *string { s := "existinguser"; return &s }(),
 			},
-			setupMock: func(m *MockUserRepository) {
+			setupMock: func(m *mocks.UserRepository) {
 				m.On("FindUserByID", mock.Anything, userID).Return(baseUser, nil)
 				m.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(nil, repository.ErrDuplicateUsername)
 			},
 			expectedErr: service.ErrDuplicateUsername,
 		},
+		{
+			name: "Retired Username",
+			update: &dto.UserProfileUpdateRequest{
+				Username: func() *string { s := "retireduser"; return &s }(),
+			},
+			setupMock: func(m *mocks.UserRepository) {
+				m.On("FindUserByID", mock.Anything, userID).Return(baseUser, nil)
+				m.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(nil, repository.ErrUsernameRetired)
+			},
+			expectedErr: service.ErrUsernameRetired,
+		},
+		{
+			name: "Email Change Requires Confirmation",
+			update: &dto.UserProfileUpdateRequest{
+				Email: func() *string { s := "new@email.com"; return &s }(),
+			},
+			setupMock: func(*mocks.UserRepository) {
+				// Neither FindUserByID nor UpdateUser should be called
+			},
+			expectedErr: service.ErrEmailChangeRequiresConfirmation,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockRepo := new(MockUserRepository)
-			mockTokenStore := new(MockTokenStore)
-			svc := service.NewUserService(mockRepo, mockTokenStore, nil)
+			mockRepo := new(mocks.UserRepository)
+			mockTokenStore := new(mocks.TokenStore)
+			svc := service.NewUserService(mockRepo, mockTokenStore, nil, nil)
 
 			tt.setupMock(mockRepo)
 
@@ -516,6 +405,181 @@ func TestUserServiceUpdateUserProfile(t *testing.T) { //nolint:funlen // table-d
 	}
 }
 
+func TestUserServiceUpdateUserProfile_Location(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	france, paris, canada, quebec, none := "FR", "FR-75C", "CA", "CA-QC", ""
+	stored := &dto.User{UserID: userID.String(), Country: &france, Region: &paris}
+
+	tests := []struct {
+		name       string
+		update     dto.UserProfileUpdateRequest
+		wantRegion *string
+		wantErr    error
+	}{
+		{"region in the stored country", dto.UserProfileUpdateRequest{Region: &paris}, &paris, nil},
+		{"region outside the stored country", dto.UserProfileUpdateRequest{Region: &quebec}, nil,
+			service.ErrRegionOutsideCountry},
+		{"region without a country", dto.UserProfileUpdateRequest{Country: &none, Region: &paris}, nil,
+			service.ErrRegionOutsideCountry},
+		{"new country and region", dto.UserProfileUpdateRequest{Country: &canada, Region: &quebec}, &quebec, nil},
+		{"new country clears the region", dto.UserProfileUpdateRequest{Country: &canada}, &none, nil},
+		{"clearing the country clears the region", dto.UserProfileUpdateRequest{Country: &none}, &none, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewUserRepository(t)
+			svc := service.NewUserService(mockRepo, nil, nil, nil)
+
+			mockRepo.On("FindUserByID", mock.Anything, userID).Return(stored, nil)
+
+			if tt.wantErr == nil {
+				mockRepo.On("UpdateUser", mock.Anything, userID, mock.MatchedBy(func(u *dto.UserProfileUpdateRequest) bool {
+					return assert.ObjectsAreEqual(tt.wantRegion, u.Region)
+				})).Return(stored, nil)
+			}
+
+			_, err := svc.UpdateUserProfile(t.Context(), userID, &tt.update)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUserServiceUpdateUserProfile_ModeratedAccount(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	username, bio := testNewUsername, "Still allowed"
+
+	mockRepo := mocks.NewUserRepository(t)
+	moderation := mocks.NewModerationRepository(t)
+	svc := service.NewUserService(mockRepo, nil, nil, moderation)
+
+	mockRepo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+	moderation.On("IsUnderModeration", mock.Anything, userID).Return(true, nil).Once()
+
+	_, err := svc.UpdateUserProfile(t.Context(), userID, &dto.UserProfileUpdateRequest{Username: &username})
+	require.ErrorIs(t, err, service.ErrChangeApprovalRequired)
+
+	// Other fields do not need approval
+	mockRepo.On("UpdateUser", mock.Anything, userID, mock.Anything).
+		Return(&dto.User{UserID: userID.String(), Bio: &bio}, nil)
+
+	_, err = svc.UpdateUserProfile(t.Context(), userID, &dto.UserProfileUpdateRequest{Bio: &bio})
+	require.NoError(t, err)
+}
+
+func TestUserServiceUpdateUserProfile_ContentModeration(t *testing.T) { //nolint:funlen // one subtest per action
+	t.Parallel()
+
+	userID := uuid.New()
+	words := contentmod.NewWordList([]string{"darn"})
+	fullName, cleanBio, rudeBio, maskedBio := "Sam Cook", "Bakes bread", "Darn good bread", "**** good bread"
+
+	t.Run("reject", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := mocks.NewUserRepository(t)
+		svc := service.NewUserService(mockRepo, nil, nil, nil)
+		svc.SetContentModerator(words, contentmod.ActionReject)
+
+		mockRepo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+
+		_, err := svc.UpdateUserProfile(t.Context(), userID,
+			&dto.UserProfileUpdateRequest{FullName: &fullName, Bio: &rudeBio})
+		require.ErrorIs(t, err, service.ErrObjectionableContent)
+
+		var rejected validation.ValidationErrors
+
+		require.ErrorAs(t, err, &rejected)
+		require.Len(t, rejected, 1)
+		assert.Equal(t, "bio", rejected[0].Field)
+		assert.Equal(t, "moderation", rejected[0].Code)
+		assert.Nil(t, rejected[0].RejectedValue, "the text is not echoed back")
+	})
+
+	t.Run("mask", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := mocks.NewUserRepository(t)
+		svc := service.NewUserService(mockRepo, nil, nil, nil)
+		svc.SetContentModerator(words, contentmod.ActionMask)
+
+		update := &dto.UserProfileUpdateRequest{FullName: &fullName, Bio: &rudeBio}
+
+		mockRepo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+		mockRepo.On("UpdateUser", mock.Anything, userID, mock.MatchedBy(func(u *dto.UserProfileUpdateRequest) bool {
+			return *u.Bio == maskedBio && *u.FullName == fullName
+		})).Return(&dto.User{UserID: userID.String(), FullName: &fullName, Bio: &maskedBio}, nil)
+
+		profile, err := svc.UpdateUserProfile(t.Context(), userID, update)
+		require.NoError(t, err)
+		assert.Equal(t, maskedBio, *profile.Bio)
+		assert.Equal(t, rudeBio, *update.Bio, "the caller's update is not modified")
+	})
+
+	t.Run("flag", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := mocks.NewUserRepository(t)
+		moderation := mocks.NewModerationRepository(t)
+		svc := service.NewUserService(mockRepo, nil, nil, moderation)
+		svc.SetContentModerator(words, contentmod.ActionFlag)
+
+		mockRepo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+		mockRepo.On("UpdateUser", mock.Anything, userID, mock.MatchedBy(func(u *dto.UserProfileUpdateRequest) bool {
+			return *u.Bio == rudeBio
+		})).Return(&dto.User{UserID: userID.String(), Bio: &rudeBio}, nil)
+		moderation.On("FlagContent", mock.Anything, &dto.ContentFlag{
+			UserID: userID.String(), Field: "bio", Content: rudeBio, Terms: []string{"Darn"},
+		}).Return(nil).Once()
+
+		_, err := svc.UpdateUserProfile(t.Context(), userID, &dto.UserProfileUpdateRequest{Bio: &rudeBio})
+		require.NoError(t, err)
+	})
+
+	t.Run("flag failure fails the update", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := mocks.NewUserRepository(t)
+		moderation := mocks.NewModerationRepository(t)
+		tx := mocks.NewTxManager(t)
+		svc := service.NewUserService(mockRepo, nil, nil, moderation)
+		svc.SetContentModerator(words, contentmod.ActionFlag)
+		svc.SetTxManager(tx)
+
+		tx.On("WithinTx", mock.Anything, mock.Anything).Return(
+			func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) },
+		).Once()
+		mockRepo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+		mockRepo.On("UpdateUser", mock.Anything, userID, mock.Anything).
+			Return(&dto.User{UserID: userID.String(), Bio: &rudeBio}, nil)
+		moderation.On("FlagContent", mock.Anything, mock.Anything).Return(assert.AnError).Once()
+
+		_, err := svc.UpdateUserProfile(t.Context(), userID, &dto.UserProfileUpdateRequest{Bio: &rudeBio})
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("clean text", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := mocks.NewUserRepository(t)
+		svc := service.NewUserService(mockRepo, nil, nil, nil)
+		svc.SetContentModerator(words, contentmod.ActionReject)
+
+		mockRepo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+		mockRepo.On("UpdateUser", mock.Anything, userID, mock.Anything).
+			Return(&dto.User{UserID: userID.String(), Bio: &cleanBio}, nil)
+
+		_, err := svc.UpdateUserProfile(t.Context(), userID, &dto.UserProfileUpdateRequest{Bio: &cleanBio})
+		require.NoError(t, err)
+	})
+}
+
 func TestUserServiceRequestAccountDeletion(t *testing.T) { //nolint:funlen // table-driven test
 	t.Parallel()
 
@@ -535,14 +599,14 @@ func TestUserServiceRequestAccountDeletion(t *testing.T) { //nolint:funlen // ta
 
 	tests := []struct {
 		name          string
-		setupMock     func(*MockUserRepository, *MockTokenStore)
+		setupMock     func(*mocks.UserRepository, *mocks.TokenStore)
 		tokenStoreNil bool
 		expectedErr   error
 		validateResp  func(*testing.T, *dto.UserAccountDeleteRequestResponse)
 	}{
 		{
 			name: "Success",
-			setupMock: func(repo *MockUserRepository, ts *MockTokenStore) {
+			setupMock: func(repo *mocks.UserRepository, ts *mocks.TokenStore) {
 				repo.On("FindUserByID", mock.Anything, userID).Return(baseUser, nil)
 				ts.On("StoreDeleteToken", mock.Anything, userID, mock.Anything, service.DeleteTokenTTL).Return(nil)
 			},
@@ -560,14 +624,14 @@ func TestUserServiceRequestAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		},
 		{
 			name: "User Not Found",
-			setupMock: func(repo *MockUserRepository, ts *MockTokenStore) {
+			setupMock: func(repo *mocks.UserRepository, ts *mocks.TokenStore) {
 				repo.On("FindUserByID", mock.Anything, userID).Return(nil, repository.ErrUserNotFound)
 			},
 			expectedErr: service.ErrUserNotFound,
 		},
 		{
 			name: "Cache Unavailable - Redis Error",
-			setupMock: func(repo *MockUserRepository, ts *MockTokenStore) {
+			setupMock: func(repo *mocks.UserRepository, ts *mocks.TokenStore) {
 				repo.On("FindUserByID", mock.Anything, userID).Return(baseUser, nil)
 				ts.On("StoreDeleteToken", mock.Anything, userID, mock.Anything, service.DeleteTokenTTL).Return(errRedis)
 			},
@@ -579,18 +643,18 @@ func TestUserServiceRequestAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockRepo := new(MockUserRepository)
+			mockRepo := new(mocks.UserRepository)
 
-			var tokenStore *MockTokenStore
+			var tokenStore *mocks.TokenStore
 			if !tt.tokenStoreNil {
-				tokenStore = new(MockTokenStore)
+				tokenStore = new(mocks.TokenStore)
 			}
 
 			var svc *service.UserServiceImpl
 			if tt.tokenStoreNil {
-				svc = service.NewUserService(mockRepo, nil, nil)
+				svc = service.NewUserService(mockRepo, nil, nil, nil)
 			} else {
-				svc = service.NewUserService(mockRepo, tokenStore, nil)
+				svc = service.NewUserService(mockRepo, tokenStore, nil, nil)
 			}
 
 			if tt.setupMock != nil && tokenStore != nil {
@@ -638,7 +702,7 @@ func TestUserServiceConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 	tests := []struct {
 		name          string
 		token         string
-		setupMock     func(*MockUserRepository, *MockTokenStore)
+		setupMock     func(*mocks.UserRepository, *mocks.TokenStore)
 		tokenStoreNil bool
 		expectedErr   error
 		validateResp  func(*testing.T, *dto.UserConfirmAccountDeleteResponse)
@@ -646,7 +710,7 @@ func TestUserServiceConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		{
 			name:  "Success",
 			token: token,
-			setupMock: func(repo *MockUserRepository, ts *MockTokenStore) {
+			setupMock: func(repo *mocks.UserRepository, ts *mocks.TokenStore) {
 				ts.On("GetDeleteToken", mock.Anything, userID).Return(token, nil)
 
 				deactivatedUser := *baseUser
@@ -669,7 +733,7 @@ func TestUserServiceConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		{
 			name:  "Invalid Token - Not Found in Cache",
 			token: token,
-			setupMock: func(repo *MockUserRepository, ts *MockTokenStore) {
+			setupMock: func(repo *mocks.UserRepository, ts *mocks.TokenStore) {
 				ts.On("GetDeleteToken", mock.Anything, userID).Return("", redis.ErrTokenNotFound)
 			},
 			expectedErr: service.ErrInvalidToken,
@@ -677,7 +741,7 @@ func TestUserServiceConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		{
 			name:  "Invalid Token - Token Mismatch",
 			token: "wrong-token",
-			setupMock: func(repo *MockUserRepository, ts *MockTokenStore) {
+			setupMock: func(repo *mocks.UserRepository, ts *mocks.TokenStore) {
 				ts.On("GetDeleteToken", mock.Anything, userID).Return(token, nil)
 			},
 			expectedErr: service.ErrInvalidToken,
@@ -685,7 +749,7 @@ func TestUserServiceConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		{
 			name:  "User Not Found During Deactivation",
 			token: token,
-			setupMock: func(repo *MockUserRepository, ts *MockTokenStore) {
+			setupMock: func(repo *mocks.UserRepository, ts *mocks.TokenStore) {
 				ts.On("GetDeleteToken", mock.Anything, userID).Return(token, nil)
 				repo.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(nil, repository.ErrUserNotFound)
 			},
@@ -694,7 +758,7 @@ func TestUserServiceConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		{
 			name:  "Success - Token Cleanup Error Ignored",
 			token: token,
-			setupMock: func(repo *MockUserRepository, ts *MockTokenStore) {
+			setupMock: func(repo *mocks.UserRepository, ts *mocks.TokenStore) {
 				ts.On("GetDeleteToken", mock.Anything, userID).Return(token, nil)
 
 				deactivatedUser := *baseUser
@@ -714,18 +778,18 @@ func TestUserServiceConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockRepo := new(MockUserRepository)
+			mockRepo := new(mocks.UserRepository)
 
-			var tokenStore *MockTokenStore
+			var tokenStore *mocks.TokenStore
 			if !tt.tokenStoreNil {
-				tokenStore = new(MockTokenStore)
+				tokenStore = new(mocks.TokenStore)
 			}
 
 			var svc *service.UserServiceImpl
 			if tt.tokenStoreNil {
-				svc = service.NewUserService(mockRepo, nil, nil)
+				svc = service.NewUserService(mockRepo, nil, nil, nil)
 			} else {
-				svc = service.NewUserService(mockRepo, tokenStore, nil)
+				svc = service.NewUserService(mockRepo, tokenStore, nil, nil)
 			}
 
 			if tt.setupMock != nil && tokenStore != nil {
@@ -750,6 +814,75 @@ func TestUserServiceConfirmAccountDeletion(t *testing.T) { //nolint:funlen // ta
 	}
 }
 
+func TestUserServiceAccountDeletion_SignedTokens(t *testing.T) {
+	t.Parallel()
+
+	signer := signedtoken.New([]byte("test-key"))
+	userID := uuid.New()
+
+	t.Run("Request Issues Signed Token", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(mocks.UserRepository)
+		tokenStore := new(mocks.TokenStore)
+		mockRepo.On("FindUserByID", mock.Anything, userID).Return(&dto.User{UserID: userID.String()}, nil)
+		tokenStore.On("StoreDeleteToken", mock.Anything, userID, mock.Anything, service.DeleteTokenTTL).Return(nil)
+
+		svc := service.NewUserService(mockRepo, tokenStore, nil, nil)
+		svc.SetTokenSigner(signer)
+
+		resp, err := svc.RequestAccountDeletion(t.Context(), userID)
+		require.NoError(t, err)
+
+		claims, err := signer.Verify(resp.ConfirmationToken, service.DeleteTokenPurpose, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, userID, claims.UserID)
+		tokenStore.AssertCalled(t, "StoreDeleteToken", mock.Anything, userID, resp.ConfirmationToken,
+			service.DeleteTokenTTL)
+	})
+
+	t.Run("Confirm Rejects Tokens That Do Not Verify", func(t *testing.T) {
+		t.Parallel()
+
+		otherUser, err := signer.Sign(uuid.New(), service.DeleteTokenPurpose, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		otherPurpose, err := signer.Sign(userID, "email-verification", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		expired, err := signer.Sign(userID, service.DeleteTokenPurpose, time.Now().Add(-time.Second))
+		require.NoError(t, err)
+
+		// The token store is not consulted, so a token surviving in it is not enough
+		svc := service.NewUserService(new(mocks.UserRepository), new(mocks.TokenStore), nil, nil)
+		svc.SetTokenSigner(signer)
+
+		for _, token := range []string{uuid.NewString(), otherUser, otherPurpose, expired} {
+			_, err := svc.ConfirmAccountDeletion(t.Context(), userID, token)
+			require.ErrorIs(t, err, service.ErrInvalidToken)
+		}
+	})
+
+	t.Run("Confirm Requires The Latest Stored Token", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := signer.Sign(userID, service.DeleteTokenPurpose, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		latest, err := signer.Sign(userID, service.DeleteTokenPurpose, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		tokenStore := new(mocks.TokenStore)
+		tokenStore.On("GetDeleteToken", mock.Anything, userID).Return(latest, nil)
+
+		svc := service.NewUserService(new(mocks.UserRepository), tokenStore, nil, nil)
+		svc.SetTokenSigner(signer)
+
+		_, err = svc.ConfirmAccountDeletion(t.Context(), userID, token)
+		require.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+}
+
 func TestUserServiceGetUserStats(t *testing.T) {
 	t.Parallel()
 
@@ -764,20 +897,20 @@ func TestUserServiceGetUserStats(t *testing.T) {
 
 	tests := []struct {
 		name        string
-		setupMock   func(*MockUserRepository)
+		setupMock   func(*mocks.UserRepository)
 		expectedErr error
 		expected    *dto.UserStatsResponse
 	}{
 		{
 			name: "Success",
-			setupMock: func(m *MockUserRepository) {
+			setupMock: func(m *mocks.UserRepository) {
 				m.On("GetUserStats", mock.Anything).Return(expectedStats, nil)
 			},
 			expected: expectedStats,
 		},
 		{
 			name: "Repository Error",
-			setupMock: func(m *MockUserRepository) {
+			setupMock: func(m *mocks.UserRepository) {
 				m.On("GetUserStats", mock.Anything).Return(nil, errDB)
 			},
 			expectedErr: errDB,
@@ -788,9 +921,9 @@ func TestUserServiceGetUserStats(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockRepo := new(MockUserRepository)
-			mockTokenStore := new(MockTokenStore)
-			svc := service.NewUserService(mockRepo, mockTokenStore, nil)
+			mockRepo := new(mocks.UserRepository)
+			mockTokenStore := new(mocks.TokenStore)
+			svc := service.NewUserService(mockRepo, mockTokenStore, nil, nil)
 
 			tt.setupMock(mockRepo)
 
@@ -807,3 +940,481 @@ func TestUserServiceGetUserStats(t *testing.T) {
 		})
 	}
 }
+
+func TestUserServiceSearchUsers_Personalized(t *testing.T) {
+	t.Parallel()
+
+	requesterID := uuid.New()
+	ranked := []dto.UserSearchResult{
+		{Username: "chefbob", Connection: dto.SearchConnectionFollowing},
+		{Username: "chef", Connection: dto.SearchConnectionFollowing},
+		{Username: "aliceschef", Connection: dto.SearchConnectionSecondDegree},
+		{Username: "bakerchef"},
+		{Username: "Chef"},
+	}
+
+	t.Run("Ranks Connections And Closer Matches First", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("SearchUsersRanked", mock.Anything, requesterID, "chef", dto.LocationFilter{}, 20, 0).
+			Return(slices.Clone(ranked), 9, nil)
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+		svc.SetPersonalizedSearch(true)
+
+		resp, err := svc.SearchUsers(context.Background(), requesterID, "chef", dto.LocationFilter{}, 20, 0, false)
+		require.NoError(t, err)
+		assert.Equal(t, 9, resp.TotalCount)
+
+		var usernames []string
+		for _, result := range resp.Results {
+			usernames = append(usernames, result.Username)
+		}
+
+		assert.Equal(t, []string{"chef", "chefbob", "aliceschef", "Chef", "bakerchef"}, usernames)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Off By Default", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("SearchUsers", mock.Anything, "chef", dto.LocationFilter{}, 20, 0).Return(slices.Clone(ranked), 9, nil)
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+
+		resp, err := svc.SearchUsers(context.Background(), requesterID, "chef", dto.LocationFilter{}, 20, 0, false)
+		require.NoError(t, err)
+		assert.Equal(t, ranked, resp.Results)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserServiceSearchUsers_Cache(t *testing.T) {
+	t.Parallel()
+
+	requesterID := uuid.New()
+	results := []dto.UserSearchResult{{UserID: uuid.NewString(), Username: "chef"}}
+
+	t.Run("Serves Repeated Searches From Cache", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("SearchUsers", mock.Anything, "chef", dto.LocationFilter{}, 20, 0).
+			Return(slices.Clone(results), 1, nil).Once()
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+		svc.SetSearchCache(memory.New(), time.Minute)
+
+		first, err := svc.SearchUsers(context.Background(), requesterID, "chef", dto.LocationFilter{}, 20, 0, false)
+		require.NoError(t, err)
+
+		second, err := svc.SearchUsers(context.Background(), uuid.Nil, "Chef", dto.LocationFilter{}, 20, 0, false)
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Personalized Searches Are Not Cached", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("SearchUsersRanked", mock.Anything, requesterID, "chef", dto.LocationFilter{}, 20, 0).
+			Return(slices.Clone(results), 1, nil).Twice()
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+		svc.SetPersonalizedSearch(true)
+		svc.SetSearchCache(memory.New(), time.Minute)
+
+		for range 2 {
+			_, err := svc.SearchUsers(context.Background(), requesterID, "chef", dto.LocationFilter{}, 20, 0, false)
+			require.NoError(t, err)
+		}
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Searches Without Cache When It Fails", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("SearchUsers", mock.Anything, "chef", dto.LocationFilter{}, 20, 0).Return(slices.Clone(results), 1, nil)
+
+		cache := mocks.NewSearchCache(t)
+		cache.On("GetSearchResults", mock.Anything, mock.Anything).Return(nil, redis.ErrRedisUnavailable)
+		cache.On("SetSearchResults", mock.Anything, mock.Anything, mock.Anything, time.Minute).
+			Return(redis.ErrRedisUnavailable)
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+		svc.SetSearchCache(cache, time.Minute)
+
+		resp, err := svc.SearchUsers(context.Background(), requesterID, "chef", dto.LocationFilter{}, 20, 0, false)
+		require.NoError(t, err)
+		assert.Equal(t, results, resp.Results)
+	})
+
+	t.Run("Concurrent Misses Share One Search", func(t *testing.T) {
+		t.Parallel()
+
+		const searches = 5
+
+		var looked sync.WaitGroup
+
+		looked.Add(searches)
+
+		release := make(chan time.Time)
+
+		cache := mocks.NewSearchCache(t)
+		cache.On("GetSearchResults", mock.Anything, mock.Anything).
+			Run(func(mock.Arguments) { looked.Done() }).Return(nil, nil)
+		cache.On("SetSearchResults", mock.Anything, mock.Anything, mock.Anything, time.Minute).Return(nil).Once()
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("SearchUsers", mock.Anything, "chef", dto.LocationFilter{}, 20, 0).
+			WaitUntil(release).Return(slices.Clone(results), 1, nil).Once()
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+		svc.SetSearchCache(cache, time.Minute)
+
+		var searched sync.WaitGroup
+		for range searches {
+			searched.Go(func() {
+				resp, err := svc.SearchUsers(context.Background(), uuid.Nil, "chef", dto.LocationFilter{}, 20, 0, false)
+				assert.NoError(t, err)
+				assert.Equal(t, results, resp.Results)
+			})
+		}
+
+		// Let every search reach the shared load before it returns
+		looked.Wait()
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		searched.Wait()
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Profile Updates Drop Cached Results", func(t *testing.T) {
+		t.Parallel()
+
+		userID := uuid.New()
+		fullName := "New Name"
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("SearchUsers", mock.Anything, "chef", dto.LocationFilter{}, 20, 0).
+			Return(slices.Clone(results), 1, nil).Twice()
+		mockRepo.On("FindUserByID", mock.Anything, userID).Return(createBaseUser(userID), nil)
+		mockRepo.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(createBaseUser(userID), nil)
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+		svc.SetSearchCache(memory.New(), time.Minute)
+
+		_, err := svc.SearchUsers(context.Background(), uuid.Nil, "chef", dto.LocationFilter{}, 20, 0, false)
+		require.NoError(t, err)
+
+		_, err = svc.UpdateUserProfile(context.Background(), userID, &dto.UserProfileUpdateRequest{FullName: &fullName})
+		require.NoError(t, err)
+
+		_, err = svc.SearchUsers(context.Background(), uuid.Nil, "chef", dto.LocationFilter{}, 20, 0, false)
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserServiceGetUserProfile_SharedReads(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.New()
+	privacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
+
+	t.Run("Concurrent Views Share Lookups", func(t *testing.T) {
+		t.Parallel()
+
+		const views = 5
+
+		release := make(chan time.Time)
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("FindUserByID", mock.Anything, targetID).
+			WaitUntil(release).Return(createBaseUser(targetID), nil).Once()
+		mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(privacy, nil)
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+
+		var viewed sync.WaitGroup
+		for range views {
+			viewed.Go(func() {
+				resp, err := svc.GetUserProfile(context.Background(), uuid.Nil, targetID)
+				assert.NoError(t, err)
+				assert.Equal(t, "targetuser", resp.Username)
+			})
+		}
+
+		// Let every view join the lookup before it returns
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		viewed.Wait()
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Cancelled View Stops Waiting", func(t *testing.T) {
+		t.Parallel()
+
+		release := make(chan time.Time)
+		defer close(release)
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("FindUserByID", mock.Anything, targetID).WaitUntil(release).Return(createBaseUser(targetID), nil)
+		mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).Return(privacy, nil).Maybe()
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := svc.GetUserProfile(ctx, uuid.Nil, targetID)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Slow Lookup Times Out", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("FindUserByID", mock.Anything, targetID).
+			Return(
+				func(ctx context.Context, _ uuid.UUID) *dto.User {
+					<-ctx.Done()
+
+					return nil
+				},
+				func(ctx context.Context, _ uuid.UUID) error { return ctx.Err() },
+			).
+			Once()
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+		svc.SetProfileReadTimeout(10 * time.Millisecond)
+
+		start := time.Now()
+		_, err := svc.GetUserProfile(context.Background(), uuid.Nil, targetID)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserServiceGetUserProfile_PublicProfiles(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.New()
+	projected := &dto.PublicProfile{
+		UserID:   targetID.String(),
+		IsActive: true,
+		Public:   true,
+		Profile:  &dto.UserProfileResponse{UserID: targetID.String(), Username: "targetuser", IsActive: true},
+	}
+
+	t.Run("Anonymous Views Read The Projection", func(t *testing.T) {
+		t.Parallel()
+
+		profiles := mocks.NewPublicProfileService(t)
+		profiles.On("GetPublicProfile", mock.Anything, targetID).Return(projected, nil)
+
+		svc := service.NewUserService(mocks.NewUserRepository(t), new(mocks.TokenStore), nil, nil)
+		svc.SetPublicProfiles(profiles)
+
+		resp, err := svc.GetUserProfile(context.Background(), uuid.Nil, targetID)
+		require.NoError(t, err)
+		assert.Equal(t, projected.Profile, resp)
+	})
+
+	t.Run("Non-Public Projected Profile Is Private", func(t *testing.T) {
+		t.Parallel()
+
+		profiles := mocks.NewPublicProfileService(t)
+		profiles.On("GetPublicProfile", mock.Anything, targetID).
+			Return(&dto.PublicProfile{UserID: targetID.String(), IsActive: true}, nil)
+
+		svc := service.NewUserService(mocks.NewUserRepository(t), new(mocks.TokenStore), nil, nil)
+		svc.SetPublicProfiles(profiles)
+
+		_, err := svc.GetUserProfile(context.Background(), uuid.Nil, targetID)
+		require.ErrorIs(t, err, service.ErrProfilePrivate)
+	})
+
+	t.Run("Signed In Views Read The Database", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(mocks.UserRepository)
+		mockRepo.On("FindUserByID", mock.Anything, targetID).Return(createBaseUser(targetID), nil)
+		mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).
+			Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}, nil)
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+		svc.SetPublicProfiles(mocks.NewPublicProfileService(t))
+
+		_, err := svc.GetUserProfile(context.Background(), uuid.New(), targetID)
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserServiceGetUserProfile_Badges(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.New()
+	badges := []dto.Badge{{BadgeID: "first-recipe", Name: "First Recipe", EarnedAt: time.Now()}}
+
+	mockRepo := new(mocks.UserRepository)
+	mockRepo.On("FindUserByID", mock.Anything, targetID).Return(createBaseUser(targetID), nil)
+	mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).
+		Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}, nil)
+
+	t.Run("earned badges", func(t *testing.T) {
+		t.Parallel()
+
+		badgeSvc := mocks.NewBadgeService(t)
+		badgeSvc.On("ListEarnedBadges", mock.Anything, targetID).Return(badges, nil)
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+		svc.SetBadgeService(badgeSvc)
+
+		resp, err := svc.GetUserProfile(context.Background(), uuid.Nil, targetID)
+		require.NoError(t, err)
+		assert.Equal(t, badges, resp.Badges)
+	})
+
+	t.Run("badges unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		badgeSvc := mocks.NewBadgeService(t)
+		badgeSvc.On("ListEarnedBadges", mock.Anything, targetID).Return(nil, errors.New("connection refused"))
+
+		svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+		svc.SetBadgeService(badgeSvc)
+
+		resp, err := svc.GetUserProfile(context.Background(), uuid.Nil, targetID)
+		require.NoError(t, err, "the profile is served without badges")
+		assert.Empty(t, resp.Badges)
+	})
+}
+
+func TestUserServiceGetUserProfileByUsername(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.New()
+	requesterID := uuid.New()
+	now := time.Now()
+
+	activeUser := &dto.User{
+		UserID:    targetID.String(),
+		Username:  "ChefJane",
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	inactiveUser := &dto.User{
+		UserID:    targetID.String(),
+		Username:  "ChefJane",
+		IsActive:  false,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	tests := []struct {
+		name        string
+		requesterID uuid.UUID
+		setupMock   func(*mocks.UserRepository)
+		expectedErr error
+	}{
+		{
+			name:        "Public Profile",
+			requesterID: requesterID,
+			setupMock: func(m *mocks.UserRepository) {
+				m.On("FindUserByUsername", mock.Anything, "chefjane").Return(activeUser, nil)
+				m.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).
+					Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic, Discoverable: true}, nil)
+			},
+		},
+		{
+			name:        "Undiscoverable Profile Looks Missing To Strangers",
+			requesterID: requesterID,
+			setupMock: func(m *mocks.UserRepository) {
+				m.On("FindUserByUsername", mock.Anything, "chefjane").Return(activeUser, nil)
+				m.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).
+					Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}, nil)
+				m.On("IsFollowing", mock.Anything, targetID, requesterID).Return(false, nil)
+			},
+			expectedErr: service.ErrUserNotFound,
+		},
+		{
+			name:        "Undiscoverable Profile Visible To People They Follow",
+			requesterID: requesterID,
+			setupMock: func(m *mocks.UserRepository) {
+				m.On("FindUserByUsername", mock.Anything, "chefjane").Return(activeUser, nil)
+				m.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).
+					Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}, nil)
+				m.On("IsFollowing", mock.Anything, targetID, requesterID).Return(true, nil)
+			},
+		},
+		{
+			name:        "Private Profile Looks Missing",
+			requesterID: requesterID,
+			setupMock: func(m *mocks.UserRepository) {
+				m.On("FindUserByUsername", mock.Anything, "chefjane").Return(activeUser, nil)
+				m.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).
+					Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}, nil)
+			},
+			expectedErr: service.ErrUserNotFound,
+		},
+		{
+			name:        "Private Profile Visible To Owner",
+			requesterID: targetID,
+			setupMock: func(m *mocks.UserRepository) {
+				m.On("FindUserByUsername", mock.Anything, "chefjane").Return(activeUser, nil)
+				m.On("FindPrivacyPreferencesByUserID", mock.Anything, targetID).
+					Return(&dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}, nil)
+			},
+		},
+		{
+			name:        "Deactivated User Looks Missing",
+			requesterID: requesterID,
+			setupMock: func(m *mocks.UserRepository) {
+				m.On("FindUserByUsername", mock.Anything, "chefjane").Return(inactiveUser, nil)
+			},
+			expectedErr: service.ErrUserNotFound,
+		},
+		{
+			name:        "Unknown Username",
+			requesterID: requesterID,
+			setupMock: func(m *mocks.UserRepository) {
+				m.On("FindUserByUsername", mock.Anything, "chefjane").Return(nil, repository.ErrUserNotFound)
+			},
+			expectedErr: service.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := new(mocks.UserRepository)
+			svc := service.NewUserService(mockRepo, new(mocks.TokenStore), nil, nil)
+
+			tt.setupMock(mockRepo)
+
+			profile, err := svc.GetUserProfileByUsername(context.Background(), tt.requesterID, "chefjane")
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, profile)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "ChefJane", profile.Username)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package signedtoken_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/signedtoken"
+)
+
+func TestSigner(t *testing.T) {
+	t.Parallel()
+
+	signer := signedtoken.New(signedtoken.DeriveKey([]byte("secret"), "confirmation-token"))
+	userID := uuid.New()
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+
+	token, err := signer.Sign(userID, "account-deletion", expiresAt)
+	require.NoError(t, err)
+
+	other, err := signer.Sign(userID, "account-deletion", expiresAt)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other, "each token is unique")
+
+	claims, err := signer.Verify(token, "account-deletion", now)
+	require.NoError(t, err)
+	assert.Equal(t, userID, claims.UserID)
+	assert.Equal(t, "account-deletion", claims.Purpose)
+	assert.Equal(t, expiresAt.Truncate(time.Second).Unix(), claims.ExpiresAt.Unix())
+
+	t.Run("expired", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := signer.Verify(token, "account-deletion", expiresAt.Add(time.Second))
+		require.ErrorIs(t, err, signedtoken.ErrExpired)
+	})
+
+	t.Run("other purpose", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := signer.Verify(token, "email-verification", now)
+		require.ErrorIs(t, err, signedtoken.ErrInvalid)
+	})
+
+	t.Run("open", func(t *testing.T) {
+		t.Parallel()
+
+		claims, err := signer.Open(token, now)
+		require.NoError(t, err)
+		assert.Equal(t, "account-deletion", claims.Purpose)
+
+		_, err = signer.Open(token, expiresAt.Add(time.Second))
+		require.ErrorIs(t, err, signedtoken.ErrExpired)
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		t.Parallel()
+
+		otherSigner := signedtoken.New(signedtoken.DeriveKey([]byte("secret"), "profile-share-token"))
+
+		_, err := otherSigner.Verify(token, "account-deletion", now)
+		require.ErrorIs(t, err, signedtoken.ErrInvalid)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		t.Parallel()
+
+		payload, sig, _ := strings.Cut(token, ".")
+		tampered := "B" + payload[1:] + "." + sig
+
+		for _, bad := range []string{tampered, payload, "", uuid.NewString(), token + "." + sig} {
+			_, err := signer.Verify(bad, "account-deletion", now)
+			require.ErrorIs(t, err, signedtoken.ErrInvalid, bad)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"strconv"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// profileLengthTag checks a profile field against the ProfileLimits in force. Its parameter
+// names the field: username, full_name or bio. Broken limits are reported as min or max rules.
+const profileLengthTag = "profile_length"
+
+// Default profile field limits, in characters.
+const (
+	DefaultUsernameMinLength = 3
+	DefaultUsernameMaxLength = 50
+	DefaultFullNameMaxLength = 255
+	DefaultBioMaxLength      = 1000
+)
+
+// UsernamePattern is the username_pattern rule as a regular expression, for clients.
+const UsernamePattern = "^[a-zA-Z0-9_]+$"
+
+// ReleasedUsernamePrefix starts the placeholder username a retired account gets when its
+// identifiers are released. The unreserved rule keeps it from other usernames.
+const ReleasedUsernamePrefix = "released_"
+
+// ProfileLimits are the lengths, in characters, allowed for the free-text profile fields.
+type ProfileLimits struct {
+	UsernameMin int
+	UsernameMax int
+	FullNameMax int
+	BioMax      int
+}
+
+// DefaultProfileLimits returns the limits in force until SetProfileLimits is called.
+func DefaultProfileLimits() ProfileLimits {
+	return ProfileLimits{
+		UsernameMin: DefaultUsernameMinLength,
+		UsernameMax: DefaultUsernameMaxLength,
+		FullNameMax: DefaultFullNameMaxLength,
+		BioMax:      DefaultBioMaxLength,
+	}
+}
+
+var profileLimits atomic.Pointer[ProfileLimits]
+
+// SetProfileLimits replaces the profile field limits every Validator checks against. It is meant
+// to be called once at startup, from configuration.
+func SetProfileLimits(limits ProfileLimits) {
+	profileLimits.Store(&limits)
+}
+
+// CurrentProfileLimits returns the profile field limits in force.
+func CurrentProfileLimits() ProfileLimits {
+	if limits := profileLimits.Load(); limits != nil {
+		return *limits
+	}
+
+	return DefaultProfileLimits()
+}
+
+// bounds returns the shortest and longest lengths allowed for a profile field. Zero means no
+// bound; unknown fields have none.
+func (l ProfileLimits) bounds(field string) (int, int) {
+	switch field {
+	case "username":
+		return l.UsernameMin, l.UsernameMax
+	case "full_name":
+		return 0, l.FullNameMax
+	case "bio":
+		return 0, l.BioMax
+	default:
+		return 0, 0
+	}
+}
+
+// broken returns the rule value breaks as the named profile field, min or max, with the limit as
+// its parameter. The rule is empty if value is within the limits.
+func (l ProfileLimits) broken(field, value string) (string, string) {
+	low, high := l.bounds(field)
+	length := utf8.RuneCountInString(value)
+
+	switch {
+	case low > 0 && length < low:
+		return "min", strconv.Itoa(low)
+	case high > 0 && length > high:
+		return "max", strconv.Itoa(high)
+	default:
+		return "", ""
+	}
+}
+
+// validateProfileLength validates a profile field against the limits in force.
+func validateProfileLength(fl validator.FieldLevel) bool {
+	rule, _ := CurrentProfileLimits().broken(fl.Param(), fl.Field().String())
+
+	return rule == ""
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"unicode"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -13,9 +14,18 @@ import (
 // jsonTagParts is the number of parts to split a JSON tag into (name and options).
 const jsonTagParts = 2
 
+// maxRejectedValueLength is how many characters of a rejected string are echoed back.
+const maxRejectedValueLength = 64
+
+// sensitiveFields are substrings of field names whose rejected values are never echoed back.
+var sensitiveFields = []string{"password", "secret", "token"}
+
 // ErrValidation is the base error for validation failures.
 var ErrValidation = errors.New("validation error")
 
+// codes checks single values against the built-in rules of the validator.
+var codes = validator.New()
+
 // validationMessages maps validation tags to their error message templates.
 // Messages with %s will have the parameter substituted.
 var validationMessages = map[string]string{
@@ -26,7 +36,15 @@ var validationMessages = map[string]string{
 	"alphanum":         "must contain only alphanumeric characters",
 	"alpha":            "must contain only alphabetic characters",
 	"numeric":          "must be numeric",
+	"integer":          "must be a whole number",
+	"boolean":          "must be true or false",
+	"timestamp":        "must be an RFC 3339 timestamp or a YYYY-MM-DD date",
 	"username_pattern": "must contain only alphanumeric characters and underscores",
+	"unreserved":       "must not start with \"" + ReleasedUsernamePrefix + "\"",
+	"handle_pattern":   "must start with a letter and contain only lowercase letters, digits and single hyphens",
+	"iso3166_1_alpha2": "must be an ISO 3166-1 alpha-2 country code",
+	"iso3166_2":        "must be an ISO 3166-2 subdivision code",
+	"moderation":       "must not contain objectionable language",
 }
 
 // parameterizedMessages maps validation tags to their parameterized message formats.
@@ -39,6 +57,15 @@ var parameterizedMessages = map[string]string{
 	"lte":   "must be less than or equal to %s",
 	"gt":    "must be greater than %s",
 	"lt":    "must be less than %s",
+	"enum":  "must be one of: %s",
+	"type":  "must be a %s",
+}
+
+// Enum is implemented by string types restricted to a fixed set of values. Fields of such types
+// are checked with the enum tag.
+type Enum interface {
+	IsValid() bool
+	Values() []string
 }
 
 // Validator wraps the go-playground/validator with custom error formatting.
@@ -46,10 +73,27 @@ type Validator struct {
 	validate *validator.Validate
 }
 
-// ValidationError represents a validation error with field details.
+// ValidationError represents a validation error with field details. Code is the rule that
+// failed, e.g. "required" or "max", and RejectedValue the offending value, shortened and left
+// out for sensitive fields. Param is the rule's parameter, kept to localize the message.
 type ValidationError struct {
-	Field   string `json:"field"`
-	Message string `json:"message"`
+	Field         string `json:"field"`
+	Code          string `json:"code"`
+	Message       string `json:"message"`
+	RejectedValue any    `json:"rejectedValue,omitempty"`
+	Param         string `json:"-"`
+}
+
+// Invalid describes a field, such as a query or path parameter, that broke rule. param fills
+// in parameterized messages like "max" and may be empty otherwise.
+func Invalid(field, rule, param string, value any) ValidationError {
+	return ValidationError{
+		Field:         field,
+		Code:          rule,
+		Message:       ruleMessage(rule, param),
+		RejectedValue: sanitize(field, value),
+		Param:         param,
+	}
 }
 
 // ValidationErrors is a collection of validation errors.
@@ -96,12 +140,46 @@ func New() *Validator {
 	// Register custom username pattern validator (alphanumeric + underscore)
 	_ = v.RegisterValidation("username_pattern", validateUsernamePattern)
 
+	// Register the check that keeps usernames out of the released account namespace
+	_ = v.RegisterValidation("unreserved", validateUsernameUnreserved)
+
+	// Register custom handle pattern validator (lowercase slug)
+	_ = v.RegisterValidation("handle_pattern", validateHandlePattern)
+
+	// Register enum validator for types restricted to a fixed set of values
+	_ = v.RegisterValidation("enum", validateEnum)
+
+	// Register the configurable profile field limits
+	_ = v.RegisterValidation(profileLengthTag, validateProfileLength)
+
 	return &Validator{validate: v}
 }
 
 // validateUsernamePattern validates that a string contains only alphanumeric characters and underscores.
 func validateUsernamePattern(fl validator.FieldLevel) bool {
-	value := fl.Field().String()
+	return IsValidUsername(fl.Field().String())
+}
+
+// validateUsernameUnreserved validates that a username is not one only released accounts get.
+func validateUsernameUnreserved(fl validator.FieldLevel) bool {
+	return !IsReservedUsername(fl.Field().String())
+}
+
+// validateHandlePattern validates a profile handle: lowercase letters, digits and hyphens,
+// starting with a letter, with no trailing or consecutive hyphens.
+func validateHandlePattern(fl validator.FieldLevel) bool {
+	return IsValidHandle(fl.Field().String())
+}
+
+// validateEnum validates that an Enum field holds one of its declared values.
+func validateEnum(fl validator.FieldLevel) bool {
+	enum, ok := fl.Field().Interface().(Enum)
+
+	return ok && enum.IsValid()
+}
+
+// IsValidUsername reports whether value satisfies the username_pattern rules.
+func IsValidUsername(value string) bool {
 	for _, r := range value {
 		if !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_') {
 			return false
@@ -111,6 +189,42 @@ func validateUsernamePattern(fl validator.FieldLevel) bool {
 	return true
 }
 
+// IsReservedUsername reports whether value starts with ReleasedUsernamePrefix, ignoring case as
+// the username uniqueness check does.
+func IsReservedUsername(value string) bool {
+	return len(value) >= len(ReleasedUsernamePrefix) &&
+		strings.EqualFold(value[:len(ReleasedUsernamePrefix)], ReleasedUsernamePrefix)
+}
+
+// IsValidHandle reports whether value satisfies the handle_pattern rules.
+func IsValidHandle(value string) bool {
+	if value == "" || value[0] < 'a' || value[0] > 'z' || strings.HasSuffix(value, "-") {
+		return false
+	}
+
+	if strings.Contains(value, "--") {
+		return false
+	}
+
+	for _, r := range value {
+		if !((r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsCountryCode reports whether value is an ISO 3166-1 alpha-2 country code, e.g. "FR".
+func IsCountryCode(value string) bool {
+	return codes.Var(value, "iso3166_1_alpha2") == nil
+}
+
+// IsRegionCode reports whether value is an ISO 3166-2 subdivision code, e.g. "FR-IDF".
+func IsRegionCode(value string) bool {
+	return codes.Var(value, "iso3166_2") == nil
+}
+
 // Validate validates a struct and returns formatted validation errors.
 func (v *Validator) Validate(s any) error {
 	err := v.validate.Struct(s)
@@ -127,29 +241,108 @@ func (v *Validator) Validate(s any) error {
 
 	errs := make(ValidationErrors, 0, len(validationErrs))
 	for _, e := range validationErrs {
+		rule, param := ruleOf(e)
 		errs = append(errs, ValidationError{
-			Field:   e.Field(),
-			Message: formatMessage(e),
+			Field:         e.Field(),
+			Code:          rule,
+			Message:       ruleMessage(rule, param),
+			RejectedValue: sanitize(e.Field(), e.Value()),
+			Param:         param,
 		})
 	}
 
 	return errs
 }
 
-// formatMessage creates a human-readable error message for a validation error.
-func formatMessage(e validator.FieldError) string {
-	tag := e.Tag()
+// ruleOf returns the rule a field broke and its parameter; for enums, their values. Profile
+// field limits are reported as the min or max rule they broke.
+func ruleOf(e validator.FieldError) (string, string) {
+	switch e.Tag() {
+	case "enum":
+		if enum, ok := e.Value().(Enum); ok {
+			return e.Tag(), strings.Join(enum.Values(), ", ")
+		}
+	case profileLengthTag:
+		value, _ := e.Value().(string)
+		if rule, param := CurrentProfileLimits().broken(e.Param(), value); rule != "" {
+			return rule, param
+		}
+	}
+
+	return e.Tag(), e.Param()
+}
 
+// ruleMessage creates a human-readable message for a broken rule.
+func ruleMessage(rule, param string) string {
 	// Check for static messages first
-	if msg, ok := validationMessages[tag]; ok {
+	if msg, ok := validationMessages[rule]; ok {
 		return msg
 	}
 
 	// Check for parameterized messages
-	if format, ok := parameterizedMessages[tag]; ok {
-		return fmt.Sprintf(format, e.Param())
+	if format, ok := parameterizedMessages[rule]; ok {
+		return fmt.Sprintf(format, param)
 	}
 
 	// Default fallback
-	return fmt.Sprintf("failed %s validation", tag)
+	return fmt.Sprintf("failed %s validation", rule)
+}
+
+// sanitize makes a rejected value safe to echo back: nothing for sensitive fields, empty values
+// and composite ones, long strings shortened, control characters dropped.
+func sanitize(field string, value any) any {
+	name := strings.ToLower(field)
+	for _, sensitive := range sensitiveFields {
+		if strings.Contains(name, sensitive) {
+			return nil
+		}
+	}
+
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return sanitizeString(v.String())
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return v.Interface()
+	default:
+		return nil
+	}
+}
+
+func sanitizeString(value string) any {
+	var b strings.Builder
+
+	n := 0
+
+	for _, r := range value {
+		if unicode.IsControl(r) {
+			continue
+		}
+
+		if n == maxRejectedValueLength {
+			b.WriteString("…")
+
+			break
+		}
+
+		b.WriteRune(r)
+		n++
+	}
+
+	if b.Len() == 0 {
+		return nil
+	}
+
+	return b.String()
 }
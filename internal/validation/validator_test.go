@@ -1,10 +1,13 @@
 package validation
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/i18n"
 )
 
 type testStruct struct {
@@ -96,6 +99,39 @@ func TestValidator_Validate_InvalidOneof(t *testing.T) {
 	assert.Equal(t, "must be one of: admin user guest", validationErrs[0].Message)
 }
 
+type testSize string
+
+func (s testSize) IsValid() bool { return s == "S" || s == "M" }
+
+func (testSize) Values() []string { return []string{"S", "M"} }
+
+type enumStruct struct {
+	Size *testSize `json:"size,omitempty" validate:"omitnil,enum"`
+}
+
+func TestValidator_Validate_Enum(t *testing.T) {
+	t.Parallel()
+
+	size := func(s string) *testSize {
+		v := testSize(s)
+
+		return &v
+	}
+
+	v := New()
+
+	require.NoError(t, v.Validate(enumStruct{}), "nil is allowed")
+	require.NoError(t, v.Validate(enumStruct{Size: size("M")}))
+
+	for _, invalid := range []string{"", "m", "XL"} {
+		err := v.Validate(enumStruct{Size: size(invalid)})
+
+		var validationErrs ValidationErrors
+		require.ErrorAs(t, err, &validationErrs, invalid)
+		assert.Equal(t, map[string]string{"size": "must be one of: S, M"}, validationErrs.ToMap())
+	}
+}
+
 func TestValidator_Validate_MultipleErrors(t *testing.T) {
 	t.Parallel()
 
@@ -109,6 +145,74 @@ func TestValidator_Validate_MultipleErrors(t *testing.T) {
 	assert.GreaterOrEqual(t, len(validationErrs), 4)
 }
 
+func TestValidator_Validate_FieldDetails(t *testing.T) {
+	t.Parallel()
+
+	v := New()
+	s := validTestStruct()
+	s.Email = ""
+	s.Name = strings.Repeat("x", 80)
+	s.Age = 200
+	s.Password = "short"
+
+	var validationErrs ValidationErrors
+	require.ErrorAs(t, v.Validate(s), &validationErrs)
+
+	byField := make(map[string]ValidationError, len(validationErrs))
+	for _, e := range validationErrs {
+		byField[e.Field] = e
+	}
+
+	assert.Equal(t, "required", byField["email"].Code)
+	assert.Nil(t, byField["email"].RejectedValue, "empty values are left out")
+
+	assert.Equal(t, "max", byField["name"].Code)
+	assert.Equal(t, strings.Repeat("x", 64)+"…", byField["name"].RejectedValue, "long values are shortened")
+
+	assert.Equal(t, "lte", byField["age"].Code)
+	assert.Equal(t, 200, byField["age"].RejectedValue)
+
+	assert.Equal(t, "min", byField["password"].Code)
+	assert.Nil(t, byField["password"].RejectedValue, "sensitive values are never echoed")
+}
+
+func TestInvalid(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ValidationError{
+		Field:         "limit",
+		Code:          "lte",
+		Message:       "must be less than or equal to 100",
+		RejectedValue: "500",
+		Param:         "100",
+	}, Invalid("limit", "lte", "100", "500"))
+
+	assert.Equal(t, ValidationError{
+		Field:         "user_id",
+		Code:          "uuid",
+		Message:       "must be a valid UUID",
+		RejectedValue: "not-a-uuid",
+	}, Invalid("user_id", "uuid", "", "not-a-uuid\n"))
+
+	assert.Nil(t, Invalid("token", "required", "", "abc").RejectedValue)
+}
+
+func TestRuleMessagesAreTranslated(t *testing.T) {
+	t.Parallel()
+
+	for _, lang := range i18n.Languages()[1:] {
+		for rule := range validationMessages {
+			_, ok := i18n.Rule(lang, rule, "")
+			assert.True(t, ok, "no %s translation for rule %s", lang, rule)
+		}
+
+		for rule := range parameterizedMessages {
+			_, ok := i18n.Rule(lang, rule, "3")
+			assert.True(t, ok, "no %s translation for rule %s", lang, rule)
+		}
+	}
+}
+
 func TestValidationErrors_Error(t *testing.T) {
 	t.Parallel()
 
@@ -237,3 +341,111 @@ func TestValidator_UsernamePattern(t *testing.T) { //nolint:funlen // table-driv
 		})
 	}
 }
+
+func TestValidator_Unreserved(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Username string `json:"username" validate:"username_pattern,unreserved"`
+	}
+
+	v := New()
+
+	for _, username := range []string{"released_0f3c9a", "RELEASED_", "Released_bob"} {
+		var validationErrs ValidationErrors
+		require.ErrorAs(t, v.Validate(request{Username: username}), &validationErrs, username)
+		require.Len(t, validationErrs, 1)
+		assert.Equal(t, `must not start with "released_"`, validationErrs[0].Message)
+	}
+
+	for _, username := range []string{"released", "unreleased_bob", "release_notes"} {
+		assert.NoError(t, v.Validate(request{Username: username}), username)
+	}
+}
+
+func TestIsValidHandle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		handle string
+		valid  bool
+	}{
+		{"chef-jane", true},
+		{"chef42", true},
+		{"a1-b2-c3", true},
+		{"", false},
+		{"1chef", false},
+		{"-chef", false},
+		{"chef-", false},
+		{"chef--jane", false},
+		{"Chef", false},
+		{"chef_jane", false},
+		{"chef.jane", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.handle, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.valid, IsValidHandle(tt.handle))
+		})
+	}
+}
+
+func TestLocationCodes(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsCountryCode("FR"))
+	assert.False(t, IsCountryCode("fr"), "codes are upper case")
+	assert.False(t, IsCountryCode("FRA"), "alpha-3 codes are not accepted")
+	assert.False(t, IsCountryCode("XX"))
+
+	assert.True(t, IsRegionCode("FR-IDF"))
+	assert.True(t, IsRegionCode("US-CA"))
+	assert.False(t, IsRegionCode("US-ZZ"))
+	assert.False(t, IsRegionCode("CA"))
+
+	type profile struct {
+		Country string `json:"country" validate:"omitempty,iso3166_1_alpha2"`
+		Region  string `json:"region"  validate:"omitempty,iso3166_2"`
+	}
+
+	err := New().Validate(profile{Country: "USA", Region: "US-XX"})
+
+	var validationErrs ValidationErrors
+
+	require.ErrorAs(t, err, &validationErrs)
+	assert.Equal(t, map[string]string{
+		"country": "must be an ISO 3166-1 alpha-2 country code",
+		"region":  "must be an ISO 3166-2 subdivision code",
+	}, validationErrs.ToMap())
+}
+
+//nolint:paralleltest // replaces the process-wide profile limits
+func TestValidator_ProfileLimits(t *testing.T) {
+	type profile struct {
+		Username string  `json:"username" validate:"profile_length=username"`
+		Bio      *string `json:"bio"      validate:"omitnil,profile_length=bio"`
+	}
+
+	v := New()
+	bio := "Crème brûlée"
+
+	require.NoError(t, v.Validate(profile{Username: "alice", Bio: &bio}))
+
+	SetProfileLimits(ProfileLimits{UsernameMin: 6, UsernameMax: 8, FullNameMax: 10, BioMax: 11})
+	t.Cleanup(func() { SetProfileLimits(DefaultProfileLimits()) })
+
+	err := v.Validate(profile{Username: "alice", Bio: &bio})
+
+	var errs ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 2)
+	assert.Equal(t, ValidationError{
+		Field: "username", Code: "min", Message: "must be at least 6 characters", RejectedValue: "alice", Param: "6",
+	}, errs[0])
+	assert.Equal(t, "max", errs[1].Code)
+	assert.Equal(t, "11", errs[1].Param)
+
+	require.NoError(t, v.Validate(profile{Username: "alice_b"}))
+}
@@ -0,0 +1,4 @@
+ALTER TABLE recipe_manager.user_privacy_preferences
+    DROP COLUMN IF EXISTS allow_messages,
+    DROP COLUMN IF EXISTS allow_follows,
+    DROP COLUMN IF EXISTS show_full_name;
@@ -0,0 +1,8 @@
+-- Converge privacy storage on the unified privacy model. The follow, message and full-name
+-- flags of the legacy access-control shape were never stored and always read as true; they
+-- become columns of user_privacy_preferences with that value as the default, so existing users
+-- keep their current behaviour.
+ALTER TABLE recipe_manager.user_privacy_preferences
+    ADD COLUMN IF NOT EXISTS show_full_name BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN IF NOT EXISTS allow_follows BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN IF NOT EXISTS allow_messages BOOLEAN NOT NULL DEFAULT TRUE;
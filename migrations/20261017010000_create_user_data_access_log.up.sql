@@ -0,0 +1,10 @@
+-- Audit log of service accounts reading user data, summarized in the user's privacy report.
+-- One row per user, service and data category keeps the storage bounded.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_data_access_log (
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    client_id TEXT NOT NULL,
+    category TEXT NOT NULL,
+    access_count BIGINT NOT NULL DEFAULT 0,
+    last_accessed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, client_id, category)
+);
@@ -0,0 +1,31 @@
+-- Consent ledger for the marketing email, analytics tracking and data sharing preferences. Rows
+-- are only ever inserted; the latest row of a purpose is the user's current consent.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_consents (
+    consent_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    purpose TEXT NOT NULL,
+    granted BOOLEAN NOT NULL,
+    source TEXT NOT NULL,
+    policy_version TEXT NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_consents_user_purpose
+    ON recipe_manager.user_consents (user_id, purpose, consent_id DESC);
+
+-- Preferences enabled before the ledger existed keep working: record them as imported grants
+-- under the "legacy" policy version.
+INSERT INTO recipe_manager.user_consents (user_id, purpose, granted, source, policy_version, recorded_at)
+SELECT user_id, 'marketing_emails', true, 'import', 'legacy', updated_at
+FROM recipe_manager.user_notification_preferences
+WHERE marketing_emails;
+
+INSERT INTO recipe_manager.user_consents (user_id, purpose, granted, source, policy_version, recorded_at)
+SELECT user_id, 'analytics_tracking', true, 'import', 'legacy', updated_at
+FROM recipe_manager.user_privacy_preferences
+WHERE analytics_tracking;
+
+INSERT INTO recipe_manager.user_consents (user_id, purpose, granted, source, policy_version, recorded_at)
+SELECT user_id, 'data_sharing', true, 'import', 'legacy', updated_at
+FROM recipe_manager.user_privacy_preferences
+WHERE data_sharing;
@@ -0,0 +1,9 @@
+-- Versions of the terms of service and privacy policy each user accepted. The current versions
+-- are declared in the service configuration.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_policy_acceptances (
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    document TEXT NOT NULL,
+    version TEXT NOT NULL,
+    accepted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, document, version)
+);
@@ -0,0 +1,9 @@
+-- Birthdates and admin age overrides, kept apart from profiles so they are never exposed with
+-- them. Minors (under 18, or overridden to 'minor') get a private profile, are left out of search
+-- and cannot consent to marketing emails.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_age_verifications (
+    user_id UUID PRIMARY KEY REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    birthdate DATE,
+    age_override TEXT CHECK (age_override IN ('adult', 'minor')),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
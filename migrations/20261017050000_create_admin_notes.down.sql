@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS recipe_manager.admin_audit_log;
+DROP TABLE IF EXISTS recipe_manager.admin_notes;
@@ -0,0 +1,28 @@
+-- Internal notes left on user accounts by admins and support agents. Only admin endpoints read
+-- them.
+CREATE TABLE IF NOT EXISTS recipe_manager.admin_notes (
+    note_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    author_id UUID NOT NULL,
+    text TEXT NOT NULL,
+    pinned BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_notes_user
+    ON recipe_manager.admin_notes (user_id, pinned DESC, note_id DESC);
+
+-- Audit trail of admin actions on user accounts. Rows are only ever inserted, in the same
+-- transaction as the action they record.
+CREATE TABLE IF NOT EXISTS recipe_manager.admin_audit_log (
+    audit_id BIGSERIAL PRIMARY KEY,
+    actor_id UUID NOT NULL,
+    action TEXT NOT NULL,
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    resource_id TEXT NOT NULL DEFAULT '',
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_user
+    ON recipe_manager.admin_audit_log (user_id, audit_id DESC);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS recipe_manager.identity_change_requests;
+DROP TABLE IF EXISTS recipe_manager.user_moderation;
@@ -0,0 +1,29 @@
+-- Accounts under moderation. Username and email changes on them go through the change-request
+-- queue instead of applying immediately.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_moderation (
+    user_id UUID PRIMARY KEY REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    flagged_by UUID NOT NULL,
+    flagged_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Identity changes waiting for, or given, an admin decision. A user has at most one pending
+-- request per field.
+CREATE TABLE IF NOT EXISTS recipe_manager.identity_change_requests (
+    request_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    field TEXT NOT NULL CHECK (field IN ('username', 'email')),
+    old_value TEXT NOT NULL,
+    new_value TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')),
+    reason TEXT,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    decided_at TIMESTAMPTZ,
+    decided_by UUID
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_identity_change_requests_pending
+    ON recipe_manager.identity_change_requests (user_id, field)
+    WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_identity_change_requests_status
+    ON recipe_manager.identity_change_requests (status, request_id);
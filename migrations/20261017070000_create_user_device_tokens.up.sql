@@ -0,0 +1,17 @@
+-- Devices registered for push notifications. A token belongs to one user at a time; tokens not
+-- registered again within push.stale_after are removed by the service.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_device_tokens (
+    device_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    platform TEXT NOT NULL CHECK (platform IN ('ios', 'android', 'web')),
+    token TEXT NOT NULL UNIQUE,
+    app_version TEXT NOT NULL DEFAULT '',
+    registered_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_device_tokens_user
+    ON recipe_manager.user_device_tokens (user_id, last_seen_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_user_device_tokens_last_seen
+    ON recipe_manager.user_device_tokens (last_seen_at);
@@ -0,0 +1,4 @@
+ALTER TABLE recipe_manager.user_follows
+    DROP COLUMN IF EXISTS notify_reviews,
+    DROP COLUMN IF EXISTS notify_new_recipes,
+    DROP COLUMN IF EXISTS muted;
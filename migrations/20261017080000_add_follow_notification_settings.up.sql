@@ -0,0 +1,6 @@
+-- Per-follow notification settings. A muted follow sends no activity notifications; otherwise
+-- each flag controls one kind of activity notification about the followed user.
+ALTER TABLE recipe_manager.user_follows
+    ADD COLUMN IF NOT EXISTS muted BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS notify_new_recipes BOOLEAN NOT NULL DEFAULT true,
+    ADD COLUMN IF NOT EXISTS notify_reviews BOOLEAN NOT NULL DEFAULT true;
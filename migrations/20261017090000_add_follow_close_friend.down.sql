@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS recipe_manager.user_follows_close_friends_idx;
+
+ALTER TABLE recipe_manager.user_follows
+    DROP COLUMN IF EXISTS is_close_friend;
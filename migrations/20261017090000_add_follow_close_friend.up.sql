@@ -0,0 +1,9 @@
+-- Close friends. The flag sits on the friend's follow of the owner and is set by the owner; owners
+-- with activity_visibility CLOSE_FRIENDS only show their activity to those followers. Unfollowing
+-- drops the flag with the edge.
+ALTER TABLE recipe_manager.user_follows
+    ADD COLUMN IF NOT EXISTS is_close_friend BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS user_follows_close_friends_idx
+    ON recipe_manager.user_follows (followee_id, followed_at DESC)
+    WHERE is_close_friend;
@@ -0,0 +1,16 @@
+-- Follow history. Every follow and unfollow is recorded so unfollows, which delete the edge, can
+-- still be counted; existing follows are backfilled as FOLLOW events at their followed_at.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_follow_events (
+    event_id BIGSERIAL PRIMARY KEY,
+    follower_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    followee_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    event TEXT NOT NULL CHECK (event IN ('FOLLOW', 'UNFOLLOW')),
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_follow_events_followee
+    ON recipe_manager.user_follow_events (followee_id, occurred_at DESC);
+
+INSERT INTO recipe_manager.user_follow_events (follower_id, followee_id, event, occurred_at)
+SELECT follower_id, followee_id, 'FOLLOW', followed_at
+FROM recipe_manager.user_follows;
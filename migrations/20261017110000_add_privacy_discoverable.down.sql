@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS recipe_manager.user_privacy_preferences_undiscoverable_idx;
+
+ALTER TABLE recipe_manager.user_privacy_preferences
+    DROP COLUMN IF EXISTS discoverable;
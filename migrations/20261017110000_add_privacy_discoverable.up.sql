@@ -0,0 +1,8 @@
+-- Discoverability. Users who opt out are left out of search and can only be looked up by exact
+-- username by the people they follow.
+ALTER TABLE recipe_manager.user_privacy_preferences
+    ADD COLUMN IF NOT EXISTS discoverable BOOLEAN NOT NULL DEFAULT true;
+
+CREATE INDEX IF NOT EXISTS user_privacy_preferences_undiscoverable_idx
+    ON recipe_manager.user_privacy_preferences (user_id)
+    WHERE NOT discoverable;
@@ -0,0 +1,5 @@
+-- Prefix index for username typeahead. text_pattern_ops lets LIKE 'prefix%' use the index
+-- regardless of the database collation.
+CREATE INDEX IF NOT EXISTS users_username_prefix_idx
+    ON recipe_manager.users (LOWER(username) text_pattern_ops)
+    WHERE is_active;
@@ -0,0 +1,3 @@
+-- Presence. Users who opt out are shown as hidden instead of online or last seen.
+ALTER TABLE recipe_manager.user_privacy_preferences
+    ADD COLUMN IF NOT EXISTS show_last_seen BOOLEAN NOT NULL DEFAULT true;
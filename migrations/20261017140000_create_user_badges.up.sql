@@ -0,0 +1,11 @@
+-- Badges users earned. Which badges exist, and what earns them, is configuration (badges.yaml);
+-- a badge removed from it stays here but is no longer shown.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_badges (
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    badge_id TEXT NOT NULL,
+    earned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, badge_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_badges_badge
+    ON recipe_manager.user_badges (badge_id);
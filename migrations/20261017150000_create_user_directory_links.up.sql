@@ -0,0 +1,16 @@
+-- Accounts mapped to an LDAP directory entry, and how their last attribute sync went. The synced_*
+-- columns hold the directory values seen by the last sync, so local edits made since can be told
+-- from directory changes.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_directory_links (
+    user_id UUID PRIMARY KEY REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    external_id TEXT NOT NULL UNIQUE,
+    status TEXT NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'SYNCED', 'CONFLICT', 'NOT_FOUND', 'FAILED')),
+    synced_full_name TEXT,
+    synced_email TEXT,
+    synced_is_active BOOLEAN,
+    conflicts TEXT[] NOT NULL DEFAULT '{}',
+    last_error TEXT,
+    last_synced_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS recipe_manager.users_privacy_defaults_version_idx;
+
+ALTER TABLE recipe_manager.users
+    DROP COLUMN IF EXISTS privacy_defaults_version;
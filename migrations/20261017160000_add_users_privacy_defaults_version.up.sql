@@ -0,0 +1,12 @@
+-- Privacy defaults versions. Existing users keep the original defaults; users created afterwards
+-- are left NULL and provisioned under the configured version the first time their privacy
+-- preferences are read or written.
+ALTER TABLE recipe_manager.users
+    ADD COLUMN IF NOT EXISTS privacy_defaults_version SMALLINT;
+
+UPDATE recipe_manager.users
+SET privacy_defaults_version = 1
+WHERE privacy_defaults_version IS NULL;
+
+CREATE INDEX IF NOT EXISTS users_privacy_defaults_version_idx
+    ON recipe_manager.users (privacy_defaults_version, user_id);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS recipe_manager.users_country_region_idx;
+
+ALTER TABLE recipe_manager.user_privacy_preferences
+    DROP COLUMN IF EXISTS show_location;
+
+ALTER TABLE recipe_manager.users
+    DROP COLUMN IF EXISTS region,
+    DROP COLUMN IF EXISTS country;
@@ -0,0 +1,13 @@
+-- Profile location. Country is an ISO 3166-1 alpha-2 code and region an ISO 3166-2 subdivision
+-- code of that country. Locations are only shown, searchable and counted in follower insights
+-- for users who turn on show_location, which is off by default.
+ALTER TABLE recipe_manager.users
+    ADD COLUMN IF NOT EXISTS country CHAR(2),
+    ADD COLUMN IF NOT EXISTS region VARCHAR(6);
+
+ALTER TABLE recipe_manager.user_privacy_preferences
+    ADD COLUMN IF NOT EXISTS show_location BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS users_country_region_idx
+    ON recipe_manager.users (country, region)
+    WHERE country IS NOT NULL;
@@ -0,0 +1,16 @@
+-- Profile text that content moderation flagged for admin review. The text was saved as written;
+-- terms lists the flagged words. A flag is pending until an admin resolves it.
+CREATE TABLE IF NOT EXISTS recipe_manager.content_flags (
+    flag_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    field TEXT NOT NULL CHECK (field IN ('fullName', 'bio')),
+    content TEXT NOT NULL,
+    terms TEXT[] NOT NULL DEFAULT '{}',
+    flagged_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ,
+    resolved_by UUID
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_flags_pending
+    ON recipe_manager.content_flags (flag_id)
+    WHERE resolved_at IS NULL;
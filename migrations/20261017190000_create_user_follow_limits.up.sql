@@ -0,0 +1,7 @@
+-- Admin overrides of the maximum number of users an account may follow. Accounts without a row
+-- get the configured default; a limit of 0 lets the account follow any number of users.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_follow_limits (
+    user_id UUID PRIMARY KEY REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    max_following INTEGER NOT NULL CHECK (max_following >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
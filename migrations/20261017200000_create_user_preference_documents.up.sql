@@ -0,0 +1,12 @@
+-- Preference categories stored as JSONB documents, one row per user and category, for deployments
+-- that set preferences.storage to jsonb. Privacy stays in user_privacy_preferences in both layouts.
+-- Documents hold only the settings; keys missing from a document read as the category's defaults.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_preference_documents (
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    category TEXT NOT NULL CHECK (category IN (
+        'notification', 'display', 'accessibility', 'language', 'security', 'social', 'sound', 'theme'
+    )),
+    doc JSONB NOT NULL CHECK (jsonb_typeof(doc) = 'object'),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, category)
+);
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS recipe_manager.user_follow_events (
+    event_id BIGSERIAL PRIMARY KEY,
+    follower_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    followee_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    event TEXT NOT NULL CHECK (event IN ('FOLLOW', 'UNFOLLOW')),
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_follow_events_followee
+    ON recipe_manager.user_follow_events (followee_id, occurred_at DESC);
+
+INSERT INTO recipe_manager.user_follow_events (follower_id, followee_id, event, occurred_at)
+SELECT follower_id, followee_id, event, occurred_at
+FROM (
+    SELECT follower_id, followee_id, 'FOLLOW' AS event, followed_at AS occurred_at, 0 AS position
+    FROM recipe_manager.user_follows
+    UNION ALL
+    SELECT follower_id, followee_id, 'UNFOLLOW', deleted_at, 1
+    FROM recipe_manager.user_follows
+    WHERE deleted_at IS NOT NULL
+) events
+ORDER BY occurred_at, position;
+
+DELETE FROM recipe_manager.user_follows WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS recipe_manager.user_follows_deleted_idx;
+DROP INDEX IF EXISTS recipe_manager.user_follows_current_idx;
+
+ALTER TABLE recipe_manager.user_follows DROP CONSTRAINT IF EXISTS user_follows_pkey;
+ALTER TABLE recipe_manager.user_follows ADD PRIMARY KEY (follower_id, followee_id);
+
+ALTER TABLE recipe_manager.user_follows
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS follow_id;
@@ -0,0 +1,31 @@
+-- Soft-deleted follows. Unfollowing sets deleted_at rather than deleting the edge, and following
+-- again adds a new edge, so only one edge per pair may be current. The retained edges are the
+-- follow history: a follow at followed_at and, once deleted, an unfollow at deleted_at. Past
+-- unfollows are carried over from user_follow_events as deleted edges before the events go.
+ALTER TABLE recipe_manager.user_follows
+    ADD COLUMN IF NOT EXISTS follow_id BIGSERIAL,
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+ALTER TABLE recipe_manager.user_follows DROP CONSTRAINT IF EXISTS user_follows_pkey;
+ALTER TABLE recipe_manager.user_follows ADD PRIMARY KEY (follow_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_follows_current_idx
+    ON recipe_manager.user_follows (follower_id, followee_id)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS user_follows_deleted_idx
+    ON recipe_manager.user_follows (deleted_at)
+    WHERE deleted_at IS NOT NULL;
+
+INSERT INTO recipe_manager.user_follows (follower_id, followee_id, followed_at, deleted_at)
+SELECT follower_id, followee_id, followed_at, occurred_at
+FROM (
+    SELECT follower_id, followee_id, event, occurred_at,
+        LAG(event) OVER pair AS previous_event,
+        LAG(occurred_at) OVER pair AS followed_at
+    FROM recipe_manager.user_follow_events
+    WINDOW pair AS (PARTITION BY follower_id, followee_id ORDER BY occurred_at, event_id)
+) events
+WHERE event = 'UNFOLLOW' AND previous_event = 'FOLLOW';
+
+DROP TABLE IF EXISTS recipe_manager.user_follow_events;
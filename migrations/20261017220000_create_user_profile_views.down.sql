@@ -0,0 +1,4 @@
+ALTER TABLE recipe_manager.user_privacy_preferences
+    DROP COLUMN IF EXISTS count_profile_views;
+
+DROP TABLE IF EXISTS recipe_manager.user_profile_views;
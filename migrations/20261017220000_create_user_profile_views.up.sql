@@ -0,0 +1,10 @@
+-- Profile view counts. Views are counted in Redis and added here periodically; only the total is
+-- kept, never who viewed. Users who turn off count_profile_views are not counted.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_profile_views (
+    user_id UUID PRIMARY KEY REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    view_count BIGINT NOT NULL DEFAULT 0 CHECK (view_count >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE recipe_manager.user_privacy_preferences
+    ADD COLUMN IF NOT EXISTS count_profile_views BOOLEAN NOT NULL DEFAULT true;
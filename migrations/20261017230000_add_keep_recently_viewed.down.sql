@@ -0,0 +1,2 @@
+ALTER TABLE recipe_manager.user_privacy_preferences
+    DROP COLUMN IF EXISTS keep_recently_viewed;
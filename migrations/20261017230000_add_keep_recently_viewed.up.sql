@@ -0,0 +1,4 @@
+-- Users who turn off keep_recently_viewed do not have the profiles they view remembered. The list
+-- itself is kept in Redis only.
+ALTER TABLE recipe_manager.user_privacy_preferences
+    ADD COLUMN IF NOT EXISTS keep_recently_viewed BOOLEAN NOT NULL DEFAULT true;
@@ -0,0 +1,5 @@
+-- The role stored on each account decides what it may do beyond its own data. Admins assign
+-- roles; everyone starts as a plain user.
+ALTER TABLE recipe_manager.users
+    ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'user'
+        CONSTRAINT users_role_check CHECK (role IN ('user', 'moderator', 'admin', 'service'));
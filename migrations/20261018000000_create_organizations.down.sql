@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS recipe_manager.organization_members;
+DROP TABLE IF EXISTS recipe_manager.organizations;
@@ -0,0 +1,20 @@
+-- Organizations are shared "brand" accounts. Each one is backed by a regular user account, which
+-- holds its profile and social graph, and is managed by its members.
+CREATE TABLE IF NOT EXISTS recipe_manager.organizations (
+    org_id UUID PRIMARY KEY REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Owners manage the membership; editors may act as the organization.
+CREATE TABLE IF NOT EXISTS recipe_manager.organization_members (
+    org_id UUID NOT NULL REFERENCES recipe_manager.organizations (org_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('owner', 'editor')),
+    added_by UUID NOT NULL,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (org_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user
+    ON recipe_manager.organization_members (user_id);
@@ -0,0 +1,13 @@
+-- Invitation codes users hand out to bring others to the app. A redeemed invitation records who
+-- invited whom; each user redeems at most one.
+CREATE TABLE IF NOT EXISTS recipe_manager.invitations (
+    code TEXT PRIMARY KEY,
+    inviter_id UUID NOT NULL REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    redeemed_by UUID UNIQUE REFERENCES recipe_manager.users (user_id) ON DELETE SET NULL,
+    redeemed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_invitations_inviter
+    ON recipe_manager.invitations (inviter_id, created_at DESC);
@@ -0,0 +1,2 @@
+ALTER TABLE recipe_manager.users
+    DROP COLUMN IF EXISTS preference_center_generation;
@@ -0,0 +1,4 @@
+-- Preference center links in emails are signed for the generation current when they were sent.
+-- Bumping it revokes every link sent before.
+ALTER TABLE recipe_manager.users
+    ADD COLUMN IF NOT EXISTS preference_center_generation INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,17 @@
+DROP TRIGGER IF EXISTS user_preference_documents_change_log ON recipe_manager.user_preference_documents;
+DROP TRIGGER IF EXISTS user_theme_preferences_change_log ON recipe_manager.user_theme_preferences;
+DROP TRIGGER IF EXISTS user_sound_preferences_change_log ON recipe_manager.user_sound_preferences;
+DROP TRIGGER IF EXISTS user_social_preferences_change_log ON recipe_manager.user_social_preferences;
+DROP TRIGGER IF EXISTS user_security_preferences_change_log ON recipe_manager.user_security_preferences;
+DROP TRIGGER IF EXISTS user_language_preferences_change_log ON recipe_manager.user_language_preferences;
+DROP TRIGGER IF EXISTS user_accessibility_preferences_change_log ON recipe_manager.user_accessibility_preferences;
+DROP TRIGGER IF EXISTS user_privacy_preferences_change_log ON recipe_manager.user_privacy_preferences;
+DROP TRIGGER IF EXISTS user_display_preferences_change_log ON recipe_manager.user_display_preferences;
+DROP TRIGGER IF EXISTS user_notification_preferences_change_log ON recipe_manager.user_notification_preferences;
+DROP TRIGGER IF EXISTS users_update_change_log ON recipe_manager.users;
+DROP TRIGGER IF EXISTS users_insert_change_log ON recipe_manager.users;
+
+DROP FUNCTION IF EXISTS recipe_manager.log_preference_change();
+DROP FUNCTION IF EXISTS recipe_manager.log_user_change();
+
+DROP TABLE IF EXISTS recipe_manager.user_change_log;
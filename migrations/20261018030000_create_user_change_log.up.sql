@@ -0,0 +1,102 @@
+-- Change log behind the internal user change feed. Triggers on users and the preference tables
+-- append to it, so every write is recorded whichever code path made it; sequence is the feed
+-- cursor. Entries are kept after their user is deleted so consumers can catch up.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_change_log (
+    sequence BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    change_type TEXT NOT NULL CHECK (change_type IN ('USER_UPSERT', 'USER_DEACTIVATED', 'PREFERENCE_CHANGED')),
+    category TEXT,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_change_log_user
+    ON recipe_manager.user_change_log (user_id, change_type, category, sequence DESC);
+
+CREATE INDEX IF NOT EXISTS idx_user_change_log_changed_at
+    ON recipe_manager.user_change_log (changed_at);
+
+-- A user row written records USER_UPSERT, or USER_DEACTIVATED when it turns inactive.
+CREATE OR REPLACE FUNCTION recipe_manager.log_user_change() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO recipe_manager.user_change_log (user_id, change_type)
+    VALUES (
+        NEW.user_id,
+        CASE WHEN TG_OP = 'UPDATE' AND OLD.is_active AND NOT NEW.is_active
+            THEN 'USER_DEACTIVATED' ELSE 'USER_UPSERT' END
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- A preference row written records PREFERENCE_CHANGED with its category: the trigger argument
+-- for the per-category tables, the category column for the JSONB documents.
+CREATE OR REPLACE FUNCTION recipe_manager.log_preference_change() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO recipe_manager.user_change_log (user_id, change_type, category)
+    VALUES (NEW.user_id, 'PREFERENCE_CHANGED', COALESCE(TG_ARGV[0], to_jsonb(NEW) ->> 'category'));
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS users_insert_change_log ON recipe_manager.users;
+CREATE TRIGGER users_insert_change_log
+    AFTER INSERT ON recipe_manager.users
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_user_change();
+
+DROP TRIGGER IF EXISTS users_update_change_log ON recipe_manager.users;
+CREATE TRIGGER users_update_change_log
+    AFTER UPDATE ON recipe_manager.users
+    FOR EACH ROW WHEN (OLD.* IS DISTINCT FROM NEW.*)
+    EXECUTE FUNCTION recipe_manager.log_user_change();
+
+DROP TRIGGER IF EXISTS user_notification_preferences_change_log ON recipe_manager.user_notification_preferences;
+CREATE TRIGGER user_notification_preferences_change_log
+    AFTER INSERT OR UPDATE ON recipe_manager.user_notification_preferences
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_preference_change('notification');
+
+DROP TRIGGER IF EXISTS user_display_preferences_change_log ON recipe_manager.user_display_preferences;
+CREATE TRIGGER user_display_preferences_change_log
+    AFTER INSERT OR UPDATE ON recipe_manager.user_display_preferences
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_preference_change('display');
+
+DROP TRIGGER IF EXISTS user_privacy_preferences_change_log ON recipe_manager.user_privacy_preferences;
+CREATE TRIGGER user_privacy_preferences_change_log
+    AFTER INSERT OR UPDATE ON recipe_manager.user_privacy_preferences
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_preference_change('privacy');
+
+DROP TRIGGER IF EXISTS user_accessibility_preferences_change_log ON recipe_manager.user_accessibility_preferences;
+CREATE TRIGGER user_accessibility_preferences_change_log
+    AFTER INSERT OR UPDATE ON recipe_manager.user_accessibility_preferences
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_preference_change('accessibility');
+
+DROP TRIGGER IF EXISTS user_language_preferences_change_log ON recipe_manager.user_language_preferences;
+CREATE TRIGGER user_language_preferences_change_log
+    AFTER INSERT OR UPDATE ON recipe_manager.user_language_preferences
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_preference_change('language');
+
+DROP TRIGGER IF EXISTS user_security_preferences_change_log ON recipe_manager.user_security_preferences;
+CREATE TRIGGER user_security_preferences_change_log
+    AFTER INSERT OR UPDATE ON recipe_manager.user_security_preferences
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_preference_change('security');
+
+DROP TRIGGER IF EXISTS user_social_preferences_change_log ON recipe_manager.user_social_preferences;
+CREATE TRIGGER user_social_preferences_change_log
+    AFTER INSERT OR UPDATE ON recipe_manager.user_social_preferences
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_preference_change('social');
+
+DROP TRIGGER IF EXISTS user_sound_preferences_change_log ON recipe_manager.user_sound_preferences;
+CREATE TRIGGER user_sound_preferences_change_log
+    AFTER INSERT OR UPDATE ON recipe_manager.user_sound_preferences
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_preference_change('sound');
+
+DROP TRIGGER IF EXISTS user_theme_preferences_change_log ON recipe_manager.user_theme_preferences;
+CREATE TRIGGER user_theme_preferences_change_log
+    AFTER INSERT OR UPDATE ON recipe_manager.user_theme_preferences
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_preference_change('theme');
+
+DROP TRIGGER IF EXISTS user_preference_documents_change_log ON recipe_manager.user_preference_documents;
+CREATE TRIGGER user_preference_documents_change_log
+    AFTER INSERT OR UPDATE ON recipe_manager.user_preference_documents
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.log_preference_change();
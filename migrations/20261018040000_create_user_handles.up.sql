@@ -0,0 +1,7 @@
+-- Public profile handles, one per user. Claiming a new handle replaces the user's current one;
+-- the unique constraint makes a handle taken by another user fail the claim.
+CREATE TABLE IF NOT EXISTS recipe_manager.user_handles (
+    user_id UUID PRIMARY KEY REFERENCES recipe_manager.users (user_id) ON DELETE CASCADE,
+    handle TEXT NOT NULL CONSTRAINT user_handles_handle_key UNIQUE,
+    claimed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS recipe_manager.users_username_lower_idx;
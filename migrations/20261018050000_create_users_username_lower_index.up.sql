@@ -0,0 +1,4 @@
+-- Case-insensitive username lookups compare LOWER(username) = LOWER($1) across all users, which
+-- the typeahead prefix index can't serve: it only covers active users.
+CREATE INDEX IF NOT EXISTS users_username_lower_idx
+    ON recipe_manager.users (LOWER(username));
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS recipe_manager.users_deactivated_at_idx;
+
+DROP TRIGGER IF EXISTS users_set_deactivated_at ON recipe_manager.users;
+DROP FUNCTION IF EXISTS recipe_manager.set_user_deactivated_at();
+
+ALTER TABLE recipe_manager.users
+    DROP COLUMN IF EXISTS deactivated_at;
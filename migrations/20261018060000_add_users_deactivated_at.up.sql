@@ -0,0 +1,36 @@
+-- When each inactive user was deactivated. The identifier retention window and account recovery
+-- count from it, so later writes to a deactivated row don't restart the window. A trigger keeps
+-- it current whichever code path changes is_active. Users deactivated earlier are counted from
+-- their last update, the best record there is.
+ALTER TABLE recipe_manager.users
+    ADD COLUMN IF NOT EXISTS deactivated_at TIMESTAMPTZ;
+
+UPDATE recipe_manager.users
+SET deactivated_at = updated_at
+WHERE NOT is_active AND deactivated_at IS NULL;
+
+-- Active users have no deactivation time; a user turning inactive, or inserted inactive without
+-- one, is stamped now. Writes to a row that stays inactive keep the time it has.
+CREATE OR REPLACE FUNCTION recipe_manager.set_user_deactivated_at() RETURNS trigger AS $$
+BEGIN
+    IF NEW.is_active THEN
+        NEW.deactivated_at := NULL;
+    ELSIF TG_OP = 'INSERT' THEN
+        NEW.deactivated_at := COALESCE(NEW.deactivated_at, NOW());
+    ELSIF OLD.is_active THEN
+        NEW.deactivated_at := NOW();
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS users_set_deactivated_at ON recipe_manager.users;
+CREATE TRIGGER users_set_deactivated_at
+    BEFORE INSERT OR UPDATE ON recipe_manager.users
+    FOR EACH ROW EXECUTE FUNCTION recipe_manager.set_user_deactivated_at();
+
+-- The identifier-release job looks up inactive users by deactivation time.
+CREATE INDEX IF NOT EXISTS users_deactivated_at_idx
+    ON recipe_manager.users (deactivated_at)
+    WHERE NOT is_active;
@@ -0,0 +1,9 @@
+// Package migrations embeds the schema migrations so the binary can compare them with a database.
+package migrations
+
+import "embed"
+
+// FS holds the up migrations, named <version>_<description>.up.sql.
+//
+//go:embed *.up.sql
+var FS embed.FS
@@ -0,0 +1,58 @@
+package migrations_test
+
+import (
+	"io/fs"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/migrations"
+)
+
+// changeLogTables are the tables whose writes the user change feed reports.
+var changeLogTables = []string{
+	"recipe_manager.users",
+	"recipe_manager.user_notification_preferences",
+	"recipe_manager.user_display_preferences",
+	"recipe_manager.user_privacy_preferences",
+	"recipe_manager.user_accessibility_preferences",
+	"recipe_manager.user_language_preferences",
+	"recipe_manager.user_security_preferences",
+	"recipe_manager.user_social_preferences",
+	"recipe_manager.user_sound_preferences",
+	"recipe_manager.user_theme_preferences",
+	"recipe_manager.user_preference_documents",
+}
+
+var changeLogTriggerPattern = regexp.MustCompile(
+	`(?is)CREATE\s+TRIGGER\s+\w+\s+AFTER\s+[A-Z ]+?\s+ON\s+(\w+\.\w+)\s+FOR\s+EACH\s+ROW[^;]*?` +
+		`EXECUTE\s+FUNCTION\s+recipe_manager\.log_(?:user|preference)_change\(`)
+
+func TestChangeLogTriggers(t *testing.T) {
+	t.Parallel()
+
+	names, err := fs.Glob(migrations.FS, "*.up.sql")
+	require.NoError(t, err)
+
+	var sql strings.Builder
+
+	for _, name := range names {
+		content, err := fs.ReadFile(migrations.FS, name)
+		require.NoError(t, err)
+		sql.Write(content)
+	}
+
+	assert.Contains(t, sql.String(), "CREATE TABLE IF NOT EXISTS recipe_manager.user_change_log")
+
+	var triggered []string
+	for _, match := range changeLogTriggerPattern.FindAllStringSubmatch(sql.String(), -1) {
+		triggered = append(triggered, match[1])
+	}
+
+	slices.Sort(triggered)
+	assert.ElementsMatch(t, changeLogTables, slices.Compact(triggered), "every write the change feed reports is logged by a trigger")
+}
@@ -0,0 +1,390 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// AdminStatsParams selects the range of GetAdminStats. Zero values use the server defaults.
+type AdminStatsParams struct {
+	From     time.Time
+	To       time.Time
+	Interval StatsInterval
+}
+
+// FollowerInsightsParams selects the range of GetFollowerInsights, with the same defaults as
+// GetAdminStats.
+type FollowerInsightsParams = AdminStatsParams
+
+func (p AdminStatsParams) query() url.Values {
+	query := url.Values{}
+	if !p.From.IsZero() {
+		query.Set("from", p.From.Format(time.RFC3339))
+	}
+
+	if !p.To.IsZero() {
+		query.Set("to", p.To.Format(time.RFC3339))
+	}
+
+	if p.Interval != "" {
+		query.Set("interval", string(p.Interval))
+	}
+
+	return query
+}
+
+// GetAdminStats calls GET /admin/stats.
+func (c *Client) GetAdminStats(ctx context.Context, params AdminStatsParams) (*AdminStatsResponse, error) {
+	return call[AdminStatsResponse](ctx, c, http.MethodGet, apiPrefix+"/admin/stats", params.query(), nil)
+}
+
+// GetUserStats calls GET /admin/users/stats.
+func (c *Client) GetUserStats(ctx context.Context) (*UserStatsResponse, error) {
+	return call[UserStatsResponse](ctx, c, http.MethodGet, apiPrefix+"/admin/users/stats", nil, nil)
+}
+
+// ClearCache calls POST /admin/cache/clear. An empty keyPattern clears every cache key.
+func (c *Client) ClearCache(ctx context.Context, keyPattern string) (*CacheClearResponse, error) {
+	body := dto.CacheClearRequest{KeyPattern: keyPattern}
+
+	return call[CacheClearResponse](ctx, c, http.MethodPost, apiPrefix+"/admin/cache/clear", nil, body)
+}
+
+// GetMaintenance calls GET /admin/maintenance.
+func (c *Client) GetMaintenance(ctx context.Context) (*MaintenanceStatus, error) {
+	return call[MaintenanceStatus](ctx, c, http.MethodGet, apiPrefix+"/admin/maintenance", nil, nil)
+}
+
+// SetMaintenance calls PUT /admin/maintenance. While maintenance mode is on, writes fail with a
+// 503 RETRY_LATER APIError.
+func (c *Client) SetMaintenance(ctx context.Context, req MaintenanceRequest) (*MaintenanceStatus, error) {
+	return call[MaintenanceStatus](ctx, c, http.MethodPut, apiPrefix+"/admin/maintenance", nil, req)
+}
+
+// GetDrainStatus calls GET /admin/drain.
+func (c *Client) GetDrainStatus(ctx context.Context) (*DrainStatus, error) {
+	return call[DrainStatus](ctx, c, http.MethodGet, apiPrefix+"/admin/drain", nil, nil)
+}
+
+// StartDrain calls POST /admin/drain. The instance that serves the call fails readiness and shuts
+// down once its drain period has passed.
+func (c *Client) StartDrain(ctx context.Context) (*DrainStatus, error) {
+	return call[DrainStatus](ctx, c, http.MethodPost, apiPrefix+"/admin/drain", nil, nil)
+}
+
+// GetSearchIndexStatus calls GET /admin/search/index.
+func (c *Client) GetSearchIndexStatus(ctx context.Context) (*SearchIndexStatusResponse, error) {
+	return call[SearchIndexStatusResponse](ctx, c, http.MethodGet, apiPrefix+"/admin/search/index", nil, nil)
+}
+
+// StartReindex calls POST /admin/search/reindex. The rebuild runs in the background; poll
+// GetSearchIndexStatus for its progress.
+func (c *Client) StartReindex(ctx context.Context) (*SearchReindexJob, error) {
+	return call[SearchReindexJob](ctx, c, http.MethodPost, apiPrefix+"/admin/search/reindex", nil, nil)
+}
+
+// ListJobs calls GET /admin/jobs.
+func (c *Client) ListJobs(ctx context.Context) (*ScheduledJobsResponse, error) {
+	return call[ScheduledJobsResponse](ctx, c, http.MethodGet, apiPrefix+"/admin/jobs", nil, nil)
+}
+
+// RunJob calls POST /admin/jobs/{name}/run. The job runs in the background; poll ListJobs for
+// how the run went.
+func (c *Client) RunJob(ctx context.Context, name string) (*ScheduledJob, error) {
+	return call[ScheduledJob](ctx, c, http.MethodPost, pathf(apiPrefix, "/admin/jobs/%s/run", name), nil, nil)
+}
+
+// GetPrivacyDefaults calls GET /admin/preferences/privacy-defaults.
+func (c *Client) GetPrivacyDefaults(ctx context.Context) (*PrivacyDefaultsStatusResponse, error) {
+	return call[PrivacyDefaultsStatusResponse](ctx, c, http.MethodGet, apiPrefix+"/admin/preferences/privacy-defaults",
+		nil, nil)
+}
+
+// MigratePrivacyDefaults calls POST /admin/preferences/privacy-defaults/migrate. Each call moves
+// one batch; repeat until the response reports nothing remaining.
+func (c *Client) MigratePrivacyDefaults(
+	ctx context.Context,
+	req *PrivacyDefaultsMigrationRequest,
+) (*PrivacyDefaultsMigrationResponse, error) {
+	return call[PrivacyDefaultsMigrationResponse](ctx, c, http.MethodPost,
+		apiPrefix+"/admin/preferences/privacy-defaults/migrate", nil, req)
+}
+
+// GetUserAge calls GET /admin/users/{user_id}/age.
+func (c *Client) GetUserAge(ctx context.Context, userID uuid.UUID) (*AgeStatusResponse, error) {
+	return call[AgeStatusResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/admin/users/%s/age", userID), nil, nil)
+}
+
+// SetAgeOverride calls PUT /admin/users/{user_id}/age/override.
+func (c *Client) SetAgeOverride(
+	ctx context.Context,
+	userID uuid.UUID,
+	override AgeOverride,
+) (*AgeStatusResponse, error) {
+	path := pathf(apiPrefix, "/admin/users/%s/age/override", userID)
+
+	return call[AgeStatusResponse](ctx, c, http.MethodPut, path, nil, dto.AgeOverrideRequest{Override: override})
+}
+
+// ClearAgeOverride calls DELETE /admin/users/{user_id}/age/override.
+func (c *Client) ClearAgeOverride(ctx context.Context, userID uuid.UUID) (*AgeStatusResponse, error) {
+	path := pathf(apiPrefix, "/admin/users/%s/age/override", userID)
+
+	return call[AgeStatusResponse](ctx, c, http.MethodDelete, path, nil, nil)
+}
+
+// ListUserNotes calls GET /admin/users/{user_id}/notes.
+func (c *Client) ListUserNotes(ctx context.Context, userID uuid.UUID) (*AdminNotesResponse, error) {
+	return call[AdminNotesResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/admin/users/%s/notes", userID), nil, nil)
+}
+
+// CreateUserNote calls POST /admin/users/{user_id}/notes.
+func (c *Client) CreateUserNote(ctx context.Context, userID uuid.UUID, req *AdminNoteRequest) (*AdminNote, error) {
+	return call[AdminNote](ctx, c, http.MethodPost, pathf(apiPrefix, "/admin/users/%s/notes", userID), nil, req)
+}
+
+// UpdateUserNote calls PUT /admin/users/{user_id}/notes/{note_id}.
+func (c *Client) UpdateUserNote(
+	ctx context.Context,
+	userID uuid.UUID,
+	noteID int64,
+	req *AdminNoteUpdateRequest,
+) (*AdminNote, error) {
+	path := pathf(apiPrefix, "/admin/users/%s/notes/%s", userID, noteID)
+
+	return call[AdminNote](ctx, c, http.MethodPut, path, nil, req)
+}
+
+// DeleteUserNote calls DELETE /admin/users/{user_id}/notes/{note_id}.
+func (c *Client) DeleteUserNote(ctx context.Context, userID uuid.UUID, noteID int64) error {
+	return c.do(ctx, http.MethodDelete, pathf(apiPrefix, "/admin/users/%s/notes/%s", userID, noteID), nil, nil, nil)
+}
+
+// GetUserAuditTrail calls GET /admin/users/{user_id}/audit.
+func (c *Client) GetUserAuditTrail(ctx context.Context, userID uuid.UUID) (*AuditTrailResponse, error) {
+	return call[AuditTrailResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/admin/users/%s/audit", userID), nil, nil)
+}
+
+// GetUserRole calls GET /admin/users/{user_id}/role.
+func (c *Client) GetUserRole(ctx context.Context, userID uuid.UUID) (*UserRoleResponse, error) {
+	return call[UserRoleResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/admin/users/%s/role", userID), nil, nil)
+}
+
+// SetUserRole calls PUT /admin/users/{user_id}/role.
+func (c *Client) SetUserRole(ctx context.Context, userID uuid.UUID, role UserRole) (*UserRoleResponse, error) {
+	path := pathf(apiPrefix, "/admin/users/%s/role", userID)
+
+	return call[UserRoleResponse](ctx, c, http.MethodPut, path, nil, dto.UserRoleRequest{Role: role})
+}
+
+// SetModeration calls PUT /admin/users/{user_id}/moderation.
+func (c *Client) SetModeration(ctx context.Context, userID uuid.UUID) (*ModerationStatusResponse, error) {
+	path := pathf(apiPrefix, "/admin/users/%s/moderation", userID)
+
+	return call[ModerationStatusResponse](ctx, c, http.MethodPut, path, nil, nil)
+}
+
+// ClearModeration calls DELETE /admin/users/{user_id}/moderation.
+func (c *Client) ClearModeration(ctx context.Context, userID uuid.UUID) (*ModerationStatusResponse, error) {
+	path := pathf(apiPrefix, "/admin/users/%s/moderation", userID)
+
+	return call[ModerationStatusResponse](ctx, c, http.MethodDelete, path, nil, nil)
+}
+
+// GetUserQuota calls GET /admin/users/{user_id}/quota.
+func (c *Client) GetUserQuota(ctx context.Context, userID uuid.UUID) (*QuotaResponse, error) {
+	return call[QuotaResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/admin/users/%s/quota", userID), nil, nil)
+}
+
+// SetFollowLimit calls PUT /admin/users/{user_id}/quota/following. A zero limit lets the user
+// follow any number of accounts.
+func (c *Client) SetFollowLimit(ctx context.Context, userID uuid.UUID, limit int) (*QuotaResponse, error) {
+	path := pathf(apiPrefix, "/admin/users/%s/quota/following", userID)
+
+	return call[QuotaResponse](ctx, c, http.MethodPut, path, nil, dto.FollowLimitRequest{MaxFollowing: &limit})
+}
+
+// ClearFollowLimit calls DELETE /admin/users/{user_id}/quota/following, restoring the configured
+// limit.
+func (c *Client) ClearFollowLimit(ctx context.Context, userID uuid.UUID) (*QuotaResponse, error) {
+	path := pathf(apiPrefix, "/admin/users/%s/quota/following", userID)
+
+	return call[QuotaResponse](ctx, c, http.MethodDelete, path, nil, nil)
+}
+
+// ListChangeRequests calls GET /admin/change-requests. An empty status lists pending requests.
+func (c *Client) ListChangeRequests(ctx context.Context, status ChangeRequestStatus) (*ChangeRequestsResponse, error) {
+	query := url.Values{}
+	if status != "" {
+		query.Set("status", string(status))
+	}
+
+	return call[ChangeRequestsResponse](ctx, c, http.MethodGet, apiPrefix+"/admin/change-requests", query, nil)
+}
+
+// ApproveChangeRequest calls POST /admin/change-requests/{request_id}/approve.
+func (c *Client) ApproveChangeRequest(ctx context.Context, requestID int64) (*ChangeRequest, error) {
+	path := pathf(apiPrefix, "/admin/change-requests/%s/approve", requestID)
+
+	return call[ChangeRequest](ctx, c, http.MethodPost, path, nil, nil)
+}
+
+// RejectChangeRequest calls POST /admin/change-requests/{request_id}/reject.
+func (c *Client) RejectChangeRequest(ctx context.Context, requestID int64, reason string) (*ChangeRequest, error) {
+	path := pathf(apiPrefix, "/admin/change-requests/%s/reject", requestID)
+
+	return call[ChangeRequest](ctx, c, http.MethodPost, path, nil, dto.ChangeRequestRejection{Reason: reason})
+}
+
+// ListContentFlags calls GET /admin/content-flags.
+func (c *Client) ListContentFlags(ctx context.Context) (*ContentFlagsResponse, error) {
+	return call[ContentFlagsResponse](ctx, c, http.MethodGet, apiPrefix+"/admin/content-flags", nil, nil)
+}
+
+// ResolveContentFlag calls POST /admin/content-flags/{flag_id}/resolve.
+func (c *Client) ResolveContentFlag(ctx context.Context, flagID int64) (*ContentFlag, error) {
+	path := pathf(apiPrefix, "/admin/content-flags/%s/resolve", flagID)
+
+	return call[ContentFlag](ctx, c, http.MethodPost, path, nil, nil)
+}
+
+// GetDirectoryLink calls GET /admin/users/{user_id}/directory.
+func (c *Client) GetDirectoryLink(ctx context.Context, userID uuid.UUID) (*DirectoryLink, error) {
+	return call[DirectoryLink](ctx, c, http.MethodGet, pathf(apiPrefix, "/admin/users/%s/directory", userID), nil, nil)
+}
+
+// LinkDirectoryEntry calls PUT /admin/users/{user_id}/directory.
+func (c *Client) LinkDirectoryEntry(ctx context.Context, userID uuid.UUID, externalID string) (*DirectoryLink, error) {
+	path := pathf(apiPrefix, "/admin/users/%s/directory", userID)
+
+	return call[DirectoryLink](ctx, c, http.MethodPut, path, nil, DirectoryLinkRequest{ExternalID: externalID})
+}
+
+// UnlinkDirectoryEntry calls DELETE /admin/users/{user_id}/directory.
+func (c *Client) UnlinkDirectoryEntry(ctx context.Context, userID uuid.UUID) error {
+	return c.do(ctx, http.MethodDelete, pathf(apiPrefix, "/admin/users/%s/directory", userID), nil, nil, nil)
+}
+
+// SyncDirectoryEntry calls POST /admin/users/{user_id}/directory/sync.
+func (c *Client) SyncDirectoryEntry(ctx context.Context, userID uuid.UUID) (*DirectoryLink, error) {
+	path := pathf(apiPrefix, "/admin/users/%s/directory/sync", userID)
+
+	return call[DirectoryLink](ctx, c, http.MethodPost, path, nil, nil)
+}
+
+// GetPerformanceMetrics calls GET /metrics/performance.
+func (c *Client) GetPerformanceMetrics(ctx context.Context) (*PerformanceMetricsResponse, error) {
+	return call[PerformanceMetricsResponse](ctx, c, http.MethodGet, apiPrefix+"/metrics/performance", nil, nil)
+}
+
+// GetCacheMetrics calls GET /metrics/cache.
+func (c *Client) GetCacheMetrics(ctx context.Context) (*CacheMetricsResponse, error) {
+	return call[CacheMetricsResponse](ctx, c, http.MethodGet, apiPrefix+"/metrics/cache", nil, nil)
+}
+
+// GetSystemMetrics calls GET /metrics/system.
+func (c *Client) GetSystemMetrics(ctx context.Context) (*SystemMetricsResponse, error) {
+	return call[SystemMetricsResponse](ctx, c, http.MethodGet, apiPrefix+"/metrics/system", nil, nil)
+}
+
+// GetDetailedHealthMetrics calls GET /metrics/health/detailed.
+func (c *Client) GetDetailedHealthMetrics(ctx context.Context) (*DetailedHealthMetricsResponse, error) {
+	return call[DetailedHealthMetricsResponse](ctx, c, http.MethodGet, apiPrefix+"/metrics/health/detailed", nil, nil)
+}
+
+// GetUserChanges calls the internal change feed GET /internal/v1/users/changes. It requires a
+// service token with the user:read scope (or an admin token).
+func (c *Client) GetUserChanges(ctx context.Context, since int64, limit int) (*UserChangesResponse, error) {
+	query := url.Values{}
+	if since > 0 {
+		query.Set("since", strconv.FormatInt(since, 10))
+	}
+
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	return call[UserChangesResponse](ctx, c, http.MethodGet, internalPrefix+"/users/changes", query, nil)
+}
+
+// ResolveMentions calls the internal POST /internal/v1/users/resolve-mentions. It returns the users
+// the @usernames link to; usernames of users that do not exist or cannot be found are returned as
+// unresolved. It requires a service token with the user:read scope (or an admin token).
+func (c *Client) ResolveMentions(ctx context.Context, usernames []string) (*MentionResolveResponse, error) {
+	body := &MentionResolveRequest{Usernames: usernames}
+
+	return call[MentionResolveResponse](ctx, c, http.MethodPost, internalPrefix+"/users/resolve-mentions", nil, body)
+}
+
+// GetPushTargets calls the internal GET /internal/v1/users/{user_id}/push-tokens. It returns no
+// devices if the user turned push notifications off, and requires a service token with the
+// user:read scope (or an admin token).
+func (c *Client) GetPushTargets(ctx context.Context, userID uuid.UUID) (*PushTargetsResponse, error) {
+	path := pathf(internalPrefix, "/users/%s/push-tokens", userID)
+
+	return call[PushTargetsResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
+
+// Heartbeat calls the internal POST /internal/v1/users/{user_id}/heartbeat, recording that the
+// user is online now. It requires a service token with the user:write scope (or an admin token).
+func (c *Client) Heartbeat(ctx context.Context, userID uuid.UUID) error {
+	path := pathf(internalPrefix, "/users/%s/heartbeat", userID)
+
+	return c.do(ctx, http.MethodPost, path, nil, nil, nil)
+}
+
+// IssuePreferenceCenterToken calls the internal POST
+// /internal/v1/users/{user_id}/preference-center-token, signing a token for the preference center
+// link of an email. It requires a service token with the user:write scope (or an admin token).
+func (c *Client) IssuePreferenceCenterToken(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*PreferenceCenterTokenResponse, error) {
+	path := pathf(internalPrefix, "/users/%s/preference-center-token", userID)
+
+	return call[PreferenceCenterTokenResponse](ctx, c, http.MethodPost, path, nil, nil)
+}
+
+// GetListUnsubscribe calls the internal GET /internal/v1/users/{user_id}/list-unsubscribe. It
+// returns the List-Unsubscribe and List-Unsubscribe-Post header values for an email of category,
+// and requires a service token with the user:read scope (or an admin token).
+func (c *Client) GetListUnsubscribe(
+	ctx context.Context,
+	userID uuid.UUID,
+	category EmailCategory,
+) (*ListUnsubscribeResponse, error) {
+	path := pathf(internalPrefix, "/users/%s/list-unsubscribe", userID)
+	query := url.Values{"category": {string(category)}}
+
+	return call[ListUnsubscribeResponse](ctx, c, http.MethodGet, path, query, nil)
+}
+
+// RecordBadgeEvent calls the internal POST /internal/v1/users/{user_id}/events, reporting something
+// the user did that may earn them a badge. It requires a service token with the user:write scope
+// (or an admin token).
+func (c *Client) RecordBadgeEvent(ctx context.Context, userID uuid.UUID, event BadgeEvent) error {
+	path := pathf(internalPrefix, "/users/%s/events", userID)
+
+	return c.do(ctx, http.MethodPost, path, nil, &BadgeEventRequest{Type: event}, nil)
+}
+
+// GetNotificationRecipients calls the internal GET /internal/v1/users/{user_id}/notification-recipients.
+// It lists the followers to notify of event, leaving out those who muted the user or turned that
+// notification off, and requires a service token with the user:read scope (or an admin token).
+func (c *Client) GetNotificationRecipients(
+	ctx context.Context,
+	userID uuid.UUID,
+	event FollowNotificationEvent,
+) (*NotificationRecipientsResponse, error) {
+	path := pathf(internalPrefix, "/users/%s/notification-recipients", userID)
+	query := url.Values{"event": {string(event)}}
+
+	return call[NotificationRecipientsResponse](ctx, c, http.MethodGet, path, query, nil)
+}
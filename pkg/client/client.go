@@ -0,0 +1,362 @@
+// Package client is a Go client for the user management service API.
+//
+// Request and response types are aliases of the service's own DTOs, and the contract tests in
+// this package check every method against the server's registered routes, so the client cannot
+// drift from the handlers.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/requestsign"
+)
+
+const (
+	apiPrefix      = "/api/v1/user-management"
+	internalPrefix = "/internal/v1"
+
+	defaultTimeout      = 30 * time.Second
+	defaultMaxRetries   = 2
+	defaultRetryBackoff = 200 * time.Millisecond
+	maxRetryBackoff     = 5 * time.Second
+	userIDHeader        = "X-User-Id"
+	actAsHeader         = "X-Act-As"
+)
+
+// ErrRequestFailed is returned when a request cannot be sent or its response cannot be read.
+var ErrRequestFailed = errors.New("user management request failed")
+
+// TokenProvider supplies the bearer token sent with each request.
+type TokenProvider interface {
+	GetToken(ctx context.Context) (string, error)
+}
+
+// StaticToken is a TokenProvider that always returns the same token.
+type StaticToken string
+
+// GetToken returns the token.
+func (t StaticToken) GetToken(_ context.Context) (string, error) {
+	return string(t), nil
+}
+
+// Config configures a Client.
+type Config struct {
+	// BaseURL is the service root, e.g. http://user-management:8080.
+	BaseURL string
+
+	// BasePath overrides the prefix of the public API routes for services deployed with a custom
+	// server.base_path. Defaults to /api/v1/user-management.
+	BasePath string
+
+	// TokenProvider supplies bearer tokens. Leave nil when the service runs with OAuth2 disabled
+	// and set UserID instead.
+	TokenProvider TokenProvider
+
+	// UserID is sent as X-User-Id for services running with OAuth2 disabled (local development).
+	UserID string
+
+	// ActAs is sent as X-Act-As so that requests act as the organization with this ID. The
+	// authenticated user must be a member of it.
+	ActAs string
+
+	// HTTPClient overrides the default client with a 30 second timeout.
+	HTTPClient *http.Client
+
+	// MaxRetries is how many times idempotent requests are retried after a network error,
+	// 429 or 502-504 response. Zero uses the default of 2; a negative value disables retries.
+	MaxRetries int
+
+	// RetryBackoff is the initial delay between retries, doubled on each attempt. A Retry-After
+	// header from the server takes precedence. Defaults to 200ms.
+	RetryBackoff time.Duration
+
+	// SigningSecret signs requests to the internal routes for services running with
+	// requestsigning.enabled. Each attempt is signed afresh.
+	SigningSecret []byte
+}
+
+// Client calls the user management service API.
+type Client struct {
+	httpClient    *http.Client
+	baseURL       string
+	basePath      string
+	tokenProvider TokenProvider
+	userID        string
+	actAs         string
+	maxRetries    int
+	retryBackoff  time.Duration
+	signingSecret []byte
+}
+
+// New creates a new Client.
+func New(cfg Config) *Client {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultMaxRetries
+	} else if maxRetries < 0 {
+		maxRetries = 0
+	}
+
+	retryBackoff := cfg.RetryBackoff
+	if retryBackoff <= 0 {
+		retryBackoff = defaultRetryBackoff
+	}
+
+	return &Client{
+		httpClient:    httpClient,
+		baseURL:       strings.TrimSuffix(cfg.BaseURL, "/"),
+		basePath:      strings.TrimSuffix(cfg.BasePath, "/"),
+		tokenProvider: cfg.TokenProvider,
+		userID:        cfg.UserID,
+		actAs:         cfg.ActAs,
+		maxRetries:    maxRetries,
+		retryBackoff:  retryBackoff,
+		signingSecret: cfg.SigningSecret,
+	}
+}
+
+// APIError is returned for non-2xx responses and carries the service's error body.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Details    map[string]string
+	// Errors lists the fields of a VALIDATION_ERROR.
+	Errors []FieldError
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("user management API error: status %d", e.StatusCode)
+	}
+
+	return fmt.Sprintf("user management API error: status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// StatusCode returns the HTTP status of an APIError in err's chain, or 0 if there is none.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+
+	return 0
+}
+
+// do sends a request to path (including its prefix) and decodes a JSON response into out.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	var payload []byte
+
+	if body != nil {
+		var err error
+
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+	}
+
+	if c.basePath != "" && strings.HasPrefix(path, apiPrefix) {
+		path = c.basePath + strings.TrimPrefix(path, apiPrefix)
+	}
+
+	target := c.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	retries := 0
+	if isIdempotent(method) {
+		retries = c.maxRetries
+	}
+
+	sign := c.signingSecret != nil && strings.HasPrefix(path, internalPrefix)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, method, target, payload, sign)
+
+		var retryAfter time.Duration
+
+		switch {
+		case err != nil:
+			if ctx.Err() != nil || attempt >= retries {
+				return err
+			}
+		case isRetryableStatus(resp.StatusCode) && attempt < retries:
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+
+			drainAndClose(resp)
+		default:
+			return decodeResponse(resp, out)
+		}
+
+		wait := c.backoff(attempt, retryAfter)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ErrRequestFailed, ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, method, target string, payload []byte, sign bool) (*http.Response, error) {
+	var bodyReader io.Reader
+	if payload != nil {
+		bodyReader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.tokenProvider != nil {
+		token, tokenErr := c.tokenProvider.GetToken(ctx)
+		if tokenErr != nil {
+			return nil, fmt.Errorf("failed to get auth token: %w", tokenErr)
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	if c.userID != "" {
+		req.Header.Set(userIDHeader, c.userID)
+	}
+
+	if c.actAs != "" {
+		req.Header.Set(actAsHeader, c.actAs)
+	}
+
+	if sign {
+		err = requestsign.Sign(req, c.signingSecret, payload, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrRequestFailed, err)
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRequestFailed, err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return min(retryAfter, maxRetryBackoff)
+	}
+
+	return min(c.retryBackoff<<attempt, maxRetryBackoff)
+}
+
+func decodeResponse(resp *http.Response, out any) error {
+	defer drainAndClose(resp)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+
+		var body struct {
+			Code    string            `json:"error"`
+			Message string            `json:"message"`
+			Details map[string]string `json:"details"`
+			Errors  []FieldError      `json:"errors"`
+		}
+
+		if json.NewDecoder(resp.Body).Decode(&body) == nil {
+			apiErr.Code = body.Code
+			apiErr.Message = body.Message
+			apiErr.Details = body.Details
+			apiErr.Errors = body.Errors
+		}
+
+		return apiErr
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	err := json.NewDecoder(resp.Body).Decode(out)
+	if err != nil {
+		return fmt.Errorf("%w: failed to decode response: %w", ErrRequestFailed, err)
+	}
+
+	return nil
+}
+
+func drainAndClose(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// pathf builds an API path, escaping each argument as a path segment.
+func pathf(prefix, format string, args ...any) string {
+	escaped := make([]any, len(args))
+	for i, arg := range args {
+		escaped[i] = url.PathEscape(fmt.Sprint(arg))
+	}
+
+	return prefix + fmt.Sprintf(format, escaped...)
+}
+
+// call sends a request and returns the decoded response, or nil and the error.
+func call[T any](ctx context.Context, c *Client, method, path string, query url.Values, body any) (*T, error) {
+	var out T
+
+	err := c.do(ctx, method, path, query, body, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, cfg Config) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg.BaseURL = server.URL
+	cfg.HTTPClient = server.Client()
+	cfg.RetryBackoff = time.Millisecond
+
+	return New(cfg)
+}
+
+func TestClient_AuthInjection(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, userID.String(), r.Header.Get("X-User-Id"))
+		assert.Equal(t, "/api/v1/user-management/users/"+userID.String()+"/", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"userId":"` + userID.String() + `","username":"alice"}`))
+	}, Config{TokenProvider: StaticToken("secret"), UserID: userID.String()})
+
+	user, err := c.GetUserByID(t.Context(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user.Username)
+}
+
+func TestClient_BasePath(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/users/search", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"results":[],"totalCount":0}`))
+	}, Config{BasePath: "/accounts/"})
+
+	_, err := c.SearchUsers(t.Context(), "al", PageParams{})
+	require.NoError(t, err)
+}
+
+func TestClient_APIError(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"USER_NOT_FOUND","message":"User not found"}`))
+	}, Config{})
+
+	user, err := c.GetUserProfile(t.Context(), uuid.New())
+	assert.Nil(t, user)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "USER_NOT_FOUND", apiErr.Code)
+	assert.Equal(t, http.StatusNotFound, StatusCode(err))
+}
+
+func TestClient_Retries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retries idempotent requests on retryable statuses", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+
+		c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"status":"UP"}`))
+		}, Config{})
+
+		health, err := c.Health(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "UP", health.Status)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+
+		c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusTooManyRequests)
+		}, Config{MaxRetries: 1})
+
+		_, err := c.Health(t.Context())
+		assert.Equal(t, http.StatusTooManyRequests, StatusCode(err))
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("does not retry non-idempotent requests", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+
+		c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}, Config{})
+
+		_, err := c.FollowUser(t.Context(), uuid.New(), uuid.New())
+		assert.Equal(t, http.StatusServiceUnavailable, StatusCode(err))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+func TestClient_Backoff(t *testing.T) {
+	t.Parallel()
+
+	c := New(Config{RetryBackoff: 100 * time.Millisecond})
+
+	assert.Equal(t, 100*time.Millisecond, c.backoff(0, 0))
+	assert.Equal(t, 400*time.Millisecond, c.backoff(2, 0))
+	assert.Equal(t, maxRetryBackoff, c.backoff(10, 0))
+	assert.Equal(t, 2*time.Second, c.backoff(0, 2*time.Second))
+	assert.Equal(t, 2*time.Second, c.backoff(0, parseRetryAfter("2")))
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// GetConsents calls GET /users/{user_id}/consents.
+func (c *Client) GetConsents(ctx context.Context, userID uuid.UUID) (*ConsentsResponse, error) {
+	return call[ConsentsResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/users/%s/consents/", userID), nil, nil)
+}
+
+// RecordConsent calls POST /users/{user_id}/consents.
+func (c *Client) RecordConsent(ctx context.Context, userID uuid.UUID, req *ConsentRequest) (*ConsentRecord, error) {
+	return call[ConsentRecord](ctx, c, http.MethodPost, pathf(apiPrefix, "/users/%s/consents/", userID), nil, req)
+}
+
+// GetConsentHistory calls GET /users/{user_id}/consents/history.
+func (c *Client) GetConsentHistory(ctx context.Context, userID uuid.UUID) (*ConsentHistoryResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/consents/history", userID)
+
+	return call[ConsentHistoryResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
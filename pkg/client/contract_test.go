@@ -0,0 +1,348 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/pkg/client"
+)
+
+// Paths served by the service that are intentionally not part of the client.
+var unsupportedPaths = map[string]bool{
+	"/metrics": true, // Prometheus scrape endpoint
+	// One-click unsubscribes (RFC 8058), posted as forms by mailbox providers
+	"/api/v1/user-management/unsubscribe/{token}": true,
+}
+
+// routeRecorder is an http.RoundTripper that matches each request against the server's router
+// and records the route pattern, answering with an empty JSON object instead of calling handlers.
+type routeRecorder struct {
+	router chi.Routes
+
+	mu      sync.Mutex
+	matched map[string]bool
+	misses  []string
+}
+
+func (rr *routeRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	rctx := chi.NewRouteContext()
+	key := req.Method + " " + req.URL.Path
+
+	rr.mu.Lock()
+	if rr.router.Match(rctx, req.Method, req.URL.Path) {
+		rr.matched[req.Method+" "+rctx.RoutePattern()] = true
+	} else {
+		rr.misses = append(rr.misses, key)
+	}
+	rr.mu.Unlock()
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+// TestContract_EveryRouteHasAClientMethod calls every client method and checks that each one hits
+// a registered route, and that every registered route is reachable through the client.
+func TestContract_EveryRouteHasAClientMethod(t *testing.T) {
+	t.Parallel()
+
+	router, ok := servertest.New(t).Handler.(chi.Routes)
+	require.True(t, ok, "server handler must be a chi router")
+
+	recorder := &routeRecorder{router: router, matched: make(map[string]bool)}
+	c := client.New(client.Config{
+		BaseURL:    "http://user-management.test",
+		HTTPClient: &http.Client{Transport: recorder},
+		MaxRetries: -1,
+	})
+
+	ctx := context.Background()
+	userID, targetID := uuid.New(), uuid.New()
+
+	calls := []func() error{
+		func() error { _, err := c.Health(ctx); return err },
+		func() error { _, err := c.Ready(ctx); return err },
+		func() error { _, err := c.Version(ctx); return err },
+		func() error { _, err := c.SearchUsers(ctx, "al", client.PageParams{Limit: 5}); return err },
+		func() error { _, err := c.Typeahead(ctx, "al"); return err },
+		func() error { _, err := c.GetUserByID(ctx, userID); return err },
+		func() error { _, err := c.GetUserDetails(ctx, userID, client.UserExpansionStats); return err },
+		func() error { _, err := c.GetUserProfile(ctx, userID); return err },
+		func() error { _, err := c.GetUserProfileByUsername(ctx, "alice"); return err },
+		func() error { _, err := c.UpdateProfile(ctx, &client.UserProfileUpdateRequest{}); return err },
+		func() error { _, err := c.GetProfileConstraints(ctx); return err },
+		func() error { _, err := c.GetShareToken(ctx); return err },
+		func() error { return c.RevokeShareToken(ctx) },
+		func() error { _, err := c.GetSharedProfile(ctx, "abc.def"); return err },
+		func() error { _, err := c.GetProfileEmbed(ctx, userID); return err },
+		func() error { _, err := c.GetProfileViews(ctx, userID); return err },
+		func() error { _, err := c.RequestAccountDeletion(ctx); return err },
+		func() error { _, err := c.ConfirmAccountDeletion(ctx, "token"); return err },
+		func() error { _, err := c.RequestEmailChange(ctx, "new@example.com"); return err },
+		func() error { _, err := c.ConfirmOldEmail(ctx, "token"); return err },
+		func() error { _, err := c.ConfirmNewEmail(ctx, "token"); return err },
+		func() error { return c.RequestAccountRecovery(ctx, "user@example.com") },
+		func() error { _, err := c.ConfirmAccountRecovery(ctx, "token"); return err },
+		func() error { _, err := c.GetPrivacyReport(ctx); return err },
+		func() error { _, err := c.GetBirthdate(ctx); return err },
+		func() error { _, err := c.SetBirthdate(ctx, "2001-04-09"); return err },
+		func() error { _, err := c.GetPolicyStatus(ctx); return err },
+		func() error { _, err := c.AcceptPolicy(ctx, client.PolicyDocumentTerms, "2026-10"); return err },
+		func() error { _, err := c.ReserveHandle(ctx, "chef"); return err },
+		func() error { _, err := c.ClaimHandle(ctx, "chef"); return err },
+		func() error { _, err := c.ResolveHandle(ctx, "chef"); return err },
+		func() error { _, err := c.GetFollowing(ctx, userID, client.PageParams{}); return err },
+		func() error { _, err := c.GetFollowers(ctx, userID, client.PageParams{CountOnly: true}); return err },
+		func() error { _, err := c.CheckFollowing(ctx, userID, targetID); return err },
+		func() error { _, err := c.FollowUser(ctx, userID, targetID); return err },
+		func() error { _, err := c.UnfollowUser(ctx, userID, targetID); return err },
+		func() error {
+			_, err := c.UpdateFollowSettings(ctx, userID, targetID, client.FollowSettingsUpdateRequest{})
+			return err
+		},
+		func() error { _, err := c.GetCloseFriends(ctx, userID, client.PageParams{}); return err },
+		func() error { _, err := c.GetFollowHistory(ctx, userID, client.PageParams{Limit: 10}); return err },
+		func() error { _, err := c.GetQuota(ctx, userID); return err },
+		func() error { _, err := c.GetDigestPreview(ctx, 5); return err },
+		func() error {
+			_, err := c.GetFollowerInsights(ctx, userID, client.FollowerInsightsParams{Interval: client.StatsIntervalDay})
+			return err
+		},
+		func() error { return c.AddCloseFriend(ctx, userID, targetID) },
+		func() error { return c.RemoveCloseFriend(ctx, userID, targetID) },
+		func() error { _, err := c.GetUserActivity(ctx, userID, 3); return err },
+		func() error { _, err := c.GetPresence(ctx, userID); return err },
+		func() error { _, err := c.GetBadges(ctx, userID); return err },
+		func() error { _, err := c.GetFollowersWithPresence(ctx, userID, client.PageParams{}); return err },
+		func() error { _, err := c.GetPreferences(ctx, userID, client.PreferenceCategoryDisplay); return err },
+		func() error {
+			_, err := c.UpdatePreferences(ctx, userID, &client.UserPreferencesUpdateRequest{})
+			return err
+		},
+		func() error {
+			_, err := c.GetCategoryPreferences(ctx, userID, client.PreferenceCategoryTheme)
+			return err
+		},
+		func() error {
+			_, err := c.UpdateCategoryPreferences(ctx, userID, client.PreferenceCategoryDisplay,
+				dto.DisplayPreferencesUpdate{})
+			return err
+		},
+		func() error { _, err := c.ResetPreferences(ctx, userID, client.PreferenceCategorySound); return err },
+		func() error {
+			_, err := c.ResetCategoryPreferences(ctx, userID, client.PreferenceCategoryTheme)
+			return err
+		},
+		func() error { _, err := c.GetConsents(ctx, userID); return err },
+		func() error {
+			_, err := c.RecordConsent(ctx, userID, &client.ConsentRequest{Purpose: client.ConsentPurposeDataSharing})
+			return err
+		},
+		func() error { _, err := c.GetConsentHistory(ctx, userID); return err },
+		func() error { _, err := c.GetMyProfile(ctx); return err },
+		func() error { _, err := c.GetMyProfileViews(ctx); return err },
+		func() error { _, err := c.GetRecentlyViewed(ctx); return err },
+		func() error { return c.ClearRecentlyViewed(ctx) },
+		func() error { _, err := c.GetMyFollowing(ctx, client.PageParams{}); return err },
+		func() error { _, err := c.GetMyFollowers(ctx, client.PageParams{}); return err },
+		func() error { _, err := c.GetMyFollowerInsights(ctx, client.FollowerInsightsParams{}); return err },
+		func() error { _, err := c.GetMyFollowHistory(ctx, client.PageParams{}); return err },
+		func() error { _, err := c.GetMyQuota(ctx); return err },
+		func() error { _, err := c.GetMyActivity(ctx, 0); return err },
+		func() error { _, err := c.GetMyPresence(ctx); return err },
+		func() error { _, err := c.GetMyBadges(ctx); return err },
+		func() error { _, err := c.GetMyPreferences(ctx); return err },
+		func() error {
+			_, err := c.UpdateMyPreferences(ctx, &client.UserPreferencesUpdateRequest{})
+			return err
+		},
+		func() error { _, err := c.GetMyCategoryPreferences(ctx, client.PreferenceCategoryTheme); return err },
+		func() error {
+			_, err := c.UpdateMyCategoryPreferences(ctx, client.PreferenceCategoryTheme, dto.ThemePreferencesUpdate{})
+			return err
+		},
+		func() error { _, err := c.ResetMyPreferences(ctx); return err },
+		func() error { _, err := c.ExportPreferences(ctx, client.PreferenceCategoryTheme); return err },
+		func() error { _, err := c.ImportPreferences(ctx, &client.PreferenceImportRequest{}); return err },
+		func() error { _, err := c.GetPreferenceCenter(ctx, "abc.def"); return err },
+		func() error {
+			_, err := c.UpdatePreferenceCenter(ctx, "abc.def", &client.PreferenceCenterUpdateRequest{})
+			return err
+		},
+		func() error { return c.RevokePreferenceCenterLinks(ctx) },
+		func() error { _, err := c.ResetMyCategoryPreferences(ctx, client.PreferenceCategoryTheme); return err },
+		func() error { _, err := c.GetMyConsents(ctx); return err },
+		func() error {
+			_, err := c.RecordMyConsent(ctx, &client.ConsentRequest{Purpose: client.ConsentPurposeMarketingEmails})
+			return err
+		},
+		func() error { _, err := c.GetMyConsentHistory(ctx); return err },
+		func() error {
+			_, err := c.SubmitChangeRequest(ctx, client.ChangeRequestFieldUsername, "alice2")
+			return err
+		},
+		func() error { _, err := c.ListMyChangeRequests(ctx); return err },
+		func() error {
+			_, err := c.RegisterDevice(ctx, &client.DeviceTokenRequest{Platform: client.DevicePlatformIOS, Token: "t"})
+			return err
+		},
+		func() error { _, err := c.ListDevices(ctx); return err },
+		func() error { return c.UnregisterDevice(ctx, 1) },
+		func() error {
+			_, err := c.GetAdminStats(ctx, client.AdminStatsParams{Interval: client.StatsIntervalWeek})
+			return err
+		},
+		func() error { _, err := c.GetUserStats(ctx); return err },
+		func() error { _, err := c.ClearCache(ctx, "user:*"); return err },
+		func() error { _, err := c.GetMaintenance(ctx); return err },
+		func() error { _, err := c.SetMaintenance(ctx, client.MaintenanceRequest{}); return err },
+		func() error { _, err := c.GetDrainStatus(ctx); return err },
+		func() error { _, err := c.StartDrain(ctx); return err },
+		func() error { _, err := c.GetSearchIndexStatus(ctx); return err },
+		func() error { _, err := c.StartReindex(ctx); return err },
+		func() error { _, err := c.ListJobs(ctx); return err },
+		func() error { _, err := c.RunJob(ctx, "device-cleanup"); return err },
+		func() error { _, err := c.GetPrivacyDefaults(ctx); return err },
+		func() error {
+			_, err := c.MigratePrivacyDefaults(ctx, &client.PrivacyDefaultsMigrationRequest{FromVersion: 1, ToVersion: 2})
+			return err
+		},
+		func() error { _, err := c.GetUserAge(ctx, userID); return err },
+		func() error { _, err := c.SetAgeOverride(ctx, userID, client.AgeOverrideAdult); return err },
+		func() error { _, err := c.ClearAgeOverride(ctx, userID); return err },
+		func() error { _, err := c.ListUserNotes(ctx, userID); return err },
+		func() error {
+			_, err := c.CreateUserNote(ctx, userID, &client.AdminNoteRequest{Text: "Refund issued"})
+			return err
+		},
+		func() error { _, err := c.UpdateUserNote(ctx, userID, 1, &client.AdminNoteUpdateRequest{}); return err },
+		func() error { return c.DeleteUserNote(ctx, userID, 1) },
+		func() error { _, err := c.GetUserAuditTrail(ctx, userID); return err },
+		func() error { _, err := c.GetUserRole(ctx, userID); return err },
+		func() error { _, err := c.SetUserRole(ctx, userID, client.UserRoleModerator); return err },
+		func() error { _, err := c.SetModeration(ctx, userID); return err },
+		func() error { _, err := c.CreateOrganization(ctx, &client.OrganizationCreateRequest{}); return err },
+		func() error { _, err := c.ListOrganizations(ctx); return err },
+		func() error { _, err := c.ListOrganizationMembers(ctx, userID); return err },
+		func() error {
+			_, err := c.SetOrganizationMember(ctx, userID, targetID, client.OrganizationRoleEditor)
+			return err
+		},
+		func() error { return c.RemoveOrganizationMember(ctx, userID, targetID) },
+		func() error { _, err := c.CreateInvitation(ctx); return err },
+		func() error { _, err := c.ListInvitations(ctx); return err },
+		func() error { _, err := c.GetInvitation(ctx, "K5VDS4TFMFZWK3LF"); return err },
+		func() error { _, err := c.RedeemInvitation(ctx, "K5VDS4TFMFZWK3LF"); return err },
+		func() error { _, err := c.GetInvitationStats(ctx); return err },
+		func() error { _, err := c.ClearModeration(ctx, userID); return err },
+		func() error { _, err := c.GetUserQuota(ctx, userID); return err },
+		func() error { _, err := c.SetFollowLimit(ctx, userID, 50); return err },
+		func() error { _, err := c.ClearFollowLimit(ctx, userID); return err },
+		func() error { _, err := c.ListChangeRequests(ctx, client.ChangeRequestStatusPending); return err },
+		func() error { _, err := c.ApproveChangeRequest(ctx, 1); return err },
+		func() error { _, err := c.RejectChangeRequest(ctx, 1, "Impersonation"); return err },
+		func() error { _, err := c.ListContentFlags(ctx); return err },
+		func() error { _, err := c.ResolveContentFlag(ctx, 1); return err },
+		func() error { _, err := c.GetDirectoryLink(ctx, userID); return err },
+		func() error { _, err := c.LinkDirectoryEntry(ctx, userID, "ada"); return err },
+		func() error { return c.UnlinkDirectoryEntry(ctx, userID) },
+		func() error { _, err := c.SyncDirectoryEntry(ctx, userID); return err },
+		func() error { _, err := c.GetPerformanceMetrics(ctx); return err },
+		func() error { _, err := c.GetCacheMetrics(ctx); return err },
+		func() error { _, err := c.GetSystemMetrics(ctx); return err },
+		func() error { _, err := c.GetDetailedHealthMetrics(ctx); return err },
+		func() error { _, err := c.GetUserChanges(ctx, 10, 50); return err },
+		func() error { _, err := c.ResolveMentions(ctx, []string{"alice"}); return err },
+		func() error { _, err := c.GetPushTargets(ctx, userID); return err },
+		func() error { _, err := c.IssuePreferenceCenterToken(ctx, userID); return err },
+		func() error {
+			_, err := c.GetListUnsubscribe(ctx, userID, client.EmailCategoryMarketing)
+			return err
+		},
+		func() error { return c.Heartbeat(ctx, userID) },
+		func() error { return c.RecordBadgeEvent(ctx, userID, client.BadgeEventRecipeCreated) },
+		func() error {
+			_, err := c.GetNotificationRecipients(ctx, userID, client.FollowNotificationEventNewRecipe)
+			return err
+		},
+	}
+
+	for _, call := range calls {
+		require.NoError(t, call())
+	}
+
+	assert.Empty(t, recorder.misses, "client methods calling unregistered routes")
+
+	err := chi.Walk(router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if unsupportedPaths[route] || strings.HasPrefix(route, "/debug/") {
+			return nil
+		}
+
+		// chi reports matched patterns without the trailing slash of subrouter index routes.
+		key := method + " " + strings.TrimSuffix(route, "/")
+
+		assert.True(t, recorder.matched[key], "route %s has no client method", key)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestContract_InMemoryServer round-trips requests through the real handlers and middleware.
+func TestContract_InMemoryServer(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(servertest.New(t).Handler)
+	t.Cleanup(ts.Close)
+
+	t.Run("decodes success responses", func(t *testing.T) {
+		t.Parallel()
+
+		c := client.New(client.Config{BaseURL: ts.URL, HTTPClient: ts.Client()})
+
+		health, err := c.Health(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "UP", health.Status)
+	})
+
+	t.Run("surfaces authentication errors", func(t *testing.T) {
+		t.Parallel()
+
+		c := client.New(client.Config{BaseURL: ts.URL, HTTPClient: ts.Client()})
+
+		_, err := c.GetUserProfile(t.Context(), uuid.New())
+
+		var apiErr *client.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+		assert.Equal(t, "UNAUTHORIZED", apiErr.Code)
+	})
+
+	t.Run("surfaces handler validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		c := client.New(client.Config{BaseURL: ts.URL, HTTPClient: ts.Client(), UserID: uuid.NewString()})
+
+		_, err := c.GetCategoryPreferences(t.Context(), uuid.New(), "not-a-category")
+
+		var apiErr *client.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+		assert.Equal(t, "INVALID_CATEGORY", apiErr.Code)
+	})
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateInvitation calls POST /users/account/invitations.
+func (c *Client) CreateInvitation(ctx context.Context) (*Invitation, error) {
+	return call[Invitation](ctx, c, http.MethodPost, pathf(apiPrefix, "/users/account/invitations"), nil, nil)
+}
+
+// ListInvitations calls GET /users/account/invitations.
+func (c *Client) ListInvitations(ctx context.Context) (*InvitationsResponse, error) {
+	return call[InvitationsResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/users/account/invitations"), nil, nil)
+}
+
+// GetInvitation calls GET /invitations/{code}. Only the inviter sees who redeemed it.
+func (c *Client) GetInvitation(ctx context.Context, code string) (*Invitation, error) {
+	return call[Invitation](ctx, c, http.MethodGet, pathf(apiPrefix, "/invitations/%s", code), nil, nil)
+}
+
+// RedeemInvitation calls POST /invitations/{code}/redeem.
+func (c *Client) RedeemInvitation(ctx context.Context, code string) (*InvitationRedemption, error) {
+	path := pathf(apiPrefix, "/invitations/%s/redeem", code)
+
+	return call[InvitationRedemption](ctx, c, http.MethodPost, path, nil, nil)
+}
+
+// GetInvitationStats calls GET /admin/invitations/stats.
+func (c *Client) GetInvitationStats(ctx context.Context) (*InvitationStatsResponse, error) {
+	path := pathf(apiPrefix, "/admin/invitations/stats")
+
+	return call[InvitationStatsResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// mePrefix addresses the authenticated user, so these methods need no user ID.
+const mePrefix = apiPrefix + "/users/me"
+
+// GetMyProfile calls GET /users/me/profile.
+func (c *Client) GetMyProfile(ctx context.Context) (*UserProfileResponse, error) {
+	return call[UserProfileResponse](ctx, c, http.MethodGet, mePrefix+"/profile", nil, nil)
+}
+
+// GetMyProfileViews calls GET /users/me/profile/views.
+func (c *Client) GetMyProfileViews(ctx context.Context) (*ProfileViewsResponse, error) {
+	return call[ProfileViewsResponse](ctx, c, http.MethodGet, mePrefix+"/profile/views", nil, nil)
+}
+
+// GetRecentlyViewed calls GET /users/me/recently-viewed.
+func (c *Client) GetRecentlyViewed(ctx context.Context) (*RecentlyViewedResponse, error) {
+	return call[RecentlyViewedResponse](ctx, c, http.MethodGet, mePrefix+"/recently-viewed", nil, nil)
+}
+
+// ClearRecentlyViewed calls DELETE /users/me/recently-viewed.
+func (c *Client) ClearRecentlyViewed(ctx context.Context) error {
+	return c.do(ctx, http.MethodDelete, mePrefix+"/recently-viewed", nil, nil, nil)
+}
+
+// GetMyFollowing calls GET /users/me/following.
+func (c *Client) GetMyFollowing(ctx context.Context, page PageParams) (*GetFollowedUsersResponse, error) {
+	return call[GetFollowedUsersResponse](ctx, c, http.MethodGet, mePrefix+"/following", page.query(), nil)
+}
+
+// GetMyFollowers calls GET /users/me/followers.
+func (c *Client) GetMyFollowers(ctx context.Context, page PageParams) (*GetFollowedUsersResponse, error) {
+	return call[GetFollowedUsersResponse](ctx, c, http.MethodGet, mePrefix+"/followers", page.query(), nil)
+}
+
+// GetMyFollowerInsights calls GET /users/me/followers/insights.
+func (c *Client) GetMyFollowerInsights(
+	ctx context.Context,
+	params FollowerInsightsParams,
+) (*FollowerInsightsResponse, error) {
+	return call[FollowerInsightsResponse](ctx, c, http.MethodGet, mePrefix+"/followers/insights", params.query(), nil)
+}
+
+// GetMyFollowHistory calls GET /users/me/followers/history. CountOnly is ignored.
+func (c *Client) GetMyFollowHistory(ctx context.Context, page PageParams) (*FollowHistoryResponse, error) {
+	return call[FollowHistoryResponse](ctx, c, http.MethodGet, mePrefix+"/followers/history", page.query(), nil)
+}
+
+// GetMyQuota calls GET /users/me/quota.
+func (c *Client) GetMyQuota(ctx context.Context) (*QuotaResponse, error) {
+	return call[QuotaResponse](ctx, c, http.MethodGet, mePrefix+"/quota", nil, nil)
+}
+
+// GetMyActivity calls GET /users/me/activity. A zero perTypeLimit uses the server default.
+func (c *Client) GetMyActivity(ctx context.Context, perTypeLimit int) (*UserActivityResponse, error) {
+	return call[UserActivityResponse](ctx, c, http.MethodGet, mePrefix+"/activity", activityQuery(perTypeLimit), nil)
+}
+
+// GetMyPresence calls GET /users/me/presence.
+func (c *Client) GetMyPresence(ctx context.Context) (*UserPresenceResponse, error) {
+	return call[UserPresenceResponse](ctx, c, http.MethodGet, mePrefix+"/presence", nil, nil)
+}
+
+// GetMyBadges calls GET /users/me/badges.
+func (c *Client) GetMyBadges(ctx context.Context) (*UserBadgesResponse, error) {
+	return call[UserBadgesResponse](ctx, c, http.MethodGet, mePrefix+"/badges", nil, nil)
+}
+
+// GetMyPreferences calls GET /users/me/preferences, optionally limited to categories.
+func (c *Client) GetMyPreferences(
+	ctx context.Context,
+	categories ...PreferenceCategory,
+) (*UserPreferencesResponse, error) {
+	path := mePrefix + "/preferences/"
+
+	return call[UserPreferencesResponse](ctx, c, http.MethodGet, path, categoriesQuery(categories), nil)
+}
+
+// UpdateMyPreferences calls PUT /users/me/preferences.
+func (c *Client) UpdateMyPreferences(
+	ctx context.Context,
+	update *UserPreferencesUpdateRequest,
+) (*UserPreferencesResponse, error) {
+	return call[UserPreferencesResponse](ctx, c, http.MethodPut, mePrefix+"/preferences/", nil, update)
+}
+
+// GetMyCategoryPreferences calls GET /users/me/preferences/{category}.
+func (c *Client) GetMyCategoryPreferences(
+	ctx context.Context,
+	category PreferenceCategory,
+) (*PreferenceCategoryResponse, error) {
+	path := pathf(mePrefix, "/preferences/%s", category)
+
+	return call[PreferenceCategoryResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
+
+// UpdateMyCategoryPreferences calls PUT /users/me/preferences/{category}. update is the category's
+// update DTO, as for UpdateCategoryPreferences.
+func (c *Client) UpdateMyCategoryPreferences(
+	ctx context.Context,
+	category PreferenceCategory,
+	update any,
+) (*PreferenceCategoryResponse, error) {
+	path := pathf(mePrefix, "/preferences/%s", category)
+
+	return call[PreferenceCategoryResponse](ctx, c, http.MethodPut, path, nil, update)
+}
+
+// ResetMyPreferences calls DELETE /users/me/preferences, optionally limited to categories.
+func (c *Client) ResetMyPreferences(
+	ctx context.Context,
+	categories ...PreferenceCategory,
+) (*UserPreferencesResponse, error) {
+	path := mePrefix + "/preferences/"
+
+	return call[UserPreferencesResponse](ctx, c, http.MethodDelete, path, categoriesQuery(categories), nil)
+}
+
+// ResetMyCategoryPreferences calls DELETE /users/me/preferences/{category}.
+func (c *Client) ResetMyCategoryPreferences(
+	ctx context.Context,
+	category PreferenceCategory,
+) (*PreferenceCategoryResponse, error) {
+	path := pathf(mePrefix, "/preferences/%s", category)
+
+	return call[PreferenceCategoryResponse](ctx, c, http.MethodDelete, path, nil, nil)
+}
+
+// GetMyConsents calls GET /users/me/consents.
+func (c *Client) GetMyConsents(ctx context.Context) (*ConsentsResponse, error) {
+	return call[ConsentsResponse](ctx, c, http.MethodGet, mePrefix+"/consents/", nil, nil)
+}
+
+// RecordMyConsent calls POST /users/me/consents.
+func (c *Client) RecordMyConsent(ctx context.Context, req *ConsentRequest) (*ConsentRecord, error) {
+	return call[ConsentRecord](ctx, c, http.MethodPost, mePrefix+"/consents/", nil, req)
+}
+
+// GetMyConsentHistory calls GET /users/me/consents/history.
+func (c *Client) GetMyConsentHistory(ctx context.Context) (*ConsentHistoryResponse, error) {
+	return call[ConsentHistoryResponse](ctx, c, http.MethodGet, mePrefix+"/consents/history", nil, nil)
+}
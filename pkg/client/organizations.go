@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// CreateOrganization calls POST /organizations. The caller becomes its first owner.
+func (c *Client) CreateOrganization(ctx context.Context, req *OrganizationCreateRequest) (*Organization, error) {
+	return call[Organization](ctx, c, http.MethodPost, pathf(apiPrefix, "/organizations"), nil, req)
+}
+
+// ListOrganizations calls GET /organizations.
+func (c *Client) ListOrganizations(ctx context.Context) (*OrganizationsResponse, error) {
+	return call[OrganizationsResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/organizations"), nil, nil)
+}
+
+// ListOrganizationMembers calls GET /organizations/{org_id}/members.
+func (c *Client) ListOrganizationMembers(ctx context.Context, orgID uuid.UUID) (*OrganizationMembersResponse, error) {
+	path := pathf(apiPrefix, "/organizations/%s/members", orgID)
+
+	return call[OrganizationMembersResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
+
+// SetOrganizationMember calls PUT /organizations/{org_id}/members/{user_id}. Only owners may call it.
+func (c *Client) SetOrganizationMember(
+	ctx context.Context,
+	orgID, userID uuid.UUID,
+	role OrganizationRole,
+) (*OrganizationMembersResponse, error) {
+	path := pathf(apiPrefix, "/organizations/%s/members/%s", orgID, userID)
+
+	return call[OrganizationMembersResponse](ctx, c, http.MethodPut, path, nil, dto.OrganizationMemberRequest{Role: role})
+}
+
+// RemoveOrganizationMember calls DELETE /organizations/{org_id}/members/{user_id}.
+func (c *Client) RemoveOrganizationMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	path := pathf(apiPrefix, "/organizations/%s/members/%s", orgID, userID)
+
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+// GetPreferences calls GET /users/{user_id}/preferences, optionally limited to categories.
+func (c *Client) GetPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	categories ...PreferenceCategory,
+) (*UserPreferencesResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/preferences/", userID)
+
+	return call[UserPreferencesResponse](ctx, c, http.MethodGet, path, categoriesQuery(categories), nil)
+}
+
+func categoriesQuery(categories []PreferenceCategory) url.Values {
+	query := url.Values{}
+	for _, category := range categories {
+		query.Add("categories", string(category))
+	}
+
+	return query
+}
+
+// UpdatePreferences calls PUT /users/{user_id}/preferences.
+func (c *Client) UpdatePreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update *UserPreferencesUpdateRequest,
+) (*UserPreferencesResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/preferences/", userID)
+
+	return call[UserPreferencesResponse](ctx, c, http.MethodPut, path, nil, update)
+}
+
+// GetCategoryPreferences calls GET /users/{user_id}/preferences/{category}.
+func (c *Client) GetCategoryPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	category PreferenceCategory,
+) (*PreferenceCategoryResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/preferences/%s", userID, category)
+
+	return call[PreferenceCategoryResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
+
+// UpdateCategoryPreferences calls PUT /users/{user_id}/preferences/{category}. update is the
+// category's update DTO, e.g. dto.DisplayPreferencesUpdate for the display category.
+func (c *Client) UpdateCategoryPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	category PreferenceCategory,
+	update any,
+) (*PreferenceCategoryResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/preferences/%s", userID, category)
+
+	return call[PreferenceCategoryResponse](ctx, c, http.MethodPut, path, nil, update)
+}
+
+// ResetPreferences calls DELETE /users/{user_id}/preferences, restoring all categories, or only
+// the given ones, to their defaults.
+func (c *Client) ResetPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	categories ...PreferenceCategory,
+) (*UserPreferencesResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/preferences/", userID)
+
+	return call[UserPreferencesResponse](ctx, c, http.MethodDelete, path, categoriesQuery(categories), nil)
+}
+
+// ResetCategoryPreferences calls DELETE /users/{user_id}/preferences/{category}.
+func (c *Client) ResetCategoryPreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	category PreferenceCategory,
+) (*PreferenceCategoryResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/preferences/%s", userID, category)
+
+	return call[PreferenceCategoryResponse](ctx, c, http.MethodDelete, path, nil, nil)
+}
+
+// GetPreferenceCenter calls GET /preference-center/{token}. The token from a preference center
+// link authorizes the request, so it does not require authentication.
+func (c *Client) GetPreferenceCenter(ctx context.Context, token string) (*PreferenceCenterResponse, error) {
+	path := pathf(apiPrefix, "/preference-center/%s", token)
+
+	return call[PreferenceCenterResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
+
+// UpdatePreferenceCenter calls POST /preference-center/{token}, changing the marketing emails,
+// activity summaries and recipe recommendations of the user the link was sent to.
+func (c *Client) UpdatePreferenceCenter(
+	ctx context.Context,
+	token string,
+	update *PreferenceCenterUpdateRequest,
+) (*PreferenceCenterResponse, error) {
+	path := pathf(apiPrefix, "/preference-center/%s", token)
+
+	return call[PreferenceCenterResponse](ctx, c, http.MethodPost, path, nil, update)
+}
+
+// RevokePreferenceCenterLinks calls DELETE /users/account/preference-center-links, invalidating
+// every preference center link sent to the caller so far.
+func (c *Client) RevokePreferenceCenterLinks(ctx context.Context) error {
+	return c.do(ctx, http.MethodDelete, apiPrefix+"/users/account/preference-center-links", nil, nil, nil)
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/requestsign"
+)
+
+// SignRequest signs req with secret for services running with requestsigning.enabled, for
+// callers sending internal requests with their own HTTP client. The body is read and replaced,
+// so req can still be sent. Sign each attempt again when retrying, since the service accepts
+// every signature once.
+func SignRequest(req *http.Request, secret []byte) error {
+	var body []byte
+
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+
+		_ = req.Body.Close()
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	}
+
+	err := requestsign.Sign(req, secret, body, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+)
+
+var testSigningSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// signatureChecker wraps handler in the service's signature check.
+func signatureChecker(handler http.HandlerFunc) http.HandlerFunc {
+	return middleware.RequireSignature(middleware.RequestSigningConfig{
+		Enabled: true,
+		Secrets: [][]byte{testSigningSecret},
+		Nonces:  memory.New(),
+	})(handler).ServeHTTP
+}
+
+func TestClient_SignsInternalRequests(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, signatureChecker(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"usernames":["chef"]}`, string(body), "the handler still sees the body")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"resolved":[],"unresolved":["chef"]}`))
+	}), Config{SigningSecret: testSigningSecret})
+
+	resp, err := c.ResolveMentions(t.Context(), []string{"chef"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"chef"}, resp.Unresolved)
+
+	// A second call is signed with a fresh nonce
+	_, err = c.ResolveMentions(t.Context(), []string{"chef"})
+	require.NoError(t, err)
+
+	t.Run("public routes are not signed", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("X-Signature"))
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"userId":"` + uuid.NewString() + `"}`))
+		}, Config{SigningSecret: testSigningSecret})
+
+		_, err := c.GetUserByID(t.Context(), uuid.New())
+		require.NoError(t, err)
+	})
+
+	t.Run("unsigned without a secret", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestClient(t, signatureChecker(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}), Config{})
+
+		_, err := c.ResolveMentions(t.Context(), []string{"chef"})
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+		assert.Equal(t, "INVALID_SIGNATURE", apiErr.Code)
+	})
+}
+
+func TestSignRequest(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(signatureChecker(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost,
+		server.URL+"/internal/v1/users/resolve-mentions", strings.NewReader(`{"usernames":[]}`))
+	require.NoError(t, err)
+	require.NoError(t, SignRequest(req, testSigningSecret))
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"usernames":[]}`, string(body))
+
+	// Replaying the same signed request is rejected
+	replay, err := http.NewRequestWithContext(t.Context(), http.MethodPost, req.URL.String(),
+		strings.NewReader(`{"usernames":[]}`))
+	require.NoError(t, err)
+
+	replay.Header = req.Header.Clone()
+
+	resp, err = server.Client().Do(replay)
+	require.NoError(t, err)
+
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// GetFollowing calls GET /users/{user_id}/following.
+func (c *Client) GetFollowing(
+	ctx context.Context,
+	userID uuid.UUID,
+	page PageParams,
+) (*GetFollowedUsersResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/following", userID)
+
+	return call[GetFollowedUsersResponse](ctx, c, http.MethodGet, path, page.query(), nil)
+}
+
+// GetFollowers calls GET /users/{user_id}/followers.
+func (c *Client) GetFollowers(
+	ctx context.Context,
+	userID uuid.UUID,
+	page PageParams,
+) (*GetFollowedUsersResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/followers", userID)
+
+	return call[GetFollowedUsersResponse](ctx, c, http.MethodGet, path, page.query(), nil)
+}
+
+// GetFollowersWithPresence calls GET /users/{user_id}/followers?includePresence=true. Each follower
+// carries their presence, hidden unless the requester may see it.
+func (c *Client) GetFollowersWithPresence(
+	ctx context.Context,
+	userID uuid.UUID,
+	page PageParams,
+) (*GetFollowedUsersResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/followers", userID)
+	query := page.query()
+	query.Set("includePresence", "true")
+
+	return call[GetFollowedUsersResponse](ctx, c, http.MethodGet, path, query, nil)
+}
+
+// CheckFollowing calls GET /users/{user_id}/following/{target_user_id}.
+func (c *Client) CheckFollowing(ctx context.Context, userID, targetUserID uuid.UUID) (*FollowingCheckResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/following/%s", userID, targetUserID)
+
+	return call[FollowingCheckResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
+
+// FollowUser calls POST /users/{user_id}/follow/{target_user_id}.
+func (c *Client) FollowUser(ctx context.Context, userID, targetUserID uuid.UUID) (*FollowResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/follow/%s", userID, targetUserID)
+
+	return call[FollowResponse](ctx, c, http.MethodPost, path, nil, nil)
+}
+
+// UnfollowUser calls DELETE /users/{user_id}/follow/{target_user_id}.
+func (c *Client) UnfollowUser(ctx context.Context, userID, targetUserID uuid.UUID) (*FollowResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/follow/%s", userID, targetUserID)
+
+	return call[FollowResponse](ctx, c, http.MethodDelete, path, nil, nil)
+}
+
+// UpdateFollowSettings calls PUT /users/{user_id}/following/{target_user_id}/settings.
+func (c *Client) UpdateFollowSettings(
+	ctx context.Context,
+	userID, targetUserID uuid.UUID,
+	req FollowSettingsUpdateRequest,
+) (*FollowSettings, error) {
+	path := pathf(apiPrefix, "/users/%s/following/%s/settings", userID, targetUserID)
+
+	return call[FollowSettings](ctx, c, http.MethodPut, path, nil, req)
+}
+
+// GetCloseFriends calls GET /users/{user_id}/close-friends. Only the owner can list their close friends.
+func (c *Client) GetCloseFriends(
+	ctx context.Context,
+	userID uuid.UUID,
+	page PageParams,
+) (*GetFollowedUsersResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/close-friends", userID)
+
+	return call[GetFollowedUsersResponse](ctx, c, http.MethodGet, path, page.query(), nil)
+}
+
+// AddCloseFriend calls PUT /users/{user_id}/close-friends/{target_user_id}. The target must follow userID.
+func (c *Client) AddCloseFriend(ctx context.Context, userID, targetUserID uuid.UUID) error {
+	path := pathf(apiPrefix, "/users/%s/close-friends/%s", userID, targetUserID)
+
+	return c.do(ctx, http.MethodPut, path, nil, nil, nil)
+}
+
+// RemoveCloseFriend calls DELETE /users/{user_id}/close-friends/{target_user_id}.
+func (c *Client) RemoveCloseFriend(ctx context.Context, userID, targetUserID uuid.UUID) error {
+	path := pathf(apiPrefix, "/users/%s/close-friends/%s", userID, targetUserID)
+
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// GetFollowerInsights calls GET /users/{user_id}/followers/insights. Only the owner may read them.
+func (c *Client) GetFollowerInsights(
+	ctx context.Context,
+	userID uuid.UUID,
+	params FollowerInsightsParams,
+) (*FollowerInsightsResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/followers/insights", userID)
+
+	return call[FollowerInsightsResponse](ctx, c, http.MethodGet, path, params.query(), nil)
+}
+
+// GetFollowHistory calls GET /users/{user_id}/followers/history. Only the owner may read it;
+// CountOnly is ignored.
+func (c *Client) GetFollowHistory(
+	ctx context.Context,
+	userID uuid.UUID,
+	page PageParams,
+) (*FollowHistoryResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/followers/history", userID)
+
+	return call[FollowHistoryResponse](ctx, c, http.MethodGet, path, page.query(), nil)
+}
+
+// GetQuota calls GET /users/{user_id}/quota. Only the owner may read it.
+func (c *Client) GetQuota(ctx context.Context, userID uuid.UUID) (*QuotaResponse, error) {
+	return call[QuotaResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/users/%s/quota", userID), nil, nil)
+}
+
+// GetUserActivity calls GET /users/{user_id}/activity. A zero perTypeLimit uses the server default.
+func (c *Client) GetUserActivity(
+	ctx context.Context,
+	userID uuid.UUID,
+	perTypeLimit int,
+) (*UserActivityResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/activity", userID)
+
+	return call[UserActivityResponse](ctx, c, http.MethodGet, path, activityQuery(perTypeLimit), nil)
+}
+
+// GetPresence calls GET /users/{user_id}/presence. The status is hidden when the user's privacy
+// preferences keep their presence from the requester.
+func (c *Client) GetPresence(ctx context.Context, userID uuid.UUID) (*UserPresenceResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/presence", userID)
+
+	return call[UserPresenceResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
+
+// GetBadges calls GET /users/{user_id}/badges. Badges are shown to whoever can view the profile.
+func (c *Client) GetBadges(ctx context.Context, userID uuid.UUID) (*UserBadgesResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/badges", userID)
+
+	return call[UserBadgesResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
+
+// GetDigestPreview calls GET /users/preferences/notifications/digest-preview for the authenticated
+// user. A zero perTypeLimit uses the server default.
+func (c *Client) GetDigestPreview(ctx context.Context, perTypeLimit int) (*DigestPreviewResponse, error) {
+	path := apiPrefix + "/users/preferences/notifications/digest-preview"
+
+	return call[DigestPreviewResponse](ctx, c, http.MethodGet, path, activityQuery(perTypeLimit), nil)
+}
+
+func activityQuery(perTypeLimit int) url.Values {
+	query := url.Values{}
+	if perTypeLimit > 0 {
+		query.Set("per_type_limit", strconv.Itoa(perTypeLimit))
+	}
+
+	return query
+}
@@ -0,0 +1,319 @@
+package client
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// Request and response types are aliases of the service DTOs so they always match the handlers.
+type (
+	ReadyResponse   = dto.ReadyResponse
+	VersionResponse = dto.VersionResponse
+	FieldError      = dto.FieldError
+
+	UserProfileUpdateRequest         = dto.UserProfileUpdateRequest
+	UserProfileResponse              = dto.UserProfileResponse
+	ProfileConstraintsResponse       = dto.ProfileConstraintsResponse
+	FieldConstraints                 = dto.FieldConstraints
+	UserSearchResult                 = dto.UserSearchResult
+	UserSearchResponse               = dto.UserSearchResponse
+	LocationFilter                   = dto.LocationFilter
+	UserTypeaheadResult              = dto.UserTypeaheadResult
+	UserTypeaheadResponse            = dto.UserTypeaheadResponse
+	TypeaheadSource                  = dto.TypeaheadSource
+	SearchReindexState               = dto.SearchReindexState
+	SearchReindexJob                 = dto.SearchReindexJob
+	TypeaheadVerification            = dto.TypeaheadVerification
+	SearchIndexStatusResponse        = dto.SearchIndexStatusResponse
+	UserExpansion                    = dto.UserExpansion
+	UserDetailsResponse              = dto.UserDetailsResponse
+	UserAccountDeleteRequestResponse = dto.UserAccountDeleteRequestResponse
+	UserConfirmAccountDeleteResponse = dto.UserConfirmAccountDeleteResponse
+	ProfileShareTokenResponse        = dto.ProfileShareTokenResponse
+	ProfileViewsResponse             = dto.ProfileViewsResponse
+	RecentlyViewedProfile            = dto.RecentlyViewedProfile
+	RecentlyViewedResponse           = dto.RecentlyViewedResponse
+	EmailChangeRequestResponse       = dto.EmailChangeRequestResponse
+	EmailChangeStatusResponse        = dto.EmailChangeStatusResponse
+	AccountRecoveryResponse          = dto.AccountRecoveryResponse
+	PrivacyReportResponse            = dto.PrivacyReportResponse
+	HandleReservationResponse        = dto.HandleReservationResponse
+	HandleResponse                   = dto.HandleResponse
+	HandleResolutionResponse         = dto.HandleResolutionResponse
+
+	GetFollowedUsersResponse = dto.GetFollowedUsersResponse
+	FollowResponse           = dto.FollowResponse
+	FollowingCheckResponse   = dto.FollowingCheckResponse
+	UserActivityResponse     = dto.UserActivityResponse
+	Presence                 = dto.Presence
+	PresenceStatus           = dto.PresenceStatus
+	UserPresenceResponse     = dto.UserPresenceResponse
+	Badge                    = dto.Badge
+	UserBadgesResponse       = dto.UserBadgesResponse
+	BadgeEvent               = dto.BadgeEvent
+	BadgeEventRequest        = dto.BadgeEventRequest
+	MentionedUser            = dto.MentionedUser
+	MentionResolveRequest    = dto.MentionResolveRequest
+	MentionResolveResponse   = dto.MentionResolveResponse
+	ProfileEmbedResponse     = dto.ProfileEmbedResponse
+
+	FollowSettings                 = dto.FollowSettings
+	FollowSettingsUpdateRequest    = dto.FollowSettingsUpdateRequest
+	FollowEvent                    = dto.FollowEvent
+	FollowHistoryEntry             = dto.FollowHistoryEntry
+	FollowHistoryResponse          = dto.FollowHistoryResponse
+	FollowNotificationEvent        = dto.FollowNotificationEvent
+	NotificationRecipientsResponse = dto.NotificationRecipientsResponse
+	QuotaResponse                  = dto.QuotaResponse
+	DigestRecipe                   = dto.DigestRecipe
+	DigestPreviewResponse          = dto.DigestPreviewResponse
+	QuotaUsage                     = dto.QuotaUsage
+
+	PreferenceCategory            = dto.PreferenceCategory
+	UserPreferencesResponse       = dto.UserPreferencesResponse
+	UserPreferencesUpdateRequest  = dto.UserPreferencesUpdateRequest
+	PreferenceCategoryResponse    = dto.PreferenceCategoryResponse
+	PreferenceBundle              = dto.PreferenceBundle
+	PreferenceImportRequest       = dto.PreferenceImportRequest
+	PreferenceCenterResponse      = dto.PreferenceCenterResponse
+	PreferenceCenterUpdateRequest = dto.PreferenceCenterUpdateRequest
+	PreferenceCenterTokenResponse = dto.PreferenceCenterTokenResponse
+	EmailCategory                 = dto.EmailCategory
+	ListUnsubscribeResponse       = dto.ListUnsubscribeResponse
+
+	ConsentPurpose         = dto.ConsentPurpose
+	ConsentRequest         = dto.ConsentRequest
+	ConsentRecord          = dto.ConsentRecord
+	ConsentsResponse       = dto.ConsentsResponse
+	ConsentHistoryResponse = dto.ConsentHistoryResponse
+
+	PolicyDocument       = dto.PolicyDocument
+	PolicyAcceptance     = dto.PolicyAcceptance
+	PolicyStatusResponse = dto.PolicyStatusResponse
+
+	AgeOverride       = dto.AgeOverride
+	AgeRestriction    = dto.AgeRestriction
+	AgeStatusResponse = dto.AgeStatusResponse
+
+	AdminNote              = dto.AdminNote
+	AdminNoteRequest       = dto.AdminNoteRequest
+	AdminNoteUpdateRequest = dto.AdminNoteUpdateRequest
+	AdminNotesResponse     = dto.AdminNotesResponse
+	AuditAction            = dto.AuditAction
+	AuditEntry             = dto.AuditEntry
+	AuditTrailResponse     = dto.AuditTrailResponse
+
+	UserRole         = dto.UserRole
+	UserRoleResponse = dto.UserRoleResponse
+
+	OrganizationCreateRequest   = dto.OrganizationCreateRequest
+	OrganizationRole            = dto.OrganizationRole
+	Organization                = dto.Organization
+	OrganizationMember          = dto.OrganizationMember
+	OrganizationMembership      = dto.OrganizationMembership
+	OrganizationsResponse       = dto.OrganizationsResponse
+	OrganizationMembersResponse = dto.OrganizationMembersResponse
+
+	InvitationStatus        = dto.InvitationStatus
+	Invitation              = dto.Invitation
+	InvitationsResponse     = dto.InvitationsResponse
+	InvitationRedemption    = dto.InvitationRedemption
+	InviterStats            = dto.InviterStats
+	InvitationStatsResponse = dto.InvitationStatsResponse
+
+	ModerationStatusResponse = dto.ModerationStatusResponse
+	ChangeRequestField       = dto.ChangeRequestField
+	ChangeRequestStatus      = dto.ChangeRequestStatus
+	ChangeRequest            = dto.ChangeRequest
+	ChangeRequestsResponse   = dto.ChangeRequestsResponse
+	ContentFlag              = dto.ContentFlag
+	ContentFlagsResponse     = dto.ContentFlagsResponse
+
+	DirectoryAttribute   = dto.DirectoryAttribute
+	DirectoryAttributes  = dto.DirectoryAttributes
+	DirectorySyncStatus  = dto.DirectorySyncStatus
+	DirectoryLink        = dto.DirectoryLink
+	DirectoryLinkRequest = dto.DirectoryLinkRequest
+
+	DevicePlatform       = dto.DevicePlatform
+	DeviceTokenRequest   = dto.DeviceTokenRequest
+	DeviceToken          = dto.DeviceToken
+	DeviceTokensResponse = dto.DeviceTokensResponse
+	PushTargetsResponse  = dto.PushTargetsResponse
+
+	AdminStatsResponse               = dto.AdminStatsResponse
+	FollowerInsightsResponse         = dto.FollowerInsightsResponse
+	FollowerLocation                 = dto.FollowerLocation
+	StatsInterval                    = dto.StatsInterval
+	UserStatsResponse                = dto.UserStatsResponse
+	CacheClearResponse               = dto.CacheClearResponse
+	MaintenanceRequest               = dto.MaintenanceRequest
+	MaintenanceStatus                = dto.MaintenanceStatus
+	DrainStatus                      = dto.DrainStatus
+	ScheduledJobsResponse            = dto.ScheduledJobsResponse
+	ScheduledJob                     = dto.ScheduledJob
+	PrivacyDefaultsStatusResponse    = dto.PrivacyDefaultsStatusResponse
+	PrivacyDefaultsCohort            = dto.PrivacyDefaultsCohort
+	PrivacyDefaultsMigrationRequest  = dto.PrivacyDefaultsMigrationRequest
+	PrivacyDefaultsMigrationResponse = dto.PrivacyDefaultsMigrationResponse
+	JobRun                           = dto.JobRun
+	PerformanceMetricsResponse       = dto.PerformanceMetricsResponse
+	CacheMetricsResponse             = dto.CacheMetricsResponse
+	SystemMetricsResponse            = dto.SystemMetricsResponse
+	DetailedHealthMetricsResponse    = dto.DetailedHealthMetricsResponse
+	UserChangesResponse              = dto.UserChangesResponse
+)
+
+// Preference categories accepted by the preference methods.
+const (
+	PreferenceCategoryNotification  = dto.PreferenceCategoryNotification
+	PreferenceCategoryDisplay       = dto.PreferenceCategoryDisplay
+	PreferenceCategoryPrivacy       = dto.PreferenceCategoryPrivacy
+	PreferenceCategoryAccessibility = dto.PreferenceCategoryAccessibility
+	PreferenceCategoryLanguage      = dto.PreferenceCategoryLanguage
+	PreferenceCategorySecurity      = dto.PreferenceCategorySecurity
+	PreferenceCategorySocial        = dto.PreferenceCategorySocial
+	PreferenceCategorySound         = dto.PreferenceCategorySound
+	PreferenceCategoryTheme         = dto.PreferenceCategoryTheme
+)
+
+// Consent purposes accepted by RecordConsent.
+const (
+	ConsentPurposeMarketingEmails   = dto.ConsentPurposeMarketingEmails
+	ConsentPurposeAnalyticsTracking = dto.ConsentPurposeAnalyticsTracking
+	ConsentPurposeDataSharing       = dto.ConsentPurposeDataSharing
+)
+
+// Policy documents accepted by AcceptPolicy.
+const (
+	PolicyDocumentTerms   = dto.PolicyDocumentTerms
+	PolicyDocumentPrivacy = dto.PolicyDocumentPrivacy
+)
+
+// Device platforms accepted by RegisterDevice.
+const (
+	DevicePlatformIOS     = dto.DevicePlatformIOS
+	DevicePlatformAndroid = dto.DevicePlatformAndroid
+	DevicePlatformWeb     = dto.DevicePlatformWeb
+)
+
+// Follow notification events accepted by GetNotificationRecipients.
+const (
+	FollowNotificationEventNewRecipe = dto.FollowNotificationEventNewRecipe
+	FollowNotificationEventReview    = dto.FollowNotificationEventReview
+)
+
+// Email categories accepted by GetListUnsubscribe.
+const (
+	EmailCategoryMarketing             = dto.EmailCategoryMarketing
+	EmailCategoryActivitySummaries     = dto.EmailCategoryActivitySummaries
+	EmailCategoryRecipeRecommendations = dto.EmailCategoryRecipeRecommendations
+)
+
+// Follow history events returned by GetFollowHistory.
+const (
+	FollowEventFollow   = dto.FollowEventFollow
+	FollowEventUnfollow = dto.FollowEventUnfollow
+)
+
+// Presence statuses returned by GetPresence and GetFollowersWithPresence.
+const (
+	PresenceOnline  = dto.PresenceOnline
+	PresenceOffline = dto.PresenceOffline
+	PresenceHidden  = dto.PresenceHidden
+)
+
+// Badge events accepted by RecordBadgeEvent.
+const (
+	BadgeEventRecipeCreated  = dto.BadgeEventRecipeCreated
+	BadgeEventFollowerGained = dto.BadgeEventFollowerGained
+)
+
+// Age overrides accepted by SetAgeOverride.
+const (
+	AgeOverrideAdult = dto.AgeOverrideAdult
+	AgeOverrideMinor = dto.AgeOverrideMinor
+)
+
+// Roles accepted by SetUserRole.
+const (
+	UserRoleUser      = dto.UserRoleUser
+	UserRoleModerator = dto.UserRoleModerator
+	UserRoleAdmin     = dto.UserRoleAdmin
+	UserRoleService   = dto.UserRoleService
+)
+
+// Organization roles accepted by SetOrganizationMember.
+const (
+	OrganizationRoleOwner  = dto.OrganizationRoleOwner
+	OrganizationRoleEditor = dto.OrganizationRoleEditor
+)
+
+// Invitation statuses reported on Invitation.
+const (
+	InvitationStatusPending  = dto.InvitationStatusPending
+	InvitationStatusRedeemed = dto.InvitationStatusRedeemed
+	InvitationStatusExpired  = dto.InvitationStatusExpired
+)
+
+// Identity fields accepted by SubmitChangeRequest.
+const (
+	ChangeRequestFieldUsername = dto.ChangeRequestFieldUsername
+	ChangeRequestFieldEmail    = dto.ChangeRequestFieldEmail
+)
+
+// Change request statuses accepted by ListChangeRequests.
+const (
+	ChangeRequestStatusPending  = dto.ChangeRequestStatusPending
+	ChangeRequestStatusApproved = dto.ChangeRequestStatusApproved
+	ChangeRequestStatusRejected = dto.ChangeRequestStatusRejected
+)
+
+// Directory sync statuses returned by GetDirectoryLink and SyncDirectoryEntry.
+const (
+	DirectorySyncPending  = dto.DirectorySyncPending
+	DirectorySyncSynced   = dto.DirectorySyncSynced
+	DirectorySyncConflict = dto.DirectorySyncConflict
+	DirectorySyncNotFound = dto.DirectorySyncNotFound
+	DirectorySyncFailed   = dto.DirectorySyncFailed
+)
+
+// Related resources accepted by GetUserDetails.
+const (
+	UserExpansionPrivacy        = dto.UserExpansionPrivacy
+	UserExpansionStats          = dto.UserExpansionStats
+	UserExpansionRecentActivity = dto.UserExpansionRecentActivity
+)
+
+// Statistics intervals accepted by GetAdminStats and GetFollowerInsights.
+const (
+	StatsIntervalDay  = dto.StatsIntervalDay
+	StatsIntervalWeek = dto.StatsIntervalWeek
+)
+
+// PageParams controls pagination of list endpoints. Zero values use the server defaults.
+type PageParams struct {
+	Limit     int
+	Offset    int
+	CountOnly bool
+}
+
+func (p PageParams) query() url.Values {
+	query := url.Values{}
+
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+
+	if p.Offset > 0 {
+		query.Set("offset", strconv.Itoa(p.Offset))
+	}
+
+	if p.CountOnly {
+		query.Set("countOnly", "true")
+	}
+
+	return query
+}
@@ -0,0 +1,295 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+)
+
+// Health calls GET /health (liveness).
+func (c *Client) Health(ctx context.Context) (*ReadyResponse, error) {
+	return call[ReadyResponse](ctx, c, http.MethodGet, apiPrefix+"/health", nil, nil)
+}
+
+// Ready calls GET /ready. A service that is not ready returns an *APIError with status 503.
+func (c *Client) Ready(ctx context.Context) (*ReadyResponse, error) {
+	return call[ReadyResponse](ctx, c, http.MethodGet, apiPrefix+"/ready", nil, nil)
+}
+
+// Version calls GET /version, identifying the service's running build.
+func (c *Client) Version(ctx context.Context) (*VersionResponse, error) {
+	return call[VersionResponse](ctx, c, http.MethodGet, apiPrefix+"/version", nil, nil)
+}
+
+// SearchUsers calls GET /users/search.
+func (c *Client) SearchUsers(ctx context.Context, query string, page PageParams) (*UserSearchResponse, error) {
+	params := page.query()
+	if query != "" {
+		params.Set("query", query)
+	}
+
+	return call[UserSearchResponse](ctx, c, http.MethodGet, apiPrefix+"/users/search", params, nil)
+}
+
+// SearchUsersInLocation calls GET /users/search, keeping only users who show their location and
+// are in location's country and, if set, region.
+func (c *Client) SearchUsersInLocation(
+	ctx context.Context,
+	query string,
+	location LocationFilter,
+	page PageParams,
+) (*UserSearchResponse, error) {
+	params := page.query()
+	if query != "" {
+		params.Set("query", query)
+	}
+
+	if location.Country != "" {
+		params.Set("country", location.Country)
+	}
+
+	if location.Region != "" {
+		params.Set("region", location.Region)
+	}
+
+	return call[UserSearchResponse](ctx, c, http.MethodGet, apiPrefix+"/users/search", params, nil)
+}
+
+// Typeahead calls GET /users/search/typeahead, autocompleting usernames that start with prefix.
+func (c *Client) Typeahead(ctx context.Context, prefix string) (*UserTypeaheadResponse, error) {
+	return call[UserTypeaheadResponse](ctx, c, http.MethodGet, apiPrefix+"/users/search/typeahead",
+		url.Values{"prefix": {prefix}}, nil)
+}
+
+// GetUserByID calls GET /users/{user_id}.
+func (c *Client) GetUserByID(ctx context.Context, userID uuid.UUID) (*UserSearchResult, error) {
+	return call[UserSearchResult](ctx, c, http.MethodGet, pathf(apiPrefix, "/users/%s/", userID), nil, nil)
+}
+
+// GetUserDetails calls GET /users/{user_id} with ?expand=, embedding the given related resources.
+func (c *Client) GetUserDetails(
+	ctx context.Context,
+	userID uuid.UUID,
+	expand ...UserExpansion,
+) (*UserDetailsResponse, error) {
+	names := make([]string, len(expand))
+	for i, expansion := range expand {
+		names[i] = string(expansion)
+	}
+
+	query := url.Values{}
+	if len(names) > 0 {
+		query.Set("expand", strings.Join(names, ","))
+	}
+
+	return call[UserDetailsResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/users/%s/", userID), query, nil)
+}
+
+// GetUserProfile calls GET /users/{user_id}/profile.
+func (c *Client) GetUserProfile(ctx context.Context, userID uuid.UUID) (*UserProfileResponse, error) {
+	return call[UserProfileResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/users/%s/profile", userID), nil, nil)
+}
+
+// GetUserProfileByUsername calls GET /users/by-username/{username}.
+func (c *Client) GetUserProfileByUsername(ctx context.Context, username string) (*UserProfileResponse, error) {
+	return call[UserProfileResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/users/by-username/%s", username), nil, nil)
+}
+
+// UpdateProfile calls PUT /users/profile for the authenticated user.
+func (c *Client) UpdateProfile(ctx context.Context, update *UserProfileUpdateRequest) (*UserProfileResponse, error) {
+	return call[UserProfileResponse](ctx, c, http.MethodPut, apiPrefix+"/users/profile", nil, update)
+}
+
+// GetProfileConstraints calls GET /users/profile/constraints, returning the profile field limits
+// UpdateProfile checks.
+func (c *Client) GetProfileConstraints(ctx context.Context) (*ProfileConstraintsResponse, error) {
+	return call[ProfileConstraintsResponse](ctx, c, http.MethodGet, apiPrefix+"/users/profile/constraints", nil, nil)
+}
+
+// GetShareToken calls GET /users/profile/share-token, issuing a link token for the caller's profile.
+func (c *Client) GetShareToken(ctx context.Context) (*ProfileShareTokenResponse, error) {
+	return call[ProfileShareTokenResponse](ctx, c, http.MethodGet, apiPrefix+"/users/profile/share-token", nil, nil)
+}
+
+// RevokeShareToken calls DELETE /users/profile/share-token.
+func (c *Client) RevokeShareToken(ctx context.Context) error {
+	return c.do(ctx, http.MethodDelete, apiPrefix+"/users/profile/share-token", nil, nil, nil)
+}
+
+// GetSharedProfile calls GET /shared-profiles/{token}.
+func (c *Client) GetSharedProfile(ctx context.Context, token string) (*UserProfileResponse, error) {
+	return call[UserProfileResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/shared-profiles/%s", token), nil, nil)
+}
+
+// GetProfileEmbed calls GET /users/{user_id}/embed. It does not require authentication; only
+// public profiles have an embed.
+func (c *Client) GetProfileEmbed(ctx context.Context, userID uuid.UUID) (*ProfileEmbedResponse, error) {
+	return call[ProfileEmbedResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/users/%s/embed", userID), nil, nil)
+}
+
+// GetProfileViews calls GET /users/{user_id}/profile/views. Only the owner can read the count.
+func (c *Client) GetProfileViews(ctx context.Context, userID uuid.UUID) (*ProfileViewsResponse, error) {
+	path := pathf(apiPrefix, "/users/%s/profile/views", userID)
+
+	return call[ProfileViewsResponse](ctx, c, http.MethodGet, path, nil, nil)
+}
+
+// RequestAccountDeletion calls POST /users/account/delete-request.
+func (c *Client) RequestAccountDeletion(ctx context.Context) (*UserAccountDeleteRequestResponse, error) {
+	path := apiPrefix + "/users/account/delete-request"
+
+	return call[UserAccountDeleteRequestResponse](ctx, c, http.MethodPost, path, nil, nil)
+}
+
+// ConfirmAccountDeletion calls DELETE /users/account with the token from RequestAccountDeletion.
+func (c *Client) ConfirmAccountDeletion(
+	ctx context.Context,
+	confirmationToken string,
+) (*UserConfirmAccountDeleteResponse, error) {
+	body := dto.UserAccountDeleteRequest{ConfirmationToken: confirmationToken}
+
+	return call[UserConfirmAccountDeleteResponse](ctx, c, http.MethodDelete, apiPrefix+"/users/account", nil, body)
+}
+
+// RequestEmailChange calls POST /users/account/email-change.
+func (c *Client) RequestEmailChange(ctx context.Context, newEmail string) (*EmailChangeRequestResponse, error) {
+	body := dto.EmailChangeRequest{NewEmail: newEmail}
+
+	return call[EmailChangeRequestResponse](ctx, c, http.MethodPost, apiPrefix+"/users/account/email-change", nil, body)
+}
+
+// ConfirmOldEmail calls POST /users/account/email-change/confirm-old.
+func (c *Client) ConfirmOldEmail(ctx context.Context, confirmationToken string) (*EmailChangeStatusResponse, error) {
+	return c.confirmEmail(ctx, "/users/account/email-change/confirm-old", confirmationToken)
+}
+
+// ConfirmNewEmail calls POST /users/account/email-change/confirm-new.
+func (c *Client) ConfirmNewEmail(ctx context.Context, confirmationToken string) (*EmailChangeStatusResponse, error) {
+	return c.confirmEmail(ctx, "/users/account/email-change/confirm-new", confirmationToken)
+}
+
+func (c *Client) confirmEmail(ctx context.Context, path, token string) (*EmailChangeStatusResponse, error) {
+	body := dto.EmailChangeConfirmRequest{ConfirmationToken: token}
+
+	return call[EmailChangeStatusResponse](ctx, c, http.MethodPost, apiPrefix+path, nil, body)
+}
+
+// RequestAccountRecovery calls POST /users/account/recovery. It does not need credentials and
+// succeeds whether or not email belongs to a recoverable account.
+func (c *Client) RequestAccountRecovery(ctx context.Context, email string) error {
+	body := dto.AccountRecoveryRequest{Email: email}
+
+	return c.do(ctx, http.MethodPost, apiPrefix+"/users/account/recovery", nil, body, nil)
+}
+
+// ConfirmAccountRecovery calls POST /users/account/recovery/confirm with the emailed recovery token.
+func (c *Client) ConfirmAccountRecovery(ctx context.Context, recoveryToken string) (*AccountRecoveryResponse, error) {
+	body := dto.AccountRecoveryConfirmRequest{RecoveryToken: recoveryToken}
+
+	return call[AccountRecoveryResponse](ctx, c, http.MethodPost, apiPrefix+"/users/account/recovery/confirm", nil, body)
+}
+
+// GetPrivacyReport calls GET /users/account/privacy-report for the authenticated user.
+func (c *Client) GetPrivacyReport(ctx context.Context) (*PrivacyReportResponse, error) {
+	return call[PrivacyReportResponse](ctx, c, http.MethodGet, apiPrefix+"/users/account/privacy-report", nil, nil)
+}
+
+// ExportPreferences calls GET /users/account/preferences/export, optionally limited to categories.
+// The returned bundle can be imported as is into another account with ImportPreferences.
+func (c *Client) ExportPreferences(ctx context.Context, categories ...PreferenceCategory) (*PreferenceBundle, error) {
+	path := apiPrefix + "/users/account/preferences/export"
+
+	return call[PreferenceBundle](ctx, c, http.MethodGet, path, categoriesQuery(categories), nil)
+}
+
+// ImportPreferences calls POST /users/account/preferences/import for the authenticated user.
+func (c *Client) ImportPreferences(
+	ctx context.Context,
+	req *PreferenceImportRequest,
+) (*UserPreferencesResponse, error) {
+	path := apiPrefix + "/users/account/preferences/import"
+
+	return call[UserPreferencesResponse](ctx, c, http.MethodPost, path, nil, req)
+}
+
+// GetBirthdate calls GET /users/account/birthdate for the authenticated user.
+func (c *Client) GetBirthdate(ctx context.Context) (*AgeStatusResponse, error) {
+	return call[AgeStatusResponse](ctx, c, http.MethodGet, apiPrefix+"/users/account/birthdate", nil, nil)
+}
+
+// SetBirthdate calls PUT /users/account/birthdate for the authenticated user. birthdate is a
+// YYYY-MM-DD date and can only be set once.
+func (c *Client) SetBirthdate(ctx context.Context, birthdate string) (*AgeStatusResponse, error) {
+	body := dto.BirthdateRequest{Birthdate: birthdate}
+
+	return call[AgeStatusResponse](ctx, c, http.MethodPut, apiPrefix+"/users/account/birthdate", nil, body)
+}
+
+// SubmitChangeRequest calls POST /users/account/change-requests for the authenticated user. It
+// queues a username or email change on an account under moderation for admin approval.
+func (c *Client) SubmitChangeRequest(
+	ctx context.Context,
+	field ChangeRequestField,
+	value string,
+) (*ChangeRequest, error) {
+	body := dto.ChangeRequestSubmission{Field: field, Value: value}
+
+	return call[ChangeRequest](ctx, c, http.MethodPost, apiPrefix+"/users/account/change-requests", nil, body)
+}
+
+// ListMyChangeRequests calls GET /users/account/change-requests for the authenticated user.
+func (c *Client) ListMyChangeRequests(ctx context.Context) (*ChangeRequestsResponse, error) {
+	return call[ChangeRequestsResponse](ctx, c, http.MethodGet, apiPrefix+"/users/account/change-requests", nil, nil)
+}
+
+// RegisterDevice calls POST /users/account/devices for the authenticated user. Registering a
+// token again refreshes it, so apps can call this on every launch.
+func (c *Client) RegisterDevice(ctx context.Context, req *DeviceTokenRequest) (*DeviceToken, error) {
+	return call[DeviceToken](ctx, c, http.MethodPost, apiPrefix+"/users/account/devices", nil, req)
+}
+
+// ListDevices calls GET /users/account/devices for the authenticated user.
+func (c *Client) ListDevices(ctx context.Context) (*DeviceTokensResponse, error) {
+	return call[DeviceTokensResponse](ctx, c, http.MethodGet, apiPrefix+"/users/account/devices", nil, nil)
+}
+
+// UnregisterDevice calls DELETE /users/account/devices/{device_id} for the authenticated user.
+func (c *Client) UnregisterDevice(ctx context.Context, deviceID int64) error {
+	return c.do(ctx, http.MethodDelete, pathf(apiPrefix, "/users/account/devices/%s", deviceID), nil, nil, nil)
+}
+
+// GetPolicyStatus calls GET /users/account/policies for the authenticated user.
+func (c *Client) GetPolicyStatus(ctx context.Context) (*PolicyStatusResponse, error) {
+	return call[PolicyStatusResponse](ctx, c, http.MethodGet, apiPrefix+"/users/account/policies", nil, nil)
+}
+
+// AcceptPolicy calls POST /users/account/policies for the authenticated user.
+func (c *Client) AcceptPolicy(ctx context.Context, document PolicyDocument, version string) (*PolicyAcceptance, error) {
+	body := dto.PolicyAcceptanceRequest{Document: document, Version: version}
+
+	return call[PolicyAcceptance](ctx, c, http.MethodPost, apiPrefix+"/users/account/policies", nil, body)
+}
+
+// ReserveHandle calls POST /users/handle/reservation.
+func (c *Client) ReserveHandle(ctx context.Context, handle string) (*HandleReservationResponse, error) {
+	body := dto.HandleRequest{Handle: handle}
+
+	return call[HandleReservationResponse](ctx, c, http.MethodPost, apiPrefix+"/users/handle/reservation", nil, body)
+}
+
+// ClaimHandle calls PUT /users/handle.
+func (c *Client) ClaimHandle(ctx context.Context, handle string) (*HandleResponse, error) {
+	body := dto.HandleRequest{Handle: handle}
+
+	return call[HandleResponse](ctx, c, http.MethodPut, apiPrefix+"/users/handle", nil, body)
+}
+
+// ResolveHandle calls GET /handles/{handle}.
+func (c *Client) ResolveHandle(ctx context.Context, handle string) (*HandleResolutionResponse, error) {
+	return call[HandleResolutionResponse](ctx, c, http.MethodGet, pathf(apiPrefix, "/handles/%s", handle), nil, nil)
+}
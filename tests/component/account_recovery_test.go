@@ -0,0 +1,95 @@
+package component_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/notification"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// recoveryMailbox captures the recovery emails that would have been sent.
+type recoveryMailbox struct {
+	notification.NoopClient
+
+	sent chan recoveryEmail
+}
+
+type recoveryEmail struct {
+	recipientID uuid.UUID
+	token       string
+}
+
+func (m *recoveryMailbox) NotifyAccountRecovery(_ context.Context, recipientID uuid.UUID, _, token string) {
+	m.sent <- recoveryEmail{recipientID: recipientID, token: token}
+}
+
+func TestAccountRecovery_ReactivatesDeactivatedAccount(t *testing.T) {
+	t.Parallel()
+
+	aliceEmail, daveEmail, inactive := "alice@example.com", "dave@example.com", false
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{
+			{Username: "alice", Email: &aliceEmail},
+			{Username: "dave", Email: &daveEmail, IsActive: &inactive},
+		},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	mailbox := &recoveryMailbox{sent: make(chan recoveryEmail, 1)}
+	srv := servertest.New(t,
+		servertest.WithMemoryStore(store),
+		servertest.WithContainer(func(c *app.Container) {
+			c.AccountRecoveryService = service.NewAccountRecoveryService(store, store, store, mailbox)
+		}),
+	)
+
+	dave, _ := f.UserID("dave")
+	recovery := servertest.Path("users", "account", "recovery")
+	confirm := servertest.Path("users", "account", "recovery", "confirm")
+
+	// Every address is accepted, but only deactivated accounts are sent a token
+	for _, email := range []string{"alice@example.com", "nobody@example.com", "DAVE@example.com"} {
+		srv.Post(recovery, map[string]any{"email": email}).Do(t).AssertStatus(http.StatusAccepted)
+	}
+
+	var sent recoveryEmail
+
+	select {
+	case sent = <-mailbox.sent:
+	case <-time.After(time.Second):
+		require.FailNow(t, "no recovery email was sent")
+	}
+
+	assert.Equal(t, dave, sent.recipientID)
+
+	srv.Post(confirm, map[string]any{"recoveryToken": "not-a-token"}).
+		Do(t).
+		AssertError(http.StatusBadRequest, "INVALID_TOKEN")
+
+	w := srv.Post(confirm, map[string]any{"recoveryToken": sent.token}).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.Equal(t, "dave", servertest.DecodeJSON[dto.AccountRecoveryResponse](w).Username)
+
+	user, err := store.FindUserByID(t.Context(), dave)
+	require.NoError(t, err)
+	assert.True(t, user.IsActive)
+
+	// Tokens are single use
+	srv.Post(confirm, map[string]any{"recoveryToken": sent.token}).
+		Do(t).
+		AssertError(http.StatusBadRequest, "INVALID_TOKEN")
+}
@@ -1,106 +1,37 @@
 package component_test
 
 import (
-	"context"
-	"encoding/json"
-	"errors"
-	"fmt"
 	"net/http"
-	"net/http/httptest"
-	"strings"
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"github.com/stretchr/testify/require"
-)
-
-// MockRedisCacheClient for component tests.
-type MockRedisCacheClient struct {
-	mock.Mock
-}
 
-// Sentinel errors for mocks.
-var (
-	ErrInvalidTypeAssertion = errors.New("invalid type assertion")
-	ErrMockNil              = errors.New("mock returned nil")
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 )
 
-func (m *MockRedisCacheClient) ClearCache(ctx context.Context, pattern string) (int, error) {
-	args := m.Called(ctx, pattern)
-	return args.Int(0), args.Error(1)
-}
-
-func (m *MockRedisCacheClient) GetCacheMetrics(ctx context.Context) (*dto.CacheMetricsResponse, error) {
-	args := m.Called(ctx)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, ErrMockNil
-	}
-
-	resp, ok := args.Get(0).(*dto.CacheMetricsResponse)
-	if !ok {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("mock error: %w", err)
-		}
-
-		return nil, ErrInvalidTypeAssertion
-	}
-
-	err := args.Error(1)
-	if err != nil {
-		return resp, fmt.Errorf("mock error: %w", err)
-	}
-
-	return resp, nil
-}
-
-func (m *MockRedisCacheClient) Health(ctx context.Context) map[string]string {
-	args := m.Called(ctx)
-
-	resp, ok := args.Get(0).(map[string]string)
-	if !ok {
-		return map[string]string{}
-	}
-
-	return resp
-}
+// Sentinel errors for mocks.
+var ()
 
 func TestClearCache_Success(t *testing.T) {
 	t.Parallel()
 
 	// Setup Mocks
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	mockAdminRedis := new(MockRedisCacheClient)
-
-	// Create Service
-	userService := service.NewUserService(mockRepo, mockTokenStore, nil)
-	adminService := service.NewAdminService(mockAdminRedis)
-
-	// Create Container
-	c := &app.Container{
-		UserService:  userService,
-		AdminService: adminService,
-		Config:       config.Instance,
-	}
-	// Setup Health so router doesn't panic
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	// Create Server
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	adminID := uuid.New()
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	mockAdminRedis := new(mocks.RedisCacheClient)
+
+	srv := servertest.New(
+		t,
+		servertest.WithRepositories(mockRepo, nil, mockTokenStore),
+		servertest.WithRoles(adminRoles(t, adminID)),
+		servertest.WithAdminService(service.NewAdminService(mockAdminRedis)),
+	)
 
 	// Mock Expectation
 	pattern := "user:*"
@@ -108,20 +39,12 @@ func TestClearCache_Success(t *testing.T) {
 
 	// Execute
 	reqBody := `{"keyPattern": "user:*"}`
-	req := httptest.NewRequest(http.MethodPost, "/api/v1/user-management/admin/cache/clear", strings.NewReader(reqBody))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-User-Id", uuid.New().String())
-
-	w := httptest.NewRecorder()
-	handler.ServeHTTP(w, req)
+	w := srv.Post(servertest.Path("admin/cache/clear"), reqBody).As(adminID).Do(t)
 
 	// Assert
-	assert.Equal(t, http.StatusOK, w.Code)
-
-	var apiResp dto.CacheClearResponse
+	w.AssertStatus(http.StatusOK)
 
-	err := json.Unmarshal(w.Body.Bytes(), &apiResp)
-	require.NoError(t, err)
+	apiResp := servertest.DecodeJSON[dto.CacheClearResponse](w)
 	assert.Equal(t, 42, apiResp.ClearedCount)
 	assert.Equal(t, pattern, apiResp.Pattern)
 
@@ -132,48 +55,32 @@ func TestClearCache_Success_EmptyBody(t *testing.T) {
 	t.Parallel()
 
 	// Setup Mocks
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	mockAdminRedis := new(MockRedisCacheClient)
-
-	// Create Service
-	userService := service.NewUserService(mockRepo, mockTokenStore, nil)
-	adminService := service.NewAdminService(mockAdminRedis)
-
-	// Create Container
-	c := &app.Container{
-		UserService:  userService,
-		AdminService: adminService,
-		Config:       config.Instance,
-	}
-	// Setup Health so router doesn't panic
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	// Create Server
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	adminID := uuid.New()
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	mockAdminRedis := new(mocks.RedisCacheClient)
+
+	srv := servertest.New(
+		t,
+		servertest.WithRepositories(mockRepo, nil, mockTokenStore),
+		servertest.WithRoles(adminRoles(t, adminID)),
+		servertest.WithAdminService(service.NewAdminService(mockAdminRedis)),
+	)
 
 	// Mock Expectation - Expect "*" as default pattern
 	pattern := "*"
 	mockAdminRedis.On("ClearCache", mock.Anything, pattern).Return(100, nil)
 
 	// Execute with NO body
-	req := httptest.NewRequest(http.MethodPost, "/api/v1/user-management/admin/cache/clear", nil)
-	req.Header.Set("X-User-Id", uuid.New().String())
 	// Even without body, content-type is often not present, or maybe application/json
 	// If the binder checks header first, we might need to be careful.
 	// But binder.BindJSON check r.Body == nil first.
-
-	w := httptest.NewRecorder()
-	handler.ServeHTTP(w, req)
+	w := srv.Post(servertest.Path("admin/cache/clear"), nil).As(adminID).Do(t)
 
 	// Assert
-	assert.Equal(t, http.StatusOK, w.Code)
-
-	var apiResp dto.CacheClearResponse
+	w.AssertStatus(http.StatusOK)
 
-	err := json.Unmarshal(w.Body.Bytes(), &apiResp)
-	require.NoError(t, err)
+	apiResp := servertest.DecodeJSON[dto.CacheClearResponse](w)
 	assert.Equal(t, 100, apiResp.ClearedCount)
 	assert.Equal(t, pattern, apiResp.Pattern)
 
@@ -0,0 +1,48 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestAdminNotes_HiddenFromUsers(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+
+	err = store.CreateNote(t.Context(), alice, &dto.AdminNote{AuthorID: bob.String(), Text: "Chargeback pending"})
+	require.NoError(t, err)
+
+	// Without the admin scope every note and audit route is forbidden, even on one's own account
+	notes := servertest.Path("admin", "users", alice.String(), "notes")
+	srv.Get(notes).As(alice).Do(t).AssertStatus(http.StatusForbidden)
+	srv.Post(notes, map[string]any{"text": "Looks fine"}).As(bob).Do(t).AssertStatus(http.StatusForbidden)
+	srv.Put(notes+"/1", map[string]any{"pinned": true}).As(bob).Do(t).AssertStatus(http.StatusForbidden)
+	srv.Delete(notes + "/1").As(bob).Do(t).AssertStatus(http.StatusForbidden)
+	srv.Get(servertest.Path("admin", "users", alice.String(), "audit")).As(alice).Do(t).
+		AssertStatus(http.StatusForbidden)
+
+	// User-facing endpoints never include notes
+	for _, path := range []string{
+		servertest.Path("users", "me", "profile"),
+		servertest.Path("users", "account", "privacy-report"),
+	} {
+		w := srv.Get(path).As(alice).Do(t)
+		w.AssertStatus(http.StatusOK)
+		require.NotContains(t, w.Body.String(), "Chargeback pending")
+	}
+}
@@ -0,0 +1,100 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+// newAdminStatsServer serves the users of newUsersMeServer, with alice as an admin.
+func newAdminStatsServer(t *testing.T) (*servertest.Server, uuid.UUID) {
+	t.Helper()
+
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}},
+		Follows: []fixtures.Follow{
+			{Follower: "bob", Followee: "alice"},
+			{Follower: "alice", Followee: "carol"},
+		},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	alice, _ := f.UserID("alice")
+	require.NoError(t, store.SetUserRole(t.Context(), alice, alice, dto.UserRoleAdmin))
+
+	return servertest.New(t, servertest.WithMemoryStore(store), servertest.WithRoles(store)), alice
+}
+
+func TestAdminStats_RequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	bob, _ := f.UserID("bob")
+
+	srv.Get(servertest.Path("admin", "stats")).As(bob).Do(t).AssertError(http.StatusForbidden, "FORBIDDEN")
+}
+
+func TestAdminStats_DefaultRange(t *testing.T) {
+	t.Parallel()
+
+	srv, alice := newAdminStatsServer(t)
+
+	w := srv.Get(servertest.Path("admin", "stats")).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	stats := servertest.DecodeJSON[dto.AdminStatsResponse](w)
+	assert.Equal(t, dto.StatsIntervalDay, stats.Interval)
+	assert.Equal(t, dto.AdminStatsTotals{TotalUsers: 3, ActiveUsers: 3, FollowEdges: 2}, stats.Totals)
+	require.Len(t, stats.Series, 31)
+
+	today := stats.Series[len(stats.Series)-1]
+	assert.True(t, today.Start.Equal(dto.StatsIntervalDay.Truncate(time.Now())))
+	assert.Equal(t, 3, today.Signups)
+	assert.Equal(t, 2, today.NewFollows)
+	assert.Len(t, stats.PreferenceAdoption, len(dto.ValidPreferenceCategories))
+}
+
+func TestAdminStats_InvalidParameters(t *testing.T) {
+	t.Parallel()
+
+	srv, alice := newAdminStatsServer(t)
+
+	for name, query := range map[string]string{
+		"interval":       "?interval=month",
+		"from":           "?from=yesterday",
+		"reversed":       "?from=2026-10-10&to=2026-10-01",
+		"range too long": "?from=2024-01-01&to=2026-01-01",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			w := srv.Get(servertest.Path("admin", "stats") + query).As(alice).Do(t)
+			w.AssertStatus(http.StatusBadRequest)
+		})
+	}
+}
+
+func TestAdminStats_WeeklyDateRange(t *testing.T) {
+	t.Parallel()
+
+	srv, alice := newAdminStatsServer(t)
+
+	w := srv.Get(servertest.Path("admin", "stats") + "?from=2026-10-07&to=2026-10-14&interval=week").As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	stats := servertest.DecodeJSON[dto.AdminStatsResponse](w)
+	assert.True(t, stats.From.Equal(time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, stats.To.Equal(time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)))
+	assert.Len(t, stats.Series, 2)
+}
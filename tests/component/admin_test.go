@@ -1,43 +1,67 @@
 package component_test
 
 import (
-	"encoding/json"
 	"net/http"
-	"net/http/httptest"
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 )
 
-func TestGetUserStatsComponent_Success(t *testing.T) {
+// adminRoles is a role repository in which adminID is an admin and everyone else a plain user.
+func adminRoles(t *testing.T, adminID uuid.UUID) *mocks.RoleRepository {
+	t.Helper()
+
+	roles := mocks.NewRoleRepository(t)
+	roles.On("GetUserRole", mock.Anything, adminID).Return(dto.UserRoleAdmin, nil).Maybe()
+	roles.On("GetUserRole", mock.Anything, mock.Anything).Return(dto.UserRoleUser, nil).Maybe()
+
+	return roles
+}
+
+func TestAdminRoutes_RequireAdmin(t *testing.T) {
 	t.Parallel()
 
-	// Setup Mocks
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
+	mockRepo := new(mocks.UserRepository)
+	mockAdminRedis := new(mocks.RedisCacheClient)
 
-	// Create Service
-	userService := service.NewUserService(mockRepo, mockTokenStore, nil)
+	srv := servertest.New(
+		t,
+		servertest.WithRepositories(mockRepo, nil, new(mocks.TokenStore)),
+		servertest.WithRoles(adminRoles(t, uuid.New())),
+		servertest.WithAdminService(service.NewAdminService(mockAdminRedis)),
+	)
 
-	// Create Container
-	c := &app.Container{
-		UserService: userService,
-		Config:      config.Instance,
-	}
-	// Setup Health so router doesn't panic
-	c.HealthService = service.NewHealthService(nil, nil)
+	user := uuid.New()
+
+	srv.Get(servertest.Path("admin/users/stats")).As(user).Do(t).AssertError(http.StatusForbidden, "FORBIDDEN")
+	srv.Post(servertest.Path("admin/cache/clear"), nil).As(user).Do(t).AssertError(http.StatusForbidden, "FORBIDDEN")
+	srv.Get(servertest.Path("admin/stats")).As(user).Do(t).AssertError(http.StatusForbidden, "FORBIDDEN")
+	srv.Post(servertest.Path("admin/search/reindex"), nil).As(user).Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+	srv.Get(servertest.Path("admin/jobs")).As(user).Do(t).AssertError(http.StatusForbidden, "FORBIDDEN")
+	srv.Get(servertest.Path("admin/change-requests")).As(user).Do(t).AssertError(http.StatusForbidden, "FORBIDDEN")
+
+	mockRepo.AssertNotCalled(t, "GetUserStats", mock.Anything)
+	mockAdminRedis.AssertNotCalled(t, "ClearCache", mock.Anything, mock.Anything)
+}
+
+func TestGetUserStatsComponent_Success(t *testing.T) {
+	t.Parallel()
 
-	// Create Server
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	// Setup Mocks
+	adminID := uuid.New()
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore),
+		servertest.WithRoles(adminRoles(t, adminID)))
 
 	// Mock Expectation
 	expectedStats := &dto.UserStatsResponse{
@@ -52,18 +76,12 @@ func TestGetUserStatsComponent_Success(t *testing.T) {
 	mockRepo.On("GetUserStats", mock.Anything).Return(expectedStats, nil)
 
 	// Execute
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/admin/users/stats", nil)
-	req.Header.Set("X-User-Id", uuid.New().String())
-	w := httptest.NewRecorder()
-	handler.ServeHTTP(w, req)
+	w := srv.Get(servertest.Path("admin/users/stats")).As(adminID).Do(t)
 
 	// Assert
-	assert.Equal(t, http.StatusOK, w.Code)
-
-	var apiResp dto.UserStatsResponse
+	w.AssertStatus(http.StatusOK)
 
-	err := json.Unmarshal(w.Body.Bytes(), &apiResp)
-	require.NoError(t, err)
+	apiResp := servertest.DecodeJSON[dto.UserStatsResponse](w)
 	assert.Equal(t, expectedStats, &apiResp)
 
 	mockRepo.AssertExpectations(t)
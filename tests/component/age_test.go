@@ -0,0 +1,100 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestAge_MinorRestrictions(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	birthdate := servertest.Path("users", "account", "birthdate")
+	privacy := servertest.Path("users", "me", "preferences", "privacy")
+
+	w := srv.Put(birthdate, map[string]any{"birthdate": time.Now().AddDate(-14, 0, 0).Format(time.DateOnly)}).
+		As(alice).
+		Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	status := servertest.DecodeJSON[dto.AgeStatusResponse](w)
+	assert.True(t, status.IsMinor)
+	assert.Equal(t, dto.MinorRestrictions, status.Restrictions)
+
+	// The profile is made private and has to stay that way
+	srv.Get(privacy).As(alice).Do(t).AssertStatus(http.StatusOK).AssertBodyContains(`"profileVisibility":"PRIVATE"`)
+
+	srv.Put(privacy, map[string]any{"profileVisibility": "PUBLIC"}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusForbidden, "AGE_RESTRICTED")
+
+	// Restoring the defaults keeps the profile private
+	srv.Delete(privacy).As(alice).Do(t).AssertStatus(http.StatusOK).AssertBodyContains(`"profileVisibility":"PRIVATE"`)
+
+	srv.Post(servertest.Path("users", "me", "consents")+"/", map[string]any{
+		"purpose":       "marketing_emails",
+		"granted":       true,
+		"policyVersion": "2026-10",
+	}).As(alice).Do(t).AssertError(http.StatusForbidden, "AGE_RESTRICTED")
+
+	// Minors are left out of search
+	w = srv.Get(servertest.Path("users", "search") + "?query=alice").As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.Zero(t, servertest.DecodeJSON[dto.UserSearchResponse](w).TotalCount)
+
+	// Only an admin can change the outcome once the birthdate is set
+	srv.Put(birthdate, map[string]any{"birthdate": "1990-01-01"}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusConflict, "BIRTHDATE_ALREADY_SET")
+
+	srv.Put(servertest.Path("admin", "users", alice.String(), "age", "override"), map[string]any{"override": "adult"}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusForbidden)
+}
+
+func TestAge_Validation(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	bob, _ := f.UserID("bob")
+	birthdate := servertest.Path("users", "account", "birthdate")
+
+	srv.Put(birthdate, map[string]any{"birthdate": "17/04/2001"}).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusBadRequest, "VALIDATION_ERROR")
+
+	srv.Put(birthdate, map[string]any{"birthdate": time.Now().AddDate(0, 0, 2).Format(time.DateOnly)}).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusBadRequest, "INVALID_BIRTHDATE")
+
+	// Adults keep their settings and stay searchable
+	srv.Put(birthdate, map[string]any{"birthdate": "1990-01-01"}).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusOK).
+		AssertBodyContains(`"isMinor":false`)
+
+	w := srv.Get(birthdate).As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	status := servertest.DecodeJSON[dto.AgeStatusResponse](w)
+	assert.Equal(t, "1990-01-01", status.Birthdate)
+	assert.Empty(t, status.Restrictions)
+
+	w = srv.Get(servertest.Path("users", "search") + "?query=bob").As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.Equal(t, 1, servertest.DecodeJSON[dto.UserSearchResponse](w).TotalCount)
+}
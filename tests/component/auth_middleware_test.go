@@ -1,57 +1,41 @@
 package component_test
 
 import (
-	"context"
 	"net/http"
-	"net/http/httptest"
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
-	"github.com/stretchr/testify/assert"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
 )
 
-// authMockMetricsService is a mock implementation for testing.
-type authMockMetricsService struct{}
+// authMetricsServiceMock stubs the metrics calls the protected routes reach.
+func authMetricsServiceMock() *mocks.MetricsService {
+	m := new(mocks.MetricsService)
 
-func (m *authMockMetricsService) GetPerformanceMetrics(_ context.Context) (*dto.PerformanceMetricsResponse, error) {
-	return &dto.PerformanceMetricsResponse{
+	m.On("GetPerformanceMetrics", mock.Anything).Return(&dto.PerformanceMetricsResponse{
 		RequestCounts: dto.RequestCounts{TotalRequests: 100},
 		ResponseTimes: dto.ResponseTimes{AverageMs: 42.0},
-	}, nil
-}
-
-func (m *authMockMetricsService) GetCacheMetrics(_ context.Context) (*dto.CacheMetricsResponse, error) {
-	return &dto.CacheMetricsResponse{HitRate: 0.9}, nil
-}
-
-func (m *authMockMetricsService) GetSystemMetrics(_ context.Context) (*dto.SystemMetricsResponse, error) {
-	return &dto.SystemMetricsResponse{
+	}, nil).Maybe()
+	m.On("GetCacheMetrics", mock.Anything).Return(&dto.CacheMetricsResponse{HitRate: 0.9}, nil).Maybe()
+	m.On("GetSystemMetrics", mock.Anything).Return(&dto.SystemMetricsResponse{
 		System:  dto.SystemInfo{CPUUsagePercent: 25.5},
 		Process: dto.ProcessInfo{NumThreads: 10},
-	}, nil
-}
+	}, nil).Maybe()
+	m.On("GetDetailedHealthMetrics", mock.Anything).Return(
+		&dto.DetailedHealthMetricsResponse{OverallStatus: "healthy"}, nil,
+	).Maybe()
 
-func (m *authMockMetricsService) GetDetailedHealthMetrics(
-	_ context.Context,
-) (*dto.DetailedHealthMetricsResponse, error) {
-	return &dto.DetailedHealthMetricsResponse{OverallStatus: "healthy"}, nil
+	return m
 }
 
 func TestAuthMiddleware_HealthRoutes_NoAuthRequired(t *testing.T) {
 	t.Parallel()
 
-	c := &app.Container{
-		Config:        config.Instance,
-		HealthService: service.NewHealthService(nil, nil),
-	}
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t)
 
 	tests := []struct {
 		name           string
@@ -60,12 +44,12 @@ func TestAuthMiddleware_HealthRoutes_NoAuthRequired(t *testing.T) {
 	}{
 		{
 			name:           "health endpoint without auth",
-			endpoint:       "/api/v1/user-management/health",
+			endpoint:       servertest.BasePath + "/health",
 			expectedStatus: http.StatusOK,
 		},
 		{
 			name:           "ready endpoint without auth",
-			endpoint:       "/api/v1/user-management/ready",
+			endpoint:       servertest.BasePath + "/ready",
 			expectedStatus: http.StatusOK,
 		},
 	}
@@ -74,11 +58,9 @@ func TestAuthMiddleware_HealthRoutes_NoAuthRequired(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			req := httptest.NewRequest(http.MethodGet, tc.endpoint, nil)
-			w := httptest.NewRecorder()
-			handler.ServeHTTP(w, req)
+			w := srv.Get(tc.endpoint).Do(t)
 
-			assert.Equal(t, tc.expectedStatus, w.Code)
+			w.AssertStatus(tc.expectedStatus)
 		})
 	}
 }
@@ -86,19 +68,10 @@ func TestAuthMiddleware_HealthRoutes_NoAuthRequired(t *testing.T) {
 func TestAuthMiddleware_ProtectedRoutes_RequireAuth(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userService := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService:   userService,
-		Config:        config.Instance,
-		HealthService: service.NewHealthService(nil, nil),
-	}
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	tests := []struct {
 		name           string
@@ -108,19 +81,19 @@ func TestAuthMiddleware_ProtectedRoutes_RequireAuth(t *testing.T) {
 	}{
 		{
 			name:           "admin stats without auth",
-			endpoint:       "/api/v1/user-management/admin/users/stats",
+			endpoint:       servertest.BasePath + "/admin/users/stats",
 			method:         http.MethodGet,
 			expectedStatus: http.StatusUnauthorized,
 		},
 		{
 			name:           "users list without auth",
-			endpoint:       "/api/v1/user-management/users",
+			endpoint:       servertest.BasePath + "/users",
 			method:         http.MethodGet,
 			expectedStatus: http.StatusUnauthorized,
 		},
 		{
 			name:           "metrics without auth",
-			endpoint:       "/api/v1/user-management/metrics/performance",
+			endpoint:       servertest.BasePath + "/metrics/performance",
 			method:         http.MethodGet,
 			expectedStatus: http.StatusUnauthorized,
 		},
@@ -130,11 +103,9 @@ func TestAuthMiddleware_ProtectedRoutes_RequireAuth(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			req := httptest.NewRequest(tc.method, tc.endpoint, nil)
-			w := httptest.NewRecorder()
-			handler.ServeHTTP(w, req)
+			w := srv.NewRequest(tc.method, tc.endpoint, nil).Do(t)
 
-			assert.Equal(t, tc.expectedStatus, w.Code)
+			w.AssertStatus(tc.expectedStatus)
 		})
 	}
 }
@@ -142,21 +113,15 @@ func TestAuthMiddleware_ProtectedRoutes_RequireAuth(t *testing.T) {
 func TestAuthMiddleware_ProtectedRoutes_WithValidAuth(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	mockMetrics := &authMockMetricsService{}
-
-	userService := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService:    userService,
-		MetricsService: mockMetrics,
-		Config:         config.Instance,
-		HealthService:  service.NewHealthService(nil, nil),
-	}
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	mockMetrics := authMetricsServiceMock()
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(
+		t,
+		servertest.WithRepositories(mockRepo, nil, mockTokenStore),
+		servertest.WithMetricsService(mockMetrics),
+	)
 
 	tests := []struct {
 		name           string
@@ -166,13 +131,13 @@ func TestAuthMiddleware_ProtectedRoutes_WithValidAuth(t *testing.T) {
 	}{
 		{
 			name:           "metrics with valid auth",
-			endpoint:       "/api/v1/user-management/metrics/performance",
+			endpoint:       servertest.BasePath + "/metrics/performance",
 			method:         http.MethodGet,
 			expectedStatus: http.StatusOK,
 		},
 		{
 			name:           "metrics system with valid auth",
-			endpoint:       "/api/v1/user-management/metrics/system",
+			endpoint:       servertest.BasePath + "/metrics/system",
 			method:         http.MethodGet,
 			expectedStatus: http.StatusOK,
 		},
@@ -182,13 +147,9 @@ func TestAuthMiddleware_ProtectedRoutes_WithValidAuth(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			req := httptest.NewRequest(tc.method, tc.endpoint, nil)
-			req.Header.Set("X-User-Id", uuid.New().String())
-
-			w := httptest.NewRecorder()
-			handler.ServeHTTP(w, req)
+			w := srv.NewRequest(tc.method, tc.endpoint, nil).As(uuid.New()).Do(t)
 
-			assert.Equal(t, tc.expectedStatus, w.Code)
+			w.AssertStatus(tc.expectedStatus)
 		})
 	}
 }
@@ -196,13 +157,7 @@ func TestAuthMiddleware_ProtectedRoutes_WithValidAuth(t *testing.T) {
 func TestAuthMiddleware_InvalidUserID(t *testing.T) {
 	t.Parallel()
 
-	c := &app.Container{
-		Config:        config.Instance,
-		HealthService: service.NewHealthService(nil, nil),
-	}
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t)
 
 	tests := []struct {
 		name           string
@@ -230,15 +185,14 @@ func TestAuthMiddleware_InvalidUserID(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/admin/users/stats", nil)
+			req := srv.Get(servertest.Path("admin/users/stats"))
 			if tc.userIDHeader != "" {
-				req.Header.Set("X-User-Id", tc.userIDHeader)
+				req.WithHeader("X-User-Id", tc.userIDHeader)
 			}
 
-			w := httptest.NewRecorder()
-			handler.ServeHTTP(w, req)
+			w := req.Do(t)
 
-			assert.Equal(t, tc.expectedStatus, w.Code)
+			w.AssertStatus(tc.expectedStatus)
 		})
 	}
 }
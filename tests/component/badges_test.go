@@ -0,0 +1,87 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestBadges(t *testing.T) {
+	t.Parallel()
+
+	private := dto.ProfileVisibilityPrivate
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{
+			{Username: "alice"},
+			{Username: "bob"},
+			{Username: "carol", Preferences: &dto.UserPreferencesUpdateRequest{
+				Privacy: &dto.PrivacyPreferencesUpdate{ProfileVisibility: &private},
+			}},
+		},
+		Follows: []fixtures.Follow{{Follower: "bob", Followee: "alice"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t,
+		servertest.WithMemoryStore(store),
+		servertest.WithBadges(store, dto.BadgeDefinition{
+			BadgeID:   "followers-2",
+			Name:      "Two Followers",
+			Metric:    dto.BadgeMetricFollowers,
+			Threshold: 2,
+		}),
+	)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+
+	badges := func(userID string) []dto.Badge {
+		w := srv.Get(servertest.Path("users", userID, "badges")).As(bob).Do(t)
+		w.AssertStatus(http.StatusOK)
+
+		return servertest.DecodeJSON[dto.UserBadgesResponse](w).Badges
+	}
+
+	assert.Empty(t, badges(alice.String()))
+
+	// The second follower earns alice the badge
+	srv.Post(servertest.Path("users", carol.String(), "follow", alice.String()), nil).
+		As(carol).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	earned := badges(alice.String())
+	require.Len(t, earned, 1)
+	assert.Equal(t, "followers-2", earned[0].BadgeID)
+	assert.Equal(t, "Two Followers", earned[0].Name)
+
+	// Earned badges are part of the profile
+	w := srv.Get(servertest.Path("users", alice.String(), "profile")).As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.Equal(t, earned, servertest.DecodeJSON[dto.UserProfileResponse](w).Badges)
+
+	// Badges are as private as the profile
+	srv.Get(servertest.Path("users", carol.String(), "badges")).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusForbidden, "PROFILE_PRIVATE")
+
+	own := srv.Get(servertest.Path("users", "me", "badges")).As(carol).Do(t)
+	own.AssertStatus(http.StatusOK)
+	assert.Empty(t, servertest.DecodeJSON[dto.UserBadgesResponse](own).Badges)
+
+	// Events come from other services, with a service token
+	srv.Post("/internal/v1/users/"+alice.String()+"/events", map[string]any{"type": "recipe_created"}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusForbidden)
+}
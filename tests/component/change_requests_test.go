@@ -0,0 +1,92 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestChangeRequests_ModeratedAccount(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	adminID := uuid.New()
+	changeRequests := servertest.Path("users", "account", "change-requests")
+
+	// Accounts that are not moderated change their username directly
+	srv.Post(changeRequests, map[string]any{"field": "username", "value": "alice_new"}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusConflict, "NOT_UNDER_MODERATION")
+
+	require.NoError(t, store.SetUnderModeration(t.Context(), adminID, alice, true))
+
+	srv.Put(servertest.Path("users", "profile"), map[string]any{"username": "alice_new"}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusForbidden, "CHANGE_APPROVAL_REQUIRED")
+
+	srv.Post(servertest.Path("users", "account", "email-change"), map[string]any{"newEmail": "alice@example.com"}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusForbidden, "CHANGE_APPROVAL_REQUIRED")
+
+	srv.Post(changeRequests, map[string]any{"field": "username", "value": "no spaces"}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusBadRequest)
+
+	w := srv.Post(changeRequests, map[string]any{"field": "username", "value": "alice_new"}).As(alice).Do(t)
+	w.AssertStatus(http.StatusCreated)
+
+	request := servertest.DecodeJSON[dto.ChangeRequest](w)
+	assert.Equal(t, dto.ChangeRequestStatusPending, request.Status)
+	assert.Equal(t, "alice", request.OldValue)
+
+	srv.Post(changeRequests, map[string]any{"field": "username", "value": "alice_other"}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusConflict, "CHANGE_REQUEST_PENDING")
+
+	// Deciding is admin-only
+	srv.Get(servertest.Path("admin", "change-requests")).As(alice).Do(t).AssertStatus(http.StatusForbidden)
+	srv.Post(servertest.Path("admin", "change-requests", "1", "approve"), nil).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusForbidden)
+
+	_, err = store.ApproveChangeRequest(t.Context(), adminID, request.RequestID)
+	require.NoError(t, err)
+
+	w = srv.Get(changeRequests).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	requests := servertest.DecodeJSON[dto.ChangeRequestsResponse](w).Requests
+	require.Len(t, requests, 1)
+	assert.Equal(t, dto.ChangeRequestStatusApproved, requests[0].Status)
+
+	srv.Get(servertest.Path("users", "by-username", "alice_new")).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	audit, err := store.GetAuditTrail(t.Context(), alice, 10)
+	require.NoError(t, err)
+	require.Len(t, audit, 2)
+	assert.Equal(t, dto.AuditActionChangeRequestApproved, audit[0].Action)
+	assert.Equal(t, dto.AuditActionModerationEnabled, audit[1].Action)
+}
@@ -0,0 +1,76 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestCloseFriends_ActivityVisibility(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{
+		Users:   []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}, {Username: "dave"}},
+		Follows: []fixtures.Follow{{Follower: "alice", Followee: "bob"}, {Follower: "carol", Followee: "bob"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+	dave, _ := f.UserID("dave")
+
+	privacy := servertest.Path("users", bob.String(), "preferences", "privacy")
+
+	// CLOSE_FRIENDS is an activity visibility level only
+	srv.Put(privacy, map[string]any{"profileVisibility": "CLOSE_FRIENDS"}).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusBadRequest)
+
+	srv.Put(privacy, map[string]any{"activityVisibility": "CLOSE_FRIENDS"}).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	srv.Put(servertest.Path("users", bob.String(), "close-friends", alice.String()), nil).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusNoContent)
+
+	// Only followers can be close friends
+	srv.Put(servertest.Path("users", bob.String(), "close-friends", dave.String()), nil).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusConflict, "NOT_A_FOLLOWER")
+
+	w := srv.Get(servertest.Path("users", bob.String(), "close-friends")).As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	list := servertest.DecodeJSON[dto.GetFollowedUsersResponse](w)
+	require.Len(t, list.FollowedUsers, 1)
+	assert.Equal(t, "alice", list.FollowedUsers[0].Username)
+
+	srv.Get(servertest.Path("users", bob.String(), "close-friends")).As(alice).Do(t).AssertStatus(http.StatusForbidden)
+
+	// A close friend sees bob's activity; a follower who is not one does not
+	activity := servertest.Path("users", bob.String(), "activity")
+	srv.Get(activity).As(alice).Do(t).AssertStatus(http.StatusOK)
+	srv.Get(activity).As(carol).Do(t).AssertStatus(http.StatusForbidden)
+
+	srv.Delete(servertest.Path("users", bob.String(), "close-friends", alice.String())).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusNoContent)
+	srv.Get(activity).As(alice).Do(t).AssertStatus(http.StatusForbidden)
+}
@@ -0,0 +1,88 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestConsents_GateConsentedPreferences(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	notification := servertest.Path("users", alice.String(), "preferences", "notification")
+	consents := servertest.Path("users", alice.String(), "consents") + "/"
+
+	// Enabling marketing emails needs a recorded grant
+	srv.Put(notification, map[string]any{"marketingEmails": true}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusConflict, "CONSENT_REQUIRED")
+
+	w := srv.Post(consents, map[string]any{
+		"purpose":       "marketing_emails",
+		"granted":       true,
+		"policyVersion": "2026-10",
+	}).As(alice).Do(t)
+	w.AssertStatus(http.StatusCreated)
+
+	granted := servertest.DecodeJSON[dto.ConsentRecord](w)
+	assert.Equal(t, dto.ConsentSourceUI, granted.Source)
+	assert.False(t, granted.RecordedAt.IsZero())
+
+	srv.Put(notification, map[string]any{"marketingEmails": true}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	// Withdrawing the consent turns the preference off again
+	srv.Post(consents, map[string]any{
+		"purpose":       "marketing_emails",
+		"granted":       false,
+		"policyVersion": "2026-10",
+	}).As(alice).Do(t).AssertStatus(http.StatusCreated)
+
+	w = srv.Get(notification).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+	w.AssertBodyContains(`"marketingEmails":false`)
+
+	w = srv.Get(consents).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	current := servertest.DecodeJSON[dto.ConsentsResponse](w)
+	require.Len(t, current.Consents, 1)
+	assert.False(t, current.Consents[0].Granted)
+
+	w = srv.Get(servertest.Path("users", "me", "consents", "history")).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	history := servertest.DecodeJSON[dto.ConsentHistoryResponse](w)
+	require.Len(t, history.Records, 2)
+	assert.False(t, history.Records[0].Granted, "newest first")
+	assert.True(t, history.Records[1].Granted)
+}
+
+func TestConsents_Validation(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+
+	srv.Post(servertest.Path("users", alice.String(), "consents")+"/", map[string]any{
+		"purpose":       "telemetry",
+		"granted":       true,
+		"policyVersion": "2026-10",
+	}).As(alice).Do(t).AssertError(http.StatusBadRequest, "VALIDATION_ERROR")
+
+	srv.Get(servertest.Path("users", alice.String(), "consents") + "/").
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusForbidden)
+}
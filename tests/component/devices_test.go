@@ -0,0 +1,78 @@
+package component_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestDevices_RegisterListUnregister(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	devices := servertest.Path("users", "account", "devices")
+
+	srv.Post(devices, map[string]any{"platform": "blackberry", "token": "t"}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusBadRequest)
+
+	w := srv.Post(devices, map[string]any{"platform": "ios", "token": "apns-token", "appVersion": "3.1.0"}).
+		As(alice).
+		Do(t)
+	w.AssertStatus(http.StatusCreated)
+
+	registered := servertest.DecodeJSON[dto.DeviceToken](w)
+	assert.Equal(t, dto.DevicePlatformIOS, registered.Platform)
+
+	// Registering the same token again refreshes it instead of adding a device
+	w = srv.Post(devices, map[string]any{"platform": "ios", "token": "apns-token", "appVersion": "3.2.0"}).
+		As(alice).
+		Do(t)
+	w.AssertStatus(http.StatusCreated)
+
+	refreshed := servertest.DecodeJSON[dto.DeviceToken](w)
+	assert.Equal(t, registered.DeviceID, refreshed.DeviceID)
+	assert.Equal(t, "3.2.0", refreshed.AppVersion)
+
+	w = srv.Get(devices).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	list := servertest.DecodeJSON[dto.DeviceTokensResponse](w)
+	require.Len(t, list.Devices, 1)
+
+	deviceID := servertest.Path("users", "account", "devices", strconv.FormatInt(registered.DeviceID, 10))
+	srv.Delete(deviceID).As(alice).Do(t).AssertStatus(http.StatusNoContent)
+	srv.Delete(deviceID).As(alice).Do(t).AssertError(http.StatusNotFound, "DEVICE_NOT_FOUND")
+}
+
+func TestDevices_PushTargetsRequireServiceScope(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+
+	srv.Get("/internal/v1/users/" + alice.String() + "/push-tokens").
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusForbidden)
+}
@@ -0,0 +1,79 @@
+package component_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+// fakeDirectory serves directory entries keyed by external ID.
+type fakeDirectory map[string]dto.DirectoryEntry
+
+func (d fakeDirectory) LookupEntries(_ context.Context, externalIDs []string) (map[string]dto.DirectoryEntry, error) {
+	entries := make(map[string]dto.DirectoryEntry)
+
+	for _, id := range externalIDs {
+		if entry, ok := d[id]; ok {
+			entries[id] = entry
+		}
+	}
+
+	return entries, nil
+}
+
+func TestDirectorySync(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	fullName := "Alice Directory"
+	srv := servertest.New(t,
+		servertest.WithMemoryStore(store),
+		servertest.WithDirectory(store, fakeDirectory{
+			"alice.d": {DN: "uid=alice.d,dc=example,dc=com", DirectoryAttributes: dto.DirectoryAttributes{
+				FullName: &fullName,
+			}},
+		}),
+	)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+
+	// Links are managed by admins only
+	directory := servertest.Path("admin", "users", alice.String(), "directory")
+	srv.Get(directory).As(alice).Do(t).AssertStatus(http.StatusForbidden)
+	srv.Put(directory, map[string]any{"externalId": "alice.d"}).As(alice).Do(t).AssertStatus(http.StatusForbidden)
+	srv.Delete(directory).As(bob).Do(t).AssertStatus(http.StatusForbidden)
+	srv.Post(directory+"/sync", nil).As(alice).Do(t).AssertStatus(http.StatusForbidden)
+
+	_, err = store.LinkDirectoryEntry(t.Context(), uuid.New(), alice, "alice.d")
+	require.NoError(t, err)
+
+	_, err = store.LinkDirectoryEntry(t.Context(), uuid.New(), bob, "bob.gone")
+	require.NoError(t, err)
+
+	synced, err := srv.Container.DirectorySyncService.(*service.DirectorySyncServiceImpl).SyncAll(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 2, synced)
+
+	// The directory name shows on the profile; bob, missing from the directory, is left alone
+	w := srv.Get(servertest.Path("users", "me", "profile")).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.Equal(t, fullName, *servertest.DecodeJSON[dto.UserProfileResponse](w).FullName)
+
+	link, err := store.GetDirectoryLink(t.Context(), bob)
+	require.NoError(t, err)
+	assert.Equal(t, dto.DirectorySyncNotFound, link.Status)
+}
@@ -0,0 +1,58 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestDiscoverable(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{
+		Users:   []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}},
+		Follows: []fixtures.Follow{{Follower: "alice", Followee: "bob"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+
+	search := func() []string {
+		w := srv.Get(servertest.Path("users/search?query=a")).As(carol).Do(t)
+		w.AssertStatus(http.StatusOK)
+
+		var usernames []string
+		for _, result := range servertest.DecodeJSON[dto.UserSearchResponse](w).Results {
+			usernames = append(usernames, result.Username)
+		}
+
+		return usernames
+	}
+
+	assert.Contains(t, search(), "alice")
+
+	srv.Put(servertest.Path("users", alice.String(), "preferences", "privacy"), map[string]any{"discoverable": false}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	assert.NotContains(t, search(), "alice")
+
+	// Exact lookups still work for people alice follows
+	byUsername := servertest.Path("users", "by-username", "alice")
+	srv.Get(byUsername).As(bob).Do(t).AssertStatus(http.StatusOK)
+	srv.Get(byUsername).As(alice).Do(t).AssertStatus(http.StatusOK)
+	srv.Get(byUsername).As(carol).Do(t).AssertError(http.StatusNotFound, "USER_NOT_FOUND")
+}
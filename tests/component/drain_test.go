@@ -0,0 +1,31 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+
+	srv.Get(servertest.Path("ready")).Do(t).AssertStatus(http.StatusOK)
+
+	srv.Container.DrainService.StartDrain(t.Context(), service.DrainTriggerSignal)
+
+	// Readiness fails so the instance is taken out of rotation
+	srv.Get(servertest.Path("ready")).Do(t).AssertStatus(http.StatusServiceUnavailable).AssertBodyContains("DRAINING")
+
+	// Requests are still served while draining
+	srv.Get(servertest.Path("users", alice.String(), "followers")).As(bob).Do(t).AssertStatus(http.StatusOK)
+	srv.Post(servertest.Path("users", bob.String(), "follow", alice.String()), nil).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusOK)
+}
@@ -0,0 +1,60 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestProfileEmbeds(t *testing.T) {
+	t.Parallel()
+
+	private := dto.ProfileVisibilityPrivate
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{
+			{Username: "alice"},
+			{Username: "bob"},
+			{Username: "carol", Preferences: &dto.UserPreferencesUpdateRequest{
+				Privacy: &dto.PrivacyPreferencesUpdate{ProfileVisibility: &private},
+			}},
+		},
+		Follows: []fixtures.Follow{{Follower: "bob", Followee: "alice"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	cfg := *config.Instance
+	cfg.Embed.CacheTTL = 5 * time.Minute
+
+	srv := servertest.New(t, servertest.WithConfig(&cfg), servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	carol, _ := f.UserID("carol")
+
+	// Link preview crawlers read public profiles anonymously
+	w := srv.Get(servertest.Path("users", alice.String(), "embed")).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+
+	embed := servertest.DecodeJSON[dto.ProfileEmbedResponse](w)
+	assert.Equal(t, "alice", embed.Username)
+	assert.Equal(t, 1, embed.FollowerCount)
+
+	// Other profiles look missing, even to their owner
+	srv.Get(servertest.Path("users", carol.String(), "embed")).
+		As(carol).
+		Do(t).
+		AssertError(http.StatusNotFound, "USER_NOT_FOUND")
+
+	// Routes under /users still need authentication
+	srv.Get(servertest.Path("users", alice.String(), "profile")).Do(t).AssertStatus(http.StatusUnauthorized)
+}
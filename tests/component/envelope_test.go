@@ -0,0 +1,161 @@
+package component_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+var routeParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// TestResponseEnvelope_EveryRoute calls every route, anonymously and as a user, and checks each
+// response has the standard shape: the resource as JSON on success and dto.Error on failure.
+func TestResponseEnvelope_EveryRoute(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	carol, _ := f.UserID("carol")
+
+	routes, ok := srv.Handler.(chi.Routes)
+	require.True(t, ok, "the server handler is the router")
+
+	fill := func(route string) string {
+		return routeParam.ReplaceAllStringFunc(route, func(param string) string {
+			switch name := strings.Trim(param, "{}"); {
+			case name == "user_id":
+				return alice.String()
+			case strings.HasSuffix(name, "user_id"):
+				return carol.String()
+			case strings.HasSuffix(name, "_id"):
+				return uuid.NewString()
+			default:
+				return "unknown"
+			}
+		})
+	}
+
+	err := chi.Walk(routes, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		// Prometheus scrapes its own text format
+		if route == "/metrics" {
+			return nil
+		}
+
+		var body any
+		if method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch {
+			body = "{}"
+		}
+
+		for _, requester := range []*uuid.UUID{nil, &alice} {
+			req := srv.NewRequest(method, fill(route), body)
+			if requester != nil {
+				req = req.As(*requester)
+			}
+
+			assertStandardResponse(t, method+" "+route, req.Do(t))
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assertStandardResponse(t, "unknown route", srv.Get(servertest.Path("widgets")).As(alice).Do(t))
+	assertStandardResponse(t, "wrong method", srv.NewRequest(http.MethodPatch, servertest.Path("ready"), nil).Do(t))
+}
+
+func assertStandardResponse(t *testing.T, name string, resp *servertest.Response) {
+	t.Helper()
+
+	if resp.Code == http.StatusNoContent || resp.Code == http.StatusNotModified {
+		assert.Empty(t, resp.Body.String(), "%s: %d has no body", name, resp.Code)
+
+		return
+	}
+
+	assert.Equal(t, "application/json", resp.Header().Get("Content-Type"), "%s: content type", name)
+
+	if resp.Code < http.StatusBadRequest {
+		assert.True(t, json.Valid(resp.Body.Bytes()), "%s: %d body is not JSON: %s", name, resp.Code, resp.Body)
+
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(resp.Body.Bytes()))
+	decoder.DisallowUnknownFields()
+
+	var body dto.Error
+	if assert.NoError(t, decoder.Decode(&body), "%s: %d body is not an error: %s", name, resp.Code, resp.Body) {
+		assert.NotEmpty(t, body.Code, "%s: error code", name)
+		assert.NotEmpty(t, body.Message, "%s: error message", name)
+	}
+}
+
+func TestValidationErrors_ListFields(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	tests := []struct {
+		name string
+		req  *servertest.Request
+		code string
+		want dto.FieldError
+	}{
+		{
+			name: "body rule",
+			req:  srv.Put(servertest.Path("users", "profile"), `{"username": "ab"}`),
+			code: "VALIDATION_ERROR",
+			want: dto.FieldError{
+				Field: "username", Code: "min", Message: "must be at least 3 characters", RejectedValue: "ab",
+			},
+		},
+		{
+			name: "body type",
+			req:  srv.Put(servertest.Path("users", "profile"), `{"username": 42}`),
+			code: "INVALID_JSON",
+			want: dto.FieldError{Field: "username", Code: "type", Message: "must be a string"},
+		},
+		{
+			name: "query",
+			req:  srv.Get(servertest.Path("users", "search") + "?limit=500"),
+			code: "VALIDATION_ERROR",
+			want: dto.FieldError{
+				Field: "limit", Code: "lte", Message: "must be less than or equal to 100", RejectedValue: "500",
+			},
+		},
+		{
+			name: "path",
+			req:  srv.Get(servertest.Path("users", "not-a-uuid", "following")),
+			code: "VALIDATION_ERROR",
+			want: dto.FieldError{
+				Field: "user_id", Code: "uuid", Message: "must be a valid UUID", RejectedValue: "not-a-uuid",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := tt.req.As(alice).Do(t)
+			require.GreaterOrEqual(t, resp.Code, http.StatusBadRequest)
+
+			body := servertest.DecodeJSON[dto.Error](resp)
+			assert.Equal(t, tt.code, body.Code)
+			assert.Equal(t, []dto.FieldError{tt.want}, body.Errors)
+			assert.Equal(t, map[string]string{tt.want.Field: tt.want.Message}, body.Details)
+		})
+	}
+}
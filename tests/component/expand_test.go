@@ -0,0 +1,59 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestExpand_UserByID(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+
+	path := servertest.Path("users", alice.String()) + "?expand=privacy,stats,recentActivity"
+
+	self := servertest.DecodeJSON[dto.UserDetailsResponse](srv.Get(path).As(alice).Do(t).AssertStatus(http.StatusOK))
+	assert.Equal(t, "alice", self.Username)
+	require.NotNil(t, self.Privacy)
+	require.NotNil(t, self.Stats)
+	assert.Equal(t, dto.UserSocialStats{FollowerCount: 1, FollowingCount: 1}, *self.Stats)
+	require.NotNil(t, self.RecentActivity)
+	assert.Equal(t, alice.String(), self.RecentActivity.UserID)
+
+	// Privacy settings are only visible to their owner.
+	other := srv.Get(path).As(bob).Do(t).AssertStatus(http.StatusOK).AssertBodyNotContains(`"privacy"`)
+	assert.NotNil(t, servertest.DecodeJSON[dto.UserDetailsResponse](other).Stats)
+}
+
+func TestExpand_WithoutExpandKeepsPublicShape(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	srv.Get(servertest.Path("users", alice.String())).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK).
+		AssertBodyNotContains(`"stats"`, `"privacy"`, `"recentActivity"`)
+}
+
+func TestExpand_UnknownExpansion(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	srv.Get(servertest.Path("users", alice.String())+"?expand=friends").
+		As(alice).
+		Do(t).
+		AssertError(http.StatusBadRequest, "VALIDATION_ERROR")
+}
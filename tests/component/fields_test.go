@@ -0,0 +1,93 @@
+package component_test
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestFields_Profile(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	resp := srv.Get(servertest.Path("users", alice.String(), "profile") + "?fields=userId,username").
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	assert.JSONEq(t, `{"userId":"`+alice.String()+`","username":"alice"}`, resp.Body.String())
+	assert.Equal(t, `{"userId":"`+alice.String()+`","username":"alice"}`+"\n", resp.Body.String(),
+		"fields keep their declared order")
+}
+
+func TestFields_Followers(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	resp := srv.Get(servertest.Path("users", alice.String(), "followers") + "?fields=username").
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+	body := servertest.DecodeJSON[map[string]any](resp)
+
+	assert.InDelta(t, 1, body["totalCount"], 0, "envelope fields are kept")
+
+	users, ok := body["followedUsers"].([]any)
+	require.True(t, ok)
+	require.Len(t, users, 1)
+	assert.Equal(t, map[string]any{"username": "bob"}, users[0])
+}
+
+func TestFields_Search(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	resp := srv.Get(servertest.Path("users", "search") + "?query=car&fields=userId,%20username").
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+	body := servertest.DecodeJSON[map[string]any](resp)
+
+	assert.ElementsMatch(t, []string{"results", "totalCount", "limit", "offset"}, slices.Collect(maps.Keys(body)))
+
+	results, ok := body["results"].([]any)
+	require.True(t, ok)
+	require.NotEmpty(t, results)
+
+	for _, result := range results {
+		item, ok := result.(map[string]any)
+		require.True(t, ok)
+		assert.ElementsMatch(t, []string{"userId", "username"}, slices.Collect(maps.Keys(item)))
+	}
+}
+
+func TestFields_UnknownField(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	for _, path := range []string{
+		servertest.Path("users", alice.String(), "profile"),
+		servertest.Path("users", "me", "followers"),
+		servertest.Path("users", "search"),
+	} {
+		srv.Get(path+"?fields=username,password").
+			As(alice).
+			Do(t).
+			AssertError(http.StatusBadRequest, "VALIDATION_ERROR").
+			AssertBodyContains("unknown field: password")
+	}
+}
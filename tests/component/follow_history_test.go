@@ -0,0 +1,50 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestFollowHistory_RecordsChurn(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+
+	srv.Post(servertest.Path("users", carol.String(), "follow", alice.String()), nil).
+		As(carol).
+		Do(t).
+		AssertStatus(http.StatusOK)
+	srv.Delete(servertest.Path("users", bob.String(), "follow", alice.String())).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	w := srv.Get(servertest.Path("users", "me", "followers", "history") + "?limit=2").As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	history := servertest.DecodeJSON[dto.FollowHistoryResponse](w)
+	assert.Equal(t, 3, history.TotalCount)
+	require.Len(t, history.Events, 2)
+	assert.Equal(t, dto.FollowHistoryEntry{
+		UserID:     bob.String(),
+		Username:   "bob",
+		Event:      dto.FollowEventUnfollow,
+		OccurredAt: history.Events[0].OccurredAt,
+	}, history.Events[0])
+	assert.Equal(t, "carol", history.Events[1].Username)
+	assert.Equal(t, dto.FollowEventFollow, history.Events[1].Event)
+
+	srv.Get(servertest.Path("users", alice.String(), "followers", "history")).
+		As(carol).
+		Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+}
@@ -0,0 +1,76 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestFollowSettings_MuteAndList(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{
+		Users:   []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}},
+		Follows: []fixtures.Follow{{Follower: "alice", Followee: "bob"}, {Follower: "carol", Followee: "bob"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+
+	settingsPath := servertest.Path("users", alice.String(), "following", bob.String(), "settings")
+
+	w := srv.Put(settingsPath, map[string]any{"muted": true}).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	settings := servertest.DecodeJSON[dto.FollowSettings](w)
+	assert.Equal(t, dto.FollowSettings{Muted: true, NotifyNewRecipes: true, NotifyReviews: true}, settings)
+
+	// Only the follower can change the settings of their follow
+	srv.Put(settingsPath, map[string]any{"muted": false}).As(carol).Do(t).AssertStatus(http.StatusForbidden)
+
+	// Changing the settings of a follow that does not exist is a 404
+	srv.Put(servertest.Path("users", bob.String(), "following", alice.String(), "settings"), map[string]any{}).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusNotFound, "NOT_FOLLOWING")
+
+	// The settings show up in alice's own following list, but not in anyone else's view of it
+	w = srv.Get(servertest.Path("users", alice.String(), "following")).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	own := servertest.DecodeJSON[dto.GetFollowedUsersResponse](w)
+	require.Len(t, own.FollowedUsers, 1)
+	require.NotNil(t, own.FollowedUsers[0].FollowSettings)
+	assert.True(t, own.FollowedUsers[0].FollowSettings.Muted)
+
+	w = srv.Get(servertest.Path("users", alice.String(), "following")).As(carol).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	other := servertest.DecodeJSON[dto.GetFollowedUsersResponse](w)
+	require.Len(t, other.FollowedUsers, 1)
+	assert.Nil(t, other.FollowedUsers[0].FollowSettings)
+
+	// Alice muted bob, so only carol is notified of bob's new recipes
+	recipients, err := store.GetNotificationRecipients(t.Context(), bob, dto.FollowNotificationEventNewRecipe)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{carol}, recipients)
+
+	// The recipient list is for the notification service, not for users
+	srv.Get("/internal/v1/users/" + bob.String() + "/notification-recipients?event=new_recipe").
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusForbidden)
+}
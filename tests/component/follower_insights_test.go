@@ -0,0 +1,69 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestFollowerInsights(t *testing.T) {
+	t.Parallel()
+
+	private := dto.ProfileVisibilityPrivate
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{
+			{Username: "alice"},
+			{Username: "bob"},
+			{Username: "carol", Preferences: &dto.UserPreferencesUpdateRequest{
+				Privacy: &dto.PrivacyPreferencesUpdate{ProfileVisibility: &private},
+			}},
+			{Username: "dave"},
+		},
+		Follows: []fixtures.Follow{
+			{Follower: "bob", Followee: "alice"},
+			{Follower: "carol", Followee: "alice"},
+			{Follower: "dave", Followee: "alice"},
+		},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	dave, _ := f.UserID("dave")
+
+	srv.Delete(servertest.Path("users", dave.String(), "follow", alice.String())).
+		As(dave).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	w := srv.Get(servertest.Path("users", "me", "followers", "insights")).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	insights := servertest.DecodeJSON[dto.FollowerInsightsResponse](w)
+	assert.Equal(t, dto.FollowerInsightsTotals{Followers: 2, PublicProfileFollowers: 1}, insights.Totals)
+	assert.InDelta(t, 50.0, insights.PublicProfilePercent, 1e-9)
+	assert.Equal(t, 1, insights.RecentUnfollows)
+	require.Len(t, insights.Series, 31)
+
+	today := insights.Series[len(insights.Series)-1]
+	assert.True(t, today.Start.Equal(dto.StatsIntervalDay.Truncate(time.Now())))
+	assert.Equal(t, 3, today.NewFollowers)
+	assert.Equal(t, 1, today.Unfollows)
+
+	// Insights are private to their owner
+	srv.Get(servertest.Path("users", alice.String(), "followers", "insights")).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+}
@@ -9,12 +9,14 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
 )
 
 func TestHealthEndpoint(t *testing.T) {
 	t.Parallel()
 
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/api/v1/user-management/health", nil)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, servertest.BasePath+"/health", nil)
 	require.NoError(t, err)
 
 	rr := httptest.NewRecorder()
@@ -33,7 +35,7 @@ func TestHealthEndpoint(t *testing.T) {
 func TestReadyEndpoint(t *testing.T) {
 	t.Parallel()
 
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/api/v1/user-management/ready", nil)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, servertest.BasePath+"/ready", nil)
 	require.NoError(t, err)
 
 	rr := httptest.NewRecorder()
@@ -0,0 +1,115 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestInvitations(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}, {Username: "dave"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+	dave, _ := f.UserID("dave")
+	require.NoError(t, store.SetUserRole(t.Context(), dave, dave, dto.UserRoleAdmin))
+
+	srv := servertest.New(t,
+		servertest.WithMemoryStore(store),
+		servertest.WithInvitations(store, service.InvitationOptions{MaxPerUser: 2, TTL: time.Hour, MutualFollow: true}),
+		servertest.WithRoles(store),
+	)
+	invitationsPath := servertest.Path("users", "account", "invitations")
+
+	// Each user has a limited number of invitations
+	codes := make([]string, 0, 2)
+
+	for range 2 {
+		w := srv.Post(invitationsPath, nil).As(alice).Do(t)
+		w.AssertStatus(http.StatusCreated)
+		codes = append(codes, servertest.DecodeJSON[dto.Invitation](w).Code)
+	}
+
+	srv.Post(invitationsPath, nil).As(alice).Do(t).AssertError(http.StatusConflict, "INVITATION_LIMIT_REACHED")
+
+	w := srv.Get(invitationsPath).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	list := servertest.DecodeJSON[dto.InvitationsResponse](w)
+	assert.Len(t, list.Invitations, 2)
+	require.NotNil(t, list.Remaining)
+	assert.Equal(t, 0, *list.Remaining)
+
+	// Codes can be looked up before redeeming them
+	w = srv.Get(servertest.Path("invitations", codes[0])).As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.Equal(t, "alice", servertest.DecodeJSON[dto.Invitation](w).InviterUsername)
+
+	srv.Post(servertest.Path("invitations", codes[0], "redeem"), nil).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusBadRequest, "CANNOT_REDEEM_OWN_INVITATION")
+
+	// Redeeming makes the inviter and the invitee follow each other
+	w = srv.Post(servertest.Path("invitations", codes[0], "redeem"), nil).As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.True(t, servertest.DecodeJSON[dto.InvitationRedemption](w).MutualFollow)
+
+	for _, edge := range [][2]uuid.UUID{{alice, bob}, {bob, alice}} {
+		following, err := store.IsFollowing(t.Context(), edge[0], edge[1])
+		require.NoError(t, err)
+		assert.True(t, following)
+	}
+
+	srv.Post(servertest.Path("invitations", codes[0], "redeem"), nil).
+		As(carol).
+		Do(t).
+		AssertError(http.StatusConflict, "INVITATION_ALREADY_REDEEMED")
+	srv.Post(servertest.Path("invitations", codes[1], "redeem"), nil).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusConflict, "ALREADY_INVITED")
+
+	// Only the inviter sees who redeemed an invitation
+	w = srv.Get(servertest.Path("invitations", codes[0])).As(carol).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.Nil(t, servertest.DecodeJSON[dto.Invitation](w).RedeemedBy)
+
+	w = srv.Get(servertest.Path("invitations", codes[0])).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	invitation := servertest.DecodeJSON[dto.Invitation](w)
+	assert.Equal(t, dto.InvitationStatusRedeemed, invitation.Status)
+	require.NotNil(t, invitation.RedeemedBy)
+	assert.Equal(t, bob.String(), *invitation.RedeemedBy)
+
+	// Admins see who invites the most
+	srv.Get(servertest.Path("admin", "invitations", "stats")).As(alice).Do(t).AssertStatus(http.StatusForbidden)
+
+	w = srv.Get(servertest.Path("admin", "invitations", "stats")).As(dave).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	stats := servertest.DecodeJSON[dto.InvitationStatsResponse](w)
+	assert.Equal(t, int64(2), stats.Created)
+	assert.Equal(t, int64(1), stats.Redeemed)
+	require.Len(t, stats.TopInviters, 1)
+	assert.Equal(t, alice.String(), stats.TopInviters[0].UserID)
+}
@@ -0,0 +1,75 @@
+package component_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/scheduler"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/scheduler/cron"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestJobs(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	schedule, err := cron.Parse("0 3 * * *")
+	require.NoError(t, err)
+
+	var runs atomic.Int32
+
+	jobs := scheduler.New(nil)
+	require.NoError(t, jobs.Register(scheduler.Job{
+		Name:     "device-cleanup",
+		Schedule: schedule,
+		Run: func(context.Context) error {
+			runs.Add(1)
+
+			return nil
+		},
+	}))
+	jobs.Start()
+	t.Cleanup(jobs.Stop)
+
+	srv := servertest.New(t,
+		servertest.WithMemoryStore(store),
+		servertest.WithContainer(func(c *app.Container) { c.JobService = jobs }),
+	)
+	alice, _ := f.UserID("alice")
+
+	// Runs go through the scheduler, so a manual run does not overlap a scheduled one
+	job, err := srv.Container.JobService.RunJob(t.Context(), "device-cleanup")
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.TriggerAdmin, job.LastRun.Trigger)
+
+	require.Eventually(t, func() bool {
+		listed := srv.Container.JobService.ListJobs(t.Context())
+
+		return listed[0].LastRun.Outcome == dto.JobOutcomeSuccess && listed[0].NextRunAt != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), runs.Load())
+
+	assert.Equal(t, "0 3 * * *", srv.Container.JobService.ListJobs(t.Context())[0].Schedule)
+
+	// Without the admin scope the job routes are forbidden
+	srv.Get(servertest.Path("admin", "jobs")).As(alice).Do(t).AssertError(http.StatusForbidden, "FORBIDDEN")
+	srv.Post(servertest.Path("admin", "jobs", "device-cleanup", "run"), nil).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+	assert.Equal(t, int32(1), runs.Load())
+}
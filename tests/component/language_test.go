@@ -0,0 +1,67 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestErrorMessages_FollowAcceptLanguage(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		resp := srv.Put(servertest.Path("users", "profile"), `{"username": "ab"}`).
+			As(alice).WithHeader("Accept-Language", "es-MX,es;q=0.9,en;q=0.5").Do(t).
+			AssertError(http.StatusBadRequest, "VALIDATION_ERROR")
+
+		assert.Equal(t, "es", resp.Header().Get("Content-Language"))
+		assert.Contains(t, resp.Header().Values("Vary"), "Accept-Language")
+
+		body := servertest.DecodeJSON[dto.Error](resp)
+		assert.Equal(t, "La validación de la solicitud falló", body.Message)
+		assert.Equal(t, []dto.FieldError{{
+			Field: "username", Code: "min", Message: "debe tener al menos 3 caracteres", RejectedValue: "ab",
+		}}, body.Errors)
+		assert.Equal(t, map[string]string{"username": "debe tener al menos 3 caracteres"}, body.Details)
+	})
+
+	t.Run("handler errors", func(t *testing.T) {
+		t.Parallel()
+
+		resp := srv.Get(servertest.Path("users", uuid.NewString())).
+			As(alice).WithHeader("Accept-Language", "fr").Do(t).
+			AssertError(http.StatusNotFound, "USER_NOT_FOUND")
+
+		assert.Equal(t, "Utilisateur introuvable", servertest.DecodeJSON[dto.Error](resp).Message)
+	})
+
+	t.Run("middleware errors", func(t *testing.T) {
+		t.Parallel()
+
+		resp := srv.Get(servertest.Path("widgets")).WithHeader("Accept-Language", "es").Do(t).
+			AssertError(http.StatusNotFound, "NOT_FOUND")
+
+		assert.Equal(t, "Ruta no encontrada", servertest.DecodeJSON[dto.Error](resp).Message)
+	})
+
+	t.Run("unsupported languages fall back to English", func(t *testing.T) {
+		t.Parallel()
+
+		resp := srv.Get(servertest.Path("users", uuid.NewString())).
+			As(alice).WithHeader("Accept-Language", "de-DE, ja;q=0.8").Do(t).
+			AssertError(http.StatusNotFound, "USER_NOT_FOUND")
+
+		assert.Equal(t, "en", resp.Header().Get("Content-Language"))
+		assert.Equal(t, "User not found", servertest.DecodeJSON[dto.Error](resp).Message)
+	})
+}
@@ -0,0 +1,61 @@
+package component_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestListUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	alice, _ := f.UserID("alice")
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	unsubscribes := srv.Container.ListUnsubscribeService
+
+	headers, err := unsubscribes.GetListUnsubscribe(t.Context(), alice, dto.EmailCategoryActivitySummaries)
+	require.NoError(t, err)
+
+	// The header URL points at this service's one-click route
+	path, ok := strings.CutPrefix(headers.URL, "https://servertest.invalid")
+	require.True(t, ok, headers.URL)
+
+	oneClick := func(path, body string) *servertest.Response {
+		return srv.Post(path, body).
+			WithHeader("Content-Type", "application/x-www-form-urlencoded").
+			Do(t)
+	}
+
+	// Only the RFC 8058 body unsubscribes, so link scanners following the URL do not
+	oneClick(path, "").AssertError(http.StatusBadRequest, "INVALID_UNSUBSCRIBE_REQUEST")
+
+	oneClick(path, headers.ListUnsubscribePost).AssertStatus(http.StatusNoContent)
+
+	w := srv.Get(servertest.Path("users", alice.String(), "preferences", "notification")).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK).AssertBodyContains(`"activitySummaries":false`, `"recipeRecommendations":true`)
+
+	// Revoking preference center links revokes unsubscribe links too
+	srv.Delete(servertest.Path("users", "account", "preference-center-links")).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusNoContent)
+
+	oneClick(path, headers.ListUnsubscribePost).AssertError(http.StatusNotFound, "UNSUBSCRIBE_LINK_INVALID")
+
+	headers, err = unsubscribes.GetListUnsubscribe(t.Context(), alice, dto.EmailCategoryMarketing)
+	require.NoError(t, err)
+	assert.Equal(t, "List-Unsubscribe=One-Click", headers.ListUnsubscribePost)
+}
@@ -0,0 +1,43 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestMaintenanceMode(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	enabled := true
+
+	_, err := srv.Container.MaintenanceService.SetMaintenance(t.Context(), uuid.New(), &dto.MaintenanceRequest{
+		Enabled: &enabled,
+		Message: "Migrating follows, back in 10 minutes",
+	})
+	require.NoError(t, err)
+
+	// Writes are rejected
+	srv.Post(servertest.Path("users", bob.String(), "follow", alice.String()), nil).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusServiceUnavailable, "RETRY_LATER").
+		AssertBodyContains("Migrating follows, back in 10 minutes")
+
+	// Reads stay available
+	srv.Get(servertest.Path("users", alice.String(), "followers")).As(bob).Do(t).AssertStatus(http.StatusOK)
+
+	// The switch itself stays reachable so it can be turned off
+	srv.Put(servertest.Path("admin", "maintenance"), map[string]any{"enabled": false}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+}
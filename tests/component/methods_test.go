@@ -0,0 +1,80 @@
+package component_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestHead_MatchesGet(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	for _, path := range []string{
+		servertest.Path("health"),
+		servertest.Path("users", alice.String(), "profile"),
+		servertest.Path("users", "me", "followers"),
+	} {
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+
+			get := srv.Get(path).As(alice).Do(t).AssertStatus(http.StatusOK)
+			head := srv.NewRequest(http.MethodHead, path, nil).As(alice).Do(t).AssertStatus(http.StatusOK)
+
+			assert.Empty(t, head.Body.String())
+			assert.Equal(t, strconv.Itoa(get.Body.Len()), head.Header().Get("Content-Length"))
+			assert.Equal(t, get.Header().Get("Content-Length"), head.Header().Get("Content-Length"))
+			assert.Equal(t, get.Header().Get("Content-Type"), head.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestOptions_AdvertisesRouteMethods(t *testing.T) {
+	t.Parallel()
+
+	srv := servertest.New(t)
+	userID := uuid.NewString()
+
+	tests := []struct {
+		path  string
+		allow string
+	}{
+		{path: servertest.Path("users", userID, "profile"), allow: "GET, HEAD, OPTIONS"},
+		{path: servertest.Path("users", userID, "preferences", "theme"), allow: "GET, PUT, DELETE, HEAD, OPTIONS"},
+		{path: servertest.Path("users", userID, "follow", uuid.NewString()), allow: "POST, DELETE, OPTIONS"},
+		{path: servertest.Path("users", "me", "following"), allow: "GET, HEAD, OPTIONS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			// No credentials: OPTIONS describes the resource without requiring authentication.
+			resp := srv.NewRequest(http.MethodOptions, tt.path, nil).Do(t).AssertStatus(http.StatusNoContent)
+
+			assert.Equal(t, tt.allow, resp.Header().Get("Allow"))
+		})
+	}
+
+	srv.NewRequest(http.MethodOptions, servertest.Path("no-such-route"), nil).Do(t).AssertStatus(http.StatusNotFound)
+}
+
+func TestMethodNotAllowed_ListsAllowedMethods(t *testing.T) {
+	t.Parallel()
+
+	srv := servertest.New(t)
+
+	resp := srv.Delete(servertest.Path("users", uuid.NewString(), "profile")).
+		As(uuid.New()).
+		Do(t).
+		AssertError(http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED")
+
+	assert.Equal(t, "GET, HEAD, OPTIONS", resp.Header().Get("Allow"))
+}
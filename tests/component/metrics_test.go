@@ -1,109 +1,74 @@
 package component
 
 import (
-	"context"
-	"encoding/json"
 	"net/http"
-	"net/http/httptest"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/mock"
 
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
 	internalConfig "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
 )
 
-func TestMetricsEndpoint(t *testing.T) {
-	t.Parallel()
+// newMetricsServer builds the router around a mocked metrics service to avoid real DB connections.
+func newMetricsServer(t *testing.T) *servertest.Server {
+	t.Helper()
 
-	// We manually construct the container to avoid real DB connections
-	container := &app.Container{
-		Config: &internalConfig.Config{
+	return servertest.New(t,
+		servertest.WithConfig(&internalConfig.Config{
 			Server: internalConfig.ServerConfig{
 				Port: 8080,
 			},
-		},
-	}
-
-	// Mock the metrics service
-	mockMetricsSvc := &mockMetricsService{}
-	container.MetricsService = mockMetricsSvc
-
-	srv := server.NewServerWithContainer(container)
-
-	ts := httptest.NewServer(srv.Handler)
-	defer ts.Close()
-
-	// Make request
-	req, err := http.NewRequestWithContext(
-		context.Background(),
-		http.MethodGet,
-		ts.URL+"/api/v1/user-management/metrics/performance",
-		nil,
+		}),
+		servertest.WithMetricsService(metricsServiceMock()),
 	)
-	require.NoError(t, err)
-	req.Header.Set("X-User-Id", uuid.New().String())
-
-	resp, err := ts.Client().Do(req)
-	require.NoError(t, err)
+}
 
-	defer func() {
-		_ = resp.Body.Close()
-	}()
+func TestMetricsEndpoint(t *testing.T) {
+	t.Parallel()
 
-	// Assert
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp := newMetricsServer(t).Get(servertest.Path("metrics/performance")).As(uuid.New()).Do(t)
 
-	var response dto.PerformanceMetricsResponse
+	resp.AssertStatus(http.StatusOK)
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
-	require.NoError(t, err)
+	response := servertest.DecodeJSON[dto.PerformanceMetricsResponse](resp)
 
 	// Verify values from mock
 	assert.Equal(t, 100, response.RequestCounts.TotalRequests)
 	assert.InDelta(t, 42.0, response.ResponseTimes.AverageMs, 0.1)
 }
 
-// Mock service.
-type mockMetricsService struct{}
+// metricsServiceMock stubs every metrics call with fixed values.
+func metricsServiceMock() *mocks.MetricsService {
+	m := new(mocks.MetricsService)
 
-func (m *mockMetricsService) GetPerformanceMetrics(ctx context.Context) (*dto.PerformanceMetricsResponse, error) {
-	return &dto.PerformanceMetricsResponse{
+	m.On("GetPerformanceMetrics", mock.Anything).Return(&dto.PerformanceMetricsResponse{
 		RequestCounts: dto.RequestCounts{
 			TotalRequests: 100,
 		},
 		ResponseTimes: dto.ResponseTimes{
 			AverageMs: 42.0,
 		},
-	}, nil
-}
-
-func (m *mockMetricsService) GetCacheMetrics(ctx context.Context) (*dto.CacheMetricsResponse, error) {
-	return &dto.CacheMetricsResponse{
+	}, nil).Maybe()
+	m.On("GetCacheMetrics", mock.Anything).Return(&dto.CacheMetricsResponse{
 		MemoryUsage:      "1024",
 		MemoryUsageHuman: "1KB",
 		KeysCount:        100,
 		HitRate:          0.9,
-	}, nil
-}
-
-func (m *mockMetricsService) GetSystemMetrics(ctx context.Context) (*dto.SystemMetricsResponse, error) {
-	return &dto.SystemMetricsResponse{
+	}, nil).Maybe()
+	m.On("GetSystemMetrics", mock.Anything).Return(&dto.SystemMetricsResponse{
 		System: dto.SystemInfo{
 			CPUUsagePercent: 25.5,
 		},
 		Process: dto.ProcessInfo{
 			NumThreads: 10,
 		},
-	}, nil
-}
-
-func (m *mockMetricsService) GetDetailedHealthMetrics(ctx context.Context) (*dto.DetailedHealthMetricsResponse, error) {
-	return &dto.DetailedHealthMetricsResponse{
+	}, nil).Maybe()
+	m.On("GetDetailedHealthMetrics", mock.Anything).Return(&dto.DetailedHealthMetricsResponse{
 		OverallStatus: "healthy",
 		Services: dto.ServicesHealth{
 			Redis: dto.RedisHealth{
@@ -116,50 +81,19 @@ func (m *mockMetricsService) GetDetailedHealthMetrics(ctx context.Context) (*dto
 		Application: dto.ApplicationInfo{
 			Version: "1.0.0",
 		},
-	}, nil
+	}, nil).Maybe()
+
+	return m
 }
 
 func TestMetricsEndpoint_System(t *testing.T) {
 	t.Parallel()
 
-	container := &app.Container{
-		Config: &internalConfig.Config{
-			Server: internalConfig.ServerConfig{
-				Port: 8080,
-			},
-		},
-	}
-
-	mockMetricsSvc := &mockMetricsService{}
-	container.MetricsService = mockMetricsSvc
-
-	srv := server.NewServerWithContainer(container)
-
-	ts := httptest.NewServer(srv.Handler)
-	defer ts.Close()
-
-	req, err := http.NewRequestWithContext(
-		context.Background(),
-		http.MethodGet,
-		ts.URL+"/api/v1/user-management/metrics/system",
-		nil,
-	)
-	require.NoError(t, err)
-	req.Header.Set("X-User-Id", uuid.New().String())
+	resp := newMetricsServer(t).Get(servertest.Path("metrics/system")).As(uuid.New()).Do(t)
 
-	resp, err := ts.Client().Do(req)
-	require.NoError(t, err)
+	resp.AssertStatus(http.StatusOK)
 
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
-
-	var response dto.SystemMetricsResponse
-
-	err = json.NewDecoder(resp.Body).Decode(&response)
-	require.NoError(t, err)
+	response := servertest.DecodeJSON[dto.SystemMetricsResponse](resp)
 
 	assert.InDelta(t, 25.5, response.System.CPUUsagePercent, 0.01)
 	assert.Equal(t, 10, response.Process.NumThreads)
@@ -168,44 +102,11 @@ func TestMetricsEndpoint_System(t *testing.T) {
 func TestMetricsEndpointDetailedHealth(t *testing.T) {
 	t.Parallel()
 
-	container := &app.Container{
-		Config: &internalConfig.Config{
-			Server: internalConfig.ServerConfig{
-				Port: 8080,
-			},
-		},
-	}
-
-	mockMetricsSvc := &mockMetricsService{}
-	container.MetricsService = mockMetricsSvc
-
-	srv := server.NewServerWithContainer(container)
-
-	ts := httptest.NewServer(srv.Handler)
-	defer ts.Close()
-
-	req, err := http.NewRequestWithContext(
-		context.Background(),
-		http.MethodGet,
-		ts.URL+"/api/v1/user-management/metrics/health/detailed",
-		nil,
-	)
-	require.NoError(t, err)
-	req.Header.Set("X-User-Id", uuid.New().String())
-
-	resp, err := ts.Client().Do(req)
-	require.NoError(t, err)
-
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp := newMetricsServer(t).Get(servertest.Path("metrics/health/detailed")).As(uuid.New()).Do(t)
 
-	var response dto.DetailedHealthMetricsResponse
+	resp.AssertStatus(http.StatusOK)
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
-	require.NoError(t, err)
+	response := servertest.DecodeJSON[dto.DetailedHealthMetricsResponse](resp)
 
 	assert.Equal(t, "healthy", response.OverallStatus)
 	assert.Equal(t, "1.0.0", response.Application.Version)
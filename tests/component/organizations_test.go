@@ -0,0 +1,159 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/middleware"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestOrganizations(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}, {Username: "dave"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+	dave, _ := f.UserID("dave")
+
+	w := srv.Post(servertest.Path("organizations"), map[string]string{
+		"username": "tasty_kitchen",
+		"email":    "hello@tasty.example",
+		"fullName": "Tasty Kitchen",
+	}).As(alice).Do(t)
+	w.AssertStatus(http.StatusCreated)
+
+	org := servertest.DecodeJSON[dto.Organization](w)
+	assert.Equal(t, alice.String(), org.CreatedBy)
+
+	orgID := uuid.MustParse(org.OrgID)
+	membersPath := servertest.Path("organizations", org.OrgID, "members")
+
+	// The organization's account holds identifiers like any other
+	srv.Post(servertest.Path("organizations"), map[string]string{"username": "tasty_kitchen", "email": "x@y.example"}).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusConflict, "DUPLICATE_USERNAME")
+
+	// Only owners manage members
+	srv.Put(servertest.Path("organizations", org.OrgID, "members", bob.String()), map[string]string{"role": "editor"}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+	srv.Put(servertest.Path("organizations", org.OrgID, "members", carol.String()), map[string]string{"role": "editor"}).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusForbidden, "ORGANIZATION_OWNER_REQUIRED")
+	srv.Get(membersPath).As(carol).Do(t).AssertError(http.StatusForbidden, "NOT_ORGANIZATION_MEMBER")
+
+	w = srv.Get(membersPath).As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	members := servertest.DecodeJSON[dto.OrganizationMembersResponse](w).Members
+	require.Len(t, members, 2)
+	assert.Equal(t, dto.OrganizationRoleOwner, members[0].Role)
+	assert.Equal(t, "bob", members[1].Username)
+
+	// Editors act as the organization through the user routes
+	srv.Put(servertest.Path("users/profile"), map[string]string{"bio": "Recipes from our kitchen"}).
+		As(bob).
+		WithHeader(middleware.ActAsHeader, org.OrgID).
+		Do(t).
+		AssertStatus(http.StatusOK)
+	srv.Post(servertest.Path("users", org.OrgID, "follow", dave.String()), nil).
+		As(bob).
+		WithHeader(middleware.ActAsHeader, org.OrgID).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	following, err := store.IsFollowing(t.Context(), orgID, dave)
+	require.NoError(t, err)
+	assert.True(t, following)
+
+	// Non-members cannot
+	srv.Post(servertest.Path("users", org.OrgID, "follow", carol.String()), nil).
+		As(carol).
+		WithHeader(middleware.ActAsHeader, org.OrgID).
+		Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+
+	// Editors cannot delete as the organization; owners can
+	srv.Delete(servertest.Path("users", org.OrgID, "follow", dave.String())).
+		As(bob).
+		WithHeader(middleware.ActAsHeader, org.OrgID).
+		Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+	srv.Post(servertest.Path("users", org.OrgID, "follow", carol.String()), nil).
+		As(alice).
+		WithHeader(middleware.ActAsHeader, org.OrgID).
+		Do(t).
+		AssertStatus(http.StatusOK)
+	srv.Delete(servertest.Path("users", org.OrgID, "follow", carol.String())).
+		As(alice).
+		WithHeader(middleware.ActAsHeader, org.OrgID).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	// Routes about the account itself are closed to members acting as the organization
+	for _, req := range []*servertest.Request{
+		srv.Post(servertest.Path("users/account/delete-request"), nil),
+		srv.Delete(servertest.Path("users/account")),
+		srv.Post(servertest.Path("users/account/email-change"), map[string]string{"newEmail": "x@tasty.example"}),
+		srv.Post(servertest.Path("users/handle/reservation"), map[string]string{"handle": "tasty"}),
+		srv.Post(servertest.Path("users/account/policies"), nil),
+		srv.Get(servertest.Path("organizations")),
+		srv.Post(servertest.Path("organizations"), map[string]string{"username": "org_of_org", "email": "o@o.example"}),
+	} {
+		req.As(bob).WithHeader(middleware.ActAsHeader, org.OrgID).Do(t).AssertError(http.StatusForbidden, "FORBIDDEN")
+	}
+
+	active, err := store.FindUserByID(t.Context(), orgID)
+	require.NoError(t, err)
+	assert.True(t, active.IsActive, "the organization's account is left as it was")
+
+	// Membership is still managed by the person
+	w = srv.Get(servertest.Path("organizations")).As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.Len(t, servertest.DecodeJSON[dto.OrganizationsResponse](w).Organizations, 1)
+
+	// Actions taken as the organization are attributed to the member who took them
+	trail, err := store.GetAuditTrail(t.Context(), orgID, 10)
+	require.NoError(t, err)
+	require.Len(t, trail, 6)
+	assert.Equal(t, dto.AuditActionOrganizationActed, trail[0].Action)
+	assert.Equal(t, alice.String(), trail[0].ActorID)
+	assert.Equal(t, "DELETE /api/v1/user-management/users/{user_id}/follow/{target_user_id}", trail[0].ResourceID)
+	assert.Equal(t, bob.String(), trail[2].ActorID)
+	assert.Equal(t, "POST /api/v1/user-management/users/{user_id}/follow/{target_user_id}", trail[2].ResourceID)
+	assert.Equal(t, "PUT /api/v1/user-management/users/profile", trail[3].ResourceID)
+
+	// The last owner cannot leave; editors can
+	srv.Delete(servertest.Path("organizations", org.OrgID, "members", alice.String())).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusConflict, "LAST_ORGANIZATION_OWNER")
+	srv.Delete(servertest.Path("organizations", org.OrgID, "members", bob.String())).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusNoContent)
+	srv.Get(servertest.Path("users", "me", "profile")).
+		As(bob).
+		WithHeader(middleware.ActAsHeader, org.OrgID).
+		Do(t).
+		AssertStatus(http.StatusForbidden)
+}
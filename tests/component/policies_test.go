@@ -0,0 +1,102 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	internalConfig "github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPolicies_EnforceAcceptance(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t,
+		servertest.WithConfig(&internalConfig.Config{
+			Policies: internalConfig.PoliciesConfig{EnforceAcceptance: true},
+		}),
+		servertest.WithMemoryStore(store),
+		servertest.WithContainer(func(c *app.Container) {
+			c.PolicyService = service.NewPolicyService(store, map[dto.PolicyDocument]string{
+				dto.PolicyDocumentTerms:   "2026-10",
+				dto.PolicyDocumentPrivacy: "2026-09",
+			})
+		}),
+	)
+
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	follow := servertest.Path("users", alice.String(), "follow", bob.String())
+	policies := servertest.Path("users", "account", "policies")
+
+	// Mutations wait for acceptance; reads do not
+	srv.Post(follow, nil).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusPreconditionRequired, "POLICY_ACCEPTANCE_REQUIRED").
+		AssertBodyContains("terms_of_service,privacy_policy")
+
+	srv.Get(servertest.Path("users", "me", "profile")).As(alice).Do(t).AssertStatus(http.StatusOK)
+
+	w := srv.Get(policies).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	status := servertest.DecodeJSON[dto.PolicyStatusResponse](w)
+	require.Len(t, status.Policies, 2)
+	assert.True(t, status.Policies[0].AcceptanceRequired)
+	assert.Empty(t, status.Policies[0].AcceptedVersion)
+
+	srv.Post(policies, map[string]any{"document": "terms_of_service", "version": "2026-01"}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusConflict, "POLICY_VERSION_NOT_CURRENT")
+
+	for document, version := range map[string]string{"terms_of_service": "2026-10", "privacy_policy": "2026-09"} {
+		srv.Post(policies, map[string]any{"document": document, "version": version}).
+			As(alice).
+			Do(t).
+			AssertStatus(http.StatusCreated)
+	}
+
+	srv.Post(follow, nil).As(alice).Do(t).AssertStatus(http.StatusOK)
+
+	w = srv.Get(policies).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	status = servertest.DecodeJSON[dto.PolicyStatusResponse](w)
+	for _, policy := range status.Policies {
+		assert.False(t, policy.AcceptanceRequired, policy.Document)
+		assert.NotNil(t, policy.AcceptedAt)
+	}
+}
+
+func TestPolicies_NotPublished(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	srv.Post(servertest.Path("users", "account", "policies"), map[string]any{
+		"document": "terms_of_service",
+		"version":  "2026-10",
+	}).As(alice).Do(t).AssertError(http.StatusBadRequest, "POLICY_NOT_PUBLISHED")
+
+	srv.Get(servertest.Path("users", "account", "policies")).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK).
+		AssertBodyContains(`"policies":[]`)
+}
@@ -0,0 +1,72 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPreferenceCenter(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	alice, _ := f.UserID("alice")
+
+	var links service.PreferenceCenterService
+
+	srv := servertest.New(t,
+		servertest.WithMemoryStore(store),
+		servertest.WithContainer(func(c *app.Container) {
+			links = c.PreferenceCenterService
+		}),
+	)
+
+	issued, err := links.IssueToken(t.Context(), alice)
+	require.NoError(t, err)
+
+	link := servertest.Path("preference-center", issued.Token)
+
+	// The link works without signing in
+	w := srv.Get(link).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.True(t, servertest.DecodeJSON[dto.PreferenceCenterResponse](w).Notification.ActivitySummaries)
+
+	w = srv.Post(link, map[string]any{"activitySummaries": false}).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.False(t, servertest.DecodeJSON[dto.PreferenceCenterResponse](w).Notification.ActivitySummaries)
+
+	// Only the optional mailings can be changed through the link
+	srv.Post(link, map[string]any{"securityAlerts": false}).
+		Do(t).
+		AssertError(http.StatusBadRequest, "INVALID_JSON")
+
+	srv.Post(servertest.Path("preference-center", issued.Token+"x"), map[string]any{"marketingEmails": false}).
+		Do(t).
+		AssertError(http.StatusNotFound, "PREFERENCE_CENTER_LINK_INVALID")
+
+	// Revoking invalidates every link sent so far, but not the ones issued afterwards
+	srv.Delete(servertest.Path("users", "account", "preference-center-links")).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusNoContent)
+
+	srv.Get(link).Do(t).AssertError(http.StatusNotFound, "PREFERENCE_CENTER_LINK_INVALID")
+
+	reissued, err := links.IssueToken(t.Context(), alice)
+	require.NoError(t, err)
+
+	srv.Get(servertest.Path("preference-center", reissued.Token)).Do(t).AssertStatus(http.StatusOK)
+}
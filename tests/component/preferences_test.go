@@ -0,0 +1,150 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestPreferences_RejectsUnknownEnumValues(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	srv.Put(servertest.Path("users", alice.String(), "preferences", "display"), map[string]any{"fontSize": "HUGE"}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusBadRequest, "VALIDATION_ERROR").
+		AssertBodyContains(`"fontSize":"must be one of: SMALL, MEDIUM, LARGE, EXTRA_LARGE"`)
+
+	srv.Put(servertest.Path("users", alice.String(), "preferences"), map[string]any{
+		"sound": map[string]any{"volumeLevel": "loud"},
+	}).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusBadRequest, "VALIDATION_ERROR").
+		AssertBodyContains(`"volumeLevel":"must be one of: MUTED, LOW, MEDIUM, HIGH"`)
+
+	srv.Put(servertest.Path("users", alice.String(), "preferences", "display"), map[string]any{"fontSize": "LARGE"}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK).
+		AssertBodyContains(`"fontSize":"LARGE"`)
+}
+
+func TestPreferences_PrivacyFlagsDriveAccessChecks(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+
+	srv.Put(servertest.Path("users", carol.String(), "preferences", "privacy"), map[string]any{
+		"allowFollows": false,
+		"showFullName": false,
+	}).
+		As(carol).
+		Do(t).
+		AssertStatus(http.StatusOK).
+		AssertBodyContains(`"allowFollows":false`)
+
+	srv.Post(servertest.Path("users", bob.String(), "follow", carol.String()), nil).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+}
+
+func TestPreferences_ResetRestoresDefaults(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	preferences := servertest.Path("users", "me", "preferences")
+
+	srv.Put(preferences+"/display", map[string]any{"fontSize": "LARGE"}).As(alice).Do(t).AssertStatus(http.StatusOK)
+	srv.Put(preferences+"/sound", map[string]any{"volumeLevel": "HIGH"}).As(alice).Do(t).AssertStatus(http.StatusOK)
+
+	srv.Delete(preferences + "/display").
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK).
+		AssertBodyContains(`"fontSize":"MEDIUM"`)
+
+	srv.Get(preferences + "/sound").As(alice).Do(t).AssertBodyContains(`"volumeLevel":"HIGH"`)
+
+	srv.Delete(preferences + "/").
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK).
+		AssertBodyContains(`"volumeLevel":"MEDIUM"`)
+
+	srv.Delete(preferences+"/colors").As(alice).Do(t).AssertError(http.StatusBadRequest, "INVALID_CATEGORY")
+
+	srv.Delete(servertest.Path("users", alice.String(), "preferences", "display")).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+}
+
+func TestPreferences_ExportImportBetweenAccounts(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	export := servertest.Path("users", "account", "preferences", "export")
+	importPath := servertest.Path("users", "account", "preferences", "import")
+
+	srv.Put(servertest.Path("users", "me", "preferences", "display"), map[string]any{"fontSize": "LARGE"}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	w := srv.Get(export + "?categories=display,sound").As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	bundle := servertest.DecodeJSON[dto.PreferenceBundle](w)
+	assert.Equal(t, alice.String(), bundle.UserID)
+	assert.Nil(t, bundle.Preferences.Theme)
+
+	srv.Post(importPath, map[string]any{"bundle": bundle, "categories": []string{"display"}}).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusOK).
+		AssertBodyContains(`"fontSize":"LARGE"`).
+		AssertBodyNotContains(`"sound"`)
+
+	srv.Get(servertest.Path("users", "me", "preferences", "display")).
+		As(bob).
+		Do(t).
+		AssertBodyContains(`"fontSize":"LARGE"`)
+
+	// Bundles are checked like any update, then against their signature
+	fontSize := dto.FontSize("HUGE")
+	bundle.Preferences.Display.FontSize = &fontSize
+
+	srv.Post(importPath, map[string]any{"bundle": bundle}).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusBadRequest, "VALIDATION_ERROR")
+
+	fontSize = dto.FontSizeSmall
+
+	srv.Post(importPath, map[string]any{"bundle": bundle}).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusBadRequest, "INVALID_PREFERENCE_BUNDLE")
+
+	bundle.SchemaVersion = 2
+
+	srv.Post(importPath, map[string]any{"bundle": bundle}).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusBadRequest, "UNSUPPORTED_BUNDLE_VERSION")
+}
@@ -0,0 +1,91 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestPresence(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{
+		Users:   []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}},
+		Follows: []fixtures.Follow{{Follower: "bob", Followee: "alice"}, {Follower: "carol", Followee: "alice"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+
+	require.NoError(t, store.TouchPresence(t.Context(), bob, time.Now(), time.Hour))
+	require.NoError(t, store.TouchPresence(t.Context(), carol, time.Now().Add(-time.Hour), 2*time.Hour))
+
+	presence := func(userID string) dto.UserPresenceResponse {
+		w := srv.Get(servertest.Path("users", userID, "presence")).As(alice).Do(t)
+		w.AssertStatus(http.StatusOK)
+
+		return servertest.DecodeJSON[dto.UserPresenceResponse](w)
+	}
+
+	assert.Equal(t, dto.PresenceOnline, presence(bob.String()).Status)
+	assert.NotNil(t, presence(bob.String()).LastSeenAt)
+	assert.Equal(t, dto.PresenceOffline, presence(carol.String()).Status)
+	assert.Equal(t, dto.Presence{Status: dto.PresenceOffline}, presence(alice.String()).Presence, "never seen")
+
+	srv.Put(servertest.Path("users", bob.String(), "preferences", "privacy"), map[string]any{"showLastSeen": false}).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	assert.Equal(t, dto.Presence{Status: dto.PresenceHidden}, presence(bob.String()).Presence)
+
+	// Users always see their own presence
+	own := srv.Get(servertest.Path("users", "me", "presence")).As(bob).Do(t)
+	own.AssertStatus(http.StatusOK)
+	assert.Equal(t, dto.PresenceOnline, servertest.DecodeJSON[dto.UserPresenceResponse](own).Status)
+
+	// Followers lists only carry presence when asked for
+	followersPath := servertest.Path("users", alice.String(), "followers")
+
+	plain := srv.Get(followersPath).As(alice).Do(t)
+	plain.AssertStatus(http.StatusOK)
+
+	for _, follower := range servertest.DecodeJSON[dto.GetFollowedUsersResponse](plain).FollowedUsers {
+		assert.Nil(t, follower.Presence)
+	}
+
+	withPresence := srv.Get(followersPath + "?includePresence=true").As(alice).Do(t)
+	withPresence.AssertStatus(http.StatusOK)
+
+	statuses := map[string]dto.PresenceStatus{}
+	for _, follower := range servertest.DecodeJSON[dto.GetFollowedUsersResponse](withPresence).FollowedUsers {
+		require.NotNil(t, follower.Presence)
+		statuses[follower.Username] = follower.Presence.Status
+	}
+
+	assert.Equal(t, map[string]dto.PresenceStatus{"bob": dto.PresenceHidden, "carol": dto.PresenceOffline}, statuses)
+
+	srv.Get(servertest.Path("users", "00000000-0000-0000-0000-000000000001", "presence")).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusNotFound, "USER_NOT_FOUND")
+
+	// Heartbeats come from the gateway, with a service token
+	srv.Post("/internal/v1/users/"+bob.String()+"/heartbeat", nil).
+		As(bob).
+		Do(t).
+		AssertStatus(http.StatusForbidden)
+}
@@ -0,0 +1,63 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestPrivacyDefaults_StagedRollout(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t,
+		servertest.WithMemoryStore(store),
+		servertest.WithPrivacyDefaultsVersion(store, dto.PrivacyDefaultsVersionPublicProfile),
+	)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+	privacy := servertest.Path("users", "me", "preferences", "privacy")
+
+	// Alice and Carol are provisioned under the public defaults; Carol then saves her own choice
+	srv.Get(privacy).As(alice).Do(t).AssertStatus(http.StatusOK).AssertBodyContains(`"profileVisibility":"PUBLIC"`)
+	srv.Put(privacy, map[string]any{"allowMessages": false}).As(carol).Do(t).AssertStatus(http.StatusOK)
+
+	// The rollout flips the defaults for users provisioned from now on only
+	store.SetPrivacyDefaultsVersion(dto.PrivacyDefaultsVersionPrivateProfile)
+
+	srv.Get(privacy).As(bob).Do(t).AssertStatus(http.StatusOK).AssertBodyContains(`"profileVisibility":"PRIVATE"`)
+	srv.Get(privacy).As(alice).Do(t).AssertStatus(http.StatusOK).AssertBodyContains(`"profileVisibility":"PUBLIC"`)
+
+	status, err := srv.Container.PrivacyDefaultsService.GetPrivacyDefaults(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []dto.PrivacyDefaultsCohort{{Version: 1, Users: 2}, {Version: 2, Users: 1}}, status.Cohorts)
+
+	// Migrating the first cohort changes Alice's defaults and keeps Carol's saved choices
+	migrated, err := srv.Container.PrivacyDefaultsService.MigratePrivacyDefaults(t.Context(), alice,
+		&dto.PrivacyDefaultsMigrationRequest{FromVersion: 1, ToVersion: 2, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 2, migrated.Moved)
+	assert.Equal(t, 1, migrated.Changed)
+	assert.Zero(t, migrated.Remaining)
+
+	srv.Get(privacy).As(alice).Do(t).AssertStatus(http.StatusOK).AssertBodyContains(`"profileVisibility":"PRIVATE"`)
+	srv.Get(privacy).As(carol).Do(t).AssertStatus(http.StatusOK).AssertBodyContains(`"profileVisibility":"PUBLIC"`)
+
+	// Only admins see the cohorts
+	srv.Get(servertest.Path("admin", "preferences", "privacy-defaults")).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+}
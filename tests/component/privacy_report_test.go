@@ -0,0 +1,51 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestPrivacyReport_DescribesStoredData(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	srv.Put(servertest.Path("users", alice.String(), "preferences", "theme"), map[string]any{"darkMode": true}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	w := srv.Get(servertest.Path("users", "account", "privacy-report")).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	report := servertest.DecodeJSON[dto.PrivacyReportResponse](w)
+	assert.Equal(t, alice.String(), report.UserID)
+	require.NotNil(t, report.PrivacySettings)
+	require.Len(t, report.Categories, 4)
+
+	byCategory := make(map[dto.DataCategory]dto.PrivacyReportCategory)
+	for _, category := range report.Categories {
+		byCategory[category.Category] = category
+		assert.NotEmpty(t, category.Description)
+		assert.NotEmpty(t, category.Retention)
+	}
+
+	assert.Equal(t, []string{"You follow 1 person", "Followed by 1 person"}, byCategory[dto.DataCategorySocialGraph].Items)
+	assert.Equal(t, []string{"Theme preferences"}, byCategory[dto.DataCategoryPreferences].Items)
+	assert.NotNil(t, byCategory[dto.DataCategoryPreferences].LastUpdatedAt)
+}
+
+func TestPrivacyReport_RequiresUser(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := newUsersMeServer(t)
+
+	srv.Get(servertest.Path("users", "account", "privacy-report")).Do(t).AssertStatus(http.StatusUnauthorized)
+}
@@ -0,0 +1,99 @@
+package component_test
+
+import (
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestProfileViews(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store), servertest.WithContainer(func(c *app.Container) {
+		c.TrustedProxies = []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	}))
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+	profilePath := servertest.Path("users", alice.String(), "profile")
+
+	views := func() dto.ProfileViewsResponse {
+		w := srv.Get(servertest.Path("users", "me", "profile", "views")).As(alice).Do(t)
+		w.AssertStatus(http.StatusOK)
+
+		return servertest.DecodeJSON[dto.ProfileViewsResponse](w)
+	}
+
+	// Each viewer counts once a day, whichever way they open the profile
+	srv.Get(profilePath).As(bob).Do(t).AssertStatus(http.StatusOK)
+	srv.Get(profilePath).As(bob).Do(t).AssertStatus(http.StatusOK)
+	srv.Get(servertest.Path("users", "by-username", "alice")).As(bob).Do(t).AssertStatus(http.StatusOK)
+	srv.Get(profilePath).As(alice).Do(t).AssertStatus(http.StatusOK)
+
+	// Anonymous viewers of a shared link count once a day per IP address
+	share := srv.Get(servertest.Path("users", "profile", "share-token")).As(alice).Do(t)
+	share.AssertStatus(http.StatusOK)
+	sharedPath := servertest.Path("shared-profiles", servertest.DecodeJSON[dto.ProfileShareTokenResponse](share).Token)
+
+	for _, ip := range []string{"203.0.113.7", "203.0.113.7", "203.0.113.8"} {
+		srv.Get(sharedPath).From(ip + ":40000").Do(t).AssertStatus(http.StatusOK)
+	}
+
+	assert.Equal(t, dto.ProfileViewsResponse{UserID: alice.String(), ViewCount: 3, Counting: true}, views())
+
+	// Forwarding headers only name the viewer when a trusted proxy sends them
+	for _, ip := range []string{"198.51.100.1", "198.51.100.2", "198.51.100.3"} {
+		srv.Get(sharedPath).From("203.0.113.7:40000").WithHeader("X-Forwarded-For", ip).Do(t).
+			AssertStatus(http.StatusOK)
+		srv.Get(sharedPath).From("203.0.113.7:40000").WithHeader("X-Real-IP", ip).Do(t).
+			AssertStatus(http.StatusOK)
+	}
+
+	assert.Equal(t, int64(3), views().ViewCount)
+
+	for _, ip := range []string{"198.51.100.1", "198.51.100.1"} {
+		srv.Get(sharedPath).From("10.0.0.2:443").WithHeader("X-Forwarded-For", ip).Do(t).
+			AssertStatus(http.StatusOK)
+	}
+
+	assert.Equal(t, int64(4), views().ViewCount)
+
+	// Flushed views stay counted
+	flushed, err := srv.Container.ProfileViewService.FlushViews(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, flushed)
+
+	srv.Get(profilePath).As(carol).Do(t).AssertStatus(http.StatusOK)
+	assert.Equal(t, int64(5), views().ViewCount)
+
+	// Only the owner sees the count
+	srv.Get(servertest.Path("users", alice.String(), "profile", "views")).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+
+	// Opting out stops the counting
+	srv.Put(servertest.Path("users", "me", "preferences", "privacy"), map[string]any{"countProfileViews": false}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+	srv.Get(sharedPath).From("203.0.113.9:40000").Do(t).AssertStatus(http.StatusOK)
+
+	assert.Equal(t, dto.ProfileViewsResponse{UserID: alice.String(), ViewCount: 5, Counting: false}, views())
+}
@@ -0,0 +1,57 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
+)
+
+func TestPublicProfiles_HiddenAtOnce(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t,
+		servertest.WithMemoryStore(store),
+		servertest.WithPublicProfiles(store, service.PublicProfileOptions{MaxStaleness: time.Hour}),
+	)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+
+	// Anonymous views project both profiles
+	srv.Get(servertest.Path("users", alice.String(), "embed")).Do(t).AssertStatus(http.StatusOK)
+	srv.Get(servertest.Path("users", bob.String(), "embed")).Do(t).AssertStatus(http.StatusOK)
+
+	projected, err := store.GetPublicProfile(t.Context(), alice)
+	require.NoError(t, err)
+	require.NotNil(t, projected)
+
+	// Making the profile private hides it from the next anonymous view, well within MaxStaleness
+	srv.Put(servertest.Path("users", alice.String(), "preferences", "privacy"), map[string]any{
+		"profileVisibility": dto.ProfileVisibilityPrivate,
+	}).As(alice).Do(t).AssertStatus(http.StatusOK)
+
+	srv.Get(servertest.Path("users", alice.String(), "embed")).Do(t).AssertError(http.StatusNotFound, "USER_NOT_FOUND")
+
+	// So does deactivating the account
+	w := srv.Post(servertest.Path("users", "account", "delete-request"), nil).As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	token := servertest.DecodeJSON[dto.UserAccountDeleteRequestResponse](w).ConfirmationToken
+	srv.NewRequest(http.MethodDelete, servertest.Path("users", "account"), map[string]any{
+		"confirmationToken": token,
+	}).As(bob).Do(t).AssertStatus(http.StatusOK)
+
+	srv.Get(servertest.Path("users", bob.String(), "embed")).Do(t).AssertError(http.StatusNotFound, "USER_NOT_FOUND")
+}
@@ -0,0 +1,76 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestRecentlyViewed(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+	carol, _ := f.UserID("carol")
+	recentPath := servertest.Path("users", "me", "recently-viewed")
+
+	usernames := func() []string {
+		w := srv.Get(recentPath).As(alice).Do(t)
+		w.AssertStatus(http.StatusOK)
+
+		var names []string
+		for _, profile := range servertest.DecodeJSON[dto.RecentlyViewedResponse](w).Profiles {
+			names = append(names, profile.Username)
+		}
+
+		return names
+	}
+
+	// Profiles viewed again move to the front; alice's own profile is not kept
+	srv.Get(servertest.Path("users", bob.String(), "profile")).As(alice).Do(t).AssertStatus(http.StatusOK)
+	srv.Get(servertest.Path("users", "by-username", "carol")).As(alice).Do(t).AssertStatus(http.StatusOK)
+	srv.Get(servertest.Path("users", alice.String(), "profile")).As(alice).Do(t).AssertStatus(http.StatusOK)
+	srv.Get(servertest.Path("users", bob.String(), "profile")).As(alice).Do(t).AssertStatus(http.StatusOK)
+
+	assert.Equal(t, []string{"bob", "carol"}, usernames())
+
+	// Profiles made private since are left out
+	srv.Put(servertest.Path("users", "me", "preferences", "privacy"), map[string]any{"profileVisibility": "PRIVATE"}).
+		As(carol).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	assert.Equal(t, []string{"bob"}, usernames())
+
+	srv.Delete(recentPath).As(alice).Do(t).AssertStatus(http.StatusNoContent)
+	assert.Empty(t, usernames())
+
+	// Opting out stops the recording
+	srv.Put(servertest.Path("users", "me", "preferences", "privacy"), map[string]any{"keepRecentlyViewed": false}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+	srv.Get(servertest.Path("users", bob.String(), "profile")).As(alice).Do(t).AssertStatus(http.StatusOK)
+
+	w := srv.Get(recentPath).As(alice).Do(t)
+	w.AssertStatus(http.StatusOK)
+	assert.Equal(t, dto.RecentlyViewedResponse{Profiles: []dto.RecentlyViewedProfile{}},
+		servertest.DecodeJSON[dto.RecentlyViewedResponse](w))
+
+	srv.Get(recentPath).Do(t).AssertStatus(http.StatusUnauthorized)
+}
@@ -0,0 +1,76 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestSearchReindex(t *testing.T) {
+	t.Parallel()
+
+	hidden := false
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{
+			{Username: "alice"},
+			{Username: "Alex"},
+			{Username: "alina", Preferences: &dto.UserPreferencesUpdateRequest{
+				Privacy: &dto.PrivacyPreferencesUpdate{Discoverable: &hidden},
+			}},
+		},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store), servertest.WithRoles(store))
+	alice, _ := f.UserID("alice")
+	require.NoError(t, store.SetUserRole(t.Context(), alice, alice, dto.UserRoleAdmin))
+
+	w := srv.Post(servertest.Path("admin", "search", "reindex"), nil).As(alice).Do(t)
+	w.AssertStatus(http.StatusAccepted)
+	assert.Equal(t, dto.SearchReindexRunning, servertest.DecodeJSON[dto.SearchReindexJob](w).State)
+
+	var status dto.SearchIndexStatusResponse
+
+	require.Eventually(t, func() bool {
+		w := srv.Get(servertest.Path("admin", "search", "index")).As(alice).Do(t)
+		w.AssertStatus(http.StatusOK)
+		status = servertest.DecodeJSON[dto.SearchIndexStatusResponse](w)
+
+		return status.LastJob.State != dto.SearchReindexRunning
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, dto.TypeaheadSourcePostgres, status.Source)
+	assert.Equal(t, dto.SearchReindexCompleted, status.LastJob.State)
+	assert.Equal(t, 2, status.LastJob.Indexed, "undiscoverable users are not indexed")
+
+	results, err := store.MatchUsernamePrefix(t.Context(), "al", 10)
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+}
+
+func TestSearchIndex_RequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice"}}}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store), servertest.WithRoles(store))
+	alice, _ := f.UserID("alice")
+
+	srv.Post(servertest.Path("admin", "search", "reindex"), nil).As(alice).Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+	srv.Get(servertest.Path("admin", "search", "index")).As(alice).Do(t).
+		AssertError(http.StatusForbidden, "FORBIDDEN")
+}
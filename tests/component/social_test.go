@@ -1,176 +1,21 @@
 package component_test
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
-	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-)
-
-// MockSocialRepoComponent for component tests.
-type MockSocialRepoComponent struct {
-	mock.Mock
-}
-
-func (m *MockSocialRepoComponent) GetFollowing(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit, offset int,
-) ([]dto.User, int, error) {
-	args := m.Called(ctx, userID, limit, offset)
-
-	err := args.Error(2)
-	if err != nil {
-		return nil, 0, fmt.Errorf(mockErrorFmt, err)
-	}
-
-	users, _ := args.Get(0).([]dto.User)
-
-	return users, args.Int(1), nil
-}
-
-func (m *MockSocialRepoComponent) GetFollowers(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit, offset int,
-) ([]dto.User, int, error) {
-	args := m.Called(ctx, userID, limit, offset)
-
-	err := args.Error(2)
-	if err != nil {
-		return nil, 0, fmt.Errorf(mockErrorFmt, err)
-	}
-
-	users, _ := args.Get(0).([]dto.User)
-
-	return users, args.Int(1), nil
-}
-
-func (m *MockSocialRepoComponent) FollowUser(
-	ctx context.Context,
-	followerID, followeeID uuid.UUID,
-) error {
-	args := m.Called(ctx, followerID, followeeID)
-
-	err := args.Error(0)
-	if err != nil {
-		return fmt.Errorf(mockErrorFmt, err)
-	}
-
-	return nil
-}
-
-func (m *MockSocialRepoComponent) UnfollowUser(
-	ctx context.Context,
-	followerID, followeeID uuid.UUID,
-) error {
-	args := m.Called(ctx, followerID, followeeID)
-
-	err := args.Error(0)
-	if err != nil {
-		return fmt.Errorf(mockErrorFmt, err)
-	}
-
-	return nil
-}
 
-func (m *MockSocialRepoComponent) GetRecentRecipes(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.RecipeSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockErrorFmt, err)
-	}
-
-	recipes, _ := args.Get(0).([]dto.RecipeSummary)
-
-	return recipes, nil
-}
-
-func (m *MockSocialRepoComponent) GetRecentFollows(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.UserSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockErrorFmt, err)
-	}
-
-	follows, _ := args.Get(0).([]dto.UserSummary)
-
-	return follows, nil
-}
-
-func (m *MockSocialRepoComponent) GetRecentReviews(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.ReviewSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockErrorFmt, err)
-	}
-
-	reviews, _ := args.Get(0).([]dto.ReviewSummary)
-
-	return reviews, nil
-}
-
-func (m *MockSocialRepoComponent) GetRecentFavorites(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.FavoriteSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockErrorFmt, err)
-	}
-
-	favorites, _ := args.Get(0).([]dto.FavoriteSummary)
-
-	return favorites, nil
-}
-
-func (m *MockSocialRepoComponent) CheckFollowing(
-	ctx context.Context,
-	followerID, followeeID uuid.UUID,
-) (*time.Time, error) {
-	args := m.Called(ctx, followerID, followeeID)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf(mockErrorFmt, err)
-	}
-
-	followedAt, _ := args.Get(0).(*time.Time)
-
-	return followedAt, nil
-}
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
 
 func createTestUserComponent(userID uuid.UUID, username string) *dto.User {
 	now := time.Now()
@@ -214,47 +59,28 @@ func createFollowedUsersComponent(count int) []dto.User {
 func TestGetFollowingComponent_Success(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 	followedUsers := createFollowedUsersComponent(2)
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
 	mockSocialRepo.On("GetFollowing", mock.Anything, targetUserID, 20, 0).Return(followedUsers, 2, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/following", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "following")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
+	rr.AssertStatus(http.StatusOK)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
-
-	var apiResp dto.GetFollowedUsersResponse
-
-	err := json.Unmarshal(rr.Body.Bytes(), &apiResp)
-	require.NoError(t, err)
+	apiResp := servertest.DecodeJSON[dto.GetFollowedUsersResponse](rr)
 	assert.Equal(t, 2, apiResp.TotalCount)
 	assert.Len(t, apiResp.FollowedUsers, 2)
 	require.NotNil(t, apiResp.Limit)
@@ -269,45 +95,25 @@ func TestGetFollowingComponent_Success(t *testing.T) {
 func TestGetFollowingComponent_CountOnly(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
 	mockSocialRepo.On("GetFollowing", mock.Anything, targetUserID, 20, 0).Return([]dto.User{}, 42, nil).Once()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+targetUserID.String()+"/following?countOnly=true",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "following?countOnly=true")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), `"totalCount":42`)
 	// countOnly mode should not include followedUsers, limit, offset
 	assert.NotContains(t, rr.Body.String(), `"followedUsers"`)
@@ -319,46 +125,26 @@ func TestGetFollowingComponent_CountOnly(t *testing.T) {
 func TestGetFollowingComponent_WithPagination(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 	followedUsers := createFollowedUsersComponent(5)
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
 	mockSocialRepo.On("GetFollowing", mock.Anything, targetUserID, 50, 10).Return(followedUsers, 100, nil).Once()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+targetUserID.String()+"/following?limit=50&offset=10",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "following?limit=50&offset=10")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), `"totalCount":100`)
 	assert.Contains(t, rr.Body.String(), `"limit":50`)
 	assert.Contains(t, rr.Body.String(), `"offset":10`)
@@ -370,22 +156,11 @@ func TestGetFollowingComponent_WithPagination(t *testing.T) {
 func TestGetFollowingComponent_OwnProfile(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 
@@ -395,15 +170,12 @@ func TestGetFollowingComponent_OwnProfile(t *testing.T) {
 	// When viewing own profile, privacy check is skipped
 	mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(targetUser, nil).Once()
 	mockSocialRepo.On("GetFollowing", mock.Anything, userID, 20, 0).Return(followedUsers, 3, nil).Once()
+	mockSocialRepo.On("GetFollowSettings", mock.Anything, userID, mock.Anything).
+		Return(map[uuid.UUID]dto.FollowSettings{}, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+userID.String()+"/following", nil)
-	req.Header.Set("X-User-Id", userID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", userID.String(), "following")).As(userID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), `"totalCount":3`)
 
 	// Privacy preferences should NOT be fetched when viewing own profile
@@ -415,36 +187,20 @@ func TestGetFollowingComponent_OwnProfile(t *testing.T) {
 func TestGetFollowingComponent_NotFound(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(nil, repository.ErrUserNotFound).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/following", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "following")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 
 	mockUserRepo.AssertExpectations(t)
@@ -454,40 +210,24 @@ func TestGetFollowingComponent_NotFound(t *testing.T) {
 func TestGetFollowingComponent_Forbidden_PrivateProfile(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "privateuser")
-	privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+	privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(privatePrivacy, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/following", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "following")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusForbidden, rr.Code)
+	rr.AssertStatus(http.StatusForbidden)
 	assert.Contains(t, rr.Body.String(), "FORBIDDEN")
 
 	mockUserRepo.AssertExpectations(t)
@@ -497,41 +237,25 @@ func TestGetFollowingComponent_Forbidden_PrivateProfile(t *testing.T) {
 func TestGetFollowingComponent_Forbidden_FollowersOnlyNotFollowing(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "followersonly")
-	followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+	followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(followersOnlyPrivacy, nil).Once()
 	mockUserRepo.On("IsFollowing", mock.Anything, requesterID, targetUserID).Return(false, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/following", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "following")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusForbidden, rr.Code)
+	rr.AssertStatus(http.StatusForbidden)
 	assert.Contains(t, rr.Body.String(), "FORBIDDEN")
 
 	mockUserRepo.AssertExpectations(t)
@@ -541,28 +265,17 @@ func TestGetFollowingComponent_Forbidden_FollowersOnlyNotFollowing(t *testing.T)
 func TestGetFollowingComponent_Success_FollowersOnlyWhenFollowing(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "followersonly")
-	followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+	followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 	followedUsers := createFollowedUsersComponent(1)
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
@@ -570,14 +283,9 @@ func TestGetFollowingComponent_Success_FollowersOnlyWhenFollowing(t *testing.T)
 	mockUserRepo.On("IsFollowing", mock.Anything, requesterID, targetUserID).Return(true, nil).Once()
 	mockSocialRepo.On("GetFollowing", mock.Anything, targetUserID, 20, 0).Return(followedUsers, 1, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/following", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "following")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), `"totalCount":1`)
 
 	mockUserRepo.AssertExpectations(t)
@@ -587,104 +295,53 @@ func TestGetFollowingComponent_Success_FollowersOnlyWhenFollowing(t *testing.T)
 func TestGetFollowingComponent_Unauthorized(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 
 	// Missing X-User-Id header
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/following", nil)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "following")).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr.AssertStatus(http.StatusUnauthorized)
 	assert.Contains(t, rr.Body.String(), "UNAUTHORIZED")
 }
 
 func TestGetFollowingComponent_ValidationError_InvalidUUID(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	requesterID := uuid.New()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/invalid-uuid/following", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users/invalid-uuid/following")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	rr.AssertStatus(http.StatusUnprocessableEntity)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
 func TestGetFollowingComponent_ValidationError_InvalidLimit(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+targetUserID.String()+"/following?limit=0",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "following?limit=0")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr.AssertStatus(http.StatusBadRequest)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
@@ -695,47 +352,28 @@ func TestGetFollowingComponent_ValidationError_InvalidLimit(t *testing.T) {
 func TestGetFollowersComponent_Success(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 	followers := createFollowedUsersComponent(2)
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
 	mockSocialRepo.On("GetFollowers", mock.Anything, targetUserID, 20, 0).Return(followers, 2, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/followers", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "followers")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
+	rr.AssertStatus(http.StatusOK)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
-
-	var apiResp dto.GetFollowedUsersResponse
-
-	err := json.Unmarshal(rr.Body.Bytes(), &apiResp)
-	require.NoError(t, err)
+	apiResp := servertest.DecodeJSON[dto.GetFollowedUsersResponse](rr)
 	assert.Equal(t, 2, apiResp.TotalCount)
 	assert.Len(t, apiResp.FollowedUsers, 2)
 	require.NotNil(t, apiResp.Limit)
@@ -750,45 +388,25 @@ func TestGetFollowersComponent_Success(t *testing.T) {
 func TestGetFollowersComponent_CountOnly(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
 	mockSocialRepo.On("GetFollowers", mock.Anything, targetUserID, 20, 0).Return([]dto.User{}, 42, nil).Once()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+targetUserID.String()+"/followers?countOnly=true",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "followers?countOnly=true")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), `"totalCount":42`)
 	// countOnly mode should not include followedUsers, limit, offset
 	assert.NotContains(t, rr.Body.String(), `"followedUsers"`)
@@ -800,46 +418,26 @@ func TestGetFollowersComponent_CountOnly(t *testing.T) {
 func TestGetFollowersComponent_WithPagination(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 	followers := createFollowedUsersComponent(5)
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
 	mockSocialRepo.On("GetFollowers", mock.Anything, targetUserID, 50, 10).Return(followers, 100, nil).Once()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+targetUserID.String()+"/followers?limit=50&offset=10",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "followers?limit=50&offset=10")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), `"totalCount":100`)
 	assert.Contains(t, rr.Body.String(), `"limit":50`)
 	assert.Contains(t, rr.Body.String(), `"offset":10`)
@@ -851,22 +449,11 @@ func TestGetFollowersComponent_WithPagination(t *testing.T) {
 func TestGetFollowersComponent_OwnProfile(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 
@@ -877,14 +464,9 @@ func TestGetFollowersComponent_OwnProfile(t *testing.T) {
 	mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(targetUser, nil).Once()
 	mockSocialRepo.On("GetFollowers", mock.Anything, userID, 20, 0).Return(followers, 3, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+userID.String()+"/followers", nil)
-	req.Header.Set("X-User-Id", userID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", userID.String(), "followers")).As(userID).Do(t)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), `"totalCount":3`)
 
 	// Privacy preferences should NOT be fetched when viewing own profile
@@ -896,36 +478,20 @@ func TestGetFollowersComponent_OwnProfile(t *testing.T) {
 func TestGetFollowersComponent_NotFound(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(nil, repository.ErrUserNotFound).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/followers", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "followers")).As(requesterID).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 
 	mockUserRepo.AssertExpectations(t)
@@ -935,40 +501,24 @@ func TestGetFollowersComponent_NotFound(t *testing.T) {
 func TestGetFollowersComponent_Forbidden_PrivateProfile(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "privateuser")
-	privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+	privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(privatePrivacy, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/followers", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "followers")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusForbidden, rr.Code)
+	rr.AssertStatus(http.StatusForbidden)
 	assert.Contains(t, rr.Body.String(), "FORBIDDEN")
 
 	mockUserRepo.AssertExpectations(t)
@@ -978,41 +528,25 @@ func TestGetFollowersComponent_Forbidden_PrivateProfile(t *testing.T) {
 func TestGetFollowersComponent_Forbidden_FollowersOnlyNotFollowing(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "followersonly")
-	followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+	followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(followersOnlyPrivacy, nil).Once()
 	mockUserRepo.On("IsFollowing", mock.Anything, requesterID, targetUserID).Return(false, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/followers", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "followers")).As(requesterID).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusForbidden, rr.Code)
+	rr.AssertStatus(http.StatusForbidden)
 	assert.Contains(t, rr.Body.String(), "FORBIDDEN")
 
 	mockUserRepo.AssertExpectations(t)
@@ -1022,28 +556,17 @@ func TestGetFollowersComponent_Forbidden_FollowersOnlyNotFollowing(t *testing.T)
 func TestGetFollowersComponent_Success_FollowersOnlyWhenFollowing(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "followersonly")
-	followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+	followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 	followers := createFollowedUsersComponent(1)
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
@@ -1051,14 +574,9 @@ func TestGetFollowersComponent_Success_FollowersOnlyWhenFollowing(t *testing.T)
 	mockUserRepo.On("IsFollowing", mock.Anything, requesterID, targetUserID).Return(true, nil).Once()
 	mockSocialRepo.On("GetFollowers", mock.Anything, targetUserID, 20, 0).Return(followers, 1, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/followers", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "followers")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), `"totalCount":1`)
 
 	mockUserRepo.AssertExpectations(t)
@@ -1068,33 +586,18 @@ func TestGetFollowersComponent_Success_FollowersOnlyWhenFollowing(t *testing.T)
 func TestGetFollowersComponent_Unauthorized(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 
 	// Missing X-User-Id header
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/followers", nil)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "followers")).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr.AssertStatus(http.StatusUnauthorized)
 	assert.Contains(t, rr.Body.String(), "UNAUTHORIZED")
 }
 
@@ -1102,71 +605,35 @@ func TestGetFollowersComponent_Unauthorized(t *testing.T) {
 func TestGetFollowersComponent_ValidationError_InvalidUUID(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	requesterID := uuid.New()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/invalid-uuid/followers", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users/invalid-uuid/followers")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	rr.AssertStatus(http.StatusUnprocessableEntity)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
 func TestGetFollowersComponent_ValidationError_InvalidLimit(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+targetUserID.String()+"/followers?limit=0",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "followers?limit=0")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr.AssertStatus(http.StatusBadRequest)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
@@ -1183,22 +650,11 @@ func targetEmailPtr() *string {
 func TestFollowUserComponent_Success(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	followerID := uuid.New()
 	targetUserID := uuid.New()
@@ -1212,25 +668,21 @@ func TestFollowUserComponent_Success(t *testing.T) {
 	}, nil)
 
 	// Target user allows follows
-	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(&dto.PrivacyPreferences{
+	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(&dto.UserPrivacyPreferences{
 		AllowFollows: true,
 	}, nil)
 
+	// No follow limit applies
+	mockSocialRepo.On("GetFollowLimit", mock.Anything, followerID).Return(nil, nil)
+
 	// Follow succeeds
 	mockSocialRepo.On("FollowUser", mock.Anything, followerID, targetUserID).Return(nil)
 
-	req := httptest.NewRequest(
-		http.MethodPost,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", followerID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Post(servertest.Path("users", followerID.String(), "follow", targetUserID.String()), nil).
+		As(followerID).
+		Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), "Successfully followed user")
 	assert.Contains(t, rr.Body.String(), `"isFollowing":true`)
 }
@@ -1238,24 +690,19 @@ func TestFollowUserComponent_Success(t *testing.T) {
 func TestFollowUserComponent_Success_AdminOverride(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	adminID := uuid.New()
+	roles := mocks.NewRoleRepository(t)
+	roles.On("GetUserRole", mock.Anything, adminID).Return(dto.UserRoleAdmin, nil)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t,
+		servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore),
+		servertest.WithRoles(roles),
+	)
 
-	adminID := uuid.New()
 	followerID := uuid.New()
 	targetUserID := uuid.New()
 
@@ -1268,65 +715,59 @@ func TestFollowUserComponent_Success_AdminOverride(t *testing.T) {
 	}, nil)
 
 	// Target user allows follows
-	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(&dto.PrivacyPreferences{
+	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(&dto.UserPrivacyPreferences{
 		AllowFollows: true,
 	}, nil)
 
+	// No follow limit applies
+	mockSocialRepo.On("GetFollowLimit", mock.Anything, followerID).Return(nil, nil)
+
 	// Follow succeeds
 	mockSocialRepo.On("FollowUser", mock.Anything, followerID, targetUserID).Return(nil)
 
 	// Admin creates follow on behalf of another user
-	req := httptest.NewRequest(
-		http.MethodPost,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", adminID.String())
-	req.Header.Set("X-User-Role", "admin")
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Post(servertest.Path("users", followerID.String(), "follow", targetUserID.String()), nil).
+		As(adminID).
+		Do(t)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), "Successfully followed user")
 }
 
-func TestFollowUserComponent_BadRequest_SelfFollow(t *testing.T) {
+func TestFollowUserComponent_Forbidden_RoleHeaderNotTrusted(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	requesterID := uuid.New()
+	roles := mocks.NewRoleRepository(t)
+	roles.On("GetUserRole", mock.Anything, requesterID).Return(dto.UserRoleUser, nil)
+
+	srv := servertest.New(t,
+		servertest.WithRepositories(new(mocks.UserRepository), new(mocks.SocialRepository), new(mocks.TokenStore)),
+		servertest.WithRoles(roles),
+	)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	srv.Post(servertest.Path("users", uuid.NewString(), "follow", uuid.NewString()), nil).
+		As(requesterID).
+		WithHeader("X-User-Role", "admin").
+		Do(t).
+		AssertStatus(http.StatusForbidden)
+}
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+func TestFollowUserComponent_BadRequest_SelfFollow(t *testing.T) {
+	t.Parallel()
+
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 
 	// Attempt to follow self
-	req := httptest.NewRequest(
-		http.MethodPost,
-		"/api/v1/user-management/users/"+userID.String()+"/follow/"+userID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", userID.String())
+	rr := srv.Post(servertest.Path("users", userID.String(), "follow", userID.String()), nil).As(userID).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr.AssertStatus(http.StatusBadRequest)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 	assert.Contains(t, rr.Body.String(), "Cannot follow yourself")
 }
@@ -1334,22 +775,11 @@ func TestFollowUserComponent_BadRequest_SelfFollow(t *testing.T) {
 func TestFollowUserComponent_NotFound_TargetDoesNotExist(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	followerID := uuid.New()
 	targetUserID := uuid.New()
@@ -1357,40 +787,22 @@ func TestFollowUserComponent_NotFound_TargetDoesNotExist(t *testing.T) {
 	// Target user not found
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(nil, repository.ErrUserNotFound)
 
-	req := httptest.NewRequest(
-		http.MethodPost,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", followerID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Post(servertest.Path("users", followerID.String(), "follow", targetUserID.String()), nil).
+		As(followerID).
+		Do(t)
 
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 }
 
 func TestFollowUserComponent_NotFound_TargetInactive(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	followerID := uuid.New()
 	targetUserID := uuid.New()
@@ -1403,40 +815,22 @@ func TestFollowUserComponent_NotFound_TargetInactive(t *testing.T) {
 		IsActive: false,
 	}, nil)
 
-	req := httptest.NewRequest(
-		http.MethodPost,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", followerID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Post(servertest.Path("users", followerID.String(), "follow", targetUserID.String()), nil).
+		As(followerID).
+		Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 }
 
 func TestFollowUserComponent_Forbidden_UserDoesNotAllowFollows(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	followerID := uuid.New()
 	targetUserID := uuid.New()
@@ -1450,175 +844,91 @@ func TestFollowUserComponent_Forbidden_UserDoesNotAllowFollows(t *testing.T) {
 	}, nil)
 
 	// Target user does not allow follows
-	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(&dto.PrivacyPreferences{
+	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(&dto.UserPrivacyPreferences{
 		AllowFollows: false,
 	}, nil)
 
-	req := httptest.NewRequest(
-		http.MethodPost,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", followerID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Post(servertest.Path("users", followerID.String(), "follow", targetUserID.String()), nil).
+		As(followerID).
+		Do(t)
 
-	assert.Equal(t, http.StatusForbidden, rr.Code)
+	rr.AssertStatus(http.StatusForbidden)
 	assert.Contains(t, rr.Body.String(), "FORBIDDEN")
 }
 
 func TestFollowUserComponent_Forbidden_UserIdMismatch(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	requesterID := uuid.New()
 	differentUserID := uuid.New()
 	targetUserID := uuid.New()
 
 	// Non-admin trying to follow on behalf of another user
-	req := httptest.NewRequest(
-		http.MethodPost,
-		"/api/v1/user-management/users/"+differentUserID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
+	rr := srv.Post(servertest.Path("users", differentUserID.String(), "follow", targetUserID.String()), nil).
+		As(requesterID).
+		Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusForbidden, rr.Code)
+	rr.AssertStatus(http.StatusForbidden)
 	assert.Contains(t, rr.Body.String(), "FORBIDDEN")
 }
 
 func TestFollowUserComponent_Unauthorized_MissingHeader(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	followerID := uuid.New()
 	targetUserID := uuid.New()
 
 	// Missing X-User-Id header
-	req := httptest.NewRequest(
-		http.MethodPost,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
+	rr := srv.Post(servertest.Path("users", followerID.String(), "follow", targetUserID.String()), nil).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr.AssertStatus(http.StatusUnauthorized)
 	assert.Contains(t, rr.Body.String(), "UNAUTHORIZED")
 }
 
 func TestFollowUserComponent_ValidationError_InvalidUserUUID(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	requesterID := uuid.New()
 	targetUserID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodPost,
-		"/api/v1/user-management/users/invalid-uuid/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Post(servertest.Path("users/invalid-uuid/follow", targetUserID.String()), nil).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	rr.AssertStatus(http.StatusUnprocessableEntity)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
 func TestFollowUserComponent_ValidationError_InvalidTargetUUID(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	requesterID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodPost,
-		"/api/v1/user-management/users/"+requesterID.String()+"/follow/invalid-uuid",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Post(servertest.Path("users", requesterID.String(), "follow/invalid-uuid"), nil).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	rr.AssertStatus(http.StatusUnprocessableEntity)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
@@ -1628,22 +938,11 @@ func TestFollowUserComponent_ValidationError_InvalidTargetUUID(t *testing.T) {
 func TestUnfollowUserComponent_Success(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	followerID := uuid.New()
 	targetUserID := uuid.New()
@@ -1657,18 +956,11 @@ func TestUnfollowUserComponent_Success(t *testing.T) {
 	}, nil)
 	mockSocialRepo.On("UnfollowUser", mock.Anything, followerID, targetUserID).Return(nil)
 
-	req := httptest.NewRequest(
-		http.MethodDelete,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", followerID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Delete(servertest.Path("users", followerID.String(), "follow", targetUserID.String())).
+		As(followerID).
+		Do(t)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), "Successfully unfollowed user")
 	mockUserRepo.AssertExpectations(t)
 	mockSocialRepo.AssertExpectations(t)
@@ -1677,24 +969,19 @@ func TestUnfollowUserComponent_Success(t *testing.T) {
 func TestUnfollowUserComponent_Success_AdminOverride(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	adminID := uuid.New()
+	roles := mocks.NewRoleRepository(t)
+	roles.On("GetUserRole", mock.Anything, adminID).Return(dto.UserRoleAdmin, nil)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t,
+		servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore),
+		servertest.WithRoles(roles),
+	)
 
-	adminID := uuid.New()
 	userID := uuid.New()
 	targetUserID := uuid.New()
 
@@ -1707,19 +994,11 @@ func TestUnfollowUserComponent_Success_AdminOverride(t *testing.T) {
 	}, nil)
 	mockSocialRepo.On("UnfollowUser", mock.Anything, userID, targetUserID).Return(nil)
 
-	req := httptest.NewRequest(
-		http.MethodDelete,
-		"/api/v1/user-management/users/"+userID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", adminID.String())
-	req.Header.Set("X-User-Role", "admin")
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Delete(servertest.Path("users", userID.String(), "follow", targetUserID.String())).
+		As(adminID).
+		Do(t)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), "Successfully unfollowed user")
 	mockUserRepo.AssertExpectations(t)
 	mockSocialRepo.AssertExpectations(t)
@@ -1729,22 +1008,11 @@ func TestUnfollowUserComponent_Success_AdminOverride(t *testing.T) {
 func TestUnfollowUserComponent_Success_Idempotent(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	followerID := uuid.New()
 	targetUserID := uuid.New()
@@ -1759,18 +1027,11 @@ func TestUnfollowUserComponent_Success_Idempotent(t *testing.T) {
 	// Unfollow returns nil even if not following (idempotent)
 	mockSocialRepo.On("UnfollowUser", mock.Anything, followerID, targetUserID).Return(nil)
 
-	req := httptest.NewRequest(
-		http.MethodDelete,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", followerID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Delete(servertest.Path("users", followerID.String(), "follow", targetUserID.String())).
+		As(followerID).
+		Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), "Successfully unfollowed user")
 	mockUserRepo.AssertExpectations(t)
 	mockSocialRepo.AssertExpectations(t)
@@ -1779,77 +1040,39 @@ func TestUnfollowUserComponent_Success_Idempotent(t *testing.T) {
 func TestUnfollowUserComponent_BadRequest_SelfUnfollow(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodDelete,
-		"/api/v1/user-management/users/"+userID.String()+"/follow/"+userID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", userID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Delete(servertest.Path("users", userID.String(), "follow", userID.String())).As(userID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr.AssertStatus(http.StatusBadRequest)
 	assert.Contains(t, rr.Body.String(), "Cannot unfollow yourself")
 }
 
 func TestUnfollowUserComponent_NotFound_TargetDoesNotExist(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	followerID := uuid.New()
 	targetUserID := uuid.New()
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(nil, repository.ErrUserNotFound)
 
-	req := httptest.NewRequest(
-		http.MethodDelete,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", followerID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Delete(servertest.Path("users", followerID.String(), "follow", targetUserID.String())).
+		As(followerID).
+		Do(t)
 
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 	mockUserRepo.AssertExpectations(t)
 }
@@ -1857,22 +1080,11 @@ func TestUnfollowUserComponent_NotFound_TargetDoesNotExist(t *testing.T) {
 func TestUnfollowUserComponent_NotFound_TargetInactive(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	followerID := uuid.New()
 	targetUserID := uuid.New()
@@ -1884,18 +1096,11 @@ func TestUnfollowUserComponent_NotFound_TargetInactive(t *testing.T) {
 		IsActive: false,
 	}, nil)
 
-	req := httptest.NewRequest(
-		http.MethodDelete,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", followerID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Delete(servertest.Path("users", followerID.String(), "follow", targetUserID.String())).
+		As(followerID).
+		Do(t)
 
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 	mockUserRepo.AssertExpectations(t)
 }
@@ -1904,151 +1109,74 @@ func TestUnfollowUserComponent_NotFound_TargetInactive(t *testing.T) {
 func TestUnfollowUserComponent_Forbidden_UserIdMismatch(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	requesterID := uuid.New()
 	pathUserID := uuid.New()
 	targetUserID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodDelete,
-		"/api/v1/user-management/users/"+pathUserID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Delete(servertest.Path("users", pathUserID.String(), "follow", targetUserID.String())).
+		As(requesterID).
+		Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusForbidden, rr.Code)
+	rr.AssertStatus(http.StatusForbidden)
 	assert.Contains(t, rr.Body.String(), "Cannot perform unfollow action for another user")
 }
 
 func TestUnfollowUserComponent_Unauthorized_MissingHeader(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	followerID := uuid.New()
 	targetUserID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodDelete,
-		"/api/v1/user-management/users/"+followerID.String()+"/follow/"+targetUserID.String(),
-		nil,
-	)
+	rr := srv.Delete(servertest.Path("users", followerID.String(), "follow", targetUserID.String())).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr.AssertStatus(http.StatusUnauthorized)
 	assert.Contains(t, rr.Body.String(), "UNAUTHORIZED")
 }
 
 func TestUnfollowUserComponent_ValidationError_InvalidUserUUID(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	requesterID := uuid.New()
 	targetUserID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodDelete,
-		"/api/v1/user-management/users/invalid-uuid/follow/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Delete(servertest.Path("users/invalid-uuid/follow", targetUserID.String())).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	rr.AssertStatus(http.StatusUnprocessableEntity)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
 func TestUnfollowUserComponent_ValidationError_InvalidTargetUUID(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	requesterID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodDelete,
-		"/api/v1/user-management/users/"+requesterID.String()+"/follow/invalid-uuid",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Delete(servertest.Path("users", requesterID.String(), "follow/invalid-uuid")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	rr.AssertStatus(http.StatusUnprocessableEntity)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
@@ -2085,28 +1213,17 @@ func createTestActivityDataComponent() (
 func TestGetUserActivityComponent_Success_Authenticated(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 	recipes, follows, reviews, favorites := createTestActivityDataComponent()
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
@@ -2116,19 +1233,11 @@ func TestGetUserActivityComponent_Success_Authenticated(t *testing.T) {
 	mockSocialRepo.On("GetRecentReviews", mock.Anything, targetUserID, 15).Return(reviews, nil).Once()
 	mockSocialRepo.On("GetRecentFavorites", mock.Anything, targetUserID, 15).Return(favorites, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/activity", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "activity")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 
-	var apiResp dto.UserActivityResponse
-
-	err := json.Unmarshal(rr.Body.Bytes(), &apiResp)
-	require.NoError(t, err)
+	apiResp := servertest.DecodeJSON[dto.UserActivityResponse](rr)
 	assert.Equal(t, targetUserID.String(), apiResp.UserID)
 	assert.Len(t, apiResp.RecentRecipes, 2)
 	assert.Len(t, apiResp.RecentFollows, 1)
@@ -2142,53 +1251,32 @@ func TestGetUserActivityComponent_Success_Authenticated(t *testing.T) {
 func TestGetUserActivityComponent_Unauthorized_Anonymous(t *testing.T) {
 	t.Parallel()
 
-	c := &app.Container{
-		Config: config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t)
 
 	targetUserID := uuid.New()
 
 	// No X-User-Id header - requires authentication
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/activity", nil)
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "activity")).Do(t)
 
 	// All protected routes now require authentication
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr.AssertStatus(http.StatusUnauthorized)
 	assert.Contains(t, rr.Body.String(), "UNAUTHORIZED")
 }
 
 func TestGetUserActivityComponent_Success_CustomLimit(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
@@ -2197,18 +1285,9 @@ func TestGetUserActivityComponent_Success_CustomLimit(t *testing.T) {
 	mockSocialRepo.On("GetRecentReviews", mock.Anything, targetUserID, 50).Return([]dto.ReviewSummary{}, nil).Once()
 	mockSocialRepo.On("GetRecentFavorites", mock.Anything, targetUserID, 50).Return([]dto.FavoriteSummary{}, nil).Once()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+targetUserID.String()+"/activity?per_type_limit=50",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "activity?per_type_limit=50")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 
 	mockUserRepo.AssertExpectations(t)
 	mockSocialRepo.AssertExpectations(t)
@@ -2217,22 +1296,11 @@ func TestGetUserActivityComponent_Success_CustomLimit(t *testing.T) {
 func TestGetUserActivityComponent_Success_OwnProfile(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 
@@ -2245,14 +1313,9 @@ func TestGetUserActivityComponent_Success_OwnProfile(t *testing.T) {
 	mockSocialRepo.On("GetRecentReviews", mock.Anything, userID, 15).Return(reviews, nil).Once()
 	mockSocialRepo.On("GetRecentFavorites", mock.Anything, userID, 15).Return(favorites, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+userID.String()+"/activity", nil)
-	req.Header.Set("X-User-Id", userID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", userID.String(), "activity")).As(userID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 
 	// Privacy preferences should NOT be fetched when viewing own activity
 	mockUserRepo.AssertNotCalled(t, "FindPrivacyPreferencesByUserID", mock.Anything, mock.Anything)
@@ -2263,36 +1326,20 @@ func TestGetUserActivityComponent_Success_OwnProfile(t *testing.T) {
 func TestGetUserActivityComponent_NotFound(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(nil, repository.ErrUserNotFound).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/activity", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "activity")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 
 	mockUserRepo.AssertExpectations(t)
@@ -2301,40 +1348,24 @@ func TestGetUserActivityComponent_NotFound(t *testing.T) {
 func TestGetUserActivityComponent_Forbidden_PrivateProfile(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	targetUser := createTestUserComponent(targetUserID, "privateuser")
-	privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+	privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(privatePrivacy, nil).Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/activity", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "activity")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusForbidden, rr.Code)
+	rr.AssertStatus(http.StatusForbidden)
 	assert.Contains(t, rr.Body.String(), "FORBIDDEN")
 
 	mockUserRepo.AssertExpectations(t)
@@ -2343,133 +1374,67 @@ func TestGetUserActivityComponent_Forbidden_PrivateProfile(t *testing.T) {
 func TestGetUserActivityComponent_Unauthorized_FollowersOnlyAnonymous(t *testing.T) {
 	t.Parallel()
 
-	c := &app.Container{
-		Config: config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t)
 
 	targetUserID := uuid.New()
 
 	// Anonymous request (no X-User-Id header) - auth check happens before profile visibility check
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+targetUserID.String()+"/activity", nil)
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "activity")).Do(t)
 
 	// Returns 401 because auth is required before any visibility check
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr.AssertStatus(http.StatusUnauthorized)
 	assert.Contains(t, rr.Body.String(), "UNAUTHORIZED")
 }
 
 func TestGetUserActivityComponent_ValidationError_InvalidUUID(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	requesterID := uuid.New()
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/invalid-uuid/activity", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
+	rr := srv.Get(servertest.Path("users/invalid-uuid/activity")).As(requesterID).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	rr.AssertStatus(http.StatusUnprocessableEntity)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
 func TestGetUserActivityComponent_ValidationError_InvalidPerTypeLimit(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+targetUserID.String()+"/activity?per_type_limit=0",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "activity?per_type_limit=0")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr.AssertStatus(http.StatusBadRequest)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
 func TestGetUserActivityComponent_ValidationError_PerTypeLimitTooHigh(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+targetUserID.String()+"/activity?per_type_limit=101",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", targetUserID.String(), "activity?per_type_limit=101")).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr.AssertStatus(http.StatusBadRequest)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
@@ -2478,22 +1443,11 @@ func TestGetUserActivityComponent_ValidationError_PerTypeLimitTooHigh(t *testing
 func TestCheckFollowingComponent_Success_IsFollowing(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 	targetUserID := uuid.New()
@@ -2501,7 +1455,7 @@ func TestCheckFollowingComponent_Success_IsFollowing(t *testing.T) {
 
 	user := createTestUserComponent(userID, "testuser")
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 	// Mock both users exist and are active with public profiles
 	mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
@@ -2512,23 +1466,11 @@ func TestCheckFollowingComponent_Success_IsFollowing(t *testing.T) {
 	followedAt := time.Now().Add(-24 * time.Hour)
 	mockSocialRepo.On("CheckFollowing", mock.Anything, userID, targetUserID).Return(&followedAt, nil)
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+userID.String()+"/following/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", userID.String(), "following", targetUserID.String())).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
+	rr.AssertStatus(http.StatusOK)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
-
-	var response dto.FollowingCheckResponse
-
-	err := json.Unmarshal(rr.Body.Bytes(), &response)
-	require.NoError(t, err)
+	response := servertest.DecodeJSON[dto.FollowingCheckResponse](rr)
 
 	assert.True(t, response.IsFollowing)
 	assert.NotNil(t, response.FollowedAt)
@@ -2537,22 +1479,11 @@ func TestCheckFollowingComponent_Success_IsFollowing(t *testing.T) {
 func TestCheckFollowingComponent_Success_NotFollowing(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 	targetUserID := uuid.New()
@@ -2560,7 +1491,7 @@ func TestCheckFollowingComponent_Success_NotFollowing(t *testing.T) {
 
 	user := createTestUserComponent(userID, "testuser")
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 	// Mock both users exist and are active with public profiles
 	mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
@@ -2570,23 +1501,11 @@ func TestCheckFollowingComponent_Success_NotFollowing(t *testing.T) {
 	// Mock no follow relationship
 	mockSocialRepo.On("CheckFollowing", mock.Anything, userID, targetUserID).Return((*time.Time)(nil), nil)
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+userID.String()+"/following/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", userID.String(), "following", targetUserID.String())).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
+	rr.AssertStatus(http.StatusOK)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
-
-	var response dto.FollowingCheckResponse
-
-	err := json.Unmarshal(rr.Body.Bytes(), &response)
-	require.NoError(t, err)
+	response := servertest.DecodeJSON[dto.FollowingCheckResponse](rr)
 
 	assert.False(t, response.IsFollowing)
 	assert.Nil(t, response.FollowedAt)
@@ -2595,22 +1514,11 @@ func TestCheckFollowingComponent_Success_NotFollowing(t *testing.T) {
 func TestCheckFollowingComponent_Success_OwnProfile(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	requesterID := uuid.New()
 	targetUserID := uuid.New()
@@ -2625,23 +1533,13 @@ func TestCheckFollowingComponent_Success_OwnProfile(t *testing.T) {
 	followedAt := time.Now()
 	mockSocialRepo.On("CheckFollowing", mock.Anything, requesterID, targetUserID).Return(&followedAt, nil)
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+requesterID.String()+"/following/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", requesterID.String(), "following", targetUserID.String())).
+		As(requesterID).
+		Do(t)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 
-	var response dto.FollowingCheckResponse
-
-	err := json.Unmarshal(rr.Body.Bytes(), &response)
-	require.NoError(t, err)
+	response := servertest.DecodeJSON[dto.FollowingCheckResponse](rr)
 
 	assert.True(t, response.IsFollowing)
 }
@@ -2649,22 +1547,11 @@ func TestCheckFollowingComponent_Success_OwnProfile(t *testing.T) {
 func TestCheckFollowingComponent_NotFound_UserDoesNotExist(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 	targetUserID := uuid.New()
@@ -2673,47 +1560,27 @@ func TestCheckFollowingComponent_NotFound_UserDoesNotExist(t *testing.T) {
 	// Mock user does not exist - return repository.ErrUserNotFound
 	mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(nil, repository.ErrUserNotFound)
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+userID.String()+"/following/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
+	rr := srv.Get(servertest.Path("users", userID.String(), "following", targetUserID.String())).As(requesterID).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 }
 
 func TestCheckFollowingComponent_NotFound_TargetUserDoesNotExist(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
 	user := createTestUserComponent(userID, "testuser")
-	publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+	publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 	// Mock user exists with public profile
 	mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
@@ -2721,40 +1588,20 @@ func TestCheckFollowingComponent_NotFound_TargetUserDoesNotExist(t *testing.T) {
 	// Mock target user does not exist - return repository.ErrUserNotFound
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(nil, repository.ErrUserNotFound)
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+userID.String()+"/following/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", userID.String(), "following", targetUserID.String())).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 }
 
 func TestCheckFollowingComponent_Forbidden_PrivateProfile(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 	targetUserID := uuid.New()
@@ -2762,25 +1609,16 @@ func TestCheckFollowingComponent_Forbidden_PrivateProfile(t *testing.T) {
 
 	user := createTestUserComponent(userID, "testuser")
 	targetUser := createTestUserComponent(targetUserID, "targetuser")
-	privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+	privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 
 	// Mock user has private profile - service checks both users before privacy
 	mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
 	mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil)
 	mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).Return(privatePrivacy, nil)
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+userID.String()+"/following/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", userID.String(), "following", targetUserID.String())).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusForbidden, rr.Code)
+	rr.AssertStatus(http.StatusForbidden)
 	assert.Contains(t, rr.Body.String(), "FORBIDDEN")
 }
 
@@ -2788,76 +1626,37 @@ func TestCheckFollowingComponent_Forbidden_PrivateProfile(t *testing.T) {
 func TestCheckFollowingComponent_Unauthorized_MissingHeader(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 	targetUserID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+userID.String()+"/following/"+targetUserID.String(),
-		nil,
-	)
 	// No X-User-Id header set
+	rr := srv.Get(servertest.Path("users", userID.String(), "following", targetUserID.String())).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr.AssertStatus(http.StatusUnauthorized)
 	assert.Contains(t, rr.Body.String(), "UNAUTHORIZED")
 }
 
 func TestCheckFollowingComponent_ValidationError_InvalidUserUUID(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
-
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	targetUserID := uuid.New()
 	requesterID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/invalid-uuid/following/"+targetUserID.String(),
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users/invalid-uuid/following", targetUserID.String())).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	rr.AssertStatus(http.StatusUnprocessableEntity)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
@@ -2865,37 +1664,17 @@ func TestCheckFollowingComponent_ValidationError_InvalidUserUUID(t *testing.T) {
 func TestCheckFollowingComponent_ValidationError_InvalidTargetUUID(t *testing.T) {
 	t.Parallel()
 
-	mockUserRepo := new(MockUserRepo)
-	mockSocialRepo := new(MockSocialRepoComponent)
-	mockTokenStore := new(MockTokenStore)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	userSvc := service.NewUserService(mockUserRepo, mockTokenStore, nil)
-	socialSvc := service.NewSocialService(mockUserRepo, mockSocialRepo, nil)
-
-	c := &app.Container{
-		UserService:   userSvc,
-		SocialService: socialSvc,
-		Config:        config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockUserRepo, mockSocialRepo, mockTokenStore))
 
 	userID := uuid.New()
 	requesterID := uuid.New()
 
-	req := httptest.NewRequest(
-		http.MethodGet,
-		"/api/v1/user-management/users/"+userID.String()+"/following/invalid-uuid",
-		nil,
-	)
-	req.Header.Set("X-User-Id", requesterID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", userID.String(), "following/invalid-uuid")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	rr.AssertStatus(http.StatusUnprocessableEntity)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
@@ -0,0 +1,51 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func TestTypeahead(t *testing.T) {
+	t.Parallel()
+
+	hidden := false
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{
+			{Username: "alice"},
+			{Username: "Alex"},
+			{Username: "alina", Preferences: &dto.UserPreferencesUpdateRequest{
+				Privacy: &dto.PrivacyPreferencesUpdate{Discoverable: &hidden},
+			}},
+			{Username: "bob"},
+		},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+	bob, _ := f.UserID("bob")
+
+	w := srv.Get(servertest.Path("users/search/typeahead?prefix=aL")).As(bob).Do(t)
+	w.AssertStatus(http.StatusOK)
+
+	var usernames []string
+	for _, result := range servertest.DecodeJSON[dto.UserTypeaheadResponse](w).Results {
+		usernames = append(usernames, result.Username)
+	}
+
+	assert.ElementsMatch(t, []string{"alice", "Alex"}, usernames, "undiscoverable users are not suggested")
+
+	srv.Get(servertest.Path("users/search/typeahead")).
+		As(bob).
+		Do(t).
+		AssertError(http.StatusBadRequest, "VALIDATION_ERROR")
+}
@@ -1,207 +1,35 @@
 package component_test
 
 import (
-	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
-	"net/http/httptest"
-	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
-	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-)
-
-const mockErrorFmt = "mock error: %w"
 
-var (
-	errMockArgs           = errors.New("mock: missing args")
-	errMockInvalidUser    = errors.New("invalid type assertion for User")
-	errMockInvalidPrivacy = errors.New("invalid type assertion for PrivacyPreferences")
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
 )
 
-// MockUserRepo for component tests.
-type MockUserRepo struct {
-	mock.Mock
-}
-
-func (m *MockUserRepo) FindUserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf(mockErrorFmt, err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.User); ok {
-		return val, nil
-	}
-
-	return nil, errMockInvalidUser
-}
-
-func (m *MockUserRepo) FindPrivacyPreferencesByUserID(
-	ctx context.Context,
-	userID uuid.UUID,
-) (*dto.PrivacyPreferences, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf(mockErrorFmt, err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.PrivacyPreferences); ok {
-		return val, nil
-	}
-
-	return nil, errMockInvalidPrivacy
-}
-
-func (m *MockUserRepo) IsFollowing(ctx context.Context, followerID, followedID uuid.UUID) (bool, error) {
-	args := m.Called(ctx, followerID, followedID)
-
-	err := args.Error(1)
-	if err != nil {
-		return args.Bool(0), fmt.Errorf(mockErrorFmt, err)
-	}
-
-	return args.Bool(0), nil
-}
-
-func (m *MockUserRepo) UpdateUser(
-	ctx context.Context,
-	userID uuid.UUID,
-	update *dto.UserProfileUpdateRequest,
-) (*dto.User, error) {
-	args := m.Called(ctx, userID, update)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf(mockErrorFmt, err)
-		}
-
-		return nil, errMockArgs
-	}
-
-	if val, ok := args.Get(0).(*dto.User); ok {
-		return val, nil
-	}
-
-	return nil, errMockInvalidUser
-}
-
-func (m *MockUserRepo) SearchUsers(
-	ctx context.Context,
-	query string,
-	limit, offset int,
-) ([]dto.UserSearchResult, int, error) {
-	args := m.Called(ctx, query, limit, offset)
-
-	err := args.Error(2)
-	if err != nil {
-		return nil, 0, fmt.Errorf(mockErrorFmt, err)
-	}
-
-	results, _ := args.Get(0).([]dto.UserSearchResult)
-
-	return results, args.Int(1), nil
-}
-
-func (m *MockUserRepo) GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error) {
-	args := m.Called(ctx)
-	if args.Get(0) == nil {
-		return nil, fmt.Errorf("mock error: %w", args.Error(1))
-	}
-
-	if val, ok := args.Get(0).(*dto.UserStatsResponse); ok {
-		return val, nil
-	}
-
-	return nil, fmt.Errorf("mock error: %w", args.Error(1))
-}
-
-// MockTokenStore for component tests.
-type MockTokenStore struct {
-	mock.Mock
-}
-
-func (m *MockTokenStore) StoreDeleteToken(
-	ctx context.Context,
-	userID uuid.UUID,
-	token string,
-	ttl time.Duration,
-) error {
-	args := m.Called(ctx, userID, token, ttl)
-
-	err := args.Error(0)
-	if err != nil {
-		return fmt.Errorf(mockErrorFmt, err)
-	}
-
-	return nil
-}
-
-func (m *MockTokenStore) GetDeleteToken(ctx context.Context, userID uuid.UUID) (string, error) {
-	args := m.Called(ctx, userID)
-
-	err := args.Error(1)
-	if err != nil {
-		return args.String(0), fmt.Errorf(mockErrorFmt, err)
-	}
-
-	return args.String(0), nil
-}
-
-func (m *MockTokenStore) DeleteDeleteToken(ctx context.Context, userID uuid.UUID) error {
-	args := m.Called(ctx, userID)
-
-	err := args.Error(0)
-	if err != nil {
-		return fmt.Errorf(mockErrorFmt, err)
-	}
-
-	return nil
-}
+var ()
 
 func TestUserProfileComponent(t *testing.T) {
 	t.Parallel()
 
 	// Create Mock Repo
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-
-	// Create Service using Mock Repo
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	// Create Container
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	// Setup Health so router doesn't panic
-	c.HealthService = service.NewHealthService(nil, nil)
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
 
-	// Create Server
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 
@@ -215,10 +43,10 @@ func TestUserProfileComponent(t *testing.T) {
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
-	privacy := &dto.PrivacyPreferences{
-		ProfileVisibility: "public",
-		ShowEmail:         true,
-		ShowFullName:      true,
+	privacy := &dto.UserPrivacyPreferences{
+		ProfileVisibility:     dto.ProfileVisibilityPublic,
+		ContactInfoVisibility: dto.ProfileVisibilityPublic,
+		ShowFullName:          true,
 	}
 
 	// Mock Expectations
@@ -227,19 +55,12 @@ func TestUserProfileComponent(t *testing.T) {
 
 	// Perform Request
 	requesterID := uuid.New()
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+userID.String()+"/profile", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", userID.String(), "profile")).As(requesterID).Do(t)
 
 	// Assert Response
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 
-	var resp dto.UserProfileResponse
-
-	err := json.Unmarshal(rr.Body.Bytes(), &resp)
-	require.NoError(t, err)
+	resp := servertest.DecodeJSON[dto.UserProfileResponse](rr)
 
 	assert.Equal(t, userID.String(), resp.UserID)
 	assert.NotNil(t, resp.FullName)
@@ -251,18 +72,9 @@ func TestUserProfileComponent(t *testing.T) {
 func TestUpdateUserProfileComponent_Success(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 	now := time.Now()
@@ -291,70 +103,62 @@ func TestUpdateUserProfileComponent_Success(t *testing.T) {
 	mockRepo.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(updatedUser, nil)
 
 	reqBody := `{"username": "newusername"}`
-	req := httptest.NewRequest(http.MethodPut, "/api/v1/user-management/users/profile", strings.NewReader(reqBody))
-	req.Header.Set("X-User-Id", userID.String())
-	req.Header.Set("Content-Type", "application/json")
+	rr := srv.Put(servertest.Path("users/profile"), reqBody).As(userID).Do(t)
 
-	rr := httptest.NewRecorder()
+	rr.AssertStatus(http.StatusOK)
 
-	handler.ServeHTTP(rr, req)
+	apiResp := servertest.DecodeJSON[dto.UserProfileResponse](rr)
+	assert.Equal(t, "newusername", apiResp.Username)
+}
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+func TestUpdateUserProfileComponent_EmailRequiresConfirmation(t *testing.T) {
+	t.Parallel()
 
-	var apiResp dto.UserProfileResponse
+	email := "alice@example.com"
+	f := &fixtures.Fixtures{Users: []fixtures.User{{Username: "alice", Email: &email}}}
 
-	err := json.Unmarshal(rr.Body.Bytes(), &apiResp)
+	store, err := memory.NewFromFixtures(t.Context(), f)
 	require.NoError(t, err)
-	assert.Equal(t, "newusername", apiResp.Username)
+
+	alice, _ := f.UserID("alice")
+	srv := servertest.New(t, servertest.WithMemoryStore(store))
+
+	// The email only changes through the two-step email change flow
+	srv.Put(servertest.Path("users/profile"), `{"email": "mallory@example.com", "bio": "hi"}`).
+		As(alice).
+		Do(t).
+		AssertError(http.StatusBadRequest, "EMAIL_CHANGE_REQUIRES_CONFIRMATION")
+
+	user, err := store.FindUserByID(t.Context(), alice)
+	require.NoError(t, err)
+	require.NotNil(t, user.Email)
+	assert.Equal(t, email, *user.Email)
+	assert.Nil(t, user.Bio, "nothing in the rejected update is applied")
 }
 
 func TestUpdateUserProfileComponent_NotFound(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 
 	mockRepo.On("FindUserByID", mock.Anything, userID).Return(nil, repository.ErrUserNotFound)
 
 	reqBody := `{"username": "newusername"}`
-	req := httptest.NewRequest(http.MethodPut, "/api/v1/user-management/users/profile", strings.NewReader(reqBody))
-	req.Header.Set("X-User-Id", userID.String())
-	req.Header.Set("Content-Type", "application/json")
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Put(servertest.Path("users/profile"), reqBody).As(userID).Do(t)
 
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 }
 
 func TestUpdateUserProfileComponent_DuplicateUsername(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 	now := time.Now()
@@ -371,64 +175,34 @@ func TestUpdateUserProfileComponent_DuplicateUsername(t *testing.T) {
 	mockRepo.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(nil, repository.ErrDuplicateUsername)
 
 	reqBody := `{"username": "existinguser"}`
-	req := httptest.NewRequest(http.MethodPut, "/api/v1/user-management/users/profile", strings.NewReader(reqBody))
-	req.Header.Set("X-User-Id", userID.String())
-	req.Header.Set("Content-Type", "application/json")
+	rr := srv.Put(servertest.Path("users/profile"), reqBody).As(userID).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusConflict, rr.Code)
+	rr.AssertStatus(http.StatusConflict)
 }
 
 func TestUpdateUserProfileComponent_ValidationError(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 
 	// Invalid username (too short)
 	reqBody := `{"username": "ab"}`
-	req := httptest.NewRequest(http.MethodPut, "/api/v1/user-management/users/profile", strings.NewReader(reqBody))
-	req.Header.Set("X-User-Id", userID.String())
-	req.Header.Set("Content-Type", "application/json")
-
-	rr := httptest.NewRecorder()
+	rr := srv.Put(servertest.Path("users/profile"), reqBody).As(userID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr.AssertStatus(http.StatusBadRequest)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
 func TestRequestAccountDeletionComponent_Success(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 	now := time.Now()
@@ -444,19 +218,11 @@ func TestRequestAccountDeletionComponent_Success(t *testing.T) {
 	mockRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
 	mockTokenStore.On("StoreDeleteToken", mock.Anything, userID, mock.Anything, service.DeleteTokenTTL).Return(nil)
 
-	req := httptest.NewRequest(http.MethodPost, "/api/v1/user-management/users/account/delete-request", nil)
-	req.Header.Set("X-User-Id", userID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Post(servertest.Path("users/account/delete-request"), nil).As(userID).Do(t)
 
-	handler.ServeHTTP(rr, req)
+	rr.AssertStatus(http.StatusOK)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
-
-	var apiResp dto.UserAccountDeleteRequestResponse
-
-	err := json.Unmarshal(rr.Body.Bytes(), &apiResp)
-	require.NoError(t, err)
+	apiResp := servertest.DecodeJSON[dto.UserAccountDeleteRequestResponse](rr)
 	assert.Equal(t, userID.String(), apiResp.UserID)
 	assert.NotEmpty(t, apiResp.ConfirmationToken)
 	assert.False(t, apiResp.ExpiresAt.IsZero())
@@ -465,104 +231,53 @@ func TestRequestAccountDeletionComponent_Success(t *testing.T) {
 func TestRequestAccountDeletionComponent_Unauthorized(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	// Missing X-User-Id header
-	req := httptest.NewRequest(http.MethodPost, "/api/v1/user-management/users/account/delete-request", nil)
+	rr := srv.Post(servertest.Path("users/account/delete-request"), nil).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr.AssertStatus(http.StatusUnauthorized)
 }
 
 func TestRequestAccountDeletionComponent_NotFound(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 
 	mockRepo.On("FindUserByID", mock.Anything, userID).Return(nil, repository.ErrUserNotFound)
 
-	req := httptest.NewRequest(http.MethodPost, "/api/v1/user-management/users/account/delete-request", nil)
-	req.Header.Set("X-User-Id", userID.String())
-
-	rr := httptest.NewRecorder()
+	rr := srv.Post(servertest.Path("users/account/delete-request"), nil).As(userID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 }
 
 func TestRequestAccountDeletionComponent_ServiceUnavailable(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	// Use nil token store to simulate cache unavailable
-	svc := service.NewUserService(mockRepo, nil, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, nil))
 
 	userID := uuid.New()
 
-	req := httptest.NewRequest(http.MethodPost, "/api/v1/user-management/users/account/delete-request", nil)
-	req.Header.Set("X-User-Id", userID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Post(servertest.Path("users/account/delete-request"), nil).As(userID).Do(t)
 
-	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	rr.AssertStatus(http.StatusServiceUnavailable)
 	assert.Contains(t, rr.Body.String(), "SERVICE_UNAVAILABLE")
 }
 
 func TestConfirmAccountDeletionComponent_Success(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 	token := uuid.New().String()
@@ -581,20 +296,11 @@ func TestConfirmAccountDeletionComponent_Success(t *testing.T) {
 	mockTokenStore.On("DeleteDeleteToken", mock.Anything, userID).Return(nil)
 
 	reqBody := fmt.Sprintf(`{"confirmationToken": "%s"}`, token)
-	req := httptest.NewRequest(http.MethodDelete, "/api/v1/user-management/users/account", strings.NewReader(reqBody))
-	req.Header.Set("X-User-Id", userID.String())
-	req.Header.Set("Content-Type", "application/json")
+	rr := srv.NewRequest(http.MethodDelete, servertest.Path("users/account"), reqBody).As(userID).Do(t)
 
-	rr := httptest.NewRecorder()
+	rr.AssertStatus(http.StatusOK)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
-
-	var apiResp dto.UserConfirmAccountDeleteResponse
-
-	err := json.Unmarshal(rr.Body.Bytes(), &apiResp)
-	require.NoError(t, err)
+	apiResp := servertest.DecodeJSON[dto.UserConfirmAccountDeleteResponse](rr)
 	assert.Equal(t, userID.String(), apiResp.UserID)
 	assert.False(t, apiResp.DeactivatedAt.IsZero())
 }
@@ -602,18 +308,9 @@ func TestConfirmAccountDeletionComponent_Success(t *testing.T) {
 func TestConfirmAccountDeletionComponent_InvalidToken(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 	storedToken := uuid.New().String()
@@ -621,74 +318,38 @@ func TestConfirmAccountDeletionComponent_InvalidToken(t *testing.T) {
 	mockTokenStore.On("GetDeleteToken", mock.Anything, userID).Return(storedToken, nil)
 
 	reqBody := `{"confirmationToken": "wrong-token"}`
-	req := httptest.NewRequest(http.MethodDelete, "/api/v1/user-management/users/account", strings.NewReader(reqBody))
-	req.Header.Set("X-User-Id", userID.String())
-	req.Header.Set("Content-Type", "application/json")
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.NewRequest(http.MethodDelete, servertest.Path("users/account"), reqBody).As(userID).Do(t)
 
-	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr.AssertStatus(http.StatusBadRequest)
 	assert.Contains(t, rr.Body.String(), "INVALID_TOKEN")
 }
 
 func TestConfirmAccountDeletionComponent_Unauthorized(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	reqBody := `{"confirmationToken": "some-token"}`
-	req := httptest.NewRequest(http.MethodDelete, "/api/v1/user-management/users/account", strings.NewReader(reqBody))
-	req.Header.Set("Content-Type", "application/json")
 	// Missing X-User-Id header
+	rr := srv.NewRequest(http.MethodDelete, servertest.Path("users/account"), reqBody).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr.AssertStatus(http.StatusUnauthorized)
 }
 
 func TestConfirmAccountDeletionComponent_ServiceUnavailable(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	// Use nil token store to simulate cache unavailable
-	svc := service.NewUserService(mockRepo, nil, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, nil))
 
 	userID := uuid.New()
 
 	reqBody := `{"confirmationToken": "some-token"}`
-	req := httptest.NewRequest(http.MethodDelete, "/api/v1/user-management/users/account", strings.NewReader(reqBody))
-	req.Header.Set("X-User-Id", userID.String())
-	req.Header.Set("Content-Type", "application/json")
-
-	rr := httptest.NewRecorder()
+	rr := srv.NewRequest(http.MethodDelete, servertest.Path("users/account"), reqBody).As(userID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	rr.AssertStatus(http.StatusServiceUnavailable)
 	assert.Contains(t, rr.Body.String(), "SERVICE_UNAVAILABLE")
 }
 
@@ -699,18 +360,9 @@ func TestConfirmAccountDeletionComponent_ServiceUnavailable(t *testing.T) {
 func TestSearchUsersComponent_Success(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 	now := time.Now()
@@ -727,16 +379,11 @@ func TestSearchUsersComponent_Success(t *testing.T) {
 		},
 	}
 
-	mockRepo.On("SearchUsers", mock.Anything, "test", 20, 0).Return(searchResults, 1, nil)
-
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/search?query=test", nil)
-	req.Header.Set("X-User-Id", userID.String())
+	mockRepo.On("SearchUsers", mock.Anything, "test", dto.LocationFilter{}, 20, 0).Return(searchResults, 1, nil)
 
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users/search?query=test")).As(userID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 
 	// Verify response structure
 	assert.Contains(t, rr.Body.String(), "testuser1")
@@ -746,32 +393,19 @@ func TestSearchUsersComponent_Success(t *testing.T) {
 func TestSearchUsersComponent_CountOnly(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 
 	// When countOnly is true, service still calls repo but returns empty results
-	mockRepo.On("SearchUsers", mock.Anything, "test", 20, 0).Return([]dto.UserSearchResult{}, 5, nil)
-
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/search?query=test&countOnly=true", nil)
-	req.Header.Set("X-User-Id", userID.String())
-
-	rr := httptest.NewRecorder()
+	mockRepo.On("SearchUsers", mock.Anything, "test", dto.LocationFilter{}, 20, 0).
+		Return([]dto.UserSearchResult{}, 5, nil)
 
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users/search?query=test&countOnly=true")).As(userID).Do(t)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), `"totalCount":5`)
 	assert.Contains(t, rr.Body.String(), `"results":[]`)
 }
@@ -779,31 +413,18 @@ func TestSearchUsersComponent_CountOnly(t *testing.T) {
 func TestSearchUsersComponent_WithPagination(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 
-	mockRepo.On("SearchUsers", mock.Anything, "test", 10, 5).Return([]dto.UserSearchResult{}, 15, nil)
+	mockRepo.On("SearchUsers", mock.Anything, "test", dto.LocationFilter{}, 10, 5).
+		Return([]dto.UserSearchResult{}, 15, nil)
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/search?query=test&limit=10&offset=5", nil)
-	req.Header.Set("X-User-Id", userID.String())
+	rr := srv.Get(servertest.Path("users/search?query=test&limit=10&offset=5")).As(userID).Do(t)
 
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
+	rr.AssertStatus(http.StatusOK)
 	assert.Contains(t, rr.Body.String(), `"totalCount":15`)
 	assert.Contains(t, rr.Body.String(), `"limit":10`)
 	assert.Contains(t, rr.Body.String(), `"offset":5`)
@@ -812,55 +433,28 @@ func TestSearchUsersComponent_WithPagination(t *testing.T) {
 func TestSearchUsersComponent_Unauthorized(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	// Missing X-User-Id header
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/search?query=test", nil)
-
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users/search?query=test")).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr.AssertStatus(http.StatusUnauthorized)
 }
 
 func TestSearchUsersComponent_InvalidLimit(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/search?query=test&limit=0", nil)
-	req.Header.Set("X-User-Id", userID.String())
-
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users/search?query=test&limit=0")).As(userID).Do(t)
 
-	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr.AssertStatus(http.StatusBadRequest)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
 
@@ -871,18 +465,9 @@ func TestSearchUsersComponent_InvalidLimit(t *testing.T) {
 func TestGetUserByIDComponent_Success(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 	now := time.Now()
@@ -895,8 +480,8 @@ func TestGetUserByIDComponent_Success(t *testing.T) {
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
-	privacy := &dto.PrivacyPreferences{
-		ProfileVisibility: "public",
+	privacy := &dto.UserPrivacyPreferences{
+		ProfileVisibility: dto.ProfileVisibilityPublic,
 		ShowFullName:      true,
 	}
 
@@ -904,18 +489,11 @@ func TestGetUserByIDComponent_Success(t *testing.T) {
 	mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).Return(privacy, nil)
 
 	requesterID := uuid.New()
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+userID.String(), nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", userID.String())).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
+	rr.AssertStatus(http.StatusOK)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
-
-	var apiResp dto.UserSearchResult
-
-	err := json.Unmarshal(rr.Body.Bytes(), &apiResp)
-	require.NoError(t, err)
+	apiResp := servertest.DecodeJSON[dto.UserSearchResult](rr)
 	assert.Equal(t, userID.String(), apiResp.UserID)
 	assert.Equal(t, "publicuser", apiResp.Username)
 }
@@ -923,49 +501,27 @@ func TestGetUserByIDComponent_Success(t *testing.T) {
 func TestGetUserByIDComponent_NotFound_UserDoesNotExist(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	nonExistentID := uuid.New()
 
 	mockRepo.On("FindUserByID", mock.Anything, nonExistentID).Return(nil, repository.ErrUserNotFound)
 
 	requesterID := uuid.New()
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+nonExistentID.String(), nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", nonExistentID.String())).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 }
 
 func TestGetUserByIDComponent_NotFound_PrivateProfile(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 	now := time.Now()
@@ -977,40 +533,27 @@ func TestGetUserByIDComponent_NotFound_PrivateProfile(t *testing.T) {
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
-	privacy := &dto.PrivacyPreferences{
-		ProfileVisibility: "private",
+	privacy := &dto.UserPrivacyPreferences{
+		ProfileVisibility: dto.ProfileVisibilityPrivate,
 	}
 
 	mockRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
 	mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).Return(privacy, nil)
 
 	requesterID := uuid.New()
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+userID.String(), nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", userID.String())).As(requesterID).Do(t)
 
 	// Private profile returns 404, not 403
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 	assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
 }
 
 func TestGetUserByIDComponent_NotFound_FollowersOnlyProfile(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 	now := time.Now()
@@ -1022,39 +565,26 @@ func TestGetUserByIDComponent_NotFound_FollowersOnlyProfile(t *testing.T) {
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
-	privacy := &dto.PrivacyPreferences{
-		ProfileVisibility: "followers_only",
+	privacy := &dto.UserPrivacyPreferences{
+		ProfileVisibility: dto.ProfileVisibilityFriendsOnly,
 	}
 
 	mockRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
 	mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).Return(privacy, nil)
 
 	requesterID := uuid.New()
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+userID.String(), nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users", userID.String())).As(requesterID).Do(t)
 
 	// followers_only returns 404 for non-followers
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 }
 
 func TestGetUserByIDComponent_NotFound_InactiveUser(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	userID := uuid.New()
 	now := time.Now()
@@ -1070,38 +600,21 @@ func TestGetUserByIDComponent_NotFound_InactiveUser(t *testing.T) {
 	mockRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
 
 	requesterID := uuid.New()
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/"+userID.String(), nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-	rr := httptest.NewRecorder()
+	rr := srv.Get(servertest.Path("users", userID.String())).As(requesterID).Do(t)
 
-	handler.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr.AssertStatus(http.StatusNotFound)
 }
 
 func TestGetUserByIDComponent_ValidationError_InvalidUUID(t *testing.T) {
 	t.Parallel()
 
-	mockRepo := new(MockUserRepo)
-	mockTokenStore := new(MockTokenStore)
-	svc := service.NewUserService(mockRepo, mockTokenStore, nil)
-
-	c := &app.Container{
-		UserService: svc,
-		Config:      config.Instance,
-	}
-	c.HealthService = service.NewHealthService(nil, nil)
-
-	srv := server.NewServerWithContainer(c)
-	handler := srv.Handler
+	mockRepo := new(mocks.UserRepository)
+	mockTokenStore := new(mocks.TokenStore)
+	srv := servertest.New(t, servertest.WithRepositories(mockRepo, nil, mockTokenStore))
 
 	requesterID := uuid.New()
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/user-management/users/not-a-uuid", nil)
-	req.Header.Set("X-User-Id", requesterID.String())
-	rr := httptest.NewRecorder()
-
-	handler.ServeHTTP(rr, req)
+	rr := srv.Get(servertest.Path("users/not-a-uuid")).As(requesterID).Do(t)
 
-	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	rr.AssertStatus(http.StatusUnprocessableEntity)
 	assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
 }
@@ -0,0 +1,100 @@
+package component_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository/memory"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/servertest"
+)
+
+func newUsersMeServer(t *testing.T) (*servertest.Server, *fixtures.Fixtures) {
+	t.Helper()
+
+	f := &fixtures.Fixtures{
+		Users: []fixtures.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}},
+		Follows: []fixtures.Follow{
+			{Follower: "bob", Followee: "alice"},
+			{Follower: "alice", Followee: "carol"},
+		},
+	}
+
+	store, err := memory.NewFromFixtures(t.Context(), f)
+	require.NoError(t, err)
+
+	return servertest.New(t, servertest.WithMemoryStore(store)), f
+}
+
+func TestUsersMe_MatchesExplicitID(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	for _, suffix := range []string{"profile", "followers", "following", "activity", "preferences"} {
+		t.Run(suffix, func(t *testing.T) {
+			t.Parallel()
+
+			me := srv.Get(servertest.Path("users", "me", suffix)).As(alice).Do(t)
+			byID := srv.Get(servertest.Path("users", alice.String(), suffix)).As(alice).Do(t)
+
+			me.AssertStatus(http.StatusOK)
+			assert.Equal(t, byID.Code, me.Code)
+
+			// Activity and default preferences carry generation timestamps.
+			if suffix != "activity" && suffix != "preferences" {
+				assert.JSONEq(t, byID.Body.String(), me.Body.String())
+			}
+		})
+	}
+}
+
+func TestUsersMe_ResolvesRequester(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+	bob, _ := f.UserID("bob")
+
+	profile := servertest.DecodeJSON[dto.UserProfileResponse](
+		srv.Get(servertest.Path("users", "me", "profile")).As(bob).Do(t).AssertStatus(http.StatusOK))
+	assert.Equal(t, "bob", profile.Username)
+
+	following := servertest.DecodeJSON[dto.GetFollowedUsersResponse](
+		srv.Get(servertest.Path("users", "me", "following")).As(bob).Do(t).AssertStatus(http.StatusOK))
+	require.Len(t, following.FollowedUsers, 1)
+	assert.Equal(t, alice.String(), following.FollowedUsers[0].UserID)
+}
+
+func TestUsersMe_UpdatesPreferences(t *testing.T) {
+	t.Parallel()
+
+	srv, f := newUsersMeServer(t)
+	alice, _ := f.UserID("alice")
+
+	srv.Put(servertest.Path("users", "me", "preferences", "theme"), map[string]any{"darkMode": true}).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK)
+
+	srv.Get(servertest.Path("users", alice.String(), "preferences", "theme")).
+		As(alice).
+		Do(t).
+		AssertStatus(http.StatusOK).
+		AssertBodyContains(`"darkMode":true`)
+}
+
+func TestUsersMe_RequiresAuthentication(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := newUsersMeServer(t)
+
+	srv.Get(servertest.Path("users", "me", "profile")).
+		Do(t).
+		AssertError(http.StatusUnauthorized, "UNAUTHORIZED")
+}
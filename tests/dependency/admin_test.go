@@ -10,9 +10,35 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
 )
 
+// newAdminHandler serves the routes with userID holding the given role.
+func newAdminHandler(t *testing.T, userID uuid.UUID, role dto.UserRole) http.Handler {
+	t.Helper()
+
+	roleRepo := mocks.NewRoleRepository(t)
+	roleRepo.On("GetUserRole", mock.Anything, userID).Return(role, nil)
+
+	container, err := app.NewContainer(app.ContainerConfig{
+		Config:   &config.Config{},
+		UserRepo: new(mocks.UserRepository),
+		RoleRepo: roleRepo,
+	})
+	require.NoError(t, err)
+
+	container.AdminService = adminServiceMock()
+
+	return server.NewServerWithContainer(container).Handler
+}
+
 func TestClearCacheEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -20,16 +46,17 @@ func TestClearCacheEndpoint(t *testing.T) {
 	req, err := http.NewRequestWithContext(
 		context.Background(),
 		http.MethodPost,
-		"/api/v1/user-management/admin/cache/clear",
+		config.DefaultBasePath+"/admin/cache/clear",
 		bytes.NewBufferString(reqBody),
 	)
 	require.NoError(t, err)
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-User-Id", uuid.New().String())
+	adminID := uuid.New()
+	req.Header.Set("X-User-Id", adminID.String())
 
 	rr := httptest.NewRecorder()
 
-	testHandler.ServeHTTP(rr, req)
+	newAdminHandler(t, adminID, dto.UserRoleAdmin).ServeHTTP(rr, req)
 
 	assert.Equal(t, http.StatusOK, rr.Code)
 
@@ -43,3 +70,25 @@ func TestClearCacheEndpoint(t *testing.T) {
 	require.True(t, ok, "clearedCount should be float64")
 	assert.InDelta(t, 10.0, clearedCount, 0.1) // Mock returns 10
 }
+
+func TestClearCacheEndpoint_RequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		config.DefaultBasePath+"/admin/cache/clear",
+		bytes.NewBufferString(`{"keyPattern": "user:*"}`),
+	)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	userID := uuid.New()
+	req.Header.Set("X-User-Id", userID.String())
+
+	rr := httptest.NewRecorder()
+
+	newAdminHandler(t, userID, dto.UserRoleUser).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
@@ -10,9 +10,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-)
 
-const responseDataMapMsg = "response data should be a map"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+)
 
 func TestPerformanceMetricsEndpoint(t *testing.T) {
 	t.Parallel()
@@ -20,7 +20,7 @@ func TestPerformanceMetricsEndpoint(t *testing.T) {
 	req, err := http.NewRequestWithContext(
 		context.Background(),
 		http.MethodGet,
-		"/api/v1/user-management/metrics/performance",
+		config.DefaultBasePath+"/metrics/performance",
 		nil,
 	)
 	require.NoError(t, err)
@@ -47,7 +47,7 @@ func TestCacheMetricsEndpoint(t *testing.T) {
 	req, err := http.NewRequestWithContext(
 		context.Background(),
 		http.MethodGet,
-		"/api/v1/user-management/metrics/cache",
+		config.DefaultBasePath+"/metrics/cache",
 		nil,
 	)
 	require.NoError(t, err)
@@ -73,7 +73,7 @@ func TestSystemMetricsEndpoint(t *testing.T) {
 	req, err := http.NewRequestWithContext(
 		context.Background(),
 		http.MethodGet,
-		"/api/v1/user-management/metrics/system",
+		config.DefaultBasePath+"/metrics/system",
 		nil,
 	)
 	require.NoError(t, err)
@@ -100,7 +100,7 @@ func TestDetailedHealthMetricsEndpoint(t *testing.T) {
 	req, err := http.NewRequestWithContext(
 		context.Background(),
 		http.MethodGet,
-		"/api/v1/user-management/metrics/health/detailed",
+		config.DefaultBasePath+"/metrics/health/detailed",
 		nil,
 	)
 	require.NoError(t, err)
@@ -5,69 +5,68 @@ import (
 	"net/http"
 	"os"
 	"testing"
-
 	"time"
 
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
-	"github.com/spf13/viper"
 )
 
 var testHandler http.Handler
 
-// MockHealthChecker mocks health checking.
-type MockHealthChecker struct{}
+// healthCheckerMock reports every dependency as up.
+func healthCheckerMock() *mocks.HealthChecker {
+	m := new(mocks.HealthChecker)
+	m.On("Health", mock.Anything).Return(map[string]string{"status": "up"}).Maybe()
+	m.On("Close").Return(nil).Maybe()
 
-func (m *MockHealthChecker) Health(ctx context.Context) map[string]string {
-	return map[string]string{
-		"status": "up",
-	}
+	return m
 }
 
-func (m *MockHealthChecker) Close() error {
-	return nil
-}
-
-// MockMetricsService mocks metrics service.
-type MockMetricsService struct{}
-
-func (m *MockMetricsService) GetPerformanceMetrics(ctx context.Context) (*dto.PerformanceMetricsResponse, error) {
-	return &dto.PerformanceMetricsResponse{
+// metricsServiceMock stubs every metrics call with fixed values.
+func metricsServiceMock() *mocks.MetricsService {
+	m := new(mocks.MetricsService)
+	m.On("GetPerformanceMetrics", mock.Anything).Return(&dto.PerformanceMetricsResponse{
 		RequestCounts: dto.RequestCounts{TotalRequests: 100},
 		Database:      dto.DatabaseMetrics{ActiveConnections: 5},
-	}, nil
-}
-
-func (m *MockMetricsService) GetCacheMetrics(ctx context.Context) (*dto.CacheMetricsResponse, error) {
-	return &dto.CacheMetricsResponse{
+	}, nil).Maybe()
+	m.On("GetCacheMetrics", mock.Anything).Return(&dto.CacheMetricsResponse{
 		HitRate: 0.5,
-	}, nil
-}
-
-func (m *MockMetricsService) GetSystemMetrics(ctx context.Context) (*dto.SystemMetricsResponse, error) {
-	return &dto.SystemMetricsResponse{
+	}, nil).Maybe()
+	m.On("GetSystemMetrics", mock.Anything).Return(&dto.SystemMetricsResponse{
 		UptimeSeconds: 3600,
-	}, nil
+	}, nil).Maybe()
+	m.On("GetDetailedHealthMetrics", mock.Anything).Return(
+		func(context.Context) *dto.DetailedHealthMetricsResponse {
+			return &dto.DetailedHealthMetricsResponse{
+				OverallStatus: "healthy",
+				Timestamp:     time.Now(),
+			}
+		}, nil,
+	).Maybe()
+
+	return m
 }
 
-func (m *MockMetricsService) GetDetailedHealthMetrics(ctx context.Context) (*dto.DetailedHealthMetricsResponse, error) {
-	return &dto.DetailedHealthMetricsResponse{
-		OverallStatus: "healthy",
-		Timestamp:     time.Now(),
-	}, nil
-}
-
-// MockAdminService mocks admin service.
-type MockAdminService struct{}
-
-func (m *MockAdminService) ClearCache(ctx context.Context, keyPattern string) (*dto.CacheClearResponse, error) {
-	return &dto.CacheClearResponse{
-		Message:      "Cache cleared successfully",
-		Pattern:      keyPattern,
-		ClearedCount: 10,
-	}, nil
+// adminServiceMock clears any pattern successfully.
+func adminServiceMock() *mocks.AdminService {
+	m := new(mocks.AdminService)
+	m.On("ClearCache", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, keyPattern string) *dto.CacheClearResponse {
+			return &dto.CacheClearResponse{
+				Message:      "Cache cleared successfully",
+				Pattern:      keyPattern,
+				ClearedCount: 10,
+			}
+		}, nil,
+	).Maybe()
+
+	return m
 }
 
 func TestMain(m *testing.M) {
@@ -78,7 +77,7 @@ func TestMain(m *testing.M) {
 	cfg := config.Load()
 
 	// Create container with injected mock dependencies for health checks
-	mockHealth := &MockHealthChecker{}
+	mockHealth := healthCheckerMock()
 	container, _ := app.NewContainer(app.ContainerConfig{
 		Config:   cfg,
 		Database: mockHealth,
@@ -86,8 +85,8 @@ func TestMain(m *testing.M) {
 	})
 
 	// Inject mock metrics service
-	container.MetricsService = &MockMetricsService{}
-	container.AdminService = &MockAdminService{}
+	container.MetricsService = metricsServiceMock()
+	container.AdminService = adminServiceMock()
 
 	// Initialize the router with container
 	srv := server.NewServerWithContainer(container)
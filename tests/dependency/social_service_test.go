@@ -11,208 +11,47 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
-	"github.com/stretchr/testify/require"
 )
 
 const (
-	socialBaseURL = "/api/v1/user-management/users"
+	socialBaseURL = config.DefaultBasePath + "/users"
 )
 
 var (
-	errUnexpectedUsersSliceType = errors.New("unexpected type for []dto.User")
-	errDatabaseFailure          = errors.New("database error")
+	errDatabaseFailure = errors.New("database error")
 )
 
-// MockSocialRepository is a mock implementation of repository.SocialRepository.
-type MockSocialRepository struct {
-	mock.Mock
-}
-
-func (m *MockSocialRepository) GetFollowing(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit, offset int,
-) ([]dto.User, int, error) {
-	args := m.Called(ctx, userID, limit, offset)
-
-	err := args.Error(2)
-	if err != nil {
-		return nil, 0, fmt.Errorf("get following: %w", err)
-	}
-
-	if args.Get(0) == nil {
-		return nil, args.Int(1), nil
-	}
-
-	users, ok := args.Get(0).([]dto.User)
-	if !ok {
-		return nil, 0, errUnexpectedUsersSliceType
-	}
-
-	return users, args.Int(1), nil
-}
-
-func (m *MockSocialRepository) GetFollowers(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit, offset int,
-) ([]dto.User, int, error) {
-	args := m.Called(ctx, userID, limit, offset)
-
-	err := args.Error(2)
-	if err != nil {
-		return nil, 0, fmt.Errorf("get followers: %w", err)
-	}
-
-	if args.Get(0) == nil {
-		return nil, args.Int(1), nil
-	}
-
-	users, ok := args.Get(0).([]dto.User)
-	if !ok {
-		return nil, 0, errUnexpectedUsersSliceType
-	}
-
-	return users, args.Int(1), nil
-}
-
-func (m *MockSocialRepository) FollowUser(
-	ctx context.Context,
-	followerID, followeeID uuid.UUID,
-) error {
-	args := m.Called(ctx, followerID, followeeID)
-
-	err := args.Error(0)
-	if err != nil {
-		return fmt.Errorf("follow user: %w", err)
-	}
-
-	return nil
-}
-
-func (m *MockSocialRepository) UnfollowUser(
-	ctx context.Context,
-	followerID, followeeID uuid.UUID,
-) error {
-	args := m.Called(ctx, followerID, followeeID)
-
-	err := args.Error(0)
-	if err != nil {
-		return fmt.Errorf("unfollow user: %w", err)
-	}
-
-	return nil
-}
-
-func (m *MockSocialRepository) GetRecentRecipes(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.RecipeSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf("get recent recipes: %w", err)
-	}
-
-	recipes, _ := args.Get(0).([]dto.RecipeSummary)
-
-	return recipes, nil
-}
-
-func (m *MockSocialRepository) GetRecentFollows(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.UserSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf("get recent follows: %w", err)
-	}
-
-	follows, _ := args.Get(0).([]dto.UserSummary)
-
-	return follows, nil
-}
-
-func (m *MockSocialRepository) GetRecentReviews(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.ReviewSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf("get recent reviews: %w", err)
-	}
-
-	reviews, _ := args.Get(0).([]dto.ReviewSummary)
-
-	return reviews, nil
-}
-
-func (m *MockSocialRepository) GetRecentFavorites(
-	ctx context.Context,
-	userID uuid.UUID,
-	limit int,
-) ([]dto.FavoriteSummary, error) {
-	args := m.Called(ctx, userID, limit)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf("get recent favorites: %w", err)
-	}
-
-	favorites, _ := args.Get(0).([]dto.FavoriteSummary)
-
-	return favorites, nil
-}
-
-func (m *MockSocialRepository) CheckFollowing(
-	ctx context.Context,
-	followerID, followeeID uuid.UUID,
-) (*time.Time, error) {
-	args := m.Called(ctx, followerID, followeeID)
-
-	err := args.Error(1)
-	if err != nil {
-		return nil, fmt.Errorf("check following: %w", err)
-	}
-
-	followedAt, _ := args.Get(0).(*time.Time)
-
-	return followedAt, nil
-}
-
 type socialTestFixture struct {
 	handler        http.Handler
-	mockUserRepo   *MockUserRepository
-	mockSocialRepo *MockSocialRepository
+	mockUserRepo   *mocks.UserRepository
+	mockSocialRepo *mocks.SocialRepository
+	mockRoleRepo   *mocks.RoleRepository
 	requesterID    uuid.UUID
 }
 
 func setupSocialTest(t *testing.T) *socialTestFixture {
 	t.Helper()
 
-	mockUserRepo := new(MockUserRepository)
-	mockSocialRepo := new(MockSocialRepository)
+	mockUserRepo := new(mocks.UserRepository)
+	mockSocialRepo := new(mocks.SocialRepository)
+	mockRoleRepo := new(mocks.RoleRepository)
 	cfg := &config.Config{}
 
 	container, err := app.NewContainer(app.ContainerConfig{
 		Config:     cfg,
 		UserRepo:   mockUserRepo,
 		SocialRepo: mockSocialRepo,
+		RoleRepo:   mockRoleRepo,
 	})
 	require.NoError(t, err)
 
@@ -222,6 +61,7 @@ func setupSocialTest(t *testing.T) *socialTestFixture {
 		handler:        srv.Handler,
 		mockUserRepo:   mockUserRepo,
 		mockSocialRepo: mockSocialRepo,
+		mockRoleRepo:   mockRoleRepo,
 		requesterID:    uuid.New(),
 	}
 }
@@ -263,7 +103,7 @@ func TestGetFollowing(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 		now := time.Now()
 
 		followedUsers := []dto.User{
@@ -304,7 +144,7 @@ func TestGetFollowing(t *testing.T) {
 		fix := setupSocialTest(t)
 		userID := fix.requesterID
 		user := createTestUserForSocial(userID)
-		privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+		privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 		now := time.Now()
 
 		followedUsers := []dto.User{
@@ -320,6 +160,8 @@ func TestGetFollowing(t *testing.T) {
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, userID).Return(privatePrivacy, nil).Once()
 		fix.mockSocialRepo.On("GetFollowing", mock.Anything, userID, 20, 0).Return(followedUsers, 1, nil).Once()
+		fix.mockSocialRepo.On("GetFollowSettings", mock.Anything, userID, mock.Anything).
+			Return(map[uuid.UUID]dto.FollowSettings{}, nil).Once()
 
 		rr := httptest.NewRecorder()
 		fix.handler.ServeHTTP(rr, newGetFollowingRequest(t, userID, fix.requesterID, ""))
@@ -337,7 +179,7 @@ func TestGetFollowing(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).
@@ -357,7 +199,7 @@ func TestGetFollowing(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
@@ -381,7 +223,7 @@ func TestGetFollowing(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
@@ -407,7 +249,7 @@ func TestGetFollowing(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+		privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(privatePrivacy, nil).Once()
@@ -425,7 +267,7 @@ func TestGetFollowing(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).
@@ -541,7 +383,7 @@ func TestGetFollowing(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
@@ -581,7 +423,7 @@ func TestGetFollowers(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 		now := time.Now()
 
 		followers := []dto.User{
@@ -653,7 +495,7 @@ func TestGetFollowers(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).
@@ -673,7 +515,7 @@ func TestGetFollowers(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
@@ -697,7 +539,7 @@ func TestGetFollowers(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
@@ -723,7 +565,7 @@ func TestGetFollowers(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+		privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(privatePrivacy, nil).Once()
@@ -741,7 +583,7 @@ func TestGetFollowers(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		followersOnlyPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "followers_only"}
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityFriendsOnly}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).
@@ -857,7 +699,7 @@ func TestGetFollowers(t *testing.T) {
 		fix := setupSocialTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public"}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
@@ -872,11 +714,7 @@ func TestGetFollowers(t *testing.T) {
 	})
 }
 
-func newFollowUserRequest(
-	t *testing.T,
-	followerID, targetUserID, requesterID uuid.UUID,
-	isAdmin bool,
-) *http.Request {
+func newFollowUserRequest(t *testing.T, followerID, targetUserID, requesterID uuid.UUID) *http.Request {
 	t.Helper()
 
 	reqPath := fmt.Sprintf("%s/%s/follow/%s", socialBaseURL, followerID, targetUserID)
@@ -885,18 +723,10 @@ func newFollowUserRequest(
 	require.NoError(t, err)
 	req.Header.Set(headerUserID, requesterID.String())
 
-	if isAdmin {
-		req.Header.Set("X-User-Role", "admin")
-	}
-
 	return req
 }
 
-func newUnfollowUserRequest(
-	t *testing.T,
-	followerID, targetUserID, requesterID uuid.UUID,
-	isAdmin bool,
-) *http.Request {
+func newUnfollowUserRequest(t *testing.T, followerID, targetUserID, requesterID uuid.UUID) *http.Request {
 	t.Helper()
 
 	reqPath := fmt.Sprintf("%s/%s/follow/%s", socialBaseURL, followerID, targetUserID)
@@ -905,10 +735,6 @@ func newUnfollowUserRequest(
 	require.NoError(t, err)
 	req.Header.Set(headerUserID, requesterID.String())
 
-	if isAdmin {
-		req.Header.Set("X-User-Role", "admin")
-	}
-
 	return req
 }
 
@@ -923,14 +749,15 @@ func TestFollowUser(t *testing.T) {
 		followerID := fix.requesterID
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public", AllowFollows: true}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic, AllowFollows: true}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
+		fix.mockSocialRepo.On("GetFollowLimit", mock.Anything, followerID).Return(nil, nil).Once()
 		fix.mockSocialRepo.On("FollowUser", mock.Anything, followerID, targetUserID).Return(nil).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, targetUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, targetUserID, fix.requesterID))
 
 		require.Equal(t, http.StatusOK, rr.Code)
 
@@ -948,15 +775,17 @@ func TestFollowUser(t *testing.T) {
 		followerID := uuid.New()
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public", AllowFollows: true}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic, AllowFollows: true}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
+		fix.mockSocialRepo.On("GetFollowLimit", mock.Anything, followerID).Return(nil, nil).Once()
 		fix.mockSocialRepo.On("FollowUser", mock.Anything, followerID, targetUserID).Return(nil).Once()
+		fix.mockRoleRepo.On("GetUserRole", mock.Anything, adminID).Return(dto.UserRoleAdmin, nil).Once()
 
 		rr := httptest.NewRecorder()
 		// Admin can follow on behalf of another user
-		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, targetUserID, adminID, true))
+		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, targetUserID, adminID))
 
 		require.Equal(t, http.StatusOK, rr.Code)
 
@@ -972,15 +801,16 @@ func TestFollowUser(t *testing.T) {
 		followerID := fix.requesterID
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public", AllowFollows: true}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic, AllowFollows: true}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
 		// ON CONFLICT DO NOTHING - returns success even if already following
+		fix.mockSocialRepo.On("GetFollowLimit", mock.Anything, followerID).Return(nil, nil).Once()
 		fix.mockSocialRepo.On("FollowUser", mock.Anything, followerID, targetUserID).Return(nil).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, targetUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, targetUserID, fix.requesterID))
 
 		require.Equal(t, http.StatusOK, rr.Code)
 	})
@@ -992,7 +822,7 @@ func TestFollowUser(t *testing.T) {
 		userID := fix.requesterID
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, userID, userID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, userID, userID, fix.requesterID))
 
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
 		assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
@@ -1010,7 +840,7 @@ func TestFollowUser(t *testing.T) {
 			Return(nil, repository.ErrUserNotFound).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, nonExistentID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, nonExistentID, fix.requesterID))
 
 		assert.Equal(t, http.StatusNotFound, rr.Code)
 		assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
@@ -1031,7 +861,7 @@ func TestFollowUser(t *testing.T) {
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, inactiveUserID).Return(inactiveUser, nil).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, inactiveUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, inactiveUserID, fix.requesterID))
 
 		assert.Equal(t, http.StatusNotFound, rr.Code)
 		assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
@@ -1044,14 +874,14 @@ func TestFollowUser(t *testing.T) {
 		followerID := fix.requesterID
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		noFollowsPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public", AllowFollows: false}
+		noFollowsPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic, AllowFollows: false}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).
 			Return(noFollowsPrivacy, nil).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, targetUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, targetUserID, fix.requesterID))
 
 		assert.Equal(t, http.StatusForbidden, rr.Code)
 		assert.Contains(t, rr.Body.String(), "FORBIDDEN")
@@ -1064,9 +894,11 @@ func TestFollowUser(t *testing.T) {
 		differentUserID := uuid.New()
 		targetUserID := uuid.New()
 
+		fix.mockRoleRepo.On("GetUserRole", mock.Anything, fix.requesterID).Return(dto.UserRoleUser, nil).Once()
+
 		rr := httptest.NewRecorder()
 		// Non-admin trying to follow on behalf of another user
-		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, differentUserID, targetUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, differentUserID, targetUserID, fix.requesterID))
 
 		assert.Equal(t, http.StatusForbidden, rr.Code)
 		assert.Contains(t, rr.Body.String(), "FORBIDDEN")
@@ -1134,15 +966,16 @@ func TestFollowUser(t *testing.T) {
 		followerID := fix.requesterID
 		targetUserID := uuid.New()
 		targetUser := createTestUserForSocial(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public", AllowFollows: true}
+		publicPrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPublic, AllowFollows: true}
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockUserRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
+		fix.mockSocialRepo.On("GetFollowLimit", mock.Anything, followerID).Return(nil, nil).Once()
 		fix.mockSocialRepo.On("FollowUser", mock.Anything, followerID, targetUserID).
 			Return(errDatabaseFailure).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, targetUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newFollowUserRequest(t, followerID, targetUserID, fix.requesterID))
 
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 		assert.Contains(t, rr.Body.String(), "INTERNAL_ERROR")
@@ -1165,7 +998,7 @@ func TestUnfollowUser(t *testing.T) {
 		fix.mockSocialRepo.On("UnfollowUser", mock.Anything, followerID, targetUserID).Return(nil).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, targetUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, targetUserID, fix.requesterID))
 
 		require.Equal(t, http.StatusOK, rr.Code)
 
@@ -1186,10 +1019,11 @@ func TestUnfollowUser(t *testing.T) {
 
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockSocialRepo.On("UnfollowUser", mock.Anything, followerID, targetUserID).Return(nil).Once()
+		fix.mockRoleRepo.On("GetUserRole", mock.Anything, adminID).Return(dto.UserRoleAdmin, nil).Once()
 
 		rr := httptest.NewRecorder()
 		// Admin can unfollow on behalf of another user
-		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, targetUserID, adminID, true))
+		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, targetUserID, adminID))
 
 		require.Equal(t, http.StatusOK, rr.Code)
 
@@ -1211,7 +1045,7 @@ func TestUnfollowUser(t *testing.T) {
 		fix.mockSocialRepo.On("UnfollowUser", mock.Anything, followerID, targetUserID).Return(nil).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, targetUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, targetUserID, fix.requesterID))
 
 		require.Equal(t, http.StatusOK, rr.Code)
 	})
@@ -1223,7 +1057,7 @@ func TestUnfollowUser(t *testing.T) {
 		userID := fix.requesterID
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, userID, userID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, userID, userID, fix.requesterID))
 
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
 		assert.Contains(t, rr.Body.String(), "VALIDATION_ERROR")
@@ -1241,7 +1075,7 @@ func TestUnfollowUser(t *testing.T) {
 			Return(nil, repository.ErrUserNotFound).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, nonExistentID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, nonExistentID, fix.requesterID))
 
 		assert.Equal(t, http.StatusNotFound, rr.Code)
 		assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
@@ -1262,7 +1096,7 @@ func TestUnfollowUser(t *testing.T) {
 		fix.mockUserRepo.On("FindUserByID", mock.Anything, inactiveUserID).Return(inactiveUser, nil).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, inactiveUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, inactiveUserID, fix.requesterID))
 
 		assert.Equal(t, http.StatusNotFound, rr.Code)
 		assert.Contains(t, rr.Body.String(), "USER_NOT_FOUND")
@@ -1275,9 +1109,11 @@ func TestUnfollowUser(t *testing.T) {
 		differentUserID := uuid.New()
 		targetUserID := uuid.New()
 
+		fix.mockRoleRepo.On("GetUserRole", mock.Anything, fix.requesterID).Return(dto.UserRoleUser, nil).Once()
+
 		rr := httptest.NewRecorder()
 		// Non-admin trying to unfollow on behalf of another user
-		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, differentUserID, targetUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, differentUserID, targetUserID, fix.requesterID))
 
 		assert.Equal(t, http.StatusForbidden, rr.Code)
 		assert.Contains(t, rr.Body.String(), "FORBIDDEN")
@@ -1351,7 +1187,7 @@ func TestUnfollowUser(t *testing.T) {
 			Return(errDatabaseFailure).Once()
 
 		rr := httptest.NewRecorder()
-		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, targetUserID, fix.requesterID, false))
+		fix.handler.ServeHTTP(rr, newUnfollowUserRequest(t, followerID, targetUserID, fix.requesterID))
 
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
 		assert.Contains(t, rr.Body.String(), "INTERNAL_ERROR")
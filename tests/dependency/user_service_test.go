@@ -3,7 +3,6 @@ package dependency_test
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -14,154 +13,49 @@ import (
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/crypto"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/redis"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/repository"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/service"
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
-	"github.com/stretchr/testify/require"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/signedtoken"
 )
 
 const (
 	headerUserID = "X-User-Id"
-	baseURL      = "/api/v1/user-management/users"
+	baseURL      = config.DefaultBasePath + "/users"
 	reqPathFmt   = "%s/%s/profile"
-	mockErrorFmt = "mock error: %w"
 )
 
-var (
-	errUnexpectedUserType    = errors.New("unexpected type for User")
-	errUnexpectedPrefsType   = errors.New("unexpected type for PrivacyPreferences")
-	errMockReturnedNilResult = errors.New("mock returned nil result")
-)
-
-// MockUserRepository is a mock implementation of repository.UserRepository.
-type MockUserRepository struct {
-	mock.Mock
-}
-
-func (m *MockUserRepository) FindUserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("find user: %w", err)
-		}
-
-		return nil, errMockReturnedNilResult
-	}
-
-	user, ok := args.Get(0).(*dto.User)
-	if !ok {
-		return nil, errUnexpectedUserType
-	}
-
-	err := args.Error(1)
-	if err != nil {
-		return user, fmt.Errorf("find user: %w", err)
-	}
-
-	return user, nil
-}
-
-func (m *MockUserRepository) FindPrivacyPreferencesByUserID(
-	ctx context.Context,
-	userID uuid.UUID,
-) (*dto.PrivacyPreferences, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("find privacy preferences: %w", err)
-		}
-
-		return nil, errMockReturnedNilResult
-	}
-
-	prefs, ok := args.Get(0).(*dto.PrivacyPreferences)
-	if !ok {
-		return nil, errUnexpectedPrefsType
-	}
-
-	err := args.Error(1)
-	if err != nil {
-		return prefs, fmt.Errorf("find privacy preferences: %w", err)
-	}
-
-	return prefs, nil
-}
-
-func (m *MockUserRepository) IsFollowing(ctx context.Context, followerID, followedID uuid.UUID) (bool, error) {
-	args := m.Called(ctx, followerID, followedID)
-	return args.Bool(0), args.Error(1)
-}
-
-func (m *MockUserRepository) UpdateUser(
-	ctx context.Context,
-	userID uuid.UUID,
-	update *dto.UserProfileUpdateRequest,
-) (*dto.User, error) {
-	args := m.Called(ctx, userID, update)
-	if args.Get(0) == nil {
-		err := args.Error(1)
-		if err != nil {
-			return nil, fmt.Errorf("update user: %w", err)
-		}
-
-		return nil, errMockReturnedNilResult
-	}
-
-	user, ok := args.Get(0).(*dto.User)
-	if !ok {
-		return nil, errUnexpectedUserType
-	}
-
-	err := args.Error(1)
-	if err != nil {
-		return user, fmt.Errorf("update user: %w", err)
-	}
-
-	return user, nil
-}
-
-func (m *MockUserRepository) SearchUsers(
-	ctx context.Context,
-	query string,
-	limit, offset int,
-) ([]dto.UserSearchResult, int, error) {
-	args := m.Called(ctx, query, limit, offset)
-
-	err := args.Error(2)
-	if err != nil {
-		return nil, 0, fmt.Errorf("search users: %w", err)
-	}
-
-	results, _ := args.Get(0).([]dto.UserSearchResult)
-
-	return results, args.Int(1), nil
-}
-
-func (m *MockUserRepository) GetUserStats(ctx context.Context) (*dto.UserStatsResponse, error) {
-	return &dto.UserStatsResponse{}, nil
-}
+var ()
 
 type testFixture struct {
 	handler     http.Handler
-	mockRepo    *MockUserRepository
+	mockRepo    *mocks.UserRepository
 	requesterID uuid.UUID
 }
 
 func setupTest(t *testing.T) *testFixture {
 	t.Helper()
 
-	mockRepo := new(MockUserRepository)
+	mockRepo := new(mocks.UserRepository)
+	moderationRepo := new(mocks.ModerationRepository)
+	moderationRepo.On("IsUnderModeration", mock.Anything, mock.Anything).Return(false, nil).Maybe()
 	cfg := &config.Config{}
 
-	container, err := app.NewContainer(app.ContainerConfig{Config: cfg, UserRepo: mockRepo})
+	container, err := app.NewContainer(app.ContainerConfig{
+		Config:         cfg,
+		UserRepo:       mockRepo,
+		ModerationRepo: moderationRepo,
+	})
 	require.NoError(t, err)
 
 	srv := server.NewServerWithContainer(container)
@@ -201,7 +95,11 @@ func TestGetUserProfile(t *testing.T) {
 		fix := setupTest(t)
 		targetUserID := uuid.New()
 		targetUser := createTestUser(targetUserID)
-		publicPrivacy := &dto.PrivacyPreferences{ProfileVisibility: "public", ShowFullName: true, ShowEmail: true}
+		publicPrivacy := &dto.UserPrivacyPreferences{
+			ProfileVisibility:     dto.ProfileVisibilityPublic,
+			ContactInfoVisibility: dto.ProfileVisibilityPublic,
+			ShowFullName:          true,
+		}
 
 		fix.mockRepo.On("FindUserByID", mock.Anything, targetUserID).Return(targetUser, nil).Once()
 		fix.mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, targetUserID).Return(publicPrivacy, nil).Once()
@@ -236,10 +134,11 @@ func TestGetUserProfile(t *testing.T) {
 		fix := setupTest(t)
 		privateTargetID := uuid.New()
 		privateUser := &dto.User{UserID: privateTargetID.String(), Username: "privateuser"}
-		privatePrivacy := &dto.PrivacyPreferences{ProfileVisibility: "private"}
+		privatePrivacy := &dto.UserPrivacyPreferences{ProfileVisibility: dto.ProfileVisibilityPrivate}
 
 		fix.mockRepo.On("FindUserByID", mock.Anything, privateTargetID).Return(privateUser, nil).Once()
-		fix.mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, privateTargetID).Return(privatePrivacy, nil).Once()
+		fix.mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, privateTargetID).
+			Return(privatePrivacy, nil).Once()
 
 		rr := httptest.NewRecorder()
 		fix.handler.ServeHTTP(rr, newProfileRequest(t, privateTargetID, fix.requesterID))
@@ -364,9 +263,10 @@ func TestUpdateUserProfile(t *testing.T) { //nolint:funlen // table-driven test
 // testFixtureWithRedis includes a real miniredis instance for token storage tests.
 type testFixtureWithRedis struct {
 	handler     http.Handler
-	mockRepo    *MockUserRepository
+	mockRepo    *mocks.UserRepository
 	redisServer *miniredis.Miniredis
 	requesterID uuid.UUID
+	signer      *signedtoken.Signer
 }
 
 func setupTestWithRedis(t *testing.T) *testFixtureWithRedis {
@@ -387,8 +287,9 @@ func setupTestWithRedis(t *testing.T) *testFixtureWithRedis {
 	redisService, err := redis.New(redisConfig)
 	require.NoError(t, err)
 
-	mockRepo := new(MockUserRepository)
+	mockRepo := new(mocks.UserRepository)
 	cfg := &config.Config{}
+	cfg.OAuth2.JWTSecret = "dependency-test-secret"
 
 	container, err := app.NewContainer(app.ContainerConfig{
 		Config:     cfg,
@@ -404,6 +305,7 @@ func setupTestWithRedis(t *testing.T) *testFixtureWithRedis {
 		mockRepo:    mockRepo,
 		redisServer: mr,
 		requesterID: uuid.New(),
+		signer:      signedtoken.New(signedtoken.DeriveKey([]byte(cfg.OAuth2.JWTSecret), "confirmation-token")),
 	}
 }
 
@@ -451,10 +353,14 @@ func TestRequestAccountDeletion(t *testing.T) { //nolint:funlen // table-driven
 		assert.NotEmpty(t, resp.ConfirmationToken)
 		assert.False(t, resp.ExpiresAt.IsZero())
 
-		// Verify token is stored in Redis
+		// Verify only the token's hash is stored in Redis
 		storedToken, err := fix.redisServer.Get("delete-request:" + userID.String())
 		require.NoError(t, err)
-		assert.Equal(t, resp.ConfirmationToken, storedToken)
+		assert.NotContains(t, storedToken, resp.ConfirmationToken)
+
+		matches, err := crypto.Verify(resp.ConfirmationToken, storedToken)
+		require.NoError(t, err)
+		assert.True(t, matches)
 
 		// Verify TTL is set (approximately 24 hours)
 		ttl := fix.redisServer.TTL("delete-request:" + userID.String())
@@ -505,7 +411,10 @@ func TestRequestAccountDeletion(t *testing.T) { //nolint:funlen // table-driven
 		// Verify only the second token is stored
 		storedToken, err := fix.redisServer.Get("delete-request:" + userID.String())
 		require.NoError(t, err)
-		assert.Equal(t, secondToken, storedToken)
+
+		matches, err := crypto.Verify(secondToken, storedToken)
+		require.NoError(t, err)
+		assert.True(t, matches)
 	})
 
 	t.Run("NotFound_UserDoesNotExist", func(t *testing.T) {
@@ -579,8 +488,9 @@ func TestConfirmAccountDeletion(t *testing.T) { //nolint:funlen // table-driven
 		}
 
 		// Pre-store a token in Redis
-		token := uuid.New().String()
-		err := fix.redisServer.Set("delete-request:"+userID.String(), token)
+		token, err := fix.signer.Sign(userID, service.DeleteTokenPurpose, now.Add(time.Hour))
+		require.NoError(t, err)
+		err = fix.redisServer.Set("delete-request:"+userID.String(), token)
 		require.NoError(t, err)
 
 		fix.mockRepo.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(deactivatedUser, nil).Once()
@@ -755,7 +665,7 @@ func TestSearchUsers(t *testing.T) {
 			},
 		}
 
-		fix.mockRepo.On("SearchUsers", mock.Anything, "test", 20, 0).Return(searchResults, 1, nil)
+		fix.mockRepo.On("SearchUsers", mock.Anything, "test", dto.LocationFilter{}, 20, 0).Return(searchResults, 1, nil)
 
 		rr := httptest.NewRecorder()
 		fix.handler.ServeHTTP(rr, newSearchRequest(t, fix.requesterID, "?query=test"))
@@ -775,7 +685,8 @@ func TestSearchUsers(t *testing.T) {
 
 		fix := setupTest(t)
 
-		fix.mockRepo.On("SearchUsers", mock.Anything, "test", 20, 0).Return([]dto.UserSearchResult{}, 10, nil)
+		fix.mockRepo.On("SearchUsers", mock.Anything, "test", dto.LocationFilter{}, 20, 0).
+			Return([]dto.UserSearchResult{}, 10, nil)
 
 		rr := httptest.NewRecorder()
 		fix.handler.ServeHTTP(rr, newSearchRequest(t, fix.requesterID, "?query=test&countOnly=true"))
@@ -794,7 +705,8 @@ func TestSearchUsers(t *testing.T) {
 
 		fix := setupTest(t)
 
-		fix.mockRepo.On("SearchUsers", mock.Anything, "user", 10, 5).Return([]dto.UserSearchResult{}, 25, nil)
+		fix.mockRepo.On("SearchUsers", mock.Anything, "user", dto.LocationFilter{}, 10, 5).
+			Return([]dto.UserSearchResult{}, 25, nil)
 
 		rr := httptest.NewRecorder()
 		fix.handler.ServeHTTP(rr, newSearchRequest(t, fix.requesterID, "?query=user&limit=10&offset=5"))
@@ -814,7 +726,8 @@ func TestSearchUsers(t *testing.T) {
 
 		fix := setupTest(t)
 
-		fix.mockRepo.On("SearchUsers", mock.Anything, "", 20, 0).Return([]dto.UserSearchResult{}, 0, nil)
+		fix.mockRepo.On("SearchUsers", mock.Anything, "", dto.LocationFilter{}, 20, 0).
+			Return([]dto.UserSearchResult{}, 0, nil)
 
 		rr := httptest.NewRecorder()
 		fix.handler.ServeHTTP(rr, newSearchRequest(t, fix.requesterID, ""))
@@ -883,7 +796,8 @@ func TestSearchUsers(t *testing.T) {
 
 		fix := setupTest(t)
 
-		fix.mockRepo.On("SearchUsers", mock.Anything, "test", 20, 0).Return(nil, 0, repository.ErrUserNotFound)
+		fix.mockRepo.On("SearchUsers", mock.Anything, "test", dto.LocationFilter{}, 20, 0).
+			Return(nil, 0, repository.ErrUserNotFound)
 
 		rr := httptest.NewRecorder()
 		fix.handler.ServeHTTP(rr, newSearchRequest(t, fix.requesterID, "?query=test"))
@@ -922,8 +836,8 @@ func TestGetUserByID(t *testing.T) { //nolint:funlen // table-driven test
 			CreatedAt: now,
 			UpdatedAt: now,
 		}
-		publicPrivacy := &dto.PrivacyPreferences{
-			ProfileVisibility: "public",
+		publicPrivacy := &dto.UserPrivacyPreferences{
+			ProfileVisibility: dto.ProfileVisibilityPublic,
 			ShowFullName:      true,
 		}
 
@@ -968,12 +882,13 @@ func TestGetUserByID(t *testing.T) { //nolint:funlen // table-driven test
 			CreatedAt: now,
 			UpdatedAt: now,
 		}
-		privatePrivacy := &dto.PrivacyPreferences{
-			ProfileVisibility: "private",
+		privatePrivacy := &dto.UserPrivacyPreferences{
+			ProfileVisibility: dto.ProfileVisibilityPrivate,
 		}
 
 		fix.mockRepo.On("FindUserByID", mock.Anything, privateUserID).Return(user, nil).Once()
-		fix.mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, privateUserID).Return(privatePrivacy, nil).Once()
+		fix.mockRepo.On("FindPrivacyPreferencesByUserID", mock.Anything, privateUserID).
+			Return(privatePrivacy, nil).Once()
 
 		rr := httptest.NewRecorder()
 		fix.handler.ServeHTTP(rr, newGetUserByIDRequest(t, privateUserID))
@@ -995,8 +910,8 @@ func TestGetUserByID(t *testing.T) { //nolint:funlen // table-driven test
 			CreatedAt: now,
 			UpdatedAt: now,
 		}
-		followersOnlyPrivacy := &dto.PrivacyPreferences{
-			ProfileVisibility: "followers_only",
+		followersOnlyPrivacy := &dto.UserPrivacyPreferences{
+			ProfileVisibility: dto.ProfileVisibilityFriendsOnly,
 		}
 
 		fix.mockRepo.On("FindUserByID", mock.Anything, followersOnlyUserID).Return(user, nil).Once()
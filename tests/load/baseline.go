@@ -0,0 +1,93 @@
+package load
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+)
+
+// Threshold is the recorded performance of one scenario.
+type Threshold struct {
+	P99       Duration `json:"p99"`
+	ErrorRate float64  `json:"errorRate"`
+}
+
+// Baseline holds per-scenario thresholds and how far a run may drift from them.
+type Baseline struct {
+	// LatencyTolerance is the allowed relative p99 increase, e.g. 0.25 for 25%.
+	LatencyTolerance float64 `json:"latencyTolerance"`
+	// ErrorRateTolerance is the allowed absolute error rate increase, e.g. 0.01 for one point.
+	ErrorRateTolerance float64              `json:"errorRateTolerance"`
+	Scenarios          map[string]Threshold `json:"scenarios"`
+}
+
+// LoadBaseline reads a baseline file.
+func LoadBaseline(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
+	var b Baseline
+
+	err = json.Unmarshal(data, &b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse baseline %s: %w", path, err)
+	}
+
+	return &b, nil
+}
+
+// Save writes the baseline to path.
+func (b *Baseline) Save(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o600) //nolint:mnd // owner read/write
+}
+
+// Record replaces the thresholds of the given results with their observed values.
+func (b *Baseline) Record(results []*Result) {
+	if b.Scenarios == nil {
+		b.Scenarios = make(map[string]Threshold, len(results))
+	}
+
+	for _, r := range results {
+		b.Scenarios[r.Scenario] = Threshold{P99: Duration{r.P99}, ErrorRate: r.ErrorRate()}
+	}
+}
+
+// Check returns a description of every regression in results. Scenarios without a recorded
+// threshold are reported too, so new scenarios cannot slip past the gate unmeasured.
+func (b *Baseline) Check(results []*Result) []string {
+	var regressions []string
+
+	for _, r := range results {
+		threshold, ok := b.Scenarios[r.Scenario]
+		if !ok {
+			regressions = append(regressions, fmt.Sprintf("%s: no baseline recorded", r.Scenario))
+
+			continue
+		}
+
+		maxP99 := time.Duration(float64(threshold.P99.Duration) * (1 + b.LatencyTolerance))
+		if r.P99 > maxP99 {
+			regressions = append(regressions, fmt.Sprintf("%s: p99 %s exceeds %s (baseline %s)",
+				r.Scenario, r.P99, maxP99, threshold.P99.Duration))
+		}
+
+		maxErrorRate := threshold.ErrorRate + b.ErrorRateTolerance
+		if r.ErrorRate() > maxErrorRate {
+			regressions = append(regressions, fmt.Sprintf("%s: error rate %.4f exceeds %.4f (baseline %.4f)",
+				r.Scenario, r.ErrorRate(), maxErrorRate, threshold.ErrorRate))
+		}
+	}
+
+	slices.Sort(regressions)
+
+	return regressions
+}
@@ -0,0 +1,273 @@
+// Package load drives constant-rate HTTP load against a running user-management-service and
+// compares the observed latency and error rate with a recorded baseline.
+//
+// Scenarios are described in JSON in the same spirit as vegeta targets: each names a request rate, a
+// duration and a list of requests that are issued round-robin. Paths may reference fixture users
+// as {user:alice}, which resolves to that user's ID, and each request may authenticate as a
+// fixture user through the X-User-Id header (OAuth2 disabled).
+package load
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrUnknownUser is returned when a scenario references a user missing from the fixtures.
+var ErrUnknownUser = errors.New("unknown fixture user")
+
+var userPlaceholder = regexp.MustCompile(`\{user:([^}]+)\}`)
+
+// Duration is a time.Duration that reads from JSON strings such as "30s".
+type Duration struct {
+	time.Duration
+}
+
+// UnmarshalJSON parses a Go duration string.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	d.Duration, err = time.ParseDuration(s)
+
+	return err
+}
+
+// MarshalJSON writes the duration as a Go duration string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// Target is a single request in a scenario.
+type Target struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	// As is the fixture username the request authenticates as.
+	As string `json:"as,omitempty"`
+}
+
+// Scenario issues its targets round-robin at Rate requests per second for Duration.
+type Scenario struct {
+	Name     string   `json:"name"`
+	Rate     int      `json:"rate"`
+	Duration Duration `json:"duration"`
+	Targets  []Target `json:"targets"`
+}
+
+// Result summarizes one scenario run.
+type Result struct {
+	Scenario string
+	Requests int
+	// Errors counts transport failures and 5xx responses. 4xx responses are expected for
+	// mutations that race each other (following a user twice) and are not counted.
+	Errors int
+	P50    time.Duration
+	P99    time.Duration
+}
+
+// ErrorRate returns the fraction of requests that failed.
+func (r *Result) ErrorRate() float64 {
+	if r.Requests == 0 {
+		return 0
+	}
+
+	return float64(r.Errors) / float64(r.Requests)
+}
+
+// LoadScenarios reads a JSON array of scenarios from path.
+func LoadScenarios(path string) ([]Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenarios: %w", err)
+	}
+
+	var scenarios []Scenario
+
+	err = json.Unmarshal(data, &scenarios)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse scenarios %s: %w", path, err)
+	}
+
+	for _, s := range scenarios {
+		if s.Name == "" || s.Rate <= 0 || s.Duration.Duration <= 0 || len(s.Targets) == 0 {
+			return nil, fmt.Errorf("scenario %q needs a name, positive rate and duration, and targets", s.Name)
+		}
+	}
+
+	return scenarios, nil
+}
+
+// Runner sends scenario traffic to a base URL.
+type Runner struct {
+	BaseURL string
+	Client  *http.Client
+	// Users resolves fixture usernames to user IDs.
+	Users func(username string) (uuid.UUID, bool)
+}
+
+type preparedRequest struct {
+	method string
+	url    string
+	body   []byte
+	userID string
+}
+
+// Run executes the scenario and returns its latency and error summary.
+func (r *Runner) Run(ctx context.Context, s *Scenario) (*Result, error) {
+	requests, err := r.prepare(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		latencies = make([]time.Duration, 0, s.Rate*int(s.Duration.Seconds()+1))
+		errCount  int
+	)
+
+	ticker := time.NewTicker(time.Second / time.Duration(s.Rate))
+	defer ticker.Stop()
+
+	deadline := time.After(s.Duration.Duration)
+
+	for i := 0; ; i++ {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+
+			return nil, ctx.Err()
+		case <-deadline:
+			wg.Wait()
+
+			return summarize(s.Name, latencies, errCount), nil
+		case <-ticker.C:
+		}
+
+		req := requests[i%len(requests)]
+
+		wg.Go(func() {
+			latency, ok := r.send(ctx, req)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			latencies = append(latencies, latency)
+			if !ok {
+				errCount++
+			}
+		})
+	}
+}
+
+func (r *Runner) prepare(s *Scenario) ([]preparedRequest, error) {
+	base := strings.TrimRight(r.BaseURL, "/")
+	requests := make([]preparedRequest, 0, len(s.Targets))
+
+	for _, t := range s.Targets {
+		var resolveErr error
+
+		path := userPlaceholder.ReplaceAllStringFunc(t.Path, func(match string) string {
+			id, err := r.resolve(userPlaceholder.FindStringSubmatch(match)[1])
+			if err != nil {
+				resolveErr = err
+			}
+
+			return id
+		})
+		if resolveErr != nil {
+			return nil, fmt.Errorf("scenario %s: %w", s.Name, resolveErr)
+		}
+
+		req := preparedRequest{method: t.Method, url: base + path, body: t.Body}
+
+		if t.As != "" {
+			id, err := r.resolve(t.As)
+			if err != nil {
+				return nil, fmt.Errorf("scenario %s: %w", s.Name, err)
+			}
+
+			req.userID = id
+		}
+
+		requests = append(requests, req)
+	}
+
+	return requests, nil
+}
+
+func (r *Runner) resolve(username string) (string, error) {
+	id, ok := r.Users(username)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownUser, username)
+	}
+
+	return id.String(), nil
+}
+
+func (r *Runner) send(ctx context.Context, p preparedRequest) (time.Duration, bool) {
+	start := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, p.method, p.url, bytes.NewReader(p.body))
+	if err != nil {
+		return time.Since(start), false
+	}
+
+	if len(p.body) > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if p.userID != "" {
+		req.Header.Set("X-User-Id", p.userID)
+	}
+
+	resp, err := r.Client.Do(req)
+	if err != nil {
+		return time.Since(start), false
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	return time.Since(start), resp.StatusCode < http.StatusInternalServerError
+}
+
+func summarize(name string, latencies []time.Duration, errCount int) *Result {
+	slices.Sort(latencies)
+
+	return &Result{
+		Scenario: name,
+		Requests: len(latencies),
+		Errors:   errCount,
+		P50:      percentile(latencies, 50), //nolint:mnd // median
+		P99:      percentile(latencies, 99), //nolint:mnd // tail latency
+	}
+}
+
+// percentile returns the nearest-rank percentile of sorted latencies.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := (p*len(sorted) + 99) / 100 //nolint:mnd // ceil(p/100 * n)
+
+	return sorted[max(rank, 1)-1]
+}
@@ -0,0 +1,169 @@
+package load_test
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/fixtures"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/tests/load"
+)
+
+const (
+	scenariosFile = "scenarios.json"
+	baselineFile  = "baseline.json"
+)
+
+var updateBaseline = flag.Bool("load.update", false, "record observed latencies as the new baseline")
+
+// TestLoadGate runs the scenarios against LOAD_BASE_URL and fails when p99 latency or error rate
+// regress beyond the baseline. It is skipped unless LOAD_BASE_URL is set; see `make test-load`.
+func TestLoadGate(t *testing.T) {
+	baseURL := os.Getenv("LOAD_BASE_URL")
+	if baseURL == "" {
+		t.Skip("LOAD_BASE_URL not set")
+	}
+
+	fixturesFile := os.Getenv("LOAD_FIXTURES_FILE")
+	if fixturesFile == "" {
+		fixturesFile = "../../config/fixtures/demo.yaml"
+	}
+
+	f, err := fixtures.Load(fixturesFile)
+	require.NoError(t, err)
+
+	scenarios, err := load.LoadScenarios(scenariosFile)
+	require.NoError(t, err)
+
+	baseline, err := load.LoadBaseline(baselineFile)
+	require.NoError(t, err)
+
+	runner := &load.Runner{
+		BaseURL: baseURL,
+		Client:  &http.Client{Timeout: 10 * time.Second},
+		Users:   f.UserID,
+	}
+
+	results := make([]*load.Result, 0, len(scenarios))
+
+	for i := range scenarios {
+		result, err := runner.Run(t.Context(), &scenarios[i])
+		require.NoError(t, err)
+
+		t.Logf("%s: %d requests, p50 %s, p99 %s, error rate %.4f",
+			result.Scenario, result.Requests, result.P50, result.P99, result.ErrorRate())
+
+		results = append(results, result)
+	}
+
+	if *updateBaseline {
+		baseline.Record(results)
+		require.NoError(t, baseline.Save(baselineFile))
+		t.Logf("baseline written to %s", baselineFile)
+
+		return
+	}
+
+	regressions := baseline.Check(results)
+	assert.Empty(t, regressions, "performance regressed:\n%s", strings.Join(regressions, "\n"))
+}
+
+func TestRunner_Run(t *testing.T) {
+	t.Parallel()
+
+	alice := uuid.New()
+
+	var seenUser atomic.Value
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/broken") {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		seenUser.Store(r.Header.Get("X-User-Id"))
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	runner := &load.Runner{
+		BaseURL: server.URL,
+		Client:  server.Client(),
+		Users: func(username string) (uuid.UUID, bool) {
+			return alice, username == "alice"
+		},
+	}
+
+	scenario := &load.Scenario{
+		Name:     "mixed",
+		Rate:     100,
+		Duration: load.Duration{Duration: 200 * time.Millisecond},
+		Targets: []load.Target{
+			{Method: http.MethodGet, Path: "/users/{user:alice}/profile", As: "alice"},
+			{Method: http.MethodGet, Path: "/broken"},
+		},
+	}
+
+	result, err := runner.Run(t.Context(), scenario)
+	require.NoError(t, err)
+	assert.Equal(t, "mixed", result.Scenario)
+	assert.Positive(t, result.Requests)
+	assert.InDelta(t, 0.5, result.ErrorRate(), 0.1, "only 5xx responses count as errors")
+	assert.LessOrEqual(t, result.P50, result.P99)
+	assert.Equal(t, alice.String(), seenUser.Load())
+
+	scenario.Targets = []load.Target{{Method: http.MethodGet, Path: "/users/{user:mallory}"}}
+
+	_, err = runner.Run(t.Context(), scenario)
+	require.ErrorIs(t, err, load.ErrUnknownUser)
+}
+
+func TestBaseline_Check(t *testing.T) {
+	t.Parallel()
+
+	var baseline load.Baseline
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"latencyTolerance": 0.5,
+		"errorRateTolerance": 0.01,
+		"scenarios": {"reads": {"p99": "100ms", "errorRate": 0}}
+	}`), &baseline))
+
+	within := &load.Result{Scenario: "reads", Requests: 100, Errors: 1, P99: 140 * time.Millisecond}
+	assert.Empty(t, baseline.Check([]*load.Result{within}))
+
+	slow := &load.Result{Scenario: "reads", Requests: 100, Errors: 5, P99: 200 * time.Millisecond}
+	regressions := baseline.Check([]*load.Result{slow, {Scenario: "writes"}})
+	require.Len(t, regressions, 3)
+	assert.Contains(t, regressions[0], "error rate")
+	assert.Contains(t, regressions[1], "p99 200ms")
+	assert.Contains(t, regressions[2], "writes: no baseline")
+
+	baseline.Record([]*load.Result{slow})
+	assert.Empty(t, baseline.Check([]*load.Result{slow}))
+}
+
+func TestLoadScenarios(t *testing.T) {
+	t.Parallel()
+
+	scenarios, err := load.LoadScenarios(scenariosFile)
+	require.NoError(t, err)
+
+	baseline, err := load.LoadBaseline(baselineFile)
+	require.NoError(t, err)
+
+	for _, s := range scenarios {
+		assert.Contains(t, baseline.Scenarios, s.Name, "every scenario has a recorded baseline")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/database"
 )
 
@@ -31,7 +32,7 @@ func BenchmarkGetUserStats(b *testing.B) {
 	}
 
 	requesterID := uuid.New()
-	reqPath := "/api/v1/user-management/admin/users/stats"
+	reqPath := config.DefaultBasePath + "/admin/users/stats"
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, reqPath, nil)
 	req.Header.Set("X-User-Id", requesterID.String())
 
@@ -65,7 +66,7 @@ func BenchmarkGetUserStatsConcurrent(b *testing.B) {
 	}
 
 	requesterID := uuid.New()
-	reqPath := "/api/v1/user-management/admin/users/stats"
+	reqPath := config.DefaultBasePath + "/admin/users/stats"
 
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {
@@ -86,7 +87,7 @@ func BenchmarkClearCache(b *testing.B) {
 		b.Fatal("benchmark container is nil")
 	}
 
-	reqPath := "/api/v1/user-management/admin/cache/clear"
+	reqPath := config.DefaultBasePath + "/admin/cache/clear"
 
 	requesterID := uuid.New()
 
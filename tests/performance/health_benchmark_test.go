@@ -5,10 +5,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
 )
 
 func BenchmarkHealthEndpoint(b *testing.B) {
-	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/api/v1/user-management/health", nil)
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, config.DefaultBasePath+"/health", nil)
 
 	for b.Loop() {
 		rr := httptest.NewRecorder()
@@ -17,7 +19,7 @@ func BenchmarkHealthEndpoint(b *testing.B) {
 }
 
 func BenchmarkReadyEndpoint(b *testing.B) {
-	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/api/v1/user-management/ready", nil)
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, config.DefaultBasePath+"/ready", nil)
 
 	for b.Loop() {
 		rr := httptest.NewRecorder()
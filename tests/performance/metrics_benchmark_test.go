@@ -7,13 +7,15 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
 )
 
 func BenchmarkPerformanceMetricsEndpoint(b *testing.B) {
 	req, _ := http.NewRequestWithContext(
 		context.Background(),
 		http.MethodGet,
-		"/api/v1/user-management/metrics/performance",
+		config.DefaultBasePath+"/metrics/performance",
 		nil,
 	)
 	req.Header.Set("X-User-Id", uuid.New().String())
@@ -28,7 +30,7 @@ func BenchmarkCacheMetricsEndpoint(b *testing.B) {
 	req, _ := http.NewRequestWithContext(
 		context.Background(),
 		http.MethodGet,
-		"/api/v1/user-management/metrics/cache",
+		config.DefaultBasePath+"/metrics/cache",
 		nil,
 	)
 	req.Header.Set("X-User-Id", uuid.New().String())
@@ -43,7 +45,7 @@ func BenchmarkSystemMetricsEndpoint(b *testing.B) {
 	req, _ := http.NewRequestWithContext(
 		context.Background(),
 		http.MethodGet,
-		"/api/v1/user-management/metrics/system",
+		config.DefaultBasePath+"/metrics/system",
 		nil,
 	)
 	req.Header.Set("X-User-Id", uuid.New().String())
@@ -58,7 +60,7 @@ func BenchmarkDetailedHealthMetricsEndpoint(b *testing.B) {
 	req, _ := http.NewRequestWithContext(
 		context.Background(),
 		http.MethodGet,
-		"/api/v1/user-management/metrics/health/detailed",
+		config.DefaultBasePath+"/metrics/health/detailed",
 		nil,
 	)
 	req.Header.Set("X-User-Id", uuid.New().String())
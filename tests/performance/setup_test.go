@@ -7,9 +7,12 @@ import (
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
+
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/app"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/dto"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/mocks"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/server"
 )
 
@@ -42,7 +45,7 @@ func TestMain(m *testing.M) {
 	benchmarkContainer = container
 
 	// Inject mocks for benchmarks where external dependencies aren't available
-	container.AdminService = &MockAdminService{}
+	container.AdminService = adminServiceMock()
 
 	// Initialize the router with container
 	srv := server.NewServerWithContainer(container)
@@ -52,13 +55,18 @@ func TestMain(m *testing.M) {
 	os.Exit(code)
 }
 
-// MockAdminService for benchmarks.
-type MockAdminService struct{}
+// adminServiceMock clears any pattern successfully.
+func adminServiceMock() *mocks.AdminService {
+	m := new(mocks.AdminService)
+	m.On("ClearCache", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, keyPattern string) *dto.CacheClearResponse {
+			return &dto.CacheClearResponse{
+				Message:      "Cache cleared successfully",
+				Pattern:      keyPattern,
+				ClearedCount: 50,
+			}
+		}, nil,
+	).Maybe()
 
-func (m *MockAdminService) ClearCache(ctx context.Context, keyPattern string) (*dto.CacheClearResponse, error) {
-	return &dto.CacheClearResponse{
-		Message:      "Cache cleared successfully",
-		Pattern:      keyPattern,
-		ClearedCount: 50,
-	}, nil
+	return m
 }
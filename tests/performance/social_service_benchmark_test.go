@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/database"
 )
 
@@ -115,7 +116,7 @@ func BenchmarkGetFollowing(b *testing.B) {
 	requesterID := uuid.New()
 	seedBenchmarkUserForSocial(b, dbSvc.GetDB(), requesterID, "requester_"+requesterID.String()[:8])
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/following", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/following", targetUserID)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, reqPath, nil)
 	req.Header.Set("X-User-Id", requesterID.String())
 
@@ -160,7 +161,7 @@ func BenchmarkGetFollowingConcurrent(b *testing.B) {
 	requesterName := "requester_conc_" + requesterID.String()[:8]
 	seedBenchmarkUserForSocial(b, dbSvc.GetDB(), requesterID, requesterName)
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/following", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/following", targetUserID)
 
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {
@@ -206,7 +207,7 @@ func BenchmarkGetFollowingCountOnly(b *testing.B) {
 	requesterID := uuid.New()
 	seedBenchmarkUserForSocial(b, dbSvc.GetDB(), requesterID, "requester_count_"+requesterID.String()[:8])
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/following?countOnly=true", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/following?countOnly=true", targetUserID)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, reqPath, nil)
 	req.Header.Set("X-User-Id", requesterID.String())
 
@@ -249,7 +250,7 @@ func BenchmarkGetFollowingLargePagination(b *testing.B) {
 	requesterID := uuid.New()
 	seedBenchmarkUserForSocial(b, dbSvc.GetDB(), requesterID, "requester_large_"+requesterID.String()[:8])
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/following?limit=100", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/following?limit=100", targetUserID)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, reqPath, nil)
 	req.Header.Set("X-User-Id", requesterID.String())
 
@@ -291,7 +292,7 @@ func BenchmarkGetFollowers(b *testing.B) {
 	requesterID := uuid.New()
 	seedBenchmarkUserForSocial(b, dbSvc.GetDB(), requesterID, "requester_followers_"+requesterID.String()[:8])
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/followers", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/followers", targetUserID)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, reqPath, nil)
 	req.Header.Set("X-User-Id", requesterID.String())
 
@@ -336,7 +337,7 @@ func BenchmarkGetFollowersConcurrent(b *testing.B) {
 	requesterName := "requester_followers_conc_" + requesterID.String()[:8]
 	seedBenchmarkUserForSocial(b, dbSvc.GetDB(), requesterID, requesterName)
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/followers", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/followers", targetUserID)
 
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {
@@ -382,7 +383,7 @@ func BenchmarkGetFollowersCountOnly(b *testing.B) {
 	requesterID := uuid.New()
 	seedBenchmarkUserForSocial(b, dbSvc.GetDB(), requesterID, "requester_followers_count_"+requesterID.String()[:8])
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/followers?countOnly=true", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/followers?countOnly=true", targetUserID)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, reqPath, nil)
 	req.Header.Set("X-User-Id", requesterID.String())
 
@@ -425,7 +426,7 @@ func BenchmarkGetFollowersLargePagination(b *testing.B) {
 	requesterID := uuid.New()
 	seedBenchmarkUserForSocial(b, dbSvc.GetDB(), requesterID, "requester_followers_large_"+requesterID.String()[:8])
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/followers?limit=100", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/followers?limit=100", targetUserID)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, reqPath, nil)
 	req.Header.Set("X-User-Id", requesterID.String())
 
@@ -468,7 +469,7 @@ func BenchmarkFollowUser(b *testing.B) {
 	b.ResetTimer()
 
 	for i := range b.N {
-		reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/follow/%s", followerID, targetUserIDs[i])
+		reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/follow/%s", followerID, targetUserIDs[i])
 		req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, reqPath, nil)
 		req.Header.Set("X-User-Id", followerID.String())
 
@@ -519,7 +520,7 @@ func BenchmarkFollowUserConcurrent(b *testing.B) {
 			followerIdx++
 			followerID := followerIDs[idx]
 
-			reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/follow/%s", followerID, targetUserID)
+			reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/follow/%s", followerID, targetUserID)
 			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, reqPath, nil)
 			req.Header.Set("X-User-Id", followerID.String())
 
@@ -557,7 +558,7 @@ func BenchmarkFollowUserIdempotent(b *testing.B) {
 	// Create initial follow relationship
 	seedFollowRelationship(b, dbSvc.GetDB(), followerID, targetUserID)
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/follow/%s", followerID, targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/follow/%s", followerID, targetUserID)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, reqPath, nil)
 	req.Header.Set("X-User-Id", followerID.String())
 
@@ -603,7 +604,7 @@ func BenchmarkUnfollowUser(b *testing.B) {
 	b.ResetTimer()
 
 	for i := range b.N {
-		reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/follow/%s", followerID, targetUserIDs[i])
+		reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/follow/%s", followerID, targetUserIDs[i])
 		req, _ := http.NewRequestWithContext(context.Background(), http.MethodDelete, reqPath, nil)
 		req.Header.Set("X-User-Id", followerID.String())
 
@@ -655,7 +656,7 @@ func BenchmarkUnfollowUserConcurrent(b *testing.B) {
 			followerIdx++
 			followerID := followerIDs[idx]
 
-			reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/follow/%s", followerID, targetUserID)
+			reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/follow/%s", followerID, targetUserID)
 			req, _ := http.NewRequestWithContext(context.Background(), http.MethodDelete, reqPath, nil)
 			req.Header.Set("X-User-Id", followerID.String())
 
@@ -692,7 +693,7 @@ func BenchmarkUnfollowUserIdempotent(b *testing.B) {
 
 	// No initial follow relationship - testing idempotent behavior when not following
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/follow/%s", followerID, targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/follow/%s", followerID, targetUserID)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodDelete, reqPath, nil)
 	req.Header.Set("X-User-Id", followerID.String())
 
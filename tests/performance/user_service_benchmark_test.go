@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/config"
 	"github.com/jsamuelsen/recipe-web-app/user-management-service/internal/database"
 )
 
@@ -77,7 +78,7 @@ func BenchmarkGetUserProfile(b *testing.B) {
 
 	seedBenchmarkUser(b, dbSvc.GetDB(), targetUserID)
 
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s/profile", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s/profile", targetUserID)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, reqPath, nil)
 	req.Header.Set("X-User-Id", requesterID.String())
 
@@ -108,7 +109,7 @@ func BenchmarkUpdateUserProfile(b *testing.B) {
 
 	seedBenchmarkUser(b, dbSvc.GetDB(), userID)
 
-	reqPath := "/api/v1/user-management/users/profile"
+	reqPath := config.DefaultBasePath + "/users/profile"
 	reqBody := `{"bio": "Updated bio for benchmark test"}`
 
 	for b.Loop() {
@@ -142,7 +143,7 @@ func BenchmarkRequestAccountDeletion(b *testing.B) {
 
 	seedBenchmarkUser(b, dbSvc.GetDB(), userID)
 
-	reqPath := "/api/v1/user-management/users/account/delete-request"
+	reqPath := config.DefaultBasePath + "/users/account/delete-request"
 
 	for b.Loop() {
 		req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, reqPath, nil)
@@ -174,7 +175,7 @@ func BenchmarkRequestAccountDeletionConcurrent(b *testing.B) {
 
 	seedBenchmarkUser(b, dbSvc.GetDB(), userID)
 
-	reqPath := "/api/v1/user-management/users/account/delete-request"
+	reqPath := config.DefaultBasePath + "/users/account/delete-request"
 
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {
@@ -213,7 +214,7 @@ func BenchmarkSearchUsers(b *testing.B) {
 	requesterID := uuid.New()
 	seedBenchmarkUser(b, dbSvc.GetDB(), requesterID)
 
-	reqPath := "/api/v1/user-management/users/search?query=perf&limit=10"
+	reqPath := config.DefaultBasePath + "/users/search?query=perf&limit=10"
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, reqPath, nil)
 	req.Header.Set("X-User-Id", requesterID.String())
 
@@ -249,7 +250,7 @@ func BenchmarkSearchUsersConcurrent(b *testing.B) {
 	requesterID := uuid.New()
 	seedBenchmarkUser(b, dbSvc.GetDB(), requesterID)
 
-	reqPath := "/api/v1/user-management/users/search?query=perf&limit=10"
+	reqPath := config.DefaultBasePath + "/users/search?query=perf&limit=10"
 
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {
@@ -284,7 +285,7 @@ func BenchmarkGetUserByID(b *testing.B) {
 	seedBenchmarkUser(b, dbSvc.GetDB(), targetUserID)
 
 	requesterID := uuid.New()
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s", targetUserID)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, reqPath, nil)
 	req.Header.Set("X-User-Id", requesterID.String())
 
@@ -316,7 +317,7 @@ func BenchmarkGetUserByIDConcurrent(b *testing.B) {
 	seedBenchmarkUser(b, dbSvc.GetDB(), targetUserID)
 
 	requesterID := uuid.New()
-	reqPath := fmt.Sprintf("/api/v1/user-management/users/%s", targetUserID)
+	reqPath := fmt.Sprintf(config.DefaultBasePath+"/users/%s", targetUserID)
 
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {